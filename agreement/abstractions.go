@@ -26,6 +26,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -305,6 +306,12 @@ type EventsProcessingMonitor interface {
 	UpdateEventsQueue(queueName string, queueLength int)
 }
 
+// RoundTimingObserver receives the timing of the agreement on every round, as telemetrized when
+// EnableAgreementTimeMetrics is set. It is called from the main state machine loop, and so must not block.
+type RoundTimingObserver interface {
+	ObserveRoundTiming(timing telemetryspec.RoundTimingMetrics)
+}
+
 // LedgerDroppedRoundError is a wrapper error for when the ledger cannot return a Lookup query because
 // the entry is old and was dropped from the ledger. The purpose of this wrapper is to help the
 // agreement differentiate between a malicious vote and a vote that it cannot verify
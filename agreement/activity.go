@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// Activity tracks the work pending in an agreement service, so that a simulation which hands every
+// input to the service, such as the nodetest package, can tell when the service is done reacting to them.
+//
+// The messages of the service must be queued through a network wrapped by the gossip package, which
+// reports them with MessageQueued. The timeouts of its clock must be signalled by closing their channel,
+// as the virtual clocks of util/timers do.
+type Activity struct {
+	monitor coserviceMonitor
+	notify  func()
+
+	mu          deadlock.Mutex
+	pending     uint
+	timeouts    [2]<-chan time.Time
+	nextRound   <-chan struct{}
+	prioritized bool
+}
+
+// MakeActivity creates an Activity which calls notify whenever the service may have become idle.
+// notify is called with the Activity locked, so it must not block nor call back into the Activity.
+func MakeActivity(notify func()) *Activity {
+	a := &Activity{notify: notify}
+	a.monitor.untimed = true
+	a.monitor.coserviceListener = a
+	return a
+}
+
+// MessageQueued records that a message was queued for the service.
+// It must be called before the message is queued, so that the service cannot take it first.
+func (a *Activity) MessageQueued() {
+	if a == nil {
+		return
+	}
+	a.monitor.inc(tokenizerCoserviceType)
+}
+
+// MessageDropped records that a message reported with MessageQueued could not be queued after all.
+func (a *Activity) MessageDropped() {
+	if a == nil {
+		return
+	}
+	a.monitor.dec(tokenizerCoserviceType)
+}
+
+// Idle returns true if the service is waiting for new inputs, with nothing left of the inputs it was handed:
+// no message or verification is pending, and none of the timeouts it awaits has fired.
+func (a *Activity) Idle() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.pending > 0 || a.prioritized {
+		return false
+	}
+	for _, ch := range a.timeouts {
+		select {
+		case <-ch:
+			return false
+		default:
+		}
+	}
+	select {
+	case <-a.nextRound:
+		return false
+	default:
+	}
+	return true
+}
+
+// await records the channels the demux is about to wait on, before it reports that it is waiting.
+// prioritized is set if the demux also waits for the events of a prioritized channel, such as those of the persistence loop,
+// which are produced without further input.
+func (a *Activity) await(deadline <-chan time.Time, fastDeadline <-chan time.Time, nextRound <-chan struct{}, prioritized bool) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.timeouts = [2]<-chan time.Time{deadline, fastDeadline}
+	a.nextRound = nextRound
+	a.prioritized = prioritized
+}
+
+func (a *Activity) inc(sum uint, state map[coserviceType]uint) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.pending = sum
+}
+
+func (a *Activity) dec(sum uint, state map[coserviceType]uint) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.pending = sum
+	if a.notify != nil {
+		a.notify()
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestActivityIdle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	notified := 0
+	a := MakeActivity(func() { notified++ })
+	m := &a.monitor
+
+	// the demux is busy until it waits
+	m.inc(demuxCoserviceType)
+	require.False(t, a.Idle())
+
+	deadline := make(chan time.Time)
+	nextRound := make(chan struct{})
+	a.await(deadline, nil, nextRound, false)
+	m.dec(demuxCoserviceType)
+	require.True(t, a.Idle())
+	require.Equal(t, 1, notified)
+
+	// a queued message keeps the service busy until the demux takes it
+	a.MessageQueued()
+	require.False(t, a.Idle())
+	m.inc(demuxCoserviceType)
+	m.dec(tokenizerCoserviceType)
+	require.False(t, a.Idle())
+	a.await(deadline, nil, nextRound, false)
+	m.dec(demuxCoserviceType)
+	require.True(t, a.Idle())
+
+	// so does a dropped message, until it is reported
+	a.MessageQueued()
+	require.False(t, a.Idle())
+	a.MessageDropped()
+	require.True(t, a.Idle())
+
+	// the timeouts taken by the demux are not counted, the fired ones it awaits keep it busy instead
+	close(deadline)
+	require.False(t, a.Idle())
+	m.inc(demuxCoserviceType)
+	m.dec(clockCoserviceType)
+	a.await(make(chan time.Time), nil, nextRound, false)
+	m.dec(demuxCoserviceType)
+	require.True(t, a.Idle())
+
+	close(nextRound)
+	require.False(t, a.Idle())
+	a.await(nil, nil, make(chan struct{}), true)
+	require.False(t, a.Idle())
+}
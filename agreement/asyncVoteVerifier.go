@@ -36,6 +36,31 @@ type asyncVerifyVoteRequest struct {
 	out chan<- asyncVerifyVoteResponse
 }
 
+// maxVoteBatchSize is the largest number of votes verified by a single task.
+const maxVoteBatchSize = 16
+
+// voteBatchSize returns the size of the batches n votes should be verified in
+// so that all of the parallelism workers get a share of them.
+func voteBatchSize(n int, parallelism int) int {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	size := (n + parallelism - 1) / parallelism
+	if size > maxVoteBatchSize {
+		size = maxVoteBatchSize
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// asyncVerifyVoteBatchRequest is a batch of votes verified by a single task.
+type asyncVerifyVoteBatchRequest struct {
+	l    LedgerReader
+	reqs []asyncVerifyVoteRequest
+}
+
 type asyncVerifyVoteResponse struct {
 	v         vote
 	ev        equivocationVote
@@ -86,9 +111,15 @@ func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVeri
 func (avv *AsyncVoteVerifier) worker() {
 	defer close(avv.workerWaitCh)
 	for res := range avv.execpoolOut {
-		asyncResponse := res.(*asyncVerifyVoteResponse)
-		if asyncResponse != nil {
-			asyncResponse.req.out <- *asyncResponse
+		switch asyncResponse := res.(type) {
+		case *asyncVerifyVoteResponse:
+			if asyncResponse != nil {
+				asyncResponse.req.out <- *asyncResponse
+			}
+		case []asyncVerifyVoteResponse:
+			for i := range asyncResponse {
+				asyncResponse[i].req.out <- asyncResponse[i]
+			}
 		}
 		avv.wg.Done()
 	}
@@ -113,6 +144,39 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 	}
 }
 
+// executeVoteBatchVerification verifies a batch of votes at once, and returns a
+// response for each of them.
+func (avv *AsyncVoteVerifier) executeVoteBatchVerification(task interface{}) interface{} {
+	batch := task.(asyncVerifyVoteBatchRequest)
+
+	responses := make([]asyncVerifyVoteResponse, len(batch.reqs))
+	uvs := make([]unauthenticatedVote, 0, len(batch.reqs))
+	pending := make([]int, 0, len(batch.reqs))
+	for i := range batch.reqs {
+		req := &batch.reqs[i]
+		select {
+		case <-req.ctx.Done():
+			// request cancelled, return an error response on the channel
+			responses[i] = asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: req, index: req.index}
+		default:
+			uvs = append(uvs, *req.uv)
+			pending = append(pending, i)
+		}
+	}
+
+	votes, errs := verifyVotes(batch.l, uvs)
+	for j, i := range pending {
+		req := &batch.reqs[i]
+		req.message.Vote = votes[j]
+
+		var e *LedgerDroppedRoundError
+		cancelled := errors.As(errs[j], &e)
+
+		responses[i] = asyncVerifyVoteResponse{v: votes[j], index: req.index, message: req.message, err: errs[j], cancelled: cancelled, req: req}
+	}
+	return responses
+}
+
 func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interface{} {
 	req := task.(asyncVerifyVoteRequest)
 
@@ -151,6 +215,27 @@ func (avv *AsyncVoteVerifier) verifyVote(verctx context.Context, l LedgerReader,
 	return nil
 }
 
+// verifyVotes enqueues a single task verifying the votes of all the given
+// requests, so that the VRF proofs of their credentials are checked as a batch.
+// A response is written for each request, on its own output channel.
+func (avv *AsyncVoteVerifier) verifyVotes(l LedgerReader, reqs []asyncVerifyVoteRequest) error {
+	select {
+	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the request
+	// as in verifyVote, do not wait on the requests' contexts here, or we will lose the votes
+	default:
+		for i := range reqs {
+			reqs[i].l = l
+		}
+		avv.wg.Add(1)
+		if err := avv.backlogExecPool.EnqueueBacklog(avv.ctx, avv.executeVoteBatchVerification, asyncVerifyVoteBatchRequest{l: l, reqs: reqs}, avv.execpoolOut); err != nil {
+			// as in verifyVote, fix the accounting of the number of pending tasks
+			avv.wg.Done()
+			return err
+		}
+	}
+	return nil
+}
+
 func (avv *AsyncVoteVerifier) verifyEqVote(verctx context.Context, l LedgerReader, uev unauthenticatedEquivocationVote, index uint64, message message, out chan<- asyncVerifyVoteResponse) error {
 	select {
 	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the request
@@ -188,6 +273,14 @@ func (avv *AsyncVoteVerifier) Quit() {
 	<-avv.workerWaitCh
 }
 
+// saturated reports whether all the verifier's workers are busy and at least
+// as many tasks are waiting for them, in which case batching more votes into a
+// task delays none of them.
+func (avv *AsyncVoteVerifier) saturated() bool {
+	length, _ := avv.backlogExecPool.BufferSize()
+	return length >= avv.backlogExecPool.GetParallelism()
+}
+
 // Parallelism gives the maximum parallelism of the vote verifier.
 func (avv *AsyncVoteVerifier) Parallelism() int {
 	return avv.backlogExecPool.GetParallelism()
@@ -50,4 +50,61 @@ func TestVerificationAgainstFullExecutionPool(t *testing.T) {
 	require.Equal(t, context.Canceled, verifyErr)
 	verifyEqVoteErr := voteVerifier.verifyEqVote(context.Background(), nil, unauthenticatedEquivocationVote{}, 0, message{}, make(chan<- asyncVerifyVoteResponse, 1))
 	require.Equal(t, context.Canceled, verifyEqVoteErr)
+	verifyVotesErr := voteVerifier.verifyVotes(nil, []asyncVerifyVoteRequest{{ctx: context.Background(), uv: &unauthenticatedVote{}, out: make(chan<- asyncVerifyVoteResponse, 1)}})
+	require.Equal(t, context.Canceled, verifyVotesErr)
+}
+
+func TestVoteBatchSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, 1, voteBatchSize(0, 4))
+	require.Equal(t, 1, voteBatchSize(3, 4))
+	require.Equal(t, 3, voteBatchSize(10, 4))
+	require.Equal(t, 10, voteBatchSize(10, 0))
+	require.Equal(t, maxVoteBatchSize, voteBatchSize(1000, 4))
+}
+
+// Test that a batch of votes gets a response for each of its votes, in the
+// order of its requests.
+func TestVerifyVotesBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	voteVerifier := MakeAsyncVoteVerifier(nil)
+	defer voteVerifier.Quit()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	const n = 10
+	out := make(chan asyncVerifyVoteResponse, n)
+	reqs := make([]asyncVerifyVoteRequest, n)
+	for i := range reqs {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		rv := rawVote{Sender: addresses[i], Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		reqs[i] = asyncVerifyVoteRequest{ctx: context.Background(), uv: &uv, index: uint64(i), out: out}
+	}
+	reqs[3].ctx = cancelled
+
+	require.NoError(t, voteVerifier.verifyVotes(ledger, reqs))
+	for i := 0; i < n; i++ {
+		res := <-out
+		require.Equal(t, uint64(i), res.index)
+		if i == 3 {
+			require.True(t, res.cancelled)
+			require.ErrorIs(t, res.err, context.Canceled)
+			continue
+		}
+		_, err := reqs[i].uv.verify(ledger)
+		require.Equal(t, err, res.err)
+		if err == nil {
+			require.Equal(t, res.v, res.message.Vote)
+		}
+	}
 }
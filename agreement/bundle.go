@@ -192,18 +192,27 @@ func (b unauthenticatedBundle) verifyAsync(ctx context.Context, l LedgerReader,
 	// make a buffer large enough to queue all results so we never wait
 	results := make(chan asyncVerifyVoteResponse, len(b.Votes)+len(b.EquivocationVotes))
 
-	// create verification requests for votes
-	for i, auth := range b.Votes {
+	// create verification requests for votes, in batches spread over the verifier's workers
+	batchSize := voteBatchSize(len(b.Votes), avv.Parallelism())
+	for first := 0; first < len(b.Votes); first += batchSize {
 		select {
 		case <-ctx.Done():
 			return termErrorFn(ctx.Err())
 		default:
 		}
 
-		rv := rawVote{Sender: auth.Sender, Round: b.Round, Period: b.Period, Step: b.Step, Proposal: b.Proposal}
-		uv := unauthenticatedVote{R: rv, Cred: auth.Cred, Sig: auth.Sig}
+		votes := b.Votes[first:]
+		if len(votes) > batchSize {
+			votes = votes[:batchSize]
+		}
+		reqs := make([]asyncVerifyVoteRequest, len(votes))
+		for j, auth := range votes {
+			rv := rawVote{Sender: auth.Sender, Round: b.Round, Period: b.Period, Step: b.Step, Proposal: b.Proposal}
+			uv := unauthenticatedVote{R: rv, Cred: auth.Cred, Sig: auth.Sig}
+			reqs[j] = asyncVerifyVoteRequest{ctx: ctx, uv: &uv, index: uint64(first + j), out: results}
+		}
 
-		avv.verifyVote(ctx, l, uv, uint64(i), message{}, results) //nolint:errcheck // verifyVote will call EnqueueBacklog, which blocks until the verify task is queued, or returns an error when ctx.Done(), which we are already checking
+		avv.verifyVotes(l, reqs) //nolint:errcheck // verifyVotes will call EnqueueBacklog, which blocks until the verify task is queued, or returns an error when ctx.Done(), which we are already checking
 	}
 
 	// create verification requests for equivocation votes
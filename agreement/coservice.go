@@ -41,6 +41,9 @@ type coserviceMonitor struct {
 	id int
 	c  map[coserviceType]uint
 
+	// untimed monitors are not told about the timeouts about to fire, so the timeouts taken by the demux are not counted.
+	untimed bool
+
 	coserviceListener
 }
 
@@ -68,7 +71,7 @@ func (m *coserviceMonitor) inc(t coserviceType) {
 }
 
 func (m *coserviceMonitor) dec(t coserviceType) {
-	if m == nil {
+	if m == nil || (m.untimed && t == clockCoserviceType) {
 		return
 	}
 
@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// crashCompactMinFreePages is the number of unused pages the crash database must
+// have accumulated for the service to compact it on startup.
+const crashCompactMinFreePages = 64
+
+// ErrNoCrashState is returned by ReadCrashState when no state is persisted in the
+// crash database.
+var ErrNoCrashState = errors.New("no agreement crash state available")
+
+// CrashState summarizes the agreement state persisted for crash recovery, which
+// the service resumes from when it restarts.
+type CrashState struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Deadline is the time of the next timeout of the player, relative to the
+	// start of the period.
+	Deadline time.Duration
+	Napping  bool
+
+	// PendingProposals is the number of proposals waiting for a vote to be verified.
+	PendingProposals int
+
+	// Votes holds the votes counted in each step, in round, period and step order.
+	Votes []CrashStateStepVotes
+
+	// Actions holds the actions which were pending when the state was persisted.
+	Actions []string
+}
+
+// CrashStateStepVotes are the votes counted in a step of a CrashState.
+type CrashStateStepVotes struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Proposals holds the votes for each proposal, by decreasing weight.
+	Proposals []CrashStateProposalVotes
+
+	// Equivocators is the number of voters which equivocated in the step.
+	Equivocators int
+}
+
+// CrashStateProposalVotes are the votes for a proposal in a CrashStateStepVotes.
+// The zero BlockDigest stands for the votes for no proposal.
+type CrashStateProposalVotes struct {
+	BlockDigest      crypto.Digest
+	OriginalProposer basics.Address
+	OriginalPeriod   uint64
+	Weight           uint64
+	Voters           int
+}
+
+// ReadCrashState reads and decodes the state persisted in the crash database.
+// It returns ErrNoCrashState if there is none. Unlike the restoration done by
+// the service, it never modifies the database.
+func ReadCrashState(log logging.Logger, crash db.Accessor) (CrashState, error) {
+	var raw []byte
+	err := crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var ok bool
+		err := tx.QueryRow("select count(*) > 0 from sqlite_master where type = 'table' and name = 'Service'").Scan(&ok)
+		if err != nil || !ok {
+			return err
+		}
+		err = tx.QueryRow("select data from Service where rowid = 1").Scan(&raw)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return CrashState{}, fmt.Errorf("unable to read the agreement crash state: %w", err)
+	}
+	if raw == nil {
+		return CrashState{}, ErrNoCrashState
+	}
+
+	_, rr, p, a, err := decode(raw, timers.MakeMonotonicClock[TimeoutType](time.Now()), makeServiceLogger(log), false)
+	if err != nil {
+		return CrashState{}, fmt.Errorf("unable to decode the agreement crash state: %w", err)
+	}
+	return makeCrashState(rr, p, a), nil
+}
+
+func makeCrashState(rr rootRouter, p player, a []action) CrashState {
+	cs := CrashState{
+		Round:            p.Round,
+		Period:           uint64(p.Period),
+		Step:             uint64(p.Step),
+		Deadline:         p.Deadline.Duration,
+		Napping:          p.Napping,
+		PendingProposals: len(p.Pending.Pending),
+	}
+	for _, act := range a {
+		cs.Actions = append(cs.Actions, act.String())
+	}
+
+	for r, rrouter := range rr.Children {
+		for per, prouter := range rrouter.Children {
+			for s, srouter := range prouter.Children {
+				tracker := srouter.VoteTracker
+				if len(tracker.Counts) == 0 && len(tracker.Equivocators) == 0 {
+					continue
+				}
+				votes := CrashStateStepVotes{Round: r, Period: uint64(per), Step: uint64(s), Equivocators: len(tracker.Equivocators)}
+				for pv, counter := range tracker.Counts {
+					votes.Proposals = append(votes.Proposals, CrashStateProposalVotes{
+						BlockDigest:      pv.BlockDigest,
+						OriginalProposer: pv.OriginalProposer,
+						OriginalPeriod:   uint64(pv.OriginalPeriod),
+						Weight:           counter.Count,
+						Voters:           len(counter.Votes),
+					})
+				}
+				sort.Slice(votes.Proposals, func(i, j int) bool {
+					pi, pj := votes.Proposals[i], votes.Proposals[j]
+					if pi.Weight != pj.Weight {
+						return pi.Weight > pj.Weight
+					}
+					return bytes.Compare(pi.BlockDigest[:], pj.BlockDigest[:]) < 0
+				})
+				cs.Votes = append(cs.Votes, votes)
+			}
+		}
+	}
+	sort.Slice(cs.Votes, func(i, j int) bool {
+		vi, vj := cs.Votes[i], cs.Votes[j]
+		if vi.Round != vj.Round {
+			return vi.Round < vj.Round
+		}
+		if vi.Period != vj.Period {
+			return vi.Period < vj.Period
+		}
+		return vi.Step < vj.Step
+	})
+	return cs
+}
+
+// StepName returns the name of an agreement step, as used in the specification.
+func StepName(s uint64) string {
+	switch st := step(s); {
+	case st == propose:
+		return "propose"
+	case st == soft:
+		return "soft"
+	case st == cert:
+		return "cert"
+	case st == late:
+		return "late"
+	case st == redo:
+		return "redo"
+	case st == down:
+		return "down"
+	case st >= next && st < late:
+		return fmt.Sprintf("next%d", st-next)
+	default:
+		return fmt.Sprintf("step%d", s)
+	}
+}
+
+// Dump writes the state in human readable form.
+func (cs CrashState) Dump(w io.Writer) {
+	fmt.Fprintf(w, "Round: %d\nPeriod: %d\nStep: %s\n", cs.Round, cs.Period, StepName(cs.Step))
+	fmt.Fprintf(w, "Deadline: %v\nNapping: %v\nPending proposals: %d\n", cs.Deadline, cs.Napping, cs.PendingProposals)
+
+	fmt.Fprintf(w, "Pending actions: %d\n", len(cs.Actions))
+	for _, act := range cs.Actions {
+		fmt.Fprintf(w, "  %s\n", act)
+	}
+
+	fmt.Fprintf(w, "Votes:\n")
+	if len(cs.Votes) == 0 {
+		fmt.Fprintf(w, "  none\n")
+	}
+	for _, votes := range cs.Votes {
+		fmt.Fprintf(w, "  round %d period %d step %s, %d equivocators\n", votes.Round, votes.Period, StepName(votes.Step), votes.Equivocators)
+		for _, pv := range votes.Proposals {
+			if pv.BlockDigest.IsZero() {
+				fmt.Fprintf(w, "    no proposal: weight %d from %d voters\n", pv.Weight, pv.Voters)
+				continue
+			}
+			fmt.Fprintf(w, "    block %v proposed by %v in period %d: weight %d from %d voters\n",
+				pv.BlockDigest, pv.OriginalProposer, pv.OriginalPeriod, pv.Weight, pv.Voters)
+		}
+	}
+}
+
+// CompactCrashState reclaims the space of the crash database left unused by the
+// states persisted earlier. It must not be called while the database is in use
+// by the service.
+func CompactCrashState(ctx context.Context, crash db.Accessor) (db.VacuumStats, error) {
+	return crash.Vacuum(ctx)
+}
+
+// compact compacts the crash database when the states persisted earlier left
+// enough unused space in it. Failures are logged, since the database remains
+// usable.
+func compact(log logging.Logger, crash db.Accessor) {
+	ctx := context.Background()
+	free, err := crash.GetFreelistCount(ctx)
+	if err != nil {
+		log.Warnf("compact (agreement): unable to read the free pages of the crash database: %v", err)
+		return
+	}
+	if free < crashCompactMinFreePages {
+		return
+	}
+	stats, err := CompactCrashState(ctx, crash)
+	if err != nil {
+		log.Warnf("compact (agreement): unable to compact the crash database: %v", err)
+		return
+	}
+	log.Infof("compact (agreement): compacted the crash database from %d to %d bytes", stats.SizeBefore, stats.SizeAfter)
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+func TestReadCrashState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accessor, err := db.MakeAccessor(filepath.Join(t.TempDir(), "crash.sqlite"), false, false)
+	require.NoError(t, err)
+	defer accessor.Close()
+	log := makeServiceLogger(logging.TestingLog(t))
+
+	_, err = ReadCrashState(log, accessor)
+	require.ErrorIs(t, err, ErrNoCrashState)
+	_, err = restore(log, accessor)
+	require.ErrorIs(t, err, errNoCrashStateAvailable)
+	_, err = ReadCrashState(log, accessor)
+	require.ErrorIs(t, err, ErrNoCrashState)
+
+	var voterA, voterB, voterC basics.Address
+	voterA[0], voterB[0], voterC[0] = 1, 2, 3
+	pv := proposalValue{OriginalPeriod: 1, OriginalProposer: voterA, BlockDigest: crypto.Hash([]byte("block"))}
+	status := player{Round: 350, Period: 1, Step: cert, Deadline: Deadline{Duration: 4 * time.Second, Type: TimeoutDeadline}}
+	router := makeRootRouter(status)
+	router.Children = map[round]*roundRouter{
+		350: {Children: map[period]*periodRouter{
+			1: {Children: map[step]*stepRouter{
+				next + 1: {VoteTracker: voteTracker{
+					Counts: map[proposalValue]proposalVoteCounter{
+						{}: {Count: 5, Votes: map[basics.Address]vote{voterC: {}}},
+						pv: {Count: 9, Votes: map[basics.Address]vote{voterA: {}, voterB: {}}},
+					},
+					Equivocators: map[basics.Address]equivocationVote{voterC: {}},
+				}},
+				// steps without votes are left out
+				cert: {},
+			}},
+			0: {Children: map[step]*stepRouter{
+				soft: {VoteTracker: voteTracker{
+					Counts: map[proposalValue]proposalVoteCounter{pv: {Count: 3, Votes: map[basics.Address]vote{voterB: {}}}},
+				}},
+			}},
+		}},
+	}
+	a := []action{checkpointAction{Round: 350, Period: 1, Step: cert}}
+	raw := encode(timers.MakeMonotonicClock[TimeoutType](time.Now()), router, status, a, false)
+	require.NoError(t, persist(log, accessor, 350, 1, cert, raw))
+
+	state, err := ReadCrashState(log, accessor)
+	require.NoError(t, err)
+	require.Equal(t, CrashState{
+		Round:    350,
+		Period:   1,
+		Step:     uint64(cert),
+		Deadline: 4 * time.Second,
+		Votes: []CrashStateStepVotes{
+			{Round: 350, Period: 0, Step: uint64(soft), Proposals: []CrashStateProposalVotes{
+				{BlockDigest: pv.BlockDigest, OriginalProposer: voterA, OriginalPeriod: 1, Weight: 3, Voters: 1},
+			}},
+			{Round: 350, Period: 1, Step: uint64(next + 1), Equivocators: 1, Proposals: []CrashStateProposalVotes{
+				{BlockDigest: pv.BlockDigest, OriginalProposer: voterA, OriginalPeriod: 1, Weight: 9, Voters: 2},
+				{Weight: 5, Voters: 1},
+			}},
+		},
+		Actions: []string{a[0].String()},
+	}, state)
+
+	var out bytes.Buffer
+	state.Dump(&out)
+	require.Contains(t, out.String(), "Round: 350\nPeriod: 1\nStep: cert\n")
+	require.Contains(t, out.String(), "round 350 period 1 step next1, 1 equivocators\n")
+	require.Contains(t, out.String(), "    no proposal: weight 5 from 1 voters\n")
+}
+
+func TestCompactCrashState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accessor, err := db.MakeAccessor(filepath.Join(t.TempDir(), "crash.sqlite"), false, false)
+	require.NoError(t, err)
+	defer accessor.Close()
+	log := makeServiceLogger(logging.TestingLog(t))
+	freePages := func() uint64 {
+		free, err := accessor.GetFreelistCount(context.Background())
+		require.NoError(t, err)
+		return free
+	}
+
+	_, err = restore(log, accessor)
+	require.ErrorIs(t, err, errNoCrashStateAvailable)
+
+	// a large state, e.g. persisted after a long partition, leaves its pages unused once replaced
+	require.NoError(t, persist(log, accessor, 1, 0, soft, make([]byte, 1<<20)))
+	require.NoError(t, persist(log, accessor, 2, 0, soft, []byte{1}))
+	require.Greater(t, freePages(), uint64(crashCompactMinFreePages))
+
+	compact(log, accessor)
+	require.Zero(t, freePages())
+	err = accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var data []byte
+		require.NoError(t, tx.QueryRow("select data from Service").Scan(&data))
+		require.Equal(t, []byte{1}, data)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// small amounts of unused space are left alone
+	require.NoError(t, persist(log, accessor, 3, 0, soft, make([]byte, 8<<10)))
+	require.NoError(t, persist(log, accessor, 4, 0, soft, []byte{1}))
+	free := freePages()
+	require.NotZero(t, free)
+	compact(log, accessor)
+	require.Equal(t, free, freePages())
+}
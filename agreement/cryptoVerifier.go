@@ -213,16 +213,28 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 				continue
 			}
 
-			uv := votereq.message.UnauthenticatedVote
-			err := c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.votes.out)
-			if err != nil && c.votes.out != nil {
-				select {
-				case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
-				default:
-					voteVerifierOutFullCounter.Inc(nil)
-					c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+			// when the verifier is already busy, verify the votes that queued up
+			// meanwhile in a single batch
+			votereqs := []cryptoVoteRequest{votereq}
+			if c.voteVerifier.saturated() {
+			drain:
+				for len(votereqs) < maxVoteBatchSize {
+					select {
+					case votereq, ok := <-votesin:
+						if !ok {
+							votesin = nil
+							break drain
+						}
+						votereqs = append(votereqs, votereq)
+					default:
+						break drain
+					}
 				}
 			}
+			c.verifyVotes(votereqs)
+			if votesin == nil && bundlesin == nil {
+				return
+			}
 		case bundlereq, ok := <-bundlesin:
 			if !ok {
 				bundlesin = nil
@@ -251,6 +263,33 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 	}
 }
 
+func (c *poolCryptoVerifier) verifyVotes(votereqs []cryptoVoteRequest) {
+	var err error
+	if len(votereqs) == 1 {
+		votereq := votereqs[0]
+		uv := votereq.message.UnauthenticatedVote
+		err = c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.votes.out)
+	} else {
+		reqs := make([]asyncVerifyVoteRequest, len(votereqs))
+		for i, votereq := range votereqs {
+			uv := votereq.message.UnauthenticatedVote
+			reqs[i] = asyncVerifyVoteRequest{ctx: votereq.ctx, uv: &uv, index: votereq.TaskIndex, message: votereq.message, out: c.votes.out}
+		}
+		err = c.voteVerifier.verifyVotes(c.ledger, reqs)
+	}
+	if err == nil || c.votes.out == nil {
+		return
+	}
+	for _, votereq := range votereqs {
+		select {
+		case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
+		default:
+			voteVerifierOutFullCounter.Inc(nil)
+			c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+		}
+	}
+}
+
 func (c *poolCryptoVerifier) bundleWaitWorker(fromVoteFill <-chan bundleFuture) {
 	defer c.wg.Done()
 	for future := range fromVoteFill {
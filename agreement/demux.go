@@ -60,6 +60,7 @@ type demux struct {
 	queue             []<-chan externalEvent
 	processingMonitor EventsProcessingMonitor
 	monitor           *coserviceMonitor
+	activity          *Activity
 	cancelTokenizers  context.CancelFunc
 
 	log logging.Logger
@@ -74,6 +75,7 @@ type demuxParams struct {
 	processingMonitor EventsProcessingMonitor
 	log               logging.Logger
 	monitor           *coserviceMonitor
+	activity          *Activity
 }
 
 // makeDemux initializes the goroutines needed to process external events, setting up the appropriate channels.
@@ -85,6 +87,7 @@ func makeDemux(params demuxParams) (d *demux) {
 	d.log = params.log
 	d.ledger = params.ledger
 	d.monitor = params.monitor
+	d.activity = params.activity
 	d.queue = make([]<-chan externalEvent, 0)
 	d.processingMonitor = params.processingMonitor
 
@@ -133,6 +136,7 @@ func (d *demux) tokenizeMessages(ctx context.Context, net Network, tag protocol.
 					d.log.Warn(warnMsg)
 					net.Disconnect(raw.MessageHandle)
 					d.UpdateEventsQueue(eventQueueTokenizing[tag], 0)
+					d.monitor.dec(tokenizerCoserviceType)
 					continue
 				}
 
@@ -253,6 +257,7 @@ func (d *demux) next(s *Service, deadline Deadline, fastDeadline Deadline, curre
 	fastDeadlineCh := s.Clock.TimeoutAt(fastDeadline.Duration, fastDeadline.Type)
 
 	d.UpdateEventsQueue(eventQueueDemux, 0)
+	d.activity.await(deadlineCh, fastDeadlineCh, ledgerNextRoundCh, pseudonodeEvents != nil)
 	d.monitor.dec(demuxCoserviceType)
 
 	select {
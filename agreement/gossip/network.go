@@ -57,7 +57,8 @@ type networkImpl struct {
 	net network.GossipNode
 	log logging.Logger
 
-	trace messagetracer.MessageTracer
+	trace    messagetracer.MessageTracer
+	activity *agreement.Activity
 }
 
 // WrapNetwork adapts a network.GossipNode into an agreement.Network.
@@ -80,6 +81,12 @@ func SetTrace(net agreement.Network, trace messagetracer.MessageTracer) {
 	i.trace = trace
 }
 
+// SetActivity modifies the result of WrapNetwork to report the messages it queues to the given Activity
+func SetActivity(net agreement.Network, activity *agreement.Activity) {
+	i := net.(*networkImpl)
+	i.activity = activity
+}
+
 func (i *networkImpl) Start() {
 	handlers := []network.TaggedMessageHandler{
 		{Tag: protocol.AgreementVoteTag, MessageHandler: network.HandlerFunc(i.processVoteMessage)},
@@ -115,6 +122,7 @@ func (i *networkImpl) processBundleMessage(raw network.IncomingMessage) network.
 func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<- agreement.Message, msgType string) network.OutgoingMessage {
 	metadata := &messageMetadata{raw: raw}
 
+	i.activity.MessageQueued()
 	select {
 	case submit <- agreement.Message{MessageHandle: agreement.MessageHandle(metadata), Data: raw.Data}:
 		// It would be slightly better to measure at de-queue
@@ -123,6 +131,7 @@ func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<-
 		messagesHandledTotal.Inc(nil)
 		messagesHandledByType.Add(msgType, 1)
 	default:
+		i.activity.MessageDropped()
 		messagesDroppedTotal.Inc(nil)
 		messagesDroppedByType.Add(msgType, 1)
 	}
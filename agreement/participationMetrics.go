@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ParticipationMetrics reports how an account took part in the agreement through the participation
+// keys of this node, since the node started.
+//
+//msgp:ignore ParticipationMetrics
+type ParticipationMetrics struct {
+	Address basics.Address
+
+	// Proposals is the number of block proposals made by the account.
+	Proposals uint64
+	// SoftVotes, CertVotes and NextVotes are the number of votes sent by the account in the soft step,
+	// the cert step and the recovery steps (next, late, redo and down).
+	SoftVotes uint64
+	CertVotes uint64
+	NextVotes uint64
+
+	// CredentialsWon is the total weight of the credentials of the proposals and votes sent by the account.
+	CredentialsWon uint64
+	// CredentialsExpected is the total weight the credentials of the account were expected to have, given
+	// its share of the online stake, over all the steps it had a chance to propose or vote in. Over time, an
+	// account that takes part in the agreement as it should has CredentialsWon close to CredentialsExpected.
+	CredentialsExpected float64
+}
+
+// participationStats collects the ParticipationMetrics of the accounts of the pseudonode. It is a
+// metrics.Metric, reporting them under the address label.
+//
+//msgp:ignore participationStats
+type participationStats struct {
+	mu       deadlock.Mutex
+	accounts map[basics.Address]*ParticipationMetrics
+}
+
+func makeParticipationStats() *participationStats {
+	return &participationStats{accounts: make(map[basics.Address]*ParticipationMetrics)}
+}
+
+func (ps *participationStats) account(addr basics.Address) *ParticipationMetrics {
+	m, ok := ps.accounts[addr]
+	if !ok {
+		m = &ParticipationMetrics{Address: addr}
+		ps.accounts[addr] = m
+	}
+	return m
+}
+
+// recordAttempt records that the account had a chance to propose or vote, with a credential of the given expected weight.
+func (ps *participationStats) recordAttempt(addr basics.Address, expectedWeight float64) {
+	if ps == nil {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.account(addr).CredentialsExpected += expectedWeight
+}
+
+// recordVote records that the account sent a vote, or a proposal for the propose step.
+func (ps *participationStats) recordVote(addr basics.Address, s step, weight uint64) {
+	if ps == nil {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	m := ps.account(addr)
+	switch s {
+	case propose:
+		m.Proposals++
+	case soft:
+		m.SoftVotes++
+	case cert:
+		m.CertVotes++
+	default:
+		m.NextVotes++
+	}
+	m.CredentialsWon += weight
+}
+
+// snapshot returns the metrics of all the accounts, sorted by address.
+func (ps *participationStats) snapshot() []ParticipationMetrics {
+	ps.mu.Lock()
+	out := make([]ParticipationMetrics, 0, len(ps.accounts))
+	for _, m := range ps.accounts {
+		out = append(out, *m)
+	}
+	ps.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		return bytes.Compare(out[i].Address[:], out[j].Address[:]) < 0
+	})
+	return out
+}
+
+var participationMetricsFamilies = []struct {
+	name        string
+	description string
+	kind        string
+	value       func(m ParticipationMetrics) string
+}{
+	{"algod_agreement_participation_proposals_total", "number of block proposals made by the account", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatUint(m.Proposals, 10) }},
+	{"algod_agreement_participation_soft_votes_total", "number of soft votes sent by the account", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatUint(m.SoftVotes, 10) }},
+	{"algod_agreement_participation_cert_votes_total", "number of cert votes sent by the account", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatUint(m.CertVotes, 10) }},
+	{"algod_agreement_participation_next_votes_total", "number of recovery votes sent by the account", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatUint(m.NextVotes, 10) }},
+	{"algod_agreement_participation_credentials_won_total", "total weight of the credentials won by the account", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatUint(m.CredentialsWon, 10) }},
+	{"algod_agreement_participation_credentials_expected_total", "total weight of the credentials the account was expected to win given its stake", "counter",
+		func(m ParticipationMetrics) string { return strconv.FormatFloat(m.CredentialsExpected, 'f', -1, 64) }},
+}
+
+// WriteMetric implements metrics.Metric.
+func (ps *participationStats) WriteMetric(buf *strings.Builder, parentLabels string) {
+	accounts := ps.snapshot()
+	if len(accounts) == 0 {
+		return
+	}
+	for _, family := range participationMetricsFamilies {
+		buf.WriteString("# HELP ")
+		buf.WriteString(family.name)
+		buf.WriteString(" ")
+		buf.WriteString(family.description)
+		buf.WriteString("\n# TYPE ")
+		buf.WriteString(family.name)
+		buf.WriteString(" ")
+		buf.WriteString(family.kind)
+		buf.WriteString("\n")
+		for _, m := range accounts {
+			buf.WriteString(family.name)
+			buf.WriteString("{address=\"")
+			buf.WriteString(m.Address.String())
+			buf.WriteString("\"")
+			if len(parentLabels) > 0 {
+				buf.WriteString(",")
+				buf.WriteString(parentLabels)
+			}
+			buf.WriteString("} ")
+			buf.WriteString(family.value(m))
+			buf.WriteString("\n")
+		}
+	}
+}
+
+// AddMetric implements metrics.Metric. The telemetry only gets the totals over all the accounts.
+func (ps *participationStats) AddMetric(values map[string]float64) {
+	var proposals, votes, won uint64
+	var expected float64
+	for _, m := range ps.snapshot() {
+		proposals += m.Proposals
+		votes += m.SoftVotes + m.CertVotes + m.NextVotes
+		won += m.CredentialsWon
+		expected += m.CredentialsExpected
+	}
+	values["algod_agreement_participation_proposals"] = float64(proposals)
+	values["algod_agreement_participation_votes"] = float64(votes)
+	values["algod_agreement_participation_credentials_won"] = float64(won)
+	values["algod_agreement_participation_credentials_expected"] = expected
+}
+
+// expectedCredentialWeight returns the weight the credential of the account is expected to have at (r, p, s),
+// that is the committee size of the step scaled by the share of the online stake of the account.
+func expectedCredentialWeight(l LedgerReader, addr basics.Address, r basics.Round, p period, s step) (float64, error) {
+	m, err := membership(l, addr, r, p, s)
+	if err != nil {
+		return 0, err
+	}
+	proto, err := l.ConsensusParams(ParamsRound(r))
+	if err != nil {
+		return 0, err
+	}
+	if m.TotalMoney.IsZero() {
+		return 0, nil
+	}
+	return float64(s.committeeSize(proto)) * float64(m.Record.VotingStake().Raw) / float64(m.TotalMoney.Raw), nil
+}
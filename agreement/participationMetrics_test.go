@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPseudonodeParticipationMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rootSeed := sha256.Sum256([]byte(t.Name()))
+	accounts, balances := createTestAccountsAndBalances(t, 10, rootSeed[:])
+	ledger := makeTestLedger(balances)
+
+	sLogger := serviceLogger{logging.NewLogger()}
+	sLogger.SetLevel(logging.Warn)
+
+	stats := makeParticipationStats()
+	pb := makePseudonode(pseudonodeParams{
+		factory:      testBlockFactory{Owner: 0},
+		validator:    testBlockValidator{},
+		keys:         makeRecordingKeyManager(accounts),
+		ledger:       ledger,
+		voteVerifier: MakeAsyncVoteVerifier(nil),
+		log:          sLogger,
+		stats:        stats,
+	})
+	defer pb.Quit()
+
+	persist := make(chan error)
+	close(persist)
+	round := ledger.NextRound()
+	ch, err := pb.MakeVotes(context.Background(), round, 0, cert, makeProposalValue(0, accounts[0].Address()), persist)
+	require.NoError(t, err)
+	var votes, weight uint64
+	for ev := range ch {
+		votes++
+		weight += ev.(messageEvent).Input.Vote.Cred.Weight
+	}
+	require.NotZero(t, votes)
+
+	snapshot := stats.snapshot()
+	require.Len(t, snapshot, len(accounts))
+	var certVotes, won uint64
+	var expected float64
+	for _, m := range snapshot {
+		require.Zero(t, m.Proposals)
+		require.Zero(t, m.SoftVotes)
+		require.Zero(t, m.NextVotes)
+		require.Positive(t, m.CredentialsExpected)
+		certVotes += m.CertVotes
+		won += m.CredentialsWon
+		expected += m.CredentialsExpected
+	}
+	require.Equal(t, votes, certVotes)
+	require.Equal(t, weight, won)
+
+	// all the online stake is held by the accounts of the pseudonode: they are expected to fill the whole committee
+	proto, err := ledger.ConsensusParams(ParamsRound(round))
+	require.NoError(t, err)
+	require.InDelta(t, float64(proto.CertCommitteeSize), expected, 1e-6)
+
+	var buf strings.Builder
+	stats.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), "# TYPE algod_agreement_participation_cert_votes_total counter\n")
+	require.Contains(t, buf.String(), `algod_agreement_participation_proposals_total{address="`+snapshot[0].Address.String()+`"} 0`)
+
+	values := make(map[string]float64)
+	stats.AddMetric(values)
+	require.Equal(t, float64(votes), values["algod_agreement_participation_votes"])
+}
@@ -81,6 +81,8 @@ type asyncPseudonode struct {
 	quit                   chan struct{}   // a quit signal for the verifier goroutines
 	closeWg                *sync.WaitGroup // frontend waitgroup to get notified when all the verifier goroutines are done.
 	monitor                *coserviceMonitor
+	stats                  *participationStats
+	status                 *roundStatus
 	participationKeysRound basics.Round                          // the round to which the participationKeys matches
 	participationKeys      []account.ParticipationRecordForRound // the list of the participation keys for round participationKeysRound
 
@@ -133,6 +135,8 @@ type pseudonodeParams struct {
 	voteVerifier *AsyncVoteVerifier
 	log          serviceLogger
 	monitor      *coserviceMonitor
+	stats        *participationStats
+	status       *roundStatus
 }
 
 func makePseudonode(params pseudonodeParams) pseudonode {
@@ -145,6 +149,8 @@ func makePseudonode(params pseudonodeParams) pseudonode {
 		quit:      make(chan struct{}),
 		closeWg:   &sync.WaitGroup{},
 		monitor:   params.monitor,
+		stats:     params.stats,
+		status:    params.status,
 	}
 
 	pn.proposalsVerifier = pn.makePseudonodeVerifier(params.voteVerifier)
@@ -333,6 +339,26 @@ func (n asyncPseudonode) makeVotes(round basics.Round, period period, step step,
 	return votes
 }
 
+// recordAttempts records, in the participation metrics, the credential weight each of the accounts
+// was expected to get for the votes it made, whether they turn out to be selected or not, and counts
+// the attempts in the status of the round.
+func (n asyncPseudonode) recordAttempts(votes []unauthenticatedVote) {
+	for _, uv := range votes {
+		n.status.recordAttempt(uv.R)
+	}
+	if n.stats == nil {
+		return
+	}
+	for _, uv := range votes {
+		expected, err := expectedCredentialWeight(n.ledger, uv.R.Sender, uv.R.Round, uv.R.Period, uv.R.Step)
+		if err != nil {
+			n.log.Debugf("pseudonode: unable to compute the expected credential weight of %v: %v", uv.R.Sender, err)
+			continue
+		}
+		n.stats.recordAttempt(uv.R.Sender, expected)
+	}
+}
+
 func (pv *pseudonodeVerifier) close() {
 	close(pv.incomingTasks)
 }
@@ -382,6 +408,7 @@ func (t pseudonodeVotesTask) execute(verifier *AsyncVoteVerifier, quit chan stru
 
 	unverifiedVotes := t.node.makeVotes(t.round, t.period, t.step, t.prop, t.participation)
 	t.node.log.Infof("pseudonode: made %v votes", len(unverifiedVotes))
+	t.node.recordAttempts(unverifiedVotes)
 	results := make(chan asyncVerifyVoteResponse, len(unverifiedVotes))
 	orderedResults := make([]asyncVerifyVoteResponse, len(unverifiedVotes))
 	asyncVerifyingVotes := len(unverifiedVotes)
@@ -472,6 +499,8 @@ verifiedVotesLoop:
 			select {
 			case t.out <- messageEvent{T: voteVerified, Input: r.message, Err: makeSerErr(r.err)}:
 				t.node.keys.Record(r.v.R.Sender, r.v.R.Round, account.Vote)
+				t.node.stats.recordVote(r.v.R.Sender, r.v.R.Step, r.v.Cred.Weight)
+				t.node.status.recordSent(r.v.R)
 				continue verifiedVotesLoop
 			case <-quit:
 				return
@@ -497,6 +526,7 @@ func (t pseudonodeProposalsTask) execute(verifier *AsyncVoteVerifier, quit chan
 	}
 
 	payloads, votes := t.node.makeProposals(t.round, t.period, t.participation)
+	t.node.recordAttempts(votes)
 	fields := logging.Fields{
 		"Type":         logspec.ProposalAssembled.String(),
 		"ObjectRound":  t.round,
@@ -576,6 +606,8 @@ verifiedVotesLoop:
 			select {
 			case t.out <- messageEvent{T: voteVerified, Input: r.message, Err: makeSerErr(r.err)}:
 				t.node.keys.Record(r.v.R.Sender, r.v.R.Round, account.BlockProposal)
+				t.node.stats.recordVote(r.v.R.Sender, r.v.R.Step, r.v.Cred.Weight)
+				t.node.status.recordSent(r.v.R)
 				continue verifiedVotesLoop
 			case <-quit:
 				return
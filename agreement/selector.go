@@ -47,6 +47,12 @@ func (sel selector) CommitteeSize(proto config.ConsensusParams) uint64 {
 	return sel.Step.committeeSize(proto)
 }
 
+// BalanceRound returns the round whose balances determine the stake of the
+// accounts taking part in the agreement on round r.
+func BalanceRound(r basics.Round, cparams config.ConsensusParams) basics.Round {
+	return balanceRound(r, cparams)
+}
+
 func balanceRound(r basics.Round, cparams config.ConsensusParams) basics.Round {
 	return r.SubSaturate(basics.Round(2 * cparams.SeedRefreshInterval * cparams.SeedLookback))
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-algorand/util/timers"
 )
 
@@ -53,11 +54,27 @@ type Service struct {
 
 	monitor *coserviceMonitor
 
+	// participation collects the participation metrics of the accounts of the pseudonode
+	participation *participationStats
+	// status collects the progress of the agreement in the current round
+	status *roundStatus
+
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
 }
 
+var agreementRoundGauge = metrics.MakeGauge(metrics.AgreementRound)
+var agreementPeriodGauge = metrics.MakeGauge(metrics.AgreementPeriod)
+var agreementStepGauge = metrics.MakeGauge(metrics.AgreementStep)
+
+// setPlayerMetrics publishes the round, period and step the player is at.
+func setPlayerMetrics(p player) {
+	agreementRoundGauge.Set(uint64(p.Round))
+	agreementPeriodGauge.Set(uint64(p.Period))
+	agreementStepGauge.Set(uint64(p.Step))
+}
+
 // Parameters holds the parameters necessary to run the agreement protocol.
 type Parameters struct {
 	Ledger
@@ -67,6 +84,10 @@ type Parameters struct {
 	BlockFactory
 	RandomSource
 	EventsProcessingMonitor
+	// RoundTimingObserver is optional.
+	RoundTimingObserver
+	// Activity is optional. It is only set by simulations which hand every input to the service.
+	Activity *Activity
 	timers.Clock[TimeoutType]
 	db.Accessor
 	logging.Logger
@@ -102,8 +123,17 @@ func MakeService(p Parameters) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.tracer.timingObserver = p.RoundTimingObserver
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
+	s.participation = makeParticipationStats()
+	s.status = makeRoundStatus()
+
+	if p.Activity != nil {
+		// the demux is busy until it first waits for an event
+		s.monitor = &p.Activity.monitor
+		s.monitor.inc(demuxCoserviceType)
+	}
 
 	return s, nil
 }
@@ -131,6 +161,7 @@ func (s *Service) Start() {
 		processingMonitor: s.EventsProcessingMonitor,
 		log:               s.log,
 		monitor:           s.monitor,
+		activity:          s.Activity,
 	})
 	s.loopback = makePseudonode(pseudonodeParams{
 		factory:      s.BlockFactory,
@@ -140,7 +171,10 @@ func (s *Service) Start() {
 		voteVerifier: s.voteVerifier,
 		log:          s.log,
 		monitor:      s.monitor,
+		stats:        s.participation,
+		status:       s.status,
 	})
+	metrics.DefaultRegistry().Register(s.participation)
 
 	s.persistenceLoop.Start()
 	input := make(chan externalEvent)
@@ -158,6 +192,18 @@ func (s *Service) Shutdown() {
 	s.quitFn()
 	<-s.done
 	s.persistenceLoop.Quit()
+	metrics.DefaultRegistry().Deregister(s.participation)
+}
+
+// ParticipationMetrics returns the participation metrics of the accounts which took part in the agreement
+// through this node, sorted by address.
+func (s *Service) ParticipationMetrics() []ParticipationMetrics {
+	return s.participation.snapshot()
+}
+
+// Status returns the progress of the agreement in the current round.
+func (s *Service) Status() Status {
+	return s.status.snapshot()
 }
 
 // demuxLoop repeatedly executes pending actions and then requests the next event from the Service.demux.
@@ -201,6 +247,8 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 			s.log.Infof("decode (agreement): restored crash state from database (pending %v @ %+v)", a, status)
 		}
 	}
+	compact(s.log, s.Accessor)
+
 	// err will tell us if the restore/decode operations above completed successfully or not.
 	if err != nil || status.Round < s.Ledger.NextRound() {
 		// in this case, we don't have fresh and valid state
@@ -223,6 +271,8 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		s.Clock = clock
 	}
 
+	setPlayerMetrics(status)
+	s.status.setPlayer(status)
 	for {
 		output <- a
 		fastRecoveryDeadline := Deadline{Duration: status.FastRecoveryDeadline, Type: TimeoutFastRecovery}
@@ -233,6 +283,9 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		}
 
 		status, a = router.submitTop(s.tracer, status, e)
+		setPlayerMetrics(status)
+		s.status.setPlayer(status)
+		s.status.recordEvent(e)
 
 		if persistent(a) {
 			s.persistRouter = router
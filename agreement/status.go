@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// Status reports the progress of the agreement in the current round, as seen by this node.
+//
+//msgp:ignore Status
+type Status struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// ProposalsSeen is the number of distinct block proposals of the round this node received or made.
+	ProposalsSeen uint64
+	// Votes are the votes of the round this node received or made, per period and step.
+	Votes []StepVotes
+	// Accounts report how the accounts with participation keys on this node took part in the round.
+	Accounts []AccountRoundStatus
+}
+
+// StepVotes counts the votes of a step.
+//
+//msgp:ignore StepVotes
+type StepVotes struct {
+	Period uint64
+	Step   uint64
+	// Votes is the number of votes, and Weight the sum of their credential weights.
+	Votes  uint64
+	Weight uint64
+}
+
+// AccountRoundStatus reports how an account of this node took part in the round. An account whose proposal or
+// vote attempts outnumber the ones sent wasn't selected by the VRF for the others; an account which sent its
+// proposals or votes while the network doesn't reach the steps is likely to be poorly connected.
+//
+//msgp:ignore AccountRoundStatus
+type AccountRoundStatus struct {
+	Address basics.Address
+	// ProposalAttempts is the number of periods the account tried to propose a block in, and Proposals the
+	// number of proposals it was selected for and sent.
+	ProposalAttempts uint64
+	Proposals        uint64
+	// VoteAttempts is the number of steps the account tried to vote in, and Votes the number of votes it was
+	// selected for and sent.
+	VoteAttempts uint64
+	Votes        uint64
+}
+
+type stepKey struct {
+	period period
+	step   step
+}
+
+// roundStatus collects the Status of the current round. The state machine reports the player and the
+// verified votes, the pseudonode the proposals and votes of the accounts of this node.
+//
+//msgp:ignore roundStatus
+type roundStatus struct {
+	mu        deadlock.Mutex
+	round     round
+	period    period
+	step      step
+	proposals map[proposalValue]struct{}
+	votes     map[stepKey]*StepVotes
+	accounts  map[basics.Address]*AccountRoundStatus
+}
+
+func makeRoundStatus() *roundStatus {
+	return &roundStatus{
+		proposals: make(map[proposalValue]struct{}),
+		votes:     make(map[stepKey]*StepVotes),
+		accounts:  make(map[basics.Address]*AccountRoundStatus),
+	}
+}
+
+// setPlayer records the round, period and step of the player, starting over when it enters a new round.
+func (rs *roundStatus) setPlayer(p player) {
+	if rs == nil {
+		return
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if p.Round != rs.round {
+		rs.round = p.Round
+		rs.proposals = make(map[proposalValue]struct{})
+		rs.votes = make(map[stepKey]*StepVotes)
+		rs.accounts = make(map[basics.Address]*AccountRoundStatus)
+	}
+	rs.period = p.Period
+	rs.step = p.Step
+}
+
+// recordEvent records the votes of the current round the state machine gets once verified.
+func (rs *roundStatus) recordEvent(e externalEvent) {
+	if rs == nil {
+		return
+	}
+	if e.t() != voteVerified {
+		return
+	}
+	me := e.(messageEvent)
+	if me.Err != nil || me.Cancelled {
+		return
+	}
+	v := me.Input.Vote
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if v.R.Round != rs.round {
+		return
+	}
+	if v.R.Step == propose {
+		rs.proposals[v.R.Proposal] = struct{}{}
+	}
+	key := stepKey{period: v.R.Period, step: v.R.Step}
+	sv, ok := rs.votes[key]
+	if !ok {
+		sv = &StepVotes{Period: uint64(v.R.Period), Step: uint64(v.R.Step)}
+		rs.votes[key] = sv
+	}
+	sv.Votes++
+	sv.Weight += v.Cred.Weight
+}
+
+func (rs *roundStatus) account(addr basics.Address) *AccountRoundStatus {
+	a, ok := rs.accounts[addr]
+	if !ok {
+		a = &AccountRoundStatus{Address: addr}
+		rs.accounts[addr] = a
+	}
+	return a
+}
+
+// recordAttempt records that an account of this node tried to propose or vote.
+func (rs *roundStatus) recordAttempt(r rawVote) {
+	if rs == nil {
+		return
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if r.Round != rs.round {
+		return
+	}
+	if r.Step == propose {
+		rs.account(r.Sender).ProposalAttempts++
+	} else {
+		rs.account(r.Sender).VoteAttempts++
+	}
+}
+
+// recordSent records that an account of this node was selected and sent its proposal or vote.
+func (rs *roundStatus) recordSent(r rawVote) {
+	if rs == nil {
+		return
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if r.Round != rs.round {
+		return
+	}
+	if r.Step == propose {
+		rs.account(r.Sender).Proposals++
+	} else {
+		rs.account(r.Sender).Votes++
+	}
+}
+
+// snapshot returns the status of the current round, the votes sorted by period and step and the accounts by address.
+func (rs *roundStatus) snapshot() Status {
+	rs.mu.Lock()
+	status := Status{
+		Round:         rs.round,
+		Period:        uint64(rs.period),
+		Step:          uint64(rs.step),
+		ProposalsSeen: uint64(len(rs.proposals)),
+		Votes:         make([]StepVotes, 0, len(rs.votes)),
+		Accounts:      make([]AccountRoundStatus, 0, len(rs.accounts)),
+	}
+	for _, sv := range rs.votes {
+		status.Votes = append(status.Votes, *sv)
+	}
+	for _, a := range rs.accounts {
+		status.Accounts = append(status.Accounts, *a)
+	}
+	rs.mu.Unlock()
+
+	sort.Slice(status.Votes, func(i, j int) bool {
+		if status.Votes[i].Period != status.Votes[j].Period {
+			return status.Votes[i].Period < status.Votes[j].Period
+		}
+		return status.Votes[i].Step < status.Votes[j].Step
+	})
+	sort.Slice(status.Accounts, func(i, j int) bool {
+		return bytes.Compare(status.Accounts[i].Address[:], status.Accounts[j].Address[:]) < 0
+	})
+	return status
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRoundStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rootSeed := sha256.Sum256([]byte(t.Name()))
+	accounts, balances := createTestAccountsAndBalances(t, 10, rootSeed[:])
+	ledger := makeTestLedger(balances)
+
+	sLogger := serviceLogger{logging.NewLogger()}
+	sLogger.SetLevel(logging.Warn)
+
+	status := makeRoundStatus()
+	pb := makePseudonode(pseudonodeParams{
+		factory:      testBlockFactory{Owner: 0},
+		validator:    testBlockValidator{},
+		keys:         makeRecordingKeyManager(accounts),
+		ledger:       ledger,
+		voteVerifier: MakeAsyncVoteVerifier(nil),
+		log:          sLogger,
+		status:       status,
+	})
+	defer pb.Quit()
+
+	round := ledger.NextRound()
+	status.setPlayer(player{Round: round, Period: 1, Step: cert})
+
+	persist := make(chan error)
+	close(persist)
+	ch, err := pb.MakeVotes(context.Background(), round, 1, cert, makeProposalValue(0, accounts[0].Address()), persist)
+	require.NoError(t, err)
+	var votes, weight uint64
+	for ev := range ch {
+		status.recordEvent(ev.(messageEvent))
+		votes++
+		weight += ev.(messageEvent).Input.Vote.Cred.Weight
+	}
+	require.NotZero(t, votes)
+
+	// events which failed verification or of other rounds are not counted
+	status.recordEvent(messageEvent{T: voteVerified, Err: makeSerErrStr("invalid vote")})
+	status.recordEvent(messageEvent{T: voteVerified, Input: message{Vote: vote{R: rawVote{Round: round + 1, Step: cert}}}})
+
+	snapshot := status.snapshot()
+	require.Equal(t, round, snapshot.Round)
+	require.Equal(t, uint64(1), snapshot.Period)
+	require.Equal(t, uint64(cert), snapshot.Step)
+	require.Zero(t, snapshot.ProposalsSeen)
+	require.Equal(t, []StepVotes{{Period: 1, Step: uint64(cert), Votes: votes, Weight: weight}}, snapshot.Votes)
+	require.Len(t, snapshot.Accounts, len(accounts))
+	var sent uint64
+	for i, a := range snapshot.Accounts {
+		if i > 0 {
+			require.Negative(t, bytes.Compare(snapshot.Accounts[i-1].Address[:], a.Address[:]))
+		}
+		require.Zero(t, a.ProposalAttempts)
+		require.Zero(t, a.Proposals)
+		require.Equal(t, uint64(1), a.VoteAttempts)
+		sent += a.Votes
+	}
+	require.Equal(t, votes, sent)
+
+	// the status starts over in the next round
+	status.setPlayer(player{Round: round + 1})
+	snapshot = status.snapshot()
+	require.Equal(t, round+1, snapshot.Round)
+	require.Empty(t, snapshot.Votes)
+	require.Empty(t, snapshot.Accounts)
+}
@@ -70,6 +70,8 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+	// timingObserver, if set, is given the same timing data, whether or not it is telemetrized
+	timingObserver RoundTimingObserver
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -111,19 +113,24 @@ func (t *tracer) resetTimingWithPipeline(target round) {
 	t.tRPlus1 = nil
 }
 
+// timingEnabled returns whether the timing of the rounds is gathered.
+func (t *tracer) timingEnabled() bool {
+	return t.timingReports || t.timingObserver != nil
+}
+
 // tR and tRPlus1 may be accessed before timing is "initialized" (e.g.
 // on crash recovery). Instead of trying to initialize timing info every time (even
 // when unrecoverable) just make a new timinginfogen when not already set.
 func (t *tracer) timeR() *timingInfoGenerator {
 	if t.tR == nil {
-		t.tR = makeTimingInfoGen(t.timingReports, t.log)
+		t.tR = makeTimingInfoGen(t.timingEnabled(), t.log)
 	}
 	return t.tR
 }
 
 func (t *tracer) timeRPlus1() *timingInfoGenerator {
 	if t.tRPlus1 == nil {
-		t.tRPlus1 = makeTimingInfoGen(t.timingReports, t.log)
+		t.tRPlus1 = makeTimingInfoGen(t.timingEnabled(), t.log)
 	}
 	return t.tRPlus1
 }
@@ -247,10 +254,15 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 
 func (t *tracer) logRoundStart(p player, target round) {
 	// Log timing telemetry.
-	if t.tR != nil && t.timingReports {
+	if t.tR != nil && t.timingEnabled() {
 		timeInfo := t.tR.Build(p.Step)
-		// Generate a distinct event than blockAccepted for convenience (this one is generated by player, other by service)
-		t.log.Metrics(telemetryspec.Agreement, timeInfo, nil)
+		if t.timingReports {
+			// Generate a distinct event than blockAccepted for convenience (this one is generated by player, other by service)
+			t.log.Metrics(telemetryspec.Agreement, timeInfo, nil)
+		}
+		if t.timingObserver != nil {
+			t.timingObserver.ObserveRoundTiming(timeInfo)
+		}
 	}
 
 }
@@ -19,6 +19,7 @@ package agreement
 import (
 	"fmt"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
@@ -90,55 +91,101 @@ type (
 
 // verify verifies that a vote that was received from the network is valid.
 func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
+	m, proto, err := uv.verifySignature(l)
+	if err != nil {
+		return vote{}, err
+	}
+	cred, err := uv.Cred.Verify(proto, m)
+	return uv.authenticate(cred, err)
+}
+
+// verifyVotes verifies a batch of votes that were received from the network.
+// It is equivalent to calling verify on each of them, but the VRF proofs of
+// their credentials are checked together.
+func verifyVotes(l LedgerReader, uvs []unauthenticatedVote) ([]vote, []error) {
+	votes := make([]vote, len(uvs))
+	errs := make([]error, len(uvs))
+
+	protos := make([]config.ConsensusParams, 0, len(uvs))
+	creds := make([]committee.UnauthenticatedCredential, 0, len(uvs))
+	ms := make([]committee.Membership, 0, len(uvs))
+	signed := make([]int, 0, len(uvs))
+	for i, uv := range uvs {
+		m, proto, err := uv.verifySignature(l)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		protos = append(protos, proto)
+		creds = append(creds, uv.Cred)
+		ms = append(ms, m)
+		signed = append(signed, i)
+	}
+
+	verified, credErrs := committee.BatchVerify(protos, creds, ms)
+	for j, i := range signed {
+		votes[i], errs[i] = uvs[i].authenticate(verified[j], credErrs[j])
+	}
+	return votes, errs
+}
+
+// verifySignature checks everything about the vote but its credential, and
+// returns the membership parameters and consensus parameters the credential
+// should be verified against.
+func (uv unauthenticatedVote) verifySignature(l LedgerReader) (committee.Membership, config.ConsensusParams, error) {
 	rv := uv.R
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
+		return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
 	}
 
 	switch rv.Step {
 	case propose:
 		if rv.Period == rv.Proposal.OriginalPeriod && rv.Sender != rv.Proposal.OriginalProposer {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
+			return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
 		}
 		// The following check could apply to all steps, but it's sufficient to only check in the propose step.
 		if rv.Proposal.OriginalPeriod > rv.Period {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
+			return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
 		}
 		fallthrough
 	case soft:
 		fallthrough
 	case cert:
 		if rv.Proposal == bottom {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
+			return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
 		}
 	}
 
 	proto, err := l.ConsensusParams(ParamsRound(rv.Round))
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
+		return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
 	}
 
 	if rv.Round < m.Record.VoteFirstValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
+		return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
 	}
 
 	if m.Record.VoteLastValid != 0 && rv.Round > m.Record.VoteLastValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
+		return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
 	}
 
 	ephID := basics.OneTimeIDForRound(rv.Round, m.Record.KeyDilution(proto))
 	voteID := m.Record.VoteID
 	if !voteID.Verify(ephID, rv, uv.Sig) {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", rv.Sender, voteID, uv)
+		return committee.Membership{}, config.ConsensusParams{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", rv.Sender, voteID, uv)
 	}
 
-	cred, err := uv.Cred.Verify(proto, m)
+	return m, proto, nil
+}
+
+// authenticate builds the vote from the result of the verification of its credential.
+func (uv unauthenticatedVote) authenticate(cred committee.Credential, err error) (vote, error) {
 	if err != nil {
 		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
 	}
 
-	return vote{R: rv, Cred: cred, Sig: uv.Sig}, nil
+	return vote{R: uv.R, Cred: cred, Sig: uv.Sig}, nil
 }
 
 // makeVote creates a new unauthenticated vote from its constituent components.
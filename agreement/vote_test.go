@@ -117,6 +117,49 @@ func TestVoteValidation(t *testing.T) {
 	require.True(t, processedVote, "No votes were processed")
 }
 
+func TestVerifyVotesMatchesVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	var uvs []unauthenticatedVote
+	for i, address := range addresses {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		rv := rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+
+		switch i % 4 {
+		case 1:
+			uv.Sig = crypto.OneTimeSignature{}
+		case 2:
+			uv.Cred.Proof[70] ^= 1
+		case 3:
+			uv.R.Round++
+		}
+		uvs = append(uvs, uv)
+	}
+
+	votes, errs := verifyVotes(ledger, uvs)
+	require.Len(t, votes, len(uvs))
+	require.Len(t, errs, len(uvs))
+	verified := 0
+	for i, uv := range uvs {
+		v, err := uv.verify(ledger)
+		require.Equal(t, v, votes[i])
+		require.Equal(t, err, errs[i])
+		if i%4 != 0 {
+			require.Error(t, errs[i])
+		}
+		if errs[i] == nil {
+			verified++
+		}
+	}
+	require.NotZero(t, verified)
+}
+
 func TestVoteReproposalValidation(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
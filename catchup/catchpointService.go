@@ -32,6 +32,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/stateproof"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 const (
@@ -62,6 +63,56 @@ type CatchpointCatchupStats struct {
 	TotalAccountHashes uint64
 	TotalKVHashes      uint64
 	StartTime          time.Time
+	// Stage is the current stage of the catchup, and StageStartTime the time it started at.
+	Stage          ledger.CatchpointCatchupState
+	StageStartTime time.Time
+}
+
+// stageProgress returns the completed fraction of the current stage, if it can be measured.
+func (s CatchpointCatchupStats) stageProgress() (float64, bool) {
+	switch s.Stage {
+	case ledger.CatchpointCatchupStateLedgerDownload:
+		// the accounts and KVs are downloaded first, and verified once they all are.
+		total := 2 * (s.TotalAccounts + s.TotalKVs)
+		if total == 0 {
+			return 0, false
+		}
+		return float64(s.ProcessedAccounts+s.ProcessedKVs+s.VerifiedAccounts+s.VerifiedKVs) / float64(total), true
+	case ledger.CatchpointCatchupStateBlocksDownload:
+		total := 2 * s.TotalBlocks
+		if total == 0 {
+			return 0, false
+		}
+		return float64(s.AcquiredBlocks+s.VerifiedBlocks) / float64(total), true
+	default:
+		return 0, false
+	}
+}
+
+var catchpointCatchupStageGauge = metrics.MakeGauge(metrics.CatchpointCatchupStage)
+var catchpointCatchupStageProgressGauge = metrics.MakeGauge(metrics.CatchpointCatchupStageProgress)
+
+// publishMetrics sets the catchpoint catchup gauges from the statistics.
+func (s CatchpointCatchupStats) publishMetrics() {
+	catchpointCatchupStageGauge.Set(uint64(s.Stage))
+	progress, _ := s.stageProgress()
+	catchpointCatchupStageProgressGauge.Set(uint64(progress * 100))
+}
+
+// EstimatedStageCompletion extrapolates the time the current stage will complete at from the
+// progress it made since it started. It returns false if the progress of the stage cannot be
+// measured, which is the case of the short stages downloading the latest block and switching
+// to the new ledger, or if the stage made no progress yet.
+func (s CatchpointCatchupStats) EstimatedStageCompletion(now time.Time) (time.Time, bool) {
+	progress, ok := s.stageProgress()
+	if !ok || progress <= 0 || s.StageStartTime.IsZero() {
+		return time.Time{}, false
+	}
+	if progress >= 1 {
+		return now, true
+	}
+	elapsed := now.Sub(s.StageStartTime)
+	return now.Add(time.Duration(float64(elapsed) * (1 - progress) / progress)), true
 }
 
 // CatchpointCatchupService represents the catchpoint catchup service.
@@ -253,6 +304,11 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	if err != nil {
 		return err
 	}
+	cs.statsMu.Lock()
+	cs.stats.Stage = cs.stage
+	cs.stats.StageStartTime = time.Now()
+	cs.stats.publishMetrics()
+	cs.statsMu.Unlock()
 	return nil
 }
 
@@ -359,6 +415,7 @@ func (cs *CatchpointCatchupService) updateVerifiedCounts(accountCount, kvCount u
 	if cs.stats.TotalKVs > 0 {
 		cs.stats.VerifiedKVs = kvCount
 	}
+	cs.stats.publishMetrics()
 }
 
 // processStageLatestBlockDownload is the third catchpoint catchup stage. It downloads the latest block and verify that against the previously downloaded ledger.
@@ -537,6 +594,7 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 	cs.stats.TotalBlocks = uint64(lookback)
 	cs.stats.AcquiredBlocks = 0
 	cs.stats.VerifiedBlocks = 0
+	cs.stats.publishMetrics()
 	cs.statsMu.Unlock()
 
 	prevBlock := &topBlock
@@ -740,6 +798,11 @@ func (cs *CatchpointCatchupService) updateStage(newStage ledger.CatchpointCatchu
 		return err
 	}
 	cs.stage = newStage
+	cs.statsMu.Lock()
+	cs.stats.Stage = newStage
+	cs.stats.StageStartTime = time.Now()
+	cs.stats.publishMetrics()
+	cs.statsMu.Unlock()
 	return nil
 }
 
@@ -777,6 +840,7 @@ func (cs *CatchpointCatchupService) updateLedgerFetcherProgress(fetcherStats *le
 	cs.stats.ProcessedKVs = fetcherStats.ProcessedKVs
 	cs.stats.ProcessedBytes = fetcherStats.ProcessedBytes
 	cs.stats.TotalAccountHashes = fetcherStats.TotalAccountHashes
+	cs.stats.publishMetrics()
 }
 
 // GetStatistics returns a copy of the current catchpoint catchup statistics
@@ -793,6 +857,7 @@ func (cs *CatchpointCatchupService) updateBlockRetrievalStatistics(acquiredBlock
 	defer cs.statsMu.Unlock()
 	cs.stats.AcquiredBlocks = uint64(int64(cs.stats.AcquiredBlocks) + acquiredBlocksDelta)
 	cs.stats.VerifiedBlocks = uint64(int64(cs.stats.VerifiedBlocks) + verifiedBlocksDelta)
+	cs.stats.publishMetrics()
 }
 
 func (cs *CatchpointCatchupService) initDownloadPeerSelector() {
@@ -19,6 +19,7 @@ package catchup
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -95,3 +96,39 @@ func TestCatchpointServicePeerRank(t *testing.T) {
 	err := cs.processStageLatestBlockDownload()
 	require.NoError(t, err)
 }
+
+func TestCatchpointStatsEstimatedStageCompletion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	stats := CatchpointCatchupStats{
+		Stage:          ledger.CatchpointCatchupStateLedgerDownload,
+		StageStartTime: now.Add(-time.Minute),
+		TotalAccounts:  100,
+		TotalKVs:       100,
+	}
+	// no progress yet
+	_, ok := stats.EstimatedStageCompletion(now)
+	require.False(t, ok)
+
+	// all the accounts and KVs were processed but none verified: halfway there
+	stats.ProcessedAccounts = 100
+	stats.ProcessedKVs = 100
+	completion, ok := stats.EstimatedStageCompletion(now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(time.Minute), completion)
+
+	stats.Stage = ledger.CatchpointCatchupStateBlocksDownload
+	stats.TotalBlocks = 10
+	stats.AcquiredBlocks = 10
+	stats.VerifiedBlocks = 5
+	completion, ok = stats.EstimatedStageCompletion(now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(20*time.Second), completion)
+
+	// the progress of the switch stage cannot be measured
+	stats.Stage = ledger.CatchpointCatchupStateSwitch
+	_, ok = stats.EstimatedStageCompletion(now)
+	require.False(t, ok)
+}
@@ -88,31 +88,46 @@ func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPee
 	}
 
 	network.SetUserAgentHeader(request.Header)
+	if method == http.MethodHead {
+		// the transport only asks for, and transparently decompresses, gzip responses on GET requests;
+		// asking for it on HEAD lets the peer report the size of the compressed download.
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
 	return peer.GetHTTPClient().Do(request)
 }
 
 func (lf *ledgerFetcher) headLedger(ctx context.Context, peer network.Peer, round basics.Round) error {
+	_, err := lf.ledgerSize(ctx, peer, round)
+	return err
+}
+
+// ledgerSize checks that the peer has the catchpoint file for round, and returns the size of its download as
+// reported by the peer, or zero if the peer does not report it.
+func (lf *ledgerFetcher) ledgerSize(ctx context.Context, peer network.Peer, round basics.Round) (uint64, error) {
 	httpPeer, ok := peer.(network.HTTPPeer)
 	if !ok {
-		return errNonHTTPPeer
+		return 0, errNonHTTPPeer
 	}
 	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
 	defer timeoutContextCancel()
 	response, err := lf.requestLedger(timeoutContext, httpPeer, round, http.MethodHead)
 	if err != nil {
 		lf.log.Debugf("getPeerLedger HEAD : %s", err)
-		return err
+		return 0, err
 	}
 	defer func() { _ = response.Body.Close() }()
 
 	// check to see that we had no errors.
 	switch response.StatusCode {
 	case http.StatusOK:
-		return nil
+		if response.ContentLength > 0 {
+			return uint64(response.ContentLength), nil
+		}
+		return 0, nil
 	case http.StatusNotFound: // server could not find a block with that round number.
-		return errNoLedgerForRound
+		return 0, errNoLedgerForRound
 	default:
-		return fmt.Errorf("headLedger error response status code %d", response.StatusCode)
+		return 0, fmt.Errorf("headLedger error response status code %d", response.StatusCode)
 	}
 }
 
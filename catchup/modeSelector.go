@@ -0,0 +1,393 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/httpclient"
+)
+
+const (
+	// catchupModeCheckInterval is how often the catchup mode selector measures the block catchup rate and
+	// reconsiders the catchup mode.
+	catchupModeCheckInterval = time.Minute
+	// catchpointLabelFetchTimeout bounds the request for the latest catchpoint label.
+	catchpointLabelFetchTimeout = 10 * time.Second
+	// maxCatchpointLabelLength bounds the response read from CatchpointLabelURL.
+	maxCatchpointLabelLength = 256
+	// catchpointSizeAttempts is the number of relays asked for the size of the catchpoint file.
+	catchpointSizeAttempts = 3
+	// catchpointSpeedupFactor is how much faster than block catchup the catchpoint catchup needs to be expected to
+	// be before the node switches to it. The margin keeps the estimates' errors from triggering needless switches.
+	catchpointSpeedupFactor = 2
+	// catchpointDiskAmplification is the number of bytes written to the disk, when the accounts are stored and
+	// hashed, for every byte of the catchpoint file.
+	catchpointDiskAmplification = 4
+	// blockSizeSamples is the number of the latest blocks which sizes are averaged to estimate the block size.
+	blockSizeSamples = 16
+	// diskProbeBytes is the amount of data written to measure the speed of the disk.
+	diskProbeBytes      = 16 << 20
+	diskProbeChunkBytes = 1 << 20
+)
+
+// CatchupModeNodeServices defines the node support needed by the catchup mode selector to switch the node to
+// catchpoint catchup.
+type CatchupModeNodeServices interface {
+	StartCatchup(catchpoint string) error
+}
+
+// catchupModeLedger is the subset of the ledger the catchup mode selector measures.
+type catchupModeLedger interface {
+	Latest() basics.Round
+	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
+	GetCatchpointCatchupState(ctx context.Context) (ledger.CatchpointCatchupState, error)
+}
+
+// catchupEstimate holds the measurements the catchup mode is selected from.
+type catchupEstimate struct {
+	// lag is the number of rounds between the latest block of the node and the catchpoint.
+	lag basics.Round
+	// lookback is the number of blocks catchpoint catchup fetches in addition to the catchpoint file.
+	lookback uint64
+	// blocksPerSecond is the rate at which block catchup has been advancing the ledger.
+	blocksPerSecond float64
+	// blockBytes is the average size of a block and its certificate.
+	blockBytes uint64
+	// catchpointBytes is the size of the catchpoint file download.
+	catchpointBytes uint64
+	// diskBytesPerSecond is the speed at which the local disk writes synchronously.
+	diskBytesPerSecond float64
+	// minDownloadBytesPerSecond is the slowest acceptable catchpoint file download.
+	minDownloadBytesPerSecond float64
+}
+
+// blocksDuration is the expected time block catchup would take to cover the lag.
+func (e catchupEstimate) blocksDuration() time.Duration {
+	return secondsToDuration(float64(e.lag) / e.blocksPerSecond)
+}
+
+// catchpointDuration is the expected time catchpoint catchup would take: downloading and storing the catchpoint
+// file, then fetching the lookback blocks. The download is assumed to proceed at least as fast as block catchup
+// has been receiving blocks.
+func (e catchupEstimate) catchpointDuration() time.Duration {
+	download := e.blocksPerSecond * float64(e.blockBytes)
+	if download < e.minDownloadBytesPerSecond {
+		download = e.minDownloadBytesPerSecond
+	}
+	seconds := float64(e.catchpointBytes) / download
+	seconds += float64(e.catchpointBytes) * catchpointDiskAmplification / e.diskBytesPerSecond
+	seconds += float64(e.lookback) / e.blocksPerSecond
+	return secondsToDuration(seconds)
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// selectCatchpointCatchup returns true if the node should switch to catchpoint catchup. A catchpoint that does
+// not take the node past the blocks that catchpoint catchup would fetch anyway is never worth it.
+func selectCatchpointCatchup(mode string, e catchupEstimate) bool {
+	if uint64(e.lag) <= e.lookback {
+		return false
+	}
+	if mode == config.CatchupModeCatchpoint {
+		return true
+	}
+	if e.blocksPerSecond <= 0 || e.catchpointBytes == 0 || e.diskBytesPerSecond <= 0 {
+		// not measured yet
+		return false
+	}
+	return e.catchpointDuration()*catchpointSpeedupFactor < e.blocksDuration()
+}
+
+// catchpointLookback is the number of blocks catchpoint catchup fetches below the catchpoint round under the
+// current consensus protocol, as processStageBlocksDownload does, leaving out the state proof support.
+func catchpointLookback() uint64 {
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	lookback := proto.MaxTxnLife + proto.DeeperBlockHeaderHistory + proto.CatchpointLookback
+	if lookback < proto.MaxBalLookback {
+		lookback = proto.MaxBalLookback
+	}
+	return lookback
+}
+
+// CatchupModeSelector switches a node which fell far behind the network from block catchup to catchpoint
+// catchup, as selected by CatchupMode. It polls CatchpointLabelURL for the latest catchpoint of the network.
+type CatchupModeSelector struct {
+	cfg    config.Local
+	node   CatchupModeNodeServices
+	ledger catchupModeLedger
+	net    network.GossipNode
+	log    logging.Logger
+	dir    string
+	client *http.Client
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// the fields below are only accessed by the selector's goroutine.
+	lastRound          basics.Round
+	lastCheck          time.Time
+	diskBytesPerSecond float64
+	attemptedLabel     string
+}
+
+// MakeCatchupModeSelector creates a catchup mode selector for the node. It returns nil when catchpoint catchup
+// could never be selected: on archival nodes, when CatchupMode is "blocks" or when CatchpointLabelURL is empty.
+// The speed of the disk is measured in dir, which should be where the ledger's trackers are stored.
+func MakeCatchupModeSelector(cfg config.Local, node CatchupModeNodeServices, l catchupModeLedger, net network.GossipNode, log logging.Logger, dir string) (*CatchupModeSelector, error) {
+	switch cfg.CatchupMode {
+	case config.CatchupModeAuto, config.CatchupModeCatchpoint:
+	case config.CatchupModeBlocks:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown CatchupMode '%s'", cfg.CatchupMode)
+	}
+	if cfg.Archival || cfg.CatchpointLabelURL == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(cfg.CatchpointLabelURL, "http://") && !strings.HasPrefix(cfg.CatchpointLabelURL, "https://") {
+		return nil, fmt.Errorf("CatchpointLabelURL '%s' is not an http(s) URL", cfg.CatchpointLabelURL)
+	}
+
+	return &CatchupModeSelector{
+		cfg:    cfg,
+		node:   node,
+		ledger: l,
+		net:    net,
+		log:    log,
+		dir:    dir,
+		client: &http.Client{
+			Timeout:   catchpointLabelFetchTimeout,
+			Transport: httpclient.MakeTransport(http.DefaultTransport, httpclient.PolicyFromConfig(cfg)),
+		},
+	}, nil
+}
+
+// Start starts polling for the catchup mode.
+func (s *CatchupModeSelector) Start() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Stop stops polling and waits for an ongoing check to complete. The node's lock must not be held, since the
+// check may be starting the catchpoint catchup.
+func (s *CatchupModeSelector) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *CatchupModeSelector) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(catchupModeCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(s.ctx, time.Now())
+		}
+	}
+}
+
+// check measures the block catchup rate since the previous check, and starts catchpoint catchup to the latest
+// catchpoint if it is selected.
+func (s *CatchupModeSelector) check(ctx context.Context, now time.Time) {
+	state, err := s.ledger.GetCatchpointCatchupState(ctx)
+	if err != nil || state != ledger.CatchpointCatchupStateInactive {
+		// the rate measured across a catchpoint catchup is meaningless.
+		s.lastCheck = time.Time{}
+		return
+	}
+
+	latest := s.ledger.Latest()
+	var blocksPerSecond float64
+	if !s.lastCheck.IsZero() && now.After(s.lastCheck) {
+		blocksPerSecond = float64(latest.SubSaturate(s.lastRound)) / now.Sub(s.lastCheck).Seconds()
+	}
+	s.lastRound, s.lastCheck = latest, now
+
+	label, err := s.fetchLabel(ctx)
+	if err != nil {
+		s.log.Debugf("catchup mode: %v", err)
+		return
+	}
+	if label == s.attemptedLabel {
+		return
+	}
+	round, _, err := ledgercore.ParseCatchpointLabel(label)
+	if err != nil {
+		s.log.Warnf("catchup mode: invalid catchpoint label '%s' from %s: %v", label, s.cfg.CatchpointLabelURL, err)
+		return
+	}
+
+	e := catchupEstimate{
+		lag:                       round.SubSaturate(latest),
+		lookback:                  catchpointLookback(),
+		blocksPerSecond:           blocksPerSecond,
+		minDownloadBytesPerSecond: float64(s.cfg.MinCatchpointFileDownloadBytesPerSecond),
+	}
+	if uint64(e.lag) <= e.lookback {
+		return
+	}
+	if s.cfg.CatchupMode == config.CatchupModeAuto && blocksPerSecond > 0 {
+		e.blockBytes = s.averageBlockBytes(latest)
+		e.catchpointBytes, err = s.catchpointSize(ctx, round)
+		if err != nil {
+			s.log.Debugf("catchup mode: %v", err)
+			return
+		}
+		e.diskBytesPerSecond, err = s.diskSpeed()
+		if err != nil {
+			s.log.Warnf("catchup mode: unable to measure the disk speed: %v", err)
+			return
+		}
+	}
+	if !selectCatchpointCatchup(s.cfg.CatchupMode, e) {
+		return
+	}
+
+	s.attemptedLabel = label
+	if s.cfg.CatchupMode == config.CatchupModeAuto {
+		s.log.Infof("catchup mode: %d rounds behind catchpoint %s, expecting catchpoint catchup to take %v instead of %v by blocks",
+			e.lag, label, e.catchpointDuration().Round(time.Second), e.blocksDuration().Round(time.Second))
+	} else {
+		s.log.Infof("catchup mode: %d rounds behind catchpoint %s", e.lag, label)
+	}
+	err = s.node.StartCatchup(label)
+	if err != nil {
+		s.log.Warnf("catchup mode: unable to start catching up to catchpoint %s: %v", label, err)
+	}
+}
+
+// fetchLabel returns the latest catchpoint label published at CatchpointLabelURL.
+func (s *CatchupModeSelector) fetchLabel(ctx context.Context) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.CatchpointLabelURL, nil)
+	if err != nil {
+		return "", err
+	}
+	network.SetUserAgentHeader(request.Header)
+	response, err := s.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("catchpoint label request to %s returned %s", s.cfg.CatchpointLabelURL, response.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxCatchpointLabelLength))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// averageBlockBytes returns the average size of the latest blocks and their certificates.
+func (s *CatchupModeSelector) averageBlockBytes(latest basics.Round) uint64 {
+	var total, samples uint64
+	for rnd := latest; rnd > 0 && samples < blockSizeSamples; rnd-- {
+		blk, cert, err := s.ledger.EncodedBlockCert(rnd)
+		if err != nil {
+			break
+		}
+		total += uint64(len(blk) + len(cert))
+		samples++
+	}
+	if samples == 0 {
+		return 0
+	}
+	return total / samples
+}
+
+// catchpointSize asks relays for the size of the catchpoint file of round.
+func (s *CatchupModeSelector) catchpointSize(ctx context.Context, round basics.Round) (size uint64, err error) {
+	peerSelector := makePeerSelector(s.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+	fetcher := makeLedgerFetcher(s.net, nil, s.log, nil, s.cfg)
+	err = errNoLedgerForRound
+	for i := 0; i < catchpointSizeAttempts; i++ {
+		psp, peerErr := peerSelector.getNextPeer()
+		if peerErr != nil {
+			break
+		}
+		size, err = fetcher.ledgerSize(ctx, psp.Peer, round)
+		if err == nil && size > 0 {
+			return size, nil
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf("relays did not report the size of the catchpoint file for round %d", round)
+	}
+	return 0, fmt.Errorf("catchpoint file for round %d unavailable: %w", round, err)
+}
+
+// diskSpeed measures, once, how fast the disk holding the ledger writes synchronously.
+func (s *CatchupModeSelector) diskSpeed() (float64, error) {
+	if s.diskBytesPerSecond > 0 {
+		return s.diskBytesPerSecond, nil
+	}
+
+	f, err := os.CreateTemp(s.dir, "catchup-disk-probe-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// random data keeps compressing file systems from making the disk look faster than it is.
+	chunk := make([]byte, diskProbeChunkBytes)
+	crypto.RandBytes(chunk)
+	start := time.Now()
+	for written := 0; written < diskProbeBytes; written += len(chunk) {
+		_, err = f.Write(chunk)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = f.Sync()
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+
+	s.diskBytesPerSecond = diskProbeBytes / elapsed.Seconds()
+	return s.diskBytesPerSecond, nil
+}
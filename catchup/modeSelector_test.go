@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSelectCatchpointCatchup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// 1M rounds behind at 100 blocks/s is ~2.8 hours of block catchup, while a 1GB catchpoint
+	// downloads at 500KB/s (100 blocks/s of 5KB) in ~35 minutes.
+	e := catchupEstimate{
+		lag:                       1_000_000,
+		lookback:                  1320,
+		blocksPerSecond:           100,
+		blockBytes:                5_000,
+		catchpointBytes:           1_000_000_000,
+		diskBytesPerSecond:        200_000_000,
+		minDownloadBytesPerSecond: 20 * 1024,
+	}
+	require.Equal(t, 10000*time.Second, e.blocksDuration())
+	require.InDelta(t, float64(2000+20+13.2), e.catchpointDuration().Seconds(), 0.01)
+	require.True(t, selectCatchpointCatchup(config.CatchupModeAuto, e))
+	require.True(t, selectCatchpointCatchup(config.CatchupModeCatchpoint, e))
+
+	// a shorter block catchup is not worth leaving
+	fast := e
+	fast.blocksPerSecond = 1000
+	fast.lag = 100_000
+	require.False(t, selectCatchpointCatchup(config.CatchupModeAuto, fast))
+	require.True(t, selectCatchpointCatchup(config.CatchupModeCatchpoint, fast))
+
+	// neither is a slow disk
+	slowDisk := e
+	slowDisk.diskBytesPerSecond = 1_000_000
+	require.False(t, selectCatchpointCatchup(config.CatchupModeAuto, slowDisk))
+
+	// nor a catchpoint within the lookback
+	near := e
+	near.lag = basics.Round(near.lookback)
+	require.False(t, selectCatchpointCatchup(config.CatchupModeAuto, near))
+	require.False(t, selectCatchpointCatchup(config.CatchupModeCatchpoint, near))
+
+	// nothing is selected before the measurements are in
+	unmeasured := e
+	unmeasured.blocksPerSecond = 0
+	require.False(t, selectCatchpointCatchup(config.CatchupModeAuto, unmeasured))
+	unmeasured = e
+	unmeasured.catchpointBytes = 0
+	require.False(t, selectCatchpointCatchup(config.CatchupModeAuto, unmeasured))
+}
+
+type catchupModeTestLedger struct {
+	latest basics.Round
+	state  ledger.CatchpointCatchupState
+}
+
+func (l *catchupModeTestLedger) Latest() basics.Round {
+	return l.latest
+}
+
+func (l *catchupModeTestLedger) EncodedBlockCert(rnd basics.Round) ([]byte, []byte, error) {
+	if rnd > l.latest {
+		return nil, nil, fmt.Errorf("no block %d", rnd)
+	}
+	return make([]byte, 4000), make([]byte, 1000), nil
+}
+
+func (l *catchupModeTestLedger) GetCatchpointCatchupState(ctx context.Context) (ledger.CatchpointCatchupState, error) {
+	return l.state, nil
+}
+
+type catchupModeTestNode struct {
+	started []string
+}
+
+func (n *catchupModeTestNode) StartCatchup(catchpoint string) error {
+	n.started = append(n.started, catchpoint)
+	return nil
+}
+
+// catchupModeTestNetwork serves a single relay
+type catchupModeTestNetwork struct {
+	mocks.MockNetwork
+	relay testHTTPPeer
+}
+
+func (n *catchupModeTestNetwork) GetPeers(options ...network.PeerOption) []network.Peer {
+	return []network.Peer{&n.relay}
+}
+
+func TestCatchupModeSelector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var label string
+	labelServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, label)
+	}))
+	defer labelServer.Close()
+	var catchpointHeads int
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		require.Contains(t, r.Header.Get("Accept-Encoding"), "gzip")
+		catchpointHeads++
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Length", "1000000000")
+	}))
+	defer relay.Close()
+
+	makeLabel := func(rnd basics.Round) string {
+		var digest crypto.Digest
+		return ledgercore.MakeLabel(ledgercore.MakeCatchpointLabelMakerCurrent(rnd, &digest, &digest, ledgercore.AccountTotals{}, &digest))
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointLabelURL = labelServer.URL
+	l := &catchupModeTestLedger{latest: 1000}
+	node := &catchupModeTestNode{}
+	net := &catchupModeTestNetwork{relay: testHTTPPeer(strings.TrimPrefix(relay.URL, "http://"))}
+
+	cfg.CatchupMode = config.CatchupModeBlocks
+	s, err := MakeCatchupModeSelector(cfg, node, l, net, logging.TestingLog(t), t.TempDir())
+	require.NoError(t, err)
+	require.Nil(t, s)
+	cfg.CatchupMode = "fastest"
+	_, err = MakeCatchupModeSelector(cfg, node, l, net, logging.TestingLog(t), t.TempDir())
+	require.Error(t, err)
+
+	cfg.CatchupMode = config.CatchupModeAuto
+	s, err = MakeCatchupModeSelector(cfg, node, l, net, logging.TestingLog(t), t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+
+	// the first check only starts measuring the block catchup rate
+	label = makeLabel(2_000_000)
+	s.check(ctx, now)
+	require.Empty(t, node.started)
+	require.Zero(t, catchpointHeads)
+
+	// 6000 blocks a minute is too slow to cover 2M rounds
+	now = now.Add(time.Minute)
+	l.latest += 6000
+	s.check(ctx, now)
+	require.Equal(t, []string{label}, node.started)
+	require.Equal(t, 1, catchpointHeads)
+	require.Positive(t, s.diskBytesPerSecond)
+
+	// the same catchpoint is not attempted twice
+	now = now.Add(time.Minute)
+	l.latest += 6000
+	s.check(ctx, now)
+	require.Len(t, node.started, 1)
+
+	// nor during a catchpoint catchup
+	label = makeLabel(3_000_000)
+	l.state = ledger.CatchpointCatchupStateLedgerDownload
+	s.check(ctx, now.Add(time.Minute))
+	require.Len(t, node.started, 1)
+
+	// a close catchpoint is not worth it
+	l.state = ledger.CatchpointCatchupStateInactive
+	l.latest = 2_999_000
+	s.check(ctx, now.Add(2*time.Minute))
+	l.latest += 6000
+	label = makeLabel(l.latest + 1000)
+	s.check(ctx, now.Add(3*time.Minute))
+	require.Len(t, node.started, 1)
+
+	// unless catchpoint catchup is forced, which requires no measurement
+	cfg.CatchupMode = config.CatchupModeCatchpoint
+	s, err = MakeCatchupModeSelector(cfg, node, l, net, logging.TestingLog(t), t.TempDir())
+	require.NoError(t, err)
+	label = makeLabel(l.latest + 10_000)
+	s.check(ctx, now)
+	require.Equal(t, label, node.started[1])
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 const catchupPeersForSync = 10
@@ -44,6 +45,8 @@ const blockQueryPeerLimit = 10
 // this should be at least the number of relays
 const catchupRetryLimit = 500
 
+var catchupSynchronizingGauge = metrics.MakeGauge(metrics.CatchupSynchronizing)
+
 // ErrSyncRoundInvalid is returned when the sync round requested is behind the current ledger round
 var ErrSyncRoundInvalid = errors.New("requested sync round cannot be less than the latest round")
 
@@ -105,6 +108,9 @@ type Service struct {
 	// unsupportedRoundMonitor goroutine, after detecting
 	// an unsupported block.
 	onceUnsupportedRound sync.Once
+
+	// stateDeltaPeers holds the host:port addresses of the peers whose state deltas are accepted.
+	stateDeltaPeers map[string]bool
 }
 
 // A BlockAuthenticator authenticates blocks given a certificate.
@@ -133,6 +139,12 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
 	s.syncNow = make(chan struct{}, 1)
+	s.stateDeltaPeers = make(map[string]bool)
+	for _, addr := range strings.Split(config.CatchupStateDeltaTrustedPeers, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			s.stateDeltaPeers[addr] = true
+		}
+	}
 
 	return s
 }
@@ -356,6 +368,11 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 		r1, r2 := peerSelector.rankPeer(psp, peerRank)
 		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
 
+		var delta *ledgercore.StateDelta
+		if s.cfg.EnableFollowMode && s.cfg.CatchupFetchStateDeltas && s.trustsStateDeltasOf(peer) {
+			delta = s.fetchStateDelta(ctx, block, peer)
+		}
+
 		// Write to ledger, noting that ledger writes must be in order
 		select {
 		case <-ctx.Done():
@@ -377,7 +394,10 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 				return false
 			}
 
-			if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
+			if delta != nil {
+				// the peer served the delta of the block, so that it does not need to be evaluated
+				err = s.ledger.AddValidatedBlock(ledgercore.MakeValidatedBlock(*block, *delta), *cert)
+			} else if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
 				var vb *ledgercore.ValidatedBlock
 				vb, err = s.ledger.Validate(s.ctx, *block, s.blockValidationPool)
 				if err != nil {
@@ -426,6 +446,37 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 	}
 }
 
+// trustsStateDeltasOf tells whether peer is listed in CatchupStateDeltaTrustedPeers. The state delta of a block
+// cannot be checked against the block, so that it is only accepted from these peers.
+func (s *Service) trustsStateDeltasOf(peer network.Peer) bool {
+	addressed, ok := peer.(interface{ GetAddress() string })
+	if !ok {
+		return false
+	}
+	u, err := network.ParseHostOrURL(addressed.GetAddress())
+	if err != nil {
+		return false
+	}
+	return s.stateDeltaPeers[u.Host]
+}
+
+// fetchStateDelta fetches the state delta of a block from the peer which served the block. It returns nil when
+// the peer cannot serve the delta, or when the delta does not match the block, so that the block gets evaluated.
+func (s *Service) fetchStateDelta(ctx context.Context, block *bookkeeping.Block, peer network.Peer) *ledgercore.StateDelta {
+	fetcher := makeUniversalBlockFetcher(s.log, s.net, s.cfg)
+	delta, err := fetcher.fetchStateDelta(ctx, block.Round(), peer)
+	if err != nil {
+		s.log.Debugf("fetchStateDelta(%d): could not fetch the state delta, the block will be evaluated: %v", block.Round(), err)
+		return nil
+	}
+	if delta.Hdr.Hash() != block.Hash() {
+		s.log.Warnf("fetchStateDelta(%d): the state delta does not match the block, the block will be evaluated", block.Round())
+		return nil
+	}
+	delta.Hdr = &block.BlockHeader
+	return delta
+}
+
 // TODO the following code does not handle the following case: seedLookback upgrades during fetch
 func (s *Service) pipelinedFetch(seedLookback uint64) {
 	parallelRequests := s.parallelBlocks
@@ -611,6 +662,7 @@ func (s *Service) sync() {
 	if !atomic.CompareAndSwapInt64(&s.syncStartNS, 0, timeInNS) {
 		s.log.Infof("resuming previous sync from %d (now=%d)", atomic.LoadInt64(&s.syncStartNS), timeInNS)
 	}
+	catchupSynchronizingGauge.Set(1)
 
 	pr := s.ledger.LastRound()
 
@@ -633,6 +685,7 @@ func (s *Service) sync() {
 	if !s.suspendForCatchpointWriting {
 		// in that case, don't change the timer so that the "timer" would keep running.
 		atomic.StoreInt64(&s.syncStartNS, 0)
+		catchupSynchronizingGauge.Set(0)
 
 		// close the initial sync channel if not already close
 		if atomic.CompareAndSwapUint32(&s.initialSyncNotified, 0, 1) {
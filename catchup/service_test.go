@@ -1127,3 +1127,25 @@ func TestDownloadBlocksToSupportStateProofs(t *testing.T) {
 	lookback = lookbackForStateproofsSupport(&topBlk)
 	assert.Equal(t, uint64(0), lookback)
 }
+
+func TestTrustsStateDeltasOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := defaultConfig
+	cfg.CatchupStateDeltaTrustedPeers = "relay-1.example.com:4160, 10.0.0.2:4161"
+	s := MakeService(logging.TestingLog(t), cfg, &httpTestPeerSource{}, nil, nil, nil, nil)
+
+	trusted := testHTTPPeer("http://relay-1.example.com:4160")
+	require.True(t, s.trustsStateDeltasOf(&trusted))
+	trustedHostPort := testHTTPPeer("10.0.0.2:4161")
+	require.True(t, s.trustsStateDeltasOf(&trustedHostPort))
+	otherPort := testHTTPPeer("http://relay-1.example.com:4161")
+	require.False(t, s.trustsStateDeltasOf(&otherPort))
+	other := testHTTPPeer("http://relay-2.example.com:4160")
+	require.False(t, s.trustsStateDeltasOf(&other))
+
+	// no peer is trusted unless listed
+	s = MakeService(logging.TestingLog(t), defaultConfig, &httpTestPeerSource{}, nil, nil, nil, nil)
+	require.False(t, s.trustsStateDeltasOf(&trusted))
+}
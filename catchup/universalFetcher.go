@@ -30,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
@@ -113,6 +114,32 @@ func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk
 	return &decodedEntry.Block, &decodedEntry.Certificate, nil
 }
 
+// fetchStateDelta returns the state delta of a round from the peer. Only ws peers serve state deltas.
+func (uf *universalBlockFetcher) fetchStateDelta(ctx context.Context, round basics.Round, peer network.Peer) (*ledgercore.StateDelta, error) {
+	wsPeer, validWSPeer := peer.(network.UnicastPeer)
+	if !validWSPeer {
+		return nil, errStateDeltaUnsupportedPeer
+	}
+	fetcherClient := &wsFetcherClient{
+		target: wsPeer,
+		config: &uf.config,
+	}
+	fetchedBuf, err := fetcherClient.getStateDeltaBytes(ctx, round)
+	if err != nil {
+		return nil, err
+	}
+	var delta ledgercore.StateDelta
+	err = protocol.DecodeReflect(fetchedBuf, &delta)
+	if err != nil {
+		return nil, fmt.Errorf("fetchStateDelta: cannot decode state delta %d from peer %s: %w", round, fetcherClient.address(), err)
+	}
+	if delta.Hdr == nil || delta.Hdr.Round != round {
+		return nil, fmt.Errorf("fetchStateDelta: got a state delta without the header of round %d from peer %s", round, fetcherClient.address())
+	}
+	delta.Hydrate()
+	return &delta, nil
+}
+
 // a stub fetcherClient to satisfy the NetworkFetcher interface
 type wsFetcherClient struct {
 	target network.UnicastPeer // the peer where we're going to send the request.
@@ -146,6 +173,25 @@ func (w *wsFetcherClient) getBlockBytes(ctx context.Context, r basics.Round) ([]
 	return blockBytes, nil
 }
 
+// getStateDeltaBytes requests the encoded state delta of round r
+func (w *wsFetcherClient) getStateDeltaBytes(ctx context.Context, r basics.Round) ([]byte, error) {
+	childCtx, cancelFunc := context.WithTimeout(ctx, time.Duration(w.config.CatchupGossipBlockFetchTimeoutSec)*time.Second)
+	defer cancelFunc()
+
+	resp, err := w.target.Request(childCtx, protocol.UniStateDeltaReqTag, makeStateDeltaRequestTopics(r))
+	if err != nil {
+		return nil, makeErrWsFetcherRequestFailed(r, w.target.GetAddress(), err.Error())
+	}
+	if errMsg, found := resp.Topics.GetValue(network.ErrorKey); found {
+		return nil, makeErrWsFetcherRequestFailed(r, w.target.GetAddress(), string(errMsg))
+	}
+	deltaBytes, found := resp.Topics.GetValue(rpcs.StateDeltaDataKey)
+	if !found || len(deltaBytes) == 0 {
+		return nil, makeErrWsFetcherRequestFailed(r, w.target.GetAddress(), "State delta data not found")
+	}
+	return deltaBytes, nil
+}
+
 // Address implements FetcherClient
 func (w *wsFetcherClient) address() string {
 	return fmt.Sprintf("[ws] (%s)", w.target.GetAddress())
@@ -164,6 +210,17 @@ func makeBlockRequestTopics(r basics.Round) network.Topics {
 	}
 }
 
+// makeStateDeltaRequestTopics builds topics for requesting a state delta.
+func makeStateDeltaRequestTopics(r basics.Round) network.Topics {
+	roundBin := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(roundBin, uint64(r))
+	return network.Topics{
+		network.MakeTopic(
+			rpcs.RoundKey,
+			roundBin),
+	}
+}
+
 // requestBlock send a request for block <round> and wait until it receives a response or a context expires.
 func (w *wsFetcherClient) requestBlock(ctx context.Context, round basics.Round) ([]byte, error) {
 	topics := makeBlockRequestTopics(round)
@@ -197,6 +254,8 @@ const fetcherMaxBlockBytes = 10 << 20
 
 var errNoBlockForRound = errors.New("No block available for given round")
 
+var errStateDeltaUnsupportedPeer = errors.New("fetchStateDelta: only ws peers serve state deltas")
+
 // HTTPFetcher implements FetcherClient doing an HTTP GET of the block
 type HTTPFetcher struct {
 	peer    network.HTTPPeer
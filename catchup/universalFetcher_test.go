@@ -80,6 +80,51 @@ func TestUGetBlockWs(t *testing.T) {
 	require.Equal(t, int64(duration), int64(0))
 }
 
+// TestUGetStateDeltaWs tests fetching state deltas from a ws peer
+func TestUGetStateDeltaWs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+
+	ledger, next, b, err := buildTestLedger(t, bookkeeping.Block{})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	serviceConfig := config.GetDefaultLocal()
+	serviceConfig.EnableGossipStateDeltaService = true
+
+	net := &httpTestPeerSource{}
+
+	up := makeTestUnicastPeer(net, t)
+	ss := rpcs.MakeStateDeltaService(logging.Base(), serviceConfig, ledger, net)
+	ss.Start()
+	defer ss.Stop()
+
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
+
+	delta, err := fetcher.fetchStateDelta(context.Background(), next, up)
+	require.NoError(t, err)
+	require.Equal(t, b.BlockHeader, *delta.Hdr)
+
+	expected, err := ledger.GetStateDeltaForRound(next)
+	require.NoError(t, err)
+	expected.Dehydrate()
+	delta.Dehydrate()
+	require.Equal(t, expected, *delta)
+
+	delta, err = fetcher.fetchStateDelta(context.Background(), next+1, up)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "requested state delta is not available")
+	require.Nil(t, delta)
+
+	httpPeer := testHTTPPeer("http://127.0.0.1")
+	delta, err = fetcher.fetchStateDelta(context.Background(), next, &httpPeer)
+	require.ErrorIs(t, err, errStateDeltaUnsupportedPeer)
+	require.Nil(t, delta)
+}
+
 // TestUGetBlockHTTP tests the universal fetcher http peer case
 func TestUGetBlockHTTP(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -328,3 +373,17 @@ func TestMaxBlockRequestSize(t *testing.T) {
 	require.Equal(t, uint64(len(serializedMsg)), protocol.UniEnsBlockReqTag.MaxMessageSize())
 
 }
+
+// State delta request topics are handrolled like the block request ones. This test ensures that their size
+// matches the defined constant in protocol
+func TestMaxStateDeltaRequestSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	round := rand.Uint64()
+	topics := makeStateDeltaRequestTopics(basics.Round(round))
+	nonce := rand.Uint64() - 1
+	nonceTopic := network.MakeNonceTopic(nonce)
+	topics = append(topics, nonceTopic)
+	serializedMsg := topics.MarshallTopics()
+	require.Equal(t, uint64(len(serializedMsg)), protocol.UniStateDeltaReqTag.MaxMessageSize())
+}
@@ -31,7 +31,9 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod"
+	"github.com/algorand/go-algorand/daemon/algod/service"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
@@ -51,12 +53,15 @@ var versionCheck = flag.Bool("v", false, "Display and write current build versio
 var branchCheck = flag.Bool("b", false, "Display the git branch behind the build")
 var channelCheck = flag.Bool("c", false, "Display and release channel behind the build")
 var initAndExit = flag.Bool("x", false, "Initialize the ledger and exit")
+var migrateStorage = flag.String("migrate-storage", "", "Copy the ledger tracker database into the given storage engine (pebbledb) and exit")
 var logToStdout = flag.Bool("o", false, "Write to stdout instead of node.log by overriding config.LogSizeLimit to 0")
 var peerOverride = flag.String("p", "", "Override phonebook with peer ip:port (or semicolon separated list: ip:port;ip:port;ip:port...)")
 var listenIP = flag.String("l", "", "Override config.EndpointAddress (REST listening address) with ip:port")
 var sessionGUID = flag.String("s", "", "Telemetry Session GUID to use")
 var telemetryOverride = flag.String("t", "", `Override telemetry setting if supported (Use "true", "false", "0" or "1")`)
 var seed = flag.String("seed", "", "input to math/rand.Seed()")
+var serviceCommand = flag.String("service", "", "Manage algod as a native service of the operating system (Windows service or launchd): install, uninstall, or run")
+var serviceName = flag.String("service-name", service.DefaultName, "Name of the native service managed with -service")
 
 func main() {
 	flag.Parse()
@@ -114,6 +119,15 @@ func run() int {
 		return 1
 	}
 
+	switch *serviceCommand {
+	case "", "run":
+	case "install", "uninstall":
+		return manageService(*serviceCommand, absolutePath)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown service command %#v, expected install, uninstall or run\n", *serviceCommand)
+		return 1
+	}
+
 	genesisPath := *genesisFile
 	if genesisPath == "" {
 		genesisPath = filepath.Join(dataDir, config.GenesisJSONFile)
@@ -194,6 +208,22 @@ func run() int {
 		// log is not setup yet, this will log to stderr
 		log.Fatalf("Unable to load optional consensus protocols file: %v", err)
 	}
+	err = config.Consensus.CheckDevnetOracle(genesis.Hash(), cfg.EnableDevnetOracle)
+	if err != nil {
+		// log is not setup yet, this will log to stderr
+		log.Fatalf("Invalid consensus protocols file: %v", err)
+	}
+
+	if *migrateStorage != "" {
+		ledgerPathnamePrefix := filepath.Join(absolutePath, genesis.ID(), config.LedgerFilenamePrefix)
+		err = ledger.MigrateTrackerDB(ledgerPathnamePrefix, genesis.ID(), genesis.Proto, genesis.Hash(), cfg, *migrateStorage, log)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot migrate the ledger tracker database: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stdout, "Migrated the ledger tracker database; set StorageEngine to \"%s\" in config.json to start using it\n", *migrateStorage)
+		return 0
+	}
 
 	// Enable telemetry hook in daemon to send logs to cloud
 	// If ALGOTEST env variable is set, telemetry is disabled - allows disabling telemetry for tests
@@ -414,10 +444,52 @@ func run() int {
 		}()
 	}
 
+	if *serviceCommand == "run" {
+		err = service.Run(serviceConfig(absolutePath, ""), log, s.Start, func() { s.RequestShutdown(0) })
+		if err != nil {
+			log.Errorf("Cannot run as service %s: %v", *serviceName, err)
+			return 1
+		}
+		return 0
+	}
+
 	s.Start()
 	return 0
 }
 
+func serviceConfig(dataDir string, executable string) service.Config {
+	return service.Config{
+		Name:       *serviceName,
+		DataDir:    dataDir,
+		Executable: executable,
+	}
+}
+
+// manageService installs or uninstalls algod as a native service running the node of dataDir.
+func manageService(command string, dataDir string) int {
+	if _, err := os.Stat(dataDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Data directory %s does not appear to be valid\n", dataDir)
+		return 1
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot locate the algod executable: %v\n", err)
+		return 1
+	}
+	cfg := serviceConfig(dataDir, executable)
+	if command == "install" {
+		err = service.Install(cfg)
+	} else {
+		err = service.Uninstall(cfg)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot %s service %s: %v\n", command, *serviceName, err)
+		return 1
+	}
+	fmt.Printf("Service %s %sed\n", *serviceName, command)
+	return 0
+}
+
 var startupConfigCheckFields = []string{
 	"AgreementIncomingBundlesQueueLength",
 	"AgreementIncomingProposalsQueueLength",
@@ -33,6 +33,7 @@ var netName = flag.String("n", "", "The name of the network for this ledger (wil
 var configFile = flag.String("c", "", "The config file containing the genesis ledger and wallets")
 var quiet = flag.Bool("q", false, "Skip verbose informational messages")
 var short = flag.Bool("s", false, "Cap the last participation key round to 1500")
+var accountsManifest = flag.String("m", "", "A CSV or JSON manifest of accounts to import into the ledger, with their balances, online status and participation key files")
 
 func init() {
 	flag.Parse()
@@ -57,6 +58,17 @@ func main() {
 		genesisData.NetworkName = *netName
 	}
 
+	if *accountsManifest != "" {
+		accounts, err := gen.LoadAccountsManifest(*accountsManifest)
+		if err != nil {
+			reportErrorf("error loading accounts manifest: %v\n", err)
+		}
+		genesisData.ImportedAccounts = append(genesisData.ImportedAccounts, accounts...)
+		if !*quiet {
+			fmt.Printf("Importing %d accounts from %s\n", len(accounts), *accountsManifest)
+		}
+	}
+
 	var verboseOut io.Writer = nil
 	if !*quiet {
 		verboseOut = os.Stdout
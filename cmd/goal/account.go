@@ -70,6 +70,9 @@ var (
 	listAccountInfo    bool
 	onlyShowAssetIds   bool
 	partKeyIDToDelete  string
+	optInAssetIDs      []uint
+	optInAppIDs        []uint
+	optInCloseOut      bool
 )
 
 func init() {
@@ -102,6 +105,8 @@ func init() {
 
 	accountCmd.AddCommand(dumpCmd)
 
+	accountCmd.AddCommand(optInCmd)
+
 	// Wallet to be used for the account operation
 	accountCmd.PersistentFlags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to be used for the selected operation")
 
@@ -219,6 +224,16 @@ func init() {
 	dumpCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to retrieve balance (required)")
 	balanceCmd.MarkFlagRequired("address")
 
+	// optInCmd flags
+	optInCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to opt in (if not specified, uses default account)")
+	optInCmd.Flags().UintSliceVar(&optInAssetIDs, "assetid", nil, "IDs of the assets to opt in to")
+	optInCmd.Flags().UintSliceVar(&optInAppIDs, "app-id", nil, "IDs of the applications to opt in to")
+	optInCmd.Flags().BoolVar(&optInCloseOut, "close-out", false, "Close the account out of the assets and applications instead of opting in to them")
+	optInCmd.Flags().StringVarP(&outFilename, "out", "o", "", "Write the transaction groups to this file instead of sending them")
+	optInCmd.Flags().BoolVarP(&sign, "sign", "s", false, "Use with -o to indicate that the dumped transactions should be signed")
+	optInCmd.Flags().StringVarP(&signerAddress, "signer", "S", "", "Address of key to sign with, if different from the account due to rekeying")
+	optInCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for the transaction groups to commit")
+
 	// deletePartkeyCmd flags
 	deletePartKeyCmd.Flags().StringVarP(&partKeyIDToDelete, "partkeyid", "", "", "Participation Key ID to delete")
 	rewardsCmd.MarkFlagRequired("partkeyid")
@@ -360,7 +375,7 @@ var deletePartKeyCmd = &cobra.Command{
 	Long:  `Delete the indicated participation key.`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, args []string) {
-		dataDir := datadir.EnsureSingleDataDir()
+		dataDir := ensureSingleNode()
 
 		client := ensureAlgodClient(dataDir)
 
@@ -946,7 +961,7 @@ system security.
 No --delete-input flag specified, exiting without installing key.`)
 		}
 
-		dataDir := datadir.EnsureSingleDataDir()
+		dataDir := ensureSingleNode()
 
 		client := ensureAlgodClient(dataDir)
 		addResponse, err := client.AddParticipationKey(partKeyFile)
@@ -1145,7 +1160,7 @@ var listParticipationKeysCmd = &cobra.Command{
 	Long:  `List all participation keys tracked by algod along with summary of additional information. For detailed key information use 'partkeyinfo'.`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, args []string) {
-		dataDir := datadir.EnsureSingleDataDir()
+		dataDir := ensureSingleNode()
 
 		client := ensureGoalClient(dataDir, libgoal.DynamicClient)
 		parts, err := client.ListParticipationKeys()
@@ -1393,7 +1408,7 @@ var partkeyInfoCmd = &cobra.Command{
 	Long:  `Output details about all available part keys in the specified data directory(ies), such as key validity period.`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, args []string) {
-		datadir.OnDataDirs(func(dataDir string) {
+		onNodes(func(dataDir string) {
 			fmt.Printf("Dumping participation key info from %s...\n", dataDir)
 			client := ensureAlgodClient(dataDir)
 
@@ -1478,3 +1493,98 @@ var markNonparticipatingCmd = &cobra.Command{
 		}
 	},
 }
+
+var optInCmd = &cobra.Command{
+	Use:   "optin",
+	Short: "Opt an account in to, or out of, multiple assets and applications",
+	Long: `Opt an account in to, or close it out of, a list of assets and applications. The node builds the transactions, chunked into groups of at most 16 transactions, and simulates them to check the account can afford the minimum balance they require. Nothing is signed or sent if a group would fail.
+Assets and applications the account is already opted in to, or out of, are skipped. Asset holdings are closed out to the asset creator.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(optInAssetIDs) == 0 && len(optInAppIDs) == 0 {
+			reportErrorf("At least one of --assetid or --app-id must be specified")
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		accountList := makeAccountsList(dataDir)
+		if accountAddress == "" {
+			accountAddress = accountList.getDefaultAccount()
+		}
+		addr := accountList.getAddressByName(accountAddress)
+
+		var signer basics.Address
+		if signerAddress != "" {
+			var err error
+			signer, err = basics.UnmarshalChecksumAddress(signerAddress)
+			if err != nil {
+				reportErrorf("Signer invalid (%s): %v", signerAddress, err)
+			}
+		}
+
+		assets := make([]uint64, len(optInAssetIDs))
+		for i, id := range optInAssetIDs {
+			assets[i] = uint64(id)
+		}
+		apps := make([]uint64, len(optInAppIDs))
+		for i, id := range optInAppIDs {
+			apps[i] = uint64(id)
+		}
+		response, err := client.BuildOptInGroups(addr, assets, apps, optInCloseOut)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		if response.FailureMessage != nil {
+			reportErrorf("Transaction group %d of %d would fail: %s", *response.FailedGroup+1, len(response.TxnGroups), *response.FailureMessage)
+		}
+		if len(response.TxnGroups) == 0 {
+			reportInfof("Account %s has nothing to opt in to or out of", addr)
+			return
+		}
+
+		groups := make([][]transactions.SignedTxn, len(response.TxnGroups))
+		for i, group := range response.TxnGroups {
+			groups[i] = make([]transactions.SignedTxn, len(group.Txns))
+			for j, encoded := range group.Txns {
+				var tx transactions.Transaction
+				err = protocol.Decode(encoded, &tx)
+				if err != nil {
+					reportErrorf("Cannot decode transaction: %v", err)
+				}
+				groups[i][j], err = createSignedTransaction(client, outFilename == "" || sign, dataDir, walletName, tx, signer)
+				if err != nil {
+					reportErrorf(errorSigningTX, err)
+				}
+			}
+		}
+
+		if outFilename != "" {
+			var stxns []transactions.SignedTxn
+			for _, group := range groups {
+				stxns = append(stxns, group...)
+			}
+			err = writeSignedTxnsToFile(stxns, outFilename)
+			if err != nil {
+				reportErrorf(err.Error())
+			}
+			return
+		}
+
+		for i, group := range groups {
+			err = client.BroadcastTransactionGroup(group)
+			if err != nil {
+				reportErrorf(errorBroadcastingTX, err)
+			}
+			reportInfof("Issued transaction group %d of %d from account %s", i+1, len(groups), addr)
+
+			if !noWaitAfterSend {
+				last := group[len(group)-1].Txn
+				_, err = waitForCommit(client, last.ID().String(), uint64(last.LastValid))
+				if err != nil {
+					reportErrorf(err.Error())
+				}
+			}
+		}
+		reportInfof("Minimum balance of account %s once all groups are committed: %d microAlgos", addr, response.MinBalance)
+	},
+}
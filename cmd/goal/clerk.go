@@ -80,6 +80,10 @@ var (
 	simulateScratchChange         bool
 	simulateAppStateChange        bool
 	simulateAllowUnnamedResources bool
+	simulateAllowInsufficientFees bool
+	simulateFixSigners            bool
+	simulatePopulateResources     bool
+	simulateBatch                 bool
 )
 
 func init() {
@@ -175,6 +179,10 @@ func init() {
 	simulateCmd.Flags().BoolVar(&simulateScratchChange, "scratch", false, "Report scratch change during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAppStateChange, "state", false, "Report application state changes during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAllowUnnamedResources, "allow-unnamed-resources", false, "Allow access to unnamed resources during simulation")
+	simulateCmd.Flags().BoolVar(&simulateAllowInsufficientFees, "allow-insufficient-fees", false, "Simulate transaction groups that do not pay enough fees, and report the additional fees needed")
+	simulateCmd.Flags().BoolVar(&simulateFixSigners, "fix-signers", false, "Evaluate unsigned transactions with the current authorizer of their sender, and report it. Requires --allow-empty-signatures")
+	simulateCmd.Flags().BoolVar(&simulatePopulateResources, "populate-resources", false, "Report the resources to add to the foreign arrays of the group. Requires --allow-unnamed-resources")
+	simulateCmd.Flags().BoolVar(&simulateBatch, "batch", false, "Simulate each transaction group of the request independently, against the same round. Requires --request")
 }
 
 var clerkCmd = &cobra.Command{
@@ -1257,6 +1265,9 @@ var simulateCmd = &cobra.Command{
 		if txProvided == requestProvided {
 			reportErrorf("exactly one of --txfile or --request must be provided")
 		}
+		if simulateBatch && !requestProvided {
+			reportErrorf("--batch requires --request")
+		}
 
 		extraBudgetProvided := cmd.Flags().Changed("extra-opcode-budget")
 		if simulateAllowMoreOpcodeBudget && extraBudgetProvided {
@@ -1288,6 +1299,9 @@ var simulateCmd = &cobra.Command{
 				AllowEmptySignatures:  simulateAllowEmptySignatures,
 				AllowMoreLogging:      simulateAllowMoreLogging,
 				AllowUnnamedResources: simulateAllowUnnamedResources,
+				AllowInsufficientFees: simulateAllowInsufficientFees,
+				FixSigners:            simulateFixSigners,
+				PopulateResources:     simulatePopulateResources,
 				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
 				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
 			}
@@ -1313,6 +1327,9 @@ var simulateCmd = &cobra.Command{
 				AllowEmptySignatures:  simulateAllowEmptySignatures,
 				AllowMoreLogging:      simulateAllowMoreLogging,
 				AllowUnnamedResources: simulateAllowUnnamedResources,
+				AllowInsufficientFees: simulateAllowInsufficientFees,
+				FixSigners:            simulateFixSigners,
+				PopulateResources:     simulatePopulateResources,
 				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
 				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
 			}
@@ -1322,7 +1339,11 @@ var simulateCmd = &cobra.Command{
 			if err != nil {
 				reportErrorf(fileReadError, requestFilename, err)
 			}
-			simulateResponse, responseErr = client.SimulateTransactionsRaw(data)
+			if simulateBatch {
+				simulateResponse, responseErr = client.SimulateTransactionsBatchRaw(data)
+			} else {
+				simulateResponse, responseErr = client.SimulateTransactionsRaw(data)
+			}
 		}
 
 		if responseErr != nil {
@@ -310,6 +310,9 @@ func ensureFullClient(dataDir string) libgoal.Client {
 }
 
 func getGoalClient(dataDir string, clientType libgoal.ClientType) (client libgoal.Client, err error) {
+	if len(targetNodes) > 0 {
+		return getRemoteGoalClient(dataDir, clientType)
+	}
 	clientConfig := libgoal.ClientConfig{
 		AlgodDataDir: dataDir,
 		KMDDataDir:   resolveKmdDataDir(dataDir),
@@ -19,12 +19,18 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"runtime"
 	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/protocol/transcode"
+	"github.com/algorand/go-algorand/util/db"
 )
 
 var (
@@ -32,16 +38,41 @@ var (
 	rawBlock       bool
 	base32Encoding bool
 	strictJSON     bool
+	diffLimit      int
+
+	grepFirst         uint64
+	grepLast          uint64
+	grepSender        string
+	grepAppID         uint64
+	grepType          string
+	grepOutDir        string
+	grepRoundsPerFile uint64
+	grepWorkers       int
 )
 
 func init() {
 	ledgerCmd.AddCommand(supplyCmd)
 	ledgerCmd.AddCommand(blockCmd)
+	ledgerCmd.AddCommand(diffTrackersCmd)
+	ledgerCmd.AddCommand(verifyCatchpointCmd)
+	ledgerCmd.AddCommand(grepCmd)
 
 	blockCmd.Flags().StringVarP(&blockFilename, "out", "o", stdoutFilenameValue, "The filename to dump the block to (if not set, use stdout)")
 	blockCmd.Flags().BoolVarP(&rawBlock, "raw", "r", false, "Format block as msgpack")
 	blockCmd.Flags().BoolVar(&base32Encoding, "b32", false, "Encode binary blobs using base32 instead of base64")
 	blockCmd.Flags().BoolVar(&strictJSON, "strict", false, "Strict JSON decode: turn all keys into strings")
+
+	diffTrackersCmd.Flags().IntVarP(&diffLimit, "limit", "l", 10, "The maximum number of divergent entries to list for each table")
+
+	grepCmd.Flags().Uint64Var(&grepFirst, "first", 0, "The first round to scan (if not set, the earliest round of the block database)")
+	grepCmd.Flags().Uint64Var(&grepLast, "last", 0, "The last round to scan (if not set, the latest round of the block database)")
+	grepCmd.Flags().StringVar(&grepSender, "sender", "", "Only report transactions sent by this address")
+	grepCmd.Flags().Uint64Var(&grepAppID, "app-id", 0, "Only report application calls to, or creating, this application")
+	grepCmd.Flags().StringVar(&grepType, "type", "", "Only report transactions of this type (pay, keyreg, acfg, axfer, afrz, appl, stpf)")
+	grepCmd.Flags().StringVarP(&grepOutDir, "out", "o", "", "The directory to write the matching transactions to")
+	grepCmd.Flags().Uint64Var(&grepRoundsPerFile, "rounds-per-file", 100000, "The number of rounds covered by each output file")
+	grepCmd.Flags().IntVar(&grepWorkers, "workers", runtime.NumCPU(), "The number of blocks decoded in parallel")
+	grepCmd.MarkFlagRequired("out")
 }
 
 var ledgerCmd = &cobra.Command{
@@ -111,3 +142,134 @@ var blockCmd = &cobra.Command{
 		}
 	},
 }
+
+var verifyCatchpointCmd = &cobra.Command{
+	Use:     "verify-catchpoint [catchpoint]",
+	Short:   "Compare a published catchpoint label against the node's own catchpoint",
+	Long:    "Look up the catchpoint label the node generated for the round of the provided catchpoint, and compare the two. This allows cross-checking the node's ledger state against published catchpoints without re-syncing. The node must still retain the catchpoint for that round, see CatchpointFileHistoryLength.",
+	Example: "goal ledger verify-catchpoint 6500000#1234567890ABCDEF01234567890ABCDEF0",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		label := args[0]
+		round, _, err := ledgercore.ParseCatchpointLabel(label)
+		if err != nil {
+			reportErrorln(errorCatchpointLabelParsingFailed)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		response, err := ensureAlgodClient(dataDir).CatchpointLabel(uint64(round))
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		if response.Catchpoint != label {
+			reportErrorf(errCatchpointMismatch, response.Catchpoint)
+		}
+		reportInfof(infoCatchpointMatch, round)
+	},
+}
+
+var diffTrackersCmd = &cobra.Command{
+	Use:   "diff-trackers [dirA] [dirB]",
+	Short: "Compare the tracker databases of two nodes",
+	Long:  "Compare the tracker databases of two stopped nodes at the same round, and report the accounts, resources, boxes and online state that differ between them. Each argument is either a node data directory, whose tracker database is found where the node keeps it according to its configuration, or the tracker database itself: a SQLite file or a Pebble directory. The databases may be kept in different storage engines.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dbA := openTrackerDatabase(args[0])
+		defer dbA.Close()
+		dbB := openTrackerDatabase(args[1])
+		defer dbB.Close()
+
+		roundA, err := trackerDatabaseRound(dbA)
+		if err != nil {
+			reportErrorf(errTrackerDatabase, args[0], err)
+		}
+		roundB, err := trackerDatabaseRound(dbB)
+		if err != nil {
+			reportErrorf(errTrackerDatabase, args[1], err)
+		}
+		if roundA != roundB {
+			reportErrorf(errTrackerRoundsDiffer, roundA, roundB)
+		}
+
+		differ, err := diffTrackers(dbA, dbB, diffLimit, os.Stdout)
+		if err != nil {
+			reportErrorf(errTrackerDiff, err)
+		}
+		if differ {
+			reportErrorln(errTrackersDiffer)
+		}
+		reportInfof(infoTrackersMatch, roundA)
+	},
+}
+
+var grepCmd = &cobra.Command{
+	Use:     "grep",
+	Short:   "Extract transactions from the node's block database",
+	Long:    "Scan a range of rounds of the local block database, and write the top-level transactions matching all the given filters to files as JSON lines. This reads the database directly, so it works on a stopped node and needs neither the REST API nor an indexer. Only the rounds the node still retains can be scanned, archival nodes retain all of them.",
+	Example: "goal ledger grep --first 1000 --last 2000 --type appl --app-id 1234 -o ./txns",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		var filter ledgerGrepFilter
+		if grepSender != "" {
+			sender, err := basics.UnmarshalChecksumAddress(grepSender)
+			if err != nil {
+				reportErrorf(errGrepBadFilter, "sender", err)
+			}
+			filter.sender = sender
+		}
+		if grepType != "" {
+			switch txType := protocol.TxType(grepType); txType {
+			case protocol.PaymentTx, protocol.KeyRegistrationTx, protocol.AssetConfigTx, protocol.AssetTransferTx,
+				protocol.AssetFreezeTx, protocol.ApplicationCallTx, protocol.StateProofTx:
+				filter.txType = txType
+			default:
+				reportErrorf(errGrepBadFilter, "type", fmt.Sprintf("unknown transaction type %s", grepType))
+			}
+		}
+		filter.appID = basics.AppIndex(grepAppID)
+		if grepRoundsPerFile == 0 {
+			reportErrorf(errGrepBadFilter, "rounds-per-file", "must be positive")
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		blockPath, err := blockDatabasePath(dataDir)
+		if err != nil {
+			reportErrorf(errBlockDatabase, dataDir, err)
+		}
+		accessor, err := db.MakeAccessor(blockPath, true, false)
+		if err != nil {
+			reportErrorf(errBlockDatabase, dataDir, err)
+		}
+		defer accessor.Close()
+
+		earliest, latest, err := blockDatabaseRange(accessor.Handle)
+		if err != nil {
+			reportErrorf(errBlockDatabase, dataDir, err)
+		}
+		first, last := earliest, latest
+		if cmd.Flags().Changed("first") {
+			first = basics.Round(grepFirst)
+		}
+		if cmd.Flags().Changed("last") {
+			last = basics.Round(grepLast)
+		}
+		if first > last || first > latest || last < earliest {
+			reportErrorf(errGrepBadRange, first, last, earliest, latest)
+		}
+
+		err = os.MkdirAll(grepOutDir, 0755)
+		if err != nil {
+			reportErrorf(errGrepOutput, grepOutDir, err)
+		}
+		writer := ledgerGrepWriter{dir: grepOutDir, roundsPerFile: grepRoundsPerFile}
+		scanned, err := grepBlocks(accessor.Handle, first, last, filter, grepWorkers, writer.write)
+		if closeErr := writer.close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			reportErrorf(errGrepFailed, err)
+		}
+		reportInfof(infoGrepDone, scanned, writer.written, len(writer.files), grepOutDir)
+	},
+}
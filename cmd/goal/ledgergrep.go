@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ledgerGrepBatchRounds is how many blocks each decoding worker is handed at a time.
+const ledgerGrepBatchRounds = 16
+
+// ledgerGrepFilter selects the transactions reported by goal ledger grep. Zero fields match everything.
+type ledgerGrepFilter struct {
+	sender basics.Address
+	appID  basics.AppIndex
+	txType protocol.TxType
+}
+
+// match reports whether a top-level transaction passes every filter. Application calls
+// creating an application match the id of the created application.
+func (f ledgerGrepFilter) match(stxn transactions.SignedTxnWithAD) bool {
+	txn := stxn.Txn
+	if !f.sender.IsZero() && txn.Sender != f.sender {
+		return false
+	}
+	if f.txType != "" && txn.Type != f.txType {
+		return false
+	}
+	if f.appID != 0 {
+		if txn.Type != protocol.ApplicationCallTx {
+			return false
+		}
+		if txn.ApplicationID != f.appID && stxn.ApplyData.ApplicationID != f.appID {
+			return false
+		}
+	}
+	return true
+}
+
+// ledgerGrepMatch is a transaction found by goal ledger grep, as written to the output files.
+type ledgerGrepMatch struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Round  basics.Round                 `codec:"round"`
+	Offset int                          `codec:"offset"`
+	TxID   string                       `codec:"txid"`
+	Txn    transactions.SignedTxnWithAD `codec:"txn"`
+}
+
+// ledgerGrepBlock holds a block read from the block database and the transactions matching in it.
+type ledgerGrepBlock struct {
+	round   basics.Round
+	data    []byte
+	matches []ledgerGrepMatch
+	err     error
+}
+
+// decode decodes the block and collects its matching transactions.
+func (b *ledgerGrepBlock) decode(filter ledgerGrepFilter) {
+	var blk bookkeeping.Block
+	if b.err = protocol.Decode(b.data, &blk); b.err != nil {
+		b.err = fmt.Errorf("round %d: %w", b.round, b.err)
+		return
+	}
+	b.data = nil
+	for i, txib := range blk.Payset {
+		var stxn transactions.SignedTxnWithAD
+		stxn.SignedTxn, stxn.ApplyData, b.err = blk.DecodeSignedTxn(txib)
+		if b.err != nil {
+			b.err = fmt.Errorf("round %d: %w", b.round, b.err)
+			return
+		}
+		if filter.match(stxn) {
+			b.matches = append(b.matches, ledgerGrepMatch{Round: b.round, Offset: i, TxID: stxn.ID().String(), Txn: stxn})
+		}
+	}
+}
+
+// grepBlocks scans the blocks of rounds first through last in the block database, decoding them
+// with the given number of parallel workers, and calls emit with the matching transactions in
+// round order. It returns the number of blocks scanned.
+func grepBlocks(handle *sql.DB, first, last basics.Round, filter ledgerGrepFilter, workers int, emit func(ledgerGrepMatch) error) (int, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	rows, err := handle.Query("SELECT rnd, blkdata FROM blocks WHERE rnd >= ? AND rnd <= ? ORDER BY rnd", first, last)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	scanned := 0
+	batch := make([]ledgerGrepBlock, 0, workers*ledgerGrepBatchRounds)
+	flush := func() error {
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := w; i < len(batch); i += workers {
+					batch[i].decode(filter)
+				}
+			}(w)
+		}
+		wg.Wait()
+		for _, blk := range batch {
+			if blk.err != nil {
+				return blk.err
+			}
+			for _, match := range blk.matches {
+				if err := emit(match); err != nil {
+					return err
+				}
+			}
+		}
+		scanned += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for rows.Next() {
+		var blk ledgerGrepBlock
+		if err = rows.Scan(&blk.round, &blk.data); err != nil {
+			return scanned, err
+		}
+		batch = append(batch, blk)
+		if len(batch) == cap(batch) {
+			if err = flush(); err != nil {
+				return scanned, err
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return scanned, err
+	}
+	return scanned, flush()
+}
+
+// ledgerGrepWriter writes matching transactions as JSON lines into files of the output
+// directory, each file covering a fixed number of rounds. Files are only created for
+// rounds with matches.
+type ledgerGrepWriter struct {
+	dir           string
+	roundsPerFile uint64
+
+	file    *os.File
+	chunk   uint64
+	written int
+	files   []string
+}
+
+func (w *ledgerGrepWriter) write(match ledgerGrepMatch) error {
+	chunk := uint64(match.Round) / w.roundsPerFile
+	if w.file == nil || chunk != w.chunk {
+		if err := w.close(); err != nil {
+			return err
+		}
+		first := chunk * w.roundsPerFile
+		name := filepath.Join(w.dir, fmt.Sprintf("transactions-%d-%d.json", first, first+w.roundsPerFile-1))
+		file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		w.file, w.chunk = file, chunk
+		w.files = append(w.files, name)
+	}
+
+	var line bytes.Buffer
+	if err := json.Compact(&line, protocol.EncodeJSONStrict(match)); err != nil {
+		return err
+	}
+	line.WriteByte('\n')
+	if _, err := w.file.Write(line.Bytes()); err != nil {
+		return err
+	}
+	w.written++
+	return nil
+}
+
+func (w *ledgerGrepWriter) close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// blockDatabasePath returns the block database of the node in the given data directory.
+// It honors the BlockDBDir setting of the node configuration, as the node does.
+func blockDatabasePath(dataDir string) (string, error) {
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	genesis, err := readGenesis(dataDir)
+	if err != nil {
+		return "", err
+	}
+	dirs := cfg.ResolveGenesisDirs(filepath.Join(dataDir, genesis.ID()), genesis.ID())
+	blockPath := filepath.Join(dirs.BlockGenesisDir, config.LedgerFilenamePrefix+".block.sqlite")
+	_, err = os.Stat(blockPath)
+	if err != nil {
+		return "", err
+	}
+	return blockPath, nil
+}
+
+// blockDatabaseRange returns the earliest and latest rounds stored in the block database.
+func blockDatabaseRange(handle *sql.DB) (earliest, latest basics.Round, err error) {
+	err = handle.QueryRow("SELECT MIN(rnd), MAX(rnd) FROM blocks").Scan(&earliest, &latest)
+	return
+}
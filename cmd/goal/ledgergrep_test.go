@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestLedgerGrep(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var alice, bob basics.Address
+	alice[0], bob[0] = 1, 2
+
+	accessor, err := db.MakeAccessor(filepath.Join(t.TempDir(), "blocks"), false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	// every round holds a payment from alice, and odd rounds an application call from bob
+	const rounds = 50
+	err = accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		if err := blockdb.BlockInit(tx, nil); err != nil {
+			return err
+		}
+		for rnd := basics.Round(0); rnd < rounds; rnd++ {
+			var blk bookkeeping.Block
+			blk.BlockHeader.Round = rnd
+			blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+			blk.BlockHeader.GenesisHash = crypto.Digest{1}
+
+			txns := []transactions.Transaction{{
+				Type:             protocol.PaymentTx,
+				Header:           transactions.Header{Sender: alice, FirstValid: rnd, GenesisHash: blk.BlockHeader.GenesisHash},
+				PaymentTxnFields: transactions.PaymentTxnFields{Receiver: bob, Amount: basics.MicroAlgos{Raw: uint64(rnd)}},
+			}}
+			if rnd%2 == 1 {
+				txns = append(txns, transactions.Transaction{
+					Type:                     protocol.ApplicationCallTx,
+					Header:                   transactions.Header{Sender: bob, FirstValid: rnd, GenesisHash: blk.BlockHeader.GenesisHash},
+					ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: 7},
+				})
+			}
+			for _, txn := range txns {
+				txib, err := blk.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+				if err != nil {
+					return err
+				}
+				blk.Payset = append(blk.Payset, txib)
+			}
+			if err := blockdb.BlockPut(tx, blk, agreement.Certificate{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	earliest, latest, err := blockDatabaseRange(accessor.Handle)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), earliest)
+	require.Equal(t, basics.Round(rounds-1), latest)
+
+	grep := func(first, last basics.Round, filter ledgerGrepFilter, workers int) (int, []ledgerGrepMatch) {
+		var matches []ledgerGrepMatch
+		scanned, err := grepBlocks(accessor.Handle, first, last, filter, workers, func(match ledgerGrepMatch) error {
+			matches = append(matches, match)
+			return nil
+		})
+		require.NoError(t, err)
+		return scanned, matches
+	}
+
+	scanned, matches := grep(0, rounds-1, ledgerGrepFilter{}, 3)
+	require.Equal(t, rounds, scanned)
+	require.Len(t, matches, rounds+rounds/2)
+	for i := 1; i < len(matches); i++ {
+		// matches are reported in round order whatever the number of workers
+		require.LessOrEqual(t, matches[i-1].Round, matches[i].Round)
+	}
+
+	scanned, matches = grep(10, 19, ledgerGrepFilter{sender: alice}, 4)
+	require.Equal(t, 10, scanned)
+	require.Len(t, matches, 10)
+	for i, match := range matches {
+		require.Equal(t, basics.Round(10+i), match.Round)
+		require.Equal(t, 0, match.Offset)
+		require.Equal(t, uint64(10+i), match.Txn.Txn.Amount.Raw)
+		require.Equal(t, match.Txn.ID().String(), match.TxID)
+	}
+
+	_, matches = grep(0, rounds-1, ledgerGrepFilter{appID: 7, txType: protocol.ApplicationCallTx}, 2)
+	require.Len(t, matches, rounds/2)
+	_, matches = grep(0, rounds-1, ledgerGrepFilter{appID: 8}, 2)
+	require.Empty(t, matches)
+	_, matches = grep(0, rounds-1, ledgerGrepFilter{sender: bob, txType: protocol.PaymentTx}, 1)
+	require.Empty(t, matches)
+
+	// the writer splits the matches into files by round
+	writer := ledgerGrepWriter{dir: t.TempDir(), roundsPerFile: 20}
+	_, err = grepBlocks(accessor.Handle, 0, rounds-1, ledgerGrepFilter{sender: bob}, 2, writer.write)
+	require.NoError(t, err)
+	require.NoError(t, writer.close())
+	require.Equal(t, rounds/2, writer.written)
+	require.Equal(t, []string{
+		filepath.Join(writer.dir, "transactions-0-19.json"),
+		filepath.Join(writer.dir, "transactions-20-39.json"),
+		filepath.Join(writer.dir, "transactions-40-59.json"),
+	}, writer.files)
+
+	file, err := os.Open(writer.files[0])
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	lines := 0
+	for scanner.Scan() {
+		var match ledgerGrepMatch
+		require.NoError(t, protocol.DecodeJSON(scanner.Bytes(), &match))
+		require.Equal(t, bob, match.Txn.Txn.Sender)
+		require.Equal(t, basics.AppIndex(7), match.Txn.Txn.ApplicationID)
+		lines++
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 10, lines)
+}
+
+func TestBlockDatabasePath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	genesis := bookkeeping.Genesis{SchemaID: "v1", Network: "testnet"}
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, config.GenesisJSONFile), protocol.EncodeJSON(genesis), 0600))
+
+	// the block database is looked up in the genesis directory by default
+	_, err := blockDatabasePath(dataDir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	defaultPath := filepath.Join(dataDir, genesis.ID(), config.LedgerFilenamePrefix+".block.sqlite")
+	require.NoError(t, os.MkdirAll(filepath.Dir(defaultPath), 0700))
+	require.NoError(t, os.WriteFile(defaultPath, nil, 0600))
+	path, err := blockDatabasePath(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, defaultPath, path)
+
+	// and in the directory set by BlockDBDir otherwise
+	cfg := config.GetDefaultLocal()
+	cfg.BlockDBDir = t.TempDir()
+	require.NoError(t, cfg.SaveToDisk(dataDir))
+	_, err = blockDatabasePath(dataDir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	blockDBPath := filepath.Join(cfg.BlockDBDir, genesis.ID(), config.LedgerFilenamePrefix+".block.sqlite")
+	require.NoError(t, os.MkdirAll(filepath.Dir(blockDBPath), 0700))
+	require.NoError(t, os.WriteFile(blockDBPath, nil, 0600))
+	path, err = blockDatabasePath(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, blockDBPath, path)
+}
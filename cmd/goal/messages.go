@@ -60,6 +60,8 @@ const (
 	infoNodeDidNotRestart                   = "Algorand node did not restart. The node is still running!"
 	infoTryingToStopNode                    = "Trying to stop the node..."
 	infoNodeShuttingDown                    = "Algorand node is shutting down..."
+	infoNodeDrained                         = "Algorand node drained at round %d"
+	infoNodeDrainedCatchpoint               = "Catchpoint written at the drained round: %s"
 	infoNodeSuccessfullyStopped             = "The node was successfully stopped."
 	infoNodeStatus                          = "Last committed block: %d\nTime since last block: %s\nSync Time: %s\nLast consensus protocol: %s\nNext consensus protocol: %s\nRound for next consensus protocol: %d\nNext consensus protocol supported: %v"
 	infoNodeStatusConsensusUpgradeVoting    = "Consensus upgrade state: Voting\nYes votes: %d\nNo votes: %d\nVotes remaining: %d\nYes votes required: %d\nVote window close round: %d"
@@ -74,6 +76,7 @@ const (
 	errorNodeCreationIPFailure              = "Parsing passed IP %v failed: need a valid IPv4 or IPv6 address with a specified port number"
 	errorNodeNotDetected                    = "Algorand node does not appear to be running: %s"
 	errorNodeStatus                         = "Cannot contact Algorand node: %s"
+	errorNodeTopRefresh                     = "The dashboard refresh time must be greater than zero"
 	errorNodeFailedToStart                  = "Algorand node failed to start: %s"
 	errorNodeRunning                        = "Node must be stopped before writing APIToken"
 	errorNodeFailGenToken                   = "Cannot generate API token: %s"
@@ -92,6 +95,15 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	infoNodeTarget                          = "[Node: %s]"
+	infoNodeTargetAdded                     = "Registered node '%s' at %s"
+	infoNodeTargetDeleted                   = "Deleted node '%s'"
+	infoNoNodeTargets                       = "No nodes are registered"
+	infoNodeTargetTokenPrompt               = "Please enter the API token of node '%s': "
+	infoNodeRegistryPasswordPrompt          = "Please enter the password of the node registry: "
+	infoNodeRegistryNewPasswordPrompt       = "Please choose a password for the node registry: "
+	errorNodeRegistry                       = "Cannot access the node registry: %s"
+	errorOneNodeTargetSupported             = "Only one target node is supported by this command"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -207,4 +219,41 @@ const (
 	errParsingRoundNumber  = "Error parsing round number: %s"
 	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
 	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+	errTrackerDatabase     = "Unable to open tracker database for '%s': %s"
+	errTrackerRoundsDiffer = "Tracker databases are at different rounds (%d and %d), stop both nodes at the same round and try again"
+	errTrackersDiffer      = "Tracker databases differ"
+	errTrackerDiff         = "Error comparing tracker databases: %s"
+	infoTrackersMatch      = "Tracker databases match at round %d"
+	errCatchpointMismatch  = "Catchpoint mismatch, the node generated %s"
+	infoCatchpointMatch    = "Catchpoint matches the node's ledger at round %d"
+	errBlockDatabase       = "Unable to open block database for '%s': %s"
+	errGrepBadFilter       = "Invalid --%s filter: %s"
+	errGrepBadRange        = "Invalid round range %d-%d, the block database holds rounds %d-%d"
+	errGrepOutput          = "Unable to create output directory '%s': %s"
+	errGrepFailed          = "Error scanning blocks: %s"
+	infoGrepDone           = "Scanned %d blocks, wrote %d matching transactions to %d files in %s"
+
+	// Crash state
+	errCrashDatabase           = "Unable to open crash database for '%s': %s"
+	errCrashState              = "Error accessing the crash state: %s"
+	errorCrashStateNodeRunning = "Node must be stopped before compacting the crash database"
+	infoNoCrashState           = "No agreement state is persisted for crash recovery"
+	infoCrashStateCompacted    = "Compacted the crash database from %d to %d bytes"
+
+	// Relocation
+	errRelocate              = "Unable to relocate the files of '%s': %s"
+	errorRelocateNodeRunning = "Node must be stopped before relocating its files"
+	infoNothingToRelocate    = "The node files are already in the directories set in the configuration"
+	infoRelocating           = "Moving %s to %s"
+	infoRelocateDryRun       = "Would move %s to %s"
+
+	// Snapshots
+	errSnapshot             = "Unable to write the snapshot of the node: %s"
+	errRestore              = "Unable to restore %s into '%s': %s"
+	errRestoreExistingState = "Unable to restore %s into '%s': %s; use --force to replace it"
+	errorRestoreNodeRunning = "Node must be stopped before restoring a snapshot into its data directory"
+	infoSnapshotWriting     = "Writing the snapshot of the node..."
+	infoSnapshotWritten     = "Wrote the snapshot of round %d to %s (%d bytes, SHA-256 %s)"
+	infoSnapshotRestored    = "Restored the snapshot of round %d of %s, taken at %s"
+	warnRestoredKeys        = "The snapshot holds participation keys: they must not be used by this node while another node participates with them"
 )
@@ -20,6 +20,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -34,14 +35,17 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/node/snapshot"
 	"github.com/algorand/go-algorand/nodecontrol"
 	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -61,6 +65,12 @@ var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
+var drainNode bool
+var topRefreshMillisecond uint64
+var topOnce bool
+var relocateDryRun bool
+var snapshotExcludeKeys bool
+var restoreForce bool
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -76,8 +86,15 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
-	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
-	//nodeCmd.AddCommand(shutdownCmd)
+	nodeCmd.AddCommand(shutdownCmd)
+	nodeCmd.AddCommand(topCmd)
+	nodeCmd.AddCommand(crashStateCmd)
+	nodeCmd.AddCommand(relocateCmd)
+	nodeCmd.AddCommand(snapshotCmd)
+	nodeCmd.AddCommand(restoreCmd)
+
+	crashStateCmd.AddCommand(crashStateShowCmd)
+	crashStateCmd.AddCommand(crashStateCompactCmd)
 
 	startCmd.Flags().StringVarP(&peerDial, "peer", "p", "", "Peer address to dial for initial connection")
 	startCmd.Flags().StringVarP(&listenIP, "listen", "l", "", "Endpoint / REST address to listen on")
@@ -89,6 +106,8 @@ func init() {
 	restartCmd.Flags().BoolVarP(&runUnderHost, "hosted", "H", false, "Run algod hosted by algoh")
 	restartCmd.Flags().StringVarP(&telemetryOverride, "telemetry", "t", "", `Enable telemetry if supported (Use "true", "false", "0" or "1")`)
 
+	shutdownCmd.Flags().BoolVar(&drainNode, "drain", false, "Stop accepting transactions, complete the current round and commit the ledger state before shutting down")
+
 	cloneCmd.Flags().StringVarP(&targetDir, "targetdir", "t", "", "Target directory for the clone")
 	cloneCmd.Flags().BoolVarP(&noLedger, "noledger", "n", false, "Don't include ledger when copying (No Ledger)")
 
@@ -113,10 +132,17 @@ func init() {
 	pendingTxnsCmd.Flags().Uint64VarP(&maxPendingTransactions, "maxPendingTxn", "m", 0, "Cap the number of txns to fetch")
 	waitCmd.Flags().Uint32VarP(&waitSec, "waittime", "w", 5, "Time (in seconds) to wait for node to make progress")
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
+	topCmd.Flags().Uint64VarP(&topRefreshMillisecond, "refresh", "r", 1000, "Time (in milliseconds) between two successive dashboard updates")
+	topCmd.Flags().BoolVar(&topOnce, "once", false, "Print the dashboard once instead of refreshing it")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
 
+	relocateCmd.Flags().BoolVar(&relocateDryRun, "dry-run", false, "List the files to move without moving them")
+
+	snapshotCmd.Flags().BoolVar(&snapshotExcludeKeys, "exclude-keys", false, "Leave the participation keys out of the snapshot")
+	restoreCmd.Flags().BoolVar(&restoreForce, "force", false, "Replace the ledger, and the participation keys held by the snapshot, of the node")
+
 }
 
 var nodeCmd = &cobra.Command{
@@ -161,7 +187,7 @@ var catchupCmd = &cobra.Command{
 	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
-		datadir.OnDataDirs(func(dataDir string) {
+		onNodes(func(dataDir string) {
 			if !abortCatchup && len(args) == 0 {
 				client := ensureAlgodClient(dataDir)
 				vers, err := client.AlgodVersions()
@@ -267,9 +293,15 @@ var shutdownCmd = &cobra.Command{
 		}
 		datadir.OnDataDirs(func(dataDir string) {
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
-			err := nc.Shutdown()
+			response, err := nc.Shutdown(drainNode)
 
 			if err == nil {
+				if drainNode {
+					reportInfof(infoNodeDrained, response.Round)
+					if response.CatchpointWritten {
+						reportInfof(infoNodeDrainedCatchpoint, *response.Catchpoint)
+					}
+				}
 				reportInfoln(infoNodeShuttingDown)
 			} else {
 				reportErrorf(errorNodeFailedToShutdown, err)
@@ -417,7 +449,20 @@ var statusCmd = &cobra.Command{
 	Long:  `Show the current status of the running Algorand node.`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, _ []string) {
-		datadir.OnDataDirs(getStatus)
+		onNodes(getStatus)
+	},
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show a live dashboard of the node",
+	Long:  `Show a dashboard of the running Algorand node, refreshed until interrupted: the round progress, the peers, the transaction pool, the participation keys and the last warnings of node.log.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		if topRefreshMillisecond == 0 {
+			reportErrorf(errorNodeTopRefresh)
+		}
+		runNodeTop(datadir.EnsureSingleDataDir(), time.Duration(topRefreshMillisecond)*time.Millisecond, topOnce)
 	},
 }
 
@@ -719,7 +764,7 @@ var createCmd = &cobra.Command{
 }
 
 func catchup(dataDir string, args []string) {
-	client := ensureAlgodClient(datadir.EnsureSingleDataDir())
+	client := ensureAlgodClient(dataDir)
 	if abortCatchup {
 		err := client.AbortCatchup()
 		if err != nil {
@@ -751,3 +796,205 @@ func verifyPeerDialArg() bool {
 	return true
 
 }
+
+var crashStateCmd = &cobra.Command{
+	Use:   "crashstate",
+	Short: "Inspect and compact the agreement state persisted for crash recovery",
+	Long:  "Inspect and compact the agreement state the node persists to resume from after an unclean shutdown. The crash database is accessed directly, so these commands work on a stopped node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var crashStateShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the agreement state persisted for crash recovery",
+	Long:  "Show the round, period and step the node would resume agreement from, along with the votes it counted and the actions it had pending. The state is only persisted at some steps, so it may lag behind the node while it runs.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			crash := openCrashDatabase(dataDir, true)
+			defer crash.Close()
+
+			state, err := agreement.ReadCrashState(log, crash)
+			if errors.Is(err, agreement.ErrNoCrashState) {
+				reportInfoln(infoNoCrashState)
+				return
+			}
+			if err != nil {
+				reportErrorf(errCrashState, err)
+			}
+			state.Dump(os.Stdout)
+		})
+	},
+}
+
+var crashStateCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Reclaim the space of the crash database left unused by earlier states",
+	Long:  "Rewrite the crash database to reclaim the space left unused by the states persisted earlier. The node compacts it on startup when enough space is unused; this command allows compacting it on demand. The node must be stopped.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		datadir.OnDataDirs(func(dataDir string) {
+			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			if _, err := nc.GetAlgodPID(); err == nil {
+				reportErrorln(errorCrashStateNodeRunning)
+			}
+
+			crash := openCrashDatabase(dataDir, false)
+			defer crash.Close()
+
+			stats, err := agreement.CompactCrashState(context.Background(), crash)
+			if err != nil {
+				reportErrorf(errCrashState, err)
+			}
+			reportInfof(infoCrashStateCompacted, stats.SizeBefore, stats.SizeAfter)
+		})
+	},
+}
+
+var relocateCmd = &cobra.Command{
+	Use:   "relocate",
+	Short: "Move the node files to the directories set in the configuration",
+	Long:  "Move the tracker database, the block database, the catchpoint files and the participation key registry from the data directory to the directories set by TrackerDBDir, BlockDBDir, CatchpointDir and ParticipationDir in config.json. The node refuses to start until they are moved. Files moved to another volume are copied, then removed. The node must be stopped.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		datadir.OnDataDirs(func(dataDir string) {
+			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			if _, err := nc.GetAlgodPID(); err == nil && !relocateDryRun {
+				reportErrorln(errorRelocateNodeRunning)
+			}
+
+			cfg, err := config.LoadConfigFromDisk(dataDir)
+			if err != nil && !os.IsNotExist(err) {
+				reportErrorf(errLoadingConfig, dataDir, err)
+			}
+			genesis, err := readGenesis(dataDir)
+			if err != nil {
+				reportErrorf(errRelocate, dataDir, err)
+			}
+			dirs, err := cfg.EnsureGenesisDirs(filepath.Join(dataDir, genesis.ID()), genesis.ID())
+			if err != nil {
+				reportErrorf(errRelocate, dataDir, err)
+			}
+			relocations, err := dirs.PendingRelocations()
+			if err != nil {
+				reportErrorf(errRelocate, dataDir, err)
+			}
+			if len(relocations) == 0 {
+				reportInfoln(infoNothingToRelocate)
+				return
+			}
+			for _, r := range relocations {
+				if relocateDryRun {
+					reportInfof(infoRelocateDryRun, r.From, r.To)
+					continue
+				}
+				reportInfof(infoRelocating, r.From, r.To)
+				err = r.Apply()
+				if err != nil {
+					reportErrorf(errRelocate, dataDir, err)
+				}
+			}
+		})
+	},
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Write a snapshot of the running node, for other nodes to be cloned from",
+	Long:  "Write a snapshot of the running node into the snapshots directory of its data directory. The snapshot is a gzipped tarball of the genesis file, consistent copies of the tracker and block databases taken while the node keeps running, and the participation key registry, along with a manifest holding the SHA-256 hash of every file. Restore it into the data directory of another node with goal node restore, rather than copying the databases of a running node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		datadir.OnDataDirs(func(dataDir string) {
+			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			algodClient, err := nc.AlgodClient()
+			if err != nil {
+				reportErrorf(errSnapshot, err)
+			}
+			status, err := algodClient.CreateSnapshot(snapshotExcludeKeys)
+			if err != nil {
+				reportErrorf(errSnapshot, err)
+			}
+			reportInfoln(infoSnapshotWriting)
+			for status.Running {
+				time.Sleep(time.Second)
+				status, err = algodClient.SnapshotStatus()
+				if err != nil {
+					reportErrorf(errSnapshot, err)
+				}
+			}
+			if status.Error != nil {
+				reportErrorf(errSnapshot, *status.Error)
+			}
+			reportInfof(infoSnapshotWritten, *status.Round, *status.Path, *status.Size, *status.Sha256)
+		})
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [snapshot file]",
+	Short: "Restore a snapshot written by goal node snapshot into the data directory",
+	Long:  "Restore a snapshot written by goal node snapshot into the data directory of a stopped node of the same network. The files of the snapshot are verified against the hashes of its manifest before any of them is put in place, the databases in the directories set in config.json. The ledger of the node, and its participation key registry when the snapshot holds one, are only replaced with --force.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		archivePath := args[0]
+		datadir.OnDataDirs(func(dataDir string) {
+			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			if _, err := nc.GetAlgodPID(); err == nil {
+				reportErrorln(errorRestoreNodeRunning)
+			}
+
+			cfg, err := config.LoadConfigFromDisk(dataDir)
+			if err != nil && !os.IsNotExist(err) {
+				reportErrorf(errLoadingConfig, dataDir, err)
+			}
+			manifest, err := snapshot.Restore(archivePath, dataDir, cfg, restoreForce)
+			if errors.Is(err, snapshot.ErrExistingState) {
+				reportErrorf(errRestoreExistingState, archivePath, dataDir, err)
+			}
+			if err != nil {
+				reportErrorf(errRestore, archivePath, dataDir, err)
+			}
+			reportInfof(infoSnapshotRestored, manifest.Round, manifest.GenesisID, manifest.Created.Format(time.RFC3339))
+			if manifest.ParticipationKeys {
+				reportWarnln(warnRestoredKeys)
+			}
+		})
+	},
+}
+
+// openCrashDatabase opens the crash database of the node in dataDir.
+func openCrashDatabase(dataDir string, readOnly bool) db.Accessor {
+	genesis, err := readGenesis(dataDir)
+	if err != nil {
+		reportErrorf(errCrashDatabase, dataDir, err)
+	}
+	crashPath := filepath.Join(dataDir, genesis.ID(), config.CrashFilename)
+	if _, err = os.Stat(crashPath); err != nil {
+		reportErrorf(errCrashDatabase, dataDir, err)
+	}
+	crash, err := db.MakeAccessor(crashPath, readOnly, false)
+	if err != nil {
+		reportErrorf(errCrashDatabase, dataDir, err)
+	}
+	return crash
+}
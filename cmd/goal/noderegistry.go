@@ -0,0 +1,388 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+// nodeRegistryFilename is the name of the file, in the global configuration directory, the remote nodes are registered in.
+const nodeRegistryFilename = "goal-nodes.json"
+
+// nodeRegistryPasswordEnv is the environment variable the node registry password is read from, instead of prompting for it.
+const nodeRegistryPasswordEnv = "ALGORAND_NODES_PASSWORD"
+
+const (
+	nodeRegistrySaltLen  = 32
+	nodeRegistryNonceLen = 24
+	nodeRegistryKeyLen   = 32
+	nodeRegistryScryptN  = 32768
+	nodeRegistryScryptR  = 8
+	nodeRegistryScryptP  = 1
+)
+
+var (
+	errNodeRegistryPassword = errors.New("wrong node registry password")
+	errNodeRegistryLocked   = errors.New("node registry is locked")
+)
+
+// targetNodes are the names of the registered nodes given with --target
+var targetNodes []string
+
+var targetAddress string
+var targetToken string
+
+func init() {
+	rootCmd.PersistentFlags().StringArrayVar(&targetNodes, "target", nil, "Name of a registered remote node to talk to instead of a local data directory (see 'goal node target')")
+
+	nodeCmd.AddCommand(targetCmd)
+	targetCmd.AddCommand(targetAddCmd)
+	targetCmd.AddCommand(targetListCmd)
+	targetCmd.AddCommand(targetDeleteCmd)
+
+	targetAddCmd.Flags().StringVar(&targetAddress, "address", "", "Address of the node REST API, e.g. https://node.example.com:8080")
+	targetAddCmd.Flags().StringVar(&targetToken, "token", "", "API token of the node, prompted for when not given")
+	targetAddCmd.MarkFlagRequired("address")
+}
+
+var targetCmd = &cobra.Command{
+	Use:   "target",
+	Short: "Manage the remote nodes goal can administer",
+	Long: `Manage the registry of remote nodes goal can administer through their REST APIs, selected with --target instead of a local data directory. ` +
+		`The API tokens of the nodes are stored encrypted with the registry password, which is prompted for or read from $` + nodeRegistryPasswordEnv + `.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var targetAddCmd = &cobra.Command{
+	Use:   "add [name]",
+	Short: "Register a remote node",
+	Long:  `Register a remote node under the given name, with the address and the API token of its REST API. Give the admin API token to be able to run admin commands such as catchup or participation key operations.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		registry := ensureNodeRegistry()
+		token := targetToken
+		if token == "" {
+			fmt.Printf(infoNodeTargetTokenPrompt, name)
+			token = strings.TrimSpace(string(ensurePassword()))
+		}
+		ensureNodeRegistryUnlocked(registry)
+		err := registry.add(name, targetAddress, token)
+		if err == nil {
+			err = registry.save()
+		}
+		if err != nil {
+			reportErrorf(errorNodeRegistry, err)
+		}
+		reportInfof(infoNodeTargetAdded, name, registry.Nodes[len(registry.Nodes)-1].Address)
+	},
+}
+
+var targetListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the registered remote nodes",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		registry := ensureNodeRegistry()
+		if len(registry.Nodes) == 0 {
+			reportInfoln(infoNoNodeTargets)
+			return
+		}
+		for _, node := range registry.Nodes {
+			reportInfof("%s\t%s", node.Name, node.Address)
+		}
+	},
+}
+
+var targetDeleteCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a registered remote node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		registry := ensureNodeRegistry()
+		err := registry.remove(args[0])
+		if err == nil {
+			err = registry.save()
+		}
+		if err != nil {
+			reportErrorf(errorNodeRegistry, err)
+		}
+		reportInfof(infoNodeTargetDeleted, args[0])
+	},
+}
+
+// registeredNode is a remote node of the node registry.
+type registeredNode struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	// Token is the API token of the node, sealed with the registry key and prefixed with its nonce.
+	Token []byte `json:"token"`
+}
+
+// nodeRegistry is the list of the remote nodes goal can administer. It is stored as JSON, the API tokens
+// of the nodes being encrypted with a key derived from the registry password.
+type nodeRegistry struct {
+	// Salt is the salt the registry key is derived from the password with.
+	Salt  []byte           `json:"salt"`
+	Nodes []registeredNode `json:"nodes"`
+
+	path string
+	key  *[nodeRegistryKeyLen]byte
+}
+
+// loadNodeRegistry reads the node registry stored at path. A missing file is an empty registry.
+func loadNodeRegistry(path string) (*nodeRegistry, error) {
+	registry := &nodeRegistry{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, registry)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+	return registry, nil
+}
+
+// save writes the registry back to its file, which only the current user can read.
+func (r *nodeRegistry) save() error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0600)
+}
+
+// unlock derives the registry key from the password, and checks it against the stored tokens.
+// The salt is generated on the first use, and the password chosen then protects the following tokens.
+func (r *nodeRegistry) unlock(password []byte) error {
+	if len(r.Salt) == 0 {
+		r.Salt = make([]byte, nodeRegistrySaltLen)
+		_, err := rand.Read(r.Salt)
+		if err != nil {
+			return err
+		}
+	}
+	keySlice, err := scrypt.Key(password, r.Salt, nodeRegistryScryptN, nodeRegistryScryptR, nodeRegistryScryptP, nodeRegistryKeyLen)
+	if err != nil {
+		return err
+	}
+	var key [nodeRegistryKeyLen]byte
+	copy(key[:], keySlice)
+	r.key = &key
+	if len(r.Nodes) > 0 {
+		_, err = r.open(r.Nodes[0].Token)
+		if err != nil {
+			r.key = nil
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *nodeRegistry) seal(token string) ([]byte, error) {
+	if r.key == nil {
+		return nil, errNodeRegistryLocked
+	}
+	var nonce [nodeRegistryNonceLen]byte
+	_, err := rand.Read(nonce[:])
+	if err != nil {
+		return nil, err
+	}
+	return secretbox.Seal(nonce[:], []byte(token), &nonce, r.key), nil
+}
+
+func (r *nodeRegistry) open(sealed []byte) (string, error) {
+	if r.key == nil {
+		return "", errNodeRegistryLocked
+	}
+	if len(sealed) < nodeRegistryNonceLen {
+		return "", errNodeRegistryPassword
+	}
+	var nonce [nodeRegistryNonceLen]byte
+	copy(nonce[:], sealed)
+	token, ok := secretbox.Open(nil, sealed[nodeRegistryNonceLen:], &nonce, r.key)
+	if !ok {
+		return "", errNodeRegistryPassword
+	}
+	return string(token), nil
+}
+
+// add registers the node called name, reachable at address with the API token. The registry must be unlocked.
+func (r *nodeRegistry) add(name, address, token string) error {
+	if name == "" {
+		return errors.New("the node name cannot be empty")
+	}
+	if _, ok := r.find(name); ok {
+		return fmt.Errorf("node '%s' is already registered", name)
+	}
+	nodeURL, err := parseNodeAddress(address)
+	if err != nil {
+		return err
+	}
+	sealed, err := r.seal(token)
+	if err != nil {
+		return err
+	}
+	r.Nodes = append(r.Nodes, registeredNode{Name: name, Address: nodeURL.String(), Token: sealed})
+	return nil
+}
+
+// remove deletes the node called name from the registry.
+func (r *nodeRegistry) remove(name string) error {
+	i, ok := r.find(name)
+	if !ok {
+		return fmt.Errorf("node '%s' is not registered", name)
+	}
+	r.Nodes = append(r.Nodes[:i], r.Nodes[i+1:]...)
+	return nil
+}
+
+// lookup returns the address and the API token of the node called name. The registry must be unlocked.
+func (r *nodeRegistry) lookup(name string) (address string, token string, err error) {
+	i, ok := r.find(name)
+	if !ok {
+		return "", "", fmt.Errorf("node '%s' is not registered", name)
+	}
+	token, err = r.open(r.Nodes[i].Token)
+	if err != nil {
+		return "", "", err
+	}
+	return r.Nodes[i].Address, token, nil
+}
+
+func (r *nodeRegistry) find(name string) (int, bool) {
+	for i, node := range r.Nodes {
+		if node.Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// parseNodeAddress parses the address of a node REST API, defaulting to http when no scheme is given.
+func parseNodeAddress(address string) (*url.URL, error) {
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		address = "http://" + address
+	}
+	nodeURL, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	if nodeURL.Host == "" {
+		return nil, fmt.Errorf("invalid node address '%s'", address)
+	}
+	return nodeURL, nil
+}
+
+// nodeRegistryInstance caches the registry once loaded, so its password is only asked once.
+var nodeRegistryInstance *nodeRegistry
+
+func ensureNodeRegistry() *nodeRegistry {
+	if nodeRegistryInstance != nil {
+		return nodeRegistryInstance
+	}
+	root, err := config.GetGlobalConfigFileRoot()
+	if err != nil {
+		reportErrorf(errorNodeRegistry, err)
+	}
+	registry, err := loadNodeRegistry(filepath.Join(root, nodeRegistryFilename))
+	if err != nil {
+		reportErrorf(errorNodeRegistry, err)
+	}
+	nodeRegistryInstance = registry
+	return registry
+}
+
+func ensureNodeRegistryUnlocked(registry *nodeRegistry) {
+	if registry.key != nil {
+		return
+	}
+	password := []byte(os.Getenv(nodeRegistryPasswordEnv))
+	if len(password) == 0 {
+		if len(registry.Nodes) == 0 {
+			fmt.Print(infoNodeRegistryNewPasswordPrompt)
+		} else {
+			fmt.Print(infoNodeRegistryPasswordPrompt)
+		}
+		password = ensurePassword()
+	}
+	err := registry.unlock(password)
+	if err != nil {
+		reportErrorf(errorNodeRegistry, err)
+	}
+}
+
+// onNodes runs the action on every node given with --target, or on every data directory otherwise.
+// The node names are passed to the action in place of the data directories, ensureGoalClient resolving them.
+func onNodes(action func(dataDir string)) {
+	if len(targetNodes) == 0 {
+		datadir.OnDataDirs(action)
+		return
+	}
+	doreport := len(targetNodes) > 1
+	for _, name := range targetNodes {
+		if doreport {
+			reportInfof(infoNodeTarget, name)
+		}
+		action(name)
+	}
+}
+
+// ensureSingleNode returns the node given with --target, or the single data directory otherwise.
+func ensureSingleNode() string {
+	if len(targetNodes) == 0 {
+		return datadir.EnsureSingleDataDir()
+	}
+	if len(targetNodes) > 1 {
+		reportErrorln(errorOneNodeTargetSupported)
+	}
+	return targetNodes[0]
+}
+
+// getRemoteGoalClient makes a client talking to the registered node called name.
+func getRemoteGoalClient(name string, clientType libgoal.ClientType) (libgoal.Client, error) {
+	registry := ensureNodeRegistry()
+	ensureNodeRegistryUnlocked(registry)
+	address, token, err := registry.lookup(name)
+	if err != nil {
+		return libgoal.Client{}, err
+	}
+	return libgoal.MakeClientFromConfig(libgoal.ClientConfig{AlgodURL: address, AlgodAPIToken: token}, clientType)
+}
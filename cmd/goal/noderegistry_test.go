@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestNodeRegistry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), nodeRegistryFilename)
+	registry, err := loadNodeRegistry(path)
+	require.NoError(t, err)
+	require.Empty(t, registry.Nodes)
+
+	// the registry must be unlocked to add nodes
+	require.ErrorIs(t, registry.add("relay1", "relay1.example.com:8080", "token1"), errNodeRegistryLocked)
+
+	require.NoError(t, registry.unlock([]byte("secret")))
+	require.NoError(t, registry.add("relay1", "relay1.example.com:8080", "token1"))
+	require.NoError(t, registry.add("relay2", "https://relay2.example.com", "token2"))
+	require.Error(t, registry.add("relay1", "relay1.example.com:8081", "token3"))
+	require.Error(t, registry.add("relay3", "http://", "token3"))
+	require.NoError(t, registry.save())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "token1")
+	require.NotContains(t, string(data), "token2")
+
+	registry, err = loadNodeRegistry(path)
+	require.NoError(t, err)
+	require.Len(t, registry.Nodes, 2)
+	require.Equal(t, "http://relay1.example.com:8080", registry.Nodes[0].Address)
+	require.Equal(t, "https://relay2.example.com", registry.Nodes[1].Address)
+
+	_, _, err = registry.lookup("relay1")
+	require.ErrorIs(t, err, errNodeRegistryLocked)
+	require.ErrorIs(t, registry.unlock([]byte("wrong")), errNodeRegistryPassword)
+	require.NoError(t, registry.unlock([]byte("secret")))
+
+	address, token, err := registry.lookup("relay2")
+	require.NoError(t, err)
+	require.Equal(t, "https://relay2.example.com", address)
+	require.Equal(t, "token2", token)
+	_, _, err = registry.lookup("relay3")
+	require.Error(t, err)
+
+	require.NoError(t, registry.remove("relay1"))
+	require.Error(t, registry.remove("relay1"))
+	require.NoError(t, registry.save())
+
+	registry, err = loadNodeRegistry(path)
+	require.NoError(t, err)
+	require.Len(t, registry.Nodes, 1)
+	require.Equal(t, "relay2", registry.Nodes[0].Name)
+}
+
+func TestParseNodeAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	nodeURL, err := parseNodeAddress("127.0.0.1:8080")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8080", nodeURL.String())
+
+	nodeURL, err = parseNodeAddress("https://node.example.com/algod")
+	require.NoError(t, err)
+	require.Equal(t, "https://node.example.com/algod", nodeURL.String())
+
+	_, err = parseNodeAddress("")
+	require.Error(t, err)
+}
@@ -0,0 +1,338 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+const (
+	// topClearScreen moves the cursor to the top left corner and clears the screen
+	topClearScreen = "\033[H\033[2J"
+	// topRoundWindow is the number of rounds the average round time is taken over
+	topRoundWindow = 10
+	// topLogTailSize is how much of the end of node.log is searched for warnings
+	topLogTailSize = 256 * 1024
+)
+
+// logWarning is a warning or error logged by the node.
+type logWarning struct {
+	Time  string `json:"time"`
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+}
+
+// topSnapshot is what the dashboard shows, as read from the node at one point in time.
+type topSnapshot struct {
+	dataDir string
+	taken   time.Time
+
+	status    model.NodeStatusResponse
+	statusErr error
+	// roundTime is the average time between the rounds seen by the dashboard, zero until it has seen two rounds.
+	roundTime time.Duration
+
+	metrics    map[string]float64
+	metricsErr error
+
+	pool    model.TransactionPoolStatsResponse
+	poolErr error
+
+	partKeys    []model.ParticipationKey
+	partKeysErr error
+
+	warnings    []logWarning
+	warningsErr error
+}
+
+// roundHistory remembers when the last rounds seen by the dashboard were reached.
+type roundHistory struct {
+	rounds []uint64
+	times  []time.Time
+}
+
+// observe records the last round of a status taken at now.
+func (h *roundHistory) observe(status model.NodeStatusResponse, now time.Time) {
+	if len(h.rounds) > 0 && h.rounds[len(h.rounds)-1] == status.LastRound {
+		return
+	}
+	h.rounds = append(h.rounds, status.LastRound)
+	h.times = append(h.times, now.Add(-time.Duration(status.TimeSinceLastRound)))
+	if len(h.rounds) > topRoundWindow+1 {
+		h.rounds = h.rounds[1:]
+		h.times = h.times[1:]
+	}
+}
+
+// averageRoundTime returns the average time between the rounds observed, or zero if fewer than two were.
+func (h *roundHistory) averageRoundTime() time.Duration {
+	n := len(h.rounds)
+	if n < 2 || h.rounds[n-1] <= h.rounds[0] {
+		return 0
+	}
+	return h.times[n-1].Sub(h.times[0]) / time.Duration(h.rounds[n-1]-h.rounds[0])
+}
+
+// runNodeTop shows the dashboard of the node of dataDir, refreshing it until interrupted,
+// or only once when once is set.
+func runNodeTop(dataDir string, refresh time.Duration, once bool) {
+	client := ensureAlgodClient(dataDir)
+	var history roundHistory
+	for {
+		snap := takeTopSnapshot(client, dataDir, &history)
+		var buf bytes.Buffer
+		if !once {
+			buf.WriteString(topClearScreen)
+		}
+		renderTop(&buf, snap)
+		os.Stdout.Write(buf.Bytes())
+		if once {
+			return
+		}
+		time.Sleep(refresh)
+	}
+}
+
+func takeTopSnapshot(client libgoal.Client, dataDir string, history *roundHistory) (snap topSnapshot) {
+	snap.dataDir = dataDir
+	snap.taken = time.Now()
+
+	snap.status, snap.statusErr = client.Status()
+	if snap.statusErr == nil {
+		history.observe(snap.status, snap.taken)
+	}
+	snap.roundTime = history.averageRoundTime()
+
+	var metrics string
+	metrics, snap.metricsErr = client.Metrics()
+	if snap.metricsErr == nil {
+		snap.metrics = parseMetrics(metrics)
+	}
+	snap.pool, snap.poolErr = client.TransactionPoolStats()
+
+	var keys model.ParticipationKeysResponse
+	keys, snap.partKeysErr = client.GetParticipationKeys()
+	snap.partKeys = keys
+
+	snap.warnings, snap.warningsErr = recentLogWarnings(filepath.Join(dataDir, "node.log"), 5)
+	return
+}
+
+// parseMetrics reads the samples of metrics in the Prometheus text format, summing up the samples
+// of a metric with different labels.
+func parseMetrics(text string) map[string]float64 {
+	values := make(map[string]float64)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var name, rest string
+		if open := strings.IndexByte(line, '{'); open >= 0 {
+			closing := strings.LastIndexByte(line, '}')
+			if closing < open {
+				continue
+			}
+			name, rest = line[:open], line[closing+1:]
+		} else {
+			var found bool
+			name, rest, found = strings.Cut(line, " ")
+			if !found {
+				continue
+			}
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		values[name] += value
+	}
+	return values
+}
+
+// recentLogWarnings returns the last count warnings and errors logged in the end of the log file at path.
+func recentLogWarnings(path string, count int) ([]logWarning, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := info.Size() - topLogTailSize
+	if offset < 0 {
+		offset = 0
+	}
+	tail, err := io.ReadAll(io.NewSectionReader(f, offset, info.Size()-offset))
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(tail), "\n")
+	if offset > 0 {
+		// the first line is likely cut
+		lines = lines[1:]
+	}
+
+	var warnings []logWarning
+	for _, line := range lines {
+		var entry logWarning
+		if json.Unmarshal([]byte(line), &entry) != nil {
+			continue
+		}
+		switch entry.Level {
+		case "warning", "error", "fatal", "panic":
+			warnings = append(warnings, entry)
+		}
+	}
+	if len(warnings) > count {
+		warnings = warnings[len(warnings)-count:]
+	}
+	return warnings, nil
+}
+
+func renderTop(w io.Writer, snap topSnapshot) {
+	fmt.Fprintf(w, "Node %s at %s\n", snap.dataDir, snap.taken.Format(time.TimeOnly))
+
+	fmt.Fprintf(w, "\nROUND\n")
+	if snap.statusErr != nil {
+		fmt.Fprintf(w, "  unavailable: %v\n", snap.statusErr)
+	} else {
+		st := snap.status
+		fmt.Fprintf(w, "  Last round:   %d (%.1fs ago)\n", st.LastRound, time.Duration(st.TimeSinceLastRound).Seconds())
+		if snap.roundTime > 0 {
+			fmt.Fprintf(w, "  Round time:   %.2fs on average\n", snap.roundTime.Seconds())
+		}
+		fmt.Fprintf(w, "  Protocol:     %s\n", st.LastVersion)
+		switch {
+		case st.StoppedAtUnsupportedRound:
+			fmt.Fprintf(w, "  Sync:         stopped at unsupported round %d\n", st.LastRound)
+		case st.Catchpoint != nil && *st.Catchpoint != "":
+			fmt.Fprintf(w, "  Sync:         fast catchup to %s\n", *st.Catchpoint)
+		case st.CatchupTime > 0:
+			fmt.Fprintf(w, "  Sync:         catching up for %.1fs\n", time.Duration(st.CatchupTime).Seconds())
+		default:
+			fmt.Fprintf(w, "  Sync:         synced\n")
+		}
+	}
+
+	fmt.Fprintf(w, "\nPEERS\n")
+	if snap.metricsErr != nil {
+		fmt.Fprintf(w, "  unavailable: %v\n", snap.metricsErr)
+	} else {
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "  TOTAL\tINCOMING\tOUTGOING\tPING MIN\tMEAN\tMEDIAN\tMAX\n")
+		m := snap.metrics
+		fmt.Fprintf(tw, "  %.0f\t%.0f\t%.0f\t%s\t%s\t%s\t%s\n",
+			m["algod_network_peers"], m["algod_network_incoming_peers"], m["algod_network_outgoing_peers"],
+			formatPing(m["algod_network_peer_min_ping_seconds"]), formatPing(m["algod_network_peer_mean_ping_seconds"]),
+			formatPing(m["algod_network_peer_median_ping_seconds"]), formatPing(m["algod_network_peer_max_ping_seconds"]))
+		tw.Flush()
+	}
+
+	fmt.Fprintf(w, "\nTRANSACTION POOL\n")
+	if snap.poolErr != nil {
+		fmt.Fprintf(w, "  unavailable: %v\n", snap.poolErr)
+	} else {
+		p := snap.pool
+		fmt.Fprintf(w, "  Pending:      %d transactions in %d groups, of %d\n", p.PendingTransactions, p.PendingGroups, p.MaxTransactions)
+		fmt.Fprintf(w, "  Fee per byte: min %.1f, median %.1f, max %.1f, required %d\n", p.MinFeePerByte, p.MedianFeePerByte, p.MaxFeePerByte, p.FeePerByte)
+		senderLimit := "no sender limit"
+		if p.MaxTransactionsPerSender > 0 {
+			senderLimit = fmt.Sprintf("%d per sender", p.MaxTransactionsPerSender)
+		}
+		ordering := "arrival order"
+		if p.FeePriority {
+			ordering = "fee priority"
+		}
+		fmt.Fprintf(w, "  Policy:       %s, %s eviction, %s\n", ordering, p.EvictionPolicy, senderLimit)
+		fmt.Fprintf(w, "  Dropped:      %d groups evicted, %d rejected by the sender limit\n", p.EvictedGroups, p.SenderLimitRejections)
+	}
+
+	fmt.Fprintf(w, "\nPARTICIPATION\n")
+	switch {
+	case snap.partKeysErr != nil:
+		fmt.Fprintf(w, "  unavailable: %v\n", snap.partKeysErr)
+	case len(snap.partKeys) == 0:
+		fmt.Fprintf(w, "  no participation keys\n")
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "  ADDRESS\tFIRST\tLAST\tSTATUS\tLAST VOTE\tLAST PROPOSAL\n")
+		for _, key := range snap.partKeys {
+			fmt.Fprintf(tw, "  %s\t%d\t%d\t%s\t%s\t%s\n", key.Address, key.Key.VoteFirstValid, key.Key.VoteLastValid,
+				participationStatus(key, snap.status.LastRound), formatOptionalRound(key.LastVote), formatOptionalRound(key.LastBlockProposal))
+		}
+		tw.Flush()
+	}
+
+	fmt.Fprintf(w, "\nRECENT WARNINGS\n")
+	switch {
+	case snap.warningsErr != nil:
+		fmt.Fprintf(w, "  unavailable: %v\n", snap.warningsErr)
+	case len(snap.warnings) == 0:
+		fmt.Fprintf(w, "  none\n")
+	default:
+		for _, warning := range snap.warnings {
+			fmt.Fprintf(w, "  %s %-7s %s\n", warning.Time, warning.Level, warning.Msg)
+		}
+	}
+}
+
+// participationStatus tells whether a participation key is used at round.
+func participationStatus(key model.ParticipationKey, round uint64) string {
+	switch {
+	case key.Key.VoteLastValid < round:
+		return "expired"
+	case key.EffectiveFirstValid == nil || key.EffectiveLastValid == nil:
+		return "not registered"
+	case round < *key.EffectiveFirstValid:
+		return "registered"
+	case round <= *key.EffectiveLastValid:
+		return "active"
+	default:
+		return "expired"
+	}
+}
+
+func formatPing(seconds float64) string {
+	return fmt.Sprintf("%dms", time.Duration(seconds*float64(time.Second)).Milliseconds())
+}
+
+func formatOptionalRound(round *uint64) string {
+	if round == nil {
+		return "-"
+	}
+	return strconv.FormatUint(*round, 10)
+}
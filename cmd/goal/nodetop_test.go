@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	metrics := parseMetrics(`# HELP algod_network_peers Number of active peers.
+# TYPE algod_network_peers gauge
+algod_network_peers 8
+algod_network_incoming_peers{} 3
+algod_network_sent_bytes_total{tag="a b"} 10 1700000000
+algod_network_sent_bytes_total{tag="TX"} 5
+not_a_sample
+bad_value NaN?
+`)
+	require.Equal(t, map[string]float64{
+		"algod_network_peers":            8,
+		"algod_network_incoming_peers":   3,
+		"algod_network_sent_bytes_total": 15,
+	}, metrics)
+}
+
+func TestRecentLogWarnings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var log strings.Builder
+	for i := 0; i < 10; i++ {
+		level := "info"
+		if i%2 == 1 {
+			level = "warning"
+		}
+		fmt.Fprintf(&log, `{"level":%q,"msg":"message %d","time":"2023-01-01T00:00:%02dZ"}`+"\n", level, i, i)
+	}
+	log.WriteString("not json\n")
+	path := filepath.Join(t.TempDir(), "node.log")
+	require.NoError(t, os.WriteFile(path, []byte(log.String()), 0600))
+
+	warnings, err := recentLogWarnings(path, 2)
+	require.NoError(t, err)
+	require.Equal(t, []logWarning{
+		{Time: "2023-01-01T00:00:07Z", Level: "warning", Msg: "message 7"},
+		{Time: "2023-01-01T00:00:09Z", Level: "warning", Msg: "message 9"},
+	}, warnings)
+
+	_, err = recentLogWarnings(filepath.Join(t.TempDir(), "missing.log"), 2)
+	require.Error(t, err)
+}
+
+func TestRoundHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var history roundHistory
+	start := time.Unix(1700000000, 0)
+	history.observe(model.NodeStatusResponse{LastRound: 10}, start)
+	require.Zero(t, history.averageRoundTime())
+
+	// the same round seen again is ignored
+	history.observe(model.NodeStatusResponse{LastRound: 10, TimeSinceLastRound: uint64(time.Second)}, start.Add(time.Second))
+	history.observe(model.NodeStatusResponse{LastRound: 12, TimeSinceLastRound: uint64(time.Second)}, start.Add(7*time.Second))
+	require.Equal(t, 3*time.Second, history.averageRoundTime())
+
+	// only the last rounds are kept
+	for round := uint64(13); round < 13+2*topRoundWindow; round++ {
+		history.observe(model.NodeStatusResponse{LastRound: round}, start.Add(time.Duration(round)*time.Second))
+	}
+	require.Len(t, history.rounds, topRoundWindow+1)
+	require.Equal(t, time.Second, history.averageRoundTime())
+}
+
+func TestRenderTop(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	first, last := uint64(100), uint64(2000)
+	vote := uint64(1234)
+	snap := topSnapshot{
+		dataDir:   "/data",
+		taken:     time.Unix(1700000000, 0),
+		status:    model.NodeStatusResponse{LastRound: 1235, LastVersion: "future", TimeSinceLastRound: uint64(time.Second)},
+		roundTime: 2800 * time.Millisecond,
+		metrics: map[string]float64{
+			"algod_network_peers":                 4,
+			"algod_network_incoming_peers":        1,
+			"algod_network_outgoing_peers":        3,
+			"algod_network_peer_min_ping_seconds": 0.012,
+		},
+		pool: model.TransactionPoolStatsResponse{PendingTransactions: 7, PendingGroups: 5, MaxTransactions: 75000, EvictionPolicy: "fifo"},
+		partKeys: []model.ParticipationKey{{
+			Address:             "ADDRESS",
+			Key:                 model.AccountParticipation{VoteFirstValid: 1, VoteLastValid: 3000},
+			EffectiveFirstValid: &first,
+			EffectiveLastValid:  &last,
+			LastVote:            &vote,
+		}},
+		warningsErr: os.ErrNotExist,
+	}
+
+	var buf bytes.Buffer
+	renderTop(&buf, snap)
+	out := buf.String()
+	require.Contains(t, out, "Last round:   1235 (1.0s ago)")
+	require.Contains(t, out, "Round time:   2.80s on average")
+	require.Contains(t, out, "Sync:         synced")
+	require.Regexp(t, `4\s+1\s+3\s+12ms`, out)
+	require.Contains(t, out, "Pending:      7 transactions in 5 groups, of 75000")
+	require.Regexp(t, `ADDRESS\s+1\s+3000\s+active\s+1234\s+-`, out)
+	require.Contains(t, out, "RECENT WARNINGS\n  unavailable: file does not exist")
+}
+
+func TestParticipationStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	first, last := uint64(100), uint64(200)
+	registered := model.ParticipationKey{
+		Key:                 model.AccountParticipation{VoteFirstValid: 50, VoteLastValid: 300},
+		EffectiveFirstValid: &first,
+		EffectiveLastValid:  &last,
+	}
+	require.Equal(t, "registered", participationStatus(registered, 99))
+	require.Equal(t, "active", participationStatus(registered, 150))
+	require.Equal(t, "expired", participationStatus(registered, 201))
+	require.Equal(t, "expired", participationStatus(registered, 301))
+
+	unregistered := model.ParticipationKey{Key: model.AccountParticipation{VoteFirstValid: 50, VoteLastValid: 300}}
+	require.Equal(t, "not registered", participationStatus(unregistered, 150))
+	require.Equal(t, "expired", participationStatus(unregistered, 301))
+}
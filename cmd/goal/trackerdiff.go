@@ -0,0 +1,302 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// trackerTable describes how to read a tracker database table as a sequence of rows ordered by key.
+// The keys are those of the trackerdb.OrderedTable iterators, which do not depend on node local
+// state (such as rowids), so that the same row has the same key on every node.
+type trackerTable struct {
+	name   string
+	rows   func(ctx context.Context, reader trackerdb.Reader) (trackerdb.KVsIter, error)
+	format func(key []byte) string
+}
+
+var trackerTables = []trackerTable{
+	{
+		name:   "accounts",
+		rows:   orderedTableRows(trackerdb.AccountsTable),
+		format: formatAddressKey,
+	},
+	{
+		name:   "resources",
+		rows:   orderedTableRows(trackerdb.ResourcesTable),
+		format: formatIndexedAddressKey("/"),
+	},
+	{
+		name:   "boxes",
+		rows:   orderedTableRows(trackerdb.KVsTable),
+		format: formatKvKey,
+	},
+	{
+		name:   "online accounts",
+		rows:   orderedTableRows(trackerdb.OnlineAccountsTable),
+		format: formatIndexedAddressKey("@"),
+	},
+	{
+		name:   "online round params",
+		rows:   orderedTableRows(trackerdb.OnlineRoundParamsTable),
+		format: formatRoundKey,
+	},
+	{
+		name:   "totals",
+		rows:   totalsRows,
+		format: func(key []byte) string { return fmt.Sprintf("%q", key) },
+	},
+}
+
+func orderedTableRows(table trackerdb.OrderedTable) func(ctx context.Context, reader trackerdb.Reader) (trackerdb.KVsIter, error) {
+	return func(ctx context.Context, reader trackerdb.Reader) (trackerdb.KVsIter, error) {
+		return reader.MakeOrderedTableIter(ctx, table)
+	}
+}
+
+// totalsRows reads the account totals as a single row.
+func totalsRows(ctx context.Context, reader trackerdb.Reader) (trackerdb.KVsIter, error) {
+	ar, err := reader.MakeAccountsReader()
+	if err != nil {
+		return nil, err
+	}
+	totals, err := ar.AccountsTotals(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &singleRowIter{value: protocol.Encode(&totals)}, nil
+}
+
+// singleRowIter is a trackerdb.KVsIter over a single row with an empty key.
+type singleRowIter struct {
+	value []byte
+	done  bool
+}
+
+func (i *singleRowIter) Next() bool {
+	next := !i.done
+	i.done = true
+	return next
+}
+
+func (i *singleRowIter) KeyValue() (k []byte, v []byte, err error) {
+	return []byte{}, i.value, nil
+}
+
+func (i *singleRowIter) Close() {}
+
+// trackerTableDiff summarizes the differences found in a single tracker table.
+type trackerTableDiff struct {
+	onlyA   int
+	onlyB   int
+	differ  int
+	samples []string
+}
+
+func (d trackerTableDiff) empty() bool {
+	return d.onlyA == 0 && d.onlyB == 0 && d.differ == 0
+}
+
+func formatAddressKey(key []byte) string {
+	var addr basics.Address
+	copy(addr[:], key)
+	return addr.String()
+}
+
+func formatIndexedAddressKey(separator string) func(key []byte) string {
+	return func(key []byte) string {
+		if len(key) < 8 {
+			return base64.StdEncoding.EncodeToString(key)
+		}
+		split := len(key) - 8
+		return fmt.Sprintf("%s%s%d", formatAddressKey(key[:split]), separator, binary.BigEndian.Uint64(key[split:]))
+	}
+}
+
+func formatKvKey(key []byte) string {
+	appIdx, name, err := apps.SplitBoxKey(string(key))
+	if err != nil {
+		return base64.StdEncoding.EncodeToString(key)
+	}
+	return fmt.Sprintf("box(%d, %s)", appIdx, base64.StdEncoding.EncodeToString([]byte(name)))
+}
+
+func formatRoundKey(key []byte) string {
+	return fmt.Sprintf("round %d", binary.BigEndian.Uint64(key))
+}
+
+// trackerDatabasePath returns the tracker database for the given path, which is either a node data
+// directory or the tracker database itself. The database of a data directory is looked up where the
+// node keeps it, honoring the TrackerDBDir and StorageEngine settings of the node configuration.
+func trackerDatabasePath(path string) (string, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	_, err = os.Stat(filepath.Join(path, config.GenesisJSONFile))
+	if err != nil {
+		return path, nil
+	}
+	cfg, err := config.LoadConfigFromDisk(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	genesis, err := readGenesis(path)
+	if err != nil {
+		return "", err
+	}
+	trackerPath, err := ledger.TrackerDBPath(filepath.Join(path, genesis.ID(), config.LedgerFilenamePrefix), genesis.ID(), cfg)
+	if err != nil {
+		return "", err
+	}
+	_, err = os.Stat(trackerPath)
+	if err != nil {
+		return "", err
+	}
+	return trackerPath, nil
+}
+
+func trackerDatabaseRound(reader trackerdb.Reader) (rnd basics.Round, err error) {
+	ar, err := reader.MakeAccountsReader()
+	if err != nil {
+		return 0, err
+	}
+	return ar.AccountsRound()
+}
+
+// diffTrackerTable walks the rows of a table in both databases in key order, counting the rows
+// found in only one of the databases and the rows whose values differ. At most limit divergent
+// keys are formatted into samples.
+func diffTrackerTable(ctx context.Context, dbA, dbB trackerdb.Reader, table trackerTable, limit int) (diff trackerTableDiff, err error) {
+	rowsA, err := table.rows(ctx, dbA)
+	if err != nil {
+		return diff, err
+	}
+	defer rowsA.Close()
+	rowsB, err := table.rows(ctx, dbB)
+	if err != nil {
+		return diff, err
+	}
+	defer rowsB.Close()
+
+	next := func(rows trackerdb.KVsIter) (key, value []byte, ok bool, err error) {
+		if !rows.Next() {
+			return nil, nil, false, nil
+		}
+		key, value, err = rows.KeyValue()
+		return key, value, err == nil, err
+	}
+	sample := func(kind string, key []byte) {
+		if len(diff.samples) < limit {
+			diff.samples = append(diff.samples, fmt.Sprintf("%s: %s", kind, table.format(key)))
+		}
+	}
+
+	keyA, valueA, okA, err := next(rowsA)
+	if err != nil {
+		return diff, err
+	}
+	keyB, valueB, okB, err := next(rowsB)
+	if err != nil {
+		return diff, err
+	}
+	for okA || okB {
+		cmp := 0
+		if !okB {
+			cmp = -1
+		} else if !okA {
+			cmp = 1
+		} else {
+			cmp = bytes.Compare(keyA, keyB)
+		}
+
+		switch {
+		case cmp < 0:
+			diff.onlyA++
+			sample("only in first", keyA)
+		case cmp > 0:
+			diff.onlyB++
+			sample("only in second", keyB)
+		case !bytes.Equal(valueA, valueB):
+			diff.differ++
+			sample("differs", keyA)
+		}
+
+		if cmp <= 0 {
+			keyA, valueA, okA, err = next(rowsA)
+			if err != nil {
+				return diff, err
+			}
+		}
+		if cmp >= 0 {
+			keyB, valueB, okB, err = next(rowsB)
+			if err != nil {
+				return diff, err
+			}
+		}
+	}
+	return diff, nil
+}
+
+// diffTrackers compares the tables of two tracker databases which are at the same round, and
+// writes a report of the divergent tables to out. It returns whether any differences were found.
+func diffTrackers(dbA, dbB trackerdb.Reader, limit int, out io.Writer) (bool, error) {
+	differ := false
+	for _, table := range trackerTables {
+		diff, err := diffTrackerTable(context.Background(), dbA, dbB, table, limit)
+		if err != nil {
+			return differ, fmt.Errorf("%s: %w", table.name, err)
+		}
+		if diff.empty() {
+			fmt.Fprintf(out, "%s: match\n", table.name)
+			continue
+		}
+		differ = true
+		fmt.Fprintf(out, "%s: %d only in first, %d only in second, %d differ\n", table.name, diff.onlyA, diff.onlyB, diff.differ)
+		for _, sample := range diff.samples {
+			fmt.Fprintf(out, "  %s\n", sample)
+		}
+	}
+	return differ, nil
+}
+
+func openTrackerDatabase(path string) trackerdb.Store {
+	trackerPath, err := trackerDatabasePath(path)
+	if err != nil {
+		reportErrorf(errTrackerDatabase, path, err)
+	}
+	store, err := ledger.OpenExistingTrackerDB(trackerPath, log)
+	if err != nil {
+		reportErrorf(errTrackerDatabase, path, err)
+	}
+	return store
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// trackerDiffTestState is the state written into the tracker databases of the tests.
+type trackerDiffTestState struct {
+	accounts  []basics.Address // in insertion order, so that the rowids can differ
+	data      map[basics.Address]uint64
+	resources map[basics.Address][]basics.CreatableIndex
+	kvs       map[string][]byte
+	online    map[basics.Address][]basics.Round
+	totals    uint64
+}
+
+func makeTrackerDiffTestState() trackerDiffTestState {
+	var addr1, addr2 basics.Address
+	addr1[0], addr2[0] = 1, 2
+	return trackerDiffTestState{
+		accounts:  []basics.Address{addr1, addr2},
+		data:      map[basics.Address]uint64{addr1: 100, addr2: 200},
+		resources: map[basics.Address][]basics.CreatableIndex{addr1: {5}},
+		kvs:       map[string][]byte{"bx:key": {1}},
+		online:    map[basics.Address][]basics.Round{},
+		totals:    1,
+	}
+}
+
+// makeTrackerDiffTestDB writes state at round 10 into a new tracker database kept in the given
+// storage engine at path. The caller closes it.
+func makeTrackerDiffTestDB(t *testing.T, engine string, path string, state trackerDiffTestState) trackerdb.Store {
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	var store trackerdb.Store
+	var err error
+	switch engine {
+	case ledger.StorageEnginePebbleDB:
+		store, err = pebbledbdriver.Open(strings.TrimSuffix(path, ".pebbledb"), false, proto, logging.TestingLog(t))
+	default:
+		store, err = sqlitedriver.Open(path, false, logging.TestingLog(t))
+	}
+	require.NoError(t, err)
+	_, err = store.RunMigrations(context.Background(), trackerdb.Params{InitProto: protocol.ConsensusCurrentVersion}, logging.TestingLog(t), trackerdb.AccountDBVersion)
+	require.NoError(t, err)
+
+	aw, err := store.MakeAccountsOptimizedWriter(true, true, true, false)
+	require.NoError(t, err)
+	defer aw.Close()
+	for _, addr := range state.accounts {
+		data := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: state.data[addr]}}
+		ref, err := aw.InsertAccount(addr, data.NormalizedOnlineBalance(proto), data)
+		require.NoError(t, err)
+		for _, aidx := range state.resources[addr] {
+			_, err = aw.InsertResource(ref, aidx, trackerdb.MakeResourcesData(0))
+			require.NoError(t, err)
+		}
+	}
+	for key, value := range state.kvs {
+		require.NoError(t, aw.UpsertKvPair(key, value))
+	}
+
+	oaw, err := store.MakeOnlineAccountsOptimizedWriter(true)
+	require.NoError(t, err)
+	defer oaw.Close()
+	for addr, rounds := range state.online {
+		data := trackerdb.BaseOnlineAccountData{MicroAlgos: basics.MicroAlgos{Raw: 1}}
+		for _, rnd := range rounds {
+			_, err = oaw.InsertOnlineAccount(addr, data.NormalizedOnlineBalance(proto), data, uint64(rnd), 0)
+			require.NoError(t, err)
+		}
+	}
+
+	w, err := store.MakeAccountsWriter()
+	require.NoError(t, err)
+	require.NoError(t, w.AccountsPutTotals(ledgercore.AccountTotals{Online: ledgercore.AlgoCount{Money: basics.MicroAlgos{Raw: state.totals}}}, false))
+	require.NoError(t, w.UpdateAccountsRound(10))
+	return store
+}
+
+func trackerDiffTestPath(t *testing.T, engine string) string {
+	if engine == ledger.StorageEnginePebbleDB {
+		return filepath.Join(t.TempDir(), "tracker.pebble.pebbledb")
+	}
+	return filepath.Join(t.TempDir(), "ledger.tracker.sqlite")
+}
+
+func TestDiffTrackers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	engines := []string{ledger.StorageEngineSQLite, ledger.StorageEnginePebbleDB}
+	for _, engineA := range engines {
+		for _, engineB := range engines {
+			engineA, engineB := engineA, engineB
+			t.Run(engineA+"-"+engineB, func(t *testing.T) {
+				testDiffTrackers(t, engineA, engineB)
+			})
+		}
+	}
+}
+
+func testDiffTrackers(t *testing.T, engineA, engineB string) {
+	makeDB := func(engine string, state trackerDiffTestState) trackerdb.Store {
+		store := makeTrackerDiffTestDB(t, engine, trackerDiffTestPath(t, engine), state)
+		t.Cleanup(store.Close)
+		return store
+	}
+	addr1, addr2 := makeTrackerDiffTestState().accounts[0], makeTrackerDiffTestState().accounts[1]
+	var addr3 basics.Address
+	addr3[0] = 3
+
+	t.Run("match", func(t *testing.T) {
+		dbA := makeDB(engineA, makeTrackerDiffTestState())
+		// resources are matched by address, not by the local rowid of the account
+		stateB := makeTrackerDiffTestState()
+		stateB.accounts = []basics.Address{addr2, addr1}
+		dbB := makeDB(engineB, stateB)
+
+		var out strings.Builder
+		differ, err := diffTrackers(dbA, dbB, 10, &out)
+		require.NoError(t, err)
+		require.False(t, differ, out.String())
+
+		rnd, err := trackerDatabaseRound(dbB)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(10), rnd)
+	})
+
+	t.Run("differ", func(t *testing.T) {
+		stateA := makeTrackerDiffTestState()
+		stateA.online[addr3] = []basics.Round{4}
+		dbA := makeDB(engineA, stateA)
+
+		stateB := makeTrackerDiffTestState()
+		stateB.accounts = stateB.accounts[:1]
+		stateB.data[addr1] = 101
+		stateB.totals = 100
+		stateB.kvs["bx:other"] = []byte{1}
+		stateB.online[addr3] = []basics.Round{5}
+		dbB := makeDB(engineB, stateB)
+
+		var out strings.Builder
+		differ, err := diffTrackers(dbA, dbB, 10, &out)
+		require.NoError(t, err)
+		require.True(t, differ)
+
+		report := out.String()
+		require.Contains(t, report, "accounts: 1 only in first, 0 only in second, 1 differ")
+		require.Contains(t, report, "differs: "+addr1.String())
+		require.Contains(t, report, "only in first: "+addr2.String())
+		require.Contains(t, report, "boxes: 0 only in first, 1 only in second, 0 differ")
+		require.Contains(t, report, "only in first: "+addr3.String()+"@4")
+		require.Contains(t, report, "only in second: "+addr3.String()+"@5")
+		require.Contains(t, report, "totals: 0 only in first, 0 only in second, 1 differ")
+		require.Contains(t, report, "resources: match")
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		dbA := makeDB(engineA, makeTrackerDiffTestState())
+		dbB := makeDB(engineB, trackerDiffTestState{})
+
+		var out strings.Builder
+		differ, err := diffTrackers(dbA, dbB, 1, &out)
+		require.NoError(t, err)
+		require.True(t, differ)
+		// the limit applies to each table
+		require.Equal(t, 1, strings.Count(out.String(), "only in first: "+addr1.String()+"\n")+strings.Count(out.String(), "only in first: "+addr2.String()+"\n"))
+		require.Contains(t, out.String(), "accounts: 2 only in first, 0 only in second, 0 differ")
+		require.Contains(t, out.String(), "boxes: 1 only in first, 0 only in second, 0 differ")
+	})
+}
+
+func TestTrackerDatabasePath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	trackerDir := t.TempDir()
+	genesis := bookkeeping.Genesis{SchemaID: "v1", Network: "testdiff", Proto: protocol.ConsensusCurrentVersion}
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, config.GenesisJSONFile), protocol.EncodeJSON(genesis), 0644))
+	cfg := config.GetDefaultLocal()
+	cfg.TrackerDBDir = trackerDir
+	cfg.StorageEngine = ledger.StorageEnginePebbleDB
+	require.NoError(t, cfg.SaveToDisk(dataDir))
+
+	// the node keeps the database in the configured directory and storage engine
+	_, err := trackerDatabasePath(dataDir)
+	require.Error(t, err)
+	expected := filepath.Join(trackerDir, genesis.ID(), config.LedgerFilenamePrefix, "tracker.pebble.pebbledb")
+	require.NoError(t, os.MkdirAll(filepath.Dir(expected), 0700))
+	makeTrackerDiffTestDB(t, ledger.StorageEnginePebbleDB, expected, makeTrackerDiffTestState()).Close()
+
+	path, err := trackerDatabasePath(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, expected, path)
+	path, err = trackerDatabasePath(expected)
+	require.NoError(t, err)
+	require.Equal(t, expected, path)
+
+	store, err := ledger.OpenExistingTrackerDB(path, logging.TestingLog(t))
+	require.NoError(t, err)
+	defer store.Close()
+	rnd, err := trackerDatabaseRound(store)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), rnd)
+
+	// other directories are not opened as empty stores
+	_, err = ledger.OpenExistingTrackerDB(trackerDir, logging.TestingLog(t))
+	require.Error(t, err)
+}
@@ -74,6 +74,11 @@ func (m *MockParticipationRegistry) GetStateProofSecretsForRound(id account.Part
 	return account.StateProofSecretsForRound{}, nil
 }
 
+// Export fetches a record with all of its secrets, including the remaining state proof keys.
+func (m *MockParticipationRegistry) Export(id account.ParticipationID) (account.ParticipationExport, error) {
+	return account.ParticipationExport{}, nil
+}
+
 // HasLiveKeys quickly tests to see if there is a valid participation key over some range of rounds
 func (m *MockParticipationRegistry) HasLiveKeys(from, to basics.Round) bool {
 	return false
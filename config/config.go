@@ -72,6 +72,10 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// PersistedMetricsFilename is the name of the file the persisted node metrics are kept in.
+// It is used when EnableMetricsPersistence is set.
+const PersistedMetricsFilename = "metrics.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus protocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
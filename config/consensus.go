@@ -17,7 +17,9 @@
 package config
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -516,6 +518,96 @@ type ConsensusParams struct {
 	// used by agreement for Circulation, and updates the calculation of StateProofOnlineTotalWeight used
 	// by state proofs to use the same method (rather than excluding stake from the top N stakeholders as before).
 	ExcludeExpiredCirculation bool
+
+	// DevnetOracleAppID, when non-zero, reserves an application ID whose global state is served
+	// from DevnetOracleValues instead of the ledger, so that contracts reading an oracle with
+	// app_global_get_ex can be tested without deploying a mock oracle app. No application can be
+	// created with this ID, and reading the oracle fails if an application had been created with
+	// it before the protocol set it up. None of the built-in protocols set it; devnets enable it
+	// through a consensus.json override, which nodes refuse to start with unless they opt in with
+	// EnableDevnetOracle, and always refuse on mainnet, testnet and betanet.
+	DevnetOracleAppID uint64
+
+	// DevnetOracleValues holds the global state of the DevnetOracleAppID application.
+	DevnetOracleValues map[string]DevnetOracleValue
+
+	// ClearedFeatures are the experimental node features cleared for the networks running this protocol version.
+	// They are not part of the consensus, and only tell nodes which features are known to work on such networks.
+	ClearedFeatures map[Feature]bool
+}
+
+// publicNetworkGenesisHashes are the genesis hashes of mainnet, testnet and betanet, whose blocks never serve
+// the devnet oracle application.
+var publicNetworkGenesisHashes = map[[32]byte]protocol.NetworkID{
+	mustDecodeGenesisHash("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8="): Mainnet,
+	mustDecodeGenesisHash("SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI="): Testnet,
+	mustDecodeGenesisHash("mFgazF+2uRS1tMiL9dsj01hJGySEmPN28B/TjjvpVW0="): Betanet,
+}
+
+func mustDecodeGenesisHash(b64 string) (hash [32]byte) {
+	data, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil || len(data) != len(hash) {
+		panic(fmt.Sprintf("invalid genesis hash %s", b64))
+	}
+	copy(hash[:], data)
+	return
+}
+
+// DevnetOracleAllowed tells whether the blocks of the given genesis may serve the devnet oracle
+// application, which those of mainnet, testnet and betanet never do. Nodes of the other networks
+// additionally need the EnableDevnetOracle opt-in, see CheckDevnetOracle.
+func DevnetOracleAllowed(genesisHash [32]byte) bool {
+	_, public := publicNetworkGenesisHashes[genesisHash]
+	return !public
+}
+
+// CheckDevnetOracle returns an error when a protocol sets up the devnet oracle application while
+// the node did not opt in with EnableDevnetOracle, or while the genesis is the one of mainnet,
+// testnet or betanet. It also returns an error when one of the oracle values has no valid type.
+func (cp ConsensusProtocols) CheckDevnetOracle(genesisHash [32]byte, enabled bool) error {
+	for consensusVersion, consensusParams := range cp {
+		if consensusParams.DevnetOracleAppID == 0 {
+			continue
+		}
+		if network, public := publicNetworkGenesisHashes[genesisHash]; public {
+			return fmt.Errorf("consensus protocol %s sets DevnetOracleAppID, which is never allowed on %s", consensusVersion, network)
+		}
+		if !enabled {
+			return fmt.Errorf("consensus protocol %s sets DevnetOracleAppID, which requires EnableDevnetOracle to be set", consensusVersion)
+		}
+		for key, value := range consensusParams.DevnetOracleValues {
+			switch value.Type {
+			case DevnetOracleBytes:
+				if value.Uint != 0 {
+					return fmt.Errorf("consensus protocol %s sets the Uint of the devnet oracle value %q, whose type is %s", consensusVersion, key, value.Type)
+				}
+			case DevnetOracleUint:
+				if value.Bytes != "" {
+					return fmt.Errorf("consensus protocol %s sets the Bytes of the devnet oracle value %q, whose type is %s", consensusVersion, key, value.Type)
+				}
+			default:
+				return fmt.Errorf("consensus protocol %s sets the devnet oracle value %q with type %q; the types are %q and %q", consensusVersion, key, value.Type, DevnetOracleBytes, DevnetOracleUint)
+			}
+		}
+	}
+	return nil
+}
+
+// The types of the global state values of the devnet oracle application.
+const (
+	// DevnetOracleBytes is the type of the byte-slice values, held in DevnetOracleValue.Bytes.
+	DevnetOracleBytes = "bytes"
+	// DevnetOracleUint is the type of the integer values, held in DevnetOracleValue.Uint.
+	DevnetOracleUint = "uint"
+)
+
+// DevnetOracleValue is a global state value of the devnet oracle application.
+type DevnetOracleValue struct {
+	// Type is DevnetOracleBytes or DevnetOracleUint. It is explicit so that empty byte slices and zeros
+	// can both be served.
+	Type  string
+	Bytes string `json:",omitempty"`
+	Uint  uint64 `json:",omitempty"`
 }
 
 // PaysetCommitType enumerates possible ways for the block header to commit to
@@ -711,6 +803,20 @@ func (cp ConsensusProtocols) DeepCopy() ConsensusProtocols {
 			}
 			consensusParams.ApprovedUpgrades = newApprovedUpgrades
 		}
+		if consensusParams.DevnetOracleValues != nil {
+			newOracleValues := make(map[string]DevnetOracleValue, len(consensusParams.DevnetOracleValues))
+			for key, value := range consensusParams.DevnetOracleValues {
+				newOracleValues[key] = value
+			}
+			consensusParams.DevnetOracleValues = newOracleValues
+		}
+		if consensusParams.ClearedFeatures != nil {
+			newClearedFeatures := make(map[Feature]bool, len(consensusParams.ClearedFeatures))
+			for name, cleared := range consensusParams.ClearedFeatures {
+				newClearedFeatures[name] = cleared
+			}
+			consensusParams.ClearedFeatures = newClearedFeatures
+		}
 		staticConsensus[consensusVersion] = consensusParams
 	}
 	return staticConsensus
@@ -1347,6 +1453,12 @@ func initConsensusProtocols() {
 
 	v38.AgreementFilterTimeoutPeriod0 = 3000 * time.Millisecond
 
+	v38.ClearedFeatures = map[Feature]bool{
+		FeatureTxnEvalTracer:        true,
+		FeatureAppEventIndex:        true,
+		FeatureTxPoolEvictionPolicy: true,
+	}
+
 	Consensus[protocol.ConsensusV38] = v38
 
 	// v37 can be upgraded to v38, with an update delay of 12h:
@@ -1362,6 +1474,11 @@ func initConsensusProtocols() {
 	vFuture.LogicSigVersion = 10 // When moving this to a release, put a new higher LogicSigVersion here
 	vFuture.EnableLogicSigCostPooling = true
 
+	vFuture.ClearedFeatures = map[Feature]bool{FeatureP2PNetwork: true}
+	for name := range v38.ClearedFeatures {
+		vFuture.ClearedFeatures[name] = true
+	}
+
 	Consensus[protocol.ConsensusFuture] = vFuture
 
 	// vAlphaX versions are an separate series of consensus parameters and versions for alphanet
@@ -19,6 +19,7 @@ package config
 import (
 	"testing"
 
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -69,3 +70,43 @@ func TestConsensusStateProofParams(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckDevnetOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mainnet := mustDecodeGenesisHash("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	testnet := mustDecodeGenesisHash("SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=")
+	betanet := mustDecodeGenesisHash("mFgazF+2uRS1tMiL9dsj01hJGySEmPN28B/TjjvpVW0=")
+	devnet := mustDecodeGenesisHash("sC3P7e2SdbqKJK0tbiCdK9tdSpbe6XeCGKdoNzmlj0E=")
+
+	require.NoError(t, Consensus.CheckDevnetOracle(mainnet, false))
+	require.NoError(t, Consensus.CheckDevnetOracle(mainnet, true))
+
+	protocols := Consensus.DeepCopy()
+	params := protocols[protocol.ConsensusFuture]
+	params.DevnetOracleAppID = 5000
+	protocols[protocol.ConsensusFuture] = params
+
+	require.NoError(t, protocols.CheckDevnetOracle(devnet, true))
+	require.NoError(t, protocols.CheckDevnetOracle([32]byte{1}, true))
+	require.ErrorContains(t, protocols.CheckDevnetOracle(devnet, false), "EnableDevnetOracle")
+	for _, hash := range [][32]byte{mainnet, testnet, betanet} {
+		require.ErrorContains(t, protocols.CheckDevnetOracle(hash, true), "never allowed")
+		require.ErrorContains(t, protocols.CheckDevnetOracle(hash, false), "never allowed")
+		require.False(t, DevnetOracleAllowed(hash))
+	}
+	require.True(t, DevnetOracleAllowed(devnet))
+
+	// the values must have a type, and only the field of that type
+	params.DevnetOracleValues = map[string]DevnetOracleValue{
+		"empty": {Type: DevnetOracleBytes},
+		"zero":  {Type: DevnetOracleUint},
+	}
+	protocols[protocol.ConsensusFuture] = params
+	require.NoError(t, protocols.CheckDevnetOracle(devnet, true))
+	for _, value := range []DevnetOracleValue{{Bytes: "ALGO/USD"}, {Type: "string"}, {Type: DevnetOracleBytes, Uint: 1}, {Type: DevnetOracleUint, Bytes: "1"}} {
+		params.DevnetOracleValues = map[string]DevnetOracleValue{"price": value}
+		protocols[protocol.ConsensusFuture] = params
+		require.ErrorContains(t, protocols.CheckDevnetOracle(devnet, true), `devnet oracle value "price"`, value)
+	}
+}
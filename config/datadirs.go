@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/algorand/go-algorand/util"
+)
+
+// catchpointDirName is the directory the catchpoint files are kept in, as named by trackerdb.CatchpointDirName.
+const catchpointDirName = "catchpoints"
+
+// ResolvedGenesisDirs holds the directories the files of a network are kept in, once the directories set in
+// the configuration are resolved for the network.
+type ResolvedGenesisDirs struct {
+	// RootGenesisDir is the directory of the network in the data directory. The files which have no
+	// directory configured for them are kept in it.
+	RootGenesisDir          string
+	TrackerGenesisDir       string
+	BlockGenesisDir         string
+	CatchpointGenesisDir    string
+	ParticipationGenesisDir string
+}
+
+// Relocation is the move of a file, or directory, of a network from the data directory to the directory
+// configured for it.
+type Relocation struct {
+	From string
+	To   string
+}
+
+// ResolveGenesisDirs returns the directories the files of the network genesisID are kept in, rootGenesisDir
+// being the directory of the network in the data directory.
+func (cfg Local) ResolveGenesisDirs(rootGenesisDir string, genesisID string) ResolvedGenesisDirs {
+	resolve := func(dir string) string {
+		if dir == "" {
+			return rootGenesisDir
+		}
+		return filepath.Join(dir, genesisID)
+	}
+	return ResolvedGenesisDirs{
+		RootGenesisDir:          rootGenesisDir,
+		TrackerGenesisDir:       resolve(cfg.TrackerDBDir),
+		BlockGenesisDir:         resolve(cfg.BlockDBDir),
+		CatchpointGenesisDir:    resolve(cfg.CatchpointDir),
+		ParticipationGenesisDir: resolve(cfg.ParticipationDir),
+	}
+}
+
+// EnsureGenesisDirs validates the directories set in the configuration and creates the directories of the
+// network genesisID in them. The configured directories must already exist: a volume which isn't mounted
+// must not be replaced silently by a directory of the root volume.
+func (cfg Local) EnsureGenesisDirs(rootGenesisDir string, genesisID string) (ResolvedGenesisDirs, error) {
+	configured := []struct {
+		name string
+		dir  string
+	}{
+		{"TrackerDBDir", cfg.TrackerDBDir},
+		{"BlockDBDir", cfg.BlockDBDir},
+		{"CatchpointDir", cfg.CatchpointDir},
+		{"ParticipationDir", cfg.ParticipationDir},
+	}
+	for _, c := range configured {
+		if c.dir == "" {
+			continue
+		}
+		if !filepath.IsAbs(c.dir) {
+			return ResolvedGenesisDirs{}, fmt.Errorf("%s must be an absolute path, got %q", c.name, c.dir)
+		}
+		info, err := os.Stat(c.dir)
+		if err != nil {
+			return ResolvedGenesisDirs{}, fmt.Errorf("%s cannot be used: %w", c.name, err)
+		}
+		if !info.IsDir() {
+			return ResolvedGenesisDirs{}, fmt.Errorf("%s %s is not a directory", c.name, c.dir)
+		}
+	}
+
+	dirs := cfg.ResolveGenesisDirs(rootGenesisDir, genesisID)
+	for _, dir := range []string{dirs.RootGenesisDir, dirs.TrackerGenesisDir, dirs.BlockGenesisDir, dirs.CatchpointGenesisDir, dirs.ParticipationGenesisDir} {
+		err := os.MkdirAll(dir, 0700)
+		if err != nil {
+			return ResolvedGenesisDirs{}, err
+		}
+	}
+	return dirs, nil
+}
+
+// PendingRelocations returns the files of the network which are still in the data directory although a
+// directory is configured for them. It fails if a file is found in both places, since either copy could be
+// the one in use.
+func (dirs ResolvedGenesisDirs) PendingRelocations() ([]Relocation, error) {
+	sqlite := func(name string) []string {
+		return []string{name, name + "-shm", name + "-wal"}
+	}
+	placed := []struct {
+		dir   string
+		names []string
+	}{
+		// the pebble storage engine keeps the tracker database in a directory named after the ledger
+		{dirs.TrackerGenesisDir, append(sqlite(LedgerFilenamePrefix+".tracker.sqlite"), LedgerFilenamePrefix)},
+		{dirs.BlockGenesisDir, sqlite(LedgerFilenamePrefix + ".block.sqlite")},
+		{dirs.CatchpointGenesisDir, []string{catchpointDirName}},
+		{dirs.ParticipationGenesisDir, sqlite(ParticipationRegistryFilename)},
+	}
+
+	var relocations []Relocation
+	for _, p := range placed {
+		if p.dir == dirs.RootGenesisDir {
+			continue
+		}
+		for _, name := range p.names {
+			r := Relocation{From: filepath.Join(dirs.RootGenesisDir, name), To: filepath.Join(p.dir, name)}
+			if !util.FileExists(r.From) {
+				continue
+			}
+			if util.FileExists(r.To) {
+				return nil, fmt.Errorf("%s is found both in %s and in %s; remove the one which is not in use", name, dirs.RootGenesisDir, p.dir)
+			}
+			relocations = append(relocations, r)
+		}
+	}
+	return relocations, nil
+}
+
+// Apply moves the file, copying it when it is moved to another volume. The node must not be running.
+func (r Relocation) Apply() error {
+	err := os.Rename(r.From, r.To)
+	if !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	info, err := os.Stat(r.From)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		err = util.CopyFolder(r.From, r.To)
+	} else {
+		_, err = util.CopyFile(r.From, r.To)
+		if err == nil {
+			err = os.Chmod(r.To, info.Mode().Perm())
+		}
+	}
+	if err != nil {
+		os.RemoveAll(r.To)
+		return fmt.Errorf("cannot copy %s to %s: %w", r.From, r.To, err)
+	}
+	return os.RemoveAll(r.From)
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestResolveGenesisDirs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := GetDefaultLocal()
+	dirs := cfg.ResolveGenesisDirs("/data/mainnet-v1.0", "mainnet-v1.0")
+	require.Equal(t, ResolvedGenesisDirs{
+		RootGenesisDir:          "/data/mainnet-v1.0",
+		TrackerGenesisDir:       "/data/mainnet-v1.0",
+		BlockGenesisDir:         "/data/mainnet-v1.0",
+		CatchpointGenesisDir:    "/data/mainnet-v1.0",
+		ParticipationGenesisDir: "/data/mainnet-v1.0",
+	}, dirs)
+
+	cfg.TrackerDBDir = "/hot"
+	cfg.BlockDBDir = "/cold"
+	dirs = cfg.ResolveGenesisDirs("/data/mainnet-v1.0", "mainnet-v1.0")
+	require.Equal(t, "/hot/mainnet-v1.0", dirs.TrackerGenesisDir)
+	require.Equal(t, "/cold/mainnet-v1.0", dirs.BlockGenesisDir)
+	require.Equal(t, "/data/mainnet-v1.0", dirs.CatchpointGenesisDir)
+}
+
+func TestEnsureGenesisDirs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "net")
+	cfg := GetDefaultLocal()
+
+	cfg.BlockDBDir = "relative"
+	_, err := cfg.EnsureGenesisDirs(root, "net")
+	require.ErrorContains(t, err, "BlockDBDir must be an absolute path")
+
+	// a directory which doesn't exist, e.g. an unmounted volume, is not created
+	cfg.BlockDBDir = filepath.Join(t.TempDir(), "unmounted")
+	_, err = cfg.EnsureGenesisDirs(root, "net")
+	require.ErrorContains(t, err, "BlockDBDir cannot be used")
+	require.NoDirExists(t, cfg.BlockDBDir)
+
+	cfg.BlockDBDir = t.TempDir()
+	dirs, err := cfg.EnsureGenesisDirs(root, "net")
+	require.NoError(t, err)
+	require.DirExists(t, dirs.RootGenesisDir)
+	require.DirExists(t, filepath.Join(cfg.BlockDBDir, "net"))
+	require.Equal(t, filepath.Join(cfg.BlockDBDir, "net"), dirs.BlockGenesisDir)
+}
+
+func TestPendingRelocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "net")
+	cfg := GetDefaultLocal()
+	dirs, err := cfg.EnsureGenesisDirs(root, "net")
+	require.NoError(t, err)
+
+	write := func(path string) {
+		require.NoError(t, os.WriteFile(path, []byte(filepath.Base(path)), 0600))
+	}
+	write(filepath.Join(root, "ledger.block.sqlite"))
+	write(filepath.Join(root, "ledger.tracker.sqlite"))
+	require.NoError(t, os.Mkdir(filepath.Join(root, catchpointDirName), 0700))
+	write(filepath.Join(root, catchpointDirName, "1.catchpoint"))
+
+	// nothing is to be moved while no directory is configured
+	relocations, err := dirs.PendingRelocations()
+	require.NoError(t, err)
+	require.Empty(t, relocations)
+
+	cfg.BlockDBDir = t.TempDir()
+	cfg.CatchpointDir = t.TempDir()
+	dirs, err = cfg.EnsureGenesisDirs(root, "net")
+	require.NoError(t, err)
+	relocations, err = dirs.PendingRelocations()
+	require.NoError(t, err)
+	require.Equal(t, []Relocation{
+		{From: filepath.Join(root, "ledger.block.sqlite"), To: filepath.Join(dirs.BlockGenesisDir, "ledger.block.sqlite")},
+		{From: filepath.Join(root, catchpointDirName), To: filepath.Join(dirs.CatchpointGenesisDir, catchpointDirName)},
+	}, relocations)
+
+	for _, r := range relocations {
+		require.NoError(t, r.Apply())
+		require.NoFileExists(t, r.From)
+		require.NoDirExists(t, r.From)
+	}
+	require.FileExists(t, filepath.Join(dirs.BlockGenesisDir, "ledger.block.sqlite"))
+	require.FileExists(t, filepath.Join(dirs.CatchpointGenesisDir, catchpointDirName, "1.catchpoint"))
+	relocations, err = dirs.PendingRelocations()
+	require.NoError(t, err)
+	require.Empty(t, relocations)
+
+	// a file found in both places can't be moved
+	write(filepath.Join(root, "ledger.block.sqlite"))
+	_, err = dirs.PendingRelocations()
+	require.ErrorContains(t, err, "ledger.block.sqlite is found both in")
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Feature names an experimental subsystem of the node. Experimental subsystems are requested through the Local
+// configuration, and are cleared for the consensus protocol versions, the networks and the builds of the release
+// channels on which they are known to work. The features requested where they aren't cleared are still turned on,
+// with a warning.
+type Feature string
+
+const (
+	// FeatureP2PNetwork is the libp2p based gossip network.
+	FeatureP2PNetwork Feature = "p2p-network"
+	// FeatureTxnEvalTracer is the tracer collecting the state deltas of the transactions.
+	FeatureTxnEvalTracer Feature = "txn-eval-tracer"
+	// FeatureAppEventIndex is the ledger index of the application events.
+	FeatureAppEventIndex Feature = "app-event-index"
+	// FeatureTxPoolEvictionPolicy is any transaction pool eviction policy other than fifo, requested by
+	// TxPoolEvictionPolicy.
+	FeatureTxPoolEvictionPolicy Feature = "txpool-eviction-policy"
+)
+
+// channelMaturity orders the release channels from the most stable one. Builds without a channel are built from
+// source, and rank with the dev channel.
+var channelMaturity = map[string]int{
+	"stable":  0,
+	"beta":    1,
+	"alpha":   2,
+	"nightly": 2,
+	"master":  3,
+	"dev":     3,
+	"":        3,
+}
+
+// featureGate decides where a feature is cleared, and how the Local configuration requests it.
+type featureGate struct {
+	description string
+	// channel is the most stable release channel whose builds are cleared to run the feature.
+	channel string
+	// excludedNetworks are the networks the feature isn't cleared for, whatever the channel.
+	excludedNetworks []protocol.NetworkID
+	// listed tells whether the feature is requested by listing it in ExperimentalFeatures.
+	listed bool
+	// setting tells whether the feature is requested by a setting of its own.
+	setting func(cfg Local) bool
+}
+
+var featureGates = map[Feature]featureGate{
+	FeatureP2PNetwork: {
+		description:      "Gossip network built on libp2p, replacing the websocket network.",
+		channel:          "beta",
+		excludedNetworks: []protocol.NetworkID{Mainnet},
+		listed:           true,
+		setting:          func(cfg Local) bool { return cfg.EnableP2P },
+	},
+	FeatureTxnEvalTracer: {
+		description: "Tracer collecting the state deltas of the transactions evaluated by the ledger.",
+		channel:     "stable",
+		listed:      true,
+		setting:     func(cfg Local) bool { return cfg.EnableTxnEvalTracer },
+	},
+	FeatureAppEventIndex: {
+		description: "Ledger index of the events emitted by application calls.",
+		channel:     "stable",
+		listed:      true,
+		setting:     func(cfg Local) bool { return cfg.EnableAppEventIndex },
+	},
+	FeatureTxPoolEvictionPolicy: {
+		description: "Transaction pool eviction policies other than fifo.",
+		channel:     "beta",
+		setting:     func(cfg Local) bool { return cfg.TxPoolEvictionPolicy != "" && cfg.TxPoolEvictionPolicy != "fifo" },
+	},
+}
+
+// FeatureRequested tells whether the configuration requests an experimental feature, either by listing it in
+// ExperimentalFeatures or through its own setting.
+func (cfg Local) FeatureRequested(name Feature) bool {
+	gate, ok := featureGates[name]
+	if !ok {
+		return false
+	}
+	if gate.setting != nil && gate.setting(cfg) {
+		return true
+	}
+	if !gate.listed {
+		return false
+	}
+	for _, listed := range strings.Split(cfg.ExperimentalFeatures, ",") {
+		if Feature(strings.TrimSpace(listed)) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FeatureStatus reports whether an experimental feature is requested by the configuration, and whether it is
+// cleared for the consensus protocol and the network of the node, and for its build.
+type FeatureStatus struct {
+	Name        Feature
+	Description string
+	Requested   bool
+	Available   bool
+	Enabled     bool
+	// Reason explains why the feature isn't cleared, when it isn't.
+	Reason string
+}
+
+// available tells whether the gate clears the feature for the consensus protocol and the network, in the builds of
+// the channel, and why not when it doesn't.
+func (g featureGate) available(name Feature, proto protocol.ConsensusVersion, network protocol.NetworkID, channel string) (bool, string) {
+	if !Consensus[proto].ClearedFeatures[name] {
+		return false, fmt.Sprintf("not cleared for consensus protocol %s", proto)
+	}
+	for _, excluded := range g.excludedNetworks {
+		if network == excluded {
+			return false, fmt.Sprintf("not cleared for %s", network)
+		}
+	}
+	if channelMaturity[channel] < channelMaturity[g.channel] {
+		return false, fmt.Sprintf("not cleared for %s builds, only from the %s channel on", channel, g.channel)
+	}
+	return true, ""
+}
+
+// validateExperimentalFeatures checks that ExperimentalFeatures lists only features which may be listed.
+func (cfg Local) validateExperimentalFeatures() error {
+	for _, listed := range strings.Split(cfg.ExperimentalFeatures, ",") {
+		name := Feature(strings.TrimSpace(listed))
+		if name == "" {
+			continue
+		}
+		gate, ok := featureGates[name]
+		if !ok {
+			return fmt.Errorf("unknown experimental feature %q in ExperimentalFeatures", name)
+		}
+		if !gate.listed {
+			return fmt.Errorf("experimental feature %q cannot be listed in ExperimentalFeatures", name)
+		}
+	}
+	return nil
+}
+
+// GateFeatures returns the status of every experimental feature, sorted by name, on a network running the given
+// consensus protocol, for a build of the given release channel. The features requested by the configuration are
+// enabled as requested, whether they are cleared or not: callers warn about those which aren't. It fails on
+// unknown release channels and on unknown features listed in ExperimentalFeatures.
+func (cfg Local) GateFeatures(proto protocol.ConsensusVersion, network protocol.NetworkID, channel string) ([]FeatureStatus, error) {
+	if _, ok := channelMaturity[channel]; !ok {
+		return nil, fmt.Errorf("unknown release channel %q", channel)
+	}
+	if err := cfg.validateExperimentalFeatures(); err != nil {
+		return nil, err
+	}
+	statuses := make([]FeatureStatus, 0, len(featureGates))
+	for name, gate := range featureGates {
+		status := FeatureStatus{Name: name, Description: gate.description, Requested: cfg.FeatureRequested(name)}
+		status.Available, status.Reason = gate.available(name, proto, network, channel)
+		status.Enabled = status.Requested
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
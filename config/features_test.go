@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func featureStatus(t *testing.T, statuses []FeatureStatus, name Feature) FeatureStatus {
+	for _, s := range statuses {
+		if s.Name == name {
+			return s
+		}
+	}
+	require.FailNow(t, "missing feature status", name)
+	return FeatureStatus{}
+}
+
+func TestGateFeatures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	current := protocol.ConsensusCurrentVersion
+	cfg := GetDefaultLocal()
+	statuses, err := cfg.GateFeatures(current, Mainnet, "stable")
+	require.NoError(t, err)
+	require.Len(t, statuses, len(featureGates))
+	for i, s := range statuses {
+		require.False(t, s.Requested)
+		require.False(t, s.Enabled)
+		require.NotEmpty(t, s.Description)
+		if i > 0 {
+			require.Less(t, statuses[i-1].Name, s.Name)
+		}
+	}
+
+	cfg.EnableP2P = true
+	cfg.ExperimentalFeatures = "app-event-index"
+	cfg.TxPoolEvictionPolicy = "lowest-fee"
+	require.True(t, cfg.FeatureRequested(FeatureP2PNetwork))
+	require.True(t, cfg.FeatureRequested(FeatureAppEventIndex))
+	require.True(t, cfg.FeatureRequested(FeatureTxPoolEvictionPolicy))
+	require.False(t, cfg.FeatureRequested(FeatureTxnEvalTracer))
+
+	// the features requested where they aren't cleared are still turned on
+	statuses, err = cfg.GateFeatures(protocol.ConsensusFuture, Mainnet, "dev")
+	require.NoError(t, err)
+	p2p := featureStatus(t, statuses, FeatureP2PNetwork)
+	require.True(t, p2p.Requested)
+	require.False(t, p2p.Available)
+	require.True(t, p2p.Enabled)
+	require.Contains(t, p2p.Reason, string(Mainnet))
+	require.True(t, featureStatus(t, statuses, FeatureAppEventIndex).Available)
+
+	statuses, err = cfg.GateFeatures(current, Testnet, "stable")
+	require.NoError(t, err)
+	require.Contains(t, featureStatus(t, statuses, FeatureTxPoolEvictionPolicy).Reason, "beta")
+	require.True(t, featureStatus(t, statuses, FeatureTxPoolEvictionPolicy).Enabled)
+
+	// features are cleared by consensus protocol version
+	statuses, err = cfg.GateFeatures(current, Testnet, "beta")
+	require.NoError(t, err)
+	require.False(t, featureStatus(t, statuses, FeatureP2PNetwork).Available)
+	require.Contains(t, featureStatus(t, statuses, FeatureP2PNetwork).Reason, string(current))
+	statuses, err = cfg.GateFeatures(protocol.ConsensusFuture, Testnet, "beta")
+	require.NoError(t, err)
+	require.True(t, featureStatus(t, statuses, FeatureP2PNetwork).Available)
+	statuses, err = cfg.GateFeatures(protocol.ConsensusV37, Testnet, "")
+	require.NoError(t, err)
+	require.False(t, featureStatus(t, statuses, FeatureAppEventIndex).Available)
+
+	// unknown release channels and features are rejected
+	_, err = cfg.GateFeatures(current, Testnet, "unknown")
+	require.ErrorContains(t, err, "unknown")
+	cfg.ExperimentalFeatures = "app-event-index, hot-accounts"
+	_, err = cfg.GateFeatures(current, Testnet, "")
+	require.ErrorContains(t, err, "hot-accounts")
+	cfg.ExperimentalFeatures = "txpool-eviction-policy"
+	_, err = cfg.GateFeatures(current, Testnet, "")
+	require.Error(t, err)
+}
@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitely, otherwise, only the most recent blocks
@@ -449,6 +449,12 @@ type Local struct {
 	// ProposalAssemblyTime is the max amount of time to spend on generating a proposal block.
 	ProposalAssemblyTime time.Duration `version[19]:"250000000" version[23]:"500000000"`
 
+	// ProposalAssemblyBudget is a hard limit on the time spent selecting and validating transactions for the
+	// next proposal, counted from the moment the transaction pool starts evaluating the round. Once exceeded, the
+	// payset is closed with whatever was evaluated so far and the remaining transactions are deferred to a later
+	// block. Setting this to zero disables the budget.
+	ProposalAssemblyBudget time.Duration `version[32]:"0"`
+
 	// When the number of http connections to the REST layer exceeds the soft limit,
 	// we start returning http code 429 Too Many Requests.
 	RestConnectionsSoftLimit uint64 `version[20]:"1024"`
@@ -510,7 +516,8 @@ type Local struct {
 	// StorageEngine allows to control which type of storage to use for the ledger.
 	// Available options are:
 	// - sqlite (default)
-	// - pebbledb (experimental, in development)
+	// - pebbledb (experimental, in development; does not support catchpoints, so CatchpointTracking must be -1 on archival nodes)
+	// An existing sqlite ledger can be copied into another engine with `algod -migrate-storage <engine>`.
 	StorageEngine string `version[28]:"sqlite"`
 
 	// TxIncomingFilterMaxSize sets the maximum size for the de-duplication cache used by the incoming tx filter
@@ -521,7 +528,8 @@ type Local struct {
 	// When it exceeds this capacity, it redirects the block requests to a different node
 	BlockServiceMemCap uint64 `version[28]:"500000000"`
 
-	// EnableP2P turns on the peer to peer network
+	// EnableP2P turns on the peer to peer network, like listing p2p-network in ExperimentalFeatures. It is an
+	// experimental feature, which is not cleared for mainnet nor for the builds of the stable channel.
 	EnableP2P bool `version[31]:"false"`
 
 	// P2PPersistPeerID will write the private key used for the node's PeerID to the P2PPrivateKeyLocation.
@@ -535,8 +543,450 @@ type Local struct {
 
 	// DisableAPIAuth turns off authentication for public (non-admin) API endpoints.
 	DisableAPIAuth bool `version[30]:"false"`
+
+	// AdminEndpointAddress configures a dedicated address the admin (private) REST API is served on.
+	// When set, the admin routes are no longer served on EndpointAddress, and every route served on
+	// this address requires the admin API token. The bound address is written to algod.admin.net.
+	// The admin API is still served by the algod process itself: this separates the network surfaces
+	// of the two APIs, e.g. to keep the admin one on a loopback address, but not their memory.
+	AdminEndpointAddress string `version[32]:""`
+
+	// EnableRestResponseCompression compresses the REST API responses of at least RestResponseCompressionThreshold
+	// bytes with zstd or gzip, when the client advertises support for either in its Accept-Encoding header.
+	EnableRestResponseCompression bool `version[32]:"true"`
+
+	// RestResponseCompressionThreshold is the minimal size, in bytes, of the REST API responses that get compressed.
+	RestResponseCompressionThreshold int `version[32]:"16384"`
+
+	// EnableMetricsPersistence persists the monotonic node metrics (transactions relayed, blocks validated and
+	// uptime) to the data directory, so that they keep counting across restarts instead of starting over from zero.
+	EnableMetricsPersistence bool `version[32]:"false"`
+
+	// MetricsPersistenceInterval is the interval, in seconds, at which the persisted metrics are written to disk.
+	MetricsPersistenceInterval uint64 `version[32]:"60"`
+
+	// GossipTLSCertFile and GossipTLSKeyFile are the PEM encoded certificate and private key identifying this node
+	// on the websocket gossip network. When both are set, the gossip connections, incoming and outgoing, use mutual
+	// TLS and every peer is required to present a certificate accepted by GossipTLSCAFile or GossipTLSPinnedPeers.
+	// All the nodes of the private network must enable it, since the gossip endpoint is then only served over TLS.
+	GossipTLSCertFile string `version[32]:""`
+	GossipTLSKeyFile  string `version[32]:""`
+
+	// GossipTLSCAFile is the PEM encoded bundle of the certificate authorities issuing the node certificates of the
+	// private network. If it is empty, the peers are authenticated by GossipTLSPinnedPeers only.
+	GossipTLSCAFile string `version[32]:""`
+
+	// GossipTLSPinnedPeers is a comma delimited list of the SHA-256 fingerprints, in hex, of the peer certificates
+	// accepted on the gossip network. If it is not empty, a peer presenting any other certificate is rejected, even
+	// if that certificate was issued by GossipTLSCAFile.
+	GossipTLSPinnedPeers string `version[32]:""`
+
+	// OutboundHTTPRetryAttempts is the number of times the outbound idempotent HTTP requests of the node (block and
+	// catchpoint downloads, catchpoint labels, asset metadata) are retried after failing with a network error or a 5xx
+	// or 429 status code.
+	OutboundHTTPRetryAttempts int `version[32]:"2"`
+
+	// OutboundHTTPRetryBackoff is the delay before retrying a failed outbound HTTP request. It doubles with every
+	// subsequent retry of the request, up to OutboundHTTPRetryMaxBackoff. Negative values are treated as zero.
+	OutboundHTTPRetryBackoff    time.Duration `version[32]:"100000000"`
+	OutboundHTTPRetryMaxBackoff time.Duration `version[32]:"1000000000"`
+
+	// OutboundHTTPCircuitBreakerThreshold is the number of consecutive failed outbound HTTP requests to a destination
+	// after which the requests to that destination fail immediately, without being sent, for
+	// OutboundHTTPCircuitBreakerCooldown. Setting it to 0 disables the circuit breaking.
+	OutboundHTTPCircuitBreakerThreshold int           `version[32]:"5"`
+	OutboundHTTPCircuitBreakerCooldown  time.Duration `version[32]:"10000000000"`
+
+	// EnableAssetComplianceIndex makes the ledger index asset freeze and clawback transactions by asset and by
+	// target address, and enables the algod APIs that query this index. The index is kept in memory and rebuilt
+	// on startup from the blocks retained by the node, within AssetComplianceIndexRounds.
+	EnableAssetComplianceIndex bool `version[32]:"false"`
+
+	// AssetComplianceIndexRounds is the number of most recent rounds covered by the asset compliance index; the
+	// events of older rounds are pruned. Setting it to 0 keeps the events of all the rounds kept by the node, which
+	// holds them all in memory and takes a while to rebuild on archival nodes.
+	AssetComplianceIndexRounds uint64 `version[32]:"100000"`
+
+	// ArchivalSinceRound and ArchivalWindowRounds make a non-archival node keep more blocks than its trackers
+	// require, without going fully archival. When ArchivalSinceRound is non-zero, all blocks from that round
+	// onward are kept. When ArchivalWindowRounds is non-zero, the most recent ArchivalWindowRounds blocks are kept.
+	// When both are set, the node keeps the union of the two ranges. Both are ignored when Archival is enabled.
+	ArchivalSinceRound   uint64 `version[32]:"0"`
+	ArchivalWindowRounds uint64 `version[32]:"0"`
+
+	// APITokenRotationOverlap is how long the previous algod API token remains accepted after a new one is
+	// generated through the admin API, giving clients time to switch to the new token. Setting it to 0 revokes
+	// the previous token immediately.
+	APITokenRotationOverlap time.Duration `version[32]:"3600000000000"`
+
+	// ParticipationLeaseFile enables the coordination of redundant nodes configured with the same participation
+	// keys. It is the path of a lease on storage shared by these nodes, kept in files named after it suffixed by
+	// the generation of the lease, and only the node holding the lease votes. The storage must support hard links.
+	// The lease is renewed while the node runs, and taken over by another node once it has expired.
+	// An empty path disables the coordination, and the node always votes with its participation keys.
+	ParticipationLeaseFile string `version[32]:""`
+
+	// ParticipationLeaseDuration is how long the participation lease is valid for once acquired or renewed.
+	// The holder renews it every third of this duration, and stops voting a fifth of this duration before it
+	// expires, so a standby node takes over a little after this duration has passed without the holder renewing it.
+	ParticipationLeaseDuration time.Duration `version[32]:"10000000000"`
+
+	// FollowerSyncHighWatermark enables the sync round policy of follower nodes when non-zero. The policy moves
+	// the sync round forward as the downstream consumer acknowledges rounds through the REST API. When the node
+	// has this many rounds the consumer has not acknowledged, and no acknowledgement arrived for
+	// FollowerSyncAckTimeout, the consumer is considered stalled and the sync round is moved forward so that only
+	// FollowerSyncLowWatermark rounds remain unacknowledged. The node cannot sync more than MaxAcctLookback rounds
+	// past the sync round, so values above MaxAcctLookback+1 are lowered to it.
+	FollowerSyncHighWatermark uint64 `version[32]:"0"`
+	FollowerSyncLowWatermark  uint64 `version[32]:"0"`
+
+	// FollowerSyncAckTimeout is how long the follower sync policy waits for an acknowledgement once the high
+	// watermark is reached, before it moves the sync round forward without the consumer.
+	FollowerSyncAckTimeout time.Duration `version[32]:"30000000000"`
+
+	// MaxSimulateSessions is the maximum number of simulation sessions the node keeps at a time. A simulation
+	// session lets successive simulate requests, authenticated with the same API token, build on the state
+	// changes of the previous ones. Setting it to 0 disables simulation sessions.
+	MaxSimulateSessions uint64 `version[32]:"16"`
+
+	// SimulateSessionTTL is how long a simulation session is kept after it was last used.
+	SimulateSessionTTL time.Duration `version[32]:"600000000000"`
+
+	// GossipCaptureDir enables the capture of inbound gossip messages when set. The messages are written to
+	// this directory, in one series of files per message tag, which tools/debug/gossipreplay can feed back
+	// into a node. Capturing is meant for debugging and benchmarking, and is disabled by default.
+	GossipCaptureDir string `version[32]:""`
+
+	// GossipCaptureTags is a comma-separated list of the message tags to capture, e.g. "TX,AV". All tags
+	// are captured when it is empty.
+	GossipCaptureTags string `version[32]:""`
+
+	// GossipCaptureMaxFileSize is the size in bytes at which a capture file is rotated, and
+	// GossipCaptureMaxFiles is the number of capture files kept for each tag, the oldest ones being removed.
+	GossipCaptureMaxFileSize uint64 `version[32]:"104857600"`
+	GossipCaptureMaxFiles    uint64 `version[32]:"10"`
+
+	// MemoryTarget is the memory, in bytes, the node aims to use. When set, the node adjusts GOGC to the
+	// headroom left above its live heap, which is mostly made of ledger caches, and sets GOMEMLIMIT to the
+	// target, overriding the GOGC and GOMEMLIMIT environment variables. It can be changed at runtime
+	// through the admin API. The node leaves the garbage collector settings alone when it is 0.
+	MemoryTarget uint64 `version[32]:"0"`
+
+	// MemoryBallast is the size in bytes of a memory ballast, an allocation which is never written to
+	// and pads the heap the garbage collector sizes its cycles from. It is only allocated when MemoryTarget is set.
+	MemoryBallast uint64 `version[32]:"0"`
+
+	// PaymentNotificationAddresses is a comma-separated list of addresses for which the node pushes a
+	// notification to the configured endpoints whenever they receive a payment, in algos or assets. The
+	// notifications are only sent for the blocks the node adds to its ledger while running: consumers must
+	// reconcile with the ledger after an outage. Payment notifications are disabled when it is empty.
+	PaymentNotificationAddresses string `version[32]:""`
+
+	// PaymentNotificationWebhookURL is a URL to which payment notifications are posted as JSON objects.
+	PaymentNotificationWebhookURL string `version[32]:""`
+
+	// PaymentNotificationMQTTBroker is the host:port of an MQTT broker to which payment notifications are
+	// published, as JSON objects, to the PaymentNotificationMQTTTopic topic.
+	PaymentNotificationMQTTBroker string `version[32]:""`
+	PaymentNotificationMQTTTopic  string `version[32]:"algorand/payments"`
+
+	// EnableUpgradeDryRun makes the node evaluate every block a second time, under the consensus version of a
+	// pending protocol upgrade, and report through telemetry the blocks the next version would reject or give
+	// different effects to. It gives early warning of consensus bugs in an upgrade before the switch-over round,
+	// at the cost of doubling the time spent evaluating blocks while an upgrade is pending.
+	EnableUpgradeDryRun bool `version[32]:"false"`
+
+	// UpgradeDryRunProtocol is the consensus version blocks are evaluated under when EnableUpgradeDryRun is set,
+	// whether or not an upgrade to it is pending. When empty, the version of the pending upgrade is used.
+	UpgradeDryRunProtocol string `version[32]:""`
+
+	// CryptoBackend selects the implementation of the ed25519 signature and VRF primitives: "libsodium" for the
+	// bundled C library, "go" for the pure Go implementation, or "auto" to benchmark both at startup and use the
+	// faster one. Both implementations produce and accept exactly the same keys, signatures and proofs.
+	CryptoBackend string `version[32]:"libsodium"`
+
+	// FalconBackend selects the implementation of the Falcon signatures used by state proofs. The "reference"
+	// implementation is always available; optimized implementations are compiled in with build tags and
+	// selected here by name. When empty, the reference implementation is used.
+	FalconBackend string `version[32]:""`
+
+	// EnableBoxHistoryIndex makes the ledger record the rounds in which boxes are created and deleted, and report
+	// them from the box endpoints of the algod API. The index is stored along with the blocks and only covers the
+	// rounds following the one it was enabled at. Lookups query the block database, so only the events of the rounds
+	// not committed yet are kept in memory.
+	EnableBoxHistoryIndex bool `version[32]:"false"`
+
+	// EnableSpeculativeAssembly makes the transaction pool start assembling the block of the next round as soon as
+	// a proposal for the current round is validated, before it is certified. When the certified block turns out to be
+	// the validated one, the speculatively assembled payset is used as is, reducing the time needed to propose.
+	EnableSpeculativeAssembly bool `version[32]:"false"`
+
+	// RestMaxRequestBodyBytes is the maximum size of the request bodies accepted by the public REST API routes
+	// which have no limit of their own. A value of 0 (or less) disables the limit of these routes entirely, so that
+	// their request bodies are accepted whatever their size; the routes with a limit of their own keep it.
+	RestMaxRequestBodyBytes int64 `version[32]:"10485760"`
+
+	// RestRequestBodyLimits overrides the maximum request body size of individual REST API routes, as a semicolon
+	// separated list of route=bytes pairs, e.g. "/v2/transactions=2097152;/v2/transactions/simulate=20971520".
+	// Requests exceeding the limit of their route are rejected as soon as the excess is detected.
+	RestRequestBodyLimits string `version[32]:""`
+
+	// EnableAccountTxnIndex makes the ledger index the IDs of the transactions touching each account, and enables
+	// the algod API that queries this index. The index is stored in the tracker database and only covers the rounds
+	// following the one it was enabled at.
+	EnableAccountTxnIndex bool `version[32]:"false"`
+
+	// AccountTxnIndexRounds is the number of most recent rounds covered by the account transaction index; the
+	// entries of older rounds are pruned. Setting it to 0 keeps the entries of all the rounds.
+	AccountTxnIndexRounds uint64 `version[32]:"100000"`
+
+	// EnableContentionWatchdog starts a watchdog which logs warnings, with stack excerpts, when the go scheduler
+	// falls behind, when goroutines spend too long waiting on mutexes, or when the ledger locks are held for too
+	// long. It enables the sampling of mutex contention by the go runtime, which has a small overhead.
+	EnableContentionWatchdog bool `version[32]:"false"`
+
+	// ContentionWatchdogSchedulerLatency is the delay in scheduling a runnable goroutine above which the
+	// contention watchdog logs a warning.
+	ContentionWatchdogSchedulerLatency time.Duration `version[32]:"100000000"`
+
+	// ContentionWatchdogLockThreshold is the time spent waiting on mutexes per second, summed over all
+	// goroutines, and the time a ledger lock is held, above which the contention watchdog logs a warning.
+	ContentionWatchdogLockThreshold time.Duration `version[32]:"1000000000"`
+
+	// EnableGossipStateDeltaService enables serving the state deltas of recent rounds over the gossip network,
+	// to follower nodes which fetch them instead of evaluating the blocks. The functionality of this depends
+	// on NetAddress, which must also be provided.
+	EnableGossipStateDeltaService bool `version[32]:"false"`
+
+	// CatchupFetchStateDeltas makes a node in follow mode fetch the state delta of each block from the peer
+	// it fetched the block from, and add the block to its ledger with that delta instead of evaluating it.
+	// The deltas cannot be checked against the block, so they are only fetched from the peers listed in
+	// CatchupStateDeltaTrustedPeers, and trusted as served by them. Peers only keep the deltas of their most
+	// recent rounds, and the node evaluates the block as usual when the peer cannot serve its delta.
+	CatchupFetchStateDeltas bool `version[32]:"false"`
+
+	// CatchupStateDeltaTrustedPeers is a comma-separated list of the addresses, as host:port, of the peers
+	// whose state deltas a node with CatchupFetchStateDeltas set accepts. The blocks fetched from any other
+	// peer are evaluated.
+	CatchupStateDeltaTrustedPeers string `version[32]:""`
+
+	// FlightRecorderWindow is the time span the flight recorder covers. The flight recorder samples the CPU,
+	// memory and disk usage, the ledger lag and the transaction pool size of the node every second, and keeps
+	// the samples of that span in memory, from where they can be dumped through the admin API. It is disabled
+	// when set to 0.
+	FlightRecorderWindow time.Duration `version[32]:"21600000000000"`
+
+	// EnableParticipationKeyAutoRenewal makes the node install a successor to the participation key an account
+	// is registered online with, ParticipationKeyRenewalLeadRounds rounds before that key expires, and register it.
+	// The key registration is signed and broadcast, again on every block until it is committed or its validity
+	// window is over, when the root key of the account (or of its authorizer) is in the genesis directory, and
+	// left for an external signer through the admin API otherwise.
+	EnableParticipationKeyAutoRenewal bool `version[32]:"false"`
+
+	// ParticipationKeyRenewalLeadRounds is how many rounds before the participation key of an account expires
+	// its successor is installed and registered.
+	ParticipationKeyRenewalLeadRounds uint64 `version[32]:"100000"`
+
+	// ParticipationKeyRenewalValidity is the number of rounds the participation keys installed by the automatic
+	// renewal are valid for. It must be larger than ParticipationKeyRenewalLeadRounds.
+	ParticipationKeyRenewalValidity uint64 `version[32]:"3000000"`
+
+	// RelayPolicyFile is the path of a JSON file with the node-local relay policy, which drops or deprioritizes
+	// classes of transactions received from the network, such as zero-amount payments or transactions with
+	// oversized notes. Relative paths are resolved against the data directory. The file is reloaded whenever
+	// it changes. The relay policy does not affect the validity of transactions in blocks. No policy is applied
+	// when it is empty.
+	RelayPolicyFile string `version[32]:""`
+
+	// EnableRecentTxnIndex makes the ledger index the leases and note prefixes of the transactions of the last
+	// MaxTxnLife rounds, and enables the algod API that queries this index, e.g. to check whether a transaction
+	// submitted earlier with a given lease was confirmed. The index is held in memory.
+	EnableRecentTxnIndex bool `version[32]:"false"`
+
+	// TxPoolFeePriority makes the transaction pool feed its pending transaction groups to the block evaluator in
+	// decreasing order of fee per byte, so the groups paying more make it into the next block. Groups sharing an
+	// account, an application or an asset keep their relative order, and no group moves past a group that may
+	// create an application or an asset.
+	TxPoolFeePriority bool `version[32]:"false"`
+
+	// TxPoolEvictionPolicy decides what happens to a transaction group arriving when the transaction pool is full:
+	// "fifo" rejects it, "lowest-fee" evicts the pending groups paying the lowest fee per byte to make room for it
+	// when they pay less than it does. Policies other than "fifo" are experimental, and are not available in the builds
+	// of the stable channel.
+	TxPoolEvictionPolicy string `version[32]:"fifo"`
+
+	// TxPoolMaxTxnsPerSender is the number of transactions a single sender may have pending in the transaction pool.
+	// Transaction groups taking a sender past it are rejected. Zero means no limit.
+	TxPoolMaxTxnsPerSender int `version[32]:"0"`
+
+	// MetricsEndpointAddress configures a dedicated address algod serves its metrics on, in the Prometheus
+	// text format, at the /metrics path and without authentication so that Prometheus can scrape it directly.
+	// When set, the metrics are no longer pushed through the node exporter even if EnableMetricReporting is set.
+	// The bound address is written to algod.metrics.net.
+	MetricsEndpointAddress string `version[32]:""`
+
+	// MaxBlockHistoryRounds bounds the number of recent blocks kept in the block database when non-zero, including
+	// on archival nodes, and takes precedence over ArchivalSinceRound and ArchivalWindowRounds. Older blocks are
+	// pruned as new ones are written, except for the blocks the ledger still needs, e.g. to generate catchpoints.
+	// Requests for pruned blocks fail with an error telling they are behind the retention horizon.
+	MaxBlockHistoryRounds uint64 `version[32]:"0"`
+
+	// EnableAssetMetadataVerification enables the /v2/assets/{asset-id}/metadata/verify API, which fetches the
+	// off-chain metadata an asset URL points to and checks it against the on-chain metadata hash of the asset.
+	// The node then makes outbound requests to URLs chosen by asset creators; requests to loopback, private and
+	// link-local addresses are refused.
+	EnableAssetMetadataVerification bool `version[32]:"false"`
+
+	// AssetMetadataFetchTimeout bounds the time spent fetching the metadata of an asset, and AssetMetadataMaxBytes
+	// its size; larger metadata is reported as unreachable.
+	AssetMetadataFetchTimeout time.Duration `version[32]:"5000000000"`
+	AssetMetadataMaxBytes     uint64        `version[32]:"1048576"`
+
+	// AssetMetadataIPFSGateway is the base URL of the IPFS gateway ipfs:// asset URLs are fetched through, e.g.
+	// https://ipfs.io/ipfs/. The metadata of assets with an ipfs:// URL can't be verified when it is empty.
+	AssetMetadataIPFSGateway string `version[32]:""`
+
+	// TxRelayFilterExchangeInterval is how often the node sends its peers a bloom filter of the transaction groups it
+	// received recently, so that they stop relaying those groups back to it. Each filter covers the groups received
+	// over the last two intervals. Filters received from peers are honored regardless of this setting. Zero disables
+	// sending filters.
+	TxRelayFilterExchangeInterval time.Duration `version[32]:"0"`
+
+	// MaxAccountHistoryRounds is the number of rounds, preceding the last MaxAcctLookback rounds kept in memory by the
+	// ledger, at which the account states can still be looked up, e.g. through the round parameter of the account
+	// endpoint of the algod API. The history is only recorded while the node runs, so it is empty after a restart.
+	// Zero disables the history.
+	MaxAccountHistoryRounds uint64 `version[32]:"0"`
+
+	// TrackerDBDir, BlockDBDir, CatchpointDir and ParticipationDir are absolute paths of existing directories, e.g. the
+	// mount points of other volumes, to keep the tracker database, the block database, the catchpoint files and the
+	// participation key registry in instead of the data directory. Each is kept in a subdirectory named after the
+	// genesis ID. The node refuses to start while such files are still in the data directory; `goal node relocate`
+	// moves them. Empty keeps the files in the data directory.
+	TrackerDBDir     string `version[32]:""`
+	BlockDBDir       string `version[32]:""`
+	CatchpointDir    string `version[32]:""`
+	ParticipationDir string `version[32]:""`
+
+	// CatchpointUploadBucket is the bucket of an S3 compatible object store the catchpoint files written by the
+	// node are uploaded to, along with a manifest of their size and SHA-256 digest. The uploads resume where an
+	// interrupted upload stopped. The credentials are taken from the AWS environment variables or shared
+	// configuration files. Empty disables the uploads.
+	CatchpointUploadBucket string `version[32]:""`
+
+	// CatchpointUploadEndpoint is the URL of the object store the catchpoint files are uploaded to, e.g.
+	// https://storage.googleapis.com for GCS. Empty uploads to AWS S3 in CatchpointUploadRegion.
+	CatchpointUploadEndpoint string `version[32]:""`
+	CatchpointUploadRegion   string `version[32]:"us-east-1"`
+
+	// CatchpointUploadPrefix is prepended to the names of the catchpoint files uploaded, which are kept under
+	// the genesis ID.
+	CatchpointUploadPrefix string `version[32]:""`
+
+	// BlockHeaderCacheRounds is how many block headers read from the block database the ledger keeps in memory, the
+	// most recently used ones, so that repeated lookups of headers older than the last MaxTxnLife rounds, e.g.
+	// through the block header endpoint of the algod API, don't hit the database. Zero disables the cache.
+	BlockHeaderCacheRounds uint64 `version[32]:"0"`
+
+	// EnableAdaptiveVerificationConcurrency makes the node bound the number of workers verifying the signatures of
+	// the transactions received, so that the verification of the votes and blocks, on the critical path of the
+	// rounds, completes within VerificationLatencyTarget on average. The bound is halved every second the target is
+	// missed, and raised by one worker otherwise, up to the number of CPUs. At least one worker keeps verifying
+	// transactions.
+	EnableAdaptiveVerificationConcurrency bool          `version[32]:"false"`
+	VerificationLatencyTarget             time.Duration `version[32]:"20000000"`
+
+	// HotAccountsCacheSize is how many of the accounts, and how many of the resources, most frequently looked up
+	// the ledger caches in memory, in addition to the most recently used ones, so that the block evaluation doesn't
+	// read the ones it keeps coming back to from the accounts database every time they leave the recently used ones.
+	// They are cached in the background after a few lookups, and dropped once they are no longer looked up for a
+	// while. Zero, the default, disables the cache, and so does DisableLedgerLRUCache.
+	HotAccountsCacheSize int `version[32]:"0"`
+
+	// FeeEstimateBlocks is how many of the recent blocks the node keeps the fees of, to estimate in the
+	// /v2/transactions/fee-estimate endpoint the fee a transaction needs to pay to be committed promptly.
+	// Zero disables the endpoint.
+	FeeEstimateBlocks int `version[32]:"20"`
+
+	// TxSubmissionIdempotencyWindow is how long the node remembers the response to a transaction submission made
+	// with an Idempotency-Key header, to return it again when the submission is retried instead of failing because
+	// the transactions are already in the pool or the ledger. The responses are kept in the data directory, so that
+	// they survive a restart. Zero disables the idempotency keys, which are then ignored.
+	TxSubmissionIdempotencyWindow time.Duration `version[32]:"3600000000000"`
+
+	// EnableWebhooks makes the node post, after it commits each block, the transactions of the block matching the
+	// filters of every registered webhook, along with the changes they made to the balances, asset holdings and
+	// boxes of the accounts and applications involved. Webhooks are registered through the /v2/webhooks endpoints,
+	// which keep them in the data directory, and through WebhookURLs. As with payment notifications, only the blocks
+	// committed while the node runs are notified: consumers must reconcile with the ledger after an outage.
+	EnableWebhooks bool `version[32]:"false"`
+
+	// WebhookURLs is a comma-separated list of URLs registered as webhooks matching every transaction, while
+	// EnableWebhooks is set. The notifications posted to them are signed with WebhookSecret, if set.
+	WebhookURLs   string `version[32]:""`
+	WebhookSecret string `version[32]:""`
+
+	// RelayHealthReportEndpoint is a URL to which a relay posts, every RelayHealthReportInterval, an anonymized report
+	// of the health of the network as it sees it: the distributions of the round latency and of the spread of the
+	// arrivals of the votes, and the ratio of the transaction messages it received which were duplicates. The reports
+	// follow a standard JSON schema, so that the reports of many relays can be aggregated, and hold no address or
+	// identity of the relay. Relay health reports are disabled when it is empty, and may only be enabled on relays.
+	RelayHealthReportEndpoint string        `version[32]:""`
+	RelayHealthReportInterval time.Duration `version[32]:"600000000000"`
+
+	// CatchupMode selects how a node which is behind the network catches up. "blocks" only fetches and applies the
+	// missing blocks, "catchpoint" switches to catchpoint catchup whenever the latest catchpoint published at
+	// CatchpointLabelURL is ahead of the node, and "auto" switches only when the catchpoint catchup is expected to be
+	// much faster, judging by the measured lag and block catchup rate, the size of the catchpoint file and the speed
+	// of the local disk. Catchpoint catchup is never selected by archival nodes, nor when CatchpointLabelURL is empty.
+	CatchupMode string `version[32]:"auto"`
+
+	// CatchpointLabelURL is a URL returning, as plain text, the label of the latest catchpoint of the network, which
+	// the node trusts to catch up to when CatchupMode selects catchpoint catchup.
+	CatchpointLabelURL string `version[32]:""`
+
+	// EnablePeerDuplicateLatency records, for each peer, how long after their first arrival from any peer the votes and
+	// proposals arrive from that peer, and exposes the resulting histograms through the metrics and the admin API, so
+	// that the operators of relays can identify the peers which are consistently slow.
+	EnablePeerDuplicateLatency bool `version[32]:"false"`
+
+	// EnableAppEventIndex makes the ledger index the ARC-28 events emitted by application calls, i.e. their logs of at
+	// least 4 bytes, by application and selector, and enables the algod API that queries this index. The index is held
+	// in memory and rebuilt on startup from the blocks kept by the node.
+	EnableAppEventIndex bool `version[32]:"false"`
+
+	// AppEventIndexRounds is the number of most recent rounds covered by the application event index; the events of
+	// older rounds are pruned. Setting it to 0 keeps the events of all the rounds kept by the node.
+	AppEventIndexRounds uint64 `version[32]:"10000"`
+
+	// OutgoingTagBandwidthBudgets caps the rate at which the messages of the given tags are sent to each peer, as a
+	// comma-separated list of tag:bytes-per-second pairs, e.g. "TX:2000000,TS:8000000". Messages exceeding the budget
+	// of their tag are set aside until it allows them while the messages of the other tags keep flowing, and dropped
+	// once they have waited for too long. The votes and proposals cannot be budgeted. Tags which are not listed are not
+	// limited.
+	OutgoingTagBandwidthBudgets string `version[32]:""`
+
+	// ExperimentalFeatures is a comma-separated list of the experimental features to turn on, among p2p-network,
+	// txn-eval-tracer and app-event-index. It replaces EnableP2P, EnableTxnEvalTracer and EnableAppEventIndex, which
+	// are still honored. Every feature is cleared for some consensus protocol versions, networks and release channels;
+	// the features turned on where they aren't cleared run anyway, and the node warns about them on startup.
+	ExperimentalFeatures string `version[32]:""`
+
+	// EnableDevnetOracle lets the consensus protocols loaded from consensus.json set up the devnet oracle application
+	// with DevnetOracleAppID. Nodes refuse to start with such protocols unless it is set, and always refuse them on
+	// mainnet, testnet and betanet.
+	EnableDevnetOracle bool `version[32]:"false"`
 }
 
+const (
+	// CatchupModeAuto selects catchpoint catchup when it is expected to be faster than block catchup
+	CatchupModeAuto = "auto"
+	// CatchupModeBlocks always catches up block by block
+	CatchupModeBlocks = "blocks"
+	// CatchupModeCatchpoint selects catchpoint catchup whenever a newer catchpoint is available
+	CatchupModeCatchpoint = "catchpoint"
+)
+
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
 func (cfg Local) DNSBootstrapArray(networkID protocol.NetworkID) []*DNSBootstrap {
 	// Should never return an error here, as the config has already been validated at init
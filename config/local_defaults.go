@@ -20,32 +20,56 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    31,
+	Version:                                    32,
+	APITokenRotationOverlap:                    3600000000000,
+	AccountTxnIndexRounds:                      100000,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
+	AdminEndpointAddress:                       "",
 	AgreementIncomingBundlesQueueLength:        15,
 	AgreementIncomingProposalsQueueLength:      50,
 	AgreementIncomingVotesQueueLength:          20000,
 	AnnounceParticipationKey:                   true,
+	AppEventIndexRounds:                        10000,
 	Archival:                                   false,
+	ArchivalSinceRound:                         0,
+	ArchivalWindowRounds:                       0,
+	AssetComplianceIndexRounds:                 100000,
+	AssetMetadataFetchTimeout:                  5000000000,
+	AssetMetadataIPFSGateway:                   "",
+	AssetMetadataMaxBytes:                      1048576,
 	BaseLoggerDebugLevel:                       4,
+	BlockDBDir:                                 "",
+	BlockHeaderCacheRounds:                     0,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BlockServiceMemCap:                         500000000,
 	BroadcastConnectionsLimit:                  -1,
 	CadaverDirectory:                           "",
 	CadaverSizeTarget:                          0,
+	CatchpointDir:                              "",
 	CatchpointFileHistoryLength:                365,
 	CatchpointInterval:                         10000,
+	CatchpointLabelURL:                         "",
 	CatchpointTracking:                         0,
+	CatchpointUploadBucket:                     "",
+	CatchpointUploadEndpoint:                   "",
+	CatchpointUploadPrefix:                     "",
+	CatchpointUploadRegion:                     "us-east-1",
 	CatchupBlockDownloadRetryAttempts:          1000,
 	CatchupBlockValidateMode:                   0,
 	CatchupFailurePeerRefreshRate:              10,
+	CatchupFetchStateDeltas:                    false,
 	CatchupGossipBlockFetchTimeoutSec:          4,
 	CatchupHTTPBlockFetchTimeoutSec:            4,
 	CatchupLedgerDownloadRetryAttempts:         50,
+	CatchupMode:                                "auto",
 	CatchupParallelBlocks:                      16,
+	CatchupStateDeltaTrustedPeers:              "",
 	ConnectionsRateLimitingCount:               60,
 	ConnectionsRateLimitingWindowSeconds:       1,
+	ContentionWatchdogLockThreshold:            1000000000,
+	ContentionWatchdogSchedulerLatency:         100000000,
+	CryptoBackend:                              "libsodium",
 	DNSBootstrapID:                             "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
 	DNSSecurityFlags:                           1,
 	DeadlockDetection:                          0,
@@ -55,38 +79,71 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAccountTxnIndex:                      false,
 	EnableAccountUpdatesStats:                  false,
+	EnableAdaptiveVerificationConcurrency:      false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
+	EnableAppEventIndex:                        false,
 	EnableAssembleStats:                        false,
+	EnableAssetComplianceIndex:                 false,
+	EnableAssetMetadataVerification:            false,
 	EnableBlockService:                         false,
 	EnableBlockServiceFallbackToArchiver:       true,
+	EnableBoxHistoryIndex:                      false,
 	EnableCatchupFromArchiveServers:            false,
+	EnableContentionWatchdog:                   false,
 	EnableDeveloperAPI:                         false,
+	EnableDevnetOracle:                         false,
 	EnableExperimentalAPI:                      false,
 	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
+	EnableGossipStateDeltaService:              false,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
+	EnableMetricsPersistence:                   false,
 	EnableOutgoingNetworkMessageFiltering:      true,
 	EnableP2P:                                  false,
+	EnableParticipationKeyAutoRenewal:          false,
+	EnablePeerDuplicateLatency:                 false,
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
+	EnableRecentTxnIndex:                       false,
 	EnableRequestLogger:                        false,
+	EnableRestResponseCompression:              true,
 	EnableRuntimeMetrics:                       false,
+	EnableSpeculativeAssembly:                  false,
 	EnableTopAccountsReporting:                 false,
 	EnableTxBacklogRateLimiting:                true,
 	EnableTxnEvalTracer:                        false,
+	EnableUpgradeDryRun:                        false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
+	EnableWebhooks:                             false,
 	EndpointAddress:                            "127.0.0.1:0",
+	ExperimentalFeatures:                       "",
+	FalconBackend:                              "",
 	FallbackDNSResolverAddress:                 "",
+	FeeEstimateBlocks:                          20,
+	FlightRecorderWindow:                       21600000000000,
+	FollowerSyncAckTimeout:                     30000000000,
+	FollowerSyncHighWatermark:                  0,
+	FollowerSyncLowWatermark:                   0,
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
+	GossipCaptureDir:                           "",
+	GossipCaptureMaxFileSize:                   104857600,
+	GossipCaptureMaxFiles:                      10,
+	GossipCaptureTags:                          "",
 	GossipFanout:                               4,
+	GossipTLSCAFile:                            "",
+	GossipTLSCertFile:                          "",
+	GossipTLSKeyFile:                           "",
+	GossipTLSPinnedPeers:                       "",
 	HeartbeatUpdateInterval:                    600,
+	HotAccountsCacheSize:                       0,
 	IncomingConnectionsLimit:                   2400,
 	IncomingMessageFilterBucketCount:           5,
 	IncomingMessageFilterBucketSize:            512,
@@ -96,9 +153,16 @@ var defaultLocal = Local{
 	LogSizeLimit:                               1073741824,
 	MaxAPIBoxPerApplication:                    100000,
 	MaxAPIResourcesPerAccount:                  100000,
+	MaxAccountHistoryRounds:                    0,
 	MaxAcctLookback:                            4,
+	MaxBlockHistoryRounds:                      0,
 	MaxCatchpointDownloadDuration:              43200000000000,
 	MaxConnectionsPerIP:                        15,
+	MaxSimulateSessions:                        16,
+	MemoryBallast:                              0,
+	MemoryTarget:                               0,
+	MetricsEndpointAddress:                     "",
+	MetricsPersistenceInterval:                 60,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
 	NetAddress:                                 "",
 	NetworkMessageTraceServer:                  "",
@@ -106,29 +170,53 @@ var defaultLocal = Local{
 	NodeExporterListenAddress:                  ":9100",
 	NodeExporterPath:                           "./node_exporter",
 	OptimizeAccountsDatabaseOnStartup:          false,
+	OutboundHTTPCircuitBreakerCooldown:         10000000000,
+	OutboundHTTPCircuitBreakerThreshold:        5,
+	OutboundHTTPRetryAttempts:                  2,
+	OutboundHTTPRetryBackoff:                   100000000,
+	OutboundHTTPRetryMaxBackoff:                1000000000,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	OutgoingTagBandwidthBudgets:                "",
 	P2PPersistPeerID:                           false,
 	P2PPrivateKeyLocation:                      "",
+	ParticipationDir:                           "",
+	ParticipationKeyRenewalLeadRounds:          100000,
+	ParticipationKeyRenewalValidity:            3000000,
 	ParticipationKeysRefreshInterval:           60000000000,
+	ParticipationLeaseDuration:                 10000000000,
+	ParticipationLeaseFile:                     "",
+	PaymentNotificationAddresses:               "",
+	PaymentNotificationMQTTBroker:              "",
+	PaymentNotificationMQTTTopic:               "algorand/payments",
+	PaymentNotificationWebhookURL:              "",
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
 	PriorityPeers:                              map[string]bool{},
+	ProposalAssemblyBudget:                     0,
 	ProposalAssemblyTime:                       500000000,
 	PublicAddress:                              "",
 	ReconnectTime:                              60000000000,
+	RelayHealthReportEndpoint:                  "",
+	RelayHealthReportInterval:                  600000000000,
+	RelayPolicyFile:                            "",
 	ReservedFDs:                                256,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
+	RestMaxRequestBodyBytes:                    10485760,
 	RestReadTimeoutSeconds:                     15,
+	RestRequestBodyLimits:                      "",
+	RestResponseCompressionThreshold:           16384,
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
+	SimulateSessionTTL:                         600000000000,
 	StorageEngine:                              "sqlite",
 	SuggestedFeeBlockHistory:                   3,
 	SuggestedFeeSlidingWindowSize:              50,
 	TLSCertFile:                                "",
 	TLSKeyFile:                                 "",
 	TelemetryToLog:                             true,
+	TrackerDBDir:                               "",
 	TransactionSyncDataExchangeRate:            0,
 	TransactionSyncSignificantMessageThreshold: 0,
 	TxBacklogReservedCapacityPerPeer:           20,
@@ -136,11 +224,20 @@ var defaultLocal = Local{
 	TxBacklogSize:                              26000,
 	TxIncomingFilterMaxSize:                    500000,
 	TxIncomingFilteringFlags:                   1,
+	TxPoolEvictionPolicy:                       "fifo",
 	TxPoolExponentialIncreaseFactor:            2,
+	TxPoolFeePriority:                          false,
+	TxPoolMaxTxnsPerSender:                     0,
 	TxPoolSize:                                 75000,
+	TxRelayFilterExchangeInterval:              0,
+	TxSubmissionIdempotencyWindow:              3600000000000,
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
 	TxSyncTimeoutSeconds:                       30,
+	UpgradeDryRunProtocol:                      "",
 	UseXForwardedForAddressField:               "",
+	VerificationLatencyTarget:                  20000000,
 	VerifiedTranscationsCacheSize:              150000,
+	WebhookSecret:                              "",
+	WebhookURLs:                                "",
 }
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Backend names an implementation of the ed25519 signature and VRF primitives.
+type Backend string
+
+const (
+	// BackendLibsodium uses the cgo bindings to the bundled libsodium fork.
+	BackendLibsodium Backend = "libsodium"
+	// BackendGo uses the pure Go implementation, which produces identical
+	// keys, signatures and proofs and accepts exactly the same inputs.
+	BackendGo Backend = "go"
+	// BackendAuto benchmarks both implementations and keeps the faster one.
+	BackendAuto Backend = "auto"
+)
+
+// backendImpl is the set of primitives that differ between backends.
+type backendImpl struct {
+	name Backend
+
+	ed25519GenerateKeySeed func(seed ed25519Seed) (ed25519PublicKey, ed25519PrivateKey)
+	ed25519Sign            func(secret ed25519PrivateKey, data []byte) ed25519Signature
+	ed25519Verify          func(public ed25519PublicKey, data []byte, sig ed25519Signature) bool
+	batchVerify            func(messages [][]byte, publicKeys []SignatureVerifier, signatures []Signature) (bool, []bool)
+
+	vrfKeygenFromSeed func(seed [32]byte) (VrfPubkey, VrfPrivkey)
+	vrfProve          func(sk VrfPrivkey, msg []byte) (VrfProof, bool)
+	vrfProofToHash    func(proof VrfProof) (VrfOutput, bool)
+	vrfVerify         func(pk VrfPubkey, proof VrfProof, msg []byte) (bool, VrfOutput)
+	vrfBatchVerify    func(publicKeys []VrfPubkey, proofs []VrfProof, messages [][]byte) ([]VrfOutput, []bool)
+}
+
+var libsodiumBackend = &backendImpl{
+	name:                   BackendLibsodium,
+	ed25519GenerateKeySeed: sodiumEd25519GenerateKeySeed,
+	ed25519Sign:            sodiumEd25519Sign,
+	ed25519Verify:          sodiumEd25519Verify,
+	batchVerify:            sodiumBatchVerify,
+	vrfKeygenFromSeed:      sodiumVrfKeygenFromSeed,
+	vrfProve:               sodiumVrfProve,
+	vrfProofToHash:         sodiumVrfProofToHash,
+	vrfVerify:              sodiumVrfVerify,
+	vrfBatchVerify:         sodiumVrfBatchVerify,
+}
+
+// autoLibsodiumBackend is the libsodium backend as selected by BackendAuto.
+// libsodium has no batch verification of VRF proofs, so an operator who opted
+// into the automatic selection also gets the Go batch verifier, which accepts
+// exactly the proofs libsodium accepts, in less time.
+var autoLibsodiumBackend = func() *backendImpl {
+	impl := *libsodiumBackend
+	impl.vrfBatchVerify = goVrfBatchVerify
+	return &impl
+}()
+
+var goBackend = &backendImpl{
+	name:                   BackendGo,
+	ed25519GenerateKeySeed: goEd25519GenerateKeySeed,
+	ed25519Sign:            goEd25519Sign,
+	ed25519Verify:          goEd25519Verify,
+	batchVerify:            goBatchVerify,
+	vrfKeygenFromSeed:      goVrfKeygenFromSeed,
+	vrfProve:               goVrfProve,
+	vrfProofToHash:         goVrfProofToHash,
+	vrfVerify:              goVrfVerify,
+	vrfBatchVerify:         goVrfBatchVerify,
+}
+
+var activeBackend atomic.Pointer[backendImpl]
+
+func init() {
+	activeBackend.Store(libsodiumBackend)
+}
+
+func currentBackend() *backendImpl {
+	return activeBackend.Load()
+}
+
+// CurrentBackend returns the implementation currently used for ed25519 and VRF operations.
+func CurrentBackend() Backend {
+	return currentBackend().name
+}
+
+// SetBackend switches the ed25519 and VRF implementation used by this package.
+// BackendAuto runs a short benchmark of both implementations and keeps the faster;
+// the returned value is the backend that was actually selected.
+// An empty name selects libsodium.
+func SetBackend(name Backend) (Backend, error) {
+	var impl *backendImpl
+	switch name {
+	case BackendLibsodium, "":
+		impl = libsodiumBackend
+	case BackendGo:
+		impl = goBackend
+	case BackendAuto:
+		impl = fastestBackend()
+		if impl == libsodiumBackend {
+			impl = autoLibsodiumBackend
+		}
+	default:
+		return CurrentBackend(), fmt.Errorf("unknown crypto backend %q", name)
+	}
+	activeBackend.Store(impl)
+	return impl.name, nil
+}
+
+// backendBenchmarkRounds is the number of signature and VRF verifications
+// timed for each backend when selecting one automatically.
+const backendBenchmarkRounds = 32
+
+// fastestBackend times verification, the dominant cost on a running node, on
+// every backend and returns the quickest. Ties go to libsodium.
+func fastestBackend() *backendImpl {
+	best := libsodiumBackend
+	bestElapsed := time.Duration(-1)
+	for _, impl := range []*backendImpl{libsodiumBackend, goBackend} {
+		elapsed := benchmarkBackend(impl)
+		if bestElapsed < 0 || elapsed < bestElapsed {
+			best, bestElapsed = impl, elapsed
+		}
+	}
+	return best
+}
+
+func benchmarkBackend(impl *backendImpl) time.Duration {
+	var seed [32]byte
+	copy(seed[:], "algorand crypto backend benchmark")
+	msg := []byte("crypto backend benchmark message")
+
+	sigPK, sigSK := impl.ed25519GenerateKeySeed(ed25519Seed(seed))
+	sig := impl.ed25519Sign(sigSK, msg)
+	vrfPK, vrfSK := impl.vrfKeygenFromSeed(seed)
+	proof, _ := impl.vrfProve(vrfSK, msg)
+
+	start := time.Now()
+	for i := 0; i < backendBenchmarkRounds; i++ {
+		impl.ed25519Verify(sigPK, msg, sig)
+		impl.vrfVerify(vrfPK, proof, msg)
+	}
+	return time.Since(start)
+}
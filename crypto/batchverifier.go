@@ -138,6 +138,11 @@ func (b *BatchVerifier) VerifyWithFeedback() (failed []bool, err error) {
 // it returns true if all the signatures were authentically signed by the owners
 // otherwise, returns false, and sets the indexes of the failed sigs in failed
 func batchVerificationImpl(messages [][]byte, publicKeys []SignatureVerifier, signatures []Signature) (allSigsValid bool, failed []bool) {
+	return currentBackend().batchVerify(messages, publicKeys, signatures)
+}
+
+// sodiumBatchVerify implements batchVerificationImpl with libsodium's batch verifier.
+func sodiumBatchVerify(messages [][]byte, publicKeys []SignatureVerifier, signatures []Signature) (allSigsValid bool, failed []bool) {
 
 	numberOfSignatures := len(messages)
 
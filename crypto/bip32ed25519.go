@@ -0,0 +1,326 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo darwin,arm64 LDFLAGS: ${SRCDIR}/libs/darwin/arm64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BIP32HardenedOffset is added to a derivation index to request hardened
+// derivation.
+const BIP32HardenedOffset uint32 = 0x80000000
+
+// BIP44 purpose and coin types used by ARC-0052 derivation paths.
+const (
+	bip44Purpose          uint32 = 44
+	bip44CoinTypeAlgorand uint32 = 283
+	bip44CoinTypeIdentity uint32 = 0
+)
+
+// bip32TruncatedBits is the number of high bits of Z_L discarded on every
+// derivation step (Peikert's amendment to BIP32-Ed25519, g = 9). It keeps
+// the child scalar below 2^255 for the derivation depths used by ARC-0052.
+const bip32TruncatedBits = 9
+
+var errBIP32KeyOverflow = errors.New("derived BIP32-Ed25519 scalar is out of range")
+var errBIP32HardenedPublic = errors.New("hardened keys cannot be derived from a public key")
+
+// ARC52Context selects the key context of an ARC-0052 derivation path.
+//
+//msgp:ignore ARC52Context
+type ARC52Context int
+
+const (
+	// ARC52ContextAddress derives keys used as Algorand addresses.
+	ARC52ContextAddress ARC52Context = iota
+	// ARC52ContextIdentity derives keys used for off-chain identity.
+	ARC52ContextIdentity
+)
+
+// ARC52Path returns the BIP44 derivation path m/44'/coin'/account'/0/index
+// for the given ARC-0052 context.
+func ARC52Path(context ARC52Context, account, index uint32) ([]uint32, error) {
+	var coin uint32
+	switch context {
+	case ARC52ContextAddress:
+		coin = bip44CoinTypeAlgorand
+	case ARC52ContextIdentity:
+		coin = bip44CoinTypeIdentity
+	default:
+		return nil, fmt.Errorf("unknown ARC-0052 context %d", context)
+	}
+	if account >= BIP32HardenedOffset || index >= BIP32HardenedOffset {
+		return nil, fmt.Errorf("account %d or index %d out of range", account, index)
+	}
+	return []uint32{
+		bip44Purpose + BIP32HardenedOffset,
+		coin + BIP32HardenedOffset,
+		account + BIP32HardenedOffset,
+		0,
+		index,
+	}, nil
+}
+
+// FormatBIP32Path renders a derivation path using the m/44'/283'/0'/0/0 notation.
+func FormatBIP32Path(path []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, idx := range path {
+		if idx >= BIP32HardenedOffset {
+			fmt.Fprintf(&b, "/%d'", idx-BIP32HardenedOffset)
+		} else {
+			fmt.Fprintf(&b, "/%d", idx)
+		}
+	}
+	return b.String()
+}
+
+// BIP32Ed25519Key is a BIP32-Ed25519 extended private key: the 32 byte
+// scalar k_L, the 32 byte nonce key k_R and the 32 byte chain code.
+//
+//msgp:ignore BIP32Ed25519Key
+type BIP32Ed25519Key [96]byte
+
+// BIP32Ed25519PublicKey is a BIP32-Ed25519 extended public key: the point
+// A = k_L*B followed by the 32 byte chain code. It can only derive
+// non-hardened children.
+//
+//msgp:ignore BIP32Ed25519PublicKey
+type BIP32Ed25519PublicKey [64]byte
+
+// BIP32Ed25519RootKey derives the root extended key from a seed, as done by
+// the ARC-0052 reference implementation.
+func BIP32Ed25519RootKey(seed []byte) (root BIP32Ed25519Key) {
+	k := sha512.Sum512(seed)
+	// Retry until the third highest bit of k_L is clear.
+	for k[31]&0x20 != 0 {
+		mac := hmac.New(sha512.New, k[:32])
+		mac.Write(k[32:])
+		copy(k[:], mac.Sum(nil))
+	}
+	k[0] &= 0xf8
+	k[31] &= 0x7f
+	k[31] |= 0x40
+
+	chain := sha256.Sum256(append([]byte{0x01}, seed...))
+	copy(root[:64], k[:])
+	copy(root[64:], chain[:])
+	return
+}
+
+func (k *BIP32Ed25519Key) kL() []byte        { return k[:32] }
+func (k *BIP32Ed25519Key) kR() []byte        { return k[32:64] }
+func (k *BIP32Ed25519Key) chainCode() []byte { return k[64:] }
+
+// PublicKey returns the Ed25519 public key k_L*B.
+func (k BIP32Ed25519Key) PublicKey() (pk PublicKey) {
+	// k_L stays below 2^255 (see DeriveChild), which is what the
+	// non-clamping base multiplication expects.
+	C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&pk[0]), (*C.uchar)(&k[0]))
+	return
+}
+
+// ExtendedPublicKey returns the extended public key matching k.
+func (k BIP32Ed25519Key) ExtendedPublicKey() (xpk BIP32Ed25519PublicKey) {
+	pk := k.PublicKey()
+	copy(xpk[:32], pk[:])
+	copy(xpk[32:], k.chainCode())
+	return
+}
+
+// DeriveChild derives the child extended key at index. Indexes at or above
+// BIP32HardenedOffset use hardened derivation.
+func (k BIP32Ed25519Key) DeriveChild(index uint32) (child BIP32Ed25519Key, err error) {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+
+	var data []byte
+	var zTag, ccTag byte
+	if index >= BIP32HardenedOffset {
+		data = append(append(append([]byte{0}, k.kL()...), k.kR()...), idx[:]...)
+		zTag, ccTag = 0x00, 0x01
+	} else {
+		pk := k.PublicKey()
+		data = append(append([]byte{0}, pk[:]...), idx[:]...)
+		zTag, ccTag = 0x02, 0x03
+	}
+	z, cc := bip32HMACs(k.chainCode(), data, zTag, ccTag)
+
+	if !bip32AddScaledZL(child[:32], k.kL(), z[:32]) {
+		return BIP32Ed25519Key{}, errBIP32KeyOverflow
+	}
+	bip32Add256(child[32:64], k.kR(), z[32:])
+	copy(child[64:], cc[32:])
+	return child, nil
+}
+
+// DerivePath derives the extended key at path, starting from k.
+func (k BIP32Ed25519Key) DerivePath(path []uint32) (BIP32Ed25519Key, error) {
+	var err error
+	for _, idx := range path {
+		k, err = k.DeriveChild(idx)
+		if err != nil {
+			return BIP32Ed25519Key{}, err
+		}
+	}
+	return k, nil
+}
+
+// DeriveChild derives the non-hardened child extended public key at index.
+// The result matches the public half of BIP32Ed25519Key.DeriveChild.
+func (xpk BIP32Ed25519PublicKey) DeriveChild(index uint32) (child BIP32Ed25519PublicKey, err error) {
+	if index >= BIP32HardenedOffset {
+		return BIP32Ed25519PublicKey{}, errBIP32HardenedPublic
+	}
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+	data := append(append([]byte{0}, xpk[:32]...), idx[:]...)
+	z, cc := bip32HMACs(xpk[32:], data, 0x02, 0x03)
+
+	var zero, scaled [32]byte
+	bip32AddScaledZL(scaled[:], zero[:], z[:32])
+	var point [32]byte
+	if C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&point[0]), (*C.uchar)(&scaled[0])) != 0 {
+		return BIP32Ed25519PublicKey{}, errBIP32KeyOverflow
+	}
+	if C.crypto_core_ed25519_add((*C.uchar)(&child[0]), (*C.uchar)(&xpk[0]), (*C.uchar)(&point[0])) != 0 {
+		return BIP32Ed25519PublicKey{}, errBIP32KeyOverflow
+	}
+	copy(child[32:], cc[32:])
+	return child, nil
+}
+
+// PublicKey returns the Ed25519 public key of the extended public key.
+func (xpk BIP32Ed25519PublicKey) PublicKey() (pk PublicKey) {
+	copy(pk[:], xpk[:32])
+	return
+}
+
+// Sign produces a signature of a Hashable message that verifies under
+// k.PublicKey() like any other Ed25519 signature.
+func (k BIP32Ed25519Key) Sign(message Hashable) Signature {
+	return k.SignBytes(HashRep(message))
+}
+
+// SignBytes signs a message directly, without first hashing.
+// Caller is responsible for domain separation.
+func (k BIP32Ed25519Key) SignBytes(message []byte) (sig Signature) {
+	pk := k.PublicKey()
+
+	// r = H(k_R || M) mod L, R = r*B
+	h := sha512.New()
+	h.Write(k.kR())
+	h.Write(message)
+	var r [32]byte
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&r[0]), (*C.uchar)(&h.Sum(nil)[0]))
+	C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&sig[0]), (*C.uchar)(&r[0]))
+
+	// S = (H(R || A || M) * k_L + r) mod L
+	h.Reset()
+	h.Write(sig[:32])
+	h.Write(pk[:])
+	h.Write(message)
+	var hram [32]byte
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&hram[0]), (*C.uchar)(&h.Sum(nil)[0]))
+	s := bip32ScalarMul(hram, k.kL())
+	C.crypto_core_ed25519_scalar_add((*C.uchar)(&sig[32]), (*C.uchar)(&s[0]), (*C.uchar)(&r[0]))
+	return
+}
+
+// bip32ScalarMul returns (h * kL) mod L. The libsodium we link has no public
+// scalar multiplication, so it doubles and adds with crypto_core_ed25519_scalar_add,
+// which reduces its result; it only branches on the bits of h, which is public
+// since every verifier computes it.
+func bip32ScalarMul(h [32]byte, kL []byte) (s [32]byte) {
+	for i := 255; i >= 0; i-- {
+		C.crypto_core_ed25519_scalar_add((*C.uchar)(&s[0]), (*C.uchar)(&s[0]), (*C.uchar)(&s[0]))
+		if h[i/8]>>(i%8)&1 == 1 {
+			C.crypto_core_ed25519_scalar_add((*C.uchar)(&s[0]), (*C.uchar)(&s[0]), (*C.uchar)(&kL[0]))
+		}
+	}
+	return
+}
+
+// bip32HMACs returns HMAC-SHA512(chainCode, tag || data[1:]) for both tags.
+func bip32HMACs(chainCode []byte, data []byte, zTag, ccTag byte) (z, cc []byte) {
+	mac := hmac.New(sha512.New, chainCode)
+	data[0] = zTag
+	mac.Write(data)
+	z = mac.Sum(nil)
+
+	mac.Reset()
+	data[0] = ccTag
+	mac.Write(data)
+	cc = mac.Sum(nil)
+	return
+}
+
+// bip32AddScaledZL sets out = kL + 8*trunc(zL) as 256 bit little-endian
+// integers, where trunc drops the top bip32TruncatedBits bits of zL. It
+// reports false if the result does not fit below 2^255.
+func bip32AddScaledZL(out, kL, zL []byte) bool {
+	var t [32]byte
+	copy(t[:], zL)
+	t[31] = 0
+	t[30] &= 0xff >> (bip32TruncatedBits - 8)
+
+	var carry uint16
+	for i := 0; i < 32; i++ {
+		// 8*trunc(zL): shift left by 3 bits, carrying across bytes.
+		shifted := uint16(t[i]) << 3
+		if i > 0 {
+			shifted |= uint16(t[i-1]) >> 5
+		}
+		sum := uint16(kL[i]) + (shifted & 0xff) + carry
+		out[i] = byte(sum)
+		carry = sum >> 8
+	}
+	return carry == 0 && out[31]&0x80 == 0
+}
+
+// bip32Add256 sets out = (a + b) mod 2^256 for little-endian integers.
+func bip32Add256(out, a, b []byte) {
+	var carry uint16
+	for i := 0; i < 32; i++ {
+		sum := uint16(a[i]) + uint16(b[i]) + carry
+		out[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
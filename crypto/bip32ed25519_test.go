@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP32Ed25519RootKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for i := 0; i < 64; i++ {
+		seed := make([]byte, 32)
+		RandBytes(seed)
+		root := BIP32Ed25519RootKey(seed)
+		require.Equal(t, root, BIP32Ed25519RootKey(seed))
+		require.Zero(t, root[0]&0x07)
+		require.Equal(t, byte(0x40), root[31]&0xe0)
+	}
+}
+
+func TestBIP32Ed25519SignVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed MasterDerivationKey
+	RandBytes(seed[:])
+	root := BIP32Ed25519RootKey(seed[:])
+
+	for _, context := range []ARC52Context{ARC52ContextAddress, ARC52ContextIdentity} {
+		for index := uint32(0); index < 4; index++ {
+			path, err := ARC52Path(context, 1, index)
+			require.NoError(t, err)
+			key, err := root.DerivePath(path)
+			require.NoError(t, err)
+
+			pk := SignatureVerifier(key.PublicKey())
+			msg := TestingHashable{[]byte("message")}
+			sig := key.Sign(msg)
+			require.True(t, pk.Verify(msg, sig))
+			require.False(t, pk.Verify(TestingHashable{[]byte("other")}, sig))
+
+			raw := make([]byte, 100)
+			RandBytes(raw)
+			require.True(t, pk.VerifyBytes(raw, key.SignBytes(raw)))
+			require.True(t, pk.VerifyBytes(nil, key.SignBytes(nil)))
+		}
+	}
+}
+
+func TestBIP32Ed25519PublicDerivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed MasterDerivationKey
+	RandBytes(seed[:])
+	root := BIP32Ed25519RootKey(seed[:])
+	account, err := root.DerivePath([]uint32{44 + BIP32HardenedOffset, 283 + BIP32HardenedOffset, BIP32HardenedOffset})
+	require.NoError(t, err)
+
+	for change := uint32(0); change < 2; change++ {
+		child, err := account.DeriveChild(change)
+		require.NoError(t, err)
+		xpk, err := account.ExtendedPublicKey().DeriveChild(change)
+		require.NoError(t, err)
+		require.Equal(t, child.ExtendedPublicKey(), xpk)
+
+		for index := uint32(0); index < 8; index++ {
+			leaf, err := child.DeriveChild(index)
+			require.NoError(t, err)
+			leafPub, err := xpk.DeriveChild(index)
+			require.NoError(t, err)
+			require.Equal(t, leaf.PublicKey(), leafPub.PublicKey())
+		}
+	}
+
+	_, err = account.ExtendedPublicKey().DeriveChild(BIP32HardenedOffset)
+	require.ErrorIs(t, err, errBIP32HardenedPublic)
+}
+
+func TestBIP32Ed25519DistinctKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed MasterDerivationKey
+	RandBytes(seed[:])
+	root := BIP32Ed25519RootKey(seed[:])
+
+	seen := make(map[PublicKey]string)
+	for account := uint32(0); account < 3; account++ {
+		for index := uint32(0); index < 3; index++ {
+			path, err := ARC52Path(ARC52ContextAddress, account, index)
+			require.NoError(t, err)
+			key, err := root.DerivePath(path)
+			require.NoError(t, err)
+			pk := key.PublicKey()
+			require.NotContains(t, seen, pk)
+			seen[pk] = FormatBIP32Path(path)
+		}
+	}
+}
+
+func TestARC52Path(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path, err := ARC52Path(ARC52ContextAddress, 2, 7)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/283'/2'/0/7", FormatBIP32Path(path))
+
+	path, err = ARC52Path(ARC52ContextIdentity, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/0'/0'/0/0", FormatBIP32Path(path))
+
+	_, err = ARC52Path(ARC52ContextAddress, BIP32HardenedOffset, 0)
+	require.Error(t, err)
+	_, err = ARC52Path(ARC52Context(5), 0, 0)
+	require.Error(t, err)
+}
+
+func TestBIP32Ed25519ScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+	littleEndian := func(b []byte) *big.Int {
+		be := make([]byte, len(b))
+		for i := range b {
+			be[len(b)-1-i] = b[i]
+		}
+		return new(big.Int).SetBytes(be)
+	}
+
+	var seed MasterDerivationKey
+	for i := 0; i < 16; i++ {
+		RandBytes(seed[:])
+		key := BIP32Ed25519RootKey(seed[:])
+		var h [32]byte
+		RandBytes(h[:31])
+		if i == 0 {
+			h = [32]byte{}
+		}
+
+		s := bip32ScalarMul(h, key.kL())
+		expected := new(big.Int).Mul(littleEndian(h[:]), littleEndian(key.kL()))
+		expected.Mod(expected, l)
+		require.Zero(t, expected.Cmp(littleEndian(s[:])))
+	}
+}
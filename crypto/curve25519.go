@@ -97,11 +97,23 @@ func ed25519GenerateKeyRNG(rng RNG) (public ed25519PublicKey, secret ed25519Priv
 }
 
 func ed25519GenerateKeySeed(seed ed25519Seed) (public ed25519PublicKey, secret ed25519PrivateKey) {
+	return currentBackend().ed25519GenerateKeySeed(seed)
+}
+
+func ed25519Sign(secret ed25519PrivateKey, data []byte) (sig ed25519Signature) {
+	return currentBackend().ed25519Sign(secret, data)
+}
+
+func ed25519Verify(public ed25519PublicKey, data []byte, sig ed25519Signature) bool {
+	return currentBackend().ed25519Verify(public, data, sig)
+}
+
+func sodiumEd25519GenerateKeySeed(seed ed25519Seed) (public ed25519PublicKey, secret ed25519PrivateKey) {
 	C.crypto_sign_ed25519_seed_keypair((*C.uchar)(&public[0]), (*C.uchar)(&secret[0]), (*C.uchar)(&seed[0]))
 	return
 }
 
-func ed25519Sign(secret ed25519PrivateKey, data []byte) (sig ed25519Signature) {
+func sodiumEd25519Sign(secret ed25519PrivateKey, data []byte) (sig ed25519Signature) {
 	// &data[0] will make Go panic if msg is zero length
 	d := (*C.uchar)(C.NULL)
 	if len(data) != 0 {
@@ -112,7 +124,7 @@ func ed25519Sign(secret ed25519PrivateKey, data []byte) (sig ed25519Signature) {
 	return
 }
 
-func ed25519Verify(public ed25519PublicKey, data []byte, sig ed25519Signature) bool {
+func sodiumEd25519Verify(public ed25519PublicKey, data []byte, sig ed25519Signature) bool {
 	// &data[0] will make Go panic if msg is zero length
 	d := (*C.uchar)(C.NULL)
 	if len(data) != 0 {
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	cfalcon "github.com/algorand/falcon"
+)
+
+// FalconBackend is an implementation of the Falcon primitives behind
+// FalconSigner and FalconVerifier.
+//
+// Falcon signatures are verified as part of consensus, when state proofs are
+// validated, so every backend must accept exactly the signatures the reference
+// implementation accepts and produce signatures in the same compressed format.
+// Backends optimized for specific hardware, or wrapping an external library,
+// are compiled in under a build tag and register themselves, from an init
+// function, with RegisterFalconBackend.
+type FalconBackend interface {
+	// Name identifies the backend in the configuration.
+	Name() string
+	// GenerateKey derives a key pair from a seed.
+	GenerateKey(seed []byte) (FalconPublicKey, FalconPrivateKey, error)
+	// SignCompressed signs msg and returns the signature in compressed form.
+	SignCompressed(sk *FalconPrivateKey, msg []byte) ([]byte, error)
+	// Verify checks a compressed signature of msg.
+	Verify(pk *FalconPublicKey, sig []byte, msg []byte) error
+}
+
+// FalconReferenceBackend is the name of the portable C implementation of Falcon,
+// which is always available and used by default.
+const FalconReferenceBackend = "reference"
+
+type falconReference struct{}
+
+func (falconReference) Name() string {
+	return FalconReferenceBackend
+}
+
+func (falconReference) GenerateKey(seed []byte) (FalconPublicKey, FalconPrivateKey, error) {
+	pk, sk, err := cfalcon.GenerateKey(seed)
+	return FalconPublicKey(pk), FalconPrivateKey(sk), err
+}
+
+func (falconReference) SignCompressed(sk *FalconPrivateKey, msg []byte) ([]byte, error) {
+	return (*cfalcon.PrivateKey)(sk).SignCompressed(msg)
+}
+
+func (falconReference) Verify(pk *FalconPublicKey, sig []byte, msg []byte) error {
+	return (*cfalcon.PublicKey)(pk).Verify(cfalcon.CompressedSignature(sig), msg)
+}
+
+var falconBackends = struct {
+	mu       sync.Mutex
+	byName   map[string]FalconBackend
+	selected atomic.Pointer[FalconBackend]
+}{
+	byName: make(map[string]FalconBackend),
+}
+
+func init() {
+	RegisterFalconBackend(falconReference{})
+	if _, err := SetFalconBackend(FalconReferenceBackend); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterFalconBackend makes a Falcon backend available for selection with
+// SetFalconBackend. It panics if a backend with the same name is registered twice.
+func RegisterFalconBackend(b FalconBackend) {
+	falconBackends.mu.Lock()
+	defer falconBackends.mu.Unlock()
+	if _, exists := falconBackends.byName[b.Name()]; exists {
+		panic(fmt.Sprintf("falcon backend %q registered twice", b.Name()))
+	}
+	falconBackends.byName[b.Name()] = b
+}
+
+// FalconBackends returns the names of the registered Falcon backends, sorted.
+func FalconBackends() []string {
+	falconBackends.mu.Lock()
+	defer falconBackends.mu.Unlock()
+	return falconBackendNames()
+}
+
+// SetFalconBackend selects the registered Falcon backend used from now on.
+// An empty name selects the reference implementation. It returns the name of
+// the selected backend.
+func SetFalconBackend(name string) (string, error) {
+	if name == "" {
+		name = FalconReferenceBackend
+	}
+	falconBackends.mu.Lock()
+	defer falconBackends.mu.Unlock()
+	b, ok := falconBackends.byName[name]
+	if !ok {
+		return "", fmt.Errorf("unknown falcon backend %q, not one of %v", name, falconBackendNames())
+	}
+	falconBackends.selected.Store(&b)
+	return name, nil
+}
+
+// falconBackendNames lists the registered backends; the caller holds falconBackends.mu.
+func falconBackendNames() []string {
+	names := make([]string, 0, len(falconBackends.byName))
+	for name := range falconBackends.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CurrentFalconBackend returns the name of the Falcon backend in use.
+func CurrentFalconBackend() string {
+	return currentFalconBackend().Name()
+}
+
+func currentFalconBackend() FalconBackend {
+	return *falconBackends.selected.Load()
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// countingFalconBackend wraps the reference backend and counts the calls it serves.
+type countingFalconBackend struct {
+	falconReference
+	calls atomic.Int64
+}
+
+func (b *countingFalconBackend) Name() string {
+	return "counting"
+}
+
+func (b *countingFalconBackend) GenerateKey(seed []byte) (FalconPublicKey, FalconPrivateKey, error) {
+	b.calls.Add(1)
+	return b.falconReference.GenerateKey(seed)
+}
+
+func (b *countingFalconBackend) SignCompressed(sk *FalconPrivateKey, msg []byte) ([]byte, error) {
+	b.calls.Add(1)
+	return b.falconReference.SignCompressed(sk, msg)
+}
+
+func (b *countingFalconBackend) Verify(pk *FalconPublicKey, sig []byte, msg []byte) error {
+	b.calls.Add(1)
+	return b.falconReference.Verify(pk, sig, msg)
+}
+
+var testCountingFalconBackend = &countingFalconBackend{}
+
+func init() {
+	RegisterFalconBackend(testCountingFalconBackend)
+}
+
+func TestFalconBackendSelection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// not parallel: the backend is package-wide state
+	a := require.New(t)
+
+	a.Equal(FalconReferenceBackend, CurrentFalconBackend())
+	a.Contains(FalconBackends(), FalconReferenceBackend)
+
+	_, err := SetFalconBackend("missing")
+	a.ErrorContains(err, "unknown falcon backend")
+	a.Equal(FalconReferenceBackend, CurrentFalconBackend())
+
+	counting := testCountingFalconBackend
+	counting.calls.Store(0)
+	a.Panics(func() { RegisterFalconBackend(&countingFalconBackend{}) })
+	a.Equal([]string{"counting", FalconReferenceBackend}, FalconBackends())
+
+	name, err := SetFalconBackend("counting")
+	a.NoError(err)
+	a.Equal("counting", name)
+	defer SetFalconBackend("")
+
+	var seed FalconSeed
+	SystemRNG.RandBytes(seed[:])
+	key, err := GenerateFalconSigner(seed)
+	a.NoError(err)
+	msg := []byte("falcon backend")
+	sig, err := key.SignBytes(msg)
+	a.NoError(err)
+	a.NoError(key.GetVerifyingKey().VerifyBytes(msg, sig))
+	a.EqualValues(3, counting.calls.Load())
+
+	// signatures made by one backend verify under the others
+	name, err = SetFalconBackend("")
+	a.NoError(err)
+	a.Equal(FalconReferenceBackend, name)
+	a.NoError(key.GetVerifyingKey().VerifyBytes(msg, sig))
+	a.EqualValues(3, counting.calls.Load())
+}
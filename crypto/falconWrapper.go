@@ -50,10 +50,10 @@ type FalconSigner struct {
 
 // GenerateFalconSigner Generates a Falcon Signer.
 func GenerateFalconSigner(seed FalconSeed) (FalconSigner, error) {
-	pk, sk, err := cfalcon.GenerateKey(seed[:])
+	pk, sk, err := currentFalconBackend().GenerateKey(seed[:])
 	return FalconSigner{
-		PublicKey:  FalconPublicKey(pk),
-		PrivateKey: FalconPrivateKey(sk),
+		PublicKey:  pk,
+		PrivateKey: sk,
 	}, err
 }
 
@@ -65,7 +65,7 @@ func (d *FalconSigner) Sign(message Hashable) (FalconSignature, error) {
 
 // SignBytes receives bytes and signs over them.
 func (d *FalconSigner) SignBytes(data []byte) (FalconSignature, error) {
-	signedData, err := (*cfalcon.PrivateKey)(&d.PrivateKey).SignCompressed(data)
+	signedData, err := currentFalconBackend().SignCompressed(&d.PrivateKey, data)
 	return FalconSignature(signedData), err
 }
 
@@ -93,8 +93,7 @@ func (d *FalconVerifier) Verify(message Hashable, sig FalconSignature) error {
 func (d *FalconVerifier) VerifyBytes(data []byte, sig FalconSignature) error {
 	// The wrapper, currently, support only the compress form signature. so we can
 	// assume that the signature given is in a compress form
-	falconSig := cfalcon.CompressedSignature(sig)
-	return (*cfalcon.PublicKey)(&d.PublicKey).Verify(falconSig, data)
+	return currentFalconBackend().Verify(&d.PublicKey, sig, data)
 }
 
 // GetFixedLengthHashableRepresentation is used to fetch a plain serialized version of the public data (without the use of the msgpack).
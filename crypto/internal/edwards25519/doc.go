@@ -0,0 +1,17 @@
+// Copyright (c) 2021 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edwards25519 implements group logic for the twisted Edwards curve
+//
+//	-x^2 + y^2 = 1 + -(121665/121666)*x^2*y^2
+//
+// This is better known as the Edwards curve equivalent to Curve25519, and is
+// the curve used by the Ed25519 signature scheme.
+//
+// This is a copy of the generic (assembly free) implementation found in the
+// crypto/internal/fips140/edwards25519 package of the Go standard library. It
+// backs the pure Go implementations of the ed25519 and VRF primitives of the
+// crypto package, which are selectable at runtime as an alternative to
+// libsodium.
+package edwards25519
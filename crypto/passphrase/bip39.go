@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// bip39MnemonicLenWords is the length of the BIP-39 mnemonics of 32-byte keys: 256 bits of
+// entropy followed by an 8-bit checksum, split into words of 11 bits.
+const bip39MnemonicLenWords = (keyLenBytes*8 + keyLenBytes/4) / bitsPerWord
+
+// bip39SeedIterations is the number of PBKDF2 iterations BIP-39 derives seeds with.
+const bip39SeedIterations = 2048
+
+var errWrongBIP39MnemonicLen = fmt.Errorf("BIP-39 mnemonic must be %d words", bip39MnemonicLenWords)
+
+// KeyToBIP39Mnemonic converts a 32-byte key into the 24 word BIP-39 mnemonic
+// having it as entropy. Unlike the mnemonics of KeyToMnemonic, the words hold
+// the bits of the key in big-endian order, followed by the first byte of its
+// SHA-256 hash as checksum.
+func KeyToBIP39Mnemonic(key []byte) (string, error) {
+	if len(key) != keyLenBytes {
+		return "", errWrongKeyLen
+	}
+	hash := sha256.Sum256(key)
+	bits := append(append([]byte{}, key...), hash[0])
+
+	words := make([]string, bip39MnemonicLenWords)
+	for i := range words {
+		var index int
+		for b := i * bitsPerWord; b < (i+1)*bitsPerWord; b++ {
+			index = index<<1 | int(bits[b/8]>>(7-b%8)&1)
+		}
+		words[i] = wordlist[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// BIP39MnemonicToKey converts a 24 word BIP-39 mnemonic into the 32-byte key
+// it encodes. It returns an error if the checksum is incorrect, if the number
+// of words is unexpected, or if one of the words is not found in the words list.
+func BIP39MnemonicToKey(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) != bip39MnemonicLenWords {
+		return nil, errWrongBIP39MnemonicLen
+	}
+
+	bits := make([]byte, keyLenBytes+1)
+	for i, w := range words {
+		index := indexOf(wordlist, w)
+		if index == -1 {
+			return nil, fmt.Errorf("%s is not in the words list", w)
+		}
+		for b := 0; b < bitsPerWord; b++ {
+			if index>>(bitsPerWord-1-b)&1 != 0 {
+				pos := i*bitsPerWord + b
+				bits[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+
+	key := bits[:keyLenBytes]
+	if hash := sha256.Sum256(key); hash[0] != bits[keyLenBytes] {
+		return nil, errWrongChecksum
+	}
+	return key, nil
+}
+
+// BIP39Seed derives the 64-byte seed of a BIP-39 mnemonic protected by the
+// given passphrase, which is empty when there is none. The mnemonic and the
+// passphrase are expected to be in NFKD form already, which holds for the
+// words of the English list and ASCII passphrases.
+func BIP39Seed(mnemonic, passphrase string) []byte {
+	return pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+passphrase), bip39SeedIterations, sha512.Size, sha512.New)
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestBIP39Vectors checks the 256-bit entropy vectors of the BIP-39
+// reference, all of which use the passphrase "TREZOR".
+func TestBIP39Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	vectors := []struct {
+		entropy  byte
+		mnemonic string
+		seed     string
+	}{
+		{
+			0x00,
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+		},
+		{
+			0x7f,
+			"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth title",
+			"bc09fca1804f7e69da93c2f2028eb238c227f2e9dda30cd63699232578480a4021b146ad717fbb7e451ce9eb835f43620bf5c514db0f8add49f5d121449d3e87",
+		},
+		{
+			0x80,
+			"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+			"c0c519bd0e91a2ed54357d9d1ebef6f5af218a153624cf4f2da911a0ed8f7a09e2ef61af0aca007096df430022f7a2b6fb91661a9589097069720d015e4e982f",
+		},
+		{
+			0xff,
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+			"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+		},
+	}
+	for _, v := range vectors {
+		entropy := bytes.Repeat([]byte{v.entropy}, 32)
+
+		m, err := KeyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.mnemonic, m)
+
+		key, err := BIP39MnemonicToKey(v.mnemonic)
+		require.NoError(t, err)
+		require.Equal(t, entropy, key)
+
+		require.Equal(t, v.seed, hex.EncodeToString(BIP39Seed(v.mnemonic, "TREZOR")))
+	}
+}
+
+func TestBIP39Recovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	key := make([]byte, 32)
+	for i := 0; i < 1000; i++ {
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		m, err := KeyToBIP39Mnemonic(key)
+		require.NoError(t, err)
+		recovered, err := BIP39MnemonicToKey(m)
+		require.NoError(t, err)
+		require.Equal(t, key, recovered)
+	}
+}
+
+func TestBIP39InvalidMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	words := strings.Split(strings.Repeat("zoo ", 23)+"vote", sepStr)
+
+	// Shuffle the last word, which holds the checksum
+	wl := append([]string{}, words...)
+	wl[len(wl)-1] = "wrong"
+	_, err := BIP39MnemonicToKey(strings.Join(wl, sepStr))
+	require.ErrorIs(t, err, errWrongChecksum)
+
+	wl[len(wl)-1] = "zzz"
+	_, err = BIP39MnemonicToKey(strings.Join(wl, sepStr))
+	require.Error(t, err)
+
+	_, err = BIP39MnemonicToKey(strings.Join(words[1:], sepStr))
+	require.ErrorIs(t, err, errWrongBIP39MnemonicLen)
+
+	// Algorand mnemonics have one more word
+	m, err := KeyToMnemonic(make([]byte, 32))
+	require.NoError(t, err)
+	_, err = BIP39MnemonicToKey(m)
+	require.ErrorIs(t, err, errWrongBIP39MnemonicLen)
+
+	_, err = KeyToBIP39Mnemonic(make([]byte, 31))
+	require.Error(t, err)
+}
+
+// TestARC52Vectors checks the root key and the derived public keys of the
+// ARC-0052 specification, which starts from a BIP-39 mnemonic without
+// passphrase.
+func TestARC52Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mnemonic := "salon zoo engage submit smile frost later decide wing sight chaos renew lizard rely canal coral scene hobby scare step bus leaf tobacco slice"
+	_, err := BIP39MnemonicToKey(mnemonic)
+	require.NoError(t, err)
+
+	root := crypto.BIP32Ed25519RootKey(BIP39Seed(mnemonic, ""))
+	require.Equal(t, "a8ba80028922d9fcfa055c78aede55b5c575bcd8d5a53168edf45f36d9ec8f4694592b4bc892907583e22669ecdf1b0409a9f3bd5549f2dd751b51360909cd05796b9206ec30e142e94b790a98805bf999042b55046963174ee6cee2d0375946", hex.EncodeToString(root[:]))
+
+	vectors := []struct {
+		context crypto.ARC52Context
+		account uint32
+		index   uint32
+		pk      string
+	}{
+		{crypto.ARC52ContextAddress, 0, 0, "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9"},
+		{crypto.ARC52ContextAddress, 0, 1, "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519"},
+		{crypto.ARC52ContextAddress, 0, 2, "00a72635e97cba966529e9bfb4baf4a32d7b8cd2fcd8e2476ce5be1177848cb3"},
+		{crypto.ARC52ContextAddress, 1, 0, "358d8c4382992849a764438e02b1c45c2ca4e86bbcfe10fd5b963f3610012bc9"},
+		{crypto.ARC52ContextIdentity, 0, 0, "ff8b1863ef5e40d0a48c245f26a6dbdf5da94dc75a1851f51d8a04e547bd5f5a"},
+		{crypto.ARC52ContextIdentity, 0, 1, "2b46c2af0890493e486049d456509a0199e565b41a5fb622f0ea4b9337bd2b97"},
+		{crypto.ARC52ContextIdentity, 0, 2, "2713f135f19ef3dcfca73cb536b1e077b1165cd0b7bedbef709447319ff0016d"},
+	}
+	for _, v := range vectors {
+		path, err := crypto.ARC52Path(v.context, v.account, v.index)
+		require.NoError(t, err)
+		key, err := root.DerivePath(path)
+		require.NoError(t, err)
+		pk := key.PublicKey()
+		require.Equal(t, v.pk, hex.EncodeToString(pk[:]), crypto.FormatBIP32Path(path))
+	}
+}
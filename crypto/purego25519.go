@@ -0,0 +1,457 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+
+	"github.com/algorand/go-algorand/crypto/internal/edwards25519"
+	"github.com/algorand/go-algorand/crypto/internal/edwards25519/field"
+)
+
+// The functions in this file are pure Go ports of the libsodium routines
+// wrapped in curve25519.go, batchverifier.go and vrf.go. They must stay
+// bit-for-bit compatible with the C code: the same keys and signatures are
+// produced, and exactly the same inputs are accepted and rejected, so that
+// nodes running either backend always agree on validity.
+
+// vrfSuite is the ECVRF-ED25519-SHA512-Elligator2 suite string of draft-03.
+const vrfSuite = 0x04
+
+// smallOrderEncodings lists the encodings (ignoring the sign bit) of the
+// points of small order, as rejected by ge25519_has_small_order.
+var smallOrderEncodings = [][32]byte{
+	// 0 (order 4)
+	{},
+	// 1 (order 1)
+	{0x01},
+	// order 8
+	{0x26, 0xe8, 0x95, 0x8f, 0xc2, 0xb2, 0x27, 0xb0, 0x45, 0xc3, 0xf4, 0x89, 0xf2, 0xef, 0x98, 0xf0,
+		0xd5, 0xdf, 0xac, 0x05, 0xd3, 0xc6, 0x33, 0x39, 0xb1, 0x38, 0x02, 0x88, 0x6d, 0x53, 0xfc, 0x05},
+	// order 8
+	{0xc7, 0x17, 0x6a, 0x70, 0x3d, 0x4d, 0xd8, 0x4f, 0xba, 0x3c, 0x0b, 0x76, 0x0d, 0x10, 0x67, 0x0f,
+		0x2a, 0x20, 0x53, 0xfa, 0x2c, 0x39, 0xcc, 0xc6, 0x4e, 0xc7, 0xfd, 0x77, 0x92, 0xac, 0x03, 0x7a},
+	// p-1 (order 2)
+	{0xec, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+	// p (=0, order 4)
+	{0xed, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+	// p+1 (=1, order 1)
+	{0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+}
+
+// hasSmallOrder mirrors ge25519_has_small_order.
+func hasSmallOrder(s *[32]byte) bool {
+	for i := range smallOrderEncodings {
+		var c byte
+		for j := 0; j < 31; j++ {
+			c |= s[j] ^ smallOrderEncodings[i][j]
+		}
+		c |= (s[31] & 0x7f) ^ smallOrderEncodings[i][31]
+		if c == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isCanonicalPoint mirrors ge25519_is_canonical: it rejects encodings whose
+// y coordinate is not reduced modulo p.
+func isCanonicalPoint(s *[32]byte) bool {
+	if s[31]&0x7f != 0x7f || s[0] < 0xed {
+		return true
+	}
+	for i := 1; i < 31; i++ {
+		if s[i] != 0xff {
+			return true
+		}
+	}
+	return false
+}
+
+// isCanonicalPointVartime mirrors ge25519_is_canonical_vartime, which in
+// addition rejects the negative-zero encodings of the points (0,1) and (0,-1).
+func isCanonicalPointVartime(s *[32]byte) bool {
+	if s[0] >= 0xed {
+		return isCanonicalPoint(s)
+	}
+	negZeroOne := [32]byte{0: 0x01, 31: 0x80}
+	negZeroMinusOne := [32]byte{0: 0xec}
+	for i := 1; i < 32; i++ {
+		negZeroMinusOne[i] = 0xff
+	}
+	return *s != negZeroOne && *s != negZeroMinusOne
+}
+
+// mulByCofactor sets v = 8*p and returns v.
+func mulByCofactor(v, p *edwards25519.Point) *edwards25519.Point {
+	v.Add(p, p)
+	v.Add(v, v)
+	return v.Add(v, v)
+}
+
+func goEd25519GenerateKeySeed(seed ed25519Seed) (public ed25519PublicKey, secret ed25519PrivateKey) {
+	h := sha512.Sum512(seed[:])
+	s, _ := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	copy(public[:], new(edwards25519.Point).ScalarBaseMult(s).Bytes())
+	copy(secret[:32], seed[:])
+	copy(secret[32:], public[:])
+	return
+}
+
+func goEd25519Sign(secret ed25519PrivateKey, data []byte) (sig ed25519Signature) {
+	az := sha512.Sum512(secret[:32])
+	s, _ := edwards25519.NewScalar().SetBytesWithClamping(az[:32])
+
+	hs := sha512.New()
+	hs.Write(az[32:])
+	hs.Write(data)
+	var nonce [64]byte
+	r, _ := edwards25519.NewScalar().SetUniformBytes(hs.Sum(nonce[:0]))
+	copy(sig[:32], new(edwards25519.Point).ScalarBaseMult(r).Bytes())
+
+	// like libsodium, the public half of the secret key is trusted as is
+	hs.Reset()
+	hs.Write(sig[:32])
+	hs.Write(secret[32:])
+	hs.Write(data)
+	var hram [64]byte
+	k, _ := edwards25519.NewScalar().SetUniformBytes(hs.Sum(hram[:0]))
+	copy(sig[32:], edwards25519.NewScalar().MultiplyAdd(k, s, r).Bytes())
+	return
+}
+
+// goEd25519Verify mirrors crypto_sign_ed25519_bv_compatible_verify_detached,
+// including its cofactored verification equation.
+func goEd25519Verify(public ed25519PublicKey, data []byte, sig ed25519Signature) bool {
+	var r [32]byte
+	copy(r[:], sig[:32])
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return false
+	}
+	pk := [32]byte(public)
+	if !isCanonicalPointVartime(&r) || !isCanonicalPointVartime(&pk) || hasSmallOrder(&pk) {
+		return false
+	}
+	a, err := new(edwards25519.Point).SetBytes(pk[:])
+	if err != nil {
+		return false
+	}
+	a.Negate(a)
+	rsig, err := new(edwards25519.Point).SetBytes(r[:])
+	if err != nil {
+		return false
+	}
+
+	hs := sha512.New()
+	hs.Write(r[:])
+	hs.Write(pk[:])
+	hs.Write(data)
+	var hram [64]byte
+	k, _ := edwards25519.NewScalar().SetUniformBytes(hs.Sum(hram[:0]))
+
+	rcheck := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(k, a, s)
+	rcheck.Subtract(rcheck, rsig)
+	mulByCofactor(rcheck, rcheck)
+	return rcheck.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+// goBatchVerify checks each signature on its own; this accepts exactly the
+// signatures accepted by the libsodium batch verifier.
+func goBatchVerify(messages [][]byte, publicKeys []SignatureVerifier, signatures []Signature) (allSigsValid bool, failed []bool) {
+	allSigsValid = true
+	failed = make([]bool, len(messages))
+	for i := range messages {
+		if !goEd25519Verify(ed25519PublicKey(publicKeys[i]), messages[i], ed25519Signature(signatures[i])) {
+			failed[i] = true
+			allSigsValid = false
+		}
+	}
+	return allSigsValid, failed
+}
+
+// vrfStringToPoint mirrors _vrf_ietfdraft03_string_to_point.
+func vrfStringToPoint(s []byte) (*edwards25519.Point, bool) {
+	var buf [32]byte
+	copy(buf[:], s)
+	if !isCanonicalPoint(&buf) {
+		return nil, false
+	}
+	p, err := new(edwards25519.Point).SetBytes(buf[:])
+	if err != nil {
+		return nil, false
+	}
+	return p, true
+}
+
+// curve25519A is the Montgomery curve coefficient A = 486662.
+var curve25519A = func() *field.Element {
+	var b [32]byte
+	b[0], b[1], b[2] = 0x06, 0x6d, 0x07
+	e, _ := new(field.Element).SetBytes(b[:])
+	return e
+}()
+
+// vrfFromUniform mirrors ge25519_from_uniform: it maps r to a curve point with
+// Elligator 2 and clears the cofactor.
+func vrfFromUniform(r [32]byte) *edwards25519.Point {
+	xSign := r[31] & 0x80
+	r[31] &= 0x7f
+
+	one := new(field.Element).One()
+	rr2, _ := new(field.Element).SetBytes(r[:])
+	rr2.Square(rr2)
+	rr2.Add(rr2, rr2)
+	rr2.Add(rr2, one)
+	rr2.Invert(rr2)
+	x := new(field.Element).Multiply(curve25519A, rr2)
+	x.Negate(x)
+
+	// e = x^3 + A*x^2 + x
+	x2 := new(field.Element).Square(x)
+	e := new(field.Element).Multiply(x, x2)
+	e.Add(e, x)
+	x2.Multiply(x2, curve25519A)
+	e.Add(x2, e)
+
+	// chi(e) = e^((p-1)/2) = (e^((p-5)/8))^4 * e^2
+	chi := new(field.Element).Pow22523(e)
+	chi.Square(chi)
+	chi.Square(chi)
+	chi.Multiply(chi, new(field.Element).Square(e))
+
+	eIsMinus1 := int(chi.Bytes()[1] & 1)
+	negx := new(field.Element).Negate(x)
+	x.Select(negx, x, eIsMinus1)
+	x2.Select(curve25519A, new(field.Element).Zero(), eIsMinus1)
+	x.Subtract(x, x2)
+
+	// yed = (x-1)/(x+1)
+	xPlusOne := new(field.Element).Add(x, one)
+	xMinusOne := new(field.Element).Subtract(x, one)
+	yed := new(field.Element).Multiply(xMinusOne, xPlusOne.Invert(xPlusOne))
+
+	var s [32]byte
+	copy(s[:], yed.Bytes())
+	s[31] |= xSign
+	p, err := new(edwards25519.Point).SetBytes(s[:])
+	if err != nil {
+		panic("vrfFromUniform: elligator2 produced an invalid point")
+	}
+	return mulByCofactor(p, p)
+}
+
+// vrfHashToCurve mirrors _vrf_ietfdraft03_hash_to_curve_elligator2_25519,
+// given the encoding of the public key Y.
+func vrfHashToCurve(y []byte, alpha []byte) *edwards25519.Point {
+	hs := sha512.New()
+	hs.Write([]byte{vrfSuite, 0x01})
+	hs.Write(y)
+	hs.Write(alpha)
+	var digest [64]byte
+	hs.Sum(digest[:0])
+
+	var r [32]byte
+	copy(r[:], digest[:32])
+	r[31] &= 0x7f
+	return vrfFromUniform(r)
+}
+
+// vrfHashPoints mirrors _vrf_ietfdraft03_hash_points.
+func vrfHashPoints(p1, p2, p3, p4 *edwards25519.Point) (c [16]byte) {
+	hs := sha512.New()
+	hs.Write([]byte{vrfSuite, 0x02})
+	hs.Write(p1.Bytes())
+	hs.Write(p2.Bytes())
+	hs.Write(p3.Bytes())
+	hs.Write(p4.Bytes())
+	var digest [64]byte
+	copy(c[:], hs.Sum(digest[:0]))
+	return c
+}
+
+func goVrfKeygenFromSeed(seed [32]byte) (pub VrfPubkey, priv VrfPrivkey) {
+	h := sha512.Sum512(seed[:])
+	x, _ := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	copy(pub[:], new(edwards25519.Point).ScalarBaseMult(x).Bytes())
+	copy(priv[:32], seed[:])
+	copy(priv[32:], pub[:])
+	return pub, priv
+}
+
+func goVrfProve(sk VrfPrivkey, msg []byte) (proof VrfProof, ok bool) {
+	y, ok := vrfStringToPoint(sk[32:])
+	if !ok {
+		return proof, false
+	}
+	az := sha512.Sum512(sk[:32])
+	x, _ := edwards25519.NewScalar().SetBytesWithClamping(az[:32])
+
+	h := vrfHashToCurve(y.Bytes(), msg)
+	hString := h.Bytes()
+	gamma := new(edwards25519.Point).ScalarMult(x, h)
+
+	hs := sha512.New()
+	hs.Write(az[32:])
+	hs.Write(hString)
+	var nonce [64]byte
+	k, _ := edwards25519.NewScalar().SetUniformBytes(hs.Sum(nonce[:0]))
+	kB := new(edwards25519.Point).ScalarBaseMult(k)
+	kH := new(edwards25519.Point).ScalarMult(k, h)
+
+	var cBytes [32]byte
+	c16 := vrfHashPoints(h, gamma, kB, kH)
+	copy(cBytes[:], c16[:])
+	c, _ := edwards25519.NewScalar().SetCanonicalBytes(cBytes[:])
+
+	copy(proof[:32], gamma.Bytes())
+	copy(proof[32:48], c16[:])
+	copy(proof[48:], edwards25519.NewScalar().MultiplyAdd(c, x, k).Bytes())
+	return proof, true
+}
+
+func goVrfProofToHash(proof VrfProof) (hash VrfOutput, ok bool) {
+	gamma, ok := vrfStringToPoint(proof[:32])
+	if !ok {
+		return hash, false
+	}
+	hs := sha512.New()
+	hs.Write([]byte{vrfSuite, 0x03})
+	hs.Write(mulByCofactor(gamma, gamma).Bytes())
+	hs.Sum(hash[:0])
+	return hash, true
+}
+
+func goVrfVerify(pk VrfPubkey, proof VrfProof, msg []byte) (bool, VrfOutput) {
+	var out VrfOutput
+	pkBytes := [32]byte(pk)
+	if hasSmallOrder(&pkBytes) {
+		return false, out
+	}
+	y, ok := vrfStringToPoint(pk[:])
+	if !ok {
+		return false, out
+	}
+	gamma, ok := vrfStringToPoint(proof[:32])
+	if !ok {
+		return false, out
+	}
+	var cBytes [32]byte
+	copy(cBytes[:], proof[32:48])
+	c, _ := edwards25519.NewScalar().SetCanonicalBytes(cBytes[:])
+	// s is reduced rather than rejected when it is not canonical
+	var sWide [64]byte
+	copy(sWide[:], proof[48:])
+	s, _ := edwards25519.NewScalar().SetUniformBytes(sWide[:])
+
+	h := vrfHashToCurve(y.Bytes(), msg)
+
+	// U = s*B - c*Y
+	u := new(edwards25519.Point).ScalarBaseMult(s)
+	u.Subtract(u, new(edwards25519.Point).ScalarMult(c, y))
+	// V = s*H - c*Gamma
+	v := new(edwards25519.Point).ScalarMult(s, h)
+	v.Subtract(v, new(edwards25519.Point).ScalarMult(c, gamma))
+
+	cPrime := vrfHashPoints(h, gamma, u, v)
+	if subtle.ConstantTimeCompare(cPrime[:], proof[32:48]) != 1 {
+		return false, out
+	}
+	out, ok = goVrfProofToHash(proof)
+	return ok, out
+}
+
+// goVrfBatchVerify verifies a batch of VRF proofs. It accepts exactly the
+// proofs goVrfVerify accepts, and returns the same outputs, but it uses
+// variable-time double scalar multiplications, which is safe as all of its
+// inputs are public, and encodes all the points it hashes with a single field
+// inversion.
+func goVrfBatchVerify(publicKeys []VrfPubkey, proofs []VrfProof, messages [][]byte) (outputs []VrfOutput, failed []bool) {
+	outputs = make([]VrfOutput, len(proofs))
+	failed = make([]bool, len(proofs))
+
+	// for each proof that decodes, the points H, Gamma, U, V and 8*Gamma
+	const pointsPerProof = 5
+	points := make([]*edwards25519.Point, 0, pointsPerProof*len(proofs))
+	decoded := make([]int, 0, len(proofs))
+	for i := range proofs {
+		pk := [32]byte(publicKeys[i])
+		if hasSmallOrder(&pk) {
+			failed[i] = true
+			continue
+		}
+		y, ok := vrfStringToPoint(pk[:])
+		if !ok {
+			failed[i] = true
+			continue
+		}
+		gamma, ok := vrfStringToPoint(proofs[i][:32])
+		if !ok {
+			failed[i] = true
+			continue
+		}
+		var cBytes [32]byte
+		copy(cBytes[:], proofs[i][32:48])
+		c, _ := edwards25519.NewScalar().SetCanonicalBytes(cBytes[:])
+		var sWide [64]byte
+		copy(sWide[:], proofs[i][48:])
+		s, _ := edwards25519.NewScalar().SetUniformBytes(sWide[:])
+
+		// a canonical encoding of a point which is not of small order is
+		// exactly the encoding Bytes() would return
+		h := vrfHashToCurve(pk[:], messages[i])
+
+		// c is applied to -Y and -Gamma rather than -c to Y and Gamma, as
+		// they may have a torsion component and c < 2^128 is not reduced
+		negY := new(edwards25519.Point).Negate(y)
+		negGamma := new(edwards25519.Point).Negate(gamma)
+		u := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(c, negY, s)
+		v := new(edwards25519.Point).VarTimeDoubleScalarMult(s, h, c, negGamma)
+		gamma8 := mulByCofactor(new(edwards25519.Point), gamma)
+
+		points = append(points, h, gamma, u, v, gamma8)
+		decoded = append(decoded, i)
+	}
+
+	encoded := edwards25519.BatchBytes(points)
+	for j, i := range decoded {
+		enc := encoded[j*pointsPerProof : (j+1)*pointsPerProof]
+
+		hs := sha512.New()
+		hs.Write([]byte{vrfSuite, 0x02})
+		hs.Write(enc[0][:])
+		hs.Write(enc[1][:])
+		hs.Write(enc[2][:])
+		hs.Write(enc[3][:])
+		var digest [64]byte
+		cPrime := hs.Sum(digest[:0])[:16]
+		if subtle.ConstantTimeCompare(cPrime, proofs[i][32:48]) != 1 {
+			failed[i] = true
+			continue
+		}
+
+		hs.Reset()
+		hs.Write([]byte{vrfSuite, 0x03})
+		hs.Write(enc[4][:])
+		hs.Sum(outputs[i][:0])
+	}
+	return outputs, failed
+}
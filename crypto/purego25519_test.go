@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto/internal/edwards25519"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// order8Point is the encoding of a point of order 8.
+var order8Point = [32]byte{0x26, 0xe8, 0x95, 0x8f, 0xc2, 0xb2, 0x27, 0xb0, 0x45, 0xc3, 0xf4, 0x89, 0xf2, 0xef, 0x98, 0xf0,
+	0xd5, 0xdf, 0xac, 0x05, 0xd3, 0xc6, 0x33, 0x39, 0xb1, 0x38, 0x02, 0x88, 0x6d, 0x53, 0xfc, 0x05}
+
+// addScalarOrder returns the 32-byte little-endian encoding of s + L, a
+// non-canonical encoding of the same scalar.
+func addScalarOrder(s []byte) (out [32]byte) {
+	l, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+	be := make([]byte, 32)
+	for i := range s {
+		be[31-i] = s[i]
+	}
+	sum := new(big.Int).Add(new(big.Int).SetBytes(be), l).FillBytes(make([]byte, 32))
+	for i := range sum {
+		out[31-i] = sum[i]
+	}
+	return out
+}
+
+// interestingPointEncodings returns edge case encodings for a point field.
+func interestingPointEncodings() [][32]byte {
+	encodings := append([][32]byte{order8Point}, smallOrderEncodings...)
+	for _, e := range smallOrderEncodings {
+		e[31] |= 0x80
+		encodings = append(encodings, e)
+	}
+	nonCanonical := [32]byte{0: 0xef}
+	for i := 1; i < 32; i++ {
+		nonCanonical[i] = 0xff
+	}
+	encodings = append(encodings, nonCanonical)
+	nonCanonical[31] = 0x7f
+	encodings = append(encodings, nonCanonical)
+	var junk [32]byte
+	RandBytes(junk[:])
+	return append(encodings, junk)
+}
+
+func TestGoEd25519MatchesLibsodium(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for i := 0; i < 64; i++ {
+		var seed ed25519Seed
+		RandBytes(seed[:])
+		msg := make([]byte, i)
+		RandBytes(msg)
+
+		pk, sk := sodiumEd25519GenerateKeySeed(seed)
+		goPK, goSK := goEd25519GenerateKeySeed(seed)
+		require.Equal(t, pk, goPK)
+		require.Equal(t, sk, goSK)
+
+		sig := sodiumEd25519Sign(sk, msg)
+		require.Equal(t, sig, goEd25519Sign(sk, msg))
+		require.True(t, goEd25519Verify(pk, msg, sig))
+
+		check := func(pk ed25519PublicKey, msg []byte, sig ed25519Signature) {
+			require.Equal(t, sodiumEd25519Verify(pk, msg, sig), goEd25519Verify(pk, msg, sig), "pk %x sig %x", pk, sig)
+		}
+
+		// non-canonical S
+		badS := sig
+		nonCanonicalS := addScalarOrder(sig[32:])
+		copy(badS[32:], nonCanonicalS[:])
+		check(pk, msg, badS)
+		require.False(t, goEd25519Verify(pk, msg, badS))
+
+		// single bit flips
+		flipped := sig
+		flipped[i%64] ^= 1 << (i % 8)
+		check(pk, msg, flipped)
+		flippedPK := pk
+		flippedPK[i%32] ^= 1 << (i % 8)
+		check(flippedPK, msg, sig)
+
+		for _, enc := range interestingPointEncodings() {
+			check(ed25519PublicKey(enc), msg, sig)
+			badR := sig
+			copy(badR[:32], enc[:])
+			check(pk, msg, badR)
+		}
+	}
+}
+
+// TestGoEd25519Cofactored checks that, like libsodium, a public key with a
+// torsion component is verified with the cofactored equation.
+func TestGoEd25519Cofactored(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	torsion, err := new(edwards25519.Point).SetBytes(order8Point[:])
+	require.NoError(t, err)
+
+	for i := 0; i < 16; i++ {
+		var seed ed25519Seed
+		RandBytes(seed[:])
+		pk, sk := goEd25519GenerateKeySeed(seed)
+		a, err := new(edwards25519.Point).SetBytes(pk[:])
+		require.NoError(t, err)
+		copy(pk[:], a.Add(a, torsion).Bytes())
+		copy(sk[32:], pk[:])
+
+		msg := []byte{byte(i)}
+		sig := sodiumEd25519Sign(sk, msg)
+		require.Equal(t, sig, goEd25519Sign(sk, msg))
+		require.True(t, sodiumEd25519Verify(pk, msg, sig))
+		require.True(t, goEd25519Verify(pk, msg, sig))
+	}
+}
+
+func TestGoBatchVerifyMatchesLibsodium(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const n = 32
+	messages := make([][]byte, n)
+	publicKeys := make([]SignatureVerifier, n)
+	signatures := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		var seed ed25519Seed
+		RandBytes(seed[:])
+		pk, sk := sodiumEd25519GenerateKeySeed(seed)
+		messages[i] = []byte{byte(i), 1, 2, 3}
+		publicKeys[i] = SignatureVerifier(pk)
+		signatures[i] = Signature(sodiumEd25519Sign(sk, messages[i]))
+	}
+
+	allValid, failed := goBatchVerify(messages, publicKeys, signatures)
+	require.True(t, allValid)
+	require.Equal(t, make([]bool, n), failed)
+
+	signatures[3][5] ^= 1
+	signatures[17][40] ^= 1
+	sodiumValid, sodiumFailed := sodiumBatchVerify(messages, publicKeys, signatures)
+	allValid, failed = goBatchVerify(messages, publicKeys, signatures)
+	require.False(t, allValid)
+	require.Equal(t, sodiumValid, allValid)
+	require.Equal(t, sodiumFailed, failed)
+}
+
+func TestGoVrfMatchesLibsodium(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for i := 0; i < 32; i++ {
+		var seed [32]byte
+		RandBytes(seed[:])
+		msg := make([]byte, i)
+		RandBytes(msg)
+
+		pk, sk := sodiumVrfKeygenFromSeed(seed)
+		goPK, goSK := goVrfKeygenFromSeed(seed)
+		require.Equal(t, pk, goPK)
+		require.Equal(t, sk, goSK)
+
+		proof, ok := sodiumVrfProve(sk, msg)
+		require.True(t, ok)
+		goProof, ok := goVrfProve(sk, msg)
+		require.True(t, ok)
+		require.Equal(t, proof, goProof)
+
+		out, ok := sodiumVrfProofToHash(proof)
+		require.True(t, ok)
+		goOut, ok := goVrfProofToHash(proof)
+		require.True(t, ok)
+		require.Equal(t, out, goOut)
+
+		check := func(pk VrfPubkey, proof VrfProof, msg []byte) {
+			ok, out := sodiumVrfVerify(pk, proof, msg)
+			goOK, goOut := goVrfVerify(pk, proof, msg)
+			require.Equal(t, ok, goOK, "pk %x proof %x", pk, proof)
+			require.Equal(t, out, goOut)
+			_, ok = sodiumVrfProofToHash(proof)
+			_, goOK = goVrfProofToHash(proof)
+			require.Equal(t, ok, goOK)
+		}
+		check(pk, proof, msg)
+		ok, _ = goVrfVerify(pk, proof, msg)
+		require.True(t, ok)
+
+		// s is reduced, so a non-canonical encoding is still accepted
+		nonCanonicalS := proof
+		s := addScalarOrder(proof[48:])
+		copy(nonCanonicalS[48:], s[:])
+		check(pk, nonCanonicalS, msg)
+		ok, _ = goVrfVerify(pk, nonCanonicalS, msg)
+		require.True(t, ok)
+
+		flipped := proof
+		flipped[i%80] ^= 1 << (i % 8)
+		check(pk, flipped, msg)
+		flippedPK := pk
+		flippedPK[i%32] ^= 1 << (i % 8)
+		check(flippedPK, proof, msg)
+
+		for _, enc := range interestingPointEncodings() {
+			check(VrfPubkey(enc), proof, msg)
+			badGamma := proof
+			copy(badGamma[:32], enc[:])
+			check(pk, badGamma, msg)
+
+			badSK := sk
+			copy(badSK[32:], enc[:])
+			proof, ok := sodiumVrfProve(badSK, msg)
+			goProof, goOK := goVrfProve(badSK, msg)
+			require.Equal(t, ok, goOK)
+			require.Equal(t, proof, goProof)
+		}
+	}
+}
+
+func TestSetBackend(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// not parallel: the backend is package-wide state
+	defer SetBackend(BackendLibsodium)
+
+	require.Equal(t, BackendLibsodium, CurrentBackend())
+
+	selected, err := SetBackend(BackendGo)
+	require.NoError(t, err)
+	require.Equal(t, BackendGo, selected)
+	require.Equal(t, BackendGo, CurrentBackend())
+
+	secrets := GenerateSignatureSecrets(Seed{1})
+	sig := secrets.SignBytes([]byte("msg"))
+	require.True(t, secrets.VerifyBytes([]byte("msg"), sig))
+	pk, sk := VrfKeygen()
+	proof, ok := sk.proveBytes([]byte("msg"))
+	require.True(t, ok)
+	ok, _ = pk.verifyBytes(proof, []byte("msg"))
+	require.True(t, ok)
+
+	_, err = SetBackend("openssl")
+	require.Error(t, err)
+	require.Equal(t, BackendGo, CurrentBackend())
+
+	selected, err = SetBackend(BackendAuto)
+	require.NoError(t, err)
+	require.Contains(t, []Backend{BackendLibsodium, BackendGo}, selected)
+	require.Equal(t, selected, CurrentBackend())
+
+	selected, err = SetBackend("")
+	require.NoError(t, err)
+	require.Equal(t, BackendLibsodium, selected)
+}
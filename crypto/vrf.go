@@ -84,14 +84,19 @@ type (
 
 // VrfKeygenFromSeed deterministically generates a VRF keypair from 32 bytes of (secret) entropy.
 func VrfKeygenFromSeed(seed [32]byte) (pub VrfPubkey, priv VrfPrivkey) {
+	return currentBackend().vrfKeygenFromSeed(seed)
+}
+
+func sodiumVrfKeygenFromSeed(seed [32]byte) (pub VrfPubkey, priv VrfPrivkey) {
 	C.crypto_vrf_keypair_from_seed((*C.uchar)(&pub[0]), (*C.uchar)(&priv[0]), (*C.uchar)(&seed[0]))
 	return pub, priv
 }
 
 // VrfKeygen generates a random VRF keypair.
 func VrfKeygen() (pub VrfPubkey, priv VrfPrivkey) {
-	C.crypto_vrf_keypair((*C.uchar)(&pub[0]), (*C.uchar)(&priv[0]))
-	return pub, priv
+	var seed [32]byte
+	RandBytes(seed[:])
+	return VrfKeygenFromSeed(seed)
 }
 
 // Pubkey returns the public key that corresponds to the given private key.
@@ -101,6 +106,10 @@ func (sk VrfPrivkey) Pubkey() (pk VrfPubkey) {
 }
 
 func (sk VrfPrivkey) proveBytes(msg []byte) (proof VrfProof, ok bool) {
+	return currentBackend().vrfProve(sk, msg)
+}
+
+func sodiumVrfProve(sk VrfPrivkey, msg []byte) (proof VrfProof, ok bool) {
 	// &msg[0] will make Go panic if msg is zero length
 	m := (*C.uchar)(C.NULL)
 	if len(msg) != 0 {
@@ -119,11 +128,19 @@ func (sk VrfPrivkey) Prove(message Hashable) (proof VrfProof, ok bool) {
 // Hash converts a VRF proof to a VRF output without verifying the proof.
 // TODO: Consider removing so that we don't accidentally hash an unverified proof
 func (proof VrfProof) Hash() (hash VrfOutput, ok bool) {
+	return currentBackend().vrfProofToHash(proof)
+}
+
+func sodiumVrfProofToHash(proof VrfProof) (hash VrfOutput, ok bool) {
 	ret := C.crypto_vrf_proof_to_hash((*C.uchar)(&hash[0]), (*C.uchar)(&proof[0]))
 	return hash, ret == 0
 }
 
 func (pk VrfPubkey) verifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
+	return currentBackend().vrfVerify(pk, proof, msg)
+}
+
+func sodiumVrfVerify(pk VrfPubkey, proof VrfProof, msg []byte) (bool, VrfOutput) {
 	var out VrfOutput
 	// &msg[0] will make Go panic if msg is zero length
 	m := (*C.uchar)(C.NULL)
@@ -134,6 +151,22 @@ func (pk VrfPubkey) verifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
 	return ret == 0, out
 }
 
+// sodiumVrfBatchVerify verifies each proof of the batch on its own with
+// libsodium, leaving the output of the failed ones zero.
+func sodiumVrfBatchVerify(publicKeys []VrfPubkey, proofs []VrfProof, messages [][]byte) (outputs []VrfOutput, failed []bool) {
+	outputs = make([]VrfOutput, len(proofs))
+	failed = make([]bool, len(proofs))
+	for i := range proofs {
+		ok, out := sodiumVrfVerify(publicKeys[i], proofs[i], messages[i])
+		if !ok {
+			failed[i] = true
+			continue
+		}
+		outputs[i] = out
+	}
+	return outputs, failed
+}
+
 // Verify checks a VRF proof of a given Hashable. If the proof is valid the pseudorandom VrfOutput will be returned.
 // For a given public key and message, there are potentially multiple valid proofs.
 // However, given a public key and message, all valid proofs will yield the same output.
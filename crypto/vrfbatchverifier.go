@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import "errors"
+
+// VrfBatchVerifier enqueues VRF proofs to be verified in batch.
+//
+// Proofs of the ECVRF draft-03 construction carry the challenge c rather than
+// the commitments U and V, so unlike ed25519 signatures they cannot be checked
+// with a single combined equation. The batch still shares work across its
+// proofs: see goVrfBatchVerify, which the go backend uses for batches. The
+// libsodium backend verifies the proofs of a batch one by one, unless it was
+// picked by BackendAuto.
+type VrfBatchVerifier struct {
+	publicKeys []VrfPubkey
+	proofs     []VrfProof
+	messages   []Hashable
+}
+
+// Batch VRF verification errors
+var (
+	ErrBatchHasFailedProofs = errors.New("At least one VRF proof didn't pass verification")
+)
+
+// MakeVrfBatchVerifier creates a VrfBatchVerifier instance, with enough
+// pre-allocated space to enqueue hint proofs without expanding.
+func MakeVrfBatchVerifier(hint int) *VrfBatchVerifier {
+	if hint < minBatchVerifierAlloc {
+		hint = minBatchVerifierAlloc
+	}
+	return &VrfBatchVerifier{
+		publicKeys: make([]VrfPubkey, 0, hint),
+		proofs:     make([]VrfProof, 0, hint),
+		messages:   make([]Hashable, 0, hint),
+	}
+}
+
+// EnqueueProof enqueues a proof of a Hashable message to be verified against a public key.
+func (b *VrfBatchVerifier) EnqueueProof(pk VrfPubkey, proof VrfProof, message Hashable) {
+	b.publicKeys = append(b.publicKeys, pk)
+	b.proofs = append(b.proofs, proof)
+	b.messages = append(b.messages, message)
+}
+
+// GetNumberOfEnqueuedProofs returns the number of proofs currently enqueued into the VrfBatchVerifier
+func (b *VrfBatchVerifier) GetNumberOfEnqueuedProofs() int {
+	return len(b.proofs)
+}
+
+// VerifyWithFeedback verifies all the enqueued proofs, and returns the VRF
+// output of each of them, as VrfPubkey.Verify would. If some proofs are
+// invalid, true is set in failed at their indexes, their output is left
+// zero, and ErrBatchHasFailedProofs is returned.
+func (b *VrfBatchVerifier) VerifyWithFeedback() (outputs []VrfOutput, failed []bool, err error) {
+	if b.GetNumberOfEnqueuedProofs() == 0 {
+		return nil, nil, nil
+	}
+	messages := make([][]byte, len(b.messages))
+	for i := range b.messages {
+		messages[i] = HashRep(b.messages[i])
+	}
+	outputs, failed = currentBackend().vrfBatchVerify(b.publicKeys, b.proofs, messages)
+	for i := range failed {
+		if failed[i] {
+			return outputs, failed, ErrBatchHasFailedProofs
+		}
+	}
+	return outputs, failed, nil
+}
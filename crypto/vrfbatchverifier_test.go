@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto/internal/edwards25519"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVrfBatchVerifier(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const n = 20
+	bv := MakeVrfBatchVerifier(1)
+	var expected []VrfOutput
+	for i := 0; i < n; i++ {
+		pk, sk := VrfKeygen()
+		msg := randString()
+		proof, ok := sk.Prove(msg)
+		require.True(t, ok)
+		_, out := pk.Verify(proof, msg)
+		expected = append(expected, out)
+		bv.EnqueueProof(pk, proof, msg)
+	}
+	require.Equal(t, n, bv.GetNumberOfEnqueuedProofs())
+
+	outputs, failed, err := bv.VerifyWithFeedback()
+	require.NoError(t, err)
+	require.Equal(t, make([]bool, n), failed)
+	require.Equal(t, expected, outputs)
+
+	bv.proofs[7][50] ^= 1
+	outputs, failed, err = bv.VerifyWithFeedback()
+	require.ErrorIs(t, err, ErrBatchHasFailedProofs)
+	for i := range failed {
+		require.Equal(t, i == 7, failed[i])
+	}
+	require.Equal(t, VrfOutput{}, outputs[7])
+
+	outputs, failed, err = MakeVrfBatchVerifier(0).VerifyWithFeedback()
+	require.NoError(t, err)
+	require.Empty(t, outputs)
+	require.Empty(t, failed)
+}
+
+// TestGoVrfBatchVerifyMatchesLibsodium checks that the pure Go batch verifier
+// accepts exactly the proofs libsodium accepts, including malformed ones.
+func TestGoVrfBatchVerifyMatchesLibsodium(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	torsion, err := new(edwards25519.Point).SetBytes(order8Point[:])
+	require.NoError(t, err)
+	addTorsion := func(enc []byte) {
+		p, err := new(edwards25519.Point).SetBytes(enc)
+		require.NoError(t, err)
+		copy(enc, p.Add(p, torsion).Bytes())
+	}
+
+	var publicKeys []VrfPubkey
+	var proofs []VrfProof
+	var messages [][]byte
+	enqueue := func(pk VrfPubkey, proof VrfProof, msg []byte) {
+		publicKeys = append(publicKeys, pk)
+		proofs = append(proofs, proof)
+		messages = append(messages, msg)
+	}
+
+	for i := 0; i < 16; i++ {
+		var seed [32]byte
+		RandBytes(seed[:])
+		pk, sk := VrfKeygenFromSeed(seed)
+		msg := make([]byte, i)
+		RandBytes(msg)
+		proof, ok := sk.proveBytes(msg)
+		require.True(t, ok)
+		enqueue(pk, proof, msg)
+
+		nonCanonicalS := proof
+		s := addScalarOrder(proof[48:])
+		copy(nonCanonicalS[48:], s[:])
+		enqueue(pk, nonCanonicalS, msg)
+
+		flipped := proof
+		flipped[i*5%80] ^= 1 << (i % 8)
+		enqueue(pk, flipped, msg)
+
+		torsionGamma := proof
+		addTorsion(torsionGamma[:32])
+		enqueue(pk, torsionGamma, msg)
+
+		// a key with a torsion component, and its honestly generated proof
+		torsionPK := pk
+		addTorsion(torsionPK[:])
+		torsionSK := sk
+		copy(torsionSK[32:], torsionPK[:])
+		torsionProof, ok := sodiumVrfProve(torsionSK, msg)
+		require.True(t, ok)
+		enqueue(torsionPK, torsionProof, msg)
+
+		for _, enc := range interestingPointEncodings() {
+			enqueue(VrfPubkey(enc), proof, msg)
+			badGamma := proof
+			copy(badGamma[:32], enc[:])
+			enqueue(pk, badGamma, msg)
+		}
+	}
+
+	outputs, failed := goVrfBatchVerify(publicKeys, proofs, messages)
+	sodiumOutputs, sodiumFailed := sodiumVrfBatchVerify(publicKeys, proofs, messages)
+	require.Equal(t, sodiumFailed, failed)
+	require.Equal(t, sodiumOutputs, outputs)
+	require.Contains(t, failed, false)
+	require.Contains(t, failed, true)
+}
+
+func BenchmarkVrfBatchVerifier(b *testing.B) {
+	for _, batchSize := range []int{1, 16, 64} {
+		bv := MakeVrfBatchVerifier(batchSize)
+		for i := 0; i < batchSize; i++ {
+			pk, sk := VrfKeygen()
+			msg := randString()
+			proof, _ := sk.Prove(msg)
+			bv.EnqueueProof(pk, proof, msg)
+		}
+		b.Run(fmt.Sprintf("batch/batchsize %d", batchSize), func(b *testing.B) {
+			for i := 0; i < b.N; i += batchSize {
+				_, _, err := bv.VerifyWithFeedback()
+				require.NoError(b, err)
+			}
+		})
+		b.Run(fmt.Sprintf("one by one/batchsize %d", batchSize), func(b *testing.B) {
+			for i := 0; i < b.N; i += batchSize {
+				for j := range bv.proofs {
+					ok, _ := bv.publicKeys[j].Verify(bv.proofs[j], bv.messages[j])
+					require.True(b, ok)
+				}
+			}
+		})
+	}
+}
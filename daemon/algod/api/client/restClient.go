@@ -29,6 +29,7 @@ import (
 
 	"github.com/google/go-querystring/query"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
@@ -36,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/httpclient"
 )
 
 const (
@@ -44,14 +46,36 @@ const (
 	maxRawResponseBytes = 50e6
 )
 
+// restTransport retries the failed idempotent requests to algod with the default outbound http policy. The circuit
+// breaking is left out, since the callers poll algod while it starts and would otherwise wait out the cooldown.
+var restTransport = makeRestTransport()
+
+func makeRestTransport() http.RoundTripper {
+	policy := httpclient.PolicyFromConfig(config.GetDefaultLocal())
+	policy.CircuitBreakerThreshold = 0
+	return httpclient.MakeTransport(http.DefaultTransport, policy)
+}
+
 // rawRequestPaths is a set of paths where the body should not be urlencoded
 var rawRequestPaths = map[string]bool{
-	"/v2/transactions":          true,
-	"/v2/transactions/async":    true,
-	"/v2/teal/dryrun":           true,
-	"/v2/teal/compile":          true,
-	"/v2/participation":         true,
-	"/v2/transactions/simulate": true,
+	"/v2/transactions":                true,
+	"/v2/transactions/async":          true,
+	"/v2/teal/dryrun":                 true,
+	"/v2/teal/compile":                true,
+	"/v2/teal/templates/recognize":    true,
+	"/v2/participation":               true,
+	"/v2/participation/import":        true,
+	"/v2/transactions/simulate":       true,
+	"/v2/transactions/simulate/batch": true,
+	"/v2/transactions/merge":          true,
+	"/v2/encoding/convert":            true,
+	"/v2/tokens":                      true,
+}
+
+// isRawRequestPath reports whether the body of a request to the given path should not be urlencoded.
+// Besides rawRequestPaths, it matches the paths built from an account address.
+func isRawRequestPath(path string) bool {
+	return rawRequestPaths[path] || (strings.HasPrefix(path, "/v2/accounts/") && strings.HasSuffix(path, "/opt-in"))
 }
 
 // unauthorizedRequestError is generated when we receive 401 error from the server. This error includes the inner error
@@ -194,7 +218,7 @@ func (client RestClient) submitForm(
 		}
 	}
 
-	if requestMethod == "POST" && rawRequestPaths[path] {
+	if requestMethod == "POST" && isRawRequestPath(path) {
 		reqBytes, ok := body.([]byte)
 		if !ok {
 			return fmt.Errorf("couldn't decode raw request as bytes")
@@ -218,7 +242,7 @@ func (client RestClient) submitForm(
 		req.Header.Set(authHeader, client.apiToken)
 	}
 
-	httpClient := &http.Client{}
+	httpClient := &http.Client{Transport: restTransport}
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -330,6 +354,17 @@ func (client RestClient) GetPendingTransactions(maxTxns uint64) (response model.
 	return
 }
 
+type pendingBlockParams struct {
+	Max uint64 `url:"max"`
+}
+
+// PendingBlock asks algod for a summary of the block being assembled by its transaction pool,
+// listing at most maxTxns transaction IDs. If maxTxns = 0, lists all the transaction IDs of the payset.
+func (client RestClient) PendingBlock(maxTxns uint64) (response model.PendingBlockResponse, err error) {
+	err = client.get(&response, "/v2/blocks/pending", pendingBlockParams{Max: maxTxns})
+	return
+}
+
 // GetRawPendingTransactions gets the raw encoded msgpack transactions.
 // If maxTxns = 0, fetches as many transactions as possible.
 func (client RestClient) GetRawPendingTransactions(maxTxns uint64) (response []byte, err error) {
@@ -352,10 +387,20 @@ func (client RestClient) LedgerSupply() (response model.SupplyResponse, err erro
 	return
 }
 
+// CatchpointLabel gets the catchpoint label the node generated for the given round
+func (client RestClient) CatchpointLabel(round uint64) (response model.CatchpointLabelResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/ledger/catchpoint/%d", round), nil)
+	return
+}
+
 type pendingTransactionsByAddrParams struct {
 	Max uint64 `url:"max"`
 }
 
+type senderAdvisoryParams struct {
+	Leases uint64 `url:"leases"`
+}
+
 type transactionsByAddrParams struct {
 	FirstRound uint64 `url:"firstRound"`
 	LastRound  uint64 `url:"lastRound"`
@@ -380,10 +425,48 @@ type rawFormat struct {
 	Format string `url:"format"`
 }
 
+type convertEncodingParams struct {
+	Type         string `url:"type"`
+	InputFormat  string `url:"input-format"`
+	OutputFormat string `url:"output-format"`
+	Canonical    bool   `url:"canonical,omitempty"`
+}
+
+type memorySettingsParams struct {
+	Target  *uint64 `url:"target,omitempty"`
+	Ballast *uint64 `url:"ballast,omitempty"`
+}
+
+type loggingSettingsParams struct {
+	Level                      string `url:"level,omitempty"`
+	Output                     string `url:"output,omitempty"`
+	EnableTelemetryCategories  string `url:"enable-telemetry-categories,omitempty"`
+	DisableTelemetryCategories string `url:"disable-telemetry-categories,omitempty"`
+}
+
+type flightRecordingParams struct {
+	Since uint64 `url:"since,omitempty"`
+}
+
+type participationKeyAutoRenewalParams struct {
+	Enabled    *bool   `url:"enabled,omitempty"`
+	LeadRounds *uint64 `url:"lead-rounds,omitempty"`
+	Validity   *uint64 `url:"validity,omitempty"`
+}
+
 type proofParams struct {
 	HashType string `url:"hashtype"`
 }
 
+type participationExportParams struct {
+	Recipient string `url:"recipient"`
+	Keep      bool   `url:"keep,omitempty"`
+}
+
+type participationChallengeParams struct {
+	Challenge string `url:"challenge"`
+}
+
 type accountInformationParams struct {
 	Format  string `url:"format"`
 	Exclude string `url:"exclude"`
@@ -403,12 +486,89 @@ func (client RestClient) RawPendingTransactionsByAddr(addr string, max uint64) (
 	return
 }
 
+// SenderAdvisory returns lease suggestions and non-conflicting batches for the pending transactions of addr.
+func (client RestClient) SenderAdvisory(addr string, leases uint64) (response model.SenderAdvisoryResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/transactions/advisory", addr), senderAdvisoryParams{leases})
+	return
+}
+
 // AssetInformation gets the AssetInformationResponse associated with the passed asset index
 func (client RestClient) AssetInformation(index uint64) (response model.Asset, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/assets/%d", index), nil)
 	return
 }
 
+type complianceEventsParams struct {
+	Address  string `url:"address,omitempty"`
+	MinRound uint64 `url:"min-round,omitempty"`
+	MaxRound uint64 `url:"max-round,omitempty"`
+}
+
+// AssetComplianceEvents gets the freeze and clawback events of the passed asset index,
+// optionally restricted to the ones targeting addr. A zero maxRound leaves the round range open-ended.
+func (client RestClient) AssetComplianceEvents(index uint64, addr string, minRound, maxRound uint64) (response model.ComplianceEventsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/assets/%d/compliance-events", index), complianceEventsParams{addr, minRound, maxRound})
+	return
+}
+
+// AccountComplianceEvents gets the freeze and clawback events targeting the holdings of addr.
+// A zero maxRound leaves the round range open-ended.
+func (client RestClient) AccountComplianceEvents(addr string, minRound, maxRound uint64) (response model.ComplianceEventsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/compliance-events", addr), complianceEventsParams{MinRound: minRound, MaxRound: maxRound})
+	return
+}
+
+type applicationEventsParams struct {
+	Topic    string `url:"topic,omitempty"`
+	MinRound uint64 `url:"min-round,omitempty"`
+	MaxRound uint64 `url:"max-round,omitempty"`
+	Limit    uint64 `url:"limit,omitempty"`
+}
+
+// ApplicationEvents gets the ARC-28 events emitted by the passed application, optionally restricted to
+// the given topic, an event signature or hex encoded selector. A zero maxRound leaves the round range
+// open-ended, and a zero limit returns the default number of events.
+func (client RestClient) ApplicationEvents(index uint64, topic string, minRound, maxRound, limit uint64) (response model.ApplicationEventsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/events", index), applicationEventsParams{topic, minRound, maxRound, limit})
+	return
+}
+
+type accountDiffParams struct {
+	From uint64 `url:"from"`
+	To   uint64 `url:"to"`
+}
+
+// AccountDiff gets the net change of the balance, assets, applications and boxes of addr
+// between the rounds from and to.
+func (client RestClient) AccountDiff(addr string, from, to uint64) (response model.AccountDiffResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/diff", addr), accountDiffParams{from, to})
+	return
+}
+
+type accountOnlinenessParams struct {
+	Round uint64 `url:"round,omitempty"`
+}
+
+// AccountOnlineness gets whether addr votes in a future round, and with which stake, projecting its pending
+// key registrations. A zero round projects the next round.
+func (client RestClient) AccountOnlineness(addr string, round uint64) (response model.AccountOnlinenessResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/onlineness", addr), accountOnlinenessParams{round})
+	return
+}
+
+type proposerReportParams struct {
+	MinRound uint64 `url:"min-round,omitempty"`
+	MaxRound uint64 `url:"max-round,omitempty"`
+	Limit    uint64 `url:"limit,omitempty"`
+}
+
+// ProposerReport gets the expected and actual block proposals of the top limit online accounts between
+// minRound and maxRound. Zero values select the node's defaults.
+func (client RestClient) ProposerReport(minRound, maxRound, limit uint64) (response model.ProposerReportResponse, err error) {
+	err = client.get(&response, "/v2/proposers/report", proposerReportParams{minRound, maxRound, limit})
+	return
+}
+
 // ApplicationInformation gets the ApplicationInformationResponse associated
 // with the passed application index
 func (client RestClient) ApplicationInformation(index uint64) (response model.Application, err error) {
@@ -516,6 +676,17 @@ func (client RestClient) RawAccountAssetInformation(accountAddress string, asset
 	return
 }
 
+// BuildOptInGroups builds the transaction groups opting an account in to, or closing it out of,
+// the given assets and applications, and reports whether they would fail.
+func (client RestClient) BuildOptInGroups(accountAddress string, request model.OptInRequest) (response model.OptInResponse, err error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return
+	}
+	err = client.submitForm(&response, fmt.Sprintf("/v2/accounts/%s/opt-in", accountAddress), nil, body, "POST", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
 // SuggestedParams gets the suggested transaction parameters
 func (client RestClient) SuggestedParams() (response model.TransactionParametersResponse, err error) {
 	err = client.get(&response, "/v2/transactions/params", nil)
@@ -547,6 +718,16 @@ func (client RestClient) SendRawTransactionGroup(txgroup []transactions.SignedTx
 	return client.post(&response, "/v2/transactions", nil, enc, false)
 }
 
+// TransactionGroupResources gets the resources a transaction group shares with its programs
+func (client RestClient) TransactionGroupResources(txgroup []transactions.SignedTxn) (response model.TransactionGroupResourcesResponse, err error) {
+	var enc []byte
+	for _, tx := range txgroup {
+		enc = append(enc, protocol.Encode(&tx)...)
+	}
+	err = client.post(&response, "/v2/transactions/resources", nil, enc, false)
+	return
+}
+
 // Block gets the block info for the given round
 func (client RestClient) Block(round uint64) (response model.BlockResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d", round), nil)
@@ -561,10 +742,28 @@ func (client RestClient) RawBlock(round uint64) (response []byte, err error) {
 	return
 }
 
-// Shutdown requests the node to shut itself down
-func (client RestClient) Shutdown() (err error) {
-	response := 1
-	err = client.post(&response, "/v2/shutdown", nil, nil, false)
+type blockRangeParams struct {
+	First  uint64 `url:"first"`
+	Last   uint64 `url:"last"`
+	Format string `url:"format"`
+}
+
+// RawBlockRange gets the blocks from first to last as a delta compressed stream, which
+// rpcs.DecodeBlockRange reads
+func (client RestClient) RawBlockRange(first, last uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, "/v2/blocks/range", blockRangeParams{First: first, Last: last, Format: "msgpack"})
+	response = blob
+	return
+}
+
+type shutdownParams struct {
+	Drain bool `url:"drain,omitempty"`
+}
+
+// Shutdown requests the node to shut itself down, after draining it if drain is true
+func (client RestClient) Shutdown(drain bool) (response model.ShutdownNodeResponse, err error) {
+	err = client.post(&response, "/v2/shutdown", shutdownParams{Drain: drain}, nil, false)
 	return
 }
 
@@ -580,6 +779,12 @@ func (client RestClient) Catchup(catchpointLabel string) (response model.Catchpo
 	return
 }
 
+// CatchupStatus returns the progress of the catchup to the given catchpoint label
+func (client RestClient) CatchupStatus(catchpointLabel string) (response model.CatchupStatusResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/catchup/%s/status", catchpointLabel), nil)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -591,6 +796,29 @@ func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string,
 	return
 }
 
+// Metrics returns the metrics of the node, in the Prometheus text format
+func (client RestClient) Metrics() (string, error) {
+	return client.doGetWithQuery(context.Background(), "/metrics", nil)
+}
+
+// TransactionPoolStats returns statistics on the transaction pool of the node
+func (client RestClient) TransactionPoolStats() (response model.TransactionPoolStatsResponse, err error) {
+	err = client.get(&response, "/v2/transactions/pool/stats", nil)
+	return
+}
+
+// FeeEstimate returns the suggested fees per byte for a new transaction
+func (client RestClient) FeeEstimate() (response model.TransactionFeeEstimateResponse, err error) {
+	err = client.get(&response, "/v2/transactions/fee-estimate", nil)
+	return
+}
+
+// RecognizeTealTemplate gets the TEAL template the given program is an instance of
+func (client RestClient) RecognizeTealTemplate(program []byte) (response model.TealTemplateResponse, err error) {
+	err = client.submitForm(&response, "/v2/teal/templates/recognize", nil, program, "POST", false, true, false)
+	return
+}
+
 type compileParams struct {
 	SourceMap bool `url:"sourcemap,omitempty"`
 }
@@ -656,7 +884,7 @@ func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryA
 
 	req.Header.Set(authHeader, client.apiToken)
 
-	httpClient := http.Client{}
+	httpClient := http.Client{Transport: restTransport}
 	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return
@@ -692,6 +920,51 @@ func (client RestClient) RawSimulateRawTransaction(data []byte) (response []byte
 	return
 }
 
+// RawSimulateBatchRawTransaction simulates each transaction group of the raw request independently and returns the
+// simulation results as raw bytes.
+func (client RestClient) RawSimulateBatchRawTransaction(data []byte) (response []byte, err error) {
+	var blob Blob
+	err = client.submitForm(&blob, "/v2/transactions/simulate/batch", rawFormat{Format: "msgpack"}, data, "POST", false /* encodeJSON */, false /* decodeJSON */, false)
+	response = blob
+	return
+}
+
+// DeleteSimulateSession discards a simulation session opened with the client's API token
+func (client RestClient) DeleteSimulateSession(name string) error {
+	return client.delete(nil, fmt.Sprintf("/v2/transactions/simulate/sessions/%s", url.PathEscape(name)), nil, true)
+}
+
+// MergeMultisigTransactions asks the node to merge partially-signed copies of the same multisig
+// transactions, and returns one merged signed transaction per distinct transaction.
+func (client RestClient) MergeMultisigTransactions(stxns []transactions.SignedTxn) ([]transactions.SignedTxn, error) {
+	var enc []byte
+	for _, stxn := range stxns {
+		enc = append(enc, protocol.Encode(&stxn)...)
+	}
+
+	var blob Blob
+	err := client.submitForm(&blob, "/v2/transactions/merge", rawFormat{Format: "msgpack"}, enc, "POST", false /* encodeJSON */, false /* decodeJSON */, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Txns []transactions.SignedTxn `codec:"txns"`
+	}
+	err = protocol.DecodeReflect(blob, &response)
+	return response.Txns, err
+}
+
+// ConvertEncoding asks the node to re-encode a block, transaction, state delta or genesis
+// between the "json" and "msgpack" formats.
+func (client RestClient) ConvertEncoding(objectType string, data []byte, inputFormat, outputFormat string, canonical bool) (response []byte, err error) {
+	var blob Blob
+	params := convertEncodingParams{Type: objectType, InputFormat: inputFormat, OutputFormat: outputFormat, Canonical: canonical}
+	err = client.submitForm(&blob, "/v2/encoding/convert", params, data, "POST", false /* encodeJSON */, false /* decodeJSON */, false)
+	response = blob
+	return
+}
+
 // StateProofs gets a state proof that covers a given round
 func (client RestClient) StateProofs(round uint64) (response model.StateProofResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/stateproofs/%d", round), nil)
@@ -704,6 +977,21 @@ func (client RestClient) LightBlockHeaderProof(round uint64) (response model.Lig
 	return
 }
 
+// LightBlockHeaderProofBundle gets the light block header of a given round together with
+// the state proof covering the round and a Merkle proof of the header in its commitment.
+func (client RestClient) LightBlockHeaderProofBundle(round uint64) (response model.LightBlockHeaderProofBundleResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/lightblockheaders/%d/proof", round), nil)
+	return
+}
+
+// RawBlockFinality gets the msgpack encoded finality proof bundle for the block of a given round.
+func (client RestClient) RawBlockFinality(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/blocks/%d/finality", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
 // TransactionProof gets a Merkle proof for a transaction in a block.
 func (client RestClient) TransactionProof(txid string, round uint64, hashType crypto.HashType) (response model.TransactionProofResponse, err error) {
 	txid = stripTransaction(txid)
@@ -735,6 +1023,51 @@ func (client RestClient) RemoveParticipationKeyByID(participationID string) (err
 	return
 }
 
+// GetParticipationTransportKey gets the key participation keys must be sealed to before they are imported to the node
+func (client RestClient) GetParticipationTransportKey() (response model.ParticipationTransportKeyResponse, err error) {
+	err = client.get(&response, "/v2/participation/transport-key", nil)
+	return
+}
+
+// GetParticipationMetrics gets the proposals, votes and credentials of the accounts participating through the node
+func (client RestClient) GetParticipationMetrics() (response model.ParticipationMetricsResponse, err error) {
+	err = client.get(&response, "/v2/participation/metrics", nil)
+	return
+}
+
+// GetAgreementStatus gets the round, period and step of the agreement of the node, with the proposals and votes seen in the round
+func (client RestClient) GetAgreementStatus() (response model.AgreementStatusResponse, err error) {
+	err = client.get(&response, "/v2/agreement/status", nil)
+	return
+}
+
+// GetAgreementAccounts gets the proposals and votes the accounts of the node attempted and sent in the current round
+func (client RestClient) GetAgreementAccounts() (response model.AgreementAccountsResponse, err error) {
+	err = client.get(&response, "/v2/agreement/accounts", nil)
+	return
+}
+
+// ExportParticipationKeyByID gets a single participation key, sealed to the recipient's transport key.
+// The node removes the key once exported, unless keep is true.
+func (client RestClient) ExportParticipationKeyByID(participationID string, recipient []byte, keep bool) (response model.ParticipationExportResponse, err error) {
+	params := participationExportParams{Recipient: base64.StdEncoding.EncodeToString(recipient), Keep: keep}
+	err = client.get(&response, fmt.Sprintf("/v2/participation/%s/export", participationID), params)
+	return
+}
+
+// ProveParticipationChallenge gets a proof of the challenge made with the selection key of a single participation key
+func (client RestClient) ProveParticipationChallenge(participationID string, challenge []byte) (response model.ParticipationChallengeResponse, err error) {
+	params := participationChallengeParams{Challenge: base64.StdEncoding.EncodeToString(challenge)}
+	err = client.get(&response, fmt.Sprintf("/v2/participation/%s/challenge", participationID), params)
+	return
+}
+
+// ImportParticipationKey sends a sealed participation key to the node.
+func (client RestClient) ImportParticipationKey(sealed []byte) (response model.PostParticipationResponse, err error) {
+	err = client.post(&response, "/v2/participation/import", nil, sealed, false)
+	return
+}
+
 /* Endpoint registered for follower nodes */
 
 // SetSyncRound sets the sync round for the catchup service
@@ -749,6 +1082,12 @@ func (client RestClient) UnsetSyncRound() (err error) {
 	return
 }
 
+// AcknowledgeSyncRound tells the follower sync policy that every round up to round has been processed
+func (client RestClient) AcknowledgeSyncRound(round uint64) (err error) {
+	err = client.post(nil, fmt.Sprintf("/v2/ledger/sync/ack/%d", round), nil, nil, true)
+	return
+}
+
 // GetSyncRound retrieves the sync round (if set)
 func (client RestClient) GetSyncRound() (response model.GetSyncRoundResponse, err error) {
 	err = client.get(&response, "/v2/ledger/sync", nil)
@@ -779,6 +1118,139 @@ func (client RestClient) SetBlockTimestampOffset(offset uint64) (err error) {
 	return
 }
 
+// ResetPersistedMetrics sets the persisted node metrics back to zero
+func (client RestClient) ResetPersistedMetrics() (err error) {
+	err = client.post(nil, "/v2/metrics/reset", nil, nil, true)
+	return
+}
+
+// PeerDuplicateLatency returns, for each peer, the histograms of how late it delivers the votes and proposals
+func (client RestClient) PeerDuplicateLatency() (response model.PeerDuplicateLatencyResponse, err error) {
+	err = client.get(&response, "/v2/peers/duplicate-latency", nil)
+	return
+}
+
+// Features returns the experimental features of the node, and whether they are requested and enabled
+func (client RestClient) Features() (response model.FeaturesResponse, err error) {
+	err = client.get(&response, "/v2/features", nil)
+	return
+}
+
+// GetMemorySettings returns the memory target and ballast of the node, and the garbage collector settings derived from them
+func (client RestClient) GetMemorySettings() (response model.MemorySettingsResponse, err error) {
+	err = client.get(&response, "/v2/memory", nil)
+	return
+}
+
+// SetMemorySettings changes the memory target and ballast of the node, in bytes. A nil value keeps the current one.
+func (client RestClient) SetMemorySettings(target, ballast *uint64) (response model.MemorySettingsResponse, err error) {
+	err = client.submitForm(&response, "/v2/memory", memorySettingsParams{target, ballast}, nil, "PUT", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
+// GetLoggingSettings returns the logging level of the node, where its log is written and the telemetry categories it doesn't send
+func (client RestClient) GetLoggingSettings() (response model.LoggingSettingsResponse, err error) {
+	err = client.get(&response, "/v2/debug/logging", nil)
+	return
+}
+
+// SetLoggingSettings changes the logging settings of the node at runtime. The categories are comma-separated lists.
+// An empty value keeps the current setting.
+func (client RestClient) SetLoggingSettings(level, output, enableCategories, disableCategories string) (response model.LoggingSettingsResponse, err error) {
+	err = client.submitForm(&response, "/v2/debug/logging", loggingSettingsParams{level, output, enableCategories, disableCategories}, nil, "PUT", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
+// GenerateCatchpoint makes the node write the file of its next catchpoint, and upload it when configured to
+func (client RestClient) GenerateCatchpoint() (response model.CatchpointGenerateResponse, err error) {
+	err = client.post(&response, "/v2/catchpoints/generate", nil, nil, false)
+	return
+}
+
+type snapshotParams struct {
+	ExcludeKeys bool `url:"exclude-keys,omitempty"`
+}
+
+// CreateSnapshot makes the node write a snapshot of itself into the snapshots directory of its data directory, in the
+// background, leaving out its participation keys when excludeKeys is set
+func (client RestClient) CreateSnapshot(excludeKeys bool) (response model.SnapshotStatusResponse, err error) {
+	err = client.post(&response, "/v2/snapshots", snapshotParams{excludeKeys}, nil, false)
+	return
+}
+
+// SnapshotStatus returns the state of the latest snapshot the node was asked to write
+func (client RestClient) SnapshotStatus() (response model.SnapshotStatusResponse, err error) {
+	err = client.get(&response, "/v2/snapshots", nil)
+	return
+}
+
+// FlightRecording returns the samples of the flight recorder of the node taken at or after since, in seconds
+// since the Unix epoch, oldest first. A zero since returns all the samples.
+func (client RestClient) FlightRecording(since uint64) (response model.FlightRecordingResponse, err error) {
+	err = client.get(&response, "/v2/flight-recorder", flightRecordingParams{since})
+	return
+}
+
+// ParticipationKeyAutoRenewal returns the settings of the automatic renewal of participation keys, and the keys it installed.
+func (client RestClient) ParticipationKeyAutoRenewal() (response model.ParticipationKeyAutoRenewalResponse, err error) {
+	err = client.get(&response, "/v2/participation/autorenew", nil)
+	return
+}
+
+// SetParticipationKeyAutoRenewal changes the settings of the automatic renewal of participation keys. Nil settings keep their current value.
+func (client RestClient) SetParticipationKeyAutoRenewal(enabled *bool, leadRounds, validity *uint64) (response model.ParticipationKeyAutoRenewalResponse, err error) {
+	err = client.submitForm(&response, "/v2/participation/autorenew", participationKeyAutoRenewalParams{enabled, leadRounds, validity}, nil, "POST", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
+// RotateAPIToken generates a new algod API token. The previous token remains valid for the overlap period configured on the node.
+func (client RestClient) RotateAPIToken() (response model.RotateAPITokenResponse, err error) {
+	err = client.post(&response, "/v2/api-token/rotate", nil, nil, true)
+	return
+}
+
+// ListAPITokens lists the named API tokens of the node, without their values.
+func (client RestClient) ListAPITokens() (response model.APITokensResponse, err error) {
+	err = client.get(&response, "/v2/tokens", nil)
+	return
+}
+
+// CreateAPIToken generates a named API token, granted only the scopes of the request.
+func (client RestClient) CreateAPIToken(request model.CreateAPITokenRequest) (response model.CreateAPITokenResponse, err error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return
+	}
+	err = client.submitForm(&response, "/v2/tokens", nil, body, "POST", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
+// DeleteAPIToken revokes a named API token.
+func (client RestClient) DeleteAPIToken(name string) error {
+	return client.delete(nil, fmt.Sprintf("/v2/tokens/%s", url.PathEscape(name)), nil, true)
+}
+
+// ListWebhooks lists the webhooks registered on the node, without their secrets.
+func (client RestClient) ListWebhooks() (response model.WebhooksResponse, err error) {
+	err = client.get(&response, "/v2/webhooks", nil)
+	return
+}
+
+// CreateWebhook registers a webhook, to which the node posts the committed transactions matching its filter.
+func (client RestClient) CreateWebhook(request model.CreateWebhookRequest) (response model.CreateWebhookResponse, err error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return
+	}
+	err = client.submitForm(&response, "/v2/webhooks", nil, body, "POST", false /* encodeJSON */, true /* decodeJSON */, false)
+	return
+}
+
+// DeleteWebhook stops the node posting notifications to a webhook.
+func (client RestClient) DeleteWebhook(id string) error {
+	return client.delete(nil, fmt.Sprintf("/v2/webhooks/%s", url.PathEscape(id)), nil, true)
+}
+
 // GetBlockTimestampOffset gets the offset in seconds which is being added to devmode blocks
 func (client RestClient) GetBlockTimestampOffset() (response model.GetBlockTimeStampOffsetResponse, err error) {
 	err = client.get(&response, "/v2/devmode/blocks/offset", nil)
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/nonparticipating/public"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestConvertEncodingRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	public.RegisterHandlers(e, &v2.Handlers{Log: logging.TestingLog(t)})
+	server := httptest.NewServer(e)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := MakeRestClient(*serverURL, "")
+
+	stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		Header:           transactions.Header{Sender: basics.Address{1}, Fee: basics.MicroAlgos{Raw: 1000}, FirstValid: 1, LastValid: 1000},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: basics.Address{2}, Amount: basics.MicroAlgos{Raw: 100}},
+	}}
+	encoded := protocol.Encode(&stxn)
+
+	// the request body reaches the node, and its output converts back to the same encoding
+	jsonEncoded, err := client.ConvertEncoding("txn", encoded, "msgpack", "json", true)
+	require.NoError(t, err)
+	var decoded transactions.SignedTxn
+	require.NoError(t, protocol.DecodeJSON(jsonEncoded, &decoded))
+	require.Equal(t, stxn, decoded)
+
+	msgpEncoded, err := client.ConvertEncoding("txn", jsonEncoded, "json", "msgpack", false)
+	require.NoError(t, err)
+	require.Equal(t, encoded, msgpEncoded)
+
+	_, err = client.ConvertEncoding("txn", nil, "msgpack", "json", false)
+	require.ErrorContains(t, err, "400")
+}
@@ -17,12 +17,18 @@
 package middlewares
 
 import (
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-deadlock"
 )
 
 // TokenPathParam is the name of the path parameter used by URLAuthPrefix
@@ -35,25 +41,215 @@ const urlAuthFormatter = "/urlAuth/%s"
 // InvalidTokenMessage is the message set when an invalid / missing token is found.
 const InvalidTokenMessage = "Invalid API Token"
 
+// ForbiddenAddressMessage is the message set when a token is used from an address it is not allowed from.
+const ForbiddenAddressMessage = "API token is not allowed from this address"
+
+// MissingScopeMessage is the message set when a token is not granted the scope of the requested route.
+const MissingScopeMessage = "API token lacks the %s scope"
+
+// Scope is a group of REST routes which an API token may be granted.
+type Scope string
+
+const (
+	// ScopeReadOnly grants the public routes which do not change the state of the node.
+	ScopeReadOnly Scope = "read-only"
+	// ScopeParticipation grants the routes submitting transactions and managing participation keys.
+	ScopeParticipation Scope = "participation"
+	// ScopeAdmin grants every route.
+	ScopeAdmin Scope = "admin"
+)
+
+// ParseScope checks that s names a scope.
+func ParseScope(s string) (Scope, error) {
+	switch scope := Scope(s); scope {
+	case ScopeReadOnly, ScopeParticipation, ScopeAdmin:
+		return scope, nil
+	}
+	return "", fmt.Errorf("unknown scope %s", s)
+}
+
+// authTokenIDKey is the echo context key under which the auth middleware stores the ID of the token
+// which authenticated the request, so that the request can be audited without logging the token itself.
+const authTokenIDKey = "authTokenID"
+
+// AuthTokenID returns the ID of the token which authenticated the request, or an empty string
+// when the request was not authenticated with a token.
+func AuthTokenID(ctx echo.Context) string {
+	id, _ := ctx.Get(authTokenIDKey).(string)
+	return id
+}
+
+// tokenFingerprintLength is the number of hex characters of the token hash used to identify a token.
+const tokenFingerprintLength = 8
+
 // AuthMiddleware provides some data to the handler.
 type AuthMiddleware struct {
 	// Header is the token header which needs to be provided. For example 'X-Algod-API-Token'.
 	header string
 
 	// Tokens is the set of tokens which can be set to allow access.
-	tokens [][]byte
+	tokens []*TokenSet
+
+	// scope returns the scope a token must be granted to be served a request, or an empty scope
+	// if any token of the sets is served.
+	scope func(*http.Request) Scope
 }
 
-// MakeAuth constructs the auth middleware function
-func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
-	apiTokenBytes := make([][]byte, 0)
+// authToken is a single API token of a TokenSet.
+type authToken struct {
+	value []byte
+	label string
+	// id identifies the token in the logs, it is made of the label of the token and a fingerprint of its value.
+	id string
+	// expires is the time after which the token is no longer accepted, or zero if it never expires.
+	expires time.Time
+	// scopes are the scopes granted to the token, or nil if it is granted every scope.
+	scopes []Scope
+	// networks are the networks the token may be used from, or nil if it may be used from anywhere.
+	networks []*net.IPNet
+}
+
+func (t authToken) granted(scope Scope) bool {
+	if t.scopes == nil || scope == "" {
+		return true
+	}
+	for _, s := range t.scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+func (t authToken) allowedFrom(remoteAddr string) bool {
+	if t.networks == nil {
+		return true
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range t.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenSet is a set of API tokens which can be replaced while requests are being authenticated with it.
+type TokenSet struct {
+	mu     deadlock.RWMutex
+	tokens []authToken
+}
+
+// MakeTokenSet creates a TokenSet holding the given tokens, which never expire. The label is
+// used to tell apart the tokens of different sets in the logs.
+func MakeTokenSet(label string, tokens ...string) *TokenSet {
+	ts := &TokenSet{}
 	for _, token := range tokens {
-		apiTokenBytes = append(apiTokenBytes, []byte(token))
+		ts.tokens = append(ts.tokens, makeAuthToken(label, token))
+	}
+	return ts
+}
+
+func makeAuthToken(label string, token string) authToken {
+	digest := sha256.Sum256([]byte(token))
+	id := hex.EncodeToString(digest[:])[:tokenFingerprintLength]
+	if label != "" {
+		id = label + "/" + id
+	}
+	return authToken{value: []byte(token), label: label, id: id}
+}
+
+// Add adds a token to the set, which is only granted the given scopes and may only be used from
+// the given networks. A nil networks allows the token to be used from anywhere.
+func (ts *TokenSet) Add(label string, token string, scopes []Scope, networks []*net.IPNet) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	t := makeAuthToken(label, token)
+	t.scopes = append([]Scope{}, scopes...)
+	t.networks = networks
+	ts.tokens = append(ts.tokens, t)
+}
+
+// Remove revokes the tokens of the set with the given label, and returns whether there was any.
+func (ts *TokenSet) Remove(label string) bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	tokens := ts.tokens[:0]
+	for _, t := range ts.tokens {
+		if t.label != label {
+			tokens = append(tokens, t)
+		}
 	}
+	removed := len(tokens) < len(ts.tokens)
+	ts.tokens = tokens
+	return removed
+}
+
+// Replace adds a new token to the set. The tokens currently in the set remain accepted for the
+// overlap duration, and the time at which they expire is returned.
+func (ts *TokenSet) Replace(label string, token string, overlap time.Duration) time.Time {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	now := time.Now()
+	expires := now.Add(overlap)
+	tokens := make([]authToken, 0, len(ts.tokens)+1)
+	for _, t := range ts.tokens {
+		if overlap <= 0 || (!t.expires.IsZero() && !t.expires.After(now)) {
+			continue
+		}
+		if t.expires.IsZero() || t.expires.After(expires) {
+			t.expires = expires
+		}
+		tokens = append(tokens, t)
+	}
+	ts.tokens = append(tokens, makeAuthToken(label, token))
+	return expires
+}
+
+// match returns the token of the set equal to the provided one. Tokens are compared in constant time.
+func (ts *TokenSet) match(provided []byte, now time.Time) (authToken, bool) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	for _, t := range ts.tokens {
+		if subtle.ConstantTimeCompare(provided, t.value) == 1 {
+			if !t.expires.IsZero() && !t.expires.After(now) {
+				continue
+			}
+			return t, true
+		}
+	}
+	return authToken{}, false
+}
+
+// MakeAuth constructs the auth middleware function
+func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
+	return MakeAuthWithTokenSets(header, MakeTokenSet("", tokens...))
+}
+
+// MakeAuthWithTokenSets constructs the auth middleware function, accepting any token held by the given sets
+// at the time a request is served.
+func MakeAuthWithTokenSets(header string, tokens ...*TokenSet) echo.MiddlewareFunc {
+	return MakeScopedAuth(header, nil, tokens...)
+}
 
+// MakeScopedAuth constructs the auth middleware function, accepting the tokens held by the given sets
+// which are granted the scope returned by scope for the request.
+func MakeScopedAuth(header string, scope func(*http.Request) Scope, tokens ...*TokenSet) echo.MiddlewareFunc {
 	auth := AuthMiddleware{
 		header: header,
-		tokens: apiTokenBytes,
+		tokens: tokens,
+		scope:  scope,
 	}
 
 	return auth.handler
@@ -94,9 +290,19 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// Check the tokens in constant time
-		for _, tokenBytes := range auth.tokens {
-			if subtle.ConstantTimeCompare(providedToken, tokenBytes) == 1 {
-				// Token was correct, keep serving request
+		now := time.Now()
+		for _, tokenSet := range auth.tokens {
+			if token, ok := tokenSet.match(providedToken, now); ok {
+				if !token.allowedFrom(ctx.Request().RemoteAddr) {
+					return echo.NewHTTPError(http.StatusForbidden, ForbiddenAddressMessage)
+				}
+				if auth.scope != nil {
+					if scope := auth.scope(ctx.Request()); !token.granted(scope) {
+						return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf(MissingScopeMessage, scope))
+					}
+				}
+				// Token was correct, record which one for the logger and keep serving request
+				ctx.Set(authTokenIDKey, token.id)
 				return next(ctx)
 			}
 		}
@@ -18,8 +18,10 @@ package middlewares
 
 import (
 	"errors"
+	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/labstack/echo/v4"
@@ -189,3 +191,92 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenSetReplace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tokens := MakeTokenSet("api", "token1")
+	authFn := MakeAuthWithTokenSets(testAPIHeader, MakeTokenSet("admin", "admin1"), tokens)
+	handler := authFn(success)
+
+	authenticate := func(token string) (string, error) {
+		req, _ := http.NewRequest("GET", "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		ctx := e.NewContext(req, nil)
+		err := handler(ctx)
+		id, _ := ctx.Get(authTokenIDKey).(string)
+		return id, err
+	}
+
+	// the token which authenticated a request is identified by its label and a fingerprint
+	id, err := authenticate("admin1")
+	require.Equal(t, errSuccess, err)
+	require.Regexp(t, "^admin/[0-9a-f]{8}$", id)
+	oldID, err := authenticate("token1")
+	require.Equal(t, errSuccess, err)
+	require.Regexp(t, "^api/[0-9a-f]{8}$", oldID)
+
+	// the previous token is still accepted during the overlap
+	expires := tokens.Replace("api", "token2", time.Hour)
+	require.WithinDuration(t, time.Now().Add(time.Hour), expires, time.Minute)
+	id, err = authenticate("token1")
+	require.Equal(t, errSuccess, err)
+	require.Equal(t, oldID, id)
+	newID, err := authenticate("token2")
+	require.Equal(t, errSuccess, err)
+	require.NotEqual(t, oldID, newID)
+
+	// and rejected once the overlap is over
+	require.False(t, func() bool { _, ok := tokens.match([]byte("token1"), expires); return ok }())
+
+	// without an overlap, the previous tokens are revoked right away
+	tokens.Replace("api", "token3", 0)
+	_, err = authenticate("token1")
+	require.Equal(t, invalidTokenError, err)
+	_, err = authenticate("token2")
+	require.Equal(t, invalidTokenError, err)
+	_, err = authenticate("token3")
+	require.Equal(t, errSuccess, err)
+}
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, local, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	named := MakeTokenSet("")
+	named.Add("dashboard", "read1", []Scope{ScopeReadOnly}, nil)
+	named.Add("local", "read2", []Scope{ScopeReadOnly}, []*net.IPNet{local})
+	named.Add("operator", "admin2", []Scope{ScopeAdmin}, nil)
+	routeScope := ScopeReadOnly
+	authFn := MakeScopedAuth(testAPIHeader, func(*http.Request) Scope { return routeScope }, MakeTokenSet("admin", "admin1"), named)
+	handler := authFn(success)
+
+	authenticate := func(token string, remoteAddr string) error {
+		req, _ := http.NewRequest("GET", "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		req.RemoteAddr = remoteAddr
+		return handler(e.NewContext(req, nil))
+	}
+	forbiddenScope := echo.NewHTTPError(http.StatusForbidden, "API token lacks the participation scope")
+	forbiddenAddress := echo.NewHTTPError(http.StatusForbidden, ForbiddenAddressMessage)
+
+	require.Equal(t, errSuccess, authenticate("read1", "192.0.2.1:4160"))
+	require.Equal(t, errSuccess, authenticate("read2", "10.1.2.3:4160"))
+	require.Equal(t, forbiddenAddress, authenticate("read2", "192.0.2.1:4160"))
+
+	// the built-in tokens are granted every scope, the admin scope grants every scope too
+	routeScope = ScopeParticipation
+	require.Equal(t, errSuccess, authenticate("admin1", "192.0.2.1:4160"))
+	require.Equal(t, errSuccess, authenticate("admin2", "192.0.2.1:4160"))
+	require.Equal(t, forbiddenScope, authenticate("read1", "192.0.2.1:4160"))
+
+	require.True(t, named.Remove("dashboard"))
+	require.False(t, named.Remove("dashboard"))
+	routeScope = ScopeReadOnly
+	require.Equal(t, invalidTokenError, authenticate("read1", "192.0.2.1:4160"))
+	require.Equal(t, errSuccess, authenticate("read2", "10.1.2.3:4160"))
+
+	_, err = ParseScope("write")
+	require.ErrorContains(t, err, "unknown scope write")
+}
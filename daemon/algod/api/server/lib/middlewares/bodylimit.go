@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestTooLargeMessage is the message set when a request body exceeds the limit of its route.
+const RequestTooLargeMessage = "request body exceeds the limit of %d bytes"
+
+// limitedBody is a request body which fails once more than limit bytes are read from it, and
+// remembers that it did so even when the error gets wrapped by a decoder.
+type limitedBody struct {
+	io.ReadCloser
+	limit    int64
+	exceeded bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// RequestBodyLimitExceeded returns the body size limit of a request, and whether reading its body
+// failed because it was exceeded.
+func RequestBodyLimitExceeded(req *http.Request) (int64, bool) {
+	body, ok := req.Body.(*limitedBody)
+	if !ok {
+		return 0, false
+	}
+	return body.limit, body.exceeded
+}
+
+// MakeBodyLimit makes an echo middleware which caps the size of the request bodies at the limit
+// given to their route in routeLimits, or at defaultLimit unless it is zero. Requests declaring a larger body are
+// rejected before it is read. The bodies of the other requests fail as soon as they exceed the limit,
+// so that handlers decoding them as a stream stop there rather than buffering them entirely.
+func MakeBodyLimit(defaultLimit int64, routeLimits map[string]int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			limit := defaultLimit
+			if routeLimit, ok := routeLimits[ctx.Path()]; ok {
+				limit = routeLimit
+			}
+			if limit <= 0 {
+				return next(ctx)
+			}
+
+			req := ctx.Request()
+			if req.ContentLength > limit {
+				return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf(RequestTooLargeMessage, limit))
+			}
+			req.Body = &limitedBody{
+				ReadCloser: http.MaxBytesReader(ctx.Response().Writer, req.Body, limit),
+				limit:      limit,
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// ParseBodyLimits parses a semicolon separated list of route=bytes pairs, such as
+// "/v2/transactions=1048576;/v2/transactions/simulate=20971520".
+func ParseBodyLimits(spec string) (map[string]int64, error) {
+	limits := make(map[string]int64)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("body limit %q is not of the form route=bytes", entry)
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("body limit of route %s is not a positive number of bytes: %q", route, value)
+		}
+		limits[strings.TrimSpace(route)] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// serveLimited posts body to route, with its length undeclared if chunked is set, and returns
+// the response along with whether the handler was reached.
+func serveLimited(t *testing.T, route string, body []byte, chunked bool) (*httptest.ResponseRecorder, bool) {
+	e := echo.New()
+	e.Use(middlewares.MakeBodyLimit(64, map[string]int64{"/v2/small": 8}))
+	reached := false
+	handler := func(c echo.Context) error {
+		reached = true
+		_, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			limit, exceeded := middlewares.RequestBodyLimitExceeded(c.Request())
+			require.True(t, exceeded)
+			return c.String(http.StatusRequestEntityTooLarge, strconv.FormatInt(limit, 10))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+	e.POST("/v2/small", handler)
+	e.POST("/v2/other", handler)
+
+	req := httptest.NewRequest(http.MethodPost, route, bytes.NewReader(body))
+	if chunked {
+		req.ContentLength = -1
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec, reached
+}
+
+func TestBodyLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rec, reached := serveLimited(t, "/v2/other", make([]byte, 64), false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, reached)
+
+	// declared bodies over the limit are rejected before the handler runs
+	rec, reached = serveLimited(t, "/v2/other", make([]byte, 65), false)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.False(t, reached)
+	rec, reached = serveLimited(t, "/v2/small", make([]byte, 9), false)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.False(t, reached)
+
+	// undeclared ones fail while being read
+	rec, reached = serveLimited(t, "/v2/small", make([]byte, 9), true)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.True(t, reached)
+	require.Equal(t, "8", rec.Body.String())
+	rec, reached = serveLimited(t, "/v2/small", make([]byte, 8), true)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, reached)
+}
+
+func TestParseBodyLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limits, err := middlewares.ParseBodyLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	limits, err = middlewares.ParseBodyLimits(" /v2/transactions = 1024 ;/v2/transactions/simulate=2048;")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"/v2/transactions": 1024, "/v2/transactions/simulate": 2048}, limits)
+
+	_, err = middlewares.ParseBodyLimits("/v2/transactions")
+	require.Error(t, err)
+	_, err = middlewares.ParseBodyLimits("/v2/transactions=0")
+	require.Error(t, err)
+	_, err = middlewares.ParseBodyLimits("/v2/transactions=1MB")
+	require.Error(t, err)
+}
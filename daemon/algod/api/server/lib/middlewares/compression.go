@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/zstd"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	encodingZstd = "zstd"
+	encodingGzip = "gzip"
+)
+
+// negotiateEncoding picks the content encoding of a response from the request Accept-Encoding header.
+// zstd is preferred over gzip when the client weighs them equally. It returns an empty string when
+// neither is acceptable.
+func negotiateEncoding(acceptEncoding string) string {
+	best, bestQ := "", 0.0
+	for _, entry := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(entry), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != encodingZstd && name != encodingGzip {
+			continue
+		}
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if found && strings.TrimSpace(key) == "q" {
+				parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				if err != nil {
+					parsed = 0
+				}
+				q = parsed
+			}
+		}
+		if q > bestQ || (q == bestQ && q > 0 && name == encodingZstd) {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+func compress(encoding string, data []byte) ([]byte, error) {
+	if encoding == encodingZstd {
+		return zstd.CompressLevel(nil, data, zstd.BestSpeed)
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MakeCompression makes an echo middleware which compresses the successful responses of at least
+// threshold bytes with zstd or gzip, depending on the encodings accepted by the client. The responses
+// of the streamed routes are sent as they are written, and never compressed.
+func MakeCompression(threshold int, streamed ...string) echo.MiddlewareFunc {
+	skipped := make(map[string]bool, len(streamed))
+	for _, route := range streamed {
+		skipped[route] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			encoding := negotiateEncoding(ctx.Request().Header.Get(echo.HeaderAcceptEncoding))
+			if encoding == "" || skipped[ctx.Path()] {
+				return next(ctx)
+			}
+
+			response := ctx.Response()
+			original := response.Writer
+			original.Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+			buffered := &bufferedWriter{header: original.Header(), status: http.StatusOK}
+			response.Writer = buffered
+			err := next(ctx)
+			response.Writer = original
+			if !response.Committed {
+				return err
+			}
+
+			body := buffered.body.Bytes()
+			if buffered.status == http.StatusOK && len(body) >= threshold && original.Header().Get(echo.HeaderContentEncoding) == "" {
+				compressed, compressErr := compress(encoding, body)
+				if compressErr == nil {
+					body = compressed
+					original.Header().Set(echo.HeaderContentEncoding, encoding)
+					original.Header().Del(echo.HeaderContentLength)
+				}
+			}
+			original.WriteHeader(buffered.status)
+			n, writeErr := original.Write(body)
+			response.Size = int64(n)
+			if err == nil {
+				err = writeErr
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/zstd"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func serveCompressed(t *testing.T, acceptEncoding string, payload []byte) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.Use(middlewares.MakeCompression(1024))
+	e.GET("/v2/blocks/:round", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, "application/msgpack", payload)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/blocks/1", nil)
+	if acceptEncoding != "" {
+		req.Header.Set(echo.HeaderAcceptEncoding, acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec
+}
+
+func TestCompressionNegotiation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	large := bytes.Repeat([]byte("algorand"), 1024)
+
+	rec := serveCompressed(t, "gzip, deflate", large)
+	require.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, large, decompressed)
+
+	rec = serveCompressed(t, "gzip, zstd", large)
+	require.Equal(t, "zstd", rec.Header().Get(echo.HeaderContentEncoding))
+	decompressed, err = zstd.Decompress(nil, rec.Body.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, large, decompressed)
+
+	// quality values are honored
+	rec = serveCompressed(t, "zstd;q=0.5, gzip;q=0.8", large)
+	require.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+	rec = serveCompressed(t, "zstd;q=0, gzip;q=0", large)
+	require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	require.Equal(t, large, rec.Body.Bytes())
+
+	// unsupported or missing encodings
+	rec = serveCompressed(t, "br", large)
+	require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	require.Equal(t, large, rec.Body.Bytes())
+	rec = serveCompressed(t, "", large)
+	require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	require.Equal(t, large, rec.Body.Bytes())
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	small := bytes.Repeat([]byte("a"), 1023)
+	rec := serveCompressed(t, "gzip", small)
+	require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	require.Equal(t, small, rec.Body.Bytes())
+	require.Equal(t, echo.HeaderAcceptEncoding, rec.Header().Get(echo.HeaderVary))
+}
+
+func TestCompressionSkipsStreamedRoutes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	large := bytes.Repeat([]byte("algorand"), 1024)
+	e := echo.New()
+	e.Use(middlewares.MakeCompression(1024, "/v2/blocks/subscribe"))
+	e.GET("/v2/blocks/subscribe", func(c echo.Context) error {
+		c.Response().WriteHeader(http.StatusOK)
+		// flushing does nothing if the response writer was replaced by a buffer.
+		c.Response().Flush()
+		_, err := c.Response().Write(large)
+		return err
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/blocks/subscribe", nil)
+	req.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	require.Equal(t, large, rec.Body.Bytes())
+}
+
+func TestCompressionBufferedResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	large := bytes.Repeat([]byte("algorand"), 1024)
+	e := echo.New()
+	e.Use(middlewares.MakeCompression(1024))
+	e.GET("/v2/blocks/:round", func(c echo.Context) error {
+		// a buffered response can be flushed, but its connection cannot be taken over
+		c.Response().Flush()
+		_, _, err := c.Response().Hijack()
+		require.Error(t, err)
+		return c.Blob(http.StatusOK, "application/msgpack", large)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/blocks/1", nil)
+	req.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+}
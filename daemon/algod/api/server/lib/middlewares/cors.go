@@ -23,11 +23,11 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 )
 
-// MakeCORS sets up CORS with a token header.
-func MakeCORS(tokenHeader string) echo.MiddlewareFunc {
+// MakeCORS sets up CORS with a token header, and the other request headers the API accepts.
+func MakeCORS(tokenHeader string, headers ...string) echo.MiddlewareFunc {
 	return middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
-		AllowHeaders: []string{tokenHeader, "Content-Type"},
+		AllowHeaders: append([]string{tokenHeader, "Content-Type"}, headers...),
 		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
 	})
 }
@@ -53,10 +53,16 @@ func (logger *LoggerMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc
 			ctx.Error(err)
 		}
 
+		// Report the token which authenticated the request in the user field, when there is one.
+		user := "-"
+		if id := AuthTokenID(ctx); id != "" {
+			user = id
+		}
+
 		logger.log.Infof("%s %s %s [%v] \"%s %s %s\" %d %s \"%s\" %s",
 			req.RemoteAddr,
 			"-",
-			"-",
+			user,
 			start,
 			req.Method,
 			req.RequestURI,
@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+)
+
+// FieldsQueryParam is the query parameter listing the response fields a client wants returned.
+const FieldsQueryParam = "fields"
+
+// fieldTree is the parsed form of a fields query parameter. A nil subtree keeps the whole value.
+type fieldTree map[string]fieldTree
+
+// parseFields parses a comma separated list of dotted paths, such as "amount,assets.asset-id".
+func parseFields(fields string) (fieldTree, error) {
+	tree := make(fieldTree)
+	for _, path := range strings.Split(fields, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		node := tree
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			if segment == "" {
+				return nil, fmt.Errorf("invalid field path '%s'", path)
+			}
+			child, ok := node[segment]
+			if ok && child == nil {
+				// an enclosing path already keeps the whole value
+				break
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if !ok {
+				child = make(fieldTree)
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	if len(tree) == 0 {
+		return nil, fmt.Errorf("no field requested")
+	}
+	return tree, nil
+}
+
+// project keeps the parts of a decoded JSON value selected by tree. Arrays are traversed
+// transparently, so that a path applies to each of their elements.
+func (tree fieldTree) project(value interface{}) (interface{}, bool) {
+	if tree == nil {
+		return value, true
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		projected := make(map[string]interface{}, len(tree))
+		for key, subtree := range tree {
+			child, ok := v[key]
+			if !ok {
+				continue
+			}
+			if child, ok = subtree.project(child); ok {
+				projected[key] = child
+			}
+		}
+		return projected, true
+	case []interface{}:
+		projected := make([]interface{}, len(v))
+		for i, elem := range v {
+			elem, ok := tree.project(elem)
+			if !ok {
+				elem = map[string]interface{}{}
+			}
+			projected[i] = elem
+		}
+		return projected, true
+	default:
+		// the path goes deeper than the value
+		return nil, false
+	}
+}
+
+// bufferedWriter holds a response back so that it can be rewritten before being sent.
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// Flush does nothing, the response is sent once the handler returns.
+func (w *bufferedWriter) Flush() {
+}
+
+// errBufferedHijack is returned to handlers trying to take over the connection of a buffered response.
+var errBufferedHijack = errors.New("the connection of a buffered response cannot be hijacked")
+
+// Hijack fails, as the connection cannot be handed over while the response is held back.
+func (w *bufferedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errBufferedHijack
+}
+
+// MakeFieldProjection makes an echo middleware which prunes the successful JSON responses of the
+// given routes down to the fields listed in the FieldsQueryParam query parameter, if any.
+// Paths are dot separated, and apply to every element of the arrays they traverse; for instance
+// "fields=amount,assets.asset-id" keeps only the balance and the asset ids of an account.
+// The projection is decoded and encoded again with handle, which must be the JSON handle the routes
+// encode their responses with. Other responses, including msgpack encoded ones, are sent unmodified.
+func MakeFieldProjection(handle codec.Handle, routes ...string) echo.MiddlewareFunc {
+	projected := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		projected[route] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			fields := ctx.QueryParam(FieldsQueryParam)
+			if fields == "" || !projected[ctx.Path()] {
+				return next(ctx)
+			}
+			tree, err := parseFields(fields)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s parameter: %v", FieldsQueryParam, err))
+			}
+
+			response := ctx.Response()
+			original := response.Writer
+			buffered := &bufferedWriter{header: original.Header(), status: http.StatusOK}
+			response.Writer = buffered
+			err = next(ctx)
+			response.Writer = original
+			if !response.Committed {
+				// nothing was written, e.g. the handler returned an error for echo to report
+				return err
+			}
+
+			body := buffered.body.Bytes()
+			if buffered.status == http.StatusOK && strings.HasPrefix(original.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+				body = projectJSON(body, tree, handle)
+				original.Header().Del(echo.HeaderContentLength)
+			}
+			original.WriteHeader(buffered.status)
+			n, writeErr := original.Write(body)
+			response.Size = int64(n)
+			if err == nil {
+				err = writeErr
+			}
+			return err
+		}
+	}
+}
+
+// projectJSON returns the projection of a JSON document, or the document itself if it cannot be parsed.
+func projectJSON(body []byte, tree fieldTree, handle codec.Handle) []byte {
+	var value interface{}
+	if err := codec.NewDecoderBytes(body, handle).Decode(&value); err != nil {
+		return body
+	}
+	value, ok := tree.project(value)
+	if !ok {
+		value = map[string]interface{}{}
+	}
+	var out []byte
+	if err := codec.NewEncoderBytes(&out, handle).Encode(value); err != nil {
+		return body
+	}
+	return out
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const accountJSON = `{"address":"ADDR","amount":18446744073709551615,"assets":[{"asset-id":1,"amount":5,"is-frozen":false},{"asset-id":2,"amount":7,"is-frozen":true}],"round":12}`
+
+func serveProjected(t *testing.T, target string, handler echo.HandlerFunc) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.Use(middlewares.MakeFieldProjection(protocol.JSONStrictHandle, "/v2/accounts/:address"))
+	e.GET("/v2/accounts/:address", handler)
+	e.GET("/v2/other", handler)
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func accountHandler(c echo.Context) error {
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, []byte(accountJSON))
+}
+
+func TestFieldProjection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rec := serveProjected(t, "/v2/accounts/ADDR?fields=amount,assets.asset-id", accountHandler)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"amount":18446744073709551615,"assets":[{"asset-id":1},{"asset-id":2}]}`, rec.Body.String())
+
+	// a path covered by a shorter one keeps the whole value
+	rec = serveProjected(t, "/v2/accounts/ADDR?fields=assets.amount,assets", accountHandler)
+	require.JSONEq(t, `{"assets":[{"asset-id":1,"amount":5,"is-frozen":false},{"asset-id":2,"amount":7,"is-frozen":true}]}`, rec.Body.String())
+
+	// unknown fields, and paths deeper than the values, are dropped
+	rec = serveProjected(t, "/v2/accounts/ADDR?fields=round,missing,amount.deeper", accountHandler)
+	require.JSONEq(t, `{"round":12}`, rec.Body.String())
+}
+
+func TestFieldProjectionEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	type asset struct {
+		ID   uint64 `codec:"asset-id"`
+		Name string `codec:"name"`
+		URL  []byte `codec:"url"`
+	}
+	type account struct {
+		Amount uint64  `codec:"amount"`
+		Assets []asset `codec:"assets"`
+		Round  uint64  `codec:"round"`
+	}
+	full := account{Amount: 1<<64 - 1, Assets: []asset{{ID: 1, Name: "<a&b>", URL: []byte{0xff, 0x00}}}, Round: 12}
+	handler := func(c echo.Context) error {
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, protocol.EncodeJSONStrict(full))
+	}
+
+	// the projection is encoded as the response would have been: indented, with the HTML characters
+	// and the base64 encoding of the byte slices left as is
+	type projectedAsset struct {
+		Name string `codec:"name"`
+		URL  []byte `codec:"url"`
+	}
+	type projectedAccount struct {
+		Amount uint64           `codec:"amount"`
+		Assets []projectedAsset `codec:"assets"`
+	}
+	expected := projectedAccount{Amount: full.Amount, Assets: []projectedAsset{{Name: full.Assets[0].Name, URL: full.Assets[0].URL}}}
+
+	rec := serveProjected(t, "/v2/accounts/ADDR?fields=amount,assets.name,assets.url", handler)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, string(protocol.EncodeJSONStrict(expected)), rec.Body.String())
+}
+
+func TestFieldProjectionPassThrough(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// no fields requested
+	rec := serveProjected(t, "/v2/accounts/ADDR", accountHandler)
+	require.Equal(t, accountJSON, rec.Body.String())
+
+	// route not subject to projection
+	rec = serveProjected(t, "/v2/other?fields=amount", accountHandler)
+	require.Equal(t, accountJSON, rec.Body.String())
+
+	// non JSON response
+	rec = serveProjected(t, "/v2/accounts/ADDR?fields=amount", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, "application/msgpack", []byte{0x81, 0xa1, 0x61, 0x01})
+	})
+	require.Equal(t, []byte{0x81, 0xa1, 0x61, 0x01}, rec.Body.Bytes())
+
+	// error responses are not projected
+	rec = serveProjected(t, "/v2/accounts/ADDR?fields=amount", func(c echo.Context) error {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "account not found"})
+	})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"message":"account not found"}`, rec.Body.String())
+
+	// errors returned by the handler are reported by echo
+	rec = serveProjected(t, "/v2/accounts/ADDR?fields=amount", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "catching up")
+	})
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestFieldProjectionInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, fields := range []string{",", "amount..asset-id", "assets."} {
+		rec := serveProjected(t, "/v2/accounts/ADDR?fields="+fields, accountHandler)
+		require.Equal(t, http.StatusBadRequest, rec.Code, fields)
+	}
+}
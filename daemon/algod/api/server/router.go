@@ -38,7 +38,7 @@ import (
 	ppublic "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/participating/public"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
-	"github.com/algorand/go-algorand/util/tokens"
+	"github.com/algorand/go-algorand/protocol"
 )
 
 // APINodeInterface describes all the node methods required by common and v2 APIs, and the server/router
@@ -51,10 +51,38 @@ const (
 	apiV1Tag = "/v1"
 	// TokenHeader is the header where we put the token.
 	TokenHeader = "X-Algo-API-Token"
-	// MaxRequestBodyBytes is the maximum request body size that we allow in our APIs.
-	MaxRequestBodyBytes = "10MB"
 )
 
+// projectedRoutes are the heavy routes whose JSON responses may be pruned with the fields query parameter.
+var projectedRoutes = []string{
+	"/v2/accounts/:address",
+	"/v2/accounts/:address/transactions/pending",
+	"/v2/blocks/:round",
+	"/v2/transactions/pending",
+	"/v2/transactions/pending/:txid",
+}
+
+// requestBodyLimits are the request body size limits of the public routes whose payloads are bounded
+// well below RestMaxRequestBodyBytes, so that oversized submissions are rejected early. They may be
+// overridden with RestRequestBodyLimits.
+var requestBodyLimits = map[string]int64{
+	// a transaction group is made of at most 16 transactions of a few kilobytes each.
+	"/v2/transactions":       1 << 20,
+	"/v2/transactions/async": 1 << 20,
+	"/v2/transactions/merge": 4 << 20,
+	// a list of at most 256 assets and applications to opt in to.
+	"/v2/accounts/:address/opt-in": 64 << 10,
+}
+
+// streamedRoutes are the routes whose responses are streamed, and must not be buffered by middlewares.
+var streamedRoutes = []string{
+	"/v2/blocks/subscribe",
+	"/v2/blocks/range",
+	"/v2/applications/:application-id/boxes/watch",
+	// the debug adapter hijacks the connection to upgrade it to a WebSocket
+	"/v2/transactions/simulate/debug",
+}
+
 // wrapCtx passes a common context to each request without a global variable.
 func wrapCtx(ctx lib.ReqContext, handler func(lib.ReqContext, echo.Context)) echo.HandlerFunc {
 	return func(context echo.Context) error {
@@ -71,23 +99,77 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 	}
 }
 
+// RouterRole selects which sets of REST handlers a router serves.
+type RouterRole int
+
+const (
+	// RouterRoleCombined serves both the public and the admin routes, each guarded by its own tokens.
+	RouterRoleCombined RouterRole = iota
+	// RouterRolePublic serves only the public routes; no admin route is registered.
+	RouterRolePublic
+	// RouterRoleAdmin serves all routes, and requires the admin token for every one of them.
+	RouterRoleAdmin
+)
+
+// scope returns the scope a named token must be granted to be served the requests of a group of routes.
+func scope(s middlewares.Scope) func(*http.Request) middlewares.Scope {
+	return func(*http.Request) middlewares.Scope { return s }
+}
+
+// writeScope returns the scope a named token must be granted to be served the requests of a group
+// of public routes: reading them only requires ScopeReadOnly.
+func writeScope(s middlewares.Scope) func(*http.Request) middlewares.Scope {
+	return func(r *http.Request) middlewares.Scope {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return middlewares.ScopeReadOnly
+		}
+		return s
+	}
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
-	if err := tokens.ValidateAPIToken(apiToken); err != nil {
-		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
+func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiTokens *APITokens, submissions *v2.SubmissionCache, shutdowner v2.ShutdownRequester, listener net.Listener, numConnectionsLimit uint64, role RouterRole) *echo.Echo {
+	// The built-in tokens are granted every route they are accepted for, while the named tokens
+	// are only granted the routes of their scopes, derived from the tags of the routes in the
+	// OpenAPI specification.
+	adminTokens := []*middlewares.TokenSet{apiTokens.admin, apiTokens.named}
+	publicTokens := []*middlewares.TokenSet{apiTokens.admin, apiTokens.api, apiTokens.named}
+	if role == RouterRoleAdmin {
+		publicTokens = adminTokens
 	}
-	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
-		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
+	adminMiddleware := func(routesScope func(*http.Request) middlewares.Scope) []echo.MiddlewareFunc {
+		if role == RouterRoleAdmin {
+			routesScope = scope(middlewares.ScopeAdmin)
+		}
+		return []echo.MiddlewareFunc{
+			middlewares.MakeScopedAuth(TokenHeader, routesScope, adminTokens...),
+		}
 	}
-	adminMiddleware := []echo.MiddlewareFunc{
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken}),
+	bodyLimits := make(map[string]int64, len(requestBodyLimits))
+	for route, limit := range requestBodyLimits {
+		bodyLimits[route] = limit
 	}
-	publicMiddleware := []echo.MiddlewareFunc{
-		middleware.BodyLimit(MaxRequestBodyBytes),
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken}),
+	overrides, err := middlewares.ParseBodyLimits(node.Config().RestRequestBodyLimits)
+	if err != nil {
+		logger.Warnf("ignoring RestRequestBodyLimits: %v", err)
 	}
+	for route, limit := range overrides {
+		bodyLimits[route] = limit
+	}
+	bodyLimit := middlewares.MakeBodyLimit(node.Config().RestMaxRequestBodyBytes, bodyLimits)
+	publicMiddleware := func(routesScope func(*http.Request) middlewares.Scope) []echo.MiddlewareFunc {
+		if role == RouterRoleAdmin {
+			routesScope = scope(middlewares.ScopeAdmin)
+		}
+		return []echo.MiddlewareFunc{
+			bodyLimit,
+			middlewares.MakeScopedAuth(TokenHeader, routesScope, publicTokens...),
+		}
+	}
+	registerAdmin := role != RouterRolePublic
 
 	e := echo.New()
+	e.HTTPErrorHandler = v2.HTTPErrorHandler
 
 	e.Listener = listener
 	e.HideBanner = true
@@ -97,8 +179,12 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		middleware.RemoveTrailingSlash())
 	e.Use(
 		middlewares.MakeLogger(logger),
-		middlewares.MakeCORS(TokenHeader),
+		middlewares.MakeCORS(TokenHeader, v2.IdempotencyKeyHeader),
 	)
+	if node.Config().EnableRestResponseCompression {
+		e.Use(middlewares.MakeCompression(node.Config().RestResponseCompressionThreshold, streamedRoutes...))
+	}
+	e.Use(middlewares.MakeFieldProjection(protocol.JSONStrictHandle, projectedRoutes...))
 
 	// Request Context
 	ctx := lib.ReqContext{Node: node, Log: logger, Shutdown: shutdown}
@@ -107,33 +193,40 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 
 	// Route pprof requests to DefaultServeMux.
 	// The auth middleware removes /urlAuth/:token so that it can be routed correctly.
-	if node.Config().EnableProfiler {
-		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
-		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
+	if node.Config().EnableProfiler && registerAdmin {
+		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminMiddleware(scope(middlewares.ScopeAdmin))...)
+		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminMiddleware(scope(middlewares.ScopeAdmin))...)
 	}
 	// Registering common routes (no auth)
 	registerHandlers(e, "", common.Routes, ctx)
 
 	// Registering v1 routes
-	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, publicMiddleware...)
+	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, publicMiddleware(writeScope(middlewares.ScopeParticipation))...)
 
 	// Registering v2 routes
 	v2Handler := v2.Handlers{
-		Node:     node,
-		Log:      logger,
-		Shutdown: shutdown,
+		Node:        node,
+		Log:         logger,
+		Shutdown:    shutdown,
+		APITokens:   apiTokens,
+		Shutdowner:  shutdowner,
+		Submissions: submissions,
+	}
+	// the nonparticipating routes still change the state of the node with their other methods, such as
+	// the devmode block offset and the simulation sessions.
+	nppublic.RegisterHandlers(e, &v2Handler, publicMiddleware(writeScope(middlewares.ScopeParticipation))...)
+	ppublic.RegisterHandlers(e, &v2Handler, publicMiddleware(writeScope(middlewares.ScopeParticipation))...)
+	if registerAdmin {
+		npprivate.RegisterHandlers(e, &v2Handler, adminMiddleware(scope(middlewares.ScopeAdmin))...)
+		pprivate.RegisterHandlers(e, &v2Handler, adminMiddleware(scope(middlewares.ScopeParticipation))...)
 	}
-	nppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
-	npprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
-	ppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
-	pprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
 
 	if node.Config().EnableFollowMode {
-		data.RegisterHandlers(e, &v2Handler, publicMiddleware...)
+		data.RegisterHandlers(e, &v2Handler, publicMiddleware(writeScope(middlewares.ScopeParticipation))...)
 	}
 
 	if node.Config().EnableExperimentalAPI {
-		experimental.RegisterHandlers(e, &v2Handler, publicMiddleware...)
+		experimental.RegisterHandlers(e, &v2Handler, publicMiddleware(writeScope(middlewares.ScopeParticipation))...)
 	}
 
 	return e
@@ -22,8 +22,11 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -76,3 +79,36 @@ func TestGetTransactionV1Sunset(t *testing.T) {
 		assert.Equal(t, http.StatusGone, rec.Code)
 	}
 }
+
+// configOnlyNode serves the configuration of the node, which is all a router needs to be built.
+type configOnlyNode struct {
+	APINodeInterface
+}
+
+func (configOnlyNode) Config() config.Local {
+	return config.GetDefaultLocal()
+}
+
+func TestReadOnlyTokenScope(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	apiTokens := MakeAPITokens(log, t.TempDir(), "", "", 0)
+	token, err := apiTokens.CreateAPIToken("reader", []middlewares.Scope{middlewares.ScopeReadOnly}, nil)
+	require.NoError(t, err)
+	e := NewRouter(log, configOnlyNode{}, nil, apiTokens, nil, nil, nil, 10, RouterRoleCombined)
+
+	// the nonparticipating routes changing the state of the node are not granted to read-only tokens
+	for _, route := range []struct{ method, path string }{
+		{http.MethodPost, "/v2/devmode/blocks/offset/10"},
+		{http.MethodDelete, "/v2/transactions/simulate/sessions/session"},
+		{http.MethodPost, "/v2/transactions/simulate"},
+	} {
+		req := httptest.NewRequest(route.method, route.path, nil)
+		req.Header.Set(TokenHeader, token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusForbidden, rec.Code, route.path)
+		require.Contains(t, rec.Body.String(), string(middlewares.ScopeParticipation), route.path)
+	}
+}
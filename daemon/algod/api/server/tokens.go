@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+const (
+	apiTokenLabel      = "api"
+	adminAPITokenLabel = "admin"
+)
+
+// APITokens holds the tokens guarding the REST API. It is shared by all the routers of a server,
+// so that the API token can be rotated and named tokens created while they are serving requests.
+type APITokens struct {
+	api   *middlewares.TokenSet
+	admin *middlewares.TokenSet
+	// named holds the named tokens, which are only granted their scopes.
+	named       *middlewares.TokenSet
+	namedTokens []namedAPIToken
+
+	// apiAuth is false when the public routes do not require a token.
+	apiAuth bool
+	// dataDir is the directory the rotated API token is written to.
+	dataDir string
+	// overlap is how long the previous API token remains accepted after a rotation.
+	overlap time.Duration
+
+	log logging.Logger
+	mu  deadlock.Mutex
+}
+
+// namedAPIToken is a named API token, as written to the data directory.
+type namedAPIToken struct {
+	Name         string              `json:"name"`
+	Token        string              `json:"token"`
+	Scopes       []middlewares.Scope `json:"scopes"`
+	AllowedCIDRs []string            `json:"allowed-cidrs,omitempty"`
+}
+
+// MakeAPITokens creates the APITokens of a server, loading the named tokens from the data directory.
+// An empty apiToken disables the authentication of the public routes, in which case the API token
+// cannot be rotated.
+func MakeAPITokens(log logging.Logger, dataDir string, apiToken string, adminAPIToken string, overlap time.Duration) *APITokens {
+	if apiToken != "" {
+		if err := tokens.ValidateAPIToken(apiToken); err != nil {
+			log.Errorf("Invalid apiToken was passed to MakeAPITokens ('%s'): %v", apiToken, err)
+		}
+	}
+	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
+		log.Errorf("Invalid adminAPIToken was passed to MakeAPITokens ('%s'): %v", adminAPIToken, err)
+	}
+	t := &APITokens{
+		api:     middlewares.MakeTokenSet(apiTokenLabel, apiToken),
+		admin:   middlewares.MakeTokenSet(adminAPITokenLabel, adminAPIToken),
+		named:   middlewares.MakeTokenSet(""),
+		apiAuth: apiToken != "",
+		dataDir: dataDir,
+		overlap: overlap,
+		log:     log,
+	}
+	if err := t.loadNamedTokens(); err != nil {
+		log.Errorf("Named API tokens could not be loaded from %s: %v", tokens.AlgodNamedTokensFilename, err)
+	}
+	return t
+}
+
+func (t *APITokens) loadNamedTokens() error {
+	data, err := os.ReadFile(filepath.Join(t.dataDir, tokens.AlgodNamedTokensFilename))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	var namedTokens []namedAPIToken
+	err = json.Unmarshal(data, &namedTokens)
+	if err != nil {
+		return err
+	}
+	for _, namedToken := range namedTokens {
+		networks, err := parseNetworks(namedToken.AllowedCIDRs)
+		for _, scope := range namedToken.Scopes {
+			if err == nil {
+				_, err = middlewares.ParseScope(string(scope))
+			}
+		}
+		if err != nil {
+			t.log.Warnf("Ignoring the named API token %s: %v", namedToken.Name, err)
+			continue
+		}
+		t.named.Add(namedToken.Name, namedToken.Token, namedToken.Scopes, networks)
+		t.namedTokens = append(t.namedTokens, namedToken)
+	}
+	return nil
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// writeNamedTokens writes the named tokens to the data directory, only readable by the owner of
+// the node since the file holds the values of the tokens.
+func (t *APITokens) writeNamedTokens(namedTokens []namedAPIToken) error {
+	data, err := json.MarshalIndent(namedTokens, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(t.dataDir, tokens.AlgodNamedTokensFilename)
+	err = os.WriteFile(path+".tmp", data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// CreateAPIToken generates a named API token, and writes it to the data directory.
+func (t *APITokens) CreateAPIToken(name string, scopes []middlewares.Scope, networks []*net.IPNet) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// the names of the built-in tokens label them in the logs too
+	if name == apiTokenLabel || name == adminAPITokenLabel {
+		return "", v2.ErrAPITokenExists
+	}
+	for _, namedToken := range t.namedTokens {
+		if namedToken.Name == name {
+			return "", v2.ErrAPITokenExists
+		}
+	}
+	token, err := tokens.NewAPIToken()
+	if err != nil {
+		return "", err
+	}
+	namedToken := namedAPIToken{Name: name, Token: token, Scopes: scopes}
+	for _, network := range networks {
+		namedToken.AllowedCIDRs = append(namedToken.AllowedCIDRs, network.String())
+	}
+	namedTokens := append(append([]namedAPIToken{}, t.namedTokens...), namedToken)
+	err = t.writeNamedTokens(namedTokens)
+	if err != nil {
+		return "", err
+	}
+	t.named.Add(name, token, scopes, networks)
+	t.namedTokens = namedTokens
+	return token, nil
+}
+
+// DeleteAPIToken revokes a named API token, and removes it from the data directory.
+func (t *APITokens) DeleteAPIToken(name string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	namedTokens := make([]namedAPIToken, 0, len(t.namedTokens))
+	for _, namedToken := range t.namedTokens {
+		if namedToken.Name != name {
+			namedTokens = append(namedTokens, namedToken)
+		}
+	}
+	if len(namedTokens) == len(t.namedTokens) {
+		return v2.ErrAPITokenNotFound
+	}
+	err := t.writeNamedTokens(namedTokens)
+	if err != nil {
+		return err
+	}
+	t.named.Remove(name)
+	t.namedTokens = namedTokens
+	return nil
+}
+
+// NamedAPITokens returns the named API tokens, without their values.
+func (t *APITokens) NamedAPITokens() []v2.NamedAPIToken {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	namedTokens := make([]v2.NamedAPIToken, len(t.namedTokens))
+	for i, namedToken := range t.namedTokens {
+		// the networks were checked when the token was created or loaded
+		networks, _ := parseNetworks(namedToken.AllowedCIDRs)
+		namedTokens[i] = v2.NamedAPIToken{Name: namedToken.Name, Scopes: namedToken.Scopes, Networks: networks}
+	}
+	return namedTokens
+}
+
+// RotateAPIToken generates a new API token, writes it to the data directory and starts accepting it.
+// The previous API token remains accepted until the returned time.
+func (t *APITokens) RotateAPIToken() (string, time.Time, error) {
+	if !t.apiAuth {
+		return "", time.Time{}, v2.ErrAPIAuthDisabled
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	apiToken, err := tokens.GenerateAPIToken(t.dataDir, tokens.AlgodTokenFilename)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expires := t.api.Replace(apiTokenLabel, apiToken, t.overlap)
+	t.log.Infof("API token rotated, the previous API token is accepted until %v", expires)
+	return apiToken, expires, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// maxSuggestedLeases is the maximum number of lease values GetSenderAdvisory suggests at once.
+const maxSuggestedLeases = 16
+
+// leaseHold is a lease held by a pending transaction during its validity window.
+type leaseHold struct {
+	lease       [32]byte
+	first, last basics.Round
+}
+
+// pendingUnit is a pending transaction group of a sender, or a single transaction when it isn't
+// part of a group. Units are the smallest pieces batches are built from.
+type pendingUnit struct {
+	txids  []string
+	leases []leaseHold
+	// isolated is set when the unit closes or rekeys the sender account, which invalidates any
+	// transaction of the sender ordered after it.
+	isolated bool
+}
+
+func (u *pendingUnit) conflicts(other *pendingUnit) bool {
+	if u.isolated || other.isolated {
+		return true
+	}
+	for _, a := range u.leases {
+		for _, b := range other.leases {
+			if a.lease == b.lease && a.first <= b.last && b.first <= a.last {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// adviseSender partitions the pending transactions of sender into batches which do not conflict
+// with each other, and lists the leases those transactions hold. pending is expected in pool
+// order, with the transactions of each group next to each other.
+func adviseSender(sender basics.Address, pending []transactions.SignedTxn) ([]model.PendingTransactionBatch, []model.LeaseUsage) {
+	var units []*pendingUnit
+	groups := make(map[crypto.Digest]*pendingUnit)
+	leasesInUse := make([]model.LeaseUsage, 0)
+	for _, stxn := range pending {
+		txn := stxn.Txn
+		if txn.Sender != sender {
+			continue
+		}
+		unit := groups[txn.Group]
+		if unit == nil {
+			unit = &pendingUnit{}
+			units = append(units, unit)
+			if !txn.Group.IsZero() {
+				groups[txn.Group] = unit
+			}
+		}
+		txid := txn.ID().String()
+		unit.txids = append(unit.txids, txid)
+		if txn.Lease != [32]byte{} {
+			unit.leases = append(unit.leases, leaseHold{lease: txn.Lease, first: txn.FirstValid, last: txn.LastValid})
+			leasesInUse = append(leasesInUse, model.LeaseUsage{Lease: txn.Lease[:], LastValid: uint64(txn.LastValid), Txid: txid})
+		}
+		if !txn.CloseRemainderTo.IsZero() || !txn.RekeyTo.IsZero() {
+			unit.isolated = true
+		}
+	}
+
+	// Units are placed in the first batch they do not conflict with. An isolated unit gets a batch
+	// of its own after every existing batch, and the units following it may only be placed after it.
+	var batches [][]*pendingUnit
+	floor := 0
+	for _, unit := range units {
+		placed := false
+		if !unit.isolated {
+			for b := floor; b < len(batches) && !placed; b++ {
+				conflicting := false
+				for _, other := range batches[b] {
+					if unit.conflicts(other) {
+						conflicting = true
+						break
+					}
+				}
+				if !conflicting {
+					batches[b] = append(batches[b], unit)
+					placed = true
+				}
+			}
+		}
+		if !placed {
+			batches = append(batches, []*pendingUnit{unit})
+		}
+		if unit.isolated {
+			floor = len(batches)
+		}
+	}
+
+	response := make([]model.PendingTransactionBatch, len(batches))
+	for i, batch := range batches {
+		response[i].Txids = make([]string, 0)
+		for _, unit := range batch {
+			response[i].Txids = append(response[i].Txids, unit.txids...)
+		}
+	}
+	return response, leasesInUse
+}
+
+// suggestLeases returns count random lease values, none of which is held by a pending transaction.
+func suggestLeases(count int, inUse []model.LeaseUsage) [][]byte {
+	held := make(map[[32]byte]bool, len(inUse))
+	for _, usage := range inUse {
+		var lease [32]byte
+		copy(lease[:], usage.Lease)
+		held[lease] = true
+	}
+	leases := make([][]byte, 0, count)
+	for len(leases) < count {
+		var lease [32]byte
+		crypto.RandBytes(lease[:])
+		if held[lease] {
+			continue
+		}
+		held[lease] = true
+		leases = append(leases, lease[:])
+	}
+	return leases
+}
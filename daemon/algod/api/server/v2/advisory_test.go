@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAdviseSender(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{0x01}
+	other := basics.Address{0x02}
+	payment := func(from basics.Address, note byte, lease byte, first, last basics.Round) transactions.SignedTxn {
+		stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: from, FirstValid: first, LastValid: last, Note: []byte{note}},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: other, Amount: basics.MicroAlgos{Raw: 1}},
+		}}
+		if lease != 0 {
+			stxn.Txn.Lease = [32]byte{lease}
+		}
+		return stxn
+	}
+	txid := func(stxn transactions.SignedTxn) string {
+		return stxn.Txn.ID().String()
+	}
+	txids := func(batches []model.PendingTransactionBatch) (ids [][]string) {
+		for _, batch := range batches {
+			ids = append(ids, batch.Txids)
+		}
+		return
+	}
+
+	// no pending transaction
+	batches, inUse := adviseSender(sender, nil)
+	require.Empty(t, batches)
+	require.Empty(t, inUse)
+
+	// transactions without a lease never conflict, other senders are ignored
+	a := payment(sender, 1, 0, 1, 100)
+	b := payment(sender, 2, 0, 1, 100)
+	batches, inUse = adviseSender(sender, []transactions.SignedTxn{a, payment(other, 3, 0, 1, 100), b})
+	require.Equal(t, [][]string{{txid(a), txid(b)}}, txids(batches))
+	require.Empty(t, inUse)
+
+	// the same lease only conflicts when the validity windows overlap
+	l1 := payment(sender, 1, 7, 1, 100)
+	l2 := payment(sender, 2, 7, 50, 150)
+	l3 := payment(sender, 3, 7, 101, 200)
+	batches, inUse = adviseSender(sender, []transactions.SignedTxn{l1, l2, l3})
+	require.Equal(t, [][]string{{txid(l1), txid(l3)}, {txid(l2)}}, txids(batches))
+	require.Len(t, inUse, 3)
+	require.Equal(t, model.LeaseUsage{Lease: l2.Txn.Lease[:], LastValid: 150, Txid: txid(l2)}, inUse[1])
+
+	// closing the account isolates the transaction and pushes the following ones after it
+	closing := payment(sender, 4, 0, 1, 100)
+	closing.Txn.CloseRemainderTo = other
+	batches, _ = adviseSender(sender, []transactions.SignedTxn{l1, l2, closing, a})
+	require.Equal(t, [][]string{{txid(l1)}, {txid(l2)}, {txid(closing)}, {txid(a)}}, txids(batches))
+
+	// rekeying is treated the same way
+	rekey := payment(sender, 5, 0, 1, 100)
+	rekey.Txn.RekeyTo = other
+	batches, _ = adviseSender(sender, []transactions.SignedTxn{a, rekey, b})
+	require.Equal(t, [][]string{{txid(a)}, {txid(rekey)}, {txid(b)}}, txids(batches))
+
+	// transactions of a group stay together, and conflict as a whole
+	g1 := payment(sender, 1, 9, 1, 100)
+	g2 := payment(sender, 2, 0, 1, 100)
+	g1.Txn.Group = crypto.Digest{0x01}
+	g2.Txn.Group = crypto.Digest{0x01}
+	l := payment(sender, 3, 9, 1, 100)
+	batches, _ = adviseSender(sender, []transactions.SignedTxn{g1, g2, l, b})
+	require.Equal(t, [][]string{{txid(g1), txid(g2), txid(b)}, {txid(l)}}, txids(batches))
+}
+
+func TestSuggestLeases(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	held := [32]byte{0x01}
+	leases := suggestLeases(maxSuggestedLeases, []model.LeaseUsage{{Lease: held[:]}})
+	require.Len(t, leases, maxSuggestedLeases)
+	seen := make(map[string]bool)
+	for _, lease := range leases {
+		require.Len(t, lease, 32)
+		require.NotEqual(t, held[:], lease)
+		require.False(t, seen[string(lease)])
+		seen[string(lease)] = true
+	}
+}
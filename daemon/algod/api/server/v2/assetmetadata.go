@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/httpclient"
+)
+
+// assetMetadataFetcher fetches the off-chain metadata of assets to verify it against their
+// on-chain metadata hash.
+type assetMetadataFetcher struct {
+	client      *http.Client
+	maxBytes    uint64
+	ipfsGateway string
+}
+
+// makeAssetMetadataFetcher returns a fetcher configured by cfg. control, when not nil, is called
+// on every connection the fetcher dials, after the address is resolved, and may refuse it.
+func makeAssetMetadataFetcher(cfg config.Local, control func(network, address string, c syscall.RawConn) error) assetMetadataFetcher {
+	dialer := &net.Dialer{Timeout: cfg.AssetMetadataFetchTimeout, Control: control}
+	return assetMetadataFetcher{
+		client: &http.Client{
+			Timeout: cfg.AssetMetadataFetchTimeout,
+			Transport: httpclient.MakeTransport(&http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: cfg.AssetMetadataFetchTimeout,
+				DisableKeepAlives:   true,
+			}, httpclient.PolicyFromConfig(cfg)),
+		},
+		maxBytes:    cfg.AssetMetadataMaxBytes,
+		ipfsGateway: cfg.AssetMetadataIPFSGateway,
+	}
+}
+
+// refusePrivateAddresses is a net.Dialer control refusing to connect to the loopback, private,
+// link-local and multicast addresses, so that asset URLs can't be used to reach the network of
+// the node. As it runs on the resolved address, it also covers DNS names and redirects.
+func refusePrivateAddresses(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address %s", address)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("refusing to connect to the non-public address %s", ip)
+	}
+	return nil
+}
+
+// resolve returns the URL the metadata named by assetURL is fetched from. ARC-3 URLs may end with
+// a #arc3 fragment, which is dropped.
+func (f assetMetadataFetcher) resolve(assetURL string) (string, error) {
+	u, err := url.Parse(assetURL)
+	if err != nil {
+		return "", err
+	}
+	u.Fragment = ""
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.String(), nil
+	case "ipfs":
+		if f.ipfsGateway == "" {
+			return "", fmt.Errorf("ipfs URLs are not fetched unless AssetMetadataIPFSGateway is configured")
+		}
+		return strings.TrimSuffix(f.ipfsGateway, "/") + "/" + strings.TrimPrefix(u.Host+u.Path, "/"), nil
+	default:
+		return "", fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+}
+
+// fetch downloads the content at target, failing when it is larger than maxBytes.
+func (f assetMetadataFetcher) fetch(ctx context.Context, target string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, int64(f.maxBytes)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(content)) > f.maxBytes {
+		return nil, fmt.Errorf("the metadata is larger than %d bytes", f.maxBytes)
+	}
+	return content, nil
+}
+
+// verify fetches the metadata of an asset and checks it against its metadata hash, which ARC-3
+// defines as the SHA-256 of the metadata. The SHA-512/256 used throughout the protocol is
+// accepted too.
+func (f assetMetadataFetcher) verify(ctx context.Context, asset basics.AssetIndex, params basics.AssetParams) model.AssetMetadataVerificationResponse {
+	response := model.AssetMetadataVerificationResponse{AssetId: uint64(asset), Url: params.URL}
+	if params.MetadataHash != ([32]byte{}) {
+		response.MetadataHash = sliceOrNil(params.MetadataHash[:])
+	}
+	switch {
+	case params.URL == "":
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictNoUrl
+		return response
+	case response.MetadataHash == nil:
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictNoMetadataHash
+		return response
+	}
+
+	target, err := f.resolve(params.URL)
+	if err != nil {
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictUnsupportedUrl
+		response.Error = omitEmpty(err.Error())
+		return response
+	}
+	content, err := f.fetch(ctx, target)
+	if err != nil {
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictUnreachable
+		response.Error = omitEmpty(err.Error())
+		return response
+	}
+	length := uint64(len(content))
+	response.ContentLength = &length
+
+	sha256Hash := sha256.Sum256(content)
+	sha512Hash := sha512.Sum512_256(content)
+	switch params.MetadataHash {
+	case sha512Hash:
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictMatch
+		response.HashAlgorithm = omitEmpty("sha512_256")
+		response.ContentHash = sliceOrNil(sha512Hash[:])
+	case sha256Hash:
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictMatch
+		response.HashAlgorithm = omitEmpty("sha256")
+		response.ContentHash = sliceOrNil(sha256Hash[:])
+	default:
+		response.Verdict = model.AssetMetadataVerificationResponseVerdictMismatch
+		response.HashAlgorithm = omitEmpty("sha256")
+		response.ContentHash = sliceOrNil(sha256Hash[:])
+	}
+	return response
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAssetMetadataResolve(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	fetcher := makeAssetMetadataFetcher(cfg, nil)
+	target, err := fetcher.resolve("https://example.com/nft/1.json#arc3")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/nft/1.json", target)
+
+	_, err = fetcher.resolve("ipfs://bafkreid/metadata.json")
+	require.ErrorContains(t, err, "AssetMetadataIPFSGateway")
+	_, err = fetcher.resolve("template-ipfs://{ipfscid:1:raw:reserve:sha2-256}")
+	require.Error(t, err)
+	_, err = fetcher.resolve("file:///etc/passwd")
+	require.ErrorContains(t, err, "unsupported URL scheme")
+
+	cfg.AssetMetadataIPFSGateway = "https://gateway.example.com/ipfs/"
+	fetcher = makeAssetMetadataFetcher(cfg, nil)
+	target, err = fetcher.resolve("ipfs://bafkreid/metadata.json#arc3")
+	require.NoError(t, err)
+	require.Equal(t, "https://gateway.example.com/ipfs/bafkreid/metadata.json", target)
+}
+
+func TestAssetMetadataVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	metadata := []byte(`{"name":"nft","image":"ipfs://bafkreid"}`)
+	tampered := []byte(`{"name":"nft","image":"ipfs://bafkreie"}`)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata.json", func(w http.ResponseWriter, r *http.Request) { w.Write(metadata) })
+	mux.HandleFunc("/tampered.json", func(w http.ResponseWriter, r *http.Request) { w.Write(tampered) })
+	mux.HandleFunc("/large.json", func(w http.ResponseWriter, r *http.Request) { w.Write(make([]byte, 2048)) })
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.AssetMetadataMaxBytes = 1024
+	fetcher := makeAssetMetadataFetcher(cfg, nil)
+	verify := func(url string, hash [32]byte) model.AssetMetadataVerificationResponse {
+		return fetcher.verify(context.Background(), 7, basics.AssetParams{URL: url, MetadataHash: hash})
+	}
+
+	sha256Hash := sha256.Sum256(metadata)
+	response := verify(server.URL+"/metadata.json#arc3", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictMatch, response.Verdict)
+	require.Equal(t, uint64(7), response.AssetId)
+	require.Equal(t, "sha256", *response.HashAlgorithm)
+	require.Equal(t, sha256Hash[:], *response.ContentHash)
+	require.Equal(t, sha256Hash[:], *response.MetadataHash)
+	require.Equal(t, uint64(len(metadata)), *response.ContentLength)
+
+	sha512Hash := sha512.Sum512_256(metadata)
+	response = verify(server.URL+"/metadata.json", sha512Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictMatch, response.Verdict)
+	require.Equal(t, "sha512_256", *response.HashAlgorithm)
+
+	response = verify(server.URL+"/tampered.json", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictMismatch, response.Verdict)
+	tamperedHash := sha256.Sum256(tampered)
+	require.Equal(t, tamperedHash[:], *response.ContentHash)
+
+	response = verify(server.URL+"/large.json", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictUnreachable, response.Verdict)
+	require.Contains(t, *response.Error, "larger than 1024 bytes")
+	require.Nil(t, response.ContentLength)
+
+	response = verify(server.URL+"/missing.json", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictUnreachable, response.Verdict)
+	require.Contains(t, *response.Error, "404")
+
+	response = verify("ftp://example.com/metadata.json", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictUnsupportedUrl, response.Verdict)
+
+	response = verify("", sha256Hash)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictNoUrl, response.Verdict)
+
+	response = verify(server.URL+"/metadata.json", [32]byte{})
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictNoMetadataHash, response.Verdict)
+	require.Nil(t, response.MetadataHash)
+}
+
+func TestAssetMetadataRefusesPrivateAddresses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.AssetMetadataFetchTimeout = 10 * time.Second
+	fetcher := makeAssetMetadataFetcher(cfg, refusePrivateAddresses)
+	response := fetcher.verify(context.Background(), 7, basics.AssetParams{URL: server.URL, MetadataHash: sha256.Sum256([]byte("{}"))})
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictUnreachable, response.Verdict)
+	require.Contains(t, *response.Error, "non-public address")
+
+	for _, addr := range []string{"127.0.0.1:80", "10.1.2.3:443", "192.168.0.1:80", "169.254.169.254:80", "[::1]:80", "[fe80::1]:80", "0.0.0.0:80"} {
+		require.Error(t, refusePrivateAddresses("tcp", addr, nil), addr)
+	}
+	for _, addr := range []string{"8.8.8.8:443", "[2001:4860:4860::8888]:443"} {
+		require.NoError(t, refusePrivateAddresses("tcp", addr, nil), addr)
+	}
+}
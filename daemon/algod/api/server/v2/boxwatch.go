@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// boxEvent is the data of a box event streamed by WatchApplicationBoxes.
+type boxEvent struct {
+	Round  basics.Round  `codec:"round"`
+	Name   []byte        `codec:"name"`
+	Change string        `codec:"change"`
+	Size   *uint64       `codec:"size,omitempty"`
+	Digest crypto.Digest `codec:"digest,omitempty"`
+}
+
+func makeBoxEvent(rnd basics.Round, delta ledgercore.KvDelta) (boxEvent, error) {
+	_, name, err := apps.SplitBoxKey(delta.Key)
+	if err != nil {
+		return boxEvent{}, err
+	}
+	ev := boxEvent{Round: rnd, Name: []byte(name)}
+	switch {
+	case delta.Data == nil:
+		ev.Change = "deleted"
+		return ev, nil
+	case delta.Existed:
+		ev.Change = "modified"
+	default:
+		ev.Change = "created"
+	}
+	size := uint64(len(delta.Data))
+	ev.Size = &size
+	ev.Digest = crypto.Hash(delta.Data)
+	return ev, nil
+}
+
+// WatchApplicationBoxes streams the changes made to the boxes of an application as server-sent events.
+// (GET /v2/applications/{application-id}/boxes/watch)
+func (v2 *Handlers) WatchApplicationBoxes(ctx echo.Context, applicationID uint64, params model.WatchApplicationBoxesParams) error {
+	var prefix []byte
+	if params.Prefix != nil {
+		prefixBytes, err := apps.NewAppCallBytes(*params.Prefix)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		prefix, err = prefixBytes.Raw()
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("WatchApplicationBoxes failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	sub := v2.Node.LedgerForAPI().SubscribeKvDeltas(apps.MakeBoxKey(applicationID, string(prefix)))
+	defer sub.Close()
+
+	stream := blockSubscription{
+		response:   ctx.Response(),
+		controller: http.NewResponseController(ctx.Response().Writer),
+	}
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	ctx.Response().WriteHeader(http.StatusOK)
+	if err := stream.write(fmt.Sprintf(": watching from round %d\n\n", sub.Since())); err != nil {
+		return nil
+	}
+
+	keepAlive := time.NewTicker(BlockSubscriptionKeepAlive)
+	defer keepAlive.Stop()
+	closed := ctx.Request().Context().Done()
+	for {
+		select {
+		case <-v2.Shutdown:
+			return nil
+		case <-closed:
+			return nil
+		case <-keepAlive.C:
+			if err := stream.write(": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		case update, ok := <-sub.Updates():
+			if !ok {
+				if sub.Lagged() {
+					_ = stream.writeEvent("error", "", []byte("the watcher fell behind the box changes"))
+				}
+				return nil
+			}
+			for _, delta := range update.Deltas {
+				ev, err := makeBoxEvent(update.Round, delta)
+				if err != nil {
+					v2.Log.Warnf("WatchApplicationBoxes: unexpected key %q: %v", delta.Key, err)
+					continue
+				}
+				data, err := encode(protocol.JSONStrictHandle, ev)
+				if err != nil {
+					return nil
+				}
+				if err := stream.writeEvent("box", strconv.FormatUint(uint64(update.Round), 10), data); err != nil {
+					// the watcher went away.
+					return nil
+				}
+			}
+		}
+	}
+}
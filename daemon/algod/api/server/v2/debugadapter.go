@@ -0,0 +1,638 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/websocket"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// debugAdapterIdleTimeout is how long a debug adapter session waits for the next message of
+// the IDE before it is closed.
+const debugAdapterIdleTimeout = 30 * time.Minute
+
+var debugAdapterUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// dapRequest is a request of the Debug Adapter Protocol.
+type dapRequest struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// dapResponse is a response of the Debug Adapter Protocol.
+type dapResponse struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// dapEvent is an event of the Debug Adapter Protocol.
+type dapEvent struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+type dapSource struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type dapStackFrame struct {
+	ID                          int        `json:"id"`
+	Name                        string     `json:"name"`
+	Source                      *dapSource `json:"source,omitempty"`
+	Line                        uint64     `json:"line"`
+	Column                      uint64     `json:"column"`
+	InstructionPointerReference string     `json:"instructionPointerReference"`
+}
+
+type dapScope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+type dapVariable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type dapBreakpoint struct {
+	Verified bool   `json:"verified"`
+	Line     uint64 `json:"line"`
+}
+
+// debugStep is an opcode evaluated by the simulation, in the order of evaluation.
+type debugStep struct {
+	unit *simulation.OpcodeTraceUnit
+	// program is the index of the program evaluating the opcode in debugAdapterSession.programs.
+	program int
+}
+
+// debugProgram is an evaluation of a program by the simulation.
+type debugProgram struct {
+	name string
+	hash crypto.Digest
+	// steps are the indexes of the steps of the program.
+	steps []int
+	// caller is the index of the step spawning the inner transaction evaluating the program,
+	// or -1 for the programs of the top level transactions.
+	caller int
+	depth  int
+}
+
+// debugAdapterSession steps through the execution trace of a simulation on behalf of an IDE
+// speaking the Debug Adapter Protocol. Since the whole trace is known once the simulation is
+// done, stepping backward is as cheap as stepping forward.
+type debugAdapterSession struct {
+	ws       *websocket.Conn
+	simulate func(PreEncodedSimulateRequest) (simulation.Result, error)
+
+	seq             int
+	linesStartAt1   bool
+	columnsStartAt1 bool
+
+	launched       bool
+	stopOnEntry    bool
+	failureMessage string
+	programs       []debugProgram
+	steps          []debugStep
+	cursor         int
+	terminated     bool
+
+	// breakpoints holds the lines with a breakpoint, by source.
+	breakpoints map[string]map[uint64]bool
+}
+
+func (s *debugAdapterSession) run() error {
+	// launch requests hold a simulate request, which is bounded like a dryrun request
+	s.ws.SetReadLimit(MaxTealDryrunBytes)
+	for {
+		err := s.ws.SetReadDeadline(time.Now().Add(debugAdapterIdleTimeout))
+		if err != nil {
+			return err
+		}
+		messageType, data, err := s.ws.ReadMessage()
+		if err != nil {
+			return err
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+		var request dapRequest
+		err = json.Unmarshal(stripContentLength(data), &request)
+		if err != nil || request.Type != "request" {
+			continue
+		}
+		done, err := s.handle(request)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// stripContentLength removes the Content-Length header which precedes the messages of the
+// Debug Adapter Protocol on streams, for the IDEs which keep it on WebSockets.
+func stripContentLength(data []byte) []byte {
+	if !strings.HasPrefix(string(data), "Content-Length:") {
+		return data
+	}
+	i := strings.Index(string(data), "\r\n\r\n")
+	if i < 0 {
+		return data
+	}
+	return data[i+4:]
+}
+
+func (s *debugAdapterSession) send(message interface{}) error {
+	return s.ws.WriteJSON(message)
+}
+
+func (s *debugAdapterSession) nextSeq() int {
+	s.seq++
+	return s.seq
+}
+
+func (s *debugAdapterSession) respond(request dapRequest, body interface{}) error {
+	return s.send(dapResponse{Seq: s.nextSeq(), Type: "response", RequestSeq: request.Seq, Success: true, Command: request.Command, Body: body})
+}
+
+func (s *debugAdapterSession) fail(request dapRequest, err error) error {
+	return s.send(dapResponse{Seq: s.nextSeq(), Type: "response", RequestSeq: request.Seq, Success: false, Command: request.Command, Message: err.Error()})
+}
+
+func (s *debugAdapterSession) event(event string, body interface{}) error {
+	return s.send(dapEvent{Seq: s.nextSeq(), Type: "event", Event: event, Body: body})
+}
+
+// handle answers a request of the IDE, and returns whether the session is over.
+func (s *debugAdapterSession) handle(request dapRequest) (bool, error) {
+	var err error
+	switch request.Command {
+	case "initialize":
+		err = s.initialize(request)
+	case "launch":
+		err = s.launch(request)
+	case "setBreakpoints":
+		err = s.setBreakpoints(request)
+	case "configurationDone":
+		err = s.configurationDone(request)
+	case "threads":
+		err = s.respond(request, map[string]interface{}{
+			"threads": []map[string]interface{}{{"id": 1, "name": "simulation"}},
+		})
+	case "stackTrace":
+		err = s.stackTrace(request)
+	case "scopes":
+		err = s.scopes(request)
+	case "variables":
+		err = s.variables(request)
+	case "next", "stepIn", "stepOut", "continue", "stepBack", "reverseContinue":
+		err = s.step(request)
+	case "disconnect", "terminate":
+		err = s.respond(request, nil)
+		return true, err
+	default:
+		err = s.fail(request, fmt.Errorf("unsupported command %s", request.Command))
+	}
+	return false, err
+}
+
+func (s *debugAdapterSession) initialize(request dapRequest) error {
+	args := struct {
+		LinesStartAt1   *bool `json:"linesStartAt1"`
+		ColumnsStartAt1 *bool `json:"columnsStartAt1"`
+	}{}
+	if len(request.Arguments) > 0 {
+		err := json.Unmarshal(request.Arguments, &args)
+		if err != nil {
+			return s.fail(request, err)
+		}
+	}
+	// Lines and columns start at 1 unless the IDE says otherwise
+	s.linesStartAt1 = args.LinesStartAt1 == nil || *args.LinesStartAt1
+	s.columnsStartAt1 = args.ColumnsStartAt1 == nil || *args.ColumnsStartAt1
+
+	err := s.respond(request, map[string]interface{}{
+		"supportsConfigurationDoneRequest": true,
+		"supportsStepBack":                 true,
+	})
+	if err != nil {
+		return err
+	}
+	return s.event("initialized", nil)
+}
+
+func (s *debugAdapterSession) launch(request dapRequest) error {
+	if s.launched {
+		return s.fail(request, errors.New("the simulation was already launched"))
+	}
+	args := struct {
+		SimulateRequest json.RawMessage `json:"simulateRequest"`
+		StopOnEntry     bool            `json:"stopOnEntry"`
+	}{}
+	err := json.Unmarshal(request.Arguments, &args)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	if len(args.SimulateRequest) == 0 {
+		return s.fail(request, errors.New("launch requires a simulateRequest"))
+	}
+	var simulateRequest PreEncodedSimulateRequest
+	err = decode(protocol.JSONStrictHandle, args.SimulateRequest, &simulateRequest)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	simulateRequest.ExecTraceConfig = simulation.ExecTraceConfig{Enable: true, Stack: true, Scratch: true, State: true}
+
+	result, err := s.simulate(simulateRequest)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	for _, group := range result.TxnGroups {
+		s.addGroup(group)
+		if s.failureMessage == "" {
+			s.failureMessage = group.FailureMessage
+		}
+	}
+	s.launched = true
+	s.stopOnEntry = args.StopOnEntry
+	return s.respond(request, nil)
+}
+
+// addGroup adds the programs evaluated by a transaction group in the order of evaluation: the
+// logic sigs of the group are evaluated before its transactions.
+func (s *debugAdapterSession) addGroup(group simulation.TxnGroupResult) {
+	for i := range group.Txns {
+		trace := group.Txns[i].Trace
+		if trace != nil {
+			s.addProgram(fmt.Sprintf("txn %d logic sig", i), trace.LogicSigHash, trace.LogicSigTrace, nil, -1, 0)
+		}
+	}
+	for i := range group.Txns {
+		s.addTxn(fmt.Sprintf("txn %d", i), group.Txns[i].Trace, -1, 0)
+	}
+}
+
+func (s *debugAdapterSession) addTxn(name string, trace *simulation.TransactionTrace, caller int, depth int) {
+	if trace == nil {
+		return
+	}
+	s.addProgram(name+" approval program", trace.ApprovalProgramHash, trace.ApprovalProgramTrace, trace.InnerTraces, caller, depth)
+	s.addProgram(name+" clear state program", trace.ClearStateProgramHash, trace.ClearStateProgramTrace, trace.InnerTraces, caller, depth)
+}
+
+// addProgram adds the steps of a program, followed by the steps of the inner transactions
+// spawned by each of them.
+func (s *debugAdapterSession) addProgram(name string, hash crypto.Digest, units []simulation.OpcodeTraceUnit, inners []simulation.TransactionTrace, caller int, depth int) {
+	if len(units) == 0 {
+		return
+	}
+	program := len(s.programs)
+	s.programs = append(s.programs, debugProgram{name: name, hash: hash, caller: caller, depth: depth})
+	for i := range units {
+		step := len(s.steps)
+		s.steps = append(s.steps, debugStep{unit: &units[i], program: program})
+		s.programs[program].steps = append(s.programs[program].steps, step)
+		for _, inner := range units[i].SpawnedInners {
+			if inner < len(inners) {
+				s.addTxn(fmt.Sprintf("%s inner %d", name, inner), &inners[inner], step, depth+1)
+			}
+		}
+	}
+}
+
+func (s *debugAdapterSession) setBreakpoints(request dapRequest) error {
+	args := struct {
+		Source      dapSource `json:"source"`
+		Breakpoints []struct {
+			Line uint64 `json:"line"`
+		} `json:"breakpoints"`
+	}{}
+	err := json.Unmarshal(request.Arguments, &args)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	source := args.Source.Path
+	if source == "" {
+		source = args.Source.Name
+	}
+	if s.breakpoints == nil {
+		s.breakpoints = make(map[string]map[uint64]bool)
+	}
+	lines := make(map[uint64]bool, len(args.Breakpoints))
+	breakpoints := make([]dapBreakpoint, len(args.Breakpoints))
+	for i, breakpoint := range args.Breakpoints {
+		lines[breakpoint.Line] = true
+		breakpoints[i] = dapBreakpoint{Line: breakpoint.Line}
+	}
+	s.breakpoints[source] = lines
+	// Before the launch, the lines of the opcodes are not known yet
+	for i := range breakpoints {
+		breakpoints[i].Verified = !s.launched
+	}
+	for _, step := range s.steps {
+		line, ok := s.breakpointLine(step, source)
+		if !ok {
+			continue
+		}
+		for i := range breakpoints {
+			if breakpoints[i].Line == line {
+				breakpoints[i].Verified = true
+			}
+		}
+	}
+	return s.respond(request, map[string]interface{}{"breakpoints": breakpoints})
+}
+
+// breakpointLine returns the line of a step, as the IDE numbers it, if the step is located in
+// source. IDEs name sources by their path, while source maps usually name them relatively.
+func (s *debugAdapterSession) breakpointLine(step debugStep, source string) (uint64, bool) {
+	location := step.unit.SourceLocation
+	if location == nil {
+		return 0, false
+	}
+	if source != location.Source && !strings.HasSuffix(source, "/"+location.Source) {
+		return 0, false
+	}
+	return s.line(location.Line), true
+}
+
+func (s *debugAdapterSession) atBreakpoint(step int) bool {
+	for source, lines := range s.breakpoints {
+		line, ok := s.breakpointLine(s.steps[step], source)
+		if ok && lines[line] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *debugAdapterSession) line(line uint64) uint64 {
+	if s.linesStartAt1 {
+		return line + 1
+	}
+	return line
+}
+
+func (s *debugAdapterSession) column(column uint64) uint64 {
+	if s.columnsStartAt1 {
+		return column + 1
+	}
+	return column
+}
+
+func (s *debugAdapterSession) configurationDone(request dapRequest) error {
+	if !s.launched {
+		return s.fail(request, errors.New("the simulation was not launched"))
+	}
+	err := s.respond(request, nil)
+	if err != nil {
+		return err
+	}
+	if s.stopOnEntry && len(s.steps) > 0 {
+		s.cursor = 0
+		return s.stopped("entry")
+	}
+	return s.runTo(0, func(step int) bool { return s.atBreakpoint(step) }, "breakpoint")
+}
+
+// runTo moves the cursor to the first step from start satisfying stop, or terminates the
+// session if there is none.
+func (s *debugAdapterSession) runTo(start int, stop func(int) bool, reason string) error {
+	for step := start; step < len(s.steps); step++ {
+		if stop(step) {
+			s.cursor = step
+			if reason != "breakpoint" && s.atBreakpoint(step) {
+				reason = "breakpoint"
+			}
+			return s.stopped(reason)
+		}
+	}
+	return s.terminate()
+}
+
+func (s *debugAdapterSession) stopped(reason string) error {
+	return s.event("stopped", map[string]interface{}{"reason": reason, "threadId": 1, "allThreadsStopped": true})
+}
+
+func (s *debugAdapterSession) terminate() error {
+	s.terminated = true
+	if s.failureMessage != "" {
+		err := s.event("output", map[string]interface{}{"category": "stderr", "output": s.failureMessage + "\n"})
+		if err != nil {
+			return err
+		}
+	}
+	return s.event("terminated", nil)
+}
+
+func (s *debugAdapterSession) depth(step int) int {
+	return s.programs[s.steps[step].program].depth
+}
+
+func (s *debugAdapterSession) step(request dapRequest) error {
+	if !s.launched || s.terminated || len(s.steps) == 0 {
+		return s.fail(request, errors.New("the simulation is not stopped"))
+	}
+	err := s.respond(request, nil)
+	if err != nil {
+		return err
+	}
+	depth := s.depth(s.cursor)
+	switch request.Command {
+	case "next":
+		return s.runTo(s.cursor+1, func(step int) bool { return s.depth(step) <= depth || s.atBreakpoint(step) }, "step")
+	case "stepIn":
+		return s.runTo(s.cursor+1, func(step int) bool { return true }, "step")
+	case "stepOut":
+		return s.runTo(s.cursor+1, func(step int) bool { return s.depth(step) < depth || s.atBreakpoint(step) }, "step")
+	case "continue":
+		return s.runTo(s.cursor+1, s.atBreakpoint, "breakpoint")
+	case "stepBack":
+		if s.cursor > 0 {
+			s.cursor--
+		}
+		return s.stopped("step")
+	default: // reverseContinue
+		for s.cursor > 0 {
+			s.cursor--
+			if s.atBreakpoint(s.cursor) {
+				return s.stopped("breakpoint")
+			}
+		}
+		return s.stopped("entry")
+	}
+}
+
+// frames returns the steps of the stack frames at the cursor, innermost first.
+func (s *debugAdapterSession) frames() []int {
+	var frames []int
+	for step := s.cursor; step >= 0; step = s.programs[s.steps[step].program].caller {
+		frames = append(frames, step)
+	}
+	return frames
+}
+
+func (s *debugAdapterSession) stackTrace(request dapRequest) error {
+	if !s.launched || s.terminated || len(s.steps) == 0 {
+		return s.fail(request, errors.New("the simulation is not stopped"))
+	}
+	frames := s.frames()
+	stackFrames := make([]dapStackFrame, len(frames))
+	for i, step := range frames {
+		unit := s.steps[step].unit
+		program := s.programs[s.steps[step].program]
+		stackFrames[i] = dapStackFrame{
+			ID:                          step,
+			Name:                        fmt.Sprintf("%s (pc %d)", program.name, unit.PC),
+			InstructionPointerReference: strconv.FormatUint(unit.PC, 10),
+		}
+		if unit.SourceLocation != nil {
+			stackFrames[i].Source = &dapSource{Name: unit.SourceLocation.Source, Path: unit.SourceLocation.Source}
+			stackFrames[i].Line = s.line(unit.SourceLocation.Line)
+			stackFrames[i].Column = s.column(unit.SourceLocation.Column)
+		}
+	}
+	return s.respond(request, map[string]interface{}{"stackFrames": stackFrames, "totalFrames": len(stackFrames)})
+}
+
+// The variables of a frame are referenced by the step of the frame: its stack is referenced by
+// 2*step+1, and its scratch slots by 2*step+2.
+func (s *debugAdapterSession) scopes(request dapRequest) error {
+	args := struct {
+		FrameID int `json:"frameId"`
+	}{}
+	err := json.Unmarshal(request.Arguments, &args)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	if args.FrameID < 0 || args.FrameID >= len(s.steps) {
+		return s.fail(request, fmt.Errorf("unknown frame %d", args.FrameID))
+	}
+	return s.respond(request, map[string]interface{}{
+		"scopes": []dapScope{
+			{Name: "Stack", VariablesReference: 2*args.FrameID + 1},
+			{Name: "Scratch", VariablesReference: 2*args.FrameID + 2},
+		},
+	})
+}
+
+func (s *debugAdapterSession) variables(request dapRequest) error {
+	args := struct {
+		VariablesReference int `json:"variablesReference"`
+	}{}
+	err := json.Unmarshal(request.Arguments, &args)
+	if err != nil {
+		return s.fail(request, err)
+	}
+	step := (args.VariablesReference - 1) / 2
+	if args.VariablesReference <= 0 || step >= len(s.steps) {
+		return s.fail(request, fmt.Errorf("unknown variables reference %d", args.VariablesReference))
+	}
+	stack, scratch := s.state(step)
+	var variables []dapVariable
+	if args.VariablesReference%2 == 1 {
+		variables = make([]dapVariable, len(stack))
+		for i, value := range stack {
+			variables[i] = tealValueVariable(strconv.Itoa(i), value)
+		}
+	} else {
+		slots := make([]uint64, 0, len(scratch))
+		for slot := range scratch {
+			slots = append(slots, slot)
+		}
+		sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+		variables = make([]dapVariable, len(slots))
+		for i, slot := range slots {
+			variables[i] = tealValueVariable(fmt.Sprintf("slot %d", slot), scratch[slot])
+		}
+	}
+	return s.respond(request, map[string]interface{}{"variables": variables})
+}
+
+// state replays the changes made by the steps of a program preceding a step, returning the
+// stack and the written scratch slots the step is evaluated with.
+func (s *debugAdapterSession) state(step int) ([]basics.TealValue, map[uint64]basics.TealValue) {
+	var stack []basics.TealValue
+	scratch := make(map[uint64]basics.TealValue)
+	for _, previous := range s.programs[s.steps[step].program].steps {
+		if previous == step {
+			break
+		}
+		unit := s.steps[previous].unit
+		pop := int(unit.StackPopCount)
+		if pop > len(stack) {
+			pop = len(stack)
+		}
+		stack = append(stack[:len(stack)-pop], unit.StackAdded...)
+		for _, change := range unit.ScratchSlotChanges {
+			scratch[change.Slot] = change.NewValue
+		}
+	}
+	return stack, scratch
+}
+
+func tealValueVariable(name string, value basics.TealValue) dapVariable {
+	if value.Type == basics.TealBytesType {
+		return dapVariable{Name: name, Value: "0x" + hex.EncodeToString([]byte(value.Bytes)), Type: "bytes"}
+	}
+	return dapVariable{Name: name, Value: strconv.FormatUint(value.Uint, 10), Type: "uint64"}
+}
+
+// debugAdapterSimulate returns the function simulating the request launched by a debug adapter
+// session, scoping its simulation session to the client.
+func (v2 *Handlers) debugAdapterSimulate(proto config.ConsensusParams, client string) func(PreEncodedSimulateRequest) (simulation.Result, error) {
+	return func(simulateRequest PreEncodedSimulateRequest) (simulation.Result, error) {
+		err := checkSimulateRequest(simulateRequest, proto)
+		if err != nil {
+			return simulation.Result{}, err
+		}
+		request := convertSimulationRequest(simulateRequest)
+		if request.Session != "" {
+			request.Session = client + "/" + request.Session
+		}
+		return v2.Node.Simulate(request)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/algorand/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// debugAdapterClient drives a debug adapter session as an IDE would.
+type debugAdapterClient struct {
+	t   *testing.T
+	ws  *websocket.Conn
+	seq int
+}
+
+// request sends a request and returns its response, along with the events sent after it.
+func (c *debugAdapterClient) request(command string, arguments interface{}, events int) (map[string]interface{}, []map[string]interface{}) {
+	c.seq++
+	err := c.ws.WriteJSON(map[string]interface{}{"seq": c.seq, "type": "request", "command": command, "arguments": arguments})
+	require.NoError(c.t, err)
+	var response map[string]interface{}
+	require.NoError(c.t, c.ws.ReadJSON(&response))
+	require.Equal(c.t, "response", response["type"])
+	require.Equal(c.t, command, response["command"])
+	received := make([]map[string]interface{}, events)
+	for i := range received {
+		require.NoError(c.t, c.ws.ReadJSON(&received[i]))
+		require.Equal(c.t, "event", received[i]["type"])
+	}
+	return response, received
+}
+
+// step sends a stepping request, and returns the reason of the stop and the name and line of
+// the innermost stack frame.
+func (c *debugAdapterClient) step(command string) (string, string, float64) {
+	_, events := c.request(command, map[string]interface{}{"threadId": 1}, 1)
+	require.Equal(c.t, "stopped", events[0]["event"])
+	name, line := c.top()
+	return events[0]["body"].(map[string]interface{})["reason"].(string), name, line
+}
+
+func (c *debugAdapterClient) top() (string, float64) {
+	response, _ := c.request("stackTrace", map[string]interface{}{"threadId": 1}, 0)
+	require.True(c.t, response["success"].(bool))
+	frames := response["body"].(map[string]interface{})["stackFrames"].([]interface{})
+	frame := frames[0].(map[string]interface{})
+	return frame["name"].(string), frame["line"].(float64)
+}
+
+func (c *debugAdapterClient) variables(reference int) []interface{} {
+	response, _ := c.request("variables", map[string]interface{}{"variablesReference": reference}, 0)
+	require.True(c.t, response["success"].(bool))
+	return response["body"].(map[string]interface{})["variables"].([]interface{})
+}
+
+func TestDebugAdapterSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	at := func(line uint64) *simulation.SourceLocation {
+		return &simulation.SourceLocation{Source: "app.teal", Line: line}
+	}
+	uintValue := func(v uint64) basics.TealValue { return basics.TealValue{Type: basics.TealUintType, Uint: v} }
+	result := simulation.Result{TxnGroups: []simulation.TxnGroupResult{{
+		Txns: []simulation.TxnResult{{Trace: &simulation.TransactionTrace{
+			ApprovalProgramTrace: []simulation.OpcodeTraceUnit{
+				{PC: 1, SourceLocation: at(0), StackAdded: []basics.TealValue{uintValue(1)}},
+				{PC: 3, SourceLocation: at(1), SpawnedInners: []int{0}},
+				{PC: 4, SourceLocation: at(2), ScratchSlotChanges: []simulation.ScratchChange{{Slot: 5, NewValue: uintValue(1)}}, StackPopCount: 1},
+				{PC: 6, SourceLocation: at(3)},
+			},
+			InnerTraces: []simulation.TransactionTrace{{
+				ApprovalProgramTrace: []simulation.OpcodeTraceUnit{{PC: 1}, {PC: 2}},
+			}},
+		}}},
+		FailureMessage: "logic eval error",
+	}}}
+
+	var launched PreEncodedSimulateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := debugAdapterUpgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer ws.Close()
+		session := debugAdapterSession{ws: ws, simulate: func(request PreEncodedSimulateRequest) (simulation.Result, error) {
+			launched = request
+			return result, nil
+		}}
+		session.run()
+	}))
+	defer server.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer ws.Close()
+	client := debugAdapterClient{t: t, ws: ws}
+
+	response, events := client.request("initialize", map[string]interface{}{"adapterID": "avm"}, 1)
+	require.True(t, response["success"].(bool))
+	require.Equal(t, true, response["body"].(map[string]interface{})["supportsStepBack"])
+	require.Equal(t, "initialized", events[0]["event"])
+
+	response, _ = client.request("stackTrace", map[string]interface{}{"threadId": 1}, 0)
+	require.False(t, response["success"].(bool))
+
+	simulateRequest := json.RawMessage(`{"txn-groups":[{"txns":[]}]}`)
+	response, _ = client.request("launch", map[string]interface{}{"simulateRequest": simulateRequest, "stopOnEntry": true}, 0)
+	require.True(t, response["success"].(bool))
+	require.True(t, launched.ExecTraceConfig.Enable)
+	require.True(t, launched.ExecTraceConfig.Scratch)
+
+	response, _ = client.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]interface{}{"path": "/home/dev/contract/app.teal"},
+		"breakpoints": []map[string]interface{}{{"line": 3}, {"line": 10}},
+	}, 0)
+	breakpoints := response["body"].(map[string]interface{})["breakpoints"].([]interface{})
+	require.Equal(t, true, breakpoints[0].(map[string]interface{})["verified"])
+	require.Equal(t, false, breakpoints[1].(map[string]interface{})["verified"])
+
+	_, events = client.request("configurationDone", nil, 1)
+	require.Equal(t, "entry", events[0]["body"].(map[string]interface{})["reason"])
+	name, line := client.top()
+	require.Equal(t, "txn 0 approval program (pc 1)", name)
+	require.EqualValues(t, 1, line)
+
+	// stepping over the opcode spawning the inner transaction skips its program
+	reason, name, line := client.step("next")
+	require.Equal(t, "step", reason)
+	require.EqualValues(t, 2, line)
+	reason, name, line = client.step("next")
+	require.Equal(t, "breakpoint", reason)
+	require.EqualValues(t, 3, line)
+
+	// the state is the one the opcode is evaluated with
+	stack := client.variables(2*4 + 1)
+	require.Len(t, stack, 1)
+	require.Equal(t, "1", stack[0].(map[string]interface{})["value"])
+	require.Empty(t, client.variables(2*4+2))
+
+	// stepping back into the inner transaction, which is located in its caller
+	reason, name, _ = client.step("stepBack")
+	require.Equal(t, "step", reason)
+	require.Equal(t, "txn 0 approval program inner 0 approval program (pc 2)", name)
+	response, _ = client.request("stackTrace", map[string]interface{}{"threadId": 1}, 0)
+	require.EqualValues(t, 2, response["body"].(map[string]interface{})["totalFrames"])
+	reason, name, line = client.step("stepOut")
+	require.Equal(t, "breakpoint", reason)
+	require.EqualValues(t, 3, line)
+
+	reason, _, line = client.step("stepIn")
+	require.Equal(t, "step", reason)
+	require.EqualValues(t, 4, line)
+	scratch := client.variables(2*5 + 2)
+	require.Len(t, scratch, 1)
+	require.Equal(t, "slot 5", scratch[0].(map[string]interface{})["name"])
+	require.Empty(t, client.variables(2*5+1))
+
+	reason, _, line = client.step("reverseContinue")
+	require.Equal(t, "breakpoint", reason)
+	require.EqualValues(t, 3, line)
+
+	_, events = client.request("continue", map[string]interface{}{"threadId": 1}, 2)
+	require.Equal(t, "output", events[0]["event"])
+	require.Equal(t, "terminated", events[1]["event"])
+
+	response, _ = client.request("evaluate", map[string]interface{}{"expression": "x"}, 0)
+	require.False(t, response["success"].(bool))
+	response, _ = client.request("disconnect", nil, 0)
+	require.True(t, response["success"].(bool))
+}
+
+func TestStripContentLength(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	message := `{"seq":1,"type":"request","command":"threads"}`
+	require.Equal(t, message, string(stripContentLength([]byte(message))))
+	require.Equal(t, message, string(stripContentLength([]byte("Content-Length: 46\r\n\r\n"+message))))
+}
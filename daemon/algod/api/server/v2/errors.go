@@ -16,6 +16,17 @@
 
 package v2
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+)
+
 var (
 	errAppDoesNotExist                         = "application does not exist"
 	errAssetDoesNotExist                       = "asset does not exist"
@@ -30,7 +41,32 @@ var (
 	errFailedRetrievingTimeStampOffset         = "failed retrieving timestamp offset from node: %v"
 	errFailedSettingTimeStampOffset            = "failed to set timestamp offset on the node: %v"
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
+	errFailedRetrievingParticipationMetrics    = "failed retrieving participation metrics: %v"
+	errFailedRetrievingAgreementStatus         = "failed retrieving agreement status: %v"
+	errFailedAnalyzingGroupResources           = "failed analyzing the resources of the group: %v"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
+	errFailedAcknowledgingSyncRound            = "failed to acknowledge the sync round"
+	errSimulateSessionNotFound                 = "simulation session not found"
+	errSimulateBatchSession                    = "simulation sessions are not supported when simulating a batch"
+	errSyncPolicyDisabled                      = "the follower sync policy is not enabled"
+	errFailedResettingPersistedMetrics         = "failed to reset persisted metrics: %v"
+	errFailedRotatingAPIToken                  = "failed to rotate the API token: %v"
+	errAPIAuthDisabled                         = "API authentication is disabled"
+	errAPITokenExists                          = "API token already exists"
+	errAPITokenNotFound                        = "API token not found"
+	errInvalidAPITokenName                     = "the name of an API token must be made of at most 64 letters, digits, dashes and underscores"
+	errMissingAPITokenScopes                   = "an API token must be granted at least one scope"
+	errFailedToParseCIDR                       = "failed to parse the network %s, it must be in CIDR notation"
+	errFailedCreatingAPIToken                  = "failed to create the API token: %v"
+	errFailedDeletingAPIToken                  = "failed to delete the API token: %v"
+	errWebhooksDisabled                        = "/v2/webhooks was not enabled in the configuration file by setting the EnableWebhooks to true"
+	errPeerDuplicateLatencyDisabled            = "/v2/peers/duplicate-latency was not enabled in the configuration file by setting the EnablePeerDuplicateLatency to true"
+	errInvalidWebhook                          = "invalid webhook: %v"
+	errTooManyWebhooks                         = "too many webhooks are registered"
+	errWebhookNotFound                         = "webhook not found"
+	errWebhookConfigured                       = "the webhooks set in the configuration file cannot be deleted"
+	errFailedRegisteringWebhook                = "failed to register the webhook: %v"
+	errFailedDeletingWebhook                   = "failed to delete the webhook: %v"
 	errFailedParsingFormatOption               = "failed to parse the format option"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
@@ -41,11 +77,260 @@ var (
 	errTransactionNotFound                     = "could not find the transaction in the transaction pool or in the last 1000 confirmed rounds"
 	errServiceShuttingDown                     = "operation aborted as server is shutting down"
 	errRequestedRoundInUnsupportedRound        = "requested round would reach only after the protocol upgrade which isn't supported"
+	errTransactionGenesisMismatch              = "transaction genesis does not match the network of this node"
+	errSubmissionWarnings                      = "transaction group was rejected in strict mode because of submission warnings"
+	errMemoryBallastOverTarget                 = "memory ballast must be smaller than the memory target"
+	errUnknownTelemetryCategory                = "unknown telemetry category %s"
+	errFailedRedirectingLog                    = "failed to redirect the log: %v"
+	errFlightRecorderDisabled                  = "the flight recorder is not enabled"
+	errKeyRenewalLeadOverValidity              = "the validity of renewed participation keys must be larger than the renewal lead"
+	errInvalidLeaseCount                       = "the number of suggested leases must be between 1 and 16"
+	errNoPendingBlock                          = "the transaction pool has not assembled a block yet"
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
+	errCatchpointNotRetained                   = "no catchpoint is retained for the given round"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
+	errCatchupNotInProgress                    = "the node is not catching up to this catchpoint"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
+	errNonCanonicalEncoding                    = "object is not canonically encoded"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errInvalidProposerReportRange              = "min-round must be positive and not greater than max-round"
+	errProposerReportRangeTooLarge             = "the round range cannot span more than %d rounds"
+	errProposerReportStakeNotRetained          = "the online stake at the balance round of max-round is not retained by the node"
+	errInvalidBlockRange                       = "last must not be lower than first"
+	errBlockRangeTooLarge                      = "a block range cannot span more than %d rounds"
+	errLimitTooLarge                           = "limit cannot be greater than %d"
+	errFeeEstimateUnavailable                  = "fee estimates are not available"
+	errIdempotencyKeyTooLong                   = "idempotency key cannot be longer than %d bytes"
+	errIdempotencyKeyReused                    = "idempotency key was already used with different transactions"
+	errInvalidAccountDiffRange                 = "from must not be greater than to"
+	errRoundNotInFuture                        = "round must be after the latest round"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errProgramNotTemplate                      = "program does not match a known template"
+	errResultLimitExceeded                     = "Result limit exceeded"
+	errEndpointNotImplemented                  = "Endpoint not implemented."
+	errAsyncTransactionsNotEnabled             = "/transactions/async was not enabled in the configuration file by setting the EnableExperimentalAPI to true"
+	errDryrunNotEnabled                        = "/teal/dryrun was not enabled in the configuration file by setting the EnableDeveloperAPI to true"
+	errCompileNotEnabled                       = "/teal/compile was not enabled in the configuration file by setting the EnableDeveloperAPI to true"
+	errDisassembleNotEnabled                   = "/teal/disassemble was not enabled in the configuration file by setting the EnableDeveloperAPI to true"
+	errDebugAdapterNotEnabled                  = "/transactions/simulate/debug was not enabled in the configuration file by setting the EnableDeveloperAPI to true"
+	errMetricsPersistenceNotEnabled            = "/metrics/reset was not enabled in the configuration file by setting the EnableMetricsPersistence to true"
+	errAssetComplianceIndexNotEnabled          = "/compliance-events was not enabled in the configuration file by setting the EnableAssetComplianceIndex to true"
+	errAccountTxnIndexNotEnabled               = "/accounts/{address}/transactions was not enabled in the configuration file by setting the EnableAccountTxnIndex to true"
+	errTooManyOptInTargets                     = "cannot opt in to or out of more than %d assets and applications at once"
+	errRecentTxnIndexNotEnabled                = "/transactions/recent was not enabled in the configuration file by setting the EnableRecentTxnIndex to true"
+	errAppEventIndexNotEnabled                 = "/applications/{application-id}/events was not enabled in the configuration file by setting the EnableAppEventIndex to true"
+	errFailedToParseEventTopic                 = "failed to parse the topic, it must be an event signature or a hex encoded 4 byte selector"
+	errFailedToParseLease                      = "failed to parse the lease, it must be 32 base64 encoded bytes"
+	errFailedToParseNotePrefix                 = "failed to parse the note prefix, it must be at most %d base64 encoded bytes"
+	errRecentTxnQueryEmpty                     = "a lease or a note prefix must be given"
+	errLeaseWithoutSender                      = "looking up a lease requires its sender"
+	errFailedToParseBoxPrefix                  = "failed to parse the box name prefix, it must be base64 encoded"
+	errFailedToParseNextToken                  = "failed to parse the next token"
+	errRoundPruned                             = "round %d is behind the retention horizon of the node, the earliest round kept is %d"
+	errAssetMetadataVerificationNotEnabled     = "/metadata/verify was not enabled in the configuration file by setting the EnableAssetMetadataVerification to true"
+	errUnknownSubsystem                        = "unknown subsystem %s"
+	errTxnSubmitStopped                        = "transaction submission was stopped by the operator of the node"
+	errAccountRoundNotRetained                 = "the account state at round %d is not retained by the node"
+	errCatchpointsNotEnabled                   = "catchpoints are not enabled on this node, CatchpointInterval must be set and CatchpointTracking not be -1"
+	errFailedRequestingCatchpoint              = "failed requesting the catchpoint file"
+	errFailedCreatingSnapshot                  = "failed starting the snapshot of the node"
+	errSnapshotInProgress                      = "a snapshot is already being written"
+	errNoSnapshot                              = "no snapshot was requested"
 )
+
+// errorCodes is the registry of the stable, machine-readable codes reported with
+// v2 API errors, keyed by external error message. A message which is a format
+// string matches every message starting with its constant prefix. Messages which
+// are not registered get a generic code derived from the HTTP status.
+var errorCodes = map[string]string{
+	errAppDoesNotExist:                         "application-not-found",
+	errAssetDoesNotExist:                       "asset-not-found",
+	errAccountAppDoesNotExist:                  "account-application-not-found",
+	errAccountAssetDoesNotExist:                "account-asset-not-found",
+	errBoxDoesNotExist:                         "box-not-found",
+	errFailedLookingUpLedger:                   "ledger-lookup-failed",
+	errFailedLookingUpTransactionPool:          "transaction-pool-lookup-failed",
+	errFailedRetrievingStateDelta:              "state-delta-unavailable",
+	errFailedRetrievingNodeStatus:              "node-status-unavailable",
+	errFailedRetrievingLatestBlockHeaderStatus: "latest-block-header-unavailable",
+	errFailedRetrievingTimeStampOffset:         "timestamp-offset-unavailable",
+	errFailedSettingTimeStampOffset:            "timestamp-offset-rejected",
+	errFailedRetrievingSyncRound:               "sync-round-unavailable",
+	errFailedRetrievingParticipationMetrics:    "participation-metrics-unavailable",
+	errFailedRetrievingAgreementStatus:         "agreement-status-unavailable",
+	errFailedAnalyzingGroupResources:           "group-resources-failed",
+	errFailedSettingSyncRound:                  "sync-round-rejected",
+	errFailedAcknowledgingSyncRound:            "sync-round-ack-rejected",
+	errSimulateSessionNotFound:                 "simulate-session-not-found",
+	errSimulateBatchSession:                    "simulate-batch-session",
+	errSyncPolicyDisabled:                      "sync-policy-disabled",
+	errFailedResettingPersistedMetrics:         "metrics-reset-failed",
+	errFailedRotatingAPIToken:                  "api-token-rotation-failed",
+	errAPIAuthDisabled:                         "api-auth-disabled",
+	errAPITokenExists:                          "api-token-exists",
+	errAPITokenNotFound:                        "api-token-not-found",
+	errInvalidAPITokenName:                     "invalid-api-token-name",
+	errMissingAPITokenScopes:                   "missing-api-token-scopes",
+	errFailedToParseCIDR:                       "invalid-cidr",
+	errFailedCreatingAPIToken:                  "api-token-creation-failed",
+	errFailedDeletingAPIToken:                  "api-token-deletion-failed",
+	errWebhooksDisabled:                        "webhooks-disabled",
+	errPeerDuplicateLatencyDisabled:            "peer-duplicate-latency-disabled",
+	errInvalidWebhook:                          "invalid-webhook",
+	errTooManyWebhooks:                         "too-many-webhooks",
+	errWebhookNotFound:                         "webhook-not-found",
+	errWebhookConfigured:                       "webhook-configured",
+	errFailedRegisteringWebhook:                "webhook-registration-failed",
+	errFailedDeletingWebhook:                   "webhook-deletion-failed",
+	errFailedParsingFormatOption:               "invalid-format",
+	errFailedToParseAddress:                    "invalid-address",
+	errFailedToParseExclude:                    "invalid-exclude",
+	errFailedToEncodeResponse:                  "response-encoding-failed",
+	errInternalFailure:                         "internal-failure",
+	errNoValidTxnSpecified:                     "invalid-transaction-id",
+	errInvalidHashType:                         "invalid-hash-type",
+	errTransactionNotFound:                     "transaction-not-found",
+	errServiceShuttingDown:                     "shutting-down",
+	errRequestedRoundInUnsupportedRound:        "unsupported-protocol-round",
+	errTransactionGenesisMismatch:              "genesis-mismatch",
+	errSubmissionWarnings:                      "submission-warnings",
+	errMemoryBallastOverTarget:                 "memory-ballast-over-target",
+	errUnknownTelemetryCategory:                "unknown-telemetry-category",
+	errFailedRedirectingLog:                    "log-redirection-failed",
+	errFlightRecorderDisabled:                  "flight-recorder-disabled",
+	errKeyRenewalLeadOverValidity:              "renewal-lead-over-validity",
+	errInvalidLeaseCount:                       "invalid-lease-count",
+	errNoPendingBlock:                          "pending-block-unavailable",
+	errFailedToParseCatchpoint:                 "invalid-catchpoint",
+	errCatchpointNotRetained:                   "catchpoint-not-found",
+	errFailedToAbortCatchup:                    "catchup-abort-failed",
+	errFailedToStartCatchup:                    "catchup-start-failed",
+	errCatchupNotInProgress:                    "catchup-not-in-progress",
+	errOperationNotAvailableDuringCatchup:      "unavailable-during-catchup",
+	errRESTPayloadZeroLength:                   "empty-payload",
+	errNonCanonicalEncoding:                    "non-canonical-encoding",
+	errRoundGreaterThanTheLatest:               "round-not-available",
+	errInvalidProposerReportRange:              "invalid-round-range",
+	errProposerReportRangeTooLarge:             "round-range-too-large",
+	errProposerReportStakeNotRetained:          "round-not-retained",
+	errInvalidBlockRange:                       "invalid-round-range",
+	errBlockRangeTooLarge:                      "round-range-too-large",
+	errLimitTooLarge:                           "limit-too-large",
+	errFeeEstimateUnavailable:                  "fee-estimate-unavailable",
+	errIdempotencyKeyTooLong:                   "idempotency-key-too-long",
+	errIdempotencyKeyReused:                    "idempotency-key-reused",
+	errInvalidAccountDiffRange:                 "invalid-round-range",
+	errRoundNotInFuture:                        "round-not-in-future",
+	errFailedRetrievingTracer:                  "tracer-unavailable",
+	errProgramNotTemplate:                      "template-not-recognized",
+	errResultLimitExceeded:                     "result-limit-exceeded",
+	errEndpointNotImplemented:                  "not-implemented",
+	errAsyncTransactionsNotEnabled:             "experimental-api-disabled",
+	errDryrunNotEnabled:                        "developer-api-disabled",
+	errCompileNotEnabled:                       "developer-api-disabled",
+	errDisassembleNotEnabled:                   "developer-api-disabled",
+	errDebugAdapterNotEnabled:                  "developer-api-disabled",
+	errMetricsPersistenceNotEnabled:            "metrics-persistence-disabled",
+	errAssetComplianceIndexNotEnabled:          "asset-compliance-index-disabled",
+	errAccountTxnIndexNotEnabled:               "account-txn-index-disabled",
+	errTooManyOptInTargets:                     "too-many-opt-in-targets",
+	errRecentTxnIndexNotEnabled:                "recent-txn-index-disabled",
+	errAppEventIndexNotEnabled:                 "app-event-index-disabled",
+	errFailedToParseEventTopic:                 "invalid-topic",
+	errFailedToParseLease:                      "invalid-lease",
+	errFailedToParseNotePrefix:                 "invalid-note-prefix",
+	errRecentTxnQueryEmpty:                     "empty-recent-txn-query",
+	errLeaseWithoutSender:                      "lease-without-sender",
+	errFailedToParseBoxPrefix:                  "invalid-box-prefix",
+	errFailedToParseNextToken:                  "invalid-next-token",
+	errRoundPruned:                             "round-pruned",
+	errAssetMetadataVerificationNotEnabled:     "asset-metadata-verification-disabled",
+	errUnknownSubsystem:                        "unknown-subsystem",
+	errTxnSubmitStopped:                        "txn-submit-stopped",
+	errAccountRoundNotRetained:                 "account-round-not-retained",
+	errCatchpointsNotEnabled:                   "catchpoints-disabled",
+	errFailedRequestingCatchpoint:              "catchpoint-request-failed",
+	errFailedCreatingSnapshot:                  "snapshot-failed",
+	errSnapshotInProgress:                      "snapshot-in-progress",
+	errNoSnapshot:                              "snapshot-not-found",
+	middlewares.InvalidTokenMessage:            "invalid-api-token",
+	middlewares.ForbiddenAddressMessage:        "api-token-address-forbidden",
+	middlewares.MissingScopeMessage:            "api-token-scope-missing",
+	middlewares.RequestTooLargeMessage:         "request-too-large",
+}
+
+// errorTypePrefix turns an error code into the URI identifying its problem type.
+const errorTypePrefix = "urn:algod:error:"
+
+// problemJSONContentType is the media type of RFC 7807 problem details.
+const problemJSONContentType = "application/problem+json"
+
+// errorCode looks up the code of an external error message in errorCodes.
+func errorCode(status int, message string) string {
+	if code, ok := errorCodes[message]; ok {
+		return code
+	}
+	code, matched := "", 0
+	for format, formatCode := range errorCodes {
+		prefix, _, isFormat := strings.Cut(format, "%")
+		if isFormat && len(prefix) > matched && strings.HasPrefix(message, prefix) {
+			code, matched = formatCode, len(prefix)
+		}
+	}
+	if code != "" {
+		return code
+	}
+	return strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "-"))
+}
+
+// makeErrorResponse builds the problem details of an error. The message is
+// reported as both message and detail, so that existing clients keep working.
+func makeErrorResponse(status int, message string, data *map[string]interface{}) model.ErrorResponse {
+	code := errorCode(status, message)
+	errorType := errorTypePrefix + code
+	title := http.StatusText(status)
+	statusCode := uint64(status)
+	return model.ErrorResponse{
+		Code:    &code,
+		Data:    data,
+		Detail:  &message,
+		Message: message,
+		Status:  &statusCode,
+		Title:   &title,
+		Type:    &errorType,
+	}
+}
+
+// writeErrorResponse writes an error as an application/problem+json response.
+func writeErrorResponse(ctx echo.Context, status int, message string, data *map[string]interface{}) error {
+	ctx.Response().Header().Set(echo.HeaderContentType, problemJSONContentType)
+	return ctx.JSON(status, makeErrorResponse(status, message, data))
+}
+
+// HTTPErrorHandler is an echo.HTTPErrorHandler writing the errors returned by
+// middleware and parameter binding as problem details, like the v2 handlers do.
+func HTTPErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	httpErr, ok := err.(*echo.HTTPError)
+	if !ok {
+		httpErr = echo.ErrInternalServerError
+	}
+	message, ok := httpErr.Message.(string)
+	if !ok {
+		message = fmt.Sprint(httpErr.Message)
+	}
+
+	if ctx.Request().Method == http.MethodHead {
+		err = ctx.NoContent(httpErr.Code)
+	} else {
+		err = writeErrorResponse(ctx, httpErr.Code, message, nil)
+	}
+	if err != nil {
+		ctx.Logger().Error(err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestErrorCode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Every registered code is usable in a URI and found by its message.
+	for message, code := range errorCodes {
+		require.Regexp(t, "^[a-z]+(-[a-z]+)*$", code)
+		require.Equal(t, code, errorCode(http.StatusBadRequest, message))
+	}
+
+	// Format strings match the messages formatted from them.
+	require.Equal(t, "catchup-start-failed", errorCode(http.StatusInternalServerError, fmt.Sprintf(errFailedToStartCatchup, "boom")))
+	require.Equal(t, "state-delta-unavailable", errorCode(http.StatusNotFound, fmt.Sprintf(errFailedRetrievingStateDelta, "boom")))
+
+	// Unregistered messages get a code derived from the status.
+	require.Equal(t, "bad-request", errorCode(http.StatusBadRequest, "something unexpected"))
+	require.Equal(t, "request-timeout", errorCode(http.StatusRequestTimeout, "something unexpected"))
+	require.Equal(t, "internal-server-error", errorCode(http.StatusInternalServerError, "something unexpected"))
+}
+
+func TestWriteErrorResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	data := map[string]interface{}{"max": 1}
+	require.NoError(t, writeErrorResponse(ctx, http.StatusBadRequest, errResultLimitExceeded, &data))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, problemJSONContentType, rec.Header().Get(echo.HeaderContentType))
+	var response model.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, errResultLimitExceeded, response.Message)
+	require.Equal(t, errResultLimitExceeded, *response.Detail)
+	require.Equal(t, "result-limit-exceeded", *response.Code)
+	require.Equal(t, "urn:algod:error:result-limit-exceeded", *response.Type)
+	require.Equal(t, "Bad Request", *response.Title)
+	require.Equal(t, uint64(http.StatusBadRequest), *response.Status)
+	require.Equal(t, float64(1), (*response.Data)["max"])
+}
+
+func TestMissingLedgerEntry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	for _, test := range []struct {
+		err     error
+		code    string
+		message string
+	}{
+		{ledgercore.ErrNoEntry{Round: 5, Latest: 100, Committed: 100, Earliest: 10}, "round-pruned", fmt.Sprintf(errRoundPruned, 5, 10)},
+		{ledgercore.ErrNoEntry{Round: 105, Latest: 100, Committed: 100, Earliest: 10}, "ledger-lookup-failed", errFailedLookingUpLedger},
+	} {
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, missingLedgerEntry(ctx, test.err, logging.TestingLog(t)))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		var response model.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Equal(t, test.message, response.Message)
+		require.Equal(t, test.code, *response.Code)
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.GET("/unauthorized", func(echo.Context) error {
+		return echo.NewHTTPError(http.StatusUnauthorized, middlewares.InvalidTokenMessage)
+	})
+	e.GET("/failure", func(echo.Context) error {
+		return fmt.Errorf("unexpected failure")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unauthorized", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, problemJSONContentType, rec.Header().Get(echo.HeaderContentType))
+	var response model.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, middlewares.InvalidTokenMessage, response.Message)
+	require.Equal(t, "invalid-api-token", *response.Code)
+
+	// Errors which are not echo.HTTPErrors do not leak their message.
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/failure", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	response = model.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, http.StatusText(http.StatusInternalServerError), response.Message)
+	require.Equal(t, "internal-server-error", *response.Code)
+
+	// Unknown routes are reported as problems too.
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	response = model.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, "not-found", *response.Code)
+}
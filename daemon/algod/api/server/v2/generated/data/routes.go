@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Returns the minimum sync round the ledger is keeping in cache.
 	// (GET /v2/ledger/sync)
 	GetSyncRound(ctx echo.Context) error
+	// Given a round, tells the follower sync policy the consumer is done with it.
+	// (POST /v2/ledger/sync/ack/{round})
+	AcknowledgeSyncRound(ctx echo.Context, round uint64) error
 	// Given a round, tells the ledger to keep that round in its cache.
 	// (POST /v2/ledger/sync/{round})
 	SetSyncRound(ctx echo.Context, round uint64) error
@@ -59,6 +62,24 @@ func (w *ServerInterfaceWrapper) GetSyncRound(ctx echo.Context) error {
 	return err
 }
 
+// AcknowledgeSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) AcknowledgeSyncRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AcknowledgeSyncRound(ctx, round)
+	return err
+}
+
 // SetSyncRound converts echo context to params.
 func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
 	var err error
@@ -107,6 +128,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.DELETE(baseURL+"/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
 	router.GET(baseURL+"/v2/ledger/sync", wrapper.GetSyncRound, m...)
+	router.POST(baseURL+"/v2/ledger/sync/ack/:round", wrapper.AcknowledgeSyncRound, m...)
 	router.POST(baseURL+"/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
 
 }
@@ -114,204 +136,400 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5PcNpLgX0HUboQsXbFbL3vGupjYa0u2p8+yrVC3Pbcr6WwUmVWFaRbAAcDuKuv0",
-	"3y+QCZAgCVaxH5Y8G/NJ6iIeiUQikZnIx/tZrjaVkiCtmT17P6u45huwoPEvnueqljYThfurAJNrUVmh",
-	"5OxZ+MaM1UKuZvOZcL9W3K5n85nkG2jbuP7zmYZ/1EJDMXtmdQ3zmcnXsOFuYLurXOtmpG22Upkf4oSG",
-	"OH0x+7DnAy8KDcYMofxRljsmZF7WBTCruTQ8d58MuxJ2zexaGOY7MyGZksDUktl1pzFbCigLcxQW+Y8a",
-	"9C5apZ98fEkfWhAzrUoYwvlcbRZCQoAKGqCaDWFWsQKW2GjNLXMzOFhDQ6uYAa7zNVsqfQBUAiKGF2S9",
-	"mT17MzMgC9C4WzmIS/zvUgP8BpnlegV29m6eWtzSgs6s2CSWduqxr8HUpTUM2+IaV+ISJHO9jtj3tbFs",
-	"AYxL9vqb5+zJkydfuoVsuLVQeCIbXVU7e7wm6j57Niu4hfB5SGu8XCnNZZE17V9/8xznP/MLnNqKGwPp",
-	"w3LivrDTF2MLCB0TJCSkhRXuQ4f6XY/EoWh/XsBSaZi4J9T4Tjclnv+T7krObb6ulJA2sS8MvzL6nORh",
-	"Ufd9PKwBoNO+cpjSbtA3D7Mv371/NH/08MO/vTnJ/sv/+fmTDxOX/7wZ9wAGkg3zWmuQ+S5baeB4WtZc",
-	"DvHx2tODWau6LNiaX+Lm8w2yet+Xub7EOi95WTs6EblWJ+VKGcY9GRWw5HVpWZiY1bJ0bMqN5qmdCcMq",
-	"rS5FAcXccd+rtcjXLOeGhsB27EqUpaPB2kAxRmvp1e05TB9ilDi4boQPXNAfFxntug5gArbIDbK8VAYy",
-	"qw5cT+HG4bJg8YXS3lXmepcVO18Dw8ndB7psEXfS0XRZ7pjFfS0YN4yzcDXNmViynarZFW5OKS6wv1+N",
-	"w9qGOaTh5nTuUXd4x9A3QEYCeQulSuASkRfO3RBlcilWtQbDrtZg1/7O02AqJQ0wtfg75NZt+/8++/EH",
-	"pjT7HozhK3jF8wsGMlcFFEfsdMmkshFpeFpCHLqeY+vwcKUu+b8b5WhiY1YVzy/SN3opNiKxqu/5Vmzq",
-	"DZP1ZgHabWm4QqxiGmyt5RhANOIBUtzw7XDSc13LHPe/nbYjyzlqE6Yq+Q4RtuHbvzyce3AM42XJKpCF",
-	"kCtmt3JUjnNzHwYv06qWxQQxx7o9jS5WU0EulgIK1oyyBxI/zSF4hLwePK3wFYETBhkFp5nlADgStgma",
-	"cafbfWEVX0FEMkfsJ8/c8KtVFyAbQmeLHX6qNFwKVZum0wiMOPV+CVwqC1mlYSkSNHbm0eEYDLXxHHjj",
-	"ZaBcScuFhMIxZwRaWSBmNQpTNOF+fWd4iy+4gS+ejt3x7deJu79U/V3fu+OTdhsbZXQkE1en++oPbFqy",
-	"6vSfoB/GcxuxyujnwUaK1bm7bZaixJvo727/Ahpqg0ygg4hwNxmxktzWGp69lQ/cXyxjZ5bLguvC/bKh",
-	"n76vSyvOxMr9VNJPL9VK5GdiNYLMBtakwoXdNvSPGy/Nju02qVe8VOqiruIF5R3FdbFjpy/GNpnGvC5h",
-	"njTabqx4nG+DMnLdHnbbbOQIkKO4q7hreAE7DQ5ani/xn+0S6Ykv9W/un6oqXW9bLVOodXTsr2Q0H3iz",
-	"wklVlSLnDomv/Wf31TEBIEWCty2O8UJ99j4CsdKqAm0FDcqrKitVzsvMWG5xpH/XsJw9m/3bcWt/Oabu",
-	"5jia/KXrdYadnMhKYlDGq+oaY7xyoo/Zwywcg8ZPyCaI7aHQJCRtoiMl4VhwCZdc2qNWZenwg+YAv/Ez",
-	"tfgmaYfw3VPBRhHOqOECDEnA1PCeYRHqGaKVIVpRIF2VatH88NlJVbUYxO8nVUX4QOkRBApmsBXGmvu4",
-	"fN6epHie0xdH7Nt4bBTFlSx37nIgUcPdDUt/a/lbrLEt+TW0I94zDLdT6SO3NQENTsy/C4pDtWKtSif1",
-	"HKQV1/ivvm1MZu73SZ3/OUgsxu04caGi5TFHOg7+Eik3n/UoZ0g43txzxE76fW9GNm6UNMHciFb27ieN",
-	"uwePDQqvNK8IQP+F7lIhUUmjRgTrLbnpREaXhDk6wxGtIVQ3PmsHz0MSEiSFHgxflSq/+Cs36zs484sw",
-	"1vD44TRsDbwAzdbcrI9mKSkjPl7taFOOmGuICj5bRFMdNUu8q+UdWFrBLY+W5uFNiyWEeuyHTA90Qnf5",
-	"Ef/DS+Y+u7PtWD8Ne8TOkYEZOs7+kaFw2j4pCDSTa4BWCMU2pOAzp3VfC8rn7eTpfZq0R1+TTcHvkF9E",
-	"s0PnW1GYu9omHGxsr2IB9fQFaXQWNiahtTWr4lrzXXrtNNcUBJyripVwCWUfBGJZOBohRG3vnC98pbYp",
-	"mL5S2wFPUFu4k51w46BcHbB7AL4XHjKlD2Mex56CdLdAJ8sbZA8yFoHcLK21+mSh9M3YcY/PStba4Bl3",
-	"o0a30byHJGxaV5k/mwk7HjXoDdQ+e+7nov3hUxjrYOHM8t8BC8aNehdY6A5011hQm0qUcAekv07eggtu",
-	"4MljdvbXk88fPf7l8edfOJKstFppvmGLnQXDPvPKKjN2V8L94cpQXaxLmx79i6fBctsdNzWOUbXOYcOr",
-	"4VBkESaZkJox126ItS6acdUNgJM4IrirjdDO6LHDgfZCGCdybhZ3shljCCvaWQrmISngIDFdd3ntNLt4",
-	"iXqn67vQ7UFrpZNXV6WVVbkqs0vQRqjE89Ir34L5FkHer/q/E7Tsihvm5kZbeC1RwkpQlt3K6Xyfhj7f",
-	"yhY3ezk/rTexOj/vlH3pIj+YVg2rQGd2K1kBi3rVUQ2XWm0YZwV2xDv6W7Akt4gNnFm+qX5cLu9Gd1Y4",
-	"UEKHFRswbiZGLZzUYCBXklxDDqirftQp6OkjJtgs7TgAHiNnO5mj4fUuju24Jr8REl+BzE7mkVrvYCyh",
-	"WHXI8vbq+xg6aKp7JgGOQ8dL/IyWnxdQWv6N0uet2PetVnV150Jef86py+F+Md62VLi+wagg5KrsuiOt",
-	"HOxHqTV+kgU9D8fXrwGhR4p8KVZrG+lZr7RSy7uHMTVLClD8QFpq6foMddUfVOGYia3NHYhg7WAth3N0",
-	"G/M1vlC1ZZxJVQBufm3SwtmIAwu+nOODv43lPbsmxXMBjrpyXrvV1hXD5+zBfdF2zHhOJzRD1JiRx7zm",
-	"FZZa0XTkHFFq4MWOLQAkUwv/Yubf8nCRHN/ibRBvvGiY4BcduCqtcjAGisxb6g6CFtrR1WH34AkBR4Cb",
-	"WZhRbMn1rYG9uDwI5wXsMvQcMeyz73429z8BvFZZXh5ALLZJobexe/hn0SHU06bfR3D9yWOy4xpYuFeY",
-	"VSjNlmBhDIXXwsno/vUhGuzi7dFyCRofKH9Xig+T3I6AGlB/Z3q/LbR1NeIP6dVbJ+G5DZNcqiBYpQYr",
-	"ubHZIbbsGnV0cLeCiBOmODEOPCJ4veTG0qO6kAXaAuk6wXlICHNTjAM8qoa4kX8OGshw7Nzdg9LUplFH",
-	"TF1VSlsoUmuQsN0z1w+wbeZSy2jsRuexitUGDo08hqVofI8sWgkhiNvm7cl7nQwXhy807p7fJVHZAaJF",
-	"xD5AzkKrCLuxT9gIIMK0iCbCEaZHOY0j2nxmrKoqxy1sVsum3xiazqj1if2pbTskLm7be7tQYNAVzbf3",
-	"kF8RZskbcM0N83CwDb9wsgeaQej1fwizO4yZETKHbB/lo4rnWsVH4OAhrauV5gVkBZR8Nxz0J/rM6PO+",
-	"AXDHW3VXWcjIrSu96S0lBy+aPUMrHM+khEeGX1jujqBTBVoC8b0PjFwAjp1iTp6O7jVD4VzJLQrj4bJp",
-	"qxMj4m14qazbcU8PCLLn6FMAHsFDM/TNUYGds1b37E/xn2D8BI0ccf1JdmDGltCOf60FjNhQvcd8dF56",
-	"7L3HgZNsc5SNHeAjY0d2xKD7imsrclGhrvMd7O5c9etPkHx3ZQVYLkooWPSB1MAq7s/IIak/5s1UwUm2",
-	"tyH4A+NbYjmlMCjydIG/gB3q3K/I0zUyddyFLpsY1d1PXDIENPjPORE8bgJbntty5wQ1u4YduwINzNSL",
-	"jbCWPNi7qq5VVRYPkHzX2DOjf9VMvinufWY9w6Gi5Q23Yj4jnWA/fOc9xaCDDq8LVEqVEyxkA2QkIZjk",
-	"AMMq5XZdeGf64E4dKKkDpGfa+KTdXP/3TAfNuAL2n6pmOZeoctUWGplGaRQUUIB0MzgRrJnTu7q0GIIS",
-	"NkCaJH558KC/8AcP/J4Lw5ZwFSJQXMM+Oh48QDvOK2Vs53DdgT3UHbfTxPWBDz7u4vNaSJ+nHHa18CNP",
-	"2clXvcGbVyJ3pozxhOuWf2sG0DuZ2ylrj2lkmpsJjjvpLafzZD9cN+77mdjUJbd38WoFl7zM1CVoLQo4",
-	"yMn9xELJry95+WPTDaNrIHc0mkOWY0zIxLHg3PWhMJJDumHrXic2GygEt1DuWKUhBwp7cCKfaWA8YuQQ",
-	"ma+5XKGkr1W98h55NA5y6tqQTUXXcjBEUhqyW5mhdTrFub0Xdoh8cXIQcKeL9U3bpHlc8WY+H+w05UqN",
-	"kNc39Sdft+azUVXVIfWyVVUJOd3wnQlcvCOoRfhpJ574BoKoc0LLEF/xtrhT4Db397G1t0OnoBxOHPkI",
-	"th/H3ASdnlzu7kBaoYGYhkqDwbslti8Z+qqWcaiev3zMzljYDE3w1PWXkeP3elTRU7IUErKNkrBLRqcL",
-	"Cd/jx+RxwvttpDNKGmN9+8pDB/4eWN15plDjbfGLu90/of2nJvON0nf1lkkDTpbLJzwdHnwn91Pe9IGT",
-	"l2XiTdAH8vQZgJk3iQOEZtwYlQsUtk4LM6eD5p8RfdRPF/2vGvfkOzh7/XF7j19xjCgad6GsGGd5KdD0",
-	"q6Sxus7tW8nRuBQtNeG1FLTocXPj89Akbd9MmB/9UG8lR4+1xuSU9LRYQsK+8g1AsDqaerUCY3tKyhLg",
-	"rfSthGS1FBbn2rjjktF5qUCj69ARtdzwHVs6mrCK/QZasUVtu2I7xqkZK8rSv8S5aZhavpXcshK4sex7",
-	"Ic+3OFx4rQ9HVoK9UvqiwUL6dl+BBCNMlvau+pa+oiewX/7aewVjXgH6HLws28DZmVtmJ1b+/372H8/e",
-	"nGT/xbPfHmZf/o/jd++ffrj/YPDj4w9/+cv/6/705MNf7v/Hv6d2KsCeiqLykJ++8Crt6QvUW9rHmwHs",
-	"H81wvxEySxJZ7IbRoy32GUYMewK637Vq2TW8lXYrHSFd8lIUjrfchBz6N8zgLNLp6FFNZyN6Vqyw1mtq",
-	"A7fgMizBZHqs8cZS1NAhMR2viK+JPgQRz8uylrSVQfqmcJzgGKaW8yYmldLVPGMYsLjmwavR//n48y9m",
-	"8zbQsPk+m8/813cJShbFNhVOWsA2peT5A4IH455hFd8ZsGnugbAnfeDIKSMedgObBWizFtXH5xTGikWa",
-	"w4UgB28s2spTSR7t7vzg2+TOP3mo5ceH22qAAiq7TqWx6Ahq2KrdTYCev0il1SXIORNHcNQ31hROX/Te",
-	"eCXwJaZTQO1TTdGGmnNAhBaoIsJ6vJBJFpEU/fT8+f3lb+5cHfIDp+Dqz9k8RIa/rWL3vv36nB17hmnu",
-	"UWQzDR3FoiZUaR9u1fEkctyMkveQkPdWvpUvYCmkcN+fvZUFt/x4wY3IzXFtQH/FSy5zOFop9ixEcL3g",
-	"lr+VA0lrNL9WFDvHqnpRipxdxApJS56UM2U4wtu3b3i5Um/fvhs4VQzVBz9Vkr/QBJkThFVtM5/xIdNw",
-	"xXXq0co0Ef84MqV02TcrCdmqJstmyCjhx0/zPF5Vph/5O1x+VZVu+REZGh/X6raMGat0kEWcgELQ4P7+",
-	"oPzFoPlVsKvUBgz7dcOrN0Ladyx7Wz98+ARYJxT2V3/lO5rcVTDZujIamdw3quDCSa2ErdU8q/gq9Tb2",
-	"9u0bC7zC3Ud5eYM2jrJk2K0Tghs86nGodgEBH+MbQHBcO5wQF3dGvUJ2r/QS8BNuIbZx4kb7Yn/T/YqC",
-	"cm+8Xb3A3sEu1XadubOdXJVxJB52pkn6s3JCVnCjMGKF2qrPj7QAlq8hv/CJa2BT2d280z146nhBM7AO",
-	"YSilEYXUYVINfFlYAKurgntRnMtdP7uBAWuDP/BruIDduWpzclwnnUE3ut6MHVSk1Ei6dMQaH1s/Rn/z",
-	"vTsYKvZVFYLUMVoxkMWzhi5Cn/GDTCLvHRziFFF0or/HEMF1AhFE/CMouMFC3Xi3Iv3U8pyWsaCbL5He",
-	"KPB+5pu0ypP33IpXg1Z3+r4BzI+mrgxbcCe3K5/aiyLIIy5WG76CEQk5ftyZGKfdeRDCQQ7de8mbTi37",
-	"F9rgvkmCTI0zt+YkpYD74kgFlZmev16Yid4P/csEZuz0CFuUKCY1jo3EdLjuPLJRCsIx0NIEDFq2AkcA",
-	"o4uRWLJZcxOyjmFytnCWJ8kAv2NGhH15cE4jV7MoA1uT5Sbw3P45HWiXPhtOSIET8t7EquWEHDZOwkfv",
-	"9tR2KIkCUAElrGjh1DgQSpudod0gB8ePy2UpJLAs5bUWmUGja8bPAU4+fsAYWeDZ5BFSZByBje/iODD7",
-	"QcVnU66uA6T02SV4GBtf1KO/IR33RX7cTuRRlWPhYuRVKw8cgHtXx+b+6jnc4jBMyDlzbO6Sl47NeY2v",
-	"HWSQjgXF1l7yFe+ZcX9MnN3zAEIXy7XWRFfRTVYTy0wB6LRAtwfihdpmFPiZlHgX24Wj96RrO4ahpg4m",
-	"Jb65Z9hCbdHbB68WcqU+AMs4HAGMSMPfCoP0iv3GbnMCZt+0+6WpFBUaJBlvzmvIZUycmDL1iAQzRi6f",
-	"RblsbgRAz9jRJob2yu9BJbUrngwv8/ZWm7c52kLUUOr4jx2h5C6N4G9ohWmyz7zqSyxJO0XXaaWbeCcS",
-	"IVNE79jE8JFm+BRkoARUCrKOEJVdpF5OnW4DeOOchW6R8QLT+3C5ux95QmlYCWOhNaIHP4lPYZ7kmFVQ",
-	"qeX46myll259r5Vqril6RsSOnWV+9BWgK/FSaGMzfIFILsE1+sagUv2Na5qWlbq+VpSDVxRp3oDTXsAu",
-	"K0RZp+nVz/vdCzftDw1LNPUC+a2Q5LCywJzRSQ/MPVOTk+7eBb+kBb/kd7beaafBNXUTa0cu3Tn+Sc5F",
-	"j/PuYwcJAkwRx3DXRlG6h0FGkbND7hjJTdEb/9E+6+vgMBVh7INeOyF+d+yOopGSa4kMBntXIfCZyIkl",
-	"wkYpl4chrSNngFeVKLY9WyiNOqox82sZPEKiuh4WcHf9YAcwgCLta1iChqQJoflE3tGNuBQnKsTI7k4q",
-	"nMSmjxr/u6a0cFE2lSOiiW5gBPOpJcf3uPW97KRe7C4lUbtgOGstpP3i6ZAiGxu/g2XKbpylTetnTtHo",
-	"Ij5StyiV+YFNECOKe0yeEXuOpxImFOIYkm0TA3mIcs+Bl9/B7mfXFpcz+zCf3c6QnaJ8P+IBXL9qDlsS",
-	"z+goQYbNzrvUNVHOq0qrS15m3tw/xii0uvSMApuH14GPfPGkKfv865OXrzz4H+azvASus0ZwG10Vtqv+",
-	"aVZFyShHDkhI9O808KBBkWAfbX6TQS9+Irhag8+YHukGg9Su7fNPdBT9k8Ey7a91kPf5lypa4p4XK6ia",
-	"B6vWmErvVd03Kn7JRRmsmAHaEd8qXNy0/MBJrhAPcOu3rujJMrtTdjM43enT0VLXAZ6Ec/2IKZHS0on0",
-	"CZOQFfm3qy4Lumc8ZR3jqo8XatvenhPv5G+U7jB/71iffPsKF3afMd7J3e3xOOJqFKpw9AXPI4a0xH5d",
-	"/epO44MH8VF78GDOfi39hwhA/H3hf0dj0YMHSbNkUutwTAKVCsk3cL9xEhzdiI+rokq4mnZBn1xuEHXo",
-	"6z1Ohg2F0iNWQPeVx96VFh6fhf+lgBLcT4cDaHqbTuiOgZlygs7GHOkbH4kNFf4wTMm+SxDGcDjSQma/",
-	"4ZjamKy8wyMk6w1aRjNTijz9ZiQXxrFXSb4ArjHDxiPKtRuxFiOuJbIW0Viu2ZRcXT0gozmSyDTJdGEt",
-	"7hbKH+9ain/UwEQB0rpPGu+13lUXlAMcdSCQOl1oOJcfmF4c2+FvozPFab37MiMCsV9hij0PBuC+aEyA",
-	"YaGNhb3Vma7rwBTPOGDce5yPPH14aiZn7HXXg2CaHjOlAFxgdD6/+MgcyYJuwmRLrX6DtN0KzX2JAMyQ",
-	"yFyg195vEKtncRmjDktprNVtXbp29kPbPV03Htv4W+vCYdFN7vSbXKbpU329jbyJ0mvSaQI9kseUsPjp",
-	"ouvZNsJa8HhFvhyYtjo8a3JJ54miDzsO0ulTGYciHNP47an0MA/CN0p+teCpnN5OF3IwRdvbeYC1ioXO",
-	"YQNME6JHs7PIAalpKyiDSQW6DUAfZkO7oV5D007WaFoFBikqVl3m5DRSGpUYppZXXFItNNeP+JXvbYBe",
-	"TFyvK6Ux/5BJvxUXkIsNL9MKTpEP3wULsRJU5qs2ENWR8gNRCUWiIl+Lqwk89ag5XbKH86iYnd+NQlwK",
-	"IxYlYItH1GLBDV6XzetF08UtD6RdG2z+eELzdS0LDYVdG0KsUazRPVHIazweFmCvACR7iO0efck+Q18P",
-	"Iy7hvsOiF4Jmzx59iS919MfD1C3ry7TtY9kF8uy/eZ6dpmN0dqExHJP0ox4lU7VQndbx22HPaaKuU84S",
-	"tvQXyuGztOGSryDtXrg5ABP1xd3E15ceXmRBRQaN1WrHhE3PD5Y7/jQSsuTYH4HBcrXZCLvxHgFGbRw9",
-	"tUWiaNIwHFUs9Pn9A1zhIzrWVMGvoGfr+shqDN+MuByj+9MPfANdtM4Zp6RTpWhd3kLVEXYactphwYOm",
-	"zgHhxs3llo6yJHrALVmlhbRo/6jtMvuzU4s1zx37OxoDN1t88TRROKCbW1teD/CPjncNBvRlGvV6hOyD",
-	"zOL7ss+kktnGcZTifhsiGJ3KUQ+gtK/HmMPJ/qGnSr5ulGyU3OoOufGIU9+K8OSeAW9Jis16rkWP117Z",
-	"R6fMWqfJg9duh356/dJLGRulU4lq2+PuJQ4NVgu4RIfv9Ca5MW+5F7qctAu3gf7TPlcHkTMSy8JZTioC",
-	"wei0L9DLifA/f++LEg9k7xHnNPI+a/p85AC2pNGSJLSO2ezRr0w7TRKl0QcPEOgHD+ZemPv1cfczMakH",
-	"D9Lp25KGI/dri4Xb6HXYN7WHX6mEGSfUSmme0H2QWsKMNsZq3Qd3lBd+qDnr1qX4+Hfh3bg/p11c0qfg",
-	"7ds3+CXgAf/oI+ITH3ncwNaJj1YyQihRXZ4kyRTN98i5jrOv1HYq4fQ4aSCePwCKRlAy0ciEKxnUHUo+",
-	"Oh/0eoho1I26gFI5VSlOqR5bpf958OwWP9+D7VqUxc9tgo3eRaK5zNdJ16SF6/hLWx+4WSKxymSW5jWX",
-	"EsrkcKSh/RI0uYSu+Xc1dZ6NkBPb9ute0XJ7i2sB74IZgAoTOvQKW7oJYqx2cxc0sXHlShUM52lTArfM",
-	"cVhALqpq848ajE0dDfxA/vn4ZOOYLxVVYSALtOEcsW8xitjB0sn3iLaTkJCrm5ymrkrFizkmCjv/+uQl",
-	"o1mpD1W5pKIuKzQddFeRtPVOT9bTFKxMR6FOH2d/WJxbtbFZU4MllefDtWirxIieAwAaFWLsHLEXUTF/",
-	"SgnihmCYJ05voIhKvpBGgTTh/mMtz9doKOlcZOMkP70aUaBKE5VEb0qbNinA8dw5uH1BIqpHNGfKrkFf",
-	"CQMYdwSX0E0t0uTZ8Ya6kGqkuzxdS0mUcnQNmaJJ+H1dtAfgSCAJL5xJyHqIv6aaTMW8rluc6Qx7JTOS",
-	"9is9DWqhU6KKpmTl96GaPZdKihzzgaYEIkyDMO3NZELq1PRjh5n5E5o4XMn6Uk3Eg8fiaMWpwAg94obv",
-	"j9FXt6lEHfSnha2vO7ACazxng2IeyqR567yQBnxKd0dEMZ9UOuFhkRI5suY195pkhBHOI+aWb9y3H7wx",
-	"DkP/LoREtdujzYvZZD/HCvbW6erCspUC49fTTfNi3rg+R5jxpIDtu6NQ8R7HIJ8et2xyYBsOdRLc2bz7",
-	"mGv73LX1eSibnzu+KTTpSVX5SceL6KUrh27lKIJTThThVTtCbjN+PNoectvrh4r3qSM0uEQXGqjwHh4Q",
-	"RlNQrle91akIRFHYgpE3fjIZlZAJMF4KGd5z0hdEnrwScGPwvI70M7nmlkTASTztHHjZ+Mz0GZqx/kHw",
-	"tkP1s3A6lOAawxzj29jWwhthHE2DVnDjcsfCoXDUHQkTz3nZ+HEmKtuhVOWFqAKDQ3u17lKMwzHuUE2z",
-	"ewEcKKA7b7tjStrr3kRj+T4WdbECm/GiSGXY/wq/MvzKiholB9hCXjeZ2KuK5Zjerpvvb0htfqJcSVNv",
-	"9swVGtxyuqh4ZIIa4gKWYYcxnnixw3+vU9q48eC8dkRHcNcsrpfkchihkpJ6HU1nRqyy6ZjAO+X26Gin",
-	"vhmht/3vlNJLteoC8imMpCNcLt6jFH/72l0ccRKsgbMsXS1Njip0TFWhBjqqjU12lS5XwqtskGwfn2Cb",
-	"ksL7zRDjxYHnePmNRFHFJm+6X8kMPBZLlY+G/nHrkxBYzvayoNHAbnJc7BnRh+8ZY86K5Kt4d8Znv9a9",
-	"CA1+5EOAvgtBKqziwjustMxiiFnv5jsM95ziR9tucH8RPmRv1D763eVYeF3IeYvf+8VDL8BnJqo0XApV",
-	"B1eQ4JAZVEL6tVOKswlwTK4/6eb8qY3Po6byc1/EiZbpdfLvfib3XQbS6t0fwHA+2PRBWdKhtEvmqbYJ",
-	"a+p/TKoH0rkVp+SDTqUe9rJhpzDqgbKuA7J6MUUcGJZpnc9Oi2tdmKn01TMaJXXs0kVXx7N7thk98YhV",
-	"yoi2DE+qGutEz+dzLKgaZScdjhU84i4ht1h7qfX00QDXyVXqJovqu/8ry+eIOt04iPvknvsyeg4LLh24",
-	"4wdB91HiCCpWczQ9f+VJ489J4ShX3GC2Zyqx3g3gnBxGtlxCbsXlgSQHf1uDjALo58Eug7Aso5wHogmq",
-	"wBx517c6tgDty0GwF54oV/WtwRkLqr2A3T3DOtSQrJ7TRBTdJD0aYgC5Q+ZIRJmUvxQZkr0LizANZSAW",
-	"gn8idYc20exo4c0oZccN5wok6S6ONo3HninTlf8mzeW6Xiu5DcYHjOVBGBYOG9c/XmCdNtMUxQ7p1WIt",
-	"nZ0Ok1Bf+fRsmJKieTsJidrAhN9C/hmapRQXEJcGxZeqK66L0CJpeglWnWzPfTRIXhCKXvWBXjYzi9ab",
-	"fPhWnUhrioEZeamcGJGNRbd0Hbgb76d7htzUqMoOuqY7uJagfQlllH9LZSCzKnif74NjHyrIF+9GSDCj",
-	"qcQJuNEEf6/bDIZYUoFjQj/uXfDiBTING+6g01GewfE59yH7OX0PEcEhpf5BC1NDr4drO4U4AmEGSIyp",
-	"fsn8bXk40vgmxiYhJegsvDz1kw5K0N3XkEqros7pgo4PRmOQm5zScw8rSdpp8uEqezpCFLF7AbtjUoJC",
-	"UaywgzHQJDkR6FGyqt4m36n5zaTgXt0JeJ/ScjWfVUqV2chjx+kwU2Kf4i9EfgEFczdF8LcdKVTIPkMb",
-	"e/OafbXehcyAVQUSivtHjJ1IinAID9vdUh29yeU9u2/+Lc5a1JS81BvVjt7KtKs4phXVt+RmYZj9PMyA",
-	"Y3W3nIoGOZCHbzuSpVHzq0TZzqOpWvnwqblfSrElKoIiJZOc0YvVczzoKcMRxmNHiQPwIZMz/9LFTKlS",
-	"Lpk3iRl3Q6UxFU+GAFmQU0KXGyj84EkENGUSDzgKNT5CbYW51k9oKB6VpbrK8BhlTZ7ZlNLl2pnuNRFS",
-	"67f9HL0tIPI44saLEDu25gXLldaQxz3SYVEE1UZpyEqFDkipt9GldRLhBmMhJCvViqnKKfqUrzm8IiXr",
-	"Hw7mqqXkeKFD5O+RRAHPc9Q+FfN9WNNn6pR3VV6Skp/QojN6ZRtxiQTjk514DFHjIbx7Kjxev3rk+Tph",
-	"LEPMBQK5dolIT+TXruwWgTnhcB02FJ6kKmB219WvxTpWGdmqjcjT6P7nchEadexJUW8y6wsVV6A4XWyG",
-	"PCXmY82LMJ6eIZpB8kWZvB/88fMvY0jn7r8oNvTHZUvw/GyEhw6PtGf9WT56QfUAQEgpeMzWmioyxNdH",
-	"U+dVrSjYFN/1+oBOZDjoPnE72NwIdw6UhVsBNXDZagD8jDSmOWXnIfevhdqG7/fb9D03Av7DfipPVbFN",
-	"nOKGtHyR3RDqP8IRkl4l+504qLL5YqorR1M9ZyLzjwAYd+7owDDJxeO6YCy5KKHIeALJp41iPY/UAx8W",
-	"0K+JJozn5Dknw9oamBu71uBDz6mkea+GasUdKamm+dD8JQvYgsG4cCoEyQ0Za4PR2NdT72swqspKuISO",
-	"z4uPh69RChGXENdip86sAKjwCaWv2KecOeK7vKft+bVnkTvAFOwm1T9CLO0UO6DbJTXRrczomJipR8lB",
-	"dCmKmnfwZ25RlXq8IPVAfMxITKQDMWWan2iE12GAk9A/JcoETLybxoeuzYLSqNvHgA46d+GJSp56mfbt",
-	"ipM9NFZhnK1oXo+IxFu+YSp+JcetKEOSbyXx6dXiI8R+vYUcpZqu89LtccJwMGZ6iVxGRXDd7PDNrXGf",
-	"hIb3kvDoeClVwwAy2FYZa23lYR0NXcQl67EKlnRir5OasfKE5/+e/82xcC8N5FRAKoQRV+Z/AeHZA3PL",
-	"NhZfL9CK5kILTlpzn1qsrz+KyD11w3dMafxHKsv+UfNSLHd4Qgn80I2ZNXck5N9Z6AHQO325ifcLJvMA",
-	"WFBhVZiK1i2mjhkNt3OjREC7K5Ap7U32G34B8Tbg2yZxntw6lmPqxUYYg5ddbzuHWPCLD+HhG15AFEuC",
-	"Saq6FchC2kLX+3+2oS/xVCG3TFXyvK0obPimZ1Wk0kaBuOwaNvtjo4bqcSCBplxSS7Q6xEQWlLqE8Nfk",
-	"KUBJBP+zEFZzvdvjqXnw+TvlcIyS8yGwB2VkUAy/s2Vcp65hG166J6ps0lLuehemPrIPgMaXupDg5wD4",
-	"lJgtJAP6GPhP5o8bW8YU8P8oeB+pvhPDS4V2PgKWO3HTCVjJBLhQ20zD0hx6TyYboFOEdRtxHZwIhMw1",
-	"cEMP7Kc/epWtTY8mpFMhyQWsecJoRilgKWTLLIWsutXuPbvGLGlyFyEstqQiWkcs5mNSghPDLnn54yVo",
-	"LYqxjXOng6p/xOmpg/XY900o/82dOhxAmFb7wXAsaMN9ombuAi/EcgmavLOM5bLguoibC8ly0O7eZ1d8",
-	"Z25upnfQ6trJFwcM9TySZrpBwpHJHkmbACl3/g3olkb0BkB+h9b0CVZwdANMWMDJKGLViNF7CEM6Np1v",
-	"s1KtMEhnhAB9Hjp8piBlRUk02JI8dL15jPgN9k+DKXj9wbcKZ50yxf5z9iOiDhWen6Swe08aWdP6UVPk",
-	"1kYHIdC/XLW+tbQ5Q/pPBbqdU3H9ONitX6s27DW9sdN8MFJ7p2vBHdlFfGX0UZKxudZMf8noPGSmwulI",
-	"h81QtzV7vGfBRNX9c+/9MDT6DJRiQsrcByNe0yZEluRwD4yARwXu/NnqTtu8SLtxpssa0fNrGqJKVVk+",
-	"xaWKsnQX3qDtIe3COEIfkbl6ZN3N63Nbc7mTHaKTwJ4k5ZuIu70E+ofeZap8n5I9ZtAY4aBdY7laIi/D",
-	"I0xmHHSUb4wX834IR9dg0zAJxpmGvNZo0Lziu8MlRkayQ5799eTzR49/efz5F8w1YIVYgWkzjPZKdLRu",
-	"N0L27Swf19FmsDyb3oQQ3EuICy9lIWah2RR/1ojbkuQmkwVKrmMJTVwAqVLUw9IQN9orHKf1nP1jbVdq",
-	"kXe+YykU/D575t0D0ws4kV5/UUu2n2e0DyPhuCf4hRP+E5dU2NobLHDMHjseXHoTemwNsn8YKkxEy94Z",
-	"7TXL/T0oLill3qzq3iTQhpGTCfJAAEZCojrBLHFRzjbpnybbLlqBw4NZ/xL7vn1IO+i7i5CEDgfAi2Oc",
-	"2naNu6kH5xNnz/u+QUq0lHdjlNBZ/qGwKb/A9uUx2iKv6loLVCKZcgB19yWKiTPPm1CzEdl2EJGGFTid",
-	"flOWiUg20r7xTMWE4wRLfcnLj881sDTrCeIDitfj/utxOFOMZEKluVkypZd80txR6NLdTS1fYfTc38Dt",
-	"UfKe80P5R8fBbYa2E16Sp+HSRyK7IdkVjklOJY++YAufnrnSkAvTf8ykFycfi4XRO6DF0ofCwdYeCBc6",
-	"tM6flb0FGS+D5wH7IXqUUGj8aSFsj+gnZiojJzdJ5SnqG5BFAn8pHhWXcztwXVx0YvJbWTy60ZSGO47N",
-	"j7LsXDM2f1ioburyKP7cXTq1geE6J9/WHdwmLup2bVMTS0zOpYwF9qfkg0jnPXbdMSHFnSRAvlb6498h",
-	"FQXhyI/h501RzM9jyQkpAd9IHszeftSiPOhm0Mlq+mE+W4EEIwzm7fzFZxv/uHdpgIDCY4dHlWC9TUw/",
-	"ISax1s7k0VRRvtIJqUp9t0RiUgw9yWst7A4rzQUzjPglmTTj2yYA2wfwNy8g/u6z6gKaap9tuHZtwu36",
-	"reIl3kf0MCPdLaTKI/b1lm+q0hsV2V/uLf4ET/78tHj45NGfFn9++PnDHJ5+/uXDh/zLp/zRl08eweM/",
-	"f/70ITxafvHl4nHx+OnjxdPHT7/4/Mv8ydNHi6dffPmne44POZAJ0JBG99ns/2Qn5UplJ69Os3MHbIsT",
-	"XonvwO0N6spLhZWQHFJzPImw4aKcPQs//a9wwo5ytWmHD7/OfEb/2drayjw7Pr66ujqKuxyvMD4zs6rO",
-	"18dhHqxP05FXXp02PsnkPYE72togcVM9KZzgt9dfn52zk1enRy3BzJ7NHh49PHrkiyFKXonZs9kT/AlP",
-	"zxr3/dgT2+zZ+w/z2fEaeInpDNwfG7Ba5OGTBl7s/P/NFV+tQB+h2zn9dPn4OIgVx+99nOqHfd+O44f5",
-	"4/edcN7iQE98VD5+H0qi7W/dKYfl/XmiDhOh2NfseIEJ5Kc2BRM1Hl8KKhvm+D2Ky6O/H3ubR/ojqi10",
-	"Ho5DzHu6ZQdL7+3WwXqgx1YU0UpybvN1XR2/x/8g9UZAUz60Y7uVx/j+dvy+s1b/ebDW7u9t97jF5UYV",
-	"EIBTyyWVitv3+fg9/RtNBNsKtHBiIeYg8L9SrphjrBiyG/68k/71qoRUhP9P0gCprSE/807mbcai5kCf",
-	"FqHx2U7mQX4NLmV4TB8/fEjTP8X/zHwtgl4c/LE/jxOrknczkCET7BnOGnjR6QtDwBGGRx8PhlNJbmSO",
-	"KxL3/jCfff4xsXDqNHrJS4YtafonH3ETQF+KHNg5bCqluRbljv0kG0+4qL5ZigIvpLqSAXJ39debDdc7",
-	"FKk36hIM86XTIuJkGpwQQ6/l+KLb0jDePXxl8P2pXpQin80p39w7FJtsSoII1pzhTMGS1Q7ePRXfHjwT",
-	"03ehK5juCfCfBOeB0E8afihVD/c37H3/RY2mupfaoNm/GMG/GMEdMgJbazl6RKP7C7PUQOVj63Ker2Ef",
-	"PxjeltEFP6tUKtj5bA+z8Lngx3jFWZdXtJ5as2dvplW88c8PZFkuwAhfNRu1Cicyt0K/bjhSOPPo/RTt",
-	"9b6SlB/e/SHu9+dchvPc2XFKlMB1KUA3VMDlMD3/v7jAfxsuQHVGOO3rnFkoSxOffavw7NNTjE8+JumJ",
-	"bCIf6OSKa4Xpzs/H7zt/dhUis65toa6ivmhQp9egoe7gPtam//fxFRc2WyrtE49h8dxhZwu8PPZVBnq/",
-	"tol9B18wW3H0Yxydlvz1mHslIvWtKQ6f/NhXZFNfvSI30ii4hobPrVErNhIh92zMQ2/eOd6FVTE9Y21t",
-	"Hs+OjzFWYK2MPZ59mL/v2UPij+8acgllsGaVFpeY5/ndh/8fAAD//xc+yfsK7wAA",
+	"H4sIAAAAAAAC/+y9+5fbNtIg+q/gaPceJ16p23nNN/E939nbsfPwjjPxsZ3M7sa5E4iEJHymCH4A2N1K",
+	"rv/3e6oKAEESoKhuxcnMzk92i3gUCoVCoZ6/Lgq1b1QtamsWj39dNFzzvbBC41+8KFRb25Us4a9SmELL",
+	"xkpVLx77b8xYLevtYrmQ8GvD7W6xXNR8LxaP4/7LhRb/2UotysVjq1uxXJhiJ/YcBraHBlqHkW5XW7Vy",
+	"Q1zREM+eLt5NfOBlqYUxYyi/q6sDk3VRtaVgVvPa8AI+GXYj7Y7ZnTTMdWayZqoWTG2Y3fUas40UVWku",
+	"/CL/sxX6EK3STZ5f0rsOxJVWlRjD+UTt17IWHioRgAobwqxipdhgox23DGYAWH1Dq5gRXBc7tlH6CKgE",
+	"RAyvqNv94vGPCyPqUmjcrULIa/zvRgvxi1hZrrfCLn5apha3sUKvrNwnlvbMYV8L01bWMGyLa9zKa1Ez",
+	"6HXBvm2NZWvBeM1efvWEffLJJ5/DQvbcWlE6Isuuqps9XhN1XzxelNwK/3lMa7zaKs3rchXav/zqCc7/",
+	"yi1wbitujEgfliv4wp49zS3Ad0yQkKyt2OI+9KgfeiQORffzWmyUFjP3hBqfdVPi+X/XXSm4LXaNkrVN",
+	"7AvDr4w+J3lY1H2KhwUAeu0bwJSGQX98tPr8p18/Wn706N1/+fFq9b/dn5998m7m8p+EcY9gINmwaLUW",
+	"dXFYbbXgeFp2vB7j46WjB7NTbVWyHb/Gzed7ZPWuL4O+xDqvedUCnchCq6tqqwzjjoxKseFtZZmfmLV1",
+	"JYzB0Ry1M2lYo9W1LEW5ZLJmNztZ7FjBDQ2B7diNrCqgwdaIMkdr6dVNHKZ3MUoArjvhAxf0x0VGt64j",
+	"mBC3yA1WRaWMWFl15HryNw6vSxZfKN1dZU67rNjrnWA4OXygyxZxVwNNV9WBWdzXknHDOPNX05LJDTuo",
+	"lt3g5lTyLfZ3qwGs7RkgDTend4/C4c2hb4SMBPLWSlWC14g8AjeJsj1nRsDEAHoljfWyhVui++t/vPru",
+	"r0wL06iaMKCFbXW9ZKYtdrDkn4nelkgD5sKTzM8X7K/CwNgxyvhelLRPpUI2DezMLB098aYBdComeLFj",
+	"ohJ7UQewuNb8ABQtAOP8WmgjLtizba00TKI0+1YYw7fiBS/eBoizcpHDzLRY5NnWGH31Rm5bLQy72Qm7",
+	"cyJDQJNa/4coLJwaRN8ANlEXqhTlBXu2YbWy0clyRxFJEHpmgSe4UjLSfxgFR2pvtg0v3qYFokruZWJV",
+	"3/JbuW/3rG73a6EB7/4GDtueA4hGPHKS9/x2POlr3dYF0mA3bU8UhsMqTVPxAyJsz2///dHSgWMYryrW",
+	"iLqU9ZbZ2zq73TD3cfBWWrV1OUNKtLCnkVxiGlHIjRQlC6NMQOKmOQaPrE+Dp5NdI3BkfQQcWc8Dpxa3",
+	"CZoB5ghfWMO3IiKZC/a9uxvwq1VvRR0Ina0P+KnR4lqq1oROGRhx6umTWisrVo0WG5mgsVcOHcCfqY1j",
+	"OHsnQhaqtlzWomSyJqCVdYwwC1M04fRzcSwErbkRf/p08e7Y15m7D7yvv+uTOz5rt7HRio5kQvKAr+7A",
+	"pgXTXv8Zz+t4biO3K/p5tJFy+xou642s8CL/D9g/j4bWIBPoIcJf7UZua25bLR6/qR/CX2zFXllel1yX",
+	"8Muefvq2rax8JbfwU0U/PVdbWbyS2wwyA6zJ9yp229M/MF6aHdvb5LPsuVJv2yZeUNF7968P7NnT3CbT",
+	"mKcS5lVQFsTvtte3/i13ag97GzYyA2QWdw2Hhm/FQQuAlhcb/Od2g/TEN/oX+KdpKuhtm00KtUDHXg6A",
+	"sa9ePHsNjMi8dL/Cj3D2BT2/YDhZcMDuJd6jj3+NIGu0aoS2ksZCjob/k1bs8T//VYvN4vHiv1x2WqtL",
+	"6m4u/dSLdwFMFGjosIXT8aMft1sNyRK0mgTvRYnq6sUzYrFBbKtVKWAup4i66lZ2hrXzpllVquDVylhu",
+	"xdG1d0M/h16vsBM8ckhwXvGmOWGMFyAsmwn+CHjBT8gZidOjmC1rols4PdIwLSpxzWt7sVim2FC8KzTT",
+	"nE3JI5xRw7UwJM9SwweGRahniFaGaMUnzLZS6/DDB1dN02EQv181DeED3xtCoiwqbqWx5kNcPu+YRzzP",
+	"s6cX7Ot4bHy8KVBIroWTruA63LiL2l3cQRvp1tCN+MAw3E5Q70V0Z4yw56A4fFXsVAWC3lFagcbfuLYx",
+	"mcHvszr/Y5BYjNs8cUEr5jBHr2L8JXoOfzCgnDHhOAXhBbsa9r0b2cAoaYJ5Kjebc9BLTuX+ukOOh+pi",
+	"pOMCbSk+alcoUo9HefPmR7gK37z5iVlleRW9XSIFi5Mlw3TWkYxVKXIIc9Kz4tyTbrTaZ6bt8JpDWNTC",
+	"EXvMp5SOKaLY8XoLj9n1YchxFsuZl+WIhz7BQceXp1Nr5+DGbw5ifwQmoPVkfiqc0C8P4VrdigyA+MnB",
+	"hwq6Dp6dqMI7KWwmKeUipLovCD6IAqeC/oW6zQMOJJOGeyO18YTlBI5SbjZp+rIqPUjF544x4JSdSQsh",
+	"xBmGp2dwggOdDMjd785scUtYt0UAMw8bwNbC3ghRM3ujaE0mYmrf1ZWsRS2M+c1ZG30MOjOaP8nh1rzi",
+	"dSFWR2+4m50yAni8rAWc+LdAskJuyRR4rawI84V355gKRCW3cp2yLv4t0qB5dNKosu5GfcwkKtQcHNzi",
+	"J7cIarJ0GmBWq3r1i9CKoMVLr+HaykI2dGzeigNqxGXZmyOCPChRQYeqjV15+KfwFR+LThlTcSuMRett",
+	"0JyP1oriG68PaeTREFNTu0l6x6kS5dYp1NyLID06oXTexkzvQBqBTj+3co+5JPzPnno4XWvYI6bFVhqr",
+	"adOcRAHHU5T+6unReacR0WqfwGhH/GGc6VX3FsdQUW9svKmO7owCSwt9SQJG9hOaMo2ko8cQl9+j4nhQ",
+	"Juv05gJ1rYiEkeBnMXRsKe0h7Mn49AwWeIQahgBVfBqe/t3wG4MDtImcIg1LzPnmzIMGsr0stAJxzSyZ",
+	"Fjdcl8Z7epQnXXFeW9dn1wOWEBN0OM8Rzx0sc+5t11FXH+P9q0/WjNPKoyvvThfdDIFl4j3kp2Q3mjf0",
+	"0HBfSA1IcLpG8ZvjdWRuOMMFbWRdiOPHrFDXQouRjBdr+GRditsEtaRVca2sLemNozFO0FCNkHFUV0Ur",
+	"Hcw3l7gGRp622NEDNWLmhdLBWiBNp9PaaoGmQQfyWaQqN9R8dA2AAJVMa1Ji9FHO7nmKH/HkHU9qEpbd",
+	"muZuSYCAcWvFvrFDvur+dnvhZSey6duIB/hxCCln2J5GaKkySKRvc7BIF3+jDK/MyghRpwfs3tWlNFbW",
+	"hWXrShVvWejMoHNOdIxm++23frkwVjTpKeDLTLSgDHo67b+yovkBux5jFeGeoo10YI/2w0Myl2INEtho",
+	"kXnK7Gjuy2txHtbhJjlVOscT5OQHK/dBtEAbyZKB5UdFrwWB4LIboYXzThGl99QJJmUmgTTvQkU0/HwK",
+	"GOAxxffueA92I9Oa73gLZq6q/maFdc+lN7cLYi/Re2V9GCpk8pfWPS0wM3cjKR91n2P9NEJljLDfCstL",
+	"bvkPQoO692xWoqzDadDNMVmK2sqNFPoONOvgWu242aUngS/BrUlYPDN7t9olA0y21jskQVuaTtodqko7",
+	"i/zBitQr0gNQiXprMyAY+YvIgyDBjGuFucuJ1Vrp1PP1QPN4Y7ifjG24rECzeLMTxB2vhS4luSm1tQaf",
+	"K76uUJ3b1qZtGqXBatLqKvmE7uNrAv+hDYs3jN1w09+BJTM7/vFnfwIAzI5/9tHHf//4sz9dsO9qxtle",
+	"mj24ji6dGoiaJgHzC56gC1Wvih2HZ5pHTkwpSJqzCKDVVXqC718+H4026u3wnwGxtYXqboXr6GyiDwNi",
+	"43F/h/E3Yfo/wsousIc0qU6lEqZ+YKnzuCsifM8P5F66Rh0n3zdCu13DoWsFZPK4Wy90ZbUCPPgGvW1J",
+	"NB0DPKBC6jPELCs4QL/uTpe7SFDfRcME2n585GgYIRieK9gv74mAiFksFx5/i+WC1kv/6ZPbcjGAGn8J",
+	"AKQdQHov/87bnnp7KplzRX2XJxr/m9pshrTvnvMwcbgTzn9H0fCJ24kugv699AXI21/JmlfSHs5wF6H8",
+	"vtoJXqbMeTgbo68MUHKxGCI7zY2x4zc0KtwHQqfc+INUCt9pQ0Rw2kLITprvSTfKAp05tzu7ihe4arRS",
+	"m2Mb8hz6RQt4gZ3wQcHRtW3GGOiH4DoO6LiHcYeaCWD70yZovWe4ufTurf/a8n/iLR+zCvcOd9tm1ZZU",
+	"9SEuDdlZLQQI4FYR/zswaQ3bOF5yEbjLN9zszsVZvklKGj0aw1ttcYz7d6PNwcc3TmjhPbx0SzzX8t73",
+	"8fkO/8Or3umhYSEcQxpnhgrBk2Un1NJM7iEMRLAnz3sG/OLuhy61T7P26Ety9nc75BYRduj1rSzNubYJ",
+	"B8vtVaxXfvbU9BwFRpLppConmmvWs1k1rBLXohqCQAp5xwwBIer27FLHF+o2BdMX6nYkcYAfwDl2wnt7",
+	"zNKjfKFunzrIlE4pUcD1fYWuneON/d64wJeGb2WN4LnX3Z6/JWW6Qv4IuydMCDQhxQQO2rFO58TvHGPg",
+	"1VUd8AjRgEp7bxUt9rxnbMyxstmOFbAbNd8L4yXRWJ2xjEIGr9ZK300yHdwjNesCIRmHUSPD0HKo34Om",
+	"bbNyjCQRDUQNBgN1sefTeBoOn8JYDwtfi1pobsUZiHWufrrD1h0UFW1TKX7E0B5tx0ZWAlUS2E2UTNUF",
+	"2PSktaJO2c4zmmY37VzNXgRB0M7CpO45jVCRsNTtxHO+FtU5NMgTQcAD2CqYMqlNOMte5pDZdboLQhFo",
+	"tnV029eNOl/ToKHvsPvK8t/gtBvLo0N6j9PeH+i3OO1tczb7GS8IBJLDzTG7F7Vipbqp6RDeRTs7n6jX",
+	"Am6rgrfbnSXDR5LChbFyj57cxvKtWMF9WgkYMpNIAKYJncjIEluFcBTmRhGGcYsKWSMKVZeGockAO4hG",
+	"geZR3FrNG1XhaOBihC+LRqst+twZxTZcX7Bn5CXlTAQhOGuntJvSOJe60BOPgmV7wU2rQQ/Fa7DtWFl5",
+	"j6KtgDtddLNRWLLz8PL7BAOtDwAF9qtUvRXGTXqHHWy0KoQx4DkfWcen6Ma3iygH1xJGuhcUqCk/SrqH",
+	"yB9xeK2cB46310eh+MsPZ8UB7mDmGPWIOV532zx2BLIKBOL/Q/HxpB/shwzQF4B/hMOls2O6x3xi0KDd",
+	"GHf2vlH42Ux2BioXhUAvQGnpNJgbCerpvbp24OLdYRX9X9y4lcZ6W1nDW+NaLJaLARo696n+ShbLxQC8",
+	"xXJBMycUt25bVngRTHCgDN/BbqKcYjl3o5SZwMTX2NnBwACF09nGOcRNmvqke86qQIZ3nnAmUzjHCt2x",
+	"vQNb9j3vM+lJmD3HhDMxe+epUhKaT5FDjLd3rBLHfkTvybsztXEx9QyvmAEKxjfhgNaXIylvvGsneH5u",
+	"fSABj7m4Yxsoqat9I6tzPEPThlqIg//kY/bqmytnCgZgEDC+d9f8By78mBl7qMSHyWcRuoSnR//Tpz4X",
+	"R3/c1DhGtboQe57wtaIcH3SwqRmDdikbRkxozl7oAJy1MwJ0ooR2Rtl//EZUkteFOJtP06nuQBgB1Qfj",
+	"qB7xRNcbsvZSujcUCYqK36wxnwoOlHe9eYLxnz4C+wzYofD1XzPh2J4UUMGWfMhk9HkwAEWBxSMsKR9R",
+	"8Gn7nysI9FtdvXi2wvUErf+xpydC7Sef/Yp3uY1ChHmH0L+J9U6pt2fX2bpxcxBRTAj5H/iWy8VTaYBA",
+	"9uuzMKQc0yi7WUrmTmMpjmL+1CPeTXOIjvlTfdDtOcg3eA6lwmKsKlS1uhbaSJWg0ReuBXMtfEhvM/yd",
+	"oEUvH5gbiait04QKiSJO8FSnoV/f1h1uphkNrjexOjfvnH3pI98njDGsEXplb2tWinW77UV/o4KAsxI7",
+	"ooHjK4EpSM7BnzduqNk4c3MfxVUYeLZH5G0jtNyL2vKK+d7DLBRfoRH4JTJoWW/PgADDQWtzwvojCIR+",
+	"hb2PIsNPMhcXrr1f/Qbn9PcSmne+FmR/fy334hX4UX232ZwnQ4LCgRKXitwLAzMxahG982bof92ocxAw",
+	"PBveycrmAXAYeXWoC8wo9NtaNPayxvRm5lAXUfKGLlLyrEkacuigqR6YBDiAjuf4GZ0snorK8q+UjiJy",
+	"vtaqbc5+4Q7nnLsc7hbjQh5L6OtTR8h6W/XTFG8B9ovUGn+XBT3xHNytAaFHikx6yXzR1uVZRIuxO8yp",
+	"Xjt/YA+gxOLO7ACEgx1zA8IBDQYxGYsHTzFJXhdJBJyfANNoHi8IP9Aje7wyAlhtt7LevhIWVnIO2QGk",
+	"SxBgVxYzbFp9WBXciq3SMqde777j3eb7haAUyt6pBZoxjIsymuteAurZa5FxpK5o+eRBsvQp0Btey2LJ",
+	"Ntzyakkeu0t2w3W9RBEMn4cokSWFTdXaprVJg7RL51ipLZPGG50fM3MwldouXfCx7WIK4CEeddCilJoi",
+	"Zq1aMqVDcl1/09DcnQbbqV/JlToFbLdJosZtmzak06CiLs1om2bYzmkjAoZSsy+P0M9cWclvrHGEPRQZ",
+	"vxV7pQ9nJPs1rypu7PEojT3OzFz7yRiNd8vFtlg1Qhcia+Z0Sv+vv/v6Cb3ul+wROdXgTxJWnsmcUslr",
+	"ASyzOQ40NAW20Sw94D7VMJgTA3bxw5brNVk+q0oU5DY0vUhCySqTN7a/zG+//Pb5s2+fvfaLnR7Z5e1P",
+	"C2w4azfCsqNwLveotm+NuGD/W2jVOQDi90pwbygarFbpjuR4pWoxQ+pzQC4DDfW2fYCeeNvmHgZHcvmz",
+	"oLfizFHq/sWdAkZvRYkpM4GPRdMS/wVWhgmaQ3RsP2ad+JwuiSMdXLAfbxrBtf/sPNJ618Qo1Wctyte3",
+	"dXD89Pn+C16rWhaYetunUo6DdVwG5Dn5Lt0kJ4S85xQGJ7un/wv/Z8X/u+VJmETR6q+qFPfwsOnP1w3W",
+	"KYcA07FKiK9Vaxl3lzQ2TvsfTbnNOEbb81cjh+exG00yaDF0XN3NKwino2IDlRa8PFBUmFq7FMpR/BXc",
+	"PA3XduCXkLwJIrju4XiCWjc7gacujC3M4jx37g3sDEPlW3FY4b1o2Ad/+cF8+DvAO8c0P8wvGNAb/O0H",
+	"AfY9o+mM6acIbjh5THZcE+8CqmVWBeetHApPwkl2/4YQjXbx/mi5u03/BAryk9yPgE4xzN+H3u8Lbdtk",
+	"/GCccyVoRmHDal4rr5BMJ18zdnWMLUOjeC0GVhBxwhQnxoEzCsvn3FjKsi7rEmNQTCfAYx/mEmZkAM5a",
+	"cGDkH+hjauxC1UbUpjXBkhPCWVNrwPiE7Fx/FbdhLrWJxg7mIpLhj42cw1I0/kuf/yKkZGHcRvkzYLjE",
+	"4jB/LdYdSaKyB0SHiClAXvlWEXbjIiEZQKTpEN23YKeyxRmrmga4hV3F8cYZNL2i1lf2+67tmLh4pJYo",
+	"lSCfVNc+uNnhDOQjuOOGOTh8wIl3G0nCDIdxha5lqynKR9MItIqPwNFD2jZbzUuxKkXFD4lQGfrM6PPU",
+	"ALjjnaVQWZFNyAub3lGyd5WfGFqtQp6dwUjKZbws4AiCgN8RiOt9ZORS4Ngp5uTo6EEYCudKbpEfD5dN",
+	"W50YEW9DSijn6QFBdhx9DsAZPISh744K7LzqngzDKf6XMG4C3+YOkxyEyS2hG/+kBWTCBJx7WXReBux9",
+	"wIGTbDPLxo7wkdyRzcQsfNfYZ+dwT6BkDCu0F6UvW8wI1OlgtbFkXXLsngbAj0bu28pFxk0lWIUurRb5",
+	"qA/yPOFG1dGk0AsOAU0+d9ooU4msVy6vY6Iak8/pHSyFrukwByXGR0EdJPgRQaFiW4jzO7lezspFOrLt",
+	"ueRU61ZWlny2CQsCbuI7QGFvayKCnFQ+AgBdpdbCP/gRhnbtAjFkTUqR2SmykZ6HxtfZic4i6PsbfYf8",
+	"iB6/qrGDHImyhiUrzVSLgrHLeA4r7+W5frdcvIhTeD7Z8aoS9Vb8lgmpvXNmMmUrWwuITzG5YJ9gihxj",
+	"5oeXX5GJLzwF/GrYHq63YAc0wum3YcKLN/Wb+uFflRWPXakGw/pOoBcP5+T8CYOuemuC7MZpcDsoPvjh",
+	"5VcfsqZdV7JAHOTy2Z4H1mxO2akleMzPM8c2nf0ytQl8vLQRKX5529w1qrdPh0ZwuDey+zAmQYKxqmAB",
+	"0hpmRKGFNUtGQ/nwEi0K2UiB5TTwVALAv9k2RcuYtwcO2OOY/os4XLVWvRS1uOHniFudb5HUMKfJJW8m",
+	"vShWx2ykFukE2ZXgZVYm/UbdsD2vD14ejUrzjbe9n76Y5jREAG1RCGOUhp2UtbFA0rncnoRGM5nxEaYL",
+	"43h9gOvZKfKjNP2zbqbhrrodTZnWfersY4oahzfkmgEJtDlauFT5rnT3EdG1MxTHOxZBEqFutu93axXo",
+	"0IuAO3QCGBJSiuLP7tsxnCB9KEthSRyMPhCf7INNddKGY97NIHEn2kkINEm3G6oPOwPn3wqrZXEOE+We",
+	"Rjo1g3UKmqNim59rdoBMDxGu92S25BGiXvur5K5U2sdWuJkmbsBI8kD+DHAZvECADZLuKcGfrfpNbro+",
+	"xCfJxf4GHmNYCP20JayJ59yKujicJf+00PMJMQXEUQqkKeZiofTD401TFwe2k8ZiDJPpKmnAiIgULKlx",
+	"rpxQIQRixe2RKFNyZ4MggNDpSJh9+rItxUZo7bUCR80OoSLw+A1ViY31ryUSDw4hy420CCoW1y6Z4LrK",
+	"qAsg4TJ1nApK37t6yu6EBH8d7ifFeAh6wYw142rTYTANxXEIhjN3C87JNFipYjXhkNfVhHCNXXKn4+D6",
+	"wTW3Yu7YOqqlMmdoYWTZzh+dms+ZYI5GJEXsGZmJNG8r0iilk/gOdSyNUlXQt/NySN/Gv1Zofx9j+5XY",
+	"N/bggu5Xm7aqlng2VWuXTF0LvVq35VZQNWtsw9e8LlUubA2spBuRozbT7rtMx10YBCx0lAAsFHcicAc1",
+	"U9Ioi7qv8II9xgbmzJyZKp1KjSoVnb4yogxUPy2ZDRXPrQIecY9UbKHSQ8yR+7SVQptf35iv9jZ5wGES",
+	"bG/IMQaHfHwwZ75o2/2e60PvXDrvlu5kxcbV7o67t5fcwD97PCqTVIUPNsSXlh54FzFxywtbHRg35IJF",
+	"Wfu9KnKcdAj2a1i0ZZTEaGJG56OVzOo3mehwhgOWJ4lp+F4PXCSSB0Kpao635RAZSQhm6qcU7LpEX7Xu",
+	"3PnXTA9IZ76qDh5cZzQb8uAL9r9Uywpe++zlwbqrNJpMyR3XoEtWN6cridphCJ2nyacGvzx8OFz4w4du",
+	"z6VhG3GDogKvseEQHQ8fokfbC2X6z59ziL5c22eJuw9lCziSSSUmVdGclv/dyHN28sVgcD8pniljHOHC",
+	"8s/uJjtn7TGNZBK9LhcQnyDrbeLwvPBUyhqt1pXYG7bxhm+7izhH34cRUkAdnEuUe7xhBENwhe6w41NM",
+	"ATSFS6lT8NaIYTsyoGjhJCUvFkszWzn1Kgz2N1rwDJ/OmVTwupdAdEwDdAawoozQL8WZ9Monl4byEIA7",
+	"aLIiVKjXkvbO6efro73NvENk1jXmK6nnjzRUhsjOdNzBeoeCUhAcjHSEBqnCtrwalVLqZKlQWdJP8265",
+	"eCkK8Qep0KYRlN+vQNsIFb9tfbbxcg3ViPChn9wId+UJ1mixkbe4YcqeN9lGo8W1VK2hLLgrVNdzm3Q3",
+	"86qHjH7h+1re+lx+lF2vcw/zs2C1kijdBUp7RSEam7MDTCTzAIepwXjHb0Uabzmx7vnFim+6iYnnh2JT",
+	"2fWrWsRrhhW+EnUp9FV5LY3SZ6nGQHVKcuXc6vHb1rN6hGRJsgZ8Qbs+3Fk0oltQqfCyK1S9qWRhyc6H",
+	"lhZUe863s4yk/y9gnnQIIzfCrGS9ak2CtTzHz6Go9/E1zoYRR/4enVbuUnvQYtHoa1kIUn35gjw8c+OY",
+	"drsVBnyEaMWZpTLn9NuPDPXL53USBRMYGCqWG26t0DDd//vBf3/849Xqf/PVL49Wn/+3y59+/fTdhw9H",
+	"P3787t///f/r//TJu3//8L//16SiY86be4SJIREsA53PObCENjco0gOc1xrf7ETGgC1P5z6cNEdI3CER",
+	"j++utZDeDiJU3kOyYkr2O87/6IJpGXfXq0sx3mhhRO0UsF3zlW9OjyhxcSSUxTWfYYq+0cqKflIzDxKQ",
+	"ayVYqSnBwQXrkhGTHRRrDgwXEoUm7NvKyjgFSDQJHCJ9zStXLZpsKBteGZcCufZGmYlK2ncrAN4PjVyL",
+	"La+Z2bUYa4iJDy/Y36CJX/cSAoa1s6W7QoRUdatQe/8QUfEMJbcczEHny70XNnR5Qs6N136N0vQXiMfA",
+	"eaOdJTsar1YgHGpZiuMPouAM+OU1r74L3d4tF+JWFPCOLwSecrmdORYEgxbiCXU5EknQ8Xq534tSciuq",
+	"Q5RhFUmxc1i8YJjfAdx56i36hWvVYq1/afwpEVqw1hAV6LYeDZFRqebd+a5c5Xanx+o8I0YGHDprNzzM",
+	"J8reRTETecOEKsk0Upg+0WQlzesusIGQ4wgrFPk4+s7qufX2HAb9xDMzzSDqgOuP8RVvC5yCmjdmp85X",
+	"mTdThhBff/CJbnQ3q/eRpXJ/csOkZaUs0w6AQ9c0M83cwxw7VZXmSJ144BJL8pwlzjZRXwBAyVV3jJNB",
+	"BACcj/BOGXvCfCdk8o84L6XeGUIwYNVHZp7tFtzWqM+Ztw0hIHUStVTHMb3YnbgNFsxX31ytIAVoXKfR",
+	"TzUbsWAGPZ5KoVtByDtwFvS5VB9J9NWJjCBaWp80qlvpkYftfS9gv8UdtKdUYhZdmgkURroT0c9n0GUQ",
+	"Ort3Vi850QjQ8cTsRvOGuEX3kcGKqTxRVygC4G7X5mDgqjmHEioMNlsDFOY/rvnpBp+9gaFLvF3uBVfw",
+	"Gh3rvfm5Lj2dEl4ggO4M5jYaiGnhXgWmnzedvqoNC7ERwXri8LJM1+b+e4alvszGbJEqcrVXdcqZ6jv8",
+	"+i1+TOuEwECT6YymslzfoUDcg38AVn+eOft8X/ziKYAUpa/FvjmTMN2DcMwafYV0NyET9UbpQpgkg8f6",
+	"VeNRFz+QNkJt+mOFcldhmS4N9GyRMsbFCz9a0obqGiVi/+KUwa5VrlxwRhj98up5XxrtLWRMnnlLVMC3",
+	"698Fgjq8L122CWtQzhIaawNjA9T138OZIaCoT7XdwsP+zmVpiJiw2zwsiiz46JdN8VRI1p3o/JUQX7qy",
+	"MWerPQvON3UyTAYg5ddCY1GIHddiydbC3ghRs0fIaT9a9j0hCt7wQtoDvcH61glsYXr5WErVrqvIG5NM",
+	"0LDkeWk/eiPjXL6mDllIzN1Ktzvl2V1gaA3aICwaISz786P/K42gOwC2EWLVgF/UwYpV89mjXIqeUvKa",
+	"bYRgjdAoJQ48mEBHLcPmpGK5NvG2BXy4JZK2vgY9DKsohoqT+8EqhvDeC/w8s8DPH9kdcwmuqPKZd+v6",
+	"R1/w55kFf/5Ps2Cw3m6EmE6CuxHj9Yw0CJF/qs/WMfJTvQOAo0UeBTW/B32AayFKdIRs+AH+2QpL9qGk",
+	"M2XvbU5yrpZGGOe2RY02sqoMa5uT15mwqcOuJFhM4lAmyDaFt8DCExx1Obx45gmIzqhBHpwe7S6rLxgU",
+	"bd/+PNSlDVPvmq+UPlduZxpw9mtpRirlozKJm/KuCZ8htnCcI9mZbxLhyz5yVWrGjVGFRNexZ6VZ0mvU",
+	"pVV2FoIE+l8KKkBifg+/F4TgFUgwpQvFSUnCvGlWWFUi40jo4/sjeb3npoddXRR001AdPF+lgjfNEmVT",
+	"BzvyWPi7UgWvaA9ccPw1lxUEqgVLBrdCJw2yLnH1WK6NH3zjRd4Nb02THM4YYc+GNRhsgDf4KSALBHvK",
+	"j/keEAUz3w1V0DM15GkVmKMRsVj0eDyPjrsM+Q31TQ2LJHmnQZ9Dz9SQ7n1z4qAvqFdgHUeZYlS9ym2f",
+	"I/gIV2F9fj+GB39M1BH8832UHMxp0wdgyxBTDZU6Yfg+4wzv9nM4jA/HHWSDjDQOlO1MVA3jrKikl66s",
+	"bgv7ph5dtolKtV4Wy+ffeuKbpBN+JaKO3FBvasoZHHIwJTUSSSnzKyF8Gq7gItHbnI0Qb2rXSoKQKSlS",
+	"EsW6FWmdvOBxQS1Bx7CBy9Qq9ovQiq3boWdaaywzVlaVS00J0zC1eVOHZ+K3EmrGwHAb1Zdqa2FvlH47",
+	"JdNCpmdRCyPNKl2t7Gv6+g3YJ9zyY1uF69zFGL1fjxYPuyyzkD976vQiz56i+3qXzXAE+3vLZDfrKTOg",
+	"LfZBrWwgoA/7aZ7sTryp7S36OWNAOrd3I4ehnnZ0Ful0DKimtxGDtE5+rSc6Qt+Dy7AEkxmwRqUq9GI+",
+	"i9OELOzsCM7Ee9oNkBGe4clHnqk7ud0JDaRwh7cpToKZUVQli0NGQ7rjTSMo5C518XCt5TWaPr1lD5+S",
+	"0jB4jD1mbxYbuVFvFs7P3mCV2zeLSt0IY4EI3ixotabn5DVcKLTXvecxRZS9FUwrtUdESZvj3O/59Z0J",
+	"/pmlvdFS6WQOi9jwPBHzy7Vgm85piZSE4MTTcgs4qlkpQA5BtSJlzlab3srTxmvwjT+OSaRHY+fpknh+",
+	"HTOVugDUkVit3EmL1B7kROHSqEjrafcu6qgBPIgt5514Cmjh9Ut9USbAq94jLIoyW5KUgMevrTEv/50S",
+	"oZGed46u6kSFcJ5Y5+6ynAMWcZT3RXmu/905fGIn76JedGDc+RCcBwwiUyoKsSJGfyIkHi0hGmstKGbL",
+	"e/cyDU5ywqk4YKJM8gNzX+1lEqejHU8wn8FVkyDc9ClLMNfBZTC+q6d5zXIogeS3aJam1HIrjcWsL3VS",
+	"vzyUpe7s7zIulkzQpQgJvgARQCu2aWunyHfOmqTv6WpkLendtBausNJj9qZ+CO9mX3HZ/QkuWl1h/e77",
+	"Ijhwpcrjy/J2DOSzOHdnonAFXs4PzGR0fiY3YCimFQ+7F3CyzE427//VZaxcp1+L37inYaiy8azG7Cwo",
+	"s2Hi84PLp6w27x9uq4UoRZNyenzZdx3BVt1uCjEoRtFodS3qJZMX4mIY/1xuhfFVUivBNyHdnlJznGfD",
+	"OSBC81QRYT1eyKwg4xT9oN7dvXzfLRdOkWLO7rjmBk7BNZwzZDn3f1vFHnz95Wt26R6f5gGA6uoon+Pt",
+	"5gotz1cs/q2rzDypSgwDz9f4Das/GxzUTQxw+djDlJt5zfdxMWuyuKiWHFowZmmsZ+MViFHlqpClzl3f",
+	"pDEwXdVuFE/Xzk0eiBzlrifPnr5ktbLO0/51tjWEg9xgMDflDdDCBVFRvar5pfXuW6rcFKrJ5nvBb2yr",
+	"eR2FhISxApD+3tCQDxBCaBYD7+7FcsHLvayTt8gk/bia5g7KMREtF94SNSaGkEI3KtBjGWdbeS1qZ2OD",
+	"tGdPxUbWGG34+E1dcssv19zIwly2RugvKKvvxVaxx8wN+ZRb/qYe01EuT26czLlL0ZbaDb5Pr+XNmx9B",
+	"lHvz5qdRrZKxK5+bKnmz0gQrdypWXr5zaVzGE5tGFHIjnUqPek/O2p24+Bnkxk/f9mBaWKE1YYUGvPTy",
+	"m6aC5UdczVv9YMuYsUp7jaYM9kHcX8hqR/yU3/gAlNYIw37e8+ZHWduf2OpN++jRJ4JdNQ0aX9Co/LNT",
+	"HEqDUtdsn8GrDsRusJwR0ZWmEbdW81XDt6mz+ObNj1bwBne/S8ME6nLsFuMkuMDhUN0CogykmQ0gOObd",
+	"ZdEKcXGvqFfP3DfeQfiEW4htQrDovfYLhnI2uDtvVzRGcpdau1vB2U6uygCJ+51xHIDxLZe18dVJjNyi",
+	"s4DZqRaWLFixE8VbUV6wZxvmMnjF3dWmp672rEMavD/gWpGGbSTgz/ltt03JnUIf4gxj+WYdyg7ioC/F",
+	"W3F4raj7xcwybi7RN2DDWZRX3gCeOqhIqZGOGog1PrZujOHmuypLAClvGrat1Nqd7kAWjwNd+D75g0yK",
+	"8zMc4hRRBDRM0HvDdQIR2CGHgjssFMa7F+mnljezcIFr0plgnPYrXs3rXfi+B2reanVDuUVLpurIMyHm",
+	"Yq3hW5FLihgLFnfIGBurkLL3XvKmi3QvruPovpnIXriCNScpRcAXIBUUDwdlsPxMFLnvBEuMrHYIc64b",
+	"ISdtF5IfoareToGWJmCh607g8GD0MRJLNjtu0BdSXotyGZ3lWTLA0ZA4IHCfzQLtyp1Qh6GZlbjmOfwb",
+	"uV2lNSrPogpO3AblCnBsblstPM8dntORXgX1KHIL/+zdv5WR21ipgn/t6R/89lNSo4BFI1PboWoUgEpR",
+	"iS0tnBoPkhI/MNEGARzfbTaYdWeVKgYVeaFF14ybQ4B8/JAxioZhs0dIkXEENupbcWD2VxWfzXp7CpC1",
+	"kGgb4n5spVmtor/FRJJLFHlUAyxcZsJ/C88BuKsgFu6vQR07HIbJesmAzV3zStTWP5a6QboBYrH1g57E",
+	"6dP8fZgTZyeCkehiOWlN2ONOq4llJg90WqCbgHitbnO5bUHiXd+ugd6TFSOhV/JgPjCA6QeGrdWtS29f",
+	"ly5ZyRFY8nB4MDoAxK00lK8D+uVucwJmatppaSpFhYZ9EGSbjlxy4sScqTMSTI5cPsC9vwcA2aol7vF7",
+	"9JHaF0/Gl3l3qy27ZC6+GG/q+OeOUHKXMvibUE1EouQTTLqQs+UFF1Yk2oHcWPdYSK/ExZJxy9bK7ny+",
+	"kt5XVkqqPz/QVnSjJb2GIqihiEGyDmX3Zl/xjRX6qDiWexnHI3XV+O40FKLNnAwPEXQ0wMlg+BGG9N3H",
+	"8xSdACVNUYhzvsxQB/Q+B13AOGmKwBkytOBgm4n3wZPbd56J80Hvk3a8Y16n73Xcd7jLHmsT+/uFup3a",
+	"XbykcIvw8upyafUO/m955AGKeC4016nbdCWvaO/TOug3b36ED3B5wiDw/+WgpsR7N3whjjtKmdgEv/aQ",
+	"OsqqIfRL1tahtIBv38XTgohw8TutMFfRdHqJZMaYs0hZ/n5rnOavjhonjuGLoQIhaTbotXJFftZi5H6Z",
+	"kkGZrDNxdMN6ZicUmgNVo8AH4CvfLS738gFVofwwynIdWdKCdiikHnvfdnK42NF+m1+dbfQG1vdSdQlF",
+	"sKMrQhcv8/0fK2XFCnPHrtCtOLkEaPSVQR13nJ13oLrobTaThvyU05wVp4Ua66Ws2jS9unn/8hSm/Wt4",
+	"oZh2jc8fWVOiLUwsmK7wNDE1laKdXPBzWvBzfrb1zjsN0BQm1kAu/Tn+Qc7FqC7gVNHGEQGmiGO8a1mU",
+	"zmWQ33Y1usYV+SKJwyr1Frch2AO3WpDGt8swl8/Y5So8Xcw3qr4eG0zGj87uABdC22xV6t7bHhsxA5D3",
+	"1dl+ZTAUM1ZkXvaFFiVlezcrnx87PSepNW6E3O5I0RV1HayJcvL54ZhVpLEhU7a0hmKhfCeXZ9tY/lZQ",
+	"HZhQPxjgNkyCxqekop6YZEq5hN2CgX+SsoLJeqZXaLzeG1XPWKqDMrHaLms4qm1yO3ExUcv/LFusBWa+",
+	"OBC6sk5qBOux2YYJ0bF86pwFGbU514JgqCzNZjUy3RJ7wPROUw/vY2rInIcJ9tMFdE8rJaKA60m2MWIF",
+	"pR/7aJ4xgiKPHxppYi1xMvfxYnp2WtJ2qxb9fTvGOl6arMFVAG+sldpsjMjk6W2UkXHW5YQvpitT5qrj",
+	"5spj3bua+BiAO1ULzz1Znz1NzeCddUxA6vrAZF0PY5upaAKz8UBSp0s/HU/u7vWNiU1yS0hSi78royPQ",
+	"Hr9zMfA1daPW3Y06vphdcuaraByyGFor9qj83ysds2J3I7gMJtISn7nhpn5gXfHrLvqOchyb3+4i93Ct",
+	"HLwzagVqqcq+rbJba3TzOTdQd/OdkeH37nFu+jjjGB+ZuwJQepu7Urrbs+uMrvX0RDOvmTsv5+g90620",
+	"f/f0seCBnTxJr6xofsiviVZCidStaIKx3b8DhtVBgYQybBa/+QFw3MxtbkWTHiKGYGKA+VuUyQqH0tfR",
+	"0n7UzExIadk5RkEliDa3dL+AAEhy/7oLfvr2x2QYIaNFp5EZX5dlzk1JlrcDj8JsvZde6sH72QPwUZbN",
+	"c9fDwJfXIunYWrOrl09WH/+ZCWjAhMsDPFIWjwkZbM5pArj64lnIxcv1tqXSZW7DcZ6L4/V/4d6rhV7Z",
+	"2yOJ+glwYB594LF7b0cKXlXp4MxKbVe4X/PEH5qS75VzhKvUtrtv8hP+hkIQrd075gUcnxyGBtw3l6L7",
+	"Uwob9o1O39ATxa7UmhjScW+r0/v8WwljuIaYYCKsLelMHDmJaGJ8KTZCi6RLXPhkIvnsQS8tkbsdp88n",
+	"zzqzJwWkkE8qnugOTp28aY4ZgP3M8YoGS7lP/GHnsw6wzNmNV2lX8VdWadFHfOQ+hPg6tglzTGORfjOe",
+	"Spp8QWDQ1KHtZU7O2b+IA+a0xeUsQvzLXR2zU3eQG/EIrl9kMu46PGP6EHLU7cVZnIhy3kAgGa9Wzn09",
+	"d2Vrde2ubGweZ8F9j5rbNGVDMlqXagnVYpXgehUsH9lVYbvmH2ZVWvDsZeNfcajK8B5BZBmLNp/c112I",
+	"m+9CsVAD4xpId464OhY6HM+7wG/SWYyO8j4XeUFLnIjAEE0IwOicg7HzIOZikFBNTjiB0eK6qJeTuUI8",
+	"wL1jN2InnLOym9HpTp+OjrqO8CSc67tG5MoEXtVM+a8hFqPPgh4YR1mXuOpLsGqH23PmnfyV0j3m7yrq",
+	"JGM5woN8wBjPcnc7PGaCxp1PMx+qTi8Y0hL7efsznMaHD+Oj9vDhkv1cuQ8RgPj72v2Ozo8PH46Bptsu",
+	"zSTQKgc2+g89bvIb8X5tvLW4mXdBX13vEXXQSeXJMFAoBWV4dN847N1o6fBZul9KUQn46WKO00O86YTu",
+	"GJg5J+hVrkhDiPnb81vIERQyIEQOsFi8CUgLmb0LbyWv5fERqts95bk1lSwyrkJrA+y1Jj0EPVqgcUaX",
+	"ASO2MhMqWbcyGguazdFWDICM5kgi0yQV7x3u0DkLkNbW8j9bwSRqUTZS6FCoM7rq/OPAkKZ4qPFPvnLd",
+	"wNgnGv4+2osJDzf/cppSXaADo9o3leR1IXLqC7bRQvyClsai4jdrXrxl7vWITIq0lR4b3usxwZjzwbJ+",
+	"XO+53d3YLp5AWKbFtXp7p6xBeRfJ12H0SOWAa8p4zx2b6iz6lPSjOVKlvJU53QV86SkNlnHEC20k/K+t",
+	"u/975Kd4rAsQ0vN2rWefcF1LZ6DFzSNkmztcm+/HaDWVBou+JeZZhhwb8CF5WIoROd8BBZbrbc542KFe",
+	"mc7xGAhso9Uvol7ijsP/ALLxUZoNw6lWPadLUpsxbZ9bfYSnYjnUInUnMmIEAZlhy7P80Tsujxb9NPgY",
+	"dqwv8gGOQipPSFgQz3gC/+Tu/nS3PaVw3fUjhu/PLb1Dud/oiNMn5tiqFTkaU79nT2FwaVZEhslloD/h",
+	"jeOTVMo8ngjVNdg7xRaHIleITuk2vZv92HbP1x3mNv7eukK/6PuwDJ6Wek7byLsoBXHeLJJzSqroI+tn",
+	"ssiIXni8otht9Hr2YYy8Zk7CgbKsPV6SPpVRC3NJ43en0sE83NVweSYvSIAp2t5ewKVV3Q0RMrx75zqa",
+	"nUUJB0JbSf7qjdAkOqTd5+6o9/Gp6GdqfDoFD3TsqXaongqvjEoM09Y3lKOG+hG/cr3RW8H5PtwobWBY",
+	"k44NLUUh90kD/5s3P5bFOA6wlFuJHiYM0/ZtrJPH3EDMVcYGKiqlaSrK7Bqj5tmGPVpGUqnbjVJeSyPX",
+	"lcAWH1EL8MzHtfUFWUqzbUVtdwabfzyj+a6tSy1Ku3OFaoxiQTdHEQI+wnlQq+pz9gHGdht5LT68oJx8",
+	"8EhcPP7oc4zMoz8epV4hpdjwtrJTLLtEnu1l2zQdU75XHAOYpBs1LdqS+JS/HSZOE3Wdc5awpbtQjp+l",
+	"Pa/5NiMC74/ARH1xN3vOs10aBatYKYzV6pDLDbwXlgN/yiQ6B/ZHYLgas3sXAWzUHujJM1J/2PxwlO6K",
+	"eHqAy3/EQPrGxxEPbAHvWc2Ti1bimO6gK9fn0bpk3FDtRNmluHAM8YI9g8NQitLVjvdhMoQbmMsV620U",
+	"bCH4I2lZW9QPt3az+jOoDTUvbL/OWh/c1fpPn45B/qIXqMPq0wB/73jXwgh9nUa9zpC9l1lcX0j9Xq/2",
+	"wFHKD7vCAtGpzEb8J6e1uQDz6aHnSr4wyipLbm2P3HjEqe9FePXEgPckxbCek+jx5JW9d8psdZo8eAs7",
+	"9P3L507KQL/Inplz7ROd9eQVLayW4lqU2U2CMe+5F7qatQv3gf73jYfxImcklvmznHwIeKX8VEpTEOF/",
+	"+DaXCDKTjAJ/7vq8X9pMG3UQmL5Z4aOfmYaXJEqjDx8i0GBdoKY/f9z/TEzq4cOksjitWIdfOyzc512H",
+	"fVN7CAWaxgTtgoeDs5/LcTreP5+S4ahuT3YOHBTQCootrFfihnAZlnqBr+hNjYcWnn+t9ia8L2s4tV+o",
+	"22+ksUofngXPxMDUXOAbxpR0/G7C2fAfJ6L6TImb0g6v6fMMwX/wxeMB/xgi4ndmXi5vqdcd0koyJP/U",
+	"rU7pNPGX4XsUh8zZF+o2YWlLEs7gTvDE8/sEp88Cz+0pHj7AK1aZ+gNsaWYLZ6r3cGmjZC5Jd6ij/njR",
+	"mernaDiBofwx6GJs256K4v+ilVX5Q1cQbXCFa14Xu2TY1xo6/t3FLT7+tVsiXVIprIFHRy2q5HD0Nv67",
+	"f0MnXvn/oebOs5f1zLYDXLnlDhbXAd4H0wPlJwT0SlvBBDFW+7WmQhbSaqtKhvOEWvQRM79YJPbqCd6m",
+	"Pl/3SzrH+WzVS59xmionu5Tb+IQY5PX+PzeJ95LCRwEplu2VsexPn7JKwOk0S6ePXLKSm53DI9Z4NoXS",
+	"wvxzJgAnInMJ6Sdp7PuXz5fMiEI7VdlGVpbe+9wnmz8hbg0lxFpZuTmM67G6UNwlw/JT16qCcmHLXG60",
+	"E3y9JhP4TIIEHvZU/6qrFjt0pkR4fXyyzKWLzhr0JufHPzZCa8SEl6IdREGDOqlwQYs6bF/etYwkdSs3",
+	"IVsjHEmDZThQXscDfXAH1fovcuOKYOFvOUXSbcbHbnLdXvHhM/P6w9LwAzluaQFbz4sN/nO7QQ7ON/qX",
+	"Be04kL9tNouf5qouABc7axtALPxrUAuQxkyjqH6nOm4Ph7lSB/CpPug2z93dB8p3CZ3xSVBiJybqEm0k",
+	"F+xrTGUAQL6OsYe2CblvKzQq9Wptt02leLlkMA64KTOalfpoYVtds1Ks2+2Wij317qp7lsKern99wjjT",
+	"aaZh1caurNwLY/m+SVXfhBavfQMmBw7IqLSPsXPBnpK9xMQFn42lE6nhmg3TudOINz/8x1oqR0XUMkOw",
+	"8cmP8hVsX7gWXvbozLTc/78I8gYRJsBNno6CbrclBR3fSCMwj6+4Fv2Cnx4Mf5P6AqD95em2rolSLk54",
+	"6brSp6ej3QPn3I3qCcgGiD/VCcmVfZ5Lk3SeX2GvFFHa27o/2MAF0pc8ctlBL9i3zpJY8FrVEu6hQ/KZ",
+	"jlVq5l2KbpKOU5xU1JryeI4OV4JeowyiDotu/XlG6BA39u+JvsKmEnXQn1bcWjKfb4U1jrOJcokaYlkJ",
+	"Z/2WtRGa0vMCEfVuGZ3w8E49LLuYyVMrdUpRlRlzxlfw7a/O2AVHMDgOOrQ55Q/ZpyH7NVA7JhPYKmG6",
+	"KqLxmn6EPhdYO6sUtz9dPFdbWbySWxyDYgrILU5w3YyHuvLhNC58Bdo+gbaMci2Hn3u+8TTpVdO4SZMi",
+	"c9jhhIhQZxGccuKmtj3khvHj0SbIbTIODu9TIDSohkqB5nAPjwhDaJ1SP31JNVSBorAFo3RaKaRUsk6A",
+	"8VzW3l8ifUEUySsBNwbPa6afKTS3xa7Hho5FzwSf/SFDM9Y53Nx3qMEGI0pwjX6O/Da+vq1fCtNWNsc4",
+	"QoPuec7rA/OHAqg7TjTMqxBHRkJQ3/QDUpUTokpgg75OG4llacYBjHu1F8b4GKn5D9zQ3WpeiF7fGTdR",
+	"rn7Oui23wq54WaYybH2BXxl+ZSW9NMStKNqQQLlp8FF0xMe3m6hQtWn3E3P5BvecrpQGXkD7dZWIoXka",
+	"Pooy7DBQGjzZ4N/TVA8uguzknEg+XAw7niw390caSb1A0yuo2jAfE3in3B8d3dR3I/Su/1kpHZIV9Mb6",
+	"PYyQGS4X71GKv30JF0dco3AUrEdXS6h2iFY1hd99mQSqVsRwKPeiB38QrDLx8qsn7N/+/OjfYPfXlQB2",
+	"Z7msTBdgF1dCdI3+G8iaVNU5PMwHxkRVpqAFtrmuBKjhip2sxUoLXsIvcYCPz4XkhSBcYNrj0CXkGGGN",
+	"FpFG121T8Zp3yS2kYaqg50QholR6sNAL9iyEEhi0ohrmSDvjHIbfksSeK04C+oZvXr9+4QuSAOq68jW0",
+	"q2lO59TPCSzvlLaQlWbP9WGwJNywpRudwz42O81NmDIC5WK+Sf2Kff/ymd/Eg3eUjqf0qCyFxjgUvDKh",
+	"EdFv4fJXTitRPH6TJ+WaV5nEd7EPAwl0ZNfPpb8rssliuXVVZCxnk3detjIHReoNvCLGmqlcdB4F553P",
+	"m8CtdRKhPnB6DNBffFYG1nDpPJC722mMWRfXmjdtTnH5boNHsSaU5DVrJv5KYD2iHD8QWu5FbXnFNtQw",
+	"aDpUKZZs29Xm6FQM3kNBi0rA6XE2I98TLT0JyvJajumANA+GM5kI9JEYaTkiIEnhbLppx668vdnGk3Pb",
+	"m1mmD7+DZB700njIXYXjYIpG7AVcpOGdZ9Vxc12kXSa5SS/2MIATUvEFcJzdHBXa9QObGdqtZBoTXk3P",
+	"41RKuF9uAJNZRC6Mxj1x4xljYJYRgXWblTwRldzu7EtRKF0K/Yrvm8xNgl+i64g0LlhhLl4QGfqevPge",
+	"1Z+4v6U0b9mzy+/IcQdbGlGoumSUX99fqk2Vkh+aFlVLaQpojYsDNgdjxb6bt0so7w+vrNleFlrR1Cb7",
+	"ZHiLosgKkw9mLumNrISfkdox6DOa77OPPsZQaO8HW4Mk3d5esKvqhh8MewQ/3ci6VDdT8GCE+6kAQScr",
+	"6t8App3gmQR8e7FX+hBwDw19aSOcG++69KBkkVhVfJseGjdVVLyBsY3EZMugc8dujJfXvC7ItAqrcqp4",
+	"TQEvuPNVJSd3nmCfXNeeN01HVVsFmm6A69jaJny7YkBDaihcU07Qy50E+BIdJHTFsxwt3bJ2581EmPu+",
+	"lrdMNKrYZWa6hfpyKyN/EcdyJfYUqC5CKPqNCtUdd8LAtS27Ax/2xJFc4nSmDkinao5oqr+eFB/8Wqu2",
+	"cSqzlyLS9Y/khKCAQIP3FvqRWtlHSAIF+ic0LwphjDA9rhliCm92qhI0xEzvJZdAiz17umS/CK06v8oY",
+	"4+R+afyr7Q7GDoRpKi8gfooS/xFK3O6HFSU0jjuuj92WDnlLGN7brD5nSuNx0csTkMq+8xatJZOWvMcz",
+	"vck1xolP6qY+Hu6fNcZhZlQHd4ehUVKqI+ch3oKlc+fq7CkOj1lSfoXf81Xdu7TFg0RLAf0mIvDfKA/x",
+	"UU+N7DkMJChMj+gSwe6+lOuevxV94aUneA6VUzEvnDSIhfS7Hthje9I0Wb5yx72Y5hT3s3X+YfGOB2Iu",
+	"zjMB16Fw8t3wbrJZ47kL5f4nxb3LrzMT+8l4hCsqmvUHIfh5j8w1+Yofzfb6D3N8epbSo/uYzblxNUgy",
+	"NG9bnR6QLuXQAQUaDpLqtupLNQBslBAq3F9OARO83X6Pi+r/XFYQrr8TmQJmnz1ewrVfkFHWjJ9wU56b",
+	"wprfTRD6P/OK72jr6GWPUWNAAF+0xdvkXc+KFv0fJZToxkZEKjvfM2W8SgrP/eevWoMRzfmC1sqyLb6+",
+	"NNX5AKy0TSM0Ww8yq8aRgtBgtc7rCaIROsUyLCF+3c8qNDV0Ro1mXrr1ptD7l+tcKSF/jvC7d6rwdp+3",
+	"4rB0p1NcS9X6pBghrM8579GvmELGj5epITGVEPP3Dl7LRmYhwYibfs3Qv/xAiR6ZqK0+/AEC70ab/lxw",
+	"I773dszhvpO5I6RYYq5+eI+huqVSMq/xZk7VRST1WFCOkWYMZpSUQovoClvgCKfkm0OFIzeZnaJpfq9A",
+	"5RMLBcTpqBDw47ZTWvpy0atvmC2q9By1aFPVxKhFZO5zSt9RKFPGB7XnxDKc7iulI/dUlB/GEDwJrlxe",
+	"I0yG2R5DibGGF9qIHJ/O8d4Z4ePdcvGsPMm/ZbAfNAyNktwBsNB8AdrNbwTP5kFE3w4n/VDlpB22dtln",
+	"XODR+hAXg03Ul9qKWhhpMkltYCL4EnIOU+uu5tnRp9HchJG5KmoYu5KrBGmUtlStBNqMhjoKXEQiqy5p",
+	"T3quV99crT7+7E+D5D7psJX5MIyKmYo4d2Jvc7LgzqGhF7D9SZ9RkGg2YPJYC212sqEy+VEVGM7QZNgj",
+	"sou5yXZHquPxWF7ovKZSKzF+tRC5+Ai1SU/mg3Kxye/AzrUQpWjsbtIXhXKdNXbXcXghQvrStQAGD/pj",
+	"UTsD+iCLdLntXE8rwTeeErVScyqIhZzEiMYY6BQpfdfYZ9NBqJRNlmz7nc9YXNOFqcbS60IxpZlqQRaf",
+	"rLRvcpdiqvqQmXp1HK2QOHS6xdUcnz6k0D3XxEWljFipNoHlJ/Cp90YlFDI7gX5ZGys4skXVWArRcZSy",
+	"z7gcpDf/+IV81T0Z29rFBvbYIvinYJFVjJjGUXGoxI3k42QSdlmzbXjxduXPeHoqf1WRoY4erFTm16kJ",
+	"3Pv5j+gUmo2R6RWX/os4TLIXPq5uOXKQOEGxcRXyVFKsKJia4WbS3FUvvEv5kM1GFPA0n64O/zdKZOEr",
+	"jy99PAzCsomKxUsbh5nfQT3SAVTxO8JT8fOBk3sUvBWHB4b1qOHZ0zH+u0oSM7zKe6NR+KSxZF5f+XKS",
+	"uQA+Z46WJlAGYsHnXRzUCM08zWC6UNNEbe44lydJrE0ZRN6JKaGu4h3ngq4nlerEN1eugPzwcL8UtbhJ",
+	"4fwqcbCBy/Oq6k43b63acysLpmmcU3WY7obxx71LkXKHcz55ul8PDrGfkXKpyjJfFCw3Wh893Qv6rTjk",
+	"DokW28nbJkiU47smcIKe+gv85+F2hvbkZ9jWFfBPN4A0PlL+6PvkRH3JPNzdyT2p8zvx5yHQXXoWWuy0",
+	"3wc6RDqsgPSy1oqXBazJT0QI1i6lSHDuQP7qomOj/qZdu5evuIUbHCJm56Qo75/SmGQHSpMQ1EqLC/ST",
+	"PNRC6KctiWMCws3rIqPJDAppQPlO3TA4b11WZKndIeFaS3ApAeT4MBt6oFm+pa/CvwoakXqkkQ45c+5H",
+	"6vIgSgUAlwy9RgOvkXqo654VtTPU3adk4Tma+IAEV4lbDwIMPBISD0gh9CwLzmCIyOTb7o+WBC5FxQ9h",
+	"KA/tySr85cJm3ST5djz81Q+oCKNazXAuXrzAH0Jp6OMqQ8QPzbsMVON3hRafpnlUCUfvhS98uOnIikDm",
+	"mIQS2dG0q/NBW1sqtLSAW3UlC1fAE4spYQqD1CNClsffcClPxjVAvPR/Ib3D/w7sRmjRsZhT4uNGQr4s",
+	"zUz85QPAnrpwLUpHmlTHY8T3YJ3Iu7UoRG2rQ5e8AhaMKVeN/42uUB8UVklv8cO7gVKF3HBd+haTj/kp",
+	"x8JRHWkm00BvwsyyS5c/TgmXS7wDr2tZb1e58h0DH1f/rn5gKA8vKmeQBEJCni61E73crfLcYwqOKVRA",
+	"gzsiIZ/6h4Cj3TKpdyN+CP7yIM6ZuKJUWCDTYs8lnkqrvJiYn3MK2U/ouy8w5QP/jlpxAr0eT1bqCyVI",
+	"M0JiTPUb5p7Nx0tN3iXaN5S9SWD+2bgST6NV2RZ0v8YHI0REz75jJ1hJMlC2GK9yYPWJnDTeisMl2TZd",
+	"8cawgzHQpMck0L3I0N+N2auZG/9sUnBvzwLe76klWi7QmT2TbeJZXcKahKskkmIbb2UBZb+C0hAzdpXi",
+	"gRk57rMPUJIO6YRuMGaKW7bjTSNqUX54wdhVTSUcfGYhGUEwmhxirCbmRz99VrbCVa+joN839VQZtHty",
+	"Mz/MNA8jAeSeU9Eg0xMly9QhI+M3iVfnxVw76zjXz1DK64iKoEjKJKTCQQtoRqKCSMoiRPcVtuWVs/AE",
+	"kXPg14WBYZxpYB7wCVm2+b2crTz8QdtljokHLujKzUzL6BWY56bDSqQH22K2NmmN00i7AVSNqRcMBPBc",
+	"sMhXn/rBEdtwzTbiRmg/N3obhTkkiWgVBH1vcDCl2V6aLuv2zKfGvVDgltmpoibPF6w2PUuMj8H2doF9",
+	"V3DesCKCa7GmEm6d+mLPb1c644V1Wqx05/OPQMdoSpJP6iC9RKE7Po+JZxFJ5j0WuocHCQpLzlOFKrIB",
+	"tsVG3rJKqbcpp2lZW81p/Su12WT9VWNb75B9u1dQww/uvYaxxhlV7jGd9onarOEd1qm12DNrCBdLl1lp",
+	"6b2EWFtbWdENc7et/0PXuHwPpSKP6ga8EixBX6Hao1tcb89TZ+IV5aN6glJk6jxgqF9UllzWVjHOXB4r",
+	"ZiqVcAG/U0VqGCqzG9FkPs52TmHkAIUbPIkAl6PzaBrQkAHUZfWUKsoCmk7rvEIZbRX00CnTHrQzAxff",
+	"of7aoCORiPKJcuPepwe24yUrlNaiiHukA+gIKlmbdrORhRS1XW3EPLDIcmv66qCGH5ioVbvdsY0Yg7l0",
+	"aopGaRuK6EmXHwc7UDnumNe2Boedgn+vtFhVCtOjpjK3bYA5yb2PtlZbphpM7UKx8y7HVbeNU3O1NYYp",
+	"rvREhCrhiqIcAQWuTxTqOHNKeApR/qUViQ1Hn7oO06+hD5V3pHGAMdCiV5QDLJOWH7YAGnsMUeMxvEj4",
+	"o82aiDrdyFuke5FKau6y841fKx3t846WY2InFSBJ5OtDz6OZt3antPwlsG2pHRsfkiHvNfYpJ0q5wTIz",
+	"QXmtajG6BmFjzQV7SVzGsPQxT+9uoxrcrClaehmdldCMkV7Lv2g2Sgu5rRk+Tc0wIth0Ve6HO0V4UBu3",
+	"5aHHkhnVvVyxKasVGkGE7qJ3R2Q9wsPosKQRYYTJh/F21bci6nM9LtirFqHZtFWKN6GLyeD552Na8A8a",
+	"hvBQYchAN0nQP2O2KdcUhxSOXCkNjHJxGdzS4BfsFbWl+THhe5g+JP5HwzVmKbf9OqAOtKKSoh4W7dEe",
+	"vbXySfRdeo+CayrOVQjW1pTYH11vb3ayEhPJP1d73uSS5mMDBg2ixFUY4rMMdnfQU1k6F8QyQtsuZyBy",
+	"MIdNqd24EbGM2Jy7N1zWlNk6Kc/9KDXtt7zJJP1dEXmkV50gI6vCFXYyLE5aGDlszfA78mDOkFLm+ION",
+	"FjZc1xynL3gJW7WXRZrv/2NlUs76dnXYJVq9gu5J7jyXI/Mua8UFFDxy6Q7ciegpQQ+YWgIVN16pB3G6",
+	"2pffGeT5hXK2ZRmScUNTyPRDF/d0gvisp8lwPQH0vInt+Ksnk11+0v50CiDZmLZpB1L6dp551rCx6Wnw",
+	"05xZpphKr0BTirqzlNyxxCOsnq7aKEdGn3zch0xwwqtvrj776OO/g1M+NGCl3ApjB5fHCfHb+xS8/+PV",
+	"d3/1Q3Zwo9qJaiWQKAjZjZ9Q0vFESZ+h3jVeVjz7FHeIhewUo6Qerpi41/qZ3nOxf0WO0U03YDp0H8Un",
+	"l14UL/vOpXgwrs9qZnJP1YRIRi/sVZHVAwwAQEhlvXV7AP/rvdK9WcqqLbkbkcPAANCZ7yLMQX0/2GCE",
+	"swNlxb2AGuW9DwB+QFbPJQVm0+0AnN59/7BLEXsn4N9NU3lPtMgl937VkZbGJiSA5uWFlGnB6QJACbHq",
+	"zmdSTMNCx331weh1hvMEFQKzKkqA5wq8Yz8fDIsqCKdTlSNbsKul6azTqOdMq0+cR4dLBZhxPWia1XTa",
+	"79e4wvXc5N/JxF8TD/IIgHw68B4Ms5KCnwoGqSb8A3HFM5LW6977t6dz2kjr5+y9eaOQA1W7GF/WCD14",
+	"7Q7uZJ+1arDT47f6eJNPfBf0RMuEMLHhshLliifO2rPgI7GMLL2ElZGxQBp3DgpOjzYgdC4rSHPJXsNn",
+	"nJLpfjRUw+3OIwWajz2ZXIYCrgUlQltzI1xQMLlHikpg1NjAGK2aVSWuRe/FvnTuovial9fC9zWhMyuF",
+	"aIROncvThDS39lWUIHoOdpOWfEIs7RQ7YqZPxxrXK+KWZi5HBYiuZQkW3RgJp9Jf3w0FOHoCVSP9zcop",
+	"f8q503xPI4SH0pXvn3rvekz8NO86OvkmSqPufveQ85caOqo8MHixeJdoWRdacLLDLrt7aGR2Rnp6YKYv",
+	"p9EBoAS5pg3lns9+RR0tGNGa3L1Qp+tFEOchl6Xg6IizlSEyilbaMXXT8Js67xiUuly8YmkmvUoVh9Z9",
+	"eSsKFPKdAluUToU97f3gMrLA1kPzEazLvIo5UkNnNM3D/Y306tk9nftE72o+3H/bGQ7GsEDSsW3yl2uZ",
+	"kgPueJkGdnI/t7zfhQNOMsDseCmaNFTINbIcBKdZv45wmpxOEBuotipZDSQCirkdvxZeenC355KtWz8Q",
+	"FaxFH/rulcGeCu//rOrY9ZNWxGQQFH1hBZIcxrYyGRUKghA+LOyJVSrZf7a8glKVwN8JfN8N2aqst87h",
+	"mkICXfkNmHj6dbMc2FtK5aeidcu5Y0bDHby86kYCAco7syufvylsQ3Ct8N5b6OburBWD7RxjwS2eFbyG",
+	"2wfroHbZt8CTtT6kssRg7/+7K0IYT+WvsqbiBe12sI30/EIo8MMTl49sPkUJ6UmgU0YGog1K0JKynBP+",
+	"vAMjybH4n7W0muvDmRWWK3x+HwM7esVHedTOtoyZVTjRO3hCWzilgU0s5dy7cK9cACuXc+cY+HFGxPeD",
+	"f5jRJWmcxv2ERroH/h8F7xOqbQ+vU3H/9lieVoN7ncJa3a602Bx1m8TWfROLCeZNL7gjs3sWzCokvUq8",
+	"w/xzofNlDqOUYiPrjlnKumlt4v1Itp5DhLDYawTRmvFuykkJILxe8+q7a6G1LHMb5yO+uOZ7YYWm2DTv",
+	"KeP6JjSI4U4dDyBN93bGwpiiK7wYNYMLnIRfkn2N5XXJdRk3lzUrhLZcgrPhwdzdpQqg1a1YxphPOlXx",
+	"SJrpl2seepwQINXBuZ7c07kqBeAs9yqqCN5zriLVpiEPZd+GXF2m4Zzh2BTg5Gf0cJrhmUQe7WOvJFKK",
+	"WpVxbxnDcLpn0km00/mFBHX8cWekNF7AUbpSW6w1mcu9wm9RRwD+bE7pWaNBnYTJeYv38+SrTPhpsGSJ",
+	"45ro97GdOcUcN6cOzSf7OTkWeoTKp3nld0hW+NT/vpZ2klt6Z5Z+DVJKVkLMzPMwdBB3qe+IcBP21CI9",
+	"WdMvHesX68nJnwMKmPJkdzFVYdZZpjLEhF69ruZwbLcz8xXbPcfhxK3sXvboMOS8veZpZMh2/Vx11eWd",
+	"ImiFCqIjCXQ7h+rCRcUlFGhDzRLh1/uyn6hgJuukFwsy4MGeCeNYWH/ayFWteNube67ndBqiRjWrWVH8",
+	"pagEcDHs5iHtw5gNIAkm0My6g+O4YXzLZW1sj7CjF8cD4x5Od3n9YFzid36uo55ATTGlcxnT4NGwDV47",
+	"RIWHMg7gjYtZ/4pCVe0+Mz598wTtSdRYronXWPYovS3pitavMfdfLe4woMlUhh9m63eLhuJYy07J2Xc2",
+	"GXiGHMnG6AuKu4rUDl3Te5fU6GaEjL7xXG3wZkVkkB5b6Vi1uRxmW+5rrDvHR860KFqNlq0bfhjvu69O",
+	"s7qPg82wxE0XSitHxXTeb/DsaHk2vQm+Yjp+Dp4zPrNs2BR3tOjSNV1O+lGBn1NMYgk5IJkSUHDd5ca6",
+	"817hOF1arD/WdqUWefYdS6Hgt9kzF/KfXsCVEygBymme0VnI/XFP8At4xycEDL+1d1hgziCVr9h9F3rs",
+	"zDV/GCpMlCA/G+2F5f4WFJd8bEyk774a+X2FasizQBtXB06QBwKQSTrcy1QZpepzaU0MxZOZRpFBx3tO",
+	"DC+xbzuPiqP5OBAS3+EIeHEW4a5dSCERVQF/z/n6Y9Hk24CUaCk/5Siht/xjiYndAjsXlGiLnNbKWmGI",
+	"LamxcBFlnTZPjiTVHud81kpZpmpQ+iRyRZMyBM9UTDiytkJf8+p9b8py8ZXUxl4hPkT5Mh84PExz6JFM",
+	"qBwkV5yrNX/OZ81d8d9g6voF5qf+m4A9St5zbijndTG6zVCVxSsKkAyC+bWo2Q2OiTvNPvoTW0vKRNdo",
+	"UUgz9OYg47FLtIqpOYUG8yROIW7tkVygx9b5g7L3IOONd0Fjf+0FOzhHDQdhd0R/Z6aSOblJKk9R34gs",
+	"EvhL8qhgbf4bR9/kZOZTZYF6SOJeV2LvcmERMwiZHweeL6TOFlSop9HiGjYHCKqzcKeexWXKUa+E+dHb",
+	"DtNTSopmdNA8Zl2o+8oqhfnG4B0qxIrblXOxWpESE1xdQBxCIClRB6bLwpwGK1WvtGgwtdeq4Ye9qNO1",
+	"yDOZxJ7F6fYTyRw6XE04ymbdFZ/iX2uHBLf4409pRGk3rAc+Qw1U2jpFBcZ/7BVVp312/gfGKqzbjDYV",
+	"yzVqyCEukUnLdFsnbDvzSuR3cwNF+RrAwefSdLNI46FIbty84oNhuuQYuq3TJyVOsNpBLA1zPe5cbN5N",
+	"mNoyiH4J0uC0vPdWHCgrAmu41N1jOhJJlRbZOlD5CkxTMiDA50TVwVJhWD/IsZW9AshmLw/XgVJja8R4",
+	"nbPF7R5uE5I2fH8t9k0Fl4i3eWZSR9NH8ph7/eXVc2ZdRzCyqXpLd660navkVHTWvFPTTVuo2mpVmRPO",
+	"xF+j8xAGWjLTFjvGDXv97Yvnf//qyy8vTqjN9UNck6sDzme6ocU+ZpyVopB7Xnk5ZokbSA47w+JdVA2e",
+	"kd+vewDCgo7zxeRRmybHOacMNzdUtBokAT5Y+k+//5s3P9r1mzc/ubWEzpnUdKnuFrpjR0xXcsEI1z9/",
+	"9DM5G6Ds8/AhTvDw4dI1/fnj/mcQvh4+TNfNkykJ7M2bH1sJU8PnEeB3SvhEOHJjuHlT+/FDriQ4zFSG",
+	"ouCR/S6xH1AR46gTCjTys70LlYH+DrqVv6//9On7z1DoIaDsQuPTR7Dep04WISax1t7k0VSwQ9JWMKJD",
+	"Vaeh6Zl94s1JhGsuF38T651Sb5MZiehTVAWCqS5pQpf+HYVMUeiTatSiv3WtrH/B9M2GADt49KNV8VpV",
+	"17LeuhIU96o02qXpLU8EydsrlKZktPS4kya+6UjC5aWgYvuTuXFPnT/k4kVM+LBXB9FGC/FLB9FEhlzX",
+	"71i+er/1zhdJdtv+wITJXcIaNELJOoimlLS+4HWt0LHVWT3T/hjHM3Y5UJIMel7qfXjKhEJNXVoblAB4",
+	"RLlj6OztKn0HTG6V98TDm2GxXIgaMqj/uGj4oUukv1zwYoP/3G6QZ/ON/mVBRIrZ95pYy9UtudWZ0sLf",
+	"v3yeWW+jDCVnPH5JI5eBKaLM/xHN/JSK9zZggZP28AoYuLe6yb8ny5l+HYrpuIpoQSxxqg7K4eLeN13p",
+	"ndZ4ZcrXileofiCXulowq1R1wb685fumcvZ/9u8P1v8mPvnzp+WjTz76t/WfH332qBCffvb5o0f880/5",
+	"R59/8pH4+M+fffpIfLT50+frj8uPP/14/enHn/7ps8+LTz79aP3pnz7/twf4cls8XhCgvqL448X/XEE6",
+	"xtXVi2er1wBsh1PeSKhX9O4dvlg3ih7YteUFXuViz2W1eOx/+n88r7oo1L4b3v/q9uHxYmdtYx5fXt7c",
+	"3FzEXS4hkETWK6vaYnfp53m3HLLxF89CSDr5veOV0LkLXCy6u+QKv7388tVrSKhz0d04i8eLRxePLj6C",
+	"8VUjat7IxePFJ/gTXr873PdLd1stHv/6brm43Ale2Z37Yy+sloX/pAUvD+7/5oZvt0JfYE4S+un640uv",
+	"Rbr81d0h76a+XcYu1Ze/9ln9kZ7oDnz5q2fM061BYqkkrwuxQhWLmWwNvpiTDSj/Z01X5ESzBvZ6skkv",
+	"aHFuw0teXkuj9GF+Dxd9EnXYaoExpZdR+MHom7HctvGXRq7wsF9qZbkV8Zd5OznV7HKtbk9oKsxJjS9v",
+	"XK2HWV1GZDJBb8NPk+Q2arwXlpfc8kvSDHdNKYPteOvc79qlVuj/CioVVICNvvyKKvZ3ud8vN7LmlbSH",
+	"bANnSE1/RFsIcd1LXyUr3bJHl79CQs53x3q4YhnuawH7iKzRXPrLZvC1bS5/7Zq9m/46ovJSrNvtZZdC",
+	"MfxcWW4u7W19iZrJy197ZOA+j9Dc/73rHre43qtS+GWHZLhTny9/pX+jifCxL+stXCvXQkcjQAZgLeFE",
+	"86r71edBiX7BXVxpUWB0QveBiixFmB8v0zUxbdNUh/HPh9r5doJYORYxvq+NsHE9J+jQZcgNF9+z0jd+",
+	"dagLr9b3QXN4nX386BFN/yn+B29uZxmJDvmlu7cW9II9alTWWukuFPLd6MZ+FeBFVT7K8gjDR+8Phmc1",
+	"BcqB9EBSzrvl4rP3iYVnNdW1YtiSpv/kPW6C0NeyEAz0jUpzLasD+74OsX4kZ214Mk7++/ptrW5qDzmV",
+	"UNpzuGQXL8VeXYsuEL0jTqaFsVqSASP49BENX1CFIoOviHZdyQI0adzyxU+on7ApSdsbucczeQN/N3j/",
+	"VHx99EzM34W+OmAi4fQsOI8kIqbhE6+U0f76vR86GtJUD1IbtPgXI/gXIzgjI7CtrrNHNLq/sDKnaFwK",
+	"soIXOzHFD8a35SUv3ka37KJRqfTbVwUAi9187l5WqpvaWC0wYAJTFmi24+hm7eKQxbXQBwczZb5E1yZM",
+	"POHPFJWCoBuY/c0nXt0ojAhz93OjKllg2KJLTrpkvAOIMtZUwgY1FOPlNZZAQOXjxA0fLSvmaV3M3OLx",
+	"j0dcSbrV+kzIDhcXXkcAD+DuCa8D3/ScCWNwIop0G754/CjB0n76Q0ghrye2qFa2yyH7L470T8KRvsZj",
+	"yonol8wKCH3LntT4HABNlKoW3qh6Ins6yppeTcgyziKRE2VeCXvSse8ED5f1a+ecRkmVWgojXYWZf9aD",
+	"/4TXXtzoXUhUsorrSgrtf9vxumducjz4Xyzhn50lONHEKhRNSFzQ3kkNHZvniimgJkB1hHN7DdqNgSJn",
+	"L/Y9NaVTJ19q0dNw9MpiZ36+5K1VWDE810ACGnOjDjTZo8+olYL+KzKBJBv92vuzrwU81vKy2PGqEj2d",
+	"3dE+4nawJAHILn3Z5lUV6jb7Bq4aGqC439XsWguSYfRLzRuzU7F2Ep1RcQ8Tiqyhmoz+vrzh0oLl1lXk",
+	"x7rQic7e1cukfrv8FZgxauK0nW6gIsWZFbzCoycrMfi1lIYbI/br8Rd90G09+NE7GgHeCrWtKQDctwDu",
+	"ZIZ/O5Cin5O6/L7i3unBUt/AE1MYK/c9dWavyV7obe4bXpK5eUc65NRXp4zNNVKqwi3PzUGlwbIfu2j3",
+	"1Heft+HI58t1X4efboT622ONXAmL8TY607UZ/9JT+Hb+I7E5FQWUYEj98ScQD4zQ11526ayDjy8vMR/S",
+	"Thl7uXi3/HVgOYw//hQ48q9eamm0vAZ8vfvp3f8/ADBSuilv6wEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
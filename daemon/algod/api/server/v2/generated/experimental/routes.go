@@ -90,205 +90,398 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PcNpIg/lUQtRshS79it172jvsXE3ttyfb0WbYV6rbndiWdjSKzqjDNAjgA2F1l",
-	"nb77BTIBEiTBKvbDsifi/pK6iEcikUhkJvLxYZarTaUkSGtmJx9mFdd8AxY0/sXzXNXSZqJwfxVgci0q",
-	"K5ScnYRvzFgt5Go2nwn3a8XtejafSb6Bto3rP59p+GctNBSzE6trmM9MvoYNdwPbXeVaNyNts5XK/BCn",
-	"NMTZy9nHPR94UWgwZgjlj7LcMSHzsi6AWc2l4bn7ZNi1sGtm18Iw35kJyZQEppbMrjuN2VJAWZijsMh/",
-	"1qB30Sr95ONL+tiCmGlVwhDOF2qzEBICVNAA1WwIs4oVsMRGa26Zm8HBGhpaxQxwna/ZUukDoBIQMbwg",
-	"683s5O3MgCxA427lIK7wv0sN8BtklusV2Nn7eWpxSws6s2KTWNqZx74GU5fWMGyLa1yJK5DM9Tpi39fG",
-	"sgUwLtmbb16wZ8+efekWsuHWQuGJbHRV7ezxmqj77GRWcAvh85DWeLlSmssia9q/+eYFzn/uFzi1FTcG",
-	"0ofl1H1hZy/HFhA6JkhISAsr3IcO9bseiUPR/ryApdIwcU+o8b1uSjz/H7orObf5ulJC2sS+MPzK6HOS",
-	"h0Xd9/GwBoBO+8phSrtB3z7Ovnz/4cn8yeOP//b2NPtv/+fnzz5OXP6LZtwDGEg2zGutQea7bKWB42lZ",
-	"cznExxtPD2at6rJga36Fm883yOp9X+b6Euu84mXt6ETkWp2WK2UY92RUwJLXpWVhYlbL0rEpN5qndiYM",
-	"q7S6EgUUc8d9r9ciX7OcGxoC27FrUZaOBmsDxRitpVe35zB9jFHi4LoVPnBBf15ktOs6gAnYIjfI8lIZ",
-	"yKw6cD2FG4fLgsUXSntXmZtdVuxiDQwndx/oskXcSUfTZbljFve1YNwwzsLVNGdiyXaqZte4OaW4xP5+",
-	"NQ5rG+aQhpvTuUfd4R1D3wAZCeQtlCqBS0ReOHdDlMmlWNUaDLteg137O0+DqZQ0wNTiH5Bbt+3/8/zH",
-	"H5jS7Hswhq/gNc8vGchcFVAcsbMlk8pGpOFpCXHoeo6tw8OVuuT/YZSjiY1ZVTy/TN/opdiIxKq+51ux",
-	"qTdM1psFaLel4QqximmwtZZjANGIB0hxw7fDSS90LXPc/3bajiznqE2YquQ7RNiGb//6eO7BMYyXJatA",
-	"FkKumN3KUTnOzX0YvEyrWhYTxBzr9jS6WE0FuVgKKFgzyh5I/DSH4BHyZvC0wlcEThhkFJxmlgPgSNgm",
-	"aMadbveFVXwFEckcsZ88c8OvVl2CbAidLXb4qdJwJVRtmk4jMOLU+yVwqSxklYalSNDYuUeHYzDUxnPg",
-	"jZeBciUtFxIKx5wRaGWBmNUoTNGE+/Wd4S2+4Aa+eD52x7dfJ+7+UvV3fe+OT9ptbJTRkUxcne6rP7Bp",
-	"yarTf4J+GM9txCqjnwcbKVYX7rZZihJvon+4/QtoqA0ygQ4iwt1kxEpyW2s4eScfub9Yxs4tlwXXhftl",
-	"Qz99X5dWnIuV+6mkn16plcjPxWoEmQ2sSYULu23oHzdemh3bbVKveKXUZV3FC8o7iutix85ejm0yjXlT",
-	"wjxttN1Y8bjYBmXkpj3sttnIESBHcVdx1/ASdhoctDxf4j/bJdITX+rf3D9VVbretlqmUOvo2F/JaD7w",
-	"ZoXTqipFzh0S3/jP7qtjAkCKBG9bHOOFevIhArHSqgJtBQ3KqyorVc7LzFhucaR/17Ccncz+7bi1vxxT",
-	"d3McTf7K9TrHTk5kJTEo41V1gzFeO9HH7GEWjkHjJ2QTxPZQaBKSNtGRknAsuIQrLu1Rq7J0+EFzgN/6",
-	"mVp8k7RD+O6pYKMIZ9RwAYYkYGr4wLAI9QzRyhCtKJCuSrVofvjstKpaDOL306oifKD0CAIFM9gKY81D",
-	"XD5vT1I8z9nLI/ZtPDaK4kqWO3c5kKjh7oalv7X8LdbYlvwa2hEfGIbbqfSR25qABifm3wfFoVqxVqWT",
-	"eg7Simv8N982JjP3+6TO/xokFuN2nLhQ0fKYIx0Hf4mUm896lDMkHG/uOWKn/b63Ixs3SppgbkUre/eT",
-	"xt2DxwaF15pXBKD/QnepkKikUSOC9Y7cdCKjS8IcneGI1hCqW5+1g+chCQmSQg+Gr0qVX/6Nm/U9nPlF",
-	"GGt4/HAatgZegGZrbtZHs5SUER+vdrQpR8w1RAWfLaKpjpol3tfyDiyt4JZHS/PwpsUSQj32Q6YHOqG7",
-	"/Ij/4SVzn93Zdqyfhj1iF8jADB1n/8hQOG2fFASayTVAK4RiG1LwmdO6bwTli3by9D5N2qOvyabgd8gv",
-	"otmhi60ozH1tEw42tlexgHr2kjQ6CxuT0NqaVXGt+S69dpprCgIuVMVKuIKyDwKxLByNEKK2984XvlLb",
-	"FExfqe2AJ6gt3MtOuHFQrg7YPQDfSw+Z0ocxj2NPQbpboJPlDbIHGYtAbpbWWn26UPp27LjHZyVrbfCM",
-	"u1Gj22jeQxI2ravMn82EHY8a9AZqnz33c9H+8CmMdbBwbvnvgAXjRr0PLHQHum8sqE0lSrgH0l8nb8EF",
-	"N/DsKTv/2+nnT57+8vTzLxxJVlqtNN+wxc6CYZ95ZZUZuyvh4XBlqC7WpU2P/sXzYLntjpsax6ha57Dh",
-	"1XAosgiTTEjNmGs3xFoXzbjqBsBJHBHc1UZoZ/TY4UB7KYwTOTeLe9mMMYQV7SwF85AUcJCYbrq8dppd",
-	"vES90/V96PagtdLJq6vSyqpcldkVaCNU4nnptW/BfIsg71f93wlads0Nc3OjLbyWKGElKMtu5XS+T0Nf",
-	"bGWLm72cn9abWJ2fd8q+dJEfTKuGVaAzu5WsgEW96qiGS602jLMCO+Id/S1YklvEBs4t31Q/Lpf3ozsr",
-	"HCihw4oNGDcToxZOajCQK0muIQfUVT/qFPT0ERNslnYcAI+R853M0fB6H8d2XJPfCImvQGYn80itdzCW",
-	"UKw6ZHl39X0MHTTVA5MAx6HjFX5Gy89LKC3/RumLVuz7Vqu6unchrz/n1OVwvxhvWypc32BUEHJVdt2R",
-	"Vg72o9Qa/5AFvQjH168BoUeKfCVWaxvpWa+1Usv7hzE1SwpQ/EBaaun6DHXVH1ThmImtzT2IYO1gLYdz",
-	"dBvzNb5QtWWcSVUAbn5t0sLZiAMLvpzjg7+N5T27JsVzAY66cl671dYVw+fswX3Rdsx4Tic0Q9SYkce8",
-	"5hWWWtF05BxRauDFji0AJFML/2Lm3/JwkRzf4m0Qb7xomOAXHbgqrXIwBorMW+oOghba0dVh9+AJAUeA",
-	"m1mYUWzJ9Z2Bvbw6COcl7DL0HDHss+9+Ng//AHitsrw8gFhsk0JvY/fwz6JDqKdNv4/g+pPHZMc1sHCv",
-	"MKtQmi3BwhgKb4ST0f3rQzTYxbuj5Qo0PlD+rhQfJrkbATWg/s70fldo62rEH9Krt07CcxsmuVRBsEoN",
-	"VnJjs0Ns2TXq6OBuBREnTHFiHHhE8HrFjaVHdSELtAXSdYLzkBDmphgHeFQNcSP/HDSQ4di5uwelqU2j",
-	"jpi6qpS2UKTWIGG7Z64fYNvMpZbR2I3OYxWrDRwaeQxL0fgeWbQSQhC3zduT9zoZLg5faNw9v0uisgNE",
-	"i4h9gJyHVhF2Y5+wEUCEaRFNhCNMj3IaR7T5zFhVVY5b2KyWTb8xNJ1T61P7U9t2SFzctvd2ocCgK5pv",
-	"7yG/JsySN+CaG+bhYBt+6WQPNIPQ6/8QZncYMyNkDtk+ykcVz7WKj8DBQ1pXK80LyAoo+W446E/0mdHn",
-	"fQPgjrfqrrKQkVtXetNbSg5eNHuGVjieSQmPDL+w3B1Bpwq0BOJ7Hxi5ABw7xZw8HT1ohsK5klsUxsNl",
-	"01YnRsTb8EpZt+OeHhBkz9GnADyCh2bo26MCO2et7tmf4r/A+AkaOeLmk+zAjC2hHf9GCxixoXqP+ei8",
-	"9Nh7jwMn2eYoGzvAR8aO7IhB9zXXVuSiQl3nO9jdu+rXnyD57soKsFyUULDoA6mBVdyfkUNSf8zbqYKT",
-	"bG9D8AfGt8RySmFQ5OkCfwk71Llfk6drZOq4D102Maq7n7hkCGjwn3MieNwEtjy35c4JanYNO3YNGpip",
-	"FxthLXmwd1Vdq6osHiD5rrFnRv+qmXxT3PvMeo5DRcsbbsV8RjrBfvgueopBBx1eF6iUKidYyAbISEIw",
-	"yQGGVcrtuvDO9MGdOlBSB0jPtPFJu7n+H5gOmnEF7L9UzXIuUeWqLTQyjdIoKKAA6WZwIlgzp3d1aTEE",
-	"JWyANEn88uhRf+GPHvk9F4Yt4TpEoLiGfXQ8eoR2nNfK2M7hugd7qDtuZ4nrAx983MXntZA+TznsauFH",
-	"nrKTr3uDN69E7kwZ4wnXLf/ODKB3MrdT1h7TyDQ3Exx30ltO58l+uG7c93OxqUtu7+PVCq54makr0FoU",
-	"cJCT+4mFkl9f8fLHphtG10DuaDSHLMeYkIljwYXrQ2Ekh3TD1r1ObDZQCG6h3LFKQw4U9uBEPtPAeMTI",
-	"ITJfc7lCSV+reuU98mgc5NS1IZuKruVgiKQ0ZLcyQ+t0inN7L+wQ+eLkIOBOF+ubtknzuObNfD7YacqV",
-	"GiGvb+pPvm7NZ6OqqkPqVauqEnK64TsTuHhHUIvw00488Q0EUeeEliG+4m1xp8Bt7u9ja2+HTkE5nDjy",
-	"EWw/jrkJOj253N2DtEIDMQ2VBoN3S2xfMvRVLeNQPX/5mJ2xsBma4KnrLyPH782ooqdkKSRkGyVhl4xO",
-	"FxK+x4/J44T320hnlDTG+vaVhw78PbC680yhxrviF3e7f0L7T03mG6Xv6y2TBpwsl094Ojz4Tu6nvO0D",
-	"Jy/LxJugD+TpMwAzbxIHCM24MSoXKGydFWZOB80/I/qony76Xzfuyfdw9vrj9h6/4hhRNO5CWTHO8lKg",
-	"6VdJY3Wd23eSo3EpWmrCaylo0ePmxhehSdq+mTA/+qHeSY4ea43JKelpsYSEfeUbgGB1NPVqBcb2lJQl",
-	"wDvpWwnJaikszrVxxyWj81KBRtehI2q54Tu2dDRhFfsNtGKL2nbFdoxTM1aUpX+Jc9MwtXwnuWUlcGPZ",
-	"90JebHG48FofjqwEe630ZYOF9O2+AglGmCztXfUtfUVPYL/8tfcKxrwC9Dl4WbaBszO3zE6s/P/+7D9P",
-	"3p5m/82z3x5nX/5/x+8/PP/48NHgx6cf//rX/9P96dnHvz78z39P7VSAPRVF5SE/e+lV2rOXqLe0jzcD",
-	"2D+Z4X4jZJYkstgNo0db7DOMGPYE9LBr1bJreCftVjpCuuKlKBxvuQ059G+YwVmk09Gjms5G9KxYYa03",
-	"1AbuwGVYgsn0WOOtpaihQ2I6XhFfE30IIp6XZS1pK4P0TeE4wTFMLedNTCqlqzlhGLC45sGr0f/59PMv",
-	"ZvM20LD5PpvP/Nf3CUoWxTYVTlrANqXk+QOCB+OBYRXfGbBp7oGwJ33gyCkjHnYDmwVosxbVp+cUxopF",
-	"msOFIAdvLNrKM0ke7e784Nvkzj95qOWnh9tqgAIqu06lsegIatiq3U2Anr9IpdUVyDkTR3DUN9YUTl/0",
-	"3ngl8CWmU0DtU03RhppzQIQWqCLCeryQSRaRFP30/Pn95W/uXR3yA6fg6s/ZPESGv61iD779+oIde4Zp",
-	"HlBkMw0dxaImVGkfbtXxJHLcjJL3kJD3Tr6TL2EppHDfT97Jglt+vOBG5Oa4NqC/4iWXORytFDsJEVwv",
-	"ueXv5EDSGs2vFcXOsapelCJnl7FC0pIn5UwZjvDu3VtertS7d+8HThVD9cFPleQvNEHmBGFV28xnfMg0",
-	"XHOderQyTcQ/jkwpXfbNSkK2qsmyGTJK+PHTPI9XlelH/g6XX1WlW35EhsbHtbotY8YqHWQRJ6AQNLi/",
-	"Pyh/MWh+HewqtQHDft3w6q2Q9j3L3tWPHz8D1gmF/dVf+Y4mdxVMtq6MRib3jSq4cFIrYWs1zyq+Sr2N",
-	"vXv31gKvcPdRXt6gjaMsGXbrhOAGj3ocql1AwMf4BhAcNw4nxMWdU6+Q3Su9BPyEW4htnLjRvtjfdr+i",
-	"oNxbb1cvsHewS7VdZ+5sJ1dlHImHnWmS/qyckBXcKIxYobbq8yMtgOVryC994hrYVHY373QPnjpe0Ays",
-	"QxhKaUQhdZhUA18WFsDqquBeFOdy189uYMDa4A/8Bi5hd6HanBw3SWfQja43YwcVKTWSLh2xxsfWj9Hf",
-	"fO8Ohop9VYUgdYxWDGRx0tBF6DN+kEnkvYdDnCKKTvT3GCK4TiCCiH8EBbdYqBvvTqSfWp7TMhZ08yXS",
-	"GwXez3yTVnnynlvxatDqTt83gPnR1LVhC+7kduVTe1EEecTFasNXMCIhx487E+O0Ow9COMihey9506ll",
-	"/0Ib3DdJkKlx5tacpBRwXxypoDLT89cLM9H7oX+ZwIydHmGLEsWkxrGRmA7XnUc2SkE4BlqagEHLVuAI",
-	"YHQxEks2a25C1jFMzhbO8iQZ4HfMiLAvD85Z5GoWZWBrstwEnts/pwPt0mfDCSlwQt6bWLWckMPGSfjo",
-	"3Z7aDiVRACqghBUtnBoHQmmzM7Qb5OD4cbkshQSWpbzWIjNodM34OcDJx48YIws8mzxCiowjsPFdHAdm",
-	"P6j4bMrVTYCUPrsED2Pji3r0N6TjvsiP24k8qnIsXIy8auWBA3Dv6tjcXz2HWxyGCTlnjs1d8dKxOa/x",
-	"tYMM0rGg2NpLvuI9Mx6OibN7HkDoYrnRmugqus1qYpkpAJ0W6PZAvFDbjAI/kxLvYrtw9J50bccw1NTB",
-	"pMQ3DwxbqC16++DVQq7UB2AZhyOAEWn4W2GQXrHf2G1OwOybdr80laJCgyTjzXkNuYyJE1OmHpFgxsjl",
-	"syiXza0A6Bk72sTQXvk9qKR2xZPhZd7eavM2R1uIGkod/7EjlNylEfwNrTBN9pnXfYklaafoOq10E+9E",
-	"ImSK6B2bGD7SDJ+CDJSASkHWEaKyy9TLqdNtAG+c89AtMl5geh8udw8jTygNK2EstEb04CfxR5gnOWYV",
-	"VGo5vjpb6aVb3xulmmuKnhGxY2eZn3wF6Eq8FNrYDF8gkktwjb4xqFR/45qmZaWurxXl4BVFmjfgtJew",
-	"ywpR1ml69fN+99JN+0PDEk29QH4rJDmsLDBndNIDc8/U5KS7d8GvaMGv+L2td9ppcE3dxNqRS3eOf5Fz",
-	"0eO8+9hBggBTxDHctVGU7mGQUeTskDtGclP0xn+0z/o6OExFGPug106I3x27o2ik5Foig8HeVQh8JnJi",
-	"ibBRyuVhSOvIGeBVJYptzxZKo45qzPxGBo+QqK6HBdxdP9gBDKBI+waWoCFpQmg+kXd0Iy7FiQoxsruT",
-	"Ciex6aPG/64pLVyUTeWIaKJbGMF8asnxPW59LzupF7tLSdQuGM5aC2m/eD6kyMbG72CZshvnadP6uVM0",
-	"uoiP1C1KZX5gE8SI4h6TZ8Se46mECYU4hmTbxEAeotwL4OV3sPvZtcXlzD7OZ3czZKco3494ANevm8OW",
-	"xDM6SpBhs/MudUOU86rS6oqXmTf3jzEKra48o8Dm4XXgE188acq++Pr01WsP/sf5LC+B66wR3EZXhe2q",
-	"f5lVUTLKkQMSEv07DTxoUCTYR5vfZNCLnwiu1+Azpke6wSC1a/v8Ex1F/2SwTPtrHeR9/qWKlrjnxQqq",
-	"5sGqNabSe1X3jYpfcVEGK2aAdsS3Chc3LT9wkivEA9z5rSt6sszuld0MTnf6dLTUdYAn4Vw/YkqktHQi",
-	"fcIkZEX+7arLgh4YT1nHuOrjhdq2t+fEO/kbpTvM3zvWJ9++woXdZ4z3cnd7PI64GoUqHH3B84ghLbFf",
-	"V7+60/joUXzUHj2as19L/yECEH9f+N/RWPToUdIsmdQ6HJNApULyDTxsnARHN+LTqqgSrqdd0KdXG0Qd",
-	"+nqPk2FDofSIFdB97bF3rYXHZ+F/KaAE99PhAJrephO6Y2CmnKDzMUf6xkdiQ4U/DFOy7xKEMRyOtJDZ",
-	"bzimNiYr7/AIyXqDltHMlCJPvxnJhXHsVZIvgGvMsPGIcu1GrMWIa4msRTSWazYlV1cPyGiOJDJNMl1Y",
-	"i7uF8se7luKfNTBRgLTuk8Z7rXfVBeUARx0IpE4XGs7lB6YXx3b4u+hMcVrvvsyIQOxXmGLPgwG4LxsT",
-	"YFhoY2FvdaabOjDFMw4Y9x7nI08fnprJGXvd9SCYpsdMKQAXGJ3PLz4yR7KgmzDZUqvfIG23QnNfIgAz",
-	"JDIX6LX3G8TqWVzGqMNSGmt1W5eunf3Qdk/Xjcc2/s66cFh0kzv9Npdp+lTfbCNvo/SadJpAj+QxJSx+",
-	"uuh6to2wFjxekS8Hpq0Oz5pc0nmi6MOOg3T6VMahCMc0fnsqPcyD8I2SXy94Kqe304UcTNH2dh5grWKh",
-	"c9gA04To0ewsckBq2grKYFKBbgPQh9nQbqnX0LSTNZpWgUGKilWXOTmNlEYlhqnlNZdUC831I37lexug",
-	"FxPX61ppzD9k0m/FBeRiw8u0glPkw3fBQqwElfmqDUR1pPxAVEKRqMjX4moCTz1qzpbs8TwqZud3oxBX",
-	"wohFCdjiCbVYcIPXZfN60XRxywNp1wabP53QfF3LQkNh14YQaxRrdE8U8hqPhwXYawDJHmO7J1+yz9DX",
-	"w4greOiw6IWg2cmTL/Gljv54nLplfZm2fSy7QJ79d8+z03SMzi40hmOSftSjZKoWqtM6fjvsOU3UdcpZ",
-	"wpb+Qjl8ljZc8hWk3Qs3B2Civrib+PrSw4ssqMigsVrtmLDp+cFyx59GQpYc+yMwWK42G2E33iPAqI2j",
-	"p7ZIFE0ahqOKhT6/f4ArfETHmir4FfRsXZ9YjeGbEZdjdH/6gW+gi9Y545R0qhSty1uoOsLOQk47LHjQ",
-	"1Dkg3Li53NJRlkQPuCWrtJAW7R+1XWZ/cWqx5rljf0dj4GaLL54nCgd0c2vLmwH+yfGuwYC+SqNej5B9",
-	"kFl8X/aZVDLbOI5SPGxDBKNTOeoBlPb1GHM42T/0VMnXjZKNklvdITceceo7EZ7cM+AdSbFZz43o8cYr",
-	"++SUWes0efDa7dBPb155KWOjdCpRbXvcvcShwWoBV+jwnd4kN+Yd90KXk3bhLtD/sc/VQeSMxLJwlpOK",
-	"QDA67Qv0ciL8z9/7osQD2XvEOY28z5o+nziALWm0JAmtYzZ78ivTTpNEafTRIwT60aO5F+Z+fdr9TEzq",
-	"0aN0+rak4cj92mLhLnod9k3t4VcqYcYJtVKaJ3QfpJYwo42xWvfBHeWFH2rOunUpPv1deD/uz2kXl/Qp",
-	"ePfuLX4JeMA/+oj4g488bmDrxEcrGSGUqC5PkmSK5nvkXMfZV2o7lXB6nDQQz58ARSMomWhkwpUM6g4l",
-	"H50Pej1ENOpGXUCpnKoUp1SPrdL/Onh2i5/vwXYtyuLnNsFG7yLRXObrpGvSwnX8pa0P3CyRWGUyS/Oa",
-	"SwllcjjS0H4JmlxC1/yHmjrPRsiJbft1r2i5vcW1gHfBDECFCR16hS3dBDFWu7kLmti4cqUKhvO0KYFb",
-	"5jgsIBdVtflnDcamjgZ+IP98fLJxzJeKqjCQBdpwjti3GEXsYOnke0TbSUjI1U1OU1el4sUcE4VdfH36",
-	"itGs1IeqXFJRlxWaDrqrSNp6pyfraQpWpqNQp4+zPyzOrdrYrKnBksrz4Vq0VWJEzwEAjQoxdo7Yy6iY",
-	"P6UEcUMwzBOnN1BEJV9Io0CacP+xludrNJR0LrJxkp9ejShQpYlKojelTZsU4HjuHNy+IBHVI5ozZdeg",
-	"r4UBjDuCK+imFmny7HhDXUg10l2erqUkSjm6gUzRJPy+KdoDcCSQhBfOJGQ9xN9QTaZiXjctznSOvZIZ",
-	"SfuVnga10ClRRVOy8vtQzZ5LJUWO+UBTAhGmQZj2ZjIhdWr6scPM/AlNHK5kfakm4sFjcbTiVGCEHnHD",
-	"98foq9tUog7608LW1x1YgTWes0ExD2XSvHVeSAM+pbsjophPKp3wsEiJHFnzmntDMsII5xFzyzfu2w/e",
-	"GIehf5dCotrt0ebFbLKfYwV763R1YdlKgfHr6aZ5MW9dnyPMeFLA9v1RqHiPY5BPj1s2ObANhzoN7mze",
-	"fcy1feHa+jyUzc8d3xSa9LSq/KTjRfTSlUO3chTBKSeK8KodIbcZPx5tD7nt9UPF+9QRGlyhCw1UeA8P",
-	"CKMpKNer3upUBKIobMHIGz+ZjErIBBivhAzvOekLIk9eCbgxeF5H+plcc0si4CSedgG8bHxm+gzNWP8g",
-	"eNeh+lk4HUpwjWGO8W1sa+GNMI6mQSu4cblj4VA46o6EiRe8bPw4E5XtUKryQlSBwaG9WncpxuEYd6im",
-	"2b0ADhTQnbfdMSXtTW+isXwfi7pYgc14UaQy7H+FXxl+ZUWNkgNsIa+bTOxVxXJMb9fN9zekNj9RrqSp",
-	"N3vmCg3uOF1UPDJBDXEBy7DDGE+82OG/Nylt3Hhw3jiiI7hrFjdLcjmMUElJvY6mMyNW2XRM4J1yd3S0",
-	"U9+O0Nv+90rppVp1AfkjjKQjXC7eoxR/+9pdHHESrIGzLF0tTY4qdExVoQY6qo1NdpUuV8KrbJBsH59g",
-	"m5LC+80Q48WB53j5jURRxSZvul/JDDwWS5WPhv5x65MQWM72sqDRwG5yXOwZ0YfvGWPOiuSreH/GZ7/W",
-	"vQgNfuRDgL4LQSqs4sI7rLTMYohZ7+Y7DPec4kfbbnB/ET5kb9Q++t3VWHhdyHmL3/vFQy/BZyaqNFwJ",
-	"VQdXkOCQGVRC+rVTirMJcEyuP+nm/Ecbn0dN5Re+iBMt0+vk3/1M7rsMpNW7P4HhfLDpg7KkQ2mXzFNt",
-	"E9bU/5hUD6RzK07JB51KPexlw05h1ANlXQdk9XKKODAs0zqfnRU3ujBT6atnNErq2KWLro5n92wzeuIR",
-	"q5QRbRmeVDXWiZ7PF1hQNcpOOhwreMRdQW6x9lLr6aMBbpKr1E0W1Xf/f1k+R9TpxkHcJ/fcl9FzWHDp",
-	"wB0/CLqPEkdQsZqj6fkrTxt/TgpHueYGsz1TifVuAOfkMLLlEnIrrg4kOfj7GmQUQD8PdhmEZRnlPBBN",
-	"UAXmyLu51bEFaF8Ogr3wRLmq7wzOWFDtJeweGNahhmT1nCai6Dbp0RADyB0yRyLKpPylyJDsXViEaSgD",
-	"sRD8E6k7tIlmRwtvRik7bjlXIEl3cbRpPPZMma78N2ku1/VGyW0wPmAsD8KwcNi4/vES67SZpih2SK8W",
-	"a+nsbJiE+tqnZ8OUFM3bSUjUBib8FvLP0CyluIS4NCi+VF1zXYQWSdNLsOpke+6jQfKCUPSqD/SymVm0",
-	"3uTDt+pEWlMMzMhL5cSIbCy6pevA3Xg/PTDkpkZVdtA13cG1BO1LKKP8WyoDmVXB+3wfHPtQQb54t0KC",
-	"GU0lTsCNJvh702YwxJIKHBP6ce+CFy+QadhwB52O8gyOz7kP2S/oe4gIDin1D1qYGno9XNspxBEIM0Bi",
-	"TPVL5m/Lw5HGtzE2CSlBZ+HlqZ90UILuvoZUWhV1Thd0fDAag9zklJ57WEnSTpMPV9nTEaKI3UvYHZMS",
-	"FIpihR2MgSbJiUCPklX1NvlezW8mBffqXsD7Iy1X81mlVJmNPHacDTMl9in+UuSXUDB3UwR/25FChewz",
-	"tLE3r9nX613IDFhVIKF4eMTYqaQIh/Cw3S3V0ZtcPrD75t/irEVNyUu9Ue3onUy7imNaUX1HbhaG2c/D",
-	"DDhWd8epaJADefi2I1kaNb9OlO08mqqVD5+a+6UUW6IiKFIyyTm9WL3Ag54yHGE8dpQ4AB8yOfMvXcyU",
-	"KuWSeZuYcTdUGlPxZAiQBTkldLmBwg+eREBTJvGAo1DjI9RWmGv9hIbiUVmq6wyPUdbkmU0pXa6d6V4T",
-	"IbV+28/R2wIijyNuvAixY2tesFxpDXncIx0WRVBtlIasVOiAlHobXVonEW4wFkKyUq2YqpyiT/mawytS",
-	"sv7hYK5aSo4XOkT+HkkU8DxH7VMx34c1faZOeV/lJSn5CS06o1e2EZdIMD7ZiccQNR7Cu6fC482rR16s",
-	"E8YyxFwgkBuXiPREfuPKbhGYEw7XYUPhaaoCZndd/VqsY5WRrdqIPI3ufy0XoVHHnhT1JrO+UHEFitPF",
-	"ZshTYj7WvAjj6RmiGSRflMn7wR8//zKGdO7+i2JDf1y2BM/PRnjo8Eh71p/loxdUDwCElILHbK2pIkN8",
-	"fTR1XtWKgk3xXa8P6ESGg+4Td4PNjXDvQFm4E1ADl60GwM9IY5pTdh5y/1qobfj+sE3fcyvgP+6n8lQV",
-	"28QpbkjLF9kNof4jHCHpVbLfiYMqmy+munI01XMmMv8IgHHnjg4Mk1w8bgrGkosSiownkHzWKNbzSD3w",
-	"YQH9mmjCeE6eczKsrYG5sWsNPvScSpr3aqhW3JGSapoPzV+ygC0YjAunQpDckLE2GI19PfW+BqOqrIQr",
-	"6Pi8+Hj4GqUQcQVxLXbqzAqACp9Q+op9ypkjvst72p5fexa5A0zBblL9I8TSTrEDul1SE93KjI6JmXqU",
-	"HERXoqh5B3/mDlWpxwtSD8THjMREOhBTpvmJRngTBjgN/VOiTMDE+2l86MYsKI26fQzooHMXnqjkqZdp",
-	"36442UNjFcbZiub1iEi85Rum4tdy3IoyJPlWEp9eLT5C7NdbyFGq6Tov3R0nDAdjppfIZVQE180O394a",
-	"94fQ8F4SHh0vpWoYQAbbKmOtrTyso6GLuGQ9VsGSTux1UjNWnvD83/O/ORbupYGcCkiFMOLK/C8hPHtg",
-	"btnG4usFWtFcaMFJa+5Ti/X1RxG5p274jimN/0hl2T9rXorlDk8ogR+6MbPmjoT8Ows9AHqnLzfxfsFk",
-	"HgALKqwKU9G6xdQxo+F2bpQIaHcFMqW9yX7DLyHeBnzbJM6TW8dyTL3YCGPwsutt5xALfvEhPHzDC4hi",
-	"STBJVbcCWUhb6Hr//23oSzxVyC1TlTxvKwobvulZFam0USAuu4bN/tiooXocSKApl9QSrQ4xkQWlLiH8",
-	"NXkKUBLB/yyE1Vzv9nhqHnz+Tjkco+R8COxBGRkUw+9tGTepa9iGl+6JKpu0lPvehamP7AOg8aUuJPg5",
-	"AD4lZgvJgD4F/pP548aWMQX8PwveR6rvxPBSoZ1PgOVO3HQCVjIBLtQ207A0h96TyQboFGHdRlwHJwIh",
-	"cw3c0AP72Y9eZWvTownpVEhyAWueMJpRClgK2TJLIatutXvPrjFLmtxFCIstqYjWEYv5mJTgxLArXv54",
-	"BVqLYmzj3Omg6h9xeupgPfZ9E8p/c6cOBxCm1X4wHAvacJ+ombvAC7FcgibvLGO5LLgu4uZCshy0u/fZ",
-	"Nd+Z25vpHbS6dvLFAUM9j6SZbpBwZLJH0iZAyp1/A7qjEb0BkN+jNX2CFRzdABMWcDKKWDVi9B7CkI5N",
-	"59usVCsM0hkhQJ+HDp8pSFlREg22JA/dbB4jfoP902AKXn/wrcJZp0yx/5z9iKhDhecnKezek0bWtH7U",
-	"FLm10UEI9C9XrW8tbc6Q/lOBbhdUXD8OduvXqg17TW/sNB+M1N7pWnBHdhFfGX2UZGyuNdNfMjoPmalw",
-	"OtJhM9RtzR7vWTBRdf/cez8MjT4DpZiQMvfBiDe0CZElOdwDI+BRgTt/trrTNi/Sbpzpskb0/JqGqFJV",
-	"lk9xqaIs3YU3aHtIuzCO0Edkrh5Zd/P63NZc7mSH6CSwJ0n5NuJuL4H+oXeZKt+nZI8ZNEY4aNdYrpbI",
-	"y/AIkxkHHeUb48W8H8LRNdg0TIJxpiGvNRo0r/nucImRkeyQ5387/fzJ01+efv4Fcw1YIVZg2gyjvRId",
-	"rduNkH07y6d1tBksz6Y3IQT3EuLCS1mIWWg2xZ814rYkuclkgZKbWEITF0CqFPWwNMSt9grHaT1n/1zb",
-	"lVrkve9YCgW/z55598D0Ak6l11/Uku3nGe3DSDjuCX7hhP/EJRW29hYLHLPHjgeX3oYeW4Psn4YKE9Gy",
-	"90Z7zXJ/D4pLSpm3q7o3CbRh5GSCPBCAkZCoTjBLXJSzTfqnybaLVuDwYNa/xL5vH9IO+u4iJKHDAfDi",
-	"GKe2XeNu6sH5g7Pnfd8gJVrK+zFK6Cz/UNiUX2D78hhtkVd1rQUqkUw5gLr7EsXEmRdNqNmIbDuISMMK",
-	"nE6/KctEJBtp33imYsJxgqW+4uWn5xpYmvUU8QHFm3H/9TicKUYyodLcLpnSKz5p7ih06f6mlq8xeu7v",
-	"4PYoec/5ofyj4+A2Q9sJL8nTcOkjkd2Q7BrHJKeSJ1+whU/PXGnIhek/ZtKLk4/Fwugd0GLpQ+Fgaw+E",
-	"Cx1a58/K3oGMl8HzgP0QPUooNP60ELZH9A9mKiMnN0nlKeobkEUCfykeFZdzO3BdXHZi8ltZPLrRlIZ7",
-	"js2PsuzcMDZ/WKhu6vIo/txdOrWB4Ton39Yd3CYu6nZtUxNLTM6ljAX2p+SDSOc9dt0xIcW9JEC+Ufrj",
-	"3yEVBeHIj+HnTVHMz2PJCSkB30gezN5+1KI86GbQyWr6cT5bgQQjDObt/MVnG/+0d2mAgMJjh0eVYL1L",
-	"TD8hJrHWzuTRVFG+0gmpSn23RGJSDD3Jay3sDivNBTOM+CWZNOPbJgDbB/A3LyD+7rPqEppqn224dm3C",
-	"7fqt4iXeR/QwI90tpMoj9vWWb6rSGxXZXx8s/gOe/eV58fjZk/9Y/OXx549zeP75l48f8y+f8ydfPnsC",
-	"T//y+fPH8GT5xZeLp8XT508Xz58+/+LzL/Nnz58snn/x5X88cHzIgUyAhjS6J7P/lZ2WK5Wdvj7LLhyw",
-	"LU54Jb4DtzeoKy8VVkJySM3xJMKGi3J2En76H+GEHeVq0w4ffp35jP6ztbWVOTk+vr6+Poq7HK8wPjOz",
-	"qs7Xx2EerE/TkVdenzU+yeQ9gTva2iBxUz0pnOK3N1+fX7DT12dHLcHMTmaPjx4fPfHFECWvxOxk9gx/",
-	"wtOzxn0/9sQ2O/nwcT47XgMvMZ2B+2MDVos8fNLAi53/v7nmqxXoI3Q7p5+unh4HseL4g49T/bjv23H8",
-	"MH/8oRPOWxzoiY/Kxx9CSbT9rTvlsLw/T9RhIhT7mh0vMIH81KZgosbjS0Flwxx/QHF59Pdjb/NIf0S1",
-	"hc7DcYh5T7fsYOmD3TpYD/TYiiJaSc5tvq6r4w/4H6TeCGjKh3Zst/IY39+OP3TW6j8P1tr9ve0et7ja",
-	"qAICcGq5pFJx+z4ff6B/o4lgW4EWTiykHAT+rbE5dGfF7GT2ddToxRryyxmWl0HPLzxNTx8/TiSLjHox",
-	"Otx8UULhTubzx88ndJDKxp184alhx5/kpVTXkmFqMeL09WbD9Q4lKFtradiP3zGxZNCfQpgwA3IXvjL4",
-	"wlAvSpHP5rMOet5/9EijVDrHWFBl1+Iy/LyTefLH4TZ30oiM/Hz8ofNn96yYdW0LdR31RV2LDAXD+dzH",
-	"2vT/Pr7mwjrpyeekwLpqw84WeHnsE9D2fm1zvg2+YCK76MfYcTn56zH3CJxVyiSI8Q2/jgykp9iYRAww",
-	"9iuFvHrma1b08iUcb7OFkEgXH6IK9q2IRR+HOtrgrnIaJ75IByvVMJ4UQwe14kXudH+rQi7nWSwPWV3D",
-	"x+RhwkPyeM9a/B00sRJ/N+teYkVf8YKFiMuMfc9LhxUo2Km/yDtLoyP85NNBdybJqdIdWZJlPs5nn39K",
-	"/JxJJ3bzMjAZN/2zTzf9OegrkQO7gE2lNNei3LGfZOMXemv2+A0Sp+b5JYpcDcGSE4Pm111XU50OE+ym",
-	"KteqXlEskt2yNZdF6QOrVI21+hxloVVZRa9j7loJqforpREAyoECBQWvmyN2vg6mJqzvRE7NWHHkCkpV",
-	"odkHM3vRJFxiLm1cTczeu1zd6ZDuEK9AZp6NZAtV7EL5W82v7ZZipAa8qqljnPzYl7lSX73MMdIoeDGF",
-	"z63+Feszs5O3kSbz9v3H9+6bvkJ3i7cfIvH85Jjqmq+Vscezj/MPPdE9/vi+QVio2DKrtLjClKTvP/7f",
-	"AAAA///Cen75tekAAA==",
+	"H4sIAAAAAAAC/+y9/ZfbNrIg+q/gaPedJF6p2/maO/E79+zr2Mmkd5yJj+1kdjfOm0AkJOGaIngBsLuV",
+	"PP/v71QVAIIkQFHdipO5d3+yW8RHoVAoFOrz10Wh9o2qRW3N4smvi4ZrvhdWaPyLF4Vqa7uSJfxVClNo",
+	"2Vip6sUT/40Zq2W9XSwXEn5tuN0tloua78XiSdx/udDi31upRbl4YnUrlgtT7MSew8D20EDrMNLdaqtW",
+	"bogrGuL62eLdxAdelloYM4byu7o6MFkXVVsKZjWvDS/gk2G30u6Y3UnDXGcma6ZqwdSG2V2vMdtIUZXm",
+	"wi/y31uhD9Eq3eT5Jb3rQFxpVYkxnE/Vfi1r4aESAaiwIcwqVooNNtpxy2AGgNU3tIoZwXWxYxulj4BK",
+	"QMTwirrdL578uDCiLoXG3SqEvMH/brQQv4iV5Xor7OKnZWpxGyv0ysp9YmnXDvtamLayhmFbXONW3oia",
+	"Qa8L9m1rLFsLxmv28uun7NNPP/0CFrLn1orSEVl2Vd3s8Zqo++LJouRW+M9jWuPVVmlel6vQ/uXXT3H+",
+	"V26Bc1txY0T6sFzBF3b9LLcA3zFBQrK2Yov70KN+6JE4FN3Pa7FRWszcE2p81k2J5/9dd6Xgttg1StY2",
+	"sS8MvzL6nORhUfcpHhYA6LVvAFMaBv3x8eqLn379ePnx43f/5cer1f92f37+6buZy38axj2CgWTDotVa",
+	"1MVhtdWC42nZ8XqMj5eOHsxOtVXJdvwGN5/vkdW7vgz6Euu84VULdCILra6qrTKMOzIqxYa3lWV+YtbW",
+	"lTAGR3PUzqRhjVY3shTlksma3e5ksWMFNzQEtmO3sqqABlsjyhytpVc3cZjexSgBuO6FD1zQHxcZ3bqO",
+	"YELcITdYFZUyYmXVkevJ3zi8Lll8oXR3lTntsmKvd4Lh5PCBLlvEXQ00XVUHZnFfS8YN48xfTUsmN+yg",
+	"WnaLm1PJt9jfrQawtmeANNyc3j0KhzeHvhEyEshbK1UJXiPyCNwkyvacGQETA+iVNNbLFm6J7q//8eq7",
+	"vzEtTKNqwoAWttX1kpm22MGSfyZ6WyINmAtPMj9fsL8JA2PHKON7UdI+lQrZNLAzs3T0xJsG0KmY4MWO",
+	"iUrsRR3A4lrzA1C0AIzzG6GNuGDX21ppmERp9q0whm/FC168DRBn5SKHmWmxyLOtMfrqjdy2Whh2uxN2",
+	"50SGgCa1/jdRWDg1iL4BbKIuVCnKC3a9YbWy0clyRxFJEHpmgSe4UjLSvxkFR2pvtg0v3qYFokruZWJV",
+	"3/I7uW/3rG73a6EB7/4GDtueA4hGPHKS9/xuPOlr3dYF0mA3bU8UhsMqTVPxAyJsz+/+9fHSgWMYryrW",
+	"iLqU9ZbZuzq73TD3cfBWWrV1OUNKtLCnkVxiGlHIjRQlC6NMQOKmOQaPrE+Dp5NdI3BkfQQcWc8DpxZ3",
+	"CZoB5ghfWMO3IiKZC/a9uxvwq1VvRR0Ina0P+KnR4kaq1oROGRhx6umTWisrVo0WG5mgsVcOHcCfqY1j",
+	"OHsnQhaqtlzWomSyJqCVdYwwC1M04fRzcSwErbkRf/ps8e7Y15m7D7yvv+uTOz5rt7HRio5kQvKAr+7A",
+	"pgXTXv8Zz+t4biO3K/p5tJFy+xou642s8CL/N9g/j4bWIBPoIcJf7UZua25bLZ68qR/BX2zFXllel1yX",
+	"8Muefvq2rax8JbfwU0U/PVdbWbyS2wwyA6zJ9yp229M/MF6aHdu75LPsuVJv2yZeUNF7968P7PpZbpNp",
+	"zFMJ8yooC+J32+s7/5Y7tYe9CxuZATKLu4ZDw7fioAVAy4sN/nO3QXriG/0L/NM0FfS2zSaFWqBjLwfA",
+	"2Fcvrl8DIzIv3a/wI5x9Qc8vGE4WHLB7iffok18jyBqtGqGtpLGQo+H/pBV7/M9/1WKzeLL4L5ed1uqS",
+	"uptLP/XiXQATBRo6bOF0/OjH7VZDsgStJsF7UaK6enFNLDaIbbUqBczlFFFX3crOsHbeNKtKFbxaGcut",
+	"OLr2bujn0OsVdoJHDgnOK940J4zxAoRlM8EfAS/4CTkjcXoUs2VNdAunRxqmRSVueG0vFssUG4p3hWaa",
+	"syl5hDNquBaG5Flq+IFhEeoZopUhWvEJs63UOvzw4VXTdBjE71dNQ/jA94aQKIuKO2ms+QiXzzvmEc9z",
+	"/eyC/SUeGx9vChSSa+GkK7gON+6idhd30Ea6NXQjfmAYbieo9yK6M0bYc1Acvip2qgJB7yitQONvXNuY",
+	"zOD3WZ3/OUgsxm2euKAVc5ijVzH+Ej2HPxxQzphwnILwgl0N+96PbGCUNME8k5vNOeglp3J/3SHHQ3Ux",
+	"0nGBthQftSsUqcejvHnzI1yFb978xKyyvIreLpGCxcmSYTrrSMaqFDmEOelZce5JN1rtM9N2eM0hLGrh",
+	"iD3mU0rHFFHseL2Fx+z6MOQ4i+XMy3LEQ5/ioOPL06m1c3DjNwexPwIT0HoyPxVO6JeHcK3uRAZA/OTg",
+	"QwVdB89OVOGdFDaTlHIRUt0XBB9EgVNB/1Ld5QEHkknDvZHaeMJyAkcpN5s0fVmVHqTic8cYcMrOpIUQ",
+	"4gzD0zM4wYFOBuTud2e2uCWs2yKAmYcNYGthb4Womb1VtCYTMbXv6krWohbG/OasjT4GnRnNn+Rwa17x",
+	"uhCrozfc7U4ZATxe1gJO/FsgWSG3ZAq8UVaE+cK7c0wFopJbuU5ZF/8eadA8OmlUWXejPmESFWoODm7x",
+	"k1sENVk6DTCrVb36RWhF0OKl13BtZSEbOjZvxQE14rLszRFBHpSooEPVxq48/FP4io9Fp4ypuBXGovU2",
+	"aM5Ha0XxjdeHNPJoiKmp3SS941SJcusUau5FkB6dUDpvY6Z3II1Ap59bucdcEv7rZx5O1xr2iGmxlcZq",
+	"2jQnUcDxFKW/enp03mlEtNonMNoRfxhnetW9xTFU1Bsbb6qjO6PA0kJfkoCR/YSmTCPp6DHE5feoOB6U",
+	"yTq9uUBdKyJhJPhZDB1bSnsIezI+PYMFHqGGIUAVn4anfzf8xuAAbSKnSMMSc74586CBbC8LrUBcM0um",
+	"xS3XpfGeHuVJV5zX1vXZ9YAlxAQdznPEcwfLnHvbddTVx3j/6pM147Ty6Mq710U3Q2CZeA/5Kdmt5g09",
+	"NNwXUgMSnK5R/OZ4HZkbznBBG1kX4vgxK9SN0GIk48UaPlmX4i5BLWlVXCtrS3rjaIwTNFQjZBzVVdFK",
+	"B/PNJa6BkactdvRAjZh5oXSwFkjT6bS2WqBp0IF8FqnKDTUfXQMgQCXTmpQYfZSze57iRzx5x5OahGW3",
+	"prlbEiBg3Fqxb+yQr7q/3V542Yls+jbiAX4cQsoZtqcRWqoMEunbHCzSxd8owyuzMkLU6QG7d3UpjZV1",
+	"Ydm6UsVbFjoz6JwTHaPZfvutXy6MFU16CvgyEy0og55O+6+saH7ArsdYRbinaCMd2KP98JDMpViDBDZa",
+	"ZJ4yO5r76kach3W4SU6VzvEEOfnByn0QLdBGsmRg+VHRa0EguOxWaOG8U0TpPXWCSZlJIM37UBENP58C",
+	"BnhM8b173oPdyLTme96Cmauqv1lh3XPpze2C2Ev0XlkfhgqZ/KX1QAvMzN1Iykfd51g/jVAZI+y3wvKS",
+	"W/6D0KDuPZuVKOtwGnRzTJaitnIjhb4HzTq4VjtudulJ4EtwaxIWz8zerXbJAJOt9Q5J0Jamk3aHqtLO",
+	"In+wIvWK9ABUot7aDAhG/iLyIEgw41ph7nNitVY69Xw90DzeGO4nYxsuK9As3u4EcccboUtJbkptrcHn",
+	"iq8rVOe2tWmbRmmwmrS6Sj6h+/iawH9ow+INY7fc9HdgycyOf/L5nwAAs+Off/zJPz75/E8X7LuacbaX",
+	"Zg+uo0unBqKmScD8gifoQtWrYsfhmeaRE1MKkuYsAmh1lZ7g+5fPR6ONejv8Z0BsbaG6W+EmOpvow4DY",
+	"eNLfYfxNmP6PsLIL7CFNqlOphKk/sNR53BURvucHci9do46T7xuh3a7h0LUCMnnSrRe6sloBHnyD3rYk",
+	"mo4BHlAh9RlilhUcoF93p8tdJKjvomECbT85cjSMEAzPFeyX90RAxCyWC4+/xXJB66X/9MltuRhAjb8E",
+	"ANIOIL2Xf+dtT709lcy5or7LE43/TW02Q9p3z3mYONwJ57+jaPjE7UQXQf9e+hLk7a9lzStpD2e4i1B+",
+	"X+0EL1PmPJyN0VcGKLlYDJGd5sbY8RsaFe4DoVNu/EEqhe+0ISI4bSFkJ833tBtlgc6c251dxQtcNVqp",
+	"zbENeQ79ogW8wE74oODo2jZjDPRDcB0HdNzDuEPNBLD9aRO03jPcXHr31v+z5f+Bt3zMKtw73G2bVVtS",
+	"1Ye4NGRntRAggFtF/O/ApDVs43jJReAu33CzOxdn+SYpafRoDG+1xTHu3402Bx/fOKGF9/DSLfFcy3vf",
+	"x+c7/A+veqeHhoVwDGmcGSoET5adUEszuYcwEMGePO8Z8Iv7H7rUPs3ao6/I2d/tkFtE2KHXd7I059om",
+	"HCy3V7Fe+fqZ6TkKjCTTSVVONNesZ7NqWCVuRDUEgRTyjhkCQtTd2aWOL9VdCqYv1d1I4gA/gHPshPf2",
+	"mKVH+VLdPXOQKZ1SooDr+wpdO8cb+71xgS8N38oawXOvuz1/S8p0hfwRdk+YEGhCigkctGOdzonfOcbA",
+	"q6s64BGiAZX23ipa7HnP2JhjZbMdK2A3ar4XxkuisTpjGYUMXq2Vvp9kOrhHatYFQjIOo0aGoeVQvwdN",
+	"22blGEkiGogaDAbqYs+n8TQcPoWxHhb+ImqhuRVnINa5+ukOW/dQVLRNpfgRQ3u0HRtZCVRJYDdRMlUX",
+	"YNOT1oo6ZTvPaJrdtHM1exEEQTsLk7rnNEJFwlK3E8/5WlTn0CBPBAEPYKtgyqQ24Sx7mUNm1+k+CEWg",
+	"2dbRbV836nxNg4a+w+4ry3+D024sjw7pA057f6Df4rS3zdnsZ7wgEEgON8fsXtSKleq2pkN4H+3sfKJe",
+	"C7itCt5ud5YMH0kKF8bKPXpyG8u3YgX3aSVgyEwiAZgmdCIjS2wVwlGYG0UYxi0qZI0oVF0ahiYD7CAa",
+	"BZpHcWc1b1SFo4GLEb4sGq226HNnFNtwfcGuyUvKmQhCcNZOaTelcS51oSceBcv2gptWgx6K12DbsbLy",
+	"HkVbAXe66GajsGTn4eX3CQZaHwAK7FepeiuMm/QeO9hoVQhjwHM+so5P0Y1vF1EOriWM9CAoUFN+lHQP",
+	"kT/i8Fo5Dxxvb45C8dcfzooD3MHMMeoRc7zutnniCGQVCMT/h+LjST/YDxmgLwD/CIdLZ8d0j/nEoEG7",
+	"Me7sfaPws5nsDFQuCoFegNLSaTC3EtTTe3XjwMW7wyr6v7h1K431trKGt8aNWCwXAzR07lP9lSyWiwF4",
+	"i+WCZk4obt22rPAimOBAGb6D3UQ5xXLuRykzgYmvsbODgQEKp7ONc4ibNPVJ95xVgQzvPeFMpnCOFbpj",
+	"ew+27Hs+ZNKTMHuOCWdi9t5TpSQ0nyKHGG/vWCWO/Yjek3dnauNi6hleMQMUjG/CAa0vR1LeeNdO8Pzc",
+	"+kACHnNxxzZQUlf7RlbneIamDbUQB//pJ+zVN1fOFAzAIGB87675D134MTP2UImPks8idAlPj/6nz3wu",
+	"jv64qXGManUh9jzha0U5PuhgUzMG7VI2jJjQnL3QAThrZwToRAntjLL/+I2oJK8LcTafplPdgTACqg/G",
+	"UT3iia43ZO2ldG8oEhQVv11jPhUcKO968xTjP30E9hmwQ+Hrv2bCsT0poIIt+ZDJ6PNgAIoCi0dYUj6i",
+	"4NP2P1cQ6Le6enG9wvUErf+xpydC7Sef/Yp3uY1ChHmH0L+L9U6pt2fX2bpxcxBRTAj5H/iWy8UzaYBA",
+	"9uuzMKQc0yi7WUrmTmMpjmL+1CPeTXOIjvkzfdDtOcg3eA6lwmKsKlS1uhHaSJWg0ReuBXMtfEhvM/yd",
+	"oEUvH5gbiait04QKiSJO8FSnoV/f1R1uphkNrjexOjfvnH3pI98njDGsEXpl72pWinW77UV/o4KAsxI7",
+	"ooHja4EpSM7BnzduqNk4c3MfxVUYeLZH5F0jtNyL2vKK+d7DLBRfoxH4JTJoWW/PgADDQWtzwvojCIR+",
+	"hb2PIsNPMhcXrr1f/Qbn9PcSmnf+Isj+/lruxSvwo/puszlPhgSFAyUuFbkXBmZi1CJ6583Q/7pR5yBg",
+	"eDa8k5XNA+Aw8upQF5hR6Le1aOxljenNzKEuouQNXaTkWZM05NBBU31gEuAAOp7jZ3SyeCYqy79WOorI",
+	"+YtWbXP2C3c459zlcLcYF/JYQl+fOkLW26qfpngLsF+k1vi7LOip5+BuDQg9UmTSS+bLti7PIlqM3WFO",
+	"9dr5A3sAJRZ3ZgcgHOyYGxAOaDCIyVg8eIpJ8rpIIuD8BJhG83hB+IEe2eOVEcBqu5X19pWwsJJzyA4g",
+	"XYIAu7KYYdPqw6rgVmyVljn1evcd7zbfLwSlUPZOLdCMYVyU0Vz3ElDP3oiMI3VFyycPkqVPgd7wWhZL",
+	"tuGWV0vy2F2yW67rJYpg+DxEiSwpbKrWNq1NGqRdOsdKbZk03uj8hJmDqdR26YKPbRdTAA/xqIMWpdQU",
+	"MWvVkikdkuv6m4bm7jTYTv1KrtQpYLtNEjVu27QhnQYVdWlG2zTDdk4bETCUmn15hH7mykp+Y40j7KHI",
+	"+K3YK304I9mveVVxY49HaexxZubaT8ZovFsutsWqEboQWTOnU/r/5bu/PKXX/ZI9Jqca/EnCyjOZUyp5",
+	"I4BlNseBhqbANpqlB9ynGgZzYsAufthyvSbLZ1WJgtyGphdJKFll8sb2l/ntV98+v/72+rVf7PTILm9/",
+	"WmDDWbsRlh2Fc7lHtX1rxAX730KrzgEQv1eCe0PRYLVKdyTHK1WLGVKfA3IZaKi37QP0xNs29zA4ksuf",
+	"Bb0VZ45S9y/uFDB6K0pMmQl8LJqW+C+wMkzQHKJj+zHrxOd0SRzp4IL9eNMIrv1n55HWuyZGqT5rUb6+",
+	"q4Pjp8/3X/Ba1bLA1Ns+lXIcrOMyIM/Jd+kmOSHkPacwONk9/f/g/6z4f7c8CZMoWv1NleIBHjb9+brB",
+	"OuUQYDpWCfG1ai3j7pLGxmn/oym3Gcdoe/5q5PA8dqNJBi2Gjqv7eQXhdFRsoNKClweKClNrl0I5ir+C",
+	"m6fh2g78EpI3QQTXAxxPUOtmJ/DUhbGFWZznzoOBnWGofCsOK7wXDfvwrz+Yj34HeOeY5of5BQN6g7/9",
+	"IMC+ZzSdMf0UwQ0nj8mOa+JdQLXMquC8lUPhSTjJ7t8QotEuPhwt97fpn0BBfpKHEdAphvmH0PtDoW2b",
+	"jB+Mc64EzShsWM1r5RWS6eRrxq6OsWVoFK/FwAoiTpjixDhwRmH5nBtLWdZlXWIMiukEeOzDXMKMDMBZ",
+	"Cw6M/AN9TI1dqNqI2rQmWHJCOGtqDRifkJ3rb+IuzKU20djBXEQy/LGRc1iKxn/p81+ElCyM2yh/BgyX",
+	"WBzmr8W6I0lU9oDoEDEFyCvfKsJuXCQkA4g0HaL7FuxUtjhjVdMAt7CrON44g6ZX1PrKft+1HRMXj9QS",
+	"pRLkk+raBzc7nIF8BHfcMAeHDzjxbiNJmOEwrtC1bDVF+WgagVbxETh6SNtmq3kpVqWo+CERKkOfGX2e",
+	"GgB3vLMUKiuyCXlh0ztK9q7yE0OrVcizMxhJuYyXBRxBEPA7AnG9j4xcChw7xZwcHX0QhsK5klvkx8Nl",
+	"01YnRsTbkBLKeXpAkB1HnwNwBg9h6PujAjuvuifDcIr/JYybwLe5xyQHYXJL6MY/aQGZMAHnXhadlwF7",
+	"H3DgJNvMsrEjfCR3ZDMxC9819voc7gmUjGGF9qL0ZYsZgTodrDaWrEuO3dMA+NHIfVu5yLipBKvQpdUi",
+	"H/VBnifcqDqaFHrBIaDJ504bZSqR9crldUxUY/I5vYOl0DUd5qDE+CiogwQ/IihUbAtxfi/Xy1m5SEe2",
+	"PZecat3KypLPNmFBwE18DyjsXU1EkJPKRwCgq9Ra+Ac/wtCuXSCGrEkpMjtFNtLz0Pg6O9FZBH1/o++R",
+	"H9HjVzV2kCNR1rBkpZlqUTB2Gc9h5b081++WixdxCs+nO15Vot6K3zIhtXfOTKZsZWsB8SkmF+wTTJFj",
+	"zPzw8msy8YWngF8N28P1FuyARjj9Nkx48aZ+Uz/6m7LiiSvVYFjfCfTi0ZycP2HQVW9NkN04DW4HxYc/",
+	"vPz6I9a060oWiINcPtvzwJrNKTu1BI/5eebYprNfpjaBj5c2IsWv7pr7RvX26dAIDvdGdh/GJEgwVhUs",
+	"QFrDjCi0sGbJaCgfXqJFIRspsJwGnkoA+DfbpmgZ8/bAAXsc038Vh6vWqpeiFrf8HHGr8y2SGuY0ueTN",
+	"pBfF6piN1CKdILsSvMzKpN+oW7bn9cHLo1FpvvG299MX05yGCKAtCmGM0rCTsjYWSDqX25PQaCYzPsJ0",
+	"YRyvD3A9O0V+lKZ/1s003FW3oynTuk+dfUxR4/CGXDMggTZHC5cq35XuPiK6dobieMciSCLUzfb9bq0C",
+	"HXoRcIdOAENCSlH82X07hhOkD2UpLImD0Qfik32wqU7acMz7GSTuRTsJgSbpdkP1YWfg/FthtSzOYaLc",
+	"00inZrBOQXNUbPNzzQ6Q6SHC9Z7MljxC1Gt/ldyXSvvYCjfTxA0YSR7InwEugxcIsEHSPSX4s1W/yU3X",
+	"h/gkudjfwGMMC6GftYQ18ZxbUReHs+SfFno+IaaAOEqBNMVcLJR+eLxp6uLAdtJYjGEyXSUNGBGRgiU1",
+	"zpUTKoRArLg9EmVK7mwQBBA6HQmzT1+2pdgIrb1W4KjZIVQEHr+hKrGx/rVE4sEhZLmRFkHF4tolE1xX",
+	"GXUBJFymjlNB6XtXT9mdkOCvw/2kGA9BL5ixZlxtOgymoTgOwXDmbsE5mQYrVawmHPK6mhCusUvudBxc",
+	"P7jmVswdW0e1VOYMLYws2/mjU/M5E8zRiKSIPSMzkeZtRRqldBLfoY6lUaoK+nZeDunb+NcK7e8TbL8S",
+	"+8YeXND9atNW1RLPpmrtkqkboVfrttwKqmaNbfia16XKha2BlXQjctRm2n2X6bgLg4CFjhKAheJOBO6g",
+	"ZkoaZVH3FV6wx9jAnJkzU6VTqVGlotNXRpSB6qcls6HiuVXAIx6Qii1Ueog5cp+2Umjz6xvz1d4mDzhM",
+	"gu0NOcbgkI8P5swXbbvfc33onUvn3dKdrNi42t1xD/aSG/hnj0dlkqrwwYb40tID7yIm7nhhqwPjhlyw",
+	"KGu/V0WOkw7Bfg2LtoySGE3M6Hy0kln9JhMdznDA8iQxDd/rgYtE8kAoVc3xthwiIwnBTP2Ugl2X6KvW",
+	"nTv/mukB6cxX1cGD64xmQx58wf6XalnBa5+9PFh3lUaTKbnjGnTJ6uZ0JVE7DKHzNPnU4JdHj4YLf/TI",
+	"7bk0bCNuUVTgNTYcouPRI/Roe6FM//lzDtGXa3uduPtQtoAjmVRiUhXNafnfjTxnJ18MBveT4pkyxhEu",
+	"LP/sbrJz1h7TSCbR63IB8Qmy3iYOzwtPpazRal2JvWEbb/i2u4hz9H0YIQXUwblEuccbRjAEV+gOOz7F",
+	"FEBTuJQ6BW+NGLYjA4oWTlLyYrE0s5VTr8Jgf6cFz/DpnEkFr3sJRMc0QGcAK8oI/VKcSa98cmkoDwG4",
+	"gyYrQoV6LWnvnH6+PtrbzDtEZl1jvpZ6/khDZYjsTMcdrPcoKAXBwUhHaJAqbMurUSmlTpYKlSX9NO+W",
+	"i5eiEH+QCm0aQfn9CrSNUPHb1mcbL9dQjQgf+smNcFeeYI0WG3mHG6bseZNtNFrcSNUayoK7QnU9t0l3",
+	"M696yOgXvq/lnc/lR9n1OvcwPwtWK4nSXaC0VxSisTk7wEQyD3CYGox3/Fak8ZYT655frPi2m5h4fig2",
+	"lV2/qkW8ZljhK1GXQl+VN9IofZZqDFSnJFfOrR6/bT2rR0iWJGvAF7Trw51FI7oFlQovu0LVm0oWlux8",
+	"aGlBted8O8tI+v8S5kmHMHIjzErWq9YkWMtz/ByKeh9f42wYceTv0WnlPrUHLRaNvpGFINWXL8jDMzeO",
+	"abdbYcBHiFacWSpzTr/9yFC/fF4nUTCBgaFiueHWCg3T/b8f/vcnP16t/jdf/fJ49cV/u/zp18/effRo",
+	"9OMn7/71X/+//k+fvvvXj/77f00qOua8uUeYGBLBMtD5nANLaHODIj3Aea3xzU5kDNjydO7DSXOExB0S",
+	"8fjuWgvp7SBC5T0kK6Zkv+P8jy6YlnF3vboU440WRtROAds1X/nm9IgSF0dCWVzzGaboW62s6Cc18yAB",
+	"uVaClZoSHFywLhkx2UGx5sBwIVFowr6trIxTgESTwCHSN7xy1aLJhrLhlXEpkGtvlJmopH2/AuD90Mi1",
+	"2PKamV2LsYaY+PCC/R2a+HUvIWBYO1u6K0RIVbcKtfcPERXPUHLLwRx0vtx7YUOXJ+TceO3XKE1/gXgM",
+	"nDfaWbKj8WoFwqGWpTj+IArOgF/d8Oq70O3dciHuRAHv+ELgKZfbmWNBMGghnlKXI5EEHa+X+70oJbei",
+	"OkQZVpEUO4fFC4b5HcCdp96iX7hWLdb6l8afEqEFaw1RgW7r0RAZlWrene/KVW53eqzOM2JkwKGzdsvD",
+	"fKLsXRQzkTdMqJJMI4XpE01W0rzpAhsIOY6wQpGPo++snltvz2HQTzwz0wyiDrj+GF/xtsApqHljdup8",
+	"lXkzZQjx9Qef6EZ3s3ofWSr3JzdMWlbKMu0AOHRNM9PMPcyxU1VpjtSJBy6xJM9Z4mwT9QUAlFx1xzgZ",
+	"RADA+QjvlLEnzHdCJv+I81LqnSEEA1Z9ZObZbsFtjfqcedsQAlInUUt1HNOL3Ym7YMF89c3VClKAxnUa",
+	"/VSzEQtm0OOpFLoVhLwDZ0GfS/WRRF+dyAiipfVJo7qVHnnYPvQC9lvcQXtKJWbRpZlAYaQ7Ef18Bl0G",
+	"obN7Z/WSE40AHU/MbjVviFt0HxmsmMoTdYUiAO52bQ4GrppzKKHCYLM1QGH+45qfbvDZGxi6xNvlXnAF",
+	"r9Gx3puf69LTKeEFAujOYG6jgZgW7lVg+nnT6avasBAbEawnDi/LdG3uf2RY6stszBapIld7Vaecqb7D",
+	"r9/ix7ROCAw0mc5oKsv1HQrEPfgHYPXnmbPPD8UvngJIUfpa7JszCdM9CMes0VdIdxMyUW+ULoRJMnis",
+	"XzUedfEDaSPUpj9WKHcVlunSQM8WKWNcvPCjJW2orlEi9i9OGexa5coFZ4TRr66e96XR3kLG5Jm3RAV8",
+	"u/5dIKjD+9Jlm7AG5SyhsTYwNkBd/wOcGQKK+lTbLTzs71yWhogJu83DosiCj37ZFE+FZN2Jzl8L8ZUr",
+	"G3O22rPgfFMnw2QAUn4jNBaF2HEtlmwt7K0QNXuMnPbjZd8TouANL6Q90Busb53AFqaXj6VU7bqKvDHJ",
+	"BA1Lnpf2ozcyzuVr6pCFxNyvdLtTnt0HhtagDcKiEcKyPz/+v9IIugdgGyFWDfhFHaxYNZ8/zqXoKSWv",
+	"2UYI1giNUuLAgwl01DJsTiqWaxNvW8CHWyJp62vQw7CKYqg4uR+sYggfvMAvMgv84rHdMZfgiiqfebeu",
+	"f/YFf5FZ8Bf/YRYM1tuNENNJcDdivJ6RBiHyT/XZOkZ+qvcAcLTIo6Dm96APcC1EiY6QDT/AP1thyT6U",
+	"dKbsvc1JztXSCOPctqjRRlaVYW1z8joTNnXYlQSLSRzKBNmm8BZYeIKjLocXzzwB0Rk1yIPTo91l9QWD",
+	"ou3bn4e6tGHqXfO10ufK7UwDzn4tzUilfFQmcVPeN+EzxBaOcyQ7800ifNlHrkrNuDGqkOg6dl2aJb1G",
+	"XVplZyFIoP+loAIk5vfwe0EIXoEEU7pQnJQkzJtmhVUlMo6EPr4/ktd7bnrY1UVBNw3VwfNVKnjTLFE2",
+	"dbAjj4W/K1XwivbABcffcFlBoFqwZHArdNIg6xJXj+Xa+ME3XuT98NY0yeGMEfZsWIPBBniDnwKyQLCn",
+	"/JjvAVEw8/1QBT1TQ55WgTkaEYtFj8fz6LjPkN9Q39SwSJL3GvQ59EwN6d43Jw76gnoF1nGUKUbVq9z2",
+	"OYKPcBXW5/djePDHRB3BP99HycGcNn0Atgwx1VCpE4bvM87wbj+Hw/hw3EE2yEjjQNnORNUwzopKeunK",
+	"6rawb+rRZZuoVOtlsXz+rae+STrhVyLqyA31pqacwSEHU1IjkZQyvxbCp+EKLhK9zdkI8aZ2rSQImZIi",
+	"JVGsW5HWyQseF9QSdAwbuEytYr8Irdi6HXqmtcYyY2VVudSUMA1Tmzd1eCZ+K6FmDAy3UX2pthb2Vum3",
+	"UzItZHoWtTDSrNLVyv5CX78B+4RbfmyrcJ27GKP369HiYZdlFvLrZ04vcv0M3de7bIYj2N9bJrtZT5kB",
+	"bbEPa2UDAX3UT/Nkd+JNbe/QzxkD0rm9HzkM9bSjs0inY0A1vY0YpHXyaz3REfoBXIYlmMyANSpVoRfz",
+	"WZwmZGFnR3Am3tNugIzwDE8+8kzdye1OaCCFe7xNcRLMjKIqWRwyGtIdbxpBIXepi4drLW/Q9Okte/iU",
+	"lIbBY+wJe7PYyI16s3B+9gar3L5ZVOpWGAtE8GZBqzU9J6/hQqG97j2PKaLsrWBaqT0iStoc537Pr+9M",
+	"8M8s7Y2WSidzWMSG54mYX64F23ROS6QkBCeellvAUc1KAXIIqhUpc7ba9FaeNl6Db/xxTCI9GjtPl8Tz",
+	"65ip1AWgjsRq5U5apPYgJwqXRkVaT7v3UUcN4EFsOe/EU0ALr1/qizIBXvUeYVGU2ZKkBDx+bY15+e+V",
+	"CI30vHN0VScqhPPEOneX5RywiKO8L8pz/e/P4RM7eR/1ogPj3ofgPGAQmVJRiBUx+hMh8WgJ0VhrQTFb",
+	"3ruXaXCSE07FARNlkh+Yh2ovkzgd7XiC+QyumgThpk9ZgrkOLoPxXT3Na5ZDCSS/RbM0pZZbaSxmfamT",
+	"+uWhLHVvf5dxsWSCLkVI8AWIAFqxTVs7Rb5z1iR9T1cja0nvprVwhZWesDf1I3g3+4rL7k9w0eoK63ff",
+	"F8GBK1UeX5Z3YyCv49ydicIVeDl/YCaj8zO5AUMxrXjYvYCTZXayef+vLmPlOv1a/MY9DUOVjesas7Og",
+	"zIaJzw8un7LavH+4rRaiFE3K6fFl33UEW3W7KcSgGEWj1Y2ol0xeiIth/HO5FcZXSa0E34R0e0rNcZ4N",
+	"54AIzVNFhPV4IbOCjFP0g3p39/J9t1w4RYo5u+OaGzgF13DOkOXc/20V++AvX71ml+7xaT4AUF0d5XO8",
+	"3Vyh5fmKxb93lZknVYlh4Pkav2H1Z4ODuokBLh97mHIzr/k+LmZNFhfVkkMLxiyN9Wy8AjGqXBWy1Lnr",
+	"mzQGpqvajeLp2rnJA5Gj3PX0+tlLVivrPO1fZ1tDOMgtBnNT3gAtXBAV1auaX1rvoaXKTaGabL4X/Ma2",
+	"mtdRSEgYKwDp7w0N+QAhhGYx8O5eLBe83Ms6eYtM0o+rae6gHBPRcuEtUWNiCCl0owI9lnG2lTeidjY2",
+	"SHv2TGxkjdGGT97UJbf8cs2NLMxla4T+krL6XmwVe8LckM+45W/qMR3l8uTGyZy7FG2p3eD79FrevPkR",
+	"RLk3b34a1SoZu/K5qZI3K02wcqdi5eU7l8ZlPLFpRCE30qn0qPfkrN2Ji59Bbvz0bQ+mhRVaE1ZowEsv",
+	"v2kqWH7E1bzVD7aMGau012jKYB/E/YWsdsRP+a0PQGmNMOznPW9+lLX9ia3etI8ffyrYVdOg8QWNyj87",
+	"xaE0KHXN9hm86kDsBssZEV1pGnFnNV81fJs6i2/e/GgFb3D3uzRMoC7HbjFOggscDtUtIMpAmtkAgmPe",
+	"XRatEBf3inr1zH3jHYRPuIXYJgSLPmi/YChng7v3dkVjJHeptbsVnO3kqgyQuN8ZxwEY33JZG1+dxMgt",
+	"OguYnWphyYIVO1G8FeUFu94wl8Er7q42PXW1Zx3S4P0B14o0bCMBf85vu21K7hT6EGcYyzfrUHYQB30p",
+	"3orDa0XdL2aWcXOJvgEbzqK88gbw1EFFSo101ECs8bF1Yww331VZAkh507BtpdbudAeyeBLowvfJH2RS",
+	"nJ/hEKeIIqBhgt4brhOIwA45FNxjoTDeg0g/tbyZhQtck84E47Rf8Wpe78L3PVDzVqtbyi1aMlVHngkx",
+	"F2sN34pcUsRYsLhHxthYhZS995I3XaR7cR1H981E9sIVrDlJKQK+AKmgeDgog+Vnosh9J1hiZLVDmHPd",
+	"CDlpu5D8CFX1dgq0NAELXXcChwejj5FYstlxg76Q8kaUy+gsz5IBjobEAYH7bBZoV+6EOgzNrMQNz+Hf",
+	"yO0qrVG5jio4cRuUK8CxuW218Dx3eE5HehXUo8gt/LN3/1ZGbmOlCv61p3/w209JjQIWjUxth6pRACpF",
+	"Jba0cGo8SEr8gYk2COD4brPBrDurVDGoyAstumbcHALk40eMUTQMmz1CiowjsFHfigOzv6n4bNbbU4Cs",
+	"hUTbEPdjK81qFf0tJpJcosijGmDhMhP+W3gOwF0FsXB/DerY4TBM1ksGbO6GV6K2/rHUDdINEIutH/Yk",
+	"Tp/m76OcODsRjEQXy0lrwh73Wk0sM3mg0wLdBMRrdZfLbQsS7/puDfSerBgJvZIH8wMDmP7AsLW6c+nt",
+	"69IlKzkCSx4OD0YHgLiThvJ1QL/cbU7ATE07LU2lqNCwD4Ns05FLTpyYM3VGgsmRy4e49w8AIFu1xD1+",
+	"jz5S++LJ+DLvbrVll8zFF+NNHf/cEUruUgZ/E6qJSJR8ikkXcra84MKKRDuQG+seC+mVuFgybtla2Z3P",
+	"V9L7ykpJ9ecH2oputKTXUAQ1FDFI1qHs3uwrvrFCHxXHci/jeKSuGt+9hkK0mZPhIYKOBjgZDD/CkL77",
+	"eJ6iE6CkKQpxzpcZ6oDe56ALGCdNEThDhhYcbDPxPnhy+84zcT7ofdKOd8zr9L2O+w532WNtYn+/VHdT",
+	"u4uXFG4RXl5dLq3ewf8tjzxAEc+F5jp1l67kFe19Wgf95s2P8AEuTxgE/r8c1JR474YvxHFHKROb4Nce",
+	"UkdZNYR+ydo6lBbw7bt4WhARLn6nFeYqmk4vkcwYcxYpy99vjdP81VHjxDF8MVQgJM0GvVauyM9ajNwv",
+	"UzIok3Umjm5Yz+yEQnOgahT4AHzlu8XlXj6kKpQfRVmuI0ta0A6F1GPv204OFzvab/Ors43ewPpeqi6h",
+	"CHZ0RejiZb7/Y6WsWGHu2BW6FSeXAI2+NqjjjrPzDlQXvc1m0pCfcpqz4rRQY72UVZumVzfvX5/BtH8L",
+	"LxTTrvH5I2tKtIWJBdMVniamplK0kwt+Tgt+zs+23nmnAZrCxBrIpT/HP8m5GNUFnCraOCLAFHGMdy2L",
+	"0rkM8tuuRte4Il8kcVil3uI2BHvgVgvS+HYZ5vIZu1yFp4v5RtXXY4PJ+NHZHeBCaJutSt1722MjZgDy",
+	"vjrbrwyGYsaKzMu+0KKkbO9m5fNjp+cktcatkNsdKbqiroM1UU4+PxyzijQ2ZMqW1lAslO/k8mwby98K",
+	"qgMT6gcD3IZJ0PiUVNQTk0wpl7BbMPBPUlYwWc/0Co3Xe6vqGUt1UCZW22UNR7VNbicuJmr5n2WLtcDM",
+	"FwdCV9ZJjWA9NtswITqWT52zIKM251oQDJWl2axGpltiD5jeaerhfUwNmfMwwX66gO5ppUQUcD3JNkas",
+	"oPRjH80zRlDk8UMjTawlTuY+XkzPTkvabtWiv2/HWMdLkzW4CuCNtVKbjRGZPL2NMjLOupzwxXRlylx1",
+	"3Fx5rAdXEx8DcK9q4bkn6/Wz1AzeWccEpK4PTNb1MLaZiiYwGw8kdbr00/Hk7l7fmNgkt4Qktfi7MjoC",
+	"7fE7FwNfUzdq3d2o44vZJWe+isYhi6G1Yo/K/73SMSt2N4LLYCIt8ZlbbuoPrCt+3UXfUY5j89td5B6u",
+	"lYN3Rq1ALVXZt1V2a41uPucG6m6+MzL83j3OTR9nHOMjc1cASm9zV0p3e3ad0bWenmjmNXPv5Ry9Z7qV",
+	"9u+ePhY8sJMn6ZUVzQ/5NdFKKJG6FU0wtvt3wLA6KJBQhs3iNz8Ajpu5za1o0kPEEEwMMH+LMlnhUPo6",
+	"WtqPmpkJKS07xyioBNHmlu4XEABJ7l93wU/f/pgMI2S06DQy4+uyzLkpyfJu4FGYrffSSz34MHsAPsqy",
+	"ee56GPjqRiQdW2t29fLp6pM/MwENmHB5gEfK4jEhg805TQBXX16HXLxcb1sqXeY2HOe5OF7/F+69WuiV",
+	"vTuSqJ8AB+bRBx6793ak4FWVDs6s1HaF+zVP/KEp+V45R7hKbbv7Jj/hbygE0dq9Y17A8clhaMB9cym6",
+	"P6OwYd/o9A09UexKrYkhHfe2Or3Pv5UwhmuICSbC2pLOxJGTiCbGl2IjtEi6xIVPJpLPPuilJXK34/T5",
+	"5Fln9qSAFPJJxRPdw6mTN80xA7CfOV7RYCkPiT/sfNYBljm78SrtKv7KKi36iI/chxBfxzZhjmks0m/G",
+	"U0mTLwgMmjq0vczJOftXccCctricRYh/ua9jduoOciMewfWLTMZdh2dMH0KOur04ixNRzhsIJOPVyrmv",
+	"565srW7clY3N4yy471Fzm6ZsSEbrUi2hWqwSXK+C5SO7KmzX/NOsSguevWz8Kw5VGd4jiCxj0eaT+7oL",
+	"cfNdKBZqYFwD6c4RV8dCh+N5F/hNOovRUd7nIi9oiRMRGKIJARidczB2HsRcDBKqyQknMFpcF/VyMleI",
+	"B3hw7EbshHNWdjM63enT0VHXEZ6Ec33XiFyZwKuaKf81xGL0WdAHxlHWJa76Eqza4faceSd/rXSP+buK",
+	"OslYjvAgHzDGs9zdDo+ZoHHn08yHqtMLhrTEft7+DKfx0aP4qD16tGQ/V+5DBCD+vna/o/Pjo0djoOm2",
+	"SzMJtMqBjf4jj5v8RrxfG28tbudd0Fc3e0QddFJ5MgwUSkEZHt23Dnu3Wjp8lu6XUlQCfrqY4/QQbzqh",
+	"OwZmzgl6lSvSEGL+9vwOcgSFDAiRAywWbwLSQmbvwlvJa3l8hOp2T3luTSWLjKvQ2gB7rUkPQY8WaJzR",
+	"ZcCIrcyEStatjMaCZnO0FQMgozmSyDRJxXuHO3TOAqS1tfz3VjCJWpSNFDoU6oyuOv84MKQpHmr8k69c",
+	"NzD2iYZ/iPZiwsPNv5ymVBfowKj2TSV5XYic+oJttBC/oKWxqPjtmhdvmXs9IpMibaXHhvd6TDDmfLCs",
+	"H9d7bnc3tosnEJZpcaPe3itrUN5F8nUYPVI54Joy3nPHpjqLPiX9aI5UKW9lTncBX3pKg2Uc8UIbCf9r",
+	"6+7/HvkpHusChPS8XevZJ1zX0hlocfMI2eYe1+b7MVpNpcGib4l5liHHBnxIHpZiRM73QIHlepszHnao",
+	"V6ZzPAYC22j1i6iXuOPwP4BsfJRmw3CqVc/pktRmTNvnVh/hqVgOtUjdiYwYQUBm2PIsf/SOy6NFPws+",
+	"hh3ri3yAo5DKExIWxDOewD+5uz/dbU8pXHf9iOGHc0vvUO43OuL0iTm2akWOxtTv+hkMLs2KyDC5DPQn",
+	"vHV8kkqZxxOhugZ7p9jiUOQK0SndpnezH9vu+brD3MY/WFfoF/0QlsHTUs9pG3kfpSDOm0VyTkkVfWT9",
+	"TBYZ0QuPVxS7jV7PPoyR18xJOFCWtcdL0qcyamEuafzuVDqYh7saLs/kBQkwRdvbC7i0qrshQoZ371xH",
+	"s7Mo4UBoK8lfvRGaRIe0+9w99T4+Ff1MjU+n4IGOPdUO1VPhlVGJYdr6lnLUUD/iV643eis434dbpQ0M",
+	"a9KxoaUo5D5p4H/z5seyGMcBlnIr0cOEYdq+jXXymBuIucrYQEWlNE1FmV1j1Fxv2ONlJJW63SjljTRy",
+	"XQls8TG1AM98XFtfkKU021bUdmew+Sczmu/autSitDtXqMYoFnRzFCHgI5wHtaq+YB9ibLeRN+KjC8rJ",
+	"B4/ExZOPv8DIPPrjceoVUooNbys7xbJL5Nletk3TMeV7xTGASbpR06ItiU/522HiNFHXOWcJW7oL5fhZ",
+	"2vOabzMi8P4ITNQXd7PnPNulUbCKlcJYrQ653MB7YTnwp0yic2B/BIarMbt3EcBG7YGePCP1h80PR+mu",
+	"iKcHuPxHDKRvfBzxwBbwntU8uWgljukOunJ9Hq1Lxg3VTpRdigvHEC/YNRyGUpSudrwPkyHcwFyuWG+j",
+	"YAvBH0nL2qJ+uLWb1Z9Bbah5Yft11vrgrtZ/+mwM8pe9QB1Wnwb4e8e7FkbomzTqdYbsvczi+kLq93q1",
+	"B45SftQVFohOZTbiPzmtzQWYTw89V/KFUVZZcmt75MYjTv0gwqsnBnwgKYb1nESPJ6/svVNmq9PkwVvY",
+	"oe9fPndSBvpF9syca5/orCevaGG1FDeizG4SjPnAvdDVrF14CPS/bzyMFzkjscyf5eRDwCvlp1Kaggj/",
+	"w7e5RJCZZBT4c9fn/dJm2qiDwPTNCh//zDS8JFEaffQIgQbrAjX9+ZP+Z2JSjx4llcVpxTr82mHhIe86",
+	"7JvaQyjQNCZoFzwcnP1cjtPx/vmUDEd1e7Jz4KCAVlBsYb0SN4TLsNQLfEVvajy08PxrtTfhfVXDqf1S",
+	"3X0jjVX6cB08EwNTc4FvGFPS8bsJZ8N/nojqMyVuSju8ps8zBP/BF48H/GOIiN+Zebm8pV53SCvJkPwz",
+	"tzql08Rfhu9RHDJnX6q7hKUtSTiDO8ETz+8TnD4LPLenePgAr1hl6g+wpZktnKnew6WNkrkk3aGO+uNF",
+	"Z6qfo+EEhvLHoIuxbXsqiv/LVlblD11BtMEVrnld7JJhX2vo+A8Xt/jk126JdEmlsAYeHbWoksPR2/gf",
+	"/g2deOX/m5o7z17WM9sOcOWWO1hcB3gfTA+UnxDQK20FE8RY7deaCllIq60qGc4TatFHzPxikdirp3ib",
+	"+nzdL+kc57NVL33Gaaqc7FJu4xNikNf7P28S7yWFjwJSLNsrY9mfPmOVgNNplk4fuWQlNzuHR6zxbAql",
+	"hfmPmQCciMwlpJ+kse9fPl8yIwrtVGUbWVl673OfbP6EuDWUEGtl5eYwrsfqQnGXDMtP3agKyoUtc7nR",
+	"TvD1mkzgMwkSeNhT/auuWuzQmRLh9fHJMpcuOmvQm5wf/9gIrRETXop2EAUN6qTCBS3qsH151zKS1K3c",
+	"hGyNcCQNluFAeR0P9MEdVOu/yI0rgoW/5RRJdxkfu8l1e8WHz8zrD0vDD+S4pQVsPS82+M/dBjk43+hf",
+	"FrTjQP622Sx+mqu6AFzsrG0AsfCvQS1AGjONovqd6rg9HOZKHcBn+qDbPHd3HyjfJXTGJ0GJnZioS7SR",
+	"XLC/YCoDAPJ1jD20Tch9W6FRqVdru20qxcslg3HATZnRrNRHC9vqmpVi3W63VOypd1c9sBT2dP3rE8aZ",
+	"TjMNqzZ2ZeVeGMv3Tar6JrR47RswOXBARqV9jJ0L9ozsJSYu+GwsnUgN12yYzp1GvPnhP9ZSOSqilhmC",
+	"jU9+lK9g+8K18LJHZ6bl/v9FkDeIMAFu8nQUdLstKej4VhqBeXzFjegX/PRg+JvUFwDtL0+3dU2UcnHC",
+	"S9eVPj0d7R44525UT0A2QPypTkiu7PNcmqTz/Ap7pYjS3tX9wQYukL7kkcsOesG+dZbEgteqlnAPHZLP",
+	"dKxSM+9SdJN0nOKkotaUx3N0uBL0GmUQdVh0688zQoe4sX9P9BU2laiD/rTizpL5fCuscZxNlEvUEMtK",
+	"OOu3rI3QlJ4XiKh3y+iEh3fqYdnFTJ5aqVOKqsyYM76Gb39zxi44gsFx0KHNKX/IPg3Zr4HaMZnAVgnT",
+	"VRGN1/Qj9LnA2lmluPvp4rnayuKV3OIYFFNAbnGC62Y81JUPp3HhK9D2KbRllGs5/NzzjadJr5rGTZoU",
+	"mcMOJ0SEOovglBM3te0hN4wfjzZBbpNxcHifAqFBNVQKNId7eEQYQuuU+ukrqqEKFIUtGKXTSiGlknUC",
+	"jOey9v4S6QuiSF4JuDF4XjP9TKG5LXY9NnQseib47A8ZmrHO4eahQw02GFGCa/Rz5Lfx9V39Upi2sjnG",
+	"ERp0z3NeH5g/FEDdcaJhXoU4MhKC+qYfkKqcEFUCG/R12kgsSzMOYNyrvTDGx0jNf+CG7lbzQvT6zriJ",
+	"cvVz1m25FXbFyzKVYetL/MrwKyvppSHuRNGGBMpNg4+iIz6+3USFqk27n5jLN3jgdKU08ALar6tEDM2z",
+	"8FGUYYeB0uDJBv+epnpwEWQn50Ty4WLY8WS5uT/SSOoFml5B1Yb5mMA75eHo6Ka+H6F3/c9K6ZCsoDfW",
+	"72GEzHC5eI9S/O0ruDjiGoWjYD26WkK1Q7SqKfzuyyRQtSKGQ7kXPfiDYJWJl18/Zf/y58f/Aru/rgSw",
+	"O8tlZboAu7gSomv030DWpKrO4WE+MCaqMgUtsM11JUANV+xkLVZa8BJ+iQN8fC4kLwThAtMehy4hxwhr",
+	"tIg0uu6aite8S24hDVMFPScKEaXSg4VesOsQSmDQimqYI+2Mcxh+SxJ7rjgJ6Bu+ef36hS9IAqjrytfQ",
+	"rqY5nVM/J7C8U9pCVpo914fBknDDlm50DvvY7DQ3YcoIlIv5JvUr9v3La7+JB+8oHU/pUVkKjXEoeGVC",
+	"I6LfwuWvnFaiePwmT8oNrzKJ72IfBhLoyK6fS39XZJPFcuuqyFjOJu+8bGUOitQbeEWMNVO56DwKzjuf",
+	"N4Fb6yRCfeD0GKC/+qwMrOHSeSB3t9MYsy6uNW/anOLy3QaPYk0oyWvWTPy1wHpEOX4gtNyL2vKKbahh",
+	"0HSoUizZtqvN0akYvIeCFpWA0+NsRr4nWnoSlOW1HNMBaR4MZzIR6CMx0nJEQJLC2XTTjl15e7ONJ+e2",
+	"N7NMH34HyTzopfGQuwrHwRSN2Au4SMM7z6rj5rpIu0xyk17sYQAnpOIL4Di7OSq06w9sZmi3kmlMeDU9",
+	"j1Mp4X65AUxmEbkwGvfEjWeMgVlGBNZtVvJEVHK7sy9FoXQp9Cu+bzI3CX6JriPSuGCFuXhBZOh7+uJ7",
+	"VH/i/pbSvGXXl9+R4w62NKJQdckov76/VJsqJT80LaqW0hTQGhcHbA7Gin03b5dQ3h9eWbO9LLSiqU32",
+	"yfAWRZEVJh/MXNIbWQk/I7Vj0Gc03+cff4Kh0N4PtgZJur27YFfVLT8Y9hh+upV1qW6n4MEI91MBgk5W",
+	"1L8BTDvBMwn49mKv9CHgHhr60kY4N9516UHJIrGq+DY9NG6qqHgDYxuJyZZB547dGC9veF2QaRVW5VTx",
+	"mgJecOerSk7uPME+ua49b5qOqrYKNN0A17G1Tfh2xYCG1FC4ppyglzsJ8CU6SOiKZzlaumXtzpuJMPd9",
+	"Le+YaFSxy8x0B/XlVkb+Io7lSuwpUF2EUPQbFao77oSBa1t2Bz7siSO5xOlMHZBO1RzRVH89KT74F63a",
+	"xqnMXopI1z+SE4ICAg3eW+hHamUfIQkU6J/QvCiEMcL0uGaIKbzdqUrQEDO9l1wCLXb9bMl+EVp1fpUx",
+	"xsn90vhX2z2MHQjTVF5A/BQl/iOUuN0PK0poHHdcH7stHfKWMLy3WX3BlMbjopcnIJV95y1aSyYteY9n",
+	"epNrjBOf1G19PNw/a4zDzKgO7g5Do6RUR85DvAVL587V2VMcHrOk/Aq/56u6d2mLB4mWAvpNROC/UR7i",
+	"o54a2XMYSFCYHtElgt19Kdc9fyv6wktP8Bwqp2JeOGkQC+l3PbDH9qRpsnzlnnsxzSkeZuv8w+IdD8Rc",
+	"nGcCrkPh5Pvh3WSzxnMXyv0fFPcuv85M7CfjEa6oaNYfhODnPTLX5Ct+NNvrP83x6VlKj+5jNufG1SDJ",
+	"0LxtdXpAupRDBxRoOEiq26ov1QCwUUKocH85BUzwdvs9Lqr/vKwgXH8nMgXMPnu8hGu/IKOsGT/hpjw3",
+	"hTW/myD0n/OK72jr6GWPUWNAAF+2xdvkXc+KFv0fJZToxkZEKjvfM2W8SgrP/eevWoMRzfmC1sqyLb6+",
+	"NNX5AKy0TSM0Ww8yq8aRgtBgtc7rCaIROsUyLCF+3c8qNDV0Ro1mXrr1ptD715tcKSF/jvC7d6rwdp+3",
+	"4rB0p1PcSNX6pBghrM8579GvmELGj5epITGVEPP3Dl7LRmYhwYjbfs3Qv/5AiR6ZqK0+/AEC70ab/lxw",
+	"I773dszhvpO5I6RYYq5+eI+huqVSMq/xZk7VRST1WFCOkWYMZpSUQovoClvgCKfkm0OFIzeZnaJpfq9A",
+	"5RMLBcTpqBDw47ZTWvpy0atvmC2q9By1aFPVxKhFZO5zSt9RKFPGB7XnxDKc7mulI/dUlB/GEDwNrlxe",
+	"I0yG2R5DibGGF9qIHJ/N8d4Z4ePdcnFdnuTfMtgPGoZGSe4AWGi+BO3mN4Jn8yCib4eTfqhy0g5bu+wz",
+	"LvBofYiLwSbqS21FLYw0maQ2MBF8CTmHqXVX8+zo02huwshcFTWMXclVgjRKW6pWAm1GQx0FLiKRVZe0",
+	"Jz3Xq2+uVp98/qdBcp902Mp8GEbFTEWcO7G3OVlw59DQC9j+pM8oSDQbMHmshTY72VCZ/KgKDGdoMuwR",
+	"2cXcZLsj1fF4LC903lCplRi/WohcfITapCfzQbnY5Hdg51qIUjR2N+mLQrnOGrvrOLwQIX3pWgCDB/2x",
+	"qJ0BfZBFutx2rqeV4BtPiVqpORXEQk5iRGMMdIqUvmvs9XQQKmWTJdt+5zMW13RhqrH0ulBMaaZakMUn",
+	"K+2b3KWYqj5kpl4dRyskDp1ucTXHpw8pdM81cVEpI1aqTWD5KXzqvVEJhcxOoF/WxgqObFE1lkJ0HKXs",
+	"My4H6c0/fiFfdU/GtnaxgT22CP4pWGQVI6ZxVBwqcSP5OJmEXdZsG168Xfkznp7KX1VkqKMHK5X5dWoC",
+	"937+IzqFZmNkesWl/yoOk+yFj6tbjhwkTlBsXIU8lRQrCqZmuJk0d9UL71M+ZLMRBTzNp6vD/50SWfjK",
+	"40sfD4OwbKJi8dLGYeb3UI90AFX8nvBU/Hzg5B4Fb8XhA8N61HD9bIz/rpLEDK/y3mgUPmksmddXvpxk",
+	"LoDPmaOlCZSBWPB5Fwc1QjNPM5gu1DRRm3vO5UkSa1MGkXdiSqireM+5oOtJpTrxzZUrID883C9FLW5T",
+	"OL9KHGzg8ryqutPNW6v23MqCaRrnVB2mu2H8ce9SpNzjnE+e7teDQ+xnpFyqsswXBcuN1kdP94J+Kw65",
+	"Q6LFdvK2CRLl+K4JnKCn/gL/ebidoT35GbZ1BfzTDSCNj5Q/+j45UV8yD3f3ck/q/E78eQh0l56FFjvt",
+	"94EOkQ4rIL2steJlAWvyExGCtUspEpw7kL+66Niov2nX7uUr7uAGh4jZOSnK+6c0JtmB0iQEtdLiAv0k",
+	"D7UQ+llL4piAcPO6yGgyg0IaUL5TtwzOW5cVWWp3SLjWElxKADk+zIYeaJZv6avwr4JGpB5ppEPOnPuR",
+	"ujyIUgHAJUOv0cBrpB7qumdF7Qx19ylZeI4mPiDBVeLWgwADj4TEA1IIPcuCMxgiMvm2+6MlgUtR8UMY",
+	"ykN7sgp/ubBZN0m+HQ9/9QMqwqhWM5yLFy/wh1Aa+rjKEPFD8y4D1fhdocWnaR5VwtF74UsfbjqyIpA5",
+	"JqFEdjTt6nzQ1pYKLS3gVl3JwhXwxGJKmMIg9YiQ5fE3XMqTcQ0QL/1fSO/wvwO7FVp0LOaU+LiRkC9L",
+	"MxN/+QCwZy5ci9KRJtXxGPE9WCfybi0KUdvq0CWvgAVjylXjf6Mr1AeFVdJb/PBuoFQht1yXvsXkY37K",
+	"sXBUR5rJNNCbMLPs0uWPU8LlEu/A61rW21WufMfAx9W/qz8wlIcXlTNIAiEhT5faiV7uVnnuMQXHFCqg",
+	"wT2RkE/9Q8DRbpnUuxE/BH95EOdMXFEqLJBpsecST6VVXkzMzzmF7Kf03ReY8oF/R604gV6PJyv1hRKk",
+	"GSExpvoNc8/m46Um7xPtG8reJDB/Pa7E02hVtgXdr/HBCBHRs+/YCVaSDJQtxqscWH0iJ4234nBJtk1X",
+	"vDHsYAw06TEJdC8y9Hdj9mrmxj+bFNzbs4D3e2qJlgt0Zs9km7iuS1iTcJVEUmzjrSyg7FdQGmLGrlJ8",
+	"YEaO++xDlKRDOqFbjJnilu1404halB9dMHZVUwkHn1lIRhCMJocYq4n50U+fla1w1eso6PdNPVUG7YHc",
+	"zA8zzcNIAHngVDTI9ETJMnXIyPht4tV5MdfOOs71M5TyOqIiKJIyCalw0AKakaggkrII0X2FbXnlLDxB",
+	"5Bz4dWFgGGcamAd8QpZtfi9nKw9/0HaZY+KBC7pyM9MyegXmuemwEunBtpitTVrjNNJuAFVj6gUDATwX",
+	"LPLVp35wxDZcs424FdrPjd5GYQ5JIloFQd8bHExptpemy7o986nxIBS4ZXaqqMnzBatNzxLjY7C9XWDf",
+	"FZw3rIjgWqyphFunvtjzu5XOeGGdFivd+fwj0DGakuSTOkgvUeiOz2PiWUSSeY+F7uFBgsKS81ShimyA",
+	"bbGRd6xS6m3KaVrWVnNa/0ptNll/1djWO2Tf7hXU8IN7r2GscUaVe0ynfaI2a3iHdWotdm0N4WLpMist",
+	"vZcQa2srK7ph7rf1f+gal++hVORR3YBXgiXoK1R7dIvr7XnqTLyifFRPUYpMnQcM9YvKksvaKsaZy2PF",
+	"TKUSLuD3qkgNQ2V2I5rMx9nOKYwcoHCDJxHgcnQeTQMaMoC6rJ5SRVlA02mdVyijrYIeOmXag3Zm4OI7",
+	"1F8bdCQSUT5Rbtz79MB2vGSF0loUcY90AB1BJWvTbjaykKK2q42YBxZZbk1fHdTwAxO1arc7thFjMJdO",
+	"TdEobUMRPeny42AHKscd89rW4LBT8O+VFqtKYXrUVOa2DTAnuffR1mrLVIOpXSh23uW46rZxaq62xjDF",
+	"lZ6IUCVcUZQjoMD1iUIdZ04JTyHKv7QiseHoU9dh+jX0ofKONA4wBlr0inKAZdLywxZAY48hajyGFwl/",
+	"tFkTUacbeYd0L1JJzV12vvFrpaN93tFyTOykAiSJfH3oeTTz1u6Ulr8Eti21Y+NDMuS9xj7lRCk3WGYm",
+	"KK9VLUbXIGysuWAvicsYlj7m6d1tVIObNUVLL6OzEpox0mv5F81GaSG3NcOnqRlGBJuuyv1wpwgPauO2",
+	"PPRYMqO6lys2ZbVCI4jQXfTuiKxHeBgdljQijDD5MN6u+lZEfa7HBXvVIjSbtkrxJnQxGTz/fEwL/kHD",
+	"EB4qDBnoJgn6Z8w25ZrikMKRK6WBUS4ug1sa/IK9orY0PyZ8D9OHxP9ouMYs5bZfB9SBVlRS1MOiPdqj",
+	"t1Y+ib5L71FwTcW5CsHamhL7o+vt7U5WYiL552rPm1zSfGzAoEGUuApDfJbB7g56KkvnglhGaNvlDEQO",
+	"5rAptRs3IpYRm3P3hsuaMlsn5bkfpab9ljeZpL8rIo/0qhNkZFW4wk6GxUkLI4etGX5HHswZUsocf7DR",
+	"wobrmuP0BS9hq/aySPP9f65Mylnfrg67RKtX0D3JnedyZN5lrbiAgkcu3YE7ET0l6AFTS6Dixiv1IE5X",
+	"+/I7gzy/UM62LEMybmgKmX7o4p5OEJ/1NBmuJ4CeN7Edf/VksstP2p9OASQb0zbtQErfzjPPGjY2PQ1+",
+	"mjPLFFPpFWhKUXeWkjuWeITV01Ub5cjok4/7kAlOePXN1ecff/IPcMqHBqyUW2Hs4PI4IX57n4L3f7z6",
+	"7m9+yA5uVDtRrQQSBSG78VNKOp4o6TPUu8bLimef4g6xkJ1ilNTDFRP3Wj/Tey72r8gxuukGTIfuo/jk",
+	"0oviZd+5FA/G9VnNTO6pmhDJ6IW9KrJ6gAEACKmst24P4H+9V7o3S1m1JXcjchgYADrzXYQ5qB8GG4xw",
+	"dqCseBBQo7z3AcAPyeq5pMBsuh2A07vvH3UpYu8F/LtpKu+JFrnk3q860tLYhATQvLyQMi04XQAoIVbd",
+	"+UyKaVjouK8+GL3OcJ6gQmBWRQnwXIF37OeDYVEF4XSqcmQLdrU0nXUa9Zxp9Ynz6HCpADOuB02zmk77",
+	"/RpXuJ6b/DuZ+GviQR4BkE8H3oNhVlLwU8Eg1YR/IK54RtJ63Xv/9nROG2n9nL03bxRyoGoX48saoQev",
+	"3cGd7LNWDXZ6/FYfb/KJ74KeaJkQJjZcVqJc8cRZuw4+EsvI0ktYGRkLpHHnoOD0aANC57KCNJfsNXzG",
+	"KZnuR0M13O48UqD52JPJZSjgWlAitDU3wgUFk3ukqARGjQ2M0apZVeJG9F7sS+cuiq95eSN8XxM6s1KI",
+	"RujUuTxNSHNrX0UJoudgN2nJJ8TSTrEjZvp0rHG9Im5p5nJUgOhGlmDRjZFwKv313VCAoydQNdLfrJzy",
+	"p5w7zfc0QngoXfn+qfeux8RP866jk2+iNOoedg85f6mho8oHBi8W7xIt60ILTnbYZXcPjczOSE8fmOnL",
+	"aXQAKEGuaUO557NfUUcLRrQmdy/U6XoRxHnIZSk4OuJsZYiMopV2TN00/LbOOwalLhevWJpJr1LFoXVf",
+	"3YkChXynwBalU2FPez+4jCyw9dB8BOsyr2KO1NAZTfNwfyO9enZP5z7Ru5oPD992hoMxLJB0bJv85Vqm",
+	"5IB7XqaBnTzMLe934YCTDDA7XoomDRVyjSwHwWnWryOcJqcTxAaqrUpWA4mAYm7Hb4SXHtztuWTr1g9E",
+	"BWvRh757ZbBnwvs/qzp2/aQVMRkERV9YgSSHsa1MRoWCIIQPC3tilUr27y2voFQl8HcC33dDtirrrXO4",
+	"ppBAV34DJp5+3SwH9pZS+alo3XLumNFwBy+vupFAgPLO7MrnbwrbEFwrvPcWurk7a8VgO8dYcItnBa/h",
+	"9sE6qF32LfBkrQ+pLDHY+//uihDGU/mrrKl4QbsdbCM9vxAK/PDE5SObT1FCehLolJGBaIMStKQs54Q/",
+	"78BIciz+Zy2t5vpwZoXlCp/fx8COXvFRHrWzLWNmFU70Dp7QFk5pYBNLOfcuPCgXwMrl3DkGfpwR8f3g",
+	"H2Z0SRqncT+hke6B/0fB+4Rq28PrVNy/PZan1eBep7BWdystNkfdJrF138RignnTC+7I7K6DWYWkV4l3",
+	"mH8udL7MYZRSbGTdMUtZN61NvB/J1nOIEBZ7jSBaM95NOSkBhNcbXn13I7SWZW7jfMQX13wvrNAUm+Y9",
+	"ZVzfhAYx3KnjAaTp3s5YGFN0hRejZnCBk/BLsq+xvC65LuPmsmaF0JZLcDY8mPu7VAG0uhXLGPNJpyoe",
+	"STP9cs1DjxMCpDo415MHOlelAJzlXkUVwXvOVaTaNOSh7NuQq8s0nDMcmwKc/IweTjM8k8ijfeyVREpR",
+	"qzLuLWMYTvdMOol2Or+QoI4/7oyUxgs4Sldqi7Umc7lX+B3qCMCfzSk9azSokzA5b/F+nnyVCT8Nlixx",
+	"XBP9PrYzp5jj5tSh+WQ/J8dCj1D5NK/8DskKn/rf19JOckvvzNKvQUrJSoiZeR6GDuIu9R0RbsKeWqQn",
+	"a/qlY/1iPTn5c0ABU57sLqYqzDrLVIaY0KvX1RyO7XZmvmK75zicuJXdyx4dhpy31zyNDNmun6uuurxT",
+	"BK1QQXQkgW7nUF24qLiEAm2oWSL8el/2ExXMZJ30YkEGPNgzYRwL608buaoVb3tzz/WcTkPUqGY1K4q/",
+	"FJUALobdPKR9GLMBJMEEmll3cBw3jG+5rI3tEXb04vjAuIfTfV4/GJf4nZ/rqCdQU0zpXMY0eDRsg9cO",
+	"UeGhjAN442LWv6JQVbvPjE/fPEF7EjWWa+I1lj1Ob0u6ovVrzP1Xi3sMaDKV4YfZ+t2iN7ISy07J2Xc2",
+	"GXiGHMnG6AuKu4rUDl3Te5fU6GaEjL7xXG3wZkVkkB5b6Vi1uRxmW+5rrDvHR860KFqNlq1bfhjvu69O",
+	"s3qIg82wxE0XSitHxXTeb/DsaHk2vQm+Yjp+Dp4zPrNs2BR3tOjSNV1O+lGBn1NMYgk5IJkSUHDd5ca6",
+	"917hOF1arD/WdqUWefYdS6Hgt9kzF/KfXsCVEygBymme0VnI/XFP8At4xycEDL+191hgziCVr9h9H3rs",
+	"zDV/GCpMlCA/G+2F5f4WFJd8bEyk774a+X2FasizQBtXB06QBwKQSTrcy1QZpepzaU0MxZOZRpFBx3tO",
+	"DC+xbzuPiqP5OBAS3+EIeHEW4a5dSCERVQF/z/n6Y9Hk24CUaCk/5Siht/xjiYndAjsXlGiLnNbKWmGI",
+	"LamxcBFlnTZPjyTVHud81kpZpmpQ+iRyRZMyBM9UTDiytkLf8Op9b8py8bXUxl4hPkT5Mh84PExz6JFM",
+	"qBwkV5yrNX/OZ81d8d9g6voF5qf+u4A9St5zbijndTG6zVCVxSsKkAyC+Y2o2S2OiTvNPv4TW0vKRNdo",
+	"UUgz9OYg47FLtIqpOYUG8yROIe7skVygx9b5g7IPIOONd0Fjf+sFOzhHDQdhd0R/Z6aSOblJKk9R34gs",
+	"EvhL8qhgbf47R9/kZOZTZYF6SOJeV2LvcmERMwiZHweeL6TOFlSop9HiBjYHCKqzcKeexWXKUa+E+dHb",
+	"DtNTSopmdNA8YV2o+8oqhfnG4B0qxIrblXOxWpESE1xdQBxCIClRB6bLwpwGK1WvtGgwtdeq4Ye9qNO1",
+	"yDOZxK7jdPuJZA4driYcZbPuis/wr7VDglv88ac0orQb1gOfoQYqbZ2iAuM/9oqq0z47/wNjFdZtRpuK",
+	"5Ro15BCXyKRluq0Ttp15JfK7uYGifA3g4HNpulmk8VAkN25e8cEwXXIM3dbpkxInWO0gloa5HvcuNu8m",
+	"TG0ZRL8EaXBa3nsrDpQVgTVc6u4xHYmkSotsHah8BaYpGRDgc6LqYKkwrB/k2MpeAWSzl4frQKmxNWK8",
+	"ztnidg+3CUkbvr8W+6aCS8TbPDOpo+kjecy9/urqObOuIxjZVL2lO1fazlVyKjpr3qnppi1UbbWqzAln",
+	"4m/ReQgDLZlpix3jhr3+9sXzf3z91VcXJ9Tm+iGuydUB5zPd0GKfMM5KUcg9r7wcs8QNJIedYfEuqgbP",
+	"yO/XPQBhQcf5YvKoTZPjnFOGmxsqWg2SAB8s/aff/82bH+36zZuf3FpC50xqulR3C92xI6YruWCE658/",
+	"/pmcDVD2efQIJ3j0aOma/vxJ/zMIX48epevmyZQE9ubNj62EqeHzCPB7JXwiHLkx3Lyp/fghVxIcZipD",
+	"UfDIfpfYD6iIcdQJBRr52d6FykD/AN3KP9Z/+uz9Zyj0EFB2ofHpI1gfUieLEJNYa2/yaCrYIWkrGNGh",
+	"qtPQ9Mw+8eYkwjWXi7+L9U6pt8mMRPQpqgLBVJc0oUv/jkKmKPRJNWrR37pW1r9g+mZDgB08+tGqeKOq",
+	"G1lvXQmKB1Ua7dL0lieC5O0VSlMyWnrcSRPfdCTh8lJQsf3J3Linzh9y8SImfNirg2ijhfilg2giQ67r",
+	"dyxfvd9654sku23/wITJXcIaNELJOoimlLS+4HWt0LHVWT3T/hjHM3Y5UJIMel7qfXjKhEJNXVoblAB4",
+	"RLlj6OzdKn0HTG6V98TDm2GxXIgaMqj/uGj4oUukv1zwYoP/3G2QZ/ON/mVBRIrZ95pYy9UtudWZ0sLf",
+	"v3yeWW+jDCVnPH5JI5eBKaLM/xHN/JSK9zZggZP28AoYuLe6yX8ky5n+JRTTcRXRgljiVB2Uw8W9b7rS",
+	"O63xypS/KF6h+oFc6mrBrFLVBfvqju+bytn/2b9+sP4X8emfPysff/rxv6z//Pjzx4X47PMvHj/mX3zG",
+	"P/7i04/FJ3/+/LPH4uPNn75Yf1J+8tkn688++exPn39RfPrZx+vP/vTFv3yAL7fFkwUB6iuKP1n8zxWk",
+	"Y1xdvbhevQZgO5zyRkK9onfv8MW6UfTAri0v8CoXey6rxRP/0//jedVFofbd8P5Xtw9PFjtrG/Pk8vL2",
+	"9vYi7nIJgSSyXlnVFrtLP8+75ZCNv7gOIenk945XQucucLHo7pIr/Pbyq1evIaHORXfjLJ4sHl88vvgY",
+	"xleNqHkjF08Wn+JPeP3ucN8v3W21ePLru+Xicid4ZXfuj72wWhb+kxa8PLj/m1u+3Qp9gTlJ6KebTy69",
+	"FunyV3eHvJv6dhm7VF/+2mf1R3qiO/Dlr54xT7cGiaWSvC7EClUsZrI1+GJONqD8nzVdkRPNGtjrySa9",
+	"oMW5DS95eSON0of5PVz0SdRhqwXGlF5G4Qejb8Zy28ZfGrnCw36pleVWxF/m7eRUs8u1ujuhqTAnNb68",
+	"dbUeZnUZkckEvQ0/TZLbqPFeWF5yyy9JM9w1pQy2461zv2uXWqH/K6hUUAE2+vIrqtjf5X6/3MiaV9Ie",
+	"sg2cITX9EW0hxHUvfZWsdMseXf4KCTnfHevhimW4rwXsI7JGc+kvm8HXtrn8tWv2bvrriMpLsW63l10K",
+	"xfBzZbm5tHf1JWomL3/tkYH7PEJz//eue9ziZq9K4ZcdkuFOfb78lf6NJsLHvqy3cK3cCB2NABmAtYQT",
+	"TVW7nF9xuE6uS0g7GDV6uhPF28VyQWZOQ/LBJ48fJ5IVRr0YXVuYpgzunM8efzajQ61s3KkUG54MZP6+",
+	"flur25p9pbUif3/T7vccuODiJWYNMey7vzK5YWI4hTRx9jTLtwYlu3ZdycIlSA7o+emdQ5pPE9OhcYNE",
+	"vtKiwOCN7gPVoIoIc0wFrolpm6Y6jH8+1EXyx0tevM0PBg3GHwFIpBVnkwykNzhle7Hv3SHurr/Uokd+",
+	"vZplmZ8veWsVlnPLNZD7RuncqAMxY/QZWQb0X5F8mmz0a+/PPos+1vKy2PGqEj2GerSPuBssSQCyS19T",
+	"a1WFolq+gUtVDyjudzW71pbqNkKvqXljdiq+OtBSiHuY4DJDHkZ/X95yaeFZ7colYtGuRGevhzep3y5/",
+	"BSkX2aS20w1UxNWs4BXehLISg19LabgxYr8ef9EH3daDH70WGPBWqG1N3vm+BYgjZvi3Ayn6OSlo9aUq",
+	"dwoXjTIJ/viS30bOR1fYmN5hwtgvFQrG+ExwZthIori8W61ljazq1wWpzPoKMfo4fue9WybejBh7MFH1",
+	"z6q4Up1itbC3Sr9dxI9Gq1vxLsnfkW8/nliLE/ijdUx642itdBdDPl7Rl7xkPon0in3LK8CKKNmVezX1",
+	"lka3ysfvD7rrmmKP4Rahh+O75eLz94mf65pKBfp7D6b/9P1N/0roG1kIBiYcpbmW1YF9X4fw6Xvf2F8j",
+	"cWpwqYf3bSBYihPR/La370qnE3aSjwKSN7M7jbFg8Ju9Yztel5XQQd/ZCA2UBePvVeR5CpKOiVIgQwOq",
+	"GSZKKvZiLtirnXfjUKBDChllS8jcoxp0qYAh3CRY5sH5IMUSR1/QANUnHOKtqFeOjazWqjysnFJB81t7",
+	"R9bPEa8Csz2Mv+/Jvr0me6G3uW+owMnxwdGDI/XVSe65RkpVeAXl5qA6EtmPXWhU6rsP8jvy+XLdf/Cl",
+	"G6Gwf6yRy3c8vlacntOMf+m9DjpjQ6x7Wzz5MdK6/fjTu5/gm77BKJ4ff41USU8uLzF4fqeMvVy8W/46",
+	"UDPFH38K9ParV081Wt4Avt799O7/HwASXuwTnOEBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
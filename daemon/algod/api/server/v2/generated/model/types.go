@@ -14,6 +14,13 @@ const (
 	Api_keyScopes = "api_key.Scopes"
 )
 
+// Defines values for APITokenScopes.
+const (
+	APITokenScopesAdmin         APITokenScopes = "admin"
+	APITokenScopesParticipation APITokenScopes = "participation"
+	APITokenScopesReadOnly      APITokenScopes = "read-only"
+)
+
 // Defines values for AccountSigType.
 const (
 	AccountSigTypeLsig AccountSigType = "lsig"
@@ -21,6 +28,35 @@ const (
 	AccountSigTypeSig  AccountSigType = "sig"
 )
 
+// Defines values for CreateAPITokenRequestScopes.
+const (
+	CreateAPITokenRequestScopesAdmin         CreateAPITokenRequestScopes = "admin"
+	CreateAPITokenRequestScopesParticipation CreateAPITokenRequestScopes = "participation"
+	CreateAPITokenRequestScopesReadOnly      CreateAPITokenRequestScopes = "read-only"
+)
+
+// Defines values for CreateWebhookRequestTxType.
+const (
+	CreateWebhookRequestTxTypeAcfg   CreateWebhookRequestTxType = "acfg"
+	CreateWebhookRequestTxTypeAfrz   CreateWebhookRequestTxType = "afrz"
+	CreateWebhookRequestTxTypeAppl   CreateWebhookRequestTxType = "appl"
+	CreateWebhookRequestTxTypeAxfer  CreateWebhookRequestTxType = "axfer"
+	CreateWebhookRequestTxTypeKeyreg CreateWebhookRequestTxType = "keyreg"
+	CreateWebhookRequestTxTypePay    CreateWebhookRequestTxType = "pay"
+	CreateWebhookRequestTxTypeStpf   CreateWebhookRequestTxType = "stpf"
+)
+
+// Defines values for WebhookTxType.
+const (
+	WebhookTxTypeAcfg   WebhookTxType = "acfg"
+	WebhookTxTypeAfrz   WebhookTxType = "afrz"
+	WebhookTxTypeAppl   WebhookTxType = "appl"
+	WebhookTxTypeAxfer  WebhookTxType = "axfer"
+	WebhookTxTypeKeyreg WebhookTxType = "keyreg"
+	WebhookTxTypePay    WebhookTxType = "pay"
+	WebhookTxTypeStpf   WebhookTxType = "stpf"
+)
+
 // Defines values for AddressRole.
 const (
 	AddressRoleFreezeTarget AddressRole = "freeze-target"
@@ -52,6 +88,25 @@ const (
 	TxTypeStpf   TxType = "stpf"
 )
 
+// Defines values for AssetMetadataVerificationResponseVerdict.
+const (
+	AssetMetadataVerificationResponseVerdictMatch          AssetMetadataVerificationResponseVerdict = "match"
+	AssetMetadataVerificationResponseVerdictMismatch       AssetMetadataVerificationResponseVerdict = "mismatch"
+	AssetMetadataVerificationResponseVerdictNoMetadataHash AssetMetadataVerificationResponseVerdict = "no-metadata-hash"
+	AssetMetadataVerificationResponseVerdictNoUrl          AssetMetadataVerificationResponseVerdict = "no-url"
+	AssetMetadataVerificationResponseVerdictUnreachable    AssetMetadataVerificationResponseVerdict = "unreachable"
+	AssetMetadataVerificationResponseVerdictUnsupportedUrl AssetMetadataVerificationResponseVerdict = "unsupported-url"
+)
+
+// Defines values for CatchupStatusResponseStage.
+const (
+	CatchupStatusResponseStageBlocksDownload      CatchupStatusResponseStage = "blocks-download"
+	CatchupStatusResponseStageInactive            CatchupStatusResponseStage = "inactive"
+	CatchupStatusResponseStageLatestBlockDownload CatchupStatusResponseStage = "latest-block-download"
+	CatchupStatusResponseStageLedgerDownload      CatchupStatusResponseStage = "ledger-download"
+	CatchupStatusResponseStageSwitch              CatchupStatusResponseStage = "switch"
+)
+
 // Defines values for TransactionProofResponseHashtype.
 const (
 	TransactionProofResponseHashtypeSha256    TransactionProofResponseHashtype = "sha256"
@@ -88,12 +143,29 @@ const (
 	GetPendingTransactionsByAddressParamsFormatMsgpack GetPendingTransactionsByAddressParamsFormat = "msgpack"
 )
 
+// Defines values for GetBlockRangeParamsFormat.
+const (
+	GetBlockRangeParamsFormatMsgpack GetBlockRangeParamsFormat = "msgpack"
+)
+
+// Defines values for SubscribeBlocksParamsFormat.
+const (
+	SubscribeBlocksParamsFormatJson    SubscribeBlocksParamsFormat = "json"
+	SubscribeBlocksParamsFormatMsgpack SubscribeBlocksParamsFormat = "msgpack"
+)
+
 // Defines values for GetBlockParamsFormat.
 const (
 	GetBlockParamsFormatJson    GetBlockParamsFormat = "json"
 	GetBlockParamsFormatMsgpack GetBlockParamsFormat = "msgpack"
 )
 
+// Defines values for GetBlockFinalityParamsFormat.
+const (
+	GetBlockFinalityParamsFormatJson    GetBlockFinalityParamsFormat = "json"
+	GetBlockFinalityParamsFormatMsgpack GetBlockFinalityParamsFormat = "msgpack"
+)
+
 // Defines values for GetTransactionProofParamsHashtype.
 const (
 	GetTransactionProofParamsHashtypeSha256    GetTransactionProofParamsHashtype = "sha256"
@@ -106,6 +178,16 @@ const (
 	GetTransactionProofParamsFormatMsgpack GetTransactionProofParamsFormat = "msgpack"
 )
 
+// Defines values for SetLoggingSettingsParamsLevel.
+const (
+	SetLoggingSettingsParamsLevelDebug SetLoggingSettingsParamsLevel = "debug"
+	SetLoggingSettingsParamsLevelError SetLoggingSettingsParamsLevel = "error"
+	SetLoggingSettingsParamsLevelFatal SetLoggingSettingsParamsLevel = "fatal"
+	SetLoggingSettingsParamsLevelInfo  SetLoggingSettingsParamsLevel = "info"
+	SetLoggingSettingsParamsLevelPanic SetLoggingSettingsParamsLevel = "panic"
+	SetLoggingSettingsParamsLevelWarn  SetLoggingSettingsParamsLevel = "warn"
+)
+
 // Defines values for GetLedgerStateDeltaForTransactionGroupParamsFormat.
 const (
 	GetLedgerStateDeltaForTransactionGroupParamsFormatJson    GetLedgerStateDeltaForTransactionGroupParamsFormat = "json"
@@ -124,6 +206,32 @@ const (
 	GetTransactionGroupLedgerStateDeltasForRoundParamsFormatMsgpack GetTransactionGroupLedgerStateDeltasForRoundParamsFormat = "msgpack"
 )
 
+// Defines values for ConvertEncodingParamsType.
+const (
+	ConvertEncodingParamsTypeBlock   ConvertEncodingParamsType = "block"
+	ConvertEncodingParamsTypeDelta   ConvertEncodingParamsType = "delta"
+	ConvertEncodingParamsTypeGenesis ConvertEncodingParamsType = "genesis"
+	ConvertEncodingParamsTypeTxn     ConvertEncodingParamsType = "txn"
+)
+
+// Defines values for ConvertEncodingParamsInputFormat.
+const (
+	ConvertEncodingParamsInputFormatJson    ConvertEncodingParamsInputFormat = "json"
+	ConvertEncodingParamsInputFormatMsgpack ConvertEncodingParamsInputFormat = "msgpack"
+)
+
+// Defines values for ConvertEncodingParamsOutputFormat.
+const (
+	ConvertEncodingParamsOutputFormatJson    ConvertEncodingParamsOutputFormat = "json"
+	ConvertEncodingParamsOutputFormatMsgpack ConvertEncodingParamsOutputFormat = "msgpack"
+)
+
+// Defines values for MergeTransactionsParamsFormat.
+const (
+	MergeTransactionsParamsFormatJson    MergeTransactionsParamsFormat = "json"
+	MergeTransactionsParamsFormatMsgpack MergeTransactionsParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -142,6 +250,27 @@ const (
 	SimulateTransactionParamsFormatMsgpack SimulateTransactionParamsFormat = "msgpack"
 )
 
+// Defines values for SimulateTransactionBatchParamsFormat.
+const (
+	SimulateTransactionBatchParamsFormatJson    SimulateTransactionBatchParamsFormat = "json"
+	SimulateTransactionBatchParamsFormatMsgpack SimulateTransactionBatchParamsFormat = "msgpack"
+)
+
+// APIToken A named API token, without its value.
+type APIToken struct {
+	// AllowedCidrs The networks the token may be used from, in CIDR notation. The token may be used from anywhere if there are none.
+	AllowedCidrs *[]string `json:"allowed-cidrs,omitempty"`
+
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []APITokenScopes `json:"scopes"`
+}
+
+// APITokenScopes defines model for APIToken.Scopes.
+type APITokenScopes string
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -242,6 +371,73 @@ type Account struct {
 // * lsig
 type AccountSigType string
 
+// AccountApplicationChange The local state and parameters of an application of an account, at both rounds of an account diff.
+type AccountApplicationChange struct {
+	// ApplicationId Application ID
+	ApplicationId uint64 `json:"application-id"`
+
+	// LocalStateAfter Stores local state associated with an application.
+	LocalStateAfter *ApplicationLocalState `json:"local-state-after,omitempty"`
+
+	// LocalStateBefore Stores local state associated with an application.
+	LocalStateBefore *ApplicationLocalState `json:"local-state-before,omitempty"`
+
+	// ParamsAfter Stores the global information associated with an application.
+	ParamsAfter *ApplicationParams `json:"params-after,omitempty"`
+
+	// ParamsBefore Stores the global information associated with an application.
+	ParamsBefore *ApplicationParams `json:"params-before,omitempty"`
+}
+
+// AccountAssetChange The holding and parameters of an asset of an account, at both rounds of an account diff.
+type AccountAssetChange struct {
+	// AssetId Asset ID
+	AssetId uint64 `json:"asset-id"`
+
+	// HoldingAfter Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	HoldingAfter *AssetHolding `json:"holding-after,omitempty"`
+
+	// HoldingBefore Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	HoldingBefore *AssetHolding `json:"holding-before,omitempty"`
+
+	// ParamsAfter AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	ParamsAfter *AssetParams `json:"params-after,omitempty"`
+
+	// ParamsBefore AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	ParamsBefore *AssetParams `json:"params-before,omitempty"`
+}
+
+// AccountBoxChange The value of a box held by an application account, at both rounds of an account diff.
+type AccountBoxChange struct {
+	// ApplicationId The application the box belongs to.
+	ApplicationId uint64 `json:"application-id"`
+
+	// Name \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// ValueAfter The value of the box at round to, base64 encoded, unset when the box does not exist.
+	ValueAfter *[]byte `json:"value-after,omitempty"`
+
+	// ValueBefore The value of the box at round from, base64 encoded, unset when the box did not exist.
+	ValueBefore *[]byte `json:"value-before,omitempty"`
+}
+
 // AccountParticipation AccountParticipation describes the parameters used by this account in consensus protocol.
 type AccountParticipation struct {
 	// SelectionParticipationKey \[sel\] Selection public key (if any) currently registered for this round.
@@ -263,6 +459,30 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountParticipationMetrics How an account took part in the agreement through the participation keys of this node.
+type AccountParticipationMetrics struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// CertVotes The number of votes sent by the account in the cert step.
+	CertVotes uint64 `json:"cert-votes"`
+
+	// CredentialsExpected The total weight the credentials of the account were expected to have given its share of the online stake, over all the steps it had a chance to propose or vote in.
+	CredentialsExpected float64 `json:"credentials-expected"`
+
+	// CredentialsWon The total weight of the credentials of the proposals and votes sent by the account.
+	CredentialsWon uint64 `json:"credentials-won"`
+
+	// NextVotes The number of votes sent by the account in the recovery steps.
+	NextVotes uint64 `json:"next-votes"`
+
+	// Proposals The number of block proposals made by the account.
+	Proposals uint64 `json:"proposals"`
+
+	// SoftVotes The number of votes sent by the account in the soft step.
+	SoftVotes uint64 `json:"soft-votes"`
+}
+
 // AccountStateDelta Application state delta.
 type AccountStateDelta struct {
 	Address string `json:"address"`
@@ -271,6 +491,51 @@ type AccountStateDelta struct {
 	Delta StateDelta `json:"delta"`
 }
 
+// AccountTransaction A transaction that touched an account.
+type AccountTransaction struct {
+	// IntraRoundOffset The position of the transaction in the payset of its block.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// Round The round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Txid The ID of the transaction. Accounts touched by inner transactions report the ID of their top-level transaction.
+	Txid string `json:"txid"`
+}
+
+// AgreementAccountStatus How an account with participation keys on this node took part in the round. An account which attempted more proposals or votes than it sent wasn't selected for the others.
+type AgreementAccountStatus struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// ProposalAttempts The number of periods the account attempted to propose a block in.
+	ProposalAttempts uint64 `json:"proposal-attempts"`
+
+	// Proposals The number of block proposals the account was selected for and sent.
+	Proposals uint64 `json:"proposals"`
+
+	// VoteAttempts The number of steps the account attempted to vote in.
+	VoteAttempts uint64 `json:"vote-attempts"`
+
+	// Votes The number of votes the account was selected for and sent.
+	Votes uint64 `json:"votes"`
+}
+
+// AgreementStepVotes The votes of a step of the round.
+type AgreementStepVotes struct {
+	// Period The period of the votes.
+	Period uint64 `json:"period"`
+
+	// Step The step of the votes.
+	Step uint64 `json:"step"`
+
+	// Votes The number of votes.
+	Votes uint64 `json:"votes"`
+
+	// Weight The sum of the weights of the credentials of the votes.
+	Weight uint64 `json:"weight"`
+}
+
 // Application Application index and its parameters
 type Application struct {
 	// Id \[appidx\] application index.
@@ -280,6 +545,27 @@ type Application struct {
 	Params ApplicationParams `json:"params"`
 }
 
+// ApplicationEvent An ARC-28 event emitted by an application.
+type ApplicationEvent struct {
+	// Data The ABI encoded arguments of the event.
+	Data []byte `json:"data"`
+
+	// InnerTxn Whether the event was emitted by an inner application call.
+	InnerTxn *bool `json:"inner-txn,omitempty"`
+
+	// LogIndex The position of the event among the logs of the application call.
+	LogIndex uint64 `json:"log-index"`
+
+	// Round The round of the transaction emitting the event.
+	Round uint64 `json:"round"`
+
+	// Selector The 4 byte selector of the event.
+	Selector []byte `json:"selector"`
+
+	// Txid The ID of the transaction emitting the event. Events emitted by inner application calls report the ID of their top-level transaction.
+	Txid string `json:"txid"`
+}
+
 // ApplicationLocalReference References an account's local state for an application.
 type ApplicationLocalReference struct {
 	// Account Address of the account with the local state.
@@ -366,6 +652,39 @@ type Asset struct {
 	Params AssetParams `json:"params"`
 }
 
+// AssetComplianceEvent A freeze or clawback action applied to an asset holding.
+type AssetComplianceEvent struct {
+	// Amount \[clawback\] The amount of the asset revoked.
+	Amount *uint64 `json:"amount,omitempty"`
+
+	// AssetId The asset the event applies to.
+	AssetId uint64 `json:"asset-id"`
+
+	// InnerTxn Whether the event was emitted by an inner transaction.
+	InnerTxn bool `json:"inner-txn"`
+
+	// Kind The kind of the event, one of:
+	// * freeze
+	// * unfreeze
+	// * clawback
+	Kind string `json:"kind"`
+
+	// Receiver \[clawback\] The account which received the revoked assets.
+	Receiver *string `json:"receiver,omitempty"`
+
+	// Round The round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Sender The sender of the transaction, that is the freeze or clawback account of the asset.
+	Sender string `json:"sender"`
+
+	// Target The account whose holding was frozen, unfrozen or revoked.
+	Target string `json:"target"`
+
+	// Txid The ID of the transaction. Events of inner transactions report the ID of their top-level transaction.
+	Txid string `json:"txid"`
+}
+
 // AssetHolding Describes an asset held by an account.
 //
 // Definition:
@@ -457,6 +776,9 @@ type AvmValue struct {
 
 // Box Box name and its content.
 type Box struct {
+	// CreatedRound The round in which the box was last created. Only set when the node is configured with EnableBoxHistoryIndex and the creation is covered by the index.
+	CreatedRound *uint64 `json:"created-round,omitempty"`
+
 	// Name \[name\] box name, base64 encoded
 	Name []byte `json:"name"`
 
@@ -471,6 +793,9 @@ type Box struct {
 type BoxDescriptor struct {
 	// Name Base64 encoded box name
 	Name []byte `json:"name"`
+
+	// Value Base64 encoded box value, when requested
+	Value *[]byte `json:"value,omitempty"`
 }
 
 // BoxReference References a box of an application.
@@ -492,6 +817,45 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// CreateAPITokenRequest The name, scopes and networks of a named API token.
+type CreateAPITokenRequest struct {
+	// AllowedCidrs The networks the token may be used from, in CIDR notation. The token may be used from anywhere if there are none.
+	AllowedCidrs *[]string `json:"allowed-cidrs,omitempty"`
+
+	// Name The name of the token, made of at most 64 letters, digits, dashes and underscores.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []CreateAPITokenRequestScopes `json:"scopes"`
+}
+
+// CreateAPITokenRequestScopes defines model for CreateAPITokenRequest.Scopes.
+type CreateAPITokenRequestScopes string
+
+// CreateWebhookRequest The URL, secret and filter of a webhook.
+type CreateWebhookRequest struct {
+	// Address Only notify the transactions sent by, or involving, this account.
+	Address *string `json:"address,omitempty"`
+
+	// ApplicationId Only notify the transactions calling or creating this application, or made by it.
+	ApplicationId *uint64 `json:"application-id,omitempty"`
+
+	// AssetId Only notify the transactions transferring, configuring or freezing this asset.
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Secret The key the notifications are signed with. They are not signed if it is not set.
+	Secret *string `json:"secret,omitempty"`
+
+	// TxType Only notify the transactions of this type.
+	TxType *CreateWebhookRequestTxType `json:"tx-type,omitempty"`
+
+	// Url The http or https URL the notifications are posted to.
+	Url string `json:"url"`
+}
+
+// CreateWebhookRequestTxType Only notify the transactions of this type.
+type CreateWebhookRequestTxType string
+
 // DryrunRequest Request data type for dryrun endpoint. Given the Transactions and simulated ledger state upload, run TEAL scripts and return debugging information.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -558,10 +922,24 @@ type DryrunTxnResult struct {
 	Logs                *[][]byte      `json:"logs,omitempty"`
 }
 
-// ErrorResponse An error response with optional data field.
+// ErrorResponse An error response with optional data field. Errors are served as RFC 7807 problem details with the application/problem+json media type.
 type ErrorResponse struct {
-	Data    *map[string]interface{} `json:"data,omitempty"`
-	Message string                  `json:"message"`
+	// Code A stable, machine-readable identifier for the kind of error.
+	Code *string                 `json:"code,omitempty"`
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Detail An explanation of this occurrence of the problem. It is the same as message.
+	Detail  *string `json:"detail,omitempty"`
+	Message string  `json:"message"`
+
+	// Status The HTTP status code of the response.
+	Status *uint64 `json:"status,omitempty"`
+
+	// Title A short summary of the problem type, the reason phrase of the HTTP status.
+	Title *string `json:"title,omitempty"`
+
+	// Type A URI identifying the problem type. It is derived from the error code.
+	Type *string `json:"type,omitempty"`
 }
 
 // EvalDelta Represents a TEAL value delta.
@@ -584,6 +962,144 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// Feature An experimental feature of the node, gated by the network and the release channel of the build.
+type Feature struct {
+	// Available Whether the feature may be enabled on the network of the node by its build.
+	Available bool `json:"available"`
+
+	// Description What the feature is.
+	Description string `json:"description"`
+
+	// Enabled Whether the feature is enabled, i.e. requested and available.
+	Enabled bool `json:"enabled"`
+
+	// Name The name of the feature.
+	Name string `json:"name"`
+
+	// Reason Why the feature isn't available, when it isn't.
+	Reason *string `json:"reason,omitempty"`
+
+	// Requested Whether the configuration of the node requests the feature.
+	Requested bool `json:"requested"`
+}
+
+// FlightRecorderSample A sample of the resource usage of the node. The CPU time and disk I/O cover the second before the sample.
+type FlightRecorderSample struct {
+	// CpuTime The user and system CPU time used by the node, in microseconds.
+	CpuTime uint64 `json:"cpu-time"`
+
+	// DiskReadBlocks The file system blocks read by the node, in 512-byte units on linux. Always 0 on windows.
+	DiskReadBlocks uint64 `json:"disk-read-blocks"`
+
+	// DiskWriteBlocks The file system blocks written by the node, in 512-byte units on linux. Always 0 on windows.
+	DiskWriteBlocks uint64 `json:"disk-write-blocks"`
+
+	// Heap The memory used by heap objects, in bytes.
+	Heap uint64 `json:"heap"`
+
+	// LedgerLag The time elapsed since the ledger advanced to its latest round, in milliseconds.
+	LedgerLag uint64 `json:"ledger-lag"`
+
+	// Memory The memory mapped by the go runtime, in bytes.
+	Memory uint64 `json:"memory"`
+
+	// Round The latest round of the ledger.
+	Round uint64 `json:"round"`
+
+	// Time The time the sample was taken, in seconds since the Unix epoch.
+	Time uint64 `json:"time"`
+
+	// TxpoolSize The number of transactions in the transaction pool.
+	TxpoolSize uint64 `json:"txpool-size"`
+}
+
+// GroupProgramResources An app call of a group, and whether its program accesses the resources of the whole group.
+type GroupProgramResources struct {
+	// App The app ID, zero when the transaction creates the app.
+	App uint64 `json:"app"`
+
+	// GroupIndex The index of the app call in the group.
+	GroupIndex uint64 `json:"group-index"`
+
+	// Shared Whether the program, of version 9 or later, accesses the resources of the whole group. Otherwise, it only accesses the resources named by its own transaction.
+	Shared bool `json:"shared"`
+
+	// Version The version of the approval program.
+	Version uint64 `json:"version"`
+}
+
+// GroupSharedAccount An account available to the programs of a group.
+type GroupSharedAccount struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// GroupSharedApp An app available to the programs of a group.
+type GroupSharedApp struct {
+	// App The app ID.
+	App uint64 `json:"app"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// GroupSharedAsset An asset available to the programs of a group.
+type GroupSharedAsset struct {
+	// Asset The asset ID.
+	Asset uint64 `json:"asset"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// GroupSharedBox A box available to the programs of a group.
+type GroupSharedBox struct {
+	// App The app ID.
+	App uint64 `json:"app"`
+
+	// Name The name of the box.
+	Name []byte `json:"name"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// GroupSharedHolding An asset holding available to the programs of a group. It is only available when a single transaction names both the account and the asset.
+type GroupSharedHolding struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Asset The asset ID.
+	Asset uint64 `json:"asset"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// GroupSharedLocal The local state of an account in an app available to the programs of a group. It is only available when a single transaction names both the account and the app.
+type GroupSharedLocal struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// App The app ID.
+	App uint64 `json:"app"`
+
+	// Sources The indexes in the group of the transactions which make the resource available.
+	Sources []uint64 `json:"sources"`
+}
+
+// HistogramBucket A cumulative bucket of a histogram.
+type HistogramBucket struct {
+	// Count The number of observations not greater than the upper bound.
+	Count uint64 `json:"count"`
+
+	// UpperBound The upper bound of the bucket, in seconds.
+	UpperBound float64 `json:"upper-bound"`
+}
+
 // KvDelta A single Delta containing the key, the previous value and the current value for a single round.
 type KvDelta struct {
 	// Key The key, base64 encoded.
@@ -593,6 +1109,18 @@ type KvDelta struct {
 	Value *[]byte `json:"value,omitempty"`
 }
 
+// LeaseUsage A lease held by a pending transaction of the sender.
+type LeaseUsage struct {
+	// LastValid The last round the lease is held, the last valid round of the transaction.
+	LastValid uint64 `json:"last-valid"`
+
+	// Lease The lease value.
+	Lease []byte `json:"lease"`
+
+	// Txid The ID of the transaction holding the lease.
+	Txid string `json:"txid"`
+}
+
 // LedgerStateDelta Ledger StateDelta object
 type LedgerStateDelta = map[string]interface{}
 
@@ -603,6 +1131,21 @@ type LedgerStateDeltaForTransactionGroup struct {
 	Ids   []string         `json:"Ids"`
 }
 
+// LightBlockHeader The fields of a block header committed to by state proofs.
+type LightBlockHeader struct {
+	// GenesisHash The hash of the genesis block.
+	GenesisHash []byte `json:"genesis-hash"`
+
+	// Round The round of the block.
+	Round uint64 `json:"round"`
+
+	// Seed The sortition seed of the block.
+	Seed []byte `json:"seed"`
+
+	// TransactionCommitment The SHA-256 commitment to the transactions of the block.
+	TransactionCommitment []byte `json:"transaction-commitment"`
+}
+
 // LightBlockHeaderProof Proof of membership and position of a light block header.
 type LightBlockHeaderProof struct {
 	// Index The index of the light block header in the vector commitment tree
@@ -615,6 +1158,24 @@ type LightBlockHeaderProof struct {
 	Treedepth uint64 `json:"treedepth"`
 }
 
+// OptInRequest The assets and applications an account opts in to or out of.
+type OptInRequest struct {
+	// Applications The IDs of the applications.
+	Applications *[]uint64 `json:"applications,omitempty"`
+
+	// Assets The IDs of the assets.
+	Assets *[]uint64 `json:"assets,omitempty"`
+
+	// CloseOut Close the account out of the assets and applications instead of opting in to them.
+	CloseOut *bool `json:"close-out,omitempty"`
+}
+
+// OptInTransactionGroup A group of unsigned transactions built by BuildOptInGroups.
+type OptInTransactionGroup struct {
+	// Txns The msgpack-encoded unsigned transactions of the group, which share a group ID.
+	Txns [][]byte `json:"txns"`
+}
+
 // ParticipationKey Represents a participation key used by the node.
 type ParticipationKey struct {
 	// Address Address the key was generated for.
@@ -642,6 +1203,54 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// ParticipationKeyRenewal A participation key installed by the automatic renewal.
+type ParticipationKeyRenewal struct {
+	// Address The account the key belongs to.
+	Address string `json:"address"`
+
+	// FirstValid The first round the key is valid for.
+	FirstValid uint64 `json:"first-valid"`
+
+	// Id The participation ID of the key.
+	Id string `json:"id"`
+
+	// Keyreg The msgpack encoded signed transaction registering the key, without signature unless signed is set.
+	Keyreg []byte `json:"keyreg"`
+
+	// LastValid The last round the key is valid for.
+	LastValid uint64 `json:"last-valid"`
+
+	// Round The latest round of the ledger when the key was installed.
+	Round uint64 `json:"round"`
+
+	// Signed Whether the node signed and broadcast the key registration. Otherwise it must be signed and submitted externally.
+	Signed bool `json:"signed"`
+}
+
+// PeerDuplicateLatency The histogram of how long after their first arrival the messages of a tag arrive from a peer.
+type PeerDuplicateLatency struct {
+	// Buckets The cumulative buckets of the histogram, ordered by their upper bound.
+	Buckets []HistogramBucket `json:"buckets"`
+
+	// Count The number of messages which arrived from the peer.
+	Count uint64 `json:"count"`
+
+	// Peer The address of the peer.
+	Peer string `json:"peer"`
+
+	// Sum The sum of the delays of the messages, in seconds.
+	Sum float64 `json:"sum"`
+
+	// Tag The tag of the messages, AV for votes and PP for proposals.
+	Tag string `json:"tag"`
+}
+
+// PendingTransactionBatch A set of pending transactions of a sender which do not conflict with each other.
+type PendingTransactionBatch struct {
+	// Txids The IDs of the transactions in the batch, in the order they were submitted.
+	Txids []string `json:"txids"`
+}
+
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 type PendingTransactionResponse struct {
 	// ApplicationIndex The application index if the transaction was found and it created an application.
@@ -687,20 +1296,56 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
-// ScratchChange A write operation into a scratch slot.
-type ScratchChange struct {
-	// NewValue Represents an AVM value.
-	NewValue AvmValue `json:"new-value"`
+// ProposerStats The expected and actual block proposals of an account over a range of rounds.
+type ProposerStats struct {
+	// Address The address of the account.
+	Address string `json:"address"`
 
-	// Slot The scratch slot written.
-	Slot uint64 `json:"slot"`
+	// ExpectedProposals The number of blocks of the range the account was expected to propose given its share of the online stake. An account proposing far fewer blocks than expected is likely offline or misconfigured.
+	ExpectedProposals float64 `json:"expected-proposals"`
+
+	// Proposals The number of blocks of the range proposed by the account.
+	Proposals uint64 `json:"proposals"`
+
+	// Stake The online stake of the account, in microAlgos, as of the balance round of max-round.
+	Stake uint64 `json:"stake"`
 }
 
-// SimulateRequest Request type for simulation endpoint.
-type SimulateRequest struct {
+// RecentTransaction A recent transaction matching a lease or note prefix lookup.
+type RecentTransaction struct {
+	// IntraRoundOffset The position of the transaction in the payset of its block.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// LastValid The last round the transaction was valid for. Its lease, if any, is held until this round.
+	LastValid uint64 `json:"last-valid"`
+
+	// Round The round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Sender The sender of the transaction.
+	Sender string `json:"sender"`
+
+	// Txid The ID of the transaction.
+	Txid string `json:"txid"`
+}
+
+// ScratchChange A write operation into a scratch slot.
+type ScratchChange struct {
+	// NewValue Represents an AVM value.
+	NewValue AvmValue `json:"new-value"`
+
+	// Slot The scratch slot written.
+	Slot uint64 `json:"slot"`
+}
+
+// SimulateRequest Request type for simulation endpoint.
+type SimulateRequest struct {
 	// AllowEmptySignatures Allows transactions without signatures to be simulated as if they had correct signatures.
 	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
 
+	// AllowInsufficientFees Allows transaction groups which do not pay enough fees to be simulated, and reports the additional fees each transaction must pay.
+	AllowInsufficientFees *bool `json:"allow-insufficient-fees,omitempty"`
+
 	// AllowMoreLogging Lifts limits on log opcode usage during simulation.
 	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
 
@@ -713,6 +1358,18 @@ type SimulateRequest struct {
 	// ExtraOpcodeBudget Applies extra opcode budget during simulation for each transaction group.
 	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
 
+	// FixSigners Evaluates the transactions without a signature as if they were signed by the current authorizer of their sender, and reports that authorizer when it differs from the one the transaction names. Requires allow-empty-signatures.
+	FixSigners *bool `json:"fix-signers,omitempty"`
+
+	// PopulateResources Reports the resources to add to the foreign arrays of the app calls of each transaction group, and of extra app calls, so that the group no longer accesses unnamed resources. Requires allow-unnamed-resources.
+	PopulateResources *bool `json:"populate-resources,omitempty"`
+
+	// Session The name of a simulation session. Successful transaction groups are applied to the state of the session, and later simulations in the same session are evaluated on top of that state. Sessions are scoped to the API token used, or to the address of the client when the node requires no token, and discarded once unused for a while.
+	Session *string `json:"session,omitempty"`
+
+	// SourceMaps The source maps of the programs, used to locate the opcodes of the execution trace in their source. Requires exec-trace-config to be enabled.
+	SourceMaps *[]SimulateSourceMap `json:"source-maps,omitempty"`
+
 	// TxnGroups The transaction groups to simulate.
 	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
 }
@@ -723,6 +1380,30 @@ type SimulateRequestTransactionGroup struct {
 	Txns []json.RawMessage `json:"txns"`
 }
 
+// SimulateResourceArrays Resources to add to the foreign arrays of an app call. Boxes name their application by ID, and an empty box reference of application 0 adds to the box I/O budget.
+type SimulateResourceArrays struct {
+	// Accounts The accounts to add to the app call.
+	Accounts *[]string `json:"accounts,omitempty"`
+
+	// Apps The applications to add to the app call.
+	Apps *[]uint64 `json:"apps,omitempty"`
+
+	// Assets The assets to add to the app call.
+	Assets *[]uint64 `json:"assets,omitempty"`
+
+	// Boxes The boxes to add to the app call.
+	Boxes *[]BoxReference `json:"boxes,omitempty"`
+}
+
+// SimulateSourceMap The source map of a program.
+type SimulateSourceMap struct {
+	// ProgramHash SHA512_256 hash digest of the program.
+	ProgramHash []byte `json:"program-hash"`
+
+	// Sourcemap JSON of the source map, as returned by TealCompile.
+	Sourcemap map[string]interface{} `json:"sourcemap"`
+}
+
 // SimulateTraceConfig An object that configures simulation execution trace.
 type SimulateTraceConfig struct {
 	// Enable A boolean option for opting in execution trace features simulation endpoint.
@@ -740,12 +1421,18 @@ type SimulateTraceConfig struct {
 
 // SimulateTransactionGroupResult Simulation result for an atomic transaction group
 type SimulateTransactionGroupResult struct {
+	// AdditionalFeeRequired The total additional fee the transaction group must pay to cover the minimum fee and the fees of its inner transactions. Only present if allow-insufficient-fees was requested.
+	AdditionalFeeRequired *uint64 `json:"additional-fee-required,omitempty"`
+
 	// AppBudgetAdded Total budget added during execution of app calls in the transaction group.
 	AppBudgetAdded *uint64 `json:"app-budget-added,omitempty"`
 
 	// AppBudgetConsumed Total budget consumed during execution of app calls in the transaction group.
 	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
 
+	// ExtraResourceArrays The resources which do not fit in the app calls of the group, one entry per extra app call to add to the group. Only present if populate-resources was requested.
+	ExtraResourceArrays *[]SimulateResourceArrays `json:"extra-resource-arrays,omitempty"`
+
 	// FailedAt If present, indicates which transaction in this group caused the failure. This array represents the path to the failing transaction. Indexes are zero based, the first element indicates the top-level transaction, and successive elements indicate deeper inner transactions.
 	FailedAt *[]uint64 `json:"failed-at,omitempty"`
 
@@ -761,15 +1448,24 @@ type SimulateTransactionGroupResult struct {
 
 // SimulateTransactionResult Simulation result for an individual transaction
 type SimulateTransactionResult struct {
+	// AdditionalFeeRequired The amount this transaction's fee must be increased by, to cover its share of the group's minimum fee and the fees of inner transactions it issued. Only present if allow-insufficient-fees was requested.
+	AdditionalFeeRequired *uint64 `json:"additional-fee-required,omitempty"`
+
 	// AppBudgetConsumed Budget used during execution of an app call transaction. This value includes budged used by inner app calls spawned by this transaction.
 	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
 
 	// ExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
 	ExecTrace *SimulationTransactionExecTrace `json:"exec-trace,omitempty"`
 
+	// FixedSigner The address which must sign this transaction, when it differs from the authorizer the transaction names. Only present if fix-signers was requested.
+	FixedSigner *string `json:"fixed-signer,omitempty"`
+
 	// LogicSigBudgetConsumed Budget used during execution of a logic sig transaction.
 	LogicSigBudgetConsumed *uint64 `json:"logic-sig-budget-consumed,omitempty"`
 
+	// PopulatedResourceArrays Resources to add to the foreign arrays of an app call. Boxes name their application by ID, and an empty box reference of application 0 adds to the box I/O budget.
+	PopulatedResourceArrays *SimulateResourceArrays `json:"populated-resource-arrays,omitempty"`
+
 	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 	TxnResult PendingTransactionResponse `json:"txn-result"`
 
@@ -806,17 +1502,26 @@ type SimulationEvalOverrides struct {
 	// AllowEmptySignatures If true, transactions without signatures are allowed and simulated as if they were properly signed.
 	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
 
+	// AllowInsufficientFees If true, transaction groups which do not pay enough fees are simulated, and the missing fees are reported.
+	AllowInsufficientFees *bool `json:"allow-insufficient-fees,omitempty"`
+
 	// AllowUnnamedResources If true, allows access to unnamed resources during simulation.
 	AllowUnnamedResources *bool `json:"allow-unnamed-resources,omitempty"`
 
 	// ExtraOpcodeBudget The extra opcode budget added to each transaction group during simulation
 	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
 
+	// FixSigners If true, transactions without signatures are evaluated with the current authorizer of their sender.
+	FixSigners *bool `json:"fix-signers,omitempty"`
+
 	// MaxLogCalls The maximum log calls one can make during simulation
 	MaxLogCalls *uint64 `json:"max-log-calls,omitempty"`
 
 	// MaxLogSize The maximum byte number to log during simulation
 	MaxLogSize *uint64 `json:"max-log-size,omitempty"`
+
+	// PopulateResources If true, the resources to add to the foreign arrays of the group are reported.
+	PopulateResources *bool `json:"populate-resources,omitempty"`
 }
 
 // SimulationOpcodeTraceUnit The set of trace information and effect from evaluating a single opcode.
@@ -827,6 +1532,9 @@ type SimulationOpcodeTraceUnit struct {
 	// ScratchChanges The writes into scratch slots.
 	ScratchChanges *[]ScratchChange `json:"scratch-changes,omitempty"`
 
+	// SourceLocation The position of an opcode in the source of its program.
+	SourceLocation *SimulationSourceLocation `json:"source-location,omitempty"`
+
 	// SpawnedInners The indexes of the traces for inner transactions spawned by this opcode, if any.
 	SpawnedInners *[]uint64 `json:"spawned-inners,omitempty"`
 
@@ -840,6 +1548,18 @@ type SimulationOpcodeTraceUnit struct {
 	StateChanges *[]ApplicationStateOperation `json:"state-changes,omitempty"`
 }
 
+// SimulationSourceLocation The position of an opcode in the source of its program.
+type SimulationSourceLocation struct {
+	// Column The column of the opcode, starting at 0.
+	Column uint64 `json:"column"`
+
+	// Line The line of the opcode, starting at 0.
+	Line uint64 `json:"line"`
+
+	// Source The name of the source file, from the source map of the program.
+	Source string `json:"source"`
+}
+
 // SimulationTransactionExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
 type SimulationTransactionExecTrace struct {
 	// ApprovalProgramHash SHA512_256 hash digest of the approval program executed in transaction.
@@ -894,6 +1614,30 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// SubmissionWarning A potential problem found in a submitted transaction, which does not prevent its submission.
+type SubmissionWarning struct {
+	// Code Identifies the kind of problem: last-valid-too-close, fee-at-minimum-during-congestion, or missing-lease-on-repeated-payment.
+	Code string `json:"code"`
+
+	// Index Index of the transaction in the submitted group.
+	Index uint64 `json:"index"`
+
+	// Message Describes the problem.
+	Message string `json:"message"`
+}
+
+// Subsystem A subsystem of the node which can be stopped and started while it runs.
+type Subsystem struct {
+	// Description What the subsystem does, and what happens while it is stopped.
+	Description string `json:"description"`
+
+	// Name The name of the subsystem.
+	Name string `json:"name"`
+
+	// Running Whether the subsystem is running.
+	Running bool `json:"running"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -905,6 +1649,18 @@ type TealKeyValue struct {
 // TealKeyValueStore Represents a key-value store for use in an application.
 type TealKeyValueStore = []TealKeyValue
 
+// TealTemplateParameter A parameter of a TEAL template, along with its value in a program.
+type TealTemplateParameter struct {
+	// Description What the parameter controls.
+	Description string `json:"description"`
+
+	// Name Name of the parameter, such as TMPL_FEE.
+	Name string `json:"name"`
+
+	// Value Value of the parameter in the program: a decimal integer, an address, base64 encoded bytes or an opcode name.
+	Value string `json:"value"`
+}
+
 // TealValue Represents a TEAL value.
 type TealValue struct {
 	// Bytes \[tb\] bytes value.
@@ -925,6 +1681,36 @@ type Version struct {
 	Versions       []string     `json:"versions"`
 }
 
+// Webhook A webhook registered on the node, without its secret.
+type Webhook struct {
+	// Address Only notify the transactions sent by, or involving, this account.
+	Address *string `json:"address,omitempty"`
+
+	// ApplicationId Only notify the transactions calling or creating this application, or made by it.
+	ApplicationId *uint64 `json:"application-id,omitempty"`
+
+	// AssetId Only notify the transactions transferring, configuring or freezing this asset.
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Configured Whether the webhook is set in the node's configuration file, in which case it cannot be deleted.
+	Configured bool `json:"configured"`
+
+	// Id The ID of the webhook.
+	Id string `json:"id"`
+
+	// Signed Whether the notifications are signed with a secret.
+	Signed bool `json:"signed"`
+
+	// TxType Only notify the transactions of this type.
+	TxType *WebhookTxType `json:"tx-type,omitempty"`
+
+	// Url The URL the notifications are posted to.
+	Url string `json:"url"`
+}
+
+// WebhookTxType Only notify the transactions of this type.
+type WebhookTxType string
+
 // AccountID defines model for account-id.
 type AccountID = string
 
@@ -955,6 +1741,9 @@ type CurrencyLessThan uint64
 // ExcludeCloseTo defines model for exclude-close-to.
 type ExcludeCloseTo = bool
 
+// Fields defines model for fields.
+type Fields = string
+
 // Format defines model for format.
 type Format string
 
@@ -991,6 +1780,11 @@ type TxID = string
 // TxType defines model for tx-type.
 type TxType string
 
+// APITokensResponse defines model for APITokensResponse.
+type APITokensResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
 // AccountApplicationResponse defines model for AccountApplicationResponse.
 type AccountApplicationResponse struct {
 	// AppLocalState Stores local state associated with an application.
@@ -1023,18 +1817,182 @@ type AccountAssetResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// AccountDiffResponse defines model for AccountDiffResponse.
+type AccountDiffResponse struct {
+	// Address The account address.
+	Address string `json:"address"`
+
+	// AmountAfter \[algo\] total number of MicroAlgos in the account at round to.
+	AmountAfter uint64 `json:"amount-after"`
+
+	// AmountBefore \[algo\] total number of MicroAlgos in the account at round from.
+	AmountBefore uint64 `json:"amount-before"`
+
+	// Applications The applications which local state or parameters changed, by application ID.
+	Applications []AccountApplicationChange `json:"applications"`
+
+	// Assets The assets which holding or parameters changed, by asset ID.
+	Assets []AccountAssetChange `json:"assets"`
+
+	// Boxes The boxes which value changed, held by the account as an application account, by name.
+	Boxes []AccountBoxChange `json:"boxes"`
+
+	// From The first round of the diff.
+	From uint64 `json:"from"`
+
+	// To The last round of the diff.
+	To uint64 `json:"to"`
+}
+
+// AccountOnlinenessResponse defines model for AccountOnlinenessResponse.
+type AccountOnlinenessResponse struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// BalanceRound The round whose online stakes weigh the votes of the round.
+	BalanceRound uint64 `json:"balance-round"`
+
+	// Eligible Whether the account votes in the round: it is online at the balance round, with a non-zero stake and participation keys valid in the round.
+	Eligible bool `json:"eligible"`
+
+	// FirstEligibleRound The first round after the latest one in which the account votes, if any.
+	FirstEligibleRound *uint64 `json:"first-eligible-round,omitempty"`
+
+	// LatestRound The latest round of the ledger of the node.
+	LatestRound uint64 `json:"latest-round"`
+
+	// Online Whether the account is online at the balance round.
+	Online bool `json:"online"`
+
+	// PendingKeyreg The ID of the pending key registration the projected state of the account results from, if any.
+	PendingKeyreg *string `json:"pending-keyreg,omitempty"`
+
+	// Projected Whether the balance round is past the latest round, so that the state of the account is projected.
+	Projected bool `json:"projected"`
+
+	// Round The round the participation is projected in.
+	Round uint64 `json:"round"`
+
+	// VoteFirstValid The first round of validity of the participation keys of the account at the balance round.
+	VoteFirstValid *uint64 `json:"vote-first-valid,omitempty"`
+
+	// VoteLastValid The last round of validity of the participation keys of the account at the balance round.
+	VoteLastValid *uint64 `json:"vote-last-valid,omitempty"`
+
+	// VotingStake The online stake of the account at the balance round, in microalgos, rewards included.
+	VotingStake uint64 `json:"voting-stake"`
+}
+
 // AccountResponse Account information at a given round.
 //
 // Definition:
 // data/basics/userBalance.go : AccountData
 type AccountResponse = Account
 
+// AccountTransactionsResponse defines model for AccountTransactionsResponse.
+type AccountTransactionsResponse struct {
+	// Since The first round covered by the account transaction index.
+	Since        uint64               `json:"since"`
+	Transactions []AccountTransaction `json:"transactions"`
+}
+
+// AgreementAccountsResponse defines model for AgreementAccountsResponse.
+type AgreementAccountsResponse struct {
+	Accounts []AgreementAccountStatus `json:"accounts"`
+
+	// Round The round of the agreement.
+	Round uint64 `json:"round"`
+}
+
+// AgreementStatusResponse defines model for AgreementStatusResponse.
+type AgreementStatusResponse struct {
+	// Period The period of the agreement.
+	Period uint64 `json:"period"`
+
+	// ProposalsSeen The number of distinct block proposals seen in the round.
+	ProposalsSeen uint64 `json:"proposals-seen"`
+
+	// Round The round of the agreement.
+	Round uint64 `json:"round"`
+
+	// Step The step of the agreement.
+	Step  uint64               `json:"step"`
+	Votes []AgreementStepVotes `json:"votes"`
+}
+
+// ApplicationEventsResponse defines model for ApplicationEventsResponse.
+type ApplicationEventsResponse struct {
+	// CurrentRound The latest round of the node at the time of the query, up to which the events were searched unless max-round is set.
+	CurrentRound uint64             `json:"current-round"`
+	Events       []ApplicationEvent `json:"events"`
+
+	// Since The first round covered by the application event index.
+	Since uint64 `json:"since"`
+}
+
 // ApplicationResponse Application index and its parameters
 type ApplicationResponse = Application
 
+// AssetMetadataVerificationResponse defines model for AssetMetadataVerificationResponse.
+type AssetMetadataVerificationResponse struct {
+	// AssetId The asset identifier.
+	AssetId uint64 `json:"asset-id"`
+
+	// ContentHash The hash of the fetched metadata, computed with hash-algorithm.
+	ContentHash *[]byte `json:"content-hash,omitempty"`
+
+	// ContentLength The size of the fetched metadata, in bytes.
+	ContentLength *uint64 `json:"content-length,omitempty"`
+
+	// Error Why fetching the metadata failed, when the verdict is unreachable or unsupported-url.
+	Error *string `json:"error,omitempty"`
+
+	// HashAlgorithm The hash algorithm content-hash was computed with, sha256 or sha512_256. On a mismatch, it is sha256.
+	HashAlgorithm *string `json:"hash-algorithm,omitempty"`
+
+	// MetadataHash The on-chain metadata hash of the asset.
+	MetadataHash *[]byte `json:"metadata-hash,omitempty"`
+
+	// Url The URL of the asset.
+	Url string `json:"url"`
+
+	// Verdict The outcome of the verification:
+	// * match: the metadata matches the metadata hash.
+	// * mismatch: the metadata doesn't match the metadata hash, it may have been tampered with.
+	// * no-url: the asset has no URL.
+	// * no-metadata-hash: the asset has no metadata hash.
+	// * unsupported-url: the URL of the asset can't be fetched by the node.
+	// * unreachable: fetching the metadata failed, see error.
+	Verdict AssetMetadataVerificationResponseVerdict `json:"verdict"`
+}
+
+// AssetMetadataVerificationResponseVerdict The outcome of the verification:
+// * match: the metadata matches the metadata hash.
+// * mismatch: the metadata doesn't match the metadata hash, it may have been tampered with.
+// * no-url: the asset has no URL.
+// * no-metadata-hash: the asset has no metadata hash.
+// * unsupported-url: the URL of the asset can't be fetched by the node.
+// * unreachable: fetching the metadata failed, see error.
+type AssetMetadataVerificationResponseVerdict string
+
 // AssetResponse Specifies both the unique identifier and the parameters for an asset
 type AssetResponse = Asset
 
+// BlockFinalityResponse defines model for BlockFinalityResponse.
+type BlockFinalityResponse struct {
+	// BlockHeader Block header data.
+	BlockHeader map[string]interface{} `json:"block-header"`
+
+	// Cert Agreement certificate for the block.
+	Cert map[string]interface{} `json:"cert"`
+
+	// LightBlockHeaderProof Proof of membership and position of a light block header.
+	LightBlockHeaderProof LightBlockHeaderProof `json:"light-block-header-proof"`
+
+	// StateProof Represents a state proof and its corresponding message
+	StateProof StateProof `json:"state-proof"`
+}
+
 // BlockHashResponse defines model for BlockHashResponse.
 type BlockHashResponse struct {
 	// BlockHash Block header hash.
@@ -1062,6 +2020,9 @@ type BoxResponse = Box
 // BoxesResponse defines model for BoxesResponse.
 type BoxesResponse struct {
 	Boxes []BoxDescriptor `json:"boxes"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter. Only set when more boxes remain.
+	NextToken *string `json:"next-token,omitempty"`
 }
 
 // CatchpointAbortResponse An catchpoint abort response.
@@ -1070,12 +2031,81 @@ type CatchpointAbortResponse struct {
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CatchpointGenerateResponse defines model for CatchpointGenerateResponse.
+type CatchpointGenerateResponse struct {
+	// Round The round of the catchpoint.
+	Round uint64 `json:"round"`
+
+	// Upload Whether the catchpoint file is uploaded once written.
+	Upload bool `json:"upload"`
+}
+
+// CatchpointLabelResponse defines model for CatchpointLabelResponse.
+type CatchpointLabelResponse struct {
+	// Catchpoint The catchpoint label.
+	Catchpoint string `json:"catchpoint"`
+
+	// Round The round of the catchpoint.
+	Round uint64 `json:"round"`
+}
+
 // CatchpointStartResponse An catchpoint start response.
 type CatchpointStartResponse struct {
 	// CatchupMessage Catchup start response string
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CatchupStatusResponse defines model for CatchupStatusResponse.
+type CatchupStatusResponse struct {
+	// AcquiredBlocks The number of blocks downloaded.
+	AcquiredBlocks uint64 `json:"acquired-blocks"`
+
+	// Catchpoint The catchpoint being caught up to.
+	Catchpoint string `json:"catchpoint"`
+
+	// EstimatedStageCompletionTime The estimated time the current stage completes at, in seconds since the epoch, extrapolated from its progress so far. It is omitted for the short stages whose progress is not measured, and until the stage makes progress. The ledger download is by far the longest stage.
+	EstimatedStageCompletionTime *uint64 `json:"estimated-stage-completion-time,omitempty"`
+
+	// ProcessedAccounts The number of accounts downloaded and processed.
+	ProcessedAccounts uint64 `json:"processed-accounts"`
+
+	// ProcessedBytes The number of bytes of the catchpoint file processed.
+	ProcessedBytes uint64 `json:"processed-bytes"`
+
+	// ProcessedKvs The number of KVs downloaded and processed.
+	ProcessedKvs uint64 `json:"processed-kvs"`
+
+	// Stage The current stage of the catchup: ledger-download downloads and verifies the accounts and KVs of the catchpoint, latest-block-download downloads the block of the catchpoint round, blocks-download downloads the blocks preceding it, and switch moves the node to the new ledger.
+	Stage CatchupStatusResponseStage `json:"stage"`
+
+	// StageStartTime The time the current stage started, in seconds since the epoch.
+	StageStartTime uint64 `json:"stage-start-time"`
+
+	// StartTime The time the catchup started, in seconds since the epoch.
+	StartTime uint64 `json:"start-time"`
+
+	// TotalAccounts The number of accounts of the catchpoint.
+	TotalAccounts uint64 `json:"total-accounts"`
+
+	// TotalBlocks The number of blocks to download.
+	TotalBlocks uint64 `json:"total-blocks"`
+
+	// TotalKvs The number of KVs of the catchpoint.
+	TotalKvs uint64 `json:"total-kvs"`
+
+	// VerifiedAccounts The number of accounts verified.
+	VerifiedAccounts uint64 `json:"verified-accounts"`
+
+	// VerifiedBlocks The number of blocks verified.
+	VerifiedBlocks uint64 `json:"verified-blocks"`
+
+	// VerifiedKvs The number of KVs verified.
+	VerifiedKvs uint64 `json:"verified-kvs"`
+}
+
+// CatchupStatusResponseStage The current stage of the catchup: ledger-download downloads and verifies the accounts and KVs of the catchpoint, latest-block-download downloads the block of the catchpoint round, blocks-download downloads the blocks preceding it, and switch moves the node to the new ledger.
+type CatchupStatusResponseStage string
+
 // CompileResponse defines model for CompileResponse.
 type CompileResponse struct {
 	// Hash base32 SHA512_256 of program bytes (Address style)
@@ -1088,6 +2118,23 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// ComplianceEventsResponse defines model for ComplianceEventsResponse.
+type ComplianceEventsResponse struct {
+	Events []AssetComplianceEvent `json:"events"`
+}
+
+// CreateAPITokenResponse defines model for CreateAPITokenResponse.
+type CreateAPITokenResponse struct {
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// Token The value of the token, to set in the X-Algo-API-Token header.
+	Token string `json:"token"`
+}
+
+// CreateWebhookResponse A webhook registered on the node, without its secret.
+type CreateWebhookResponse = Webhook
+
 // DisassembleResponse defines model for DisassembleResponse.
 type DisassembleResponse struct {
 	// Result disassembled Teal code
@@ -1103,6 +2150,16 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// FeaturesResponse defines model for FeaturesResponse.
+type FeaturesResponse struct {
+	Features []Feature `json:"features"`
+}
+
+// FlightRecordingResponse defines model for FlightRecordingResponse.
+type FlightRecordingResponse struct {
+	Samples []FlightRecorderSample `json:"samples"`
+}
+
 // GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
 type GetBlockTimeStampOffsetResponse struct {
 	// Offset Timestamp offset in seconds.
@@ -1121,9 +2178,60 @@ type LedgerStateDeltaForTransactionGroupResponse = LedgerStateDelta
 // LedgerStateDeltaResponse Ledger StateDelta object
 type LedgerStateDeltaResponse = LedgerStateDelta
 
+// LightBlockHeaderProofBundleResponse defines model for LightBlockHeaderProofBundleResponse.
+type LightBlockHeaderProofBundleResponse struct {
+	// LightBlockHeader The fields of a block header committed to by state proofs.
+	LightBlockHeader LightBlockHeader `json:"light-block-header"`
+
+	// LightBlockHeaderProof Proof of membership and position of a light block header.
+	LightBlockHeaderProof LightBlockHeaderProof `json:"light-block-header-proof"`
+
+	// StateProof Represents a state proof and its corresponding message
+	StateProof StateProof `json:"state-proof"`
+}
+
 // LightBlockHeaderProofResponse Proof of membership and position of a light block header.
 type LightBlockHeaderProofResponse = LightBlockHeaderProof
 
+// LoggingSettingsResponse defines model for LoggingSettingsResponse.
+type LoggingSettingsResponse struct {
+	// DisabledTelemetryCategories The categories of telemetry events which are not sent.
+	DisabledTelemetryCategories []string `json:"disabled-telemetry-categories"`
+
+	// Level The logging level, one of panic, fatal, error, warn, info and debug.
+	Level string `json:"level"`
+
+	// Output Where the log is written: syslog, the path of the file the log is redirected to, or default for the output the node started with.
+	Output string `json:"output"`
+
+	// TelemetryEnabled Whether the node sends telemetry events.
+	TelemetryEnabled bool `json:"telemetry-enabled"`
+}
+
+// MemorySettingsResponse defines model for MemorySettingsResponse.
+type MemorySettingsResponse struct {
+	// Ballast The size of the memory ballast, in bytes.
+	Ballast uint64 `json:"ballast"`
+
+	// GcPercent The current GOGC value, 0 when GOGC is off.
+	GcPercent uint64 `json:"gc-percent"`
+
+	// LiveHeap The size of the live heap, ballast excluded, as of the last garbage collection, in bytes.
+	LiveHeap uint64 `json:"live-heap"`
+
+	// MemoryLimit The current GOMEMLIMIT value, in bytes.
+	MemoryLimit uint64 `json:"memory-limit"`
+
+	// Target The memory, in bytes, the node aims to use. Zero when the node leaves the garbage collector settings alone.
+	Target uint64 `json:"target"`
+}
+
+// MergeTransactionsResponse defines model for MergeTransactionsResponse.
+type MergeTransactionsResponse struct {
+	// Txns The merged signed transactions, one for each distinct transaction in the order they first appear in the request.
+	Txns []json.RawMessage `json:"txns"`
+}
+
 // NodeStatusResponse NodeStatus contains the information about a node status
 type NodeStatusResponse struct {
 	// Catchpoint The current catchpoint that is being caught up to
@@ -1205,12 +2313,126 @@ type NodeStatusResponse struct {
 	UpgradeYesVotes *uint64 `json:"upgrade-yes-votes,omitempty"`
 }
 
+// OptInResponse defines model for OptInResponse.
+type OptInResponse struct {
+	// FailedGroup The index of the first group which failed the simulation, if any.
+	FailedGroup *uint64 `json:"failed-group,omitempty"`
+
+	// FailureMessage The reason the first failing group failed the simulation, if any.
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// MinBalance MicroAlgo minimum balance of the account once all the groups are applied.
+	MinBalance uint64 `json:"min-balance"`
+
+	// Round The round the transaction groups were built and simulated at.
+	Round uint64 `json:"round"`
+
+	// TxnGroups The transaction groups, to be signed and submitted in order.
+	TxnGroups []OptInTransactionGroup `json:"txn-groups"`
+}
+
+// ParticipationChallengeResponse defines model for ParticipationChallengeResponse.
+type ParticipationChallengeResponse struct {
+	// Address Address the participation key belongs to.
+	Address string `json:"address"`
+
+	// Proof The VRF proof of the challenge made with the selection key.
+	//
+	// *Note: this is base64 encoded.*
+	Proof []byte `json:"proof"`
+
+	// SelectionParticipationKey The selection (VRF) public key of the participation key.
+	//
+	// *Note: this is base64 encoded.*
+	SelectionParticipationKey []byte `json:"selection-participation-key"`
+}
+
+// ParticipationExportResponse defines model for ParticipationExportResponse.
+type ParticipationExportResponse struct {
+	// SealedKey The participation key with all of its secrets, sealed to the recipient's transport key.
+	//
+	// *Note: this is base64 encoded.*
+	SealedKey []byte `json:"sealed-key"`
+}
+
+// ParticipationKeyAutoRenewalResponse defines model for ParticipationKeyAutoRenewalResponse.
+type ParticipationKeyAutoRenewalResponse struct {
+	// Enabled Whether the node renews the participation keys about to expire.
+	Enabled bool `json:"enabled"`
+
+	// LeadRounds How many rounds before the participation key of an account expires its successor is installed.
+	LeadRounds uint64 `json:"lead-rounds"`
+
+	// Renewals The latest key installed by the renewal for each account.
+	Renewals []ParticipationKeyRenewal `json:"renewals"`
+
+	// Validity The number of rounds the successor keys are valid for.
+	Validity uint64 `json:"validity"`
+}
+
 // ParticipationKeyResponse Represents a participation key used by the node.
 type ParticipationKeyResponse = ParticipationKey
 
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// ParticipationMetricsResponse defines model for ParticipationMetricsResponse.
+type ParticipationMetricsResponse struct {
+	Metrics []AccountParticipationMetrics `json:"metrics"`
+}
+
+// ParticipationTransportKeyResponse defines model for ParticipationTransportKeyResponse.
+type ParticipationTransportKeyResponse struct {
+	// TransportKey The public key other nodes seal exported participation keys to.
+	//
+	// *Note: this is base64 encoded.*
+	TransportKey []byte `json:"transport-key"`
+}
+
+// PeerDuplicateLatencyResponse defines model for PeerDuplicateLatencyResponse.
+type PeerDuplicateLatencyResponse struct {
+	Peers []PeerDuplicateLatency `json:"peers"`
+}
+
+// PendingBlockResponse defines model for PendingBlockResponse.
+type PendingBlockResponse struct {
+	// AssembledAt The time the block was assembled at, in seconds since the epoch.
+	AssembledAt uint64 `json:"assembled-at"`
+
+	// DeferredGroups The number of pending transaction groups left out of the payset when it was closed early.
+	DeferredGroups uint64 `json:"deferred-groups"`
+
+	// MaxPaysetBytes The maximum encoded size of a payset under the consensus protocol of the block.
+	MaxPaysetBytes uint64 `json:"max-payset-bytes"`
+
+	// PaysetBytes The encoded size of the payset.
+	PaysetBytes uint64 `json:"payset-bytes"`
+
+	// RewardsLevel The projected rewards level of the block.
+	RewardsLevel uint64 `json:"rewards-level"`
+
+	// RewardsRate The projected rewards rate of the block.
+	RewardsRate uint64 `json:"rewards-rate"`
+
+	// RewardsResidue The projected rewards residue of the block.
+	RewardsResidue uint64 `json:"rewards-residue"`
+
+	// Round The round the block was assembled for.
+	Round uint64 `json:"round"`
+
+	// StopReason Why the transaction pool stopped adding transactions to the payset: pool-empty, block-full, timeout, over-budget or block-abandon.
+	StopReason string `json:"stop-reason"`
+
+	// TotalFees The sum of the fees of the top-level transactions in the payset, in microalgos.
+	TotalFees uint64 `json:"total-fees"`
+
+	// TransactionCount The number of top-level transactions in the payset.
+	TransactionCount uint64 `json:"transaction-count"`
+
+	// Txids The IDs of the top-level transactions in the payset, in block order, truncated to max.
+	Txids []string `json:"txids"`
+}
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1230,6 +2452,63 @@ type PostParticipationResponse struct {
 type PostTransactionsResponse struct {
 	// TxId encoding of the transaction hash.
 	TxId string `json:"txId"`
+
+	// Warnings Potential problems found in the submitted transactions. They are reported here when the submission is not strict, and cause the submission to be rejected when it is.
+	Warnings *[]SubmissionWarning `json:"warnings,omitempty"`
+}
+
+// ProposerReportResponse defines model for ProposerReportResponse.
+type ProposerReportResponse struct {
+	Accounts []ProposerStats `json:"accounts"`
+
+	// MaxRound Last round of the report.
+	MaxRound uint64 `json:"max-round"`
+
+	// MinRound First round of the report.
+	MinRound uint64 `json:"min-round"`
+}
+
+// RecentTransactionsResponse defines model for RecentTransactionsResponse.
+type RecentTransactionsResponse struct {
+	// Since The first round covered by the recent transaction index.
+	Since        uint64              `json:"since"`
+	Transactions []RecentTransaction `json:"transactions"`
+}
+
+// RotateAPITokenResponse defines model for RotateAPITokenResponse.
+type RotateAPITokenResponse struct {
+	// PreviousTokenExpiration The time, in seconds since the Unix epoch, until which the previous algod API token is accepted.
+	PreviousTokenExpiration uint64 `json:"previous-token-expiration"`
+
+	// Token The new algod API token.
+	Token string `json:"token"`
+}
+
+// SenderAdvisoryResponse defines model for SenderAdvisoryResponse.
+type SenderAdvisoryResponse struct {
+	// Batches The pending transactions of the sender, partitioned into batches which do not conflict with each other.
+	Batches []PendingTransactionBatch `json:"batches"`
+
+	// LeasesInUse Leases held by pending transactions of the sender.
+	LeasesInUse []LeaseUsage `json:"leases-in-use"`
+
+	// Round The round the advice was computed at.
+	Round uint64 `json:"round"`
+
+	// SuggestedLeases Lease values which are not held by any pending transaction of the sender.
+	SuggestedLeases [][]byte `json:"suggested-leases"`
+}
+
+// ShutdownNodeResponse defines model for ShutdownNodeResponse.
+type ShutdownNodeResponse struct {
+	// Catchpoint The label of the catchpoint written at round. Only present when catchpoint-written is true.
+	Catchpoint *string `json:"catchpoint,omitempty"`
+
+	// CatchpointWritten Whether the node wrote a catchpoint at round while draining. Catchpoints are only written at rounds that are multiples of the catchpoint interval, so this is false for any other round.
+	CatchpointWritten bool `json:"catchpoint-written"`
+
+	// Round The latest round of the ledger when the node began shutting down. When draining, every round up to it is committed to the ledger database.
+	Round uint64 `json:"round"`
 }
 
 // SimulateResponse defines model for SimulateResponse.
@@ -1250,9 +2529,41 @@ type SimulateResponse struct {
 	Version uint64 `json:"version"`
 }
 
+// SnapshotStatusResponse defines model for SnapshotStatusResponse.
+type SnapshotStatusResponse struct {
+	// Error The error the snapshot failed with, if it did.
+	Error *string `json:"error,omitempty"`
+
+	// ParticipationKeys Whether the snapshot holds the participation keys of the node, once it is written.
+	ParticipationKeys *bool `json:"participation-keys,omitempty"`
+
+	// Path The path of the snapshot on the host of the node, once it is written.
+	Path *string `json:"path,omitempty"`
+
+	// Round The round the ledger state of the snapshot is committed to, once it is written.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Running Whether the snapshot is being written.
+	Running bool `json:"running"`
+
+	// Sha256 The hex encoded SHA-256 hash of the snapshot, once it is written.
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// Size The size of the snapshot in bytes, once it is written.
+	Size *uint64 `json:"size,omitempty"`
+
+	// Started When the node started writing the snapshot, in seconds since the Unix epoch.
+	Started uint64 `json:"started"`
+}
+
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
+// SubsystemsResponse defines model for SubsystemsResponse.
+type SubsystemsResponse struct {
+	Subsystems []Subsystem `json:"subsystems"`
+}
+
 // SupplyResponse Supply represents the current supply of MicroAlgos in the system
 type SupplyResponse struct {
 	// CurrentRound Round
@@ -1265,11 +2576,73 @@ type SupplyResponse struct {
 	TotalMoney uint64 `json:"total-money"`
 }
 
+// TealTemplateResponse defines model for TealTemplateResponse.
+type TealTemplateResponse struct {
+	// Description What the template enforces.
+	Description string `json:"description"`
+
+	// Params Values of the template parameters in the program.
+	Params []TealTemplateParameter `json:"params"`
+
+	// Template Name of the template.
+	Template string `json:"template"`
+
+	// Version TEAL version of the program.
+	Version uint64 `json:"version"`
+
+	// Warnings What the program does not enforce, that its holder may not expect.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// TransactionFeeEstimateResponse defines model for TransactionFeeEstimateResponse.
+type TransactionFeeEstimateResponse struct {
+	// BlockFullness The average share, between 0 and 1, of the block capacity used by the recent blocks.
+	BlockFullness float64 `json:"block-fullness"`
+
+	// Blocks The number of recent blocks the estimate covers.
+	Blocks uint64 `json:"blocks"`
+
+	// CongestedBlocks The number of recent blocks using at least 80% of the block capacity.
+	CongestedBlocks uint64 `json:"congested-blocks"`
+
+	// FeePerByteP50 The median fee per byte, in microalgos, paid by the transaction groups of the recent congested blocks, and never less than pool-fee-per-byte.
+	FeePerByteP50 uint64 `json:"fee-per-byte-p50"`
+
+	// FeePerByteP90 The 90th percentile of the fee per byte, in microalgos, paid by the transaction groups of the recent congested blocks, and never less than pool-fee-per-byte.
+	FeePerByteP90 uint64 `json:"fee-per-byte-p90"`
+
+	// FeePerByteP99 The 99th percentile of the fee per byte, in microalgos, paid by the transaction groups of the recent congested blocks, and never less than pool-fee-per-byte.
+	FeePerByteP99 uint64 `json:"fee-per-byte-p99"`
+
+	// MinFee The minimum fee, in microalgos, of a transaction under the current consensus protocol.
+	MinFee uint64 `json:"min-fee"`
+
+	// PoolFeePerByte The minimum fee per byte, in microalgos, a transaction needs to pay to get into the transaction pool of the node, which rises as the pool fills up.
+	PoolFeePerByte uint64 `json:"pool-fee-per-byte"`
+}
+
 // TransactionGroupLedgerStateDeltasForRoundResponse defines model for TransactionGroupLedgerStateDeltasForRoundResponse.
 type TransactionGroupLedgerStateDeltasForRoundResponse struct {
 	Deltas []LedgerStateDeltaForTransactionGroup `json:"Deltas"`
 }
 
+// TransactionGroupResourcesResponse defines model for TransactionGroupResourcesResponse.
+type TransactionGroupResourcesResponse struct {
+	Accounts []GroupSharedAccount `json:"accounts"`
+
+	// AppCreations The indexes of the transactions creating an app. The created app, its account and its local states are available to the later transactions of the group.
+	AppCreations []uint64         `json:"app-creations"`
+	Apps         []GroupSharedApp `json:"apps"`
+
+	// AssetCreations The indexes of the transactions creating an asset. The created asset and its holdings are available to the later transactions of the group.
+	AssetCreations []uint64                `json:"asset-creations"`
+	Assets         []GroupSharedAsset      `json:"assets"`
+	Boxes          []GroupSharedBox        `json:"boxes"`
+	Holdings       []GroupSharedHolding    `json:"holdings"`
+	Locals         []GroupSharedLocal      `json:"locals"`
+	Programs       []GroupProgramResources `json:"programs"`
+}
+
 // TransactionParametersResponse TransactionParams contains the parameters that help a client construct
 // a new transaction.
 type TransactionParametersResponse struct {
@@ -1297,6 +2670,45 @@ type TransactionParametersResponse struct {
 	MinFee uint64 `json:"min-fee"`
 }
 
+// TransactionPoolStatsResponse defines model for TransactionPoolStatsResponse.
+type TransactionPoolStatsResponse struct {
+	// EvictedGroups The number of transaction groups evicted for transaction groups paying a higher fee.
+	EvictedGroups uint64 `json:"evicted-groups"`
+
+	// EvictionPolicy What happens to a transaction group arriving when the pool is full: "fifo" rejects it, "lowest-fee" evicts the pending groups paying a lower fee per byte to make room for it.
+	EvictionPolicy string `json:"eviction-policy"`
+
+	// FeePerByte The minimum fee per byte, in microalgos, a transaction needs to pay to get into the pool.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// FeePriority Whether the pending transaction groups are fed to the block evaluator in decreasing order of fee per byte.
+	FeePriority bool `json:"fee-priority"`
+
+	// MaxFeePerByte The highest fee per byte, in microalgos, paid by a pending transaction group.
+	MaxFeePerByte float64 `json:"max-fee-per-byte"`
+
+	// MaxTransactions The number of transactions the pool holds before it is full.
+	MaxTransactions uint64 `json:"max-transactions"`
+
+	// MaxTransactionsPerSender The number of transactions a single sender may have pending in the pool, zero when unlimited.
+	MaxTransactionsPerSender uint64 `json:"max-transactions-per-sender"`
+
+	// MedianFeePerByte The median fee per byte, in microalgos, paid by the pending transaction groups.
+	MedianFeePerByte float64 `json:"median-fee-per-byte"`
+
+	// MinFeePerByte The lowest fee per byte, in microalgos, paid by a pending transaction group.
+	MinFeePerByte float64 `json:"min-fee-per-byte"`
+
+	// PendingGroups The number of transaction groups pending in the pool.
+	PendingGroups uint64 `json:"pending-groups"`
+
+	// PendingTransactions The number of transactions pending in the pool.
+	PendingTransactions uint64 `json:"pending-transactions"`
+
+	// SenderLimitRejections The number of transaction groups rejected because a sender reached its limit of pending transactions.
+	SenderLimitRejections uint64 `json:"sender-limit-rejections"`
+}
+
 // TransactionProofResponse defines model for TransactionProofResponse.
 type TransactionProofResponse struct {
 	// Hashtype The type of hash function used to create the proof, must be one of:
@@ -1325,13 +2737,24 @@ type TransactionProofResponseHashtype string
 // VersionsResponse algod version information.
 type VersionsResponse = Version
 
+// WebhooksResponse defines model for WebhooksResponse.
+type WebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
 // AccountInformationParams defines parameters for AccountInformation.
 type AccountInformationParams struct {
+	// Fields Comma separated list of the fields of the JSON response to return, such as `amount,assets.asset-id`. Nested fields are named with dotted paths, which apply to each element of the arrays they traverse. Ignored for MessagePack responses.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Round Return the state of the account at the given round rather than the latest one. It must be one of the latest MaxAcctLookback rounds, or of the MaxAccountHistoryRounds rounds preceding them if the node retains the account history. Asset holdings, application local state, created asset parameters and created application parameters are only returned for the latest round, as if exclude were set to `all`.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountInformationParamsFormat defines parameters for AccountInformation.
@@ -1358,11 +2781,56 @@ type AccountAssetInformationParams struct {
 // AccountAssetInformationParamsFormat defines parameters for AccountAssetInformation.
 type AccountAssetInformationParamsFormat string
 
+// GetAccountComplianceEventsParams defines parameters for GetAccountComplianceEvents.
+type GetAccountComplianceEventsParams struct {
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// GetAccountDiffParams defines parameters for GetAccountDiff.
+type GetAccountDiffParams struct {
+	// From The round to diff the account from.
+	From uint64 `form:"from" json:"from"`
+
+	// To The round to diff the account to, not before from.
+	To uint64 `form:"to" json:"to"`
+}
+
+// AccountOnlinenessParams defines parameters for AccountOnlineness.
+type AccountOnlinenessParams struct {
+	// Round The round to project the participation of the account in, after the latest round. Defaults to the next round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
+// GetAccountTransactionsParams defines parameters for GetAccountTransactions.
+type GetAccountTransactionsParams struct {
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Limit Maximum number of results to return. Defaults to 100, and cannot exceed 1000.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSenderAdvisoryParams defines parameters for GetSenderAdvisory.
+type GetSenderAdvisoryParams struct {
+	// Leases Number of lease values to suggest, between 1 and 16. Defaults to 1.
+	Leases *uint64 `form:"leases,omitempty" json:"leases,omitempty"`
+}
+
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
 
+	// Fields Comma separated list of the fields of the JSON response to return, such as `amount,assets.asset-id`. Nested fields are named with dotted paths, which apply to each element of the arrays they traverse. Ignored for MessagePack responses.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetPendingTransactionsByAddressParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -1380,10 +2848,94 @@ type GetApplicationBoxByNameParams struct {
 type GetApplicationBoxesParams struct {
 	// Max Max number of box names to return. If max is not set, or max == 0, returns all box-names.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
+
+	// Prefix Only return the boxes whose name starts with this prefix, base64 encoded.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Next The next-token of the previous page, to get the boxes following it.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// Values Include the values of the boxes.
+	Values *bool `form:"values,omitempty" json:"values,omitempty"`
+}
+
+// WatchApplicationBoxesParams defines parameters for WatchApplicationBoxes.
+type WatchApplicationBoxesParams struct {
+	// Prefix Only watch the boxes whose name starts with this prefix, in the goal app call arg encoding form 'encoding:value'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// GetApplicationEventsParams defines parameters for GetApplicationEvents.
+type GetApplicationEventsParams struct {
+	// Topic Only include the events of this topic, given either as the signature of the event, e.g. Swap(uint64,uint64), or as its hex encoded 4 byte selector.
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Limit Maximum number of results to return. Defaults to 100, and cannot exceed 1000.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAssetComplianceEventsParams defines parameters for GetAssetComplianceEvents.
+type GetAssetComplianceEventsParams struct {
+	// Address Only include events targeting this account.
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// GetPendingBlockParams defines parameters for GetPendingBlock.
+type GetPendingBlockParams struct {
+	// Max Truncated number of transaction IDs to display. If max=0, returns all the transaction IDs of the payset.
+	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
+}
+
+// GetBlockRangeParams defines parameters for GetBlockRange.
+type GetBlockRangeParams struct {
+	// First The first round of the range.
+	First uint64 `form:"first" json:"first"`
+
+	// Last The last round of the range. At most 100 rounds are served at once.
+	Last uint64 `form:"last" json:"last"`
+
+	// Format The encoding of the response. Only msgpack is supported.
+	Format *GetBlockRangeParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBlockRangeParamsFormat defines parameters for GetBlockRange.
+type GetBlockRangeParamsFormat string
+
+// SubscribeBlocksParams defines parameters for SubscribeBlocks.
+type SubscribeBlocksParams struct {
+	// MinRound The first round to stream. If not provided, streaming starts with the next round committed by the node.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *SubscribeBlocksParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// HeaderOnly If true, the blocks are streamed without their payset.
+	HeaderOnly *bool `form:"header-only,omitempty" json:"header-only,omitempty"`
+
+	// Deltas If true, the state delta of every block is streamed along with it.
+	Deltas *bool `form:"deltas,omitempty" json:"deltas,omitempty"`
 }
 
+// SubscribeBlocksParamsFormat defines parameters for SubscribeBlocks.
+type SubscribeBlocksParamsFormat string
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
+	// Fields Comma separated list of the fields of the JSON response to return, such as `amount,assets.asset-id`. Nested fields are named with dotted paths, which apply to each element of the arrays they traverse. Ignored for MessagePack responses.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetBlockParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -1391,6 +2943,15 @@ type GetBlockParams struct {
 // GetBlockParamsFormat defines parameters for GetBlock.
 type GetBlockParamsFormat string
 
+// GetBlockFinalityParams defines parameters for GetBlockFinality.
+type GetBlockFinalityParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetBlockFinalityParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBlockFinalityParamsFormat defines parameters for GetBlockFinality.
+type GetBlockFinalityParamsFormat string
+
 // GetTransactionProofParams defines parameters for GetTransactionProof.
 type GetTransactionProofParams struct {
 	// Hashtype The type of hash function used to create the proof, must be one of:
@@ -1408,6 +2969,24 @@ type GetTransactionProofParamsHashtype string
 // GetTransactionProofParamsFormat defines parameters for GetTransactionProof.
 type GetTransactionProofParamsFormat string
 
+// SetLoggingSettingsParams defines parameters for SetLoggingSettings.
+type SetLoggingSettingsParams struct {
+	// Level The logging level.
+	Level *SetLoggingSettingsParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Output Where the log is written: syslog for the system logger, the absolute path of a file to append the log to, or default for the output the node started with.
+	Output *string `form:"output,omitempty" json:"output,omitempty"`
+
+	// EnableTelemetryCategories A comma-separated list of the categories of telemetry events to send again.
+	EnableTelemetryCategories *string `form:"enable-telemetry-categories,omitempty" json:"enable-telemetry-categories,omitempty"`
+
+	// DisableTelemetryCategories A comma-separated list of the categories of telemetry events not to send.
+	DisableTelemetryCategories *string `form:"disable-telemetry-categories,omitempty" json:"disable-telemetry-categories,omitempty"`
+}
+
+// SetLoggingSettingsParamsLevel defines parameters for SetLoggingSettings.
+type SetLoggingSettingsParamsLevel string
+
 // GetLedgerStateDeltaForTransactionGroupParams defines parameters for GetLedgerStateDeltaForTransactionGroup.
 type GetLedgerStateDeltaForTransactionGroupParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1435,9 +3014,96 @@ type GetTransactionGroupLedgerStateDeltasForRoundParams struct {
 // GetTransactionGroupLedgerStateDeltasForRoundParamsFormat defines parameters for GetTransactionGroupLedgerStateDeltasForRound.
 type GetTransactionGroupLedgerStateDeltasForRoundParamsFormat string
 
+// ConvertEncodingParams defines parameters for ConvertEncoding.
+type ConvertEncodingParams struct {
+	// Type The kind of object to convert.
+	Type ConvertEncodingParamsType `form:"type" json:"type"`
+
+	// InputFormat The encoding of the request body. Defaults to msgpack.
+	InputFormat *ConvertEncodingParamsInputFormat `form:"input-format,omitempty" json:"input-format,omitempty"`
+
+	// OutputFormat The encoding of the response. Defaults to json.
+	OutputFormat *ConvertEncodingParamsOutputFormat `form:"output-format,omitempty" json:"output-format,omitempty"`
+
+	// Canonical When true, a MessagePack encoded request body must be canonically encoded.
+	Canonical *bool `form:"canonical,omitempty" json:"canonical,omitempty"`
+}
+
+// ConvertEncodingParamsType defines parameters for ConvertEncoding.
+type ConvertEncodingParamsType string
+
+// ConvertEncodingParamsInputFormat defines parameters for ConvertEncoding.
+type ConvertEncodingParamsInputFormat string
+
+// ConvertEncodingParamsOutputFormat defines parameters for ConvertEncoding.
+type ConvertEncodingParamsOutputFormat string
+
+// GetFlightRecordingParams defines parameters for GetFlightRecording.
+type GetFlightRecordingParams struct {
+	// Since Only return the samples taken at or after this time, in seconds since the Unix epoch.
+	Since *uint64 `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// SetMemorySettingsParams defines parameters for SetMemorySettings.
+type SetMemorySettingsParams struct {
+	// Target The memory, in bytes, the node aims to use, or 0 to stop managing the garbage collector.
+	Target *uint64 `form:"target,omitempty" json:"target,omitempty"`
+
+	// Ballast The size of the memory ballast, in bytes.
+	Ballast *uint64 `form:"ballast,omitempty" json:"ballast,omitempty"`
+}
+
+// SetParticipationKeyAutoRenewalParams defines parameters for SetParticipationKeyAutoRenewal.
+type SetParticipationKeyAutoRenewalParams struct {
+	// Enabled Whether the node renews the participation keys about to expire.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty"`
+
+	// LeadRounds How many rounds before the participation key of an account expires its successor is installed.
+	LeadRounds *uint64 `form:"lead-rounds,omitempty" json:"lead-rounds,omitempty"`
+
+	// Validity The number of rounds the successor keys are valid for. It must be larger than the lead.
+	Validity *uint64 `form:"validity,omitempty" json:"validity,omitempty"`
+}
+
+// ProveParticipationChallengeParams defines parameters for ProveParticipationChallenge.
+type ProveParticipationChallengeParams struct {
+	// Challenge The base64 encoded challenge to prove, of at most 1024 bytes.
+	Challenge []byte `form:"challenge" json:"challenge"`
+}
+
+// ExportParticipationKeyByIDParams defines parameters for ExportParticipationKeyByID.
+type ExportParticipationKeyByIDParams struct {
+	// Recipient The base64 encoded transport key of the node that will import the participation key.
+	Recipient []byte `form:"recipient" json:"recipient"`
+
+	// Keep When true, the participation key stays installed on this node after it is exported. It must then not be online on both nodes at once.
+	Keep *bool `form:"keep,omitempty" json:"keep,omitempty"`
+}
+
+// GetProposerReportParams defines parameters for GetProposerReport.
+type GetProposerReportParams struct {
+	// MinRound First round of the report. Defaults to 1000 rounds before max-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Last round of the report. Defaults to the latest round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Limit Number of top online accounts to report on. Defaults to 100.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// Drain When true, the node drains before shutting down.
+	Drain *bool `form:"drain,omitempty" json:"drain,omitempty"`
+}
+
+// CreateSnapshotParams defines parameters for CreateSnapshot.
+type CreateSnapshotParams struct {
+	// ExcludeKeys When true, the participation key registry is left out of the snapshot.
+	ExcludeKeys *bool `form:"exclude-keys,omitempty" json:"exclude-keys,omitempty"`
 }
 
 // TealCompileTextBody defines parameters for TealCompile.
@@ -1449,11 +3115,32 @@ type TealCompileParams struct {
 	Sourcemap *bool `form:"sourcemap,omitempty" json:"sourcemap,omitempty"`
 }
 
+// RawTransactionParams defines parameters for RawTransaction.
+type RawTransactionParams struct {
+	// Strict When set, the submission is rejected if any warning is found in the transactions.
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+
+	// IdempotencyKey When set, a retry of a successful submission with the same key and the same transactions within the idempotency window of the node returns the original response, instead of failing because the transactions are already in the pool or the ledger. Reusing a key for different transactions is rejected. Keys are scoped to the API token used, or to the address of the client when the node requires no token.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// MergeTransactionsParams defines parameters for MergeTransactions.
+type MergeTransactionsParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *MergeTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// MergeTransactionsParamsFormat defines parameters for MergeTransactions.
+type MergeTransactionsParamsFormat string
+
 // GetPendingTransactionsParams defines parameters for GetPendingTransactions.
 type GetPendingTransactionsParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
 
+	// Fields Comma separated list of the fields of the JSON response to return, such as `amount,assets.asset-id`. Nested fields are named with dotted paths, which apply to each element of the arrays they traverse. Ignored for MessagePack responses.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetPendingTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -1463,6 +3150,9 @@ type GetPendingTransactionsParamsFormat string
 
 // PendingTransactionInformationParams defines parameters for PendingTransactionInformation.
 type PendingTransactionInformationParams struct {
+	// Fields Comma separated list of the fields of the JSON response to return, such as `amount,assets.asset-id`. Nested fields are named with dotted paths, which apply to each element of the arrays they traverse. Ignored for MessagePack responses.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *PendingTransactionInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -1470,6 +3160,21 @@ type PendingTransactionInformationParams struct {
 // PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
 type PendingTransactionInformationParamsFormat string
 
+// GetRecentTransactionsParams defines parameters for GetRecentTransactions.
+type GetRecentTransactionsParams struct {
+	// Sender Only include transactions sent by this account. Required when looking up a lease.
+	Sender *string `form:"sender,omitempty" json:"sender,omitempty"`
+
+	// Lease Only include transactions with this lease, base64 encoded.
+	Lease *string `form:"lease,omitempty" json:"lease,omitempty"`
+
+	// NotePrefix Only include transactions whose note starts with this prefix, base64 encoded. The prefix cannot be longer than 32 bytes.
+	NotePrefix *string `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
+
+	// Limit Maximum number of results to return. Defaults to 100, and cannot exceed 1000.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // SimulateTransactionParams defines parameters for SimulateTransaction.
 type SimulateTransactionParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1479,11 +3184,32 @@ type SimulateTransactionParams struct {
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// SimulateTransactionBatchParams defines parameters for SimulateTransactionBatch.
+type SimulateTransactionBatchParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *SimulateTransactionBatchParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SimulateTransactionBatchParamsFormat defines parameters for SimulateTransactionBatch.
+type SimulateTransactionBatchParamsFormat string
+
+// BuildOptInGroupsJSONRequestBody defines body for BuildOptInGroups for application/json ContentType.
+type BuildOptInGroupsJSONRequestBody = OptInRequest
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
 // TealDryrunJSONRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody = DryrunRequest
 
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = CreateAPITokenRequest
+
 // SimulateTransactionJSONRequestBody defines body for SimulateTransaction for application/json ContentType.
 type SimulateTransactionJSONRequestBody = SimulateRequest
+
+// SimulateTransactionBatchJSONRequestBody defines body for SimulateTransactionBatch for application/json ContentType.
+type SimulateTransactionBatchJSONRequestBody = SimulateRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
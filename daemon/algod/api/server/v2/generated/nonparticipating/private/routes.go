@@ -21,15 +21,81 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Rotates the algod API token.
+	// (POST /v2/api-token/rotate)
+	RotateAPIToken(ctx echo.Context) error
+	// Requests the generation of a catchpoint file.
+	// (POST /v2/catchpoints/generate)
+	GenerateCatchpoint(ctx echo.Context) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Get the progress of a catchpoint catchup.
+	// (GET /v2/catchup/{catchpoint}/status)
+	GetCatchupStatus(ctx echo.Context, catchpoint string) error
+	// Gets the logging settings of the node.
+	// (GET /v2/debug/logging)
+	GetLoggingSettings(ctx echo.Context) error
+	// Changes the logging settings of the node.
+	// (PUT /v2/debug/logging)
+	SetLoggingSettings(ctx echo.Context, params SetLoggingSettingsParams) error
+	// Get the status of the experimental features of the node.
+	// (GET /v2/features)
+	GetFeatures(ctx echo.Context) error
+	// Dumps the flight recorder of the node.
+	// (GET /v2/flight-recorder)
+	GetFlightRecording(ctx echo.Context, params GetFlightRecordingParams) error
+	// Gets the memory settings of the node.
+	// (GET /v2/memory)
+	GetMemorySettings(ctx echo.Context) error
+	// Changes the memory settings of the node.
+	// (PUT /v2/memory)
+	SetMemorySettings(ctx echo.Context, params SetMemorySettingsParams) error
+	// Resets the persisted node metrics.
+	// (POST /v2/metrics/reset)
+	ResetPersistedMetrics(ctx echo.Context) error
+	// Gets how late each peer delivers the votes and proposals.
+	// (GET /v2/peers/duplicate-latency)
+	GetPeerDuplicateLatency(ctx echo.Context) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
+	// Gets the state of the latest snapshot of the node.
+	// (GET /v2/snapshots)
+	GetSnapshotStatus(ctx echo.Context) error
+	// Starts writing a snapshot of the node.
+	// (POST /v2/snapshots)
+	CreateSnapshot(ctx echo.Context, params CreateSnapshotParams) error
+	// Get the subsystems of the node which can be stopped and started.
+	// (GET /v2/subsystems)
+	GetSubsystems(ctx echo.Context) error
+	// Starts a stopped subsystem of the node.
+	// (POST /v2/subsystems/{name}/start)
+	StartSubsystem(ctx echo.Context, name string) error
+	// Stops a subsystem of the node.
+	// (POST /v2/subsystems/{name}/stop)
+	StopSubsystem(ctx echo.Context, name string) error
+	// List the named API tokens.
+	// (GET /v2/tokens)
+	ListAPITokens(ctx echo.Context) error
+	// Creates a named API token.
+	// (POST /v2/tokens)
+	CreateAPIToken(ctx echo.Context) error
+	// Deletes a named API token.
+	// (DELETE /v2/tokens/{name})
+	DeleteAPIToken(ctx echo.Context, name string) error
+	// Lists the registered webhooks.
+	// (GET /v2/webhooks)
+	ListWebhooks(ctx echo.Context) error
+	// Registers a webhook.
+	// (POST /v2/webhooks)
+	CreateWebhook(ctx echo.Context) error
+	// Deletes a webhook.
+	// (DELETE /v2/webhooks/{id})
+	DeleteWebhook(ctx echo.Context, id string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -37,6 +103,28 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// RotateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateAPIToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RotateAPIToken(ctx)
+	return err
+}
+
+// GenerateCatchpoint converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateCatchpoint(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateCatchpoint(ctx)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 	var err error
@@ -73,6 +161,167 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// GetCatchupStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatchupStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "catchpoint" -------------
+	var catchpoint string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "catchpoint", runtime.ParamLocationPath, ctx.Param("catchpoint"), &catchpoint)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter catchpoint: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCatchupStatus(ctx, catchpoint)
+	return err
+}
+
+// GetLoggingSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLoggingSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLoggingSettings(ctx)
+	return err
+}
+
+// SetLoggingSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) SetLoggingSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetLoggingSettingsParams
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", ctx.QueryParams(), &params.Level)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter level: %s", err))
+	}
+
+	// ------------- Optional query parameter "output" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "output", ctx.QueryParams(), &params.Output)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter output: %s", err))
+	}
+
+	// ------------- Optional query parameter "enable-telemetry-categories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enable-telemetry-categories", ctx.QueryParams(), &params.EnableTelemetryCategories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enable-telemetry-categories: %s", err))
+	}
+
+	// ------------- Optional query parameter "disable-telemetry-categories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "disable-telemetry-categories", ctx.QueryParams(), &params.DisableTelemetryCategories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter disable-telemetry-categories: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetLoggingSettings(ctx, params)
+	return err
+}
+
+// GetFeatures converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeatures(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFeatures(ctx)
+	return err
+}
+
+// GetFlightRecording converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFlightRecording(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFlightRecordingParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFlightRecording(ctx, params)
+	return err
+}
+
+// GetMemorySettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMemorySettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetMemorySettings(ctx)
+	return err
+}
+
+// SetMemorySettings converts echo context to params.
+func (w *ServerInterfaceWrapper) SetMemorySettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetMemorySettingsParams
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target", ctx.QueryParams(), &params.Target)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
+	}
+
+	// ------------- Optional query parameter "ballast" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ballast", ctx.QueryParams(), &params.Ballast)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ballast: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetMemorySettings(ctx, params)
+	return err
+}
+
+// ResetPersistedMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetPersistedMetrics(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ResetPersistedMetrics(ctx)
+	return err
+}
+
+// GetPeerDuplicateLatency converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerDuplicateLatency(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerDuplicateLatency(ctx)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -88,11 +337,176 @@ func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeout: %s", err))
 	}
 
+	// ------------- Optional query parameter "drain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drain", ctx.QueryParams(), &params.Drain)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter drain: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ShutdownNode(ctx, params)
 	return err
 }
 
+// GetSnapshotStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSnapshotStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSnapshotStatus(ctx)
+	return err
+}
+
+// CreateSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSnapshot(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSnapshotParams
+	// ------------- Optional query parameter "exclude-keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude-keys", ctx.QueryParams(), &params.ExcludeKeys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude-keys: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateSnapshot(ctx, params)
+	return err
+}
+
+// GetSubsystems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSubsystems(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSubsystems(ctx)
+	return err
+}
+
+// StartSubsystem converts echo context to params.
+func (w *ServerInterfaceWrapper) StartSubsystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartSubsystem(ctx, name)
+	return err
+}
+
+// StopSubsystem converts echo context to params.
+func (w *ServerInterfaceWrapper) StopSubsystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StopSubsystem(ctx, name)
+	return err
+}
+
+// ListAPITokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAPITokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListAPITokens(ctx)
+	return err
+}
+
+// CreateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAPIToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateAPIToken(ctx)
+	return err
+}
+
+// DeleteAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAPIToken(ctx, name)
+	return err
+}
+
+// ListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListWebhooks(ctx)
+	return err
+}
+
+// CreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebhook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateWebhook(ctx)
+	return err
+}
+
+// DeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteWebhook(ctx, id)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -121,214 +535,492 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/v2/api-token/rotate", wrapper.RotateAPIToken, m...)
+	router.POST(baseURL+"/v2/catchpoints/generate", wrapper.GenerateCatchpoint, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET(baseURL+"/v2/catchup/:catchpoint/status", wrapper.GetCatchupStatus, m...)
+	router.GET(baseURL+"/v2/debug/logging", wrapper.GetLoggingSettings, m...)
+	router.PUT(baseURL+"/v2/debug/logging", wrapper.SetLoggingSettings, m...)
+	router.GET(baseURL+"/v2/features", wrapper.GetFeatures, m...)
+	router.GET(baseURL+"/v2/flight-recorder", wrapper.GetFlightRecording, m...)
+	router.GET(baseURL+"/v2/memory", wrapper.GetMemorySettings, m...)
+	router.PUT(baseURL+"/v2/memory", wrapper.SetMemorySettings, m...)
+	router.POST(baseURL+"/v2/metrics/reset", wrapper.ResetPersistedMetrics, m...)
+	router.GET(baseURL+"/v2/peers/duplicate-latency", wrapper.GetPeerDuplicateLatency, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
+	router.GET(baseURL+"/v2/snapshots", wrapper.GetSnapshotStatus, m...)
+	router.POST(baseURL+"/v2/snapshots", wrapper.CreateSnapshot, m...)
+	router.GET(baseURL+"/v2/subsystems", wrapper.GetSubsystems, m...)
+	router.POST(baseURL+"/v2/subsystems/:name/start", wrapper.StartSubsystem, m...)
+	router.POST(baseURL+"/v2/subsystems/:name/stop", wrapper.StopSubsystem, m...)
+	router.GET(baseURL+"/v2/tokens", wrapper.ListAPITokens, m...)
+	router.POST(baseURL+"/v2/tokens", wrapper.CreateAPIToken, m...)
+	router.DELETE(baseURL+"/v2/tokens/:name", wrapper.DeleteAPIToken, m...)
+	router.GET(baseURL+"/v2/webhooks", wrapper.ListWebhooks, m...)
+	router.POST(baseURL+"/v2/webhooks", wrapper.CreateWebhook, m...)
+	router.DELETE(baseURL+"/v2/webhooks/:id", wrapper.DeleteWebhook, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XMbN7Lgv4LivirHPo7kz+xaV1vvFDvJ6uLELkvJ3nu2LwFnmiRWQ2ACYCQyPv3v",
-	"V2gAM5gZgBxKjJ3Uez/Z4uCj0Wg0uhv98XGSi1UlOHCtJicfJxWVdAUaJP5F81zUXGesMH8VoHLJKs0E",
-	"n5z4b0RpyfhiMp0w82tF9XIynXC6graN6T+dSPi1ZhKKyYmWNUwnKl/CipqB9aYyrZuR1tlCZG6IUzvE",
-	"2cvJzZYPtCgkKDWE8jUvN4TxvKwLIFpSrmhuPilyzfSS6CVTxHUmjBPBgYg50ctOYzJnUBbqyC/y1xrk",
-	"Jlilmzy9pJsWxEyKEoZwvhCrGePgoYIGqGZDiBakgDk2WlJNzAwGVt9QC6KAynxJ5kLuANUCEcILvF5N",
-	"Tt5NFPACJO5WDuwK/zuXAL9BpqlcgJ58mMYWN9cgM81WkaWdOexLUHWpFcG2uMYFuwJOTK8j8n2tNJkB",
-	"oZy8/eYFefLkyXOzkBXVGgpHZMlVtbOHa7LdJyeTgmrwn4e0RsuFkJQXWdP+7TcvcP5zt8CxrahSED8s",
-	"p+YLOXuZWoDvGCEhxjUscB861G96RA5F+/MM5kLCyD2xjQ+6KeH8n3VXcqrzZSUY15F9IfiV2M9RHhZ0",
-	"38bDGgA67SuDKWkGffcwe/7h46Ppo4c3f3l3mv2n+/PZk5uRy3/RjLsDA9GGeS0l8HyTLSRQPC1Lyof4",
-	"eOvoQS1FXRZkSa9w8+kKWb3rS0xfyzqvaFkbOmG5FKflQihCHRkVMKd1qYmfmNS8NGzKjOaonTBFKimu",
-	"WAHF1HDf6yXLlySnyg6B7cg1K0tDg7WCIkVr8dVtOUw3IUoMXLfCBy7oj4uMdl07MAFr5AZZXgoFmRY7",
-	"rid/41BekPBCae8qtd9lRS6WQHBy88Fetog7bmi6LDdE474WhCpCib+apoTNyUbU5Bo3p2SX2N+txmBt",
-	"RQzScHM696g5vCn0DZARQd5MiBIoR+T5czdEGZ+zRS1Bkesl6KW78ySoSnAFRMz+Bbk22/6/z1//QIQk",
-	"34NSdAFvaH5JgOeigOKInM0JFzogDUdLiEPTM7UOB1fskv+XEoYmVmpR0fwyfqOXbMUiq/qertmqXhFe",
-	"r2YgzZb6K0QLIkHXkqcAsiPuIMUVXQ8nvZA1z3H/22k7spyhNqaqkm4QYSu6/vvDqQNHEVqWpAJeML4g",
-	"es2TcpyZezd4mRQ1L0aIOdrsaXCxqgpyNmdQkGaULZC4aXbBw/h+8LTCVwCOHyQJTjPLDnA4rCM0Y063",
-	"+UIquoCAZI7Ij4654VctLoE3hE5mG/xUSbhiolZNpwSMOPV2CZwLDVklYc4iNHbu0GEYjG3jOPDKyUC5",
-	"4JoyDoVhzgi00GCZVRKmYMLt+s7wFp9RBV8+Td3x7deRuz8X/V3fuuOjdhsbZfZIRq5O89Ud2Lhk1ek/",
-	"Qj8M51ZskdmfBxvJFhfmtpmzEm+if5n982ioFTKBDiL83aTYglNdSzh5zx+Yv0hGzjXlBZWF+WVlf/q+",
-	"LjU7ZwvzU2l/eiUWLD9niwQyG1ijChd2W9l/zHhxdqzXUb3ilRCXdRUuKO8orrMNOXuZ2mQ75r6Eedpo",
-	"u6HicbH2ysi+PfS62cgEkEncVdQ0vISNBAMtzef4z3qO9ETn8jfzT1WVpreu5jHUGjp2VzKaD5xZ4bSq",
-	"SpZTg8S37rP5apgAWEWCti2O8UI9+RiAWElRgdTMDkqrKitFTstMaapxpH+TMJ+cTP5y3Npfjm13dRxM",
-	"/sr0OsdORmS1YlBGq2qPMd4Y0UdtYRaGQeMnZBOW7aHQxLjdRENKzLDgEq4o10etytLhB80BfudmavFt",
-	"pR2L754KlkQ4sQ1noKwEbBveUyRAPUG0EkQrCqSLUsyaH744raoWg/j9tKosPlB6BIaCGayZ0uo+Lp+2",
-	"Jymc5+zlEfk2HBtFccHLjbkcrKhh7oa5u7XcLdbYltwa2hHvKYLbKeSR2RqPBiPmH4LiUK1YitJIPTtp",
-	"xTT+h2sbkpn5fVTnPweJhbhNExcqWg5zVsfBXwLl5ose5QwJx5l7jshpv+/tyMaMEieYW9HK1v20427B",
-	"Y4PCa0krC6D7Yu9SxlFJs40srHfkpiMZXRTm4AwHtIZQ3fqs7TwPUUiQFHowfFWK/PIfVC0PcOZnfqzh",
-	"8cNpyBJoAZIsqVoeTWJSRni82tHGHDHTEBV8MgumOmqWeKjl7VhaQTUNlubgjYslFvXYD5keyIju8hr/",
-	"Q0tiPpuzbVi/HfaIXCADU/Y4u0eGwmj7VkGwM5kGaIUQZGUVfGK07r2gfNFOHt+nUXv0tbUpuB1yi2h2",
-	"6GLNCnWobcLBUnsVCqhnL61Gp2GlIlpbsyoqJd3E127nGoOAC1GREq6g7INgWRaOZhEi1gfnC1+JdQym",
-	"r8R6wBPEGg6yE2YclKs9dnfA99JBJuRuzOPYY5BuFmhkeYXsgYcikJmltVafzoS8HTvu8VlOWhs8oWbU",
-	"4Daa9pCETesqc2czYsezDXoDtc+e27lof/gYxjpYONf0d8CCMqMeAgvdgQ6NBbGqWAkHIP1l9BacUQVP",
-	"HpPzf5w+e/T458fPvjQkWUmxkHRFZhsNinzhlFWi9KaE+8OVobpYlzo++pdPveW2O25sHCVqmcOKVsOh",
-	"rEXYyoS2GTHthljrohlX3QA4iiOCudos2ol97DCgvWTKiJyr2UE2I4Wwop2lIA6SAnYS077La6fZhEuU",
-	"G1kfQrcHKYWMXl2VFFrkosyuQComIs9Lb1wL4lp4eb/q/26hJddUETM32sJrjhJWhLL0mo/n+3boizVv",
-	"cbOV89v1Rlbn5h2zL13ke9OqIhXITK85KWBWLzqq4VyKFaGkwI54R38L2sotbAXnmq6q1/P5YXRngQNF",
-	"dFi2AmVmIraFkRoU5IJb15Ad6qobdQx6+ojxNkudBsBh5HzDczS8HuLYpjX5FeP4CqQ2PA/UegNjCcWi",
-	"Q5Z3V99T6LBT3VMRcAw6XuFntPy8hFLTb4S8aMW+b6Woq4MLef05xy6HusU421Jh+nqjAuOLsuuOtDCw",
-	"H8XW+FkW9MIfX7cGhB4p8hVbLHWgZ72RQswPD2Nslhig+MFqqaXpM9RVfxCFYSa6VgcQwdrBWg5n6Dbk",
-	"a3Qmak0o4aIA3PxaxYWzhAMLvpzjg78O5T29tIrnDAx15bQ2q60rgs/Zg/ui7ZjR3J7QDFGjEo95zSus",
-	"bWWns84RpQRabMgMgBMxcy9m7i0PF0nxLV578caJhhF+0YGrkiIHpaDInKVuJ2i+nb069BY8IeAIcDML",
-	"UYLMqbwzsJdXO+G8hE2GniOKfPHdT+r+Z4BXC03LHYjFNjH0NnYP9yw6hHrc9NsIrj95SHZUAvH3CtEC",
-	"pdkSNKRQuBdOkvvXh2iwi3dHyxVIfKD8XSneT3I3AmpA/Z3p/a7Q1lXCH9Kpt0bCMxvGKRdesIoNVlKl",
-	"s11s2TTq6OBmBQEnjHFiHDgheL2iSttHdcYLtAXa6wTnsUKYmSINcFINMSP/5DWQ4di5uQe5qlWjjqi6",
-	"qoTUUMTWwGG9Za4fYN3MJebB2I3OowWpFewaOYWlYHyHLLsSiyCqm7cn53UyXBy+0Jh7fhNFZQeIFhHb",
-	"ADn3rQLshj5hCUCYahFtCYepHuU0jmjTidKiqgy30FnNm34pNJ3b1qf6x7btkLiobu/tQoBCVzTX3kF+",
-	"bTFrvQGXVBEHB1nRSyN7oBnEvv4PYTaHMVOM55Bto3xU8Uyr8AjsPKR1tZC0gKyAkm6Gg/5oPxP7edsA",
-	"uOOtuis0ZNatK77pLSV7L5otQwscT8WER4JfSG6OoFEFWgJxvXeMXACOHWNOjo7uNUPhXNEt8uPhsu1W",
-	"R0bE2/BKaLPjjh4QZMfRxwCcwEMz9O1RgZ2zVvfsT/EfoNwEjRyx/yQbUKkltOPvtYCEDdV5zAfnpcfe",
-	"exw4yjaTbGwHH0kd2YRB9w2VmuWsQl3nO9gcXPXrTxB9dyUFaMpKKEjwwaqBVdifWIek/pi3UwVH2d6G",
-	"4A+Mb5HllEyhyNMF/hI2qHO/sZ6uganjELpsZFRzP1FOEFDvP2dE8LAJrGmuy40R1PQSNuQaJBBVz1ZM",
-	"a+vB3lV1taiycIDou8aWGd2rZvRNcesz6zkOFSxvuBXTidUJtsN30VMMOuhwukAlRDnCQjZARhSCUQ4w",
-	"pBJm15lzpvfu1J6SOkA6po1P2s31f0910IwrIP8hapJTjipXraGRaYREQQEFSDODEcGaOZ2rS4shKGEF",
-	"VpPELw8e9Bf+4IHbc6bIHK59BIpp2EfHgwdox3kjlO4crgPYQ81xO4tcH/jgYy4+p4X0ecpuVws38pid",
-	"fNMbvHklMmdKKUe4Zvl3ZgC9k7kes/aQRsa5meC4o95yOk/2w3Xjvp+zVV1SfYhXK7iiZSauQEpWwE5O",
-	"7iZmgn99RcvXTTeMroHc0GgOWY4xISPHggvTx4aR7NINW/c6tlpBwaiGckMqCTnYsAcj8qkGxiNiHSLz",
-	"JeULlPSlqBfOI8+Og5y6VtamIms+GCIqDek1z9A6HePczgvbR74YOQio0cX6pm2reVzTZj4X7DTmSg2Q",
-	"1zf1R1+3ppOkqmqQetWqqhY53fCdEVy8I6gF+GknHvkGgqgzQssQX+G2mFNgNvf3sbW3Q8egHE4c+Ai2",
-	"H1NugkZPLjcHkFbsQERCJUHh3RLal5T9KuZhqJ67fNRGaVgNTfC268+J4/c2qegJXjIO2Upw2ESj0xmH",
-	"7/Fj9Djh/ZbojJJGqm9feejA3wOrO88YarwrfnG3+ye0/9SkvhHyUG+ZdsDRcvmIp8Od7+Ruyts+cNKy",
-	"jLwJukCePgNQ0yZxAJOEKiVyhsLWWaGm9qC5Z0QX9dNF/5vGPfkAZ68/bu/xK4wRReMulBWhJC8Zmn4F",
-	"V1rWuX7PKRqXgqVGvJa8Fp02N77wTeL2zYj50Q31nlP0WGtMTlFPizlE7CvfAHiro6oXC1C6p6TMAd5z",
-	"14pxUnOmca6VOS6ZPS8VSHQdOrItV3RD5oYmtCC/gRRkVuuu2I5xakqzsnQvcWYaIubvOdWkBKo0+Z7x",
-	"izUO51/r/ZHloK+FvGywEL/dF8BBMZXFvau+tV/RE9gtf+m8gjGvgP3svSzbwNmJWWYnVv7/fvHvJ+9O",
-	"s/+k2W8Ps+f/4/jDx6c39x8Mfnx88/e//7/uT09u/n7/3/8ttlMe9lgUlYP87KVTac9eot7SPt4MYP9k",
-	"hvsV41mUyEI3jB5tkS8wYtgR0P2uVUsv4T3Xa24I6YqWrDC85Tbk0L9hBmfRno4e1XQ2omfF8mvdUxu4",
-	"A5chESbTY423lqKGDonxeEV8TXQhiHhe5jW3W+mlbxuO4x3DxHzaxKTadDUnBAMWl9R7Nbo/Hz/7cjJt",
-	"Aw2b75PpxH39EKFkVqxj4aQFrGNKnjsgeDDuKVLRjQId5x4Ie9QHzjplhMOuYDUDqZas+vScQmk2i3M4",
-	"H+TgjEVrfsatR7s5P/g2uXFPHmL+6eHWEqCASi9jaSw6ghq2ancToOcvUklxBXxK2BEc9Y01hdEXnTde",
-	"CXSO6RRQ+xRjtKHmHFhC81QRYD1cyCiLSIx+ev787vJXB1eH3MAxuPpzNg+R/m8tyL1vv74gx45hqns2",
-	"stkOHcSiRlRpF27V8SQy3Mwm77FC3nv+nr+EOePMfD95zwuq6fGMKpar41qB/IqWlOdwtBDkxEdwvaSa",
-	"vucDSSuZXyuInSNVPStZTi5DhaQlT5szZTjC+/fvaLkQ799/GDhVDNUHN1WUv9gJMiMIi1pnLuNDJuGa",
-	"ytijlWoi/nFkm9Jl26xWyBa1tWz6jBJu/DjPo1Wl+pG/w+VXVWmWH5ChcnGtZsuI0kJ6WcQIKBYa3N8f",
-	"hLsYJL32dpVagSK/rGj1jnH9gWTv64cPnwDphML+4q58Q5ObCkZbV5KRyX2jCi7cqpWw1pJmFV3E3sbe",
-	"v3+ngVa4+ygvr9DGUZYEu3VCcL1HPQ7VLsDjI70BFo69wwlxcee2l8/uFV8CfsItxDZG3Ghf7G+7X0FQ",
-	"7q23qxfYO9ilWi8zc7ajq1KGxP3ONEl/FkbI8m4Uii1QW3X5kWZA8iXkly5xDawqvZl2untPHSdoetbB",
-	"lE1pZEPqMKkGvizMgNRVQZ0oTvmmn91AgdbeH/gtXMLmQrQ5OfZJZ9CNrlepg4qUGkiXhljDY+vG6G++",
-	"cwdDxb6qfJA6Rit6sjhp6ML3SR9kK/Ie4BDHiKIT/Z1CBJURRFjiT6DgFgs1492J9GPLM1rGzN58kfRG",
-	"nvcT16RVnpznVrgatLrb7yvA/GjiWpEZNXK7cKm9bAR5wMVqRReQkJDDx52RcdqdByEcZNe9F73pxLx/",
-	"oQ3umyjItnFm1hylFDBfDKmgMtPz1/Mz2fdD9zKBGTsdwmYlikmNY6NlOlR2HtlsCsIUaHECBslbgcOD",
-	"0cVIKNksqfJZxzA5mz/Lo2SA3zEjwrY8OGeBq1mQga3JcuN5bv+cDrRLlw3Hp8DxeW9C1XJEDhsj4aN3",
-	"e2w7BEcBqIASFnbhtrEnlDY7Q7tBBo7X83nJOJAs5rUWmEGDa8bNAUY+fkCItcCT0SPEyDgAG9/FcWDy",
-	"gwjPJl/sAyR32SWoHxtf1IO/IR73Zf24jcgjKsPCWeJVK/ccgDpXx+b+6jnc4jCE8SkxbO6KlobNOY2v",
-	"HWSQjgXF1l7yFeeZcT8lzm55ALEXy15rslfRbVYTykwe6LhAtwXimVhnNvAzKvHO1jND71HXdgxDjR1M",
-	"m/jmniIzsUZvH7xarCv1DljScHgwAg1/zRTSK/ZL3eYWmG3TbpemYlSokGScOa8hl5Q4MWbqhASTIpcv",
-	"glw2twKgZ+xoE0M75XenktoVT4aXeXurTdscbT5qKHb8U0couksJ/A2tME32mTd9iSVqp+g6rXQT7wQi",
-	"ZIzoDZsYPtIMn4IUlIBKQdYRorLL2Mup0W0Ab5xz3y0wXmB6H8o39wNPKAkLpjS0RnTvJ/E5zJMUswoK",
-	"MU+vTldybtb3VojmmrLPiNixs8xPvgJ0JZ4zqXSGLxDRJZhG3yhUqr8xTeOyUtfXyubgZUWcN+C0l7DJ",
-	"ClbWcXp183730kz7Q8MSVT1Dfsu4dViZYc7oqAfmlqmtk+7WBb+yC35FD7becafBNDUTS0Mu3Tn+JOei",
-	"x3m3sYMIAcaIY7hrSZRuYZBB5OyQOwZyU/DGf7TN+jo4TIUfe6fXjo/fTd1RdqToWgKDwdZVMHwmMmIJ",
-	"00HK5WFIa+IM0KpixbpnC7WjJjVmupfBwyeq62EBd9cNtgMDKNK+hTlIiJoQmk/WO7oRl8JEhRjZ3UmF",
-	"E9n0pPG/a0rzF2VTOSKY6BZGMJdaMr3Hre9lJ/VidymR2gXDWWvG9ZdPhxTZ2PgNLGN24zxuWj83ikYX",
-	"8YG6ZVOZ79gEllDcQ/IM2HM4FVO+EMeQbJsYyF2UewG0/A42P5m2uJzJzXRyN0N2jPLdiDtw/aY5bFE8",
-	"o6OENWx23qX2RDmtKimuaJk5c3+KUUhx5RgFNvevA5/44olT9sXXp6/eOPBvppO8BCqzRnBLrgrbVX+a",
-	"VdlklIkD4hP9Gw3ca1BWsA82v8mgFz4RXC/BZUwPdINBatf2+Sc4iu7JYB7319rJ+9xLlV3ilhcrqJoH",
-	"q9aYat+rum9U9Iqy0lsxPbQJ3ypc3Lj8wFGuEA5w57eu4MkyOyi7GZzu+OloqWsHT8K5XmNKpLh0wl3C",
-	"JGRF7u2qy4LuKUdZx7jq45lYt7fnyDv5GyE7zN851kffvvyF3WeMB7m7HR4Trka+Ckdf8DwiSEvkl8Uv",
-	"5jQ+eBAetQcPpuSX0n0IAMTfZ+53NBY9eBA1S0a1DsMkUKngdAX3GyfB5EZ8WhWVw/W4C/r0aoWoQ1/v",
-	"NBk2FGofsTy6rx32riVz+CzcLwWUYH7aHUDT23SL7hCYMSfoPOVI3/hIrGzhD0UE77sEYQyHIS1k9iuK",
-	"qY2tlXd4hHi9QstopkqWx9+M+EwZ9sqtL4BpTLBxQrk2I9Ys4VrCaxaMZZqNydXVAzKYI4pMFU0X1uJu",
-	"Jtzxrjn7tQbCCuDafJJ4r/WuOq8c4KgDgdToQsO53MD2xbEd/i46U5jWuy8zIhDbFabQ82AA7svGBOgX",
-	"2ljYW51pXwemcMYB497ifOTow1GzdcZedj0IxukxYwrAeUbn8osn5ogWdGMqm0vxG8TtVmjuiwRg+kTm",
-	"DL32foNQPQvLGHVYSmOtbuvStbPv2u7xunFq4++sC/tFN7nTb3OZxk/1fht5G6VXxdMEOiSnlLDw6aLr",
-	"2ZZgLXi8Al8OTFvtnzUpt+fJRh92HKTjpzIMRTi247en0sE8CN8o6fWMxnJ6G13IwBRsb+cBVgviO/sN",
-	"UE2Inp2dBA5ITVtmM5hUINsA9GE2tFvqNXba0RpNq8AgRYWqy9Q6jZRKRIap+TXlthaa6Wf5leutwL6Y",
-	"mF7XQmL+IRV/Ky4gZytaxhWcIh++CxZswWyZr1pBUEfKDWRLKFoqcrW4msBTh5qzOXk4DYrZud0o2BVT",
-	"bFYCtnhkW8yowuuyeb1oupjlAddLhc0fj2i+rHkhodBLZRGrBGl0TxTyGo+HGehrAE4eYrtHz8kX6Ouh",
-	"2BXcN1h0QtDk5NFzfKmzfzyM3bKuTNs2ll0gz/6n49lxOkZnFzuGYZJu1KNoqhZbpzV9O2w5TbbrmLOE",
-	"Ld2FsvssrSinC4i7F652wGT74m7i60sPL7ywRQaVlmJDmI7PD5oa/pQIWTLsz4JBcrFaMb1yHgFKrAw9",
-	"tUWi7KR+OFux0OX393D5j+hYU3m/gp6t6xOrMXSVcDlG96cf6Aq6aJ0SapNOlax1efNVR8iZz2mHBQ+a",
-	"OgcWN2Yus3SUJdEDbk4qybhG+0et59nfjFosaW7Y31EK3Gz25dNI4YBubm2+H+CfHO8SFMirOOplguy9",
-	"zOL6ki+44NnKcJTifhsiGJzKpAdQ3Ncj5XCyfeixkq8ZJUuSW90hNxpw6jsRHt8y4B1JsVnPXvS498o+",
-	"OWXWMk4etDY79OPbV07KWAkZS1TbHncncUjQksEVOnzHN8mMece9kOWoXbgL9J/3udqLnIFY5s9yVBHw",
-	"RqdtgV5GhP/pe1eUeCB7J5zTrPdZ0+cTB7BFjZZWQuuYzR79QqTRJFEaffAAgX7wYOqEuV8edz9bJvXg",
-	"QTx9W9RwZH5tsXAXvQ77xvbwKxEx4/haKc0TugtSi5jRUqzWfDBHeeaGmpJuXYpPfxcexv057uISPwXv",
-	"37/DLx4P+EcfEZ/5yOMGtk58diUJQgnq8kRJpmi+B851lHwl1mMJp8dJPfH8AVCUQMlIIxOuZFB3KPro",
-	"vNPrIaBRM+oMSmFUpTClemiV/vPg2Sx+ugXbNSuLn9oEG72LRFKeL6OuSTPT8ee2PnCzRMsqo1mal5Rz",
-	"KKPDWQ3tZ6/JRXTNf4mx86wYH9m2X/fKLre3uBbwLpgeKD+hQS/TpZkgxGo3d0ETG1cuREFwnjYlcMsc",
-	"hwXkgqo2v9agdOxo4Afrn49PNob52qIqBHiBNpwj8i1GERtYOvke0XbiE3J1k9PUVSloMcVEYRdfn74i",
-	"dlbbx1a5tEVdFmg66K4iausdn6ynKVgZj0IdP872sDizaqWzpgZLLM+HadFWiWE9BwA0KoTYOSIvg2L+",
-	"NiWIGYJgnji5giIo+WI1CqQJ8x+tab5EQ0nnIkuT/PhqRJ4qVVASvSlt2qQAx3Nn4HYFiWw9oikRegny",
-	"minAuCO4gm5qkSbPjjPU+VQj3eXJmnNLKUd7yBRNwu990e6BswKJf+GMQtZD/J5qsi3mtW9xpnPsFc1I",
-	"2q/0NKiFbhNVNCUrv/fV7CkXnOWYDzQmEGEahHFvJiNSp8YfO9TEndDI4YrWl2oiHhwWkxWnPCN0iBu+",
-	"PwZfzaZa6rB/ali7ugML0MpxNiimvkyas84zrsCldDdEFPJJISMeFjGRI2tec/ckI4xwTphbvjHffnDG",
-	"OAz9u2Qc1W6HNidmW/s5VrDXRldnmiwEKLeebpoX9c70OcKMJwWsPxz5ivc4hvXpMcu2DmzDoU69O5tz",
-	"HzNtX5i2Lg9l83PHN8VOelpVbtJ0Eb145dA1TyI45kThX7UD5Dbjh6NtIbetfqh4nxpCgyt0oYEK7+EB",
-	"YTQF5XrVW42KYCkKWxDrjR9NRsV4BIxXjPv3nPgFkUevBNwYPK+JfiqXVFsRcBRPuwBaNj4zfYamtHsQ",
-	"vOtQ/SycBiW4Rj9HehvbWngJxtE0aAU3yjfEHwpD3YEw8YKWjR9npLIdSlVOiCowOLRX6y7GOAzj9tU0",
-	"uxfAjgK607Y7pqTd9yZK5fuY1cUCdEaLIpZh/yv8SvArKWqUHGANed1kYq8qkmN6u26+vyG1uYlywVW9",
-	"2jKXb3DH6YLikRFqCAtY+h3GeOLZBv/dp7Rx48G5d0SHd9cs9ktyOYxQiUm9hqYzxRbZeEzgnXJ3dLRT",
-	"347Q2/4HpfRSLLqAfA4jaYLLhXsU429fm4sjTII1cJa1V0uTowodU4WvgY5qY5NdpcuV8CobJNvHJ9im",
-	"pPB2M0S6OPAUL79EFFVo8rb3qzUDp2Kp8mToH9UuCYGmZCsLSgZ2W8fFnhF9+J6Rcla0voqHMz67tW5F",
-	"qPcjHwL0nQ9SIRVlzmGlZRZDzDo332G45xg/2naD+4twIXtJ++h3V6nwOp/zFr/3i4degstMVEm4YqL2",
-	"riDeIdOrhPbXTinOJsAxuv6om/PnNj4nTeUXroiTXabTyb/7ybrvEuBabv4AhvPBpg/Kkg6lXWueapuQ",
-	"pv7HqHognVtxTD7oWOphJxt2CqPuKOs6IKuXY8SBYZnW6eSs2OvCjKWvnthRYscuXnQ1nd2zzeiJR6wS",
-	"irVleGLVWEd6Pl9gQdUgO+lwLO8RdwW5xtpLraePBNgnV6mZLKjv/t9ZPhPqdOMg7pJ7bsvoOSy4tOOO",
-	"HwTdB4kjbLGao/H5K08bf04bjnJNFWZ7tiXWuwGco8PI5nPINbvakeTgn0vgQQD91NtlEJZ5kPOANUEV",
-	"mCNvf6tjC9C2HARb4QlyVd8ZnFRQ7SVs7inSoYZo9Zwmoug26dEQA8gdMkMiQsX8pawh2bmwMNVQBmLB",
-	"+yfa7tAmmk0W3gxSdtxyLk+S5uJo03hsmTJe+W/UXKbrXsltMD4glQdhWDgsrX+8xDptqimK7dOrhVo6",
-	"ORsmob526dkwJUXzduITtYHyv/n8M3aWkl1CWBoUX6quqSx8i6jpxVt1si330SB5gS961Qd63szMWm/y",
-	"4Vt1JK0pBmbkpTBiRJaKbuk6cDfeT/eUdVOzVXbQNd3ANQfpSiij/FsKBZkW3vt8GxzbUGF98W6FBJVM",
-	"JW6BSyb4e9tmMMSSChQT+lHnghcukEhYUQOdDPIMpufchuwX9ruPCPYp9XdamBp63V3byccRMDVAYkj1",
-	"c+Juy92RxrcxNjHOQWb+5amfdJCD7L6GVFIUdW4v6PBgNAa50Sk9t7CSqJ0mH66ypyMEEbuXsDm2SpAv",
-	"iuV3MATaSk4W9CBZVW+TD2p+UzG4FwcB73NarqaTSogySzx2nA0zJfYp/pLll1AQc1N4f9tEoULyBdrY",
-	"m9fs6+XGZwasKuBQ3D8i5JTbCAf/sN0t1dGbnN/T2+Zf46xFbZOXOqPa0XsedxXHtKLyjtzMD7Odhykw",
-	"rO6OU9lBduThWyeyNEp6HSnbeTRWKx8+NfdLKbZEZaGIySTn9sXqBR70mOEI47GDxAH4kEmJe+kiqhQx",
-	"l8zbxIyboeKYCidDgDTwMaHLDRRu8CgCmjKJOxyFGh+htsJc6yc0FI/KUlxneIyyJs9sTOky7VT3mvCp",
-	"9dt+ht5mEHgcUeVEiA1Z0oLkQkrIwx7xsCgL1UpIyEqBDkixt9G5NhLhCmMhOCnFgojKKPo2X7N/RYrW",
-	"PxzMVXNO8UKHwN8jigKa56h9CuL6kKbP2CkPVV7SJj+xi87sK1vCJRKUS3biMGQbD+HdUuFx/+qRF8uI",
-	"sQwx5wlk7xKRjsj3ruwWgDnicO02FJ7GKmB219WvxZqqjKzFiuVxdP+5XISSjj0x6o1mfbHFFWycLjZD",
-	"nhLyseZFGE/PEM3A6ayM3g/u+LmXMaRz818UG/rjkjk4fpbgocMj7Vh/licvqB4ACKkNHtO1tBUZwuuj",
-	"qfMqFjbYFN/1+oCOZDjoPnE32MwIBwdKw52AGrhsNQB+YTWmqc3OY92/ZmLtv99v0/fcCvib7VQeq2Ib",
-	"OcUNabkiuz7UP8ERol4l2504bGXz2VhXjqZ6zkjmHwCQdu7owDDKxWNfMOaUlVBkNILks0axngbqgQsL",
-	"6NdEY8px8pxaw9oSiBm7luBCz21J814N1YoaUhJN86H5ixewBoVx4bYQJFXWWOuNxq6eel+DEVVWwhV0",
-	"fF5cPHyNUgi7grAWu+1MCoAKn1D6in3MmSO8y3vanlt7FrgDjMFuVP2ziLU7RXbodlFNdM0ze0zU2KNk",
-	"ILpiRU07+FN3qEqdLkg9EB8zKybaAzFmmh/tCG/9AKe+f0yU8Zj4MI4P7c2C4qjbxoB2OnfhiYqeeh73",
-	"7QqTPTRWYZytaF6PLIm3fENV9JqnrShDkm8l8fHV4gPEfr2GHKWarvPS3XFCcDCieolckiK4bHb49ta4",
-	"z0LDW0k4OV5M1VCADLZVxlpbuV9HQxdhyXqsgsWN2GukZqw84fi/439TLNxrBzIqoC2EEVbmfwn+2QNz",
-	"yzYWXyfQsuZC805aU5darK8/ssA9dUU3REj8hwtNfq1pyeYbPKEWfN+NqCU1JOTeWewDoHP6MhNvF0ym",
-	"HjCvwgo/lV03GztmMNzGjBIAba5AIqQz2a/oJYTbgG+blvPk2rAcVc9WTCm87HrbOcSCW7wPD1/RAoJY",
-	"EkxS1a1A5tMWmt7/sw19CafyuWWqkuZtRWFFVz2roi1t5IlLL2G1PTZqqB57EmjKJbVEK31MZGFTl1j8",
-	"NXkKUBLB/8yYllRutnhq7nz+jjkco+S8C+xBGRkUww+2jH3qGrbhpVuiykYt5dC7MPaRfQA0vtT5BD87",
-	"wLeJ2XwyoE+B/2j+uNQyxoD/R8F7ovpOCK8ttPMJsNyJm47Aak2AM7HOJMzVrvdkawM0irBsI669EwHj",
-	"uQSq7AP72WunsrXp0Rg3KqR1AWueMJpRCpgz3jJLxqtutXvHrjFLGt8ECAstqYjWhMU8JSUYMeyKlq+v",
-	"QEpWpDbOnA5b/SNMT+2tx65vRPlv7tThAEy12g+GY0Eb7hM0Mxd4weZzkNY7S2nKCyqLsDnjJAdp7n1y",
-	"TTfq9mZ6A62sjXyxw1BPA2mmGyQcmOyRtC0g5ca9Ad3RiN4ASA9oTR9hBUc3wIgF3BpFtEgYvYcwxGPT",
-	"6TorxQKDdBIE6PLQ4TOFVVYER4OtlYf2m0ex32D7NJiC1x18LXDWMVNsP2evEXWo8PzImd560qw1rR81",
-	"Zd3a7EHw9M8XrW+t3Zwh/ccC3S5scf0w2K1fq9bvtX1jt/NBovZO14Kb2EV8ZXRRkqG5Vo1/yeg8ZMbC",
-	"6awOm6Fuq7Z4z4IKqvvnzvthaPQZKMUWKVMXjLinTchakv09kADPFrhzZ6s7bfMibcYZL2sEz69xiCpR",
-	"ZfkYlyqbpbtwBm0HaRfGBH0E5urEupvX57bmcic7RCeBvZWUbyPu9hLo73qXqfJtSnbKoJHgoF1juZgj",
-	"L8MjbM046CjfGC+m/RCOrsGmYRKEEgl5LdGgeU03u0uMJLJDnv/j9Nmjxz8/fvYlMQ1IwRag2gyjvRId",
-	"rdsN4307y6d1tBksT8c3wQf3WsT5lzIfs9BsijtrlttayY1HC5TsYwmNXACxUtTD0hC32iscp/Wc/WNt",
-	"V2yRB9+xGAp+nz1z7oHxBZxyp7+IOdnOM9qHEX/cI/zCCP+RS8pv7S0WmLLHpoNLb0OPrUH2D0OFkWjZ",
-	"g9Fes9zfg+KiUubtqu6NAm0YORkhDwQgERLVCWYJi3K2Sf+kte2iFdg/mPUvse/bh7SdvrsIie+wA7ww",
-	"xqlt17ibOnA+c/a87xukBEv5kKKEzvJ3hU25BbYvj8EWOVVXa7Alkm0OoO6+BDFx6kUTapaQbQcRaViB",
-	"0+g3ZRmJZLPaN56pkHCMYCmvaPnpuQaWZj1FfEDxNu2/HoYzhUi2qFS3S6b0io6aOwhdOtzU/A1Gz/0T",
-	"zB5F7zk3lHt0HNxmaDuhpfU0nLtIZDMkucYxrVPJoy/JzKVnriTkTPUfM+2Lk4vFwugdkGzuQuFgrXeE",
-	"C+1a509C34GM597zgPwQPEoINP60ELZH9DMzlcTJjVJ5jPoGZBHBX4xHheXcdlwXl52Y/FYWD240IeHA",
-	"sflBlp09Y/OHherGLs/Gn5tLp1YwXOfo27qD28hF3a5tbGKJ0bmUscD+mHwQ8bzHpjsmpDhIAuS90h//",
-	"DqkoLI7cGG7eGMX8lEpOaBPwJfJg9vajZuVON4NOVtOb6WQBHBRTmLfzZ5dt/NPepR4CGx47PKoW1rvE",
-	"9FvERNbamTyYKshXOiJVqesWSUyKoSd5LZneYKU5b4ZhP0eTZnzbBGC7AP7mBcTdfVpcQlPtsw3XrpW/",
-	"Xb8VtMT7yD7McHMLifKIfL2mq6p0RkXy93uzv8KTvz0tHj559NfZ3x4+e5jD02fPHz6kz5/SR8+fPILH",
-	"f3v29CE8mn/5fPa4ePz08ezp46dfPnueP3n6aPb0y+d/vWf4kAHZAurT6J5M/k92Wi5EdvrmLLswwLY4",
-	"oRX7DszeoK48F1gJySA1x5MIK8rKyYn/6X/5E3aUi1U7vP914jL6T5ZaV+rk+Pj6+voo7HK8wPjMTIs6",
-	"Xx77ebA+TUdeeXPW+CRb7wnc0dYGiZvqSOEUv739+vyCnL45O2oJZnIyeXj08OiRK4bIacUmJ5Mn+BOe",
-	"niXu+7EjtsnJx5vp5HgJtMR0BuaPFWjJcv9JAi027v/qmi4WII/Q7dz+dPX42IsVxx9dnOrNtm/H4cP8",
-	"8cdOOG+xoyc+Kh9/9CXRtrfulMNy/jxBh5FQbGt2PMME8mObggoap5eCyoY6/ojicvL3Y2fziH9EtcWe",
-	"h2Mf8x5v2cHSR702sO7osWZFsJKc6nxZV8cf8T9IvTeWnZQQi3+3iY0paZtPCdOEzoTEIlo6XxoO4qv3",
-	"MBW0DGtqnhXmGJheLywEvhiirXZ/8m7ogI4DET8S8gxzINoj3Zmp5dr4wBmU/G7upE779mZ69zB7/uHj",
-	"o+mjhzd/MTeP+/PZk5uRsRovmnHJeXOtjGz4AUvfoFcanvTHDx969uaUh4A0j91JDhY3UKLaRdpNapze",
-	"hre+o4W0g7Hbqt5ApEHGjhIdveGHwgty9Kd7rnirpamTrQ2H72eTL4gP4cO5H326uc+4dbUzN4e94W6m",
-	"k2efcvVn3JA8LQm2DGquDbf+R37JxTX3LY04Uq9WVG78MVYdpkDcZuOlRxcKH74ku6IoBXLBgxQ0fDH5",
-	"gMHMsTDKBL9Rmt6C35ybXv/Nbz4Vv8FNOgS/6Q50YH7zeM8z/+df8X9tDvv04d8+HQQ+CvyCrUDU+s/K",
-	"4c8tu70Th3cCp02xe6zX/Bhduo4/dsRn93kgPnd/b7uHLa5WogAv74r53FYf3vb5+KP9N5gI1hVItgJu",
-	"ywC6X236wWMsQrcZ/rzhefTH4To6qdcSPx9/7PzZ1S/UstaFuLZ1ZKJXJlZNp6Wr/onG5EYx1YL4Adpc",
-	"b+S1S09bbtCCzgogFOtmiFq3lgPrlOqC2pq3HTMCUUtnRF8wjhOgkR5nsWVuaeDyoyAXvEB9uHc9O8h+",
-	"EAUMr2e8gH+tQW7aG9jBOJl2+LMj8EhR2Ttfd0N2erMf+eNjgn0JGxKH+Vir/t/H15Rpc4m7pGuI0WFn",
-	"DbQ8dhUWer+2SY0HXzBTc/BjGJkX/fWYdqm9q6f70trRj30lPvbVKbGJRt4t1n9uDXqhgQzJpTGNvftg",
-	"dh0rgjpKau09J8fHGCexFEofT26mH3u2oPDjh2ajfQmwZsNvPtz8/wAAAP//Jj/x5wbwAAA=",
+	"H4sIAAAAAAAC/+z9fZccNbIgDn8Vnd59DuCt6jYMzB38nHv2aWxevGPAxzawu5hnRpWpqtJ1ViqvpOzu",
+	"gp+/++9EhKRUZkpZWdWFgbn8wcFdqZdQKBQKxesvF4XaNaoWtTUXj365aLjmO2GFxr94Uai2tktZwl+l",
+	"MIWWjZWqvnjkvzFjtaw3F4sLCb823G4vFhc134mLR3H/xYUW/9lKLcqLR1a3YnFhiq3YcRjY7htoHUa6",
+	"W27U0g1xTUM8fXLxduIDL0stjBlD+W1d7Zmsi6otBbOa14YX8MmwW2m3zG6lYa4zkzVTtWBqzey215it",
+	"pahKc+kX+Z+t0PtolW7y/JLediAutarEGM7HareStfBQiQBU2BBmFSvFGhttuWUwA8DqG1rFjOC62LK1",
+	"0gdAJSBieEXd7i4e/XhhRF0KjbtVCHmD/1xrIX4WS8v1RtiLnxapxa2t0Esrd4mlPXXY18K0lTUM2+Ia",
+	"N/JG1Ax6XbKvW2PZSjBesxdfPGZ/+ctfPoWF7Li1onREll1VN3u8Jup+8eii5Fb4z2Na49VGaV6Xy9D+",
+	"xRePcf6XboFzW3FjRPqwXMMX9vRJbgG+Y4KEZG3FBvehR/3QI3Eoup9XYq20mLkn1PismxLP/5vuSsFt",
+	"sW2UrG1iXxh+ZfQ5ycOi7lM8LADQa98ApjQM+uPD5ac//fLh4sOHb//bj9fL/+v+/OQvb2cu/3EY9wAG",
+	"kg2LVmtRF/vlRguOp2XL6zE+Xjh6MFvVViXb8hvcfL5DVu/6MuhLrPOGVy3QiSy0uq42yjDuyKgUa95W",
+	"lvmJWVtXwhgczVE7k4Y1Wt3IUpQLJmt2u5XFlhXc0BDYjt3KqgIabI0oc7SWXt3EYXobowTgOgkfuKDf",
+	"LzK6dR3AhLhDbrAsKmXE0qoD15O/cXhdsvhC6e4qc9xlxV5tBcPJ4QNdtoi7Gmi6qvbM4r6WjBvGmb+a",
+	"Fkyu2V617BY3p5JvsL9bDWBtxwBpuDm9exQObw59I2QkkLdSqhK8RuQRuEmU7TgzAiYG0CtprJct3BLd",
+	"X//r5bffMC1Mo2rCgBa21fWCmbbYwpL/SfS2QBowl55k/nnJvhEGxo5RxneipH0qFbJpYGdm4eiJNw2g",
+	"UzHBiy0TldiJOoDFteZ7oGgBGOc3QhtxyZ5uaqVhEqXZ18IYvhHPefEmQJyVixxmpsUiz7bG6KvXctNq",
+	"YdjtVtitExkCmtTqP0Rh4dQg+gawibpQpSgv2dM1q5WNTpY7ikiC0DMLPMGVkpH+wyg4UjuzaXjxJi0Q",
+	"VXInE6v6mt/JXbtjdbtbCQ149zdw2PYcQDTigZO843fjSV/pti6QBrtpe6IwHFZpmorvEWE7fvfvDxcO",
+	"HMN4VbFG1KWsN8ze1dnthrkPg7fUqq3LGVKihT2N5BLTiEKupShZGGUCEjfNIXhkfRw8newagSPrA+DI",
+	"eh44tbhL0AwwR/jCGr4REclcsu/c3YBfrXoj6kDobLXHT40WN1K1JnTKwIhTT5/UWlmxbLRYywSNvXTo",
+	"AP5MbRzD2TkRslC15bIWJZM1Aa2sY4RZmKIJp5+LYyFoxY3468cXbw99nbn7wPv6uz6547N2Gxst6Ugm",
+	"JA/46g5sWjDt9Z/xvI7nNnKzpJ9HGyk3r+CyXssKL/L/gP3zaGgNMoEeIvzVbuSm5rbV4tHr+gH8xZbs",
+	"peV1yXUJv+zop6/bysqXcgM/VfTTM7WRxUu5ySAzwJp8r2K3Hf0PxkuzY3uXfJY9U+pN28QLKnrv/tWe",
+	"PX2S22Qa81jCvA7Kgvjd9urOv+WO7WHvwkZmgMziruHQ8I3YawHQ8mKN/7tbIz3xtf4Z/tc0FfS2zTqF",
+	"WqBjLwfA2NfPn74CRmReuF/hRzj7gp5fMJwsOGD3Cu/RR79EkDVaNUJbSWMhR8N/SSt2+I//rsX64tHF",
+	"f7vqtFZX1N1c+akv3gYwUaChwxZOx49+3G41JEvQahK8FyWq6+dPicUGsa1WpYC5nCLqulvZGdbOm2ZZ",
+	"qYJXS2O5FQfX3g39DHq9xE7wyCHBecmb5ogxnoOwbCb4I+AFPyFnJE6PYrasiW7h9EjDtKjEDa/t5cUi",
+	"xYbiXaGZ5mxKHuGMGq6EIXmWGr5nWIR6hmhliFZ8wmwqtQo/vH/dNB0G8ft10xA+8L0hJMqi4k4aaz7A",
+	"5fOOecTzPH1yyb6Mx8bHmwKF5Eo46Qquw7W7qN3FHbSRbg3diO8ZhtsJ6r2I7owR9hwUh6+KrapA0DtI",
+	"K9D4K9c2JjP4fVbnPwaJxbjNExe0Yg5z9CrGX6Ln8PsDyhkTjlMQXrLrYd/TyAZGSRPME7len4Necir3",
+	"Vx1yPFSXIx0XaEvxUbtEkXo8yuvXP8JV+Pr1T8wqy6vo7RIpWJwsGaazjmSsSpFDmJOeFeeedK3VLjNt",
+	"h9ccwqIWjthjPqV0TBHFltcbeMyu9kOOc7GYeVmOeOhjHHR8eTq1dg5u/OYg9kdgAlpP5sfCCf3yEK7U",
+	"ncgAiJ8cfKig6+DZiiq8k8JmklIuQqr7guCDKHAs6J+puzzgQDJpuNdSG09YTuAo5Xqdpi+r0oNUfO4Y",
+	"A07ZmbQQQpxheHoGJzjQyYDc/e7MFreEdVsEMPOwAWwl7K0QNbO3itZkIqb2bV3JWtTCmF+dtdHHoDOj",
+	"+ZMcbsUrXhdiefCGu90qI4DHy1rAiX8DJCvkhkyBN8qKMF94d46pQFRyI1cp6+IPkQbNo5NGlXU36iMm",
+	"UaHm4OAWP7lFUJOF0wCzWtXLn4VWBC1eeg3XVhayoWPzRuxRIy7L3hwR5EGJCjpUbezSwz+Fr/hYdMqY",
+	"ilthLFpvg+Z8tFYU33i9TyOPhpia2k3SO06VKDdOoeZeBOnRCaXzNmZ6B9IIdPq5pXvMJeF/+sTD6VrD",
+	"HjEtNtJYTZvmJAo4nqL0V0+PzjuNiFa7BEY74g/jTK+6tziGinpj4011dGcUWFroSxIwsp/QlGkkHTyG",
+	"uPweFceDMlmnNxeoa0kkjAQ/i6FjS2n3YU/Gp2ewwAPUMASo4tPw9O+GXxkcoE3kFGlYYs43Zx40kO1k",
+	"oRWIa2bBtLjlujTe06M86orz2ro+ux6whJigw3mOeO5gmXNvu466+hjvX32yZpxWHl15J110MwSWifeQ",
+	"n5Ldat7QQ8N9ITUgwekaxW+OV5G54QwXtJF1IQ4fs0LdCC1GMl6s4ZN1Ke4S1JJWxbWytqQ3jsY4QkM1",
+	"QsZBXRWtdDDfXOIaGHnaYksP1IiZF0oHa4E0nU5rowWaBh3IZ5Gq3FDz0TUAAlQyrUmJ0Qc5u+cpfsSj",
+	"dzypSVh0a5q7JQECxq0Vu8YO+ar72+2Fl53Ipm8jHuDHIaScYXsaoaXKIJG+zcEiXfyNMrwySyNEnR6w",
+	"e1eX0lhZF5atKlW8YaEzg8450TGa7dff+sWFsaJJTwFfZqIFZdDjaf+lFc332PUQqwj3FG2kA3u0Hx6S",
+	"uRRrkMBGi8xTZkdzn9+I87AON8mx0jmeICc/WLkLogXaSBYMLD8qei0IBJfdCi2cd4oovadOMCkzCaR5",
+	"ChXR8PMpYIDHFN878R7sRqY1n3gLZq6q/maFdc+lN7cLYifRe2W1Hypk8pfWPS0wM3cjKR91n2P9NEJl",
+	"jLBfC8tLbvn3QoO692xWoqzDadDNMVmK2sq1FPoEmnVwLbfcbNOTwJfg1iQsnpmdW+2CASZb6x2SoC1N",
+	"J+0WVaWdRX5vReoV6QGoRL2xGRCM/FnkQZBgxrXCnHJitVY69Xzd0zzeGO4nY2suK9As3m4FcccboUtJ",
+	"bkptrcHniq8qVOe2tWmbRmmwmrS6Sj6h+/iawH9ow+INY7fc9HdgwcyWf/TJXwEAs+WffPjRPz765K+X",
+	"7NuacbaTZgeuowunBqKmScD8gifoQtXLYsvhmeaRE1MKkuYsAmh1lZ7guxfPRqONejv8Z0BsbaG6W+Em",
+	"Opvow4DYeNTfYfxNmP6PsLJL7CFNqlOphKnfs9R53BURvuN7ci9doY6T7xqh3a7h0LUCMnnUrRe6sloB",
+	"HnyD3rYkmo4BHlAh9RlilhUcoF91p8tdJKjvomECbT86cDSMEAzPFeyX90RAxFwsLjz+LhYXtF76R5/c",
+	"FhcDqPGXAEDaAaT38u+87am3p5I5V9S3eaLxv6n1ekj77jkPE4c74fx3FA2fuJ3oIujfS5+BvP2FrHkl",
+	"7f4MdxHK78ut4GXKnIezMfrKACWXF0Nkp7kxdvyKRoX7QOiUG3+QSuE7bYgITlsI2VHzPe5GuUBnzs3W",
+	"LuMFLhut1PrQhjyDftECnmMnfFBwdG2bMQb6IbiOAzruYdyhZgLY/rQJWu8Zbq68e+ufW/4vvOVjVuHe",
+	"4W7brNqQqj7EpSE7q4UAAdwq4n97Jq1ha8dLLgN3+Yqb7bk4y1dJSaNHY3irXRzi/t1oc/DxlRNaeA8v",
+	"3RLPtbx3fXy+xX/wqnd6aFgIx5DGmaFC8GTZCbU0k3sIAxHsyPOeAb84/dCl9mnWHn1Ozv5uh9wiwg69",
+	"upOlOdc24WC5vYr1yk+fmJ6jwEgynVTlRHPNejarhlXiRlRDEEgh75ghIETdnV3q+EzdpWD6TN2NJA7w",
+	"AzjHTnhvj1l6lM/U3RMHmdIpJQq4vi/RtXO8sd8ZF/jS8I2sETz3utvxN6RMV8gfYfeECYEmpJjAQTvW",
+	"6Zz4nWMMvLqqPR4hGlBp762ixY73jI05VjbbsQJ2o+Y7YbwkGqszFlHI4PVK6dMk08E9UrMuEJJxGDUy",
+	"DC2G+j1o2jZLx0gS0UDUYDBQF3s+jafh8CmM9bDwpaiF5lacgVjn6qc7bJ2gqGibSvEDhvZoO9ayEqiS",
+	"wG6iZKouwKYnrRV1ynae0TS7aedq9iIIgnYWJnXPaYSKhKVuJ57xlajOoUGeCAIewFbBlEltwln2MofM",
+	"rtMpCEWg2cbRbV836nxNg4a+w+5Ly3+F024sjw7pPU57f6Bf47S3zdnsZ7wgEEgON4fsXtSKleq2pkN4",
+	"inZ2PlGvBNxWBW83W0uGjySFC2PlDj25jeUbsYT7tBIwZCaRAEwTOpGRJbYK4SjMjSIM4xYVskYUqi4N",
+	"Q5MBdhCNAs2juLOaN6rC0cDFCF8WjVYb9Lkziq25vmRPyUvKmQhCcNZWaTelcS51oSceBct2gptWgx6K",
+	"12DbsbLyHkUbAXe66GajsGTn4eX3CQZa7QEK7FepeiOMm/SEHWy0KoQx4DkfWcen6Ma3iygH1xJGuhcU",
+	"qCk/SLr7yB9xeK2cB443Nweh+Pv3Z8UB7mDmGPWIOV532zxyBLIMBOL/QfHxpB/shwzQF4B/hMOFs2O6",
+	"x3xi0KDdGHf2vlH42Ux2BioXhUAvQGnpNJhbCerpnbpx4OLdYRX9W9y6lcZ6W1nDW+NGXCwuBmjo3Kf6",
+	"K7lYXAzAu1hc0MwJxa3bliVeBBMcKMN3sJsop1jOaZQyE5j4Gjs7GBigcDzbOIe4SVMfdc9ZFcjw5Aln",
+	"MoVzrNAd2xPYsu95n0mPwuw5JpyJ2ZOnSkloPkUOMd7esUoc+xG9J+/O1MbF1DO8YgYoGN+EA1pfjKS8",
+	"8a4d4fm58YEEPObijm2gpK52jazO8QxNG2ohDv4vH7GXX107UzAAg4Dxnbvm33fhx8zYfSU+SD6L0CU8",
+	"PfpfP/a5OPrjpsYxqtWF2PGErxXl+KCDTc0YtEvZMGJCc/ZCB+CsnRGgEyW0M8r+4zeikrwuxNl8mo51",
+	"B8IIqD4YB/WIR7rekLWX0r2hSFBU/HaF+VRwoLzrzWOM//QR2GfADoWv/5IJx/akgAq25EMmo8+DASgK",
+	"LB5hQfmIgk/b/15CoN/y+vnTJa4naP0PPT0Raj/57Fe8y20UIsw7hP4gVlul3pxdZ+vGzUFEMSHkf+Bb",
+	"Li6eSAMEsludhSHlmEbZzVIydxpLcRDzxx7xbpp9dMyf6L1uz0G+wXMoFRZjVaGq5Y3QRqoEjT53LZhr",
+	"4UN6m+HvBC16+cDcSERtnSZUSBRxhKc6Df3qru5wM81ocL2J1bl55+xLH/k+YYxhjdBLe1ezUqzaTS/6",
+	"GxUEnJXYEQ0cXwhMQXIO/rx2Q83GmZv7IK7CwLM9Iu8aoeVO1JZXzPceZqH4Ao3AL5BBy3pzBgQYDlqb",
+	"I9YfQSD0S+x9EBl+krm4cO396tc4p7+X0LzzpSD7+yu5Ey/Bj+rb9fo8GRIUDpS4VOROGJiJUYvonTdD",
+	"/+tGnYOA4dnwTlY2D4DDyMt9XWBGoV/XorGTNaY3M/u6iJI3dJGSZ03SkEMHTfWeSYAD6HiGn9HJ4omo",
+	"LP9C6Sgi50ut2ubsF+5wzrnL4W4xLuSxhL4+dYSsN1U/TfEGYL9MrfE3WdBjz8HdGhB6pMikl8xnbV2e",
+	"RbQYu8Mc67XzO/YASizuzA5AONghNyAc0GAQk7F48BST5HWRRMD5CTCN5vGC8AM9sscrI4DVZiPrzUth",
+	"YSXnkB1AugQBdmkxw6bV+2XBrdgoLXPq9e473m2+XwhKoeydWqAZw7goo7nuJaCevREZR+qKlk8eJAuf",
+	"Ar3htSwWbM0trxbksbtgt1zXCxTB8HmIEllS2FStbVqbNEi7dI6V2jBpvNH5ETN7U6nNwgUf2y6mAB7i",
+	"UQctSqkpYtaqBVM6JNf1Nw3N3WmwnfqVXKlTwHabJGrctmlDOg0q6tKMtmmG7Zw2ImAoNfviAP3MlZX8",
+	"xhpH2EOR8WuxU3p/RrJf8arixh6O0tjhzMy1n4zReLu42BTLRuhCZM2cTun/5bdfPqbX/YI9JKca/EnC",
+	"yjOZUyp5I4BlNoeBhqbANpqFB9ynGgZzYsAufthwvSLLZ1WJgtyGphdJKFlm8sb2l/n1518/e/r101d+",
+	"sdMju7z9aYENZ+1GWHQUzuUO1fatEZfs/wqtOgdA/F4J7g1Fg9Uq3ZEcr1QtZkh9DshFoKHetg/QE2/b",
+	"3MPgSC5/FvRGnDlK3b+4U8DojSgxZSbwsWha4r/AyjBBc4iO7cesE5/TJXGkvQv2400juPafnUda75oY",
+	"pfqsRfnqrg6Onz7ff8FrVcsCU2/7VMpxsI7LgDwn36Wb5IiQ95zC4Gj39D/xf1b8v10chUkUrb5RpbiH",
+	"h01/vm6wTjkEmI5VQnylWsu4u6Sxcdr/aMptxjHanr8aOTyP3WiSQYuh4/I0ryCcjooNVFrwck9RYWrl",
+	"UihH8Vdw8zRc24FfQvImiOC6h+MJat3sBJ66MLYwi/PcuTewMwyVb8R+ifeiYe///XvzwW8A7xzT/DC/",
+	"YEBv8LcfBNj3jKYzpp8iuOHkMdlxTbwLqJZZFZy3cig8CifZ/RtCNNrF+6PldJv+ERTkJ7kfAR1jmL8P",
+	"vd8X2rbJ+ME450rQjMKG1bxWXiGZTr5m7PIQW4ZG8VoMrCDihClOjANnFJbPuLGUZV3WJcagmE6Axz7M",
+	"JczIAJy14MDI39PH1NiFqo2oTWuCJSeEs6bWgPEJ2bm+EXdhLrWOxg7mIpLhD42cw1I0/guf/yKkZGHc",
+	"RvkzYLjE4jB/LdYdSaKyB0SHiClAXvpWEXbjIiEZQKTpEN23YKeyxRmrmga4hV3G8cYZNL2k1tf2u67t",
+	"mLh4pJYolSCfVNc+uNnhDOQjuOWGOTh8wIl3G0nCDIdxia5lyynKR9MItIqPwMFD2jYbzUuxLEXF94lQ",
+	"GfrM6PPUALjjnaVQWZFNyAub3lGyd5WfGFotQ56dwUjKZbws4AiCgN8RiOt9YORS4Ngp5uTo6L0wFM6V",
+	"3CI/Hi6btjoxIt6GlFDO0wOC7Dj6HIAzeAhDn44K7LzsngzDKf6PMG4C3+aESfbC5JbQjX/UAjJhAs69",
+	"LDovA/Y+4MBJtpllYwf4SO7IZmIWvm3s03O4J1AyhiXai9KXLWYE6nSw2liyLjl2TwPgRyN3beUi46YS",
+	"rEKXVot81Ad5nnCj6mhS6AWHgCafO22UqUTWS5fXMVGNyef0DpZC13SYgxLjo6AOEvyIoFCxLcT5Sa6X",
+	"s3KRjmx7LjnVqpWVJZ9twoKAm/gEKOxdTUSQk8pHAKCr1Er4Bz/C0K5cIIasSSkyO0U20vPQ+Do70VkE",
+	"fX+jT8iP6PGrGjvIkShrWLLSTLUoGLuM57DyXp7rt4uL53EKz8dbXlWi3ohfMyG1d85MpmxlKwHxKSYX",
+	"7BNMkWPMfP/iCzLxhaeAXw3bwfUW7IBGOP02THj5un5dP/hGWfHIlWowrO8EevlgTs6fMOiytybIbpwG",
+	"t4Pi/e9ffPEBa9pVJQvEQS6f7XlgzeaUnVqCx/w8c2zT2S9Tm8DHSxuR4ud3zalRvX06NILDvZHdhzEJ",
+	"EoxVBQuQ1jAjCi2sWTAayoeXaFHIRgosp4GnEgD+1bYpWsa8PXDAHsb038X+urXqhajFLT9H3Op8i6SG",
+	"OU0ueTPpRbE6ZiO1SCfIrgQvszLpV+qW7Xi99/JoVJpvvO399MU0pyECaItCGKM07KSsjQWSzuX2JDSa",
+	"yYyPMF0Yx+sDXM9OkR+l6Z91Mw131e1oyrTuU2cfUtQ4vCHXDEigzdHCpcp3pbsPiK6doTjesQiSCHWz",
+	"fb9bq0CHXgTcoRPAkJBSFH92347hBOlDWQpL4mD0gfhkH2yqkzYc8zSDxEm0kxBokm43VB92Bs6/FlbL",
+	"4hwmyh2NdGwG6xQ0B8U2P9fsAJkeIlzvyWzJI0S98lfJqVTax1a4mSZuwEjyQP4McBm8QIANku4pwZ+t",
+	"+lVuuj7ER8nF/gYeY1gI/aQlrIln3Iq62J8l/7TQ8wkxBcRBCqQp5mKh9MPjTVMXe7aVxmIMk+kqacCI",
+	"iBQsqXGunFAhBGLJ7YEoU3JngyCA0OlAmH36si3FWmjttQIHzQ6hIvD4DVWJtfWvJRIP9iHLjbQIKhbX",
+	"LpngusqoCyDhMnWcCkrfuXrK7oQEfx3uJ8V4CHrBjDXjat1hMA3FYQiGM3cLzsk0WKliOeGQ19WEcI1d",
+	"cqfD4PrBNbdi7tg6qqUyZ2hhZNnOH52az5lgjkYkRewZmYk0b0vSKKWT+A51LI1SVdC383JI38a/Vmh/",
+	"H2H7pdg1du+C7pfrtqoWeDZVaxdM3Qi9XLXlRlA1a2zDV7wuVS5sDayka5GjNtPuukzHXRgELHSUACwU",
+	"dyJwBzVT0iiLui/xgj3EBubMnJkqnUqNKhUdvzKiDFQ/LZgNFc+tAh5xj1RsodJDzJH7tJVCm1/fmK/2",
+	"NnnAYRJsb8gxBod8fDBnvmjb3Y7rfe9cOu+W7mTFxtXujru3l9zAP3s8KpNUhQ82xJeWHngXMXHHC1vt",
+	"GTfkgkVZ+70qcpx0CPZrWLRllMRoYkbno5XM6jeZ6HCGA5YniWn4Xg1cJJIHQqlqjrflEBlJCGbqpxTs",
+	"ukRfte7c+ddMD0hnvqr2HlxnNBvy4Ev2f1TLCl777OXBuqs0mkzJHdegS1Y3pyuJ2mEInafJpwa/PHgw",
+	"XPiDB27PpWFrcYuiAq+x4RAdDx6gR9tzZfrPn3OIvlzbp4m7D2ULOJJJJSZV0ZyW/93Ic3by+WBwPyme",
+	"KWMc4cLyz+4mO2ftMY1kEr0uLiA+QdabxOF57qmUNVqtKrEzbO0N33YbcY6+DyOkgNo7lyj3eMMIhuAK",
+	"3WHHp5gCaAqXUqfgrRHDdmRA0cJJSl4slma2cuplGOwHWvAMn86ZVPCql0B0TAN0BrCijNAvxJn0ykeX",
+	"hvIQgDtosiJUqNeS9s7p5+ujvc28Q2TWNeYLqeePNFSGyM503MF6QkEpCA5GOkKDVGFbXo1KKXWyVKgs",
+	"6ad5u7h4IQrxO6nQphGU365A2wgVv259tvFyDdWI8KGf3Ah35QnWaLGWd7hhyp432UajxY1UraEsuEtU",
+	"13ObdDfzqoeMfuG7Wt75XH6UXa9zD/OzYLWSKN0FSntFIRqbswNMJPMAh6nBeIdvRRpvMbHu+cWKb7uJ",
+	"ieeHYlPZ9ataxGuGFb4UdSn0dXkjjdJnqcZAdUpy5dzq8dvWs3qEZEGyBnxBuz7cWTSiW1Cp8LIrVL2u",
+	"ZGHJzoeWFlR7zrezjKT/z2CedAgjN8IsZb1sTYK1PMPPoaj34TXOhhFH/g6dVk6pPWixaPSNLASpvnxB",
+	"Hp65cUy72QgDPkK04sxSmXP67UeG+uXzOomCCQwMFcsNt1ZomO7///7/fPTj9fL/8uXPD5ef/o+rn375",
+	"+O0HD0Y/fvT23//9/+n/9Je3//7B//zvSUXHnDf3CBNDIlgEOp9zYAltblCkBzivNb7ZiYwBW57OfThp",
+	"jpC4QyIe321rIb0dRKi8g2TFlOx3nP/RBdMy7q5Xl2K80cKI2ilgu+ZL35weUeLyQCiLaz7DFH2rlRX9",
+	"pGYeJCDXSrBSU4KDS9YlIyY7KNYcGC4kCk3YtZWVcQqQaBI4RPqGV65aNNlQ1rwyLgVy7Y0yE5W0TysA",
+	"3g+NXIkNr5nZthhriIkPL9kP0MSvewEBw9rZ0l0hQqq6Vaidf4ioeIaSWw7moPPl3gsbujgi58Yrv0Zp",
+	"+gvEY+C80c6SHY1XSxAOtSzF4QdRcAb8/IZX34ZubxcX4k4U8I4vBJ5yuZk5FgSDFuIxdTkQSdDxernb",
+	"iVJyK6p9lGEVSbFzWLxkmN8B3HnqDfqFa9VirX9p/CkRWrDWEBXoth4NkVGp5t35rl3ldqfH6jwjRgYc",
+	"Omu3PMwnyt5FMRN5w4QqyTRSmD7RZCXNmy6wgZDjCCsU+Tj4zuq59fYcBv3EMzPNIOqA64/xFW8LnIKa",
+	"N2arzleZN1OGEF9/8IludDer95Glcn9yzaRlpSzTDoBD1zQzzdzDHFtVleZAnXjgEgvynCXONlFfAEDJ",
+	"VXeMk0EEAJyP8FYZe8R8R2Tyjzgvpd4ZQjBg1Qdmnu0W3Naoz5m3DSEgdRK1VMcxvdituAsWzJdfXS8h",
+	"BWhcp9FPNRuxYAY9nEqhW0HIO3AW9LlUH0n01YmMIFpanzSqW+mBh+19L2C/xR20x1RiFl2aCRRGuhPR",
+	"z2fQZRA6u3dWLznRCNDxxOxW84a4RfeRwYqpPFFXKALgbldmb+CqOYcSKgw2WwMU5j+s+ekGn72BoUu8",
+	"Xe4FV/AaHeu9+bkuPZ0SXiCA7gzmNhqIaeFeBaafN52+qjULsRHBeuLwskjX5v5HhqW+yMZskSpyuVN1",
+	"ypnqW/z6NX5M64TAQJPpjKayXN+hQNyDfwBWf545+3xf/OIpgBSlr8SuOZMw3YNwzBp9hXQ3IRP1WulC",
+	"mCSDx/pV41EvvidthFr3xwrlrsIyXRro2SJljIvnfrSkDdU1SsT+xSmDXatcueCMMPr59bO+NNpbyJg8",
+	"85aogG/XvwsEdXhfuGwT1qCcJTTWBsYGqOu/hzNDQFGfaruFh/2dy9IQMWG3eVgUWfDRL5viqZCsO9H5",
+	"CyE+d2VjzlZ7Fpxv6mSYDEDKb4TGohBbrsWCrYS9FaJmD5HTfrjoe0IUvOGFtHt6g/WtE9jC9PKxlKpd",
+	"VZE3JpmgYcnz0n70Rsa5fE0dspCY00q3O+XZKTC0Bm0QFo0Qlv3t4f8njaATAFsLsWzAL2pvxbL55GEu",
+	"RU8pec3WQrBGaJQSBx5MoKOWYXNSsVzreNsCPtwSSVtfgx6GVRRDxcn9YBlDeO8FfppZ4KcP7Za5BFdU",
+	"+cy7df3RF/xpZsGf/sssGKy3ayGmk+CuxXg9Iw1C5J/qs3WM/FRPAHC0yIOg5vegD3AtRImOkA3fw/82",
+	"wpJ9KOlM2Xubk5yrpRHGuW1Ro7WsKsPa5uh1JmzqsCsJFpM4lAmyTeEtsPAER10ML555AqIzapAHp0e7",
+	"y+oLBkXbtz8PdWnD1LvmC6XPlduZBpz9WpqRSvmgTOKmPDXhM8QWjnMkO/NNInzZR65KzbgxqpDoOva0",
+	"NAt6jbq0ys5CkED/C0EFSMxv4feCELwECaZ0oTgpSZg3zRKrSmQcCX18fySv99z0sKuLgm4aqoPnq1Tw",
+	"plmgbOpgRx4Lf1eq4BXtgQuOv+GygkC1YMngVuikQdYlrh7LtfGDb7zI0/DWNMnhjBH2bFiDwQZ4g58C",
+	"skCwp/yY7wBRMPNpqIKeqSGPq8AcjYjFosfjeXScMuRX1Dc1LJLkSYM+g56pId375shBn1OvwDoOMsWo",
+	"epXbPkfwEa7C+vx+DA/+mKgj+Of7KDmY06YPwJYhphoqdcLwfcYZ3u3ncBgfjjvIBhlpHCjbmagaxllR",
+	"SS9dWd0W9nU9umwTlWq9LJbPv/XYN0kn/EpEHbmhXteUMzjkYEpqJJJS5hdC+DRcwUWitzlrIV7XrpUE",
+	"IVNSpCSKdUvSOnnB45Jago5hDZepVexnoRVbtUPPtNZYZqysKpeaEqZhav26Ds/EryXUjIHh1qov1dbC",
+	"3ir9ZkqmhUzPohZGmmW6WtmX9PUrsE+45ce2Cte5izF6tx4tHnZZZiF/+sTpRZ4+Qff1LpvhCPZ3lslu",
+	"1lNmQFvs/VrZQEAf9NM82a14Xds79HPGgHRuTyOHoZ52dBbpdAyoprcRg7ROfq1HOkLfg8uwBJMZsEal",
+	"KvRiPovThCzs7AjOxHvaDZARnuHJR56pW7nZCg2kcMLbFCfBzCiqksU+oyHd8qYRFHKXuni41vIGTZ/e",
+	"sodPSWkYPMYesdcXa7lWry+cn73BKrevLyp1K4wFInh9Qas1PSev4UKhve49jymi7I1gWqkdIkraHOd+",
+	"x6/vTPDPLO2Nlkonc1jEhueJmF+uBVt3TkukJAQnnpZbwFHNSgFyCKoVKXO2WvdWnjZeg2/8YUwiPRo7",
+	"T5fE8+uYqdQFoA7EauVOWqT2ICcKl0ZFWk+7p6ijBvAgtpx34jGghdcv9UWZAK96j7AoymxBUgIev7bG",
+	"vPwnJUIjPe8cXdWRCuE8sc7dZTkHLOIo74ryXP/TOXxiJ09RLzowTj4E5wGDyJSKQiyJ0R8JiUdLiMZa",
+	"CYrZ8t69TIOTnHAqDpgok/zA3Fd7mcTpaMcTzGdw1SQIN33KEsx1cBmM7+ppXrMYSiD5LZqlKbXcSmMx",
+	"60ud1C8PZamT/V3GxZIJuhQhwRcgAmjF1m3tFPnOWZP0PV2NrAW9m1bCFVZ6xF7XD+Dd7Csuuz/BRasr",
+	"rN99vwgOXKny+LK8GwP5NM7dmShcgZfze2YyOj+TGzAU04qH3Qk4WWYrm3f/6jJWrtKvxa/c0zBU2Xha",
+	"Y3YWlNkw8fne5VNW63cPt9VClKJJOT2+6LuOYKtuN4UYFKNotLoR9YLJS3E5jH8uN8L4KqmV4OuQbk+p",
+	"Oc6z4RwQoXmqiLAeL2RWkHGKflDv7l6+bxcXTpFizu645gZOwTWcM2Q5939bxd778vNX7Mo9Ps17AKqr",
+	"o3yOt5srtDxfsfhDV5l5UpUYBp6v8RtWfzY4qJsY4PKxhyk385rv4mLWZHFRLTm0YMzSWM/GKxCjymUh",
+	"S527vkljYLqq3SierpybPBA5yl2Pnz55wWplnaf9q2xrCAe5xWBuyhughQuionpV80vr3bdUuSlUk833",
+	"gt/YRvM6CgkJYwUg/b2hIR8ghNBcDLy7LxYXvNzJOnmLTNKPq2nuoBwT0eLCW6LGxBBS6EYFeizjbCNv",
+	"RO1sbJD27IlYyxqjDR+9rktu+dWKG1mYq9YI/Rll9b3cKPaIuSGfcMtf12M6yuXJjZM5dynaUrvBd+m1",
+	"vH79I4hyr1//NKpVMnblc1Mlb1aaYOlOxdLLdy6Ny3hi04hCrqVT6VHvyVm7Exc/g9z46dseTAtLtCYs",
+	"0YCXXn7TVLD8iKt5qx9sGTNWaa/RlME+iPsLWe2In/JbH4DSGmHYP3e8+VHW9ie2fN0+fPgXwa6bBo0v",
+	"aFT+p1McSoNS12yfwesOxG6wnBHRlaYRd1bzZcM3qbP4+vWPVvAGd79LwwTqcuwW4yS4wOFQ3QKiDKSZ",
+	"DSA45t1l0QpxcS+pV8/cN95B+IRbiG1CsOi99guGcja4k7crGiO5S63dLuFsJ1dlgMT9zjgOwPiGy9r4",
+	"6iRGbtBZwGxVC0sWrNiK4o0oL9nTNXMZvOLuat1TV3vWIQ3eH3CtSMPWEvDn/LbbpuROoQ9xhrF8swpl",
+	"B3HQF+KN2L9S1P1yZhk3l+gbsOEsyktvAE8dVKTUSEcNxBofWzfGcPNdlSWAlDcN21Rq5U53IItHgS58",
+	"n/xBJsX5GQ5xiigCGiboveE6gQjskEPBCQuF8e5F+qnlzSxc4Jp0Jhin/YpX82obvu+Amjda3VJu0ZKp",
+	"OvJMiLlYa/hG5JIixoLFCRljYxVS9t5L3nSR7sV1HN03E9kLl7DmJKUI+AKkguLhoAyWn4ki951giZHV",
+	"DmHOdSPkpO1C8iNU1Zsp0NIELHTdCRwejD5GYslmyw36QsobUS6iszxLBjgYEgcE7rNZoF25E+owNLMS",
+	"NzyHfyM3y7RG5WlUwYnboFwBjs1tq4XnucNzOtKroB5FbuB/O/f/yshNrFTBv3b0P/z2U1KjgEUjU9uh",
+	"ahSASlGJDS2cGg+SEr9nog0COL5drzHrzjJVDCryQouuGTeHAPn4AWMUDcNmj5Ai4whs1LfiwOwbFZ/N",
+	"enMMkLWQaBvifmylWa2iv8VEkksUeVQDLFxmwn8LzwG4qyAW7q9BHTschsl6wYDN3fBK1NY/lrpBugFi",
+	"sfX9nsTp0/x9kBNnJ4KR6GI5ak3Y46TVxDKTBzot0E1AvFJ3udy2IPGu7lZA78mKkdAreTDfM4Dp9wxb",
+	"qTuX3r4uXbKSA7Dk4fBgdACIO2koXwf0y93mBMzUtNPSVIoKDXs/yDYdueTEiTlTZySYHLm8j3t/DwCy",
+	"VUvc4/fgI7Uvnowv8+5WW3TJXHwx3tTxzx2h5C5l8DehmohEyceYdCFnywsurEi0A7mx7rGQXomLBeOW",
+	"rZTd+nwlva+slFR/fqCt6EZLeg1FUEMRg2Qdyu7NvuRrK/RBcSz3Mo5H6qrxnTQUos0cDQ8RdDTA0WD4",
+	"EYb03cfzFJ0AJU1RiHO+zFAH9D4HXcA4aYrAGTK04GCbiffBk9t3nonzQe+jdrxjXsfvddx3uMseaxP7",
+	"+5m6m9pdvKRwi/Dy6nJp9Q7+r3nkAYp4LjTXqbt0Ja9o79M66Nevf4QPcHnCIPDvxaCmxDs3fCGOO0qZ",
+	"2AS/9pA6yqoh9AvW1qG0gG/fxdOCiHD5G60wV9F0eolkxpizSFn+dmuc5q+OGieO4fOhAiFpNui1ckV+",
+	"VmLkfpmSQZmsM3F0w3pmRxSaA1WjwAfgS98tLvfyPlWh/CDKch1Z0oJ2KKQee9d2crjY0X6bX51t9BrW",
+	"90J1CUWwoytCFy/z3R8rZcUSc8cu0a04uQRo9IVBHXecnXeguuhtNpOG/JTTnBWnhRrrpazaNL26ef/+",
+	"BKb9JrxQTLvC54+sKdEWJhZMV3iamJpK0U4u+Bkt+Bk/23rnnQZoChNrIJf+HH+QczGqCzhVtHFEgCni",
+	"GO9aFqVzGeTXXY2ucUW+SOKwSr3BbQj2wI0WpPHtMszlM3a5Ck+X842qr8YGk/GjszvAhdA2W5W697bH",
+	"RswA5H11tl8ZDMWMFZmXfaFFSdnezdLnx07PSWqNWyE3W1J0RV0Ha6KcfH44ZhVpbMiULa2hWCjfyeXZ",
+	"Npa/EVQHJtQPBrgNk6DxKamoJyaZUi5ht2Dgn6SsYLKe6RUar/dW1TOW6qBMrLbLGo5qm9xOXE7U8j/L",
+	"FmuBmS/2hK6skxrBemi2YUJ0LJ86Z0FGrc+1IBgqS7NZjUy3xB4wvdPUw/uYGjLnYYL9dAHd00qJKOB6",
+	"km2MWEHpxz6YZ4ygyOOHRppYS5zMfbyYnp2WtN2qRX/fjrGOlyZrcBXAG2up1msjMnl6G2VknHU54Yvp",
+	"ypS56ri58lj3riY+BuCkauG5J+vTJ6kZvLOOCUhd7Zms62FsMxVNYDYeSOp06afDyd29vjGxSW4JSWrx",
+	"d2V0BNrDdy4GvqZu1Lq7UccXs0vOfB2NQxZDa8UOlf87pWNW7G4El8FEWuIzt9zU71lX/LqLvqMcx+bX",
+	"u8g9XEsH74xagVqqsm+r7NYa3XzODdTdfGdk+L17nJs+zjjGR+auAJTe5q6U7vbsOqNrPT3RzGvm5OUc",
+	"vGe6lfbvnj4WPLCTJ+mlFc33+TXRSiiRuhVNMLb7d8CwOiiQUIbN4jc/AI6buc2taNJDxBBMDDB/izJZ",
+	"4VD6Oljaj5qZCSktO8coqATR5pbuFxAASe5fd8FP3/6YDCNktOg0MuPrssy5KcnybuBRmK330ks9eD97",
+	"AD7Ksnnuehj4/EYkHVtrdv3i8fKjvzEBDZhweYBHyuIxIYPNOU0A1589Dbl4ud60VLrMbTjOc3m4/i/c",
+	"e7XQS3t3IFE/AQ7Mow88du/tSMGrKh2cWanNEvdrnvhDU/Kdco5wldp0901+wl9RCKK1e8e8gOOjw9CA",
+	"++ZSdH9MYcO+0fEbeqTYlVoTQzrubXV6n38tYQzXEBNMhLUFnYkDJxFNjC/EWmiRdIkLn0wkn73XS0vk",
+	"bsfp88mzzuxJASnkk4onOsGpkzfNIQOwnzle0WAp94k/7HzWAZY5u/Ey7Sr+0iot+oiP3IcQX4c2YY5p",
+	"LNJvxlNJky8IDJo6tL3MyTn7d7HHnLa4nIsQ/3KqY3bqDnIjHsD180zGXYdnTB9Cjrq9OIsjUc4bCCTj",
+	"1dK5r+eubK1u3JWNzeMsuO9Qc5umbEhG61ItoVqsElwvg+Ujuyps1/xhVqUFz142/hWHqgzvEUSWsWjz",
+	"yX3dhbj5LhQLNTCugXTniKtjocPxvAv8Op3F6CDvc5EXtMSJCAzRhACMzjkYOw9iLgYJ1eSEExgtrot6",
+	"OZorxAPcO3YjdsI5K7sZne706eio6wBPwrm+bUSuTOB1zZT/GmIx+izoPeMo6wpXfQVW7XB7zryTv1C6",
+	"x/xdRZ1kLEd4kA8Y41nubofHTNC482nmQ9XpJUNaYv/c/BNO44MH8VF78GDB/lm5DxGA+PvK/Y7Ojw8e",
+	"jIGm2y7NJNAqBzb6Dzxu8hvxbm28tbidd0Ff3+wQddBJ5ckwUCgFZXh03zrs3Wrp8Fm6X0pRCfjpco7T",
+	"Q7zphO4YmDkn6GWuSEOI+dvxO8gRFDIgRA6wWLwJSAuZvQtvJa/l8RGq2x3luTWVLDKuQisD7LUmPQQ9",
+	"WqBxRpcBI7YyEypZtzIaC5rN0VYMgIzmSCLTJBXvHe7QOQuQ1tbyP1vBJGpR1lLoUKgzuur848CQpnio",
+	"8U++ct3A2Cca/j7aiwkPN/9ymlJdoAOj2jWV5HUhcuoLttZC/IyWxqLitytevGHu9YhMirSVHhve6zHB",
+	"mPPBsn5c77nd3dgunkBYpsWNenNS1qC8i+SrMHqkcsA1ZbznDk11Fn1K+tEcqVLeyJzuAr70lAaLOOKF",
+	"NhL+1dbdvz3yUzzWBQjpebvWs0+4rqUz0OLmEbLNCdfmuzFaTaXBom+JeRYhxwZ8SB6WYkTOJ6DAcr3J",
+	"GQ871CvTOR4Dga21+lnUC9xx+BdANj5Ks2E41qrndElqPabtc6uP8FQshlqk7kRGjCAgM2x5lj96x+XR",
+	"op8EH8OO9UU+wFFI5REJC+IZj+Cf3N2f7ranFK7bfsTw/bmldyj3Gx1x+sQcG7UkR2Pq9/QJDC7Nksgw",
+	"uQz0J7x1fJJKmccToboGe6fY4lDkCtEp3aZ3sx/a7vm6w9zG31tX6Bd9H5bB01LPcRt5ilIQ580iOaek",
+	"ij6yfiaLjOiFxyuK3UavZx/GyGvmJBwoy9rjJelTGbUwVzR+dyodzMNdDZdn8oIEmKLt7QVcWtXdECHD",
+	"u3euo9lZlHAgtJXkr94ITaJD2n3uRL2PT0U/U+PTKXigY0+1Q/VUeGVUYpi2vqUcNdSP+JXrjd4Kzvfh",
+	"VmkDw5p0bGgpCrlLGvhfv/6xLMZxgKXcSPQwYZi2b22dPOYGYq4yNlBRKU1TUWbXGDVP1+zhIpJK3W6U",
+	"8kYauaoEtviQWoBnPq6tL8hSmm0rars12PyjGc23bV1qUdqtK1RjFAu6OYoQ8BHOg1pVn7L3MbbbyBvx",
+	"wSXl5INH4sWjDz/FyDz642HqFVKKNW8rO8WyS+TZXrZN0zHle8UxgEm6UdOiLYlP+dth4jRR1zlnCVu6",
+	"C+XwWdrxmm8yIvDuAEzUF3ez5zzbpVGwipXCWK32udzAO2E58KdMonNgfwSGqzG7cxHARu2Anjwj9YfN",
+	"D0fproinB7j8Rwykb3wc8cAW8I7VPLloJY7pDrpyfR6tC8YN1U6UXYoLxxAv2VM4DKUoXe14HyZDuIG5",
+	"XLHeRsEWgj+SlrVF/XBr18u/gdpQ88L266z1wV2u/vrxGOTPeoE6rD4O8HeOdy2M0Ddp1OsM2XuZxfWF",
+	"1O/1cgccpfygKywQncpsxH9yWpsLMJ8eeq7kC6Mss+TW9siNR5z6XoRXTwx4T1IM6zmKHo9e2TunzFan",
+	"yYO3sEPfvXjmpAz0i+yZOVc+0VlPXtHCailuRJndJBjznnuhq1m7cB/of9t4GC9yRmKZP8vJh4BXyk+l",
+	"NAUR/vuvc4kgM8ko8Oeuz7ulzbRRB4HpmxU+/CfT8JJEafTBAwQarAvU9J8f9T8Tk3rwIKksTivW4dcO",
+	"C/d512Hf1B5CgaYxQbvg4eDs53KcjvfPp2Q4qNuTnQMHBbSCYgvrlbghXIalXuArelPjoYXnX6u9Ce/z",
+	"Gk7tZ+ruK2ms0vunwTMxMDUX+IYxJR2/m3A2/ONEVJ8pcVPa4TV9niH4D754POAfQ0T8xszL5S31ukNa",
+	"SYbkn7jVKZ0m/jJ8j+KQOftM3SUsbUnCGdwJnnh+m+D0WeC5PcXDB3jFKlO/gy3NbOFM9R4ubZTMJekO",
+	"ddAfLzpT/RwNRzCU3wddjG3bU1H8n7WyKr/vCqINrnDN62KbDPtaQcd/uLjFR790S6RLKoU18OioRZUc",
+	"jt7G//Bv6MQr/z/U3Hl2sp7ZdoArt9zB4jrA+2B6oPyEgF5pK5ggxmq/1lTIQlptVMlwnlCLPmLmlxeJ",
+	"vXqMt6nP1/2CznE+W/XCZ5ymysku5TY+IQZ5vf/rJvFeUPgoIMWynTKW/fVjVgk4nWbh9JELVnKzdXjE",
+	"Gs+mUFqYf80E4ERkLiH9JI199+LZghlRaKcqW8vK0nuf+2TzR8StoYRYKyvX+3E9VheKu2BYfupGVVAu",
+	"bJHLjXaEr9dkAp9JkMDDnupfddVih86UCK+PT5a5dNFZg97k/PjHWmiNmPBStIMoaFAnFS5oUYfty7uW",
+	"kaRu5Tpka4QjabAMB8rreKD37qBa/0WuXREs/C2nSLrL+NhNrtsrPnxmXn9YGr4nxy0tYOt5scb/3a2R",
+	"g/O1/vmCdhzI3zbri5/mqi4AF1trG0As/N+gFiCNmUZR/U512B4Oc6UO4BO9122eu7sPlO8SOuOToMRO",
+	"TNQl2kgu2ZeYygCAfBVjD20TctdWaFTq1dpum0rxcsFgHHBTZjQr9dHCtrpmpVi1mw0Ve+rdVfcshT1d",
+	"//qIcabTTMOqjV1auRPG8l2Tqr4JLV75BkwOHJBRaR9j55I9IXuJiQs+G0snUsM1G6ZzpxFvfviHtVSO",
+	"iqhlhmDjkx/lK9g+dy287NGZabn/dxHkDSJMgJs8HQXdbgsKOr6VRmAeX3Ej+gU/PRj+JvUFQPvL021d",
+	"E6VcHvHSdaVPj0e7B865G9UTkA0Qf6wTkiv7PJcm6Ty/xF4porR3dX+wgQukL3nksoNesq+dJbHgtaol",
+	"3EP75DMdq9TMuxTdJB2nOKqoNeXxHB2uBL1GGUQdFt3684zQIW7s3xN9hU0l6qA/rbizZD7fCGscZxPl",
+	"AjXEshLO+i1rIzSl5wUi6t0yOuHhnXpYdjGTx1bqlKIqM+aML+DbN87YBUcwOA46tDnlD9mnIfs1UDsm",
+	"E9goYboqovGafoQ+l1g7qxR3P10+UxtZvJQbHINiCsgtTnDdjIe69uE0LnwF2j6GtoxyLYefe77xNOl1",
+	"07hJkyJz2OGEiFBnEZxy4qa2PeSG8ePRJshtMg4O71MgNKiGSoHmcA+PCENonVI/fU41VIGisAWjdFop",
+	"pFSyToDxTNbeXyJ9QRTJKwE3Bs9rpp8pNLfFtseGDkXPBJ/9IUMz1jnc3HeowQYjSnCNfo78Nr66q18I",
+	"01Y2xzhCg+55zus984cCqDtONMyrEEdGQlDf9ANSlROiSmCDvk4biWVpxgGMe7kTxvgYqfkP3NDdal6I",
+	"Xt8ZN1Gufs6qLTfCLnlZpjJsfYZfGX5lJb00xJ0o2pBAuWnwUXTAx7ebqFC1aXcTc/kG95yulAZeQLtV",
+	"lYiheRI+ijLsMFAaPNng/8epHlwE2dE5kXy4GHY8Wm7ujzSSeoGml1C1YT4m8E65Pzq6qU8j9K7/WSkd",
+	"khX0xvotjJAZLhfvUYq/fQ4XR1yjcBSsR1dLqHaIVjWF332ZBKpWxHAo96IHfxCsMvHii8fs3/728N9g",
+	"91eVAHZnuaxMF2AXV0J0jf4HyJpU1Tk8zAfGRFWmoAW2uaoEqOGKrazFUgtewi9xgI/PheSFIFxg2uPQ",
+	"JeQYYY0WkUbXXVPxmnfJLaRhqqDnRCGiVHqw0Ev2NIQSGLSiGuZIO+Mcht+SxJ4rTgL6hq9evXruC5IA",
+	"6rryNbSraU7n1M8JLG+VtpCVZsf1frAk3LCFG53DPjZbzU2YMgLlcr5J/Zp99+Kp38S9d5SOp/SoLIXG",
+	"OBS8MqER0W/h8ldOK1E8fpMn5YZXmcR3sQ8DCXRk18+lvyuyyWK5dVVkLGeTd162MgdF6g28IsaaqVx0",
+	"HgXnnc+bwK11EqE+cHoM0N99VgbWcOk8kLvbaYxZF9eaN21Ocflug0exJpTkNWsm/kJgPaIcPxBa7kRt",
+	"ecXW1DBoOlQpFmzT1eboVAzeQ0GLSsDpcTYj3xMtPQnK8lqO6YA0D4YzmQj0kRhpOSIgSeFsumnHrry9",
+	"2caTc9ubWaYPv4NkHvTSeMhdheNgikbsBVyk4Z1n1XFzXaZdJrlJL3Y/gBNS8QVwnN0cFdr1ezYztFvJ",
+	"NCa8mp7HqZRwv9wAJrOIXBiNe+LGM8bALCIC6zYreSIqudnaF6JQuhT6Jd81mZsEv0TXEWlcsMJcvCAy",
+	"9D1+/h2qP3F/S2nesKdX35LjDrY0olB1ySi/vr9UmyolPzQtqpbSFNAaFwds9saKXTdvl1DeH15Zs50s",
+	"tKKpTfbJ8AZFkSUmH8xc0mtZCT8jtWPQZzTfJx9+hKHQ3g+2Bkm6vbtk19Ut3xv2EH66lXWpbqfgwQj3",
+	"YwGCTlbUvwJMW8EzCfh2Yqf0PuAeGvrSRjg33nXpQckisaz4Jj00bqqoeANjG4nJlkHnjt0YL294XZBp",
+	"FVblVPGaAl5w56tKTu48wT65rh1vmo6qNgo03QDXobVN+HbFgIbUULimnKCXOwnwJTpI6IpnOVq6Ze3O",
+	"m4kw910t75hoVLHNzHQH9eWWRv4sDuVK7ClQXYRQ9BsVqjvshIFrW3QHPuyJI7nE6UwdkE7VHNFUfz0p",
+	"PvilVm3jVGYvRKTrH8kJQQGBBu8N9CO1so+QBAr0T2heFMIYYXpcM8QU3m5VJWiImd5LLoEWe/pkwX4W",
+	"WnV+lTHGyf3S+FfbCcYOhGkqLyB+ihL/EUrc7ocVJTSOW64P3ZYOeQsY3tusPmVK43HRiyOQyr71Fq0F",
+	"k5a8xzO9yTXGiU/qtj4c7p81xmFmVAd3h6FRUqoD5yHegoVz5+rsKQ6PWVJ+id/zVd27tMWDREsB/SYi",
+	"8F8pD/FBT43sOQwkKEyP6BLB7r6U646/EX3hpSd4DpVTMS+cNIiF9Lse2EN70jRZvnLiXkxzivvZOn+3",
+	"eMcDMRfnmYDrUDj5NLybbNZ47kK5/0Vx7/LrzMR+Mh7hmopm/U4Ift4jc0W+4gezvf5hjk/PUnpwH7M5",
+	"N64HSYbmbavTA9KlHDqgQMNBUt1UfakGgI0SQoX7yylggrfbb3FR/ddlBeH6O5IpYPbZwyVc+wUZZc34",
+	"ETfluSms+c0Eof+aV3xHWwcve4waAwL4rC3eJO96VrTo/yihRDc2IlLZ+p4p41VSeO4/f9UKjGjOF7RW",
+	"lm3w9aWpzgdgpW0aodlqkFk1jhSEBstVXk8QjdAplmEJ8et+VqGpoTNqNPPCrTeF3r/f5EoJ+XOE371T",
+	"hbf7vBH7hTud4kaq1ifFCGF9znmPfsUUMn68TA2JqYSYv3XwWjYyCwlG3PZrhv79e0r0yERt9f53EHg3",
+	"2vRnghvxnbdjDvedzB0hxRJz9cN7DNUtlZJ5jTdzqi4iqceCcow0YzCjpBRaRFfYAkc4Jt8cKhy5yewU",
+	"TfNbBSofWSggTkeFgB+2ndLSFxe9+obZokrPUIs2VU2MWkTmPqf0HYUyZXxQe04sw+m+UDpyT0X5YQzB",
+	"4+DK5TXCZJjtMZQYa3ihjcjxyRzvnRE+3i4unpZH+bcM9oOGoVGSOwAWms9Au/mV4Nk8iOjb4aQfqpy0",
+	"xdYu+4wLPFrt42KwifpSG1ELI00mqQ1MBF9CzmFq3dU8O/g0mpswMldFDWNXcpUgjdKWqpVAm9FQB4GL",
+	"SGTZJe1Jz/Xyq+vlR5/8dZDcJx22Mh+GUTFTEedO7G1OFtw5NPQctj/pMwoSzRpMHiuhzVY2VCY/qgLD",
+	"GZoMe0R2OTfZ7kh1PB7LC503VGolxq8WIhcfodbpyXxQLjb5Ddi5FqIUjd1O+qJQrrPGbjsOL0RIX7oS",
+	"wOBBfyxqZ0AfZJEuN53raSX42lOiVmpOBbGQkxjRGAOdIqVvG/t0OgiVssmSbb/zGYtrujDVWHpdKKY0",
+	"Uy3I4pOV9k3uUkxVHzJTr46DFRKHTre4msPThxS655q4qJQRS9UmsPwYPvXeqIRCZifQL2tjBUe2qBpL",
+	"ITqOUnYZl4P05h++kK+7J2Nbu9jAHlsE/xQssooR0zgqDpW4kXycTMIuazYNL94s/RlPT+WvKjLU0YOV",
+	"yvw6NYF7P/8enUKzMTK94tJ/F/tJ9sLH1S1HDhJHKDauQ55KihUFUzPcTJq76oWnlA9Zr0UBT/Pp6vA/",
+	"UCILX3l84eNhEJZ1VCxe2jjM/AT1SAdQxU+Ep+LnAyf3KHgj9u8Z1qOGp0/G+O8qSczwKu+NRuGTxpJ5",
+	"fenLSeYC+Jw5WppAGYgFn3dxUCM08zSD6UJNE7U+cS5PklibMoi8E1NCXcUT54KuR5XqxDdXroD88HC/",
+	"ELW4TeH8OnGwgcvzqupON2+t2nErC6ZpnGN1mO6G8ce9S5FywjmfPN2vBofYz0i5VGWZLwqWG62Pnu4F",
+	"/Ubsc4dEi83kbRMkyvFdEzhBT/0F/vNwO0N78jNs6wr4pxtAGh8pf/B9cqS+ZB7uTnJP6vxO/HkIdJee",
+	"hRY77feBDpEOKyC9rLTiZQFr8hMRgrVLKRKcO5C/uujYqL9pV+7lK+7gBoeI2TkpyvunNCbZgdIkBLXS",
+	"4gL9JA+1EPpJS+KYgHDzushoMoNCGlC+VbcMzluXFVlqd0i41hJcSgA5PsyGHmiWb+ir8K+CRqQeaaRD",
+	"zpz7kbo8iFIBwAVDr9HAa6Qe6rpnRe0MdfcpWXiOJj4gwVXi1oMAA4+ExANSCD3LgjMYIjL5truDJYFL",
+	"UfF9GMpDe7QKf3Fhs26SfDMe/vp7VIRRrWY4F8+f4w+hNPRhlSHih+ZdBKrxu0KLT9M8qoSj98JnPtx0",
+	"ZEUgc0xCiexo2tX5oK0tFVpawK26koUr4InFlDCFQeoRIcvDb7iUJ+MKIF74v5De4V97diu06FjMMfFx",
+	"IyFflmYm/vIBYE9cuBalI02q4zHie7BO5N1aFKK21b5LXgELxpSrxv9GV6gPCqukt/jh3UCpQm65Ln2L",
+	"ycf8lGPhqI40k2mg12Fm2aXLH6eEyyXegde1rDfLXPmOgY+rf1e/ZygPLypnkARCQp4utRO93K3y3GMK",
+	"jilUQIMTkZBP/UPA0W6Z1LsRPwR/eRDnTFxRKiyQabHjEk+lVV5MzM85hezH9N0XmPKBfwetOIFeDycr",
+	"9YUSpBkhMab6NXPP5sOlJk+J9g1lbxKYfzquxNNoVbYF3a/xwQgR0bPv2AlWkgyULcarHFh9IieNN2J/",
+	"RbZNV7wx7GAMNOkxCXQvMvR3Y/Zq5sY/mxTcm7OA91tqiRYX6MyeyTbxtC5hTcJVEkmxjTeygLJfQWmI",
+	"GbtK8Z4ZOe6z91GSDumEbjFmilu25U0jalF+cMnYdU0lHHxmIRlBMJocYqwm5kc/fVa2wlWvo6Df1/VU",
+	"GbR7cjM/zDQPIwHknlPRINMTJcvUISPjt4lX5+VcO+s4189QyuuIiqBIyiSkwkELaEaigkjKIkT3Fbbl",
+	"lbPwBJFz4NeFgWGcaWAe8AlZtvmtnK08/EHbZQ6JBy7oys1My+gVmOemw0qkB9tgtjZpjdNIuwFUjakX",
+	"DATwXLLIV5/6wRFbc83W4lZoPzd6G4U5JIloFQR9r3EwpdlOmi7r9synxr1Q4JbZqaImzxesNj1LjI/B",
+	"9naBfddw3rAigmuxohJunfpix++WOuOFdVysdOfzj0DHaEqST+ogvUChOz6PiWcRSeY9FrqDBwkKS85T",
+	"hSqyAbbFWt6xSqk3KadpWVvNaf1LtV5n/VVjW++QfbtXUMP37r2GscYZVe4hnfaR2qzhHdaptdhTawgX",
+	"C5dZaeG9hFhbW1nRDXPa1v+ua1y+g1KRB3UDXgmWoK9Q7dEtrrfnqTPxkvJRPUYpMnUeMNQvKksua6sY",
+	"Zy6PFTOVSriAn1SRGobK7EY0mY+znVMYOUDhBk8iwOXoPJgGNGQAdVk9pYqygKbTOi9RRlsGPXTKtAft",
+	"zMDFd6i/NuhIJKJ8oty49+mebXnJCqW1KOIe6QA6gkrWpl2vZSFFbZdrMQ8sstyavjqo4XsmatVutmwt",
+	"xmAunJqiUdqGInrS5cfBDlSOO+a1rcFhp+DfKS2WlcL0qKnMbWtgTnLno63VhqkGU7tQ7LzLcdVt49Rc",
+	"bY1hiks9EaFKuKIoR0CB6xOFOs6cEp5ClH9pSWLDwaeuw/Qr6EPlHWkcYAy06CXlAMuk5YctgMYeQ9R4",
+	"DC8S/mizJqJO1/IO6V6kkpq77Hzj10pH+7yj5ZjYSQVIEvlq3/No5q3dKi1/DmxbasfGh2TIe419yolS",
+	"rrHMTFBeq1qMrkHYWHPJXhCXMSx9zNO726gGN2uKll5EZyU0Y6TX8i+atdJCbmqGT1MzjAg2XZX74U4R",
+	"HtTabXnosWBGdS9XbMpqhUYQobvo3RFZj/AwOixpRBhh8mG8XfWtiPpcj0v2skVo1m2V4k3oYjJ4/vmY",
+	"FvyDhiE8VBgy0E0S9M+Ybco1xSGFI1dKA6NcXAa3NPgle0ltaX5M+B6mD4n/0XCNWcptvw6oA62opKiH",
+	"RXu0R2+tfBJ9l96j4JqKcxWCtTUl9kfX29utrMRE8s/ljje5pPnYgEGDKHEVhvgsgt0d9FSWzgWxjNC2",
+	"yxmIHMxhU2o3bkQsIzbn7g2XNWW2TspzP0pN+zVvMkl/l0Qe6VUnyMiqcIUdDYuTFkYOWzP8jjyYM6SU",
+	"Of5go4UN1zXH6QtewlbtZJHm+3+sTMpZ364Ou0Sr19A9yZ3ncmTeZa24hIJHLt2BOxE9JegeU0ug4sYr",
+	"9SBOV/vyO4M8v+whzByScUNTyPRDF/d0gvisp8lwPQH0vInt8Ksnk11+0v50DCDZmLZpB1L6dp55VrCx",
+	"6Wnw05xZpphKr0BTirqzlNyxxAOsnq7aKEdGn3zch0xwwsuvrj/58KN/gFM+NGCl3AhjB5fHEfHbuxS8",
+	"/+vlt9/4ITu4Ue1EtRJIFITsxo8p6XiipM9Q7xovK559ijvEQnaKUVIPV0zca/1M77nYvyLH6KYbMB26",
+	"j+KTSy+Kl33nUjwY12c1M7mnakIkoxf2ssjqAQYAIKSy3rg9gH/1XuneLGXVhtyNyGFgAOjMdxHmoL4f",
+	"bDDC2YGy4l5AjfLeBwDfJ6vnggKz6XYATu++f9CliD0J+LfTVN4TLXLJvV92pKWxCQmgeXkhZVpwugBQ",
+	"Qiy785kU07DQcV99MHqd4TxBhcCsihLguQLv2M8Hw6IKwulU5cgW7GppOus06jnT6hPn0eFSAWZcD5pm",
+	"OZ32+xWucDU3+Xcy8dfEgzwCIJ8OvAfDrKTgx4JBqgn/QFzyjKT1qvf+7emc1tL6OXtv3ijkQNUuxpc1",
+	"Qg9eu4M72WetGuz0+K0+3uQj3wU90TIhTKy5rES55Imz9jT4SCwiSy9hZWQskMadg4LTow0IncsK0lyy",
+	"V/AZp2S6Hw3VcLv1SIHmY08ml6GAa0GJ0FbcCBcUTO6RohIYNTYwRqtmWYkb0XuxL5y7KL7mweHR9TWh",
+	"MyuFaIROncvjhDS39mWUIHoOdpOWfEIs7RQ7YKZPxxrXS+KWZi5HBYhuZAkW3RgJx9Jf3w0FOHoCVSP9",
+	"zdIpf8q503xHI4SH0rXvn3rvekz8NO86OvomSqPufveQ85caOqq8Z/Bi8S7Rsi604GSHXXT30MjsjPT0",
+	"npm+nEYHgBLkmjaUez77FXWwYERrcvdCna4XQZyHXJaCoyPOVobIKFppx9RNw2/rvGNQ6nLxiqWZ9CpV",
+	"HFr3+Z0oUMh3CmxROhX2tPeDy8gCWw/NR7Au8irmSA2d0TQP9zfSq2f3dO4Tvav5cP9tZzgYwwJJh7bJ",
+	"X65lSg448TIN7OR+bnm/CQecZIDZ8VI0aaiQa2Q5CE6zfh3hNDmdIDZQbVWyGkgEFHNbfiO89OBuzwVb",
+	"tX4gKliLPvTdK4M9Ed7/WdWx6yetiMkgKPrCCiQ5jG1lMioUBCF8WNgTq1Sy/2x5BaUqgb8T+L4bslVZ",
+	"b5zDNYUEuvIbMPH062YxsLeUyk9F65Zzx4yG23t51Y0EApR3Zlc+f1PYhuBa4b230M3dWSsG2znGgls8",
+	"K3gNtw/WQe2yb4Ena71PZYnB3v/frghhPJW/ypqKF7TbwTbS8wuhwA9PXD6y+RglpCeBThkZiDYoQUvK",
+	"ck748w6MJMfiP1bSaq73Z1ZYLvH5fQjs6BUf5VE72zJmVuFE7+AJbeGUBjaxlHPvwr1yASxdzp1D4McZ",
+	"Ed8N/mFGl6RxGvcTGuke+L8XvE+otj28TsX962N5Wg3udQordbfUYn3QbRJb900sJpg3veCOzO5pMKuQ",
+	"9CrxDvPPhc6XOYxSirWsO2Yp66a1ifcj2Xr2EcJirxFEa8a7KSclgPB6w6tvb4TWssxtnI/44prvhBWa",
+	"YtO8p4zrm9Aghjt1PIA03dsZC2OKrvBi1AwucBJ+SfY1ltcl12XcXNasENpyCc6Ge3O6SxVAq1uxiDGf",
+	"dKrikTTTL9c89DghQKq9cz25p3NVCsBZ7lVUEbznXEWqTUMeyr4NubpMwznDsSnAyc/o4TTDM4k82sde",
+	"SaQUtSrj3jKG4XjPpKNop/MLCer4w85IabyAo3SlNlhrMpd7hd+hjgD82ZzSs0aDOgmT8xbv58lXmfDT",
+	"YMkSxzXR72Mzc4o5bk4dmo/2c3Is9ACVT/PKb5Gs8Kn/XS3tJLf0ziz9GqSUrISYmedh6CDuUt8R4Sbs",
+	"qUV6sqZfOtYv1pOTPwcUMOXJ7nKqwqyzTGWICb16Xc3h2G5n5iu2e47DiVvZvezRYch5e83TyJDt+pnq",
+	"qss7RdASFUQHEuh2DtWFi4pLKNCGmiXCr/dlP1LBTNZJLxZkwIM9E8axsP60kata8aY391zP6TREjWqW",
+	"s6L4S1EJ4GLYzUPahzEbQBJMoJl1B8dxw/iGy9rYHmFHL473jHs4nfL6wbjEb/1cBz2BmmJK5zKmwYNh",
+	"G7x2iAoPZRzAGxez/hWFqtpdZnz65gnak6ixXBOvsexhelvSFa1fYe6/WpwwoMlUhh9m63eLXstKLDol",
+	"Z9/ZZOAZciAboy8o7ipSO3RN711So5sRMvrGc7XGmxWRQXpspWPV5mKYbbmvse4cHznTomg1WrZu+X68",
+	"7746zfI+DjbDEjddKK0cFdN5t8Gzo+XZ9Cb4iun4OXjO+MyyYVPc0aJL13Q56UcFfo4xiSXkgGRKQMF1",
+	"lxvr5L3Ccbq0WL+v7Uot8uw7lkLBr7NnLuQ/vYBrJ1AClNM8o7OQ++Oe4Bfwjk8IGH5rT1hgziCVr9h9",
+	"Cj125prfDRUmSpCfjfbCcn8Niks+NibSd1+P/L5CNeRZoI2rAyfIAwHIJB3uZaqMUvW5tCaG4slMo8ig",
+	"4z0nhpfY151HxcF8HAiJ73AAvDiLcNcupJCIqoC/43z9sWjydUBKtJSfcpTQW/6hxMRugZ0LSrRFTmtl",
+	"rTDEltRYuIiyTpvHB5Jqj3M+a6UsUzUofRK5okkZgmcqJhxZW6FvePWuN2Vx8YXUxl4jPkT5Ih84PExz",
+	"6JFMqBwkV5yrNX/GZ81d8V9h6vo55qf+QcAeJe85N5TzuhjdZqjK4hUFSAbB/EbU7BbHxJ1mH/6VrSRl",
+	"omu0KKQZenOQ8dglWsXUnEKDeRKnEHf2QC7QQ+v8Xtl7kPHau6Cxb3rBDs5Rw0HYHdHfmKlkTm6SylPU",
+	"NyKLBP6SPCpYm3/g6JuczHyqLFAPSdyrSuxcLixiBiHz48DzhdTZggr1QFEa2BwgqM7CnXoWlylHvRLm",
+	"R287TE8pKZrRQfOIdaHuS6sU5huDd6gQS26XzsVqSUpMcHUBcQiBpEQdmC4LcxosVb3UosHUXsuG73ei",
+	"Ttciz2QSexqn208kc+hwNeEom3VXfIJ/rRwS3OIPP6URpd2wHvgMNVBp6xQVGP+xV1Sd9tn5HxirsG4z",
+	"2lQs16ghh7hEJi3TbZ2w7cwrkd/NDRTlawAHn0vTzSKNhyK5cfOKD4bpkmPotk6flDjBagexNMz1OLnY",
+	"vJswtWUQ/RKkwWl5743YU1YE1nCpu8d0JJIqLbJ1oPIVmKZkQIDPiaqDpcKwfpBDK3sJkM1eHq4DpcbW",
+	"iPE6Z4vbPdwmJG34/krsmgouEW/zzKSOpo/kMffq8+tnzLqOYGRT9YbuXGk7V8mp6Kx5p6abtlC11aoy",
+	"R5yJb6LzEAZaMNMWW8YNe/X182f/+OLzzy+PqM31fVyTqwPOZ7qhxT5inJWikDteeTlmgRtIDjvD4l1U",
+	"DZ6R3697AMKCDvPF5FGbJsc5pww3N1S0GiQB3lv6R7//69c/2tXr1z+5tYTOmdR0qe4WumNHTFdyyQjX",
+	"//zwn+RsgLLPgwc4wYMHC9f0nx/1P4Pw9eBBum6eTElgr1//2EqYGj6PAD8p4RPhyI3h5k3tx/e5kuAw",
+	"UxmKgkf2u8R+QEWMg04o0MjP9jZUBvoH6Fb+sfrrx+8+Q6GHgLILjU8fwXqfOlmEmMRae5NHU8EOSVvB",
+	"iA5VnYamZ/aJNycRrrm4+EGstkq9SWYkok9RFQimuqQJXfp3FDJFoY+qUYv+1rWy/gXTNxsC7ODRj1bF",
+	"G1XdyHrjSlDcq9Jol6a3PBIkb69QmpLR0uNOmvimIwmXl4KK7U/mxj12/pCLFzHhw14dRGstxM8dRBMZ",
+	"cl2/Q/nq/dY7XyTZbft7JkzuEtagEUrWQTSlpPUFr2uFjq3O6pn2xzicscuBkmTQ81Lvw1MmFGrq0tqg",
+	"BMAjyh1DZ++W6Ttgcqu8Jx7eDBeLC1FDBvUfLxq+7xLpLy54scb/3a2RZ/O1/vmCiBSz7zWxlqtbcqsz",
+	"pYW/e/Ess95GGUrOePiSRi4DU0SZ/yOa+SkV723AAift/iUwcG91k/9IljP9MhTTcRXRgljiVB2Uw8W9",
+	"b7rSO63xypQvFa9Q/UAudbVgVqnqkn1+x3dN5ez/7N/fW/2b+MvfPi4f/uXDf1v97eEnDwvx8SefPnzI",
+	"P/2Yf/jpXz4UH/3tk48fig/Xf/109VH50ccfrT7+6OO/fvJp8ZePP1x9/NdP/+09fLldPLogQH1F8UcX",
+	"/3sJ6RiX18+fLl8BsB1OeSOhXtHbt/hiXSt6YNeWF3iVix2X1cUj/9P/z/Oqy0LtuuH9r24fHl1srW3M",
+	"o6ur29vby7jLFQSSyHppVVtsr/w8bxdDNv78aQhJJ793vBI6d4HLi+4uucZvLz5/+QoS6lx2N87Fo4uH",
+	"lw8vP4TxVSNq3siLRxd/wZ/w+t3ivl+52+ri0S9vFxdXW8Eru3V/7ITVsvCftODl3v3b3PLNRuhLzElC",
+	"P918dOW1SFe/uDvk7dS3q9il+uqXPqs/0BPdga9+8Yx5ujVILJXkdSGWqGIxk63BF3OyAeX/rOmKnGjW",
+	"wF5PNukFLc5teMXLG2mU3s/v4aJPog4bLTCm9CoKPxh9M5bbNv7SyCUe9iutLKfyRMCo8jzDMI6lhomA",
+	"u4RPqJxwblahUqW7qkpuOSulRq3lntylQ8HmbghK906Ol40r9YXDgLNuxRvWCC1ViXfhag8d8ei/UJYM",
+	"V67V4WuSSp3QTUnaEy1u1Bu6HcOZfFpidSZAi5/qYnFBhiJDHPajhw89e3G62ojkr9xJuiCZ2uUX6WUb",
+	"IRTQDizFXSP1hAOOlTsR1/JgRtYFuW5/V8s7JhoFhSQoISktrlcZe7hjssN0Jk80LjlbbHow3uFrzToU",
+	"5teduNneLjLTh4k7z2DAUH79qhbxmmGFHx+5f5NmSq2V7oLrxoB/xkvm027i3B++u7mf1hRyBUgjSn67",
+	"uPjkXa7+aU0Vkhi2pBtyzZMRzt/Vb2p1W/uWVPxmx/U+nEeXXTNBgHxjKOONvOH4KK1VHRXpAn3i28D7",
+	"5t1VU82uVuruiKbCHNX46tZVs5nVZXQRTtyow0+TF+qo8U5YDjz9imxfXVPK0T2+nNzv2iWP6f8KSmNU",
+	"8Y++/IJGxLe536/WsuaVtPtsA+cqkv6I1l6SK698HcB0y97N+wukHH57qIcrB+S+FrCPKPyZKy9O56/a",
+	"r/kbYcIdRrcq/gm3l/exREteN+6CCTRlutBriVUQujdDr15h1wtHNHQh4+DSsLapFC+7nI4uopXUy9GI",
+	"qz17HAb6DjtRea3gV4zDRpP1ymiqGswoykRZ2UzNG7NV2HAvrEuTaDnyeKM6FEjj0yJTSsiobByZRE1U",
+	"Osh7pAU4xpe8l2269WQu+hQHDO2uuu5+wDjW+p3eNR0ohn2jLPucck3+ee+cfO/QxW1CVXonUKJpY3Cg",
+	"jr2IsHvbXP3SjfOWoKtEqmLol1jUgfdOv7SMr5S2hn6FdzqlwEQdSBETdp/2r6HXY4IAH5E+iu3i0Y9j",
+	"ZSQOxPxI+DKHZ2f3cC76R8gLfxj+Em1pUAz22ncK5B8fLj/96ZcPFx8+fPvfQEHs/vzkL29nKhy7A8Be",
+	"Bq3NzIY/3VPKH3mmRPSBmxSSAiRcAWgn8ul73FYNBmIBGQfM4oPhUwL3n3LxH5A/XdPh7/Eit9mz2dEi",
+	"9/RP8xv0dTia37yEXn/ym3fFb3CTzsFv+gOdmd98dOSZ/+Ov+L82h/344d/eHQRu5eyV3AnV2j8qh39J",
+	"7PZeHH5C4OxUwxcbMf8SoPSupvNliZP8u4M0vhUe9czjxvKNC472b8EF+/v3lK/Blf1qtHI5d4xia667",
+	"FOLGyl1U9AB1gKPRGeo3BKqvkw9AfyW9JCz8eTGd42IapkUiJCxpS2fWeyvVbU0KiRO8qSOkJmfrvrtg",
+	"84K34AqORJu0dXtyK5dIV0tHV6CDA8pLTxM6zaHOjIrfafcxUUSjKG8HxpyGgFs4eXQ4oGAZk4Yp53fr",
+	"7Shmq7Sb0jjlS+gpyWV5J7gB9Q6dL1/cTDg4d6iZ8n1IweMUL36fYKDVHqBwgVfoeEz9T9jBcO6X01m1",
+	"Orrx7SLKwbWEke4FRcaZbUC60GiseCLl1XngeHNzEIq/f39WHOAO5ir5x8Tc5/6PHIEsA4H4fxB/RxWy",
+	"93EPewdfAP4RDhdYyMZYYiKpQaE9fhx3Jo3ggj6byc4Gwz8EBoRJS6fB3Epg+jt1E6tnvalT3LqVxs4m",
+	"suaFlTcY091HA/ySWgnUv++Dd7G4oJmTnijEhlBWneBAGb7j3NWnWM5plDITmFjSPjsYmEP9eLaR1Bef",
+	"NPVR95xVgQxPnnAmUzjHCt2xPYEt+573mfQozJ5jwpmYPXmq1CPSC4PEeHvHKnHsR/SevDtTGxdTz/CK",
+	"GaBgfBMOaH0xkvLGuzbXyB8/J1Kvnj8N+B8//PjdQfDKX3hOUjyg9/uDvrK/FJbZGcR37JO7FKt2cxVV",
+	"8Uw+sl9Er2nXllExgSj6bOHyDUtroFFkDe08YQpuxUZp6cRQLDdg9Z6RbR+Tc/sgRSPqMvkifkYAvBTW",
+	"YjLSU0yigzF+M3vonzaG85yNPmkat60xdR5jbWgTADz2RYsOzMM4BleSU5yPgdAipHeS1j1PVUgUrQX5",
+	"IEblMilYEeYRGsOpmkbUzvmB15gp2vsMgi4KEoa5rqq1mO7UgxMiP6XdBs+HqTPogCqlQdM8TuBKQlLu",
+	"sEv2vEsp6hJPa+ECi9WNBDDfCNG4fItesqd4JOZy+hHeQj+hjTQoapM/xd4nUBfjYpy4IjNmDS9TrGFS",
+	"XfZqyM4uvc7sP1uh953SDD9exPqxzmu/lgVEq3PLoYVA+lxc3HJdXzhHbyDrVbtJPJPeLhKxCVp4IotY",
+	"6COgCfgp6ExiEnFKypVRVWtdERm8IPBlb5WjnzCuVRiM4k5bGHOSdjKooT493Bxc5DW56C+NgO2BGSoZ",
+	"5Wiapk+r8GpwtJiBigh2GTovu0HfIahA2g7cHKDumB0P6U9/Xnv/xa+9uTfS8SJhZbm5snf1FSZGuPql",
+	"56PpPo98IPu/d93jFjc7VQrvk6jWayPsgc9Xv9D/o4kw1ljWm6tC1TdCRyOIu0ZouRM18OLwqy/DOEu6",
+	"jYfoCjgOpdwQPtb34teRM9bO50UIjelO89WV2efJmaCJqqu9bxdiKoW9VfqNy7bgaq5jYGiATgtMm4HV",
+	"CWtRmUU3aVeFwqrxxfmlsF94HJ3CVXznPjv580if/sojm2dX0vsATR57wtfoYrzUosDiALMORijx0mLS",
+	"JIPxdIa9EY315EjDMj9s/9SoqhTGkpOtc+wdNPdDQp/Hz79bsJ3YKb33tdbf0MyL2Ku24psuyCLK8NIo",
+	"VTFIrh3DALey3jtF7oKFkpSm4bWP3fkCYXrhQPpB1qW6jQN3+gfeRSWGADpp+mcXJdf0kCQcPEwfxqgH",
+	"GVAnpViMNiV7ty8OQ3jkGP9k8f2AkUUUdzonXCcnrmDbnlziEvpcPHqYKJVwkpAyWP6fQsofmaM9aXeN",
+	"OcQdjuVfdPqj6IWxNOKamLZpqv34531dJH+84sWb/GDQYPwRloUyi0vN55sMQzGInc1ittSUWa6x4AI+",
+	"8StMX9fjqVGuGvhxw/WKrPZVRYnYgjhYCi1vvGUepJPANit5I9hW8CbJir5GQO6lausP8edp/pfQtDkC",
+	"/bUVbbPOQaRxu2Q/UNqGWtVLrI5LXRddYwNL+PLbrz//+tnTr5++8mqzaApe/kdrsNGXj/1nONpaqR2r",
+	"xBqd5W+cnjucHnbNogm9MO4s9w5oGF0LjFgyh05sRoF3D9VbSmE2Ot8H9WW0JShAoKUtQi2XOypLYwSq",
+	"lx7CH8aqhu14zTc+R8No0Tlhg1A5X9pYpOCNpUBHTm47ujXkAHANf2V5508G+a+pkzkHjwyiA2bHuEKF",
+	"eD5A86Vnz0GfTgfTdQ92AmBUs94uPJ+tgBgpxe99TeM/d7Pic0JhaaFE4gJYgm9Zup4ZwWKQdzwsyq/H",
+	"1bvDfEZ/Hpc/ZPSimSbZYw9K9HOcLab38xVvrdKiFre5BnLXKG1zX/upakafUQ8B/ZeU4yjZ6Jfen/0g",
+	"+EMtr4otryrRC1k/2EfcDZYk4KVStkQhYllxOLcHXycLtBVh4bdC1TUZLWEsEgO20ljMG4ncbqtu0c82",
+	"aB+E1C7KmmvYzIreI7ze4xg4xI1Cd2NyCm2U4ZWh1sI/XrjF1iBxYa9SgBSmqfCXT+pN09Bj07BaQSu+",
+	"v2TPRSc6FaomfmWrvR/FD2DQn5MSglXqlrWNsVrwnTkn3wRgnvgteEY7kOecXwqb6nDSoyw10J+Sxx/+",
+	"aYYnDugWT2h8OkzucB3NYbEjMA8t+izFbFsLrqIT8kkjCskreg5g4qfuHFnF/ACdmMS+xa5YDt09cBhH",
+	"DaZqbZS0FkvGlkjMXbkGej1tXV78jaxxAlgNerQ6rsQjV0mnEXWvSBij1FzWccbdMDAxGGNV41P3wMBx",
+	"Ro4Fu+XSmmBd1z5Ne/DhsipEWJBdx2eJgP+cittYTEuMbhHcUmiTi3DtvEuaiu9hepwi5RrhMPsNZR8f",
+	"PPKSby/Cce/pE+hy1uPrB3TbCOUlEWmITsNWYq206G9H1kAOXVKW8C5p93nDgQ7F5lR81fm9kat87IfX",
+	"ZSVc7cPC45KG4wzmUxUrcPhewhBHF32vmJXY8AF9XzLcAcQfZgUl8weNRb6RlGOMiC7yPnIzlNzyFTf3",
+	"ziFM65udQsu5cvbW8ue1gNO/QwRAcpanwJqAS4sSN/WYa8LnzJlndUcmNzhTfoQo4RA3jJs3RKiYfehR",
+	"sK/7YgOyqlzIXM/3FNPxUJvwOwaocbvFBltutqi0gtnQiQuar7msIv+nkTz20sEYojOPl8T6Q/y+TOjv",
+	"1I/7G9VtOey0c6ag5ED301ZPkteJquv5GbJ4by5pjajWVPIXYXPfTJeI0jUbpKekpxe5fsLonUKJXENc",
+	"WqqiUrU39KBlaKN41blOKu19MKOkVu5IeEM3qIk2yLUfIRyEPsxGCUeWrrYvP3/FegedhrWaF2+EJkeW",
+	"ddWarShJVnKV6W3XqIujDncN9StUI0eV8ExXwhs2nGqMBFSD1rsr7TFaIWebnyVWQ7Ggfa4qco/c8Vqu",
+	"hYONufS0zrEWfonAcuay3ssaqly4/OewP3Yrwohsq6rSa7xRBw0jvPzqehkqAaq1u5I7d94xk3msBbfC",
+	"M4lDCvqBzJUHFnCCpgyQHx0deXxlfSrviqotBag1zEkC2R+ZEX767iC47lNuhcmQ+5faHzxXBKzC1Wm/",
+	"Dxf2ooblVlBVvrF1fphYmP6+gpcZCK4uyBWfgonOvmzQTCkmNL9HUaaM22BUtGgshXRgnnTwQvc/HfjO",
+	"5sB3GikcTfphmqtfgEtjthQ9ZSHyyVrS9cM8SKs9e2lVEyijc7ZPJM0KreaYj7OFvRKpSvB/U0lKzuIT",
+	"/3sl/3cqfHua7vbyj56NyJNyktDPcc5UM3XMUC2Yr9JH/L4ntC6YuNxcMqtcai8X68VkXchS1DYVTBbW",
+	"QwJvx3RQig5KqWWXGHTB7B2UVaz43peFxD/MuFCJFoXoOY35l4YL57yrl1RC0Q9EatDESFFVyq1W7YZ0",
+	"q6GQBHs53CtECjGdznh0MP4r5lh/8qI/edFvz4smuMCxLMgKXuFiZBXZffHXUhpujNitxl/0Xrf14Edf",
+	"6xAIr1CbWv4cd4MtnyfuwpGI0twPo2Qct6IXO3qgJc7sM2msL2JxmvQaev8pvN6XXmEzkjt7/0ytcZGW",
+	"/uALttG8thjpVJHFZIMp/UyhGlK8eOvJgpq0GDHZ3UrU3AdIuavQdUb/IF4uoaO/pnA+53TSripZgE3E",
+	"uqwDBThwBb1NrDcksu6pVBIjuqHcjTc0CeLFGZwhR+oZ9/jj5U4Oxw7GG+suezeRheJIZVgIXU/dmlFn",
+	"CKq6EJoGE/QnRvXTrTTpgWm7w8j9rnLdvVB9h5gkovXE03yDJOC4BvT1Csip8jwL5z67Q6HAl+LhhVbG",
+	"dDbRkBmOgIqqy6X0ar36OYigz1S5P9vB7U8SXBwyhi8OcTkd1QeK7sqnu2IifSHk7UklAQaQ/en58Vso",
+	"+roiVU7JJ+6kseYP6/yqRZrHz70/wKxcqFJsBDxRkCyWK1Xul04I1+EExcKKe5JNVSZ4gWW0krePq7aF",
+	"PmKYFFJHdb7cFVMzlVB+PMHJIhZy1IOjh5xf8cExhqIjOzCzBQ75X++h0SGiVpat0U3hjxpmJ1ya1lOP",
+	"XjhSyTqB8a9XvB891/u2FmLps8pmmuyE3uS+4QnKzTuq3pT66sog5RopVaEJIDeHFgXtefojhUDnOhu5",
+	"a6v8wv3nq1W/ela6EaWMOdDICIMVnzsW6Jq7srjzHnK+8cFSzvSWo5K4Z3WE/cFDkHV+hbeJb3XSO9F3",
+	"/lPW+aM/UX0+gkCrnn7v/Ux94cY0jPtBF+hrFIo2ogoSOhtfrVPa4LWJTrdoUFxkyj77DMA7n6PQlQ6z",
+	"XTx0mHcQ3FtEkUV+kC7L74pXvC4EQGWMCN4HLlU91Ozz4/dSGUcbbujRRPXUBTjXR+ATwJicG+u49aCD",
+	"pcu6Fnrg/Wq4lQZTKNPjtdDSCu2KU3ULd36KvtQ28BF80S8S9aoLrvXeBWTeMUwEI0r21dfXj5cvv7oG",
+	"z4rgIwJy4wIe1Z0nJk2w8G4uVKoZXRhfyk2NaTVc4ekFa7RYyztfB9xs+Uef/PXfL9kX5BDmQw6CisFq",
+	"yK7KXoyJ8rT3bUTdM1+475Qbk6Tv2v2qj2c3x4G383cvni08/QAWk0fqrE/nANeft8kfOfxsxO9/raeq",
+	"G974ZFrZpyoZDeCKgfuhz/6sii+mUqum8ZeIqoE7c/KrWasQ9+GqXfrp4ZgfLETNCl7XymIWyJz+jB4c",
+	"HQc4+PZ9+iRxHBPvXln+Oq9eN2vizftnpuZ3BIGjln+l1/aRXIO4gdDGP69hWFG0GosF/whrl//AsNEf",
+	"fwLSNkLf+CPV6uri0cXW2ubR1VWlCl5tlbFXF28X8Tcz+PhTAOwXf8I8gG9/evv/DgAf1YoEQVECAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -30,6 +30,24 @@ type ServerInterface interface {
 	// Get account information about a given asset.
 	// (GET /v2/accounts/{address}/assets/{asset-id})
 	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
+	// Get the freeze and clawback events targeting an account.
+	// (GET /v2/accounts/{address}/compliance-events)
+	GetAccountComplianceEvents(ctx echo.Context, address string, params GetAccountComplianceEventsParams) error
+	// Get the net change of an account between two rounds.
+	// (GET /v2/accounts/{address}/diff)
+	GetAccountDiff(ctx echo.Context, address string, params GetAccountDiffParams) error
+	// Get the projected participation of an account in a future round.
+	// (GET /v2/accounts/{address}/onlineness)
+	AccountOnlineness(ctx echo.Context, address string, params AccountOnlinenessParams) error
+	// Build and prevalidate the transaction groups opting an account in to or out of assets and applications.
+	// (POST /v2/accounts/{address}/opt-in)
+	BuildOptInGroups(ctx echo.Context, address string) error
+	// Get the IDs of the recent transactions touching an account.
+	// (GET /v2/accounts/{address}/transactions)
+	GetAccountTransactions(ctx echo.Context, address string, params GetAccountTransactionsParams) error
+	// Get the status of the agreement in the current round
+	// (GET /v2/agreement/status)
+	GetAgreementStatus(ctx echo.Context) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId uint64) error
@@ -39,12 +57,36 @@ type ServerInterface interface {
 	// Get all box names for a given application.
 	// (GET /v2/applications/{application-id}/boxes)
 	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
+	// Watch the changes made to the boxes of an application.
+	// (GET /v2/applications/{application-id}/boxes/watch)
+	WatchApplicationBoxes(ctx echo.Context, applicationId uint64, params WatchApplicationBoxesParams) error
+	// Get the events emitted by an application.
+	// (GET /v2/applications/{application-id}/events)
+	GetApplicationEvents(ctx echo.Context, applicationId uint64, params GetApplicationEventsParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
+	// Get the freeze and clawback events of an asset.
+	// (GET /v2/assets/{asset-id}/compliance-events)
+	GetAssetComplianceEvents(ctx echo.Context, assetId uint64, params GetAssetComplianceEventsParams) error
+	// Verify the off-chain metadata of an asset against its metadata hash.
+	// (GET /v2/assets/{asset-id}/metadata/verify)
+	VerifyAssetMetadata(ctx echo.Context, assetId uint64) error
+	// Get a preview of the block being assembled by the node.
+	// (GET /v2/blocks/pending)
+	GetPendingBlock(ctx echo.Context, params GetPendingBlockParams) error
+	// Get a batch of consecutive blocks, delta compressed.
+	// (GET /v2/blocks/range)
+	GetBlockRange(ctx echo.Context, params GetBlockRangeParams) error
+	// Subscribe to the blocks committed by the node.
+	// (GET /v2/blocks/subscribe)
+	SubscribeBlocks(ctx echo.Context, params SubscribeBlocksParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
+	// Get a self-contained finality proof for the block in the given round.
+	// (GET /v2/blocks/{round}/finality)
+	GetBlockFinality(ctx echo.Context, round uint64, params GetBlockFinalityParams) error
 	// Get the block hash for the block on the given round.
 	// (GET /v2/blocks/{round}/hash)
 	GetBlockHash(ctx echo.Context, round uint64) error
@@ -72,9 +114,21 @@ type ServerInterface interface {
 	// Given a timestamp offset in seconds, adds the offset to every subsequent block header's timestamp.
 	// (POST /v2/devmode/blocks/offset/{offset})
 	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Convert a node object between MessagePack and JSON.
+	// (POST /v2/encoding/convert)
+	ConvertEncoding(ctx echo.Context, params ConvertEncodingParams) error
+	// Get the catchpoint label generated by this node for a round.
+	// (GET /v2/ledger/catchpoint/{round})
+	GetCatchpointLabel(ctx echo.Context, round uint64) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
+	// Gets a light block header together with the proofs attesting to it.
+	// (GET /v2/lightblockheaders/{round}/proof)
+	GetLightBlockHeaderProofBundle(ctx echo.Context, round uint64) error
+	// Get the expected and actual block proposals of the top online accounts.
+	// (GET /v2/proposers/report)
+	GetProposerReport(ctx echo.Context, params GetProposerReportParams) error
 	// Get a state proof that covers a given round
 	// (GET /v2/stateproofs/{round})
 	GetStateProof(ctx echo.Context, round uint64) error
@@ -93,12 +147,39 @@ type ServerInterface interface {
 	// Provide debugging information for a transaction (or group).
 	// (POST /v2/teal/dryrun)
 	TealDryrun(ctx echo.Context) error
+	// Recognize the TEAL template of program bytes.
+	// (POST /v2/teal/templates/recognize)
+	RecognizeTealTemplate(ctx echo.Context) error
+	// Get the suggested fees per byte for a new transaction.
+	// (GET /v2/transactions/fee-estimate)
+	GetTransactionFeeEstimate(ctx echo.Context) error
+	// Merge partially-signed copies of multisig transactions.
+	// (POST /v2/transactions/merge)
+	MergeTransactions(ctx echo.Context, params MergeTransactionsParams) error
 	// Get parameters for constructing a new transaction
 	// (GET /v2/transactions/params)
 	TransactionParams(ctx echo.Context) error
+	// Get statistics on the transaction pool.
+	// (GET /v2/transactions/pool/stats)
+	GetTransactionPoolStats(ctx echo.Context) error
+	// Look up the recent transactions by lease or note prefix.
+	// (GET /v2/transactions/recent)
+	GetRecentTransactions(ctx echo.Context, params GetRecentTransactionsParams) error
+	// Get the resources a transaction group shares with its programs.
+	// (POST /v2/transactions/resources)
+	TransactionGroupResources(ctx echo.Context) error
 	// Simulates a raw transaction or transaction group as it would be evaluated on the network. The simulation will use blockchain state from the latest committed round.
 	// (POST /v2/transactions/simulate)
 	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
+	// Simulates several independent transaction groups against the same blockchain state.
+	// (POST /v2/transactions/simulate/batch)
+	SimulateTransactionBatch(ctx echo.Context, params SimulateTransactionBatchParams) error
+	// Debugs the simulation of a transaction group through the Debug Adapter Protocol.
+	// (GET /v2/transactions/simulate/debug)
+	SimulateDebugAdapter(ctx echo.Context) error
+	// Deletes a simulation session.
+	// (DELETE /v2/transactions/simulate/sessions/{name})
+	DeleteSimulateSession(ctx echo.Context, name string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -121,6 +202,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params AccountInformationParams
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "format" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
@@ -135,6 +223,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -210,6 +305,171 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 	return err
 }
 
+// GetAccountComplianceEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountComplianceEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountComplianceEventsParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountComplianceEvents(ctx, address, params)
+	return err
+}
+
+// GetAccountDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountDiffParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountDiff(ctx, address, params)
+	return err
+}
+
+// AccountOnlineness converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountOnlineness(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountOnlinenessParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountOnlineness(ctx, address, params)
+	return err
+}
+
+// BuildOptInGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) BuildOptInGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BuildOptInGroups(ctx, address)
+	return err
+}
+
+// GetAccountTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountTransactionsParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountTransactions(ctx, address, params)
+	return err
+}
+
+// GetAgreementStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementStatus(ctx)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	var err error
@@ -221,87 +481,371 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
-	ctx.Set(Api_keyScopes, []string{""})
-
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	return err
+}
+
+// GetApplicationBoxByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxByNameParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
+	return err
+}
+
+// GetApplicationBoxes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxesParams
+	// ------------- Optional query parameter "max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", ctx.QueryParams(), &params.Prefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "values" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "values", ctx.QueryParams(), &params.Values)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter values: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
+	return err
+}
+
+// WatchApplicationBoxes converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchApplicationBoxes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchApplicationBoxesParams
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", ctx.QueryParams(), &params.Prefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefix: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WatchApplicationBoxes(ctx, applicationId, params)
+	return err
+}
+
+// GetApplicationEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationEventsParams
+	// ------------- Optional query parameter "topic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic", ctx.QueryParams(), &params.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationEvents(ctx, applicationId, params)
+	return err
+}
+
+// GetAssetByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetByID(ctx, assetId)
+	return err
+}
+
+// GetAssetComplianceEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetComplianceEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetComplianceEventsParams
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetComplianceEvents(ctx, assetId, params)
+	return err
+}
+
+// VerifyAssetMetadata converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyAssetMetadata(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.VerifyAssetMetadata(ctx, assetId)
+	return err
+}
+
+// GetPendingBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPendingBlock(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPendingBlockParams
+	// ------------- Optional query parameter "max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPendingBlock(ctx, params)
+	return err
+}
+
+// GetBlockRange converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockRange(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockRangeParams
+	// ------------- Required query parameter "first" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "first", ctx.QueryParams(), &params.First)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter first: %s", err))
+	}
+
+	// ------------- Required query parameter "last" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "last", ctx.QueryParams(), &params.Last)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter last: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetBlockRange(ctx, params)
 	return err
 }
 
-// GetApplicationBoxByName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error {
+// SubscribeBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) SubscribeBlocks(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "application-id" -------------
-	var applicationId uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscribeBlocksParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
 	}
 
-	ctx.Set(Api_keyScopes, []string{""})
+	// ------------- Optional query parameter "format" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApplicationBoxByNameParams
-	// ------------- Required query parameter "name" -------------
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
 
-	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	// ------------- Optional query parameter "header-only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "header-only", ctx.QueryParams(), &params.HeaderOnly)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter header-only: %s", err))
+	}
+
+	// ------------- Optional query parameter "deltas" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deltas", ctx.QueryParams(), &params.Deltas)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deltas: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
+	err = w.Handler.SubscribeBlocks(ctx, params)
 	return err
 }
 
-// GetApplicationBoxes converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
+// GetBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "application-id" -------------
-	var applicationId uint64
+	// ------------- Path parameter "round" -------------
+	var round uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
 	ctx.Set(Api_keyScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApplicationBoxesParams
-	// ------------- Optional query parameter "max" -------------
+	var params GetBlockParams
+	// ------------- Optional query parameter "fields" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
-	return err
-}
-
-// GetAssetByID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "asset-id" -------------
-	var assetId uint64
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
-	ctx.Set(Api_keyScopes, []string{""})
-
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetAssetByID(ctx, assetId)
+	err = w.Handler.GetBlock(ctx, round, params)
 	return err
 }
 
-// GetBlock converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
+// GetBlockFinality converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockFinality(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "round" -------------
 	var round uint64
@@ -314,7 +858,7 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	ctx.Set(Api_keyScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBlockParams
+	var params GetBlockFinalityParams
 	// ------------- Optional query parameter "format" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
@@ -323,7 +867,7 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetBlock(ctx, round, params)
+	err = w.Handler.GetBlockFinality(ctx, round, params)
 	return err
 }
 
@@ -533,6 +1077,65 @@ func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error
 	return err
 }
 
+// ConvertEncoding converts echo context to params.
+func (w *ServerInterfaceWrapper) ConvertEncoding(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConvertEncodingParams
+	// ------------- Required query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "input-format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "input-format", ctx.QueryParams(), &params.InputFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter input-format: %s", err))
+	}
+
+	// ------------- Optional query parameter "output-format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "output-format", ctx.QueryParams(), &params.OutputFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter output-format: %s", err))
+	}
+
+	// ------------- Optional query parameter "canonical" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "canonical", ctx.QueryParams(), &params.Canonical)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter canonical: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ConvertEncoding(ctx, params)
+	return err
+}
+
+// GetCatchpointLabel converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatchpointLabel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCatchpointLabel(ctx, round)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	var err error
@@ -544,6 +1147,58 @@ func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	return err
 }
 
+// GetLightBlockHeaderProofBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLightBlockHeaderProofBundle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLightBlockHeaderProofBundle(ctx, round)
+	return err
+}
+
+// GetProposerReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProposerReport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProposerReportParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProposerReport(ctx, params)
+	return err
+}
+
 // GetStateProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStateProof(ctx echo.Context) error {
 	var err error
@@ -633,6 +1288,48 @@ func (w *ServerInterfaceWrapper) TealDryrun(ctx echo.Context) error {
 	return err
 }
 
+// RecognizeTealTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) RecognizeTealTemplate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RecognizeTealTemplate(ctx)
+	return err
+}
+
+// GetTransactionFeeEstimate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionFeeEstimate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTransactionFeeEstimate(ctx)
+	return err
+}
+
+// MergeTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MergeTransactionsParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MergeTransactions(ctx, params)
+	return err
+}
+
 // TransactionParams converts echo context to params.
 func (w *ServerInterfaceWrapper) TransactionParams(ctx echo.Context) error {
 	var err error
@@ -644,6 +1341,69 @@ func (w *ServerInterfaceWrapper) TransactionParams(ctx echo.Context) error {
 	return err
 }
 
+// GetTransactionPoolStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionPoolStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTransactionPoolStats(ctx)
+	return err
+}
+
+// GetRecentTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecentTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRecentTransactionsParams
+	// ------------- Optional query parameter "sender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sender", ctx.QueryParams(), &params.Sender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sender: %s", err))
+	}
+
+	// ------------- Optional query parameter "lease" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lease", ctx.QueryParams(), &params.Lease)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lease: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRecentTransactions(ctx, params)
+	return err
+}
+
+// TransactionGroupResources converts echo context to params.
+func (w *ServerInterfaceWrapper) TransactionGroupResources(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TransactionGroupResources(ctx)
+	return err
+}
+
 // SimulateTransaction converts echo context to params.
 func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
 	var err error
@@ -664,6 +1424,55 @@ func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
 	return err
 }
 
+// SimulateTransactionBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransactionBatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionBatchParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransactionBatch(ctx, params)
+	return err
+}
+
+// SimulateDebugAdapter converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateDebugAdapter(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateDebugAdapter(ctx)
+	return err
+}
+
+// DeleteSimulateSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSimulateSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteSimulateSession(ctx, name)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -695,11 +1504,25 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/accounts/:address", wrapper.AccountInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/compliance-events", wrapper.GetAccountComplianceEvents, m...)
+	router.GET(baseURL+"/v2/accounts/:address/diff", wrapper.GetAccountDiff, m...)
+	router.GET(baseURL+"/v2/accounts/:address/onlineness", wrapper.AccountOnlineness, m...)
+	router.POST(baseURL+"/v2/accounts/:address/opt-in", wrapper.BuildOptInGroups, m...)
+	router.GET(baseURL+"/v2/accounts/:address/transactions", wrapper.GetAccountTransactions, m...)
+	router.GET(baseURL+"/v2/agreement/status", wrapper.GetAgreementStatus, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/boxes/watch", wrapper.WatchApplicationBoxes, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/events", wrapper.GetApplicationEvents, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
+	router.GET(baseURL+"/v2/assets/:asset-id/compliance-events", wrapper.GetAssetComplianceEvents, m...)
+	router.GET(baseURL+"/v2/assets/:asset-id/metadata/verify", wrapper.VerifyAssetMetadata, m...)
+	router.GET(baseURL+"/v2/blocks/pending", wrapper.GetPendingBlock, m...)
+	router.GET(baseURL+"/v2/blocks/range", wrapper.GetBlockRange, m...)
+	router.GET(baseURL+"/v2/blocks/subscribe", wrapper.SubscribeBlocks, m...)
 	router.GET(baseURL+"/v2/blocks/:round", wrapper.GetBlock, m...)
+	router.GET(baseURL+"/v2/blocks/:round/finality", wrapper.GetBlockFinality, m...)
 	router.GET(baseURL+"/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
 	router.GET(baseURL+"/v2/blocks/:round/lightheader/proof", wrapper.GetLightBlockHeaderProof, m...)
 	router.GET(baseURL+"/v2/blocks/:round/transactions/:txid/proof", wrapper.GetTransactionProof, m...)
@@ -709,296 +1532,630 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/deltas/:round/txn/group", wrapper.GetTransactionGroupLedgerStateDeltasForRound, m...)
 	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
 	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/encoding/convert", wrapper.ConvertEncoding, m...)
+	router.GET(baseURL+"/v2/ledger/catchpoint/:round", wrapper.GetCatchpointLabel, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
+	router.GET(baseURL+"/v2/lightblockheaders/:round/proof", wrapper.GetLightBlockHeaderProofBundle, m...)
+	router.GET(baseURL+"/v2/proposers/report", wrapper.GetProposerReport, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
 	router.GET(baseURL+"/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
 	router.POST(baseURL+"/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST(baseURL+"/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST(baseURL+"/v2/teal/dryrun", wrapper.TealDryrun, m...)
+	router.POST(baseURL+"/v2/teal/templates/recognize", wrapper.RecognizeTealTemplate, m...)
+	router.GET(baseURL+"/v2/transactions/fee-estimate", wrapper.GetTransactionFeeEstimate, m...)
+	router.POST(baseURL+"/v2/transactions/merge", wrapper.MergeTransactions, m...)
 	router.GET(baseURL+"/v2/transactions/params", wrapper.TransactionParams, m...)
+	router.GET(baseURL+"/v2/transactions/pool/stats", wrapper.GetTransactionPoolStats, m...)
+	router.GET(baseURL+"/v2/transactions/recent", wrapper.GetRecentTransactions, m...)
+	router.POST(baseURL+"/v2/transactions/resources", wrapper.TransactionGroupResources, m...)
 	router.POST(baseURL+"/v2/transactions/simulate", wrapper.SimulateTransaction, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/batch", wrapper.SimulateTransactionBatch, m...)
+	router.GET(baseURL+"/v2/transactions/simulate/debug", wrapper.SimulateDebugAdapter, m...)
+	router.DELETE(baseURL+"/v2/transactions/simulate/sessions/:name", wrapper.DeleteSimulateSession, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+3fbtrIw+q9g6fvWyuMT5bzas+u7us51k7bbp81jxW732afJbSByJGGbArgB0Jaa",
-	"m//9WxgAJEiCEmXLdtL6p8QiCQwGg3lhHh9HqVgWggPXanT4cVRQSZegQeJfNE1FyXXCMvNXBiqVrNBM",
-	"8NGhf0aUlozPR+MRM78WVC9G4xGnS6jfMd+PRxL+XTIJ2ehQyxLGI5UuYEnNwHpdmLerkVbJXCRuiCM7",
-	"xPGL0acND2iWSVCqC+Vrnq8J42leZkC0pFzR1DxS5ILpBdELpoj7mDBOBAciZkQvGi+TGYM8UxO/yH+X",
-	"INfBKt3k/Uv6VIOYSJFDF87nYjllHDxUUAFVbQjRgmQww5cWVBMzg4HVv6gFUUBluiAzIbeAaoEI4QVe",
-	"LkeHv40U8Awk7lYK7Bz/O5MAf0CiqZyDHr0fxxY30yATzZaRpR077EtQZa4VwXdxjXN2DpyYrybkZak0",
-	"mQKhnLz94Tl5+vTpN2YhS6o1ZI7IeldVzx6uyX4+OhxlVIN/3KU1ms+FpDxLqvff/vAc5z9xCxz6FlUK",
-	"4oflyDwhxy/6FuA/jJAQ4xrmuA8N6jdfRA5F/fMUZkLCwD2xL+91U8L5b3VXUqrTRSEY15F9IfiU2MdR",
-	"HhZ8vomHVQA03i8MpqQZ9LdHyTfvPz4eP3706X/9dpT8j/vzq6efBi7/eTXuFgxEX0xLKYGn62QugeJp",
-	"WVDexcdbRw9qIco8Iwt6jptPl8jq3bfEfGtZ5znNS0MnLJXiKJ8LRagjowxmtMw18ROTkueGTZnRHLUT",
-	"pkghxTnLIBsb7nuxYOmCpFTZIfA9csHy3NBgqSDro7X46jYcpk8hSgxcl8IHLujzRUa9ri2YgBVygyTN",
-	"hYJEiy3iyUscyjMSCpRaVqndhBU5XQDByc0DK2wRd9zQdJ6vicZ9zQhVhBIvmsaEzchalOQCNydnZ/i9",
-	"W43B2pIYpOHmNOSoObx96OsgI4K8qRA5UI7I8+euizI+Y/NSgiIXC9ALJ/MkqEJwBURM/wWpNtv+Xyev",
-	"XxEhyUtQis7hDU3PCPBUZJBNyPGMcKED0nC0hDg0X/atw8EVE/L/UsLQxFLNC5qexSV6zpYssqqXdMWW",
-	"5ZLwcjkFabbUixAtiARdSt4HkB1xCyku6ao76akseYr7X0/b0OUMtTFV5HSNCFvS1bePxg4cRWiekwJ4",
-	"xvic6BXv1ePM3NvBS6QoeTZAzdFmTwPBqgpI2YxBRqpRNkDiptkGD+O7wVMrXwE4fpBecKpZtoDDYRWh",
-	"GXO6zRNS0DkEJDMhvzjmhk+1OANeETqZrvFRIeGciVJVH/XAiFNv1sC50JAUEmYsQmMnDh2Gwdh3HAde",
-	"Oh0oFVxTxiEzzBmBFhoss+qFKZhws73TleJTquDrZ30yvn46cPdnor3rG3d80G7jS4k9khHRaZ66AxvX",
-	"rBrfD7APw7kVmyf2585GsvmpkTYzlqMk+pfZP4+GUiETaCDCyybF5pzqUsLhO/7Q/EUScqIpz6jMzC9L",
-	"+9PLMtfshM3NT7n96WcxZ+kJm/cgs4I1anDhZ0v7jxkvzo71KmpX/CzEWVmEC0obhut0TY5f9G2yHXNX",
-	"wjyqrN3Q8DhdeWNk1y/0qtrIHiB7cVdQ8+IZrCUYaGk6w39WM6QnOpN/mH+KIjdf62IWQ62hYyeS0X3g",
-	"3ApHRZGzlBokvnWPzVPDBMAaErR+4wAF6uHHAMRCigKkZnZQWhRJLlKaJ0pTjSP9bwmz0eHofx3U/pcD",
-	"+7k6CCb/2Xx1gh8ZldWqQQktih3GeGNUH7WBWRgGjY+QTVi2h0oT43YTDSkxw4JzOKdcT2qTpcEPqgP8",
-	"m5upxrfVdiy+WyZYL8KJfXEKymrA9sV7igSoJ4hWgmhFhXSei2n1w/2joqgxiM+PisLiA7VHYKiYwYop",
-	"rR7g8ml9ksJ5jl9MyI/h2KiKC56vjXCwqoaRDTMntZwUq3xLbg31iPcUwe0UcmK2xqPBqPn7oDg0KxYi",
-	"N1rPVloxL//dvRuSmfl90MdfBomFuO0nLjS0HOasjYO/BMbN/RbldAnHuXsm5Kj97eXIxowSJ5hL0crG",
-	"/bTjbsBjhcILSQsLoHtiZSnjaKTZlyysV+SmAxldFObgDAe0hlBd+qxtPQ9RSJAUWjB8l4v07O9ULfZw",
-	"5qd+rO7xw2nIAmgGkiyoWkxGMS0jPF71aEOOmHkRDXwyDaaaVEvc1/K2LC2jmgZLc/DG1RKLevwOmR7I",
-	"iO3yGv9Dc2Iem7NtWL8ddkJOkYEpe5zdJUNmrH1rINiZzAvohRBkaQ18YqzunaB8Xk8e36dBe/S99Sm4",
-	"HXKLqHbodMUyta9twsH69ipUUI9fWItOw1JFrLZqVVRKuo6v3c41BAGnoiA5nEPeBsGyLBzNIkSs9s4X",
-	"vhOrGEzfiVWHJ4gV7GUnzDioV3vsboHvhYNMyO2Yx7GHIN0s0OjyCtkDD1UgM0vtrT6aCnk5dtzis5zU",
-	"PnhCzaiBNBq3kISvlkXizmbEj2dfaA1UX3tu5qLt4WMYa2DhRNNrwIIyo+4DC82B9o0FsSxYDnsg/UVU",
-	"Ck6pgqdPyMnfj756/OT3J199bUiykGIu6ZJM1xoUue+MVaL0OocH3ZWhuVjmOj7618+857Y5bmwcJUqZ",
-	"wpIW3aGsR9jqhPY1Yt7rYq2JZlx1BeAgjghGtFm0E3vZYUB7wZRROZfTvWxGH8KyepaMOEgy2EpMuy6v",
-	"nmYdLlGuZbkP2x6kFDIqugoptEhFnpyDVExErpfeuDeIe8Pr+0X7dwstuaCKmLnRF15y1LAilKVXfDjf",
-	"t0OfrniNm42c3643sjo375B9aSLfu1YVKUAmesVJBtNy3jANZ1IsCSUZfogy+kfQVm9hSzjRdFm8ns32",
-	"YzsLHChiw7IlKDMTsW8YrUFBKrgNDdlirrpRh6CnjRjvs9T9ADiMnKx5io7XfRzbfkt+yTjeAqk1TwOz",
-	"3sCYQzZvkOXVzfc+dNip7qkIOAYdP+Nj9Py8gFzTH4Q8rdW+H6Uoi70ree05hy6HusU431JmvvVOBcbn",
-	"eTMcaW5gn8TWeCsLeu6Pr1sDQo8U+TObL3RgZ72RQsz2D2Nslhig+MBaqbn5pmurvhKZYSa6VHtQwerB",
-	"ag5n6Dbka3QqSk0o4SID3PxSxZWzngAWvDnHC38d6nt6YQ3PKRjqSmlpVlsWBK+zO/Ki/jChqT2hCaJG",
-	"9VzmVbew9i07nQ2OyCXQbE2mAJyIqbsxc3d5uEiKd/HaqzdONYzwiwZchRQpKAVZ4jx1W0Hz71nRoTfg",
-	"CQFHgKtZiBJkRuWVgT073wrnGawTjBxR5P5Pv6oHtwCvFprmWxCL78TQW/k93LVoF+ph028iuPbkIdlR",
-	"CcTLFaIFarM5aOhD4U446d2/NkSdXbw6Ws5B4gXltVK8n+RqBFSBes30flVoy6InHtKZt0bDMxvGKRde",
-	"sYoNllOlk21s2bzUsMHNCgJOGOPEOHCP4vUzVdpeqjOeoS/QihOcxyphZop+gHvNEDPyr94C6Y6dGjnI",
-	"Vakqc0SVRSGkhiy2Bg6rDXO9glU1l5gFY1c2jxakVLBt5D4sBeM7ZNmVWARRXd09uaiT7uLwhsbI+XUU",
-	"lQ0gakRsAuTEvxVgN4wJ6wGEqRrRlnCYalFOFYg2HiktisJwC52UvPquD00n9u0j/Uv9bpe4qK7ldiZA",
-	"YSiae99BfmExa6MBF1QRBwdZ0jOje6AbxN7+d2E2hzFRjKeQbKJ8NPHMW+ER2HpIy2IuaQZJBjlddwf9",
-	"xT4m9vGmAXDHa3NXaEhsWFd802tK9lE0G4YWOJ6KKY8En5DUHEFjCtQE4r7eMnIGOHaMOTk6ulcNhXNF",
-	"t8iPh8u2Wx0ZEaXhudBmxx09IMiOow8BuAcP1dCXRwV+nNS2Z3uKf4JyE1R6xO6TrEH1LaEef6cF9PhQ",
-	"XcR8cF5a7L3FgaNss5eNbeEjfUe2x6H7hkrNUlagrfMTrPdu+rUniN67kgw0ZTlkJHhgzcAi/J7YgKT2",
-	"mJczBQf53rrgd5xvkeXkTKHK0wT+DNZoc7+xka6Bq2MftmxkVCOfKCcIqI+fMyp4+AqsaKrztVHU9ALW",
-	"5AIkEFVOl0xrG8HeNHW1KJJwgOi9xoYZ3a1m9E5x4zXrCQ4VLK+7FeORtQk2w3faMgwa6HC2QCFEPsBD",
-	"1kFGFIJBATCkEGbXmQum9+HUnpIaQDqmjVfalfi/pxpoxhWQf4qSpJSjyVVqqHQaIVFRQAXSzGBUsGpO",
-	"F+pSYwhyWIK1JPHJw4fthT986PacKTKDC5+BYl5so+PhQ/TjvBFKNw7XHvyh5rgdR8QHXvgYweeskDZP",
-	"2R5q4UYespNvWoNXt0TmTCnlCNcs/8oMoHUyV0PWHtLIsDATHHfQXU7jyr67btz3E7Ysc6r3cWsF5zRP",
-	"xDlIyTLYysndxEzw789p/rr6DLNrIDU0mkKSYk7IwLHg1Hxj00i22YZ1eB1bLiFjVEO+JoWEFGzag1H5",
-	"VAXjhNiAyHRB+Rw1fSnKuYvIs+Mgpy6V9anIkneGiGpDesUT9E7HOLeLwvaZL0YPAmpssbZr21oeF7Sa",
-	"zyU7DRGpAfLarv7o7dZ41GuqGqSe16aqRU4zfWcAF28oagF+6okH3oEg6ozS0sVXuC3mFJjNvR5fez10",
-	"DMruxEGMYP2wL0zQ2Mn5eg/aih2ISCgkKJQtoX9J2adiFqbqOeGj1krDsuuCt5/+3nP83vYaeoLnjEOy",
-	"FBzW0ex0xuElPoweJ5RvPR+jptH3bdt4aMDfAqs5zxBqvCp+cbfbJ7R91aR+EHJfd5l2wMF6+YCrw633",
-	"5G7Ky15w0jyP3Am6RJ42A1DjqnAAk4QqJVKGytZxpsb2oLlrRJf100T/myo8eQ9nrz1u6/IrzBFF5y7k",
-	"BaEkzRm6fgVXWpapfscpOpeCpUailrwV3e9ufO5fifs3I+5HN9Q7TjFirXI5RSMtZhDxr/wA4L2OqpzP",
-	"QemWkTIDeMfdW4yTkjONcy3NcUnseSlAYujQxL65pGsyMzShBfkDpCDTUjfVdsxTU5rlubuJM9MQMXvH",
-	"qSY5UKXJS8ZPVzicv633R5aDvhDyrMJCXLrPgYNiKolHV/1on2IksFv+wkUFY10B+9hHWdaJsyOzzEau",
-	"/P93/z8PfztK/ocmfzxKvvk/B+8/Pvv04GHnxyefvv32/2/+9PTTtw/+83/HdsrDHsuicpAfv3Am7fEL",
-	"tFvqy5sO7DfmuF8ynkSJLAzDaNEWuY8Zw46AHjS9WnoB77hecUNI5zRnmeEtlyGHtoTpnEV7OlpU09iI",
-	"lhfLr3VHa+AKXIZEmEyLNV5ai+oGJMbzFfE20aUg4nmZldxupde+bTqODwwTs3GVk2rL1RwSTFhcUB/V",
-	"6P588tXXo3GdaFg9H41H7un7CCWzbBVLJ81gFTPy3AHBg3FPkYKuFeg490DYozFwNigjHHYJyylItWDF",
-	"zXMKpdk0zuF8koNzFq34MbcR7eb84N3k2l15iNnNw60lQAaFXsTKWDQUNXyr3k2AVrxIIcU58DFhE5i0",
-	"nTWZsRddNF4OdIblFND6FEOsoeocWELzVBFgPVzIII9IjH5a8fxO+Ku9m0Nu4Bhc7Tmri0j/txbk3o/f",
-	"n5IDxzDVPZvZbIcOclEjprRLt2pEEhluZov3WCXvHX/HX8CMcWaeH77jGdX0YEoVS9VBqUB+R3PKU5jM",
-	"BTn0GVwvqKbveEfT6q2vFeTOkaKc5iwlZ6FBUpOnrZnSHeHdu99oPhfv3r3vBFV0zQc3VZS/2AkSowiL",
-	"Uieu4kMi4YLK2KWVqjL+cWRb0mXTrFbJFqX1bPqKEm78OM+jRaHamb/d5RdFbpYfkKFyea1my4jSQnpd",
-	"xCgoFhrc31fCCQZJL7xfpVSgyIclLX5jXL8nybvy0aOnQBqpsB+cyDc0uS5gsHelNzO57VTBhVuzElZa",
-	"0qSg89jd2Lt3v2mgBe4+6stL9HHkOcHPGim4PqIeh6oX4PHRvwEWjp3TCXFxJ/YrX90rvgR8hFuI7xh1",
-	"o76xv+x+BUm5l96uVmJvZ5dKvUjM2Y6uShkS9ztTFf2ZGyXLh1EoNkdr1dVHmgJJF5CeucI1sCz0etz4",
-	"3EfqOEXTsw6mbEkjm1KHRTXwZmEKpCwy6lRxytft6gYKtPbxwG/hDNanoq7JsUs5g2Z2veo7qEipgXZp",
-	"iDU8tm6M9ua7cDA07IvCJ6ljtqIni8OKLvw3/QfZqrx7OMQxomhkf/chgsoIIizx96DgEgs1412J9GPL",
-	"M1bG1Eq+SHkjz/uJe6U2nlzkVrga9Lrb50vA+mjiQpEpNXq7cKW9bAZ5wMVKRefQoyGHlzsD87QbF0I4",
-	"yDa5F5V0YtYWaB15EwXZvpyYNUcpBcwTQypozLTi9fxM9v7Q3UxgxU6HsGmOalIV2GiZDpWNSzZbgrAP",
-	"tDgBg+S1wuHBaGIk1GwWVPmqY1iczZ/lQTrANVZE2FQH5zgINQsqsFVVbjzPbZ/TjnXpquH4Eji+7k1o",
-	"Wg6oYWM0fIxuj22H4KgAZZDD3C7cvuwJpa7OUG+QgeP1bJYzDiSJRa0FbtBAzLg5wOjHDwmxHngyeIQY",
-	"GQdg4704DkxeifBs8vkuQHJXXYL6sfFGPfgb4nlfNo7bqDyiMCyc9dxqpZ4DUBfqWMmvVsAtDkMYHxPD",
-	"5s5pbtics/jqQTrlWFBtbRVfcZEZD/rU2Q0XIFaw7LQmK4ous5pQZ/JAxxW6DRBPxSqxiZ9RjXe6mhp6",
-	"j4a2Yxpq7GDawjf3FJmKFUb7oGixodRbYOmHw4MRWPgrppBe8bs+aW6B2TTtZm0qRoUKSca58ypy6VMn",
-	"hkzdo8H0kcv9oJbNpQBoOTvqwtDO+N1qpDbVk64wr6XauK7R5rOGYse/7whFd6kHf10vTFV95k1bY4n6",
-	"KZpBK83CO4EKGSN6wya6lzTdqyAFOaBRkDSUqOQsdnNqbBtAiXPiPwucF1jeh/L1gyASSsKcKQ21E93H",
-	"SdyGe5JiVUEhZv2r04WcmfW9FaISU/YaET9sLPPGV4ChxDMmlU7wBiK6BPPSDwqN6h/Mq3FdqRlrZWvw",
-	"sizOG3DaM1gnGcvLOL26eX96YaZ9VbFEVU6R3zJuA1amWDM6GoG5YWobpLtxwT/bBf9M97beYafBvGom",
-	"loZcmnN8IeeixXk3sYMIAcaIo7trvSjdwCCDzNkudwz0puCOf7LJ+9o5TJkfe2vUjs/f7ZNRdqToWgKH",
-	"wcZVMLwmMmoJ00HJ5W5Ka88ZoEXBslXLF2pH7bWY6U4OD1+oroUF3F032BYMoEr7FmYgIepCqB7Z6OhK",
-	"XQoLFWJmd6MUTmTTe53/TVeaF5RV54hgoks4wVxpyf49rmMvG6UXm0uJ9C7ozloyrr9+1qXIysdvYBmy",
-	"Gydx1/qJMTSaiA/MLVvKfMsmsB7DPSTPgD2HUzHlG3F0ybbKgdxGuadA859g/at5F5cz+jQeXc2RHaN8",
-	"N+IWXL+pDlsUzxgoYR2bjXupHVFOi0KKc5onzt3fxyikOHeMAl/3twM3LHjilH36/dHPbxz4n8ajNAcq",
-	"k0px610Vvld8MauyxSh7Dogv9G8scG9BWcU+2Pyqgl54RXCxAFcxPbANOqVd6+uf4Ci6K4NZPF5rK+9z",
-	"N1V2iRturKCoLqxqZ6q9r2reUdFzynLvxfTQ9sRW4eKG1QeOcoVwgCvfdQVXlsle2U3ndMdPR01dW3gS",
-	"zvUaSyLFtRPuCiYhK3J3V00WdE85yjrAVR9MxaqWngNl8g9CNpi/C6yP3n15gd1mjHuR3Q6PPaFGvgtH",
-	"W/GcEKQl8mH+wZzGhw/Do/bw4Zh8yN2DAED8fep+R2fRw4dRt2TU6jBMAo0KTpfwoAoS7N2ImzVROVwM",
-	"E9BH50tEHcZ695NhRaH2Esuj+8Jh70Iyh8/M/ZJBDuan7Qk0rU236A6BGXKCTvoC6asYiaVt/KGI4O2Q",
-	"IMzhMKSFzH5JsbSx9fJ2jxAvl+gZTVTO0vidEZ8qw165jQUwLxN8uce4NiOWrCe0hJcsGMu8NqRWVwvI",
-	"YI4oMlW0XFiNu6lwx7vk7N8lEJYB1+aRRLnWEnXeOMBROwqpsYW6c7mB7Y1jPfxVbKawrHdbZ0QgNhtM",
-	"YeRBB9wXlQvQL7TysNc2064BTOGMHca9IfjI0YejZhuMvWhGEAyzY4Y0gPOMztUX75kj2tCNqWQmxR8Q",
-	"91uhuy+SgOkLmTOM2vsDQvMsbGPUYCmVt7ruS1fPvm27h9vGfRt/ZVvYL7qqnX4ZYRo/1btt5GWMXhUv",
-	"E+iQ3GeEhVcXzci2HtaCxyuI5cCy1f5ak3J7nmz2YSNAOn4qw1SEAzt+fSodzJ30jZxeTGmsprexhQxM",
-	"wfY2LmC1IP5jvwGqStGzs5MgAKl6l9kKJgXIOgG9Ww3tknaNnXawRVMbMEhRoekytkEjuRKRYUp+Qbnt",
-	"hWa+s/zKfa3A3piYry6ExPpDKn5XnEHKljSPGzhZ2r0XzNic2TZfpYKgj5QbyLZQtFTkenFViacONccz",
-	"8mgcNLNzu5Gxc6bYNAd847F9Y0oVisvq9qL6xCwPuF4ofP3JgNcXJc8kZHqhLGKVIJXtiUpeFfEwBX0B",
-	"wMkjfO/xN+Q+xnoodg4PDBadEjQ6fPwN3tTZPx7FpKxr07aJZWfIs//heHacjjHYxY5hmKQbdRIt1WL7",
-	"tPZLhw2nyX465Czhm06gbD9LS8rpHOLhhcstMNlvcTfx9qWFF57ZJoNKS7EmTMfnB00Nf+pJWTLsz4JB",
-	"UrFcMr10EQFKLA091U2i7KR+ONux0NX393D5hxhYU/i4gpav64bNGLrsCTnG8KdXdAlNtI4JtUWnclaH",
-	"vPmuI+TY17TDhgdVnwOLGzOXWTrqkhgBNyOFZFyj/6PUs+RvxiyWNDXsb9IHbjL9+lmkcUCztjbfDfAb",
-	"x7sEBfI8jnrZQ/ZeZ3Hfkvtc8GRpOEr2oE4RDE5lbwRQPNajL+Bk89BDNV8zStJLbmWD3GjAqa9EeHzD",
-	"gFckxWo9O9Hjziu7ccosZZw8aGl26Je3PzstYylkrFBtfdydxiFBSwbnGPAd3yQz5hX3QuaDduEq0N/u",
-	"dbVXOQO1zJ/lqCHgnU6bEr2MCv/rS9eUuKN79wSn2eiz6psbTmCLOi2thtZwmz3+QKSxJFEbffgQgX74",
-	"cOyUuQ9Pmo8tk3r4MF6+Leo4Mr/WWLiKXYffxvbwOxFx4/heKdUVuktSi7jR+liteWCO8tQNNSbNvhQ3",
-	"Lwv3E/4cD3GJn4J3737DJx4P+EcbEbd85HED6yA+u5IeQgn68kRJJqueB8F1lHwnVkMJp8VJPfF8Bijq",
-	"QclAJxOupNN3KHrpvDXqIaBRM+oUcmFMpbCkeuiV/nLwbBY/3oDtkuXZr3WBjZYgkZSni2ho0tR8+Hvd",
-	"H7haomWV0SrNC8o55NHhrIX2u7fkIrbmv8TQeZaMD3y33ffKLre1uBrwJpgeKD+hQS/TuZkgxGqzdkGV",
-	"G5fPRUZwnrokcM0cuw3kgq42/y5B6djRwAc2Ph+vbAzztU1VCPAMfTgT8iNmERtYGvUe0XfiC3I1i9OU",
-	"RS5oNsZCYaffH/1M7Kz2G9vl0jZ1maProLmKqK93eLGeqmFlPAt1+Dib0+LMqpVOqh4ssTof5o26Swxr",
-	"BQCgUyHEzoS8CJr525IgZgiCdeLkErKg5Yu1KJAmzH+0pukCHSUNQdZP8sO7EXmqVEFL9Kq1aVUCHM+d",
-	"gds1JLL9iMZE6AXIC6YA847gHJqlRao6O85R50uNNJcnS84tpUx20Cmqgt+7ot0DZxUSf8MZhayF+B3N",
-	"ZNvMa9fmTCf4VbQiabvTU6cXui1UUbWsfOm72VMuOEuxHmhMIcIyCMPuTAaUTo1fdqiRO6GRwxXtL1Vl",
-	"PDgs9nac8ozQIa57/xg8NZtqqcP+qWHl+g7MQSvH2SAb+zZpzjvPuAJX0t0QUcgnhYxEWMRUjqS6zd2R",
-	"jDDDucfd8oN59so54zD174xxNLsd2pyabf3n2MFeG1udaTIXoNx6mmVe1G/mmwlWPMlg9X7iO97jGDam",
-	"xyzbBrB1hzry4WwufMy8+9y86+pQVj83YlPspEdF4Sbtb6IX7xy64r0IjgVR+FvtALnV+OFoG8htYxwq",
-	"ylNDaHCOITRQoBzuEEbVUK7VvdWYCJai8A1io/GjxagYj4DxM+P+PicuINKoSMCNwfPa851KJdVWBRzE",
-	"006B5lXMTJuhKe0uBK86VLsKp0EJrtHP0b+NdS+8HsZRvVArbpSviT8UhroDZeI5zas4zkhnO9SqnBKV",
-	"YXJoq9ddjHEYxu27aTYFwJYGuuP6cyxJu6sk6qv3MS2zOeiEZlmswv53+JTgU5KVqDnACtKyqsReFCTF",
-	"8nbNen9danMTpYKrcrlhLv/CFacLmkdGqCFsYOl3GPOJp2v8d5fWxlUE584ZHT5cM9utyGU3QyWm9Rqa",
-	"ThSbJ8MxgTLl6uiop74codff75XSczFvAnIbTtIeLhfuUYy/fW8ER1gEqxMsa0VLVaMKA1OF74GOZmNV",
-	"XaXJlVCUdYrt4xVs1VJ4sxuivznwGIVfTxZV6PK28tW6gftyqdLe1D+qXRECTclGFtSb2G0DF1tO9O59",
-	"Rl+woo1V3J/z2a11I0J9HHkXoJ98kgopKHMBKzWz6GLWhfl20z2HxNHWG9xehEvZ6/WP/nTel17na97i",
-	"83bz0DNwlYkKCedMlD4UxAdkepPQ/tpoxVklOEbXHw1zvm3nc6+r/NQ1cbLLdDb5T7/a8F0CXMv1Z+A4",
-	"72x6py1pV9u17qn6FVL1/xjUD6QhFYfUg46VHna6YaMx6pa2rh2yejFEHei2aR2PjrOdBGasfPXIjhI7",
-	"dvGmq/3VPeuKnnjECqFY3YYn1o11YOTzKTZUDaqTdsfyEXHnkGrsvVRH+kiAXWqVmsmC/u53VT57zOkq",
-	"QNwV99xU0bPbcGmLjO8k3QeFI2yzmsnw+pVHVTynTUe5oAqrPdsW680EzsFpZLMZpJqdbyly8I8F8CCB",
-	"fuz9MgjLLKh5wKqkCqyRt7vXsQZoUw2CjfAEtaqvDE5fUu0ZrO8p0qCGaPecKqPoMuXREAPIHRJDIkLF",
-	"4qWsI9mFsDBVUQZiwccn2s+hLjTb23gzKNlxybk8SRrBUZfx2DBlvPPfoLnMpzsVt8H8gL46CN3GYf32",
-	"xwvs06aqpti+vFpopZPjbhHqC1eeDUtSVHcnvlAbKP+brz9jZ8nZGYStQfGm6oLKzL8Rdb14r06yQR51",
-	"ihf4pldtoGfVzKyOJu/eVUfKmmJiRpoLo0YkfdktzQDuKvrpnrJharbLDoamG7hmIF0LZdR/c6Eg0cJH",
-	"n2+CYxMqbCzepZCgekuJW+B6C/y9rSsYYksFigX9qAvBCxdIJCypgU4GdQb759yE7Of2uc8I9iX1t3qY",
-	"Knrd3tvJ5xEw1UFiSPUz4qTl9kzjyzibGOcgE3/z1C46yEE2b0MKKbIytQI6PBiVQ25wSc8NrCTqp0m7",
-	"q2zZCEHG7hmsD6wR5Jti+R0MgbaakwU9KFbV2uS9ut9UDO75XsC7Tc/VeFQIkSc9lx3H3UqJbYo/Y+kZ",
-	"ZMRICh9v29OokNxHH3t1m32xWPvKgEUBHLIHE0KOuM1w8BfbzVYdrcn5Pb1p/hXOmpW2eKlzqk3e8Xio",
-	"OJYVlVfkZn6YzTxMgWF1V5zKDrKlDt+qp0qjpBeRtp2ToVZ596q53UqxJioLRUwnObE3Vs/xoMccR5iP",
-	"HRQOwItMStxNF1G5iIVkXiZn3AwVx1Q4GQKkgQ9JXa6gcINHEVC1SdwSKFTFCNUd5uo4oa56lOfiIsFj",
-	"lFR1ZmNGl3lPNcWEL61ff2fobQpBxBFVToVYkwXNSCqkhDT8Ip4WZaFaCglJLjAAKXY3OtNGI1xiLgQn",
-	"uZgTURhD39Zr9rdI0f6HnblKzikKdAjiPaIooGmK1qcg7htSfTN0yn21l7TFT+yiE3vL1hMSCcoVO3EY",
-	"si934d3Q4XH37pGni4izDDHnCWTnFpGOyHfu7BaAOeBwbXcUHsU6YDbX1e7F2tcZWYslS+Po/rJChHoD",
-	"e2LUG636Ypsr2DxdfA15SsjHqhthPD1dNAOn0zwqH9zxczdjSOfmv6g2tMclM3D8rIeHdo+0Y/1J2iug",
-	"WgAgpDZ5TJfSdmQIxUfV51XMbbIp3uu1AR3IcDB84mqwmRH2DpSGKwHVCdmqALxvLaaxrc5jw7+mYuWf",
-	"P6jL91wK+E+bqTzWxTZyiivSck12fap/D0eIRpVsDuKwnc2nQ0M5qu45A5l/AEB/cEcDhkEhHruCMaMs",
-	"hyyhESQfV4b1ODAPXFpAuycaU46Tp9Q61hZAzNilBJd6bluat3qoFtSQkqhe77q/eAYrUJgXbhtBUmWd",
-	"td5p7Pqpty0YUSQ5nEMj5sXlw5eohbBzCHux249JBlDgFUrbsI8Fc4SyvGXtubUnQTjAEOxGzT+LWLtT",
-	"ZIttF7VEVzyxx0QNPUoGonOWlbSBP3WFrtT9Dak76mNi1UR7IIZM84sd4a0f4Mh/H1NlPCbeD+NDO7Og",
-	"OOo2MaCtwV14oqKnnsdju8JiD5VXGGfLqtsjS+I131AFveD9XpQuydea+PBu8QFiv19BilpNM3jp6jgh",
-	"OBhRrUIuvSq4rHb48t64W6HhjSTcO17M1FCADLY2xmpfuV9HRRdhy3rsgsWN2mu0Zuw84fi/439jbNxr",
-	"BzImoG2EEXbmfwH+2gNry1YeX6fQskqg+SCtsSst1rYfWRCeuqRrIiT+w4Um/y5pzmZrPKEWfP8ZUQtq",
-	"SMjds9gLQBf0ZSberJiMPWDehBV+KrtuNnTMYLi1GSUA2ohAIqRz2S/pGYTbgHeblvOk2rAcVU6XTCkU",
-	"dq3t7GLBLd6nhy9pBkEuCRapanYg82ULzdf/T536Ek7la8sUOU3rjsKKLlteRdvayBOXXsByc25U1zz2",
-	"JFC1S6qJVvqcyMyWLrH4q+oUoCaC/5kyLalcb4jU3Hr9HQs4Rs15G9idNjKohu9tGbv0NazTSzdklQ1a",
-	"yr53YeglewdovKnzBX62gG8Ls/liQDeB/2j9uL5lDAH/c8F7T/edEF7baOcGsNzIm47Aal2AU7FKJMzU",
-	"tvtk6wM0hrCsM659EAHjqQSq7AX78WtnstXl0Rg3JqQNAauuMKpRMpgxXjNLxotmt3vHrrFKGl8HCAs9",
-	"qYjWHo95n5Zg1LBzmr8+BylZ1rdx5nTY7h9heWrvPXbfRoz/SqZ2B2Cqtn4wHQvqdJ/gNSPAMzabgbTR",
-	"WUpTnlGZha8zTlKQRu6TC7pWl3fTG2hlafSLLY56GmgzzSThwGWPpG0BydfuDuiKTvQKQLpHb/oALziG",
-	"AUY84NYpokWP07sLQzw3na6SXMwxSaeHAF0dOrymsMaK4OiwtfrQbvMo9gdsngZL8LqDrwXOOmSKzefs",
-	"NaIODZ5fONMbT5r1prWzpmxYmz0Inv75vI6ttZvTpf9Yotupba4fJru1e9X6vbZ37HY+6Om90/Tg9uwi",
-	"3jK6LMnQXauG32Q0LjJj6XTWhk3QtlUbomdBBd39Uxf90HX6dIxii5SxS0bc0SdkPcleDvSAZxvcubPV",
-	"nLa6kTbjDNc1guvXOESFKJJ0SEiVrdKdOYe2g7QJYw99BO7qnnVXt891z+VGdYhGAXurKV9G3W0V0N92",
-	"L1Okm4zsPodGDwdtOsvFDHkZHmHrxsFA+cp5MW6ncDQdNhWTIJRISEuJDs0Lut7eYqSnOuTJ34++evzk",
-	"9ydffU3MCyRjc1B1hdFWi4467Ibxtp/lZgNtOsvT8U3wyb0Wcf6mzOcsVJvizprltlZz49EGJbt4QiMC",
-	"INaKutsa4lJ7hePUkbOf13bFFrn3HYuh4Hr2zIUHxhdwxJ39ImZkM8+oL0b8cY/wC6P8R4SU39pLLLDP",
-	"H9ufXHoZeqwdsp8NFUayZfdGe9Vyr4Piolrm5bruDQKtmzkZIQ8EoCclqpHMEjblrIv+SevbRS+wvzBr",
-	"C7GX9UXa1thdhMR/sAW8MMepfq8KN3Xg3HL1vJcVUoKlvO+jhMbyt6VNuQXWN4/BFjlTV2uwLZJtDaDm",
-	"vgQ5cep5lWrWo9t2MtKwA6exb/I8kslmrW88UyHhGMVSntP85rkGtmY9QnxA9rY/fj1MZwqRbFGpLldM",
-	"6Wc6aO4gdWl/U/M3mD33DzB7FJVzbih36diRZug7obmNNJy5TGQzJLnAMW1QyeOvydSVZy4kpEy1LzPt",
-	"jZPLxcLsHZBs5lLhYKW3pAttW+evQl+BjGc+8oC8Ci4lBDp/agjrI3rLTKXn5EapPEZ9HbKI4C/Go8J2",
-	"blvExVkjJ7/WxQOJJiTsOTc/qLKzY25+t1Hd0OXZ/HMjdEoF3XUOltYN3EYEdb22oYUlBtdSxgb7Q+pB",
-	"xOsem8+xIMVeCiDvVP74GkpRWBy5Mdy8MYr5ta84oS3A11MHs7UfJcu3hhk0qpp+Go/mwEExhXU7f3fV",
-	"xm9WlnoIbHps96haWK+S028RE1lrY/JgqqBe6YBSpe6zSGFSTD1JS8n0GjvNeTcM+z1aNOPHKgHbJfBX",
-	"NyBO9mlxBlW3zzpdu1Reuv4oaI7yyF7McCOFRD4h36/ossidU5F8e2/6H/D0b8+yR08f/8f0b4++epTC",
-	"s6++efSIfvOMPv7m6WN48revnj2Cx7Ovv5k+yZ48ezJ99uTZ1199kz599nj67Otv/uOe4UMGZAuoL6N7",
-	"OPrv5Cifi+TozXFyaoCtcUIL9hOYvUFbeSawE5JBaoonEZaU5aND/9P/60/YJBXLenj/68hV9B8ttC7U",
-	"4cHBxcXFJPzkYI75mYkWZbo48PNgf5qGvvLmuIpJttETuKO1DxI31ZHCET57+/3JKTl6czypCWZ0OHo0",
-	"eTR57Johclqw0eHoKf6Ep2eB+37giG10+PHTeHSwAJpjOQPzxxK0ZKl/JIFma/d/dUHnc5ATDDu3P50/",
-	"OfBqxcFHl6f6ycwQvbWxVW2DUqa+80bd4t7lvKM70UYGq7CvmPWzlmpMprbznA8+5BkGiNjUTxV2XzzO",
-	"DMLs58c10/LN82x39MPfIrVDfMS67+kWhvwEwUD/dfL6FRGSOPPmDU3Pqmh9cjyzPXqkOGdYwzILCp+a",
-	"Lyeefv9dglzX9OU4X9hrGni5NEzEhf0v1bxoltGrtaqY16eDaz+zIYuAsKus8ppx4R1fAEnNhg1rfZR8",
-	"8/7jV3/7NBoACJY4UIAtfj7QPP9ALlieE1hhRGAr7mHcF5EyrrOU8YN6J8fokaqeBp/X7zSrz37ggsOH",
-	"vm1wgEX3gea5eVFwiO3Be2xCg8SCZ+7Jo0ee0Tg1PoDuwJ2poZ3FfcFl62uuRvEkcYmBugzJPnpbFSKT",
-	"tLBn0T2xqWnO229fmhi+82yPC22WS7vyctvDdRb9Hc2IdCl5uJTHX+xSjrmNxDOCxQrAT+PRV1/w3hxz",
-	"w3NoTvDNoMNbV9D8ws+4uOD+TaP8lMsllWtUbXTFC9vF3Olc4RUbskh7toNaN3w+ev+pV+odhCFnBx8b",
-	"hSqyK8lEG2XTaIWwRUzeU32cs9vG/f5RUWDE3Un1/KgobMNIvFUGhtIPVkxp9WBCfgy/Ru6N7YZsM59S",
-	"YtRQ7U4xUq/qn+i7MjZuToNOTFGhHbiL7+T3bcvvo6azo9HoOAZM4xRshKkTu3JVAdpNbggKUuwajloV",
-	"I3WqReL6lQwcw7dx3lszngF56Ham9zFTcCujvsNdD+761KQA3kpjqjsB3Qxr9nUNK0nSEBnXyLi/cKXv",
-	"Jc0NnQTLbfUPsN2/75TBv4wyWNU/m1vtrCj2oB5iTPzBR9/RfQ8qoWuEPkAZDM3q4Nsgrvl+i508mNjO",
-	"4eE7l+MZruDZVjUP++zfKXifgYJnK8ZtU+0cHd+qUhem1OyS4dLQRrDd/5CPv3At7i+MrF61zUC6XWG7",
-	"BPvsKGOOWV8bW/1TKmEOaXfq119a/arKkF5JAQsDVA9chndwjXUl713bO8d0pYk1S9EGnA2LIGCusz3C",
-	"4zqk27AYGy7sAoXV2FuGeJ1qjUa7WeOO3dhVsX6E0ED9bn38Ypt29QX5eQZ3lIxIgfjeXDcvjV47vL2Z",
-	"a4dhvOnZo2c3B0G4C6+EJj+gFL9mDnmtLC1OVruysE0c6WBqu5dv4kq8xZaqslm2K3nAo6oa3OPguXnb",
-	"Rmncx2zKZg+SBxPie6XXFRZctvBcGEbls4KonNuPDK8zyCD3/J+HOP69CfkBc920GmOwGVZSwhcZ14eP",
-	"nzx95l6R9MLGcrXfm3797PDo22/da4VkXGM8gLVzOq8rLQ8XkOfCfeBkRHdc8+Dwv//5P5PJ5N5WtipW",
-	"361f2aaFnwtvHcfqsFUE0LdbX/gmxax13+x9G+pu5Pr+O7GKSgGxupNCtyaFDPb/FNJn2iQjZ4hWnsxG",
-	"W4M9SiN7THaRR2Pfl9zwnUqYTMgr4TrMlDmVtvYGFvZUZF5SSbkGyCaeUrGsk7IdNdKcYZq4JArkOchE",
-	"sQzq2qNVgYhCwjnGyNelJxsQbGf0GEn72TL5l3QVpEhPKzGthVsyuj2XdEWwZLomCvTYVqdakW+/JY/G",
-	"tfWS52aApEJMjLku6Wp0g16/itiGllx54bAj5PYAXRx7iAep1n6qqndh8/q/Nuf+YjV3S+5uY/fEOXe+",
-	"+KkvdkI/guvjstGDYBU7jTVaVVkU+bquzmm0PK9CxVmcmWGoc+AzviPY6pqOGqFt9N4d4jsnwJVYSZug",
-	"dmQbmHWqDj6iXR7yjM65xay5v9Z1aXB3JMXSXx4JMgOdLlzCbgv1EfYkXdJgP29aMs6WBspH42vXanAX",
-	"u7VlwzaaGbVp8kM6tQS5lHiBBzJCxK99Y2nzmM1swWnfhsBXisOrKVezt+pdZ41v283SxfP7vN6CNnrx",
-	"bYfyeT15VyFDtOzj/vMOwbshuMMcv3c1Cezxcov4M0T8e1MyIa9EnTZuLag/5dXjdUr2617QK8HB3rEb",
-	"zdfS4t11aqV2GMZhkeLrhVj7pWqZfmkV5MDX2dmoh/zdvLRFFxkivbFmz5cowv8erUbUkDJmbZOtxRDq",
-	"0YYwZ/OirTXfbOJ9i1bMrfDTz9C0uQ2OdTMsBg+p5zNOLeD7ZTpYgscS80HVv7mPA8Vb4g/mRlpUYWjR",
-	"LvZTyAWfq8+TFW2ijjheIlRiK03ZlhWd9U/+gmf3uesn4fsiu3pPivEUiBJLQJPB6OjY48AGSz579Leb",
-	"g1CzpW+CysPc1VvmLl89enpz05+APGcpkFNYFkJSyfI1+YVXfSOuwu0UoW7PQ29whDkwjrdNzbpgaVjE",
-	"6PJMsBG69lGvWPZpOzMMCinuyAcZD/hgWESbFgVQeXkGuP3qqt1k8vhFGB3caMNfVdSKgGJQtGOA/P8Z",
-	"DfQ7Ydq7mDnhV3ILqK/+5diEC90Vs3EVHGO0ADE7JO/4Q6IW1BendH8++errHs+ZmccV7en6zuqBzGM7",
-	"zBAH2hftDtyv1l7h9/Cmd3u3TRyPWLaKNuqGVVA6vNkEz6ll9xQp6Lq3m38RL0RZaQPhsEswarxasOLm",
-	"ix0qzabxaq/e/KmaqR7z7yor2FbkM8p3cRtF7sYjLQEyKPRia+1LfKveTXBVMJlyVe9thcIxYROY2AJ+",
-	"dTeQDHvmG4uakhzorGrrIcSQ5ImAzxhC81QRYD1cyBCbNEo/WDAEifLmjdM6ycAKOo882ZI5t6ro6tsy",
-	"UhO0UYF7xaaJltvTKbGZ/Di47i6k0CIVuY1dKYtCSF2dbjUZpO5B37VdQ9vrI9wrKXMrlqmtfrRTfGsP",
-	"jrQmZasvxo926tEUc6TFFnXJinz1XENY2qkoSKeJqwHhVvnandMtxs9aPrcv3eWme0lvzx64lOp0URYH",
-	"H/E/WJHwU50ohbXa1YFe8QPsqXTwcWNIE7LU3Ogm0pZ5b9jR0ZbQXbcefl6XlP9ByE5P/20hSy2kjdtC",
-	"3/aHwtinCHu8HmvyL22EbfRXtjb86ldwkRE757XKAw663FS0GzQq8Km9tsdVhITvrow/rwXVTtwZ4xmh",
-	"wTa2fE1VH1pvA/zti130bfiFb/6e/Ksv+Jy9EpocLwvb8B+yq0UbkjaH89Jjo7jdTTFwor8bktiV+aHE",
-	"94HUlS6yVcDvYPcEpSPAT0cl1nIwsvp6zJ07Sf55S/LnvkR6gwzv5PKXI5elD/++E8Gfvwh++sWu5hov",
-	"jgeKZC+JLi2Ga0t8R4HcUQacD6vlONh0r4ymd3uV6gchfTueOyn+hV6K2p0cnGQ5xEOzzRPrptxHqP9n",
-	"Bf0wP0OeRzwNfQd1bHuT6QUwLJIlUob9Do4zNbaH2Dkn3Cm+U3w+a8Un2Os7vefO9fCFuR56tBxn9ef5",
-	"EEVjVwXofCky8BerYjZzRSn7tJ9mryxDnkrTZUHsl1Etx17CsiWcmDdf2yn2KmJrsFtqUQs8gywFqeCZ",
-	"GhDF4Ua9rBzCi6Z+AG78ZrPaAQ+LK1cxuTTJvg1qXnUogbSRr7DHmS/O6ZCRwTkxBDjZA9kefLT/ojut",
-	"ECqymhNPwJ2Nue+2xVYbteM2ACRvUAl1Hf3dV2JGHtmioyXHzMK6mSnlGdFybRRVX2NJAs1J2sgoquDo",
-	"npyT3pOz1RTorK5nTXFbQNQndJ8RDK1szp9u/AA8p9yRfBdBWhBKOMypZufgr/wndxVALi3NXP2NDQxw",
-	"TGiW2dNYbwKcg1wTVU6V0XV4MzD8nmqelx0YBqwKkMyIaJrXF/DWTDiw5T02xRGd2DeuKLRavMgWFZHN",
-	"qEUvWV3JETEjL1kqxVE+F8rHoaq10rDstAp1n/7eUyTaOxK6MauC54xDshQ81sDyNT59iQ9jX2OJlL6P",
-	"T83Dvm9b8rYJfwus5jxDZPJV8fuZnP4rBbq0ViuhENJYt1PbVNvS/45HyR+aNU+7J2nN0+BSyz0MBgrb",
-	"XTZ+PvjY+NMV93FvqkWpM3ERfIuWvQ1SHFLXI2isfwlPWqtBvbpeX9p13iEFeIidmOpppFVh/bC/W+Ff",
-	"NJ/NXbmERIKh5qk4B6la5tldUtufKqlt8L7vxGNta95tHK1U+9VIXokM7LjNztixevJcZOA6CHcVkSrY",
-	"MZ4I5KVS/V4rNSOl5XyhSVkQLWJJIPWHCU0tk02seROfMKjgaI0gnG5Bz4HQHPsykykAJ2JqFl3LR1wk",
-	"VVhD02eSuJDOqCoUwFVIkYJSkCW+fv420Kq+zBiArjfgCQFHgKtZiBJkRuWVgT073wrnGawTNHEVuf/T",
-	"r8ZgvnF4rSq4GbG2cl8EvVV1IKftdaEeNv0mgmtPHpIdlUC8aoCJb2JZ5OBS3yIo3AknvfvXhqizi1dH",
-	"C+aGsWumeD/J1QioAvWa6f2q0JZFYuR3F8Tn9ukpW6ImxikX3q8YGyynSifb2LJ5KVyLMisIOGGME+PA",
-	"PQbnz1Tpty4LOsOKWVac4DxWxzZT9ANcdeKPjfyrfRgbOzXykKtSETeCz2yCLLYGDqsNc72CVTUXpqH7",
-	"savUKevh2zZyH5aC8R2ygiYChOrgNt8MF1kc+h+pc1B0UdkAokbEJkBO/FsBdsNr/B5AmKoRbQkHiyKH",
-	"lDMVIgfKbQaqKArDLXRS8uq7PjSd2LeP9C/1u13iorqW25kAFaa1OcgvLGYVOmgXVBEHB1nSM5f5NndN",
-	"4bowm8OYYMWKZBPlo8vWvBUega2HtCzmkmaQZJDTiCvlF/uY2MebBsAd9+SZnAsNyRRmQkJ802tKlr0u",
-	"ompogeOpmPJI8AlJzRE0xnNNIO7rLSNngGPHmJOjo3vVUDhXdIv8eLhsu9U9bikzhtlxRw8IsuPoQwDu",
-	"wUM19OVRgR8ntfugPcU/QbkJKj1i90nWoPqWUI+/0wLa7rxQgDUkRYu9tzhwlG32srEtfKTvyMYciF+k",
-	"s78du3SNqXNNB2pgAE4uY9weXFCmk5mQVpFO6EyD3BoQ/w/K/HW4T8oVrpYKwRGc3HTjIJMPW/M4LmJB",
-	"IE5cGBKZkNMFSDAyjJLHZMl4qe0TUeqxrSQqgaYLo7SHnlU7EjZXdO0GJcypzHJsvDer5KaQKIyYbgl4",
-	"BDqSZdi0+M26fxByUH3iZhUuyjQpuWZ50KOhsts/P+/lnUfiziNx55G480jceSTuPBJ3Hok7j8SdR+LO",
-	"I3HnkbjzSPx1PRK3Vfwo8RqHr8PIBU/aIZJ3EZJ/qgK9lajyDhL0TlxQpl3HYV97oN9vsYMjSAPNEQcs",
-	"h/6YbRtKevr90c9EiVKmQFIDIeOkyKkxDWClq/6Xzc7Kvue7baJrmzZTBU+fkJO/H/k6ogtX77L57v0j",
-	"2+CNKL3O4YHrMAM8s5qobzUD3CDddZqhXiT4PpmuayjLMd5dke/x7RdwDrkoQNoShUTLMtJo/hRo/tzh",
-	"ZovD5x9mchdA+8GM9mHccHo5tC1p4dV8v1aqCLV5lORFkFn5YUZzBR/6kivteEtaxFpVVoLPuoKQmXwn",
-	"snXrhJhdO8ANbJ6Nupoo41SuI7WfuokNbdLQwrArR1hdX9anvde87RJtl8y2UVhMW5egoud4E5VHi71W",
-	"G9YZyqbfzlp0MopljrYrnI4qAAeV+8PkB7sn5K397naL+yFE7ojVzPyziWJsvlkxDXzXGBGO9XypGQIe",
-	"8dHTi2d/bAg7K1MgTCviy+ZuFy/j0SoxI82BJ44BJVORrZMG+xo1pFDGFFUKltPtkijkn645uxM+5slm",
-	"OXU7YuRFsLhNPDkkmlXiGHAPd15rGMybK2zhiI49Bxi/bhbdx0ZDEIjjTzGnUov37cr06mnWd4zvjvEF",
-	"p7GlETDuyoy3mcjkGhmfXMuS9/O871eQlga48CTfR+88XsnBSjcuWTOYlvM5Npnv3NGZpQGOxwS/JVZo",
-	"lzuUC+5GQXbwqvHwVVPP28N1uUuQDX7f11t8gNtB+RovM5YF5Wt/5QuJYssytzi0/Tn3y2htJfBY4eja",
-	"99fn1X7jXX6B79aJ2ubvFi3kgipi9xcyUvLM5TF1Klav+PDqJXbo0xWv2fTGSiV2vZHVuXmHiAi/y80E",
-	"ckUKkIlecXugGofJ9SWwJ3dy11z7ryE2bPo59DDYbo39miHsSXrIgK+h+Ag6KdWJeY3+SrSZJNh4hh6N",
-	"/hSXsOWSfXOvgSWd4ZvxJbW7xd2fQl4QStKc4e2q4ErLMtXvOMX7m2Bhk27siXdU9/O+5/6V+BVi5IbP",
-	"DfWOUwwyqm51ojxwBpErjB8APItV5XwOyvDRkIBmAO+4e4txUnJjhYkZWbJUisQmzJrzZXSXiX1zSddk",
-	"hnVKBPkDpCBTI/WDXbe+ZKVZnrtgFzMNEbN3nGqSA1WavGSGA5vhfJGEKuQM9IWQZxUW4h145sBBMZXE",
-	"HTM/2qfY5MYt3zsA0ZlpH9fNKW62u42HnWW9kB+/wBg1rLGcM6Xr+IgO7Dd2N75kPIkS2ekCiAsXa9MW",
-	"uY+V3RwBPWheHOkFvONG+mlBkONTfTlyaN8Adc6iPR0tqmlsROuiyK91kPm3Fy5DIkzm7trlT5RCGtCB",
-	"v9nEjbdV81t7v+MVS0PkAs/M0x6BbJ+6pog9LzkDouEka5WtcW+cNkDeeH/x5ReL3L8t6dG4N2uyO2CX",
-	"XTXb3iHe/IaPCc0Fn9tqica6FLhPjBelxgDw63TgwTnNE3EOUrIM1MCVMsG/P6f56+qzT+MRrCBNtKQp",
-	"JNajMBRrp+YbS6fbBGnQ/HO5hIxRDfmaFBJSyGxdMKZIbYhPbGUFki4on6PMlaKcL+xrdpwLkFD1STS2",
-	"b3uIeF2WFU9sjbgujEfEOjHDMrpA00WkjwtKJmNse0rIGi2iBiKvUQG0z7oej3o1ZIPU8zrmzSKnyR8G",
-	"iP+GIA/wU0+8j5Kpd9R6R623Rq2x0oSIulnLP2DxFW7LNTuSrrsQ5w36pW6lSu9dqfs/e6l7z4EUoUTS",
-	"htYf77FGFWGaXGAhoikQI3hK9Ie7xnXOQsbctuCou4qVyrW5SxeUcVfFpsokQDi067qufZvXa3ElWmaG",
-	"PkSDDkhLyfQa7QRasN/PwPz/vVG0Fchzb0KUMh8djhZaF4cHB7lIab4QSh+MPo3DZ6r18H0F/0ev/ReS",
-	"nRuL5tP7T/83AAD//2UrnPtBngEA",
+	"H4sIAAAAAAAC/+z9e5fbNrIoin8VrL7nt/I4Utt5zZ54r1n717bz8B0n8bKdzDl7nDOGSEjCNkVwA2B3",
+	"Kzn+7ndVFV4kQYlSy6+k/7JbJIFCoVBVqOfvZ4XaNKoWtTVn934/a7jmG2GFxr94Uai2tnNZwl+lMIWW",
+	"jZWqPrvnnzFjtaxXZ7MzCb823K7PZmc134ize+n3szMt/ruVWpRn96xuxezMFGux4TCw3Tbwdhjper5S",
+	"czfEBQ3x6OHZ6x0PeFlqYcwQyp/qastkXVRtKZjVvDa8gEeGXUm7ZnYtDXMfM1kzVQumlsyuOy+zpRRV",
+	"ac79Iv+7FXqbrNJNPr6k1xHEuVaVGML5QG0WshYeKhGAChvCrGKlWOJLa24ZzACw+hetYkZwXazZUuk9",
+	"oBIQKbyibjdn9/55ZkRdCo27VQh5if9daiF+E3PL9UrYs19nucUtrdBzKzeZpT1y2NfCtJU1DN/FNa7k",
+	"pagZfHXOfmiNZQvBeM2efvuAffHFF1/DQjbcWlE6IhtdVZw9XRN9fnbvrORW+MdDWuPVSmlel/Pw/tNv",
+	"H+D8z9wCp77FjRH5w3IBT9ijh2ML8B9mSEjWVqxwHzrUD19kDkX8eSGWSouJe0Ivn3RT0vnf6a4U3Bbr",
+	"RsnaZvaF4VNGj7M8LPl8Fw8LAHTebwBTGgb9593517/+/tnss7uv/59/Xsz/0/351RevJy7/QRh3Dway",
+	"Lxat1qIutvOVFhxPy5rXQ3w8dfRg1qqtSrbml7j5fIOs3n3L4FtinZe8aoFOZKHVRbVShnFHRqVY8ray",
+	"zE/M2roSxuBojtqZNKzR6lKWopwxWbOrtSzWrOCGhsD32JWsKqDB1ohyjNbyq9txmF6nKAG4jsIHLuj9",
+	"RUZc1x5MiGvkBvOiUkbMrdojnrzE4XXJUoESZZU5TFix52vBcHJ4QMIWcVcDTVfVllnc15JxwzjzomnG",
+	"5JJtVcuucHMq+Qq/d6sBrG0YIA03pyNH4fCOoW+AjAzyFkpVgteIPAI3i7INZ0bAxAB6JY31uoVbovvr",
+	"/332049MC9OomjCghW11PWOmLdaw5JdEbzOkAXPuSeblOftRGBg7RRnfiJL2qVTIpoGdmZmjJ940gE7F",
+	"BC/WTFRiI+oAFteab4GiBWCcXwptxDl7tKqVhkmUZj8IY/hKPOHFqwDxqF7kMLNbLfJsa4i+eilXrRaG",
+	"Xa2FXTuVIaBJLf5LFBZODaKvB5uoC1WK8pw9WrJa2eRkuaOIJAhfjgJPcOV0pP8yCo7UxqwaXrzKK0SV",
+	"3MjMqn7g13LTbljdbhZCA969BA7bPgYQjbjnJG/49XDS57qtC6TBOG1HFYbDKk1T8S0ibMOv/3Z35sAx",
+	"jFcVa0RdynrF7HU9ut0w937w5lq1dTlBS7Swp4leYhpRyKUUJQuj7IDETbMPHlkfBk/UXRNwZL0HHFlP",
+	"A6cW1xmaAeYIT1jDVyIhmXP2s5MN+NSqV6IOhM4WW3zUaHEpVWvCRyMw4tS7T2qtrJg3WixlhsaeOXQA",
+	"f6Z3HMPZOBWyULXlshYlkzUBraxjhKMwJRPuvi4OlaAFN+IvX5693vd04u4D7+vu+s4dn7Tb+NKcjmRG",
+	"84Cn7sDmFdPO9xOu1+ncRq7m9PNgI+XqOQjrpaxQkP8X7J9HQ2uQCXQQ4UW7kaua21aLey/qT+EvNmfP",
+	"LK9Lrkv4ZUM//dBWVj6TK/ipop8eq5UsnsnVCDIDrNn7Kn62oX9gvDw7ttfZa9ljpV61TbqgonPvX2zZ",
+	"o4djm0xjHkqYF8FYkN7bnl/7u9yhX9jrsJEjQI7iruHw4iux1QKg5cUS/7leIj3xpf4N/mmaCr62zTKH",
+	"WqBjrwfA2BdPHj0HRmSeul/hRzj7gq5fMJwsOGD3DsrRe78nkDVaNUJbSWMhR8P/SSs2+J//ocXy7N7Z",
+	"/3MnWq3u0Ofmjp/67HUAExUaOmzhdPzTjxtXQ7oErSbDe1GjunjyiFhsUNtqVQqYyxmiLuLKTrB23jTz",
+	"ShW8mhvLrdi79jj0Y/jqGX4ElxxSnOe8aQ4Y4wkoy2YHfwS84CPkjMTpUc2WNdEtnB5pmBaVuOS1PT+b",
+	"5dhQuis005RNGUc4oxcXwpA+Sy9+ZFiCeoZoZYhWvMKsKrUIP3x80TQRg/j8omkIH3jfEBJ1UXEtjTWf",
+	"4PJ5ZB7pPI8enrPv0rHx8qbAILkQTrsCcbh0gtoJ7mCNdGuII35kGG4nmPcSujNG2FNQHN4q1qoCRW8v",
+	"rcDL37t3UzKD3yd9/GGQWIrbceKCt5jDHN2K8ZfkOvxxj3KGhOMMhOfsov/tcWQDo+QJ5qFcLk9BL2Mm",
+	"9+cROR6q84GNC6yleKmdo0o9HOXFi3+CKHzx4ldmleVVcndJDCxOlwzTWUcyVuXIIcxJ14pTT7rUajMy",
+	"bcTrGMKSNxyxp3xK6ZQiijWvV3CZXWz7HOdsNlFYDnjoAxx0KDydWXsMbnzmIPZHYAe0nswPhRO+G4dw",
+	"oa7FCID4yMGHBroIz1pU4Z4UNpOMcglS3RMEH1SBQ0G/r67HAQeSycO9lNp4wnIKRymXyzx9WZUfpOJT",
+	"x+hxyujSQghxhv7p6Z3gQCc9cve7M1ndEtZtEcDMwwawhbBXQtTMXilak0mY2k91JWtRC2PeOGujh8Fm",
+	"RvNnOdyCV7wuxHyvhLtaKyOAx8tawIl/BSQr5IpcgZfKijBfuHcOqUBUciUXOe/iPxILmkcnjSrrOOo9",
+	"JtGg5uDgFh+5RdArM2cBZrWq578JrQhaFHoN11YWsqFj80ps0SIuy84cCeTBiAo2VG3s3MO/C1/psYjG",
+	"mIpbYSx6b4PlfLBWVN94vc0jj4bYNbWbpHOcKlGunEHN3QjyoxNKp23M7h3II9DZ5+buMpeF/9FDD6d7",
+	"G/aIabGSxmraNKdRwPEUpRc9HTqPFhGtNhmMRuIP4+xedWdxDA31xqab6ujOKPC00JMsYOQ/oSnzSNp7",
+	"DHH5HSpOB2Wyzm8uUNecSBgJfhJDxzel3YY9GZ6e3gL3UEMfoIrvhqcrG94wOECbyCnysKScb8o86CDb",
+	"yEIrUNfMjGlxxXVpfKRHeZCI89a6LrvusYSUoMN5Tnhub5lTpV2kri7Gu6JP1ozTyhORd5Sgm6Cw7LgP",
+	"+SnZleYNXTTcEzIDEpzupfTO8TxxN5xAQBtZF2L/MSvUpdBioOOlFj5Zl+I6Qy15U1wra0t242SMAyxU",
+	"A2TstVXRSnvzTSWunpOnLdZ0QU2YeaF08BZIE21aKy3QNehAPolW5Yaajq4eEGCSaU1Ojd7L2T1P8SMe",
+	"vONZS8IsrmnqlgQIGLdWbBrb56vub7cXXncin75NeIAfh5Bygu1phJZqBIn0bAoWSfA3yvDKzI0QdX7A",
+	"eK8upbGyLixbVKp4xcLHDD4eUx2T2d781s/OjBVNfgp4MhEtqIMeTvvPrGh+wU/3sYogp2gjHdiD/fCQ",
+	"TKVYgwQ2WOQ4ZUaa++ZSnIZ1uEkO1c7xBDn9wcpNUC3QRzJj4PlRyW1BILjsSmjholNE6SN1gkuZSSDN",
+	"Y6iIhp9OAT085vjekXIwjkxrPlIKjoiq7maFdU+lN7cLYiMxemWx7RtkxoXWDT0wE3cjqx/Fx6l9GqEy",
+	"RtgfhOUlt/wXocHcezIv0WjAabDNMVmK2sqlFPoImnVwzdfcrPOTwJMQ1iQsnpmNW+2MASZb6wOS4F2a",
+	"Tto1mkqjR35rRe4W6QGoRL2yIyAY+ZsYB0GCG9cKc8yJ1Vrp3PV1S/N4Z7ifjC25rMCyeLUWxB0vhS4l",
+	"hSm1tYaYK76o0Jzb1qZtGqXBa9LqKnuF7uJrB/7DOyzdMHbFTXcHZsys+edf/QUAMGv+1Wef/+vzr/5y",
+	"zn6qGWcbaTYQOjpzZiB6NQuYX/AOulD1vFhzuKZ55KSUgqQ5iQBaXeUn+Pnp48Fog68d/kdAbG2holS4",
+	"TM4mxjAgNu51dxh/E6b7I6zsHL+QJvdRqYSpP7L08fBTRPiGbym8dIE2Tr5phHa7hkPXCsjkXlwvfMpq",
+	"BXjwL3S2JfPqEOAeFdI3fcyyggP0i3i6nCBBexcNE2j73p6jYYRgeK5gv3wkAiLmbHbm8Xc2O6P10n+6",
+	"5DY760GNvwQA8gEgnZt/jLanrz2VTBFRP40Tjf9NLZd92nfXeZg4yITTyygaPiOdSBB05dJ90Le/lTWv",
+	"pN2eQBah/j5fC17m3Hk4G6OnDFByftZHdp4b44ff06ggD4TOhfEHrRSe04aIELSFkB0034M4yhkGc67W",
+	"dp4ucN5opZb7NuQxfJcs4Al+hBcKjqFtE8bAOAT3YY+OOxh3qNkBbHfaDK13HDd3fHjr7Zb/gbd8yCrc",
+	"Pdxtm1UrMtWHvDRkZ7UQoIBbRfxvy6Q1bOl4yXngLt9zsz4VZ/k+q2l0aAyl2tk+7h9Hm4KP753Swjt4",
+	"iUs81fLe9vH5Cf/Dq87poWEhHUMa54YKyZNlVGppJncRBiLYUOQ9A35x/KHL7dOkPfqGgv3dDrlFhB16",
+	"fi1Lc6ptwsHG9iq1Kz96aDqBAgPNdKcpJ5lr0rVZNawSl6Lqg0AGeccMASHq+uRax311nYPpvroeaBwQ",
+	"B3CKnfDRHpPsKPfV9UMHmdI5IwqEvs8xtHO4sT8bl/jS8JWsETx3u9vwV2RMV8gfYfeECYkmZJjAQSPr",
+	"dEH8LjAGbl3VFo8QDai0j1bRYsM7zsYxVjY5sAJ2o+YbYbwmmpozZknK4MVC6eM0054cqVlMhGQcRk0c",
+	"Q7O+fQ9ebZu5YySZbCB6oTdQzD3fjaf+8DmMdbDwnaiF5lacgFin2qcjto4wVLRNpfgeR3uyHUtZCTRJ",
+	"4GeiZKouwKcnrRV1znc+Yml200617CUQBOssTOqu0wgVKUtxJx7zhahOYUHekQTcg62CKbPWhJPs5Rgy",
+	"40fHIBSBZitHt13bqIs1DRb6iN1nlr+B024sTw7pDU57d6A3cdrb5mT+M14QCKSHm31+L3qLleqqpkN4",
+	"jHV2OlEvBEirgrertSXHR5bChbFyg5HcxvKVmIM8rQQMOVJIAKYJH5GTJfUK4SjMjSIM4xYNskYUqi4N",
+	"Q5cBfiAaBZZHcW01b1SFo0GIEd4sGq1WGHNnFFtyfc4eUZSUcxGE5Ky10m5K40Lqwpd4FCzbCG5aDXYo",
+	"XoNvx8rKRxStBMh0EWejtGQX4eX3CQZabAEK/K5S9UoYN+kRO9hoVQhjIHI+8Y7vohv/XkI5uJYw0o2g",
+	"QEv5XtLdJvGIfbFyGjheXe6F4u+/nBQHuIMjx6hDzOm62+aeI5B5IBD/H8qPJ/tgN2WAngD8AxzOnB/T",
+	"XeYzgwbrxvBjHxuFj83Oj4HKRSEwClBaOg3mSoJ5eqMuHbgoO6yi/4srt9LUbitruGtcirPZWQ8NMXyq",
+	"u5Kz2VkPvLPZGc2cMdy6bZmjINjBgUb4Dn4myl0s5zhKmQhMKsZODgYmKBzONk6hbtLUB8k5qwIZHj3h",
+	"RKZwihW6Y3sEW/Zf3mTSgzB7igknYvboqXIami+RQ4y3c6wyx35A71nZmdu4lHr6IqaHgqEk7NH6bKDl",
+	"DXftgMjPlU8k4CkXd2wDNXW1aWR1imto3lELefBffM6efX/hXMEADALGN07Mf+zSj5mx20p8kr0WYUh4",
+	"fvS/fOlrcXTHzY1jVKsLseGZWCuq8UEHm15j8F7Oh5ESmvMXOgAn7YwAmyihnVH1H78RleR1IU4W03Ro",
+	"OBBmQHXB2GtHPDD0hry9VO4NVYKi4lcLrKeCA42H3jzA/E+fgX0C7FD6+u8j6dieFNDAlr3IjNjzYADK",
+	"AktHmFE9ohDT9r/mkOg3v3jyaI7rCVb/fVdPhNpPPvkW72obhQzziNB/iMVaqVcnt9m6cccgopwQij/w",
+	"b87OHkoDBLJZnIQhjTGNMs5SMncaS7EX84ce8TjNNjnmD/VWt6cg3xA5lEuLsapQ1fxSaCNVhkafuDeY",
+	"e8On9Db93wlajPKBuZGI2jpPqFAo4oBIdRr6+XUdcbOb0eB6M6tz807Zly7yfcEYwxqh5/a6ZqVYtKtO",
+	"9jcaCDgr8UN0cHwrsATJKfjz0g01GWdu7r24CgNPjoi8boSWG1FbXjH/db8KxbfoBH6KDFrWqxMgwHCw",
+	"2hyw/gQCoZ/h13uR4SeZigv3vl/9Euf0cgndO98J8r8/lxvxDOKoflouT1MhQeFAGaEiN8LATIzeSO55",
+	"E+y/btQpCOifDR9kZccBcBh5tq0LrCj0Zj0aG1ljeTOzrYukeEPMlDxpkYYxdNBUH5kMOICOx/gYgywe",
+	"isryb5VOMnK+06ptTi5w+3NOXQ53i3EpjyV860tHyHpVdcsUrwD289wa38mCHngO7taA0CNFZqNk7rd1",
+	"eRLVYhgOc2jUznscAZRZ3IkDgHCwfWFAOKDBJCZj8eApJinqIouA0xNgHs3DBeEDumQPV0YAq9VK1qtn",
+	"wsJKTqE7gHYJCuzcYoVNq7fzgluxUlqOmdfjc5Rt/ruQlELVO7VAN4ZxWUZTw0vAPHspRgKpK1o+RZDM",
+	"fAn0hteymLElt7yaUcTujF1xXc9QBcPrIWpkWWVTtbZpbdYh7co5VmrFpPFO53vMbE2lVjOXfGxjTgFc",
+	"xJMPtCilpoxZq2ZM6VBc10samjtasJ35lUKpc8DGTRI1bttuRzoNKurSDLZpgu+cNiJgKDf7bA/9TNWV",
+	"/MYaR9h9lfEHsVF6e0KyX/Cq4sbuz9LY4MzMvb8zR+P17GxVzBuhCzHq5nRG/+9++u4B3e5n7C4F1eBP",
+	"ElY+UjmlkpcCWGazH2h4FdhGM/OA+1LD4E4M2MUHK64X5PmsKlFQ2NDuRRJK5iN1Y7vL/OGbHx4/+uHR",
+	"c7/Y3SO7uv15hQ1njSPMIoVzuUGzfWvEOftPoVUMAMTnleDeUdRbrdKR5HilajFB63NAzgINdba9h550",
+	"26YeBkdy42dBr8SJs9T9jTsHjF6JEktmAh9LpiX+C6wMCzSH7NhuzjrxOV0SR9q6ZD/eNIJr/9hFpHXE",
+	"xKDUZy3K59d1CPz09f4LXqtaFlh625dSTpN1XAXkKfUu3SQHpLyPGQwODk+/xf9J8f96dhAmUbX6UZXi",
+	"BhE23fniYNE4BJhOTUJ8oVrLuBPS+HI+/mhX2IxjtJ14NQp4HobRZJMWw4fz46KCcDpqNlBpwcstZYWp",
+	"hSuhnORfgeRpuLa9uISsJEjgukHgCVrd7A48xTS2MIuL3LkxsBMcla/Edo5y0bCP//6L+eQdwDvFNd+v",
+	"LxjQG+Ltewn2HafphOl3EVx/8pTsuCbeBVTLrArBW2MoPAgno/vXh2iwizdHy/E+/QMoyE9yMwI6xDF/",
+	"E3q/KbRtMxIH44IrwTIKG1bzWnmDZL74mrHzfWwZXkrXYmAFCSfMcWIceMRg+ZgbS1XWZV1iDoqJCjx+",
+	"w1zBjBGARz04MPIv9DA3dqFqI2rTmuDJCemsuTVgfsLoXD+K6zCXWiZjB3cR6fD7Rh7DUjL+U1//IpRk",
+	"Ydwm9TNguMzisH4t9h3JorIDRETELkCe+bcS7KZNQkYAkSYiuuvBzlWLM1Y1DXALO0/zjUfQ9IzevrA/",
+	"x3eHxMUTs0SpBMWkuvdDmB3OQDGCa26Yg8MnnPiwkSzMcBjnGFo230X56BqBt9IjsPeQts1K81LMS1Hx",
+	"bSZVhh4zerxrANzx6ClUVowW5IVNj5TsQ+V3DK3moc5ObyTlKl4WcARBwY8E4r7eM3IpcOwcc3J09FEY",
+	"CufKbpEfD5dNW50ZEaUhFZTz9IAgO44+BeARPIShj0cFfjyPV4b+FP9bGDeBf+eISbbCjC0hjn/QAkbS",
+	"BFx4WXJeeuy9x4GzbHOUje3hI2NHdiRn4afGPjpFeAIVY5ijvygvbLEiULTBamPJu+TYPQ2AD43ctJXL",
+	"jNtVYBU+abUYz/qgyBNuVJ1MCl/BIaDJp06bVCqR9dzVdcx0Y/I1vYOn0L3ar0GJ+VHQBwl+RFCo2Rbi",
+	"/KjQy0m1SAe+PVecatHKylLMNmFBgCQ+Agp7XRMRjGnlAwAwVGoh/IUfYWgXLhFD1mQUmVwiG+m573yd",
+	"XOgsgb670UfUR/T4VY3t1UiUNSxZaaZaVIxdxXNYeafO9evZ2ZO0hOeDNa8qUa/EmyxI7YMzsyVb2UJA",
+	"fooZS/YJrsghZn55+i25+MJVwK+GbUC8BT+gEc6+DROev6hf1J/+qKy451o1GNYNAj3/dErNnzDovLMm",
+	"qG6cBzdC8fEvT7/9hDXtopIF4mCsnu1pYB2tKbtrCR7z09yxTfRf5jaBD5c2IMVvrptjs3q7dGgEB7kx",
+	"ug9DEiQYqwoWIK1hRhRaWDNjNJRPL9GikI0U2E4DTyUA/Ma2KVnGtD1wwO7H9N/F9qK16qmoxRU/Rd7q",
+	"dI+khjnNWPFmsotid8xGapEvkF0JXo7qpN+rK7bh9dbro0lrvuG2d8sX05yGCKAtCmGM0rCTsjYWSHqs",
+	"tieh0eys+AjThXG8PcB9GQ35SZn+SZKpv6tuR3OudV86e5+hxuENuWZAAm2OFq5UvmvdvUd1jY7idMcS",
+	"SBLUTY79bq0CG3oRcIdBAH1CylH8yWM7+hPkD2UpLKmDyQPik12wqU9af8zjHBJH0U5GocmG3VB/2Ak4",
+	"/0FYLYtTuCg3NNKhFaxz0OxV2/xckxNkOohwX++sljxA1HMvSo6l0i62gmTaIQETzQP5M8BlUIAAGyTb",
+	"U4Y/W/VGJF0X4oP0Yi+BhxgWQj9sCWviMbeiLrYnqT8t9HRCzAGxlwJpiqlYKP3wKGnqYsvW0ljMYTKx",
+	"kwaMiEjBlhqnqgkVUiDm3O7JMqVwNkgCCB/tSbPPC9tSLIXW3iqw1+0QOgIP71CVWFp/WyL1YBuq3EiL",
+	"oGJz7ZIJrqsRcwEUXKYPdyWlb1w/ZXdCQrwO95NiPgTdYIaWcbWMGMxDsR+C/sxxwWM6DXaqmO8IyIs9",
+	"IdzLrrjTfnD94JpbMXVsnfRSmTK0MLJsp49Or0+ZYIpFJEfsIzoTWd7mZFHKF/Ht21gapapgb+dln76N",
+	"v63Q/t7D9+di09itS7qfL9uqmuHZVK2dMXUp9HzRlitB3azxHb7gdanG0tbAS7oUY9Rm2k2sdBzTIGCh",
+	"gwJgobkTgdvrmZJHWfL5HAXsPjYwZeaRqfKl1KhT0eErI8pA89OM2dDx3CrgETcoxRY6PaQcuUtbObT5",
+	"9Q35ameTexwmw/b6HKN3yIcHc+KNtt1suN52zqWLboknK3WuRhl34yi5Xnz2cFQmqQsfbIhvLd2LLmLi",
+	"mhe22jJuKASLqvZ7U+Sw6BDsV79py6CI0Y4ZXYxWtqrfzkKHEwKwPEnshu95L0QieyCUqqZEW/aRkYVg",
+	"on1Kwa5LjFWL587fZjpAOvdVtfXgOqdZnwefs/+tWlbw2lcvD95dpdFlSuG4BkOy4pyuJWrEEAZPU0wN",
+	"Pvn00/7CP/3U7bk0bCmuUFXgNb7YR8enn2JE2xNlutefU6i+XNtHGdmHugUcyawRk7po7tb/3chTdvJJ",
+	"b3A/KZ4pYxzhwvJPHiY7Ze0pjYwUep2dQX6CrFeZw/PEUylrtFpUYmPY0ju+7TrhHN0YRigBtXUhUe7y",
+	"hhkMIRQ6YseXmAJoCldSp+CtEf33yIGihdOUvFoszWTj1LMw2D9owRNiOidSwfNOAdEhDdAZwI4yQj8V",
+	"J7IrH9waykMA4aDZjlChX0s+Oqdbr4/2duQeIkdDY76VevpIfWOIjK7jCOsRDaUgORjpCB1ShW15NWil",
+	"FHWp0FnST/N6dvZUFOI96dCmEZR316BtgIo3259tuFxDPSJ86ic3wok8wRotlvIaN0zZ0xbbaLS4lKo1",
+	"VAV3juZ6brPhZt70MGJf+LmW176WH1XXi+FhfhbsVpKUu0BtryhEY8f8ADuKeUDAVG+8/VKRxpvtWPf0",
+	"ZsVXcWLi+aHZ1Oj6VS3SNcMKn4m6FPqivJRG6ZN0Y6A+JWPt3Orh3dazeoRkRroGPEG/PsgsGtEtqFQo",
+	"7ApVLytZWPLzoacFzZ7T/SwD7f8+zJNPYeRGmLms563JsJbH+Dg09d6/xskw4sg/Y9DKMb0HLTaNvpSF",
+	"INOXb8jDRySOaVcrYSBGiFY8slTmgn67maF++bzOomAHBvqG5YZbKzRM938+/o97/7yY/yef/3Z3/vX/",
+	"vPPr71++/uTTwY+fv/7b3/5v96cvXv/tk//4H1lDx5Q79wATfSKYBTqfcmAJbW5QpAc4rzXe2YmMAVue",
+	"zn066RghcYdEPL7r1kJ5O8hQeQvFiqnY77D+o0umZdyJV1divNHCiNoZYOPrc/86XaLE+Z5UFvf6BFf0",
+	"lVZWdIuaeZCAXCvBSk0FDs5ZLEZMflDsOdBfSJKasGkrK9MSIMkkcIj0Ja9ct2jyoSx5ZVwJ5No7ZXZ0",
+	"0j6uAXg3NXIhVrxmZt1iriEWPjxn/4BX/LpnkDCsnS/dNSKkrluF2viLiEpnKLnl4A46Xe29sKGzA2pu",
+	"PPdrlKa7QDwGLhrtJNXReDUH5VDLUuy/EIVgwG8uefVT+Oz17ExciwLu8YXAUy5XE8eCZNBCPKBP9mQS",
+	"RF4vNxtRSm5FtU0qrCIpxoDFc4b1HSCcp15hXLhWLfb6l8afEqEFaw1RgW7rwRAjJtXxcL4L17nd2bFi",
+	"ZMTAgUNn7YqH+UTZERQTkdcvqJItI4XlE82opnkZExsIOY6wQpOPvfesTlhvJ2DQTzyx0gyiDrj+EF/p",
+	"tsApqHlj1up0nXlH2hDi7Q8ekUR3s/oYWWr3J5dMWlbKMh8A2A9NM7uZe5hjrarS7OkTD1xiRpGzxNl2",
+	"9BcAUMa6O6bFIAIALkZ4rYw9YL4DKvknnJdK7/Qh6LHqPTNPDgtua7TnTNuGkJC6E7XUxzG/2LW4Dh7M",
+	"Z99fzKEEaNqn0U81GbHgBt1fSiGuINQdOAn6XKmPLPrqTEUQLa0vGhVXuudie1MB7Lc4QntIJ2YRy0yg",
+	"MhJPRLeeQawgdPLorE5xogGgw4nZleYNcYv4kMGKqT1RbBQBcLcLszUgak5hhAqDTbYAhfn3W37i4JM3",
+	"MHySbpe7wRW8xsB6736uS0+nhBdIoDuBu40GYlq4W4Hp1k2np2rJQm5E8J44vMzyvbn/NcJSn47mbJEp",
+	"cr5RdS6Y6id8+gM+zNuEwEEz8jG6ysa+7SvEHfh7YHXnmbLPN8UvngIoUfpcbJoTKdMdCIes0XdIdxMy",
+	"US+VLoTJMnjsXzUc9ewXskaoZXes0O4qLNOVgZ6sUqa4eOJHy/pQ3UuZ3L+0ZLB7a6xd8Igy+s3F4642",
+	"2lnIkDzHPVEB3+77mAjq8D5z1SasQT1LaOwNjC+grf8GwQwBRV2qjQsP+zuVpSFiwm7zsCjy4GNcNuVT",
+	"IVlH1flbIb5xbWNO1nsWgm/qbJoMQMovhcamEGuuxYwthL0SomZ3kdN+NutGQhS84YW0W7qDdb0T+Ibp",
+	"1GMpVbuokmhMckHDkqeV/eiMjHP5njrkITHHtW53xrNjYGgN+iAsOiEs++vd/18eQUcAthRi3kBc1NaK",
+	"efPV3bESPaXkNVsKwRqhUUvsRTCBjVqGzcnlci3TbQv4cEska30NdhhWUQ4Vp/CDeQrhjRf49cgCv75r",
+	"18wVuKLOZz6s60Nf8NcjC/76D7Ng8N4uhdhdBHcphusZWBCS+FRfrWMQp3oEgINF7gV1fA+6ANdClBgI",
+	"2fAt/LMSlvxD2WDKzt2c9FwtjTAubIteWsqqMqxtDl5nxqcOu5JhMZlDmSHbHN4CC89w1Flf8ExTEJ1T",
+	"gyI4PdpdVV9wKNqu/7lvS+uX3jXfKn2q2s404OTb0oRSynt1EjflsQWfIbdwWCPZuW8y6cs+c1Vqxo1R",
+	"hcTQsUelmdFt1JVVdh6CDPqfCmpAYt5F3AtC8Aw0mNKl4uQ0Yd40c+wqMRJI6PP7E329E6aHn7os6Kah",
+	"Pni+SwVvmhnqpg525LHwd6UKXtEeuOT4Sy4rSFQLngxuhc46ZF3h6qFem174hos8Dm9Nkx3OGGFPhjUY",
+	"rIc3+CkgCxR7qo/5FhAFMx+HKvgyN+RhHZiTEbFZ9HA8j45jhvyevs0NiyR51KCP4cvckO5+c+CgT+ir",
+	"wDr2MsWke5XbPkfwCa7C+vx+9A/+kKgT+KfHKDmY864PwJYhpho6dcLwXcYZ7u2nCBjvj9urBplYHKja",
+	"magaxllRSa9dWd0W9kU9ELaZTrVeFxuvv/XAv5Iv+JXJOnJDvaipZnCowZS1SGS1zG+F8GW4QohEZ3OW",
+	"Qryo3VsSlExJmZKo1s3J6uQVj3N6E2wMSxCmVrHfhFZs0fYj01pjmbGyqlxpSpiGqeWLOlwTf5DQMwaG",
+	"W6quVlsLe6X0q106LVR6FrUw0szz3cq+o6ffg3/CLT/1VbiPY47R241o8bDLchTyRw+dXeTRQwxfj9UM",
+	"B7C/tUp2k64yPdpiH9fKBgL6pFvmya7Fi9peY5wzJqRzexw59O20g7NIp6NHNZ2N6JV18ms9MBD6BlyG",
+	"ZZhMjzUqVWEU80mCJmRhJ2dwZu7TboAR5RmufBSZupartdBACkfcTXESrIyiKllsRyyka940glLucoKH",
+	"ay0v0fXpPXt4lZSGwWXsHntxtpRL9eLMxdkb7HL74qxSV8JYIIIXZ7Ra0wny6i8U3ted6zFllL0STCu1",
+	"QURJO8a53/LteyT5Z5L1RkulszUsUsfzjpxfrgVbxqAlMhJCEE/LLeCoZqUAPQTNilQ5Wy07K887ryE2",
+	"fj8mkR6NnWZL4uPrmGjUBaD25GqNnbTE7EFBFK6MirSedo8xR/XgQWy56MRDQAu3X/oWdQIU9R5hSZbZ",
+	"jLQEPH5tjXX5jyqERnbeKbaqAw3C48Q6dZflFLCIo7wtynPfH8/hMzt5jHnRgXH0ITgNGESm1BRiToz+",
+	"QEg8WkI21kJQzpaP7mUaguSEM3HARCPFD8xNrZdZnA52PMN8eqImQ7j5U5Zhrj1hMJTVu3nNrK+BjG/R",
+	"JEup5VYai1Vf6qx9ua9LHR3vMmyWTNDlCAmeABHAW2zZ1s6Q74I1yd4Te2TN6N60EK6x0j32ov4U7s2+",
+	"47L7E0K0YmP9+PwsBHDl2uPL8noI5KO0dmemcQUK54/Mzuz8kdqAoZlWOuxGwMkya9m8/VuXsXKRvy1+",
+	"766GocvGoxqrs6DOhoXPt66eslq+fbitFqIUTS7o8Wk3dATfirspRK8ZRaPVpahnTJ6L837+c7kSxndJ",
+	"rQRfhnJ7Sk0Jng3ngAjNU0WC9XQhk5KMc/SDdnd38309O3OGFHPywDU3cA6u/pyhyrn/2yr20XffPGd3",
+	"3OXTfASguj7Kp7i7uUbL0w2L/4idmXeaEsPA0y1+/e7PBgd1EwNcPvcwF2Ze803azJo8LqqlgBbMWRra",
+	"2XgFalQ5L2Spx8Q3WQxM7NqN6unChckDkaPe9eDRw6esVtZF2j8ffRvSQa4wmZvqBmjhkqioX9X01no3",
+	"bVVuCtWM1nvBZ2yleZ2khISxApBebmioBwgpNGe96O6z2RkvN7LOSpGd9ON6mjsoh0Q0O/OeqCExhBK6",
+	"SYMeyzhbyUtROx8blD17KJayxmzDey/qklt+Z8GNLMyd1gh9n6r6nq8Uu8fckA+55S/qIR2N1clNiznH",
+	"Em253eCb/FpevPgnqHIvXvw66FUyDOVzU2UlK00wd6di7vU7V8ZlOLFpRCGX0pn06Ouds8YTl16D3Ph5",
+	"aQ+uhTl6E+bowMsvv2kqWH7C1bzXD7aMGau0t2jK4B/E/YWqdsRP+ZVPQGmNMOzlhjf/lLX9lc1ftHfv",
+	"fiHYRdOg8wWdyi+d4VAa1LomxwxeRBDjYGNORNeaRlxbzecNX+XO4osX/7SCN7j7sQwTmMvxsxQnIQQO",
+	"h4oLSCqQjmwAwTFNliUrxMU9o6867r7hDsIj3EJ8JySL3mi/YCjngzt6u5IxsrvU2vUcznZ2VQZI3O+M",
+	"4wCMr7isje9OYuQKgwXMWrWwZMGKtSheifKcPVoyV8Er/VwtO+ZqzzqkQfkBYkUatpSAPxe33TYldwZ9",
+	"yDNM9ZtFaDuIgz4Vr8T2uaLPzye2cXOFvgEbzqM89w7w3EFFSk1s1ECs6bF1Y/Q333VZAkh507BVpRbu",
+	"dAeyuBfown8zfpDJcH6CQ5wjioCGHfTecJ1BBH4whoIjFgrj3Yj0c8ub2LjAvRJdMM76la7m+To83wA1",
+	"r7S6otqiJVN1EpmQcrHW8JUYK4qYKhZHVIxNTUijci8r6RLbi/twIG92VC+cw5qzlCLgCZAKqoe9Nlh+",
+	"Jsrcd4olZlY7hLnQjVCTNqbkJ6iqV7tAyxOw0HVUODwYXYykms2aG4yFlJeinCVneZIOsDclDgjcV7NA",
+	"v3JU6jA1sxKXfAz/Rq7meYvKo6SDE7fBuAIcm9tWC89z++d0YFdBO4pcwT8b929l5Co1quBfG/oHn/2a",
+	"tShg08jcdqgaFaBSVGJFC6eXe0WJPzLJBgEcPy2XWHVnnmsGlUShJWLGzSFAP/6UMcqGYZNHyJFxAjba",
+	"W3Fg9qNKz2a9OgTIWkj0DXE/ttKsVsnfYkeRS1R5VAMsXI6k/xaeA3DXQSzIr14fOxyGyXrGgM1d8krU",
+	"1l+W4iBxgFRt/bijcfoyf5+MqbM7kpFIsBy0JvziqNWkOpMHOq/Q7YB4oa7HatuCxru4XgC9ZztGwlfZ",
+	"g/mRAUx/ZNhCXbvy9nXpipXsgWUcDg9GBEBcS0P1OuC7MWlOwOyadrc2laNCwz4Ouk0klzF1YsrUIxrM",
+	"GLl8jHt/AwBGu5a4y+/eS2pXPRkK8yjVZrGYi2/Gmzv+Y0cou0sj+NthmkhUyQdYdGHMlxdCWJFoe3pj",
+	"3WEhnRYXM8YtWyi79vVKOk9ZKan/fM9aEUfLRg0lUEMTg2wfynhnn/OlFXqvOjZ2M05Hit34jhoK0WYO",
+	"hocIOhngYDD8CH367uJ5F50AJe2iEBd8OUId8PUp6ALGyVMEzjBCCw62iXjvXbn9xxNx3vv6oB2PzOvw",
+	"vU6/7e+yx9qO/b2vrnftLgop3CIUXrGWVufgv8kjD1Ckc6G7Tl3nO3kle5+3Qb948U94AMITBoH/z3o9",
+	"Jd664wtxHCllxyb4tYfSUVb1oZ+xtg6tBfz7MZ8WVITzd7TCsY6mu5dIbowpi5Tlu1vjbv7qqHHHMXzS",
+	"NyBk3Qadt1yTn4UYhF/mdFAm65E8un4/swMazYGpUeAF8Jn/LG338jF1ofwkqXKdeNKCdSiUHnvbfnIQ",
+	"7Oi/HV+dbfQS1vdUxYIi+KFrQpcu8+0fK2XFHGvHzjGsOLsEeOlbgzbutDpvz3TR2WwmDcUp5zkrTgs9",
+	"1ktZtXl6dfP+/SFM+2O4oZh2gdcfWVOhLSwsmO/wtGNqakW7c8GPacGP+cnWO+00wKswsQZy6c7xgZyL",
+	"QV/AXU0bBwSYI47hro2idCqD/CH26Bp25Es0DqvUK9yG4A9caUEW31hhbrxil+vwdD7dqfp86DAZXjrj",
+	"AS6EtqNdqTt3e3yJGYC8a872K4OhmLFi5GZfaFFStXcz9/Wx83OSWeNKyNWaDF3Jp701UU0+Pxyziiw2",
+	"5MqW1lAulP/I1dk2lr8S1Acm9A8GuA2TYPEpqaknFplSrmC3YBCfpKxgsp4YFZqu90rVE5bqoMysNlYN",
+	"R7PN2E6c7+jlf5It1gIrX2wJXaNBagTrvtn6BdGxfeqUBRm1PNWCYKhRmh21yMQldoDpnKYO3ofUMHIe",
+	"drCfmNC92yiRJFzvZBsDVlD6sffWGSMoxvFDI+1YS1rMfbiYjp+WrN2qxXjfyFiHS5M1hAqgxJqr5dKI",
+	"kTq9jTIyrbqcicV0bcpcd9yx9lg37iY+BOCobuFjV9ZHD3Mz+GAdE5C62DJZ1/3cZmqawGw6kNT51k/7",
+	"i7t7e2Nmk9wSstTiZWVyBNr9MhcTX3MStY4SdSiYXXHmi2Qc8hhaKzZo/N8onbJiJxFcBRNpic9ccVN/",
+	"ZF3z65h9RzWOzZsT5B6uuYN3Qq9ALVXZ9VXGtSaSz4WBOsl3QobfkePcdHHGMT9yTASg9jZ1pSTbR9eZ",
+	"iPX8RBPFzNHL2Stn4kq7sqeLBQ/szpP0zIrml/E10UqokLoVTXC2+3tAvzsokNAIm8VnfgAcd0SaW9Hk",
+	"h0gh2DHA9C0aqQqH2tfe1n70mtmhpY3OMUgqQbS5pfsFBECy+xcF/G7pj8UwQkWLaJEZistyLExJlte9",
+	"iMLRfi+d0oM38wfgpWy0zl0HA99cimxga80unj6Yf/5XJuAFJlwd4IGxeEjI4HPOE8DF/UehFi/Xq5Za",
+	"l7kNx3nO9/f/BblXCz2313sK9RPgwDy6wOPnnR0peFXlkzMrtZrjfk1Tf2hKvlEuEK5Sqyhvxid8g0oQ",
+	"rd0H5gUcH5yGBtx3rET3l5Q27F86fEMPVLtya2JIx52tzu/zm1LGcA0pwSRYm9GZ2HMS0cX4VCyFFtmQ",
+	"uPDIJPrZR52yRE467j6ffDSYPasghXpS6URHBHXyptnnAPYzpyvqLeUm+YcxZh1gmbIbz/Kh4s+s0qKL",
+	"+CR8CPG1bxOmuMYS+2Y6lTTjDYHBUoe+lyk1Z/8utljTFpdzFvJfjg3MzskgN+IeXD8Zqbjr8IzlQyhQ",
+	"t5NncSDKeQOJZLyau/D1MZGt1aUT2fh6WgX3LVpu85QNxWhdqSU0i1WC63nwfIyuCt9rPphVacFHhY2/",
+	"xaEpw0cEkWcs2XwKX3cpbv4TyoXqOddAu3PEFVlofzwfAr/MVzHay/tc5gUtcUcGhmhCAkYMDsaPezkX",
+	"vYJqckcQGC0uZr0czBXSAW6cu5EG4ZyU3QxOd/50ROraw5Nwrp8aMdYm8KJmyj8NuRhdFvSRcZR1B1d9",
+	"B7zaQXpOlMnfKt1h/q6jTjaXI1zIe4zxJLLb4XEkadzFNPO+6fScIS2xl6uXcBo//TQ9ap9+OmMvK/cg",
+	"ARB/X7jfMfjx00+HQJO0yzMJ9MqBj/4Tj5vxjXi7Pt5aXE0T0BeXG0QdfKTGyTBQKCVleHRfOexdaenw",
+	"WbpfSlEJ+Ol8StBDuumE7hSYKSfo2ViThpDzt+HXUCMoVEBIAmCxeROQFjJ7l95KUcvDI1S3G6pzaypZ",
+	"jIQKLQyw15rsEHRpgZdHbBkwYitHUiXrViZjwWtTrBU9IJM5ssg0WcN7xB0GZwHS2lr+dyuYRCvKUgod",
+	"GnUmos5fDgxZivsW/+wt1w2M3yTD38R6sSPCzd+cdpkuMIBRbZpK8roQY+YLttRC/IaexqLiVwtevGLu",
+	"9ohMiqyVHhs+6jHDmMeTZf24PnI7SmyXTyAs0+JSvTqqatB4iOTzMHpicsA1jUTP7ZvqJPaU/KU5MaW8",
+	"kmO2C3jSMRrM0owX2kj4X1vH/3vk53isSxDS03at459wn5bOQYubR8g2R4jNt+O02lUGi55l5pmFGhvw",
+	"IHtYigE5H4ECy/VqzHkYUa9MDDwGAltq9ZuoZ7jj8D+AbHiUJsNwqFfP2ZLUckjbpzYf4amY9a1I8UQm",
+	"jCAgM2z5KH/0gcuDRT8MMYaR9SUxwElK5QEFC9IZD+Cf3MlPJ+2phOu6mzF8c27pA8r9RiecPjPHSs0p",
+	"0Ji+e/QQBpdmTmSYXQbGE145PkmtzNOJ0FyDX+fYYl/lCtkpcdPj7Pu2e7rtcGzjb2wr9Iu+Ccvgea3n",
+	"sI08xiiI844iecxIlTxk3UoWI6oXHq8kdxujnn0aI6+Z03CgLWuHl+RPZfKGuUPjx1PpYO7vahCeWQEJ",
+	"MCXb20m4tCpKiFDh3QfX0ewsKTgQ3pUUr94ITapDPnzuSLuPL0U/0eITDTzwYce0Q/1UeGVUZpi2vqIa",
+	"NfQd8Sv3NUYruNiHK6UNDGvyuaGlKOQm6+B/8eKfZTHMAyzlSmKECcOyfUvr9DE3EHOdsYGKSmmaiiq7",
+	"pqh5tGR3Z4lW6najlJfSyEUl8I3P6A2IzMe1dRVZKrNtRW3XBl//fMLr67YutSjt2jWqMYoF2xxlCPgM",
+	"516vqq/Zx5jbbeSl+OScavLBJfHs3mdfY2Ye/XE3dwspxZK3ld3Fskvk2V63zdMx1XvFMYBJulHzqi2p",
+	"T+PSYcdpok+nnCV80wmU/Wdpw2u+GlGBN3tgom9xNzvBs7GMglWsFMZqtR2rDbwRlgN/Gil0DuyPwHA9",
+	"ZjcuA9ioDdCTZ6T+sPnhqNwV8fQAl3+IifSNzyPu+QLesplnLFuJY7mD2K7Po3XGuKHeiTKWuHAM8Zw9",
+	"gsNQitL1jvdpMoQbmMs1620UbCHEI2lZW7QPt3Y5/yuYDTUvbLfPWhfc+eIvXw5Bvt9J1GH1YYC/dbxr",
+	"YYS+zKNej5C911nct1D6vZ5vgKOUn8TGAsmpHM34z05rxxLMdw89VfOFUeaj5NZ2yI0nnPpGhFfvGPCG",
+	"pBjWcxA9Hryyt06Zrc6TB29hh35++thpGRgX2XFzLnyhs46+ooXVUlyKcnSTYMwb7oWuJu3CTaB/t/kw",
+	"XuVM1DJ/lrMXAW+U31XSFFT4X34YKwQ5UowCf47fvF3azDt1EJiuW+Gzl0zDTRK10U8/RaDBu0Cvvvy8",
+	"+5iY1KefZo3FecM6/BqxcJN7HX6b20No0DQkaJc8HIL9XI3T4f75kgx7bXsyBnBQQisYtrBfiRvCVVjq",
+	"JL5iNDUeWrj+tdq78L6p4dTeV9ffS2OV3j4KkYmBqbnEN8wpifxuR7Dhh5NRfaLCTfmA1/x5huQ/eOLx",
+	"gH/0EfGOmZerW+pth7SSEZJ/6FandJ74y/A8yUPm7L66znjasoTTkwmeeN5Ncvok8Nye4uEDvGKXqfdg",
+	"S0e2cKJ5D5c2KOaSDYfaG4+XnKlujYYDGMr7QRdD3/auLP77razKX2JDtJ4I17wu1tm0rwV8+C+Xt3jv",
+	"97hEElI5rEFERy2q7HB0N/6Xv0Nnbvn/pabOs5H1xHd7uHLL7S0uAt4F0wPlJwT0SlvBBClWu72mQhXS",
+	"aqVKhvOEXvQJMz8/y+zVA5Smvl73UzrH49WqZ77iNHVOdiW38QrRq+v95y3iPaP0UUCKZRtlLPvLl6wS",
+	"cDrNzNkjZ6zkZu3wiD2eTaG0MH/MAuBEZK4g/U4a+/np4xkzotDOVLaUlaX7PvfF5g/IW0MNsVZWLrfD",
+	"fqwuFXfGsP3UpaqgXdhsrDbaAbFeOwv47AQJIuyp/1XsFtsPpkR4fX6yHCsXPerQ2zk//rEUWiMmvBbt",
+	"IAoW1J0GF/Sow/aNh5aRpm7lMlRrhCNpsA0H6ut4oLfuoFr/RC5dEyz8bcyQdD0SY7dz3d7w4Svz+sPS",
+	"8C0FbmkBW8+LJf5zvUQOzpf6tzPacSB/2yzPfp1qugBcrK1tALHwr0ErQB4zjaL+nWq/Pxzmyh3Ah3qr",
+	"23Hu7h5QvUv4GK8EJX7ERF2ij+ScfYelDADI5yn20DchN22FTqVOr+22qRQvZwzGgTBlRrPSN1rYVtes",
+	"FIt2taJmTx1ZdcNW2Lv7Xx8wzu4y07BqY+dWboSxfNPkum/CG8/9C0z2ApDRaJ9i55w9JH+JSRs+G0sn",
+	"UoOYDdO504iSH/5jLbWjImqZoNj44kfjHWyfuDe87hHdtNz/vwj6BhEmwE2RjoKk24ySjq+kEVjHV1yK",
+	"bsNPD4aXpL4BaHd5uq1ropTzA266rvXp4Wj3wLlwo3oHZD3EHxqE5No+T6VJOs/P8KscUdrrujtYLwTS",
+	"tzxy1UHP2Q/Ok1jwWtUS5NA2e03HLjXThKKbJHKKg5paUx3PweHK0GtSQdRh0a1/nBE6xA3je5KnsKlE",
+	"HfSnFdeW3OcrYY3jbKKcoYVYVsJ5v2VthKbyvEBEHSmjMxHeuYtlzJk8tFOnFFU54s74Fp796JxdcARD",
+	"4KBDmzP+kH8aql8DtWMxgZUSJnYRTdf0T/jmHHtnleL61/PHaiWLZ3KFY1BOAYXFCa6b4VAXPp3Gpa/A",
+	"uw/gXUa1lsPPndh4mvSiadykWZU57HBGRahHEZwL4qZ3O8gN46ej7SC3nXlwKE+B0KAbKiWagxweEIbQ",
+	"Omd++oZ6qAJF4RuMymnlkFLJOgPGY1n7eIm8gCiyIgE3Bs/ryHem0NwW6w4b2pc9E2L2+wzNWBdwc9Oh",
+	"ehuMKME1+jnGt/H5df1UmLayY4wjvBCv57zeMn8ogLrTQsO8CnlkpAR1XT+gVTklqgQ26Pu0kVqWZxzA",
+	"uOcbYYzPkZp+wQ2fW80L0fl2giQa65+zaMuVsHNelrkKW/fxKcOnrKSbhrgWRRsKKDcNXor2xPjGiQpV",
+	"m3azYy7/wg2nK6WBG9BmUWVyaB6Gh6IMOwyUBlc2+Pcw04PLIDu4JpJPF8MPD9abuyMNtF6g6Tl0bZiO",
+	"CZQpN0dHnPo4Qo/fn5TSoVhBZ6x34YQc4XLpHuX42zcgONIehYNkPRItodshetUUPvdtEqhbEcOh3I0e",
+	"4kGwy8TTbx+wf/vr3X+D3V9UAtid5bIyMcEu7YToXvqfoGtSV+dwMe85E1WZgxbY5qISYIYr1rIWcy14",
+	"Cb+kCT6+FpJXgnCB+YhDV5BjgDVaRB5d103Fax6LW0jDVEHXiUIkpfRgoefsUUglMOhFNcyR9khwGD7L",
+	"EvtYcxKwN3z//PkT35AEUBfb19Cu5jmdMz9nsLxW2kJVmg3X296ScMNmbnQO+9isNTdhygSU8+ku9Qv2",
+	"89NHfhO3PlA6ndKjshQa81BQZMJLRL+Fq1+524ji8Zs9KZe8Gil8l8YwkEJHfv2x8nfFaLFYbl0XGcvZ",
+	"Tpk32pmDMvV6URFDy9RYdh4l550umsCtdSdCfeL0EKC/+6oMrOHSRSBH6TTErMtrHXdt7uLycYMHuSZU",
+	"5HXUTfytwH5EY/xAaLkRteUVW9KLwdKhSjFjq9ibI5oYfISCFpWA0+N8Rv5L9PRkKMtbOXYnpHkwnMtE",
+	"YIzEwMqRAEkGZxOnHYbydmYbTs5tZ2aZP/wOkmnQS+Mhdx2OgysasRdwkYd3mlfHzXWeD5nkJr/YbQ9O",
+	"KMUXwHF+czRo1x/ZkaHdSnZjwpvpeVpKCffLDWBGFjGWRuOuuOmMKTCzhMDiZmVPRCVXa/tUFEqXQj/j",
+	"m2ZEkuCTRByRxQU7zKULIkffgyc/o/kT97eU5hV7dOcnCtzBN40oVF0yqq/vhWpT5fSHpkXTUp4CWuPy",
+	"gM3WWLGJ88aC8v7wypptZKEVTW1GrwyvUBWZY/HBESG9lJXwM9J7DL4ZzPfVZ59jKrSPg61Bk26vz9lF",
+	"dcW3ht2Fn65kXaqrXfBghvuhAMFHVtRvAKa14CMF+DZio/Q24B5e9K2NcG6UdflBySMxr/gqPzRuqqh4",
+	"A2MbicWWweaOnzFeXvK6INcqrMqZ4jUlvODOV5XcufME+851bXjTRKpaKbB0A1z71rYjtisFNJSGwjWN",
+	"KXpjJwGeJAcJQ/EsR0+3rN15Mwnmfq7lNRONKtYjM11Df7m5kb+JfbUSOwZUlyGU/EaN6vYHYeDaZvHA",
+	"hz1xJJc5nbkDEk3NCU1115Pjg99p1TbOZPZUJLb+gZ4QDBDo8F7Bd2RW9hmSQIH+Cs2LQhgjTIdrhpzC",
+	"q7WqBA0xMXrJFdBijx7O2G9CqxhXmWKcwi+Nv7Ud4exAmHbVBcRHSeE/Qonb/bCijMVxzfU+aemQN4Ph",
+	"vc/qa6Y0Hhc9OwCp7Cfv0ZoxaSl6fORrCo1x6pO6qven+48647AyqoM7YmhQlGrPeUi3YObCuaI/xeFx",
+	"lJSf4fPxru6xbHGv0FJAv0kI/A3VId4bqTF6DgMJCtMhukyyu2/luuGvRFd56SiefeNUygt3OsRC+V0P",
+	"7L49aZpRvnLkXuzmFDfzdb63eMcDMRXnIwnXoXHycXg3o1XjuUvl/oPi3tXXmYj9bD7CBTXNek8Iftol",
+	"c0Gx4nurvX4wx6fjKd27j6M1Ny56RYambauzA5JQDh+gQsNBU11VXa0GgE0KQgX55QwwIdrtXQiqPy8r",
+	"COLvQKaA1Wf3t3DtNmSUNeMHSMpTU1jzzhShP6eIj7S1V9hj1hgQwP22eJWV9axoMf5RQotufIlIZe2/",
+	"zDmvsspz9/qrFuBEc7GgtbJshbcvTX0+ACtt0wjNFr3KqmmmILwwX4zbCZIRomEZlpDe7ic1muoHoyYz",
+	"z9x6c+j9++VYKyF/jvC5D6rwfp9XYjtzp1NcStX6ohghrc8F79GvWELGjzfSQ2JXQcx3nbw2mpmFBCOu",
+	"uj1D//4LFXpkorZ6+x4k3g02/bHgRvzs/Zj9fSd3RyixxFz/8A5DdUulYl7DzdzVF5HMY8E4RpYxmFFS",
+	"CS2iK3wDRzik3hwaHLkZ2Sma5l0lKh/YKCAtR4WA7/ed0tJnZ53+hqNNlR6jFW1XNzF6I3H3OaPvIJVp",
+	"JAa1E8TSn+5bpZPwVNQfhhA8CKFc3iJMjtkOQ0mxhgJtQI4Pp0TvDPDxenb2qDwovqW3HzQMjZLdAfDQ",
+	"3Afr5veCj9ZBxNgOp/1Q56Q1vu2qz7jEo8U2bQab6S+1ErUw0owUtYGJ4EmoOUxvx55ne69GUwtGjnVR",
+	"w9yVsU6QRmlL3UrgncFQe4FLSGQei/bk53r2/cX886/+0ivuk09bmQ7DoJmpSGsndjZnFNwpNPQEtj8b",
+	"MwoazRJcHguhzVo21CY/6QLDGboMO0R2PrXY7sB0PBzLK52X1Golxa8WYiw/Qi3zk/mkXHzlHbBzLUQp",
+	"GrveGYtCtc4au44cXohQvnQhgMGD/VjUzoHeqyJdrmLoaSX40lOiVmpKB7FQkxjRmAKdI6WfGvtodxIq",
+	"VZMl336MGUt7ujDVWLpdKKY0Uy3o4js77ZsxoZjrPmR23Tr2dkjsB93iavZPH0ronmriolJGzFWbwfID",
+	"eNS5oxIKmd2BflkbKziyRdVYStFxlLIZCTnIb/5+gXwRr4xt7XIDO2wR4lOwySpmTOOoOFRGIvk8mYxf",
+	"1qwaXrya+zOen8qLKnLU0YWV2vw6M4G7P7+PQaGjOTKd5tJ/F9ud7IUPu1sOAiQOMGxchDqVlCsKrmaQ",
+	"TJq77oXHtA9ZLkUBV/Pd3eH/QYUsfOfxmc+HQViWSbN4adM08yPMIxGgih8JT8VPB87YpeCV2H5kWIca",
+	"Hj0c4j92kpgQVd4ZjdInjSX3+ty3kxxL4HPuaGkCZSAWfN3FXo/QkasZTBd6mqjlkXN5ksTelEHl3TEl",
+	"9FU8ci749KBWnXjnGmsg3z/cT0UtrnI4v8gcbODyvKri6eatVRtuZcE0jXOoDdNJGH/cY4mUI875ztP9",
+	"vHeI/YxUS1WW403BxkbroifeoF+J7dgh0WK1U9oEjXIoawIn6Ji/IH4epDO8T3GGbV0B/3QDSOMz5ffe",
+	"Tw60l0zD3VHhSTHuxJ+HQHf5WWixu+M+MCDSYQW0l4VWvCxgTX4iQrB2JUVCcAfyV5cdm3xv2oW7+Ypr",
+	"kOCQMTulRHn3lKYk2zOahKRWWlygn+yhFkI/bEkdE5BuXhcjlsxgkAaUr9UVg/MWqyJL7Q4J11pCSAkg",
+	"x6fZ0AXN8hU9Ff5W0IjcJY1syCPnfmAuD6pUAHDGMGo08Bqp+7buSVk7fdt9TheeYokPSHCduHUvwcAj",
+	"IXOBFEJP8uD0hkhcvu1mb0vgUlR8G4by0B5swp+d2dEwSb4aDn/xCxrCqFcznIsnT/CH0Bp6v8kQ8UPz",
+	"zgLV+F2hxedpHk3CyX3hvk83HXgRyB2TMSI7mnZ9PmhrS4WeFgirrmThGnhiMyUsYZC7RMhy/x0uF8m4",
+	"AIhn/i+kd/jfll0JLSKLOSQ/bqDky9JMxN94AthDl65F5Uiz5njM+O6tE3m3FoWobbWNxStgwVhy1fjf",
+	"SIT6pLBKeo8fygYqFXLFdenf2HmZ3xVYOOgjzWQe6GWYWcZy+cOScGOFd+B2LevVfKx9Ry/G1d+rPzJU",
+	"hxeNM0gCoSBPLO1EN3erPPfYBccuVMALRyJhvPQPAUe7ZXL3RnwQ4uVBnTNpR6mwQKbFhks8lVZ5NXF8",
+	"zl3IfkDPfYMpn/i314sT6HV/sVLfKEGaARJTql8yd23e32rymGzf0PYmg/lHw048jVZlW5B8TQ9GyIie",
+	"LGN3sJJsomwxXGXP65MEabwS2zvk23TNG8MOpkCTHZNA9ypDdzcmr2Zq/rPJwb06CXjv0ko0O8Ng9pFq",
+	"E4/qEtYkXCeRHNt4JQto+xWMhlixqxQfmUHgPvsYNelQTugKc6a4ZWveNKIW5SfnjF3U1MLBVxaSCQSD",
+	"ySHHasf8GKfPyla47nWU9Pui3tUG7YbczA+zm4eRAnLDqWiQ3RNl29QhI+NXmVvn+VQ/67DWT1/Li0RF",
+	"UGR1EjLhoAd0RKOCTMoiZPcVtuWV8/AElbMX14WJYZxpYB7wCFm2eVfBVh7+YO0y+9QDl3TlZqZldBrM",
+	"cxOxktjBVlitTVrjLNJuAFVj6QUDCTznLInVp+/giC25ZktxJbSfG6ONwhySVLQKkr6XOJjSbCNNrLo9",
+	"8apxIxS4ZUZT1M7zBavNz5Lio7e9MbHvAs4bdkRwbyyohVs0X2z49VyPRGEdlisdY/4R6BRNWfLJHaSn",
+	"qHSn5zFzLSLNvMNCN3AhQWXJRapQRzbAtljKa1Yp9SoXNC1rqzmtf66Wy9F41dTX22ff7hbU8K27r2Gu",
+	"8Ygpd59N+0BrVl+GRbMWe2QN4WLmKivNfJQQa2srK5Iwx239e93j8i20itxrG/BGsAx9hW6PbnGdPc+d",
+	"iWdUj+oBapG584CpfklbcllbxThzdayYqVQmBPyojtQw1MhuJJP5PNspjZEDFG7wLAJcjc69ZUBDBVBX",
+	"1VOqpApovqzzHHW0ebBD51x78J7phfj27dcGA4lEUk+UG3c/3bI1L1mhtBZF+kU+gY6gkrVpl0tZSFHb",
+	"+VJMA4s8t6ZrDmr4lolatas1W4ohmDNnpmiUtqGJnnT1cfADased8trW4LC74N8oLeaVwvKoucptS2BO",
+	"cuOzrdWKqQZLu1DuvKtxFbdx11xtjWmKc70jQ5VwRVmOgAL3TZLqOHFKuApR/aU5qQ17r7oO08/hG2rv",
+	"SOMAY6BFz6kG2EhZftgCeNljiF4ewouEP9isHVmnS3mNdC9yRc1ddb7hbSXSPo+0nBI7mQBJI19sOxHN",
+	"vLVrpeVvgW1L7dh4nwx552VfcqKUS2wzE4zXqhYDMQgba87ZU+IyhuWPeX53G9XgZu2ipafJWQmvMbJr",
+	"+RvNUmkhVzXDq6npZwSb2OW+v1OEB7V0Wx6+mDGj4s0VX2W1QieI0DF7d0DWAzwMDkseEUaY8TTe2H0r",
+	"oT73xTl71iI0y7bK8SYMMeld/3xOC/5BwxAeKkwZiJME+zNWm3Kv4pDCkSuVgVEuL4NbGvycPaN3aX4s",
+	"+B6mD4X/0XGNVcpttw+oA62opKj7TXu0R2+tfBF9V96j4JqacxWCtTUV9sfQ26u1rMSO4p/zDW/Giubj",
+	"CwxeSApXYYrPLPjdwU5l6VwQywjvxpqByMEcNqV24ybEMmBzTm64qimTbVKe+1Fp2h94M1L0d07kkV91",
+	"hoysCiLsYFictjAI2JoQd+TBnKClTIkHGyysv64pQV9wE7ZqI4s83/+wKimPxnZF7BKtXsDnWe48lSPz",
+	"WLXiHBoeuXIH7kR0jKBbLC2Bhhtv1IM8Xe3b7/Tq/EI727IMxbjhVaj0Q4J7d4H40UiT/noC6OMutv23",
+	"npHq8jv9T4cAMprTtjuAlJ6dZp4FbGx+Gnw0ZZZdTKXToClH3aOUHFniHlZPojapkdElH/dgJDnh2fcX",
+	"X332+b8gKB9eYKVcCWN7wuOA/O1NDt7/99lPP/ohI9xodqJeCaQKQnXjB1R0PNPSp293TZeVzr6LO6RK",
+	"do5R0heumbi3+pnOdbErIofoJgmYT91H9cmVF0VhH0OKe+P6qmZm7KqaUcnohj0vRu0APQAQUlmv3B7A",
+	"/zq3dO+WsmpF4UYUMNADdOK9CGtQ3ww2GOHkQFlxI6AGde8DgB+T13NGidkkHYDTu+efxBKxRwH/ejeV",
+	"d1SLseLezyJpaXyFFNBxfSHnWnC2ADBCzOP5zKpp2Oi4az4Y3M5wnmBCYFYlBfBcg3f8zifDognC2VTl",
+	"wBfsemk67zTaOfPmExfR4UoBjoQeNM18d9nv57jCxdTi39nCXzsu5AkA4+XAOzBMKgp+KBhkmvAXxDkf",
+	"0bSed+6/HZvTUlo/Z+fOm6QcqNrl+LJG6N5ttyeTfdWq3k4P7+rDTT7wXtBRLTPKxJLLSpRznjlrj0KM",
+	"xCzx9BJWBs4Cadw5KDhd2oDQuaygzCV7Do9xSqa72VANt2uPFHh9GMnkKhRwLagQ2oIb4ZKCKTxSVAKz",
+	"xnrOaNXMK3EpOjf2mQsXxds8BDy6b034mJVCNELnzuVhSppb+zwpED0Fu1lPPiGWdortcdPnc43rOXFL",
+	"M5WjAkSXsgSPboqEQ+mvG4YCHD2DqoH9Zu6MP+XUaX6mEcJF6cJ/n7vvekz8Ok0cHSyJ8qi7mRxy8VL9",
+	"QJWPDAoWHxIt60ILTn7YWZRDA7cz0tNHZrdwGhwAKpBr2tDu+eQiam/DiNaMyYU63y+COA+FLIVAR5yt",
+	"DJlRtNLI1E3Dr+rxwKCccPGGpYn0KlWaWvfNtShQyXcGbFE6E/bu6AdXkQW2Hl4fwDobNzEnZugRS3N/",
+	"fxO7+uieTr2ix54PN992hoMxbJC0b5u8cC1zesCRwjSwk5uF5b0TDriTAY6Ol6NJQ41cE89BCJr16win",
+	"ydkE8QXVViWrgUTAMLfml8JrD056ztii9QNRw1qMoY+3DPZQ+PhnVaehn7QiJoOi6BsrkOYw9JXJpFEQ",
+	"pPBhY0/sUsn+u+WVXG6RvxP4/jNkq7JeuYBrSgl07Tdg4t23m1nP31IqPxWtW04dMxlu6/VVNxIoUD6Y",
+	"Xfn6TWEbQmiFj97CMHfnreht5xALbvGs4DVIH+yDGqtvQSRrvc1VicGv/z02IUyn8qKsqXhBux18I524",
+	"EEr88MTlM5sPMUJ6EojGyEC0wQhaUpVzwp8PYCQ9Fv+zkFZzvT2xwXKO1+99YCe3+KSO2smWMbELJ0YH",
+	"77AW7rLAZpZy6l24US2Auau5sw/8tCLi28E/zOiKNO7G/Q6LdAf89wXvO0zbHl5n4n7zWN5tBvc2hYW6",
+	"nmux3Bs2iW93XSwmuDe94o7M7lFwq5D2KlGG+etCjGUOo5RiKevILGXdtDZzfyRfzzZBWBo1gmgdiW4a",
+	"0xJAeb3k1U+XQmtZjm2cz/jimm+EFZpy03ykjPs2Y0EMMnU4gDTx7oyNMUVsvJi8BgKclF/SfY3ldcl1",
+	"mb4ua1YIbbmEYMOtOT6kCqDVrZilmM8GVfFEm+m2a+5HnBAg1daFntwwuCoH4KTwKuoI3gmuItOmoQhl",
+	"/w6FuuyGc0JgU4CTnzDCaUJkEkW0D6OSyChq1Uh4yxCGwyOTDqKdGBcSzPH7g5HyeIFA6UqtsNfkWO0V",
+	"fo02Aohnc0bPGh3qpExOW7yfZ7zLhJ8GW5Y4rolxH6uJU0wJc4poPjjOybHQPVS+m1f+hGSFV/2fa2l3",
+	"cksfzNLtQUrFSoiZeR6GAeKu9B0RbsafWuQna7qtY/1iPTn5c0AJU57sznd1mHWeqRFiwqhe13M49duZ",
+	"6YbtTuBwRiq7mz0GDLlor2kWGfJdP1axu7wzBM3RQLSngG4MqC5cVlzGgNa3LBF+fSz7gQZm8k56tWAE",
+	"PNgzYRwL606bhKoVrzpzT42czkPUqGY+KYu/FJUALoafeUi7MI4mkAQX6Mi6Q+C4YXzFZW1sh7CTG8dH",
+	"xl2cjrn9YF7iT36uvZFATbHL5jKkwb1pG7x2iAoXZRzAOxdH4ysKVbWbkfHpmSdoT6LGck28xrK7+W3J",
+	"d7R+jrX/anHEgGakM3y/Wr9b9FJWYhaNnN1gk15kyJ5qjL6huOtI7dC1e++yFt0RJaPrPFdLlKyIDLJj",
+	"K52aNmf9astdi3UMfORMi6LV6Nm64tvhvvvuNPObBNj0W9zEVFo5aKbzdpNnB8uz+U3wHdPxcYic8ZVl",
+	"w6a4o0VC18Sa9IMGP4e4xDJ6QLYkoOA61sY6eq9wnFgW6/3artwiT75jORS8mT1zKf/5BVw4hRKg3M0z",
+	"oofcH/cMv4B7fEbB8Ft7xALHHFLjHbuPocfornlvqDDTgvxktBeW+yYoLnvZ2FG++2IQ9xW6IU8Cbdgd",
+	"OEMeCMBI0eFOpcqkVJ8ra2Ion8w0ihw6PnKiL8R+iBEVe+txICT+gz3gpVWE43uhhETSBfwt1+tPVZMf",
+	"AlKSpfw6Rgmd5e8rTOwWGENQki1yVitrhSG2pIbKRVJ12jzYU1R7WPNZK2WZqsHok6kVTcYQPFMp4cja",
+	"Cn3Jq7e9KbOzb6U29gLxIcqn44nD/TKHHsmEyl5xxalW88d80twVfwNT10+wPvU/BOxRVs65oVzUxUCa",
+	"oSmLV5QgGRTzS1GzKxwTd5p99he2kFSJrtGikKYfzUHOY1doFUtzCg3uSZxCXNs9tUD3rfMXZW9Axksf",
+	"gsZ+7CQ7uEANB2E8ou+YqYyc3CyV56hvQBYZ/GV5VPA2/4NjbHK28qmyQD2kcS8qsXG1sIgZhMqPvcgX",
+	"MmcLatQDTWlgc4Cgooc7dy0uc4F6JcyP0XZYnlJSNqOD5h6Lqe5zqxTWG4N7qBBzbucuxGpORkwIdQF1",
+	"CIGkQh1YLgtrGsxVPdeiwdJe84ZvN6LO9yIfqST2KC23nynmEHG1I1B2NFzxIf61cEhwi99/lUaUxmE9",
+	"8CPUQK2tc1Rg/MNOU3XaZxd/YKzCvs3oU7Fco4Uc8hKZtEy3dca3M61FfpwbKMr3AA4xlybOIo2HIrtx",
+	"05oPhumyY+i2zp+UtMBqhFga5r44utm8mzC3ZZD9ErTB3freK7Glqgis4VLHy3SikiotRvtAjXdg2qUD",
+	"AnxOVe0tFYb1g+xb2TOAbPLycB2oNbZGDNc5Wd3u4DajacPz52LTVCBEvM9zpHQ0PaSIueffXDxm1n0I",
+	"TjZVr0jmShtDJXdlZ007NXHaQtVWq8occCZ+TM5DGGjGTFusGTfs+Q9PHv/r22++OT+gN9cvaU+uCJyv",
+	"dEOLvcc4K0UhN7zyeswMN5ACdvrNu6gbPKO4X3cBhAXt54vZo7abHKecMtzc0NGqVwR4a+k/3e9fvPin",
+	"Xbx48atbS/h4pDRd7nMLn+OHWK7knBGuX372koINUPf59FOc4NNPZ+7Vl593H4Py9emn+b55MqeBvXjx",
+	"z1bC1PB4APhRBZ8IR24MN29uP34ZawkOM5WhKXjiv8vsB3TE2BuEAi/52V6HzkD/AtvKvxZ/+fLtVyj0",
+	"EFB1oeHpI1hv0ieLEJNZa2fyZCrYIWkrGNGhKlpoOm6fdHMy6Zqzs3+IxVqpV9mKRPQo6QLBVCyaEMu/",
+	"o5IpCn1Qj1qMt66V9TeYrtsQYIeIfvQqXqrqUtYr14LiRp1GY5ne8kCQvL9CaSpGS5c7aVJJRxouLwU1",
+	"299ZG/fQ+UMtXsSET3t1EC21EL9FiHZUyHXf7atX77fexSLJuO0fmTC5K1iDTihZB9WUitYXvK4VBrY6",
+	"r2c+HmN/xS4HSpZBTyu9D1eZ0KgplrVBDYAnlDuEzl7P8zJg51b5SDyUDGezM1FDBfV/njV8Gwvpz854",
+	"scR/rpfIs/lS/3ZGRIrV95rUyhWX3OqR1sI/P308st5GGSrOuF9II5eBKZLK/wnN/JrL9zbggZN2+wwY",
+	"uPe6yX9l25l+F5rpuI5oQS1xpg6q4eLuN7H1Tmu8MeU7xSs0P1BIXS2YVao6Z99c801TOf8/+9tHi38T",
+	"X/z1y/LuF5/92+Kvd7+6W4gvv/r67l3+9Zf8s6+/+Ex8/tevvrwrPlv+5evF5+XnX36++PLzL//y1dfF",
+	"F19+tvjyL1//20d4czu7d0aA+o7i987+1xzKMc4vnjyaPwdgI055I6Ff0evXeGNdKrpg15YXKMrFhsvq",
+	"7J7/6f/vedV5oTZxeP+r24d7Z2trG3Pvzp2rq6vz9JM7kEgi67lVbbG+4+d5Peuz8SePQko6xb2jSIjh",
+	"AudnUZZc4LOn3zx7DgV1zqPEObt3dvf87vlnML5qRM0beXbv7Av8CcXvGvf9jpNWZ/d+fz07u7MWvLJr",
+	"98dGWC0L/0gLXm7d/80VX62EPseaJPTT5ed3vBXpzu9OhrymJo4Zteg7rDXKfZJgEYuKtotKFr5XryQu",
+	"7hLDTVprk0IiWjMLpTVd0mFdYmg/ZaOBnhQQ96gEhNHnj6LWg+hwNG3O7v1z2NBzs+HMiIYTZVcy+opi",
+	"o0v4C+sx+CQOZpWDO94JXhJUM9efzQuWl+fsRzRV+fG4Jh3dsbxSoUkEN80bjoDrbEPEn88vdYC4uDCM",
+	"0rSaA0mIc/ZoVSvtyiE5g/sTCGvxEJtzf4L+uxV6GymcoAIOgxpfRlt6PRtiLZR5uEp4e8BOTH5BrPVA",
+	"8gVwIL6WrGPqUpainLFSLDmkRsLS4ctRmEmdSGH2XN1V0nE9dDI8+/Us5xodUKifGfYlThzrsUY2jRF9",
+	"CSRR+wWN9u78619//+qvr88mAIJNvoywsPyXvKpesitZVUxcY95gL85/NpaBMYvl+fGDSP8zdNuGp8nn",
+	"8R3IaIqb8LJWtXg5tg0OsOw+8AqkFnw+aQ+e4mEali7zG+OLtCFrIZeC5nadNj93PYRULbAdv0/kUTH0",
+	"x73xA7++KAr7WKlXCzwiMJxBbdG9SG/AxNgxRm/RzOwKu2LodyF8M+SN75jgSpdFrd/DvqYxztnFTTcQ",
+	"2eDu/UP2okAfClVivAMxbbI0c7HenraowGBCemN7HjoR9blFjNf/dXbmOQGKoc/v3vWy1zkyEtDvODGT",
+	"DDihHj9qOuko/rwfMdBQRtMjnx/JrjRvaEfdE6qJ6uKd6KVzEMVfnnCh32itdEzRvOly+8MNFn2flz6p",
+	"lpby2Qe7lEc1pRWCrkU64evZ2Vcf8N48qqmpGMM3SalEHj3UvX6uX9XqqvZvUr+oDddb1PZt4Ek9w5Dl",
+	"K4N3IpR/xLiTlnZgfX89qgjeSVYPP3fu9TdSE/utZbFk3E7N8SMzxlVxLKov5H74+KJpMH3wWXh+0TRo",
+	"yjYYEy0kShhxLY01n5yz79KvUTQjo12IyGtldCiDShOS5V2nzm7cL3Jy6paX1WOTgJmDVNpb5ewNKGcX",
+	"XW+R9E5ZPQJM17q1C6aTC9BBzO08abNzaG4tHg40VpHeMedNc8AYdJwmVbcHPcUV91ljzmEgefQiikpc",
+	"8npKm3GaKWsd2cuob3E3grsxNSmBN2hMZcdX/+ZZsysCHCVJR2S8Qcb9gSt9P/AK6CRZrtI95N0qg38q",
+	"ZTB0daSbNm+aE6iHaBC787u3iJ1AJYSRpimD6ZU7+Ta5MH/cYyef+It68s5xPMO1cdyr5sF7twre+6Dg",
+	"4b7vVe28y/BdKnVpfZBDynV0tBHjejPt/fgD1+L+xMgaVdsA0v0K2xHsc6CMOWb9xtjqH1IJc0i7Vb/+",
+	"1OpXCB25kQIG66wkrwsxxzh0s08BiyI5xB1K29GwMMRFzFhb0//IM1HxK/SodOIvks400pqBF2uk33lo",
+	"lZw0UAleFuqbEiMhyJP6DVaRRybzIKwYg9L/HT+ltSe1sV0nSfLZxM5KtUv87ypr3wnrWGcc/BvC5h59",
+	"7b3RcB5RAVJXLtYwbpHVLK3Dh2PZomQbWccGljkdMLyw2xU0EYSFWCot+jDw6z0w8OspMJxW8YoHaHql",
+	"tB7B7I0/dHNMkeaxe3vuHDqK16JQOi3AART+psVm1sP09O14mN69GHqTciPy3+xuW65XwvpiDkl45o1k",
+	"CJQxu7nYqIVvkdF38C+EvRKuCZhzs2MlDVihVfeo/ysFA1H5JC9B8I1Ep3SlR8joMHNJ30l5ypHX486b",
+	"WGGMSv1JElcwZj9nxKOX6hUH7bTr2ze+j4UvG0gYKNFVX0mDRc18KS2pA6CWLZRd+xbV7HnEjKrKNPJB",
+	"1alwfCVEQ83BxUbpbQwIiKVRfWFmaQZCdCT0YZc8fAik8aHIwHj3sgpr83WoEEhu1Oyh1WYnUPul3+7J",
+	"rZoxCtJFabgLFqtOYnzIcbTw3p1ke9Na0X9uofHl3S/fHgRJrzLvKfEJ1cgcJaWX5hTYD13AdSUFr4eC",
+	"4kol3ftvJtyo7XvtMiOOF3GpOda/d6mo4hzjbNliX1cfgFU7lksGINeDH7O68RNYtttsroVLTo+bnLaq",
+	"j93w8fUvPr/rJYXgupJCz1L5Sc/uxaabnd+BqBpubCZkbDQ6T2Kpr/8ShYOPG9NuKD6OU0IKWO4pccWX",
+	"RfNl0zN9dBqlKlwz5bXbWFoW09YT/OH4tbLYpl5UJraSktZJTG/i4pVRTo7bXl2EeAOKMYQxhWKwmd2+",
+	"sSQVFakStbiqtn5r3EcG4+dddQCP8y1lCLMOjY56CH6K9Pkm5OyoNN0rYSbKWUccPvPRrzhWZI+mkNlw",
+	"N+gS2IlL7dLC6WMVJwnGuCu34vGPcKeKLGxAownfH3DyE4ifBlJHUK9VuVb8mIOZaRruOiF2gQMtUmlW",
+	"VAqTdKRlqrVMLWeMh+wGV3K9F13n7hidKZALr9v6FfJgq3y95lqxCu6ZyS3EV9DFNxhU2u2Xc220sqpQ",
+	"rrEf5jbQy9IkRajTZtNdHuDqHKRt7bzoGgqkYi2KV9heQStjYg3dhH27Jh/d4tFe/eb1lsTKClDlCmCz",
+	"izzm+pPzCjNqYIdEmexK2AotmHklmyaMmdxnAeWVMoCK1npuh1s27kpGEvmpsY+ov8d7a5P8lUYRxt5X",
+	"5fZkrAJXHhjhDjNZbusUbBNtUtyj88F6X5/Ujuja3q3ybaxDZd+Yi6SNdaeF1BIaAB/GytRpKd9MCfB9",
+	"LeFIYeJG1cmkvitepwnc3mljiiSYit0xHc74gyy0gjy3fScaW75jsdFwkNO+90dUWNrrnLX5NunonoAU",
+	"cduvnm+PgOLwPu0z5/5webMIQ6isI12znclVPvDkHNy23WtWCfTdjZ5qu87gd0SqxaM5dpJvta+3apwI",
+	"8TK9mMFQ9QrDAd4DpfCru1+8vemfCX0pC8GgMo7SXMtqy36ug+n1aCUVBTwZr7XAredWMHviIzRRlwWm",
+	"VqhSrEQ9d+J8vlDldu4vXkESj+q8CdQncEc/emgyhcd8N1NIyXYyyw1EtoyNMjBMgcm1tTAk8M5ZohxJ",
+	"Ez5fZGoLSIvtLTBkAwaSl8LMWCm1KGyFPdzsWmMjk1g2u1+coOdSwE75h/q8Cdrn13Xi7nb6A1j+0eVN",
+	"Y7nlOvNQznLYLY0erUpBQe41tNvlGnjebd166yZ/f93kAxh+cPe52DDBQxOS37tGmc/u3qWbnSs2Iq4L",
+	"IUr4+e4YbNiE6W26742sx6ripxZBPDDxWAQPTaeoYSmuj1H4eoxvWhjB4DjtVdFopb35jlDLHAPsyZPb",
+	"kII/nPkrkaFOSOyjgptbvtIZ7vDyUhqlt7uKjnS/cD6E5IOVFlgs407Se3PwjIqLjGodTxPFwvkaGqGl",
+	"8pU9RYOPwnihwhDKaQzjjSEESbsZaaysC19XGviSMrwy4VVy+zRC0xzSMsOvgrQlIzgaMqwVm8aa3hXZ",
+	"dOEgm5YLqV1sWR49eeHtX3tGiDpt5HrcmIm8z0PjmKADKlORknZppGsNPgso82OOdGr2ezM3QtR7+wiN",
+	"bSx83N2/7Gx7DRETgN4ndYCi8lPAk4loQQI9fN+eWdH8gp9ONSu4jXRgD/bDQzKLxDRVsNHRH6zX75K3",
+	"VBMgtyLlhiLlIGwfJksaOcfiZHe0cpm54clNihH0iw0k98z0USdRA3V17ENN1vlZ7NFD8XmCa1/f1cx8",
+	"ois8coFptDuzQRpsnjlHMO5vHz2ccqv6QNLWJ2ZFZ21R+b25VUjfrkUw2YUflWXfei76ofKwkSN/qN67",
+	"iyPdWajrCfavDlvy8aRYRm5gC3Mqa3wOb1Mdxo/R59otIf3JObvvXo3d7x2DXilexTZvXK/oI2y6q/SG",
+	"feT/vIfjf3TOvsUSsdbMsPi4a2e6YR/J2t777PMvvnSvaH5F5Z777y3+8uW9i7/9zb3WaFlbjFgla83g",
+	"dWP1vbWoKuU+cNeE4bjw4N7/+t//eX5+/tE5+4ePfPIae96sdl9duyBYNKvNInp9dDC74iYU5JIuac+Z",
+	"yTqFzeOFoOSWU2wXoD0cEyaABt3XyUaazlTYUcXPByP6HpYy+ODiTDsMGpLWE4GfO6px49GfOBK+Kso5",
+	"2hOipWmnXFLX97c/UoHx90U4DdN90xM0Ru4fMpWPmNxq2pfpoV1vUqzfV9dZMaqub8X4OxPjHb70IYvv",
+	"RZeMXGJyqGyRdsQ4pTgX5lCBPnMCHAMcgjQ+Zz8qF4rWVlyTYx9jugxbtVzz2gqfHylcoIahCNOikmiY",
+	"0swIfSn03Miy4zxCw7sJjYloehi7BwFKSl5vXR+spbyeUeCl0r5jMkADy5olKTNcg4wxVvDSjU3ysRLX",
+	"soCLULOWBS0oNorHKWeMs4a6hDHOrNyIe/4XCt5tG2YVeD1oqhktJYq2XQv2rXNEzTZKe2C12PCeT8td",
+	"6mAluFy6a8LEDTeYCAS/wt8rn5ClqkpdAQYb16Rvj4QU5n2Wjj/w68TCtQgKYuL6ebTEXXA5CEZYV2//",
+	"mv3tb+zuLN6bqwoGmBNFjTupDnRP/RRrnCaEd7VWxnUzQi+m8YlV0gT67eq/YxDR22cHBz0n5BK6y4lL",
+	"qVqDlDFLiYZgjqQj7Rg0MOphsHgXoo1d2NUyzjo2Eb2amyo2bTqtSy5wzElWxfvq+qFbp9L7O3ng2FOM",
+	"g/EONHDJ36ofH+z9nViP29i3Jv7vXHFbrEeVgGdWC75xnWFcugq2KXGhvjjGkAwZN0G4idq6dF+8xBlF",
+	"j+HrSpQrgXcEkxY52PBXvmD1OfsGzAA0NfUUqS18ztnLhbp+SSM7VipLX3el44ugj708VcbdayUMQlWn",
+	"8KSF0jGJYQKB6nWxCjexODb72F1NZ2yjSop1UNpfUD+BmWesrSthuldk9wIN5aPQY2exkNrbheDZ9xfz",
+	"rz77/A60jY4do2Wa2eu3Si1TvPISPk+CQ9pF2GsK88bdFuW/j97JU6OBt4yrDRrKMY9INSJ03qfRztmD",
+	"5AXXRgX+JMeJhPsr9g+AXGA3YF0LClzglbwUKL7hAZKq0Bi1V39k6Yu28WIzLBu2mr1EI4UnkEA5dZnA",
+	"xkQ2XfgfMM+HpAHhviN2DlQvbmQ7ewMmg+P1m/1y3oprewepYU6735UD/QGHFmNPNGqZ5W2qRs8tHm4i",
+	"xPNbaXwbTnvzcNp/hIN9gAQ+qaYwuRLTuEcSWxI8fTD//K/uwDDh0gB83Fr8luShqkphBsGugZ1z3xQ7",
+	"E5M6Iww56fclcilmREUdqN3PNBJdaAgMkLwX9x8FQcv1qgXJZf4dXtbbMKNlleDGupFN15RuACIc/Jx9",
+	"M1gqxdX2Ae6ldfEVlzWlNUvNrGrmlbgUVRrkdM6eKwd8XE2GDmaM2iTjS8hWffeyEO/pe2kMFCe04KdG",
+	"F1BV5KpW2ot4N6tPITNCUCc7q4ZOc9ZUrUEuiUaNGv8nksifgyOJmwbx+97EEUesTy+29U5VBpncuyMB",
+	"UQc61chi5m4grmai09ohmYdjTml6kmZMnK/O2bMr3nxMsTwz+ueTGRUJxOO1FtfhePXO5Xj9kAaZ1xH2",
+	"hNuo6Pc4KnpP3nr/MN3mrf8RoqyGsv9masvB5bpjOe40XAp/3BMo5S79yvKKmRa73rlOf4XkVXR05mUD",
+	"zDA1Buo9ruy8t35g9ubUR+/tGb6NdbqRrbRPUDdlG0eVnc1xkuPrzYaaCj4C1aCb6kMqOZupH2reMb/b",
+	"rfcOqlD224TnNJ6Y0HebwHdb5/a2zu2tbru/zq0zyxxTIn0gqjbC8pJbfofKtB0oqJYCfCgkLNRyOS/W",
+	"HMuv0pjMunbwqRBi2AEcL4i4NihWhOnkiZmKqbo/1JqbdU+YocuLujcg4YNB8AtKFMO3N5yAS2q+wtfg",
+	"8AJnl9LhT+//cuP7SY+Shj+4j38BfDpS+3cmmyX0TgdsJI1qCX0uLqfz9aMn3z77jltxxbfkbbJDIYkz",
+	"bDufvZ/3gT138zGs3d7R341+jwTywWv2dDrGOFPCQgPjkdZ0+c2BzJU03CQ1d3eSLWcO2I5fPS3NUWE9",
+	"UbFZVNkiHFQtVC0TuzP8r+B1STVSfPvrpF4kckf8ToulFmZNVb5V7XwSbr6tT7WdhcquSmO0m5/LJXuK",
+	"JNQCy8vh7WUJNlh60vCtEclnV6qtSv/xrFd9UIsrrkuT9EZcqyu2aYt1F0cFb3ghLbLG1oh8WP4T2of7",
+	"8MU+vvhct3WBqQXRupliGjLEsXC1aSq+9RGAf+vF+vX3J8krJzQcHQN4FGNNEdDlpe9eL3uLjtQflaMa",
+	"iJGJ52kr7M2sBhTdKK66pLkQ6PbpH1t/zT2cnWhw0k6KqKIPqD42RdtYhV63GZXTd/fUEs0Aqk78T8gV",
+	"oIwRuUINyD5Xic71EXJRTUlggotpwryqQawT+/ilrsuXn4QImd+MLRnsnhbGwNn4+CWw2ZefBJ3LzRMC",
+	"oxCb8HkhtCWdQHSKPaMCmOb5fyfsfdpoH8HjByXLDjpQ9dZvVGuTuCRpAnRep+sEsNI3bnoXucENLauU",
+	"eNy53iIHvMJGB4DXorXyMuyLWWNsuCHVzwhX2o+Qr31EWtFa7zTsFADtsPlM3BHWu95iJlRgkjXV1Gda",
+	"8DyLJNYAU+9lkL0ILr8RFJgy0jYA3t+pQLrKh2f37s4m9hCoeB4EdmFJcn52N1QAp9gwfYkFCgnXY24n",
+	"fnJAQ8iTBzOcNbQZecqUBq3/6BY+ouvkjnaThxlFsu3C3MT3zhYSqDtjgBqpNOADm0pRWZ4eLDoKEyKZ",
+	"Tt++LFHq2Zx5sUTcda9QfEvt4Xbr+acHIp7qDn8LzKhWlnUijr56y5t2Sis/W2Dkk1pmmPNsQKrHCWsX",
+	"A7s4SGDHWv8oumMkjapJHT4o/JlGM2m8M21uL9wZfpsU8BwlcRLuHNsJhMeYhuvqH6KSYb0ij7g9Z//I",
+	"i+VZTA6WZhChnLDQripyokjgRFpiaW9VAxJRaFotxaUobxr/+8zTBEpbc6iktSqEPg/6FtMDQFE3Jje9",
+	"8iXk1dNFT2n1/2N1ZoYIcdAA0lOaRrQjnlXrQ+p2X+7WgpdCz0Hr251ctBuM5CjBgRCJKitNhKyTez8G",
+	"Eo5y41SntxYCDYu8jX5++w2YQhRjaI9zoEJwG5E9RTsJAiIEYff1ghsbEX7HHUzjmfJ3wf0N+DcbzoyA",
+	"lyzmFhsbC/UL0AncX8jmA/sPEYIzZsCaxw17yTdYj5hcUufe4fDynP2ICoQfj2tKO3G38lIhRsBbYWau",
+	"IQDH8CmryBghqqQ2oWDogDUU7GA1hzBekPyrWmlBbchTSRRY3vilFqA6LID0jyUckx7uoK7SFlhFziwv",
+	"kbrBNBkHk1cyjrxznzgRF2l/2H0G10LyGxXUpDgg7dlI9UH88Hv8DhvpC51hCT/hf3jVNXA53fY5NsV3",
+	"tiJvuIt9y2gm5xkE5LvGFgx28SAoH8TJhznElerQRAhSmNBq/BbBN0HwQPZ80zGLukV86P3/uyaZHzFQ",
+	"Fg+488K8abVr9v7ZeN7vBf2oakHNLUArJFp801rg7MMxMUVziPe8UsLLMc3SuorbnaWseSXtdq9XGe+F",
+	"1L6LswoaZyYlcRZalivBaiFKVBow+IZaRfDESUaT/Zbec3VrrPfwgBp6L1ks8e9Zr9JpynQ9OpybOF5n",
+	"G63QHncpdC9XHvtzuOeh4yTN9JFxC0unp+ad2kYfTRj/I9N50yvWvtpvXhP+1iN8grkmYy6j1o+XgvmN",
+	"Axy8GVXoj6VuvgHFbk77/rbVj4v9R+F4RWJ2hkdgni5wjtS+jyE+hu+SBTzBj4CXwYmZNgYUIhfuw5xK",
+	"4zHuULMD2O60J1M1b7f8A97yjKGww+mtWhFXC9Zu2EiUapT84DoOS2sC+/0j6cq3avF7tqAHGNNWK1+g",
+	"1qktWDeWGbWJ3q2NNMY1h/zy7l8/2AVbuXE9WlXNdKTKP9Y94E0al9/0at6UrZo86UZUyznghVK5go5L",
+	"dN+Rd6Eo0KluQhCYu9eO/T28NFlzH7djwmQfpDHze4elHdpPiHDuG3yHwh1HmyKpv3dZGrwjsd+p7+6d",
+	"WJbeQ4feu7DdvB1jCx7SLtNRJ2Y6qMwSMd8J6vIYB8qr25O5kVWeF0FQz9DQsRDg4jfvJys64hoypBJ8",
+	"QGxkuP7zP+HZfe8UzPdCI/yzxAd8h/2KE+XKl3LNWUFrLP/Ne/ZVb++8ERPsNCD83V5D+uheZpg07jyQ",
+	"D8o64YPJ3ODuF1wfzwCnBXB3E3kQ70FFXIkalin8royAAig6sFPv/zyb6IGHl4BFkvBrawK0NWQBcWzC",
+	"ZVep5Sz0t1E1fHaPvag/ZWbNv/rs839B5qv78/Ov/jIWxcbNGgHLmXXjQPCYhpkSSnBrqQ5ae8Dvvbe9",
+	"24dt4uxMltdDIB9RqbxBI3J//UNW8pFJQiWzvSfVcjh00AbSYTcC1Hizlg2MFRMVtpZSWfy5+j8f/8c9",
+	"OFt8/tvd+df/886vv3/5+pNPBz9+/vpvf/u/3Z++eP23T/7jf5xlEGCsXKyz9yt//XkmV7UosSn5/eAP",
+	"JKsk9knwPOPtwm21EKVo7DrnPWy0MFS+BOyp8FbcTSHIBSeNS29D11Y9Y/JcnPd6vUIQustB46wSfBlr",
+	"QarsvvfumwmfAULzVJFgPV3IlDtpln5k7e+ob/9y+gOvYONF6QSdR57uyZx3qujad3VJneMdVdQhJ6WD",
+	"lnenU2ICwCwp5dZoZVWhKpQ9LokrnG7Kcdqr7okdyaVR2xsj3Bspc9eyNHvtaM/xrRMY0rqUbT4YO9pz",
+	"j6acIS23KF8yaMh9d3briHNNqgmkGjao4QsgvFO+dmt0y/Gzns3tQze52VHSO7EFroBsPaoVdMfft2Kv",
+	"Y3zaNnd+j6+93v009r53L5Vi0a7uVGq16jTSp9yYO/a6vrPSCgbZWQgUmbVLvcNPOzf0FEc4Wjbg6DF+",
+	"jh72hzDEt0on1+bv4Lu9hX162zHrqxM4O3v0MM9438w99U99vdtpCe1t+M0dgpkRB5zAcwnmPHcuRhC/",
+	"7KSXEQWDHbESORK+jT94X+MPlhLDJuM29qxYSkdGcBuD8EHEIHz2AUeLW/Zo01A6lihvGHPQ53BeeuwU",
+	"t4epHE70D5PlhjI/lfi+v9p4h+g+7AfcqKLZeS38dFzDfw3I6tuQ4j+jJH9AAtx0yfBWLn84cln70oq3",
+	"Ivg2DPBDDQOcIpK9JDpaDMeb+IECeaAMOOtYzySxy2ONV+/+Ks23Sj91q7qV4h+ou5V2cnKR9ykWmn02",
+	"XjflKXJc3ivop9kZqipjaRg7qLOQ2iE148aoQmK5iUelmdEhdsYJd4pvFZ/3WvFJ9vpW77k1PXxgpocR",
+	"Lcfd+qtqiqJxqAJ0uVGl8C5btVwaYXdpP6bTHxPI01i+aRh9OZ7k/FxuxDN48yea4qQiNoLdU4t64AGy",
+	"jCiUK563Jz7EjXqsHAI82XEA3rrPNOyAhwWDCYQ9P5pknya9owaUwPrIN1j4EIugLARzyCjFJdscXmsq",
+	"S7Z3fqd/0ZzWKJMriSlsHlz2sduWT/Cs0bgdANkTVEJRw6j9V2rJ7rIrWVWsrQ26LWWnB77GWlG+a7gW",
+	"vGJFp2pDgCNTzHH05Oy9CgxWN7Km/F1AxRN6ytiIXsWcv7/1A/CAqlTjPvURZBXjrBYrjpVa3VrOb1sL",
+	"HS3NXNfKHQxwxnhZhn4+bhOo1iTUtsXC8N2Q849M97wcwDB8gdc7haovXUJ9nkU8oBcM49RFwt1UF8Je",
+	"CWzuYzpXVTjmeIX1M5gZa43n/4ZvQCaUoohtKVojSKDDUIA/msG4qrUFr1UNJWUZdR7qzCbrprXufu9q",
+	"JIb3q22oaI9tvanRhS9ct+GvXAMNUZcYpsBaA4YZzGIHouM2lhI3rNGqbAsqCKjo/q5Ulak56/D1jfty",
+	"Cnt6JamGiUOtVcztymgnZYrSHOdH/m6/cJUF7XV9NqPao2ezs5WohZFmcsm5YUF1ijSCtgXdvsNOnRyD",
+	"G7drfvqaeOMV31PgYPAxyFRrTw/aP7CkGWxNj2w9XaaIDIHjGQIegzq8OqGeLM50X5XbHbzzeu7KzZ+i",
+	"Fr1fZJaqu6T7+oba734IZ2+s5L5bXugr74pwB5p818GAMeRZaVareh4Zqtffb4X6cULdsfoRyZiTihOl",
+	"NNSlgcOzEvXcUdQceMTcc6tovkRhft0ILeG+zasYTbcU2Ns/CbtbUmEY17FSxwdkHkzi/vY64tOrTvyM",
+	"VXwhqtgGM2RrlbmybFhGH2tm88FAWFcb7hG+aS3WnG84nMS0YyFniEazFqWbvBLWMBLJShtWaGXM3Fdb",
+	"E1J3baG+ypoWc7OtCzyvmSv7gwDZY5jk4MpkcWUfQvh1hDafEtXf8PNcygut6N6BqNljgvBoih9NbuQ6",
+	"oNJIm2nn1jSg5E8ZNd07hf78hQOsP/Se4/Y4YjjwguVYKiSnVNtdyR7P6I0bHuCeWQfHZLqbWuaNlAQT",
+	"nL8fZKHVRbVSxusvZmus2JzN+hyBPv3XyKH2PtlhYqGqK1mL+UbVYpsxeuDTH/Bh7murLK/GPn4OD8e+",
+	"7fGNLvw9sLrzTOEnN8Xve2JIudkR6q5WC+qk5QvmE/0feWi2dTFQTuDHO7x4lagmmReGD0HlwWuwK0Hq",
+	"Xxnkzx9a1LVXyjVXMCNRUy6V7dY/ZdLVdjWzoIXwohDGkPGNVnVvrBRJtx3f0WVd32g112zVkfttXVbi",
+	"jddkSdWl48sTHNX8c8ey369eoLeFVG4LqbzdQiqZ8zssKYqEYbANsqt8rVxDpQNEyUZslN5GObARVssC",
+	"j6mw8efka1WP/HyHt1ZpUYursRckIGtsVD/12GP0YcP381diO/bS750/5zIVcfvevFOseVUJ6mU79Rtx",
+	"3VuSANlZtkR5Yl5xK+oihZf6SmtAsfs0L1npHi/MLFwpeFGotk57qGE/UVLSQAC9co24Yq0D57zD1jJu",
+	"5pKB0GPc9VBUS9+MNIya//xKaKjO1lAfbKv8eD6xAY0G1EHWicQUsHN24aFXrcUKQGoZ0jVVLYwTXwHK",
+	"6HeMDR9h8KBBWaVcs3CPUvecuzTvpBt3rFJssANuHDKYkwErUS9zs4drXdLfKG1uy19hF0r4F7scujUt",
+	"eMWBX4cb/IZfz51+4fwqblpqo85ErdpVLBEH02BjwFpdpaj0FJB25HVNdE3Da7ZRGgth1NBkNrSZzfce",
+	"d0h7SlS4R8/4NtNYFz/sug4+i5OyhVgCNGHlp+7n95hPgajfpXhHf/OjoPgxnBWi4842UVMpAIqpeoCp",
+	"MVAquZH2qGbrR9qxPLiTozU97UDEkRnGZc4SbN6bvG3Zm20kjnvTSXL/PTeluXTnAyamWssCP8RbQ2Fb",
+	"XjmWQ0yJV6bL5zr0cdsw8K2q1k+JMSndkUzvXcfAG5kbbkaQByqPZt3aUl0lCqGpeWPWyiZaHF6MSVed",
+	"0uQvqeJ/RPJCt6qgNG82feFNpu11uhkM7x/hqactdqV5Qwa2+JCqsmGoZ/D1/6nv1C7LLSUStPGg9md6",
+	"EbG3F+s/1MV68r4fxgN9zZadHK01p/Vc/KhKQeP60GE6+kkheMYXaCuly4TxQBzownTW6/her85ewVsw",
+	"VbQNsyrn3owfznlBTJY6rpj8hJm7J7dszS8F4xVc7SAKWNRMLYYXM8a7JllXYyerWCZwNVoV2FB/nirC",
+	"u0Dz78Vr5hieEHAEOMzCjGJLrm8M7KvLvXC+Ets5RhUb9vHffzGfvAN4yWW0G7H4Tg69oemlrEegnjb9",
+	"LoLrT56SHZkTiGopMAsSNqwYAeYwnIzuXx+iwS7eHC1Y6FO+YYr3k9yMgAKob5jebwpt28xBfmfsePQU",
+	"wvFhw2peK5/KkRus4sbO97FleCldi4EVJJwwx4lx4B2X8qeupHVJZjJnOQlXbJhiHGCQolLV+ZF/oYe5",
+	"sQtVG1Gb1jA3gi9TKcrcGmpxvWOuH8V1mEstk7FDHUxKqtg38hiWkvGf+nbzwXHIuE0ccDBcZnGY8sFd",
+	"IMMQlR0gIiJ2AfLMv5VgN82cHgFEmojoTijH2WwQFzs7M1Y1DXALO2/r8N0Ymp7R2xf25/jukLicYxXm",
+	"ZKUSJq1R6iC/8qZEuMuuuWEODghKd2VMV1oYk4UZDuMcvWbzXZSPWTLwVnoE9h7StllpXop5KSqeCbn4",
+	"mR4zerxrANxxT55zcH7PyW6a3/RIyXo0lCQMrXC8DNP8UaGb3bACjiBcniOBuK/3jFwKHDvHnBwdfRSG",
+	"wrmyW+THw2XTVo+Er8AYsOP0EoHsOPoUgEfwEIY+HhX48TyaD/pT/G9h3AT+nSMm2QoztoQ4/kEL6If9",
+	"pAKsIyl67L3HgbNsc5SN7eEjY0c2Z4r9IEOx94bJn84U2A20Si6A58dcbu9ccYk5H65XJl9aofeGPv+D",
+	"S5+BHDsOU2MMhiM4uenGQSavk3Bpx0UIBObEBZAIOr80+t04+4xtZN1aeqJaO6MG+VrwYi3KDhrcSBSs",
+	"2eoaE0tWXJeVMGgU9XITEwAw8Kgr4BHoTMnY7o0f1v2t0vddStEB4TtcWtbWVlYOQOB44d7+/lkvby0S",
+	"txaJW4vErUXi1iJxa5G4tUjcWiRuLRK3Folbi8StReLPa5F4V8nrc69x+KjSWtXzflWa2/z1P1SSQBBV",
+	"3kCC1gmwIQBbShIyx+0WhxiC2gWl5KWBXeG3O7+DRQL712i7+wXVxOdW8AoRKysxXliHSgI9/+biMTOq",
+	"1QUVxgGZ2FRc1syKaztzFhO24Eb85ctQUwPlMd8waF5IQhte+OJz9uz7C99pcu06Inbf/fiiLLUwhhm7",
+	"rcQnYHOSJtbAkYYqkokadrIkoxP3cqZwVWzJ6rGUlWAG9uwbfPsh9CZSjdDUxA4rnwzNSM8Frx443Oyx",
+	"ImH9FFcI6SWM9nLWsaQ5tG144+8Ofq3cME4FFzrByS+XvDLi5ViAMo234c0NyqnArt3BDbx5dZE+aVhF",
+	"Af2IvPLkVVSGXVGHRDsks30Ulq0NIEyWOeyi8tw4ccMGQ1EZ5WWPTs5yFYD7PTDPAoCToqWxiB3tCXtK",
+	"371TockQInfEooR4b0Iju28GpoHv1sp61vPhFoUhxGdPL579mS8mhhXPHMWdoCoMTXb2OpVCpTTcGLFZ",
+	"7JdEKf/EExeEj11nltORU+9GjDxMFneqEldbKybz5oAtHNGx5wTjb5pFj7HRFATm+FPOUtXjfYcyvTjN",
+	"9pbx3TK+5DT2NAJZu4S1PhM5f4OMT291W4/zvG+uRdECcOlJ/hhN/ujnAxNQ6rnFlpUrrD41cPxRfgqM",
+	"J1X9jlghLXcqFzyMgmjwkF910xLi/eGG3CWp6v2x75v3CW4Hr7foIdk0vN56PzKYMjZtRTgsueXnZ6dl",
+	"tNQrOtdaOBoUx0zlT9wbqUHYidru74QWdsV9+TJRsrYuXRGV/sT2up6e10hDP7+uI5ve2XGC1ptZnZt3",
+	"iojwu9wtBG5YI/TcXtd0oDqHyXWup5N7fptD+OcQG1RGXIww2GEX9sgQTiQ9dMLXgviwYtNgevUdLQq1",
+	"quVvx+nP7lt8eCWqihEiUOj4OdjHaKoBmywDl/iMYaY0wyKNMzgwUpWyYA3fbkRtZ8w0lbQzdn5+/kln",
+	"VmkYr5msjcUkfbWcJSIM33QuW58o6QGIVhhXRAA8bLyqfJH6Upqm4lt2BQ94zWD16sqlYaJsWypdiExC",
+	"/lOPARBSz91874+yHjboTavqHYCGdi4fBeY3JEXoUObAbg1HPftl3+b6KAaHik7T/F2sIN27J360XHq8",
+	"nzPjCOMb0Ycsu7hROYqbeBl9zr2FDH06VxwjzcwOfHuaCF5Rh/eZC72C67mqSqHZhm/pBUxD7mBuKJp3",
+	"iVYbz0AKVFx42N+pqfpdXsLHucE7vp15GfeE4PsTpuu6lbOHQG7QJ+cH8AayC/Z3EgqeND5QSf60I+26",
+	"ZNm3Er+hmx9sa+LJob+dkyb5OaoTJv/rHd6tQdh5thRiLoyVG8fp9hZIXgoBUhxX3tNmQhHBhm+jfX8j",
+	"rSX796ax1RYLJTdCF6K2soosfimEiQM3XIYYzUxfJPeNq8uTxt5hAaRC1SssXD5jy0qppGJQB/r+4I1S",
+	"lR8avXYuWAArQiLXgz4OcOWtVS3yQMCNJ5kernjpFLwoRJNUlsQppemUbFrKUIEdw3Bo5FjQKEUe1yKO",
+	"ky6Oig/BL2rZ2yZpUBh0USE3gn72/gqosWXXNCu3rBLcWAYlWZbCDR4D6LookAb9XG6F3wrxjaOv+/Sc",
+	"UJvaCvb1z0zGODumiGF+qPerfuGfyef+rRDMMx3XwFRiv5JyxtLiWtJQtCJcpNrmaF7uy66YdkXnssds",
+	"6F4GgWPJMTnQ995hqhuhVzuuWD/AY8M2bWVlU6FSayXcPeZGrmpRskI1MnJG7DODLxu56pzkXNNLMlCq",
+	"WsTbT6FoYE0XoEIpXcqaIwfUWNLMRftjCTleVS5w3hdwPWffUOceuaqxDD9uDBSKFWWoCttnpsA3qOFP",
+	"AH301daulZa/Cf3vfulY5g54RCULQHSc29ePow44xIkQ32U6pn/LpZD4mrSUfLDQipcFN5lCr7g1Cbsw",
+	"+9z6H34v3LfeSwXPXHCL76b9vdRuFe0+9XOtt2RoeMMXYG+vHK7NUaJbS0qQMziTyGoEL9bA8KysC9uV",
+	"zCT1cQl0GJdYP80lZHXbsnTubb2YJpz++bVvP3XOftjdjyfspKOZ7j6C4sqrldK8LufhVTdJBH//dXHE",
+	"3Hpw3+Jb/J8U/69nB2HyvWoClMqIFMhbpe5YbQlF4C6+nFNFTnUH1vwKqTSrVkVbYbaKU3IUntCbJ82d",
+	"HAzfTaFMLJOUIiSqhnFfX79QtbG6LeyLOqtp9tIrfSz2uCfugX8lnyWTSWJxQ72oqQJuSFzIWi6XImP3",
+	"BLVdmp4qnfLPpRAvaveWrFlbYxfEJdvIQqs59Y4IF1R6E2yRS+x+rNhvQiu2aG1XkcNwaeqfRGqpu9W+",
+	"qMO19AcJ/sBvBfH3Tla1sFdKvwpYyFtXXQ/BeT5M8Dt6+j03a798H44K/3cf0+23w8ydSb/hFg7m2b2z",
+	"//Pxf9z758X8P/n8t7vzr//nnV9///L1J58Ofvz89d/+9n+7P33x+m+f/Mf/yO2Uh12Wo5A/eujsp48e",
+	"skoaG1MAB7C/tfQvZ0kYkeKUEd2nLfYx6siOgD7p5kbYtXhRgy82bXl5DDn0kxwGZ5FOR49qOhvRy4Xw",
+	"a50kek/CZXLX2VuB+AeqkpjQgU/ewY2n1i69vb+BJaMRNTZ+vff7jqd3frfXnSL+3ZeUqrBGhdlrX4a3",
+	"QC8vDFN1//5nmJuOycGzgen2nit1TzZK11OmwarzWXsz1d7nW7J3rl1BdxpVY3sY+B1d2XgQN2R4EJcy",
+	"WjuMqOHegC8xzHsW2FbmUsR24K5FSJoXoq0o9xlAnyhVUR3vG5o/w0Dvl/Hz6HOwk2Jw/25ixyO79ijZ",
+	"PlbqlcEaFlSoeV5BPEuXZtHOjZcVR7UoHH/g18+v68dyKUIh/i3qMsLVNRGs0WIpr2exgwQBQ10Y8Fo6",
+	"Y+J8dR6bNnn7U9fab9qF88AIriuJ6UcpWKFdE6qQ4lroc/a8o3+hXw8j/fCU0N+osoVspTTmouMw4HFV",
+	"PF1XSILD78/ZhXvPBxjiJKJkHFtqAozonKAjds6eOndMpw/DInoV3Lpc5OFTxNzz6/oRLPDf8SNcK83l",
+	"ysuGlGxDpxJZqY0/x54WwQ7gizVnDq+b8wBL4k8Ai6vR0KUhI2rnTpHGl1QIOHBBmJVSr8hM7nE+mhiE",
+	"OOxYDKOGClrn3fnXv/7+1V9fn03oCj0OtGtUIg1BM+tlfY1Bhy/nUpaOgmGtjCCywy1NofLnqweW61UC",
+	"z3zTjoXAxq7IxXnNvvg8en1zK4Dp5jTCYev4gV+j1pv61Yy3AZMpe9Cego6bA1RcF0KU8PMbaFuxW8QM",
+	"yb0rYP7MoY8fohEIRJuXbE7wdE7WiLi6mbClAAgz7jijNjiu2RIfegNmnQ5MM8aNEfhv05gZRj9BDNWM",
+	"VargFUVbu4RTde3c313TA38lYtcJ36nGxX4EHwVOTdHNyd9+OdhtCTizbivh2iNGCZT1dPguSzg9Wqvp",
+	"nU2Ehc49vcabhoFF2ZB9JIDX7VpkVXDorUWALkx/tVaVA/2chbhcchi2IJgxcRUXaSzNvU3is5ZcViaZ",
+	"A76KV50wXSbePy77O5j8aaCCd++bGtq+o9OJ17za/ibesKPp4KY/iMNna65F6Vp55UIbedPMsXGhCxrK",
+	"IGIXddKnoB7VQHwkM/FH0NiaZoYnycGOhAp/dw+dzhysimMOfDpVesRygYppf93hIo/DW9NkhzNG2JNh",
+	"DQbr4Q1+CsjyvOqtIApmPg5V8GVuSOSnx4x4X13nxvPoOGbI7+nb3LBIkkcN+hi+zA3pue9hg7pgysj8",
+	"9rnyAmMI2+cIPsFVWJ/fj/7BHxJ1Av9UP2GUJBmBjMLPh3MAXYfh3xcX4oXzq+aUCaVZ63Qk3jS3yuQf",
+	"yYBqjybdk3o6845OlwHYCSHrqk3P3BvPO1be20ilQ/t3OTSeLB10OGCWaXZEt1XMb/gsNXhhNBPuk6yb",
+	"lvq+vUllU1zyag62MC1LYSauVKr6m0te/RQ+ez07g/ThudW8EHMyyE3F2nP4huh0n+8xFmWWm40oJbei",
+	"2rJGi0KU1CteGhYzac+p3xor1rxeCRMCH/E1Ggcjx1tDMdi6rQdDZP2Y9rqeU1D6EMYLZ8DxRysEH2Vu",
+	"FJSlFuZzBrIpCkSGFfibVDY9dldaEmTVpVlJgJwuf5jgMe34PhP8xIlPEQN2S6231PrOqHXYtdShbplX",
+	"JZJtecOK7w3l1/tkXX3TS3nr+vWbX9DbVNff9GrelPbvOZBhnGl+tT/EnBsmLbvC9qQL8PnzqkWTjfJJ",
+	"WhhUROaceNSpanNrXJv6Ys1l7Xpbhvrirul5zJI7pOzjwbUAcleMOwvwtO4oLZOXACH3DadgMhEFaARr",
+	"RF1i5tysk/WBqSz5RYeO/2K5FAXFDXK/A5oaQKf1ylEzNuA5pnfIFk52aBQEaZVqgQ9aLdiG7jQwurTG",
+	"izzN3X2I1/gmyWRvFfcB3yxqEcwIY0KeSVKDOhfLkZF59xHrtze2D/HG5pNQb+9tt5rw7b3t9t52S623",
+	"97bbe9vtve323vaW7m0G8sp5lV40cvrZ4OLRv4e91XsWloQbDSV2rV9C8lYtaClWMc7+IRbPVPFKWGYa",
+	"QT1s4LWHMCK7KHljhWa+NGNM+3/08BuK3zFWNIks6kYw7eB7vez+kcsqoysiVCrxlytwQRvGmZH1qkqq",
+	"Q7rnsxB0LK1hD4jI54+x4AhbC44hviAlXla8rYv1y3DPtPyVw5EHMT5SoQojDvvSdBX7l4zrVUs152Qd",
+	"iQIuOYzToLCrgJOMsKOoYdUAIZheyS66BG44NhmiEA2pfWnYFO8v6bf5hjfmJQ3CbXK9tKJpXGOIK65L",
+	"1wuheAV/zNhCC/4K67BisLsbv5K1SIrDGAt3P/jLFBojtU2lbIDYJ0LRQhDueHGNYdXDiq3jt1okQ0eF",
+	"/fSEz+5+NqT1Z1fS1fTwNGt6dH5bL/Pt1vLaWYfzuPrJQBSdk+q0PZ5VS+MRyTO1m8SVBg7sTTaxhNZZ",
+	"KSqRq/D3UJqCa8fD+jaf9KxZwRatrDA8HxMUwtuZ+pEPcTZ/bp7RaFO6z9ZJwcEhPOf5xrP4z66+s1mL",
+	"zcAq8ec7DUMrHx6JJd41PtR65kh6eXo+8HBdicUaEp9iup//5c7vSRYgXcLwLYBEFK2WdosEzhv5r1cC",
+	"/v8rEJ0R+tLTfqurs3tna2ube3fuYPjaWhl75+z1LH1meg9/DQv43VN/o+Ullh/89fX/NwBZkd8edGMD",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -21,12 +21,30 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the agreement attempts of the accounts of this node in the current round
+	// (GET /v2/agreement/accounts)
+	GetAgreementAccounts(ctx echo.Context) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
 	// Add a participation key to the node
 	// (POST /v2/participation)
 	AddParticipationKey(ctx echo.Context) error
+	// Get the automatic renewal of participation keys
+	// (GET /v2/participation/autorenew)
+	GetParticipationKeyAutoRenewal(ctx echo.Context) error
+	// Configure the automatic renewal of participation keys
+	// (POST /v2/participation/autorenew)
+	SetParticipationKeyAutoRenewal(ctx echo.Context, params SetParticipationKeyAutoRenewalParams) error
+	// Import a sealed participation key
+	// (POST /v2/participation/import)
+	ImportParticipationKey(ctx echo.Context) error
+	// Get the participation metrics of the accounts of this node
+	// (GET /v2/participation/metrics)
+	GetParticipationMetrics(ctx echo.Context) error
+	// Get the public key used to import participation keys
+	// (GET /v2/participation/transport-key)
+	GetParticipationTransportKey(ctx echo.Context) error
 	// Delete a given participation key by ID
 	// (DELETE /v2/participation/{participation-id})
 	DeleteParticipationKeyByID(ctx echo.Context, participationId string) error
@@ -36,6 +54,12 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Prove a challenge with a participation key
+	// (GET /v2/participation/{participation-id}/challenge)
+	ProveParticipationChallenge(ctx echo.Context, participationId string, params ProveParticipationChallengeParams) error
+	// Export a participation key sealed to another node
+	// (GET /v2/participation/{participation-id}/export)
+	ExportParticipationKeyByID(ctx echo.Context, participationId string, params ExportParticipationKeyByIDParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -43,6 +67,17 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAgreementAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementAccounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementAccounts(ctx)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 	var err error
@@ -65,6 +100,84 @@ func (w *ServerInterfaceWrapper) AddParticipationKey(ctx echo.Context) error {
 	return err
 }
 
+// GetParticipationKeyAutoRenewal converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationKeyAutoRenewal(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationKeyAutoRenewal(ctx)
+	return err
+}
+
+// SetParticipationKeyAutoRenewal converts echo context to params.
+func (w *ServerInterfaceWrapper) SetParticipationKeyAutoRenewal(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetParticipationKeyAutoRenewalParams
+	// ------------- Optional query parameter "enabled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enabled", ctx.QueryParams(), &params.Enabled)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enabled: %s", err))
+	}
+
+	// ------------- Optional query parameter "lead-rounds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lead-rounds", ctx.QueryParams(), &params.LeadRounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lead-rounds: %s", err))
+	}
+
+	// ------------- Optional query parameter "validity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "validity", ctx.QueryParams(), &params.Validity)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter validity: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetParticipationKeyAutoRenewal(ctx, params)
+	return err
+}
+
+// ImportParticipationKey converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportParticipationKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportParticipationKey(ctx)
+	return err
+}
+
+// GetParticipationMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationMetrics(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationMetrics(ctx)
+	return err
+}
+
+// GetParticipationTransportKey converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationTransportKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationTransportKey(ctx)
+	return err
+}
+
 // DeleteParticipationKeyByID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteParticipationKeyByID(ctx echo.Context) error {
 	var err error
@@ -119,6 +232,67 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// ProveParticipationChallenge converts echo context to params.
+func (w *ServerInterfaceWrapper) ProveParticipationChallenge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "participation-id", runtime.ParamLocationPath, ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProveParticipationChallengeParams
+	// ------------- Required query parameter "challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "challenge", ctx.QueryParams(), &params.Challenge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter challenge: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ProveParticipationChallenge(ctx, participationId, params)
+	return err
+}
+
+// ExportParticipationKeyByID converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportParticipationKeyByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "participation-id", runtime.ParamLocationPath, ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportParticipationKeyByIDParams
+	// ------------- Required query parameter "recipient" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "recipient", ctx.QueryParams(), &params.Recipient)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter recipient: %s", err))
+	}
+
+	// ------------- Optional query parameter "keep" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keep", ctx.QueryParams(), &params.Keep)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keep: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportParticipationKeyByID(ctx, participationId, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -147,219 +321,443 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/agreement/accounts", wrapper.GetAgreementAccounts, m...)
 	router.GET(baseURL+"/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST(baseURL+"/v2/participation", wrapper.AddParticipationKey, m...)
+	router.GET(baseURL+"/v2/participation/autorenew", wrapper.GetParticipationKeyAutoRenewal, m...)
+	router.POST(baseURL+"/v2/participation/autorenew", wrapper.SetParticipationKeyAutoRenewal, m...)
+	router.POST(baseURL+"/v2/participation/import", wrapper.ImportParticipationKey, m...)
+	router.GET(baseURL+"/v2/participation/metrics", wrapper.GetParticipationMetrics, m...)
+	router.GET(baseURL+"/v2/participation/transport-key", wrapper.GetParticipationTransportKey, m...)
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET(baseURL+"/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST(baseURL+"/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.GET(baseURL+"/v2/participation/:participation-id/challenge", wrapper.ProveParticipationChallenge, m...)
+	router.GET(baseURL+"/v2/participation/:participation-id/export", wrapper.ExportParticipationKeyByID, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/ZPbNrLgv4LSe1WOfeKMv5K38dXWu4mdZOfiJC7PJHvv2b4EIlsSdiiAC4AzUnz+",
-	"36/QDZAgCUqcj9ibqvxkj0gCjUaj0d/9fparTaUkSGtmz97PKq75Bixo/IvnuaqlzUTh/irA5FpUVig5",
-	"exaeMWO1kKvZfCbcrxW369l8JvkG2nfc9/OZhn/WQkMxe2Z1DfOZydew4W5gu6vc281I22ylMj/ECQ1x",
-	"+mL2Yc8DXhQajBlC+aMsd0zIvKwLYFZzaXjuHhl2Jeya2bUwzH/MhGRKAlNLZtedl9lSQFmYo7DIf9ag",
-	"d9Eq/eTjS/rQgphpVcIQzudqsxASAlTQANVsCLOKFbDEl9bcMjeDgzW8aBUzwHW+ZkulD4BKQMTwgqw3",
-	"s2dvZgZkARp3Kwdxif9daoDfILNcr8DO3s1Ti1ta0JkVm8TSTj32NZi6tIbhu7jGlbgEydxXR+z72li2",
-	"AMYle/3Nc/bkyZMv3UI23FooPJGNrqqdPV4TfT57Niu4hfB4SGu8XCnNZZE177/+5jnOf+YXOPUtbgyk",
-	"D8uJe8JOX4wtIHyYICEhLaxwHzrU775IHIr25wUslYaJe0Iv3+mmxPN/0l3Juc3XlRLSJvaF4VNGj5M8",
-	"LPp8Hw9rAOi8XzlMaTfom4fZl+/eP5o/evjh396cZP/t//z8yYeJy3/ejHsAA8kX81prkPkuW2ngeFrW",
-	"XA7x8drTg1mruizYml/i5vMNsnr/LXPfEuu85GXt6ETkWp2UK2UY92RUwJLXpWVhYlbL0rEpN5qndiYM",
-	"q7S6FAUUc8d9r9YiX7OcGxoC32NXoiwdDdYGijFaS69uz2H6EKPEwXUjfOCC/nWR0a7rACZgi9wgy0tl",
-	"ILPqwPUUbhwuCxZfKO1dZa53WbHzNTCc3D2gyxZxJx1Nl+WOWdzXgnHDOAtX05yJJdupml3h5pTiAr/3",
-	"q3FY2zCHNNyczj3qDu8Y+gbISCBvoVQJXCLywrkbokwuxarWYNjVGuza33kaTKWkAaYW/4Dcum3/32c/",
-	"/sCUZt+DMXwFr3h+wUDmqoDiiJ0umVQ2Ig1PS4hD9+XYOjxcqUv+H0Y5mtiYVcXzi/SNXoqNSKzqe74V",
-	"m3rDZL1ZgHZbGq4Qq5gGW2s5BhCNeIAUN3w7nPRc1zLH/W+n7chyjtqEqUq+Q4Rt+PavD+ceHMN4WbIK",
-	"ZCHkitmtHJXj3NyHwcu0qmUxQcyxbk+ji9VUkIulgII1o+yBxE9zCB4hrwdPK3xF4IRBRsFpZjkAjoRt",
-	"gmbc6XZPWMVXEJHMEfvJMzd8atUFyIbQ2WKHjyoNl0LVpvloBEacer8ELpWFrNKwFAkaO/PocAyG3vEc",
-	"eONloFxJy4WEwjFnBFpZIGY1ClM04X59Z3iLL7iBL56O3fHt04m7v1T9Xd+745N2G1/K6Egmrk731B/Y",
-	"tGTV+X6CfhjPbcQqo58HGylW5+62WYoSb6J/uP0LaKgNMoEOIsLdZMRKcltrePZWPnB/sYydWS4Lrgv3",
-	"y4Z++r4urTgTK/dTST+9VCuRn4nVCDIbWJMKF362oX/ceGl2bLdJveKlUhd1FS8o7yiuix07fTG2yTTm",
-	"dQnzpNF2Y8XjfBuUket+YbfNRo4AOYq7irsXL2CnwUHL8yX+s10iPfGl/s39U1Wl+9pWyxRqHR37KxnN",
-	"B96scFJVpci5Q+Jr/9g9dUwASJHg7RvHeKE+ex+BWGlVgbaCBuVVlZUq52VmLLc40r9rWM6ezf7tuLW/",
-	"HNPn5jia/KX76gw/ciIriUEZr6prjPHKiT5mD7NwDBofIZsgtodCk5C0iY6UhGPBJVxyaY9alaXDD5oD",
-	"/MbP1OKbpB3Cd08FG0U4oxcXYEgCphfvGRahniFaGaIVBdJVqRbND5+dVFWLQXx+UlWED5QeQaBgBlth",
-	"rLmPy+ftSYrnOX1xxL6Nx0ZRXMly5y4HEjXc3bD0t5a/xRrbkl9DO+I9w3A7lT5yWxPQ4MT8u6A4VCvW",
-	"qnRSz0FacS//zb8bk5n7fdLHfwwSi3E7TlyoaHnMkY6Dv0TKzWc9yhkSjjf3HLGT/rc3Ixs3SppgbkQr",
-	"e/eTxt2DxwaFV5pXBKB/QnepkKik0UsE6y256URGl4Q5OsMRrSFUNz5rB89DEhIkhR4MX5Uqv/gbN+s7",
-	"OPOLMNbw+OE0bA28AM3W3KyPZikpIz5e7WhTjph7ERV8toimOmqWeFfLO7C0glseLc3DmxZLCPX4HTI9",
-	"0And5Uf8Dy+Ze+zOtmP9NOwRO0cGZug4eydD4bR9UhBoJvcCWiEU25CCz5zWfS0on7eTp/dp0h59TTYF",
-	"v0N+Ec0OnW9FYe5qm3Cwsb2KBdTTF6TRWdiYhNbWrIprzXfptdNcUxBwripWwiWUfRCIZeFohBC1vXO+",
-	"8JXapmD6Sm0HPEFt4U52wo2DcnXA7gH4XnjIlD6MeRx7CtLdAp0sb5A9yFgEcrO01uqThdI3Y8c9PitZ",
-	"a4Nn3I0a3UbzHpLw1brK/NlM2PHohd5ArdtzPxftD5/CWAcLZ5b/DlgwbtS7wEJ3oLvGgtpUooQ7IP11",
-	"8hZccANPHrOzv518/ujxL48//8KRZKXVSvMNW+wsGPaZV1aZsbsS7g9XhupiXdr06F88DZbb7ripcYyq",
-	"dQ4bXg2HIoswyYT0GnPvDbHWRTOuugFwEkcEd7UR2hk5OxxoL4RxIudmcSebMYawop2lYB6SAg4S03WX",
-	"106zi5eod7q+C90etFY6eXVVWlmVqzK7BG2ESriXXvk3mH8jyPtV/3eCll1xw9zcaAuvJUpYCcqyWzmd",
-	"79PQ51vZ4mYv56f1Jlbn552yL13kB9OqYRXozG4lK2BRrzqq4VKrDeOswA/xjv4WLMktYgNnlm+qH5fL",
-	"u9GdFQ6U0GHFBoybidEbTmowkCtJoSEH1FU/6hT09BETbJZ2HACPkbOdzNHwehfHdlyT3wiJXiCzk3mk",
-	"1jsYSyhWHbK8vfo+hg6a6p5JgOPQ8RIfo+XnBZSWf6P0eSv2fatVXd25kNefc+pyuF+Mty0V7ttgVBBy",
-	"VXbDkVYO9qPUGj/Jgp6H4+vXgNAjRb4Uq7WN9KxXWqnl3cOYmiUFKD4gLbV03wx11R9U4ZiJrc0diGDt",
-	"YC2Hc3Qb8zW+ULVlnElVAG5+bdLC2UgAC3rO0eFvY3nPrknxXICjrpzXbrV1xdCdPbgv2g8zntMJzRA1",
-	"ZsSZ13hh6S2ajoIjSg282LEFgGRq4T1m3peHi+Toi7dBvPGiYYJfdOCqtMrBGCgyb6k7CFp4j64OuwdP",
-	"CDgC3MzCjGJLrm8N7MXlQTgvYJdh5Ihhn333s7n/CeC1yvLyAGLxnRR6G7uHd4sOoZ42/T6C608ekx3X",
-	"wMK9wqxCabYEC2MovBZORvevD9FgF2+PlkvQ6KD8XSk+THI7AmpA/Z3p/bbQ1tVIPKRXb52E5zZMcqmC",
-	"YJUarOTGZofYsnupo4O7FUScMMWJceARweslN5ac6kIWaAuk6wTnISHMTTEO8Kga4kb+OWggw7Fzdw9K",
-	"U5tGHTF1VSltoUitQcJ2z1w/wLaZSy2jsRudxypWGzg08hiWovE9smglhCBuG9+TjzoZLg49NO6e3yVR",
-	"2QGiRcQ+QM7CWxF245iwEUCEaRFNhCNMj3KaQLT5zFhVVY5b2KyWzXdjaDqjt0/sT+27Q+Litr23CwUG",
-	"Q9H8+x7yK8IsRQOuuWEeDrbhF072QDMIef+HMLvDmBkhc8j2UT6qeO6t+AgcPKR1tdK8gKyAku+Gg/5E",
-	"jxk93jcA7nir7ioLGYV1pTe9peQQRbNnaIXjmZTwyPAJy90RdKpASyD+6wMjF4Bjp5iTp6N7zVA4V3KL",
-	"wni4bNrqxIh4G14q63bc0wOC7Dn6FIBH8NAMfXNU4MdZq3v2p/gvMH6CRo64/iQ7MGNLaMe/1gJGbKg+",
-	"Yj46Lz323uPASbY5ysYO8JGxIzti0H3FtRW5qFDX+Q52d6769SdI+l1ZAZaLEgoWPSA1sIq/ZxSQ1B/z",
-	"ZqrgJNvbEPyB8S2xnFIYFHm6wF/ADnXuVxTpGpk67kKXTYzq7icuGQIa4uecCB6/Alue23LnBDW7hh27",
-	"Ag3M1IuNsJYi2LuqrlVVFg+Q9GvsmdF7NZM+xb1u1jMcKlrecCvmM9IJ9sN33lMMOujwukClVDnBQjZA",
-	"RhKCSQEwrFJu14UPpg/h1IGSOkB6po0u7eb6v2c6aMYVsP9SNcu5RJWrttDINEqjoIACpJvBiWDNnD7U",
-	"pcUQlLAB0iTxyYMH/YU/eOD3XBi2hKuQgeJe7KPjwQO047xSxnYO1x3YQ91xO01cH+jwcRef10L6POVw",
-	"qIUfecpOvuoN3niJ3JkyxhOuW/6tGUDvZG6nrD2mkWlhJjjuJF9Ox2U/XDfu+5nY1CW3d+G1gkteZuoS",
-	"tBYFHOTkfmKh5NeXvPyx+QyzayB3NJpDlmNOyMSx4Nx9Q2kkh3TDNrxObDZQCG6h3LFKQw6U9uBEPtPA",
-	"eMQoIDJfc7lCSV+reuUj8mgc5NS1IZuKruVgiKQ0ZLcyQ+t0inP7KOyQ+eLkIOBOF+ubtknzuOLNfD7Z",
-	"acqVGiGvb+pPerfms1FV1SH1slVVCTnd9J0JXLwjqEX4aSee6ANB1DmhZYiveFvcKXCb+/vY2tuhU1AO",
-	"J45iBNuHY2GCTk8ud3cgrdBATEOlweDdEtuXDD1VyzhVz18+ZmcsbIYmePr0l5Hj93pU0VOyFBKyjZKw",
-	"S2anCwnf48PkccL7beRjlDTGvu0rDx34e2B155lCjbfFL+52/4T2XU3mG6XvypdJA06Wyye4Dg/6yf2U",
-	"N3Vw8rJM+AR9Ik+fAZh5UzhAaMaNUblAYeu0MHM6aN6N6LN+uuh/1YQn38HZ64/bc37FOaJo3IWyYpzl",
-	"pUDTr5LG6jq3byVH41K01ETUUtCix82Nz8Mraftmwvzoh3orOUasNSanZKTFEhL2lW8AgtXR1KsVGNtT",
-	"UpYAb6V/S0hWS2Fxro07Lhmdlwo0hg4d0ZsbvmNLRxNWsd9AK7aobVdsxzw1Y0VZek+cm4ap5VvJLSuB",
-	"G8u+F/J8i8MFb304shLsldIXDRbSt/sKJBhhsnR01bf0FCOB/fLXPioY6wrQ4xBl2SbOztwyO7ny//ez",
-	"/3z25iT7b5799jD78n8cv3v/9MP9B4MfH3/461//X/enJx/+ev8//z21UwH2VBaVh/z0hVdpT1+g3tI6",
-	"bwawfzTD/UbILElkcRhGj7bYZ5gx7AnofteqZdfwVtqtdIR0yUtRON5yE3Lo3zCDs0ino0c1nY3oWbHC",
-	"Wq+pDdyCy7AEk+mxxhtLUcOAxHS+InoTfQoinpdlLWkrg/RN6TghMEwt501OKpWrecYwYXHNQ1Sj//Px",
-	"51/M5m2iYfN8Np/5p+8SlCyKbSqdtIBtSsnzBwQPxj3DKr4zYNPcA2FPxsBRUEY87AY2C9BmLaqPzymM",
-	"FYs0hwtJDt5YtJWnkiLa3flB3+TOuzzU8uPDbTVAAZVdp8pYdAQ1fKvdTYBevEil1SXIORNHcNQ31hRO",
-	"X/TReCXwJZZTQO1TTdGGmnNAhBaoIsJ6vJBJFpEU/fTi+f3lb+5cHfIDp+Dqz9k4IsPfVrF73359zo49",
-	"wzT3KLOZho5yUROqtE+36kQSOW5GxXtIyHsr38oXsBRSuOfP3sqCW3684Ebk5rg2oL/iJZc5HK0UexYy",
-	"uF5wy9/KgaQ1Wl8ryp1jVb0oRc4uYoWkJU+qmTIc4e3bN7xcqbdv3w2CKobqg58qyV9ogswJwqq2ma/4",
-	"kGm44jrltDJNxj+OTCVd9s1KQraqybIZKkr48dM8j1eV6Wf+DpdfVaVbfkSGxue1ui1jxiodZBEnoBA0",
-	"uL8/KH8xaH4V7Cq1AcN+3fDqjZD2Hcve1g8fPgHWSYX91V/5jiZ3FUy2roxmJveNKrhwUithazXPKr5K",
-	"+cbevn1jgVe4+ygvb9DGUZYMP+uk4IaIehyqXUDAx/gGEBzXTifExZ3RV6G6V3oJ+Ai3EN9x4kbrsb/p",
-	"fkVJuTferl5i72CXarvO3NlOrso4Eg870xT9WTkhK4RRGLFCbdXXR1oAy9eQX/jCNbCp7G7e+TxE6nhB",
-	"M7AOYaikEaXUYVEN9CwsgNVVwb0ozuWuX93AgLUhHvg1XMDuXLU1Oa5TzqCbXW/GDipSaiRdOmKNj60f",
-	"o7/5PhwMFfuqCknqmK0YyOJZQxfhm/GDTCLvHRziFFF0sr/HEMF1AhFE/CMouMFC3Xi3Iv3U8pyWsaCb",
-	"L1HeKPB+5l9plScfuRWvBq3u9HwDWB9NXRm24E5uV760F2WQR1ysNnwFIxJy7NyZmKfdcQjhIIfuveRN",
-	"p5b9C21w3yRBppczt+YkpYB74kgFlZlevF6YifyH3jOBFTs9whYliklNYCMxHa47TjYqQTgGWpqAQctW",
-	"4AhgdDESSzZrbkLVMSzOFs7yJBngd6yIsK8OzmkUahZVYGuq3ASe2z+nA+3SV8MJJXBC3ZtYtZxQw8ZJ",
-	"+BjdntoOJVEAKqCEFS2cXg6E0lZnaDfIwfHjclkKCSxLRa1FZtDomvFzgJOPHzBGFng2eYQUGUdgo18c",
-	"B2Y/qPhsytV1gJS+ugQPY6NHPfob0nlfFMftRB5VORYuRrxaeeAA3Ic6NvdXL+AWh2FCzpljc5e8dGzO",
-	"a3ztIINyLCi29oqv+MiM+2Pi7B4HCF0s11oTXUU3WU0sMwWg0wLdHogXaptR4mdS4l1sF47ek6HtmIaa",
-	"OphU+OaeYQu1xWgfvFoolPoALONwBDAiDX8rDNIrfjd2mxMw+6bdL02lqNAgyXhzXkMuY+LElKlHJJgx",
-	"cvksqmVzIwB6xo62MLRXfg8qqV3xZHiZt7favK3RFrKGUsd/7Agld2kEf0MrTFN95lVfYknaKbpBK93C",
-	"O5EImSJ6xyaGTpqhK8hACagUZB0hKrtIeU6dbgN445yFzyLjBZb34XJ3P4qE0rASxkJrRA9xEp/CPMmx",
-	"qqBSy/HV2Uov3fpeK9VcU+RGxA87y/zoK8BQ4qXQxmbogUguwb30jUGl+hv3alpW6sZaUQ1eUaR5A057",
-	"AbusEGWdplc/73cv3LQ/NCzR1Avkt0JSwMoCa0YnIzD3TE1BunsX/JIW/JLf2XqnnQb3qptYO3LpzvEH",
-	"ORc9zruPHSQIMEUcw10bRekeBhllzg65YyQ3RT7+o33W18FhKsLYB6N2Qv7u2B1FIyXXEhkM9q5CoJvI",
-	"iSXCRiWXhymtI2eAV5Uotj1bKI06qjHzaxk8QqG6HhZwd/1gBzCAIu1rWIKGpAmheUTR0Y24FBcqxMzu",
-	"TimcxKaPGv+7prRwUTadI6KJbmAE86Ulx/e4jb3slF7sLiXRu2A4ay2k/eLpkCIbG7+DZcpunKVN62dO",
-	"0egiPlK3qJT5gU0QI4p7TJ4Re46nEiY04hiSbZMDeYhyz4GX38HuZ/cuLmf2YT67nSE7Rfl+xAO4ftUc",
-	"tiSeMVCCDJsdv9Q1Uc6rSqtLXmbe3D/GKLS69IwCXw/egY988aQp+/zrk5evPPgf5rO8BK6zRnAbXRW+",
-	"V/1hVkXFKEcOSCj07zTwoEGRYB9tflNBL3YRXK3BV0yPdINBadfW/RMdRe8yWKbjtQ7yPu+poiXu8VhB",
-	"1TisWmMq+au6Pip+yUUZrJgB2pHYKlzctPrASa4QD3BrX1fksszulN0MTnf6dLTUdYAn4Vw/YkmktHQi",
-	"fcEkZEXed9VlQfeMp6xjXPXxQm3b23PinfyN0h3m7wPrk76vcGH3GeOd3N0ejyOhRqELR1/wPGJIS+zX",
-	"1a/uND54EB+1Bw/m7NfSP4gAxN8X/nc0Fj14kDRLJrUOxyRQqZB8A/ebIMHRjfi4KqqEq2kX9MnlBlGH",
-	"sd7jZNhQKDmxArqvPPautPD4LPwvBZTgfjqcQNPbdEJ3DMyUE3Q2FkjfxEhsqPGHYUr2Q4Iwh8ORFjL7",
-	"DcfSxmTlHR4hWW/QMpqZUuRpn5FcGMdeJcUCuJcZvjyiXLsRazESWiJrEY3lXptSq6sHZDRHEpkmWS6s",
-	"xd1C+eNdS/HPGpgoQFr3SOO91rvqgnKAow4EUqcLDefyA5PHsR3+NjpTXNa7LzMiEPsVpjjyYADui8YE",
-	"GBbaWNhbnem6AUzxjAPGvSf4yNOHp2YKxl53Iwim6TFTGsAFRufri4/MkWzoJky21Oo3SNut0NyXSMAM",
-	"hcwFRu39BrF6Frcx6rCUxlrd9qVrZz+03dN147GNv7UuHBbd1E6/yWWaPtXX28ibKL0mXSbQI3lMCYtd",
-	"F93IthHWgscriuXAstXBrcklnSfKPuwESKdPZZyKcEzjt6fSwzxI3yj51YKnano7XcjBFG1vxwFrFQsf",
-	"hw0wTYoezc6iAKTmXUEVTCrQbQL6sBraDfUamnayRtMqMEhRseoyp6CR0qjEMLW84pJ6obnviF/5rw2Q",
-	"x8R9daU01h8yaV9xAbnY8DKt4BT50C9YiJWgNl+1gaiPlB+IWigSFfleXE3iqUfN6ZI9nEfN7PxuFOJS",
-	"GLEoAd94RG8suMHrsvFeNJ+45YG0a4OvP57w+rqWhYbCrg0h1ijW6J4o5DURDwuwVwCSPcT3Hn3JPsNY",
-	"DyMu4b7DoheCZs8efYmeOvrjYeqW9W3a9rHsAnn23z3PTtMxBrvQGI5J+lGPkqVaqE/r+O2w5zTRp1PO",
-	"Er7pL5TDZ2nDJV9BOrxwcwAm+hZ3E70vPbzIgpoMGqvVjgmbnh8sd/xpJGXJsT8Cg+VqsxF24yMCjNo4",
-	"emqbRNGkYTjqWOjr+we4wkMMrKlCXEHP1vWR1Ri+GQk5xvCnH/gGumidM05Fp0rRhryFriPsNNS0w4YH",
-	"TZ8Dwo2byy0dZUmMgFuySgtp0f5R22X2F6cWa5479nc0Bm62+OJponFAt7a2vB7gHx3vGgzoyzTq9QjZ",
-	"B5nFf8s+k0pmG8dRivttimB0KkcjgNKxHmMBJ/uHnir5ulGyUXKrO+TGI059K8KTewa8JSk267kWPV57",
-	"ZR+dMmudJg9eux366fVLL2VslE4Vqm2Pu5c4NFgt4BIDvtOb5Ma85V7octIu3Ab6T+uuDiJnJJaFs5xU",
-	"BILRaV+ilxPhf/7eNyUeyN4jwWkUfdZ885ET2JJGS5LQOmazR78y7TRJlEYfPECgHzyYe2Hu18fdx8Sk",
-	"HjxIl29LGo7cry0WbqPX4bepPfxKJcw4oVdK40L3SWoJM9oYq3UP3FFe+KHmrNuX4uPfhXcT/pwOcUmf",
-	"grdv3+CTgAf8o4+IT3zkcQPbID5ayQihRH15kiRTNM+j4DrOvlLbqYTT46SBeP4FUDSCkolGJlzJoO9Q",
-	"0ul8MOoholE36gJK5VSluKR6bJX+4+DZLX6+B9u1KIuf2wIbvYtEc5mvk6FJC/fhL21/4GaJxCqTVZrX",
-	"XEook8ORhvZL0OQSuuY/1NR5NkJOfLff94qW21tcC3gXzABUmNChV9jSTRBjtVu7oMmNK1eqYDhPWxK4",
-	"ZY7DBnJRV5t/1mBs6mjgA4rPR5eNY77UVIWBLNCGc8S+xSxiB0un3iPaTkJBrm5xmroqFS/mWCjs/OuT",
-	"l4xmpW+oyyU1dVmh6aC7iqStd3qxnqZhZToLdfo4+9Pi3KqNzZoeLKk6H+6NtkuM6AUAoFEhxs4RexE1",
-	"86eSIG4IhnXi9AaKqOULaRRIE+4/1vJ8jYaSzkU2TvLTuxEFqjRRS/SmtWlTAhzPnYPbNySifkRzpuwa",
-	"9JUwgHlHcAnd0iJNnR1vqAulRrrL07WURClH15ApmoLf10V7AI4EkuDhTELWQ/w11WRq5nXd5kxn+FWy",
-	"Imm/09OgFzoVqmhaVn4futlzqaTIsR5oSiDCMgjTfCYTSqemnR1m5k9o4nAl+0s1GQ8ei6MdpwIj9Igb",
-	"+h+jp25TiTroTwtb33dgBdZ4zgbFPLRJ89Z5IQ34ku6OiGI+qXQiwiIlcmSNN/eaZIQZziPmlm/csx+8",
-	"MQ5T/y6ERLXbo82L2WQ/xw721unqwrKVAuPX0y3zYt64b46w4kkB23dHoeM9jkExPW7ZFMA2HOokhLP5",
-	"8DH37nP3rq9D2fzciU2hSU+qyk863kQv3Tl0K0cRnAqiCF7tCLnN+PFoe8htbxwq3qeO0OASQ2igwnt4",
-	"QBhNQ7le91anIhBF4RuMovGTxaiETIDxUsjgz0lfEHnySsCNwfM68p3JNbckAk7iaefAyyZmps/QjPUO",
-	"wdsO1a/C6VCCawxzjG9j2wtvhHE0L7SCG5c7Fg6Fo+5ImHjOyyaOM9HZDqUqL0QVmBza63WXYhyOcYdu",
-	"mt0L4EAD3Xn7OZakve5NNFbvY1EXK7AZL4pUhf2v8CnDp6yoUXKALeR1U4m9qliO5e269f6G1OYnypU0",
-	"9WbPXOGFW04XNY9MUEPcwDLsMOYTL3b473VaGzcRnNfO6AjhmsX1ilwOM1RSUq+j6cyIVTYdE3in3B4d",
-	"7dQ3I/T2+zul9FKtuoB8CiPpCJeL9yjF3752F0dcBGsQLEtXS1OjCgNTVeiBjmpjU12ly5XwKhsU20cX",
-	"bNNSeL8ZYrw58Bwvv5EsqtjkTfcrmYHHcqny0dQ/bn0RAsvZXhY0mthNgYs9I/rQnzEWrEixindnfPZr",
-	"3YvQEEc+BOi7kKTCKi58wErLLIaY9WG+w3TPKXG07Qb3F+FT9kbto99djqXXhZq3+LzfPPQCfGWiSsOl",
-	"UHUIBQkBmUElpF87rTibBMfk+pNhzp/a+DxqKj/3TZxomV4n/+5nCt9lIK3e/QsYzgebPmhLOpR2yTzV",
-	"vsKa/h+T+oF0bsUp9aBTpYe9bNhpjHqgreuArF5MEQeGbVrns9PiWhdmqnz1jEZJHbt009Xx6p5tRU88",
-	"YpUyom3Dk+rGOjHy+RwbqkbVSYdjhYi4S8gt9l5qI300wHVqlbrJov7uf1b5HFGnmwBxX9xzX0XPYcOl",
-	"A3f8IOk+KhxBzWqOptevPGniOSkd5YobrPZMLda7CZyT08iWS8ituDxQ5ODva5BRAv082GUQlmVU80A0",
-	"SRVYI+/6VscWoH01CPbCE9WqvjU4Y0m1F7C7Z1iHGpLdc5qMopuUR0MMIHfIHIkok4qXIkOyD2ERpqEM",
-	"xEKIT6TPoS00O9p4MyrZccO5Akm6i6Mt47FnynTnv0lzuU+vVdwG8wPG6iAMG4eN6x8vsE+baZpih/Jq",
-	"sZbOTodFqK98eTYsSdH4TkKhNjDht1B/hmYpxQXErUHRU3XFdRHeSJpeglUn23MfDYoXhKZXfaCXzcyi",
-	"jSYf+qoTZU0xMSMvlRMjsrHslm4AdxP9dM9QmBp12cHQdAfXErRvoYzyb6kMZFaF6PN9cOxDBcXi3QgJ",
-	"ZrSUOAE3WuDvdVvBEFsqcCzox30IXrxApmHDHXQ6qjM4Puc+ZD+n5yEjOJTUP2hhauj1cG+nkEcgzACJ",
-	"MdUvmb8tD2ca38TYJKQEnQXPU7/ooATd9YZUWhV1Thd0fDAag9zkkp57WEnSTpMPV9nTEaKM3QvYHZMS",
-	"FJpihR2MgSbJiUCPilX1NvlOzW8mBffqTsD7lJar+axSqsxGnB2nw0qJfYq/EPkFFMzdFCHedqRRIfsM",
-	"beyNN/tqvQuVAasKJBT3jxg7kZThEBzb3VYdvcnlPbtv/i3OWtRUvNQb1Y7eynSoOJYV1bfkZmGY/TzM",
-	"gGN1t5yKBjlQh287UqVR86tE286jqVr50NXcb6XYEhVBkZJJzshj9RwPespwhPnYUeEAdGRy5j1dzJQq",
-	"FZJ5k5xxN1QaU/FkCJAFOSV1uYHCD55EQNMm8UCgUBMj1HaYa+OEhuJRWaqrDI9R1tSZTSld7j3TvSZC",
-	"af32O0dvC4gijrjxIsSOrXnBcqU15PEX6bQogmqjNGSlwgCklG90aZ1EuMFcCMlKtWKqcoo+1WsOXqRk",
-	"/8PBXLWUHC90iOI9kijgeY7ap2L+G9Z8M3XKu2ovScVPaNEZedlGQiLB+GInHkP08hDePR0er9898nyd",
-	"MJYh5gKBXLtFpCfya3d2i8CccLgOGwpPUh0wu+vq92Id64xs1UbkaXT/sUKERgN7UtSbrPpCzRUoTxdf",
-	"Q54S87HGI4ynZ4hmkHxRJu8Hf/y8Zwzp3P0XxYb+uGwJnp+N8NDhkfasP8tHL6geAAgpJY/ZWlNHhvj6",
-	"aPq8qhUlm6Jfrw/oRIaD4RO3g82NcOdAWbgVUIOQrQbAz0hjmlN1Hgr/WqhteH6/Ld9zI+A/7KfyVBfb",
-	"xCluSMs32Q2p/iMcIRlVsj+IgzqbL6aGcjTdcyYy/wiA8eCODgyTQjyuC8aSixKKjCeQfNoo1vNIPfBp",
-	"Af2eaMJ4Tp5zMqytgbmxaw0+9Zxamvd6qFbckZJqXh+av2QBWzCYF06NILkhY20wGvt+6n0NRlVZCZfQ",
-	"iXnx+fA1SiHiEuJe7PQxKwAqdKH0FftUMEd8l/e0Pb/2LAoHmILdpPpHiKWdYgd0u6QmupUZHRMz9Sg5",
-	"iC5FUfMO/swtulKPN6QeiI8ZiYl0IKZM8xON8DoMcBK+T4kyARPvpvGha7OgNOr2MaCDwV14opKnXqZj",
-	"u+JiD41VGGcrGu8RkXjLN0zFr+S4FWVI8q0kPr1bfITYr7eQo1TTDV66PU4YDsZMr5DLqAiumx2+uTXu",
-	"k9DwXhIeHS+lahhABtsqY62tPKyjoYu4ZT12wZJO7HVSM3ae8Pzf8785Nu6lgZwKSI0w4s78LyC4PbC2",
-	"bGPx9QKtaC60EKQ196XF+vqjiMJTN3zHlMZ/pLLsnzUvxXKHJ5TAD58xs+aOhLyfhRyAPujLTbxfMJkH",
-	"wIIKq8JUtG4xdcxouJ0bJQLaXYFMaW+y3/ALiLcBfZvEeXLrWI6pFxthDF52ve0cYsEvPqSHb3gBUS4J",
-	"FqnqdiALZQvd1/+zTX2Jpwq1ZaqS521HYcM3PasitTYKxGXXsNmfGzVUjwMJNO2SWqLVISeyoNIlhL+m",
-	"TgFKIvifhbCa692eSM2D7u9UwDFKzofAHrSRQTH8zpZxnb6GbXrpnqyySUu5612Y6mQfAI2eulDg5wD4",
-	"VJgtFAP6GPhP1o8bW8YU8P9V8D7SfSeGlxrtfAQsd/KmE7CSCXChtpmGpTnkTyYboFOEdZtxHYIIhMw1",
-	"cEMO9tMfvcrWlkcT0qmQFALWuDCaUQpYCtkySyGrbrd7z66xSprcRQiLLamI1hGL+ZiU4MSwS17+eAla",
-	"i2Js49zpoO4fcXnqYD323yaU/+ZOHQ4gTKv9YDoWtOk+0WvuAi/EcgmaorOM5bLguohfF5LloN29z674",
-	"ztzcTO+g1bWTLw4Y6nkkzXSThCOTPZI2AVLuvA/olkb0BkB+h9b0CVZwDANMWMDJKGLViNF7CEM6N51v",
-	"s1KtMElnhAB9HTp0U5CyoiQabEkeut48RvwG+6fBErz+4FuFs06ZYv85+xFRhwrPT1LYvSeNrGn9rCkK",
-	"a6ODEOhfrtrYWtqcIf2nEt3Oqbl+nOzW71Ub9pp87DQfjPTe6VpwR3YRvYw+SzI215rpnoyOIzOVTkc6",
-	"bIa6rdkTPQsm6u6f++iHodFnoBQTUuY+GfGaNiGyJId7YAQ8anDnz1Z32sYj7caZLmtE7tc0RJWqsnxK",
-	"SBVV6S68QdtD2oVxhD4ic/XIuhvvc9tzuVMdolPAniTlm4i7vQL6h/wyVb5PyR4zaIxw0K6xXC2Rl+ER",
-	"JjMOBso3xot5P4Wja7BpmATjTENeazRoXvHd4RYjI9Uhz/528vmjx788/vwL5l5ghViBaSuM9lp0tGE3",
-	"QvbtLB830GawPJvehJDcS4gLnrKQs9Bsij9rxG1JcpPJBiXXsYQmLoBUK+pha4gb7RWO00bO/mttV2qR",
-	"d75jKRT8PnvmwwPTCziRXn9RS7afZ7SOkXDcE/zCCf+JSyps7Q0WOGaPHU8uvQk9tgbZfxkqTGTL3hnt",
-	"Ncv9PSguKWXerOveJNCGmZMJ8kAARlKiOskscVPOtuifJtsuWoGDw6x/iX3fOtIOxu4iJOGDA+DFOU7t",
-	"e024qQfnE1fP+75BSrSUd2OU0Fn+obQpv8DW8xhtkVd1rQVqkUw1gLr7EuXEmedNqtmIbDvISMMOnE6/",
-	"KctEJhtp33imYsJxgqW+5OXH5xrYmvUE8QHF6/H49TidKUYyodLcrJjSSz5p7ih16e6mlq8we+7v4PYo",
-	"ec/5obzTcXCboe2ElxRpuPSZyG5IdoVjUlDJoy/YwpdnrjTkwvSdmeRx8rlYmL0DWix9Khxs7YF0oUPr",
-	"/FnZW5DxMkQesB8ip4RC408LYXtEPzFTGTm5SSpPUd+ALBL4S/GouJ3bgeviopOT38ri0Y2mNNxxbn5U",
-	"ZeeaufnDRnVTl0f55+7SqQ0M1zn5tu7gNnFRt2ubWlhici1lbLA/pR5Euu6x+xwLUtxJAeRrlT/+HUpR",
-	"EI78GH7eFMX8PFackArwjdTB7O1HLcqDYQadqqYf5rMVSDDCYN3OX3y18Y97lwYIKD12eFQJ1tvk9BNi",
-	"EmvtTB5NFdUrnVCq1H+WKEyKqSd5rYXdYae5YIYRvySLZnzbJGD7BP7GA+LvPqsuoOn22aZr1ybcrt8q",
-	"XuJ9RI4Z6W4hVR6xr7d8U5XeqMj+em/xH/DkL0+Lh08e/cfiLw8/f5jD08+/fPiQf/mUP/ryySN4/JfP",
-	"nz6ER8svvlw8Lh4/fbx4+vjpF59/mT95+mjx9Isv/+Oe40MOZAI0lNF9Nvs/2Um5UtnJq9Ps3AHb4oRX",
-	"4jtwe4O68lJhJySH1BxPImy4KGfPwk//K5ywo1xt2uHDrzNf0X+2trYyz46Pr66ujuJPjleYn5lZVefr",
-	"4zAP9qfpyCuvTpuYZIqewB1tbZC4qZ4UTvDZ66/PztnJq9OjlmBmz2YPjx4ePfLNECWvxOzZ7An+hKdn",
-	"jft+7Ilt9uz9h/nseA28xHIG7o8NWC3y8EgDL3b+/+aKr1agjzDsnH66fHwcxIrj9z5P9cO+Z8exY/74",
-	"fSedtzjwJTqVj9+Hlmj73+60w/LxPNEHE6HY99rxAgvIT30VTPTy+FJQ2TDH71FcHv392Ns80g9RbaHz",
-	"cBxy3tNvdrD03m4drAe+2IoiWknObb6uq+P3+B+k3ghoqod2bLfyGP1vx+87a/WPB2vt/t5+Hr9xuVEF",
-	"BODUckmt4vY9Pn5P/0YTwbYCLZxYiDUI/K9UK+YYO4bshj/vZJ78cbiOTp0Md+6SvszXVJyZs1KY4JTu",
-	"ltcwcTfR0wL5s+3X7HAvhYA0POSPHz4MnM3rDRFVHvtDHLUyn5YB3K8UMrzxhqxt38o+zGdPrwnoXttQ",
-	"p75aApiveMFC0h3O/ejjzX0qKTjO8Xq6kxCCpx8Pgs72se9gx35Qln2DytOH+ezzj7kTp9KJcrxk+GbU",
-	"sW14RH6SF1JdyfCmE2bqzYbr3eTjY/nKoPdMi0vuRcnmNbmavcN0aErE7B61k6IYED0JdWDsVwpvxzGM",
-	"bcyq8tVUW6S1Mq2QbglDpXiAqnNqXNgrukOlIYILVqoCZrG0aXUNH27JE3pue67tacLGg8ZKjJddhh6L",
-	"EajJCjJ9pyaNPNRHDpFw2wa0DTP9k6f8yVManvL5wycfb/oz0JciB3YOm0pprkW5Yz/JJn75xjzupCiS",
-	"Zbe6R/8gj5vPtlmuCliBzDwDyxaq2IVWx50JLoDU14Egc/y+86cXX2cUiZEqKeR+Z5ytsKfFcBGLHTt9",
-	"MZBw6LM+5/1qh6+28XizZ2/ek/7nlJtWPeuDOOCM82jP+7zpXZpr7iN7t5CVsk08Ci3qT0b0JyO6lXAz",
-	"+fBMkW+S2gd1muGDO3semsakmvhxOwRlio7ySY/vnWz8UP9J6TtUvgwKFj2gRLA+mv9kEX+yiNuxiG8h",
-	"cRjx1HqmkSC66+lDUxkG5vsWHb84to3Gyj30el1yHcXeHzJznOCI3rjxMbjGx1bqkrginY5LBltBUQ6J",
-	"DbxbPe9Plvcny/vjsLyTw4ymK5jcWjO6gN2GV40+ZNa1LdRV5AVBWChCaWgHdg9r0//7+IoLmy2V9sVw",
-	"+dKCHn5sgZfHvvNV79e22cTgCXbQiH6MKyYkfz3mXcN213/iWO/YhwPnSuqpdy6MvBTSlcLj1tEaOy6R",
-	"7TcuyzfvHMvGTu3+Rmj9cM+OjzF/da2MPZ59mL/v+ejih+8a8njf3COeTD68+/D/AwAA//83sjKmnvkA",
-	"AA==",
+	"H4sIAAAAAAAC/+y9f5fbNrIg+lVwtPtOEq/U7WSSuRO/c8++jp1MeseZ+NhOZvfGeTMQCUm4pgheAOxu",
+	"Jc/f/Z2qAkCQBCiqW3Ey9+Yvu0X8KBQKhUL9/HlRqH2jalFbs3jy86Lhmu+FFRr/4kWh2tquZAl/lcIU",
+	"WjZWqnrxxH9jxmpZbxfLhYRfG253i+Wi5nuxeBL3Xy60+I9WalEunljdiuXCFDux5zCwPTTQOox0t9qq",
+	"lRviioa4frZ4N/GBl6UWxoyh/LauDkzWRdWWglnNa8ML+GTYrbQ7ZnfSMNeZyZqpWjC1YXbXa8w2UlSl",
+	"ufCL/I9W6EO0Sjd5fknvOhBXWlViDOdTtV/LWnioRAAqbAizipVig4123DKYAWD1Da1iRnBd7NhG6SOg",
+	"EhAxvKJu94snPyyMqEuhcbcKIW/wvxstxE9iZbneCrv4cZla3MYKvbJyn1jatcO+FqatrGHYFte4lTei",
+	"ZtDrgn3TGsvWgvGavfzqKfvDH/7wOSxkz60VpSOy7Kq62eM1UffFk0XJrfCfx7TGq63SvC5Xof3Lr57i",
+	"/K/cAue24saI9GG5gi/s+lluAb5jgoRkbcUW96FH/dAjcSi6n9dio7SYuSfU+KybEs//q+5KwW2xa5Ss",
+	"bWJfGH5l9DnJw6LuUzwsANBr3wCmNAz6w+PV5z/+/PHy48fv/tsPV6t/c39+9od3M5f/NIx7BAPJhkWr",
+	"taiLw2qrBcfTsuP1GB8vHT2YnWqrku34DW4+3yOrd30Z9CXWecOrFuhEFlpdVVtlGHdkVIoNbyvL/MSs",
+	"rSthDI7mqJ1JwxqtbmQpyiWTNbvdyWLHCm5oCGzHbmVVAQ22RpQ5WkuvbuIwvYtRAnDdCx+4oN8uMrp1",
+	"HcGEuENusCoqZcTKqiPXk79xeF2y+ELp7ipz2mXFXu8Ew8nhA122iLsaaLqqDszivpaMG8aZv5qWTG7Y",
+	"QbXsFjenkm+xv1sNYG3PAGm4Ob17FA5vDn0jZCSQt1aqErxG5BG4SZTtOTMCJgbQK2msly3cEt1f/+vV",
+	"t39lWphG1YQBLWyr6yUzbbGDJf+D6G2JNGAuPMn844L9VRgYO0YZ34uS9qlUyKaBnZmloyfeNIBOxQQv",
+	"dkxUYi/qABbXmh+AogVgnN8IbcQFu97WSsMkSrNvhDF8K17w4m2AOCsXOcxMi0WebY3RV2/kttXCsNud",
+	"sDsnMgQ0qfW/i8LCqUH0DWATdaFKUV6w6w2rlY1OljuKSILQMws8wZWSkf7dKDhSe7NtePE2LRBVci8T",
+	"q/qG38l9u2d1u18LDXj3N3DY9hxANOKRk7znd+NJX+u2LpAGu2l7ojAcVmmaih8QYXt+96+Plw4cw3hV",
+	"sUbUpay3zN7V2e2GuY+Dt9KqrcsZUqKFPY3kEtOIQm6kKFkYZQISN80xeGR9Gjyd7BqBI+sj4Mh6Hji1",
+	"uEvQDDBH+MIavhURyVyw79zdgF+teivqQOhsfcBPjRY3UrUmdMrAiFNPn9RaWbFqtNjIBI29cugA/kxt",
+	"HMPZOxGyULXlshYlkzUBraxjhFmYogmnn4tjIWjNjfjjp4t3x77O3H3gff1dn9zxWbuNjVZ0JBOSB3x1",
+	"BzYtmPb6z3hex3MbuV3Rz6ONlNvXcFlvZIUX+b/D/nk0tAaZQA8R/mo3cltz22rx5E39CP5iK/bK8rrk",
+	"uoRf9vTTN21l5Su5hZ8q+um52srildxmkBlgTb5Xsdue/oHx0uzY3iWfZc+Vets28YKK3rt/fWDXz3Kb",
+	"TGOeSphXQVkQv9te3/m33Kk97F3YyAyQWdw1HBq+FQctAFpebPCfuw3SE9/on+Cfpqmgt202KdQCHXs5",
+	"AMa+enH9GhiReel+hR/h7At6fsFwsuCA3Uu8R5/8HEHWaNUIbSWNhRwN/yet2ON//rsWm8WTxX+77LRW",
+	"l9TdXPqpF+8CmCjQ0GELp+MHP263GpIlaDUJ3osS1dWLa2KxQWyrVSlgLqeIuupWdoa186ZZVarg1cpY",
+	"bsXRtXdDP4der7ATPHJIcF7xpjlhjBcgLJsJ/gh4wU/IGYnTo5gta6JbOD3SMC0qccNre7FYpthQvCs0",
+	"05xNySOcUcO1MCTPUsMPDItQzxCtDNGKT5htpdbhhw+vmqbDIH6/ahrCB743hERZVNxJY81HuHzeMY94",
+	"nutnF+zP8dj4eFOgkFwLJ13BdbhxF7W7uIM20q2hG/EDw3A7Qb0X0Z0xwp6D4vBVsVMVCHpHaQUaf+3a",
+	"xmQGv8/q/M9BYjFu88QFrZjDHL2K8ZfoOfzhgHLGhOMUhBfsatj3fmQDo6QJ5pncbM5BLzmV++sOOR6q",
+	"i5GOC7Sl+KhdoUg9HuXNmx/gKnzz5kdmleVV9HaJFCxOlgzTWUcyVqXIIcxJz4pzT7rRap+ZtsNrDmFR",
+	"C0fsMZ9SOqaIYsfrLTxm14chx1ksZ16WIx76FAcdX55OrZ2DG785iP0RmIDWk/mpcEK/PIRrdScyAOIn",
+	"Bx8q6Dp4dqIK76SwmaSUi5DqviD4IAqcCvoX6i4POJBMGu6N1MYTlhM4SrnZpOnLqvQgFZ87xoBTdiYt",
+	"hBBnGJ6ewQkOdDIgd787s8UtYd0WAcw8bABbC3srRM3sraI1mYipfVtXsha1MOYXZ230MejMaP4kh1vz",
+	"iteFWB294W53ygjg8bIWcOLfAskKuSVT4I2yIswX3p1jKhCV3Mp1yrr4t0iD5tFJo8q6G/UJk6hQc3Bw",
+	"i5/cIqjJ0mmAWa3q1U9CK4IWL72GaysL2dCxeSsOqBGXZW+OCPKgRAUdqjZ25eGfwld8LDplTMWtMBat",
+	"t0FzPlorim+8PqSRR0NMTe0m6R2nSpRbp1BzL4L06ITSeRszvQNpBDr93Mo95pLwXz/zcLrWsEdMi600",
+	"VtOmOYkCjqco/dXTo/NOI6LVPoHRjvjDONOr7i2OoaLe2HhTHd0ZBZYW+pIEjOwnNGUaSUePIS6/R8Xx",
+	"oEzW6c0F6loRCSPBz2Lo2FLaQ9iT8ekZLPAINQwBqvg0PP274RcGB2gTOUUalpjzzZkHDWR7WWgF4ppZ",
+	"Mi1uuS6N9/QoT7rivLauz64HLCEm6HCeI547WObc266jrj7G+1efrBmnlUdX3r0uuhkCy8R7yE/JbjVv",
+	"6KHhvpAakOB0jeI3x+vI3HCGC9rIuhDHj1mhboQWIxkv1vDJuhR3CWpJq+JaWVvSG0djnKChGiHjqK6K",
+	"VjqYby5xDYw8bbGjB2rEzAulg7VAmk6ntdUCTYMO5LNIVW6o+egaAAEqmdakxOijnN3zFD/iyTue1CQs",
+	"uzXN3ZIAAePWin1jh3zV/e32wstOZNO3EQ/w4xBSzrA9jdBSZZBI3+ZgkS7+RhlemZURok4P2L2rS2ms",
+	"rAvL1pUq3rLQmUHnnOgYzfbLb/1yYaxo0lPAl5loQRn0dNp/ZUXzPXY9xirCPUUb6cAe7YeHZC7FGiSw",
+	"0SLzlNnR3Jc34jysw01yqnSOJ8jJD1bug2iBNpIlA8uPil4LAsFlt0IL550iSu+pE0zKTAJp3oeKaPj5",
+	"FDDAY4rv3fMe7EamNd/zFsxcVf3NCuueS29uF8ReovfK+jBUyOQvrQdaYGbuRlI+6j7H+mmEyhhhvxGW",
+	"l9zy74UGde/ZrERZh9Ogm2OyFLWVGyn0PWjWwbXacbNLTwJfgluTsHhm9m61SwaYbK13SIK2NJ20O1SV",
+	"dhb5gxWpV6QHoBL11mZAMPInkQdBghnXCnOfE6u10qnn64Hm8cZwPxnbcFmBZvF2J4g73ghdSnJTamsN",
+	"Pld8XaE6t61N2zRKg9Wk1VXyCd3H1wT+QxsWbxi75aa/A0tmdvyTz/4IAJgd/+zjT/7+yWd/vGDf1oyz",
+	"vTR7cB1dOjUQNU0C5hc8QReqXhU7Ds80j5yYUpA0ZxFAq6v0BN+9fD4abdTb4T8DYmsL1d0KN9HZRB8G",
+	"xMaT/g7jb8L0f4SVXWAPaVKdSiVM/YGlzuOuiPA9P5B76Rp1nHzfCO12DYeuFZDJk2690JXVCvDgG/S2",
+	"JdF0DPCACqnPELOs4AD9ujtd7iJBfRcNE2j7yZGjYYRgeK5gv7wnAiJmsVx4/C2WC1ov/adPbsvFAGr8",
+	"JQCQdgDpvfw7b3vq7alkzhX1bZ5o/G9qsxnSvnvOw8ThTjj/HUXDJ24nugj699IXIG9/JWteSXs4w12E",
+	"8vtqJ3iZMufhbIy+MkDJxWKI7DQ3xo5f06hwHwidcuMPUil8pw0RwWkLITtpvqfdKAt05tzu7Cpe4KrR",
+	"Sm2Obchz6Bct4AV2wgcFR9e2GWOgH4LrOKDjHsYdaiaA7U+boPWe4ebSu7f+vuX/ibd8zCrcO9xtm1Vb",
+	"UtWHuDRkZ7UQIIBbRfzvwKQ1bON4yUXgLl9zszsXZ/k6KWn0aAxvtcUx7t+NNgcfXzuhhffw0i3xXMt7",
+	"38fnW/wPr3qnh4aFcAxpnBkqBE+WnVBLM7mHMBDBnjzvGfCL+x+61D7N2qMvydnf7ZBbRNih13eyNOfa",
+	"Jhwst1exXvn6mek5Cowk00lVTjTXrGezalglbkQ1BIEU8o4ZAkLU3dmlji/UXQqmL9TdSOIAP4Bz7IT3",
+	"9pilR/lC3T1zkCmdUqKA6/sKXTvHG/udcYEvDd/KGsFzr7s9f0vKdIX8EXZPmBBoQooJHLRjnc6J3znG",
+	"wKurOuARogGV9t4qWux5z9iYY2WzHStgN2q+F8ZLorE6YxmFDF6tlb6fZDq4R2rWBUIyDqNGhqHlUL8H",
+	"Tdtm5RhJIhqIGgwG6mLPp/E0HD6FsR4W/ixqobkVZyDWufrpDlv3UFS0TaX4EUN7tB0bWQlUSWA3UTJV",
+	"F2DTk9aKOmU7z2ia3bRzNXsRBEE7C5O65zRCRcJStxPP+VpU59AgTwQBD2CrYMqkNuEse5lDZtfpPghF",
+	"oNnW0W1fN+p8TYOGvsPuK8t/gdNuLI8O6QNOe3+gX+K0t83Z7Ge8IBBIDjfH7F7UipXqtqZDeB/t7Hyi",
+	"Xgu4rQrebneWDB9JChfGyj16chvLt2IF92klYMhMIgGYJnQiI0tsFcJRmBtFGMYtKmSNKFRdGoYmA+wg",
+	"GgWaR3FnNW9UhaOBixG+LBqttuhzZxTbcH3BrslLypkIQnDWTmk3pXEudaEnHgXL9oKbVoMeitdg27Gy",
+	"8h5FWwF3uuhmo7Bk5+Hl9wkGWh8ACuxXqXorjJv0HjvYaFUIY8BzPrKOT9GNbxdRDq4ljPQgKFBTfpR0",
+	"D5E/4vBaOQ8cb2+OQvGX78+KA9zBzDHqEXO87rZ54ghkFQjE/4fi40k/2A8ZoC8A/wiHS2fHdI/5xKBB",
+	"uzHu7H2j8LOZ7AxULgqBXoDS0mkwtxLU03t148DFu8Mq+r+4dSuN9bayhrfGjVgsFwM0dO5T/ZUslosB",
+	"eIvlgmZOKG7dtqzwIpjgQBm+g91EOcVy7kcpM4GJr7Gzg4EBCqezjXOImzT1SfecVYEM7z3hTKZwjhW6",
+	"Y3sPtux7PmTSkzB7jglnYvbeU6UkNJ8ihxhv71gljv2I3pN3Z2rjYuoZXjEDFIxvwgGtL0dS3njXTvD8",
+	"3PpAAh5zccc2UFJX+0ZW53iGpg21EAf/h0/Yq6+vnCkYgEHA+N5d8x+68GNm7KESHyWfRegSnh79j5/6",
+	"XBz9cVPjGNXqQux5wteKcnzQwaZmDNqlbBgxoTl7oQNw1s4I0IkS2hll//EbUUleF+JsPk2nugNhBFQf",
+	"jKN6xBNdb8jaS+neUCQoKn67xnwqOFDe9eYpxn/6COwzYIfC13/OhGN7UkAFW/Ihk9HnwQAUBRaPsKR8",
+	"RMGn7X+vINBvdfXieoXrCVr/Y09PhNpPPvsV73IbhQjzDqF/E+udUm/PrrN14+YgopgQ8j/wLZeLZ9IA",
+	"gezXZ2FIOaZRdrOUzJ3GUhzF/KlHvJvmEB3zZ/qg23OQb/AcSoXFWFWoanUjtJEqQaMvXAvmWviQ3mb4",
+	"O0GLXj4wNxJRW6cJFRJFnOCpTkO/vqs73EwzGlxvYnVu3jn70ke+TxhjWCP0yt7VrBTrdtuL/kYFAWcl",
+	"dkQDx1cCU5Ccgz9v3FCzcebmPoqrMPBsj8i7Rmi5F7XlFfO9h1kovkIj8Etk0LLengEBhoPW5oT1RxAI",
+	"/Qp7H0WGn2QuLlx7v/oNzunvJTTv/FmQ/f213ItX4Ef17WZzngwJCgdKXCpyLwzMxKhF9M6bof91o85B",
+	"wPBseCcrmwfAYeTVoS4wo9Ava9HYyxrTm5lDXUTJG7pIybMmacihg6b6wCTAAXQ8x8/oZPFMVJZ/pXQU",
+	"kfNnrdrm7BfucM65y+FuMS7ksYS+PnWErLdVP03xFmC/SK3xV1nQU8/B3RoQeqTIpJfMF21dnkW0GLvD",
+	"nOq18xv2AEos7swOQDjYMTcgHNBgEJOxePAUk+R1kUTA+QkwjebxgvADPbLHKyOA1XYr6+0rYWEl55Ad",
+	"QLoEAXZlMcOm1YdVwa3YKi1z6vXuO95tvl8ISqHsnVqgGcO4KKO57iWgnr0RGUfqipZPHiRLnwK94bUs",
+	"lmzDLa+W5LG7ZLdc10sUwfB5iBJZUthUrW1amzRIu3SOldoyabzR+QkzB1Op7dIFH9supgAe4lEHLUqp",
+	"KWLWqiVTOiTX9TcNzd1psJ36lVypU8B2myRq3LZpQzoNKurSjLZphu2cNiJgKDX78gj9zJWV/MYaR9hD",
+	"kfEbsVf6cEayX/Oq4sYej9LY48zMtZ+M0Xi3XGyLVSN0IbJmTqf0//O3f35Kr/sle0xONfiThJVnMqdU",
+	"8kYAy2yOAw1NgW00Sw+4TzUM5sSAXfyw5XpNls+qEgW5DU0vklCyyuSN7S/zmy+/eX79zfVrv9jpkV3e",
+	"/rTAhrN2Iyw7Cudyj2r71ogL9m9Cq84BEL9XgntD0WC1SnckxytVixlSnwNyGWiot+0D9MTbNvcwOJLL",
+	"nwW9FWeOUvcv7hQweitKTJkJfCyalvgvsDJM0ByiY/sx68TndEkc6eCC/XjTCK79Z+eR1rsmRqk+a1G+",
+	"vquD46fP91/wWtWywNTbPpVyHKzjMiDPyXfpJjkh5D2nMDjZPf13/J8V/++WJ2ESRau/qlI8wMOmP183",
+	"WKccAkzHKiG+Vq1l3F3S2DjtfzTlNuMYbc9fjRyex240yaDF0HF1P68gnI6KDVRa8PJAUWFq7VIoR/FX",
+	"cPM0XNuBX0LyJojgeoDjCWrd7ASeujC2MIvz3HkwsDMMlW/FYYX3omEf/uV789GvAO8c0/wwv2BAb/C3",
+	"HwTY94ymM6afIrjh5DHZcU28C6iWWRWct3IoPAkn2f0bQjTaxYej5f42/RMoyE/yMAI6xTD/EHp/KLRt",
+	"k/GDcc6VoBmFDat5rbxCMp18zdjVMbYMjeK1GFhBxAlTnBgHzigsn3NjKcu6rEuMQTGdAI99mEuYkQE4",
+	"a8GBkb+nj6mxC1UbUZvWBEtOCGdNrQHjE7Jz/VXchbnUJho7mItIhj82cg5L0fgvff6LkJKFcRvlz4Dh",
+	"EovD/LVYdySJyh4QHSKmAHnlW0XYjYuEZACRpkN034KdyhZnrGoa4BZ2FccbZ9D0ilpf2e+6tmPi4pFa",
+	"olSCfFJd++BmhzOQj+COG+bg8AEn3m0kCTMcxhW6lq2mKB9NI9AqPgJHD2nbbDUvxaoUFT8kQmXoM6PP",
+	"UwPgjneWQmVFNiEvbHpHyd5VfmJotQp5dgYjKZfxsoAjCAJ+RyCu95GRS4Fjp5iTo6MPwlA4V3KL/Hi4",
+	"bNrqxIh4G1JCOU8PCLLj6HMAzuAhDH1/VGDnVfdkGE7xf4RxE/g295jkIExuCd34Jy0gEybg3Mui8zJg",
+	"7wMOnGSbWTZ2hI/kjmwmZuHbxl6fwz2BkjGs0F6UvmwxI1Cng9XGknXJsXsaAD8auW8rFxk3lWAVurRa",
+	"5KM+yPOEG1VHk0IvOAQ0+dxpo0wlsl65vI6Jakw+p3ewFLqmwxyUGB8FdZDgRwSFim0hzu/lejkrF+nI",
+	"tueSU61bWVny2SYsCLiJ7wGFvauJCHJS+QgAdJVaC//gRxjatQvEkDUpRWanyEZ6HhpfZyc6i6Dvb/Q9",
+	"8iN6/KrGDnIkyhqWrDRTLQrGLuM5rLyX5/rdcvEiTuH5dMerStRb8UsmpPbOmcmUrWwtID7F5IJ9gily",
+	"jJnvX35FJr7wFPCrYXu43oId0Ain34YJL97Ub+pHf1VWPHGlGgzrO4FePJqT8ycMuuqtCbIbp8HtoPjw",
+	"+5dffcSadl3JAnGQy2d7HlizOWWnluAxP88c23T2y9Qm8PHSRqT45V1z36jePh0aweHeyO7DmAQJxqqC",
+	"BUhrmBGFFtYsGQ3lw0u0KGQjBZbTwFMJAP9i2xQtY94eOGCPY/ov4nDVWvVS1OKWnyNudb5FUsOcJpe8",
+	"mfSiWB2zkVqkE2RXgpdZmfRrdcv2vD54eTQqzTfe9n76YprTEAG0RSGMURp2UtbGAknncnsSGs1kxkeY",
+	"Lozj9QGuZ6fIj9L0z7qZhrvqdjRlWveps48pahzekGsGJNDmaOFS5bvS3UdE185QHO9YBEmEutm+361V",
+	"oEMvAu7QCWBISCmKP7tvx3CC9KEshSVxMPpAfLIPNtVJG455P4PEvWgnIdAk3W6oPuwMnH8jrJbFOUyU",
+	"exrp1AzWKWiOim1+rtkBMj1EuN6T2ZJHiHrtr5L7UmkfW+FmmrgBI8kD+TPAZfACATZIuqcEf7bqF7np",
+	"+hCfJBf7G3iMYSH0s5awJp5zK+ricJb800LPJ8QUEEcpkKaYi4XSD483TV0c2E4aizFMpqukASMiUrCk",
+	"xrlyQoUQiBW3R6JMyZ0NggBCpyNh9unLthQbobXXChw1O4SKwOM3VCU21r+WSDw4hCw30iKoWFy7ZILr",
+	"KqMugITL1HEqKH3v6im7ExL8dbifFOMh6AUz1oyrTYfBNBTHIRjO3C04J9NgpYrVhENeVxPCNXbJnY6D",
+	"6wfX3Iq5Y+uolsqcoYWRZTt/dGo+Z4I5GpEUsWdkJtK8rUijlE7iO9SxNEpVQd/OyyF9G/9aof19gu1X",
+	"Yt/Ygwu6X23aqlri2VStXTJ1I/Rq3ZZbQdWssQ1f87pUubA1sJJuRI7aTLvvMh13YRCw0FECsFDcicAd",
+	"1ExJoyzqvsIL9hgbmDNzZqp0KjWqVHT6yogyUP20ZDZUPLcKeMQDUrGFSg8xR+7TVgptfn1jvtrb5AGH",
+	"SbC9IccYHPLxwZz5om33e64PvXPpvFu6kxUbV7s77sFecgP/7PGoTFIVPtgQX1p64F3ExB0vbHVg3JAL",
+	"FmXt96rIcdIh2K9h0ZZREqOJGZ2PVjKr32SiwxkOWJ4kpuF7PXCRSB4Ipao53pZDZCQhmKmfUrDrEn3V",
+	"unPnXzM9IJ35qjp4cJ3RbMiDL9j/US0reO2zlwfrrtJoMiV3XIMuWd2criRqhyF0niafGvzy6NFw4Y8e",
+	"uT2Xhm3ELYoKvMaGQ3Q8eoQebS+U6T9/ziH6cm2vE3cfyhZwJJNKTKqiOS3/u5Hn7OSLweB+UjxTxjjC",
+	"heWf3U12ztpjGskkel0uID5B1tvE4XnhqZQ1Wq0rsTds4w3fdhdxjr4PI6SAOjiXKPd4wwiG4ArdYcen",
+	"mAJoCpdSp+CtEcN2ZEDRwklKXiyWZrZy6lUY7G+04Bk+nTOp4HUvgeiYBugMYEUZoV+KM+mVTy4N5SEA",
+	"d9BkRahQryXtndPP10d7m3mHyKxrzFdSzx9pqAyRnem4g/UeBaUgOBjpCA1ShW15NSql1MlSobKkn+bd",
+	"cvFSFOI3UqFNIyi/XoG2ESp+2fps4+UaqhHhQz+5Ee7KE6zRYiPvcMOUPW+yjUaLG6laQ1lwV6iu5zbp",
+	"buZVDxn9wne1vPO5/Ci7Xuce5mfBaiVRuguU9opCNDZnB5hI5gEOU4Pxjt+KNN5yYt3zixXfdhMTzw/F",
+	"prLrV7WI1wwrfCXqUuir8kYapc9SjYHqlOTKudXjt61n9QjJkmQN+IJ2fbizaES3oFLhZVeoelPJwpKd",
+	"Dy0tqPacb2cZSf9fwDzpEEZuhFnJetWaBGt5jp9DUe/ja5wNI478HTqt3Kf2oMWi0TeyEKT68gV5eObG",
+	"Me12Kwz4CNGKM0tlzum3Hxnql8/rJAomMDBULDfcWqFhuv/3w//55Ier1b/x1U+PV5//j8sff/703UeP",
+	"Rj9+8u5f//X/6//0h3f/+tH//O9JRcecN/cIE0MiWAY6n3NgCW1uUKQHOK81vtmJjAFbns59OGmOkLhD",
+	"Ih7fXWshvR1EqLyHZMWU7Hec/9EF0zLurleXYrzRwojaKWC75ivfnB5R4uJIKItrPsMUfauVFf2kZh4k",
+	"INdKsFJTgoML1iUjJjso1hwYLiQKTdi3lZVxCpBoEjhE+oZXrlo02VA2vDIuBXLtjTITlbTvVwC8Hxq5",
+	"FlteM7NrMdYQEx9esL9BE7/uJQQMa2dLd4UIqepWofb+IaLiGUpuOZiDzpd7L2zo8oScG6/9GqXpLxCP",
+	"gfNGO0t2NF6tQDjUshTHH0TBGfDLG159G7q9Wy7EnSjgHV8IPOVyO3MsCAYtxFPqciSSoOP1cr8XpeRW",
+	"VIcowyqSYueweMEwvwO489Rb9AvXqsVa/9L4UyK0YK0hKtBtPRoio1LNu/NducrtTo/VeUaMDDh01m55",
+	"mE+UvYtiJvKGCVWSaaQwfaLJSpo3XWADIccRVijycfSd1XPr7TkM+olnZppB1AHXH+Mr3hY4BTVvzE6d",
+	"rzJvpgwhvv7gE93oblbvI0vl/uSGSctKWaYdAIeuaWaauYc5dqoqzZE68cAlluQ5S5xtor4AgJKr7hgn",
+	"gwgAOB/hnTL2hPlOyOQfcV5KvTOEYMCqj8w82y24rVGfM28bQkDqJGqpjmN6sTtxFyyYr76+WkEK0LhO",
+	"o59qNmLBDHo8lUK3gpB34Czoc6k+kuirExlBtLQ+aVS30iMP24dewH6LO2hPqcQsujQTKIx0J6Kfz6DL",
+	"IHR276xecqIRoOOJ2a3mDXGL7iODFVN5oq5QBMDdrs3BwFVzDiVUGGy2BijMf1zz0w0+ewNDl3i73Auu",
+	"4DU61nvzc116OiW8QADdGcxtNBDTwr0KTD9vOn1VGxZiI4L1xOFlma7N/fcMS32ZjdkiVeRqr+qUM9W3",
+	"+PUb/JjWCYGBJtMZTWW5vkOBuAf/AKz+PHP2+aH4xVMAKUpfi31zJmG6B+GYNfoK6W5CJuqN0oUwSQaP",
+	"9avGoy6+J22E2vTHCuWuwjJdGujZImWMixd+tKQN1TVKxP7FKYNdq1y54Iww+uXV87402lvImDzzlqiA",
+	"b9e/CwR1eF+6bBPWoJwlNNYGxgao63+AM0NAUZ9qu4WH/Z3L0hAxYbd5WBRZ8NEvm+KpkKw70fkrIb50",
+	"ZWPOVnsWnG/qZJgMQMpvhMaiEDuuxZKthb0VomaPkdN+vOx7QhS84YW0B3qD9a0T2ML08rGUql1XkTcm",
+	"maBhyfPSfvRGxrl8TR2ykJj7lW53yrP7wNAatEFYNEJY9qfH/1caQfcAbCPEqgG/qIMVq+azx7kUPaXk",
+	"NdsIwRqhUUoceDCBjlqGzUnFcm3ibQv4cEskbX0NehhWUQwVJ/eDVQzhgxf4eWaBnz+2O+YSXFHlM+/W",
+	"9c++4M8zC/78P82CwXq7EWI6Ce5GjNcz0iBE/qk+W8fIT/UeAI4WeRTU/B70Aa6FKNERsuEH+GcrLNmH",
+	"ks6Uvbc5yblaGmGc2xY12siqMqxtTl5nwqYOu5JgMYlDmSDbFN4CC09w1OXw4pknIDqjBnlwerS7rL5g",
+	"ULR9+/NQlzZMvWu+UvpcuZ1pwNmvpRmplI/KJG7K+yZ8htjCcY5kZ75JhC/7yFWpGTdGFRJdx65Ls6TX",
+	"qEur7CwECfS/FFSAxPwafi8IwSuQYEoXipOShHnTrLCqRMaR0Mf3R/J6z00Pu7oo6KahOni+SgVvmiXK",
+	"pg525LHwd6UKXtEeuOD4Gy4rCFQLlgxuhU4aZF3i6rFcGz/4xou8H96aJjmcMcKeDWsw2ABv8FNAFgj2",
+	"lB/zPSAKZr4fqqBnasjTKjBHI2Kx6PF4Hh33GfJr6psaFknyXoM+h56pId375sRBX1CvwDqOMsWoepXb",
+	"PkfwEa7C+vx+DA/+mKgj+Of7KDmY06YPwJYhphoqdcLwfcYZ3u3ncBgfjjvIBhlpHCjbmagaxllRSS9d",
+	"Wd0W9k09umwTlWq9LJbPv/XUN0kn/EpEHbmh3tSUMzjkYEpqJJJS5ldC+DRcwUWitzkbId7UrpUEIVNS",
+	"pCSKdSvSOnnB44Jago5hA5epVewnoRVbt0PPtNZYZqysKpeaEqZhavOmDs/EbyTUjIHhNqov1dbC3ir9",
+	"dkqmhUzPohZGmlW6Wtmf6evXYJ9wy49tFa5zF2P0fj1aPOyyzEJ+/czpRa6foft6l81wBPt7y2Q36ykz",
+	"oC32Ya1sIKCP+mme7E68qe0d+jljQDq39yOHoZ52dBbpdAyoprcRg7ROfq0nOkI/gMuwBJMZsEalKvRi",
+	"PovThCzs7AjOxHvaDZARnuHJR56pO7ndCQ2kcI+3KU6CmVFUJYtDRkO6400jKOQudfFwreUNmj69ZQ+f",
+	"ktIweIw9YW8WG7lRbxbOz95glds3i0rdCmOBCN4saLWm5+Q1XCi0173nMUWUvRVMK7VHREmb49zv+fWd",
+	"Cf6Zpb3RUulkDovY8DwR88u1YJvOaYmUhODE03ILOKpZKUAOQbUiZc5Wm97K08Zr8I0/jkmkR2Pn6ZJ4",
+	"fh0zlboA1JFYrdxJi9Qe5ETh0qhI62n3PuqoATyILeedeApo4fVLfVEmwKveIyyKMluSlIDHr60xL/+9",
+	"EqGRnneOrupEhXCeWOfuspwDFnGU90V5rv/9OXxiJ++jXnRg3PsQnAcMIlMqCrEiRn8iJB4tIRprLShm",
+	"y3v3Mg1OcsKpOGCiTPID81DtZRKnox1PMJ/BVZMg3PQpSzDXwWUwvqunec1yKIHkt2iWptRyK43FrC91",
+	"Ur88lKXu7e8yLpZM0KUICb4AEUArtmlrp8h3zpqk7+lqZC3p3bQWrrDSE/amfgTvZl9x2f0JLlpdYf3u",
+	"+yI4cKXK48vybgzkdZy7M1G4Ai/nD8xkdH4mN2AophUPuxdwssxONu//1WWsXKdfi1+7p2GosnFdY3YW",
+	"lNkw8fnB5VNWm/cPt9VClKJJOT2+7LuOYKtuN4UYFKNotLoR9ZLJC3ExjH8ut8L4KqmV4JuQbk+pOc6z",
+	"4RwQoXmqiLAeL2RWkHGKflDv7l6+75YLp0gxZ3dccwOn4BrOGbKc+7+tYh/8+cvX7NI9Ps0HAKqro3yO",
+	"t5srtDxfsfi3rjLzpCoxDDxf4zes/mxwUDcxwOVjD1Nu5jXfx8WsyeKiWnJowZilsZ6NVyBGlatCljp3",
+	"fZPGwHRVu1E8XTs3eSBylLueXj97yWplnaf962xrCAe5xWBuyhughQuionpV80vrPbRUuSlUk833gt/Y",
+	"VvM6CgkJYwUg/b2hIR8ghNAsBt7di+WCl3tZJ2+RSfpxNc0dlGMiWi68JWpMDCGFblSgxzLOtvJG1M7G",
+	"BmnPnomNrDHa8MmbuuSWX665kYW5bI3QX1BW34utYk+YG/IZt/xNPaajXJ7cOJlzl6IttRt8n17Lmzc/",
+	"gCj35s2Po1olY1c+N1XyZqUJVu5UrLx859K4jCc2jSjkRjqVHvWenLU7cfEzyI2fvu3BtLBCa8IKDXjp",
+	"5TdNBcuPuJq3+sGWMWOV9hpNGeyDuL+Q1Y74Kb/1ASitEYb9Y8+bH2Rtf2SrN+3jx38Q7Kpp0PiCRuV/",
+	"OMWhNCh1zfYZvOpA7AbLGRFdaRpxZzVfNXybOotv3vxgBW9w97s0TKAux24xToILHA7VLSDKQJrZAIJj",
+	"3l0WrRAX94p69cx94x2ET7iF2CYEiz5ov2AoZ4O793ZFYyR3qbW7FZzt5KoMkLjfGccBGN9yWRtfncTI",
+	"LToLmJ1qYcmCFTtRvBXlBbveMJfBK+6uNj11tWcd0uD9AdeKNGwjAX/Ob7ttSu4U+hBnGMs361B2EAd9",
+	"Kd6Kw2tF3S9mlnFzib4BG86ivPIG8NRBRUqNdNRArPGxdWMMN99VWQJIedOwbaXW7nQHsngS6ML3yR9k",
+	"Upyf4RCniCKgYYLeG64TiMAOORTcY6Ew3oNIP7W8mYULXJPOBOO0X/FqXu/C9z1Q81arW8otWjJVR54J",
+	"MRdrDd+KXFLEWLC4R8bYWIWUvfeSN12ke3EdR/fNRPbCFaw5SSkCvgCpoHg4KIPlZ6LIfSdYYmS1Q5hz",
+	"3Qg5abuQ/AhV9XYKtDQBC113AocHo4+RWLLZcYO+kPJGlMvoLM+SAY6GxAGB+2wWaFfuhDoMzazEDc/h",
+	"38jtKq1RuY4qOHEblCvAsblttfA8d3hOR3oV1KPILfyzd/9WRm5jpQr+tad/8NuPSY0CFo1MbYeqUQAq",
+	"RSW2tHBqPEhK/IGJNgjg+Hazwaw7q1QxqMgLLbpm3BwC5ONHjFE0DJs9QoqMI7BR34oDs7+q+GzW21OA",
+	"rIVE2xD3YyvNahX9LSaSXKLIoxpg4TIT/lt4DsBdBbFwfw3q2OEwTNZLBmzuhleitv6x1A3SDRCLrR/2",
+	"JE6f5u+jnDg7EYxEF8tJa8Ie91pNLDN5oNMC3QTEa3WXy20LEu/6bg30nqwYCb2SB/MDA5j+wLC1unPp",
+	"7evSJSs5AkseDg9GB4C4k4bydUC/3G1OwExNOy1NpajQsA+DbNORS06cmDN1RoLJkcuHuPcPACBbtcQ9",
+	"fo8+Uvviyfgy7261ZZfMxRfjTR3/3BFK7lIGfxOqiUiUfIpJF3K2vODCikQ7kBvrHgvplbhYMm7ZWtmd",
+	"z1fS+8pKSfXnB9qKbrSk11AENRQxSNah7N7sK76xQh8Vx3Iv43ikrhrfvYZCtJmT4SGCjgY4GQw/wpC+",
+	"+3ieohOgpCkKcc6XGeqA3uegCxgnTRE4Q4YWHGwz8T54cvvOM3E+6H3SjnfM6/S9jvsOd9ljbWJ/v1B3",
+	"U7uLlxRuEV5eXS6t3sH/JY88QBHPheY6dZeu5BXtfVoH/ebND/ABLk8YBP6/HNSUeO+GL8RxRykTm+DX",
+	"HlJHWTWEfsnaOpQW8O27eFoQES5+pRXmKppOL5HMGHMWKctfb43T/NVR48QxfDFUICTNBr1WrsjPWozc",
+	"L1MyKJN1Jo5uWM/shEJzoGoU+AB85bvF5V4+pCqUH0VZriNLWtAOhdRj79tODhc72m/zq7ON3sD6Xqou",
+	"oQh2dEXo4mW+/2OlrFhh7tgVuhUnlwCNvjKo446z8w5UF73NZtKQn3Kas+K0UGO9lFWbplc371+ewbR/",
+	"DS8U067x+SNrSrSFiQXTFZ4mpqZStJMLfk4Lfs7Ptt55pwGawsQayKU/xz/JuRjVBZwq2jgiwBRxjHct",
+	"i9K5DPKbrkbXuCJfJHFYpd7iNgR74FYL0vh2GebyGbtchaeL+UbV12ODyfjR2R3gQmibrUrde9tjI2YA",
+	"8r46268MhmLGiszLvtCipGzvZuXzY6fnJLXGrZDbHSm6oq6DNVFOPj8cs4o0NmTKltZQLJTv5PJsG8vf",
+	"CqoDE+oHA9yGSdD4lFTUE5NMKZewWzDwT1JWMFnP9AqN13ur6hlLdVAmVttlDUe1TW4nLiZq+Z9li7XA",
+	"zBcHQlfWSY1gPTbbMCE6lk+dsyCjNudaEAyVpdmsRqZbYg+Y3mnq4X1MDZnzMMF+uoDuaaVEFHA9yTZG",
+	"rKD0Yx/NM0ZQ5PFDI02sJU7mPl5Mz05L2m7Vor9vx1jHS5M1uArgjbVSm40RmTy9jTIyzrqc8MV0Zcpc",
+	"ddxceawHVxMfA3CvauG5J+v1s9QM3lnHBKSuD0zW9TC2mYomMBsPJHW69NPx5O5e35jYJLeEJLX4uzI6",
+	"Au3xOxcDX1M3at3dqOOL2SVnvorGIYuhtWKPyv+90jErdjeCy2AiLfGZW27qD6wrft1F31GOY/PLXeQe",
+	"rpWDd0atQC1V2bdVdmuNbj7nBupuvjMy/N49zk0fZxzjI3NXAEpvc1dKd3t2ndG1np5o5jVz7+UcvWe6",
+	"lfbvnj4WPLCTJ+mVFc33+TXRSiiRuhVNMLb7d8CwOiiQUIbN4jc/AI6buc2taNJDxBBMDDB/izJZ4VD6",
+	"Olraj5qZCSktO8coqATR5pbuFxAASe5fd8FP3/6YDCNktOg0MuPrssy5KcnybuBRmK330ks9+DB7AD7K",
+	"snnuehj48kYkHVtrdvXy6eqTPzEBDZhweYBHyuIxIYPNOU0AV19ch1y8XG9bKl3mNhznuThe/xfuvVro",
+	"lb07kqifAAfm0Qceu/d2pOBVlQ7OrNR2hfs1T/yhKfleOUe4Sm27+yY/4S8oBNHavWNewPHJYWjAfXMp",
+	"uj+lsGHf6PQNPVHsSq2JIR33tjq9z7+UMIZriAkmwtqSzsSRk4gmxpdiI7RIusSFTyaSzz7opSVyt+P0",
+	"+eRZZ/akgBTyScUT3cOpkzfNMQOwnzle0WApD4k/7HzWAZY5u/Eq7Sr+yiot+oiP3IcQX8c2YY5pLNJv",
+	"xlNJky8IDJo6tL3MyTn7F3HAnLa4nEWIf7mvY3bqDnIjHsH1i0zGXYdnTB9Cjrq9OIsTUc4bCCTj1cq5",
+	"r+eubK1u3JWNzeMsuO9Rc5umbEhG61ItoVqsElyvguUjuyps1/zTrEoLnr1s/CsOVRneI4gsY9Hmk/u6",
+	"C3HzXSgWamBcA+nOEVfHQofjeRf4TTqL0VHe5yIvaIkTERiiCQEYnXMwdh7EXAwSqskJJzBaXBf1cjJX",
+	"iAd4cOxG7IRzVnYzOt3p09FR1xGehHN924hcmcCrmin/NcRi9FnQB8ZR1iWu+hKs2uH2nHknf6V0j/m7",
+	"ijrJWI7wIB8wxrPc3Q6PmaBx59PMh6rTC4a0xP6x/QecxkeP4qP26NGS/aNyHyIA8fe1+x2dHx89GgNN",
+	"t12aSaBVDmz0H3nc5Dfi/dp4a3E774K+utkj6qCTypNhoFAKyvDovnXYu9XS4bN0v5SiEvDTxRynh3jT",
+	"Cd0xMHNO0KtckYYQ87fnd5AjKGRAiBxgsXgTkBYyexfeSl7L4yNUt3vKc2sqWWRchdYG2GtNegh6tEDj",
+	"jC4DRmxlJlSybmU0FjSbo60YABnNkUSmSSreO9yhcxYgra3lf7SCSdSibKTQoVBndNX5x4EhTfFQ4598",
+	"5bqBsU80/EO0FxMebv7lNKW6QAdGtW8qyetC5NQXbKOF+AktjUXFb9e8eMvc6xGZFGkrPTa812OCMeeD",
+	"Zf243nO7u7FdPIGwTIsb9fZeWYPyLpKvw+iRygHXlPGeOzbVWfQp6UdzpEp5K3O6C/jSUxos44gX2kj4",
+	"X1t3//fIT/FYFyCk5+1azz7hupbOQIubR8g297g234/RaioNFn1LzLMMOTbgQ/KwFCNyvgcKLNfbnPGw",
+	"Q70yneMxENhGq59EvcQdh/8BZOOjNBuGU616TpekNmPaPrf6CE/FcqhF6k5kxAgCMsOWZ/mjd1weLfpZ",
+	"8DHsWF/kAxyFVJ6QsCCe8QT+yd396W57SuG660cMP5xbeodyv9ERp0/MsVUrcjSmftfPYHBpVkSGyWWg",
+	"P+Gt45NUyjyeCNU12DvFFociV4hO6Ta9m/3Yds/XHeY2/sG6Qr/oh7AMnpZ6TtvI+ygFcd4sknNKqugj",
+	"62eyyIheeLyi2G30evZhjLxmTsKBsqw9XpI+lVELc0njd6fSwTzc1XB5Ji9IgCna3l7ApVXdDREyvHvn",
+	"OpqdRQkHQltJ/uqN0CQ6pN3n7qn38anoZ2p8OgUPdOypdqieCq+MSgzT1reUo4b6Eb9yvdFbwfk+3Cpt",
+	"YFiTjg0tRSH3SQP/mzc/lMU4DrCUW4keJgzT9m2sk8fcQMxVxgYqKqVpKsrsGqPmesMeLyOp1O1GKW+k",
+	"ketKYIuPqQV45uPa+oIspdm2orY7g80/mdF819alFqXduUI1RrGgm6MIAR/hPKhV9Tn7EGO7jbwRH11Q",
+	"Tj54JC6efPw5RubRH49Tr5BSbHhb2SmWXSLP9rJtmo4p3yuOAUzSjZoWbUl8yt8OE6eJus45S9jSXSjH",
+	"z9Ke13ybEYH3R2CivribPefZLo2CVawUxmp1yOUG3gvLgT9lEp0D+yMwXI3ZvYsANmoP9OQZqT9sfjhK",
+	"d0U8PcDlP2IgfePjiAe2gPes5slFK3FMd9CV6/NoXTJuqHai7FJcOIZ4wa7hMJSidLXjfZgM4QbmcsV6",
+	"GwVbCP5IWtYW9cOt3az+BGpDzQvbr7PWB3e1/uOnY5C/6AXqsPo0wN873rUwQt+kUa8zZO9lFtcXUr/X",
+	"qz1wlPKjrrBAdCqzEf/JaW0uwHx66LmSL4yyypJb2yM3HnHqBxFePTHgA0kxrOckejx5Ze+dMludJg/e",
+	"wg599/K5kzLQL7Jn5lz7RGc9eUULq6W4EWV2k2DMB+6FrmbtwkOg/3XjYbzIGYll/iwnHwJeKT+V0hRE",
+	"+O+/ySWCzCSjwJ+7Pu+XNtNGHQSmb1b4+B9Mw0sSpdFHjxBosC5Q03980v9MTOrRo6SyOK1Yh187LDzk",
+	"XYd9U3sIBZrGBO2Ch4Ozn8txOt4/n5LhqG5Pdg4cFNAKii2sV+KGcBmWeoGv6E2Nhxaef632Jrwvazi1",
+	"X6i7r6WxSh+ug2diYGou8A1jSjp+N+Fs+M8TUX2mxE1ph9f0eYbgP/ji8YB/DBHxKzMvl7fU6w5pJRmS",
+	"f+ZWp3Sa+MvwPYpD5uwLdZewtCUJZ3AneOL5dYLTZ4Hn9hQPH+AVq0z9BrY0s4Uz1Xu4tFEyl6Q71FF/",
+	"vOhM9XM0nMBQfht0MbZtT0Xxf9HKqvy+K4g2uMI1r4tdMuxrDR3/7uIWn/zcLZEuqRTWwKOjFlVyOHob",
+	"/92/oROv/H9Xc+fZy3pm2wGu3HIHi+sA74PpgfITAnqlrWCCGKv9WlMhC2m1VSXDeUIt+oiZXywSe/UU",
+	"b1Ofr/slneN8tuqlzzhNlZNdym18Qgzyev/XTeK9pPBRQIple2Us++OnrBJwOs3S6SOXrORm5/CINZ5N",
+	"obQw/zkTgBORuYT0kzT23cvnS2ZEoZ2qbCMrS+997pPNnxC3hhJirazcHMb1WF0o7pJh+akbVUG5sGUu",
+	"N9oJvl6TCXwmQQIPe6p/1VWLHTpTIrw+Plnm0kVnDXqT8+MfG6E1YsJL0Q6ioEGdVLigRR22L+9aRpK6",
+	"lZuQrRGOpMEyHCiv44E+uINq/Re5cUWw8LecIuku42M3uW6v+PCZef1hafiBHLe0gK3nxQb/udsgB+cb",
+	"/dOCdhzI3zabxY9zVReAi521DSAW/jWoBUhjplFUv1Mdt4fDXKkD+EwfdJvn7u4D5buEzvgkKLETE3WJ",
+	"NpIL9mdMZQBAvo6xh7YJuW8rNCr1am23TaV4uWQwDrgpM5qV+mhhW12zUqzb7ZaKPfXuqgeWwp6uf33C",
+	"ONNppmHVxq6s3Atj+b5JVd+EFq99AyYHDsiotI+xc8Gekb3ExAWfjaUTqeGaDdO504g3P/zHWipHRdQy",
+	"Q7DxyY/yFWxfuBZe9ujMtNz/vwjyBhEmwE2ejoJutyUFHd9KIzCPr7gR/YKfHgx/k/oCoP3l6bauiVIu",
+	"TnjputKnp6PdA+fcjeoJyAaIP9UJyZV9nkuTdJ5fYa8UUdq7uj/YwAXSlzxy2UEv2DfOkljwWtUS7qFD",
+	"8pmOVWrmXYpuko5TnFTUmvJ4jg5Xgl6jDKIOi279eUboEDf274m+wqYSddCfVtxZMp9vhTWOs4lyiRpi",
+	"WQln/Za1EZrS8wIR9W4ZnfDwTj0su5jJUyt1SlGVGXPGV/Dtr87YBUcwOA46tDnlD9mnIfs1UDsmE9gq",
+	"YboqovGafoA+F1g7qxR3P148V1tZvJJbHINiCsgtTnDdjIe68uE0LnwF2j6FtoxyLYefe77xNOlV07hJ",
+	"kyJz2OGEiFBnEZxy4qa2PeSG8ePRJshtMg4O71MgNKiGSoHmcA+PCENonVI/fUk1VIGisAWjdFoppFSy",
+	"ToDxXNbeXyJ9QRTJKwE3Bs9rpp8pNLfFrseGjkXPBJ/9IUMz1jncPHSowQYjSnCNfo78Nr6+q18K01Y2",
+	"xzhCg+55zusD84cCqDtONMyrEEdGQlDf9ANSlROiSmCDvk4biWVpxgGMe7UXxvgYqfkP3NDdal6IXt8Z",
+	"N1Gufs66LbfCrnhZpjJsfYFfGX5lJb00xJ0o2pBAuWnwUXTEx7ebqFC1afcTc/kGD5yulAZeQPt1lYih",
+	"eRY+ijLsMFAaPNng39NUDy6C7OScSD5cDDueLDf3RxpJvUDTK6jaMB8TeKc8HB3d1Pcj9K7/WSkdkhX0",
+	"xvo1jJAZLhfvUYq/fQkXR1yjcBSsR1dLqHaIVjWF332ZBKpWxHAo96IHfxCsMvHyq6fsX/70+F9g99eV",
+	"AHZnuaxMF2AXV0J0jf4HyJpU1Tk8zAfGRFWmoAW2ua4EqOGKnazFSgtewi9xgI/PheSFIFxg2uPQJeQY",
+	"YY0WkUbXXVPxmnfJLaRhqqDnRCGiVHqw0At2HUIJDFpRDXOknXEOw29JYs8VJwF9w9evX7/wBUkAdV35",
+	"GtrVNKdz6ucElndKW8hKs+f6MFgSbtjSjc5hH5ud5iZMGYFyMd+kfsW+e3ntN/HgHaXjKT0qS6ExDgWv",
+	"TGhE9Fu4/JXTShSP3+RJueFVJvFd7MNAAh3Z9XPp74psslhuXRUZy9nknZetzEGRegOviLFmKhedR8F5",
+	"5/MmcGudRKgPnB4D9BeflYE1XDoP5O52GmPWxbXmTZtTXL7b4FGsCSV5zZqJvxJYjyjHD4SWe1FbXrEN",
+	"NQyaDlWKJdt2tTk6FYP3UNCiEnB6nM3I90RLT4KyvJZjOiDNg+FMJgJ9JEZajghIUjibbtqxK29vtvHk",
+	"3PZmlunD7yCZB700HnJX4TiYohF7ARdpeOdZddxcF2mXSW7Siz0M4IRUfAEcZzdHhXb9gc0M7VYyjQmv",
+	"pudxKiXcLzeAySwiF0bjnrjxjDEwy4jAus1KnohKbnf2pSiULoV+xfdN5ibBL9F1RBoXrDAXL4gMfU9f",
+	"fIfqT9zfUpq37PryW3LcwZZGFKouGeXX95dqU6Xkh6ZF1VKaAlrj4oDNwVix7+btEsr7wytrtpeFVjS1",
+	"yT4Z3qIossLkg5lLeiMr4Wekdgz6jOb77ONPMBTa+8HWIEm3dxfsqrrlB8Mew0+3si7V7RQ8GOF+KkDQ",
+	"yYr6F4BpJ3gmAd9e7JU+BNxDQ1/aCOfGuy49KFkkVhXfpofGTRUVb2BsIzHZMujcsRvj5Q2vCzKtwqqc",
+	"Kl5TwAvufFXJyZ0n2CfXtedN01HVVoGmG+A6trYJ364Y0JAaCteUE/RyJwG+RAcJXfEsR0u3rN15MxHm",
+	"vqvlHRONKnaZme6gvtzKyJ/EsVyJPQWqixCKfqNCdcedMHBty+7Ahz1xJJc4nakD0qmaI5rqryfFB/+s",
+	"Vds4ldlLEen6R3JCUECgwXsL/Uit7CMkgQL9E5oXhTBGmB7XDDGFtztVCRpipveSS6DFrp8t2U9Cq86v",
+	"MsY4uV8a/2q7h7EDYZrKC4ifosR/hBK3+2FFCY3jjutjt6VD3hKG9zarz5nSeFz08gSksm+9RWvJpCXv",
+	"8Uxvco1x4pO6rY+H+2eNcZgZ1cHdYWiUlOrIeYi3YOncuTp7isNjlpRf4fd8VfcubfEg0VJAv4kI/BfK",
+	"Q3zUUyN7DgMJCtMjukSwuy/luudvRV946QmeQ+VUzAsnDWIh/a4H9tieNE2Wr9xzL6Y5xcNsnb9ZvOOB",
+	"mIvzTMB1KJx8P7ybbNZ47kK5/5Pi3uXXmYn9ZDzCFRXN+o0Q/LxH5pp8xY9me/2nOT49S+nRfczm3Lga",
+	"JBmat61OD0iXcuiAAg0HSXVb9aUaADZKCBXuL6eACd5uv8ZF9V+XFYTr70SmgNlnj5dw7RdklDXjJ9yU",
+	"56aw5lcThP5rXvEdbR297DFqDAjgi7Z4m7zrWdGi/6OEEt3YiEhl53umjFdJ4bn//FVrMKI5X9BaWbbF",
+	"15emOh+AlbZphGbrQWbVOFIQGqzWeT1BNEKnWIYlxK/7WYWmhs6o0cxLt94Uev9ykysl5M8RfvdOFd7u",
+	"81Yclu50ihupWp8UI4T1Oec9+hVTyPjxMjUkphJi/trBa9nILCQYcduvGfqX7ynRIxO11YffQODdaNOf",
+	"C27Ed96OOdx3MneEFEvM1Q/vMVS3VErmNd7MqbqIpB4LyjHSjMGMklJoEV1hCxzhlHxzqHDkJrNTNM2v",
+	"Fah8YqGAOB0VAn7cdkpLXy569Q2zRZWeoxZtqpoYtYjMfU7pOwplyvig9pxYhtN9pXTknorywxiCp8GV",
+	"y2uEyTDbYygx1vBCG5HjszneOyN8vFsursuT/FsG+0HD0CjJHQALzReg3fxa8GweRPTtcNIPVU7aYWuX",
+	"fcYFHq0PcTHYRH2praiFkSaT1AYmgi8h5zC17mqeHX0azU0YmauihrEruUqQRmlL1UqgzWioo8BFJLLq",
+	"kvak53r19dXqk8/+OEjukw5bmQ/DqJipiHMn9jYnC+4cGnoB25/0GQWJZgMmj7XQZicbKpMfVYHhDE2G",
+	"PSK7mJtsd6Q6Ho/lhc4bKrUS41cLkYuPUJv0ZD4oF5v8CuxcC1GKxu4mfVEo11ljdx2HFyKkL10LYPCg",
+	"Pxa1M6APskiX2871tBJ84ylRKzWngljISYxojIFOkdK3jb2eDkKlbLJk2+98xuKaLkw1ll4XiinNVAuy",
+	"+GSlfZO7FFPVh8zUq+NohcSh0y2u5vj0IYXuuSYuKmXESrUJLD+FT703KqGQ2Qn0y9pYwZEtqsZSiI6j",
+	"lH3G5SC9+ccv5KvuydjWLjawxxbBPwWLrGLENI6KQyVuJB8nk7DLmm3Di7crf8bTU/mrigx19GClMr9O",
+	"TeDez79Fp9BsjEyvuPRfxGGSvfBxdcuRg8QJio2rkKeSYkXB1Aw3k+aueuF9yodsNqKAp/l0dfi/USIL",
+	"X3l86eNhEJZNVCxe2jjM/B7qkQ6git8TnoqfD5zco+CtOHxgWI8arp+N8d9VkpjhVd4bjcInjSXz+sqX",
+	"k8wF8DlztDSBMhALPu/ioEZo5mkG04WaJmpzz7k8SWJtyiDyTkwJdRXvORd0PalUJ765cgXkh4f7pajF",
+	"bQrnV4mDDVyeV1V3unlr1Z5bWTBN45yqw3Q3jD/uXYqUe5zzydP9enCI/YyUS1WW+aJgudH66Ole0G/F",
+	"IXdItNhO3jZBohzfNYET9NRf4D8PtzO0Jz/Dtq6Af7oBpPGR8kffJyfqS+bh7l7uSZ3fiT8Pge7Ss9Bi",
+	"p/0+0CHSYQWkl7VWvCxgTX4iQrB2KUWCcwfyVxcdG/U37dq9fMUd3OAQMTsnRXn/lMYkO1CahKBWWlyg",
+	"n+ShFkI/a0kcExBuXhcZTWZQSAPKd+qWwXnrsiJL7Q4J11qCSwkgx4fZ0APN8i19Ff5V0IjUI410yJlz",
+	"P1KXB1EqALhk6DUaeI3UQ133rKidoe4+JQvP0cQHJLhK3HoQYOCRkHhACqFnWXAGQ0Qm33Z/tCRwKSp+",
+	"CEN5aE9W4S8XNusmybfj4a++R0UY1WqGc/HiBf4QSkMfVxkifmjeZaAavyu0+DTNo0o4ei984cNNR1YE",
+	"MscklMiOpl2dD9raUqGlBdyqK1m4Ap5YTAlTGKQeEbI8/oZLeTKuAeKl/wvpHf53YLdCi47FnBIfNxLy",
+	"ZWlm4i8fAPbMhWtROtKkOh4jvgfrRN6tRSFqWx265BWwYEy5avxvdIX6oLBKeosf3g2UKuSW69K3mHzM",
+	"TzkWjupIM5kGehNmll26/HFKuFziHXhdy3q7ypXvGPi4+nf1B4by8KJyBkkgJOTpUjvRy90qzz2m4JhC",
+	"BTS4JxLyqX8IONotk3o34ofgLw/inIkrSoUFMi32XOKptMqLifk5p5D9lL77AlM+8O+oFSfQ6/Fkpb5Q",
+	"gjQjJMZUv2Hu2Xy81OR9on1D2ZsE5q/HlXgarcq2oPs1PhghInr2HTvBSpKBssV4lQOrT+Sk8VYcLsm2",
+	"6Yo3hh2MgSY9JoHuRYb+bsxezdz4Z5OCe3sW8H5NLdFygc7smWwT13UJaxKukkiKbbyVBZT9CkpDzNhV",
+	"ig/MyHGffYiSdEgndIsxU9yyHW8aUYvyowvGrmoq4eAzC8kIgtHkEGM1MT/66bOyFa56HQX9vqmnyqA9",
+	"kJv5YaZ5GAkgD5yKBpmeKFmmDhkZv028Oi/m2lnHuX6GUl5HVARFUiYhFQ5aQDMSFURSFiG6r7Atr5yF",
+	"J4icA78uDAzjTAPzgE/Iss2v5Wzl4Q/aLnNMPHBBV25mWkavwDw3HVYiPdgWs7VJa5xG2g2gaky9YCCA",
+	"54JFvvrUD47Yhmu2EbdC+7nR2yjMIUlEqyDoe4ODKc320nRZt2c+NR6EArfMThU1eb5gtelZYnwMtrcL",
+	"7LuC84YVEVyLNZVw69QXe3630hkvrNNipTuffwQ6RlOSfFIH6SUK3fF5TDyLSDLvsdA9PEhQWHKeKlSR",
+	"DbAtNvKOVUq9TTlNy9pqTutfqc0m668a23qH7Nu9ghp+cO81jDXOqHKP6bRP1GYN77BOrcWurSFcLF1m",
+	"paX3EmJtbWVFN8z9tv43XePyPZSKPKob8EqwBH2Fao9ucb09T52JV5SP6ilKkanzgKF+UVlyWVvFOHN5",
+	"rJipVMIF/F4VqWGozG5Ek/k42zmFkQMUbvAkAlyOzqNpQEMGUJfVU6ooC2g6rfMKZbRV0EOnTHvQzgxc",
+	"fIf6a4OORCLKJ8qNe58e2I6XrFBaiyLukQ6gI6hkbdrNRhZS1Ha1EfPAIsut6auDGn5golbtdsc2Ygzm",
+	"0qkpGqVtKKInXX4c7EDluGNe2xocdgr+vdJiVSlMj5rK3LYB5iT3PtpabZlqMLULxc67HFfdNk7N1dYY",
+	"prjSExGqhCuKcgQUuD5RqOPMKeEpRPmXViQ2HH3qOky/hj5U3pHGAcZAi15RDrBMWn7YAmjsMUSNx/Ai",
+	"4Y82ayLqdCPvkO5FKqm5y843fq10tM87Wo6JnVSAJJGvDz2PZt7andLyp8C2pXZsfEiGvNfYp5wo5QbL",
+	"zATltarF6BqEjTUX7CVxGcPSxzy9u41qcLOmaOlldFZCM0Z6Lf+i2Sgt5LZm+DQ1w4hg01W5H+4U4UFt",
+	"3JaHHktmVPdyxaasVmgEEbqL3h2R9QgPo8OSRoQRJh/G21XfiqjP9bhgr1qEZtNWKd6ELiaD55+PacE/",
+	"aBjCQ4UhA90kQf+M2aZcUxxSOHKlNDDKxWVwS4NfsFfUlubHhO9h+pD4Hw3XmKXc9uuAOtCKSop6WLRH",
+	"e/TWyifRd+k9Cq6pOFchWFtTYn90vb3dyUpMJP9c7XmTS5qPDRg0iBJXYYjPMtjdQU9l6VwQywhtu5yB",
+	"yMEcNqV240bEMmJz7t5wWVNm66Q896PUtN/wJpP0d0XkkV51goysClfYybA4aWHksDXD78iDOUNKmeMP",
+	"NlrYcF1znL7gJWzVXhZpvv/PlUk569vVYZdo9Qq6J7nzXI7Mu6wVF1DwyKU7cCeipwQ9YGoJVNx4pR7E",
+	"6WpffmeQ55c9hplDMm5oCpl+6OKeThCf9TQZrieAnjexHX/1ZLLLT9qfTgEkG9M27UBK384zzxo2Nj0N",
+	"fpozyxRT6RVoSlF3lpI7lniE1dNVG+XI6JOP+5AJTnj19dVnH3/yd3DKhwaslFth7ODyOCF+e5+C93+9",
+	"+vavfsgOblQ7Ua0EEgUhu/FTSjqeKOkz1LvGy4pnn+IOsZCdYpTUwxUT91o/03su9q/IMbrpBkyH7qP4",
+	"5NKL4mXfuRQPxvVZzUzuqZoQyeiFvSqyeoABAAiprLduD+B/vVe6N0tZtSV3I3IYGAA6812EOagfBhuM",
+	"cHagrHgQUKO89wHAD8nquaTAbLodgNO77x91KWLvBfy7aSrviRa55N6vOtLS2IQE0Ly8kDItOF0AKCFW",
+	"3flMimlY6LivPhi9znCeoEJgVkUJ8FyBd+zng2FRBeF0qnJkC3a1NJ11GvWcafWJ8+hwqQAzrgdNs5pO",
+	"+/0aV7iem/w7mfhr4kEeAZBPB96DYVZS8FPBINWEfyCueEbSet17//Z0Thtp/Zy9N28UcqBqF+PLGqEH",
+	"r93BneyzVg12evxWH2/yie+CnmiZECY2XFaiXPHEWbsOPhLLyNJLWBkZC6Rx56Dg9GgDQueygjSX7DV8",
+	"ximZ7kdDNdzuPFKg+diTyWUo4FpQIrQ1N8IFBZN7pKgERo0NjNGqWVXiRvRe7EvnLoqveXB4dH1N6MxK",
+	"IRqhU+fyNCHNrX0VJYieg92kJZ8QSzvFjpjp07HG9Yq4pZnLUQGiG1mCRTdGwqn013dDAY6eQNVIf7Ny",
+	"yp9y7jTf0QjhoXTl+6feux4TP867jk6+idKoe9g95Pylho4qHxi8WLxLtKwLLTjZYZfdPTQyOyM9fWCm",
+	"L6fRAaAEuaYN5Z7PfkUdLRjRmty9UKfrRRDnIZel4OiIs5UhMopW2jF10/DbOu8YlLpcvGJpJr1KFYfW",
+	"fXknChTynQJblE6FPe394DKywNZD8xGsy7yKOVJDZzTNw/2N9OrZPZ37RO9qPjx82xkOxrBA0rFt8pdr",
+	"mZID7nmZBnbyMLe8X4UDTjLA7HgpmjRUyDWyHASnWb+OcJqcThAbqLYqWQ0kAoq5Hb8RXnpwt+eSrVs/",
+	"EBWsRR/67pXBngnv/6zq2PWTVsRkEBR9YQWSHMa2MhkVCoIQPizsiVUq2X+0vIJSlcDfCXzfDdmqrLfO",
+	"4ZpCAl35DZh4+nWzHNhbSuWnonXLuWNGwx28vOpGAgHKO7Mrn78pbENwrfDeW+jm7qwVg+0cY8EtnhW8",
+	"htsH66B22bfAk7U+pLLEYO//uytCGE/lr7Km4gXtdrCN9PxCKPDDE5ePbD5FCelJoFNGBqINStCSspwT",
+	"/rwDI8mx+J+1tJrrw5kVlit8fh8DO3rFR3nUzraMmVU40Tt4Qls4pYFNLOXcu/CgXAArl3PnGPhxRsT3",
+	"g3+Y0SVpnMb9hEa6B/5vBe8Tqm0Pr1Nx//JYnlaDe53CWt2ttNgcdZvE1n0TiwnmTS+4I7O7DmYVkl4l",
+	"3mH+udD5ModRSrGRdccsZd20NvF+JFvPIUJY7DWCaM14N+WkBBBeb3j17Y3QWpa5jfMRX1zzvbBCU2ya",
+	"95RxfRMaxHCnjgeQpns7Y2FM0RVejJrBBU7CL8m+xvK65LqMm8uaFUJbLsHZ8GDu71IF0OpWLGPMJ52q",
+	"eCTN9Ms1Dz1OCJDq4FxPHuhclQJwlnsVVQTvOVeRatOQh7JvQ64u03DOcGwKcPIzejjN8Ewij/axVxIp",
+	"Ra3KuLeMYTjdM+kk2un8QoI6/rgzUhov4ChdqS3WmszlXuF3qCMAfzan9KzRoE7C5LzF+3nyVSb8NFiy",
+	"xHFN9PvYzpxijptTh+aT/ZwcCz1C5dO88lskK3zqf1dLO8ktvTNLvwYpJSshZuZ5GDqIu9R3RLgJe2qR",
+	"nqzpl471i/Xk5M8BBUx5sruYqjDrLFMZYkKvXldzOLbbmfmK7Z7jcOJWdi97dBhy3l7zNDJku36uuury",
+	"ThG0QgXRkQS6nUN14aLiEgq0oWaJ8Ot92U9UMJN10osFGfBgz4RxLKw/beSqVrztzT3XczoNUaOa1awo",
+	"/lJUArgYdvOQ9mHMBpAEE2hm3cFx3DC+5bI2tkfY0YvjA+MeTvd5/WBc4rd+rqOeQE0xpXMZ0+DRsA1e",
+	"O0SFhzIO4I2LWf+KQlXtPjM+ffME7UnUWK6J11j2OL0t6YrWrzH3Xy3uMaDJVIYfZut3i4biWMtOydl3",
+	"Nhl4hhzJxugLiruK1A5d03uX1OhmhIy+8Vxt8GZFZJAeW+lYtbkcZlvua6w7x0fOtChajZatW34Y77uv",
+	"TrN6iIPNsMRNF0orR8V03m/w7Gh5Nr0JvmI6fg6eMz6zbNgUd7To0jVdTvpRgZ9TTGIJOSCZElBw3eXG",
+	"uvde4ThdWqzf1nalFnn2HUuh4JfZMxfyn17AlRMoAcppntFZyP1xT/ALeMcnBAy/tfdYYM4gla/YfR96",
+	"7Mw1vxkqTJQgPxvtheX+EhSXfGxMpO++Gvl9hWrIs0AbVwdOkAcCkEk63MtUGaXqc2lNDMWTmUaRQcd7",
+	"TgwvsW86j4qj+TgQEt/hCHhxFuGuXUghEVUBf8/5+mPR5JuAlGgpP+Yoobf8Y4mJ3QI7F5Roi5zWylph",
+	"iC2psXARZZ02T48k1R7nfNZKWaZqUPokckWTMgTPVEw4srZC3/DqfW/KcvGV1MZeIT5E+TIfODxMc+iR",
+	"TKgcJFecqzV/zmfNXfFfYOr6Bean/puAPUrec24o53Uxus1QlcUrCpAMgvmNqNktjok7zT7+I1tLykTX",
+	"aFFIM/TmIOOxS7SKqTmFBvMkTiHu7JFcoMfW+b2yDyDjjXdBY3/tBTs4Rw0HYXdEf2Wmkjm5SSpPUd+I",
+	"LBL4S/KoYG3+G0ff5GTmU2WBekjiXldi73JhETMImR8Hni+kzhZUqAeK0sDmAEF1Fu7Us7hMOeqVMD96",
+	"22F6SknRjA6aJ6wLdV9ZpTDfGLxDhVhxu3IuVitSYoKrC4hDCCQl6sB0WZjTYKXqlRYNpvZaNfywF3W6",
+	"Fnkmk9h1nG4/kcyhw9WEo2zWXfEZ/rV2SHCLP/6URpR2w3rgM9RApa1TVGD8x15Rddpn539grMK6zWhT",
+	"sVyjhhziEpm0TLd1wrYzr0R+NzdQlK8BHHwuTTeLNB6K5MbNKz4YpkuOods6fVLiBKsdxNIw1+Pexebd",
+	"hKktg+iXIA1Oy3tvxYGyIrCGS909piORVGmRrQOVr8A0JQMCfE5UHSwVhvWDHFvZK4Bs9vJwHSg1tkaM",
+	"1zlb3O7hNiFpw/fXYt9UcIl4m2cmdTR9JI+5119ePWfWdQQjm6q3dOdK27lKTkVnzTs13bSFqq1WlTnh",
+	"TPw1Og9hoCUzbbFj3LDX37x4/vevvvzy4oTaXN/HNbk64HymG1rsE8ZZKQq555WXY5a4geSwMyzeRdXg",
+	"Gfn9ugcgLOg4X0wetWlynHPKcHNDRatBEuCDpf/0+79584Ndv3nzo1tL6JxJTZfqbqE7dsR0JReMcP2P",
+	"j/9BzgYo+zx6hBM8erR0Tf/xSf8zCF+PHqXr5smUBPbmzQ+thKnh8wjweyV8Ihy5Mdy8qf34PlcSHGYq",
+	"Q1HwyH6X2A+oiHHUCQUa+dnehcpAfwfdyt/Xf/z0/Wco9BBQdqHx6SNYH1InixCTWGtv8mgq2CFpKxjR",
+	"oarT0PTMPvHmJMI1l4u/ifVOqbfJjET0KaoCwVSXNKFL/45Cpij0STVq0d+6Vta/YPpmQ4AdPPrRqnij",
+	"qhtZb10JigdVGu3S9JYnguTtFUpTMlp63EkT33Qk4fJSULH9ydy4p84fcvEiJnzYq4Noo4X4qYNoIkOu",
+	"63csX73feueLJLtt/8CEyV3CGjRCyTqIppS0vuB1rdCx1Vk90/4YxzN2OVCSDHpe6n14yoRCTV1aG5QA",
+	"eES5Y+js3Sp9B0xulffEw5thsVyIGjKo/7Bo+KFLpL9c8GKD/9xtkGfzjf5pQUSK2feaWMvVLbnVmdLC",
+	"3718nllvowwlZzx+SSOXgSmizP8RzfyYivc2YIGT9vAKGLi3usm/J8uZ/jkU03EV0YJY4lQdlMPFvW+6",
+	"0jut8cqUPyteofqBXOpqwaxS1QX78o7vm8rZ/9m/frD+F/GHP31aPv7Dx/+y/tPjzx4X4tPPPn/8mH/+",
+	"Kf/48z98LD7502efPhYfb/74+fqT8pNPP1l/+smnf/zs8+IPn368/vSPn//LB/hyWzxZEKC+oviTxf9e",
+	"QTrG1dWL69VrALbDKW8k1Ct69w5frBtFD+za8gKvcrHnslo88T/9P55XXRRq3w3vf3X78GSxs7YxTy4v",
+	"b29vL+IulxBIIuuVVW2xu/TzvFsO2fiL6xCSTn7veCV07gIXi+4uucJvL7989RoS6lx0N87iyeLxxeOL",
+	"j2F81YiaN3LxZPEH/Amv3x3u+6W7rRZPfn63XFzuBK/szv2xF1bLwn/SgpcH939zy7dboS8wJwn9dPPJ",
+	"pdciXf7s7pB3U98uY5fqy5/7rP5IT3QHvvzZM+bp1iCxVJLXhVihisVMtgZfzMkGlP+zpityolkDez3Z",
+	"pBe0OLfhJS9vpFH6ML+Hiz6JOmy1wJjSyzj8IOlw+BJD8s0SCVHcCH3o8sgCNx7V/DEkcbgkystBdcAu",
+	"5y4wA6oCIS2qe/eN7fyDvTTUZfSPxpGUw9aIivLL4hmpS5RBLtgXPrlv2RZCG+RMLd1wUP9fVBW77S4b",
+	"qTvlZylLyAft8+GuBQb6dL6u8NFPioJD1EJqXC96/SqlqXxBjU3hUIbDe10iT7VXfguu/A4sF2RXMsSQ",
+	"P3n8OCd3h3aXo1HigK1PH3/s+ZlTDkdn7NId3QUJ8Uftalor3Q3+bsS0rmuK0wHmRUz23XLx2ePH7xMC",
+	"KqvDsCWx1Q1PhsV+V7+t1W3tW1LFlD3XB9obpLVwRjxxDnM5m1628CThUpUSQ2lV5A13L59wYkBj9W50",
+	"Jo3lto0ZQiNXeMleamW5FfGXeRx0qtnlWt2d0FSYkxpf3roaK7O6jNjzBJ8ffppk86PGe2F5yS2/JItM",
+	"15QyR49Zpvtdu5Qm/V9BlYmK59GXn5EO3uV+v9zImlfSHrINnAND+iPaIEnaufTV6dIte/fBz5AI992x",
+	"Hq5IjftawD6iSGIuvZA3+No2lz93zd5Nfx1ReSnW7fayS10afq4sN5f2rr5Ei8Dlzz0ycJ9HaO7/3nWP",
+	"W9zsVSn8skMS6qnPlz/Tv9FEqGST9RbEuRuhoxEg87aWcKJ51f3q8w9Fv+AurrQoMCqo+0DFzSLMj5fp",
+	"mpi2aarD+OdDXSR/vOTF2/xg0GD8EYBEZDhjd8DtgIz2Yt8TTpwQCZdWjN+e3HBE+sAqz8bH1wzEjdTl",
+	"OqxZmLtcZ19M86qaDGZN6JDGsv7UyvAOf4836Be8ZD7X829Cfvj08afvD4Le9rG/iAP7q7LsK7zH/0ll",
+	"mXnH57iIslw0ivJ/94/aVVmOiJ60E8LYL1R5mMCYq2DZR1qnJZY1LGGs/Hi3TChSRssahMvUZODt1CZW",
+	"t+LdA3nCIHqFa3ud0Gv5+yEy6vSKgM4od0cjJxQ5R0i4U8h1/gS/85TfeUrgKZ89/sP7m/6V0DeyEAzM",
+	"wUpzLasD+64OqRjuzeOuyjJZU7x/9I/yODBFFKoUW1GvHANbrVV5WDklX2+Ct4IMQiNB5pK3VmFl42MK",
+	"FTqXwlqMx1ebdHXkNM/uGcRtVxoXl+3WHJVeDvnpvR2GvR40Ql9XSjjWZeEeozSqkSRN38aEal2Ex6i9",
+	"K0Vi2FpslHbOmjCAuGukFgZqpKBK3/Qr65pkad0u8Tf6rcHnYdUdpX00URdcuozyqjgdJyulRme4g0v3",
+	"Hkr2VoLfOK+hULd/BAcmbnH5s3wNX1wGRa4elUKvWqt89ez7KHsmxuurff4rs/d/apXTvON/osg2KG7p",
+	"87zen/0wjq5qVu7FBXvRZTLwhY6F82dUN7LEcyQapyH1yjFyg2CvYxhCP6GNNL0gfmRV6C1HuRgofo6c",
+	"8WO7JjIKLZxf3fhMvjp2Jru0DIsnPxwtDo5IMmle6UvfWuWY3oU3Tv1HK1CwdfeKS+y/WEY0OfaAG8Ly",
+	"tbple8yTMWC0Kbbdr27neLDzM/U8Xw5qpqdArQR3pU1ND1znPrp48jiVmGM6vtbBT0KqB4bwp0W/qlZI",
+	"u1RxvcV94MTdAa4czDiCtIf5AP/4O2v+nTUH1vzUW9LPzqCT4qME2RhBTHPv605eS0i8Ox6KO2L2B+KT",
+	"vEY5h3gWmcl45cuwDGqtQj8Ya8w7rxGy38Rr38E/QsAo7z3FJeKavD39dy3A71qA31/e53l5E0tgPHsg",
+	"z/HuprHzD+7OR+V0/wVKEg5OczBmyJweDL92pzHBVEbC65l/jawL9DFw0udMx4c9L0Un6LrgMh8Xr0VJ",
+	"UUXY9BY9RvcNj2r7uw6h0m4QjF213leWv0W2g7xcRd4Qdif2rvjvjSAxSt6gV0uXlckhyhx/3X7jduGs",
+	"zDTa2lPqsCcBO+bO6+eaw1/HGmfXe9JB4He+99Dn8T1wfm9BLEhDK+cUeVSX17Trytf28GJUDxh8Kw95",
+	"yISo5p87A3ENhu2eewfveEmSY8bZqDfxaw+hl9/OdmBHSEucG8IS4iYs1eAaO1wkeK1VF2/qN/Wjvyor",
+	"noSMw/1Al4tHx8spDY59H+K5hz+/x78LVv/UDKYjzpD0lGScc77xfu796byaFuRynwpphd8Zd9X5R4BQ",
+	"kbzRoaduw+faF4frZ2M1F+pNGm53ndpkCOLo2ZRQV4UjltGgTD1SYCFbZUO6NVrU77bK322VDzrYsw/P",
+	"HH16UgL4Mw7MRw/6pVNE9LNjkkoY1DSpt9JRA9KvenzPsvFjF6mUS1QpLJURij64YowDNP/OIn5nEQ+/",
+	"+8d8AU6tYxoJonuI/S3PMDBPQ9lLHeRE39C8rbiOMo0f84S8whGd/+P74BrvXROcwhW5faGNXlIiqMQG",
+	"nlcJ/DvL+53l/fOwvKvjjObMSty34rDnzWLue+iy2IEh2hWPPUnuogISLAwQ5DDuVup1q74BBX8HTyoK",
+	"74KxPvz+5Vcfxd5GI8yQAwMNK51nQn/sRgusleaNUW8WL55+/WbBSrXnsmZGAFO2SpMG2Glyip0o3oqy",
+	"lxO6A6vzSvLR/cUOxvIZAINPVJxtzGU7QbUS1IDJOC2MdUeYMKt3BJ6GrTniLoGVvvuJSDrEeMXzEnmc",
+	"ZXtlLPv48SefUoKPnDW/iCbPX00TqSYGKSWW7/7158fLT94ls4/99iTrmXkaXP6E9B6ztQDfQZOOLV8u",
+	"fDRHYje/f/nVqYcIiOoservlIgy66u9BVtU4PMqx6jF3on8JHaPfp+kleMzP0UFeddvAk5uQYuO/iw2/",
+	"iw0PEhvwLugRXJbWzqgbvSSrwMmCQLj401yQQK8q7zdMKUXMsmdtEUyLQjZS1HbkIIN3P4yEntB7deNt",
+	"OJ1JWNWFCEaNJWvrCrgyukNSkpbxffvlXcrLJq33OXrdJswUTgYgTyFZVV6/nRUHUrdwQMr7uYVHXph1",
+	"VCBnvLPG8kPkzNjLRsD4xlL+ANmZ3jrfQqzS5VLgOJd2VbO1sjtnpuIWNzWHGNjao46cv22hgsh/wn53",
+	"/pP0S9y50TLmXahZF5bfr83fr80HXZvE0pMOm90JiQ3/J92eQmhzWbaEBLGquBV1EUVfu1wm2lxSSbDu",
+	"i9m1tlS3UYYaU/PG7FScOAF1BJRcfRwSPozgp78vb7m0kMxthdHiK2S5ic4++6tJ/Xb5M7BGTBKg7XQD",
+	"FcX0W8Er3GtZicGvpTTcGLFfj7/og27rwY8+9yjgrVDbmmrC+RZwNszwbwdS9HMyvU/86yXvh+j3vkFy",
+	"ZmGs3PcyLfSa7IXe5r6htJCbd5TeIvXV5YnINVKqwi3PzaFFQYc0/bErgJf67ks5Hvl8ue6nF0k3wtQS",
+	"xxoZga6x42102ezM+JdeLooupWScYQ0ltZBb7Ycf4bo2Qt94Ia5LGPbk8hJLJO+UsZeLd8v4mxl8/DGw",
+	"iJ+DDOFYxbsf3/3/AwB9b2lGghsCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -21,12 +21,15 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get lease suggestions and non-conflicting batches for the pending transactions of a sender.
+	// (GET /v2/accounts/{address}/transactions/advisory)
+	GetSenderAdvisory(ctx echo.Context, address string, params GetSenderAdvisoryParams) error
 	// Get a list of unconfirmed transactions currently in the transaction pool by address.
 	// (GET /v2/accounts/{address}/transactions/pending)
 	GetPendingTransactionsByAddress(ctx echo.Context, address string, params GetPendingTransactionsByAddressParams) error
 	// Broadcasts a raw transaction or transaction group to the network.
 	// (POST /v2/transactions)
-	RawTransaction(ctx echo.Context) error
+	RawTransaction(ctx echo.Context, params RawTransactionParams) error
 	// Get a list of unconfirmed transactions currently in the transaction pool.
 	// (GET /v2/transactions/pending)
 	GetPendingTransactions(ctx echo.Context, params GetPendingTransactionsParams) error
@@ -40,6 +43,33 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetSenderAdvisory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSenderAdvisory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSenderAdvisoryParams
+	// ------------- Optional query parameter "leases" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "leases", ctx.QueryParams(), &params.Leases)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter leases: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSenderAdvisory(ctx, address, params)
+	return err
+}
+
 // GetPendingTransactionsByAddress converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Context) error {
 	var err error
@@ -62,6 +92,13 @@ func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "format" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
@@ -80,8 +117,34 @@ func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RawTransactionParams
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.RawTransaction(ctx)
+	err = w.Handler.RawTransaction(ctx, params)
 	return err
 }
 
@@ -100,6 +163,13 @@ func (w *ServerInterfaceWrapper) GetPendingTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "format" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
@@ -127,6 +197,13 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PendingTransactionInformationParams
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "format" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
@@ -167,6 +244,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/accounts/:address/transactions/advisory", wrapper.GetSenderAdvisory, m...)
 	router.GET(baseURL+"/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.POST(baseURL+"/v2/transactions", wrapper.RawTransaction, m...)
 	router.GET(baseURL+"/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
@@ -177,216 +255,419 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9f3fbtpLoV8HT7jlJvKLt/Ore+J2efW7S9nqbNDmx23vvxnktRI4kXJMAC4Cy1Lx8",
-	"93cwAEiQBCXKdpN2t38lFklgMBgM5vd8mKSiKAUHrtXk5MOkpJIWoEHiXzRNRcV1wjLzVwYqlazUTPDJ",
-	"iX9GlJaMLybTCTO/llQvJ9MJpwU075jvpxMJv1RMQjY50bKC6USlSyioGVhvSvN2PdI6WYjEDXFqhzh7",
-	"Mfm45QHNMglK9aF8zfMNYTzNqwyIlpQrmppHilwzvSR6yRRxHxPGieBAxJzoZetlMmeQZ+rQL/KXCuQm",
-	"WKWbfHhJHxsQEyly6MP5XBQzxsFDBTVQ9YYQLUgGc3xpSTUxMxhY/YtaEAVUpksyF3IHqBaIEF7gVTE5",
-	"eTdRwDOQuFspsBX+dy4BfoVEU7kAPXk/jS1urkEmmhWRpZ057EtQVa4VwXdxjQu2Ak7MV4fkVaU0mQGh",
-	"nLz95jl5/PjxM7OQgmoNmSOywVU1s4drsp9PTiYZ1eAf92mN5gshKc+S+v233zzH+c/dAse+RZWC+GE5",
-	"NU/I2YuhBfgPIyTEuIYF7kOL+s0XkUPR/DyDuZAwck/sy3e6KeH8n3VXUqrTZSkY15F9IfiU2MdRHhZ8",
-	"vo2H1QC03i8NpqQZ9N1x8uz9h4fTh8cf/+XdafJf7s+njz+OXP7zetwdGIi+mFZSAk83yUICxdOypLyP",
-	"j7eOHtRSVHlGlnSFm08LZPXuW2K+taxzRfPK0AlLpTjNF0IR6sgogzmtck38xKTiuWFTZjRH7YQpUkqx",
-	"YhlkU8N9r5csXZKUKjsEvkeuWZ4bGqwUZEO0Fl/dlsP0MUSJgetG+MAF/X6R0axrByZgjdwgSXOhINFi",
-	"x/XkbxzKMxJeKM1dpfa7rMjFEghObh7YyxZxxw1N5/mGaNzXjFBFKPFX05SwOdmIilzj5uTsCr93qzFY",
-	"K4hBGm5O6x41h3cIfT1kRJA3EyIHyhF5/tz1UcbnbFFJUOR6CXrp7jwJqhRcARGzf0Kqzbb/5/nr74mQ",
-	"5BUoRRfwhqZXBHgqMsgOydmccKED0nC0hDg0Xw6tw8EVu+T/qYShiUItSppexW/0nBUssqpXdM2KqiC8",
-	"KmYgzZb6K0QLIkFXkg8BZEfcQYoFXfcnvZAVT3H/m2lbspyhNqbKnG4QYQVdf3k8deAoQvOclMAzxhdE",
-	"r/mgHGfm3g1eIkXFsxFijjZ7GlysqoSUzRlkpB5lCyRuml3wML4fPI3wFYDjBxkEp55lBzgc1hGaMafb",
-	"PCElXUBAMofkB8fc8KkWV8BrQiezDT4qJayYqFT90QCMOPV2CZwLDUkpYc4iNHbu0GEYjH3HceDCyUCp",
-	"4JoyDplhzgi00GCZ1SBMwYTb9Z3+LT6jCr54MnTHN09H7v5cdHd9646P2m18KbFHMnJ1mqfuwMYlq9b3",
-	"I/TDcG7FFon9ubeRbHFhbps5y/Em+qfZP4+GSiETaCHC302KLTjVlYSTS35g/iIJOdeUZ1Rm5pfC/vSq",
-	"yjU7ZwvzU25/eikWLD1niwFk1rBGFS78rLD/mPHi7Fivo3rFSyGuqjJcUNpSXGcbcvZiaJPtmPsS5mmt",
-	"7YaKx8XaKyP7fqHX9UYOADmIu5KaF69gI8FAS9M5/rOeIz3RufzV/FOWuflal/MYag0duysZzQfOrHBa",
-	"ljlLqUHiW/fYPDVMAKwiQZs3jvBCPfkQgFhKUYLUzA5KyzLJRUrzRGmqcaR/lTCfnEz+5aixvxzZz9VR",
-	"MPlL89U5fmREVisGJbQs9xjjjRF91BZmYRg0PkI2YdkeCk2M2000pMQMC85hRbk+bFSWFj+oD/A7N1OD",
-	"byvtWHx3VLBBhBP74gyUlYDti/cUCVBPEK0E0YoC6SIXs/qH+6dl2WAQn5+WpcUHSo/AUDCDNVNaPcDl",
-	"0+YkhfOcvTgk34ZjoygueL4xl4MVNczdMHe3lrvFatuSW0Mz4j1FcDuFPDRb49FgxPy7oDhUK5YiN1LP",
-	"TloxL//VvRuSmfl91Md/DBILcTtMXKhoOcxZHQd/CZSb+x3K6ROOM/ccktPutzcjGzNKnGBuRCtb99OO",
-	"uwWPNQqvJS0tgO6JvUsZRyXNvmRhvSU3HcnoojAHZzigNYTqxmdt53mIQoKk0IHhq1ykV3+lankHZ37m",
-	"x+ofP5yGLIFmIMmSquXhJCZlhMerGW3METMvooJPZsFUh/US72p5O5aWUU2DpTl442KJRT1+h0wPZER3",
-	"eY3/oTkxj83ZNqzfDntILpCBKXucnZMhM9q+VRDsTOYFtEIIUlgFnxitey8onzeTx/dp1B59bW0Kbofc",
-	"IuodulizTN3VNuFgQ3sVCqhnL6xGp6FQEa2tXhWVkm7ia7dzjUHAhShJDivIuyBYloWjWYSI9Z3zha/E",
-	"OgbTV2Ld4wliDXeyE2YclKs9dnfA98JBJuRuzOPYY5BuFmhkeYXsgYcikJmlsVafzoS8GTvu8FlOGhs8",
-	"oWbU4DaadpCEr1Zl4s5mxI5nX+gM1Lg9t3PR7vAxjLWwcK7pb4AFZUa9Cyy0B7prLIiiZDncAekvo7fg",
-	"jCp4/Iic//X06cNHPz16+oUhyVKKhaQFmW00KHLfKatE6U0OD/orQ3WxynV89C+eeMtte9zYOEpUMoWC",
-	"lv2hrEXYyoT2NWLe62OtjWZcdQ3gKI4I5mqzaCfW2WFAe8GUETmL2Z1sxhDCsmaWjDhIMthJTPsur5lm",
-	"Ey5RbmR1F7o9SClk9OoqpdAiFXmyAqmYiLiX3rg3iHvDy/tl93cLLbmmipi50RZecZSwIpSl13w837dD",
-	"X6x5g5utnN+uN7I6N++YfWkj35tWFSlBJnrNSQazatFSDedSFISSDD/EO/pb0FZuYQWca1qUr+fzu9Gd",
-	"BQ4U0WFZAcrMROwbRmpQkApuQ0N2qKtu1DHo6SLG2yz1MAAOI+cbnqLh9S6O7bAmXzCOXiC14Wmg1hsY",
-	"c8gWLbK8vfo+hA471T0VAceg4yU+RsvPC8g1/UbIi0bs+1aKqrxzIa8759jlULcYZ1vKzLfeqMD4Im+H",
-	"Iy0M7IexNX6WBT33x9etAaFHinzJFksd6FlvpBDzu4cxNksMUHxgtdTcfNPXVb8XmWEmulJ3III1gzUc",
-	"ztBtyNfoTFSaUMJFBrj5lYoLZwMBLOg5R4e/DuU9vbSK5wwMdaW0MqutSoLu7N590XyY0NSe0ARRowac",
-	"ebUX1r5lp7PBEbkEmm3IDIATMXMeM+fLw0VS9MVrL9440TDCL1pwlVKkoBRkibPU7QTNv2evDr0FTwg4",
-	"AlzPQpQgcypvDezVaiecV7BJMHJEkfvf/agefAZ4tdA034FYfCeG3tru4dyifajHTb+N4LqTh2RHJRB/",
-	"rxAtUJrNQcMQCvfCyeD+dSHq7eLt0bICiQ7K35Ti/SS3I6Aa1N+Y3m8LbVUOxEM69dZIeGbDOOXCC1ax",
-	"wXKqdLKLLZuXWjq4WUHACWOcGAceELxeUqWtU53xDG2B9jrBeawQZqYYBnhQDTEj/+g1kP7YqbkHuapU",
-	"rY6oqiyF1JDF1sBhvWWu72FdzyXmwdi1zqMFqRTsGnkIS8H4Dll2JRZBVNe+Jxd10l8cemjMPb+JorIF",
-	"RIOIbYCc+7cC7IYxYQOAMNUg2hIOUx3KqQPRphOlRVkabqGTitffDaHp3L59qn9o3u0TF9XNvZ0JUBiK",
-	"5t53kF9bzNpowCVVxMFBCnplZA80g1jvfx9mcxgTxXgKyTbKRxXPvBUegZ2HtCoXkmaQZJDTTX/QH+xj",
-	"Yh9vGwB3vFF3hYbEhnXFN72hZB9Fs2VogeOpmPBI8AlJzRE0qkBDIO7rHSNngGPHmJOjo3v1UDhXdIv8",
-	"eLhsu9WREfE2XAltdtzRA4LsOPoYgAfwUA99c1Tgx0mje3an+AcoN0EtR+w/yQbU0BKa8fdawIAN1UXM",
-	"B+elw947HDjKNgfZ2A4+MnRkBwy6b6jULGUl6jrfwebOVb/uBFG/K8lAU5ZDRoIHVg0sw++JDUjqjnkz",
-	"VXCU7a0Pfs/4FllOzhSKPG3gr2CDOvcbG+kamDruQpeNjGruJ8oJAurj54wIHr4Ca5rqfGMENb2EDbkG",
-	"CURVs4JpbSPY26quFmUSDhD1a2yZ0Xk1oz7FrW7WcxwqWF5/K6YTqxNsh++ioxi00OF0gVKIfISFrIeM",
-	"KASjAmBIKcyuMxdM78OpPSW1gHRMG13a9fV/T7XQjCsg/xAVSSlHlavSUMs0QqKggAKkmcGIYPWcLtSl",
-	"wRDkUIDVJPHJwUF34QcHbs+ZInO49hko5sUuOg4O0I7zRijdOlx3YA81x+0scn2gw8dcfE4L6fKU3aEW",
-	"buQxO/mmM3jtJTJnSilHuGb5t2YAnZO5HrP2kEbGhZnguKN8OS2XfX/duO/nrKhyqu/CawUrmidiBVKy",
-	"DHZycjcxE/zrFc1f159hdg2khkZTSFLMCRk5FlyYb2wayS7dsAmvY0UBGaMa8g0pJaRg0x6MyKdqGA+J",
-	"DYhMl5QvUNKXolq4iDw7DnLqSlmbiqx4b4ioNKTXPEHrdIxzuyhsn/li5CCgRhfrmrat5nFN6/lcstOY",
-	"KzVAXtfUH/VuTSeDqqpB6qpRVS1y2uk7I7h4S1AL8NNMPNIHgqgzQksfX+G2mFNgNve3sbU3Q8eg7E8c",
-	"xAg2D4fCBI2enG/uQFqxAxEJpQSFd0toX1L2qZiHqXru8lEbpaHom+Dtpz8NHL+3g4qe4DnjkBSCwyaa",
-	"nc44vMKH0eOE99vAxyhpDH3bVR5a8HfAas8zhhpvi1/c7e4J7bqa1DdC3pUv0w44Wi4f4Trc6Sd3U97U",
-	"wUnzPOITdIk8XQagpnXhACYJVUqkDIWts0xN7UFzbkSX9dNG/5s6PPkOzl533I7zK8wRReMu5CWhJM0Z",
-	"mn4FV1pWqb7kFI1LwVIjUUteix42Nz73r8TtmxHzoxvqklOMWKtNTtFIizlE7CvfAHiro6oWC1C6o6TM",
-	"AS65e4txUnGmca7CHJfEnpcSJIYOHdo3C7ohc0MTWpBfQQoyq3RbbMc8NaVZnjtPnJmGiPklp5rkQJUm",
-	"rxi/WONw3lvvjywHfS3kVY2F+O2+AA6KqSQeXfWtfYqRwG75SxcVjHUF7GMfZdkkzk7MMlu58v/3/n+c",
-	"vDtN/osmvx4nz/7t6P2HJx8fHPR+fPTxyy//X/unxx+/fPAf/xrbKQ97LIvKQX72wqm0Zy9Qb2mcNz3Y",
-	"P5nhvmA8iRJZGIbRoS1yHzOGHQE9aFu19BIuuV5zQ0grmrPM8JabkEP3humdRXs6OlTT2oiOFcuvdU9t",
-	"4BZchkSYTIc13liK6gckxvMV0ZvoUhDxvMwrbrfSS982HccHhon5tM5JteVqTggmLC6pj2p0fz56+sVk",
-	"2iQa1s8n04l7+j5CySxbx9JJM1jHlDx3QPBg3FOkpBsFOs49EPZoDJwNygiHLaCYgVRLVn56TqE0m8U5",
-	"nE9ycMaiNT/jNqLdnB/0TW6cy0PMPz3cWgJkUOplrIxFS1DDt5rdBOjEi5RSrIBPCTuEw66xJjP6oovG",
-	"y4HOsZwCap9ijDZUnwNLaJ4qAqyHCxllEYnRTyee313+6s7VITdwDK7unLUj0v+tBbn37dcX5MgxTHXP",
-	"ZjbboYNc1Igq7dKtWpFEhpvZ4j1WyLvkl/wFzBln5vnJJc+opkczqliqjioF8iuaU57C4UKQE5/B9YJq",
-	"esl7ktZgfa0gd46U1SxnKbkKFZKGPG3NlP4Il5fvaL4Ql5fve0EVffXBTRXlL3aCxAjCotKJq/iQSLim",
-	"Mua0UnXGP45sS7psm9UK2aKylk1fUcKNH+d5tCxVN/O3v/yyzM3yAzJULq/VbBlRWkgvixgBxUKD+/u9",
-	"cBeDpNferlIpUOTngpbvGNfvSXJZHR8/BtJKhf3ZXfmGJjcljLauDGYmd40quHCrVsJaS5qUdBHzjV1e",
-	"vtNAS9x9lJcLtHHkOcHPWim4PqIeh2oW4PExvAEWjr3TCXFx5/YrX90rvgR8hFuI7xhxo/HY33S/gqTc",
-	"G29XJ7G3t0uVXibmbEdXpQyJ+52pi/4sjJDlwygUW6C26uojzYCkS0ivXOEaKEq9mbY+95E6TtD0rIMp",
-	"W9LIptRhUQ30LMyAVGVGnShO+aZb3UCB1j4e+C1cweZCNDU59iln0M6uV0MHFSk1kC4NsYbH1o3R3XwX",
-	"DoaKfVn6JHXMVvRkcVLThf9m+CBbkfcODnGMKFrZ30OIoDKCCEv8Ayi4wULNeLci/djyjJYxszdfpLyR",
-	"5/3EvdIoTy5yK1wNWt3t8wKwPpq4VmRGjdwuXGkvm0EecLFK0QUMSMihc2dknnbLIYSD7Lr3ojedmHcv",
-	"tN59EwXZvpyYNUcpBcwTQyqozHTi9fxM1n/oPBNYsdMhbJajmFQHNlqmQ2XLyWZLEA6BFidgkLwRODwY",
-	"bYyEks2SKl91DIuz+bM8Sgb4DSsibKuDcxaEmgUV2OoqN57nds9pT7t01XB8CRxf9yZULUfUsDESPka3",
-	"x7ZDcBSAMshhYRduX/aE0lRnaDbIwPF6Ps8ZB5LEotYCM2hwzbg5wMjHB4RYCzwZPUKMjAOw0S+OA5Pv",
-	"RXg2+WIfILmrLkH92OhRD/6GeN6XjeM2Io8oDQtnA16t1HMA6kId6/urE3CLwxDGp8SwuRXNDZtzGl8z",
-	"SK8cC4qtneIrLjLjwZA4u8UBYi+WvdZkr6KbrCaUmTzQcYFuC8QzsU5s4mdU4p2tZ4beo6HtmIYaO5i2",
-	"8M09RWZijdE+eLXYUOodsAzD4cEINPw1U0iv+N3QbW6B2TbtdmkqRoUKScaZ82pyGRInxkw9IMEMkcv9",
-	"oJbNjQDoGDuawtBO+d2ppLbFk/5l3txq06ZGm88aih3/oSMU3aUB/PWtMHX1mTddiSVqp2gHrbQL7wQi",
-	"ZIzoDZvoO2n6riAFOaBSkLSEqOQq5jk1ug3gjXPuPwuMF1jeh/LNgyASSsKCKQ2NEd3HSXwO8yTFqoJC",
-	"zIdXp0s5N+t7K0R9TVk3In7YWuYnXwGGEs+ZVDpBD0R0CealbxQq1d+YV+OyUjvWytbgZVmcN+C0V7BJ",
-	"MpZXcXp18373wkz7fc0SVTVDfsu4DViZYc3oaATmlqltkO7WBb+0C35J72y9406DedVMLA25tOf4g5yL",
-	"Dufdxg4iBBgjjv6uDaJ0C4MMMmf73DGQmwIf/+E262vvMGV+7J1ROz5/d+iOsiNF1xIYDLaugqGbyIgl",
-	"TAcll/sprQNngJYly9YdW6gddVBjpnsZPHyhug4WcHfdYDswgCLtW5iDhKgJoX5ko6NrcSksVIiZ3a1S",
-	"OJFNHzT+t01p/qKsO0cEE93ACOZKSw7vcRN72Sq92F5KpHdBf9aKcf3Fkz5F1jZ+A8uY3TiPm9bPjaLR",
-	"RnygbtlS5js2gQ0o7iF5Buw5nIop34ijT7Z1DuQuyr0Amn8Hmx/Nu7icycfp5HaG7BjluxF34PpNfdii",
-	"eMZACWvYbPml9kQ5LUspVjRPnLl/iFFIsXKMAl/33oFPfPHEKfvi69OXbxz4H6eTNAcqk1pwG1wVvlf+",
-	"YVZli1EOHBBf6N9o4F6DsoJ9sPl1Bb3QRXC9BFcxPdANeqVdG/dPcBSdy2Aej9fayfucp8oucYvHCsra",
-	"YdUYU62/qu2joivKcm/F9NAOxFbh4sbVB45yhXCAW/u6Apdlcqfspne646ejoa4dPAnneo0lkeLSCXcF",
-	"k5AVOd9VmwXdU46yjnDVRzOxbm7PkXfyN0K2mL8LrI/6vvyF3WWMd3J3OzwOhBr5LhxdwfOQIC2Rnxc/",
-	"m9N4cBAetYODKfk5dw8CAPH3mfsdjUUHB1GzZFTrMEwClQpOC3hQBwkObsSnVVE5XI+7oE9XBaIOY72H",
-	"ybCmUOvE8ui+dti7lszhM3O/ZJCD+Wl3Ak1n0y26Q2DGnKDzoUD6OkaisI0/FBG8GxKEORyGtJDZFxRL",
-	"G1srb/8I8apAy2iicpbGfUZ8pgx75TYWwLxM8OUB5dqMWLGB0BJesWAs89qYWl0dIIM5oshU0XJhDe5m",
-	"wh3virNfKiAsA67NI4n3Wueq88oBjtoTSI0u1J/LDWw9js3wt9GZwrLeXZkRgdiuMIWRBz1wX9QmQL/Q",
-	"2sLe6Ez7BjCFM/YY95bgI0cfjpptMPayHUEwTo8Z0wDOMzpXX3xgjmhDN6aSuRS/Qtxuhea+SAKmL2TO",
-	"MGrvVwjVs7CNUYul1Nbqpi9dM/uu7R6vGw9t/K11Yb/ounb6TS7T+KnebyNvovSqeJlAh+QhJSx0XbQj",
-	"2wZYCx6vIJYDy1Z7tybl9jzZ7MNWgHT8VIapCEd2/OZUOph76Rs5vZ7RWE1vowsZmILtbTlgtSD+Y78B",
-	"qk7Rs7OTIACpfpfZCiYlyCYBvV8N7YZ6jZ12tEbTKDBIUaHqMrVBI7kSkWEqfk257YVmvrP8yn2twHpM",
-	"zFfXQmL9IRX3FWeQsoLmcQUnS/t+wYwtmG3zVSkI+ki5gWwLRUtFrhdXnXjqUHM2J8fToJmd242MrZhi",
-	"sxzwjYf2jRlVeF3W3ov6E7M84Hqp8PVHI15fVjyTkOmlsohVgtS6Jwp5dcTDDPQ1ACfH+N7DZ+Q+xnoo",
-	"toIHBotOCJqcPHyGnjr7x3HslnVt2rax7Ax59t8cz47TMQa72DEMk3SjHkZLtdg+rcO3w5bTZD8dc5bw",
-	"TXeh7D5LBeV0AfHwwmIHTPZb3E30vnTwwjPbZFBpKTaE6fj8oKnhTwMpS4b9WTBIKoqC6cJFBChRGHpq",
-	"mkTZSf1wtmOhq+/v4fIPMbCm9HEFHVvXJ1ZjaDEQcozhT9/TAtponRJqi07lrAl5811HyJmvaYcND+o+",
-	"BxY3Zi6zdJQlMQJuTkrJuEb7R6XnyV+MWixpatjf4RC4yeyLJ5HGAe3a2nw/wD853iUokKs46uUA2XuZ",
-	"xX1L7nPBk8JwlOxBkyIYnMrBCKB4rMdQwMn2ocdKvmaUZJDcqha50YBT34rw+JYBb0mK9Xr2ose9V/bJ",
-	"KbOScfKgldmhH96+dFJGIWSsUG1z3J3EIUFLBisM+I5vkhnzlnsh81G7cBvoP6+72oucgVjmz3JUEfBG",
-	"p22JXkaE//GVa0rck70HgtNs9Fn9zSdOYIsaLa2E1jKbPfyZSKNJojR6cIBAHxxMnTD386P2Y8ukDg7i",
-	"5duihiPza4OF2+h1+G1sD78SETOO75VSu9BdklrEjDbEas0Dc5Rnbqgpafel+PR34d2EP8dDXOKn4PLy",
-	"HT7xeMA/uoj4zEceN7AJ4rMrGSCUoC9PlGSy+nkQXEfJV2I9lnA6nNQTz+8ARQMoGWlkwpX0+g5Fnc47",
-	"ox4CGjWjziAXRlUKS6qHVuk/Dp7N4qdbsF2xPPuxKbDRuUgk5ekyGpo0Mx/+1PQHrpdoWWW0SvOScg55",
-	"dDirof3kNbmIrvlPMXaegvGR73b7XtnldhbXAN4G0wPlJzToZTo3E4RYbdcuqHPj8oXICM7TlARumGO/",
-	"gVzQ1eaXCpSOHQ18YOPz0WVjmK9tqkKAZ2jDOSTfYhaxgaVV7xFtJ74gV7s4TVXmgmZTLBR28fXpS2Jn",
-	"td/YLpe2qcsCTQftVURtveOL9dQNK+NZqOPH2Z4WZ1atdFL3YInV+TBvNF1iWCcAAI0KIXYOyYugmb8t",
-	"CWKGIFgnThaQBS1frEaBNGH+ozVNl2goaV1kwyQ/vhuRp0oVtESvW5vWJcDx3Bm4XUMi249oSoRegrxm",
-	"CjDvCFbQLi1S19lxhjpfaqS9PFlxbinlcA+Zoi74vS/aPXBWIPEezihkHcTvqSbbZl77Nmc6x6+iFUm7",
-	"nZ56vdBtoYq6ZeUr382ecsFZivVAYwIRlkEY5zMZUTo17uxQE3dCI4cr2l+qznhwWBzsOOUZoUNc3/8Y",
-	"PDWbaqnD/qlh7foOLEArx9kgm/o2ac46z7gCV9LdEFHIJ4WMRFjERI6k9ubuSUaY4TxgbvnGPPveGeMw",
-	"9e+KcVS7HdqcmG3t59jBXhtdnWmyEKDcetplXtQ7880hVjzJYP3+0He8xzFsTI9Ztg1g6w916sPZXPiY",
-	"efe5edfVoax/bsWm2ElPy9JNOtxEL945dM0HERwLovBe7QC59fjhaFvIbWscKt6nhtBghSE0UOI93COM",
-	"uqFcp3urUREsReEbxEbjR4tRMR4B4yXj3p8TvyDS6JWAG4PndeA7lUqqrQg4iqddAM3rmJkuQ1PaOQRv",
-	"O1S3CqdBCa7RzzG8jU0vvAHGUb/QCG6Ub4g/FIa6A2HiOc3rOM5IZzuUqpwQlWFyaKfXXYxxGMbtu2m2",
-	"L4AdDXSnzedYknbfm2io3sesyhagE5plsQr7X+FTgk9JVqHkAGtIq7oSe1mSFMvbtev99anNTZQKrqpi",
-	"y1z+hVtOFzSPjFBD2MDS7zDmE882+O8+rY3rCM69Mzp8uGa2X5HLfoZKTOo1NJ0otkjGYwLvlNujo5n6",
-	"ZoTefH+nlJ6LRRuQz2EkHeBy4R7F+NvX5uIIi2D1gmXt1VLXqMLAVOF7oKPaWFdXaXMlvMp6xfbRBVu3",
-	"FN5uhhhuDjzFy28giyo0edv71ZqBh3Kp0sHUP6pdEQJNyVYWNJjYbQMXO0b0vj9jKFjRxirenfHZrXUr",
-	"Qn0ceR+g73ySCikpcwErDbPoY9aF+fbTPcfE0TYb3F2ES9kbtI9+txpKr/M1b/F5t3noFbjKRKWEFROV",
-	"DwXxAZleJbS/tlpx1gmO0fVHw5w/t/F50FR+4Zo42WU6nfy7H234LgGu5eZ3YDjvbXqvLWlf2rXmqeYV",
-	"Uvf/GNUPpHUrjqkHHSs97GTDVmPUHW1de2T1Yow40G/TOp2cZXtdmLHy1RM7SuzYxZuuDlf3bCp64hEr",
-	"hWJNG55YN9aRkc8X2FA1qE7aH8tHxK0g1dh7qYn0kQD71Co1kwX93f+s8jmgTtcB4q6457aKnv2GSzvu",
-	"+F7SfVA4wjarORxfv/K0jue06SjXVGG1Z9tivZ3AOTqNbD6HVLPVjiIHf1sCDxLop94ug7DMg5oHrE6q",
-	"wBp5+1sdG4C21SDYCk9Qq/rW4Awl1V7B5p4iLWqIds+pM4puUh4NMYDcITEkIlQsXsoakl0IC1M1ZSAW",
-	"fHyi/RyaQrODjTeDkh03nMuTpLk4mjIeW6aMd/4bNZf5dK/iNpgfMFQHod84bFj/eIF92lTdFNuXVwu1",
-	"dHLWL0J97cqzYUmK2nfiC7WB8r/5+jN2lpxdQdgaFD1V11Rm/o2o6cVbdZIt91GveIFvetUFel7PzJpo",
-	"8r6vOlLWFBMz0lwYMSIZym5pB3DX0U/3lA1Ts112MDTdwDUH6Vooo/ybCwWJFj76fBsc21BhY/FuhAQ1",
-	"WErcAjdY4O9tU8EQWypQLOhHXQheuEAioaAGOhnUGRyecxuyn9vnPiPYl9TfaWGq6XV3byefR8BUD4kh",
-	"1c+Juy13ZxrfxNjEOAeZeM9Tt+ggB9n2hpRSZFVqL+jwYNQGudElPbewkqidJu2vsqMjBBm7V7A5skqQ",
-	"b4rldzAE2kpOFvSgWFVnk+/U/KZicC/uBLzPabmaTkoh8mTA2XHWr5TYpfgrll5BRsxN4eNtBxoVkvto",
-	"Y6+92dfLja8MWJbAIXtwSMgptxkO3rHdbtXRmZzf09vmX+OsWWWLlzqj2uElj4eKY1lReUtu5ofZzsMU",
-	"GFZ3y6nsIDvq8K0HqjRKeh1p23k4Vivvu5q7rRQborJQxGSSc+uxeo4HPWY4wnzsoHAAOjIpcZ4uonIR",
-	"C8m8Sc64GSqOqXAyBEgDH5O6XEPhBo8ioG6TuCNQqI4RajrMNXFCffEoz8V1gscoqevMxpQu855qXxO+",
-	"tH7znaG3GQQRR1Q5EWJDljQjqZAS0vCLeFqUhaoQEpJcYABSzDc610YiLDAXgpNcLIgojaJv6zV7L1K0",
-	"/2Fvropzihc6BPEeURTQNEXtUxD3Dam/GTvlXbWXtMVP7KIT62UbCIkE5YqdOAzZl/vwbunwuH/3yItl",
-	"xFiGmPMEsneLSEfke3d2C8Accbh2GwpPYx0w2+vq9mId6oysRcHSOLr/WCFCg4E9MeqNVn2xzRVsni6+",
-	"hjwl5GO1RxhPTx/NwOksj94P7vg5zxjSufkvig3dcckcHD8b4KH9I+1Yf5IOXlAdABBSmzymK2k7MoTX",
-	"R93nVSxssin69bqAjmQ4GD5xO9jMCHcOlIZbAdUL2aoBvG81pqmtzmPDv2Zi7Z8/aMr33Aj4j9upPNbF",
-	"NnKKa9JyTXZ9qv8AR4hGlWwP4rCdzWdjQznq7jkjmX8AwHBwRwuGUSEe+4IxpyyHLKERJJ/VivU0UA9c",
-	"WkC3JxpTjpOn1BrWlkDM2JUEl3puW5p3eqiW1JCSqF/vm794BmtQmBduG0FSZY213mjs+ql3NRhRJjms",
-	"oBXz4vLhK5RC2ArCXuz2Y5IBlOhC6Sr2sWCO8C7vaHtu7UkQDjAGu1H1zyLW7hTZodtFNdE1T+wxUWOP",
-	"koFoxbKKtvCnbtGVerghdU98TKyYaA/EmGl+sCO89QOc+u9joozHxPtxfGhvFhRH3TYGtDO4C09U9NTz",
-	"eGxXWOyhtgrjbFntPbIk3vANVdJrPmxF6ZN8I4mP7xYfIPbrNaQo1bSDl26PE4KDEdUp5DIogst6h29u",
-	"jfssNLyVhAfHi6kaCpDBNspYYyv366jpImxZj12wuBF7jdSMnScc/3f8b4qNe+1ARgW0jTDCzvwvwLs9",
-	"sLZsbfF1Ai2rLzQfpDV1pcW6+iMLwlMLuiFC4j9caPJLRXM23+AJteD7z4haUkNCzs9iHYAu6MtMvF0w",
-	"mXrAvAor/FR23WzsmMFwGzNKALS5AomQzmRf0CsItwF9m5bzpNqwHFXNCqYUXnad7exjwS3ep4cXNIMg",
-	"lwSLVLU7kPmyhebr/92kvoRT+doyZU7TpqOwokXHqmhbG3ni0ksotudG9dVjTwJ1u6SGaKXPicxs6RKL",
-	"v7pOAUoi+J8Z05LKzZZIzZ3u71jAMUrOu8DutZFBMfzOlrFPX8MmvXRLVtmopdz1Lox1sveARk+dL/Cz",
-	"A3xbmM0XA/oU+I/Wjxtaxhjwfy94H+i+E8JrG+18Aiy38qYjsFoT4EysEwlztcufbG2ARhGWTca1DyJg",
-	"PJVAlXWwn712KltTHo1xo0LaELDahVGPksGc8YZZMl62u907do1V0vgmQFhoSUW0DljMh6QEI4ataP56",
-	"BVKybGjjzOmw3T/C8tTeeuy+jSj/9Z3aH4CpRvvBdCxo0n2C18wFnrH5HKSNzlKa8ozKLHydcZKCNPc+",
-	"uaYbdXMzvYFWVka+2GGop4E0004SDkz2SNoWkHzjfEC3NKLXANI7tKaPsIJjGGDEAm6NIloMGL37MMRz",
-	"0+k6ycUCk3QGCNDVoUM3hVVWBEeDrZWH9ptHsV9h+zRYgtcdfC1w1jFTbD9nrxF1qPD8wJneetKsNa2b",
-	"NWXD2uxB8PTPF01srd2cPv3HEt0ubHP9MNmt26vW77X1sdv5YKD3TtuCO7CL6GV0WZKhuVaN92S0HJmx",
-	"dDqrwyao26ot0bOggu7+qYt+6Bt9ekqxRcrUJSPuaROylmR/DwyAZxvcubPVnrb2SJtxxssagfs1DlEp",
-	"yiQdE1Jlq3RnzqDtIG3DOEAfgbl6YN2197npudyqDtEqYG8l5ZuIu50C+rv8MmW6TckeMmgMcNC2sVzM",
-	"kZfhEbZmHAyUr40X024KR9tgUzMJQomEtJJo0Lymm90tRgaqQ57/9fTpw0c/PXr6BTEvkIwtQDUVRjst",
-	"OpqwG8a7dpZPG2jTW56Ob4JP7rWI854yn7NQb4o7a5bbWsmNRxuU7GMJjVwAsVbU/dYQN9orHKeJnP19",
-	"bVdskXe+YzEU/DZ75sID4ws45U5/EXOynWc0jhF/3CP8wgj/kUvKb+0NFjhkjx1OLr0JPTYG2d8NFUay",
-	"Ze+M9url/hYUF5Uyb9Z1bxRo/czJCHkgAAMpUa1klrApZ1P0T1rbLlqBvcOse4m9ahxpO2N3ERL/wQ7w",
-	"whyn5r063NSB85mr572qkRIs5f0QJbSWvyttyi2w8TwGW+RUXa3Btki2NYDa+xLkxKnndarZgGzby0jD",
-	"DpxGv8nzSCab1b7xTIWEYwRLuaL5p+ca2Jr1FPEB2dvh+PUwnSlEskWlulkxpZd01NxB6tLdTc3fYPbc",
-	"38DsUfSec0M5p2PvNkPbCc1tpOHcZSKbIck1jmmDSh5+QWauPHMpIWWq68y0HieXi4XZOyDZ3KXCwVrv",
-	"SBfatc4fhb4FGc995AH5PnBKCDT+NBA2R/QzM5WBkxul8hj19cgigr8Yjwrbue24Lq5aOfmNLB7caELC",
-	"HefmB1V29szN7zeqG7s8m39uLp1KQX+do2/rFm4jF3WztrGFJUbXUsYG+2PqQcTrHpvPsSDFnRRA3qv8",
-	"8W9QisLiyI3h5o1RzI9DxQltAb6BOpid/ahYvjPMoFXV9ON0sgAOiims2/mTqzb+ae9SD4FNj+0fVQvr",
-	"bXL6LWIia21NHkwV1CsdUarUfRYpTIqpJ2klmd5gpzlvhmE/RYtmfFsnYLsE/toD4u4+La6g7vbZpGtX",
-	"yt+u3wqa431kHTPc3EIiPyRfr2lR5s6oSL68N/t3ePyXJ9nx44f/PvvL8dPjFJ48fXZ8TJ89oQ+fPX4I",
-	"j/7y9MkxPJx/8Wz2KHv05NHsyaMnXzx9lj5+8nD25Itn/37P8CEDsgXUl9E9mfw9Oc0XIjl9c5ZcGGAb",
-	"nNCSfQdmb1BXngvshGSQmuJJhIKyfHLif/o//oQdpqJohve/TlxF/8lS61KdHB1dX18fhp8cLTA/M9Gi",
-	"SpdHfh7sT9OSV96c1THJNnoCd7SxQeKmOlI4xWdvvz6/IKdvzg4bgpmcTI4Pjw8fumaInJZscjJ5jD/h",
-	"6Vnivh85YpucfPg4nRwtgeZYzsD8UYCWLPWPJNBs4/6vruliAfIQw87tT6tHR16sOPrg8lQ/bnt2FDrm",
-	"jz600nmzHV+iU/nog2+Jtv3tVjssF89jlh51J30L2lWusBaCSNozWpXd6FOihHTpfaVkwpyqqbkiM0Cf",
-	"K4YOSazFqmXFU+uIs1MAx/++Ov07OiNfnf6dfEmOpy4MWqHaEZveJq/V5HCWWbD7MWDqq81pnRgeNIA/",
-	"eRczBbnWJ2U1y1lKrDSBx8nQSkDt9YgNN0PHX9AKu+HNht8eJ8/ef3j6l48xma8nwdZICnKlQ9Rr4Tta",
-	"IdIKuv5yCGVrFxdrxv2lArlpFlHQ9SQEuO8tixSQ8WkLvrFfGPcVRIT95/nr74mQxOm4b2h6VadsGJCx",
-	"UZMUK4aFTLOg+q35cghid/2FQAOvCnOTuNyPQi3Kdi3FGs3vsQsOAoqH/tHxsed0To8ITt+RO9TBTB3j",
-	"U5/QMAQiMCf2swoVgTVNdb4hVAU+aIwI8x2rOok1okxa4b1bDZj9Gd2WRGOj901sjBT7FZrmO+C76HT3",
-	"aaHDhVOU5ircnUnYQ0YUgvexyz7cWk8jf+7uf4/d7csOpBTmTDOMeW2uHH+dtYB0EmO+8eAO5Gwfkn+I",
-	"CiU8I7tXGmK9TXEGjMz2c7oSE0GQUpPQgE8ODroLPzhoQqrmcI1MlnJ8sYuOg4NDs1NP9mRlW63JrYqM",
-	"o87OPsP1NusVXdcRqZRwwRMOC6rZCkigFj45fviHXeEZtzHARqS1ovfH6eTpH3jLzrgRbGhO8E27msd/",
-	"2NWcg1yxFMgFFKWQVLJ8Q37gdZB10Dqzz/5+4FdcXHOPCKNVVkVB5cYJ0bTmORUPWihs5T+9YhGNoI1c",
-	"lC4Uxj2giGplWl9Qii8m7z96HWCkYrHttaMZ9oQa+yqo4OVh7QT9B+roA1rAB38/cm7M+EP0RFgV98iX",
-	"sYq/2VJ8Pui1gXXHF2uWBStJqU6XVXn0Af+DCmkAtC1xfKTX/AhD6o4+tNbqHvfW2v69+Tx8Y1WIDDxw",
-	"Yj633Z+3PT76YP8NJoJ1CZKZGwfLirlfbfnHI2wCuOn/vOFp9Mf+Olql7wZ+PvrQ+rNNDGpZ6UxcB9+i",
-	"f8A6t/rzmYeV6v59dE2ZNtKNq6OGvYD7H2ug+ZFrmtD5talT3HuCxZeDHzvyUClsuYq2KvqWXl+0Usuk",
-	"zb3/SqD5YIhTrpMZ48g+QvbWWP3sw75u02NqF0uwQZLecRoRHrUgMylollKFLWZde5GeUvvxlopTt1TA",
-	"WcQthmCinaBfksswgsOdvhIcd4x0GOxL0DW9ycr5zSWqHkRf0Yz4+iYJeUVzs+GQkVMnt7ew8VtLQ59f",
-	"fPnM8sYnExC+8odPEYpliFqanYzX4Aj6AI2RBoz6ZxjAAnjiWFAyE9nGtWqZSHqt1zblv8vcjmj7Hmhb",
-	"EH3T/+jDOzAv/r5tirtMiX9a8P604P1p4/nTgvfn7v5pwRtpwfvTvvWnfet/pH1rH6NWTMx0Rp1haRN7",
-	"x9LWvFbvo02N7prFt4sRMV3LZP3m/UwfEnKB9TCouSVgBZLmJKXKSleu6FKBwZNY0giyk0uetCCxIYpm",
-	"4vvNf21s6GV1fPwYyPGD7jdKszwPeXP/W5R38ZHtn/QluZxcTnojSSjECjKbsRjWiLVf7Rz2f9Xjvu4V",
-	"l8bUYCw44isfEVXN5yxlFuW54AtCF6KJazZ8m3CBT0Aa4GyLDsL01LXBYYpcm8W7Dr7tUrZtyb0vAZw1",
-	"W7gzFqBDLvEwAEN4e8YA/NuYAID/0VL6TUv83JaRbh27x1X/5Cqfgqt8dr7yR/euBqbF/5Zi5pPjJ3/Y",
-	"BYWG6O+FJt9gzP7txLG6K3qsU8lNBS1fPcOb+5q43zCOFm/ROoL23XtzESiQK3/BNmGhJ0dHWE5pKZQ+",
-	"mpjrrx0yGj58X8P8wd9OpWQrbIX5/uP/DwAA//+KFkeMLQgBAA==",
+	"H4sIAAAAAAAC/+z9e5fbtpIoin8V/Pqc30riI3U7z9nxXXud27Hz6LOdxMt2smcmzt2BSEjCmCI4ANjd",
+	"Sq6/+11VBYAgCVBUt+xkz+Qvu0U8CoVCoVDP384KtWtULWprzh79dtZwzXfCCo1/8aJQbW2XsoS/SmEK",
+	"LRsrVX32yH9jxmpZb84WZxJ+bbjdni3Oar4TZ4/i/oszLf6zlVqUZ4+sbsXizBRbseMwsN030DqMdLvc",
+	"qKUb4pKGuHpy9mbiAy9LLYwZQ/l9Xe2ZrIuqLQWzmteGF/DJsBtpt8xupWGuM5M1U7Vgas3stteYraWo",
+	"SnPuF/mfrdD7aJVu8vyS3nQgLrWqxBjOx2q3krXwUIkAVNgQZhUrxRobbbllMAPA6htaxYzgutiytdIH",
+	"QCUgYnhF3e7OHv10ZkRdCo27VQh5jf9dayF+FUvL9UbYs58XqcWtrdBLK3eJpV057Gth2soahm1xjRt5",
+	"LWoGvc7Zt62xbCUYr9nzrx6zjz/++HNYyI5bK0pHZNlVdbPHa6LuZ4/OSm6F/zymNV5tlOZ1uQztn3/1",
+	"GOd/4RY4txU3RqQPyyV8YVdPcgvwHRMkJGsrNrgPPeqHHolD0f28Emulxcw9ocYn3ZR4/t91Vwpui22j",
+	"ZG0T+8LwK6PPSR4WdZ/iYQGAXvsGMKVh0J8eLj//+bcPFx8+fPM/frpc/rv789OP38xc/uMw7gEMJBsW",
+	"rdaiLvbLjRYcT8uW12N8PHf0YLaqrUq25de4+XyHrN71ZdCXWOc1r1qgE1lodVltlGHckVEp1rytLPMT",
+	"s7auhDE4mqN2Jg1rtLqWpSgXTNbsZiuLLSu4oSGwHbuRVQU02BpR5mgtvbqJw/QmRgnAdSd84IL+uMjo",
+	"1nUAE+IWucGyqJQRS6sOXE/+xuF1yeILpburzHGXFXu5FQwnhw902SLuaqDpqtozi/taMm4YZ/5qWjC5",
+	"ZnvVshvcnEq+xv5uNYC1HQOk4eb07lE4vDn0jZCRQN5KqUrwGpFH4CZRtuPMCJgYQK+ksV62cEt0f/2f",
+	"F99/x7QwjaoJA1rYVtcLZtpiC0v+hehtgTRgzj3J/HLOvhMGxo5RxneipH0qFbJpYGdm4eiJNw2gUzHB",
+	"iy0TldiJOoDFteZ7oGgBGOfXQhtxzq42tdIwidLsW2EM34hnvHgdIM7KRQ4z02KRZ1tj9NVruWm1MOxm",
+	"K+zWiQwBTWr1H6KwcGoQfQPYRF2oUpTn7GrNamWjk+WOIpIg9MwCT3ClZKT/MAqO1M5sGl68TgtEldzJ",
+	"xKq+5bdy1+5Y3e5WQgPe/Q0ctj0HEI144CTv+O140pe6rQukwW7anigMh1WapuJ7RNiO3/714cKBYxiv",
+	"KtaIupT1htnbOrvdMPdh8JZatXU5Q0q0sKeRXGIaUci1FCULo0xA4qY5BI+sj4Onk10jcGR9ABxZzwOn",
+	"FrcJmgHmCF9YwzciIplz9oO7G/CrVa9FHQidrfb4qdHiWqrWhE4ZGHHq6ZNaKyuWjRZrmaCxFw4dwJ+p",
+	"jWM4OydCFqq2XNaiZLImoJV1jDALUzTh9HNxLAStuBGffXL25tDXmbsPvK+/65M7Pmu3sdGSjmRC8oCv",
+	"7sCmBdNe/xnP63huIzdL+nm0kXLzEi7rtazwIv8P2D+PhtYgE+ghwl/tRm5qblstHr2qH8BfbMleWF6X",
+	"XJfwy45++ratrHwhN/BTRT89VRtZvJCbDDIDrMn3Knbb0T8wXpod29vks+ypUq/bJl5Q0Xv3r/bs6klu",
+	"k2nMYwnzMigL4nfby1v/lju2h70NG5kBMou7hkPD12KvBUDLizX+c7tGeuJr/Sv80zQV9LbNOoVaoGMv",
+	"B8DYl8+uXgIjMs/dr/AjnH1Bzy8YThYcsHuB9+ij3yLIGq0aoa2ksZCj4f+kFTv8z//UYn326Ox/XHRa",
+	"qwvqbi781GdvApgo0NBhC6fjJz9utxqSJWg1Cd6LEtXlsytisUFsq1UpYC6niLrsVnaCtfOmWVaq4NXS",
+	"WG7FwbV3Qz+FXi+wEzxySHBe8qY5YoxnICybCf4IeMFPyBmJ06OYLWuiWzg90jAtKnHNa3t+tkixoXhX",
+	"aKY5m5JHOKOGK2FInqWG7xkWoZ4hWhmiFZ8wm0qtwg/vXzZNh0H8ftk0hA98bwiJsqi4lcaaD3D5vGMe",
+	"8TxXT87Z1/HY+HhToJBcCSddwXW4dhe1u7iDNtKtoRvxPcNwO0G9F9GdMcKeguLwVbFVFQh6B2kFGn/j",
+	"2sZkBr/P6vzPQWIxbvPEBa2Ywxy9ivGX6Dn8/oByxoTjFITn7HLY925kA6OkCeaJXK9PQS85lfvLDjke",
+	"qvORjgu0pfioXaJIPR7l1auf4Cp89epnZpXlVfR2iRQsTpYM01lHMlalyCHMSc+KU0+61mqXmbbDaw5h",
+	"UQtH7DGfUjqmiGLL6w08Zlf7Icc5W8y8LEc89DEOOr48nVo7Bzd+cxD7IzABrSfzY+GEfnkIV+pWZADE",
+	"Tw4+VNB18GxFFd5JYTNJKRch1X1B8EEUOBb0L9RtHnAgmTTca6mNJywncJRyvU7Tl1XpQSo+d4wBp+xM",
+	"WgghzjA8PYMTHOhkQO5+d2aLW8K6LQKYedgAthL2Roia2RtFazIRU/u+rmQtamHMW2dt9DHozGj+JIdb",
+	"8YrXhVgevOFutsoI4PGyFnDiXwPJCrkhU+C1siLMF96dYyoQldzIVcq6+PdIg+bRSaPKuhv1EZOoUHNw",
+	"cIuf3CKoycJpgFmt6uWvQiuCFi+9hmsrC9nQsXkt9qgRl2VvjgjyoEQFHao2dunhn8JXfCw6ZUzFrTAW",
+	"rbdBcz5aK4pvvN6nkUdDTE3tJukdp0qUG6dQcy+C9OiE0nkbM70DaQQ6/dzSPeaS8F898XC61rBHTIuN",
+	"NFbTpjmJAo6nKP3V06PzTiOi1S6B0Y74wzjTq+4tjqGi3th4Ux3dGQWWFvqSBIzsJzRlGkkHjyEuv0fF",
+	"8aBM1unNBepaEgkjwc9i6NhS2n3Yk/HpGSzwADUMAar4NDz9u+EtgwO0iZwiDUvM+ebMgwaynSy0AnHN",
+	"LJgWN1yXxnt6lEddcV5b12fXA5YQE3Q4zxHPHSxz7m3XUVcf4/2rT9aM08qjK+9OF90MgWXiPeSnZDea",
+	"N/TQcF9IDUhwukbxm+NlZG44wQVtZF2Iw8esUNdCi5GMF2v4ZF2K2wS1pFVxrawt6Y2jMY7QUI2QcVBX",
+	"RSsdzDeXuAZGnrbY0gM1YuaF0sFaIE2n09pogaZBB/JJpCo31Hx0DYAAlUxrUmL0Qc7ueYof8egdT2oS",
+	"Ft2a5m5JgIBxa8WusUO+6v52e+FlJ7Lp24gH+HEIKSfYnkZoqTJIpG9zsEgXf6MMr8zSCFGnB+ze1aU0",
+	"VtaFZatKFa9Z6Mygc050jGZ7+1u/ODNWNOkp4MtMtKAMejztv7Ci+RG7HmIV4Z6ijXRgj/bDQzKXYg0S",
+	"2GiRecrsaO7La3Ea1uEmOVY6xxPk5Acrd0G0QBvJgoHlR0WvBYHgshuhhfNOEaX31AkmZSaBNO9CRTT8",
+	"fAoY4DHF9+54D3Yj05rveAtmrqr+ZoV1z6U3twtiJ9F7ZbUfKmTyl9Y9LTAzdyMpH3WfY/00QmWMsN8K",
+	"y0tu+Y9Cg7r3ZFairMNp0M0xWYrayrUU+g406+BabrnZpieBL8GtSVg8Mzu32gUDTLbWOyRBW5pO2i2q",
+	"SjuL/N6K1CvSA1CJemMzIBj5q8iDIMGMa4W5y4nVWunU83VP83hjuJ+MrbmsQLN4sxXEHa+FLiW5KbW1",
+	"Bp8rvqpQndvWpm0apcFq0uoq+YTu42sC/6ENizeM3XDT34EFM1v+0aefAQBmyz/98KN/fPTpZ+fs+5px",
+	"tpNmB66jC6cGoqZJwPyCJ+hC1ctiy+GZ5pETUwqS5iwCaHWVnuCH509Ho416O/xnQGxtobpb4To6m+jD",
+	"gNh41N9h/E2Y/o+wsnPsIU2qU6mEqd+z1HncFRG+43tyL12hjpPvGqHdruHQtQIyedStF7qyWgEefIPe",
+	"tiSajgEeUCH1GWKWFRygX3Wny10kqO+iYQJtPzpwNIwQDM8V7Jf3REDEnC3OPP7OFme0XvpPn9wWZwOo",
+	"8ZcAQNoBpPfy77ztqbenkjlX1Pd5ovG/qfV6SPvuOQ8Thzvh9HcUDZ+4negi6N9LX4C8/ZWseSXt/gR3",
+	"Ecrvy63gZcqch7Mx+soAJednQ2SnuTF2/IZGhftA6JQbf5BK4TttiAhOWwjZUfM97kY5Q2fOzdYu4wUu",
+	"G63U+tCGPIV+0QKeYSd8UHB0bZsxBvohuI4DOu5h3KFmAtj+tAla7xluLrx7659b/l94y8eswr3D3bZZ",
+	"tSFVfYhLQ3ZWCwECuFXE//ZMWsPWjpecB+7yDTfbU3GWb5KSRo/G8FY7O8T9u9Hm4OMbJ7TwHl66JZ5q",
+	"ee/6+HyP/+FV7/TQsBCOIY0zQ4XgybITamkm9xAGItiR5z0DfnH3Q5fap1l79CU5+7sdcosIO/TyVpbm",
+	"VNuEg+X2KtYrXz0xPUeBkWQ6qcqJ5pr1bFYNq8S1qIYgkELeMUNAiLo9udTxhbpNwfSFuh1JHOAHcIqd",
+	"8N4es/QoX6jbJw4ypVNKFHB9X6Jr53hjfzAu8KXhG1kjeO51t+OvSZmukD/C7gkTAk1IMYGDdqzTOfE7",
+	"xxh4dVV7PEI0oNLeW0WLHe8ZG3OsbLZjBexGzXfCeEk0VmcsopDBy5XSd5NMB/dIzbpASMZh1MgwtBjq",
+	"96Bp2ywdI0lEA1GDwUBd7Pk0nobDpzDWw8LXohaaW3ECYp2rn+6wdQdFRdtUih8wtEfbsZaVQJUEdhMl",
+	"U3UBNj1prahTtvOMptlNO1ezF0EQtLMwqXtOI1QkLHU78ZSvRHUKDfJEEPAAtgqmTGoTTrKXOWR2ne6C",
+	"UASabRzd9nWjztc0aOg77L6w/C2cdmN5dEjvcdr7A72N0942J7Of8YJAIDncHLJ7UStWqpuaDuFdtLPz",
+	"iXol4LYqeLvZWjJ8JClcGCt36MltLN+IJdynlYAhM4kEYJrQiYwssVUIR2FuFGEYt6iQNaJQdWkYmgyw",
+	"g2gUaB7FrdW8URWOBi5G+LJotNqgz51RbM31ObsiLylnIgjBWVul3ZTGudSFnngULNsJbloNeiheg23H",
+	"ysp7FG0E3Omim43Ckp2Hl98nGGi1ByiwX6XqjTBu0jvsYKNVIYwBz/nIOj5FN75dRDm4ljDSvaBATflB",
+	"0t1H/ojDa+U0cLy+PgjF3348KQ5wBzPHqEfM8brb5pEjkGUgEP8fio8n/WA/ZIC+APwjHC6cHdM95hOD",
+	"Bu3GuLP3jcLPZrIzULkoBHoBSkunwdxIUE/v1LUDF+8Oq+j/4satNNbbyhreGtfibHE2QEPnPtVfydni",
+	"bADe2eKMZk4obt22LPEimOBAGb6D3UQ5xXLuRikzgYmvsZODgQEKx7ONU4ibNPVR95xVgQzvPOFMpnCK",
+	"Fbpjewe27HveZ9KjMHuKCWdi9s5TpSQ0nyKHGG/vWCWO/Yjek3dnauNi6hleMQMUjG/CAa0vRlLeeNeO",
+	"8Pzc+EACHnNxxzZQUle7RlaneIamDbUQB//xR+zFN5fOFAzAIGB856759134MTN2X4kPks8idAlPj/7Z",
+	"Jz4XR3/c1DhGtboQO57wtaIcH3SwqRmDdikbRkxozl7oAJy1MwJ0ooR2Rtl//EZUkteFOJlP07HuQBgB",
+	"1QfjoB7xSNcbsvZSujcUCYqK36wwnwoOlHe9eYzxnz4C+wTYofD13zLh2J4UUMGWfMhk9HkwAEWBxSMs",
+	"KB9R8Gn71yUE+i0vn10tcT1B63/o6YlQ+8lnv+JdbqMQYd4h9O9itVXq9cl1tm7cHEQUE0L+B77l4uyJ",
+	"NEAgu9VJGFKOaZTdLCVzp7EUBzF/7BHvptlHx/yJ3uv2FOQbPIdSYTFWFapaXgttpErQ6DPXgrkWPqS3",
+	"Gf5O0KKXD8yNRNTWaUKFRBFHeKrT0C9v6w4304wG15tYnZt3zr70ke8TxhjWCL20tzUrxard9KK/UUHA",
+	"WYkd0cDxlcAUJKfgz2s31GycubkP4ioMPNsj8rYRWu5EbXnFfO9hFoqv0Aj8HBm0rDcnQIDhoLU5Yv0R",
+	"BEK/wN4HkeEnmYsL196vfo1z+nsJzTtfC7K/v5Q78QL8qL5fr0+TIUHhQIlLRe6EgZkYtYjeeTP0v27U",
+	"OQgYng3vZGXzADiMvNjXBWYUersWjZ2sMb2Z2ddFlLyhi5Q8aZKGHDpoqvdMAhxAx1P8jE4WT0Rl+VdK",
+	"RxE5X2vVNie/cIdzzl0Od4txIY8l9PWpI2S9qfppijcA+3lqjb/Lgh57Du7WgNAjRSa9ZL5o6/IkosXY",
+	"HeZYr50/sAdQYnEndgDCwQ65AeGABoOYjMWDp5gkr4skAk5PgGk0jxeEH+iRPV4ZAaw2G1lvXggLKzmF",
+	"7ADSJQiwS4sZNq3eLwtuxUZpmVOvd9/xbvP9QlAKZe/UAs0YxkUZzXUvAfXstcg4Ule0fPIgWfgU6A2v",
+	"ZbFga255tSCP3QW74bpeoAiGz0OUyJLCpmpt09qkQdqlc6zUhknjjc6PmNmbSm0WLvjYdjEF8BCPOmhR",
+	"Sk0Rs1YtmNIhua6/aWjuToPt1K/kSp0CttskUeO2TRvSaVBRl2a0TTNs57QRAUOp2RcH6GeurOQ31jjC",
+	"HoqM34qd0vsTkv2KVxU39nCUxg5nZq79ZIzGm8XZplg2Qhcia+Z0Sv+vv//6Mb3uF+whOdXgTxJWnsmc",
+	"UslrASyzOQw0NAW20Sw84D7VMJgTA3bxw4brFVk+q0oU5DY0vUhCyTKTN7a/zG+//Pbp1bdXL/1ip0d2",
+	"efvTAhvO2o2w6Cicyx2q7Vsjztm/C606B0D8XgnuDUWD1SrdkRyvVC1mSH0OyEWgod62D9ATb9vcw+BI",
+	"Ln8W9EacOErdv7hTwOiNKDFlJvCxaFriv8DKMEFziI7tx6wTn9MlcaS9C/bjTSO49p+dR1rvmhil+qxF",
+	"+fK2Do6fPt9/wWtVywJTb/tUynGwjsuAPCffpZvkiJD3nMLgaPf0P/F/Uvy/WRyFSRStvlOluIeHTX++",
+	"brBOOQSYjlVCfKVay7i7pLFx2v9oym3GMdqevxo5PI/daJJBi6Hj8m5eQTgdFRuotODlnqLC1MqlUI7i",
+	"r+Dmabi2A7+E5E0QwXUPxxPUutkJPHVhbGEW57lzb2BnGCpfi/0S70XD3v/bj+aD3wHeOab5YX7BgN7g",
+	"bz8IsO8ZTWdMP0Vww8ljsuOaeBdQLbMqOG/lUHgUTrL7N4RotIv3R8vdbfpHUJCf5H4EdIxh/j70fl9o",
+	"2ybjB+OcK0EzChtW81p5hWQ6+Zqxy0NsGRrFazGwgogTpjgxDpxRWD7lxlKWdVmXGINiOgEe+zCXMCMD",
+	"cNaCAyP/SB9TYxeqNqI2rQmWnBDOmloDxidk5/pO3Ia51DoaO5iLSIY/NHIOS9H4z33+i5CShXEb5c+A",
+	"4RKLw/y1WHckicoeEB0ipgB54VtF2I2LhGQAkaZDdN+CncoWZ6xqGuAWdhnHG2fQ9IJaX9ofurZj4uKR",
+	"WqJUgnxSXfvgZoczkI/glhvm4PABJ95tJAkzHMYlupYtpygfTSPQKj4CBw9p22w0L8WyFBXfJ0Jl6DOj",
+	"z1MD4I53lkJlRTYhL2x6R8neVX5iaLUMeXYGIymX8bKAIwgCfkcgrveBkUuBY6eYk6Oj98JQOFdyi/x4",
+	"uGza6sSIeBtSQjlPDwiy4+hzAM7gIQx9d1Rg52X3ZBhO8W/CuAl8mztMshcmt4Ru/KMWkAkTcO5l0XkZ",
+	"sPcBB06yzSwbO8BHckc2E7PwfWOvTuGeQMkYlmgvSl+2mBGo08FqY8m65Ng9DYAfjdy1lYuMm0qwCl1a",
+	"LfJRH+R5wo2qo0mhFxwCmnzutFGmElkvXV7HRDUmn9M7WApd02EOSoyPgjpI8COCQsW2EOd3cr2clYt0",
+	"ZNtzyalWraws+WwTFgTcxHeAwt7WRAQ5qXwEALpKrYR/8CMM7coFYsialCKzU2QjPQ+Nr7MTnUXQ9zf6",
+	"DvkRPX5VYwc5EmUNS1aaqRYFY5fxHFbey3P9ZnH2LE7h+XjLq0rUG/E2E1J758xkyla2EhCfYnLBPsEU",
+	"OcbMj8+/IhNfeAr41bAdXG/BDmiE02/DhOev6lf1g++UFY9cqQbD+k6g5w/m5PwJgy57a4LsxmlwOyje",
+	"//H5Vx+wpl1VskAc5PLZngbWbE7ZqSV4zM8zxzad/TK1CXy8tBEpfnnb3DWqt0+HRnC4N7L7MCZBgrGq",
+	"YAHSGmZEoYU1C0ZD+fASLQrZSIHlNPBUAsBvbZuiZczbAwfsYUz/TewvW6uei1rc8FPErc63SGqY0+SS",
+	"N5NeFKtjNlKLdILsSvAyK5N+o27Yjtd7L49GpfnG295PX0xzGiKAtiiEMUrDTsraWCDpXG5PQqOZzPgI",
+	"04VxvD7A9ewU+VGa/lk303BX3Y6mTOs+dfYhRY3DG3LNgATaHC1cqnxXuvuA6NoZiuMdiyCJUDfb97u1",
+	"CnToRcAdOgEMCSlF8Sf37RhOkD6UpbAkDkYfiE/2waY6acMx72aQuBPtJASapNsN1YedgfNvhdWyOIWJ",
+	"ckcjHZvBOgXNQbHNzzU7QKaHCNd7MlvyCFEv/VVyVyrtYyvcTBM3YCR5IH8GuAxeIMAGSfeU4M9WvZWb",
+	"rg/xUXKxv4HHGBZCP2kJa+Ipt6Iu9ifJPy30fEJMAXGQAmmKuVgo/fB409TFnm2lsRjDZLpKGjAiIgVL",
+	"apwqJ1QIgVhyeyDKlNzZIAggdDoQZp++bEuxFlp7rcBBs0OoCDx+Q1Vibf1ricSDfchyIy2CisW1Sya4",
+	"rjLqAki4TB2ngtJ3rp6yOyHBX4f7STEegl4wY824WncYTENxGILhzN2CczINVqpYTjjkdTUhXGOX3Okw",
+	"uH5wza2YO7aOaqnMGVoYWbbzR6fmcyaYoxFJEXtGZiLN25I0SukkvkMdS6NUFfTtvBzSt/GvFdrfR9h+",
+	"KXaN3bug++W6raoFnk3V2gVT10IvV225EVTNGtvwFa9LlQtbAyvpWuSozbS7LtNxFwYBCx0lAAvFnQjc",
+	"Qc2UNMqi7ku8YA+xgTkzZ6ZKp1KjSkXHr4woA9VPC2ZDxXOrgEfcIxVbqPQQc+Q+baXQ5tc35qu9TR5w",
+	"mATbG3KMwSEfH8yZL9p2t+N63zuXzrulO1mxcbW74+7tJTfwzx6PyiRV4YMN8aWlB95FTNzywlZ7xg25",
+	"YFHWfq+KHCcdgv0aFm0ZJTGamNH5aCWz+k0mOpzhgOVJYhq+lwMXieSBUKqa4205REYSgpn6KQW7LtFX",
+	"rTt3/jXTA9KZr6q9B9cZzYY8+Jz9m2pZwWufvTxYd5VGkym54xp0yermdCVROwyh8zT51OCXBw+GC3/w",
+	"wO25NGwtblBU4DU2HKLjwQP0aHumTP/5cwrRl2t7lbj7ULaAI5lUYlIVzWn53408ZyefDQb3k+KZMsYR",
+	"Liz/5G6yc9Ye00gm0eviDOITZL1JHJ5nnkpZo9WqEjvD1t7wbbcR5+j7MEIKqL1ziXKPN4xgCK7QHXZ8",
+	"iimApnApdQreGjFsRwYULZyk5MViaWYrp16Ewf5OC57h0zmTCl72EoiOaYDOAFaUEfq5OJFe+ejSUB4C",
+	"cAdNVoQK9VrS3jn9fH20t5l3iMy6xnwl9fyRhsoQ2ZmOO1jvUFAKgoORjtAgVdiWV6NSSp0sFSpL+mne",
+	"LM6ei0L8QSq0aQTl9yvQNkLF263PNl6uoRoRPvSTG+GuPMEaLdbyFjdM2dMm22i0uJaqNZQFd4nqem6T",
+	"7mZe9ZDRL/xQy1ufy4+y63XuYX4WrFYSpbtAaa8oRGNzdoCJZB7gMDUY7/CtSOMtJtY9v1jxTTcx8fxQ",
+	"bCq7flWLeM2wwheiLoW+LK+lUfok1RioTkmunFs9ftt6Vo+QLEjWgC9o14c7i0Z0CyoVXnaFqteVLCzZ",
+	"+dDSgmrP+XaWkfT/BcyTDmHkRpilrJetSbCWp/g5FPU+vMbZMOLIP6DTyl1qD1osGn0tC0GqL1+Qh2du",
+	"HNNuNsKAjxCtOLNU5px++5Ghfvm8TqJgAgNDxXLDrRUapvt/3v/fj366XP47X/76cPn5/7r4+bdP3nzw",
+	"YPTjR2/++tf/t//Tx2/++sH//p9JRcecN/cIE0MiWAQ6n3NgCW1uUKQHOK81vtmJjAFbns59OGmOkLhD",
+	"Ih7fbWshvR1EqLyDZMWU7Hec/9EF0zLurleXYrzRwojaKWC75kvfnB5R4vxAKItrPsMUfaOVFf2kZh4k",
+	"INdKsFJTgoNz1iUjJjso1hwYLiQKTdi1lZVxCpBoEjhE+ppXrlo02VDWvDIuBXLtjTITlbTvVgC8Hxq5",
+	"EhteM7NtMdYQEx+es79DE7/uBQQMa2dLd4UIqepWoXb+IaLiGUpuOZiDTpd7L2zo4oicGy/9GqXpLxCP",
+	"gfNGO0l2NF4tQTjUshSHH0TBGfDLa159H7q9WZyJW1HAO74QeMrlZuZYEAxaiMfU5UAkQcfr5W4nSsmt",
+	"qPZRhlUkxc5h8Zxhfgdw56k36BeuVYu1/qXxp0RowVpDVKDbejRERqWad+e7dJXbnR6r84wYGXDorN3w",
+	"MJ8oexfFTOQNE6ok00hh+kSTlTSvu8AGQo4jrFDk4+A7q+fW23MY9BPPzDSDqAOuP8ZXvC1wCmremK06",
+	"XWXeTBlCfP3BJ7rR3azeR5bK/ck1k5aVskw7AA5d08w0cw9zbFVVmgN14oFLLMhzljjbRH0BACVX3TFO",
+	"BhEAcD7CW2XsEfMdkck/4ryUemcIwYBVH5h5tltwW6M+Z942hIDUSdRSHcf0YrfiNlgwX3xzuYQUoHGd",
+	"Rj/VbMSCGfRwKoVuBSHvwEnQ51J9JNFXJzKCaGl90qhupQcetve9gP0Wd9AeU4lZdGkmUBjpTkQ/n0GX",
+	"Qejk3lm95EQjQMcTsxvNG+IW3UcGK6byRF2hCIC7XZm9gavmFEqoMNhsDVCY/7Dmpxt89gaGLvF2uRdc",
+	"wWt0rPfm57r0dEp4gQC6E5jbaCCmhXsVmH7edPqq1izERgTricPLIl2b+x8Zlvo8G7NFqsjlTtUpZ6rv",
+	"8eu3+DGtEwIDTaYzmspyfYcCcQ/+AVj9eebs833xi6cAUpS+FLvmRMJ0D8Ixa/QV0t2ETNRrpQthkgwe",
+	"61eNRz37kbQRat0fK5S7Cst0aaBni5QxLp750ZI2VNcoEfsXpwx2rXLlgjPC6JeXT/vSaG8hY/LMW6IC",
+	"vl3/LhDU4X3hsk1Yg3KW0FgbGBugrv8ezgwBRX2q7RYe9ncuS0PEhN3mYVFkwUe/bIqnQrLuROevhPjS",
+	"lY05We1ZcL6pk2EyACm/FhqLQmy5Fgu2EvZGiJo9RE774aLvCVHwhhfS7ukN1rdOYAvTy8dSqnZVRd6Y",
+	"ZIKGJc9L+9EbGefyNXXIQmLuVrrdKc/uAkNr0AZh0Qhh2V8e/v/TCLoDYGshlg34Re2tWDafPsyl6Ckl",
+	"r9laCNYIjVLiwIMJdNQybE4qlmsdb1vAh1siaetr0MOwimKoOLkfLGMI773AzzML/Pyh3TKX4Ioqn3m3",
+	"rn/2BX+eWfDn/2UWDNbbtRDTSXDXYryekQYh8k/12TpGfqp3AHC0yIOg5vegD3AtRImOkA3fwz8bYck+",
+	"lHSm7L3NSc7V0gjj3Lao0VpWlWFtc/Q6EzZ12JUEi0kcygTZpvAWWHiCoy6GF888AdEZNciD06PdZfUF",
+	"g6Lt25+HurRh6l3zldKnyu1MA85+Lc1IpXxQJnFT3jXhM8QWjnMkO/NNInzZR65KzbgxqpDoOnZVmgW9",
+	"Rl1aZWchSKD/uaACJOb38HtBCF6ABFO6UJyUJMybZolVJTKOhD6+P5LXe2562NVFQTcN1cHzVSp40yxQ",
+	"NnWwI4+FvytV8Ir2wAXHX3NZQaBasGRwK3TSIOsSV4/l2vjBN17k3fDWNMnhjBH2ZFiDwQZ4g58CskCw",
+	"p/yY7wBRMPPdUAU9U0MeV4E5GhGLRY/H8+i4y5DfUN/UsEiSdxr0KfRMDeneN0cO+ox6BdZxkClG1avc",
+	"9jmCj3AV1uf3Y3jwx0QdwT/fR8nBnDZ9ALYMMdVQqROG7zPO8G4/hcP4cNxBNshI40DZzkTVMM6KSnrp",
+	"yuq2sK/q0WWbqFTrZbF8/q3Hvkk64Vci6sgN9aqmnMEhB1NSI5GUMr8SwqfhCi4Svc1ZC/Gqdq0kCJmS",
+	"IiVRrFuS1skLHufUEnQMa7hMrWK/Cq3Yqh16prXGMmNlVbnUlDANU+tXdXgmfiuhZgwMt1Z9qbYW9kbp",
+	"11MyLWR6FrUw0izT1cq+pq/fgH3CLT+2VbjOXYzRu/Vo8bDLMgv51ROnF7l6gu7rXTbDEezvLJPdrKfM",
+	"gLbY+7WygYA+6Kd5slvxqra36OeMAenc3o0chnra0Vmk0zGgmt5GDNI6+bUe6Qh9Dy7DEkxmwBqVqtCL",
+	"+SROE7KwsyM4E+9pN0BGeIYnH3mmbuVmKzSQwh3epjgJZkZRlSz2GQ3pljeNoJC71MXDtZbXaPr0lj18",
+	"SkrD4DH2iL06W8u1enXm/OwNVrl9dVapG2EsEMGrM1qt6Tl5DRcK7XXveUwRZa8F00rtEFHS5jj3O359",
+	"Z4J/ZmlvtFQ6mcMiNjxPxPxyLdi6c1oiJSE48bTcAo5qVgqQQ1CtSJmz1bq38rTxGnzjD2MS6dHYebok",
+	"nl/HTKUuAHUgVit30iK1BzlRuDQq0nravYs6agAPYst5Jx4DWnj9Ul+UCfCq9wiLoswWJCXg8WtrzMt/",
+	"p0RopOedo6s6UiGcJ9a5uyzngEUc5V1Rnut/dw6f2Mm7qBcdGHc+BKcBg8iUikIsidEfCYlHS4jGWgmK",
+	"2fLevUyDk5xwKg6YKJP8wNxXe5nE6WjHE8xncNUkCDd9yhLMdXAZjO/qaV6zGEog+S2apSm13EpjMetL",
+	"ndQvD2WpO/u7jIslE3QpQoIvQATQiq3b2inynbMm6Xu6GlkLejethCus9Ii9qh/Au9lXXHZ/gotWV1i/",
+	"+34WHLhS5fFleTsG8irO3ZkoXIGX83tmMjo/kxswFNOKh90JOFlmK5t3/+oyVq7Sr8Vv3NMwVNm4qjE7",
+	"C8psmPh87/Ipq/W7h9tqIUrRpJwen/ddR7BVt5tCDIpRNFpdi3rB5Lk4H8Y/lxthfJXUSvB1SLen1Bzn",
+	"2XAOiNA8VURYjxcyK8g4RT+od3cv3zeLM6dIMSd3XHMDp+AazhmynPu/rWLvff3lS3bhHp/mPQDV1VE+",
+	"xdvNFVqer1j8e1eZeVKVGAaer/EbVn82OKibGODysYcpN/Oa7+Ji1mRxUS05tGDM0ljPxisQo8plIUud",
+	"u75JY2C6qt0onq6cmzwQOcpdj6+ePGe1ss7T/mW2NYSD3GAwN+UN0MIFUVG9qvml9e5bqtwUqsnme8Fv",
+	"bKN5HYWEhLECkP7e0JAPEEJozgbe3WeLM17uZJ28RSbpx9U0d1COiWhx5i1RY2IIKXSjAj2WcbaR16J2",
+	"NjZIe/ZErGWN0YaPXtUlt/xixY0szEVrhP6CsvqebxR7xNyQT7jlr+oxHeXy5MbJnLsUband4Lv0Wl69",
+	"+glEuVevfh7VKhm78rmpkjcrTbB0p2Lp5TuXxmU8sWlEIdfSqfSo9+Ss3YmLn0Fu/PRtD6aFJVoTlmjA",
+	"Sy+/aSpYfsTVvNUPtowZq7TXaMpgH8T9hax2xE/5jQ9AaY0w7Jcdb36Stf2ZLV+1Dx9+LNhl06DxBY3K",
+	"vzjFoTQodc32GbzsQOwGyxkRXWkacWs1XzZ8kzqLr179ZAVvcPe7NEygLsduMU6CCxwO1S0gykCa2QCC",
+	"Y95dFq0QF/eCevXMfeMdhE+4hdgmBIvea79gKGeDu/N2RWMkd6m12yWc7eSqDJC43xnHARjfcFkbX53E",
+	"yA06C5itamHJghVbUbwW5Tm7WjOXwSvurtY9dbVnHdLg/QHXijRsLQF/zm+7bUruFPoQZxjLN6tQdhAH",
+	"fS5ei/1LRd3PZ5Zxc4m+ARvOorz0BvDUQUVKjXTUQKzxsXVjDDffVVkCSHnTsE2lVu50B7J4FOjC98kf",
+	"ZFKcn+AQp4gioGGC3huuE4jADjkU3GGhMN69SD+1vJmFC1yTzgTjtF/xal5uw/cdUPNGqxvKLVoyVUee",
+	"CTEXaw3fiFxSxFiwuEPG2FiFlL33kjddpHtxHUf3zUT2wiWsOUkpAr4AqaB4OCiD5WeiyH0nWGJktUOY",
+	"c90IOWm7kPwIVfVmCrQ0AQtddwKHB6OPkViy2XKDvpDyWpSL6CzPkgEOhsQBgftsFmhX7oQ6DM2sxDXP",
+	"4d/IzTKtUbmKKjhxG5QrwLG5bbXwPHd4Tkd6FdSjyA38s3P/VkZuYqUK/rWjf/Dbz0mNAhaNTG2HqlEA",
+	"KkUlNrRwajxISvyeiTYI4Ph+vcasO8tUMajICy26ZtwcAuTjB4xRNAybPUKKjCOwUd+KA7PvVHw2680x",
+	"QNZCom2I+7GVZrWK/hYTSS5R5FENsHCZCf8tPAfgroJYuL8GdexwGCbrBQM2d80rUVv/WOoG6QaIxdb3",
+	"exKnT/P3QU6cnQhGoovlqDVhjzutJpaZPNBpgW4C4pW6zeW2BYl3dbsCek9WjIReyYP5ngFMv2fYSt26",
+	"9PZ16ZKVHIAlD4cHowNA3EpD+TqgX+42J2Cmpp2WplJUaNj7QbbpyCUnTsyZOiPB5Mjlfdz7ewCQrVri",
+	"Hr8HH6l98WR8mXe32qJL5uKL8aaOf+4IJXcpg78J1UQkSj7GpAs5W15wYUWiHciNdY+F9EpcLBi3bKXs",
+	"1ucr6X1lpaT68wNtRTda0msoghqKGCTrUHZv9iVfW6EPimO5l3E8UleN705DIdrM0fAQQUcDHA2GH2FI",
+	"3308T9EJUNIUhTjnywx1QO9T0AWMk6YInCFDCw62mXgfPLl955k4H/Q+asc75nX8Xsd9h7vssTaxv1+o",
+	"26ndxUsKtwgvry6XVu/gv80jD1DEc6G5Tt2mK3lFe5/WQb969RN8gMsTBoH/LwY1Jd654Qtx3FHKxCb4",
+	"tYfUUVYNoV+wtg6lBXz7Lp4WRITz32mFuYqm00skM8acRcry91vjNH911DhxDJ8NFQhJs0GvlSvysxIj",
+	"98uUDMpknYmjG9YzO6LQHKgaBT4AX/hucbmX96kK5QdRluvIkha0QyH12Lu2k8PFjvbb/Opso9ewvueq",
+	"SyiCHV0RuniZ7/5YKSuWmDt2iW7FySVAo68M6rjj7LwD1UVvs5k05Kec5qw4LdRYL2XVpunVzfu3JzDt",
+	"d+GFYtoVPn9kTYm2MLFgusLTxNRUinZywU9pwU/5ydY77zRAU5hYA7n05/gnORejuoBTRRtHBJgijvGu",
+	"ZVE6l0F+29XoGlfkiyQOq9Rr3IZgD9xoQRrfLsNcPmOXq/B0Pt+o+nJsMBk/OrsDXAhts1Wpe297bMQM",
+	"QN5XZ/uVwVDMWJF52RdalJTt3Sx9fuz0nKTWuBFysyVFV9R1sCbKyeeHY1aRxoZM2dIaioXynVyebWP5",
+	"a0F1YEL9YIDbMAkan5KKemKSKeUSdgsG/knKCibrmV6h8XpvVD1jqQ7KxGq7rOGotsntxPlELf+TbLEW",
+	"mPliT+jKOqkRrIdmGyZEx/KpcxZk1PpUC4KhsjSb1ch0S+wB0ztNPbyPqSFzHibYTxfQPa2UiAKuJ9nG",
+	"iBWUfuyDecYIijx+aKSJtcTJ3MeL6dlpSdutWvT37RjreGmyBlcBvLGWar02IpOnt1FGxlmXE76YrkyZ",
+	"q46bK49172riYwDuVC0892S9epKawTvrmIDU1Z7Juh7GNlPRBGbjgaROl346nNzd6xsTm+SWkKQWf1dG",
+	"R6A9fOdi4GvqRq27G3V8MbvkzJfROGQxtFbsUPm/Uzpmxe5GcBlMpCU+c8NN/Z51xa+76DvKcWze3kXu",
+	"4Vo6eGfUCtRSlX1bZbfW6OZzbqDu5jshw+/d49z0ccYxPjJ3BaD0NneldLdn1xld6+mJZl4zd17OwXum",
+	"W2n/7uljwQM7eZJeWNH8mF8TrYQSqVvRBGO7fwcMq4MCCWXYLH7zA+C4mdvciiY9RAzBxADztyiTFQ6l",
+	"r4Ol/aiZmZDSsnOMgkoQbW7pfgEBkOT+dRf89O2PyTBCRotOIzO+Lsucm5Isbwcehdl6L73Ug/ezB+Cj",
+	"LJvnroeBL69F0rG1ZpfPHy8/+gsT0IAJlwd4pCweEzLYnNMEcPnFVcjFy/WmpdJlbsNxnvPD9X/h3quF",
+	"XtrbA4n6CXBgHn3gsXtvRwpeVengzEptlrhf88QfmpLvlHOEq9Smu2/yE75FIYjW7h3zAo6PDkMD7ptL",
+	"0f0JhQ37Rsdv6JFiV2pNDOm4t9XpfX5bwhiuISaYCGsLOhMHTiKaGJ+LtdAi6RIXPplIPnuvl5bI3Y7T",
+	"55NnndmTAlLIJxVPdAenTt40hwzAfuZ4RYOl3Cf+sPNZB1jm7MaLtKv4C6u06CM+ch9CfB3ahDmmsUi/",
+	"GU8lTb4gMGjq0PYyJ+fs38Qec9rics5C/MtdHbNTd5Ab8QCun2Uy7jo8Y/oQctTtxVkciXLeQCAZr5bO",
+	"fT13ZWt17a5sbB5nwX2Hmts0ZUMyWpdqCdVileB6GSwf2VVhu+afZlVa8Oxl419xqMrwHkFkGYs2n9zX",
+	"XYib70KxUAPjGkh3jrg6Fjocz7vAr9NZjA7yPhd5QUuciMAQTQjA6JyDsfMg5mKQUE1OOIHR4rqol6O5",
+	"QjzAvWM3Yieck7Kb0elOn46Oug7wJJzr+0bkygRe1kz5ryEWo8+C3jOOsi5w1Rdg1Q6358w7+Sule8zf",
+	"VdRJxnKEB/mAMZ7k7nZ4zASNO59mPlSdnjOkJfbL5hc4jQ8exEftwYMF+6VyHyIA8feV+x2dHx88GANN",
+	"t12aSaBVDmz0H3jc5Dfi3dp4a3Ez74K+vN4h6qCTypNhoFAKyvDovnHYu9HS4bN0v5SiEvDT+Rynh3jT",
+	"Cd0xMHNO0ItckYYQ87fjt5AjKGRAiBxgsXgTkBYyexfeSl7L4yNUtzvKc2sqWWRchVYG2GtNegh6tEDj",
+	"jC4DRmxlJlSybmU0FjSbo60YABnNkUSmSSreO9yhcxYgra3lf7aCSdSirKXQoVBndNX5x4EhTfFQ4598",
+	"5bqBsU80/H20FxMebv7lNKW6QAdGtWsqyetC5NQXbK2F+BUtjUXFb1a8eM3c6xGZFGkrPTa812OCMeeD",
+	"Zf243nO7u7FdPIGwTItr9fpOWYPyLpIvw+iRygHXlPGeOzTVSfQp6UdzpEp5LXO6C/jSUxos4ogX2kj4",
+	"X1t3//fIT/FYFyCk5+1azz7hupbOQIubR8g2d7g2343RaioNFn1LzLMIOTbgQ/KwFCNyvgMKLNebnPGw",
+	"Q70yneMxENhaq19FvcAdh/8BZOOjNBuGY616Tpek1mPaPrX6CE/FYqhF6k5kxAgCMsOWZ/mjd1weLfpJ",
+	"8DHsWF/kAxyFVB6RsCCe8Qj+yd396W57SuG67UcM359beodyv9ERp0/MsVFLcjSmfldPYHBplkSGyWWg",
+	"P+GN45NUyjyeCNU12DvFFociV4hO6Ta9m/3Qds/XHeY2/t66Qr/o+7AMnpZ6jtvIuygFcd4sknNKqugj",
+	"62eyyIheeLyi2G30evZhjLxmTsKBsqw9XpI+lVELc0Hjd6fSwTzc1XB5Ji9IgCna3l7ApVXdDREyvHvn",
+	"OpqdRQkHQltJ/uqN0CQ6pN3n7qj38anoZ2p8OgUPdOypdqieCq+MSgzT1jeUo4b6Eb9yvdFbwfk+3Cht",
+	"YFiTjg0tRSF3SQP/q1c/lcU4DrCUG4keJgzT9q2tk8fcQMxVxgYqKqVpKsrsGqPmas0eLiKp1O1GKa+l",
+	"katKYIsPqQV45uPa+oIspdm2orZbg80/mtF829alFqXdukI1RrGgm6MIAR/hPKhV9Tl7H2O7jbwWH5xT",
+	"Tj54JJ49+vBzjMyjPx6mXiGlWPO2slMsu0Se7WXbNB1TvlccA5ikGzUt2pL4lL8dJk4TdZ1zlrClu1AO",
+	"n6Udr/kmIwLvDsBEfXE3e86zXRoFq1gpjNVqn8sNvBOWA3/KJDoH9kdguBqzOxcBbNQO6MkzUn/Y/HCU",
+	"7op4eoDLf8RA+sbHEQ9sAe9YzZOLVuKY7qAr1+fRumDcUO1E2aW4cAzxnF3BYShF6WrH+zAZwg3M5Yr1",
+	"Ngq2EPyRtKwt6odbu17+BdSGmhe2X2etD+5y9dknY5C/6AXqsPo4wN853rUwQl+nUa8zZO9lFtcXUr/X",
+	"yx1wlPKDrrBAdCqzEf/JaW0uwHx66LmSL4yyzJJb2yM3HnHqexFePTHgPUkxrOcoejx6Ze+cMludJg/e",
+	"wg798PypkzLQL7Jn5lz5RGc9eUULq6W4FmV2k2DMe+6Frmbtwn2g/33jYbzIGYll/iwnHwJeKT+V0hRE",
+	"+B+/zSWCzCSjwJ+7Pu+WNtNGHQSmb1b48Bem4SWJ0uiDBwg0WBeo6S8f9T8Tk3rwIKksTivW4dcOC/d5",
+	"12Hf1B5CgaYxQbvg4eDs53KcjvfPp2Q4qNuTnQMHBbSCYgvrlbghXIalXuArelPjoYXnX6u9Ce/LGk7t",
+	"F+r2G2ms0vur4JkYmJoLfMOYko7fTTgb/vNEVJ8ocVPa4TV9niH4D754POAfQ0T8zszL5S31ukNaSYbk",
+	"n7jVKZ0m/jJ8j+KQOftC3SYsbUnCGdwJnnh+n+D0WeC5PcXDB3jFKlN/gC3NbOFM9R4ubZTMJekOddAf",
+	"LzpT/RwNRzCUPwZdjG3bU1H8X7SyKn/sCqINrnDN62KbDPtaQcd/uLjFR791S6RLKoU18OioRZUcjt7G",
+	"//Bv6MQr/z/U3Hl2sp7ZdoArt9zB4jrA+2B6oPyEgF5pK5ggxmq/1lTIQlptVMlwnlCLPmLm52eJvXqM",
+	"t6nP1/2cznE+W/XCZ5ymysku5TY+IQZ5vf/7JvFeUPgoIMWynTKWffYJqwScTrNw+sgFK7nZOjxijWdT",
+	"KC3Mf80E4ERkLiH9JI398PzpghlRaKcqW8vK0nuf+2TzR8StoYRYKyvX+3E9VheKu2BYfupaVVAubJHL",
+	"jXaEr9dkAp9JkMDDnupfddVih86UCK+PT5a5dNFZg97k/PjHWmiNmPBStIMoaFAnFS5oUYfty7uWkaRu",
+	"5Tpka4QjabAMB8rreKD37qBa/0WuXREs/C2nSLrN+NhNrtsrPnxmXn9YGr4nxy0tYOt5scZ/btfIwfla",
+	"/3pGOw7kb5v12c9zVReAi621DSAW/jWoBUhjplFUv1MdtofDXKkD+ETvdZvn7u4D5buEzvgkKLETE3WJ",
+	"NpJz9jWmMgAgX8bYQ9uE3LUVGpV6tbbbplK8XDAYB9yUGc1KfbSwra5ZKVbtZkPFnnp31T1LYU/Xvz5i",
+	"nOk007BqY5dW7oSxfNekqm9Ci5e+AZMDB2RU2sfYOWdPyF5i4oLPxtKJ1HDNhuncacSbH/5jLZWjImqZ",
+	"Idj45Ef5CrbPXAsve3RmWu7/XwR5gwgT4CZPR0G324KCjm+kEZjHV1yLfsFPD4a/SX0B0P7ydFvXRCnn",
+	"R7x0XenT49HugXPuRvUEZAPEH+uE5Mo+z6VJOs8vsFeKKO1t3R9s4ALpSx657KDn7FtnSSx4rWoJ99A+",
+	"+UzHKjXzLkU3SccpjipqTXk8R4crQa9RBlGHRbf+PCN0iBv790RfYVOJOuhPK24tmc83whrH2US5QA2x",
+	"rISzfsvaCE3peYGIereMTnh4px6WXczksZU6pajKjDnjK/j2nTN2wREMjoMObU75Q/ZpyH4N1I7JBDZK",
+	"mK6KaLymn6DPOdbOKsXtz+dP1UYWL+QGx6CYAnKLE1w346EufTiNC1+Bto+hLaNcy+Hnnm88TXrZNG7S",
+	"pMgcdjghItRZBKecuKltD7lh/Hi0CXKbjIPD+xQIDaqhUqA53MMjwhBap9RPX1INVaAobMEonVYKKZWs",
+	"E2A8lbX3l0hfEEXySsCNwfOa6WcKzW2x7bGhQ9EzwWd/yNCMdQ439x1qsMGIElyjnyO/jS9v6+fCtJXN",
+	"MY7QoHue83rP/KEA6o4TDfMqxJGRENQ3/YBU5YSoEtigr9NGYlmacQDjXu6EMT5Gav4DN3S3mhei13fG",
+	"TZSrn7Nqy42wS16WqQxbX+BXhl9ZSS8NcSuKNiRQbhp8FB3w8e0mKlRt2t3EXL7BPacrpYEX0G5VJWJo",
+	"noSPogw7DJQGTzb49zjVg4sgOzonkg8Xw45Hy839kUZSL9D0Eqo2zMcE3in3R0c39d0Ivet/UkqHZAW9",
+	"sX4PI2SGy8V7lOJvX8LFEdcoHAXr0dUSqh2iVU3hd18mgaoVMRzKvejBHwSrTDz/6jH7l788/BfY/VUl",
+	"gN1ZLivTBdjFlRBdo/8FsiZVdQ4P84ExUZUpaIFtrioBarhiK2ux1IKX8Esc4ONzIXkhCBeY9jh0CTlG",
+	"WKNFpNF121S85l1yC2mYKug5UYgolR4s9JxdhVACg1ZUwxxpZ5zD8FuS2HPFSUDf8M3Ll898QRJAXVe+",
+	"hnY1zemc+jmB5a3SFrLS7LjeD5aEG7Zwo3PYx2aruQlTRqCczzepX7Ifnl/5Tdx7R+l4So/KUmiMQ8Er",
+	"ExoR/RYuf+W0EsXjN3lSrnmVSXwX+zCQQEd2/Vz6uyKbLJZbV0XGcjZ552Urc1Ck3sArYqyZykXnUXDe",
+	"6bwJ3FonEeoDp8cA/c1nZWANl84Dubudxph1ca150+YUl+82eBRrQkles2birwTWI8rxA6HlTtSWV2xN",
+	"DYOmQ5ViwTZdbY5OxeA9FLSoBJweZzPyPdHSk6Asr+WYDkjzYDiTiUAfiZGWIwKSFM6mm3bsytubbTw5",
+	"t72ZZfrwO0jmQS+Nh9xVOA6maMRewEUa3nlWHTfXedplkpv0YvcDOCEVXwDH2c1RoV2/ZzNDu5VMY8Kr",
+	"6XmcSgn3yw1gMovIhdG4J248YwzMIiKwbrOSJ6KSm619LgqlS6Ff8F2TuUnwS3QdkcYFK8zFCyJD3+Nn",
+	"P6D6E/e3lOY1u7r4nhx3sKURhapLRvn1/aXaVCn5oWlRtZSmgNa4OGCzN1bsunm7hPL+8Mqa7WShFU1t",
+	"sk+G1yiKLDH5YOaSXstK+BmpHYM+o/k+/fAjDIX2frA1SNLt7Tm7rG743rCH8NONrEt1MwUPRrgfCxB0",
+	"sqJ+CzBtBc8k4NuJndL7gHto6Esb4dx416UHJYvEsuKb9NC4qaLiDYxtJCZbBp07dmO8vOZ1QaZVWJVT",
+	"xWsKeMGdryo5ufME++S6drxpOqraKNB0A1yH1jbh2xUDGlJD4Zpygl7uJMCX6CChK57laOmWtTtvJsLc",
+	"D7W8ZaJRxTYz0y3Ul1sa+as4lCuxp0B1EULRb1So7rATBq5t0R34sCeO5BKnM3VAOlVzRFP99aT44Nda",
+	"tY1TmT0Xka5/JCcEBQQavDfQj9TKPkISKNA/oXlRCGOE6XHNEFN4s1WVoCFmei+5BFrs6smC/Sq06vwq",
+	"Y4yT+6Xxr7Y7GDsQpqm8gPgpSvxHKHG7H1aU0DhuuT50WzrkLWB4b7P6nCmNx0UvjkAq+95btBZMWvIe",
+	"z/Qm1xgnPqmb+nC4f9YYh5lRHdwdhkZJqQ6ch3gLFs6dq7OnODxmSfkFfs9Xde/SFg8SLQX0m4jA31Ie",
+	"4oOeGtlzGEhQmB7RJYLdfSnXHX8t+sJLT/AcKqdiXjhpEAvpdz2wh/akabJ85Y57Mc0p7mfr/MPiHQ/E",
+	"XJxnAq5D4eS74d1ks8ZzF8r9XxT3Lr/OTOwn4xEuqWjWH4Tg5z0yV+QrfjDb6z/N8elZSg/uYzbnxuUg",
+	"ydC8bXV6QLqUQwcUaDhIqpuqL9UAsFFCqHB/OQVM8Hb7PS6q/76sIFx/RzIFzD57uIRrvyCjrBk/4qY8",
+	"NYU1v5sg9N/ziu9o6+Blj1FjQABftMXr5F3Pihb9HyWU6MZGRCpb3zNlvEoKz/3nr1qBEc35gtbKsg2+",
+	"vjTV+QCstE0jNFsNMqvGkYLQYLnK6wmiETrFMiwhft3PKjQ1dEaNZl649abQ+7frXCkhf47wu3eq8Haf",
+	"12K/cKdTXEvV+qQYIazPOe/Rr5hCxo+XqSExlRDz9w5ey0ZmIcGIm37N0L/9SIkemait3v8BAu9Gm/5U",
+	"cCN+8HbM4b6TuSOkWGKufniPobqlUjKv8WZO1UUk9VhQjpFmDGaUlEKL6Apb4AjH5JtDhSM3mZ2iaX6v",
+	"QOUjCwXE6agQ8MO2U1r64qxX3zBbVOkpatGmqolRi8jc55S+o1CmjA9qz4llON1XSkfuqSg/jCF4HFy5",
+	"vEaYDLM9hhJjDS+0ETk+meO9M8LHm8XZVXmUf8tgP2gYGiW5A2Ch+QK0m98Ins2DiL4dTvqhyklbbO2y",
+	"z7jAo9U+LgabqC+1EbUw0mSS2sBE8CXkHKbWXc2zg0+juQkjc1XUMHYlVwnSKG2pWgm0GQ11ELiIRJZd",
+	"0p70XC++uVx+9Olng+Q+6bCV+TCMipmKOHdib3Oy4M6hoWew/UmfUZBo1mDyWAlttrKhMvlRFRjO0GTY",
+	"I7Lzucl2R6rj8Vhe6LymUisxfrUQufgItU5P5oNyscnvwM61EKVo7HbSF4VynTV223F4IUL60pUABg/6",
+	"Y1E7A/ogi3S56VxPK8HXnhK1UnMqiIWcxIjGGOgUKX3f2KvpIFTKJku2/c5nLK7pwlRj6XWhmNJMtSCL",
+	"T1baN7lLMVV9yEy9Og5WSBw63eJqDk8fUuieauKiUkYsVZvA8mP41HujEgqZnUC/rI0VHNmiaiyF6DhK",
+	"2WVcDtKbf/hCvuyejG3tYgN7bBH8U7DIKkZM46g4VOJG8nEyCbus2TS8eL30Zzw9lb+qyFBHD1Yq8+vU",
+	"BO79/Ed0Cs3GyPSKS/9N7CfZCx9Xtxw5SByh2LgMeSopVhRMzXAzae6qF96lfMh6LQp4mk9Xh/87JbLw",
+	"lccXPh4GYVlHxeKljcPM76Ae6QCq+B3hqfjpwMk9Cl6L/XuG9ajh6skY/10liRle5b3RKHzSWDKvL305",
+	"yVwAnzNHSxMoA7Hg8y4OaoRmnmYwXahpotZ3nMuTJNamDCLvxJRQV/GOc0HXo0p14psrV0B+eLifi1rc",
+	"pHB+mTjYwOV5VXWnm7dW7biVBdM0zrE6THfD+OPepUi5wzmfPN0vB4fYz0i5VGWZLwqWG62Pnu4F/Vrs",
+	"c4dEi83kbRMkyvFdEzhBT/0F/vNwO0N78jNs6wr4pxtAGh8pf/B9cqS+ZB7u7uSe1Pmd+PMQ6C49Cy12",
+	"2u8DHSIdVkB6WWnFywLW5CciBGuXUiQ4dyB/ddGxUX/TrtzLV9zCDQ4Rs3NSlPdPaUyyA6VJCGqlxQX6",
+	"SR5qIfSTlsQxAeHmdZHRZAaFNKB8q24YnLcuK7LU7pBwrSW4lAByfJgNPdAs39BX4V8FjUg90kiHnDn3",
+	"I3V5EKUCgAuGXqOB10g91HXPitoZ6u5TsvAcTXxAgqvErQcBBh4JiQekEHqWBWcwRGTybXcHSwKXouL7",
+	"MJSH9mgV/uLMZt0k+WY8/OWPqAijWs1wLp49wx9CaejDKkPED827CFTjd4UWn6Z5VAlH74UvfLjpyIpA",
+	"5piEEtnRtKvzQVtbKrS0gFt1JQtXwBOLKWEKg9QjQpaH33ApT8YVQLzwfyG9w//27EZo0bGYY+LjRkK+",
+	"LM1M/OUDwJ64cC1KR5pUx2PE92CdyLu1KERtq32XvAIWjClXjf+NrlAfFFZJb/HDu4FShdxwXfoWk4/5",
+	"KcfCUR1pJtNAr8PMskuXP04Jl0u8A69rWW+WufIdAx9X/65+z1AeXlTOIAmEhDxdaid6uVvluccUHFOo",
+	"gAZ3REI+9Q8BR7tlUu9G/BD85UGcM3FFqbBApsWOSzyVVnkxMT/nFLIf03dfYMoH/h204gR6PZys1BdK",
+	"kGaExJjq18w9mw+XmrxLtG8oe5PA/NW4Ek+jVdkWdL/GByNERM++YydYSTJQthivcmD1iZw0Xov9Bdk2",
+	"XfHGsIMx0KTHJNC9yNDfjdmrmRv/bFJwb04C3u+pJVqcoTN7JtvEVV3CmoSrJJJiG69lAWW/gtIQM3aV",
+	"4j0zctxn76MkHdIJ3WDMFLdsy5tG1KL84Jyxy5pKOPjMQjKCYDQ5xFhNzI9++qxshateR0G/r+qpMmj3",
+	"5GZ+mGkeRgLIPaeiQaYnSpapQ0bGbxKvzvO5dtZxrp+hlNcRFUGRlElIhYMW0IxEBZGURYjuK2zLK2fh",
+	"CSLnwK8LA8M408A84BOybPN7OVt5+IO2yxwSD1zQlZuZltErMM9Nh5VID7bBbG3SGqeRdgOoGlMvGAjg",
+	"OWeRrz71gyO25pqtxY3Qfm70NgpzSBLRKgj6XuNgSrOdNF3W7ZlPjXuhwC2zU0VNni9YbXqWGB+D7e0C",
+	"+y7hvGFFBNdiRSXcOvXFjt8udcYL67hY6c7nH4GO0ZQkn9RBeo5Cd3weE88iksx7LHQHDxIUlpynClVk",
+	"A2yLtbxllVKvU07Tsraa0/qXar3O+qvGtt4h+3avoIbv3XsNY40zqtxDOu0jtVnDO6xTa7ErawgXC5dZ",
+	"aeG9hFhbW1nRDXO3rf9D17h8B6UiD+oGvBIsQV+h2qNbXG/PU2fiBeWjeoxSZOo8YKhfVJZc1lYxzlwe",
+	"K2YqlXABv1NFahgqsxvRZD7Odk5h5ACFGzyJAJej82Aa0JAB1GX1lCrKAppO67xEGW0Z9NAp0x60MwMX",
+	"36H+2qAjkYjyiXLj3qd7tuUlK5TWooh7pAPoCCpZm3a9loUUtV2uxTywyHJr+uqghu+ZqFW72bK1GIO5",
+	"cGqKRmkbiuhJlx8HO1A57pjXtgaHnYJ/p7RYVgrTo6Yyt62BOcmdj7ZWG6YaTO1CsfMux1W3jVNztTWG",
+	"KS71RIQq4YqiHAEFrk8U6jhzSngKUf6lJYkNB5+6DtMvoQ+Vd6RxgDHQopeUAyyTlh+2ABp7DFHjMbxI",
+	"+KPNmog6XctbpHuRSmrusvONXysd7fOOlmNiJxUgSeSrfc+jmbd2q7T8NbBtqR0bH5Ih7zX2KSdKucYy",
+	"M0F5rWoxugZhY805e05cxrD0MU/vbqMa3KwpWnoenZXQjJFey79o1koLuakZPk3NMCLYdFXuhztFeFBr",
+	"t+Whx4IZ1b1csSmrFRpBhO6id0dkPcLD6LCkEWGEyYfxdtW3IupzPc7ZixahWbdVijehi8ng+edjWvAP",
+	"GobwUGHIQDdJ0D9jtinXFIcUjlwpDYxycRnc0uDn7AW1pfkx4XuYPiT+R8M1Zim3/TqgDrSikqIeFu3R",
+	"Hr218kn0XXqPgmsqzlUI1taU2B9db2+2shITyT+XO97kkuZjAwYNosRVGOKzCHZ30FNZOhfEMkLbLmcg",
+	"cjCHTanduBGxjNicuzdc1pTZOinP/Sg17be8yST9XRJ5pFedICOrwhV2NCxOWhg5bM3wO/JgzpBS5viD",
+	"jRY2XNccpy94CVu1k0Wa7/9zZVLO+nZ12CVavYTuSe48lyPzLmvFORQ8cukO3InoKUH3mFoCFTdeqQdx",
+	"utqX3xnk+YVytmUZknFDU8j0Qxf3dIL4rKfJcD0B9LyJ7fCrJ5NdftL+dAwg2Zi2aQdS+naaeVawselp",
+	"8NOcWaaYSq9AU4q6s5TcscQDrJ6u2ihHRp983IdMcMKLby4//fCjf4BTPjRgpdwIYweXxxHx27sUvP/n",
+	"xfff+SE7uFHtRLUSSBSE7MaPKel4oqTPUO8aLyuefYo7xEJ2ilFSD1dM3Gv9TO+52L8ix+imGzAduo/i",
+	"k0svipd951I8GNdnNTO5p2pCJKMX9rLI6gEGACCkst64PYD/9V7p3ixl1YbcjchhYADozHcR5qC+H2ww",
+	"wsmBsuJeQI3y3gcA3yer54ICs+l2AE7vvn/QpYi9E/Bvpqm8J1rkknu/6EhLYxMSQPPyQsq04HQBoIRY",
+	"duczKaZhoeO++mD0OsN5ggqBWRUlwHMF3rGfD4ZFFYTTqcqRLdjV0nTWadRzptUnzqPDpQLMuB40zXI6",
+	"7fdLXOFqbvLvZOKviQd5BEA+HXgPhllJwY8Fg1QT/oG45BlJ62Xv/dvTOa2l9XP23rxRyIGqXYwva4Qe",
+	"vHYHd7LPWjXY6fFbfbzJR74LeqJlQphYc1mJcskTZ+0q+EgsIksvYWVkLJDGnYOC06MNCJ3LCtJcspfw",
+	"Gadkuh8N1XC79UiB5mNPJpehgGtBidBW3AgXFEzukaISGDU2MEarZlmJa9F7sS+cuyi+5sHh0fU1oTMr",
+	"hWiETp3L44Q0t/ZllCB6DnaTlnxCLO0UO2CmT8ca10vilmYuRwWIrmUJFt0YCcfSX98NBTh6AlUj/c3S",
+	"KX/KudP8QCOEh9Kl759673pM/DzvOjr6Jkqj7n73kPOXGjqqvGfwYvEu0bIutOBkh11099DI7Iz09J6Z",
+	"vpxGB4AS5Jo2lHs++RV1sGBEa3L3Qp2uF0Gch1yWgqMjzlaGyChaacfUTcNv6rxjUOpy8YqlmfQqVRxa",
+	"9+WtKFDIdwpsUToV9rT3g8vIAlsPzUewLvIq5kgNndE0D/c30qtn93TuE72r+XD/bWc4GMMCSYe2yV+u",
+	"ZUoOuONlGtjJ/dzyfhcOOMkAs+OlaNJQIdfIchCcZv06wmlyOkFsoNqqZDWQCCjmtvxaeOnB3Z4Ltmr9",
+	"QFSwFn3ou1cGeyK8/7OqY9dPWhGTQVD0hRVIchjbymRUKAhC+LCwJ1apZP/Z8kqu98jfCXzfDdmqrDfO",
+	"4ZpCAl35DZh4+nWzGNhbSuWnonXLuWNGw+29vOpGAgHKO7Mrn78pbENwrfDeW+jm7qwVg+0cY8EtnhW8",
+	"htsH66B22bfAk7Xep7LEYO//qytCGE/lr7Km4gXtdrCN9PxCKPDDE5ePbD5GCelJoFNGBqINStCSspwT",
+	"/rwDI8mx+J+VtJrr/YkVlkt8fh8CO3rFR3nUTraMmVU40Tt4Qls4pYFNLOXUu3CvXABLl3PnEPhxRsR3",
+	"g3+Y0SVpnMb9hEa6B/4fBe8Tqm0Pr1Nxv30sT6vBvU5hpW6XWqwPuk1i676JxQTzphfckdldBbMKSa8S",
+	"7zD/XOh8mcMopVjLumOWsm5am3g/kq1nHyEs9hpBtGa8m3JSAgiv17z6/lpoLcvcxvmIL675TlihKTbN",
+	"e8q4vgkNYrhTxwNI072dsTCm6AovRs3gAifhl2RfY3ldcl3GzWXNCqEtl+BsuDd3d6kCaHUrFjHmk05V",
+	"PJJm+uWahx4nBEi1d64n93SuSgE4y72KKoL3nKtItWnIQ9m3IVeXaThnODYFOPkJPZxmeCaRR/vYK4mU",
+	"olZl3FvGMBzvmXQU7XR+IUEdf9gZKY0XcJSu1AZrTeZyr/Bb1BGAP5tTetZoUCdhct7i/Tz5KhN+GixZ",
+	"4rgm+n1sZk4xx82pQ/PRfk6OhR6g8mle+T2SFT71f6ilneSW3pmlX4OUkpUQM/M8DB3EXeo7ItyEPbVI",
+	"T9b0S8f6xXpy8ueAAqY82Z1PVZh1lqkMMaFXr6s5HNvtzHzFds9xOHEru5c9Ogw5b695GhmyXT9VXXV5",
+	"pwhaooLoQALdzqG6cFFxCQXaULNE+PW+7EcqmMk66cWCDHiwZ8I4FtafNnJVK1735p7rOZ2GqFHNclYU",
+	"fykqAVwMu3lI+zBmA0iCCTSz7uA4bhjfcFkb2yPs6MXxnnEPp7u8fjAu8Xs/10FPoKaY0rmMafBg2Aav",
+	"HaLCQxkH8MbFrH9Foap2lxmfvnmC9iRqLNfEayx7mN6WdEXrl5j7rxZ3GNBkKsMPs/W7Ra9lJRadkrPv",
+	"bDLwDDmQjdEXFHcVqR26pvcuqdHNCBl947la482KyCA9ttKxanMxzLbc11h3jo+caVG0Gi1bN3w/3ndf",
+	"nWZ5HwebYYmbLpRWjorpvNvg2dHybHoTfMV0/Bw8Z3xm2bAp7mjRpWu6nPSjAj/HmMQSckAyJaDgusuN",
+	"dee9wnG6tFh/rO1KLfLkO5ZCwdvZMxfyn17ApRMoAcppntFZyP1xT/ALeMcnBAy/tXdYYM4gla/YfRd6",
+	"7Mw1fxgqTJQgPxntheW+DYpLPjYm0ndfjvy+QjXkWaCNqwMnyAMByCQd7mWqjFL1ubQmhuLJTKPIoOM9",
+	"J4aX2LedR8XBfBwIie9wALw4i3DXLqSQiKqAv+N8/bFo8m1ASrSUn3OU0Fv+ocTEboGdC0q0RU5rZa0w",
+	"xJbUWLiIsk6bxweSao9zPmulLFM1KH0SuaJJGYJnKiYcWVuhr3n1rjdlcfaV1MZeIj5E+TwfODxMc+iR",
+	"TKgcJFecqzV/ymfNXfG3MHX9DPNT/13AHiXvOTeU87oY3WaoyuIVBUgGwfxa1OwGx8SdZh9+xlaSMtE1",
+	"WhTSDL05yHjsEq1iak6hwTyJU4hbeyAX6KF1/qjsPch47V3Q2He9YAfnqOEg7I7o78xUMic3SeUp6huR",
+	"RQJ/SR4VrM1/5+ibnMx8qixQD0ncq0rsXC4sYgYh8+PA84XU2YIK9UBRGtgcIKjOwp16FpcpR70S5kdv",
+	"O0xPKSma0UHziHWh7kurFOYbg3eoEEtul87FaklKTHB1AXEIgaREHZguC3MaLFW91KLB1F7Lhu93ok7X",
+	"Is9kEruK0+0nkjl0uJpwlM26Kz7Bv1YOCW7xh5/SiNJuWA98hhqotHWKCoz/2CuqTvvs/A+MVVi3GW0q",
+	"lmvUkENcIpOW6bZO2Hbmlcjv5gaK8jWAg8+l6WaRxkOR3Lh5xQfDdMkxdFunT0qcYLWDWBrmety52Lyb",
+	"MLVlEP0SpMFpee+12FNWBNZwqbvHdCSSKi2ydaDyFZimZECAz4mqg6XCsH6QQyt7AZDNXh6uA6XG1ojx",
+	"OmeL2z3cJiRt+P5S7JoKLhFv88ykjqaP5DH38svLp8y6jmBkU/WG7lxpO1fJqeiseaemm7ZQtdWqMkec",
+	"ie+i8xAGWjDTFlvGDXv57bOn//jqyy/Pj6jN9WNck6sDzme6ocU+YpyVopA7Xnk5ZoEbSA47w+JdVA2e",
+	"kd+vewDCgg7zxeRRmybHOacMNzdUtBokAd5b+k+//6tXP9nVq1c/u7WEzpnUdKnuFrpjR0xXcs4I1798",
+	"+As5G6Ds8+ABTvDgwcI1/eWj/mcQvh48SNfNkykJ7NWrn1oJU8PnEeB3SvhEOHJjuHlT+/FjriQ4zFSG",
+	"ouCR/S6xH1AR46ATCjTys70JlYH+AbqVf6w+++TdZyj0EFB2ofHpI1jvUyeLEJNYa2/yaCrYIWkrGNGh",
+	"qtPQ9Mw+8eYkwjUXZ38Xq61Sr5MZiehTVAWCqS5pQpf+HYVMUeijatSiv3WtrH/B9M2GADt49KNV8VpV",
+	"17LeuBIU96o02qXpLY8EydsrlKZktPS4kya+6UjC5aWgYvuTuXGPnT/k4kVM+LBXB9FaC/FrB9FEhlzX",
+	"71C+er/1zhdJdtv+ngmTu4Q1aISSdRBNKWl9wetaoWOrs3qm/TEOZ+xyoCQZ9LzU+/CUCYWaurQ2KAHw",
+	"iHLH0NnbZfoOmNwq74mHN8PZ4kzUkEH9p7OG77tE+oszXqzxn9s18my+1r+eEZFi9r0m1nJ1S251prTw",
+	"D8+fZtbbKEPJGQ9f0shlYIoo839EMz+n4r0NWOCk3b8ABu6tbvIfyXKmX4diOq4iWhBLnKqDcri4901X",
+	"eqc1XpnyteIVqh/Ipa4WzCpVnbMvb/muqZz9n/31vdW/iI//8kn58OMP/2X1l4efPizEJ59+/vAh//wT",
+	"/uHnH38oPvrLp588FB+uP/t89VH50ScfrT756JPPPv28+PiTD1effPb5v7yHL7ezR2cEqK8o/ujsX5eQ",
+	"jnF5+exq+RKA7XDKGwn1it68wRfrWtEDu7a8wKtc7Liszh75n/5vz6vOC7Xrhve/un14dLa1tjGPLi5u",
+	"bm7O4y4XEEgi66VVbbG98PO8WQzZ+LOrEJJOfu94JXTuAudn3V1yid+ef/niJSTUOe9unLNHZw/PH55/",
+	"COOrRtS8kWePzj7Gn/D63eK+X7jb6uzRb28WZxdbwSu7dX/shNWy8J+04OXe/d/c8M1G6HPMSUI/XX90",
+	"4bVIF7+5O+TN1LeL2KX64rc+qz/QE92BL37zjHm6NUgsleR1IZaoYjGTrcEXc7IB5f+s6YqcaNbAXk82",
+	"6QUtzm14wctraZTeU4HMVEhsbRo07+TqP/rqBVyGwmtbMU62TGGomw0qYtdamG1cjdbErzJX1CsOoEgW",
+	"T0CnKixiMAxdzlZPYC9v1OBeD01dRigsINbFYBCQziMPfHcr3jTwf9R/SbtnN7Iu1Y05Zy/H5cMpm7zS",
+	"TAso8jLOkzwE9VroPcHaQyFgj+sQJALIihI/uUIOoY5Kf4WdFmwl1grDhcRuAK1adyvuHP94BQ7CvZAU",
+	"nAoYQ2AgVyXydfsC13Xp6WlxFli7OXv0U8o67DDQtKtKFoz0E8hygZ9EHDGkpO1uKnRqPKMnA7r7hQcA",
+	"CPUPl5///Nunf3mTuu5G7+7gndUjR6s8tS7YStgbIWr2IW7Dh59BDNaaQ0QtNPvw3IP9n63Q+w5uHM+c",
+	"xWA6n8+zRx9+tjhzitKzRx8mXNB/XpyRYdDQjfrRw4e5h1Nod9Hfgjjc7hPqDreEU+1HHPLCMd4I0Emr",
+	"qNZKd4O/GV05X/CS+SyfOPeH727uqxrPJVxfjK7nN4uzT9/l6q9qKsjEsCVN//G7m/6F0NeyEAwUZUpz",
+	"Las9+6EOQWokICD1jnn9D/XrWt3UHnKq/bPjek8n3J0Qdy5c3dOS1Vijl7gYMEbPkb0Z+VDhm3Mqv2NQ",
+	"REZeQMzDlzcDTeybmdeZmyt7m8EqqDavyRflgTg+r/0y6PkMPzVaKhBz8a1TCgxfoecXZr60uq0Limmg",
+	"KUSN//328l8xruPby39lf2UPFy4tDWZyTE5PBQFG7HUcTmu+2F8G1vjPwWxfBiRF9Wd6r1zFSmmaiu8R",
+	"aTt++9ccym5dgooE593x2x7bHQf4jMus73ZAj4BGAM/Th+2VH4e/MEuWZ7kALwHXaWp/ocwFC1c110uV",
+	"v5yz79CA6MfjmjSn7iFaKryiUZT25jxgE/sQh+GzfjhAnLc+SixWcxDUxTm72tRKuySVzg3iGTgbh0si",
+	"hzOCKoW2/HY+7pJv3UQv7oCdLiQZsTYAyaclhI0mm6W6lqUoF6yMrlfomYWZlDwxzP6t7fIbusqGiZd0",
+	"7oadzaUHLlPj4wnr5pETXKqCr7jlha32jLuNHFfeGiSPVM1yIOhPuN2NZ3RbkkztcmyJjUQKUGV5dQA+",
+	"ynyUOf7eNAEv+sNpx0fISEKQ1FnEW+tp5M/d/a+xu28WeY8KYKfhDgpMvvceJM15tR8oPYcP2nP2b6pF",
+	"RRVIaa0VgQVS0YpOzJAmmtMVO+swFOVjwi8PHgwX/uBBF9NLdVGwIMqDB2N0PHhw/ral/Rln517y67f8",
+	"NqRE4Chb1mJDtTojPfbbfFO87RW+8yfK21/Qu3zxvO3VvM0HFA88p61Dab4D/GekSeueJ0e9nDZaIKe5",
+	"iJKEjL4Zy20bf2nkElXyF1pZbkX8ZZ6+darZxUrdHtFUmKMaX9y4iqyzuoyUuRNa4eGnSaXwqPFOWF5y",
+	"yy/If7NrSnWmojds/3ftEqD2fwXHJ3RTG335DR1h3+R+v1jLmlfS7rMNXLhD+iN6LJNt5MLXsk+37D3Q",
+	"f4OyOW8O9XAlbd3XAvYRDRjmwpuEBl/b5uK3rtmb6a8jKi/Fqt1cdIVOws+V5ebC3tYXqBO9+K1HBu7z",
+	"CM3937vucYvrnSqFX3YoWTX1+eI3+jeaCB9Qst6A8eda6GgEqNOlJZxoXnW/+mzF0S+4i0stCtRidB+o",
+	"FHqE+fEyXRPTwiN1/PO+LpI/XvDidX4waDD+CEAiMojeOtwOyGgndmTKCH+jyelCix5+e6XzMz9f8NYq",
+	"LWpxk2sg4XrIjTqwdo0+45mA/ksykyYb/db7s8+DDrW8KLa8qkSPYxzsI24HSxKA7NKXdl9Woba7b+Aq",
+	"JgKK+13NtrWluonQa2remK2KeSOcQ4F7mDhGw0NKf1+AnQkeLkskiCXaPBKdvTuoSf128VvNdwL5gLbT",
+	"DVR0bK3gFbJ6WYnBr6U03BixW42/6L1u68GP3hkR8FaoTU1JInwLuG/N8G8HUvTz4CHWKCqq1dccPuc3",
+	"L/spOacUhX/fipoZ4fK0dY7j6FQr/sMVYKRgvBtyYIdPwT19aHvKKW4MHA6bUjZFjrp54DjTwuq9UyJ3",
+	"lWoigEOiELRage3NJ3EZZlYLgTbwUZYg9SGVO7tez/vaKyItZl2SG7hBozx7sjZWcPSV95l9VwLz+40w",
+	"4+xraAaPH8bMKc2JIUItF3J9QH9b1OpRep9B6UQT79A5+5vYv4MiOec5F4mrDovLv4l9b593/PapqDd2",
+	"e/boo08/y6jl0Hb0hSr3E6+I2+VK1pws193wnWcgfRxP8GaRcJ7BJCzexTWhWAFDs1a8LLix8Ect7I3S",
+	"r0dq8jf3VCoOS8VcJZyb/LWfCnwAke2wlw+OO0dzErGOyCMrDij5HW2LbMm+5RVsuCjZpdNp9bDxx7A+",
+	"fvLRR+8QgoiBAcfQItSsSjOOP+2jp3nef+HZg2Ecy1n39LI6XSWKAuOQlcx5y4PyFljURtRLxySXK1Xu",
+	"l47van5jbynIZSghXPC+RN77BpFbwli56z2sek12Qm9y31CkMLmPJ7DI/rHNsIeEqj+Nnn8aPf80ev5p",
+	"FvvT6Pnn7v5p9HyLRs8/TYJ/mgT/W5oEj7EDpoRzZ5XJy+jyWtSU+WmgDsD8ewRbJjGFtEGS7eV2XVHk",
+	"4DmDFOaaymkYcS00rzCGzHjtkzRsh1lSUNEnykev6mUPEspFAhO/3/2XksC8ah8+/Fiwhx8M+xgrq6qn",
+	"dxv1xVcCfhKwdeyv7NXZq7PRSFrs1LUoKQsjNi9bFJGp18Fh/39h3O/HtWl23BUJ8bWuWJfzutpj4XDG",
+	"N1FNcVTUdSXFtdgJ4LmGSevTWEtQeFaV2xXGawIk9d4ZSwBX3RYedDodkEva3xQI70hn0/81x9P0z7fN",
+	"n2+bE96fU8WM7nv9TI79ZvEnL/4dePHvzo3/2d343mEM0O8inH/y8JN/2gXFVp3vlGVfwWG4pxDr6nMW",
+	"KV3q3cVTpSq0++eUy8Twsh+7sgip777Ax4HPF6u+G1m6EboQHWpkBFrNxrZ8l+PAjH/p+Rx1iUbiuHuU",
+	"vULE/U8/w0VohL72YlkXRv7o4gILZ22VsRdnbxbxNzP4+HPYs9/87dxoeQ34evPzm/9vAJ9xwMWYDQIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -20,11 +20,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -32,27 +35,37 @@ import (
 
 	"github.com/algorand/avm-abi/apps"
 	"github.com/algorand/go-codec/codec"
+	"github.com/algorand/websocket"
+	"golang.org/x/exp/slices"
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	specv2 "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/webhooks"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
+	"github.com/algorand/go-algorand/tools/teal/templates"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 // MaxTealSourceBytes sets a size limit for TEAL source programs for requests
@@ -71,17 +84,49 @@ var WaitForBlockTimeout = 1 * time.Minute
 
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
-	Node     NodeInterface
-	Log      logging.Logger
-	Shutdown <-chan struct{}
+	Node       NodeInterface
+	Log        logging.Logger
+	Shutdown   <-chan struct{}
+	APITokens  APITokenManager
+	Shutdowner ShutdownRequester
+	// Submissions remembers the responses to the transaction submissions made with an idempotency key.
+	// The idempotency keys are ignored when it is nil.
+	Submissions *SubmissionCache
 }
 
+// APITokenManager rotates the algod API token, and manages the named API tokens.
+type APITokenManager interface {
+	RotateAPIToken() (token string, previousExpires time.Time, err error)
+	// CreateAPIToken generates a named API token granted the given scopes, which may only be used
+	// from the given networks if there are any.
+	CreateAPIToken(name string, scopes []middlewares.Scope, networks []*net.IPNet) (token string, err error)
+	DeleteAPIToken(name string) error
+	NamedAPITokens() []NamedAPIToken
+}
+
+// NamedAPIToken describes a named API token, without its value.
+type NamedAPIToken struct {
+	Name     string
+	Scopes   []middlewares.Scope
+	Networks []*net.IPNet
+}
+
+// ShutdownRequester shuts the algod process down.
+type ShutdownRequester interface {
+	RequestShutdown(delay time.Duration)
+}
+
+// maxDrainWait is the longest ShutdownNode waits for the round in progress to complete when draining.
+const maxDrainWait = 30 * time.Second
+
 // LedgerForAPI describes the Ledger methods used by the v2 API.
 type LedgerForAPI interface {
 	LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
+	LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error)
 	LookupKv(round basics.Round, key string) ([]byte, error)
 	LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKeyNum uint64) ([]string, error)
+	LookupKeysByRange(round basics.Round, keyPrefix string, from string, maxKeyNum uint64) ([]string, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
@@ -95,7 +140,16 @@ type LedgerForAPI interface {
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+	GetCatchpointLabel(round basics.Round) (string, error)
+	AssetComplianceEvents(asset basics.AssetIndex, target basics.Address, minRound, maxRound basics.Round) ([]ledgercore.AssetComplianceEvent, error)
+	BoxHistory(app basics.AppIndex, name string) (ledgercore.BoxHistory, error)
+	AccountTransactions(addr basics.Address, minRound, maxRound basics.Round, limit uint64) (ledgercore.AccountTxns, error)
+	AppEvents(app basics.AppIndex, selector *ledgercore.AppEventSelector, minRound, maxRound basics.Round, limit uint64) (ledgercore.AppEvents, error)
+	RecentTransactions(query ledgercore.RecentTxnQuery, limit uint64) (ledgercore.RecentTxns, error)
+	ProposerReport(minRound, maxRound basics.Round, n uint64) ([]ledgercore.ProposerStats, error)
+	LookupAccountChanges(addr basics.Address, from basics.Round, to basics.Round) (ledgercore.AccountChanges, error)
 	GetTracer() logic.EvalTracer
+	SubscribeKvDeltas(prefix string) *ledger.KvDeltaSubscription
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -107,22 +161,52 @@ type NodeInterface interface {
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	AsyncBroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	Simulate(request simulation.Request) (result simulation.Result, err error)
+	SimulateBatch(request simulation.Request) (result simulation.Result, err error)
+	DeleteSimulateSession(name string) error
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
+	PendingBlockPreview() (pools.BlockPreview, bool)
+	TransactionPoolStats() pools.PoolStats
+	FeeEstimate() (pools.FeeEstimate, error)
 	SuggestedFee() basics.MicroAlgos
 	StartCatchup(catchpoint string) error
 	AbortCatchup(catchpoint string) error
+	CatchupProgress(catchpoint string) (catchup.CatchpointCatchupStats, bool)
+	Drain(ctx context.Context) (basics.Round, string)
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
 	ListParticipationKeys() ([]account.ParticipationRecord, error)
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	ParticipationTransportKey() (publicKey, privateKey *[account.TransportKeySize]byte, err error)
+	ExportParticipationKey(id account.ParticipationID, recipient *[account.TransportKeySize]byte, keep bool) ([]byte, error)
+	ImportParticipationKey(sealed []byte) (account.ParticipationID, error)
+	ProveParticipationChallenge(id account.ParticipationID, challenge account.ParticipationChallenge) (account.ParticipationRecord, crypto.VrfProof, error)
+	ParticipationMetrics() ([]agreement.ParticipationMetrics, error)
+	AgreementStatus() (agreement.Status, error)
+	ParticipationKeyAutoRenewal() (node.KeyRenewalSettings, []node.KeyRenewal)
+	SetParticipationKeyAutoRenewal(settings node.KeyRenewalSettings) error
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	AcknowledgeSyncRound(rnd uint64) error
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	ResetPersistedMetrics() error
+	MemorySettings() node.MemorySettings
+	SetMemorySettings(target uint64, ballast uint64) node.MemorySettings
+	FlightRecording(since time.Time) ([]node.FlightRecorderSample, error)
+	PeerDuplicateLatency() ([]metrics.HistogramSnapshot, error)
+	Webhooks() ([]webhooks.Webhook, error)
+	RegisterWebhook(w webhooks.Webhook) (webhooks.Webhook, error)
+	UnregisterWebhook(id string) error
+	Subsystems() []node.SubsystemStatus
+	Features() []config.FeatureStatus
+	SetSubsystemRunning(name string, running bool) error
+	GenerateCatchpoint() (basics.Round, error)
+	StartSnapshot(excludeKeys bool) (node.SnapshotStatus, error)
+	SnapshotStatus() (node.SnapshotStatus, bool)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -183,6 +267,15 @@ var ErrTimeout = errors.New("timed out on request")
 // ErrShutdown represents the error for the string errServiceShuttingDown
 var ErrShutdown = errors.New(errServiceShuttingDown)
 
+// ErrAPIAuthDisabled is returned by an APITokenManager when the API does not require a token.
+var ErrAPIAuthDisabled = errors.New(errAPIAuthDisabled)
+
+// ErrAPITokenExists is returned by an APITokenManager when a token already has the name of the created one.
+var ErrAPITokenExists = errors.New(errAPITokenExists)
+
+// ErrAPITokenNotFound is returned by an APITokenManager when no named token has the given name.
+var ErrAPITokenNotFound = errors.New(errAPITokenNotFound)
+
 // GetStateProofTransactionForRound searches for a state proof transaction that can be used to prove on the given round (i.e the round is within the
 // attestation period). the latestRound should be provided as an upper bound for the search
 func GetStateProofTransactionForRound(ctx context.Context, txnFetcher LedgerForAPI, round, latestRound basics.Round, stop <-chan struct{}) (transactions.Transaction, error) {
@@ -341,853 +434,2261 @@ func (v2 *Handlers) AppendKeys(ctx echo.Context, participationID string) error {
 	return nil
 }
 
-// ShutdownNode shuts down the node.
-// (POST /v2/shutdown)
-func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodeParams) error {
-	// TODO: shutdown endpoint
-	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
-}
-
-// AccountInformation gets account information for a given account.
-// (GET /v2/accounts/{address})
-func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params model.AccountInformationParams) error {
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+// GetParticipationTransportKey returns the key participation keys must be sealed to before they are imported.
+// (GET /v2/participation/transport-key)
+func (v2 *Handlers) GetParticipationTransportKey(ctx echo.Context) error {
+	publicKey, _, err := v2.Node.ParticipationTransportKey()
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
 
-	addr, err := basics.UnmarshalChecksumAddress(address)
-	if err != nil {
-		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
-	}
+	response := model.ParticipationTransportKeyResponse{TransportKey: publicKey[:]}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	// should we skip fetching apps and assets?
-	if params.Exclude != nil {
-		switch *params.Exclude {
-		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
-		case "none", "":
-		default:
-			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
+func keyRenewalResponse(settings node.KeyRenewalSettings, renewals []node.KeyRenewal) model.ParticipationKeyAutoRenewalResponse {
+	response := model.ParticipationKeyAutoRenewalResponse{
+		Enabled:    settings.Enabled,
+		LeadRounds: settings.LeadRounds,
+		Validity:   settings.Validity,
+		Renewals:   make([]model.ParticipationKeyRenewal, len(renewals)),
+	}
+	for i, renewal := range renewals {
+		response.Renewals[i] = model.ParticipationKeyRenewal{
+			Address:    renewal.Account.String(),
+			Id:         renewal.ID.String(),
+			FirstValid: uint64(renewal.FirstValid),
+			LastValid:  uint64(renewal.LastValid),
+			Round:      uint64(renewal.Round),
+			Signed:     renewal.Signed,
+			Keyreg:     protocol.Encode(&renewal.Keyreg),
 		}
 	}
+	return response
+}
 
-	myLedger := v2.Node.LedgerForAPI()
+// GetParticipationKeyAutoRenewal returns the settings of the automatic renewal of participation keys and the keys it installed.
+// (GET /v2/participation/autorenew)
+func (v2 *Handlers) GetParticipationKeyAutoRenewal(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, keyRenewalResponse(v2.Node.ParticipationKeyAutoRenewal()))
+}
 
-	// count total # of resources, if max limit is set
-	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
-		record, _, _, lookupErr := myLedger.LookupAccount(myLedger.Latest(), addr)
-		if lookupErr != nil {
-			return internalError(ctx, lookupErr, errFailedLookingUpLedger, v2.Log)
-		}
-		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
-		if totalResults > maxResults {
-			v2.Log.Infof("MaxAccountAPIResults limit %d exceeded, total results %d", maxResults, totalResults)
-			extraData := map[string]interface{}{
-				"max-results":           maxResults,
-				"total-assets-opted-in": record.TotalAssets,
-				"total-created-assets":  record.TotalAssetParams,
-				"total-apps-opted-in":   record.TotalAppLocalStates,
-				"total-created-apps":    record.TotalAppParams,
-			}
-			return ctx.JSON(http.StatusBadRequest, model.ErrorResponse{
-				Message: "Result limit exceeded",
-				Data:    &extraData,
-			})
+// SetParticipationKeyAutoRenewal changes the settings of the automatic renewal of participation keys, keeping the current value of those not provided.
+// (POST /v2/participation/autorenew)
+func (v2 *Handlers) SetParticipationKeyAutoRenewal(ctx echo.Context, params model.SetParticipationKeyAutoRenewalParams) error {
+	settings, _ := v2.Node.ParticipationKeyAutoRenewal()
+	if params.Enabled != nil {
+		settings.Enabled = *params.Enabled
+	}
+	if params.LeadRounds != nil {
+		settings.LeadRounds = *params.LeadRounds
+	}
+	if params.Validity != nil {
+		settings.Validity = *params.Validity
+	}
+	err := v2.Node.SetParticipationKeyAutoRenewal(settings)
+	if err != nil {
+		if errors.Is(err, node.ErrKeyRenewalLeadOverValidity) {
+			return badRequest(ctx, err, errKeyRenewalLeadOverValidity, v2.Log)
 		}
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
+	return ctx.JSON(http.StatusOK, keyRenewalResponse(v2.Node.ParticipationKeyAutoRenewal()))
+}
 
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
+// GetParticipationMetrics returns the participation metrics of the accounts of this node.
+// (GET /v2/participation/metrics)
+func (v2 *Handlers) GetParticipationMetrics(ctx echo.Context) error {
+	participation, err := v2.Node.ParticipationMetrics()
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return internalError(ctx, err, fmt.Sprintf(errFailedRetrievingParticipationMetrics, err), v2.Log)
 	}
 
-	// check against configured total limit on assets/apps
-	if handle == protocol.CodecHandle {
-		data, err := encode(handle, record)
-		if err != nil {
-			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	response := model.ParticipationMetricsResponse{
+		Metrics: make([]model.AccountParticipationMetrics, len(participation)),
+	}
+	for i, m := range participation {
+		response.Metrics[i] = model.AccountParticipationMetrics{
+			Address:             m.Address.String(),
+			Proposals:           m.Proposals,
+			SoftVotes:           m.SoftVotes,
+			CertVotes:           m.CertVotes,
+			NextVotes:           m.NextVotes,
+			CredentialsWon:      m.CredentialsWon,
+			CredentialsExpected: m.CredentialsExpected,
 		}
-		return ctx.Blob(http.StatusOK, contentType, data)
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	consensus, err := myLedger.ConsensusParams(lastRound)
+// GetAgreementStatus returns the status of the agreement of this node in the current round.
+// (GET /v2/agreement/status)
+func (v2 *Handlers) GetAgreementStatus(ctx echo.Context) error {
+	status, err := v2.Node.AgreementStatus()
 	if err != nil {
-		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound), v2.Log)
+		return internalError(ctx, err, fmt.Sprintf(errFailedRetrievingAgreementStatus, err), v2.Log)
+	}
+
+	response := model.AgreementStatusResponse{
+		Round:         uint64(status.Round),
+		Period:        status.Period,
+		Step:          status.Step,
+		ProposalsSeen: status.ProposalsSeen,
+		Votes:         make([]model.AgreementStepVotes, len(status.Votes)),
+	}
+	for i, sv := range status.Votes {
+		response.Votes[i] = model.AgreementStepVotes{
+			Period: sv.Period,
+			Step:   sv.Step,
+			Votes:  sv.Votes,
+			Weight: sv.Weight,
+		}
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	account, err := AccountDataToAccount(address, &record, lastRound, &consensus, amountWithoutPendingRewards)
+// GetAgreementAccounts returns the agreement attempts of the accounts of this node in the current round.
+// (GET /v2/agreement/accounts)
+func (v2 *Handlers) GetAgreementAccounts(ctx echo.Context) error {
+	status, err := v2.Node.AgreementStatus()
 	if err != nil {
-		return internalError(ctx, err, errInternalFailure, v2.Log)
+		return internalError(ctx, err, fmt.Sprintf(errFailedRetrievingAgreementStatus, err), v2.Log)
 	}
 
-	response := model.AccountResponse(account)
+	response := model.AgreementAccountsResponse{
+		Round:    uint64(status.Round),
+		Accounts: make([]model.AgreementAccountStatus, len(status.Accounts)),
+	}
+	for i, a := range status.Accounts {
+		response.Accounts[i] = model.AgreementAccountStatus{
+			Address:          a.Address.String(),
+			ProposalAttempts: a.ProposalAttempts,
+			Proposals:        a.Proposals,
+			VoteAttempts:     a.VoteAttempts,
+			Votes:            a.Votes,
+		}
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
-	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+// ExportParticipationKeyByID returns a participation key, sealed to the recipient's transport key.
+// The key is removed from this node, unless the keep parameter is set.
+// (GET /v2/participation/{participation-id}/export)
+func (v2 *Handlers) ExportParticipationKeyByID(ctx echo.Context, participationID string, params model.ExportParticipationKeyByIDParams) error {
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	if handle == protocol.CodecHandle {
-		data, encErr := encode(handle, record)
-		if encErr != nil {
-			return internalError(ctx, encErr, errFailedToEncodeResponse, v2.Log)
+	if len(params.Recipient) != account.TransportKeySize {
+		err = fmt.Errorf("recipient transport key must be %d bytes, got %d", account.TransportKeySize, len(params.Recipient))
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	var recipient [account.TransportKeySize]byte
+	copy(recipient[:], params.Recipient)
+
+	keep := params.Keep != nil && *params.Keep
+	sealed, err := v2.Node.ExportParticipationKey(decodedParticipationID, &recipient, keep)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, account.ErrParticipationIDNotFound, "participation id not found", v2.Log)
 		}
-		return ctx.Blob(http.StatusOK, contentType, data)
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
 
-	consensus, err := myLedger.ConsensusParams(lastRound)
+	response := model.ParticipationExportResponse{SealedKey: sealed}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ProveParticipationChallenge proves a challenge with the selection key of a participation key.
+// (GET /v2/participation/{participation-id}/challenge)
+func (v2 *Handlers) ProveParticipationChallenge(ctx echo.Context, participationID string, params model.ProveParticipationChallengeParams) error {
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
 	if err != nil {
-		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound), v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	var apiParticipation *model.AccountParticipation
-	if record.VoteID != (crypto.OneTimeSignatureVerifier{}) {
-		apiParticipation = &model.AccountParticipation{
-			VoteParticipationKey:      record.VoteID[:],
-			SelectionParticipationKey: record.SelectionID[:],
-			VoteFirstValid:            uint64(record.VoteFirstValid),
-			VoteLastValid:             uint64(record.VoteLastValid),
-			VoteKeyDilution:           uint64(record.VoteKeyDilution),
-		}
-		if !record.StateProofID.IsEmpty() {
-			tmp := record.StateProofID[:]
-			apiParticipation.StateProofKey = &tmp
-		}
+	if len(params.Challenge) == 0 || len(params.Challenge) > account.MaxParticipationChallengeSize {
+		err = fmt.Errorf("challenge must be between 1 and %d bytes, got %d", account.MaxParticipationChallengeSize, len(params.Challenge))
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	pendingRewards, overflowed := basics.OSubA(record.MicroAlgos, amountWithoutPendingRewards)
-	if overflowed {
-		return internalError(ctx, errors.New("overflow on pending reward calculation"), errInternalFailure, v2.Log)
+	record, proof, err := v2.Node.ProveParticipationChallenge(decodedParticipationID, params.Challenge)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, account.ErrParticipationIDNotFound, "participation id not found", v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
 
-	account := model.Account{
-		SigType:                     nil,
-		Round:                       uint64(lastRound),
-		Address:                     addr.String(),
-		Amount:                      record.MicroAlgos.Raw,
-		PendingRewards:              pendingRewards.Raw,
-		AmountWithoutPendingRewards: amountWithoutPendingRewards.Raw,
-		Rewards:                     record.RewardedMicroAlgos.Raw,
-		Status:                      record.Status.String(),
-		RewardBase:                  &record.RewardsBase,
-		Participation:               apiParticipation,
-		TotalCreatedAssets:          record.TotalAssetParams,
-		TotalCreatedApps:            record.TotalAppParams,
-		TotalAssetsOptedIn:          record.TotalAssets,
-		AuthAddr:                    addrOrNil(record.AuthAddr),
-		TotalAppsOptedIn:            record.TotalAppLocalStates,
-		AppsTotalSchema: &model.ApplicationStateSchema{
-			NumByteSlice: record.TotalAppSchema.NumByteSlice,
-			NumUint:      record.TotalAppSchema.NumUint,
-		},
-		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
-		TotalBoxes:          omitEmpty(record.TotalBoxes),
-		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
-		MinBalance:          record.MinBalance(&consensus).Raw,
+	response := model.ParticipationChallengeResponse{
+		Address:                   record.Account.String(),
+		SelectionParticipationKey: record.VRF.PK[:],
+		Proof:                     proof[:],
 	}
-	response := model.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// AccountAssetInformation gets account information about a given asset.
-// (GET /v2/accounts/{address}/assets/{asset-id})
-func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params model.AccountAssetInformationParams) error {
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+// ImportParticipationKey installs a participation key sealed to this node's transport key.
+// (POST /v2/participation/import)
+func (v2 *Handlers) ImportParticipationKey(ctx echo.Context) error {
+	buf := new(bytes.Buffer)
+	_, err := buf.ReadFrom(ctx.Request().Body)
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
+	sealed := buf.Bytes()
 
-	addr, err := basics.UnmarshalChecksumAddress(address)
-	if err != nil {
-		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	if len(sealed) == 0 {
+		lenErr := fmt.Errorf(errRESTPayloadZeroLength)
+		return badRequest(ctx, lenErr, lenErr.Error(), v2.Log)
 	}
 
-	ledger := v2.Node.LedgerForAPI()
-
-	lastRound := ledger.Latest()
-	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
+	partID, err := v2.Node.ImportParticipationKey(sealed)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	if record.AssetParams == nil && record.AssetHolding == nil {
-		return notFound(ctx, errors.New(errAccountAssetDoesNotExist), errAccountAssetDoesNotExist, v2.Log)
-	}
+	response := model.PostParticipationResponse{PartId: partID.String()}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	// return msgpack response
-	if handle == protocol.CodecHandle {
-		data, err := encode(handle, specv2.AssetResourceToAccountAssetModel(record))
-		if err != nil {
-			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+// ShutdownNode shuts down the node.
+// (POST /v2/shutdown)
+func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodeParams) error {
+	if v2.Shutdowner == nil {
+		return writeErrorResponse(ctx, http.StatusNotImplemented, errEndpointNotImplemented, nil)
+	}
+
+	var response model.ShutdownNodeResponse
+	if params.Drain != nil && *params.Drain {
+		drainCtx, cancel := context.WithTimeout(ctx.Request().Context(), maxDrainWait)
+		defer cancel()
+		round, label := v2.Node.Drain(drainCtx)
+		response.Round = uint64(round)
+		if label != "" {
+			response.CatchpointWritten = true
+			response.Catchpoint = &label
 		}
-		return ctx.Blob(http.StatusOK, contentType, data)
+	} else {
+		response.Round = uint64(v2.Node.LedgerForAPI().Latest())
 	}
 
-	// prepare JSON response
-	response := model.AccountAssetResponse{Round: uint64(lastRound)}
+	var delay time.Duration
+	if params.Timeout != nil {
+		delay = time.Duration(*params.Timeout) * time.Second
+	}
+	v2.Log.Infof("ShutdownNode: shutting down in %v at round %d", delay, response.Round)
+	v2.Shutdowner.RequestShutdown(delay)
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	if record.AssetParams != nil {
-		asset := AssetParamsToAsset(addr.String(), basics.AssetIndex(assetID), record.AssetParams)
-		response.CreatedAsset = &asset.Params
+// ResetPersistedMetrics sets the persisted node metrics back to zero.
+// (POST /v2/metrics/reset)
+func (v2 *Handlers) ResetPersistedMetrics(ctx echo.Context) error {
+	if !v2.Node.Config().EnableMetricsPersistence {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errMetricsPersistenceNotEnabled, nil)
+	}
+	err := v2.Node.ResetPersistedMetrics()
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedResettingPersistedMetrics, err), v2.Log)
 	}
+	return ctx.NoContent(http.StatusOK)
+}
 
-	if record.AssetHolding != nil {
-		response.AssetHolding = &model.AssetHolding{
-			Amount:   record.AssetHolding.Amount,
-			AssetID:  uint64(assetID),
-			IsFrozen: record.AssetHolding.Frozen,
+// GetPeerDuplicateLatency returns, for each peer, how long after their first arrival the votes and proposals arrive from it.
+// (GET /v2/peers/duplicate-latency)
+func (v2 *Handlers) GetPeerDuplicateLatency(ctx echo.Context) error {
+	histograms, err := v2.Node.PeerDuplicateLatency()
+	if err != nil {
+		if errors.Is(err, network.ErrPeerDuplicateLatencyDisabled) {
+			return badRequest(ctx, err, errPeerDuplicateLatencyDisabled, v2.Log)
 		}
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
-
+	response := model.PeerDuplicateLatencyResponse{Peers: convertSlice(histograms, peerDuplicateLatencyToModel)}
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// AccountApplicationInformation gets account information about a given app.
-// (GET /v2/accounts/{address}/applications/{application-id})
-func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address string, applicationID uint64, params model.AccountApplicationInformationParams) error {
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
-	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+func peerDuplicateLatencyToModel(h metrics.HistogramSnapshot) model.PeerDuplicateLatency {
+	res := model.PeerDuplicateLatency{
+		Peer:    h.Labels["peer"],
+		Tag:     h.Labels["tag"],
+		Buckets: make([]model.HistogramBucket, len(h.Buckets)),
+		Count:   h.Count,
+		Sum:     h.Sum,
 	}
+	for i, bound := range h.Buckets {
+		res.Buckets[i] = model.HistogramBucket{UpperBound: bound, Count: h.Counts[i]}
+	}
+	return res
+}
 
-	addr, err := basics.UnmarshalChecksumAddress(address)
-	if err != nil {
-		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+func memorySettingsResponse(settings node.MemorySettings) model.MemorySettingsResponse {
+	response := model.MemorySettingsResponse{
+		Target:   settings.Target,
+		Ballast:  settings.Ballast,
+		LiveHeap: settings.LiveHeap,
+	}
+	if settings.GCPercent > 0 {
+		response.GcPercent = uint64(settings.GCPercent)
 	}
+	if settings.MemoryLimit > 0 {
+		response.MemoryLimit = uint64(settings.MemoryLimit)
+	}
+	return response
+}
 
-	ledger := v2.Node.LedgerForAPI()
+// GetMemorySettings returns the memory target and ballast of the node, and the garbage collector settings derived from them.
+// (GET /v2/memory)
+func (v2 *Handlers) GetMemorySettings(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, memorySettingsResponse(v2.Node.MemorySettings()))
+}
 
-	lastRound := ledger.Latest()
-	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+// SetMemorySettings changes the memory target and ballast of the node, keeping the current value of those not provided.
+// (PUT /v2/memory)
+func (v2 *Handlers) SetMemorySettings(ctx echo.Context, params model.SetMemorySettingsParams) error {
+	current := v2.Node.MemorySettings()
+	target, ballast := current.Target, current.Ballast
+	if params.Target != nil {
+		target = *params.Target
+	}
+	if params.Ballast != nil {
+		ballast = *params.Ballast
+	}
+	if target != 0 && ballast >= target {
+		return badRequest(ctx, nil, errMemoryBallastOverTarget, v2.Log)
 	}
+	return ctx.JSON(http.StatusOK, memorySettingsResponse(v2.Node.SetMemorySettings(target, ballast)))
+}
 
-	if record.AppParams == nil && record.AppLocalState == nil {
-		return notFound(ctx, errors.New(errAccountAppDoesNotExist), errAccountAppDoesNotExist, v2.Log)
+// loggingLevels names the logging levels, as the logging settings endpoints take them.
+var loggingLevels = map[model.SetLoggingSettingsParamsLevel]logging.Level{
+	model.SetLoggingSettingsParamsLevelPanic: logging.Panic,
+	model.SetLoggingSettingsParamsLevelFatal: logging.Fatal,
+	model.SetLoggingSettingsParamsLevelError: logging.Error,
+	model.SetLoggingSettingsParamsLevelWarn:  logging.Warn,
+	model.SetLoggingSettingsParamsLevelInfo:  logging.Info,
+	model.SetLoggingSettingsParamsLevelDebug: logging.Debug,
+}
+
+// loggingOutputDefault is the output of the logging settings endpoints standing for the output the
+// node started with.
+const loggingOutputDefault = "default"
+
+func (v2 *Handlers) loggingSettingsResponse() model.LoggingSettingsResponse {
+	response := model.LoggingSettingsResponse{
+		Output:                      v2.Log.OutputRedirection(),
+		TelemetryEnabled:            v2.Log.GetTelemetryEnabled(),
+		DisabledTelemetryCategories: []string{},
+	}
+	if response.Output == "" {
+		response.Output = loggingOutputDefault
+	}
+	for name, level := range loggingLevels {
+		if level == v2.Log.GetLevel() {
+			response.Level = string(name)
+		}
+	}
+	for _, category := range v2.Log.DisabledTelemetryCategories() {
+		response.DisabledTelemetryCategories = append(response.DisabledTelemetryCategories, string(category))
 	}
+	return response
+}
 
-	// return msgpack response
-	if handle == protocol.CodecHandle {
-		data, err := encode(handle, specv2.AppResourceToAccountApplicationModel(record))
-		if err != nil {
-			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+// parseTelemetryCategories parses a comma-separated list of telemetry categories.
+func parseTelemetryCategories(list *string) ([]telemetryspec.Category, error) {
+	if list == nil || *list == "" {
+		return nil, nil
+	}
+	var categories []telemetryspec.Category
+	for _, name := range strings.Split(*list, ",") {
+		category := telemetryspec.Category(strings.TrimSpace(name))
+		if !slices.Contains(telemetryspec.Categories, category) {
+			return nil, fmt.Errorf(errUnknownTelemetryCategory, category)
 		}
-		return ctx.Blob(http.StatusOK, contentType, data)
+		categories = append(categories, category)
 	}
+	return categories, nil
+}
 
-	// prepare JSON response
-	response := model.AccountApplicationResponse{Round: uint64(lastRound)}
+// GetLoggingSettings returns the logging level of the node, where its log is written and the telemetry categories it doesn't send.
+// (GET /v2/debug/logging)
+func (v2 *Handlers) GetLoggingSettings(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, v2.loggingSettingsResponse())
+}
 
-	if record.AppParams != nil {
-		app := AppParamsToApplication(addr.String(), basics.AppIndex(applicationID), record.AppParams)
-		response.CreatedApp = &app.Params
+// SetLoggingSettings changes the logging settings of the node at runtime, keeping the current value of those not provided.
+// (PUT /v2/debug/logging)
+func (v2 *Handlers) SetLoggingSettings(ctx echo.Context, params model.SetLoggingSettingsParams) error {
+	enable, err := parseTelemetryCategories(params.EnableTelemetryCategories)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
-
-	if record.AppLocalState != nil {
-		localState := convertTKVToGenerated(&record.AppLocalState.KeyValue)
-		response.AppLocalState = &model.ApplicationLocalState{
-			Id:       uint64(applicationID),
-			KeyValue: localState,
-			Schema: model.ApplicationStateSchema{
-				NumByteSlice: record.AppLocalState.Schema.NumByteSlice,
-				NumUint:      record.AppLocalState.Schema.NumUint,
-			},
+	disable, err := parseTelemetryCategories(params.DisableTelemetryCategories)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if params.Output != nil {
+		target := *params.Output
+		if target == loggingOutputDefault {
+			target = ""
 		}
+		err = v2.Log.RedirectOutput(target)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedRedirectingLog, err), v2.Log)
+		}
+	}
+	if params.Level != nil {
+		v2.Log.SetLevel(loggingLevels[*params.Level])
+	}
+	for _, category := range enable {
+		v2.Log.SetTelemetryCategoryEnabled(category, true)
+	}
+	for _, category := range disable {
+		v2.Log.SetTelemetryCategoryEnabled(category, false)
 	}
 
+	response := v2.loggingSettingsResponse()
+	v2.Log.Infof("SetLoggingSettings: level %s, output %s, disabled telemetry categories %v", response.Level, response.Output, response.DisabledTelemetryCategories)
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetBlock gets the block for the given round.
-// (GET /v2/blocks/{round})
-func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlockParams) error {
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+// GetFlightRecording returns the samples of the flight recorder of the node, oldest first.
+// (GET /v2/flight-recorder)
+func (v2 *Handlers) GetFlightRecording(ctx echo.Context, params model.GetFlightRecordingParams) error {
+	var since time.Time
+	if params.Since != nil {
+		since = time.Unix(int64(*params.Since), 0)
+	}
+	samples, err := v2.Node.FlightRecording(since)
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		if errors.Is(err, node.ErrFlightRecorderDisabled) {
+			return badRequest(ctx, err, errFlightRecorderDisabled, v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
-
-	// msgpack format uses 'RawBlockBytes' and attaches a custom header.
-	if handle == protocol.CodecHandle {
-		blockbytes, blockErr := rpcs.RawBlockBytes(v2.Node.LedgerForAPI(), basics.Round(round))
-		if blockErr != nil {
-			switch blockErr.(type) {
-			case ledgercore.ErrNoEntry:
-				return notFound(ctx, blockErr, errFailedLookingUpLedger, v2.Log)
-			default:
-				return internalError(ctx, blockErr, blockErr.Error(), v2.Log)
-			}
+	response := model.FlightRecordingResponse{Samples: make([]model.FlightRecorderSample, len(samples))}
+	for i, sample := range samples {
+		response.Samples[i] = model.FlightRecorderSample{
+			Time:            uint64(sample.Time.Unix()),
+			CpuTime:         uint64(sample.CPUTime / time.Microsecond),
+			Memory:          sample.MemoryBytes,
+			Heap:            sample.HeapBytes,
+			DiskReadBlocks:  sample.DiskReadBlocks,
+			DiskWriteBlocks: sample.DiskWriteBlocks,
+			Round:           uint64(sample.LatestRound),
+			LedgerLag:       uint64(sample.LedgerLag / time.Millisecond),
+			TxpoolSize:      uint64(sample.TxPoolSize),
 		}
-
-		ctx.Response().Writer.Header().Add("X-Algorand-Struct", "block-v1")
-		return ctx.Blob(http.StatusOK, contentType, blockbytes)
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	ledger := v2.Node.LedgerForAPI()
-	block, err := ledger.Block(basics.Round(round))
+// RotateAPIToken generates a new algod API token, while the previous one keeps being accepted for the configured overlap period.
+// (POST /v2/api-token/rotate)
+func (v2 *Handlers) RotateAPIToken(ctx echo.Context) error {
+	if v2.APITokens == nil {
+		return writeErrorResponse(ctx, http.StatusNotImplemented, errEndpointNotImplemented, nil)
+	}
+	token, previousExpires, err := v2.APITokens.RotateAPIToken()
 	if err != nil {
-		switch err.(type) {
-		case ledgercore.ErrNoEntry:
-			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
-		default:
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		if errors.Is(err, ErrAPIAuthDisabled) {
+			return badRequest(ctx, err, errAPIAuthDisabled, v2.Log)
 		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedRotatingAPIToken, err), v2.Log)
 	}
-
-	// Encoding wasn't working well without embedding "real" objects.
-	response := struct {
-		Block bookkeeping.Block `codec:"block"`
-	}{
-		Block: block,
+	response := model.RotateAPITokenResponse{
+		Token:                   token,
+		PreviousTokenExpiration: uint64(previousExpires.Unix()),
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	data, err := encode(handle, response)
-	if err != nil {
-		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
-	}
+// maxCreateAPITokenBytes bounds the body of CreateAPIToken requests.
+const maxCreateAPITokenBytes = 64 << 10
 
-	return ctx.Blob(http.StatusOK, contentType, data)
+// apiTokenNamePattern matches the valid names of named API tokens.
+var apiTokenNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// ListAPITokens returns the named API tokens, without their values.
+// (GET /v2/tokens)
+func (v2 *Handlers) ListAPITokens(ctx echo.Context) error {
+	if v2.APITokens == nil {
+		return writeErrorResponse(ctx, http.StatusNotImplemented, errEndpointNotImplemented, nil)
+	}
+	response := model.APITokensResponse{Tokens: []model.APIToken{}}
+	for _, token := range v2.APITokens.NamedAPITokens() {
+		response.Tokens = append(response.Tokens, model.APIToken{
+			Name:         token.Name,
+			Scopes:       convertSlice(token.Scopes, func(scope middlewares.Scope) model.APITokenScopes { return model.APITokenScopes(scope) }),
+			AllowedCidrs: sliceOrNil(convertSlice(token.Networks, (*net.IPNet).String)),
+		})
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetBlockTxids gets all top level TxIDs in a block for the given round.
-// (GET /v2/blocks/{round}/txids)
-func (v2 *Handlers) GetBlockTxids(ctx echo.Context, round uint64) error {
-	ledger := v2.Node.LedgerForAPI()
-	block, err := ledger.Block(basics.Round(round))
+// CreateAPIToken generates a named API token granted the requested scopes.
+// (POST /v2/tokens)
+func (v2 *Handlers) CreateAPIToken(ctx echo.Context) error {
+	if v2.APITokens == nil {
+		return writeErrorResponse(ctx, http.StatusNotImplemented, errEndpointNotImplemented, nil)
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(nil, ctx.Request().Body, maxCreateAPITokenBytes))
 	if err != nil {
-		switch err.(type) {
-		case ledgercore.ErrNoEntry:
-			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
-		default:
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+	var request model.CreateAPITokenRequest
+	err = decode(protocol.JSONStrictHandle, data, &request)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if !apiTokenNamePattern.MatchString(request.Name) {
+		return badRequest(ctx, nil, errInvalidAPITokenName, v2.Log)
+	}
+	if len(request.Scopes) == 0 {
+		return badRequest(ctx, nil, errMissingAPITokenScopes, v2.Log)
+	}
+	scopes := make([]middlewares.Scope, len(request.Scopes))
+	for i, s := range request.Scopes {
+		scopes[i], err = middlewares.ParseScope(string(s))
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	var networks []*net.IPNet
+	if request.AllowedCidrs != nil {
+		for _, cidr := range *request.AllowedCidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return badRequest(ctx, err, fmt.Sprintf(errFailedToParseCIDR, cidr), v2.Log)
+			}
+			networks = append(networks, network)
 		}
 	}
 
-	txns, err := block.DecodePaysetFlat()
+	token, err := v2.APITokens.CreateAPIToken(request.Name, scopes, networks)
 	if err != nil {
-		return internalError(ctx, err, "decoding transactions", v2.Log)
+		if errors.Is(err, ErrAPITokenExists) {
+			return writeErrorResponse(ctx, http.StatusConflict, errAPITokenExists, nil)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedCreatingAPIToken, err), v2.Log)
 	}
+	v2.Log.Infof("API token %s was created with scopes %v", request.Name, scopes)
+	return ctx.JSON(http.StatusOK, model.CreateAPITokenResponse{Name: request.Name, Token: token})
+}
 
-	txids := make([]string, 0, len(txns))
-	for ids := range txns {
-		txids = append(txids, txns[ids].ID().String())
+// DeleteAPIToken revokes a named API token.
+// (DELETE /v2/tokens/{name})
+func (v2 *Handlers) DeleteAPIToken(ctx echo.Context, name string) error {
+	if v2.APITokens == nil {
+		return writeErrorResponse(ctx, http.StatusNotImplemented, errEndpointNotImplemented, nil)
+	}
+	err := v2.APITokens.DeleteAPIToken(name)
+	if err != nil {
+		if errors.Is(err, ErrAPITokenNotFound) {
+			return notFound(ctx, err, errAPITokenNotFound, v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedDeletingAPIToken, err), v2.Log)
 	}
+	v2.Log.Infof("API token %s was deleted", name)
+	return ctx.NoContent(http.StatusOK)
+}
 
-	response := model.BlockTxidsResponse{BlockTxids: txids}
+// maxCreateWebhookBytes bounds the body of CreateWebhook requests.
+const maxCreateWebhookBytes = 64 << 10
 
-	return ctx.JSON(http.StatusOK, response)
+func webhookToModel(w webhooks.Webhook) model.Webhook {
+	res := model.Webhook{
+		Id:            w.ID,
+		Url:           w.URL,
+		Signed:        w.Secret != "",
+		Configured:    w.Configured,
+		ApplicationId: omitEmpty(uint64(w.Filter.Application)),
+		AssetId:       omitEmpty(uint64(w.Filter.Asset)),
+		Address:       addrOrNil(w.Filter.Address),
+	}
+	if w.Filter.TxType != "" {
+		txType := model.WebhookTxType(w.Filter.TxType)
+		res.TxType = &txType
+	}
+	return res
 }
 
-// GetBlockHash gets the block hash for the given round.
-// (GET /v2/blocks/{round}/hash)
-func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
-	ledger := v2.Node.LedgerForAPI()
-	block, err := ledger.Block(basics.Round(round))
+// ListWebhooks returns the webhooks registered on the node, without their secrets.
+// (GET /v2/webhooks)
+func (v2 *Handlers) ListWebhooks(ctx echo.Context) error {
+	registered, err := v2.Node.Webhooks()
 	if err != nil {
-		switch err.(type) {
-		case ledgercore.ErrNoEntry:
-			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
-		default:
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		if errors.Is(err, webhooks.ErrDisabled) {
+			return badRequest(ctx, err, errWebhooksDisabled, v2.Log)
 		}
+		return internalError(ctx, err, err.Error(), v2.Log)
 	}
-
-	response := model.BlockHashResponse{BlockHash: crypto.Digest(block.Hash()).String()}
-
+	response := model.WebhooksResponse{Webhooks: convertSlice(registered, webhookToModel)}
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetTransactionProof generates a Merkle proof for a transaction in a block.
-// (GET /v2/blocks/{round}/transactions/{txid}/proof)
-func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round uint64, txid string, params model.GetTransactionProofParams) error {
-	var txID transactions.Txid
-	err := txID.UnmarshalText([]byte(txid))
+// CreateWebhook registers a webhook, to which the matching transactions of the blocks committed from then on are posted.
+// (POST /v2/webhooks)
+func (v2 *Handlers) CreateWebhook(ctx echo.Context) error {
+	data, err := io.ReadAll(http.MaxBytesReader(nil, ctx.Request().Body, maxCreateWebhookBytes))
 	if err != nil {
-		return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
-	}
-
-	if params.Hashtype != nil && *params.Hashtype != "sha512_256" && *params.Hashtype != "sha256" {
-		return badRequest(ctx, nil, errInvalidHashType, v2.Log)
+		return rejectRequestBody(ctx, err, v2.Log)
 	}
-
-	ledger := v2.Node.LedgerForAPI()
-	block, err := ledger.Block(basics.Round(round))
+	var request model.CreateWebhookRequest
+	err = decode(protocol.JSONStrictHandle, data, &request)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
-
-	proto := config.Consensus[block.CurrentProtocol]
-	if proto.PaysetCommit != config.PaysetCommitMerkle {
-		return notFound(ctx, err, "protocol does not support Merkle proofs", v2.Log)
+	w := webhooks.Webhook{
+		URL:    request.Url,
+		Secret: nilToZero(request.Secret),
+		Filter: webhooks.Filter{
+			Application: basics.AppIndex(nilToZero(request.ApplicationId)),
+			Asset:       basics.AssetIndex(nilToZero(request.AssetId)),
+			TxType:      protocol.TxType(nilToZero(request.TxType)),
+		},
 	}
-
-	hashtype := "sha512_256" // default hash type for proof
-	if params.Hashtype != nil {
-		hashtype = string(*params.Hashtype)
+	if request.Address != nil {
+		w.Filter.Address, err = basics.UnmarshalChecksumAddress(*request.Address)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
 	}
-	if hashtype == "sha256" && !proto.EnableSHA256TxnCommitmentHeader {
-		return badRequest(ctx, err, "protocol does not support sha256 vector commitment proofs", v2.Log)
+	if err = w.Validate(); err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errInvalidWebhook, err), v2.Log)
 	}
 
-	txns, err := block.DecodePaysetFlat()
+	w, err = v2.Node.RegisterWebhook(w)
 	if err != nil {
-		return internalError(ctx, err, "decoding transactions", v2.Log)
+		switch {
+		case errors.Is(err, webhooks.ErrDisabled):
+			return badRequest(ctx, err, errWebhooksDisabled, v2.Log)
+		case errors.Is(err, webhooks.ErrTooMany):
+			return badRequest(ctx, err, errTooManyWebhooks, v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedRegisteringWebhook, err), v2.Log)
 	}
+	v2.Log.Infof("Webhook %s was registered for %s", w.ID, w.URL)
+	return ctx.JSON(http.StatusOK, webhookToModel(w))
+}
 
-	for idx := range txns {
-		if txns[idx].ID() != txID {
-			continue // skip
+// DeleteWebhook stops posting notifications to a webhook.
+// (DELETE /v2/webhooks/{id})
+func (v2 *Handlers) DeleteWebhook(ctx echo.Context, id string) error {
+	err := v2.Node.UnregisterWebhook(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrDisabled):
+			return badRequest(ctx, err, errWebhooksDisabled, v2.Log)
+		case errors.Is(err, webhooks.ErrNotFound):
+			return notFound(ctx, err, errWebhookNotFound, v2.Log)
+		case errors.Is(err, webhooks.ErrConfigured):
+			return badRequest(ctx, err, errWebhookConfigured, v2.Log)
 		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedDeletingWebhook, err), v2.Log)
+	}
+	v2.Log.Infof("Webhook %s was deleted", id)
+	return ctx.NoContent(http.StatusOK)
+}
 
-		var tree *merklearray.Tree
-		var stibhash crypto.Digest
+// GetSubsystems returns the subsystems of the node which can be stopped and started.
+// (GET /v2/subsystems)
+func (v2 *Handlers) GetSubsystems(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, v2.subsystemsResponse())
+}
 
-		switch hashtype {
-		case "sha256":
-			tree, err = block.TxnMerkleTreeSHA256()
-			if err != nil {
-				return internalError(ctx, err, "building Vector Commitment (SHA256)", v2.Log)
-			}
-			stibhash = block.Payset[idx].HashSHA256()
-		case "sha512_256":
-			tree, err = block.TxnMerkleTree()
-			if err != nil {
-				return internalError(ctx, err, "building Merkle tree", v2.Log)
-			}
-			stibhash = block.Payset[idx].Hash()
-		default:
-			return badRequest(ctx, err, "unsupported hash type", v2.Log)
-		}
+// StopSubsystem stops a subsystem of the node.
+// (POST /v2/subsystems/{name}/stop)
+func (v2 *Handlers) StopSubsystem(ctx echo.Context, name string) error {
+	return v2.setSubsystemRunning(ctx, name, false)
+}
 
-		proof, proofErr := tree.ProveSingleLeaf(uint64(idx))
-		if proofErr != nil {
-			return internalError(ctx, proofErr, "generating proof", v2.Log)
-		}
+// StartSubsystem starts a stopped subsystem of the node.
+// (POST /v2/subsystems/{name}/start)
+func (v2 *Handlers) StartSubsystem(ctx echo.Context, name string) error {
+	return v2.setSubsystemRunning(ctx, name, true)
+}
 
-		response := model.TransactionProofResponse{
-			Proof:     proof.GetConcatenatedProof(),
-			Stibhash:  stibhash[:],
-			Idx:       uint64(idx),
-			Treedepth: uint64(proof.TreeDepth),
-			Hashtype:  model.TransactionProofResponseHashtype(hashtype),
-		}
+func (v2 *Handlers) setSubsystemRunning(ctx echo.Context, name string, running bool) error {
+	err := v2.Node.SetSubsystemRunning(name, running)
+	if errors.Is(err, node.ErrUnknownSubsystem) {
+		return notFound(ctx, err, fmt.Sprintf(errUnknownSubsystem, name), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, v2.subsystemsResponse())
+}
 
-		return ctx.JSON(http.StatusOK, response)
+func (v2 *Handlers) subsystemsResponse() model.SubsystemsResponse {
+	subsystems := v2.Node.Subsystems()
+	response := model.SubsystemsResponse{Subsystems: make([]model.Subsystem, len(subsystems))}
+	for i, s := range subsystems {
+		response.Subsystems[i] = model.Subsystem{Name: s.Name, Description: s.Description, Running: s.Running}
 	}
+	return response
+}
 
-	err = errors.New(errTransactionNotFound)
-	return notFound(ctx, err, err.Error(), v2.Log)
+// GetFeatures returns the status of the experimental features of the node.
+// (GET /v2/features)
+func (v2 *Handlers) GetFeatures(ctx echo.Context) error {
+	features := v2.Node.Features()
+	response := model.FeaturesResponse{Features: make([]model.Feature, len(features))}
+	for i, f := range features {
+		response.Features[i] = model.Feature{
+			Name:        string(f.Name),
+			Description: f.Description,
+			Requested:   f.Requested,
+			Available:   f.Available,
+			Enabled:     f.Enabled,
+			Reason:      omitEmpty(f.Reason),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetSupply gets the current supply reported by the ledger.
-// (GET /v2/ledger/supply)
-func (v2 *Handlers) GetSupply(ctx echo.Context) error {
-	latest, totals, err := v2.Node.LedgerForAPI().LatestTotals()
+// GenerateCatchpoint makes the node write the file of its next catchpoint, and upload it when configured to.
+// (POST /v2/catchpoints/generate)
+func (v2 *Handlers) GenerateCatchpoint(ctx echo.Context) error {
+	round, err := v2.Node.GenerateCatchpoint()
+	if errors.Is(err, ledger.ErrCatchpointsDisabled) {
+		return badRequest(ctx, err, errCatchpointsNotEnabled, v2.Log)
+	}
 	if err != nil {
-		err = fmt.Errorf("GetSupply(): round %d, failed: %v", latest, err)
-		return internalError(ctx, err, errInternalFailure, v2.Log)
+		return internalError(ctx, err, errFailedRequestingCatchpoint, v2.Log)
 	}
-
-	supply := model.SupplyResponse{
-		CurrentRound: uint64(latest),
-		TotalMoney:   totals.Participating().Raw,
-		OnlineMoney:  totals.Online.Money.Raw,
+	response := model.CatchpointGenerateResponse{
+		Round:  uint64(round),
+		Upload: v2.Node.Config().CatchpointUploadBucket != "",
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	return ctx.JSON(http.StatusOK, supply)
+// convertSnapshotStatus converts the state of the latest snapshot of the node into its API response.
+func convertSnapshotStatus(status node.SnapshotStatus) model.SnapshotStatusResponse {
+	response := model.SnapshotStatusResponse{
+		Running: status.Running,
+		Started: uint64(status.Started.Unix()),
+	}
+	if status.Running {
+		return response
+	}
+	if status.Err != nil {
+		message := status.Err.Error()
+		response.Error = &message
+		return response
+	}
+	archive := status.Archive
+	round := uint64(archive.Manifest.Round)
+	size := uint64(archive.Size)
+	response.Path = &archive.Path
+	response.Round = &round
+	response.Size = &size
+	response.Sha256 = &archive.SHA256
+	response.ParticipationKeys = &archive.Manifest.ParticipationKeys
+	return response
 }
 
-// GetStatus gets the current node status.
-// (GET /v2/status)
-func (v2 *Handlers) GetStatus(ctx echo.Context) error {
-	stat, err := v2.Node.Status()
+// CreateSnapshot makes the node write a snapshot of itself into the snapshots directory of its data directory,
+// in the background.
+// (POST /v2/snapshots)
+func (v2 *Handlers) CreateSnapshot(ctx echo.Context, params model.CreateSnapshotParams) error {
+	excludeKeys := params.ExcludeKeys != nil && *params.ExcludeKeys
+	status, err := v2.Node.StartSnapshot(excludeKeys)
+	if errors.Is(err, node.ErrSnapshotInProgress) {
+		return writeErrorResponse(ctx, http.StatusConflict, errSnapshotInProgress, nil)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+		return internalError(ctx, err, errFailedCreatingSnapshot, v2.Log)
 	}
+	return ctx.JSON(http.StatusOK, convertSnapshotStatus(status))
+}
 
-	response := model.NodeStatusResponse{
-		LastRound:                   uint64(stat.LastRound),
-		LastVersion:                 string(stat.LastVersion),
-		NextVersion:                 string(stat.NextVersion),
-		NextVersionRound:            uint64(stat.NextVersionRound),
-		NextVersionSupported:        stat.NextVersionSupported,
-		TimeSinceLastRound:          uint64(stat.TimeSinceLastRound().Nanoseconds()),
-		CatchupTime:                 uint64(stat.CatchupTime.Nanoseconds()),
-		StoppedAtUnsupportedRound:   stat.StoppedAtUnsupportedRound,
-		LastCatchpoint:              &stat.LastCatchpoint,
-		Catchpoint:                  &stat.Catchpoint,
-		CatchpointTotalAccounts:     &stat.CatchpointCatchupTotalAccounts,
-		CatchpointProcessedAccounts: &stat.CatchpointCatchupProcessedAccounts,
-		CatchpointVerifiedAccounts:  &stat.CatchpointCatchupVerifiedAccounts,
-		CatchpointTotalKvs:          &stat.CatchpointCatchupTotalKVs,
-		CatchpointProcessedKvs:      &stat.CatchpointCatchupProcessedKVs,
-		CatchpointVerifiedKvs:       &stat.CatchpointCatchupVerifiedKVs,
-		CatchpointTotalBlocks:       &stat.CatchpointCatchupTotalBlocks,
-		CatchpointAcquiredBlocks:    &stat.CatchpointCatchupAcquiredBlocks,
+// GetSnapshotStatus returns the state of the latest snapshot the node was asked to write.
+// (GET /v2/snapshots)
+func (v2 *Handlers) GetSnapshotStatus(ctx echo.Context) error {
+	status, requested := v2.Node.SnapshotStatus()
+	if !requested {
+		return notFound(ctx, nil, errNoSnapshot, v2.Log)
 	}
+	return ctx.JSON(http.StatusOK, convertSnapshotStatus(status))
+}
 
-	// Make sure a vote is happening
-	if stat.NextProtocolVoteBefore > 0 {
-		votesToGo := uint64(0)
-		// Check if the vote window is still open.
-		if stat.NextProtocolVoteBefore > stat.LastRound {
-			// subtract 1 because the variables are referring to "Last" round and "VoteBefore"
-			votesToGo = uint64(stat.NextProtocolVoteBefore - stat.LastRound - 1)
+// subsystemRunning tells whether the named subsystem of the node runs. Subsystems the node doesn't
+// have are considered running.
+func (v2 *Handlers) subsystemRunning(name string) bool {
+	for _, s := range v2.Node.Subsystems() {
+		if s.Name == name {
+			return s.Running
 		}
+	}
+	return true
+}
 
-		consensus := config.Consensus[protocol.ConsensusCurrentVersion]
-		upgradeVoteRounds := consensus.UpgradeVoteRounds
-		upgradeThreshold := consensus.UpgradeThreshold
-		votes := consensus.UpgradeVoteRounds - votesToGo
-		votesYes := stat.NextProtocolApprovals
-		votesNo := votes - votesYes
-		upgradeDelay := stat.UpgradeDelay
-		response.UpgradeVotesRequired = &upgradeThreshold
-		response.UpgradeNodeVote = &stat.UpgradeApprove
-		response.UpgradeDelay = &upgradeDelay
-		response.UpgradeVotes = &votes
-		response.UpgradeYesVotes = &votesYes
-		response.UpgradeNoVotes = &votesNo
-		response.UpgradeNextProtocolVoteBefore = omitEmpty(uint64(stat.NextProtocolVoteBefore))
-		response.UpgradeVoteRounds = &upgradeVoteRounds
+// AccountInformation gets account information for a given account.
+// (GET /v2/accounts/{address})
+func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params model.AccountInformationParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
 
-	return ctx.JSON(http.StatusOK, response)
-}
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
 
-// WaitForBlock returns the node status after waiting for the given round.
-// (GET /v2/status/wait-for-block-after/{round}/)
-func (v2 *Handlers) WaitForBlock(ctx echo.Context, round uint64) error {
-	ledger := v2.Node.LedgerForAPI()
+	myLedger := v2.Node.LedgerForAPI()
 
-	stat, err := v2.Node.Status()
-	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	// the resources are only available at the latest round
+	if params.Round != nil {
+		rnd := basics.Round(*params.Round)
+		if rnd > myLedger.Latest() {
+			return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+		}
+		return v2.basicAccountInformation(ctx, addr, rnd, handle, contentType)
 	}
-	if stat.StoppedAtUnsupportedRound {
-		return badRequest(ctx, err, errRequestedRoundInUnsupportedRound, v2.Log)
+
+	// should we skip fetching apps and assets?
+	if params.Exclude != nil {
+		switch *params.Exclude {
+		case "all":
+			return v2.basicAccountInformation(ctx, addr, myLedger.Latest(), handle, contentType)
+		case "none", "":
+		default:
+			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
+		}
 	}
-	if stat.Catchpoint != "" {
-		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("WaitForBlock failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+
+	// count total # of resources, if max limit is set
+	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
+		record, _, _, lookupErr := myLedger.LookupAccount(myLedger.Latest(), addr)
+		if lookupErr != nil {
+			return internalError(ctx, lookupErr, errFailedLookingUpLedger, v2.Log)
+		}
+		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
+		if totalResults > maxResults {
+			v2.Log.Infof("MaxAccountAPIResults limit %d exceeded, total results %d", maxResults, totalResults)
+			extraData := map[string]interface{}{
+				"max-results":           maxResults,
+				"total-assets-opted-in": record.TotalAssets,
+				"total-created-assets":  record.TotalAssetParams,
+				"total-apps-opted-in":   record.TotalAppLocalStates,
+				"total-created-apps":    record.TotalAppParams,
+			}
+			return writeErrorResponse(ctx, http.StatusBadRequest, errResultLimitExceeded, &extraData)
+		}
 	}
 
-	latestBlkHdr, err := ledger.BlockHdr(ledger.Latest())
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
 	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingLatestBlockHeaderStatus, v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	if latestBlkHdr.NextProtocol != "" {
-		if _, nextProtocolSupported := config.Consensus[latestBlkHdr.NextProtocol]; !nextProtocolSupported {
-			// see if the desired protocol switch is expect to happen before or after the above point.
-			if latestBlkHdr.NextProtocolSwitchOn <= basics.Round(round+1) {
-				// we would never reach to this round, since this round would happen after the (unsupported) protocol upgrade.
-				return badRequest(ctx, err, errRequestedRoundInUnsupportedRound, v2.Log)
-			}
+
+	// check against configured total limit on assets/apps
+	if handle == protocol.CodecHandle {
+		data, err := encode(handle, record)
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 		}
+		return ctx.Blob(http.StatusOK, contentType, data)
 	}
 
-	// Wait
-	select {
-	case <-v2.Shutdown:
-		return internalError(ctx, err, errServiceShuttingDown, v2.Log)
-	case <-time.After(WaitForBlockTimeout):
-	case <-ledger.Wait(basics.Round(round + 1)):
+	consensus, err := myLedger.ConsensusParams(lastRound)
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound), v2.Log)
 	}
 
-	// Return status after the wait
-	return v2.GetStatus(ctx)
+	account, err := AccountDataToAccount(address, &record, lastRound, &consensus, amountWithoutPendingRewards)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+
+	response := model.AccountResponse(account)
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// decodeTxGroup attempts to decode a request body containing a transaction group.
-func decodeTxGroup(body io.Reader, maxTxGroupSize int) ([]transactions.SignedTxn, error) {
-	var txgroup []transactions.SignedTxn
-	dec := protocol.NewDecoder(body)
-	for {
-		var st transactions.SignedTxn
-		err := dec.Decode(&st)
-		if err == io.EOF {
-			break
+// basicAccountInformation handles the case when no resources (assets or apps) are requested, or the
+// account is looked up at a given round.
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, rnd basics.Round, handle codec.Handle, contentType string) error {
+	myLedger := v2.Node.LedgerForAPI()
+	record, _, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
+	if err != nil {
+		var roundErr *ledger.RoundOffsetError
+		if errors.As(err, &roundErr) {
+			return notFound(ctx, err, fmt.Sprintf(errAccountRoundNotRetained, rnd), v2.Log)
 		}
-		if err != nil {
-			return nil, err
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	if handle == protocol.CodecHandle {
+		data, encErr := encode(handle, record)
+		if encErr != nil {
+			return internalError(ctx, encErr, errFailedToEncodeResponse, v2.Log)
 		}
-		txgroup = append(txgroup, st)
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
 
-		if len(txgroup) > maxTxGroupSize {
-			err := fmt.Errorf("max group size is %d", maxTxGroupSize)
-			return nil, err
+	consensus, err := myLedger.ConsensusParams(rnd)
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for round (%d)", rnd), v2.Log)
+	}
+
+	var apiParticipation *model.AccountParticipation
+	if record.VoteID != (crypto.OneTimeSignatureVerifier{}) {
+		apiParticipation = &model.AccountParticipation{
+			VoteParticipationKey:      record.VoteID[:],
+			SelectionParticipationKey: record.SelectionID[:],
+			VoteFirstValid:            uint64(record.VoteFirstValid),
+			VoteLastValid:             uint64(record.VoteLastValid),
+			VoteKeyDilution:           uint64(record.VoteKeyDilution),
+		}
+		if !record.StateProofID.IsEmpty() {
+			tmp := record.StateProofID[:]
+			apiParticipation.StateProofKey = &tmp
 		}
 	}
 
-	if len(txgroup) == 0 {
-		return nil, errors.New("empty txgroup")
+	pendingRewards, overflowed := basics.OSubA(record.MicroAlgos, amountWithoutPendingRewards)
+	if overflowed {
+		return internalError(ctx, errors.New("overflow on pending reward calculation"), errInternalFailure, v2.Log)
 	}
 
-	return txgroup, nil
+	account := model.Account{
+		SigType:                     nil,
+		Round:                       uint64(rnd),
+		Address:                     addr.String(),
+		Amount:                      record.MicroAlgos.Raw,
+		PendingRewards:              pendingRewards.Raw,
+		AmountWithoutPendingRewards: amountWithoutPendingRewards.Raw,
+		Rewards:                     record.RewardedMicroAlgos.Raw,
+		Status:                      record.Status.String(),
+		RewardBase:                  &record.RewardsBase,
+		Participation:               apiParticipation,
+		TotalCreatedAssets:          record.TotalAssetParams,
+		TotalCreatedApps:            record.TotalAppParams,
+		TotalAssetsOptedIn:          record.TotalAssets,
+		AuthAddr:                    addrOrNil(record.AuthAddr),
+		TotalAppsOptedIn:            record.TotalAppLocalStates,
+		AppsTotalSchema: &model.ApplicationStateSchema{
+			NumByteSlice: record.TotalAppSchema.NumByteSlice,
+			NumUint:      record.TotalAppSchema.NumUint,
+		},
+		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
+		TotalBoxes:          omitEmpty(record.TotalBoxes),
+		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
+		MinBalance:          record.MinBalance(&consensus).Raw,
+	}
+	response := model.AccountResponse(account)
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// RawTransaction broadcasts a raw transaction to the network.
-// (POST /v2/transactions)
-func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
-	stat, err := v2.Node.Status()
+// AccountAssetInformation gets account information about a given asset.
+// (GET /v2/accounts/{address}/assets/{asset-id})
+func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params model.AccountAssetInformationParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
 	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
-	}
-	if stat.Catchpoint != "" {
-		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("RawTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
-	proto := config.Consensus[stat.LastVersion]
 
-	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
-	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	ledger := v2.Node.LedgerForAPI()
+
+	lastRound := ledger.Latest()
+	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
 	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	// For backwards compatibility, return txid of first tx in group
-	txid := txgroup[0].ID()
-	return ctx.JSON(http.StatusOK, model.PostTransactionsResponse{TxId: txid.String()})
+	if record.AssetParams == nil && record.AssetHolding == nil {
+		return notFound(ctx, errors.New(errAccountAssetDoesNotExist), errAccountAssetDoesNotExist, v2.Log)
+	}
+
+	// return msgpack response
+	if handle == protocol.CodecHandle {
+		data, err := encode(handle, specv2.AssetResourceToAccountAssetModel(record))
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		}
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+
+	// prepare JSON response
+	response := model.AccountAssetResponse{Round: uint64(lastRound)}
+
+	if record.AssetParams != nil {
+		asset := AssetParamsToAsset(addr.String(), basics.AssetIndex(assetID), record.AssetParams)
+		response.CreatedAsset = &asset.Params
+	}
+
+	if record.AssetHolding != nil {
+		response.AssetHolding = &model.AssetHolding{
+			Amount:   record.AssetHolding.Amount,
+			AssetID:  uint64(assetID),
+			IsFrozen: record.AssetHolding.Frozen,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// RawTransactionAsync broadcasts a raw transaction to the network without ensuring it is accepted by transaction pool.
-// (POST /v2/transactions/async)
-func (v2 *Handlers) RawTransactionAsync(ctx echo.Context) error {
-	if !v2.Node.Config().EnableExperimentalAPI {
-		return ctx.String(http.StatusNotFound, "/transactions/async was not enabled in the configuration file by setting the EnableExperimentalAPI to true")
+// AccountApplicationInformation gets account information about a given app.
+// (GET /v2/accounts/{address}/applications/{application-id})
+func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address string, applicationID uint64, params model.AccountApplicationInformationParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
-	txgroup, err := decodeTxGroup(ctx.Request().Body, config.MaxTxGroupSize)
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
-	err = v2.Node.AsyncBroadcastSignedTxGroup(txgroup)
+
+	ledger := v2.Node.LedgerForAPI()
+
+	lastRound := ledger.Latest()
+	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
 	if err != nil {
-		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	return ctx.NoContent(http.StatusOK)
-}
 
-// PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
-type PreEncodedSimulateTxnResult struct {
-	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
-	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
-	LogicSigBudgetConsumed   *uint64                                 `codec:"logic-sig-budget-consumed,omitempty"`
-	TransactionTrace         *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
-	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
-}
+	if record.AppParams == nil && record.AppLocalState == nil {
+		return notFound(ctx, errors.New(errAccountAppDoesNotExist), errAccountAppDoesNotExist, v2.Log)
+	}
 
-// PreEncodedSimulateTxnGroupResult mirrors model.SimulateTransactionGroupResult
-type PreEncodedSimulateTxnGroupResult struct {
-	AppBudgetAdded           *uint64                                 `codec:"app-budget-added,omitempty"`
-	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
-	FailedAt                 *[]uint64                               `codec:"failed-at,omitempty"`
-	FailureMessage           *string                                 `codec:"failure-message,omitempty"`
-	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
-	Txns                     []PreEncodedSimulateTxnResult           `codec:"txn-results"`
-}
+	// return msgpack response
+	if handle == protocol.CodecHandle {
+		data, err := encode(handle, specv2.AppResourceToAccountApplicationModel(record))
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		}
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
 
-// PreEncodedSimulateResponse mirrors model.SimulateResponse
-type PreEncodedSimulateResponse struct {
-	Version         uint64                             `codec:"version"`
-	LastRound       uint64                             `codec:"last-round"`
-	TxnGroups       []PreEncodedSimulateTxnGroupResult `codec:"txn-groups"`
-	EvalOverrides   *model.SimulationEvalOverrides     `codec:"eval-overrides,omitempty"`
-	ExecTraceConfig simulation.ExecTraceConfig         `codec:"exec-trace-config,omitempty"`
+	// prepare JSON response
+	response := model.AccountApplicationResponse{Round: uint64(lastRound)}
+
+	if record.AppParams != nil {
+		app := AppParamsToApplication(addr.String(), basics.AppIndex(applicationID), record.AppParams)
+		response.CreatedApp = &app.Params
+	}
+
+	if record.AppLocalState != nil {
+		localState := convertTKVToGenerated(&record.AppLocalState.KeyValue)
+		response.AppLocalState = &model.ApplicationLocalState{
+			Id:       uint64(applicationID),
+			KeyValue: localState,
+			Schema: model.ApplicationStateSchema{
+				NumByteSlice: record.AppLocalState.Schema.NumByteSlice,
+				NumUint:      record.AppLocalState.Schema.NumUint,
+			},
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// PreEncodedSimulateRequestTransactionGroup mirrors model.SimulateRequestTransactionGroup
-type PreEncodedSimulateRequestTransactionGroup struct {
-	Txns []transactions.SignedTxn `codec:"txns"`
+// GetPendingBlock returns a summary of the block being assembled by the transaction pool.
+// (GET /v2/blocks/pending)
+func (v2 *Handlers) GetPendingBlock(ctx echo.Context, params model.GetPendingBlockParams) error {
+	preview, ok := v2.Node.PendingBlockPreview()
+	if !ok {
+		return serviceUnavailable(ctx, errors.New(errNoPendingBlock), errNoPendingBlock, v2.Log)
+	}
+
+	txids := preview.TxIDs
+	if max := nilToZero(params.Max); max != 0 && uint64(len(txids)) > max {
+		txids = txids[:max]
+	}
+
+	response := model.PendingBlockResponse{
+		Round:            uint64(preview.Round),
+		AssembledAt:      uint64(preview.AssembledAt.Unix()),
+		StopReason:       preview.StopReason,
+		TransactionCount: uint64(len(preview.TxIDs)),
+		Txids:            stringSlice(txids),
+		DeferredGroups:   uint64(preview.DeferredGroups),
+		TotalFees:        preview.TotalFees.Raw,
+		PaysetBytes:      uint64(preview.PaysetBytes),
+		MaxPaysetBytes:   uint64(preview.MaxPaysetBytes),
+		RewardsLevel:     preview.RewardsLevel,
+		RewardsRate:      preview.RewardsRate,
+		RewardsResidue:   preview.RewardsResidue,
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// PreEncodedSimulateRequest mirrors model.SimulateRequest
-type PreEncodedSimulateRequest struct {
-	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
-	AllowEmptySignatures  bool                                        `codec:"allow-empty-signatures,omitempty"`
-	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
-	AllowUnnamedResources bool                                        `codec:"allow-unnamed-resources,omitempty"`
-	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
-	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+// GetTransactionPoolStats returns statistics on the transaction pool.
+// (GET /v2/transactions/pool/stats)
+func (v2 *Handlers) GetTransactionPoolStats(ctx echo.Context) error {
+	stats := v2.Node.TransactionPoolStats()
+	response := model.TransactionPoolStatsResponse{
+		PendingTransactions:      uint64(stats.PendingTxns),
+		PendingGroups:            uint64(stats.PendingGroups),
+		MaxTransactions:          uint64(stats.MaxTxns),
+		FeePerByte:               stats.FeePerByte,
+		MinFeePerByte:            stats.MinFeePerByte,
+		MedianFeePerByte:         stats.MedianFeePerByte,
+		MaxFeePerByte:            stats.MaxFeePerByte,
+		FeePriority:              stats.FeePriority,
+		EvictionPolicy:           stats.EvictionPolicy,
+		MaxTransactionsPerSender: uint64(stats.MaxTxnsPerSender),
+		EvictedGroups:            stats.EvictedGroups,
+		SenderLimitRejections:    stats.SenderLimitRejections,
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
-// (POST /v2/transactions/simulate)
-func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
+// GetTransactionFeeEstimate returns the suggested fees per byte for a new transaction.
+// (GET /v2/transactions/fee-estimate)
+func (v2 *Handlers) GetTransactionFeeEstimate(ctx echo.Context) error {
 	stat, err := v2.Node.Status()
 	if err != nil {
 		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
 	}
 	if stat.Catchpoint != "" {
-		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("SimulateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+		return serviceUnavailable(ctx, fmt.Errorf("GetTransactionFeeEstimate failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
 	}
-	proto := config.Consensus[stat.LastVersion]
 
-	requestBuffer := new(bytes.Buffer)
-	requestBodyReader := http.MaxBytesReader(nil, ctx.Request().Body, MaxTealDryrunBytes)
-	_, err = requestBuffer.ReadFrom(requestBodyReader)
+	estimate, err := v2.Node.FeeEstimate()
 	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		return serviceUnavailable(ctx, err, errFeeEstimateUnavailable, v2.Log)
+	}
+	response := model.TransactionFeeEstimateResponse{
+		MinFee:          config.Consensus[stat.LastVersion].MinTxnFee,
+		FeePerByteP50:   estimate.FeePerByteP50,
+		FeePerByteP90:   estimate.FeePerByteP90,
+		FeePerByteP99:   estimate.FeePerByteP99,
+		PoolFeePerByte:  estimate.PoolFeePerByte,
+		Blocks:          uint64(estimate.Blocks),
+		CongestedBlocks: uint64(estimate.CongestedBlocks),
+		BlockFullness:   estimate.Fullness,
 	}
-	requestData := requestBuffer.Bytes()
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	var simulateRequest PreEncodedSimulateRequest
-	err = decode(protocol.CodecHandle, requestData, &simulateRequest)
+// GetBlock gets the block for the given round.
+// (GET /v2/blocks/{round})
+func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlockParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
 	if err != nil {
-		err = decode(protocol.JSONStrictHandle, requestData, &simulateRequest)
-		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
-		}
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
 
-	for _, txgroup := range simulateRequest.TxnGroups {
-		if len(txgroup.Txns) == 0 {
-			err = errors.New("empty txgroup")
-			return badRequest(ctx, err, err.Error(), v2.Log)
-		}
-		if len(txgroup.Txns) > proto.MaxTxGroupSize {
-			err = fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), proto.MaxTxGroupSize)
-			return badRequest(ctx, err, err.Error(), v2.Log)
+	// msgpack format uses 'RawBlockBytes' and attaches a custom header.
+	if handle == protocol.CodecHandle {
+		blockbytes, blockErr := rpcs.RawBlockBytes(v2.Node.LedgerForAPI(), basics.Round(round))
+		if blockErr != nil {
+			switch blockErr.(type) {
+			case ledgercore.ErrNoEntry:
+				return missingLedgerEntry(ctx, blockErr, v2.Log)
+			default:
+				return internalError(ctx, blockErr, blockErr.Error(), v2.Log)
+			}
 		}
+
+		ctx.Response().Writer.Header().Add("X-Algorand-Struct", "block-v1")
+		return ctx.Blob(http.StatusOK, contentType, blockbytes)
 	}
 
-	// Simulate transaction
-	simulationResult, err := v2.Node.Simulate(convertSimulationRequest(simulateRequest))
+	ledger := v2.Node.LedgerForAPI()
+	block, err := ledger.Block(basics.Round(round))
 	if err != nil {
-		var invalidTxErr simulation.InvalidRequestError
-		switch {
-		case errors.As(err, &invalidTxErr):
-			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return missingLedgerEntry(ctx, err, v2.Log)
 		default:
-			return internalError(ctx, err, err.Error(), v2.Log)
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 		}
 	}
 
-	response := convertSimulationResult(simulationResult)
-
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
-	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	// Encoding wasn't working well without embedding "real" objects.
+	response := struct {
+		Block bookkeeping.Block `codec:"block"`
+	}{
+		Block: block,
 	}
-	responseData, err := encode(handle, &response)
+
+	data, err := encode(handle, response)
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
 
-	return ctx.Blob(http.StatusOK, contentType, responseData)
+	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
-// TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
-// (POST /v2/teal/dryrun)
-func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
-	if !v2.Node.Config().EnableDeveloperAPI {
-		return ctx.String(http.StatusNotFound, "/teal/dryrun was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+// GetBlockRange streams a batch of consecutive blocks, each delta compressed against the previous one.
+// (GET /v2/blocks/range)
+func (v2 *Handlers) GetBlockRange(ctx echo.Context, params model.GetBlockRangeParams) error {
+	if params.Format != nil && *params.Format != model.GetBlockRangeParamsFormatMsgpack {
+		return badRequest(ctx, nil, errFailedParsingFormatOption, v2.Log)
 	}
-	req := ctx.Request()
-	buf := new(bytes.Buffer)
-	req.Body = http.MaxBytesReader(nil, req.Body, MaxTealDryrunBytes)
-	_, err := buf.ReadFrom(ctx.Request().Body)
+	first, last := basics.Round(params.First), basics.Round(params.Last)
+	if last < first {
+		return badRequest(ctx, nil, errInvalidBlockRange, v2.Log)
+	}
+	if last-first >= rpcs.MaxBlockRangeLength {
+		return badRequest(ctx, nil, fmt.Sprintf(errBlockRangeTooLarge, rpcs.MaxBlockRangeLength), v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	if latest := ledger.Latest(); last > latest {
+		last = latest
+	}
+	// the first block is read before the response starts, so that a missing range is reported as such.
+	blockbytes, err := rpcs.RawBlockBytes(ledger, first)
 	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return missingLedgerEntry(ctx, err, v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
 	}
-	data := buf.Bytes()
 
-	var dr DryrunRequest
-	var gdr model.DryrunRequest
-	err = decode(protocol.JSONStrictHandle, data, &gdr)
-	if err == nil {
-		dr, err = DryrunRequestFromGenerated(&gdr)
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "application/msgpack")
+	response.Header().Add("X-Algorand-Struct", "block-range-v1")
+	response.WriteHeader(http.StatusOK)
+
+	enc := rpcs.MakeBlockRangeEncoder(response)
+	for rnd := first; ; rnd++ {
+		err = enc.Write(rnd, blockbytes)
 		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
+			v2.Log.Debugf("GetBlockRange: failed writing round %d: %v", rnd, err)
+			return nil
 		}
-	} else {
-		err = decode(protocol.CodecHandle, data, &dr)
-		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
+		response.Flush()
+		if rnd == last {
+			return nil
 		}
-	}
-
-	// fetch previous block header just once to prevent racing with network
-	var hdr bookkeeping.BlockHeader
-	if dr.ProtocolVersion == "" || dr.Round == 0 || dr.LatestTimestamp == 0 {
-		actualLedger := v2.Node.LedgerForAPI()
-		hdr, err = actualLedger.BlockHdr(actualLedger.Latest())
+		blockbytes, err = rpcs.RawBlockBytes(ledger, rnd+1)
 		if err != nil {
-			return internalError(ctx, err, "current block error", v2.Log)
+			// the stream ends early, which clients notice from the rounds of its entries.
+			v2.Log.Infof("GetBlockRange: failed reading round %d: %v", rnd+1, err)
+			return nil
 		}
 	}
+}
 
-	var response model.DryrunResponse
-
-	var protocolVersion protocol.ConsensusVersion
-	if dr.ProtocolVersion != "" {
-		var ok bool
-		_, ok = config.Consensus[protocol.ConsensusVersion(dr.ProtocolVersion)]
-		if !ok {
-			return badRequest(ctx, nil, "unsupported protocol version", v2.Log)
+// GetBlockTxids gets all top level TxIDs in a block for the given round.
+// (GET /v2/blocks/{round}/txids)
+func (v2 *Handlers) GetBlockTxids(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.LedgerForAPI()
+	block, err := ledger.Block(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return missingLedgerEntry(ctx, err, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 		}
-		protocolVersion = protocol.ConsensusVersion(dr.ProtocolVersion)
-	} else {
-		protocolVersion = hdr.CurrentProtocol
 	}
-	dr.ProtocolVersion = string(protocolVersion)
 
-	if dr.Round == 0 {
-		dr.Round = uint64(hdr.Round + 1)
+	txns, err := block.DecodePaysetFlat()
+	if err != nil {
+		return internalError(ctx, err, "decoding transactions", v2.Log)
 	}
 
-	if dr.LatestTimestamp == 0 {
-		dr.LatestTimestamp = hdr.TimeStamp
+	txids := make([]string, 0, len(txns))
+	for ids := range txns {
+		txids = append(txids, txns[ids].ID().String())
 	}
 
-	doDryrunRequest(&dr, &response)
-	response.ProtocolVersion = string(protocolVersion)
-	return ctx.JSON(http.StatusOK, response)
-}
+	response := model.BlockTxidsResponse{BlockTxids: txids}
 
-// UnsetSyncRound removes the sync round restriction from the ledger.
-// (DELETE /v2/ledger/sync)
-func (v2 *Handlers) UnsetSyncRound(ctx echo.Context) error {
-	v2.Node.UnsetSyncRound()
-	return ctx.NoContent(http.StatusOK)
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// SetSyncRound sets the sync round on the ledger.
-// (POST /v2/ledger/sync/{round})
-func (v2 *Handlers) SetSyncRound(ctx echo.Context, round uint64) error {
-	err := v2.Node.SetSyncRound(round)
+// GetBlockHash gets the block hash for the given round.
+// (GET /v2/blocks/{round}/hash)
+func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.LedgerForAPI()
+	block, err := ledger.Block(basics.Round(round))
 	if err != nil {
-		switch err {
-		case catchup.ErrSyncRoundInvalid:
-			return badRequest(ctx, err, errFailedSettingSyncRound, v2.Log)
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return missingLedgerEntry(ctx, err, v2.Log)
 		default:
-			return internalError(ctx, err, errFailedSettingSyncRound, v2.Log)
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 		}
 	}
-	return ctx.NoContent(http.StatusOK)
+
+	response := model.BlockHashResponse{BlockHash: crypto.Digest(block.Hash()).String()}
+
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetSyncRound gets the sync round from the ledger.
-// (GET /v2/ledger/sync)
-func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
-	rnd := v2.Node.GetSyncRound()
-	if rnd == 0 {
-		return notFound(ctx, fmt.Errorf("sync round is not set"), errFailedRetrievingSyncRound, v2.Log)
+// GetTransactionProof generates a Merkle proof for a transaction in a block.
+// (GET /v2/blocks/{round}/transactions/{txid}/proof)
+func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round uint64, txid string, params model.GetTransactionProofParams) error {
+	var txID transactions.Txid
+	err := txID.UnmarshalText([]byte(txid))
+	if err != nil {
+		return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
 	}
-	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: rnd})
-}
 
-// GetLedgerStateDelta returns the deltas for a given round.
+	if params.Hashtype != nil && *params.Hashtype != "sha512_256" && *params.Hashtype != "sha256" {
+		return badRequest(ctx, nil, errInvalidHashType, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	block, err := ledger.Block(basics.Round(round))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	proto := config.Consensus[block.CurrentProtocol]
+	if proto.PaysetCommit != config.PaysetCommitMerkle {
+		return notFound(ctx, err, "protocol does not support Merkle proofs", v2.Log)
+	}
+
+	hashtype := "sha512_256" // default hash type for proof
+	if params.Hashtype != nil {
+		hashtype = string(*params.Hashtype)
+	}
+	if hashtype == "sha256" && !proto.EnableSHA256TxnCommitmentHeader {
+		return badRequest(ctx, err, "protocol does not support sha256 vector commitment proofs", v2.Log)
+	}
+
+	txns, err := block.DecodePaysetFlat()
+	if err != nil {
+		return internalError(ctx, err, "decoding transactions", v2.Log)
+	}
+
+	for idx := range txns {
+		if txns[idx].ID() != txID {
+			continue // skip
+		}
+
+		var tree *merklearray.Tree
+		var stibhash crypto.Digest
+
+		switch hashtype {
+		case "sha256":
+			tree, err = block.TxnMerkleTreeSHA256()
+			if err != nil {
+				return internalError(ctx, err, "building Vector Commitment (SHA256)", v2.Log)
+			}
+			stibhash = block.Payset[idx].HashSHA256()
+		case "sha512_256":
+			tree, err = block.TxnMerkleTree()
+			if err != nil {
+				return internalError(ctx, err, "building Merkle tree", v2.Log)
+			}
+			stibhash = block.Payset[idx].Hash()
+		default:
+			return badRequest(ctx, err, "unsupported hash type", v2.Log)
+		}
+
+		proof, proofErr := tree.ProveSingleLeaf(uint64(idx))
+		if proofErr != nil {
+			return internalError(ctx, proofErr, "generating proof", v2.Log)
+		}
+
+		response := model.TransactionProofResponse{
+			Proof:     proof.GetConcatenatedProof(),
+			Stibhash:  stibhash[:],
+			Idx:       uint64(idx),
+			Treedepth: uint64(proof.TreeDepth),
+			Hashtype:  model.TransactionProofResponseHashtype(hashtype),
+		}
+
+		return ctx.JSON(http.StatusOK, response)
+	}
+
+	err = errors.New(errTransactionNotFound)
+	return notFound(ctx, err, err.Error(), v2.Log)
+}
+
+// GetCatchpointLabel returns the catchpoint label generated by this node for the given round.
+// (GET /v2/ledger/catchpoint/{round})
+func (v2 *Handlers) GetCatchpointLabel(ctx echo.Context, round uint64) error {
+	label, err := v2.Node.LedgerForAPI().GetCatchpointLabel(basics.Round(round))
+	if err != nil {
+		var noEntry ledgercore.ErrNoEntry
+		if errors.As(err, &noEntry) {
+			return notFound(ctx, err, errCatchpointNotRetained, v2.Log)
+		}
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.CatchpointLabelResponse{
+		Round:      round,
+		Catchpoint: label,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetSupply gets the current supply reported by the ledger.
+// (GET /v2/ledger/supply)
+func (v2 *Handlers) GetSupply(ctx echo.Context) error {
+	latest, totals, err := v2.Node.LedgerForAPI().LatestTotals()
+	if err != nil {
+		err = fmt.Errorf("GetSupply(): round %d, failed: %v", latest, err)
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+
+	supply := model.SupplyResponse{
+		CurrentRound: uint64(latest),
+		TotalMoney:   totals.Participating().Raw,
+		OnlineMoney:  totals.Online.Money.Raw,
+	}
+
+	return ctx.JSON(http.StatusOK, supply)
+}
+
+// GetStatus gets the current node status.
+// (GET /v2/status)
+func (v2 *Handlers) GetStatus(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+
+	response := model.NodeStatusResponse{
+		LastRound:                   uint64(stat.LastRound),
+		LastVersion:                 string(stat.LastVersion),
+		NextVersion:                 string(stat.NextVersion),
+		NextVersionRound:            uint64(stat.NextVersionRound),
+		NextVersionSupported:        stat.NextVersionSupported,
+		TimeSinceLastRound:          uint64(stat.TimeSinceLastRound().Nanoseconds()),
+		CatchupTime:                 uint64(stat.CatchupTime.Nanoseconds()),
+		StoppedAtUnsupportedRound:   stat.StoppedAtUnsupportedRound,
+		LastCatchpoint:              &stat.LastCatchpoint,
+		Catchpoint:                  &stat.Catchpoint,
+		CatchpointTotalAccounts:     &stat.CatchpointCatchupTotalAccounts,
+		CatchpointProcessedAccounts: &stat.CatchpointCatchupProcessedAccounts,
+		CatchpointVerifiedAccounts:  &stat.CatchpointCatchupVerifiedAccounts,
+		CatchpointTotalKvs:          &stat.CatchpointCatchupTotalKVs,
+		CatchpointProcessedKvs:      &stat.CatchpointCatchupProcessedKVs,
+		CatchpointVerifiedKvs:       &stat.CatchpointCatchupVerifiedKVs,
+		CatchpointTotalBlocks:       &stat.CatchpointCatchupTotalBlocks,
+		CatchpointAcquiredBlocks:    &stat.CatchpointCatchupAcquiredBlocks,
+	}
+
+	// Make sure a vote is happening
+	if stat.NextProtocolVoteBefore > 0 {
+		votesToGo := uint64(0)
+		// Check if the vote window is still open.
+		if stat.NextProtocolVoteBefore > stat.LastRound {
+			// subtract 1 because the variables are referring to "Last" round and "VoteBefore"
+			votesToGo = uint64(stat.NextProtocolVoteBefore - stat.LastRound - 1)
+		}
+
+		consensus := config.Consensus[protocol.ConsensusCurrentVersion]
+		upgradeVoteRounds := consensus.UpgradeVoteRounds
+		upgradeThreshold := consensus.UpgradeThreshold
+		votes := consensus.UpgradeVoteRounds - votesToGo
+		votesYes := stat.NextProtocolApprovals
+		votesNo := votes - votesYes
+		upgradeDelay := stat.UpgradeDelay
+		response.UpgradeVotesRequired = &upgradeThreshold
+		response.UpgradeNodeVote = &stat.UpgradeApprove
+		response.UpgradeDelay = &upgradeDelay
+		response.UpgradeVotes = &votes
+		response.UpgradeYesVotes = &votesYes
+		response.UpgradeNoVotes = &votesNo
+		response.UpgradeNextProtocolVoteBefore = omitEmpty(uint64(stat.NextProtocolVoteBefore))
+		response.UpgradeVoteRounds = &upgradeVoteRounds
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// WaitForBlock returns the node status after waiting for the given round.
+// (GET /v2/status/wait-for-block-after/{round}/)
+func (v2 *Handlers) WaitForBlock(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.LedgerForAPI()
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.StoppedAtUnsupportedRound {
+		return badRequest(ctx, err, errRequestedRoundInUnsupportedRound, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("WaitForBlock failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	latestBlkHdr, err := ledger.BlockHdr(ledger.Latest())
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingLatestBlockHeaderStatus, v2.Log)
+	}
+	if latestBlkHdr.NextProtocol != "" {
+		if _, nextProtocolSupported := config.Consensus[latestBlkHdr.NextProtocol]; !nextProtocolSupported {
+			// see if the desired protocol switch is expect to happen before or after the above point.
+			if latestBlkHdr.NextProtocolSwitchOn <= basics.Round(round+1) {
+				// we would never reach to this round, since this round would happen after the (unsupported) protocol upgrade.
+				return badRequest(ctx, err, errRequestedRoundInUnsupportedRound, v2.Log)
+			}
+		}
+	}
+
+	// Wait
+	select {
+	case <-v2.Shutdown:
+		return internalError(ctx, err, errServiceShuttingDown, v2.Log)
+	case <-time.After(WaitForBlockTimeout):
+	case <-ledger.Wait(basics.Round(round + 1)):
+	}
+
+	// Return status after the wait
+	return v2.GetStatus(ctx)
+}
+
+// decodeTxGroup attempts to decode a request body containing a transaction group.
+func decodeTxGroup(body io.Reader, maxTxGroupSize int) ([]transactions.SignedTxn, error) {
+	var txgroup []transactions.SignedTxn
+	dec := protocol.NewDecoder(body)
+	for {
+		var st transactions.SignedTxn
+		err := dec.Decode(&st)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		txgroup = append(txgroup, st)
+
+		if len(txgroup) > maxTxGroupSize {
+			err := fmt.Errorf("max group size is %d", maxTxGroupSize)
+			return nil, err
+		}
+	}
+
+	if len(txgroup) == 0 {
+		return nil, errors.New("empty txgroup")
+	}
+
+	return txgroup, nil
+}
+
+var restTxnGenesisMismatch = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_txn_genesis_mismatch_total", Description: "number of transaction submissions refused for targeting another network"})
+
+// checkTxGroupGenesis makes sure every transaction of txgroup was made for the network of this node,
+// so that tools pointed at the wrong node fail loudly instead of relying on the transaction pool.
+func (v2 *Handlers) checkTxGroupGenesis(txgroup []transactions.SignedTxn) error {
+	genesisID, genesisHash := v2.Node.GenesisID(), v2.Node.GenesisHash()
+	for _, stxn := range txgroup {
+		if (!stxn.Txn.GenesisHash.IsZero() && stxn.Txn.GenesisHash != genesisHash) ||
+			(stxn.Txn.GenesisID != "" && stxn.Txn.GenesisID != genesisID) {
+			restTxnGenesisMismatch.Inc(nil)
+			return fmt.Errorf("transaction %s was made for genesis %s (%s) but this node runs %s (%s)",
+				stxn.ID(), stxn.Txn.GenesisID, stxn.Txn.GenesisHash, genesisID, genesisHash)
+		}
+	}
+	return nil
+}
+
+// RawTransaction broadcasts a raw transaction to the network.
+// (POST /v2/transactions)
+func (v2 *Handlers) RawTransaction(ctx echo.Context, params model.RawTransactionParams) error {
+	if !v2.subsystemRunning(node.SubsystemTxnSubmit) {
+		return writeErrorResponse(ctx, http.StatusServiceUnavailable, errTxnSubmitStopped, nil)
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("RawTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	if v2.Submissions == nil || params.IdempotencyKey == nil || *params.IdempotencyKey == "" {
+		response, err := v2.submitTxGroup(ctx, stat, ctx.Request().Body, params)
+		if response == nil {
+			return err
+		}
+		return ctx.JSON(http.StatusOK, response)
+	}
+
+	key := *params.IdempotencyKey
+	if len(key) > maxIdempotencyKeyLen {
+		return badRequest(ctx, nil, fmt.Sprintf(errIdempotencyKeyTooLong, maxIdempotencyKeyLen), v2.Log)
+	}
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+	// keys are scoped to the client, so that it can neither reuse nor evict the keys of another
+	scope := clientID(ctx)
+	for {
+		result, owner, err := v2.Submissions.begin(scope, key, crypto.Hash(body))
+		if err != nil {
+			return returnError(ctx, http.StatusUnprocessableEntity, err, errIdempotencyKeyReused, v2.Log)
+		}
+		if owner {
+			response, err := v2.submitTxGroup(ctx, stat, bytes.NewReader(body), params)
+			v2.Submissions.finish(scope, key, result, response)
+			if response == nil {
+				return err
+			}
+			return ctx.JSON(http.StatusOK, response)
+		}
+		// a concurrent submission with the same key is in progress, its response is returned unless it
+		// failed, in which case it is forgotten and this submission tried again.
+		<-result.done
+		if result.response != nil {
+			return ctx.JSON(http.StatusOK, result.response)
+		}
+	}
+}
+
+// submitTxGroup decodes a transaction group from body and broadcasts it. It returns the response to
+// the submission if it succeeded, and otherwise writes the error response.
+func (v2 *Handlers) submitTxGroup(ctx echo.Context, stat node.StatusReport, body io.Reader, params model.RawTransactionParams) (*model.PostTransactionsResponse, error) {
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(body, proto.MaxTxGroupSize)
+	if err != nil {
+		return nil, rejectRequestBody(ctx, err, v2.Log)
+	}
+
+	err = v2.checkTxGroupGenesis(txgroup)
+	if err != nil {
+		return nil, badRequest(ctx, err, errTransactionGenesisMismatch, v2.Log)
+	}
+
+	pending, err := v2.Node.GetPendingTxnsFromPool()
+	if err != nil {
+		return nil, internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+	warnings := lintTxGroup(txgroup, stat.LastRound, proto, v2.Node.SuggestedFee(), pending)
+	if len(warnings) > 0 && params.Strict != nil && *params.Strict {
+		data := map[string]interface{}{"warnings": warnings}
+		return nil, writeErrorResponse(ctx, http.StatusBadRequest, errSubmissionWarnings, &data)
+	}
+
+	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	if err != nil {
+		return nil, badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// For backwards compatibility, return txid of first tx in group
+	txid := txgroup[0].ID()
+	response := model.PostTransactionsResponse{TxId: txid.String()}
+	if len(warnings) > 0 {
+		response.Warnings = &warnings
+	}
+	return &response, nil
+}
+
+// TransactionGroupResources reports the resources a transaction group makes available to its programs under
+// the group resource sharing rules.
+// (POST /v2/transactions/resources)
+func (v2 *Handlers) TransactionGroupResources(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		return serviceUnavailable(ctx, fmt.Errorf("TransactionGroupResources failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	latest := ledger.Latest()
+	appVersion := func(app basics.AppIndex) (uint64, error) {
+		creator, ok, err := ledger.GetCreator(basics.CreatableIndex(app), basics.AppCreatable)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, fmt.Errorf("%s: %d", errAppDoesNotExist, app)
+		}
+		record, err := ledger.LookupApplication(latest, creator, app)
+		if err != nil {
+			return 0, err
+		}
+		if record.AppParams == nil {
+			return 0, fmt.Errorf("%s: %d", errAppDoesNotExist, app)
+		}
+		version, _, err := transactions.ProgramVersion(record.AppParams.ApprovalProgram)
+		return version, err
+	}
+	report, err := logic.AnalyzeGroupResources(txgroup, appVersion)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedAnalyzingGroupResources, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, groupResourcesResponse(report))
+}
+
+func groupIndexes(indexes []int) []uint64 {
+	converted := make([]uint64, len(indexes))
+	for i, gi := range indexes {
+		converted[i] = uint64(gi)
+	}
+	return converted
+}
+
+func groupResourcesResponse(report logic.GroupResources) model.TransactionGroupResourcesResponse {
+	response := model.TransactionGroupResourcesResponse{
+		Accounts:       make([]model.GroupSharedAccount, len(report.Accounts)),
+		Assets:         make([]model.GroupSharedAsset, len(report.Assets)),
+		Apps:           make([]model.GroupSharedApp, len(report.Apps)),
+		Holdings:       make([]model.GroupSharedHolding, len(report.Holdings)),
+		Locals:         make([]model.GroupSharedLocal, len(report.Locals)),
+		Boxes:          make([]model.GroupSharedBox, len(report.Boxes)),
+		AppCreations:   groupIndexes(report.AppCreations),
+		AssetCreations: groupIndexes(report.AssetCreations),
+		Programs:       make([]model.GroupProgramResources, len(report.Programs)),
+	}
+	for i, a := range report.Accounts {
+		response.Accounts[i] = model.GroupSharedAccount{Address: a.Address.String(), Sources: groupIndexes(a.Sources)}
+	}
+	for i, a := range report.Assets {
+		response.Assets[i] = model.GroupSharedAsset{Asset: uint64(a.Asset), Sources: groupIndexes(a.Sources)}
+	}
+	for i, a := range report.Apps {
+		response.Apps[i] = model.GroupSharedApp{App: uint64(a.App), Sources: groupIndexes(a.Sources)}
+	}
+	for i, h := range report.Holdings {
+		response.Holdings[i] = model.GroupSharedHolding{Address: h.Address.String(), Asset: uint64(h.Asset), Sources: groupIndexes(h.Sources)}
+	}
+	for i, l := range report.Locals {
+		response.Locals[i] = model.GroupSharedLocal{Address: l.Address.String(), App: uint64(l.App), Sources: groupIndexes(l.Sources)}
+	}
+	for i, b := range report.Boxes {
+		response.Boxes[i] = model.GroupSharedBox{App: uint64(b.App), Name: []byte(b.Name), Sources: groupIndexes(b.Sources)}
+	}
+	for i, p := range report.Programs {
+		response.Programs[i] = model.GroupProgramResources{
+			GroupIndex: uint64(p.GroupIndex),
+			App:        uint64(p.App),
+			Version:    p.Version,
+			Shared:     p.Shared,
+		}
+	}
+	return response
+}
+
+// RawTransactionAsync broadcasts a raw transaction to the network without ensuring it is accepted by transaction pool.
+// (POST /v2/transactions/async)
+func (v2 *Handlers) RawTransactionAsync(ctx echo.Context) error {
+	if !v2.Node.Config().EnableExperimentalAPI {
+		return writeErrorResponse(ctx, http.StatusNotFound, errAsyncTransactionsNotEnabled, nil)
+	}
+	if !v2.subsystemRunning(node.SubsystemTxnSubmit) {
+		return writeErrorResponse(ctx, http.StatusServiceUnavailable, errTxnSubmitStopped, nil)
+	}
+	txgroup, err := decodeTxGroup(ctx.Request().Body, config.MaxTxGroupSize)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+	err = v2.checkTxGroupGenesis(txgroup)
+	if err != nil {
+		return badRequest(ctx, err, errTransactionGenesisMismatch, v2.Log)
+	}
+	err = v2.Node.AsyncBroadcastSignedTxGroup(txgroup)
+	if err != nil {
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// maxMergedCopies bounds the number of copies of a transaction accepted by MergeTransactions:
+// a multisig account cannot have more signers than this.
+const maxMergedCopies = 255
+
+// mergeMultisigTxns merges the partially-signed copies of the same multisig transactions into one
+// signed transaction per transaction ID, kept in the order each ID first appears. Every copy must
+// carry a multisig of the transaction authorizer, and every signature it holds must be valid.
+func mergeMultisigTxns(stxns []transactions.SignedTxn) ([]transactions.SignedTxn, error) {
+	var merged []transactions.SignedTxn
+	positions := make(map[transactions.Txid]int)
+	for _, stxn := range stxns {
+		txid := stxn.ID()
+		if stxn.Msig.Blank() {
+			return nil, fmt.Errorf("transaction %s is not signed with a multisig", txid)
+		}
+		msigAddr, err := crypto.MultisigAddrGenWithSubsigs(stxn.Msig.Version, stxn.Msig.Threshold, stxn.Msig.Subsigs)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s has an invalid multisig: %w", txid, err)
+		}
+		if basics.Address(msigAddr) != stxn.Authorizer() {
+			return nil, fmt.Errorf("transaction %s multisig does not match its authorizer %s", txid, stxn.Authorizer())
+		}
+		for i, subsig := range stxn.Msig.Subsigs {
+			if !subsig.Sig.Blank() && !crypto.SignatureVerifier(subsig.Key).Verify(stxn.Txn, subsig.Sig) {
+				return nil, fmt.Errorf("transaction %s has an invalid signature for subsig %d", txid, i)
+			}
+		}
+
+		pos, ok := positions[txid]
+		if !ok {
+			positions[txid] = len(merged)
+			merged = append(merged, stxn)
+			continue
+		}
+		if merged[pos].AuthAddr != stxn.AuthAddr {
+			return nil, fmt.Errorf("transaction %s copies have different authorizers", txid)
+		}
+		merged[pos].Msig, err = crypto.MultisigMerge(merged[pos].Msig, stxn.Msig)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s copies cannot be merged: %w", txid, err)
+		}
+	}
+	if len(merged) > config.MaxTxGroupSize {
+		return nil, fmt.Errorf("max group size is %d", config.MaxTxGroupSize)
+	}
+	return merged, nil
+}
+
+// MergeTransactions merges partially-signed copies of the same multisig transaction or transaction group.
+// (POST /v2/transactions/merge)
+func (v2 *Handlers) MergeTransactions(ctx echo.Context, params model.MergeTransactionsParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	stxns, err := decodeTxGroup(ctx.Request().Body, config.MaxTxGroupSize*maxMergedCopies)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+
+	merged, err := mergeMultisigTxns(stxns)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := struct {
+		Txns []transactions.SignedTxn `codec:"txns"`
+	}{
+		Txns: merged,
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// maxEncodingConvertBytes bounds the size of the objects accepted by ConvertEncoding.
+const maxEncodingConvertBytes = 10_000_000
+
+// makeConvertEncodingObject returns a pointer to a new object of the given type for ConvertEncoding to decode into.
+func makeConvertEncodingObject(objectType model.ConvertEncodingParamsType) (interface{}, error) {
+	switch objectType {
+	case model.ConvertEncodingParamsTypeBlock:
+		return &bookkeeping.Block{}, nil
+	case model.ConvertEncodingParamsTypeTxn:
+		return &transactions.SignedTxn{}, nil
+	case model.ConvertEncodingParamsTypeDelta:
+		return &ledgercore.StateDelta{}, nil
+	case model.ConvertEncodingParamsTypeGenesis:
+		return &bookkeeping.Genesis{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported object type: %s", objectType)
+	}
+}
+
+// ConvertEncoding converts a node object between its msgpack and JSON encodings.
+// (POST /v2/encoding/convert)
+func (v2 *Handlers) ConvertEncoding(ctx echo.Context, params model.ConvertEncodingParams) error {
+	obj, err := makeConvertEncodingObject(params.Type)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	inputFormat := string(model.ConvertEncodingParamsInputFormatMsgpack)
+	if params.InputFormat != nil {
+		inputFormat = string(*params.InputFormat)
+	}
+	inputHandle, _, err := getCodecHandle(&inputFormat)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	outputHandle, contentType, err := getCodecHandle((*string)(params.OutputFormat))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	input, err := io.ReadAll(http.MaxBytesReader(nil, ctx.Request().Body, maxEncodingConvertBytes))
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if len(input) == 0 {
+		return badRequest(ctx, nil, errRESTPayloadZeroLength, v2.Log)
+	}
+	err = decode(inputHandle, input, obj)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// The canonical encoding of an object is the one the node itself produces for it.
+	if params.Canonical != nil && *params.Canonical && inputHandle == protocol.CodecHandle {
+		if !bytes.Equal(input, protocol.EncodeReflect(obj)) {
+			return badRequest(ctx, nil, errNonCanonicalEncoding, v2.Log)
+		}
+	}
+
+	data, err := encode(outputHandle, obj)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
+type PreEncodedSimulateTxnResult struct {
+	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
+	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
+	LogicSigBudgetConsumed   *uint64                                 `codec:"logic-sig-budget-consumed,omitempty"`
+	AdditionalFeeRequired    *uint64                                 `codec:"additional-fee-required,omitempty"`
+	TransactionTrace         *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
+	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	FixedSigner              *string                                 `codec:"fixed-signer,omitempty"`
+	PopulatedResourceArrays  *model.SimulateResourceArrays           `codec:"populated-resource-arrays,omitempty"`
+}
+
+// PreEncodedSimulateTxnGroupResult mirrors model.SimulateTransactionGroupResult
+type PreEncodedSimulateTxnGroupResult struct {
+	AdditionalFeeRequired    *uint64                                 `codec:"additional-fee-required,omitempty"`
+	AppBudgetAdded           *uint64                                 `codec:"app-budget-added,omitempty"`
+	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
+	FailedAt                 *[]uint64                               `codec:"failed-at,omitempty"`
+	FailureMessage           *string                                 `codec:"failure-message,omitempty"`
+	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	ExtraResourceArrays      *[]model.SimulateResourceArrays         `codec:"extra-resource-arrays,omitempty"`
+	Txns                     []PreEncodedSimulateTxnResult           `codec:"txn-results"`
+}
+
+// PreEncodedSimulateResponse mirrors model.SimulateResponse
+type PreEncodedSimulateResponse struct {
+	Version         uint64                             `codec:"version"`
+	LastRound       uint64                             `codec:"last-round"`
+	TxnGroups       []PreEncodedSimulateTxnGroupResult `codec:"txn-groups"`
+	EvalOverrides   *model.SimulationEvalOverrides     `codec:"eval-overrides,omitempty"`
+	ExecTraceConfig simulation.ExecTraceConfig         `codec:"exec-trace-config,omitempty"`
+}
+
+// PreEncodedSimulateRequestTransactionGroup mirrors model.SimulateRequestTransactionGroup
+type PreEncodedSimulateRequestTransactionGroup struct {
+	Txns []transactions.SignedTxn `codec:"txns"`
+}
+
+// PreEncodedSimulateSourceMap mirrors model.SimulateSourceMap
+type PreEncodedSimulateSourceMap struct {
+	ProgramHash []byte          `codec:"program-hash"`
+	SourceMap   logic.SourceMap `codec:"sourcemap"`
+}
+
+// PreEncodedSimulateRequest mirrors model.SimulateRequest
+type PreEncodedSimulateRequest struct {
+	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
+	AllowEmptySignatures  bool                                        `codec:"allow-empty-signatures,omitempty"`
+	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
+	AllowUnnamedResources bool                                        `codec:"allow-unnamed-resources,omitempty"`
+	AllowInsufficientFees bool                                        `codec:"allow-insufficient-fees,omitempty"`
+	FixSigners            bool                                        `codec:"fix-signers,omitempty"`
+	PopulateResources     bool                                        `codec:"populate-resources,omitempty"`
+	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
+	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	Session               string                                      `codec:"session,omitempty"`
+	SourceMaps            []PreEncodedSimulateSourceMap               `codec:"source-maps,omitempty"`
+}
+
+// checkSimulateRequest checks the shape of a simulate request before it is simulated.
+func checkSimulateRequest(request PreEncodedSimulateRequest, proto config.ConsensusParams) error {
+	for _, txgroup := range request.TxnGroups {
+		if len(txgroup.Txns) == 0 {
+			return errors.New("empty txgroup")
+		}
+		if len(txgroup.Txns) > proto.MaxTxGroupSize {
+			return fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), proto.MaxTxGroupSize)
+		}
+	}
+	for _, sourceMap := range request.SourceMaps {
+		if len(sourceMap.ProgramHash) != crypto.DigestSize {
+			return fmt.Errorf("program hash of source map has length %d, expected %d", len(sourceMap.ProgramHash), crypto.DigestSize)
+		}
+	}
+	return nil
+}
+
+// SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
+// (POST /v2/transactions/simulate)
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
+	return v2.simulate(ctx, (*string)(params.Format), false)
+}
+
+// SimulateTransactionBatch simulates each transaction group of the request independently, against the same round.
+// (POST /v2/transactions/simulate/batch)
+func (v2 *Handlers) SimulateTransactionBatch(ctx echo.Context, params model.SimulateTransactionBatchParams) error {
+	return v2.simulate(ctx, (*string)(params.Format), true)
+}
+
+// simulate decodes a simulate request and replies with its simulation. The transaction groups of a
+// batch are simulated independently.
+func (v2 *Handlers) simulate(ctx echo.Context, format *string, batch bool) error {
+	operation := "SimulateTransaction"
+	if batch {
+		operation = "SimulateTransactionBatch"
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("%s failed as the node was catchpoint catchuping", operation), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	requestBodyReader := http.MaxBytesReader(nil, ctx.Request().Body, MaxTealDryrunBytes)
+	var simulateRequest PreEncodedSimulateRequest
+	if strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationMsgpack) {
+		// msgpack requests, which may hold many large groups, are decoded as they are received.
+		err = codec.NewDecoder(requestBodyReader, protocol.CodecHandle).Decode(&simulateRequest)
+		if err != nil {
+			return rejectRequestBody(ctx, fmt.Errorf("failed to decode object: %v", err), v2.Log)
+		}
+	} else {
+		requestBuffer := new(bytes.Buffer)
+		_, err = requestBuffer.ReadFrom(requestBodyReader)
+		if err != nil {
+			return rejectRequestBody(ctx, err, v2.Log)
+		}
+		requestData := requestBuffer.Bytes()
+
+		err = decode(protocol.CodecHandle, requestData, &simulateRequest)
+		if err != nil {
+			err = decode(protocol.JSONStrictHandle, requestData, &simulateRequest)
+			if err != nil {
+				return badRequest(ctx, err, err.Error(), v2.Log)
+			}
+		}
+	}
+
+	err = checkSimulateRequest(simulateRequest, proto)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// Simulate transaction
+	request := convertSimulationRequest(simulateRequest)
+	var simulationResult simulation.Result
+	if batch {
+		if request.Session != "" {
+			return badRequest(ctx, nil, errSimulateBatchSession, v2.Log)
+		}
+		simulationResult, err = v2.Node.SimulateBatch(request)
+	} else {
+		if request.Session != "" {
+			// Sessions are scoped to the client which opened them
+			request.Session = clientID(ctx) + "/" + request.Session
+		}
+		simulationResult, err = v2.Node.Simulate(request)
+	}
+	if err != nil {
+		var invalidTxErr simulation.InvalidRequestError
+		switch {
+		case errors.As(err, &invalidTxErr):
+			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+		case errors.Is(err, simulation.ErrSessionsDisabled):
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	response := convertSimulationResult(simulationResult)
+
+	handle, contentType, err := getCodecHandle(format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	responseData, err := encode(handle, &response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, responseData)
+}
+
+// DeleteSimulateSession discards a simulation session opened by the same client.
+// (DELETE /v2/transactions/simulate/sessions/{name})
+func (v2 *Handlers) DeleteSimulateSession(ctx echo.Context, name string) error {
+	err := v2.Node.DeleteSimulateSession(clientID(ctx) + "/" + name)
+	if err != nil {
+		if errors.Is(err, simulation.ErrSessionNotFound) {
+			return notFound(ctx, err, errSimulateSessionNotFound, v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// SimulateDebugAdapter upgrades the connection to a WebSocket over which IDEs debug a simulation
+// through the Debug Adapter Protocol.
+// (GET /v2/transactions/simulate/debug)
+func (v2 *Handlers) SimulateDebugAdapter(ctx echo.Context) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return writeErrorResponse(ctx, http.StatusNotFound, errDebugAdapterNotEnabled, nil)
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SimulateDebugAdapter failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	ws, err := debugAdapterUpgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return nil
+	}
+	defer ws.Close()
+
+	session := debugAdapterSession{
+		ws:       ws,
+		simulate: v2.debugAdapterSimulate(config.Consensus[stat.LastVersion], clientID(ctx)),
+	}
+	err = session.run()
+	if err != nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+		v2.Log.Debugf("SimulateDebugAdapter: session ended: %v", err)
+	}
+	return nil
+}
+
+// TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
+// (POST /v2/teal/dryrun)
+func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return writeErrorResponse(ctx, http.StatusNotFound, errDryrunNotEnabled, nil)
+	}
+	req := ctx.Request()
+	buf := new(bytes.Buffer)
+	req.Body = http.MaxBytesReader(nil, req.Body, MaxTealDryrunBytes)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+	data := buf.Bytes()
+
+	var dr DryrunRequest
+	var gdr model.DryrunRequest
+	err = decode(protocol.JSONStrictHandle, data, &gdr)
+	if err == nil {
+		dr, err = DryrunRequestFromGenerated(&gdr)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	} else {
+		err = decode(protocol.CodecHandle, data, &dr)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	// fetch previous block header just once to prevent racing with network
+	var hdr bookkeeping.BlockHeader
+	if dr.ProtocolVersion == "" || dr.Round == 0 || dr.LatestTimestamp == 0 {
+		actualLedger := v2.Node.LedgerForAPI()
+		hdr, err = actualLedger.BlockHdr(actualLedger.Latest())
+		if err != nil {
+			return internalError(ctx, err, "current block error", v2.Log)
+		}
+	}
+
+	var response model.DryrunResponse
+
+	var protocolVersion protocol.ConsensusVersion
+	if dr.ProtocolVersion != "" {
+		var ok bool
+		_, ok = config.Consensus[protocol.ConsensusVersion(dr.ProtocolVersion)]
+		if !ok {
+			return badRequest(ctx, nil, "unsupported protocol version", v2.Log)
+		}
+		protocolVersion = protocol.ConsensusVersion(dr.ProtocolVersion)
+	} else {
+		protocolVersion = hdr.CurrentProtocol
+	}
+	dr.ProtocolVersion = string(protocolVersion)
+
+	if dr.Round == 0 {
+		dr.Round = uint64(hdr.Round + 1)
+	}
+
+	if dr.LatestTimestamp == 0 {
+		dr.LatestTimestamp = hdr.TimeStamp
+	}
+
+	doDryrunRequest(&dr, &response)
+	response.ProtocolVersion = string(protocolVersion)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// UnsetSyncRound removes the sync round restriction from the ledger.
+// (DELETE /v2/ledger/sync)
+func (v2 *Handlers) UnsetSyncRound(ctx echo.Context) error {
+	v2.Node.UnsetSyncRound()
+	return ctx.NoContent(http.StatusOK)
+}
+
+// SetSyncRound sets the sync round on the ledger.
+// (POST /v2/ledger/sync/{round})
+func (v2 *Handlers) SetSyncRound(ctx echo.Context, round uint64) error {
+	err := v2.Node.SetSyncRound(round)
+	if err != nil {
+		switch err {
+		case catchup.ErrSyncRoundInvalid:
+			return badRequest(ctx, err, errFailedSettingSyncRound, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedSettingSyncRound, v2.Log)
+		}
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// AcknowledgeSyncRound tells the follower sync policy that the consumer has processed every round up to the given round.
+// (POST /v2/ledger/sync/ack/{round})
+func (v2 *Handlers) AcknowledgeSyncRound(ctx echo.Context, round uint64) error {
+	err := v2.Node.AcknowledgeSyncRound(round)
+	if err != nil {
+		switch err {
+		case node.ErrSyncPolicyDisabled:
+			return badRequest(ctx, err, errSyncPolicyDisabled, v2.Log)
+		case node.ErrSyncAckAhead, catchup.ErrSyncRoundInvalid:
+			return badRequest(ctx, err, errFailedAcknowledgingSyncRound, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedAcknowledgingSyncRound, v2.Log)
+		}
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetSyncRound gets the sync round from the ledger.
+// (GET /v2/ledger/sync)
+func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
+	rnd := v2.Node.GetSyncRound()
+	if rnd == 0 {
+		return notFound(ctx, fmt.Errorf("sync round is not set"), errFailedRetrievingSyncRound, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: rnd})
+}
+
+// GetLedgerStateDelta returns the deltas for a given round.
 // This should be a representation of the ledgercore.StateDelta object.
 // (GET /v2/deltas/{round})
 func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params model.GetLedgerStateDeltaParams) error {
@@ -1195,398 +2696,1000 @@ func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params m
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
-	sDelta, err := v2.Node.LedgerForAPI().GetStateDeltaForRound(basics.Round(round))
-	if err != nil {
-		return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStateDelta, err), v2.Log)
-	}
-	data, err := encode(handle, sDelta)
+	sDelta, err := v2.Node.LedgerForAPI().GetStateDeltaForRound(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStateDelta, err), v2.Log)
+	}
+	data, err := encode(handle, sDelta)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// TransactionParams returns the suggested parameters for constructing a new transaction.
+// (GET /v2/transactions/params)
+func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("TransactionParams failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	gh := v2.Node.GenesisHash()
+	proto := config.Consensus[stat.LastVersion]
+
+	response := model.TransactionParametersResponse{
+		ConsensusVersion: string(stat.LastVersion),
+		Fee:              v2.Node.SuggestedFee().Raw,
+		GenesisHash:      gh[:],
+		GenesisId:        v2.Node.GenesisID(),
+		LastRound:        uint64(stat.LastRound),
+		MinFee:           proto.MinTxnFee,
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PreEncodedTxInfo represents the PendingTransaction response before it is
+// encoded to a format.
+type PreEncodedTxInfo struct {
+	AssetIndex         *uint64                    `codec:"asset-index,omitempty"`
+	AssetClosingAmount *uint64                    `codec:"asset-closing-amount,omitempty"`
+	ApplicationIndex   *uint64                    `codec:"application-index,omitempty"`
+	CloseRewards       *uint64                    `codec:"close-rewards,omitempty"`
+	ClosingAmount      *uint64                    `codec:"closing-amount,omitempty"`
+	ConfirmedRound     *uint64                    `codec:"confirmed-round,omitempty"`
+	GlobalStateDelta   *model.StateDelta          `codec:"global-state-delta,omitempty"`
+	LocalStateDelta    *[]model.AccountStateDelta `codec:"local-state-delta,omitempty"`
+	PoolError          string                     `codec:"pool-error"`
+	ReceiverRewards    *uint64                    `codec:"receiver-rewards,omitempty"`
+	SenderRewards      *uint64                    `codec:"sender-rewards,omitempty"`
+	Txn                transactions.SignedTxn     `codec:"txn"`
+	Logs               *[][]byte                  `codec:"logs,omitempty"`
+	Inners             *[]PreEncodedTxInfo        `codec:"inner-txns,omitempty"`
+}
+
+// PendingTransactionInformation returns a transaction with the specified txID
+// from the transaction pool. If not found looks for the transaction in the
+// last proto.MaxTxnLife rounds
+// (GET /v2/transactions/pending/{txid})
+func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string, params model.PendingTransactionInformationParams) error {
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("PendingTransactionInformation failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	txID := transactions.Txid{}
+	if err := txID.UnmarshalText([]byte(txid)); err != nil {
+		return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
+	}
+
+	txn, ok := v2.Node.GetPendingTransaction(txID)
+
+	// We didn't find it, return a failure
+	if !ok {
+		err := errors.New(errTransactionNotFound)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	// Encoding wasn't working well without embedding "real" objects.
+	response := PreEncodedTxInfo{
+		Txn:       txn.Txn,
+		PoolError: txn.PoolError,
+	}
+
+	if txn.ConfirmedRound != 0 {
+		r := uint64(txn.ConfirmedRound)
+		response.ConfirmedRound = &r
+
+		response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
+		response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
+		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
+		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
+		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
+		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.LedgerForAPI())
+		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.LedgerForAPI())
+		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
+		response.Logs = convertLogs(txn)
+		response.Inners = convertInners(&txn)
+	}
+
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	data, err := encode(handle, response)
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
+
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
-// TransactionParams returns the suggested parameters for constructing a new transaction.
-// (GET /v2/transactions/params)
-func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
+// getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
+func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, addrFilter *string) error {
+
 	stat, err := v2.Node.Status()
 	if err != nil {
 		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
 	}
 	if stat.Catchpoint != "" {
 		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("TransactionParams failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+		return serviceUnavailable(ctx, fmt.Errorf("PendingTransactionInformation failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
 	}
 
-	gh := v2.Node.GenesisHash()
-	proto := config.Consensus[stat.LastVersion]
+	var addrPtr *basics.Address
 
-	response := model.TransactionParametersResponse{
-		ConsensusVersion: string(stat.LastVersion),
-		Fee:              v2.Node.SuggestedFee().Raw,
-		GenesisHash:      gh[:],
-		GenesisId:        v2.Node.GenesisID(),
-		LastRound:        uint64(stat.LastRound),
-		MinFee:           proto.MinTxnFee,
+	if addrFilter != nil {
+		addr, err := basics.UnmarshalChecksumAddress(*addrFilter)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+		addrPtr = &addr
+	}
+
+	handle, contentType, err := getCodecHandle(format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	txnPool, err := v2.Node.GetPendingTxnsFromPool()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+
+	// MatchAddress uses this to check FeeSink, we don't care about that here.
+	spec := transactions.SpecialAddresses{
+		FeeSink:     basics.Address{},
+		RewardsPool: basics.Address{},
+	}
+
+	txnLimit := uint64(math.MaxUint64)
+	if max != nil && *max != 0 {
+		txnLimit = *max
+	}
+
+	// Convert transactions to msgp / json strings
+	topTxns := make([]transactions.SignedTxn, 0)
+	for _, txn := range txnPool {
+		// break out if we've reached the max number of transactions
+		if uint64(len(topTxns)) >= txnLimit {
+			break
+		}
+
+		// continue if we have an address filter and the address doesn't match the transaction.
+		if addrPtr != nil && !txn.Txn.MatchAddress(*addrPtr, spec) {
+			continue
+		}
+
+		topTxns = append(topTxns, txn)
+	}
+
+	// Encoding wasn't working well without embedding "real" objects.
+	response := struct {
+		TopTransactions   []transactions.SignedTxn `json:"top-transactions"`
+		TotalTransactions uint64                   `json:"total-transactions"`
+	}{
+		TopTransactions:   topTxns,
+		TotalTransactions: uint64(len(txnPool)),
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// startCatchup Given a catchpoint, it starts catching up to this catchpoint
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
+	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
+	}
+
+	// Select 200/201, or return an error
+	var code int
+	err = v2.Node.StartCatchup(catchpoint)
+	switch err.(type) {
+	case nil:
+		code = http.StatusCreated
+	case *node.CatchpointAlreadyInProgressError:
+		code = http.StatusOK
+	case *node.CatchpointUnableToStartError:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	case *node.StartCatchpointError:
+		return timeout(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToStartCatchup, err), v2.Log)
+	}
+
+	return ctx.JSON(code, model.CatchpointStartResponse{
+		CatchupMessage: catchpoint,
+	})
+}
+
+// abortCatchup Given a catchpoint, it aborts catching up to this catchpoint
+func (v2 *Handlers) abortCatchup(ctx echo.Context, catchpoint string) error {
+	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
+	}
+
+	err = v2.Node.AbortCatchup(catchpoint)
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedToAbortCatchup, err), v2.Log)
 	}
 
+	return ctx.JSON(http.StatusOK, model.CatchpointAbortResponse{
+		CatchupMessage: catchpoint,
+	})
+}
+
+// getCatchupStatus returns the progress of the catchup to the given catchpoint
+func (v2 *Handlers) getCatchupStatus(ctx echo.Context, catchpoint string) error {
+	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
+	}
+
+	stats, ok := v2.Node.CatchupProgress(catchpoint)
+	if !ok {
+		return notFound(ctx, fmt.Errorf("not catching up to %s", catchpoint), errCatchupNotInProgress, v2.Log)
+	}
+
+	response := model.CatchupStatusResponse{
+		Catchpoint:        stats.CatchpointLabel,
+		Stage:             model.CatchupStatusResponseStage(stats.Stage.String()),
+		StartTime:         uint64(stats.StartTime.Unix()),
+		StageStartTime:    uint64(stats.StageStartTime.Unix()),
+		TotalAccounts:     stats.TotalAccounts,
+		ProcessedAccounts: stats.ProcessedAccounts,
+		VerifiedAccounts:  stats.VerifiedAccounts,
+		TotalKvs:          stats.TotalKVs,
+		ProcessedKvs:      stats.ProcessedKVs,
+		VerifiedKvs:       stats.VerifiedKVs,
+		ProcessedBytes:    stats.ProcessedBytes,
+		TotalBlocks:       stats.TotalBlocks,
+		AcquiredBlocks:    stats.AcquiredBlocks,
+		VerifiedBlocks:    stats.VerifiedBlocks,
+	}
+	if completion, ok := stats.EstimatedStageCompletion(time.Now()); ok {
+		completionTime := uint64(completion.Unix())
+		response.EstimatedStageCompletionTime = &completionTime
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// PreEncodedTxInfo represents the PendingTransaction response before it is
-// encoded to a format.
-type PreEncodedTxInfo struct {
-	AssetIndex         *uint64                    `codec:"asset-index,omitempty"`
-	AssetClosingAmount *uint64                    `codec:"asset-closing-amount,omitempty"`
-	ApplicationIndex   *uint64                    `codec:"application-index,omitempty"`
-	CloseRewards       *uint64                    `codec:"close-rewards,omitempty"`
-	ClosingAmount      *uint64                    `codec:"closing-amount,omitempty"`
-	ConfirmedRound     *uint64                    `codec:"confirmed-round,omitempty"`
-	GlobalStateDelta   *model.StateDelta          `codec:"global-state-delta,omitempty"`
-	LocalStateDelta    *[]model.AccountStateDelta `codec:"local-state-delta,omitempty"`
-	PoolError          string                     `codec:"pool-error"`
-	ReceiverRewards    *uint64                    `codec:"receiver-rewards,omitempty"`
-	SenderRewards      *uint64                    `codec:"sender-rewards,omitempty"`
-	Txn                transactions.SignedTxn     `codec:"txn"`
-	Logs               *[][]byte                  `codec:"logs,omitempty"`
-	Inners             *[]PreEncodedTxInfo        `codec:"inner-txns,omitempty"`
+// GetPendingTransactions returns the list of unconfirmed transactions currently in the transaction pool.
+// (GET /v2/transactions/pending)
+func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPendingTransactionsParams) error {
+	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), nil)
 }
 
-// PendingTransactionInformation returns a transaction with the specified txID
-// from the transaction pool. If not found looks for the transaction in the
-// last proto.MaxTxnLife rounds
-// (GET /v2/transactions/pending/{txid})
-func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string, params model.PendingTransactionInformationParams) error {
+// GetApplicationByID returns application information by app idx.
+// (GET /v2/applications/{application-id})
+func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
+	appIdx := basics.AppIndex(applicationID)
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if !ok {
+		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
+	}
+
+	lastRound := ledger.Latest()
+
+	record, err := ledger.LookupApplication(lastRound, creator, basics.AppIndex(applicationID))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	if record.AppParams == nil {
+		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
+	}
+	appParams := *record.AppParams
+	app := AppParamsToApplication(creator.String(), appIdx, &appParams)
+	response := model.ApplicationResponse(app)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func applicationBoxesMaxKeys(requestedMax uint64, algodMax uint64) uint64 {
+	if requestedMax == 0 {
+		if algodMax == 0 {
+			return math.MaxUint64 // unlimited results when both requested and algod max are 0
+		}
+		return algodMax + 1 // API limit dominates.  Increments by 1 to test if more than max supported results exist.
+	}
+
+	if requestedMax <= algodMax || algodMax == 0 {
+		return requestedMax // requested limit dominates
+	}
+
+	return algodMax + 1 // API limit dominates.  Increments by 1 to test if more than max supported results exist.
+}
+
+// GetApplicationBoxes returns the box names of an application
+// (GET /v2/applications/{application-id}/boxes)
+func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxesParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	if params.Prefix != nil || params.Next != nil || params.Values != nil {
+		return v2.getApplicationBoxesPage(ctx, appIdx, params)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	lastRound := ledger.Latest()
+	keyPrefix := apps.MakeBoxKey(uint64(appIdx), "")
+
+	requestedMax, algodMax := nilToZero(params.Max), v2.Node.Config().MaxAPIBoxPerApplication
+	max := applicationBoxesMaxKeys(requestedMax, algodMax)
+
+	if max != math.MaxUint64 {
+		record, _, _, err := ledger.LookupAccount(ledger.Latest(), appIdx.Address())
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		if record.TotalBoxes > max {
+			return writeErrorResponse(ctx, http.StatusBadRequest, errResultLimitExceeded, &map[string]interface{}{
+				"max-api-box-per-application": algodMax,
+				"max":                         requestedMax,
+				"total-boxes":                 record.TotalBoxes,
+			})
+		}
+	}
+
+	boxKeys, err := ledger.LookupKeysByPrefix(lastRound, keyPrefix, math.MaxUint64)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	prefixLen := len(keyPrefix)
+	responseBoxes := make([]model.BoxDescriptor, len(boxKeys))
+	for i, boxKey := range boxKeys {
+		responseBoxes[i] = model.BoxDescriptor{
+			Name: []byte(boxKey[prefixLen:]),
+		}
+	}
+	response := model.BoxesResponse{Boxes: responseBoxes}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// getApplicationBoxesPage returns a page of the boxes of an application, in order of their names.
+func (v2 *Handlers) getApplicationBoxesPage(ctx echo.Context, appIdx basics.AppIndex, params model.GetApplicationBoxesParams) error {
+	ledger := v2.Node.LedgerForAPI()
+	lastRound := ledger.Latest()
+	boxPrefix := apps.MakeBoxKey(uint64(appIdx), "")
+
+	var namePrefix []byte
+	if params.Prefix != nil {
+		var err error
+		namePrefix, err = base64.StdEncoding.DecodeString(*params.Prefix)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseBoxPrefix, v2.Log)
+		}
+	}
+	keyPrefix := boxPrefix + string(namePrefix)
+	from := keyPrefix
+	if params.Next != nil {
+		next, err := base64.StdEncoding.DecodeString(*params.Next)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+		}
+		from = boxPrefix + string(next)
+	}
 
-	stat, err := v2.Node.Status()
+	// the page holds the requested max boxes, within the algod limit
+	pageSize := nilToZero(params.Max)
+	if algodMax := v2.Node.Config().MaxAPIBoxPerApplication; algodMax != 0 && (pageSize == 0 || pageSize > algodMax) {
+		pageSize = algodMax
+	}
+	maxKeys := uint64(math.MaxUint64)
+	if pageSize != 0 {
+		// one more box tells whether another page follows
+		maxKeys = pageSize + 1
+	}
+
+	boxKeys, err := ledger.LookupKeysByRange(lastRound, keyPrefix, from, maxKeys)
 	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	if stat.Catchpoint != "" {
-		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("PendingTransactionInformation failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+
+	var response model.BoxesResponse
+	if pageSize != 0 && uint64(len(boxKeys)) > pageSize {
+		nextToken := base64.StdEncoding.EncodeToString([]byte(boxKeys[pageSize][len(boxPrefix):]))
+		response.NextToken = &nextToken
+		boxKeys = boxKeys[:pageSize]
 	}
 
-	txID := transactions.Txid{}
-	if err := txID.UnmarshalText([]byte(txid)); err != nil {
-		return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
+	response.Boxes = make([]model.BoxDescriptor, len(boxKeys))
+	for i, boxKey := range boxKeys {
+		response.Boxes[i].Name = []byte(boxKey[len(boxPrefix):])
+		if nilToZero(params.Values) {
+			value, err := ledger.LookupKv(lastRound, boxKey)
+			if err != nil {
+				return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			}
+			response.Boxes[i].Value = &value
+		}
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	txn, ok := v2.Node.GetPendingTransaction(txID)
+// GetApplicationBoxByName returns the value of an application's box
+// (GET /v2/applications/{application-id}/box)
+func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxByNameParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	ledger := v2.Node.LedgerForAPI()
+	lastRound := ledger.Latest()
 
-	// We didn't find it, return a failure
-	if !ok {
-		err := errors.New(errTransactionNotFound)
-		return notFound(ctx, err, err.Error(), v2.Log)
+	encodedBoxName := params.Name
+	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	// Encoding wasn't working well without embedding "real" objects.
-	response := PreEncodedTxInfo{
-		Txn:       txn.Txn,
-		PoolError: txn.PoolError,
+	value, err := ledger.LookupKv(lastRound, apps.MakeBoxKey(uint64(appIdx), string(boxName)))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	if txn.ConfirmedRound != 0 {
-		r := uint64(txn.ConfirmedRound)
-		response.ConfirmedRound = &r
+	var history *ledgercore.BoxHistory
+	if v2.Node.Config().EnableBoxHistoryIndex {
+		h, err := ledger.BoxHistory(appIdx, string(boxName))
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		history = &h
+	}
 
-		response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
-		response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
-		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
-		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
-		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
-		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.LedgerForAPI())
-		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.LedgerForAPI())
-		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
-		response.Logs = convertLogs(txn)
-		response.Inners = convertInners(&txn)
+	if value == nil {
+		if history == nil {
+			return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
+		}
+		// let the caller tell a deleted box from one that never existed since the index started.
+		data := map[string]interface{}{"indexed-since-round": uint64(history.Since)}
+		if history.Created != 0 {
+			data["created-round"] = uint64(history.Created)
+		}
+		if history.Deleted != 0 {
+			data["deleted-round"] = uint64(history.Deleted)
+		}
+		return writeErrorResponse(ctx, http.StatusNotFound, errBoxDoesNotExist, &data)
 	}
 
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	response := model.BoxResponse{
+		Round: uint64(lastRound),
+		Name:  boxName,
+		Value: value,
+	}
+	if history != nil {
+		response.CreatedRound = omitEmpty(uint64(history.Created))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAssetByID returns application information by app idx.
+// (GET /v2/assets/{asset-id})
+func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
+	assetIdx := basics.AssetIndex(assetID)
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	data, err := encode(handle, response)
+	if !ok {
+		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	}
+
+	lastRound := ledger.Latest()
+	record, err := ledger.LookupAsset(lastRound, creator, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	return ctx.Blob(http.StatusOK, contentType, data)
+	if record.AssetParams == nil {
+		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	}
+	assetParams := *record.AssetParams
+	asset := AssetParamsToAsset(creator.String(), assetIdx, &assetParams)
+	response := model.AssetResponse(asset)
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
-func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, addrFilter *string) error {
+// VerifyAssetMetadata fetches the off-chain metadata of an asset and verifies it against its
+// on-chain metadata hash.
+// (GET /v2/assets/{asset-id}/metadata/verify)
+func (v2 *Handlers) VerifyAssetMetadata(ctx echo.Context, assetID uint64) error {
+	cfg := v2.Node.Config()
+	if !cfg.EnableAssetMetadataVerification {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errAssetMetadataVerificationNotEnabled, nil)
+	}
 
-	stat, err := v2.Node.Status()
+	assetIdx := basics.AssetIndex(assetID)
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
 	if err != nil {
-		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	if stat.Catchpoint != "" {
-		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("PendingTransactionInformation failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	if !ok {
+		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	}
+	record, err := ledger.LookupAsset(ledger.Latest(), creator, assetIdx)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if record.AssetParams == nil {
+		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
 	}
 
-	var addrPtr *basics.Address
+	fetcher := makeAssetMetadataFetcher(cfg, refusePrivateAddresses)
+	response := fetcher.verify(ctx.Request().Context(), assetIdx, *record.AssetParams)
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	if addrFilter != nil {
-		addr, err := basics.UnmarshalChecksumAddress(*addrFilter)
+// GetAssetComplianceEvents returns the freeze and clawback events of an asset.
+// (GET /v2/assets/{asset-id}/compliance-events)
+func (v2 *Handlers) GetAssetComplianceEvents(ctx echo.Context, assetID uint64, params model.GetAssetComplianceEventsParams) error {
+	var target basics.Address
+	if params.Address != nil {
+		var err error
+		target, err = basics.UnmarshalChecksumAddress(*params.Address)
 		if err != nil {
 			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 		}
-		addrPtr = &addr
 	}
+	return v2.complianceEvents(ctx, basics.AssetIndex(assetID), target, params.MinRound, params.MaxRound)
+}
 
-	handle, contentType, err := getCodecHandle(format)
+// GetAccountComplianceEvents returns the freeze and clawback events targeting an account.
+// (GET /v2/accounts/{address}/compliance-events)
+func (v2 *Handlers) GetAccountComplianceEvents(ctx echo.Context, address string, params model.GetAccountComplianceEventsParams) error {
+	target, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
+	return v2.complianceEvents(ctx, 0, target, params.MinRound, params.MaxRound)
+}
 
-	txnPool, err := v2.Node.GetPendingTxnsFromPool()
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+func (v2 *Handlers) complianceEvents(ctx echo.Context, asset basics.AssetIndex, target basics.Address, minRound, maxRound *uint64) error {
+	if !v2.Node.Config().EnableAssetComplianceIndex {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errAssetComplianceIndexNotEnabled, nil)
 	}
 
-	// MatchAddress uses this to check FeeSink, we don't care about that here.
-	spec := transactions.SpecialAddresses{
-		FeeSink:     basics.Address{},
-		RewardsPool: basics.Address{},
+	events, err := v2.Node.LedgerForAPI().AssetComplianceEvents(asset, target, basics.Round(nilToZero(minRound)), basics.Round(nilToZero(maxRound)))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	txnLimit := uint64(math.MaxUint64)
-	if max != nil && *max != 0 {
-		txnLimit = *max
+	response := model.ComplianceEventsResponse{
+		Events: make([]model.AssetComplianceEvent, len(events)),
 	}
-
-	// Convert transactions to msgp / json strings
-	topTxns := make([]transactions.SignedTxn, 0)
-	for _, txn := range txnPool {
-		// break out if we've reached the max number of transactions
-		if uint64(len(topTxns)) >= txnLimit {
-			break
+	for i, ev := range events {
+		response.Events[i] = model.AssetComplianceEvent{
+			Kind:     string(ev.Kind),
+			Round:    uint64(ev.Round),
+			Txid:     ev.TxID.String(),
+			InnerTxn: ev.Inner,
+			AssetId:  uint64(ev.Asset),
+			Target:   ev.Target.String(),
+			Sender:   ev.Sender.String(),
 		}
-
-		// continue if we have an address filter and the address doesn't match the transaction.
-		if addrPtr != nil && !txn.Txn.MatchAddress(*addrPtr, spec) {
-			continue
+		if ev.Kind == ledgercore.AssetClawbackEvent {
+			receiver, amount := ev.Receiver.String(), ev.Amount
+			response.Events[i].Receiver = &receiver
+			response.Events[i].Amount = &amount
 		}
-
-		topTxns = append(topTxns, txn)
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	// Encoding wasn't working well without embedding "real" objects.
-	response := struct {
-		TopTransactions   []transactions.SignedTxn `json:"top-transactions"`
-		TotalTransactions uint64                   `json:"total-transactions"`
-	}{
-		TopTransactions:   topTxns,
-		TotalTransactions: uint64(len(txnPool)),
-	}
+// Bounds of the number of results returned by GetAccountTransactions, GetRecentTransactions and GetApplicationEvents.
+const (
+	defaultAccountTransactionsLimit = 100
+	maxAccountTransactionsLimit     = 1000
+)
 
-	data, err := encode(handle, response)
+// GetAccountDiff returns the net change of an account between two rounds.
+// (GET /v2/accounts/{address}/diff)
+func (v2 *Handlers) GetAccountDiff(ctx echo.Context, address string, params model.GetAccountDiffParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	from, to := basics.Round(params.From), basics.Round(params.To)
+	if from > to {
+		return badRequest(ctx, nil, errInvalidAccountDiffRange, v2.Log)
+	}
+	myLedger := v2.Node.LedgerForAPI()
+	if to > myLedger.Latest() {
+		return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
 	}
 
-	return ctx.Blob(http.StatusOK, contentType, data)
-}
-
-// startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
-	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
+	lookupError := func(err error) error {
+		var roundErr *ledger.RoundOffsetError
+		if errors.As(err, &roundErr) {
+			return notFound(ctx, err, fmt.Sprintf(errAccountRoundNotRetained, from), v2.Log)
+		}
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	before, _, _, err := myLedger.LookupAccount(from, addr)
 	if err != nil {
-		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
+		return lookupError(err)
 	}
-
-	// Select 200/201, or return an error
-	var code int
-	err = v2.Node.StartCatchup(catchpoint)
-	switch err.(type) {
-	case nil:
-		code = http.StatusCreated
-	case *node.CatchpointAlreadyInProgressError:
-		code = http.StatusOK
-	case *node.CatchpointUnableToStartError:
-		return badRequest(ctx, err, err.Error(), v2.Log)
-	case *node.StartCatchpointError:
-		return timeout(ctx, err, err.Error(), v2.Log)
-	default:
-		return internalError(ctx, err, fmt.Sprintf(errFailedToStartCatchup, err), v2.Log)
+	after, _, _, err := myLedger.LookupAccount(to, addr)
+	if err != nil {
+		return lookupError(err)
 	}
-
-	return ctx.JSON(code, model.CatchpointStartResponse{
-		CatchupMessage: catchpoint,
-	})
+	changes, err := myLedger.LookupAccountChanges(addr, from, to)
+	if err != nil {
+		return lookupError(err)
+	}
+
+	response := model.AccountDiffResponse{
+		Address:      addr.String(),
+		From:         uint64(from),
+		To:           uint64(to),
+		AmountBefore: before.MicroAlgos.Raw,
+		AmountAfter:  after.MicroAlgos.Raw,
+		Assets:       []model.AccountAssetChange{},
+		Applications: []model.AccountApplicationChange{},
+		Boxes:        make([]model.AccountBoxChange, len(changes.Boxes)),
+	}
+	for _, change := range changes.Resources {
+		switch change.Type {
+		case basics.AssetCreatable:
+			aidx := basics.AssetIndex(change.Index)
+			holding := func(h *basics.AssetHolding) *model.AssetHolding {
+				if h == nil {
+					return nil
+				}
+				mh := AssetHolding(*h, aidx)
+				return &mh
+			}
+			params := func(p *basics.AssetParams) *model.AssetParams {
+				if p == nil {
+					return nil
+				}
+				asset := AssetParamsToAsset(addr.String(), aidx, p)
+				return &asset.Params
+			}
+			response.Assets = append(response.Assets, model.AccountAssetChange{
+				AssetId:       uint64(aidx),
+				HoldingBefore: holding(change.Before.AssetHolding),
+				HoldingAfter:  holding(change.After.AssetHolding),
+				ParamsBefore:  params(change.Before.AssetParams),
+				ParamsAfter:   params(change.After.AssetParams),
+			})
+		case basics.AppCreatable:
+			aidx := basics.AppIndex(change.Index)
+			localState := func(s *basics.AppLocalState) *model.ApplicationLocalState {
+				if s == nil {
+					return nil
+				}
+				ms := AppLocalState(*s, aidx)
+				return &ms
+			}
+			params := func(p *basics.AppParams) *model.ApplicationParams {
+				if p == nil {
+					return nil
+				}
+				app := AppParamsToApplication(addr.String(), aidx, p)
+				return &app.Params
+			}
+			response.Applications = append(response.Applications, model.AccountApplicationChange{
+				ApplicationId:    uint64(aidx),
+				LocalStateBefore: localState(change.Before.AppLocalState),
+				LocalStateAfter:  localState(change.After.AppLocalState),
+				ParamsBefore:     params(change.Before.AppParams),
+				ParamsAfter:      params(change.After.AppParams),
+			})
+		}
+	}
+	// an empty box exists, unlike a nil value
+	value := func(v []byte) *[]byte {
+		if v == nil {
+			return nil
+		}
+		return &v
+	}
+	for i, box := range changes.Boxes {
+		response.Boxes[i] = model.AccountBoxChange{
+			ApplicationId: uint64(box.App),
+			Name:          []byte(box.Name),
+			ValueBefore:   value(box.Before),
+			ValueAfter:    value(box.After),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// abortCatchup Given a catchpoint, it aborts catching up to this catchpoint
-func (v2 *Handlers) abortCatchup(ctx echo.Context, catchpoint string) error {
-	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
+// AccountOnlineness returns whether an account votes in a future round, and with which stake.
+// (GET /v2/accounts/{address}/onlineness)
+func (v2 *Handlers) AccountOnlineness(ctx echo.Context, address string, params model.AccountOnlinenessParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
-
-	err = v2.Node.AbortCatchup(catchpoint)
+	pending, err := v2.Node.GetPendingTxnsFromPool()
 	if err != nil {
-		return internalError(ctx, err, fmt.Sprintf(errFailedToAbortCatchup, err), v2.Log)
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
 	}
-
-	return ctx.JSON(http.StatusOK, model.CatchpointAbortResponse{
-		CatchupMessage: catchpoint,
-	})
-}
-
-// GetPendingTransactions returns the list of unconfirmed transactions currently in the transaction pool.
-// (GET /v2/transactions/pending)
-func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPendingTransactionsParams) error {
-	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), nil)
+	projection, err := makeOnlinenessProjection(v2.Node.LedgerForAPI(), addr, pending)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	round := projection.latest + 1
+	if params.Round != nil {
+		round = basics.Round(*params.Round)
+	}
+	if round <= projection.latest {
+		return badRequest(ctx, nil, errRoundNotInFuture, v2.Log)
+	}
+	response, err := projection.response(round)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetApplicationByID returns application information by app idx.
-// (GET /v2/applications/{application-id})
-func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
-	appIdx := basics.AppIndex(applicationID)
-	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+// GetAccountTransactions returns the IDs of the recent transactions touching an account.
+// (GET /v2/accounts/{address}/transactions)
+func (v2 *Handlers) GetAccountTransactions(ctx echo.Context, address string, params model.GetAccountTransactionsParams) error {
+	if !v2.Node.Config().EnableAccountTxnIndex {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errAccountTxnIndexNotEnabled, nil)
+	}
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
-	if !ok {
-		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
+	limit := uint64(defaultAccountTransactionsLimit)
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit > maxAccountTransactionsLimit {
+		return badRequest(ctx, nil, fmt.Sprintf(errLimitTooLarge, maxAccountTransactionsLimit), v2.Log)
+	}
+	maxRound := basics.Round(math.MaxUint64)
+	if params.MaxRound != nil {
+		maxRound = basics.Round(*params.MaxRound)
 	}
 
-	lastRound := ledger.Latest()
-
-	record, err := ledger.LookupApplication(lastRound, creator, basics.AppIndex(applicationID))
+	txns, err := v2.Node.LedgerForAPI().AccountTransactions(addr, basics.Round(nilToZero(params.MinRound)), maxRound, limit)
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	if record.AppParams == nil {
-		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
+	response := model.AccountTransactionsResponse{
+		Since:        uint64(txns.Since),
+		Transactions: make([]model.AccountTransaction, len(txns.Txns)),
+	}
+	for i, txn := range txns.Txns {
+		response.Transactions[i] = model.AccountTransaction{
+			Round:            uint64(txn.Round),
+			IntraRoundOffset: txn.Intra,
+			Txid:             txn.TxID.String(),
+		}
 	}
-	appParams := *record.AppParams
-	app := AppParamsToApplication(creator.String(), appIdx, &appParams)
-	response := model.ApplicationResponse(app)
 	return ctx.JSON(http.StatusOK, response)
 }
 
-func applicationBoxesMaxKeys(requestedMax uint64, algodMax uint64) uint64 {
-	if requestedMax == 0 {
-		if algodMax == 0 {
-			return math.MaxUint64 // unlimited results when both requested and algod max are 0
+// GetApplicationEvents returns the ARC-28 events emitted by an application.
+// (GET /v2/applications/{application-id}/events)
+func (v2 *Handlers) GetApplicationEvents(ctx echo.Context, applicationID uint64, params model.GetApplicationEventsParams) error {
+	if !v2.Node.Config().FeatureRequested(config.FeatureAppEventIndex) {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errAppEventIndexNotEnabled, nil)
+	}
+
+	var selector *ledgercore.AppEventSelector
+	if params.Topic != nil {
+		topic, err := parseEventTopic(*params.Topic)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseEventTopic, v2.Log)
 		}
-		return algodMax + 1 // API limit dominates.  Increments by 1 to test if more than max supported results exist.
+		selector = &topic
+	}
+	limit := uint64(defaultAccountTransactionsLimit)
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit > maxAccountTransactionsLimit {
+		return badRequest(ctx, nil, fmt.Sprintf(errLimitTooLarge, maxAccountTransactionsLimit), v2.Log)
 	}
 
-	if requestedMax <= algodMax || algodMax == 0 {
-		return requestedMax // requested limit dominates
+	myLedger := v2.Node.LedgerForAPI()
+	latest := myLedger.Latest()
+	maxRound := latest
+	if params.MaxRound != nil && basics.Round(*params.MaxRound) < maxRound {
+		maxRound = basics.Round(*params.MaxRound)
+	}
+	events, err := myLedger.AppEvents(basics.AppIndex(applicationID), selector, basics.Round(nilToZero(params.MinRound)), maxRound, limit)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	return algodMax + 1 // API limit dominates.  Increments by 1 to test if more than max supported results exist.
+	response := model.ApplicationEventsResponse{
+		Since:        uint64(events.Since),
+		CurrentRound: uint64(latest),
+		Events:       make([]model.ApplicationEvent, len(events.Events)),
+	}
+	for i, ev := range events.Events {
+		response.Events[i] = model.ApplicationEvent{
+			Round:    uint64(ev.Round),
+			Txid:     ev.TxID.String(),
+			InnerTxn: omitEmpty(ev.Inner),
+			LogIndex: ev.LogIndex,
+			Selector: ev.Selector[:],
+			Data:     ev.Data,
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetApplicationBoxes returns the box names of an application
-// (GET /v2/applications/{application-id}/boxes)
-func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxesParams) error {
-	appIdx := basics.AppIndex(applicationID)
-	ledger := v2.Node.LedgerForAPI()
-	lastRound := ledger.Latest()
-	keyPrefix := apps.MakeBoxKey(uint64(appIdx), "")
+// parseEventTopic returns the selector of an event topic, given either as the signature of the
+// event or as its hex encoded selector, optionally prefixed with 0x.
+func parseEventTopic(topic string) (ledgercore.AppEventSelector, error) {
+	if strings.Contains(topic, "(") {
+		return ledgercore.MakeAppEventSelector(topic), nil
+	}
+	var selector ledgercore.AppEventSelector
+	decoded, err := hex.DecodeString(strings.TrimPrefix(topic, "0x"))
+	if err != nil {
+		return selector, err
+	}
+	if len(decoded) != len(selector) {
+		return selector, fmt.Errorf("a selector is %d bytes long, not %d", len(selector), len(decoded))
+	}
+	copy(selector[:], decoded)
+	return selector, nil
+}
 
-	requestedMax, algodMax := nilToZero(params.Max), v2.Node.Config().MaxAPIBoxPerApplication
-	max := applicationBoxesMaxKeys(requestedMax, algodMax)
+// GetRecentTransactions looks up the transactions of the last MaxTxnLife rounds by lease or note prefix.
+// (GET /v2/transactions/recent)
+func (v2 *Handlers) GetRecentTransactions(ctx echo.Context, params model.GetRecentTransactionsParams) error {
+	if !v2.Node.Config().EnableRecentTxnIndex {
+		return writeErrorResponse(ctx, http.StatusBadRequest, errRecentTxnIndexNotEnabled, nil)
+	}
 
-	if max != math.MaxUint64 {
-		record, _, _, err := ledger.LookupAccount(ledger.Latest(), appIdx.Address())
+	var query ledgercore.RecentTxnQuery
+	if params.Sender != nil {
+		addr, err := basics.UnmarshalChecksumAddress(*params.Sender)
 		if err != nil {
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 		}
-		if record.TotalBoxes > max {
-			return ctx.JSON(http.StatusBadRequest, model.ErrorResponse{
-				Message: "Result limit exceeded",
-				Data: &map[string]interface{}{
-					"max-api-box-per-application": algodMax,
-					"max":                         requestedMax,
-					"total-boxes":                 record.TotalBoxes,
-				},
-			})
+		query.Sender = addr
+	}
+	if params.Lease != nil {
+		lease, err := base64.StdEncoding.DecodeString(*params.Lease)
+		if err != nil || len(lease) != len(query.Lease) {
+			return badRequest(ctx, err, errFailedToParseLease, v2.Log)
+		}
+		copy(query.Lease[:], lease)
+		if query.Sender.IsZero() {
+			return badRequest(ctx, nil, errLeaseWithoutSender, v2.Log)
+		}
+	}
+	if params.NotePrefix != nil {
+		prefix, err := base64.StdEncoding.DecodeString(*params.NotePrefix)
+		if err != nil || len(prefix) > ledger.RecentTxnNotePrefixLength {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedToParseNotePrefix, ledger.RecentTxnNotePrefixLength), v2.Log)
 		}
+		query.NotePrefix = prefix
+	}
+	if query.Lease == [32]byte{} && len(query.NotePrefix) == 0 {
+		return badRequest(ctx, nil, errRecentTxnQueryEmpty, v2.Log)
+	}
+	limit := uint64(defaultAccountTransactionsLimit)
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit > maxAccountTransactionsLimit {
+		return badRequest(ctx, nil, fmt.Sprintf(errLimitTooLarge, maxAccountTransactionsLimit), v2.Log)
 	}
 
-	boxKeys, err := ledger.LookupKeysByPrefix(lastRound, keyPrefix, math.MaxUint64)
+	txns, err := v2.Node.LedgerForAPI().RecentTransactions(query, limit)
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	prefixLen := len(keyPrefix)
-	responseBoxes := make([]model.BoxDescriptor, len(boxKeys))
-	for i, boxKey := range boxKeys {
-		responseBoxes[i] = model.BoxDescriptor{
-			Name: []byte(boxKey[prefixLen:]),
+	response := model.RecentTransactionsResponse{
+		Since:        uint64(txns.Since),
+		Transactions: make([]model.RecentTransaction, len(txns.Txns)),
+	}
+	for i, txn := range txns.Txns {
+		response.Transactions[i] = model.RecentTransaction{
+			Round:            uint64(txn.Round),
+			IntraRoundOffset: txn.Intra,
+			Txid:             txn.TxID.String(),
+			Sender:           txn.Sender.String(),
+			LastValid:        uint64(txn.LastValid),
 		}
 	}
-	response := model.BoxesResponse{Boxes: responseBoxes}
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetApplicationBoxByName returns the value of an application's box
-// (GET /v2/applications/{application-id}/box)
-func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxByNameParams) error {
-	appIdx := basics.AppIndex(applicationID)
-	ledger := v2.Node.LedgerForAPI()
-	lastRound := ledger.Latest()
+// Bounds of the proposer report, which reads the certificate of every block of its range.
+const (
+	defaultProposerReportRounds = 1000
+	maxProposerReportRounds     = 10_000
+	defaultProposerReportLimit  = 100
+	maxProposerReportLimit      = 1000
+)
 
-	encodedBoxName := params.Name
-	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
-	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+// GetProposerReport compares the expected and actual block proposals of the top online accounts over a range of rounds.
+// (GET /v2/proposers/report)
+func (v2 *Handlers) GetProposerReport(ctx echo.Context, params model.GetProposerReportParams) error {
+	myLedger := v2.Node.LedgerForAPI()
+	maxRound := myLedger.Latest()
+	if params.MaxRound != nil {
+		maxRound = basics.Round(*params.MaxRound)
 	}
-	boxName, err := boxNameBytes.Raw()
-	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+	minRound := (maxRound + 1).SubSaturate(defaultProposerReportRounds)
+	if minRound == 0 {
+		minRound = 1
 	}
-
-	value, err := ledger.LookupKv(lastRound, apps.MakeBoxKey(uint64(appIdx), string(boxName)))
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	if params.MinRound != nil {
+		minRound = basics.Round(*params.MinRound)
 	}
-	if value == nil {
-		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
+	if minRound == 0 || minRound > maxRound {
+		return badRequest(ctx, nil, errInvalidProposerReportRange, v2.Log)
 	}
-
-	response := model.BoxResponse{
-		Round: uint64(lastRound),
-		Name:  boxName,
-		Value: value,
+	if maxRound > myLedger.Latest() {
+		return notFound(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
 	}
-	return ctx.JSON(http.StatusOK, response)
-}
-
-// GetAssetByID returns application information by app idx.
-// (GET /v2/assets/{asset-id})
-func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
-	assetIdx := basics.AssetIndex(assetID)
-	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	if maxRound-minRound >= maxProposerReportRounds {
+		return badRequest(ctx, nil, fmt.Sprintf(errProposerReportRangeTooLarge, maxProposerReportRounds), v2.Log)
 	}
-	if !ok {
-		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	limit := uint64(defaultProposerReportLimit)
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit > maxProposerReportLimit {
+		return badRequest(ctx, nil, fmt.Sprintf(errLimitTooLarge, maxProposerReportLimit), v2.Log)
 	}
 
-	lastRound := ledger.Latest()
-	record, err := ledger.LookupAsset(lastRound, creator, basics.AssetIndex(assetID))
+	report, err := myLedger.ProposerReport(minRound, maxRound, limit)
 	if err != nil {
+		var noEntry ledgercore.ErrNoEntry
+		if errors.As(err, &noEntry) {
+			return missingLedgerEntry(ctx, err, v2.Log)
+		}
+		var roundErr *ledger.RoundOffsetError
+		if errors.As(err, &roundErr) {
+			return notFound(ctx, err, errProposerReportStakeNotRetained, v2.Log)
+		}
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	if record.AssetParams == nil {
-		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	response := model.ProposerReportResponse{
+		MinRound: uint64(minRound),
+		MaxRound: uint64(maxRound),
+		Accounts: make([]model.ProposerStats, len(report)),
+	}
+	for i, stats := range report {
+		response.Accounts[i] = model.ProposerStats{
+			Address:           stats.Address.String(),
+			Stake:             stats.Stake.Raw,
+			Proposals:         stats.Proposals,
+			ExpectedProposals: stats.ExpectedProposals,
+		}
 	}
-	assetParams := *record.AssetParams
-	asset := AssetParamsToAsset(creator.String(), assetIdx, &assetParams)
-	response := model.AssetResponse(asset)
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -1596,6 +3699,46 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr strin
 	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), &addr)
 }
 
+// GetSenderAdvisory suggests lease values and partitions the pending transactions of a sender into non-conflicting batches.
+// (GET /v2/accounts/{address}/transactions/advisory)
+func (v2 *Handlers) GetSenderAdvisory(ctx echo.Context, address string, params model.GetSenderAdvisoryParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetSenderAdvisory failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	leaseCount := 1
+	if params.Leases != nil {
+		if *params.Leases < 1 || *params.Leases > maxSuggestedLeases {
+			return badRequest(ctx, nil, errInvalidLeaseCount, v2.Log)
+		}
+		leaseCount = int(*params.Leases)
+	}
+
+	txnPool, err := v2.Node.GetPendingTxnsFromPool()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+
+	batches, leasesInUse := adviseSender(addr, txnPool)
+	response := model.SenderAdvisoryResponse{
+		Round:           uint64(stat.LastRound),
+		SuggestedLeases: suggestLeases(leaseCount, leasesInUse),
+		LeasesInUse:     leasesInUse,
+		Batches:         batches,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // StartCatchup Given a catchpoint, it starts catching up to this catchpoint
 // (POST /v2/catchup/{catchpoint})
 func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string) error {
@@ -1608,6 +3751,12 @@ func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.abortCatchup(ctx, catchpoint)
 }
 
+// GetCatchupStatus Given a catchpoint, it returns the progress of the catchup to this catchpoint
+// (GET /v2/catchup/{catchpoint}/status)
+func (v2 *Handlers) GetCatchupStatus(ctx echo.Context, catchpoint string) error {
+	return v2.getCatchupStatus(ctx, catchpoint)
+}
+
 // CompileResponseWithSourceMap overrides the sourcemap field in
 // the CompileResponse for JSON marshalling.
 type CompileResponseWithSourceMap struct {
@@ -1620,7 +3769,7 @@ type CompileResponseWithSourceMap struct {
 func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams) (err error) {
 	// Return early if teal compile is not allowed in node config.
 	if !v2.Node.Config().EnableDeveloperAPI {
-		return ctx.String(http.StatusNotFound, "/teal/compile was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+		return writeErrorResponse(ctx, http.StatusNotFound, errCompileNotEnabled, nil)
 	}
 	if params.Sourcemap == nil {
 		// Backwards compatibility: set sourcemap flag to default false value.
@@ -1677,7 +3826,12 @@ func (v2 *Handlers) GetStateProof(ctx echo.Context, round uint64) error {
 		return v2.wrapStateproofError(ctx, err)
 	}
 
-	response := model.StateProofResponse{
+	response := stateProofModel(tx)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func stateProofModel(tx transactions.Transaction) model.StateProof {
+	response := model.StateProof{
 		StateProof: protocol.Encode(&tx.StateProof),
 	}
 
@@ -1686,8 +3840,7 @@ func (v2 *Handlers) GetStateProof(ctx echo.Context, round uint64) error {
 	response.Message.LnProvenWeight = tx.Message.LnProvenWeight
 	response.Message.FirstAttestedRound = tx.Message.FirstAttestedRound
 	response.Message.LastAttestedRound = tx.Message.LastAttestedRound
-
-	return ctx.JSON(http.StatusOK, response)
+	return response
 }
 
 func (v2 *Handlers) wrapStateproofError(ctx echo.Context, err error) error {
@@ -1715,27 +3868,157 @@ func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) err
 		return v2.wrapStateproofError(ctx, err)
 	}
 
+	response, err := lightBlockHeaderProof(ledger, stateProof, round)
+	if err != nil {
+		return v2.wrapLightBlockHeaderProofError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetLightBlockHeaderProofBundle returns the light block header for the given round
+// together with the state proof covering the round and a proof that the light block
+// header is part of that state proof's commitment.
+// (GET /v2/lightblockheaders/{round}/proof)
+func (v2 *Handlers) GetLightBlockHeaderProofBundle(ctx echo.Context, round uint64) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx.Request().Context(), time.Minute)
+	defer cancel()
+	ledger := v2.Node.LedgerForAPI()
+	if ledger.Latest() < basics.Round(round) {
+		return internalError(ctx, errors.New(errRoundGreaterThanTheLatest), errRoundGreaterThanTheLatest, v2.Log)
+	}
+
+	hdr, err := ledger.BlockHdr(basics.Round(round))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	stateProof, err := GetStateProofTransactionForRound(ctxWithTimeout, ledger, basics.Round(round), ledger.Latest(), v2.Shutdown)
+	if err != nil {
+		return v2.wrapStateproofError(ctx, err)
+	}
+
+	proof, err := lightBlockHeaderProof(ledger, stateProof, round)
+	if err != nil {
+		return v2.wrapLightBlockHeaderProofError(ctx, err)
+	}
+
+	lightHdr := hdr.ToLightBlockHeader()
+	response := model.LightBlockHeaderProofBundleResponse{
+		LightBlockHeader: model.LightBlockHeader{
+			Seed:                  lightHdr.Seed[:],
+			Round:                 uint64(lightHdr.Round),
+			GenesisHash:           lightHdr.GenesisHash[:],
+			TransactionCommitment: lightHdr.Sha256TxnCommitment,
+		},
+		LightBlockHeaderProof: proof,
+		StateProof:            stateProofModel(stateProof),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// lightHeadersError reports that the light block headers attested to by a state proof could not be fetched.
+type lightHeadersError struct {
+	err error
+}
+
+func (e lightHeadersError) Error() string {
+	return e.err.Error()
+}
+
+func (e lightHeadersError) Unwrap() error {
+	return e.err
+}
+
+// lightBlockHeaderProof proves that the light block header of the given round is
+// part of the block headers commitment attested to by stateProof.
+func lightBlockHeaderProof(ledger LedgerForAPI, stateProof transactions.Transaction, round uint64) (model.LightBlockHeaderProof, error) {
 	lastAttestedRound := stateProof.Message.LastAttestedRound
 	firstAttestedRound := stateProof.Message.FirstAttestedRound
 	stateProofInterval := lastAttestedRound - firstAttestedRound + 1
 
 	lightHeaders, err := stateproof.FetchLightHeaders(ledger, stateProofInterval, basics.Round(lastAttestedRound))
 	if err != nil {
-		return notFound(ctx, err, err.Error(), v2.Log)
+		return model.LightBlockHeaderProof{}, lightHeadersError{err: err}
 	}
 
 	blockIndex := round - firstAttestedRound
 	leafproof, err := stateproof.GenerateProofOfLightBlockHeaders(stateProofInterval, lightHeaders, blockIndex)
 	if err != nil {
-		return internalError(ctx, err, err.Error(), v2.Log)
+		return model.LightBlockHeaderProof{}, err
 	}
 
-	response := model.LightBlockHeaderProofResponse{
+	return model.LightBlockHeaderProof{
 		Index:     blockIndex,
 		Proof:     leafproof.GetConcatenatedProof(),
 		Treedepth: uint64(leafproof.TreeDepth),
+	}, nil
+}
+
+func (v2 *Handlers) wrapLightBlockHeaderProofError(ctx echo.Context, err error) error {
+	var headersErr lightHeadersError
+	if errors.As(err, &headersErr) {
+		return notFound(ctx, err, err.Error(), v2.Log)
 	}
-	return ctx.JSON(http.StatusOK, response)
+	return internalError(ctx, err, err.Error(), v2.Log)
+}
+
+// GetBlockFinality returns the block header for the given round together with its
+// certificate, the state proof covering the round and a proof that the block's light
+// header is part of that state proof's commitment.
+// (GET /v2/blocks/{round}/finality)
+func (v2 *Handlers) GetBlockFinality(ctx echo.Context, round uint64, params model.GetBlockFinalityParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx.Request().Context(), time.Minute)
+	defer cancel()
+	ledger := v2.Node.LedgerForAPI()
+	if ledger.Latest() < basics.Round(round) {
+		return internalError(ctx, errors.New(errRoundGreaterThanTheLatest), errRoundGreaterThanTheLatest, v2.Log)
+	}
+
+	block, cert, err := ledger.BlockCert(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return missingLedgerEntry(ctx, err, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	stateProof, err := GetStateProofTransactionForRound(ctxWithTimeout, ledger, basics.Round(round), ledger.Latest(), v2.Shutdown)
+	if err != nil {
+		return v2.wrapStateproofError(ctx, err)
+	}
+
+	lightHeaderProof, err := lightBlockHeaderProof(ledger, stateProof, round)
+	if err != nil {
+		return v2.wrapLightBlockHeaderProofError(ctx, err)
+	}
+
+	// The header and certificate are internal types, so the response is encoded
+	// with the requested codec instead of returning model.BlockFinalityResponse.
+	response := struct {
+		BlockHeader           bookkeeping.BlockHeader     `codec:"block-header"`
+		Cert                  agreement.Certificate       `codec:"cert"`
+		LightBlockHeaderProof model.LightBlockHeaderProof `codec:"light-block-header-proof"`
+		StateProof            model.StateProof            `codec:"state-proof"`
+	}{
+		BlockHeader:           block.BlockHeader,
+		Cert:                  cert,
+		LightBlockHeaderProof: lightHeaderProof,
+		StateProof:            stateProofModel(stateProof),
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
 // TealDisassemble disassembles the program bytecode in base64 into TEAL code.
@@ -1743,7 +4026,7 @@ func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) err
 func (v2 *Handlers) TealDisassemble(ctx echo.Context) error {
 	// return early if teal compile is not allowed in node config
 	if !v2.Node.Config().EnableDeveloperAPI {
-		return ctx.String(http.StatusNotFound, "/teal/disassemble was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+		return writeErrorResponse(ctx, http.StatusNotFound, errDisassembleNotEnabled, nil)
 	}
 	buf := new(bytes.Buffer)
 	ctx.Request().Body = http.MaxBytesReader(nil, ctx.Request().Body, MaxTealSourceBytes)
@@ -1762,6 +4045,38 @@ func (v2 *Handlers) TealDisassemble(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// RecognizeTealTemplate recognizes the TEAL template the program bytes are an instance of.
+// (POST /v2/teal/templates/recognize)
+func (v2 *Handlers) RecognizeTealTemplate(ctx echo.Context) error {
+	buf := new(bytes.Buffer)
+	ctx.Request().Body = http.MaxBytesReader(nil, ctx.Request().Body, MaxTealSourceBytes)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	match, err := templates.Recognize(buf.Bytes())
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if match == nil {
+		return writeErrorResponse(ctx, http.StatusNotFound, errProgramNotTemplate, nil)
+	}
+
+	response := model.TealTemplateResponse{
+		Template:    match.Template,
+		Description: match.Description,
+		Version:     match.Version,
+		Params:      make([]model.TealTemplateParameter, len(match.Params)),
+	}
+	for i, param := range match.Params {
+		response.Params[i] = model.TealTemplateParameter{Name: param.Name, Description: param.Description, Value: param.Value}
+	}
+	if len(match.Warnings) > 0 {
+		response.Warnings = &match.Warnings
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetLedgerStateDeltaForTransactionGroup retrieves the delta for a specified transaction group.
 // (GET /v2/deltas/txn/group/{id})
 func (v2 *Handlers) GetLedgerStateDeltaForTransactionGroup(ctx echo.Context, id string, params model.GetLedgerStateDeltaForTransactionGroupParams) error {
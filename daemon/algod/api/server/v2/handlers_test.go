@@ -235,6 +235,15 @@ func TestSimulateRequestStruct(t *testing.T) {
 		// type.
 		preEncodedTxPath(generatedResponseGraph).children = preEncodedTxPath(customResponseGraph).children
 	}
+	sourceMapPath := func(graph *tagNode) *tagNode {
+		// Resolve the field model.SimulateRequest{}.SourceMaps[0].Sourcemap
+		return graph.children["source-maps"].children["<value>"].children["sourcemap"]
+	}
+	if assert.Contains(t, sourceMapPath(generatedResponseGraph).children, "<map-key>") {
+		// The generated type holds a source map as a map, while the custom type
+		// uses logic.SourceMap. Let's copy that into the generated type too.
+		sourceMapPath(generatedResponseGraph).children = sourceMapPath(customResponseGraph).children
+	}
 
 	generatedResponseGraph.AssertEquals(t, customResponseGraph)
 }
@@ -0,0 +1,327 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/logging"
+)
+
+const (
+	// IdempotencyKeyHeader is the header a client names a transaction submission with, so that its retries
+	// return the original response.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// maxIdempotencyKeyLen matches the maxLength of the header in the spec.
+	maxIdempotencyKeyLen = 256
+	// SubmissionCacheFilename is the file of the data directory the responses to the transaction
+	// submissions made with an idempotency key are appended to, one JSON record per line.
+	SubmissionCacheFilename = "algod.submissions.jsonl"
+	// maxSubmissionResults bounds the number of responses kept for each client, dropping the oldest
+	// ones first.
+	maxSubmissionResults = 1000
+)
+
+// submissionResult is the outcome of a transaction submission made with an idempotency key. done is
+// closed once response is set, which is left nil when the submission failed.
+type submissionResult struct {
+	fingerprint crypto.Digest
+	expires     time.Time
+	done        chan struct{}
+	response    *model.PostTransactionsResponse
+}
+
+type submissionEntry struct {
+	key    string
+	result *submissionResult
+}
+
+// submissionScope holds the submissions of a client, so that it cannot evict the idempotency keys of
+// the others.
+type submissionScope struct {
+	results map[string]*submissionResult
+	// order lists the entries from the oldest to the newest, and may hold entries that were since
+	// removed from results.
+	order []submissionEntry
+}
+
+// submissionRecord is a successful submission, as written to the data directory.
+type submissionRecord struct {
+	Scope       string                         `json:"scope,omitempty"`
+	Key         string                         `json:"key"`
+	Fingerprint []byte                         `json:"fingerprint"`
+	Expires     time.Time                      `json:"expires"`
+	Response    model.PostTransactionsResponse `json:"response"`
+}
+
+// SubmissionCache remembers the responses to the transaction submissions made with an idempotency key,
+// so that a retried submission returns the original response instead of failing because its transactions
+// are already in the pool or the ledger. Failed submissions are forgotten so that they can be retried. The
+// successful ones are appended to a file, so that the retries still get them after a restart of the node.
+// The keys are scoped to the client, see clientID.
+// It is shared by all the routers of a server.
+type SubmissionCache struct {
+	mu     deadlock.Mutex
+	scopes map[string]*submissionScope
+
+	// fileMu serializes the writes to path, so that they are made without holding mu. lines counts
+	// the records of the file, which is compacted once it reaches compactAt.
+	fileMu    deadlock.Mutex
+	lines     int
+	compactAt int
+
+	path   string
+	window time.Duration
+	log    logging.Logger
+	now    func() time.Time
+}
+
+var errIdempotencyKeyConflict = errors.New("idempotency key was already used with different transactions")
+
+// MakeSubmissionCache creates a SubmissionCache keeping the responses for window, loading the ones
+// written to path by a previous run of the node.
+func MakeSubmissionCache(log logging.Logger, path string, window time.Duration) *SubmissionCache {
+	c := &SubmissionCache{
+		scopes:    make(map[string]*submissionScope),
+		compactAt: maxSubmissionResults,
+		path:      path,
+		window:    window,
+		log:       log,
+		now:       time.Now,
+	}
+	if err := c.load(); err != nil {
+		log.Warnf("Transaction submission responses could not be loaded from %s: %v", path, err)
+	}
+	return c
+}
+
+func (c *SubmissionCache) load() error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	now := c.now()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		c.lines++
+		var record submissionRecord
+		// a record cut short by a crash is skipped
+		if json.Unmarshal(line, &record) != nil {
+			continue
+		}
+		if !now.Before(record.Expires) || len(record.Fingerprint) != len(crypto.Digest{}) {
+			continue
+		}
+		result := &submissionResult{
+			expires:  record.Expires,
+			done:     make(chan struct{}),
+			response: &record.Response,
+		}
+		copy(result.fingerprint[:], record.Fingerprint)
+		close(result.done)
+		scope := c.scopeLocked(record.Scope)
+		scope.results[record.Key] = result
+		scope.order = append(scope.order, submissionEntry{key: record.Key, result: result})
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	for name, scope := range c.scopes {
+		c.pruneLocked(name, scope, now)
+	}
+	return nil
+}
+
+// scopeLocked returns the submissions of the named scope, creating it if needed.
+func (c *SubmissionCache) scopeLocked(name string) *submissionScope {
+	scope, ok := c.scopes[name]
+	if !ok {
+		scope = &submissionScope{results: make(map[string]*submissionResult)}
+		c.scopes[name] = scope
+	}
+	return scope
+}
+
+// begin looks up the result stored for key in the named scope. If there is none, it records a pending
+// result that the caller owns and must complete with finish. Otherwise the stored result is returned, and
+// callers wait on its done channel before reading it. Reusing a key for different transactions fails.
+func (c *SubmissionCache) begin(scopeName string, key string, fingerprint crypto.Digest) (result *submissionResult, owner bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if scope, ok := c.scopes[scopeName]; ok {
+		c.pruneLocked(scopeName, scope, now)
+	}
+	scope := c.scopeLocked(scopeName)
+
+	if result, ok := scope.results[key]; ok {
+		if result.fingerprint != fingerprint {
+			return nil, false, errIdempotencyKeyConflict
+		}
+		return result, false, nil
+	}
+
+	result = &submissionResult{
+		fingerprint: fingerprint,
+		expires:     now.Add(c.window),
+		done:        make(chan struct{}),
+	}
+	scope.results[key] = result
+	scope.order = append(scope.order, submissionEntry{key: key, result: result})
+	return result, true, nil
+}
+
+// finish completes a result returned by begin, forgetting it when the submission failed, and appending
+// it to the file of the data directory otherwise.
+func (c *SubmissionCache) finish(scopeName string, key string, result *submissionResult, response *model.PostTransactionsResponse) {
+	c.mu.Lock()
+	result.response = response
+	close(result.done)
+	if response == nil {
+		if scope, ok := c.scopes[scopeName]; ok && scope.results[key] == result {
+			delete(scope.results, key)
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+
+	record := submissionRecord{
+		Scope:       scopeName,
+		Key:         key,
+		Fingerprint: result.fingerprint[:],
+		Expires:     result.expires,
+		Response:    *response,
+	}
+	if err := c.append(record); err != nil {
+		c.log.Warnf("Transaction submission responses could not be written to %s: %v", c.path, err)
+	}
+}
+
+// pruneLocked drops the expired results of a scope, and the oldest ones when the scope is full. Scopes
+// left empty are removed.
+func (c *SubmissionCache) pruneLocked(name string, scope *submissionScope, now time.Time) {
+	for len(scope.order) > 0 {
+		entry := scope.order[0]
+		current := scope.results[entry.key] == entry.result
+		if current && now.Before(entry.result.expires) && len(scope.results) < maxSubmissionResults {
+			break
+		}
+		if current {
+			delete(scope.results, entry.key)
+		}
+		scope.order[0] = submissionEntry{}
+		scope.order = scope.order[1:]
+	}
+	if len(scope.results) == 0 {
+		delete(c.scopes, name)
+	}
+}
+
+// append writes a successful submission at the end of the file, compacting the file instead when it
+// holds too many records that were since dropped.
+func (c *SubmissionCache) append(record submissionRecord) error {
+	c.fileMu.Lock()
+	defer c.fileMu.Unlock()
+
+	if c.lines >= c.compactAt {
+		return c.compactLocked()
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(c.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	c.lines++
+	return nil
+}
+
+// compactLocked rewrites the file with the successful submissions that are still kept. It is called with
+// fileMu held.
+func (c *SubmissionCache) compactLocked() error {
+	var buf bytes.Buffer
+	lines := 0
+	c.mu.Lock()
+	now := c.now()
+	for name, scope := range c.scopes {
+		c.pruneLocked(name, scope, now)
+		for _, entry := range scope.order {
+			result := entry.result
+			if scope.results[entry.key] != result || result.response == nil {
+				continue
+			}
+			data, err := json.Marshal(submissionRecord{
+				Scope:       name,
+				Key:         entry.key,
+				Fingerprint: result.fingerprint[:],
+				Expires:     result.expires,
+				Response:    *result.response,
+			})
+			if err != nil {
+				c.mu.Unlock()
+				return err
+			}
+			buf.Write(data)
+			buf.WriteByte('\n')
+			lines++
+		}
+	}
+	c.mu.Unlock()
+
+	err := os.WriteFile(c.path+".tmp", buf.Bytes(), 0600)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(c.path+".tmp", c.path)
+	if err != nil {
+		return err
+	}
+	c.lines = lines
+	c.compactAt = 2*lines + maxSubmissionResults
+	return nil
+}
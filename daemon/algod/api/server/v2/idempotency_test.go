@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSubmissionCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), SubmissionCacheFilename)
+	c := MakeSubmissionCache(logging.TestingLog(t), path, time.Hour)
+	fp := crypto.Hash([]byte("txgroup"))
+
+	result, owner, err := c.begin("token", "key", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+
+	// a concurrent retry waits for the original submission
+	retry, owner, err := c.begin("token", "key", fp)
+	require.NoError(t, err)
+	require.False(t, owner)
+	require.Same(t, result, retry)
+
+	// a different submission cannot reuse the key
+	_, _, err = c.begin("token", "key", crypto.Hash([]byte("other")))
+	require.ErrorIs(t, err, errIdempotencyKeyConflict)
+
+	response := &model.PostTransactionsResponse{TxId: "TXID"}
+	c.finish("token", "key", result, response)
+	<-retry.done
+	require.Equal(t, response, retry.response)
+
+	// the successful submissions survive a restart
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+	reloaded := MakeSubmissionCache(logging.TestingLog(t), path, time.Hour)
+	result, owner, err = reloaded.begin("token", "key", fp)
+	require.NoError(t, err)
+	require.False(t, owner)
+	<-result.done
+	require.Equal(t, response, result.response)
+}
+
+func TestSubmissionCacheFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := MakeSubmissionCache(logging.TestingLog(t), filepath.Join(t.TempDir(), SubmissionCacheFilename), time.Hour)
+	fp := crypto.Hash([]byte("txgroup"))
+
+	result, owner, err := c.begin("token", "key", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+	c.finish("token", "key", result, nil)
+
+	// a failed submission is forgotten so that it can be retried, even with other transactions
+	_, owner, err = c.begin("token", "key", crypto.Hash([]byte("fixed")))
+	require.NoError(t, err)
+	require.True(t, owner)
+}
+
+func TestSubmissionCacheExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), SubmissionCacheFilename)
+	now := time.Now().Add(-time.Hour)
+	c := MakeSubmissionCache(logging.TestingLog(t), path, time.Minute)
+	c.now = func() time.Time { return now }
+	fp := crypto.Hash([]byte("txgroup"))
+
+	result, _, err := c.begin("token", "key", fp)
+	require.NoError(t, err)
+	c.finish("token", "key", result, &model.PostTransactionsResponse{TxId: "TXID"})
+
+	now = now.Add(time.Minute)
+	_, owner, err := c.begin("token", "key", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+
+	// the expired submissions are not loaded after a restart
+	reloaded := MakeSubmissionCache(logging.TestingLog(t), path, time.Minute)
+	require.Empty(t, reloaded.scopes)
+
+	// the oldest submissions are dropped when the cache is full
+	for i := 0; i <= maxSubmissionResults; i++ {
+		_, owner, err := reloaded.begin("token", fmt.Sprintf("key-%d", i), fp)
+		require.NoError(t, err)
+		require.True(t, owner)
+	}
+	require.Len(t, reloaded.scopes["token"].results, maxSubmissionResults)
+
+	// which does not evict the keys of the other tokens
+	_, owner, err = reloaded.begin("other", "key-0", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+	_, owner, err = reloaded.begin("token", fmt.Sprintf("key-%d", maxSubmissionResults), fp)
+	require.NoError(t, err)
+	require.False(t, owner)
+}
+
+func TestSubmissionCacheScopes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := MakeSubmissionCache(logging.TestingLog(t), filepath.Join(t.TempDir(), SubmissionCacheFilename), time.Hour)
+
+	result, owner, err := c.begin("token", "key", crypto.Hash([]byte("txgroup")))
+	require.NoError(t, err)
+	require.True(t, owner)
+	c.finish("token", "key", result, &model.PostTransactionsResponse{TxId: "TXID"})
+
+	// another token may use the same key for other transactions
+	_, owner, err = c.begin("other", "key", crypto.Hash([]byte("other")))
+	require.NoError(t, err)
+	require.True(t, owner)
+}
+
+func TestSubmissionCacheFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), SubmissionCacheFilename)
+	now := time.Now()
+	c := MakeSubmissionCache(logging.TestingLog(t), path, time.Minute)
+	c.now = func() time.Time { return now }
+	fp := crypto.Hash([]byte("txgroup"))
+
+	// each submission appends a record, and the file is compacted once most of them expired
+	for i := 0; i < 2*maxSubmissionResults; i++ {
+		if i%10 == 0 {
+			now = now.Add(time.Minute)
+		}
+		key := fmt.Sprintf("key-%d", i%10)
+		result, owner, err := c.begin("token", key, fp)
+		require.NoError(t, err)
+		require.True(t, owner)
+		c.finish("token", key, result, &model.PostTransactionsResponse{TxId: key})
+	}
+	require.Less(t, c.lines, 2*maxSubmissionResults)
+
+	// a record cut short is skipped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"scope":"token","key":"cut`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reloaded := MakeSubmissionCache(logging.TestingLog(t), path, time.Minute)
+	require.Len(t, reloaded.scopes["token"].results, 10)
+	result, owner, err := reloaded.begin("token", "key-3", fp)
+	require.NoError(t, err)
+	require.False(t, owner)
+	require.Equal(t, "key-3", result.response.TxId)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// onlinenessLedger is the part of the ledger the participation of an account is projected from.
+type onlinenessLedger interface {
+	Latest() basics.Round
+	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
+	LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error)
+	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
+}
+
+// onlinenessProjection projects the participation of an account in the agreement of the rounds after the latest
+// one. The votes of a round are weighted by the online stakes of its balance round, which lags it by the seed
+// lookback: the state of the account at the balance rounds up to the latest round is known, and its state at the
+// later ones is projected by assuming that its pending key registrations are committed in the next round, and that
+// nothing else changes it.
+type onlinenessProjection struct {
+	ledger onlinenessLedger
+	addr   basics.Address
+	proto  config.ConsensusParams
+	latest basics.Round
+
+	projected     basics.OnlineAccountData
+	pendingKeyreg *transactions.Txid
+}
+
+func makeOnlinenessProjection(l onlinenessLedger, addr basics.Address, pending []transactions.SignedTxn) (*onlinenessProjection, error) {
+	latest := l.Latest()
+	proto, err := l.ConsensusParams(latest)
+	if err != nil {
+		return nil, err
+	}
+	record, _, _, err := l.LookupLatest(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &onlinenessProjection{ledger: l, addr: addr, proto: proto, latest: latest}
+	for _, stxn := range pending {
+		if stxn.Txn.Type != protocol.KeyRegistrationTx || stxn.Txn.Sender != addr {
+			continue
+		}
+		record = projectKeyreg(record, stxn.Txn.KeyregTxnFields)
+		txid := stxn.ID()
+		p.pendingKeyreg = &txid
+	}
+	p.projected = record.OnlineAccountData()
+	return p, nil
+}
+
+// projectKeyreg registers the participation keys of a key registration the way apply.Keyreg does, leaving out
+// its validation.
+func projectKeyreg(record basics.AccountData, keyreg transactions.KeyregTxnFields) basics.AccountData {
+	if record.Status == basics.NotParticipating {
+		return record
+	}
+	record.VoteID = keyreg.VotePK
+	record.SelectionID = keyreg.SelectionPK
+	record.StateProofID = keyreg.StateProofPK
+	if (keyreg.VotePK == crypto.OneTimeSignatureVerifier{} || keyreg.SelectionPK == crypto.VRFVerifier{}) {
+		record.Status = basics.Offline
+		if keyreg.Nonparticipation {
+			record.Status = basics.NotParticipating
+		}
+		record.VoteFirstValid = 0
+		record.VoteLastValid = 0
+		record.VoteKeyDilution = 0
+	} else {
+		record.Status = basics.Online
+		record.VoteFirstValid = keyreg.VoteFirst
+		record.VoteLastValid = keyreg.VoteLast
+		record.VoteKeyDilution = keyreg.VoteKeyDilution
+	}
+	return record
+}
+
+// balanceRound returns the round whose online stakes weigh the votes of round r.
+func (p *onlinenessProjection) balanceRound(r basics.Round) basics.Round {
+	return agreement.BalanceRound(r, p.proto)
+}
+
+// onlineData returns the state of the account at the balance round of round r, and whether it is projected.
+func (p *onlinenessProjection) onlineData(r basics.Round) (basics.OnlineAccountData, bool, error) {
+	balanceRound := p.balanceRound(r)
+	if balanceRound > p.latest {
+		return p.projected, true, nil
+	}
+	data, err := p.ledger.LookupAgreement(balanceRound, p.addr)
+	return data, false, err
+}
+
+// eligible tells whether the account votes in round r, given its state at the balance round of r, the same way
+// the agreement does.
+func eligible(data basics.OnlineAccountData, r basics.Round) bool {
+	if (data.VoteID == crypto.OneTimeSignatureVerifier{}) || data.MicroAlgosWithRewards.IsZero() {
+		return false
+	}
+	return data.VoteFirstValid <= r && (data.VoteLastValid == 0 || r <= data.VoteLastValid)
+}
+
+// firstEligibleRound returns the first round after the latest one in which the account votes, if any.
+func (p *onlinenessProjection) firstEligibleRound() (basics.Round, bool, error) {
+	r := p.latest + 1
+	for ; p.balanceRound(r) <= p.latest; r++ {
+		data, _, err := p.onlineData(r)
+		if err != nil {
+			return 0, false, err
+		}
+		if eligible(data, r) {
+			return r, true, nil
+		}
+	}
+	// past r, the state of the account is projected and no longer changes
+	if p.projected.VoteFirstValid > r {
+		r = p.projected.VoteFirstValid
+	}
+	return r, eligible(p.projected, r), nil
+}
+
+// response returns the participation of the account in the agreement of round r.
+func (p *onlinenessProjection) response(r basics.Round) (model.AccountOnlinenessResponse, error) {
+	data, projected, err := p.onlineData(r)
+	if err != nil {
+		return model.AccountOnlinenessResponse{}, err
+	}
+	response := model.AccountOnlinenessResponse{
+		Address:      p.addr.String(),
+		Round:        uint64(r),
+		BalanceRound: uint64(p.balanceRound(r)),
+		LatestRound:  uint64(p.latest),
+		Projected:    projected,
+		Online:       (data.VoteID != crypto.OneTimeSignatureVerifier{}),
+		Eligible:     eligible(data, r),
+		VotingStake:  data.MicroAlgosWithRewards.Raw,
+	}
+	if response.Online {
+		response.VoteFirstValid = omitEmpty(uint64(data.VoteFirstValid))
+		response.VoteLastValid = omitEmpty(uint64(data.VoteLastValid))
+	}
+	if projected && p.pendingKeyreg != nil {
+		txid := p.pendingKeyreg.String()
+		response.PendingKeyreg = &txid
+	}
+	first, ok, err := p.firstEligibleRound()
+	if err != nil {
+		return model.AccountOnlinenessResponse{}, err
+	}
+	if ok {
+		response.FirstEligibleRound = omitEmpty(uint64(first))
+	}
+	return response, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// onlinenessTestLedger holds the state of a single account, which is online from the round onlineFrom on.
+type onlinenessTestLedger struct {
+	latest     basics.Round
+	onlineFrom basics.Round
+	record     basics.AccountData
+}
+
+func (l onlinenessTestLedger) Latest() basics.Round {
+	return l.latest
+}
+
+func (l onlinenessTestLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
+	return config.Consensus[protocol.ConsensusCurrentVersion], nil
+}
+
+func (l onlinenessTestLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	if l.onlineFrom == 0 || rnd < l.onlineFrom {
+		return basics.OnlineAccountData{}, nil
+	}
+	return l.record.OnlineAccountData(), nil
+}
+
+func (l onlinenessTestLedger) LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error) {
+	if l.onlineFrom == 0 || l.latest < l.onlineFrom {
+		record := l.record
+		record.Status = basics.Offline
+		return record, l.latest, record.MicroAlgos, nil
+	}
+	return l.record, l.latest, l.record.MicroAlgos, nil
+}
+
+func TestOnlinenessProjection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{0x01}
+	online := basics.AccountData{
+		Status:          basics.Online,
+		MicroAlgos:      basics.MicroAlgos{Raw: 1_000_000},
+		VoteID:          crypto.OneTimeSignatureVerifier{0x01},
+		SelectionID:     crypto.VRFVerifier{0x01},
+		VoteFirstValid:  1,
+		VoteLastValid:   5000,
+		VoteKeyDilution: 100,
+	}
+	keyreg := func(fields transactions.KeyregTxnFields) transactions.SignedTxn {
+		return transactions.SignedTxn{Txn: transactions.Transaction{
+			Type:            protocol.KeyRegistrationTx,
+			Header:          transactions.Header{Sender: addr},
+			KeyregTxnFields: fields,
+		}}
+	}
+	const latest = basics.Round(1000)
+	const lookback = 320
+
+	// an account online for long votes in the next rounds, until its keys expire
+	l := onlinenessTestLedger{latest: latest, onlineFrom: 1, record: online}
+	p, err := makeOnlinenessProjection(l, addr, nil)
+	require.NoError(t, err)
+	response, err := p.response(latest + 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(latest+1-lookback), response.BalanceRound)
+	require.False(t, response.Projected)
+	require.True(t, response.Online)
+	require.True(t, response.Eligible)
+	require.Equal(t, uint64(1_000_000), response.VotingStake)
+	require.Equal(t, uint64(latest+1), *response.FirstEligibleRound)
+	require.Nil(t, response.PendingKeyreg)
+	response, err = p.response(latest + lookback + 1)
+	require.NoError(t, err)
+	require.True(t, response.Projected)
+	require.True(t, response.Eligible)
+	response, err = p.response(5001)
+	require.NoError(t, err)
+	require.True(t, response.Online)
+	require.False(t, response.Eligible)
+
+	// an account which went online 100 rounds ago only votes once its balance round reaches that round
+	l = onlinenessTestLedger{latest: latest, onlineFrom: latest - 100, record: online}
+	p, err = makeOnlinenessProjection(l, addr, nil)
+	require.NoError(t, err)
+	response, err = p.response(latest + 1)
+	require.NoError(t, err)
+	require.False(t, response.Online)
+	require.False(t, response.Eligible)
+	require.Zero(t, response.VotingStake)
+	require.Equal(t, uint64(latest-100+lookback), *response.FirstEligibleRound)
+
+	// an offline account registering its keys votes once its balance round reaches the next round
+	l = onlinenessTestLedger{latest: latest, record: online}
+	stxn := keyreg(transactions.KeyregTxnFields{
+		VotePK:          crypto.OneTimeSignatureVerifier{0x02},
+		SelectionPK:     crypto.VRFVerifier{0x02},
+		VoteFirst:       latest + 1,
+		VoteLast:        latest + 2000,
+		VoteKeyDilution: 100,
+	})
+	p, err = makeOnlinenessProjection(l, addr, []transactions.SignedTxn{stxn})
+	require.NoError(t, err)
+	response, err = p.response(latest + 1)
+	require.NoError(t, err)
+	require.False(t, response.Eligible)
+	require.Nil(t, response.PendingKeyreg)
+	require.Equal(t, uint64(latest+1+lookback), *response.FirstEligibleRound)
+	response, err = p.response(latest + 1 + lookback)
+	require.NoError(t, err)
+	require.True(t, response.Projected)
+	require.True(t, response.Eligible)
+	require.Equal(t, uint64(latest+1), *response.VoteFirstValid)
+	require.Equal(t, uint64(latest+2000), *response.VoteLastValid)
+	require.Equal(t, stxn.ID().String(), *response.PendingKeyreg)
+
+	// an account without pending key registration never votes
+	p, err = makeOnlinenessProjection(l, addr, nil)
+	require.NoError(t, err)
+	response, err = p.response(latest + 1 + lookback)
+	require.NoError(t, err)
+	require.False(t, response.Eligible)
+	require.Nil(t, response.FirstEligibleRound)
+
+	// an online account going offline keeps voting until its balance round reaches the next round
+	l = onlinenessTestLedger{latest: latest, onlineFrom: 1, record: online}
+	p, err = makeOnlinenessProjection(l, addr, []transactions.SignedTxn{keyreg(transactions.KeyregTxnFields{})})
+	require.NoError(t, err)
+	response, err = p.response(latest + lookback)
+	require.NoError(t, err)
+	require.True(t, response.Eligible)
+	response, err = p.response(latest + lookback + 1)
+	require.NoError(t, err)
+	require.False(t, response.Online)
+	require.False(t, response.Eligible)
+	require.NotNil(t, response.PendingKeyreg)
+}
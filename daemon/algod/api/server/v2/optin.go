@@ -0,0 +1,286 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxOptInTargets bounds the number of assets and applications of a BuildOptInGroups request,
+// all of which are simulated before the response is sent.
+const maxOptInTargets = 256
+
+// optInBuilder builds the transactions opting an account in to, or closing it out of, assets
+// and applications, and tracks the minimum balance of the account after each of them.
+type optInBuilder struct {
+	ledger   LedgerForAPI
+	proto    config.ConsensusParams
+	rnd      basics.Round
+	addr     basics.Address
+	closeOut bool
+	record   ledgercore.AccountData
+
+	txns        []transactions.Transaction
+	minBalances []basics.MicroAlgos
+}
+
+func (b *optInBuilder) add(tx transactions.Transaction) {
+	b.txns = append(b.txns, tx)
+	b.minBalances = append(b.minBalances, b.record.MinBalance(&b.proto))
+}
+
+// asset adds the asset transfer opting the account in to, or closing it out of, an asset,
+// unless the account already is. It returns false if the asset does not exist.
+func (b *optInBuilder) asset(aidx basics.AssetIndex) (bool, error) {
+	creator, ok, err := b.ledger.GetCreator(basics.CreatableIndex(aidx), basics.AssetCreatable)
+	if err != nil || !ok {
+		return false, err
+	}
+	holding, err := b.ledger.LookupAsset(b.rnd, b.addr, aidx)
+	if err != nil {
+		return false, err
+	}
+	if (holding.AssetHolding != nil) != b.closeOut {
+		return true, nil
+	}
+
+	tx := transactions.Transaction{
+		Type: protocol.AssetTransferTx,
+		AssetTransferTxnFields: transactions.AssetTransferTxnFields{
+			XferAsset:     aidx,
+			AssetReceiver: b.addr,
+		},
+	}
+	if b.closeOut {
+		tx.AssetCloseTo = creator
+		b.record.TotalAssets--
+	} else {
+		b.record.TotalAssets++
+	}
+	b.add(tx)
+	return true, nil
+}
+
+// application adds the application call opting the account in to, or closing it out of, an
+// application, unless the account already is. It returns false if the application does not exist.
+func (b *optInBuilder) application(aidx basics.AppIndex) (bool, error) {
+	creator, ok, err := b.ledger.GetCreator(basics.CreatableIndex(aidx), basics.AppCreatable)
+	if err != nil || !ok {
+		return false, err
+	}
+	local, err := b.ledger.LookupApplication(b.rnd, b.addr, aidx)
+	if err != nil {
+		return false, err
+	}
+	if (local.AppLocalState != nil) != b.closeOut {
+		return true, nil
+	}
+	app, err := b.ledger.LookupApplication(b.rnd, creator, aidx)
+	if err != nil || app.AppParams == nil {
+		return false, err
+	}
+
+	tx := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: aidx,
+			OnCompletion:  transactions.OptInOC,
+		},
+	}
+	if b.closeOut {
+		tx.OnCompletion = transactions.CloseOutOC
+		b.record.TotalAppSchema = b.record.TotalAppSchema.SubSchema(app.AppParams.LocalStateSchema)
+		b.record.TotalAppLocalStates--
+	} else {
+		b.record.TotalAppSchema = b.record.TotalAppSchema.AddSchema(app.AppParams.LocalStateSchema)
+		b.record.TotalAppLocalStates++
+	}
+	b.add(tx)
+	return true, nil
+}
+
+// groups fills in the headers of the transactions and chunks them into groups of at most
+// MaxTxGroupSize transactions.
+func (b *optInBuilder) groups(fee basics.MicroAlgos, genesisID string, genesisHash crypto.Digest) [][]transactions.Transaction {
+	var groups [][]transactions.Transaction
+	for start := 0; start < len(b.txns); start += b.proto.MaxTxGroupSize {
+		end := start + b.proto.MaxTxGroupSize
+		if end > len(b.txns) {
+			end = len(b.txns)
+		}
+		group := b.txns[start:end]
+
+		var txGroup transactions.TxGroup
+		for i := range group {
+			group[i].Sender = b.addr
+			group[i].FirstValid = b.rnd
+			group[i].LastValid = b.rnd + basics.Round(b.proto.MaxTxnLife)
+			group[i].GenesisID = genesisID
+			group[i].GenesisHash = genesisHash
+			group[i].Fee = basics.MulAIntSaturate(fee, group[i].EstimateEncodedSize())
+			if group[i].Fee.Raw < b.proto.MinTxnFee {
+				group[i].Fee.Raw = b.proto.MinTxnFee
+			}
+			txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.Digest(group[i].ID()))
+		}
+		gid := crypto.HashObj(txGroup)
+		for i := range group {
+			group[i].Group = gid
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// BuildOptInGroups builds and prevalidates the transaction groups opting an account in to or out of assets and applications.
+// (POST /v2/accounts/{address}/opt-in)
+func (v2 *Handlers) BuildOptInGroups(ctx echo.Context, address string) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return rejectRequestBody(ctx, err, v2.Log)
+	}
+	var request model.OptInRequest
+	err = decode(protocol.JSONStrictHandle, data, &request)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	assets := nilToZero(request.Assets)
+	apps := nilToZero(request.Applications)
+	if len(assets)+len(apps) > maxOptInTargets {
+		return badRequest(ctx, nil, fmt.Sprintf(errTooManyOptInTargets, maxOptInTargets), v2.Log)
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("BuildOptInGroups failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	ledger := v2.Node.LedgerForAPI()
+	b := optInBuilder{
+		ledger:   ledger,
+		proto:    config.Consensus[stat.LastVersion],
+		rnd:      ledger.Latest(),
+		addr:     addr,
+		closeOut: nilToZero(request.CloseOut),
+	}
+	b.record, _, _, err = ledger.LookupAccount(b.rnd, addr)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	balance := b.record.MicroAlgos
+
+	seenAssets := make(map[uint64]bool, len(assets))
+	for _, aidx := range assets {
+		if seenAssets[aidx] {
+			continue
+		}
+		seenAssets[aidx] = true
+		exists, err := b.asset(basics.AssetIndex(aidx))
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		if !exists {
+			return notFound(ctx, fmt.Errorf("asset %d does not exist", aidx), errAssetDoesNotExist, v2.Log)
+		}
+	}
+	seenApps := make(map[uint64]bool, len(apps))
+	for _, aidx := range apps {
+		if seenApps[aidx] {
+			continue
+		}
+		seenApps[aidx] = true
+		exists, err := b.application(basics.AppIndex(aidx))
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		if !exists {
+			return notFound(ctx, fmt.Errorf("application %d does not exist", aidx), errAppDoesNotExist, v2.Log)
+		}
+	}
+
+	groups := b.groups(v2.Node.SuggestedFee(), v2.Node.GenesisID(), v2.Node.GenesisHash())
+	response := model.OptInResponse{
+		Round:      uint64(b.rnd),
+		TxnGroups:  make([]model.OptInTransactionGroup, len(groups)),
+		MinBalance: b.record.MinBalance(&b.proto).Raw,
+	}
+
+	// The simulator evaluates a single group at a time, on top of the latest round, so the groups
+	// do not see the effects of the previous ones. The minimum balance the account must keep as
+	// the groups are applied one after the other is checked here instead.
+	var failure string
+	var last int
+	var fees basics.MicroAlgos
+	for i, group := range groups {
+		response.TxnGroups[i].Txns = make([][]byte, len(group))
+		stxns := make([]transactions.SignedTxn, len(group))
+		for j := range group {
+			response.TxnGroups[i].Txns[j] = protocol.Encode(&group[j])
+			stxns[j].Txn = group[j]
+			fees, _ = basics.OAddA(fees, group[j].Fee)
+		}
+		last += len(group)
+		if failure != "" {
+			continue
+		}
+
+		result, err := v2.Node.Simulate(simulation.Request{
+			TxnGroups:            [][]transactions.SignedTxn{stxns},
+			AllowEmptySignatures: true,
+		})
+		if err != nil {
+			var invalidTxErr simulation.InvalidRequestError
+			if errors.As(err, &invalidTxErr) {
+				return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+			}
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+		failure = result.TxnGroups[0].FailureMessage
+		required, _ := basics.OAddA(b.minBalances[last-1], fees)
+		if failure == "" && balance.LessThan(required) {
+			failure = fmt.Sprintf("account %v balance %d does not cover fees of %d and min balance of %d", addr, balance.Raw, fees.Raw, b.minBalances[last-1].Raw)
+		}
+		if failure != "" {
+			failedGroup := uint64(i)
+			response.FailedGroup = &failedGroup
+			response.FailureMessage = &failure
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// minSubmissionValidRounds is the number of rounds after the latest round below which the last
+// valid round of a submitted transaction is considered too close: the transaction may expire
+// before it makes it into a block.
+const minSubmissionValidRounds = 10
+
+// Submission warning codes.
+const (
+	warningLastValidTooClose             = "last-valid-too-close"
+	warningFeeAtMinimumDuringCongestion  = "fee-at-minimum-during-congestion"
+	warningMissingLeaseOnRepeatedPayment = "missing-lease-on-repeated-payment"
+)
+
+// paymentPattern identifies payments which are likely to be repeats of each other.
+type paymentPattern struct {
+	sender   basics.Address
+	receiver basics.Address
+	amount   uint64
+}
+
+func leaselessPaymentPattern(txn transactions.Transaction) (paymentPattern, bool) {
+	if txn.Type != protocol.PaymentTx || txn.Lease != [32]byte{} || !txn.CloseRemainderTo.IsZero() {
+		return paymentPattern{}, false
+	}
+	return paymentPattern{sender: txn.Sender, receiver: txn.Receiver, amount: txn.Amount.Raw}, true
+}
+
+// lintTxGroup returns warnings about the transactions of a group which are valid, but likely to
+// behave in a way the submitter did not intend. latest is the latest round of the node, suggestedFee
+// the fee per byte the transaction pool currently requires, which is zero unless it is congested,
+// and pending the transactions waiting in the pool.
+func lintTxGroup(txgroup []transactions.SignedTxn, latest basics.Round, proto config.ConsensusParams, suggestedFee basics.MicroAlgos, pending []transactions.SignedTxn) []model.SubmissionWarning {
+	var warnings []model.SubmissionWarning
+	warn := func(index int, code string, format string, args ...interface{}) {
+		warnings = append(warnings, model.SubmissionWarning{Code: code, Index: uint64(index), Message: fmt.Sprintf(format, args...)})
+	}
+
+	var groupFee uint64
+	for _, stxn := range txgroup {
+		groupFee = basics.AddSaturate(groupFee, stxn.Txn.Fee.Raw)
+	}
+	if suggestedFee.Raw > 0 && groupFee <= basics.MulSaturate(proto.MinTxnFee, uint64(len(txgroup))) {
+		warn(0, warningFeeAtMinimumDuringCongestion, "the group pays the minimum fee while the transaction pool is congested, it may be delayed or dropped")
+	}
+
+	pendingPayments := make(map[paymentPattern]bool)
+	for _, stxn := range pending {
+		if pattern, ok := leaselessPaymentPattern(stxn.Txn); ok {
+			pendingPayments[pattern] = true
+		}
+	}
+
+	for i, stxn := range txgroup {
+		if stxn.Txn.LastValid >= latest && stxn.Txn.LastValid-latest < minSubmissionValidRounds {
+			warn(i, warningLastValidTooClose, "last valid round %d is only %d rounds after the latest round %d", stxn.Txn.LastValid, stxn.Txn.LastValid-latest, latest)
+		}
+		if pattern, ok := leaselessPaymentPattern(stxn.Txn); ok {
+			if pendingPayments[pattern] {
+				warn(i, warningMissingLeaseOnRepeatedPayment, "an identical payment of %d from %s to %s is already pending, set a lease to prevent paying twice", pattern.amount, pattern.sender, pattern.receiver)
+			}
+			pendingPayments[pattern] = true
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLintTxGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	sender := basics.Address{0x01}
+	receiver := basics.Address{0x02}
+	payment := func(amount uint64, lastValid basics.Round) transactions.SignedTxn {
+		return transactions.SignedTxn{Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 2 * proto.MinTxnFee}, LastValid: lastValid},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver, Amount: basics.MicroAlgos{Raw: amount}},
+		}}
+	}
+	codes := func(warnings []model.SubmissionWarning) (codes []string) {
+		for _, w := range warnings {
+			codes = append(codes, w.Code)
+		}
+		return
+	}
+
+	// a well formed payment raises no warning
+	require.Empty(t, lintTxGroup([]transactions.SignedTxn{payment(1, 1100)}, 100, proto, basics.MicroAlgos{}, nil))
+
+	// last valid round too close to the latest round
+	warnings := lintTxGroup([]transactions.SignedTxn{payment(1, 105)}, 100, proto, basics.MicroAlgos{}, nil)
+	require.Equal(t, []string{warningLastValidTooClose}, codes(warnings))
+	require.Equal(t, uint64(0), warnings[0].Index)
+
+	// minimum fee is only a problem while the pool is congested
+	cheap := payment(1, 1100)
+	cheap.Txn.Fee = basics.MicroAlgos{Raw: proto.MinTxnFee}
+	require.Empty(t, lintTxGroup([]transactions.SignedTxn{cheap}, 100, proto, basics.MicroAlgos{}, nil))
+	warnings = lintTxGroup([]transactions.SignedTxn{cheap}, 100, proto, basics.MicroAlgos{Raw: 1}, nil)
+	require.Equal(t, []string{warningFeeAtMinimumDuringCongestion}, codes(warnings))
+
+	// repeated payments without a lease, either pending or in the same group
+	warnings = lintTxGroup([]transactions.SignedTxn{payment(1, 1100)}, 100, proto, basics.MicroAlgos{}, []transactions.SignedTxn{payment(1, 1000)})
+	require.Equal(t, []string{warningMissingLeaseOnRepeatedPayment}, codes(warnings))
+	warnings = lintTxGroup([]transactions.SignedTxn{payment(1, 1100), payment(2, 1100), payment(1, 1100)}, 100, proto, basics.MicroAlgos{}, nil)
+	require.Equal(t, []string{warningMissingLeaseOnRepeatedPayment}, codes(warnings))
+	require.Equal(t, uint64(2), warnings[0].Index)
+
+	// a lease marks the repetition as intended
+	leased := payment(1, 1100)
+	leased.Txn.Lease = [32]byte{0x01}
+	require.Empty(t, lintTxGroup([]transactions.SignedTxn{leased}, 100, proto, basics.MicroAlgos{}, []transactions.SignedTxn{payment(1, 1000)}))
+}
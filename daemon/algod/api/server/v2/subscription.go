@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// BlockSubscriptionKeepAlive is the interval at which a comment line is sent to block
+// subscribers while no block gets committed, so that dead connections are noticed.
+var BlockSubscriptionKeepAlive = 15 * time.Second
+
+// blockSubscriptionWriteTimeout bounds the time spent sending a single event to a subscriber.
+// It replaces the write timeout of the server, which would otherwise end every stream.
+const blockSubscriptionWriteTimeout = 30 * time.Second
+
+// blockEvent is the data of a block event streamed by SubscribeBlocks.
+type blockEvent struct {
+	Block  bookkeeping.Block      `codec:"block"`
+	Deltas *ledgercore.StateDelta `codec:"deltas,omitempty"`
+}
+
+// blockSubscription writes the events of a block stream.
+type blockSubscription struct {
+	response   *echo.Response
+	controller *http.ResponseController
+	handle     codec.Handle
+	headerOnly bool
+	deltas     bool
+}
+
+func (s *blockSubscription) event(ledger LedgerForAPI, rnd basics.Round) ([]byte, error) {
+	var ev blockEvent
+	var err error
+	ev.Block, err = ledger.Block(rnd)
+	if err != nil {
+		return nil, err
+	}
+	if s.headerOnly {
+		ev.Block.Payset = nil
+	}
+	if s.deltas {
+		delta, err := ledger.GetStateDeltaForRound(rnd)
+		if err != nil {
+			return nil, fmt.Errorf(errFailedRetrievingStateDelta, err)
+		}
+		ev.Deltas = &delta
+	}
+	data, err := encode(s.handle, ev)
+	if err != nil {
+		return nil, err
+	}
+	if s.handle == protocol.CodecHandle {
+		data = []byte(base64.StdEncoding.EncodeToString(data))
+	}
+	return data, nil
+}
+
+// write sends raw to the subscriber right away.
+func (s *blockSubscription) write(raw string) error {
+	// the controller isn't able to set deadlines on every writer, e.g. on test recorders.
+	_ = s.controller.SetWriteDeadline(time.Now().Add(blockSubscriptionWriteTimeout))
+	if _, err := io.WriteString(s.response, raw); err != nil {
+		return err
+	}
+	s.response.Flush()
+	return nil
+}
+
+func (s *blockSubscription) writeEvent(name, id string, data []byte) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "event: %s\n", name)
+	if id != "" {
+		fmt.Fprintf(&sb, "id: %s\n", id)
+	}
+	// multi-line data, such as indented JSON, is sent as one data field per line.
+	for _, line := range strings.Split(string(data), "\n") {
+		fmt.Fprintf(&sb, "data: %s\n", line)
+	}
+	sb.WriteString("\n")
+	return s.write(sb.String())
+}
+
+// SubscribeBlocks streams the blocks committed by the node as server-sent events.
+// (GET /v2/blocks/subscribe)
+func (v2 *Handlers) SubscribeBlocks(ctx echo.Context, params model.SubscribeBlocksParams) error {
+	handle, _, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SubscribeBlocks failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	next := ledger.Latest() + 1
+	if params.MinRound != nil {
+		next = basics.Round(*params.MinRound)
+	}
+	sub := blockSubscription{
+		response:   ctx.Response(),
+		controller: http.NewResponseController(ctx.Response().Writer),
+		handle:     handle,
+		headerOnly: params.HeaderOnly != nil && *params.HeaderOnly,
+		deltas:     params.Deltas != nil && *params.Deltas,
+	}
+
+	// Report a starting round that can't be served before committing to the stream.
+	if next <= ledger.Latest() {
+		if _, err := ledger.BlockHdr(next); err != nil {
+			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		if sub.deltas {
+			if _, err := ledger.GetStateDeltaForRound(next); err != nil {
+				return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStateDelta, err), v2.Log)
+			}
+		}
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	ctx.Response().WriteHeader(http.StatusOK)
+	if err := sub.write(": subscribed\n\n"); err != nil {
+		return nil
+	}
+
+	keepAlive := time.NewTicker(BlockSubscriptionKeepAlive)
+	defer keepAlive.Stop()
+	closed := ctx.Request().Context().Done()
+	for {
+		for ; next <= ledger.Latest(); next++ {
+			data, err := sub.event(ledger, next)
+			if err != nil {
+				v2.Log.Infof("SubscribeBlocks: unable to stream round %d: %v", next, err)
+				// the stream ends either way.
+				_ = sub.writeEvent("error", "", []byte(err.Error()))
+				return nil
+			}
+			if err := sub.writeEvent("block", strconv.FormatUint(uint64(next), 10), data); err != nil {
+				// the subscriber went away.
+				return nil
+			}
+		}
+
+		select {
+		case <-v2.Shutdown:
+			return nil
+		case <-closed:
+			return nil
+		case <-keepAlive.C:
+			if err := sub.write(": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		case <-ledger.Wait(next):
+		}
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -36,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -50,6 +53,9 @@ type mockLedger struct {
 	latest   basics.Round
 	blocks   []bookkeeping.Block
 	tracer   logic.EvalTracer
+
+	// accountsSince is the earliest round the accounts can be looked up at.
+	accountsSince basics.Round
 }
 
 func (l *mockLedger) GetTracer() logic.EvalTracer {
@@ -61,13 +67,62 @@ func (l *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDe
 	return args.Get(0).(ledgercore.StateDelta), args.Error(1)
 }
 
+func (l *mockLedger) GetCatchpointLabel(round basics.Round) (string, error) {
+	args := l.Called(round)
+	return args.String(0), args.Error(1)
+}
+
+func (l *mockLedger) AssetComplianceEvents(asset basics.AssetIndex, target basics.Address, minRound, maxRound basics.Round) ([]ledgercore.AssetComplianceEvent, error) {
+	args := l.Called(asset, target, minRound, maxRound)
+	return args.Get(0).([]ledgercore.AssetComplianceEvent), args.Error(1)
+}
+
+func (l *mockLedger) BoxHistory(app basics.AppIndex, name string) (ledgercore.BoxHistory, error) {
+	args := l.Called(app, name)
+	return args.Get(0).(ledgercore.BoxHistory), args.Error(1)
+}
+
+func (l *mockLedger) AccountTransactions(addr basics.Address, minRound, maxRound basics.Round, limit uint64) (ledgercore.AccountTxns, error) {
+	args := l.Called(addr, minRound, maxRound, limit)
+	return args.Get(0).(ledgercore.AccountTxns), args.Error(1)
+}
+
+func (l *mockLedger) AppEvents(app basics.AppIndex, selector *ledgercore.AppEventSelector, minRound, maxRound basics.Round, limit uint64) (ledgercore.AppEvents, error) {
+	args := l.Called(app, selector, minRound, maxRound, limit)
+	return args.Get(0).(ledgercore.AppEvents), args.Error(1)
+}
+
+func (l *mockLedger) SubscribeKvDeltas(prefix string) *ledger.KvDeltaSubscription {
+	panic("not implemented")
+}
+
+func (l *mockLedger) RecentTransactions(query ledgercore.RecentTxnQuery, limit uint64) (ledgercore.RecentTxns, error) {
+	args := l.Called(query, limit)
+	return args.Get(0).(ledgercore.RecentTxns), args.Error(1)
+}
+
+func (l *mockLedger) ProposerReport(minRound, maxRound basics.Round, n uint64) ([]ledgercore.ProposerStats, error) {
+	args := l.Called(minRound, maxRound, n)
+	return args.Get(0).([]ledgercore.ProposerStats), args.Error(1)
+}
+
 func (l *mockLedger) LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	if round < l.accountsSince {
+		return ledgercore.AccountData{}, 0, basics.MicroAlgos{}, &ledger.RoundOffsetError{}
+	}
 	ad, ok := l.accounts[addr]
 	if !ok { // return empty / not found
 		return ledgercore.AccountData{}, l.latest, basics.MicroAlgos{Raw: 0}, nil
 	}
 	return ledgercore.ToAccountData(ad), l.latest, basics.MicroAlgos{Raw: 0}, nil
 }
+func (l *mockLedger) LookupAccountChanges(addr basics.Address, from basics.Round, to basics.Round) (ledgercore.AccountChanges, error) {
+	if from < l.accountsSince {
+		return ledgercore.AccountChanges{}, &ledger.RoundOffsetError{}
+	}
+	args := l.Called(addr, from, to)
+	return args.Get(0).(ledgercore.AccountChanges), args.Error(1)
+}
 func (l *mockLedger) LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error) {
 	ad, ok := l.accounts[addr]
 	if !ok {
@@ -75,6 +130,9 @@ func (l *mockLedger) LookupLatest(addr basics.Address) (basics.AccountData, basi
 	}
 	return ad, l.latest, basics.MicroAlgos{Raw: 0}, nil
 }
+func (l *mockLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	return l.accounts[addr].OnlineAccountData(), nil
+}
 
 func (l *mockLedger) LookupKv(round basics.Round, key string) ([]byte, error) {
 	if value, ok := l.kvstore[key]; ok {
@@ -87,6 +145,20 @@ func (l *mockLedger) LookupKeysByPrefix(round basics.Round, keyPrefix string, ma
 	panic("not implemented")
 }
 
+func (l *mockLedger) LookupKeysByRange(round basics.Round, keyPrefix string, from string, maxKeyNum uint64) ([]string, error) {
+	var keys []string
+	for key := range l.kvstore {
+		if strings.HasPrefix(key, keyPrefix) && key >= from {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if uint64(len(keys)) > maxKeyNum {
+		keys = keys[:maxKeyNum]
+	}
+	return keys, nil
+}
+
 func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	return config.Consensus[protocol.ConsensusFuture], nil
 }
@@ -136,7 +208,15 @@ func (l *mockLedger) Wait(r basics.Round) chan struct{} {
 	panic("not implemented")
 }
 func (l *mockLedger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
-	panic("not implemented")
+	if ctype != basics.AssetCreatable {
+		panic("not implemented")
+	}
+	for addr, ad := range l.accounts {
+		if _, ok := ad.AssetParams[basics.AssetIndex(cidx)]; ok {
+			return addr, true, nil
+		}
+	}
+	return basics.Address{}, false, nil
 }
 func (l *mockLedger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
 	panic("not implemented")
@@ -398,3 +478,36 @@ func TestAccountInformationResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountInformationAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handlers, addr, acctData := setupTestForLargeResources(t, 10, 0, randomAccountWithResources)
+	handlers.Node.LedgerForAPI().(*mockLedger).accountsSince = 5
+
+	accountInformation := func(rnd uint64) (int, model.Account) {
+		ctx, rec := newReq(t)
+		err := handlers.AccountInformation(ctx, addr.String(), model.AccountInformationParams{Round: &rnd})
+		require.NoError(t, err)
+		var ret model.Account
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+		}
+		return rec.Code, ret
+	}
+
+	// the resources are not returned for a given round
+	code, ret := accountInformation(7)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint64(7), ret.Round)
+	require.Equal(t, acctData.MicroAlgos.Raw, ret.Amount)
+	require.Equal(t, uint64(len(acctData.Assets)), ret.TotalAssetsOptedIn)
+	require.Nil(t, ret.Assets)
+	require.Nil(t, ret.AppsLocalState)
+
+	code, _ = accountInformation(4)
+	require.Equal(t, http.StatusNotFound, code)
+
+	code, _ = accountInformation(11)
+	require.Equal(t, http.StatusBadRequest, code)
+}
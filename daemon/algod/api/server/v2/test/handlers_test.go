@@ -19,6 +19,9 @@ package test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,16 +30,22 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"golang.org/x/exp/slices"
 
+	"github.com/algorand/avm-abi/apps"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -56,6 +65,7 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/stateproofmsg"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -63,11 +73,17 @@ import (
 	"github.com/algorand/go-algorand/data/txntest"
 	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/webhooks"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 const stateProofInterval = uint64(256)
@@ -156,6 +172,53 @@ func TestGetBlock(t *testing.T) {
 	getBlockTest(t, 0, "bad format", 400)
 }
 
+func TestGetBlockRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getBlockRange := func(t *testing.T, first, last uint64, format string) *httptest.ResponseRecorder {
+		handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+		defer releasefunc()
+		insertRounds(require.New(t), handler, 3)
+		params := model.GetBlockRangeParams{First: first, Last: last}
+		if format != "" {
+			params.Format = (*model.GetBlockRangeParamsFormat)(&format)
+		}
+		err := handler.GetBlockRange(c, params)
+		require.NoError(t, err)
+		return rec
+	}
+
+	t.Run("range", func(t *testing.T) {
+		// the range is cut at the latest round
+		rec := getBlockRange(t, 1, 10, "msgpack")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "block-range-v1", rec.Header().Get("X-Algorand-Struct"))
+		var rounds []basics.Round
+		err := rpcs.DecodeBlockRange(rec.Body, func(rnd basics.Round, encoded []byte) error {
+			var blockCert rpcs.EncodedBlockCert
+			require.NoError(t, protocol.DecodeReflect(encoded, &blockCert))
+			require.Equal(t, rnd, blockCert.Block.Round())
+			rounds = append(rounds, rnd)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []basics.Round{1, 2, 3}, rounds)
+	})
+	t.Run("missing", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, getBlockRange(t, 4, 5, "").Code)
+	})
+	t.Run("reversed", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, getBlockRange(t, 2, 1, "").Code)
+	})
+	t.Run("too-large", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, getBlockRange(t, 0, rpcs.MaxBlockRangeLength, "").Code)
+	})
+	t.Run("json", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, getBlockRange(t, 1, 2, "json").Code)
+	})
+}
+
 func testGetLedgerStateDelta(t *testing.T, round uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -194,6 +257,216 @@ func TestGetLedgerStateDelta(t *testing.T) {
 
 }
 
+type streamedEvent struct {
+	name string
+	id   string
+	data string
+}
+
+// parseEventStream splits a server-sent event stream into its events, skipping comment lines.
+func parseEventStream(body string) []streamedEvent {
+	var events []streamedEvent
+	for _, chunk := range strings.Split(body, "\n\n") {
+		var ev streamedEvent
+		for _, line := range strings.Split(chunk, "\n") {
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				ev.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "id: "):
+				ev.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				if ev.data != "" {
+					ev.data += "\n"
+				}
+				ev.data += strings.TrimPrefix(line, "data: ")
+			}
+		}
+		if ev.name != "" {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	subscribe := func(t *testing.T, params model.SubscribeBlocksParams) *httptest.ResponseRecorder {
+		// the stream ends once the blocks committed so far were sent, as the node is shutting down.
+		shutdown := make(chan struct{})
+		close(shutdown)
+		handler, c, rec, _, _, releasefunc := setupMockNodeForMethodGetWithShutdown(t, cannedStatusReportGolden, false, shutdown)
+		defer releasefunc()
+		insertRounds(require.New(t), handler, 3)
+		require.NoError(t, handler.SubscribeBlocks(c, params))
+		return rec
+	}
+	round := func(r uint64) *uint64 { return &r }
+	yes := true
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		rec := subscribe(t, model.SubscribeBlocksParams{MinRound: round(1), Deltas: &yes})
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+		events := parseEventStream(rec.Body.String())
+		require.Len(t, events, 3)
+		for i, ev := range events {
+			require.Equal(t, "block", ev.name)
+			require.Equal(t, fmt.Sprint(i+1), ev.id)
+			var decoded struct {
+				Block  bookkeeping.Block      `codec:"block"`
+				Deltas *ledgercore.StateDelta `codec:"deltas"`
+			}
+			require.NoError(t, protocol.DecodeJSON([]byte(ev.data), &decoded))
+			require.Equal(t, basics.Round(i+1), decoded.Block.Round())
+			require.NotNil(t, decoded.Deltas)
+		}
+	})
+	t.Run("msgpack-header-only", func(t *testing.T) {
+		t.Parallel()
+		format := model.SubscribeBlocksParamsFormatMsgpack
+		rec := subscribe(t, model.SubscribeBlocksParams{MinRound: round(0), Format: &format, HeaderOnly: &yes})
+		require.Equal(t, http.StatusOK, rec.Code)
+		events := parseEventStream(rec.Body.String())
+		require.Len(t, events, 4)
+		for i, ev := range events {
+			require.Equal(t, fmt.Sprint(i), ev.id)
+			raw, err := base64.StdEncoding.DecodeString(ev.data)
+			require.NoError(t, err)
+			var decoded struct {
+				Block  bookkeeping.Block      `codec:"block"`
+				Deltas *ledgercore.StateDelta `codec:"deltas"`
+			}
+			require.NoError(t, protocol.DecodeReflect(raw, &decoded))
+			require.Equal(t, basics.Round(i), decoded.Block.Round())
+			require.Empty(t, decoded.Block.Payset)
+			require.Nil(t, decoded.Deltas)
+		}
+	})
+	t.Run("next-round", func(t *testing.T) {
+		t.Parallel()
+		rec := subscribe(t, model.SubscribeBlocksParams{})
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, parseEventStream(rec.Body.String()))
+	})
+	t.Run("delta-404", func(t *testing.T) {
+		t.Parallel()
+		rec := subscribe(t, model.SubscribeBlocksParams{MinRound: round(0), Deltas: &yes})
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+	t.Run("format-400", func(t *testing.T) {
+		t.Parallel()
+		format := model.SubscribeBlocksParamsFormat("bad format")
+		rec := subscribe(t, model.SubscribeBlocksParams{Format: &format})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// streamRecorder is a response writer recording a stream, and notifying every write to it.
+type streamRecorder struct {
+	header http.Header
+	mu     sync.Mutex
+	body   strings.Builder
+	writes chan struct{}
+}
+
+func (r *streamRecorder) Header() http.Header { return r.header }
+func (r *streamRecorder) WriteHeader(int)     {}
+func (r *streamRecorder) Flush()              {}
+func (r *streamRecorder) Write(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer func() {
+		select {
+		case r.writes <- struct{}{}:
+		default:
+		}
+	}()
+	return r.body.Write(b)
+}
+func (r *streamRecorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.body.String()
+}
+
+func TestWatchApplicationBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	shutdown := make(chan struct{})
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: shutdown}
+
+	rec := &streamRecorder{header: make(http.Header), writes: make(chan struct{}, 1)}
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	prefix := "str:watched"
+	done := make(chan error)
+	go func() {
+		done <- handler.WatchApplicationBoxes(c, 7, model.WatchApplicationBoxesParams{Prefix: &prefix})
+	}()
+	// the stream opens once the watcher subscribed
+	<-rec.writes
+	require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, fmt.Sprintf(": watching from round %d\n\n", mockLedger.Latest()+1), rec.String())
+
+	prev, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	evaluator, err := mockLedger.StartEvaluator(bookkeeping.MakeBlock(prev).BlockHeader, 0, 0, nil)
+	require.NoError(t, err)
+	vb, err := evaluator.GenerateBlock()
+	require.NoError(t, err)
+	delta := vb.Delta()
+	delta.KvMods = map[string]ledgercore.KvValueDelta{
+		apps.MakeBoxKey(7, "watched-1"): {Data: []byte("value")},
+		apps.MakeBoxKey(7, "watched-2"): {OldData: []byte("value")},
+		apps.MakeBoxKey(7, "other"):     {Data: []byte("value")},
+		apps.MakeBoxKey(8, "watched-3"): {Data: []byte("value")},
+	}
+	require.NoError(t, mockLedger.AddValidatedBlock(ledgercore.MakeValidatedBlock(vb.Block(), delta), agreement.Certificate{}))
+
+	var events []streamedEvent
+	for len(events) < 2 {
+		select {
+		case <-rec.writes:
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "the box changes were not streamed", rec.String())
+		}
+		events = parseEventStream(rec.String())
+	}
+	close(shutdown)
+	require.NoError(t, <-done)
+
+	type boxEvent struct {
+		Round  uint64        `codec:"round"`
+		Name   []byte        `codec:"name"`
+		Change string        `codec:"change"`
+		Size   *uint64       `codec:"size"`
+		Digest crypto.Digest `codec:"digest"`
+	}
+	events = parseEventStream(rec.String())
+	require.Len(t, events, 2)
+	var decoded [2]boxEvent
+	for i, ev := range events {
+		require.Equal(t, "box", ev.name)
+		require.Equal(t, fmt.Sprint(vb.Block().Round()), ev.id)
+		require.NoError(t, protocol.DecodeJSON([]byte(ev.data), &decoded[i]))
+	}
+	digest := crypto.Hash([]byte("value"))
+	size := uint64(5)
+	require.Equal(t, boxEvent{Round: uint64(vb.Block().Round()), Name: []byte("watched-1"), Change: "created", Size: &size, Digest: digest}, decoded[0])
+	require.Equal(t, boxEvent{Round: uint64(vb.Block().Round()), Name: []byte("watched-2"), Change: "deleted"}, decoded[1])
+
+	badPrefix := "nope:watched"
+	rec2 := httptest.NewRecorder()
+	require.NoError(t, handler.WatchApplicationBoxes(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec2), 7, model.WatchApplicationBoxesParams{Prefix: &badPrefix}))
+	require.Equal(t, http.StatusBadRequest, rec2.Code)
+}
+
 func TestSyncRound(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -257,6 +530,259 @@ func TestSyncRound(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 200, rec.Code)
 	mockCall.Unset()
+	c, rec = newReq(t)
+
+	// TestAcknowledgeSyncRound 200
+	mockCall = mockNode.On("AcknowledgeSyncRound", uint64(5)).Return(nil)
+	err = handler.AcknowledgeSyncRound(c, 5)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	c, rec = newReq(t)
+	// TestAcknowledgeSyncRound 400 SyncPolicyDisabled
+	mockCall = mockNode.On("AcknowledgeSyncRound", mock.Anything).Return(node.ErrSyncPolicyDisabled)
+	err = handler.AcknowledgeSyncRound(c, 5)
+	require.NoError(t, err)
+	requireErrorResponse(t, rec, "the follower sync policy is not enabled", "sync-policy-disabled")
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+	c, rec = newReq(t)
+	// TestAcknowledgeSyncRound 400 SyncAckAhead
+	mockCall = mockNode.On("AcknowledgeSyncRound", mock.Anything).Return(node.ErrSyncAckAhead)
+	err = handler.AcknowledgeSyncRound(c, 5)
+	require.NoError(t, err)
+	requireErrorResponse(t, rec, "failed to acknowledge the sync round", "sync-round-ack-rejected")
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestMemorySettings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	current := node.MemorySettings{Target: 4 << 30, Ballast: 1 << 30, GCPercent: 150, MemoryLimit: 4 << 30, LiveHeap: 1 << 29}
+
+	// GetMemorySettings 200
+	mockCall := mockNode.On("MemorySettings").Return(current)
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetMemorySettings(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.MemorySettingsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.MemorySettingsResponse{Target: 4 << 30, Ballast: 1 << 30, GcPercent: 150, MemoryLimit: 4 << 30, LiveHeap: 1 << 29}, response)
+
+	// SetMemorySettings 200, keeping the ballast
+	target := uint64(8 << 30)
+	updated := current
+	updated.Target = target
+	setCall := mockNode.On("SetMemorySettings", target, current.Ballast).Return(updated)
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetMemorySettings(c, model.SetMemorySettingsParams{Target: &target}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, target, response.Target)
+	setCall.Unset()
+
+	// SetMemorySettings 400, ballast larger than the target
+	ballast := uint64(5 << 30)
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetMemorySettings(c, model.SetMemorySettingsParams{Ballast: &ballast}))
+	requireErrorResponse(t, rec, "memory ballast must be smaller than the memory target", "memory-ballast-over-target")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	mockCall.Unset()
+
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestLoggingSettings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	log := logging.TestingLog(t)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      log,
+		Shutdown: make(chan struct{}),
+	}
+
+	// GetLoggingSettings 200
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetLoggingSettings(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.LoggingSettingsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.LoggingSettingsResponse{Level: "debug", Output: "default", DisabledTelemetryCategories: []string{}}, response)
+
+	// SetLoggingSettings 200, redirecting the log to a file
+	level := model.SetLoggingSettingsParamsLevelWarn
+	output := filepath.Join(t.TempDir(), "node.log")
+	disable := "Agreement, Network"
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetLoggingSettings(c, model.SetLoggingSettingsParams{Level: &level, Output: &output, DisableTelemetryCategories: &disable}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.LoggingSettingsResponse{Level: "warn", Output: output, DisabledTelemetryCategories: []string{"Agreement", "Network"}}, response)
+	require.Equal(t, logging.Warn, log.GetLevel())
+	log.Warn("written to the redirected log")
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "written to the redirected log")
+
+	// SetLoggingSettings 200, keeping the level
+	output = "default"
+	enable := "Network"
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetLoggingSettings(c, model.SetLoggingSettingsParams{Output: &output, EnableTelemetryCategories: &enable}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.LoggingSettingsResponse{Level: "warn", Output: "default", DisabledTelemetryCategories: []string{"Agreement"}}, response)
+
+	// SetLoggingSettings 400, unknown category
+	disable = "Agreement,Ledger"
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetLoggingSettings(c, model.SetLoggingSettingsParams{DisableTelemetryCategories: &disable}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "unknown telemetry category Ledger", "unknown-telemetry-category")
+
+	// SetLoggingSettings 400, relative path
+	output = "node.log"
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetLoggingSettings(c, model.SetLoggingSettingsParams{Output: &output}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, `failed to redirect the log: the log can only be redirected to syslog or to an absolute path, got "node.log"`, "log-redirection-failed")
+}
+
+func TestGetFlightRecording(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	since := uint64(1700000000)
+	samples := []node.FlightRecorderSample{{
+		Time:            time.Unix(int64(since)+1, 0),
+		CPUTime:         250 * time.Millisecond,
+		MemoryBytes:     1 << 30,
+		HeapBytes:       1 << 29,
+		DiskReadBlocks:  8,
+		DiskWriteBlocks: 16,
+		LatestRound:     100,
+		LedgerLag:       1500 * time.Millisecond,
+		TxPoolSize:      42,
+	}}
+
+	// 200, from the given time
+	mockCall := mockNode.On("FlightRecording", time.Unix(int64(since), 0)).Return(samples, nil)
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetFlightRecording(c, model.GetFlightRecordingParams{Since: &since}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.FlightRecordingResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.FlightRecordingResponse{Samples: []model.FlightRecorderSample{{
+		Time:            since + 1,
+		CpuTime:         250000,
+		Memory:          1 << 30,
+		Heap:            1 << 29,
+		DiskReadBlocks:  8,
+		DiskWriteBlocks: 16,
+		Round:           100,
+		LedgerLag:       1500,
+		TxpoolSize:      42,
+	}}}, response)
+	mockCall.Unset()
+
+	// 400, the flight recorder is disabled
+	mockCall = mockNode.On("FlightRecording", time.Time{}).Return([]node.FlightRecorderSample(nil), node.ErrFlightRecorderDisabled)
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetFlightRecording(c, model.GetFlightRecordingParams{}))
+	requireErrorResponse(t, rec, "the flight recorder is not enabled", "flight-recorder-disabled")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	mockCall.Unset()
+
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestParticipationKeyAutoRenewal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	settings := node.KeyRenewalSettings{Enabled: true, LeadRounds: 1000, Validity: 100000}
+	renewal := node.KeyRenewal{
+		Account:    basics.Address{1},
+		ID:         account.ParticipationID{2},
+		FirstValid: 500,
+		LastValid:  100500,
+		Round:      500,
+		Keyreg:     transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.KeyRegistrationTx}},
+	}
+	expected := model.ParticipationKeyAutoRenewalResponse{
+		Enabled:    true,
+		LeadRounds: 1000,
+		Validity:   100000,
+		Renewals: []model.ParticipationKeyRenewal{{
+			Address:    renewal.Account.String(),
+			Id:         renewal.ID.String(),
+			FirstValid: 500,
+			LastValid:  100500,
+			Round:      500,
+			Keyreg:     protocol.Encode(&renewal.Keyreg),
+		}},
+	}
+
+	// 200, get the settings and renewals
+	getCall := mockNode.On("ParticipationKeyAutoRenewal").Return(settings, []node.KeyRenewal{renewal})
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetParticipationKeyAutoRenewal(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ParticipationKeyAutoRenewalResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, expected, response)
+
+	// 200, parameters not provided keep their value
+	disabled := false
+	lead := uint64(2000)
+	setCall := mockNode.On("SetParticipationKeyAutoRenewal", node.KeyRenewalSettings{LeadRounds: 2000, Validity: 100000}).Return(nil)
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetParticipationKeyAutoRenewal(c, model.SetParticipationKeyAutoRenewalParams{Enabled: &disabled, LeadRounds: &lead}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	setCall.Unset()
+
+	// 400, the lead is over the validity
+	validity := uint64(500)
+	setCall = mockNode.On("SetParticipationKeyAutoRenewal", node.KeyRenewalSettings{Enabled: true, LeadRounds: 1000, Validity: 500}).Return(node.ErrKeyRenewalLeadOverValidity)
+	c, rec = newReq(t)
+	require.NoError(t, handler.SetParticipationKeyAutoRenewal(c, model.SetParticipationKeyAutoRenewalParams{Validity: &validity}))
+	requireErrorResponse(t, rec, "the validity of renewed participation keys must be larger than the renewal lead", "renewal-lead-over-validity")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	setCall.Unset()
+	getCall.Unset()
 
 	mock.AssertExpectationsForObjects(t, mockNode)
 }
@@ -474,6 +1000,768 @@ func TestGetSupply(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetCatchpointLabel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const label = "10000#NTLVVTOXQMQAMWY7GIMLL7MBVOI4JFCW7BWDQPRQAEU4ZD7FW3QA"
+	ledger := &mockLedger{}
+	ledger.On("GetCatchpointLabel", basics.Round(10000)).Return(label, nil)
+	ledger.On("GetCatchpointLabel", basics.Round(20000)).Return("", ledgercore.ErrNoEntry{Round: 20000})
+	ledger.On("GetCatchpointLabel", basics.Round(30000)).Return("", errors.New("database is locked"))
+
+	handler := v2.Handlers{
+		Node:     makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetCatchpointLabel(c, 10000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.CatchpointLabelResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.CatchpointLabelResponse{Round: 10000, Catchpoint: label}, response)
+
+	c, rec = newReq(t)
+	err = handler.GetCatchpointLabel(c, 20000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "no catchpoint is retained for the given round", "catchpoint-not-found")
+
+	c, rec = newReq(t)
+	err = handler.GetCatchpointLabel(c, 30000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestGetComplianceEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	issuer := basics.Address{1}
+	holder := basics.Address{2}
+	events := []ledgercore.AssetComplianceEvent{
+		{Kind: ledgercore.AssetFreezeEvent, Round: 5, Asset: 7, Target: holder, Sender: issuer},
+		{Kind: ledgercore.AssetClawbackEvent, Round: 9, Asset: 7, Target: holder, Sender: issuer, Receiver: issuer, Amount: 100, Inner: true},
+	}
+	ledger := &mockLedger{}
+	ledger.On("AssetComplianceEvents", basics.AssetIndex(7), holder, basics.Round(0), basics.Round(20)).Return(events, nil)
+	ledger.On("AssetComplianceEvents", basics.AssetIndex(0), holder, basics.Round(6), basics.Round(0)).Return(events[1:], nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetAssetComplianceEvents(c, 7, model.GetAssetComplianceEventsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "/compliance-events was not enabled in the configuration file by setting the EnableAssetComplianceIndex to true", "asset-compliance-index-disabled")
+
+	mockNode.config.EnableAssetComplianceIndex = true
+
+	holderStr := holder.String()
+	maxRound := uint64(20)
+	c, rec = newReq(t)
+	err = handler.GetAssetComplianceEvents(c, 7, model.GetAssetComplianceEventsParams{Address: &holderStr, MaxRound: &maxRound})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ComplianceEventsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Events, 2)
+	require.Equal(t, "freeze", response.Events[0].Kind)
+	require.Equal(t, uint64(5), response.Events[0].Round)
+	require.Equal(t, holderStr, response.Events[0].Target)
+	require.Nil(t, response.Events[0].Receiver)
+	require.Nil(t, response.Events[0].Amount)
+	require.Equal(t, "clawback", response.Events[1].Kind)
+	require.True(t, response.Events[1].InnerTxn)
+	require.Equal(t, issuer.String(), *response.Events[1].Receiver)
+	require.Equal(t, uint64(100), *response.Events[1].Amount)
+
+	minRound := uint64(6)
+	c, rec = newReq(t)
+	err = handler.GetAccountComplianceEvents(c, holderStr, model.GetAccountComplianceEventsParams{MinRound: &minRound})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.ComplianceEventsResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Events, 1)
+	require.Equal(t, uint64(7), response.Events[0].AssetId)
+
+	badAddress := "not-an-address"
+	c, rec = newReq(t)
+	err = handler.GetAssetComplianceEvents(c, 7, model.GetAssetComplianceEventsParams{Address: &badAddress})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetAccountComplianceEvents(c, badAddress, model.GetAccountComplianceEventsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestVerifyAssetMetadata(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	creator := basics.Address{1}
+	ledger := &mockLedger{accounts: map[basics.Address]basics.AccountData{
+		creator: {AssetParams: map[basics.AssetIndex]basics.AssetParams{
+			7: {URL: server.URL + "/metadata.json#arc3", MetadataHash: sha256.Sum256([]byte("{}"))},
+			8: {MetadataHash: sha256.Sum256([]byte("{}"))},
+		}},
+	}}
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	require.NoError(t, handler.VerifyAssetMetadata(c, 7))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "/metadata/verify was not enabled in the configuration file by setting the EnableAssetMetadataVerification to true", "asset-metadata-verification-disabled")
+
+	mockNode.config.EnableAssetMetadataVerification = true
+
+	c, rec = newReq(t)
+	require.NoError(t, handler.VerifyAssetMetadata(c, 9))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	c, rec = newReq(t)
+	require.NoError(t, handler.VerifyAssetMetadata(c, 8))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AssetMetadataVerificationResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictNoUrl, response.Verdict)
+
+	// the test server listens on the loopback interface, which the node refuses to fetch from
+	c, rec = newReq(t)
+	require.NoError(t, handler.VerifyAssetMetadata(c, 7))
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.AssetMetadataVerificationResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(7), response.AssetId)
+	require.Equal(t, model.AssetMetadataVerificationResponseVerdictUnreachable, response.Verdict)
+	require.Contains(t, *response.Error, "non-public address")
+}
+
+func TestGetAccountTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	txns := ledgercore.AccountTxns{
+		Since: 3,
+		Txns: []ledgercore.AccountTxn{
+			{Round: 9, Intra: 2, TxID: transactions.Txid{9}},
+			{Round: 5, Intra: 0, TxID: transactions.Txid{5}},
+		},
+	}
+	ledger := &mockLedger{}
+	ledger.On("AccountTransactions", addr, basics.Round(0), basics.Round(math.MaxUint64), uint64(100)).Return(txns, nil)
+	ledger.On("AccountTransactions", addr, basics.Round(4), basics.Round(8), uint64(1)).Return(ledgercore.AccountTxns{Since: 3, Txns: txns.Txns[1:]}, nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetAccountTransactions(c, addr.String(), model.GetAccountTransactionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "/accounts/{address}/transactions was not enabled in the configuration file by setting the EnableAccountTxnIndex to true", "account-txn-index-disabled")
+
+	mockNode.config.EnableAccountTxnIndex = true
+
+	c, rec = newReq(t)
+	err = handler.GetAccountTransactions(c, addr.String(), model.GetAccountTransactionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AccountTransactionsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.AccountTransactionsResponse{
+		Since: 3,
+		Transactions: []model.AccountTransaction{
+			{Round: 9, IntraRoundOffset: 2, Txid: transactions.Txid{9}.String()},
+			{Round: 5, IntraRoundOffset: 0, Txid: transactions.Txid{5}.String()},
+		},
+	}, response)
+
+	minRound, maxRound, limit := uint64(4), uint64(8), uint64(1)
+	c, rec = newReq(t)
+	err = handler.GetAccountTransactions(c, addr.String(), model.GetAccountTransactionsParams{MinRound: &minRound, MaxRound: &maxRound, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.AccountTransactionsResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Transactions, 1)
+	require.Equal(t, uint64(5), response.Transactions[0].Round)
+
+	limit = 1001
+	c, rec = newReq(t)
+	err = handler.GetAccountTransactions(c, addr.String(), model.GetAccountTransactionsParams{Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "limit cannot be greater than 1000", "limit-too-large")
+
+	c, rec = newReq(t)
+	err = handler.GetAccountTransactions(c, "not-an-address", model.GetAccountTransactionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetApplicationEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	app := basics.AppIndex(7)
+	swap := ledgercore.MakeAppEventSelector("Swap(uint64,uint64)")
+	events := ledgercore.AppEvents{
+		Since: 3,
+		Events: []ledgercore.AppEvent{
+			{Round: 5, TxID: transactions.Txid{5}, App: app, Selector: swap, Data: []byte{1}},
+			{Round: 9, TxID: transactions.Txid{9}, Inner: true, App: app, LogIndex: 2, Selector: swap, Data: []byte{2}},
+		},
+	}
+	ledger := &mockLedger{latest: 10}
+	ledger.On("AppEvents", app, (*ledgercore.AppEventSelector)(nil), basics.Round(0), basics.Round(10), uint64(100)).Return(events, nil)
+	ledger.On("AppEvents", app, &swap, basics.Round(4), basics.Round(8), uint64(1)).Return(ledgercore.AppEvents{Since: 3, Events: events.Events[:1]}, nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetApplicationEvents(c, uint64(app), model.GetApplicationEventsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "/applications/{application-id}/events was not enabled in the configuration file by setting the EnableAppEventIndex to true", "app-event-index-disabled")
+
+	mockNode.config.EnableAppEventIndex = true
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationEvents(c, uint64(app), model.GetApplicationEventsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ApplicationEventsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	inner := true
+	require.Equal(t, model.ApplicationEventsResponse{
+		Since:        3,
+		CurrentRound: 10,
+		Events: []model.ApplicationEvent{
+			{Round: 5, Txid: transactions.Txid{5}.String(), Selector: swap[:], Data: []byte{1}},
+			{Round: 9, Txid: transactions.Txid{9}.String(), InnerTxn: &inner, LogIndex: 2, Selector: swap[:], Data: []byte{2}},
+		},
+	}, response)
+
+	// the topic is either the signature of the event or its selector
+	minRound, maxRound, limit := uint64(4), uint64(8), uint64(1)
+	for _, topic := range []string{"Swap(uint64,uint64)", hex.EncodeToString(swap[:]), "0x" + hex.EncodeToString(swap[:])} {
+		c, rec = newReq(t)
+		err = handler.GetApplicationEvents(c, uint64(app), model.GetApplicationEventsParams{Topic: &topic, MinRound: &minRound, MaxRound: &maxRound, Limit: &limit})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		response = model.ApplicationEventsResponse{}
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		require.Len(t, response.Events, 1)
+		require.Equal(t, uint64(5), response.Events[0].Round)
+	}
+
+	for _, topic := range []string{"Swap", "0102"} {
+		c, rec = newReq(t)
+		err = handler.GetApplicationEvents(c, uint64(app), model.GetApplicationEventsParams{Topic: &topic})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		requireErrorResponse(t, rec, "failed to parse the topic, it must be an event signature or a hex encoded 4 byte selector", "invalid-topic")
+	}
+
+	limit = 1001
+	c, rec = newReq(t)
+	err = handler.GetApplicationEvents(c, uint64(app), model.GetApplicationEventsParams{Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "limit cannot be greater than 1000", "limit-too-large")
+}
+
+func TestGetRecentTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{1}
+	lease := [32]byte{7}
+	encodedLease := base64.StdEncoding.EncodeToString(lease[:])
+	notePrefix := base64.StdEncoding.EncodeToString([]byte("order:"))
+	txns := ledgercore.RecentTxns{
+		Since: 3,
+		Txns: []ledgercore.RecentTxn{
+			{Round: 9, Intra: 2, TxID: transactions.Txid{9}, Sender: sender, LastValid: 20},
+		},
+	}
+	ledger := &mockLedger{}
+	ledger.On("RecentTransactions", ledgercore.RecentTxnQuery{Sender: sender, Lease: lease}, uint64(100)).Return(txns, nil)
+	ledger.On("RecentTransactions", ledgercore.RecentTxnQuery{NotePrefix: []byte("order:")}, uint64(5)).Return(ledgercore.RecentTxns{Since: 3}, nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetRecentTransactions(c, model.GetRecentTransactionsParams{NotePrefix: &notePrefix})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "/transactions/recent was not enabled in the configuration file by setting the EnableRecentTxnIndex to true", "recent-txn-index-disabled")
+
+	mockNode.config.EnableRecentTxnIndex = true
+
+	senderStr := sender.String()
+	c, rec = newReq(t)
+	err = handler.GetRecentTransactions(c, model.GetRecentTransactionsParams{Sender: &senderStr, Lease: &encodedLease})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.RecentTransactionsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.RecentTransactionsResponse{
+		Since: 3,
+		Transactions: []model.RecentTransaction{
+			{Round: 9, IntraRoundOffset: 2, Txid: transactions.Txid{9}.String(), Sender: senderStr, LastValid: 20},
+		},
+	}, response)
+
+	limit := uint64(5)
+	c, rec = newReq(t)
+	err = handler.GetRecentTransactions(c, model.GetRecentTransactionsParams{NotePrefix: &notePrefix, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.RecentTransactionsResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.RecentTransactionsResponse{Since: 3, Transactions: []model.RecentTransaction{}}, response)
+
+	longPrefix := base64.StdEncoding.EncodeToString(make([]byte, 33))
+	shortLease := base64.StdEncoding.EncodeToString(lease[:31])
+	for _, test := range []struct {
+		params model.GetRecentTransactionsParams
+		msg    string
+		code   string
+	}{
+		{model.GetRecentTransactionsParams{}, "a lease or a note prefix must be given", "empty-recent-txn-query"},
+		{model.GetRecentTransactionsParams{Lease: &encodedLease}, "looking up a lease requires its sender", "lease-without-sender"},
+		{model.GetRecentTransactionsParams{Sender: &senderStr, Lease: &shortLease}, "failed to parse the lease, it must be 32 base64 encoded bytes", "invalid-lease"},
+		{model.GetRecentTransactionsParams{NotePrefix: &longPrefix}, "failed to parse the note prefix, it must be at most 32 base64 encoded bytes", "invalid-note-prefix"},
+	} {
+		c, rec = newReq(t)
+		err = handler.GetRecentTransactions(c, test.params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		requireErrorResponse(t, rec, test.msg, test.code)
+	}
+	ledger.AssertExpectations(t)
+}
+
+func TestGetApplicationBoxHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	app := basics.AppIndex(50)
+	ledger := &mockLedger{
+		latest: 20,
+		kvstore: map[string][]byte{
+			apps.MakeBoxKey(uint64(app), "live"):    []byte("value"),
+			apps.MakeBoxKey(uint64(app), "deleted"): nil,
+			apps.MakeBoxKey(uint64(app), "never"):   nil,
+		},
+	}
+	ledger.On("BoxHistory", app, "live").Return(ledgercore.BoxHistory{Since: 3, Created: 5}, nil)
+	ledger.On("BoxHistory", app, "deleted").Return(ledgercore.BoxHistory{Since: 3, Created: 5, Deleted: 9}, nil)
+	ledger.On("BoxHistory", app, "never").Return(ledgercore.BoxHistory{Since: 3}, nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	getBox := func(name string) *httptest.ResponseRecorder {
+		c, rec := newReq(t)
+		err := handler.GetApplicationBoxByName(c, uint64(app), model.GetApplicationBoxByNameParams{Name: "str:" + name})
+		require.NoError(t, err)
+		return rec
+	}
+	errorData := func(rec *httptest.ResponseRecorder) map[string]interface{} {
+		var response model.ErrorResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		require.Equal(t, "box not found", response.Message)
+		if response.Data == nil {
+			return nil
+		}
+		return *response.Data
+	}
+
+	// the history is not reported unless the index is enabled
+	rec := getBox("live")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.BoxResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Nil(t, response.CreatedRound)
+
+	rec = getBox("deleted")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Nil(t, errorData(rec))
+
+	mockNode.config.EnableBoxHistoryIndex = true
+
+	rec = getBox("live")
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.BoxResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, []byte("value"), response.Value)
+	require.NotNil(t, response.CreatedRound)
+	require.Equal(t, uint64(5), *response.CreatedRound)
+
+	rec = getBox("deleted")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, map[string]interface{}{
+		"indexed-since-round": uint64(3),
+		"created-round":       uint64(5),
+		"deleted-round":       uint64(9),
+	}, errorData(rec))
+
+	rec = getBox("never")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, map[string]interface{}{"indexed-since-round": uint64(3)}, errorData(rec))
+}
+
+func TestGetApplicationBoxesPage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	app := basics.AppIndex(50)
+	ledger := &mockLedger{
+		latest: 20,
+		kvstore: map[string][]byte{
+			apps.MakeBoxKey(uint64(app), "b"):       []byte("2"),
+			apps.MakeBoxKey(uint64(app), "a"):       []byte("1"),
+			apps.MakeBoxKey(uint64(app), "key:2"):   []byte("4"),
+			apps.MakeBoxKey(uint64(app), "key:1"):   []byte("3"),
+			apps.MakeBoxKey(uint64(app), "key:3"):   []byte("5"),
+			apps.MakeBoxKey(uint64(app+1), "key:0"): []byte("6"),
+		},
+	}
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.config.MaxAPIBoxPerApplication = 2
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	getBoxes := func(params model.GetApplicationBoxesParams) model.BoxesResponse {
+		c, rec := newReq(t)
+		require.NoError(t, handler.GetApplicationBoxes(c, uint64(app), params))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response model.BoxesResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		return response
+	}
+	b64 := func(s string) *string {
+		encoded := base64.StdEncoding.EncodeToString([]byte(s))
+		return &encoded
+	}
+
+	// the pages are limited by MaxAPIBoxPerApplication, and come in order of the box names
+	values := true
+	response := getBoxes(model.GetApplicationBoxesParams{Values: &values})
+	require.Equal(t, []model.BoxDescriptor{
+		{Name: []byte("a"), Value: &[]byte{'1'}},
+		{Name: []byte("b"), Value: &[]byte{'2'}},
+	}, response.Boxes)
+	require.Equal(t, b64("key:1"), response.NextToken)
+
+	// page through the boxes matching a prefix
+	prefix := b64("key:")
+	one := uint64(1)
+	var names []string
+	var next *string
+	for {
+		response = getBoxes(model.GetApplicationBoxesParams{Prefix: prefix, Next: next, Max: &one})
+		for _, box := range response.Boxes {
+			require.Nil(t, box.Value)
+			names = append(names, string(box.Name))
+		}
+		if response.NextToken == nil {
+			break
+		}
+		next = response.NextToken
+	}
+	require.Equal(t, []string{"key:1", "key:2", "key:3"}, names)
+
+	notBase64 := "!"
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetApplicationBoxes(c, uint64(app), model.GetApplicationBoxesParams{Prefix: &notBase64}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "failed to parse the box name prefix, it must be base64 encoded", "invalid-box-prefix")
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetApplicationBoxes(c, uint64(app), model.GetApplicationBoxesParams{Next: &notBase64}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "failed to parse the next token", "invalid-next-token")
+}
+
+func TestGetPendingBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txids := []transactions.Txid{{1}, {2}, {3}}
+	preview := pools.BlockPreview{
+		Round:          11,
+		AssembledAt:    time.Unix(1700000000, 0),
+		StopReason:     telemetryspec.AssembleBlockOverBudget,
+		TxIDs:          txids,
+		DeferredGroups: 4,
+		TotalFees:      basics.MicroAlgos{Raw: 3000},
+		PaysetBytes:    700,
+		MaxPaysetBytes: 5000000,
+		RewardsLevel:   12,
+		RewardsRate:    13,
+		RewardsResidue: 14,
+	}
+	mockNode := makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	call := mockNode.On("PendingBlockPreview").Return(pools.BlockPreview{}, false)
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetPendingBlock(c, model.GetPendingBlockParams{}))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	requireErrorResponse(t, rec, "the transaction pool has not assembled a block yet", "pending-block-unavailable")
+	call.Unset()
+
+	mockNode.On("PendingBlockPreview").Return(preview, true)
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetPendingBlock(c, model.GetPendingBlockParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.PendingBlockResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.PendingBlockResponse{
+		Round:            11,
+		AssembledAt:      1700000000,
+		StopReason:       "over-budget",
+		TransactionCount: 3,
+		Txids:            []string{txids[0].String(), txids[1].String(), txids[2].String()},
+		DeferredGroups:   4,
+		TotalFees:        3000,
+		PaysetBytes:      700,
+		MaxPaysetBytes:   5000000,
+		RewardsLevel:     12,
+		RewardsRate:      13,
+		RewardsResidue:   14,
+	}, response)
+
+	maxTxns := uint64(2)
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetPendingBlock(c, model.GetPendingBlockParams{Max: &maxTxns}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.PendingBlockResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(3), response.TransactionCount)
+	require.Equal(t, []string{txids[0].String(), txids[1].String()}, response.Txids)
+}
+
+func TestGetTransactionPoolStats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	stats := pools.PoolStats{
+		PendingTxns:           7,
+		PendingGroups:         5,
+		MaxTxns:               100,
+		FeePerByte:            2,
+		MinFeePerByte:         4.5,
+		MedianFeePerByte:      6,
+		MaxFeePerByte:         50.25,
+		FeePriority:           true,
+		EvictionPolicy:        pools.EvictionPolicyLowestFee,
+		MaxTxnsPerSender:      3,
+		EvictedGroups:         8,
+		SenderLimitRejections: 9,
+	}
+	mockNode := makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.On("TransactionPoolStats").Return(stats)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetTransactionPoolStats(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.TransactionPoolStatsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.TransactionPoolStatsResponse{
+		PendingTransactions:      7,
+		PendingGroups:            5,
+		MaxTransactions:          100,
+		FeePerByte:               2,
+		MinFeePerByte:            4.5,
+		MedianFeePerByte:         6,
+		MaxFeePerByte:            50.25,
+		FeePriority:              true,
+		EvictionPolicy:           "lowest-fee",
+		MaxTransactionsPerSender: 3,
+		EvictedGroups:            8,
+		SenderLimitRejections:    9,
+	}, response)
+}
+
+func TestGetTransactionFeeEstimate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	t.Run("estimate", func(t *testing.T) {
+		estimate := pools.FeeEstimate{
+			Blocks:          20,
+			CongestedBlocks: 4,
+			Fullness:        0.35,
+			PoolFeePerByte:  2,
+			FeePerByteP50:   5,
+			FeePerByteP90:   12,
+			FeePerByteP99:   40,
+		}
+		mockNode := makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false)
+		mockNode.On("FeeEstimate").Return(estimate, nil)
+		handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+
+		c, rec := newReq(t)
+		require.NoError(t, handler.GetTransactionFeeEstimate(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response model.TransactionFeeEstimateResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		require.Equal(t, model.TransactionFeeEstimateResponse{
+			MinFee:          config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee,
+			FeePerByteP50:   5,
+			FeePerByteP90:   12,
+			FeePerByteP99:   40,
+			PoolFeePerByte:  2,
+			Blocks:          20,
+			CongestedBlocks: 4,
+			BlockFullness:   0.35,
+		}, response)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		mockNode := makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false)
+		mockNode.On("FeeEstimate").Return(pools.FeeEstimate{}, errors.New("fee estimates are disabled"))
+		handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+
+		c, rec := newReq(t)
+		require.NoError(t, handler.GetTransactionFeeEstimate(c))
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		require.Contains(t, rec.Body.String(), "fee-estimate-unavailable")
+	})
+}
+
+func TestGetProposerReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	whale := basics.Address{1}
+	fish := basics.Address{2}
+	report := []ledgercore.ProposerStats{
+		{Address: whale, Stake: basics.MicroAlgos{Raw: 5000}, Proposals: 3, ExpectedProposals: 480.5},
+		{Address: fish, Stake: basics.MicroAlgos{Raw: 10}, Proposals: 1, ExpectedProposals: 0.9},
+	}
+	roundOffsetErr := fmt.Errorf("lookup failed: %w", &ledger.RoundOffsetError{})
+	ledger := &mockLedger{latest: 200_000}
+	ledger.On("ProposerReport", basics.Round(199_001), basics.Round(200_000), uint64(100)).Return(report, nil)
+	ledger.On("ProposerReport", basics.Round(1), basics.Round(10), uint64(1)).Return(report[:1], nil)
+	ledger.On("ProposerReport", basics.Round(1), basics.Round(20), uint64(100)).Return([]ledgercore.ProposerStats(nil), ledgercore.ErrNoEntry{Round: 1})
+	ledger.On("ProposerReport", basics.Round(1), basics.Round(30), uint64(100)).Return([]ledgercore.ProposerStats(nil), roundOffsetErr)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	u64 := func(v uint64) *uint64 { return &v }
+
+	// the last 1000 rounds by default
+	c, rec := newReq(t)
+	err := handler.GetProposerReport(c, model.GetProposerReportParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ProposerReportResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(199_001), response.MinRound)
+	require.Equal(t, uint64(200_000), response.MaxRound)
+	require.Equal(t, []model.ProposerStats{
+		{Address: whale.String(), Stake: 5000, Proposals: 3, ExpectedProposals: 480.5},
+		{Address: fish.String(), Stake: 10, Proposals: 1, ExpectedProposals: 0.9},
+	}, response.Accounts)
+
+	// the default range is clamped to the first round
+	c, rec = newReq(t)
+	err = handler.GetProposerReport(c, model.GetProposerReportParams{MaxRound: u64(10), Limit: u64(1)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.ProposerReportResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(1), response.MinRound)
+	require.Len(t, response.Accounts, 1)
+
+	c, rec = newReq(t)
+	err = handler.GetProposerReport(c, model.GetProposerReportParams{MinRound: u64(1), MaxRound: u64(20)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	tests := []struct {
+		name   string
+		params model.GetProposerReportParams
+		status int
+		code   string
+	}{
+		{"round zero", model.GetProposerReportParams{MinRound: u64(0), MaxRound: u64(10)}, http.StatusBadRequest, "invalid-round-range"},
+		{"reversed", model.GetProposerReportParams{MinRound: u64(11), MaxRound: u64(10)}, http.StatusBadRequest, "invalid-round-range"},
+		{"future", model.GetProposerReportParams{MaxRound: u64(200_001)}, http.StatusNotFound, "round-not-available"},
+		{"too many rounds", model.GetProposerReportParams{MinRound: u64(190_000), MaxRound: u64(200_000)}, http.StatusBadRequest, "round-range-too-large"},
+		{"stake not retained", model.GetProposerReportParams{MinRound: u64(1), MaxRound: u64(30)}, http.StatusNotFound, "round-not-retained"},
+		{"too many accounts", model.GetProposerReportParams{Limit: u64(1001)}, http.StatusBadRequest, "limit-too-large"},
+	}
+	for _, test := range tests {
+		c, rec = newReq(t)
+		err = handler.GetProposerReport(c, test.params)
+		require.NoError(t, err, test.name)
+		require.Equal(t, test.status, rec.Code, test.name)
+		var errResponse model.ErrorResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &errResponse))
+		require.Equal(t, test.code, *errResponse.Code, test.name)
+	}
+}
+
 func TestGetStatus(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -846,34 +2134,239 @@ func prepareTransactionTest(t *testing.T, txnToUse int, txnPrep func(transaction
 		bodyBytes := txnPrep(stxn)
 		body = bytes.NewReader(bodyBytes)
 	}
-	req := httptest.NewRequest(http.MethodPost, "/", body)
-	rec = httptest.NewRecorder()
-	c = e.NewContext(req, rec)
-	return
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	return
+}
+
+// postTransactionArgs returns the reflected arguments of the transaction posting handler method.
+func postTransactionArgs(c echo.Context, method string) []reflect.Value {
+	args := []reflect.Value{reflect.ValueOf(c)}
+	if method == "RawTransaction" {
+		args = append(args, reflect.ValueOf(model.RawTransactionParams{}))
+	}
+	return args
+}
+
+func postTransactionTest(t *testing.T, txnToUse int, expectedCode int, method string, enableExperimental bool) {
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		return protocol.Encode(&stxn)
+	}
+	cfg := config.GetDefaultLocal()
+	cfg.EnableExperimentalAPI = enableExperimental
+	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, txnPrep, cfg)
+	defer releasefunc()
+	results := reflect.ValueOf(&handler).MethodByName(method).Call(postTransactionArgs(c, method))
+	require.Equal(t, 1, len(results))
+	// if the method returns nil, the cast would fail so use type assertion test
+	err, _ := results[0].Interface().(error)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestPostTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	postTransactionTest(t, -1, 400, "RawTransaction", false)
+	postTransactionTest(t, 0, 200, "RawTransaction", false)
+}
+
+func TestTransactionGroupResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	t.Run("shared", func(t *testing.T) {
+		var payment transactions.SignedTxn
+		txnPrep := func(stxn transactions.SignedTxn) []byte {
+			payment = stxn
+			return protocol.Encode(&stxn)
+		}
+		handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+		defer releasefunc()
+		err := handler.TransactionGroupResources(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response model.TransactionGroupResourcesResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Contains(t, response.Accounts, model.GroupSharedAccount{Address: payment.Txn.Sender.String(), Sources: []uint64{0}})
+		require.Contains(t, response.Accounts, model.GroupSharedAccount{Address: payment.Txn.Receiver.String(), Sources: []uint64{0}})
+		require.Empty(t, response.Assets)
+		require.Empty(t, response.Programs)
+	})
+
+	// the apps called by the group must exist
+	t.Run("unknown app", func(t *testing.T) {
+		txnPrep := func(stxn transactions.SignedTxn) []byte {
+			appl := txntest.Txn{Type: protocol.ApplicationCallTx, Sender: stxn.Txn.Sender, ApplicationID: 12345}
+			return append(protocol.Encode(&stxn), protocol.Encode(&transactions.SignedTxn{Txn: appl.Txn()})...)
+		}
+		handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+		defer releasefunc()
+		err := handler.TransactionGroupResources(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		requireErrorResponse(t, rec, "failed analyzing the resources of the group: transaction 1: application does not exist: 12345", "group-resources-failed")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		handler, c, rec, releasefunc := prepareTransactionTest(t, -1, nil, config.GetDefaultLocal())
+		defer releasefunc()
+		err := handler.TransactionGroupResources(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPostTransactionGenesisMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	modifiers := map[string]func(*transactions.Transaction){
+		"hash": func(txn *transactions.Transaction) { txn.GenesisHash = crypto.Digest{0x01} },
+		"id":   func(txn *transactions.Transaction) { txn.GenesisID = "othernet-v1" },
+	}
+	for name, modify := range modifiers {
+		modify := modify
+		for _, method := range []string{"RawTransaction", "RawTransactionAsync"} {
+			method := method
+			t.Run(name+"-"+method, func(t *testing.T) {
+				txnPrep := func(stxn transactions.SignedTxn) []byte {
+					modify(&stxn.Txn)
+					return protocol.Encode(&stxn)
+				}
+				cfg := config.GetDefaultLocal()
+				cfg.EnableExperimentalAPI = true
+				handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, cfg)
+				defer releasefunc()
+				results := reflect.ValueOf(&handler).MethodByName(method).Call(postTransactionArgs(c, method))
+				err, _ := results[0].Interface().(error)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusBadRequest, rec.Code)
+				requireErrorResponse(t, rec, "transaction genesis does not match the network of this node", "genesis-mismatch")
+			})
+		}
+	}
+}
+
+func TestPostTransactionBodyLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, method := range []string{"RawTransaction", "RawTransactionAsync"} {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+			var limit int64
+			txnPrep := func(stxn transactions.SignedTxn) []byte {
+				encoded := protocol.Encode(&stxn)
+				// the body fits the first transaction of the group, but not the second one.
+				limit = int64(len(encoded)) + 1
+				return append(encoded, encoded...)
+			}
+			cfg := config.GetDefaultLocal()
+			cfg.EnableExperimentalAPI = true
+			handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, cfg)
+			defer releasefunc()
+			// the length of the body is not declared, so that it's only found to be too large while decoding.
+			c.Request().ContentLength = -1
+			limited := middlewares.MakeBodyLimit(limit, nil)(func(c echo.Context) error {
+				results := reflect.ValueOf(&handler).MethodByName(method).Call(postTransactionArgs(c, method))
+				err, _ := results[0].Interface().(error)
+				return err
+			})
+			require.NoError(t, limited(c))
+			require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+			requireErrorResponse(t, rec, fmt.Sprintf("request body exceeds the limit of %d bytes", limit), "request-too-large")
+		})
+	}
 }
 
-func postTransactionTest(t *testing.T, txnToUse int, expectedCode int, method string, enableExperimental bool) {
+func TestPostTransactionWarnings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
 	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		stxn.Txn.LastValid = stxn.Txn.FirstValid + 5
 		return protocol.Encode(&stxn)
 	}
-	cfg := config.GetDefaultLocal()
-	cfg.EnableExperimentalAPI = enableExperimental
-	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, txnPrep, cfg)
-	defer releasefunc()
-	results := reflect.ValueOf(&handler).MethodByName(method).Call([]reflect.Value{reflect.ValueOf(c)})
-	require.Equal(t, 1, len(results))
-	// if the method returns nil, the cast would fail so use type assertion test
-	err, _ := results[0].Interface().(error)
-	require.NoError(t, err)
-	require.Equal(t, expectedCode, rec.Code)
+
+	t.Run("lenient", func(t *testing.T) {
+		handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+		defer releasefunc()
+		require.NoError(t, handler.RawTransaction(c, model.RawTransactionParams{}))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response model.PostTransactionsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.NotNil(t, response.Warnings)
+		require.Contains(t, *response.Warnings, model.SubmissionWarning{
+			Code:    "last-valid-too-close",
+			Index:   0,
+			Message: "last valid round 5 is only 4 rounds after the latest round 1",
+		})
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+		defer releasefunc()
+		strict := true
+		require.NoError(t, handler.RawTransaction(c, model.RawTransactionParams{Strict: &strict}))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		requireErrorResponse(t, rec, "transaction group was rejected in strict mode because of submission warnings", "submission-warnings")
+
+		var response model.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.NotNil(t, response.Data)
+		require.Contains(t, *response.Data, "warnings")
+	})
 }
 
-func TestPostTransaction(t *testing.T) {
+func TestPostTransactionIdempotencyKey(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
-	postTransactionTest(t, -1, 400, "RawTransaction", false)
-	postTransactionTest(t, 0, 200, "RawTransaction", false)
+	var body []byte
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		body = protocol.Encode(&stxn)
+		return body
+	}
+	handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+	defer releasefunc()
+	handler.Submissions = v2.MakeSubmissionCache(logging.TestingLog(t), filepath.Join(t.TempDir(), v2.SubmissionCacheFilename), time.Hour)
+	post := func(body []byte, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.RawTransaction(echo.New().NewContext(req, rec), model.RawTransactionParams{IdempotencyKey: &key}))
+		return rec
+	}
+
+	key := "retry-me"
+	require.NoError(t, handler.RawTransaction(c, model.RawTransactionParams{IdempotencyKey: &key}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.PostTransactionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	// the transaction is now in the pool, a retry returns the original response
+	handler.Node.(*mockNode).err = errors.New("transaction already in ledger")
+	retry := post(body, key)
+	require.Equal(t, http.StatusOK, retry.Code)
+	var retried model.PostTransactionsResponse
+	require.NoError(t, json.Unmarshal(retry.Body.Bytes(), &retried))
+	require.Equal(t, response, retried)
+
+	// without the key, the submission fails
+	require.NotEqual(t, http.StatusOK, post(body, "").Code)
+
+	// the key cannot be reused for other transactions
+	reused := post(append(append([]byte{}, body...), body...), key)
+	require.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+	requireErrorResponse(t, reused, "idempotency key was already used with different transactions", "idempotency-key-reused")
+
+	tooLong := post(body, strings.Repeat("k", 257))
+	require.Equal(t, http.StatusBadRequest, tooLong.Code)
+	requireErrorResponse(t, tooLong, "idempotency key cannot be longer than 256 bytes", "idempotency-key-too-long")
 }
 
 func TestPostTransactionAsync(t *testing.T) {
@@ -886,6 +2379,167 @@ func TestPostTransactionAsync(t *testing.T) {
 	postTransactionTest(t, 0, 200, "RawTransactionAsync", true)
 }
 
+func TestConvertEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler := v2.Handlers{Log: logging.Base(), Shutdown: make(chan struct{})}
+	convert := func(body []byte, params model.ConvertEncodingParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		require.NoError(t, handler.ConvertEncoding(c, params))
+		return rec
+	}
+	jsonFormat := model.ConvertEncodingParamsInputFormatJson
+	msgpackFormat := model.ConvertEncodingParamsOutputFormatMsgpack
+	canonical := true
+
+	stxn := transactions.SignedTxn{
+		Txn: txntest.Txn{
+			Type:        protocol.PaymentTx,
+			Sender:      poolAddr,
+			Receiver:    basics.Address{1},
+			Amount:      5,
+			Note:        []byte("note"),
+			GenesisHash: crypto.Digest{1},
+		}.Txn(),
+	}
+
+	// msgpack to JSON and back
+	rec := convert(protocol.Encode(&stxn), model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeTxn, Canonical: &canonical})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get(echo.HeaderContentType))
+	var decoded transactions.SignedTxn
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &decoded))
+	require.Equal(t, stxn, decoded)
+
+	rec = convert(rec.Body.Bytes(), model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeTxn, InputFormat: &jsonFormat, OutputFormat: &msgpackFormat})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, protocol.Encode(&stxn), rec.Body.Bytes())
+
+	// the canonical encoding of a block with transactions is accepted
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = 7
+	blk.BlockHeader.GenesisID = "test"
+	blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+	blk.BlockHeader.GenesisHash = crypto.Digest{1}
+	txib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+	require.NoError(t, err)
+	blk.Payset = append(blk.Payset, txib)
+	rec = convert(protocol.Encode(&blk), model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeBlock, OutputFormat: &msgpackFormat, Canonical: &canonical})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, protocol.Encode(&blk), rec.Body.Bytes())
+
+	// an explicit zero field is valid msgpack, but not canonical
+	var nonCanonical []byte
+	require.NoError(t, codec.NewEncoderBytes(&nonCanonical, new(codec.MsgpackHandle)).Encode(map[string]interface{}{
+		"txn": map[string]interface{}{"type": "pay", "fee": 0},
+	}))
+	rec = convert(nonCanonical, model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeTxn})
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = convert(nonCanonical, model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeTxn, Canonical: &canonical})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "object is not canonically encoded", "non-canonical-encoding")
+
+	rec = convert(nil, model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeGenesis})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = convert([]byte("garbage"), model.ConvertEncodingParams{Type: model.ConvertEncodingParamsTypeDelta})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = convert(protocol.Encode(&stxn), model.ConvertEncodingParams{Type: "account"})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestMergeTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	secrets := make([]*crypto.SignatureSecrets, 3)
+	pks := make([]crypto.PublicKey, 3)
+	for i := range secrets {
+		secrets[i] = crypto.GenerateSignatureSecrets(crypto.Seed{byte(i + 1)})
+		pks[i] = secrets[i].SignatureVerifier
+	}
+	msigAddr, err := crypto.MultisigAddrGen(1, 2, pks)
+	require.NoError(t, err)
+
+	makeTxn := func(amount uint64) transactions.Transaction {
+		return txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   basics.Address(msigAddr),
+			Receiver: poolAddr,
+			Amount:   amount,
+		}.Txn()
+	}
+	sign := func(txn transactions.Transaction, signer int) transactions.SignedTxn {
+		msig, err := crypto.MultisigSign(txn, msigAddr, 1, 2, pks, *secrets[signer])
+		require.NoError(t, err)
+		return transactions.SignedTxn{Txn: txn, Msig: msig}
+	}
+	encode := func(stxns ...transactions.SignedTxn) []byte {
+		var body []byte
+		for i := range stxns {
+			body = append(body, protocol.Encode(&stxns[i])...)
+		}
+		return body
+	}
+
+	handler := v2.Handlers{
+		Node:     makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	merge := func(body []byte, format string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		err := handler.MergeTransactions(c, model.MergeTransactionsParams{Format: (*model.MergeTransactionsParamsFormat)(&format)})
+		require.NoError(t, err)
+		return rec
+	}
+
+	txn1, txn2 := makeTxn(1), makeTxn(2)
+	rec := merge(encode(sign(txn1, 0), sign(txn2, 2), sign(txn1, 1), sign(txn2, 0), sign(txn1, 0)), "msgpack")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response struct {
+		Txns []transactions.SignedTxn `codec:"txns"`
+	}
+	require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &response))
+	require.Len(t, response.Txns, 2)
+	require.Equal(t, txn1.ID(), response.Txns[0].ID())
+	require.Equal(t, txn2.ID(), response.Txns[1].ID())
+	for _, stxn := range response.Txns {
+		require.Equal(t, 2, stxn.Msig.Signatures())
+		require.NoError(t, crypto.MultisigVerify(stxn.Txn, msigAddr, stxn.Msig))
+	}
+
+	rec = merge(encode(sign(txn1, 0), sign(txn1, 2)), "json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"txns"`)
+
+	// a signature made over another transaction is rejected.
+	forged := sign(txn1, 1)
+	forged.Msig.Subsigs[1].Sig = sign(txn2, 1).Msig.Subsigs[1].Sig
+	rec = merge(encode(sign(txn1, 0), forged), "json")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// a multisig which does not belong to the sender is rejected.
+	other := sign(txn1, 0)
+	other.Msig.Threshold = 1
+	rec = merge(encode(sign(txn1, 1), other), "json")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// a single signature transaction cannot be merged.
+	rec = merge(encode(transactions.SignedTxn{Txn: txn1, Sig: secrets[0].Sign(txn1)}), "json")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = merge(nil, "json")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = merge(encode(sign(txn1, 0)), "yaml")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func simulateTransactionTest(t *testing.T, txnToUse int, format string, expectedCode int) {
 	txnPrep := func(stxn transactions.SignedTxn) []byte {
 		request := v2.PreEncodedSimulateRequest{
@@ -1307,6 +2961,200 @@ func TestSimulateTransactionMultipleGroups(t *testing.T) {
 	require.Contains(t, bodyString, "expected 1 transaction group, got 2")
 }
 
+func TestSimulateTransactionBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// prepare node and handler
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+
+	sender := roots[0]
+	receiver := roots[1]
+	txn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Address(),
+		Receiver: receiver.Address(),
+		Amount:   1,
+	})
+	stxn := txn.Txn().Sign(sender.Secrets())
+
+	// the same transaction twice would be rejected as a duplicate if the groups were evaluated together
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{
+			{Txns: []transactions.SignedTxn{stxn}},
+			{Txns: []transactions.SignedTxn{stxn}},
+		},
+	}
+	simulate := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeReflect(&request)))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		require.NoError(t, handler.SimulateTransactionBatch(c, model.SimulateTransactionBatchParams{}))
+		return rec
+	}
+
+	rec := simulate()
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+	var response model.SimulateResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(mockLedger.Latest()), response.LastRound)
+	require.Len(t, response.TxnGroups, 2)
+	for _, group := range response.TxnGroups {
+		require.Nil(t, group.FailureMessage)
+		require.Len(t, group.TxnResults, 1)
+	}
+
+	request.Session = "session"
+	rec = simulate()
+	require.Equal(t, 400, rec.Code)
+	requireErrorResponse(t, rec, "simulation sessions are not supported when simulating a batch", "simulate-batch-session")
+}
+
+func TestSimulateTransactionSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// prepare node and handler
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+
+	sender := roots[0]
+	receiver := roots[1]
+	txn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Address(),
+		Receiver: receiver.Address(),
+		Amount:   1,
+	})
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{
+			{Txns: []transactions.SignedTxn{txn.Txn().Sign(sender.Secrets())}},
+		},
+		Session: "session",
+	}
+
+	// without API tokens, sessions are scoped to the address of the client
+	simulateFrom := func(remoteAddr string) *string {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeReflect(&request)))
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		require.NoError(t, handler.SimulateTransaction(c, model.SimulateTransactionParams{}))
+		require.Equal(t, 200, rec.Code, rec.Body.String())
+		var response model.SimulateResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		return response.TxnGroups[0].FailureMessage
+	}
+	simulate := func() *string {
+		// the address httptest requests come from, as the ones of newReq
+		return simulateFrom("192.0.2.1:1234")
+	}
+
+	// the session holds the transaction once it has been simulated, so it cannot be simulated again
+	require.Nil(t, simulate())
+	require.NotNil(t, simulate())
+	// except by another client, which gets its own session
+	require.Nil(t, simulateFrom("198.51.100.7:4160"))
+
+	c, rec := newReq(t)
+	require.NoError(t, handler.DeleteSimulateSession(c, "session"))
+	require.Equal(t, 200, rec.Code)
+	require.Nil(t, simulate())
+
+	c, rec = newReq(t)
+	require.NoError(t, handler.DeleteSimulateSession(c, "unknown"))
+	require.Equal(t, 404, rec.Code)
+	requireErrorResponse(t, rec, "simulation session not found", "simulate-session-not-found")
+}
+
+func TestSimulateTransactionSourceMaps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+	txn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   roots[0].Address(),
+		Receiver: roots[1].Address(),
+		Amount:   1,
+	})
+	sourceMap := logic.SourceMap{Version: 3, Sources: []string{"app.teal"}, Names: []string{}, Mappings: "AAAA"}
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{
+			{Txns: []transactions.SignedTxn{txn.Txn().Sign(roots[0].Secrets())}},
+		},
+		SourceMaps: []v2.PreEncodedSimulateSourceMap{{ProgramHash: make([]byte, 31), SourceMap: sourceMap}},
+	}
+	simulate := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeJSON(&request)))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		require.NoError(t, handler.SimulateTransaction(c, model.SimulateTransactionParams{}))
+		return rec
+	}
+
+	rec := simulate()
+	require.Equal(t, 400, rec.Code)
+	require.Contains(t, rec.Body.String(), "program hash of source map has length 31")
+
+	request.SourceMaps[0].ProgramHash = make([]byte, 32)
+	rec = simulate()
+	require.Equal(t, 400, rec.Code)
+	require.Contains(t, rec.Body.String(), "basic trace must be enabled when supplying source maps")
+
+	// debugging simulations requires the developer API, like execution traces
+	c, rec := newReq(t)
+	require.NoError(t, handler.SimulateDebugAdapter(c))
+	require.Equal(t, 404, rec.Code)
+	requireErrorResponse(t, rec, "/transactions/simulate/debug was not enabled in the configuration file by setting the EnableDeveloperAPI to true", "developer-api-disabled")
+
+	mockNode.config.EnableDeveloperAPI = true
+	request.ExecTraceConfig.Enable = true
+	rec = simulate()
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -1366,14 +3214,277 @@ func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
 	require.Equal(t, expectedCode, rec.Code)
 }
 
-func TestAbortCatchup(t *testing.T) {
+func TestAbortCatchup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	goodCatchPoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	abortCatchupTest(t, goodCatchPoint, 200)
+	badCatchPoint := "bad catchpoint"
+	abortCatchupTest(t, badCatchPoint, 400)
+}
+
+type shutdownRecorder struct {
+	delays []time.Duration
+}
+
+func (r *shutdownRecorder) RequestShutdown(delay time.Duration) {
+	r.delays = append(r.delays, delay)
+}
+
+func TestShutdownNode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+	e := echo.New()
+
+	shutdown := func(params model.ShutdownNodeParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.ShutdownNode(e.NewContext(req, rec), params))
+		return rec
+	}
+
+	// the server did not provide a way to shut it down
+	rec := shutdown(model.ShutdownNodeParams{})
+	require.Equal(t, http.StatusNotImplemented, rec.Code)
+
+	recorder := &shutdownRecorder{}
+	handler.Shutdowner = recorder
+	timeout := uint64(5)
+	rec = shutdown(model.ShutdownNodeParams{Timeout: &timeout})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ShutdownNodeResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(mockLedger.Latest()), response.Round)
+	require.False(t, response.CatchpointWritten)
+	require.Nil(t, response.Catchpoint)
+	require.False(t, mockNode.drained)
+	require.Equal(t, []time.Duration{5 * time.Second}, recorder.delays)
+
+	drain := true
+	rec = shutdown(model.ShutdownNodeParams{Drain: &drain})
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.ShutdownNodeResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.True(t, mockNode.drained)
+	require.False(t, response.CatchpointWritten)
+	require.Nil(t, response.Catchpoint)
+	require.Equal(t, []time.Duration{5 * time.Second, 0}, recorder.delays)
+
+	// the drained round is a catchpoint round
+	mockNode.drainCatchpoint = "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	rec = shutdown(model.ShutdownNodeParams{Drain: &drain})
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.ShutdownNodeResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.True(t, response.CatchpointWritten)
+	require.NotNil(t, response.Catchpoint)
+	require.Equal(t, mockNode.drainCatchpoint, *response.Catchpoint)
+}
+
+func TestSubsystems(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		return protocol.Encode(&stxn)
+	}
+	handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep, config.GetDefaultLocal())
+	defer releasefunc()
+
+	decode := func(rec *httptest.ResponseRecorder) map[string]bool {
+		var response model.SubsystemsResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		running := make(map[string]bool)
+		for _, s := range response.Subsystems {
+			running[s.Name] = s.Running
+		}
+		return running
+	}
+
+	listCtx, listRec := newReq(t)
+	require.NoError(t, handler.GetSubsystems(listCtx))
+	require.Equal(t, http.StatusOK, listRec.Code)
+	require.Equal(t, map[string]bool{"catchpoint-generation": true, "txn-relay": true, "txn-submit": true}, decode(listRec))
+
+	stopCtx, stopRec := newReq(t)
+	require.NoError(t, handler.StopSubsystem(stopCtx, "txn-submit"))
+	require.Equal(t, http.StatusOK, stopRec.Code)
+	require.Equal(t, map[string]bool{"catchpoint-generation": true, "txn-relay": true, "txn-submit": false}, decode(stopRec))
+
+	require.NoError(t, handler.RawTransaction(c, model.RawTransactionParams{}))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	requireErrorResponse(t, rec, "transaction submission was stopped by the operator of the node", "txn-submit-stopped")
+
+	startCtx, startRec := newReq(t)
+	require.NoError(t, handler.StartSubsystem(startCtx, "txn-submit"))
+	require.Equal(t, http.StatusOK, startRec.Code)
+	require.True(t, decode(startRec)["txn-submit"])
+
+	unknownCtx, unknownRec := newReq(t)
+	require.NoError(t, handler.StopSubsystem(unknownCtx, "agreement"))
+	require.Equal(t, http.StatusNotFound, unknownRec.Code)
+	requireErrorResponse(t, unknownRec, "unknown subsystem agreement", "unknown-subsystem")
+}
+
+func TestGetFeatures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableP2P = true
+	cfg.EnableAppEventIndex = true
+	handler, _, _, releasefunc := prepareTransactionTest(t, 0, func(stxn transactions.SignedTxn) []byte { return nil }, cfg)
+	defer releasefunc()
+
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetFeatures(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.FeaturesResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	features := make(map[string]model.Feature)
+	for _, f := range response.Features {
+		features[f.Name] = f
+	}
+
+	p2p := features[string(config.FeatureP2PNetwork)]
+	require.True(t, p2p.Requested)
+	require.True(t, p2p.Enabled)
+	require.False(t, p2p.Available)
+	require.NotNil(t, p2p.Reason)
+	appEvents := features[string(config.FeatureAppEventIndex)]
+	require.True(t, appEvents.Enabled)
+	require.Nil(t, appEvents.Reason)
+	require.False(t, features[string(config.FeatureTxnEvalTracer)].Requested)
+}
+
+func TestGenerateCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 0
+	handler, _, _, releasefunc := prepareTransactionTest(t, -1, nil, cfg)
+	defer releasefunc()
+
+	disabledCtx, disabledRec := newReq(t)
+	require.NoError(t, handler.GenerateCatchpoint(disabledCtx))
+	require.Equal(t, http.StatusBadRequest, disabledRec.Code)
+	requireErrorResponse(t, disabledRec, "catchpoints are not enabled on this node, CatchpointInterval must be set and CatchpointTracking not be -1", "catchpoints-disabled")
+
+	cfg.CatchpointInterval = 10000
+	cfg.CatchpointUploadBucket = "catchpoints"
+	handler.Node.(*mockNode).config = cfg
+	ctx, rec := newReq(t)
+	require.NoError(t, handler.GenerateCatchpoint(ctx))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.CatchpointGenerateResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.CatchpointGenerateResponse{Round: 10000, Upload: true}, response)
+}
+
+func TestSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, releasefunc := prepareTransactionTest(t, -1, nil, config.GetDefaultLocal())
+	defer releasefunc()
+	mockNode := handler.Node.(*mockNode)
+
+	statusCtx, statusRec := newReq(t)
+	require.NoError(t, handler.GetSnapshotStatus(statusCtx))
+	require.Equal(t, http.StatusNotFound, statusRec.Code)
+	requireErrorResponse(t, statusRec, "no snapshot was requested", "snapshot-not-found")
+
+	excludeKeys := true
+	ctx, rec := newReq(t)
+	require.NoError(t, handler.CreateSnapshot(ctx, model.CreateSnapshotParams{ExcludeKeys: &excludeKeys}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.SnapshotStatusResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, model.SnapshotStatusResponse{Running: true, Started: 1700000000}, response)
+
+	ctx, rec = newReq(t)
+	require.NoError(t, handler.CreateSnapshot(ctx, model.CreateSnapshotParams{}))
+	require.Equal(t, http.StatusConflict, rec.Code)
+	requireErrorResponse(t, rec, "a snapshot is already being written", "snapshot-in-progress")
+
+	mockNode.finishSnapshot()
+	statusCtx, statusRec = newReq(t)
+	require.NoError(t, handler.GetSnapshotStatus(statusCtx))
+	require.Equal(t, http.StatusOK, statusRec.Code)
+	response = model.SnapshotStatusResponse{}
+	require.NoError(t, protocol.DecodeJSON(statusRec.Body.Bytes(), &response))
+	require.False(t, response.Running)
+	require.Nil(t, response.Error)
+	require.Equal(t, "/data/snapshots/snapshot.tar.gz", *response.Path)
+	require.Equal(t, uint64(100), *response.Round)
+	require.Equal(t, uint64(1024), *response.Size)
+	require.Equal(t, "00ff", *response.Sha256)
+	require.False(t, *response.ParticipationKeys)
+
+	mockNode.snapshot.Err = errors.New("disk full")
+	statusCtx, statusRec = newReq(t)
+	require.NoError(t, handler.GetSnapshotStatus(statusCtx))
+	response = model.SnapshotStatusResponse{}
+	require.NoError(t, protocol.DecodeJSON(statusRec.Body.Bytes(), &response))
+	require.Equal(t, "disk full", *response.Error)
+	require.Nil(t, response.Path)
+}
+
+func TestGetCatchupStatus(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+	e := echo.New()
+
+	getStatus := func(catchpoint string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.GetCatchupStatus(e.NewContext(req, rec), catchpoint))
+		return rec
+	}
+
 	goodCatchPoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
-	abortCatchupTest(t, goodCatchPoint, 200)
-	badCatchPoint := "bad catchpoint"
-	abortCatchupTest(t, badCatchPoint, 400)
+	rec := getStatus("bad catchpoint")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = getStatus(goodCatchPoint)
+	requireErrorResponse(t, rec, "the node is not catching up to this catchpoint", "catchup-not-in-progress")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	stageStart := time.Now().Add(-time.Minute)
+	mockNode.catchupStats = &catchup.CatchpointCatchupStats{
+		CatchpointLabel:   goodCatchPoint,
+		Stage:             ledger.CatchpointCatchupStateLedgerDownload,
+		StartTime:         stageStart,
+		StageStartTime:    stageStart,
+		TotalAccounts:     100,
+		ProcessedAccounts: 100,
+	}
+	rec = getStatus(goodCatchPoint)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.CatchupStatusResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, goodCatchPoint, response.Catchpoint)
+	require.Equal(t, model.CatchupStatusResponseStage("ledger-download"), response.Stage)
+	require.Equal(t, uint64(100), response.ProcessedAccounts)
+	// half of the stage took a minute, so it should complete about a minute from now
+	require.NotNil(t, response.EstimatedStageCompletionTime)
+	require.InDelta(t, time.Now().Add(time.Minute).Unix(), int64(*response.EstimatedStageCompletionTime), 5)
+
+	// a different catchpoint is not in progress
+	rec = getStatus("5894691#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA")
+	require.Equal(t, http.StatusNotFound, rec.Code)
 }
 
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int,
@@ -1515,6 +3626,103 @@ func TestTealDisassemble(t *testing.T) {
 	tealDisassembleTest(t, largeProgram, 400, "http: request body too large", true)
 }
 
+func TestRecognizeTealTemplate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	recognize := func(program []byte) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(program))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.RecognizeTealTemplate(c))
+		return rec
+	}
+
+	// a hash time lock contract
+	htlc := `txn Fee
+int 1000
+<=
+txn TypeEnum
+int 1
+==
+&&
+txn Receiver
+global ZeroAddress
+==
+&&
+txn Amount
+int 0
+==
+&&
+txn CloseRemainderTo
+addr 7777777777777777777777777777777777777777777777777774MSJUVU
+==
+arg_0
+sha256
+byte base64 QzYhq9JlYbn2QdOMrhyxVlNtNjeyvyJc/I8d8VAGfGc=
+==
+&&
+txn CloseRemainderTo
+addr Y76M3MSY6DKBRHBL7C3NNDXGS5IIMQVQVUAB6MP4XEMMGVF2QWNPL226CA
+==
+txn FirstValid
+int 3000
+>
+&&
+||
+&&`
+	ops, err := logic.AssembleString(htlc)
+	require.NoError(t, err)
+	rec := recognize(ops.Program)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.TealTemplateResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, "htlc", response.Template)
+	require.Equal(t, uint64(1), response.Version)
+	require.Nil(t, response.Warnings)
+	values := make(map[string]string)
+	for _, param := range response.Params {
+		values[param.Name] = param.Value
+	}
+	require.Equal(t, map[string]string{
+		"TMPL_FEE":     "1000",
+		"TMPL_RCV":     "7777777777777777777777777777777777777777777777777774MSJUVU",
+		"TMPL_HASHFN":  "sha256",
+		"TMPL_HASHIMG": "QzYhq9JlYbn2QdOMrhyxVlNtNjeyvyJc/I8d8VAGfGc=",
+		"TMPL_OWN":     "Y76M3MSY6DKBRHBL7C3NNDXGS5IIMQVQVUAB6MP4XEMMGVF2QWNPL226CA",
+		"TMPL_TIMEOUT": "3000",
+	}, values)
+
+	// later versions allow the program to rekey the account
+	ops, err = logic.AssembleStringWithVersion(htlc, 2)
+	require.NoError(t, err)
+	rec = recognize(ops.Program)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.TealTemplateResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(2), response.Version)
+	require.NotNil(t, response.Warnings)
+	require.Len(t, *response.Warnings, 1)
+
+	ops, err = logic.AssembleString("int 1")
+	require.NoError(t, err)
+	rec = recognize(ops.Program)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "program does not match a known template", "template-not-recognized")
+
+	rec = recognize([]byte{1, 99})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func tealDryrunTest(
 	t *testing.T, obj *model.DryrunRequest, format string,
 	expCode int, expResult string, enableDeveloperAPI bool,
@@ -1559,7 +3767,7 @@ func tealDryrunTest(
 		messages := *response.Txns[0].AppCallMessages
 		require.GreaterOrEqual(t, len(messages), 1)
 		require.Equal(t, expResult, messages[len(messages)-1])
-	} else if rec.Code == 400 {
+	} else if rec.Code == 400 || rec.Code == http.StatusRequestEntityTooLarge {
 		var response model.ErrorResponse
 		data := rec.Body.Bytes()
 		err = protocol.DecodeJSON(data, &response)
@@ -1646,7 +3854,7 @@ func TestTealDryrun(t *testing.T) {
 
 	// This should fail inside the handler when reading the bytes from the request body.
 	gdr.ProtocolVersion = strings.Repeat("a", v2.MaxTealDryrunBytes+1)
-	tealDryrunTest(t, &gdr, "json", 400, "http: request body too large", true)
+	tealDryrunTest(t, &gdr, "json", http.StatusRequestEntityTooLarge, fmt.Sprintf(middlewares.RequestTooLargeMessage, v2.MaxTealDryrunBytes), true)
 }
 
 func TestAppendParticipationKeys(t *testing.T) {
@@ -1772,6 +3980,265 @@ func TestAppendParticipationKeys(t *testing.T) {
 	})
 }
 
+func TestParticipationKeyExportImport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	id := account.ParticipationID{}
+	id[0] = 10
+
+	t.Run("Transport key", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.GetParticipationTransportKey(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response model.ParticipationTransportKeyResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.TransportKey, account.TransportKeySize)
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		params := model.ExportParticipationKeyByIDParams{Recipient: make([]byte, account.TransportKeySize)}
+		err := handler.ExportParticipationKeyByID(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.False(t, mockNode.exportKept)
+	})
+
+	t.Run("Export and keep", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		keep := true
+		params := model.ExportParticipationKeyByIDParams{Recipient: make([]byte, account.TransportKeySize), Keep: &keep}
+		err := handler.ExportParticipationKeyByID(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.True(t, mockNode.exportKept)
+	})
+
+	t.Run("Export invalid recipient", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		params := model.ExportParticipationKeyByIDParams{Recipient: []byte{1, 2, 3}}
+		err := handler.ExportParticipationKeyByID(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "recipient transport key must be 32 bytes")
+	})
+
+	t.Run("Export not found", func(t *testing.T) {
+		mockNode := makeMockNode(mockLedger, t.Name(), account.ErrParticipationIDNotFound, cannedStatusReportGolden, false)
+		handler := v2.Handlers{
+			Node:     mockNode,
+			Log:      logging.Base(),
+			Shutdown: make(chan struct{}),
+		}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		params := model.ExportParticipationKeyByIDParams{Recipient: make([]byte, account.TransportKeySize)}
+		err := handler.ExportParticipationKeyByID(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		mockNode.id = id
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte{1, 2, 3}))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ImportParticipationKey(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response model.PostParticipationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Equal(t, id.String(), response.PartId)
+	})
+
+	t.Run("Import empty body", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(nil))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ImportParticipationKey(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestGetParticipationMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	handler := v2.Handlers{
+		Node:     makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetParticipationMetrics(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ParticipationMetricsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	expected := model.AccountParticipationMetrics{
+		Address:             poolAddr.String(),
+		Proposals:           2,
+		SoftVotes:           10,
+		CertVotes:           9,
+		NextVotes:           1,
+		CredentialsWon:      44,
+		CredentialsExpected: 40.5,
+	}
+	require.Equal(t, []model.AccountParticipationMetrics{expected}, response.Metrics)
+
+	handler.Node = makeMockNode(mockLedger, t.Name(), errors.New("follower node"), cannedStatusReportGolden, false)
+	c, rec = newReq(t)
+	err = handler.GetParticipationMetrics(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	requireErrorResponse(t, rec, "failed retrieving participation metrics: follower node", "participation-metrics-unavailable")
+}
+
+func TestGetAgreementStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	handler := v2.Handlers{
+		Node:     makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetAgreementStatus(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AgreementStatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(12), response.Round)
+	require.Equal(t, uint64(1), response.Period)
+	require.Equal(t, uint64(2), response.Step)
+	require.Equal(t, uint64(3), response.ProposalsSeen)
+	require.Equal(t, []model.AgreementStepVotes{{Period: 1, Step: 1, Votes: 20, Weight: 2500}}, response.Votes)
+	require.NotContains(t, rec.Body.String(), poolAddr.String())
+
+	// the attempts of the accounts of the node are only served by the private API
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetAgreementAccounts(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var accounts model.AgreementAccountsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &accounts))
+	require.Equal(t, uint64(12), accounts.Round)
+	expected := model.AgreementAccountStatus{
+		Address:          poolAddr.String(),
+		ProposalAttempts: 2,
+		VoteAttempts:     3,
+		Votes:            1,
+	}
+	require.Equal(t, []model.AgreementAccountStatus{expected}, accounts.Accounts)
+
+	handler.Node = makeMockNode(mockLedger, t.Name(), errors.New("follower node"), cannedStatusReportGolden, false)
+	c, rec = newReq(t)
+	err = handler.GetAgreementStatus(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	requireErrorResponse(t, rec, "failed retrieving agreement status: follower node", "agreement-status-unavailable")
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetAgreementAccounts(c))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestProveParticipationChallenge(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	id := account.ParticipationID{}
+	id[0] = 10
+	challenge := []byte("are you the node of this account?")
+
+	t.Run("Prove", func(t *testing.T) {
+		c, rec := newReq(t)
+		params := model.ProveParticipationChallengeParams{Challenge: challenge}
+		err := handler.ProveParticipationChallenge(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response model.ParticipationChallengeResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		var selectionKey crypto.VrfPubkey
+		var proof crypto.VrfProof
+		require.Len(t, response.SelectionParticipationKey, len(selectionKey))
+		require.Len(t, response.Proof, len(proof))
+		copy(selectionKey[:], response.SelectionParticipationKey)
+		copy(proof[:], response.Proof)
+		require.True(t, account.ParticipationChallenge(challenge).Verify(selectionKey, proof))
+	})
+
+	t.Run("Invalid challenge", func(t *testing.T) {
+		c, rec := newReq(t)
+		params := model.ProveParticipationChallengeParams{Challenge: make([]byte, account.MaxParticipationChallengeSize+1)}
+		err := handler.ProveParticipationChallenge(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "challenge must be between 1 and 1024 bytes")
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockNode.err = account.ErrParticipationIDNotFound
+		defer func() { mockNode.err = nil }()
+		c, rec := newReq(t)
+		params := model.ProveParticipationChallengeParams{Challenge: challenge}
+		err := handler.ProveParticipationChallenge(c, id.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 // TxnMerkleElemRaw this struct helps creates a hashable struct from the bytes
 type TxnMerkleElemRaw struct {
 	Txn  crypto.Digest // txn id
@@ -1913,6 +4380,7 @@ func insertRounds(a *require.Assertions, h v2.Handlers, numRounds int) {
 			blk = addStateProof(blk)
 		}
 		blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+		blk.BlockHeader.GenesisHash = crypto.Digest{1}
 		a.NoError(ledger.(*data.Ledger).AddBlock(blk, agreement.Certificate{}))
 		lastBlk = blk
 	}
@@ -1984,7 +4452,92 @@ func TestHeaderProofStateProofNotFound(t *testing.T) {
 	a.Equal(404, responseRecorder.Code)
 }
 
-func TestGetBlockProof200(t *testing.T) {
+func TestGetBlockProof200(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	handler, ctx, responseRecorder, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	insertRounds(a, handler, 1000)
+
+	a.NoError(handler.GetLightBlockHeaderProof(ctx, stateProofInterval*2+2))
+	a.Equal(200, responseRecorder.Code)
+
+	blkHdrArr, err := stateproof.FetchLightHeaders(handler.Node.LedgerForAPI(), stateProofInterval, basics.Round(stateProofInterval*3))
+	a.NoError(err)
+
+	leafproof, err := stateproof.GenerateProofOfLightBlockHeaders(stateProofInterval, blkHdrArr, 1)
+	a.NoError(err)
+
+	proofResp := model.LightBlockHeaderProofResponse{}
+	a.NoError(json.Unmarshal(responseRecorder.Body.Bytes(), &proofResp))
+	a.Equal(proofResp.Proof, leafproof.GetConcatenatedProof())
+	a.Equal(proofResp.Treedepth, uint64(leafproof.TreeDepth))
+}
+
+func TestLightBlockHeaderProofBundle200(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	handler, ctx, responseRecorder, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	insertRounds(a, handler, 1000)
+
+	round := uint64(stateProofInterval*2 + 2)
+	a.NoError(handler.GetLightBlockHeaderProofBundle(ctx, round))
+	a.Equal(200, responseRecorder.Code)
+
+	response := model.LightBlockHeaderProofBundleResponse{}
+	a.NoError(json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+
+	hdr, err := handler.Node.LedgerForAPI().BlockHdr(basics.Round(round))
+	a.NoError(err)
+	lightHdr := hdr.ToLightBlockHeader()
+	a.Equal(round, response.LightBlockHeader.Round)
+	a.Equal(lightHdr.Seed[:], response.LightBlockHeader.Seed)
+	a.Equal(lightHdr.GenesisHash[:], response.LightBlockHeader.GenesisHash)
+	a.Equal([]byte(lightHdr.Sha256TxnCommitment), response.LightBlockHeader.TransactionCommitment)
+
+	blkHdrArr, err := stateproof.FetchLightHeaders(handler.Node.LedgerForAPI(), stateProofInterval, basics.Round(stateProofInterval*3))
+	a.NoError(err)
+	leafproof, err := stateproof.GenerateProofOfLightBlockHeaders(stateProofInterval, blkHdrArr, 1)
+	a.NoError(err)
+	a.Equal(uint64(1), response.LightBlockHeaderProof.Index)
+	a.Equal(leafproof.GetConcatenatedProof(), response.LightBlockHeaderProof.Proof)
+
+	a.Equal(uint64(stateProofInterval*2+1), response.StateProof.Message.FirstAttestedRound)
+	a.Equal(uint64(stateProofInterval*3), response.StateProof.Message.LastAttestedRound)
+}
+
+func TestLightBlockHeaderProofBundleStateProofNotFound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	handler, ctx, responseRecorder, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	insertRounds(a, handler, 700)
+
+	a.NoError(handler.GetLightBlockHeaderProofBundle(ctx, 650))
+	a.Equal(404, responseRecorder.Code)
+}
+
+func TestBlockFinalityStateProofNotFound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	handler, ctx, responseRecorder, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	insertRounds(a, handler, 700)
+
+	a.NoError(handler.GetBlockFinality(ctx, 650, model.GetBlockFinalityParams{}))
+	a.Equal(404, responseRecorder.Code)
+}
+
+func TestBlockFinality200(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
 
@@ -1993,19 +4546,81 @@ func TestGetBlockProof200(t *testing.T) {
 
 	insertRounds(a, handler, 1000)
 
-	a.NoError(handler.GetLightBlockHeaderProof(ctx, stateProofInterval*2+2))
+	round := uint64(stateProofInterval*2 + 2)
+	format := model.GetBlockFinalityParamsFormatMsgpack
+	a.NoError(handler.GetBlockFinality(ctx, round, model.GetBlockFinalityParams{Format: &format}))
 	a.Equal(200, responseRecorder.Code)
 
-	blkHdrArr, err := stateproof.FetchLightHeaders(handler.Node.LedgerForAPI(), stateProofInterval, basics.Round(stateProofInterval*3))
+	var response struct {
+		BlockHeader           bookkeeping.BlockHeader     `codec:"block-header"`
+		Cert                  agreement.Certificate       `codec:"cert"`
+		LightBlockHeaderProof model.LightBlockHeaderProof `codec:"light-block-header-proof"`
+		StateProof            model.StateProof            `codec:"state-proof"`
+	}
+	a.NoError(protocol.DecodeReflect(responseRecorder.Body.Bytes(), &response))
+
+	hdr, err := handler.Node.LedgerForAPI().BlockHdr(basics.Round(round))
 	a.NoError(err)
+	a.Equal(hdr, response.BlockHeader)
 
+	blkHdrArr, err := stateproof.FetchLightHeaders(handler.Node.LedgerForAPI(), stateProofInterval, basics.Round(stateProofInterval*3))
+	a.NoError(err)
 	leafproof, err := stateproof.GenerateProofOfLightBlockHeaders(stateProofInterval, blkHdrArr, 1)
 	a.NoError(err)
+	a.Equal(uint64(1), response.LightBlockHeaderProof.Index)
+	a.Equal(leafproof.GetConcatenatedProof(), response.LightBlockHeaderProof.Proof)
+	a.Equal(uint64(leafproof.TreeDepth), response.LightBlockHeaderProof.Treedepth)
 
-	proofResp := model.LightBlockHeaderProofResponse{}
-	a.NoError(json.Unmarshal(responseRecorder.Body.Bytes(), &proofResp))
-	a.Equal(proofResp.Proof, leafproof.GetConcatenatedProof())
-	a.Equal(proofResp.Treedepth, uint64(leafproof.TreeDepth))
+	a.Equal([]byte{0x0, 0x1, 0x2}, response.StateProof.Message.BlockHeadersCommitment)
+	a.Equal(uint64(stateProofInterval*2+1), response.StateProof.Message.FirstAttestedRound)
+	a.Equal(uint64(stateProofInterval*3), response.StateProof.Message.LastAttestedRound)
+}
+
+// missingHeaderLedger fails to return the header of a round, as if the ledger no longer held it.
+type missingHeaderLedger struct {
+	v2.LedgerForAPI
+	missing basics.Round
+}
+
+func (l missingHeaderLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == l.missing {
+		return bookkeeping.BlockHeader{}, ledgercore.ErrNoEntry{Round: rnd}
+	}
+	return l.LedgerForAPI.BlockHdr(rnd)
+}
+
+func TestLightBlockHeaderProofMissingHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	insertRounds(require.New(t), handler, 1000)
+
+	// the first attested round of the state proof covering round is missing
+	round := uint64(stateProofInterval*2 + 2)
+	handler.Node = makeMockNode(missingHeaderLedger{handler.Node.LedgerForAPI(), basics.Round(stateProofInterval*2 + 1)}, t.Name(), nil, cannedStatusReportGolden, false)
+
+	endpoints := map[string]func(echo.Context) error{
+		"proof":  func(ctx echo.Context) error { return handler.GetLightBlockHeaderProof(ctx, round) },
+		"bundle": func(ctx echo.Context) error { return handler.GetLightBlockHeaderProofBundle(ctx, round) },
+		"finality": func(ctx echo.Context) error {
+			return handler.GetBlockFinality(ctx, round, model.GetBlockFinalityParams{})
+		},
+	}
+	for name, endpoint := range endpoints {
+		t.Run(name, func(t *testing.T) {
+			ctx, rec := newReq(t)
+			require.NoError(t, endpoint(ctx))
+			require.Equal(t, 404, rec.Code)
+
+			// a single error response is written
+			decoder := json.NewDecoder(rec.Body)
+			var response model.ErrorResponse
+			require.NoError(t, decoder.Decode(&response))
+			require.NotEmpty(t, response.Message)
+			require.False(t, decoder.More())
+		})
+	}
 }
 
 func TestStateproofTransactionForRound(t *testing.T) {
@@ -2131,6 +4746,16 @@ func TestExperimentalCheck(t *testing.T) {
 	require.Equal(t, "true\n", string(rec.Body.Bytes()))
 }
 
+// requireErrorResponse checks that rec holds a problem+json error with the given message and code.
+func requireErrorResponse(t *testing.T, rec *httptest.ResponseRecorder, message, code string) {
+	t.Helper()
+	require.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+	var response model.ErrorResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, message, response.Message)
+	require.Equal(t, code, *response.Code)
+}
+
 func TestTimestampOffsetNotInDevMode(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2143,7 +4768,7 @@ func TestTimestampOffsetNotInDevMode(t *testing.T) {
 	err := handler.GetBlockTimeStampOffset(c)
 	require.NoError(t, err)
 	require.Equal(t, 400, rec.Code)
-	require.Equal(t, "{\"message\":\"failed retrieving timestamp offset from node: cannot get block timestamp offset because we are not in dev mode\"}\n", rec.Body.String())
+	requireErrorResponse(t, rec, "failed retrieving timestamp offset from node: cannot get block timestamp offset because we are not in dev mode", "timestamp-offset-unavailable")
 	c, rec = newReq(t)
 
 	// TestSetBlockTimeStampOffset 400 - cannot set timestamp offset when not
@@ -2151,7 +4776,7 @@ func TestTimestampOffsetNotInDevMode(t *testing.T) {
 	err = handler.SetBlockTimeStampOffset(c, 1)
 	require.NoError(t, err)
 	require.Equal(t, 400, rec.Code)
-	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: cannot set block timestamp when not in dev mode\"}\n", rec.Body.String())
+	requireErrorResponse(t, rec, "failed to set timestamp offset on the node: cannot set block timestamp when not in dev mode", "timestamp-offset-rejected")
 }
 
 func TestTimestampOffsetInDevMode(t *testing.T) {
@@ -2165,7 +4790,7 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	err := handler.GetBlockTimeStampOffset(c)
 	require.NoError(t, err)
 	require.Equal(t, 404, rec.Code)
-	require.Equal(t, "{\"message\":\"failed retrieving timestamp offset from node: block timestamp offset was never set, using real clock for timestamps\"}\n", rec.Body.String())
+	requireErrorResponse(t, rec, "failed retrieving timestamp offset from node: block timestamp offset was never set, using real clock for timestamps", "timestamp-offset-unavailable")
 	c, rec = newReq(t)
 
 	// TestSetBlockTimeStampOffset 200
@@ -2184,7 +4809,87 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	err = handler.SetBlockTimeStampOffset(c, math.MaxUint64)
 	require.NoError(t, err)
 	require.Equal(t, 400, rec.Code)
-	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
+	requireErrorResponse(t, rec, "failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value", "timestamp-offset-rejected")
+}
+
+func TestResetPersistedMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// 400 - metrics persistence is not enabled
+	c, rec := newReq(t)
+	err := handler.ResetPersistedMetrics(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	requireErrorResponse(t, rec, "/metrics/reset was not enabled in the configuration file by setting the EnableMetricsPersistence to true", "metrics-persistence-disabled")
+
+	// 200
+	mockNode.config.EnableMetricsPersistence = true
+	c, rec = newReq(t)
+	err = handler.ResetPersistedMetrics(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	// 500 - the node fails to reset the metrics
+	mockNode.err = errors.New("disk full")
+	c, rec = newReq(t)
+	err = handler.ResetPersistedMetrics(c)
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	requireErrorResponse(t, rec, "failed to reset persisted metrics: disk full", "metrics-reset-failed")
+}
+
+func TestGetPeerDuplicateLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// 400 - duplicate latency tracking is not enabled
+	mockCall := mockNode.On("PeerDuplicateLatency").Return([]metrics.HistogramSnapshot(nil), network.ErrPeerDuplicateLatencyDisabled)
+	c, rec := newReq(t)
+	require.NoError(t, handler.GetPeerDuplicateLatency(c))
+	require.Equal(t, 400, rec.Code)
+	requireErrorResponse(t, rec, "/v2/peers/duplicate-latency was not enabled in the configuration file by setting the EnablePeerDuplicateLatency to true", "peer-duplicate-latency-disabled")
+	mockCall.Unset()
+
+	// 200
+	mockCall = mockNode.On("PeerDuplicateLatency").Return([]metrics.HistogramSnapshot{{
+		Labels:  map[string]string{"peer": "r1.algorand.network:4160", "tag": "AV"},
+		Buckets: []float64{0.1, 1},
+		Counts:  []uint64{3, 4},
+		Sum:     0.75,
+		Count:   5,
+	}}, nil)
+	c, rec = newReq(t)
+	require.NoError(t, handler.GetPeerDuplicateLatency(c))
+	require.Equal(t, 200, rec.Code)
+	var response model.PeerDuplicateLatencyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, []model.PeerDuplicateLatency{{
+		Peer:    "r1.algorand.network:4160",
+		Tag:     "AV",
+		Buckets: []model.HistogramBucket{{UpperBound: 0.1, Count: 3}, {UpperBound: 1, Count: 4}},
+		Sum:     0.75,
+		Count:   5,
+	}}, response.Peers)
+	mockCall.Unset()
 }
 
 func TestDeltasForTxnGroup(t *testing.T) {
@@ -2325,16 +5030,18 @@ func TestRouterRequestBody(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
-	mockLedger, _, _, _, _ := testingenv(t, 1, 1, true)
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
 	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
 	dummyShutdownChan := make(chan struct{})
 	l, err := net.Listen("tcp", ":0") // create listener so requests are buffered
-	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", l, 1000)
+	apiTokens := server.MakeAPITokens(logging.TestingLog(t), t.TempDir(), "", "", 0)
+	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, server.RouterRoleCombined)
 	go e.Start(":0")
 	defer e.Close()
 
 	// Admin API call greater than max body bytes should succeed
-	assert.Equal(t, "10MB", server.MaxRequestBodyBytes)
+	assert.Equal(t, int64(10<<20), mockNode.Config().RestMaxRequestBodyBytes)
 	stringReader := strings.NewReader(strings.Repeat("a", 50_000_000))
 	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("https://%s/v2/participation", e.Listener.Addr().String()), stringReader)
 	assert.NoError(t, err)
@@ -2343,11 +5050,643 @@ func TestRouterRequestBody(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 
 	// Public API call greater than max body bytes fails
-	assert.Equal(t, "10MB", server.MaxRequestBodyBytes)
 	stringReader = strings.NewReader(strings.Repeat("a", 50_000_000))
+	req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("https://%s/v2/transactions/simulate", e.Listener.Addr().String()), stringReader)
+	assert.NoError(t, err)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// Public API call greater than the limit of its route fails
+	stringReader = strings.NewReader(strings.Repeat("a", 2_000_000))
 	req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("https://%s/v2/transactions", e.Listener.Addr().String()), stringReader)
 	assert.NoError(t, err)
 	rec = httptest.NewRecorder()
 	e.ServeHTTP(rec, req)
 	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	requireErrorResponse(t, rec, "request body exceeds the limit of 1048576 bytes", "request-too-large")
+}
+
+func TestRouterRoles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	dummyShutdownChan := make(chan struct{})
+	apiToken := strings.Repeat("a", 64)
+	adminToken := strings.Repeat("b", 64)
+	catchupPath := "/v2/catchup/5894690%23DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+
+	doRequest := func(e *echo.Echo, method string, path string, token string) int {
+		req, err := http.NewRequest(method, path, nil)
+		require.NoError(t, err)
+		req.Header.Set(server.TokenHeader, token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	apiTokens := server.MakeAPITokens(logging.TestingLog(t), t.TempDir(), apiToken, adminToken, 0)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	public := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, server.RouterRolePublic)
+	// public routes are served as usual, admin routes are not registered at all
+	require.Equal(t, http.StatusOK, doRequest(public, http.MethodGet, "/v2/status", apiToken))
+	require.Equal(t, http.StatusNotFound, doRequest(public, http.MethodDelete, catchupPath, adminToken))
+
+	al, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer al.Close()
+	admin := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, al, 1000, server.RouterRoleAdmin)
+	// the admin router serves every route, but only to the admin token
+	require.Equal(t, http.StatusOK, doRequest(admin, http.MethodDelete, catchupPath, adminToken))
+	require.Equal(t, http.StatusUnauthorized, doRequest(admin, http.MethodDelete, catchupPath, apiToken))
+	require.Equal(t, http.StatusUnauthorized, doRequest(admin, http.MethodGet, "/v2/status", apiToken))
+}
+
+func TestRotateAPIToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	dummyShutdownChan := make(chan struct{})
+	apiToken := strings.Repeat("a", 64)
+	adminToken := strings.Repeat("b", 64)
+
+	doRequest := func(e *echo.Echo, method string, path string, token string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, nil)
+		require.NoError(t, err)
+		req.Header.Set(server.TokenHeader, token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	rotate := func(e *echo.Echo) model.RotateAPITokenResponse {
+		rec := doRequest(e, http.MethodPost, "/v2/api-token/rotate", adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response model.RotateAPITokenResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("overlap", func(t *testing.T) {
+		dataDir := t.TempDir()
+		apiTokens := server.MakeAPITokens(logging.TestingLog(t), dataDir, apiToken, adminToken, time.Hour)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, server.RouterRoleCombined)
+
+		// the API token cannot rotate itself
+		require.Equal(t, http.StatusUnauthorized, doRequest(e, http.MethodPost, "/v2/api-token/rotate", apiToken).Code)
+
+		response := rotate(e)
+		require.NotEqual(t, apiToken, response.Token)
+		require.NoError(t, tokens.ValidateAPIToken(response.Token))
+		require.InDelta(t, time.Now().Add(time.Hour).Unix(), int64(response.PreviousTokenExpiration), 5)
+		written, err := tokens.GetAndValidateAPIToken(dataDir, tokens.AlgodTokenFilename)
+		require.NoError(t, err)
+		require.Equal(t, response.Token, written)
+
+		// both tokens are accepted during the overlap
+		require.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/v2/status", apiToken).Code)
+		require.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/v2/status", response.Token).Code)
+	})
+
+	t.Run("no overlap", func(t *testing.T) {
+		apiTokens := server.MakeAPITokens(logging.TestingLog(t), t.TempDir(), apiToken, adminToken, 0)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		public := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, server.RouterRolePublic)
+		al, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer al.Close()
+		admin := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, al, 1000, server.RouterRoleAdmin)
+
+		// a rotation through the admin router is seen by the public router
+		response := rotate(admin)
+		require.Equal(t, http.StatusUnauthorized, doRequest(public, http.MethodGet, "/v2/status", apiToken).Code)
+		require.Equal(t, http.StatusOK, doRequest(public, http.MethodGet, "/v2/status", response.Token).Code)
+	})
+
+	t.Run("auth disabled", func(t *testing.T) {
+		apiTokens := server.MakeAPITokens(logging.TestingLog(t), t.TempDir(), "", adminToken, time.Hour)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, server.RouterRoleCombined)
+
+		rec := doRequest(e, http.MethodPost, "/v2/api-token/rotate", adminToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		requireErrorResponse(t, rec, "API authentication is disabled", "api-auth-disabled")
+	})
+}
+
+func TestNamedAPITokens(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	dummyShutdownChan := make(chan struct{})
+	apiToken := strings.Repeat("a", 64)
+	adminToken := strings.Repeat("b", 64)
+	dataDir := t.TempDir()
+	catchupPath := "/v2/catchup/5894690%23DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+
+	doRequest := func(e *echo.Echo, method string, path string, token string, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set(server.TokenHeader, token)
+		req.RemoteAddr = "192.0.2.1:4160"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	newRouter := func(role server.RouterRole) *echo.Echo {
+		apiTokens := server.MakeAPITokens(logging.TestingLog(t), dataDir, apiToken, adminToken, 0)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { l.Close() })
+		return server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, apiTokens, nil, nil, l, 1000, role)
+	}
+	create := func(e *echo.Echo, request string) string {
+		rec := doRequest(e, http.MethodPost, "/v2/tokens", adminToken, request)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var response model.CreateAPITokenResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.NoError(t, tokens.ValidateAPIToken(response.Token))
+		return response.Token
+	}
+
+	e := newRouter(server.RouterRoleCombined)
+	readOnly := create(e, `{"name":"dashboard","scopes":["read-only"]}`)
+	participation := create(e, `{"name":"keys","scopes":["read-only","participation"]}`)
+	remote := create(e, `{"name":"remote","scopes":["admin"],"allowed-cidrs":["10.0.0.0/8"]}`)
+
+	// a read-only token reads the public routes, but neither writes nor reaches the private ones
+	require.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/v2/status", readOnly, "").Code)
+	rec := doRequest(e, http.MethodPost, "/v2/transactions", readOnly, "")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	requireErrorResponse(t, rec, "API token lacks the participation scope", "api-token-scope-missing")
+	require.Equal(t, http.StatusForbidden, doRequest(e, http.MethodPost, "/v2/participation", readOnly, "a").Code)
+	require.Equal(t, http.StatusForbidden, doRequest(e, http.MethodDelete, catchupPath, readOnly, "").Code)
+
+	// a participation token submits transactions and manages participation keys
+	require.Equal(t, http.StatusBadRequest, doRequest(e, http.MethodPost, "/v2/transactions", participation, "").Code)
+	require.Equal(t, http.StatusOK, doRequest(e, http.MethodPost, "/v2/participation", participation, "a").Code)
+	require.Equal(t, http.StatusForbidden, doRequest(e, http.MethodDelete, catchupPath, participation, "").Code)
+
+	// a token restricted to some networks is refused from the others
+	rec = doRequest(e, http.MethodGet, "/v2/status", remote, "")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	requireErrorResponse(t, rec, "API token is not allowed from this address", "api-token-address-forbidden")
+
+	// invalid requests
+	for request, message := range map[string]string{
+		`{"name":"bad name","scopes":["read-only"]}`:                     "the name of an API token must be made of at most 64 letters, digits, dashes and underscores",
+		`{"name":"empty","scopes":[]}`:                                   "an API token must be granted at least one scope",
+		`{"name":"write","scopes":["write"]}`:                            "unknown scope write",
+		`{"name":"net","scopes":["admin"],"allowed-cidrs":["10.0.0.1"]}`: "failed to parse the network 10.0.0.1, it must be in CIDR notation",
+		`{"name":"dashboard","scopes":["read-only"]}`:                    "API token already exists",
+		`{"name":"admin","scopes":["read-only"]}`:                        "API token already exists",
+	} {
+		rec = doRequest(e, http.MethodPost, "/v2/tokens", adminToken, request)
+		require.Contains(t, []int{http.StatusBadRequest, http.StatusConflict}, rec.Code)
+		require.Contains(t, rec.Body.String(), message)
+	}
+	// named tokens cannot manage tokens without the admin scope
+	require.Equal(t, http.StatusForbidden, doRequest(e, http.MethodPost, "/v2/tokens", participation, `{"name":"x","scopes":["admin"]}`).Code)
+
+	// the named tokens are written to the data directory, and served by the routers created later on
+	e = newRouter(server.RouterRoleCombined)
+	rec = doRequest(e, http.MethodGet, "/v2/tokens", adminToken, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list model.APITokensResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Tokens, 3)
+	require.Equal(t, "dashboard", list.Tokens[0].Name)
+	require.Equal(t, []model.APITokenScopes{model.APITokenScopesReadOnly}, list.Tokens[0].Scopes)
+	require.Nil(t, list.Tokens[0].AllowedCidrs)
+	require.Equal(t, []string{"10.0.0.0/8"}, *list.Tokens[2].AllowedCidrs)
+	require.NotContains(t, rec.Body.String(), readOnly)
+	info, err := os.Stat(filepath.Join(dataDir, tokens.AlgodNamedTokensFilename))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	require.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/v2/status", readOnly, "").Code)
+
+	// the admin router only serves the tokens granted the admin scope
+	admin := newRouter(server.RouterRoleAdmin)
+	require.Equal(t, http.StatusForbidden, doRequest(admin, http.MethodGet, "/v2/status", readOnly, "").Code)
+
+	require.Equal(t, http.StatusOK, doRequest(e, http.MethodDelete, "/v2/tokens/dashboard", adminToken, "").Code)
+	require.Equal(t, http.StatusUnauthorized, doRequest(e, http.MethodGet, "/v2/status", readOnly, "").Code)
+	rec = doRequest(e, http.MethodDelete, "/v2/tokens/dashboard", adminToken, "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "API token not found", "api-token-not-found")
+}
+
+func TestGetSenderAdvisory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	// the golden pool holds transactions sent by the zero address
+	leases := uint64(3)
+	err := handler.GetSenderAdvisory(c, basics.Address{}.String(), model.GetSenderAdvisoryParams{Leases: &leases})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.SenderAdvisoryResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(cannedStatusReportGolden.LastRound), response.Round)
+	require.Len(t, response.SuggestedLeases, 3)
+	require.Empty(t, response.LeasesInUse)
+	require.Len(t, response.Batches, 1)
+	require.Len(t, response.Batches[0].Txids, len(txnPoolGolden))
+
+	c, rec = newReq(t)
+	err = handler.GetSenderAdvisory(c, "bad address", model.GetSenderAdvisoryParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newReq(t)
+	leases = 17
+	err = handler.GetSenderAdvisory(c, basics.Address{}.String(), model.GetSenderAdvisoryParams{Leases: &leases})
+	require.NoError(t, err)
+	requireErrorResponse(t, rec, "the number of suggested leases must be between 1 and 16", "invalid-lease-count")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestBuildOptInGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, roots, _, _, releasefunc := testingenvWithBalances(t, 2_999_998, 2_999_999, 2, 1, false)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, genesisID, nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// the first account creates more assets than the second one can afford to opt in to
+	creator := roots[0].Address()
+	holder := roots[1].Address()
+	prev, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: prev}
+	next := bookkeeping.MakeBlock(prev)
+	evaluator, err := mockLedger.StartEvaluator(next.BlockHeader, 0, 0, nil)
+	require.NoError(t, err)
+	for i := 0; i < 17; i++ {
+		txn := txnInfo.NewTxn(txntest.Txn{
+			Type:   protocol.AssetConfigTx,
+			Sender: creator,
+			AssetParams: basics.AssetParams{
+				Total:    10,
+				UnitName: fmt.Sprintf("a%d", i),
+			},
+			Note: []byte{byte(i)},
+		}).Txn()
+		require.NoError(t, evaluator.Transaction(txn.Sign(roots[0].Secrets()), transactions.ApplyData{}))
+	}
+	pay := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   holder,
+		Receiver: creator,
+		Amount:   2_000_000,
+	}).Txn()
+	require.NoError(t, evaluator.Transaction(pay.Sign(roots[1].Secrets()), transactions.ApplyData{}))
+	vb, err := evaluator.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+	var assets []uint64
+	for _, txib := range vb.Block().Payset {
+		if txib.Txn.Type == protocol.AssetConfigTx {
+			assets = append(assets, uint64(txib.ApplyData.ConfigAsset))
+		}
+	}
+
+	build := func(addr string, request model.OptInRequest) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeJSON(&request)))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.BuildOptInGroups(echo.New().NewContext(req, rec), addr))
+		return rec
+	}
+	decodeGroups := func(response model.OptInResponse) [][]transactions.Transaction {
+		groups := make([][]transactions.Transaction, len(response.TxnGroups))
+		for i, group := range response.TxnGroups {
+			groups[i] = make([]transactions.Transaction, len(group.Txns))
+			for j, encoded := range group.Txns {
+				require.NoError(t, protocol.Decode(encoded, &groups[i][j]))
+			}
+		}
+		return groups
+	}
+
+	proto := config.Consensus[cannedStatusReportGolden.LastVersion]
+
+	// opting in to a few assets, one of them twice
+	request := model.OptInRequest{Assets: &[]uint64{assets[0], assets[1], assets[0]}}
+	rec := build(holder.String(), request)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var response model.OptInResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Nil(t, response.FailedGroup, rec.Body.String())
+	require.Equal(t, uint64(mockLedger.Latest()), response.Round)
+	require.Equal(t, 3*proto.MinBalance, response.MinBalance)
+	groups := decodeGroups(response)
+	require.Len(t, groups, 1)
+	require.Len(t, groups[0], 2)
+	for i, txn := range groups[0] {
+		require.Equal(t, protocol.AssetTransferTx, txn.Type)
+		require.Equal(t, basics.AssetIndex(assets[i]), txn.XferAsset)
+		require.Equal(t, holder, txn.Sender)
+		require.Equal(t, holder, txn.AssetReceiver)
+		require.True(t, txn.AssetCloseTo.IsZero())
+		require.False(t, txn.Group.IsZero())
+		require.Equal(t, groups[0][0].Group, txn.Group)
+	}
+
+	// the account is not opted in to anything, so there is nothing to close out
+	closeOut := true
+	request.CloseOut = &closeOut
+	rec = build(holder.String(), request)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	response = model.OptInResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Empty(t, response.TxnGroups)
+	require.Equal(t, proto.MinBalance, response.MinBalance)
+
+	// the creator holds its assets, which it cannot close out of
+	rec = build(creator.String(), request)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	response = model.OptInResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	groups = decodeGroups(response)
+	require.Len(t, groups, 1)
+	require.Equal(t, creator, groups[0][0].AssetCloseTo)
+	require.NotNil(t, response.FailedGroup)
+	require.Equal(t, uint64(0), *response.FailedGroup)
+
+	// opting in to all the assets is chunked, and exceeds the balance of the account
+	rec = build(holder.String(), model.OptInRequest{Assets: &assets})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	response = model.OptInResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	groups = decodeGroups(response)
+	require.Len(t, groups, 2)
+	require.Len(t, groups[0], proto.MaxTxGroupSize)
+	require.Len(t, groups[1], len(assets)-proto.MaxTxGroupSize)
+	require.NotEqual(t, groups[0][0].Group, groups[1][0].Group)
+	require.Equal(t, uint64(len(assets)+1)*proto.MinBalance, response.MinBalance)
+	require.NotNil(t, response.FailedGroup)
+	require.Equal(t, uint64(0), *response.FailedGroup)
+	require.Contains(t, *response.FailureMessage, "below min")
+
+	rec = build(holder.String(), model.OptInRequest{Assets: &[]uint64{assets[0], 999_999}})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "asset does not exist", "asset-not-found")
+
+	rec = build(holder.String(), model.OptInRequest{Applications: &[]uint64{999_999}})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "application does not exist", "application-not-found")
+
+	rec = build(holder.String(), model.OptInRequest{Assets: &[]uint64{}, Applications: new([]uint64)})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	tooMany := make([]uint64, 257)
+	rec = build(holder.String(), model.OptInRequest{Assets: &tooMany})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "cannot opt in to or out of more than 256 assets and applications at once", "too-many-opt-in-targets")
+
+	rec = build("bad address", request)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAccountOnlineness(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, rootkeys, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	latest := handler.Node.LedgerForAPI().Latest()
+
+	require.NoError(t, handler.AccountOnlineness(c, rootkeys[0].Address().String(), model.AccountOnlinenessParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AccountOnlinenessResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(latest+1), response.Round)
+	require.Equal(t, uint64(latest), response.LatestRound)
+	require.True(t, response.Online)
+	require.True(t, response.Eligible)
+	require.NotZero(t, response.VotingStake)
+	require.Equal(t, uint64(latest+1), *response.FirstEligibleRound)
+
+	past := uint64(latest)
+	c, rec = newReq(t)
+	require.NoError(t, handler.AccountOnlineness(c, rootkeys[0].Address().String(), model.AccountOnlinenessParams{Round: &past}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "round must be after the latest round", "round-not-in-future")
+
+	c, rec = newReq(t)
+	require.NoError(t, handler.AccountOnlineness(c, "invalid", model.AccountOnlinenessParams{}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetAccountDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	ledger := &mockLedger{
+		accounts:      map[basics.Address]basics.AccountData{addr: {MicroAlgos: basics.MicroAlgos{Raw: 1000}}},
+		latest:        20,
+		accountsSince: 5,
+	}
+	changes := ledgercore.AccountChanges{
+		Resources: []ledgercore.ResourceChange{
+			{
+				Index:  7,
+				Type:   basics.AssetCreatable,
+				Before: ledgercore.AccountResource{AssetHolding: &basics.AssetHolding{Amount: 10}},
+				After:  ledgercore.AccountResource{AssetHolding: &basics.AssetHolding{Amount: 3, Frozen: true}},
+			},
+			{
+				Index: 9,
+				Type:  basics.AppCreatable,
+				After: ledgercore.AccountResource{AppLocalState: &basics.AppLocalState{Schema: basics.StateSchema{NumUint: 1}}},
+			},
+		},
+		Boxes: []ledgercore.BoxChange{
+			{App: 9, Name: "created", After: []byte("value")},
+			{App: 9, Name: "emptied", Before: []byte("value"), After: []byte{}},
+			{App: 9, Name: "deleted", Before: []byte("value")},
+		},
+	}
+	ledger.On("LookupAccountChanges", addr, basics.Round(6), basics.Round(12)).Return(changes, nil)
+
+	mockNode := makeMockNode(ledger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	c, rec := newReq(t)
+	err := handler.GetAccountDiff(c, addr.String(), model.GetAccountDiffParams{From: 6, To: 12})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AccountDiffResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, addr.String(), response.Address)
+	require.Equal(t, uint64(6), response.From)
+	require.Equal(t, uint64(12), response.To)
+	require.Equal(t, uint64(1000), response.AmountBefore)
+	require.Equal(t, uint64(1000), response.AmountAfter)
+
+	require.Len(t, response.Assets, 1)
+	require.Equal(t, uint64(7), response.Assets[0].AssetId)
+	require.Equal(t, uint64(10), response.Assets[0].HoldingBefore.Amount)
+	require.Equal(t, uint64(3), response.Assets[0].HoldingAfter.Amount)
+	require.True(t, response.Assets[0].HoldingAfter.IsFrozen)
+	require.Nil(t, response.Assets[0].ParamsBefore)
+	require.Nil(t, response.Assets[0].ParamsAfter)
+
+	require.Len(t, response.Applications, 1)
+	require.Equal(t, uint64(9), response.Applications[0].ApplicationId)
+	require.Nil(t, response.Applications[0].LocalStateBefore)
+	require.Equal(t, uint64(1), response.Applications[0].LocalStateAfter.Schema.NumUint)
+
+	require.Len(t, response.Boxes, 3)
+	require.Equal(t, []byte("created"), response.Boxes[0].Name)
+	require.Nil(t, response.Boxes[0].ValueBefore)
+	require.Equal(t, []byte("value"), *response.Boxes[0].ValueAfter)
+	require.NotNil(t, response.Boxes[1].ValueAfter)
+	require.Empty(t, *response.Boxes[1].ValueAfter)
+	require.Equal(t, []byte("value"), *response.Boxes[2].ValueBefore)
+	require.Nil(t, response.Boxes[2].ValueAfter)
+
+	c, rec = newReq(t)
+	err = handler.GetAccountDiff(c, addr.String(), model.GetAccountDiffParams{From: 12, To: 6})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "from must not be greater than to", "invalid-round-range")
+
+	c, rec = newReq(t)
+	err = handler.GetAccountDiff(c, addr.String(), model.GetAccountDiffParams{From: 6, To: 21})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "given round is greater than the latest round", "round-not-available")
+
+	c, rec = newReq(t)
+	err = handler.GetAccountDiff(c, addr.String(), model.GetAccountDiffParams{From: 4, To: 12})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "the account state at round 4 is not retained by the node", "account-round-not-retained")
+
+	c, rec = newReq(t)
+	err = handler.GetAccountDiff(c, "bad address", model.GetAccountDiffParams{From: 6, To: 12})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	ledger.AssertExpectations(t)
+}
+
+func TestWebhooks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(&mockLedger{}, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	create := func(request interface{}) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeJSON(request)))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.CreateWebhook(echo.New().NewContext(req, rec)))
+		return rec
+	}
+	list := func() *httptest.ResponseRecorder {
+		c, rec := newReq(t)
+		require.NoError(t, handler.ListWebhooks(c))
+		return rec
+	}
+	remove := func(id string) *httptest.ResponseRecorder {
+		c, rec := newReq(t)
+		require.NoError(t, handler.DeleteWebhook(c, id))
+		return rec
+	}
+
+	disabled := "/v2/webhooks was not enabled in the configuration file by setting the EnableWebhooks to true"
+	rec := list()
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, disabled, "webhooks-disabled")
+	rec = create(model.CreateWebhookRequest{Url: "http://127.0.0.1/hook"})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, disabled, "webhooks-disabled")
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableWebhooks = true
+	cfg.WebhookURLs = "http://127.0.0.1/all"
+	cfg.WebhookSecret = "secret"
+	dispatcher, err := webhooks.MakeDispatcher(cfg, t.TempDir(), logging.TestingLog(t))
+	require.NoError(t, err)
+	mockNode.webhooks = dispatcher
+
+	addr := basics.Address{1}.String()
+	txType := model.CreateWebhookRequestTxType("axfer")
+	rec = create(model.CreateWebhookRequest{Url: "https://127.0.0.1/asset", AssetId: numOrNil(7), Address: &addr, TxType: &txType})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var created model.Webhook
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &created))
+	require.NotEmpty(t, created.Id)
+	require.Equal(t, "https://127.0.0.1/asset", created.Url)
+	require.False(t, created.Signed)
+	require.False(t, created.Configured)
+	require.Equal(t, uint64(7), *created.AssetId)
+	require.Nil(t, created.ApplicationId)
+	require.Equal(t, addr, *created.Address)
+	require.Equal(t, model.WebhookTxType("axfer"), *created.TxType)
+
+	rec = list()
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.WebhooksResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Webhooks, 2)
+	require.Equal(t, model.Webhook{Id: "config-0", Url: "http://127.0.0.1/all", Signed: true, Configured: true}, response.Webhooks[0])
+	require.Equal(t, created, response.Webhooks[1])
+	// the secrets are never returned
+	require.NotContains(t, rec.Body.String(), "secret")
+
+	rec = create(model.CreateWebhookRequest{Url: "ftp://127.0.0.1/hook"})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, `invalid webhook: invalid webhook URL: unsupported scheme "ftp"`, "invalid-webhook")
+	bad := "bad address"
+	rec = create(model.CreateWebhookRequest{Url: "http://127.0.0.1/hook", Address: &bad})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = create(map[string]interface{}{"url": "http://127.0.0.1/hook", "unknown": 1})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = remove("config-0")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	requireErrorResponse(t, rec, "the webhooks set in the configuration file cannot be deleted", "webhook-configured")
+	rec = remove(created.Id)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = remove(created.Id)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	requireErrorResponse(t, rec, "webhook not found", "webhook-not-found")
+	require.Len(t, dispatcher.Webhooks(), 1)
 }
@@ -17,14 +17,17 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -34,14 +37,19 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	basics_testing "github.com/algorand/go-algorand/data/basics/testing"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/snapshot"
+	"github.com/algorand/go-algorand/node/webhooks"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 var cannedStatusReportGolden = node.StatusReport{
@@ -99,12 +107,46 @@ type mockNode struct {
 	status          node.StatusReport
 	devmode         bool
 	timestampOffset *int64
+	sessions        *simulation.Sessions
+	catchupStats    *catchup.CatchpointCatchupStats
+	drained         bool
+	drainCatchpoint string
+
+	stoppedSubsystems map[string]bool
+	webhooks          *webhooks.Dispatcher
+
+	snapshot            *node.SnapshotStatus
+	snapshotExcludeKeys bool
+
+	exportKept bool
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
 	return account.ParticipationID{}, nil
 }
 
+func (m *mockNode) ParticipationTransportKey() (*[account.TransportKeySize]byte, *[account.TransportKeySize]byte, error) {
+	return &[account.TransportKeySize]byte{}, &[account.TransportKeySize]byte{}, m.err
+}
+
+func (m *mockNode) ExportParticipationKey(id account.ParticipationID, recipient *[account.TransportKeySize]byte, keep bool) ([]byte, error) {
+	m.exportKept = keep
+	return []byte{}, m.err
+}
+
+func (m *mockNode) ProveParticipationChallenge(id account.ParticipationID, challenge account.ParticipationChallenge) (account.ParticipationRecord, crypto.VrfProof, error) {
+	if m.err != nil {
+		return account.ParticipationRecord{}, crypto.VrfProof{}, m.err
+	}
+	secrets := crypto.GenerateVRFSecrets()
+	proof, err := challenge.Prove(secrets)
+	return account.ParticipationRecord{ParticipationID: id, VRF: secrets}, proof, err
+}
+
+func (m *mockNode) ImportParticipationKey(sealed []byte) (account.ParticipationID, error) {
+	return m.id, m.err
+}
+
 func (m *mockNode) ListParticipationKeys() ([]account.ParticipationRecord, error) {
 	panic("implement me")
 }
@@ -125,6 +167,11 @@ func (m *mockNode) SetSyncRound(rnd uint64) error {
 func (m *mockNode) UnsetSyncRound() {
 }
 
+func (m *mockNode) AcknowledgeSyncRound(rnd uint64) error {
+	args := m.Called(rnd)
+	return args.Error(0)
+}
+
 func (m *mockNode) GetSyncRound() uint64 {
 	args := m.Called()
 	return uint64(args.Int(0))
@@ -149,6 +196,7 @@ func makeMockNodeWithConfig(ledger v2.LedgerForAPI, genesisID string, nodeError
 		usertxns:  map[basics.Address][]node.TxnWithStatus{},
 		status:    status,
 		devmode:   devMode,
+		sessions:  simulation.MakeSessions(cfg.SimulateSessionTTL, int(cfg.MaxSimulateSessions)),
 	}
 }
 
@@ -175,10 +223,22 @@ func (m *mockNode) AsyncBroadcastSignedTxGroup(txgroup []transactions.SignedTxn)
 }
 
 func (m *mockNode) Simulate(request simulation.Request) (simulation.Result, error) {
+	if request.Session != "" {
+		return m.sessions.Simulate(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI, request)
+	}
 	simulator := simulation.MakeSimulator(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
 	return simulator.Simulate(request)
 }
 
+func (m *mockNode) SimulateBatch(request simulation.Request) (simulation.Result, error) {
+	simulator := simulation.MakeSimulator(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
+	return simulator.SimulateBatch(request)
+}
+
+func (m *mockNode) DeleteSimulateSession(name string) error {
+	return m.sessions.Delete(name)
+}
+
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -194,6 +254,63 @@ func (m *mockNode) SuggestedFee() basics.MicroAlgos {
 }
 
 // unused by handlers:
+func (m *mockNode) Subsystems() []node.SubsystemStatus {
+	names := []string{node.SubsystemCatchpointGeneration, node.SubsystemTxnRelay, node.SubsystemTxnSubmit}
+	subsystems := make([]node.SubsystemStatus, len(names))
+	for i, name := range names {
+		subsystems[i] = node.SubsystemStatus{Name: name, Description: name, Running: !m.stoppedSubsystems[name]}
+	}
+	return subsystems
+}
+
+func (m *mockNode) Features() []config.FeatureStatus {
+	features, _ := m.config.GateFeatures(protocol.ConsensusCurrentVersion, config.Mainnet, "stable")
+	return features
+}
+
+func (m *mockNode) SetSubsystemRunning(name string, running bool) error {
+	switch name {
+	case node.SubsystemCatchpointGeneration, node.SubsystemTxnRelay, node.SubsystemTxnSubmit:
+	default:
+		return node.ErrUnknownSubsystem
+	}
+	if m.stoppedSubsystems == nil {
+		m.stoppedSubsystems = make(map[string]bool)
+	}
+	m.stoppedSubsystems[name] = !running
+	return nil
+}
+
+func (m *mockNode) GenerateCatchpoint() (basics.Round, error) {
+	if m.config.CatchpointInterval == 0 {
+		return 0, ledger.ErrCatchpointsDisabled
+	}
+	return basics.Round(m.config.CatchpointInterval), nil
+}
+
+func (m *mockNode) StartSnapshot(excludeKeys bool) (node.SnapshotStatus, error) {
+	if m.snapshot != nil && m.snapshot.Running {
+		return *m.snapshot, node.ErrSnapshotInProgress
+	}
+	m.snapshot = &node.SnapshotStatus{Running: true, Started: time.Unix(1700000000, 0)}
+	m.snapshotExcludeKeys = excludeKeys
+	return *m.snapshot, nil
+}
+
+// finishSnapshot completes the snapshot started on the node.
+func (m *mockNode) finishSnapshot() {
+	m.snapshot.Running = false
+	manifest := snapshot.Manifest{GenesisID: m.genesisID, Round: 100, ParticipationKeys: !m.snapshotExcludeKeys}
+	m.snapshot.Archive = snapshot.Archive{Path: "/data/snapshots/snapshot.tar.gz", Size: 1024, SHA256: "00ff", Manifest: manifest}
+}
+
+func (m *mockNode) SnapshotStatus() (node.SnapshotStatus, bool) {
+	if m.snapshot == nil {
+		return node.SnapshotStatus{}, false
+	}
+	return *m.snapshot, true
+}
+
 func (m *mockNode) Config() config.Local {
 	return m.config
 }
@@ -244,6 +361,18 @@ func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) Drain(ctx context.Context) (basics.Round, string) {
+	m.drained = true
+	return m.ledger.Latest(), m.drainCatchpoint
+}
+
+func (m *mockNode) CatchupProgress(catchpoint string) (catchup.CatchpointCatchupStats, bool) {
+	if m.catchupStats == nil || m.catchupStats.CatchpointLabel != catchpoint {
+		return catchup.CatchpointCatchupStats{}, false
+	}
+	return *m.catchupStats, true
+}
+
 func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
 	if !m.devmode {
 		return fmt.Errorf("cannot set block timestamp when not in dev mode")
@@ -252,6 +381,110 @@ func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
 	return nil
 }
 
+func (m *mockNode) ParticipationMetrics() ([]agreement.ParticipationMetrics, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []agreement.ParticipationMetrics{{
+		Address:             poolAddr,
+		Proposals:           2,
+		SoftVotes:           10,
+		CertVotes:           9,
+		NextVotes:           1,
+		CredentialsWon:      44,
+		CredentialsExpected: 40.5,
+	}}, nil
+}
+
+func (m *mockNode) AgreementStatus() (agreement.Status, error) {
+	if m.err != nil {
+		return agreement.Status{}, m.err
+	}
+	return agreement.Status{
+		Round:         12,
+		Period:        1,
+		Step:          2,
+		ProposalsSeen: 3,
+		Votes:         []agreement.StepVotes{{Period: 1, Step: 1, Votes: 20, Weight: 2500}},
+		Accounts: []agreement.AccountRoundStatus{{
+			Address:          poolAddr,
+			ProposalAttempts: 2,
+			VoteAttempts:     3,
+			Votes:            1,
+		}},
+	}, nil
+}
+
+func (m *mockNode) ResetPersistedMetrics() error {
+	return m.err
+}
+
+func (m *mockNode) PendingBlockPreview() (pools.BlockPreview, bool) {
+	args := m.Called()
+	return args.Get(0).(pools.BlockPreview), args.Bool(1)
+}
+
+func (m *mockNode) TransactionPoolStats() pools.PoolStats {
+	args := m.Called()
+	return args.Get(0).(pools.PoolStats)
+}
+
+func (m *mockNode) FeeEstimate() (pools.FeeEstimate, error) {
+	args := m.Called()
+	return args.Get(0).(pools.FeeEstimate), args.Error(1)
+}
+
+func (m *mockNode) MemorySettings() node.MemorySettings {
+	args := m.Called()
+	return args.Get(0).(node.MemorySettings)
+}
+
+func (m *mockNode) SetMemorySettings(target uint64, ballast uint64) node.MemorySettings {
+	args := m.Called(target, ballast)
+	return args.Get(0).(node.MemorySettings)
+}
+
+func (m *mockNode) FlightRecording(since time.Time) ([]node.FlightRecorderSample, error) {
+	args := m.Called(since)
+	return args.Get(0).([]node.FlightRecorderSample), args.Error(1)
+}
+
+func (m *mockNode) PeerDuplicateLatency() ([]metrics.HistogramSnapshot, error) {
+	args := m.Called()
+	return args.Get(0).([]metrics.HistogramSnapshot), args.Error(1)
+}
+
+func (m *mockNode) Webhooks() ([]webhooks.Webhook, error) {
+	if m.webhooks == nil {
+		return nil, webhooks.ErrDisabled
+	}
+	return m.webhooks.Webhooks(), nil
+}
+
+func (m *mockNode) RegisterWebhook(w webhooks.Webhook) (webhooks.Webhook, error) {
+	if m.webhooks == nil {
+		return webhooks.Webhook{}, webhooks.ErrDisabled
+	}
+	return m.webhooks.Register(w)
+}
+
+func (m *mockNode) UnregisterWebhook(id string) error {
+	if m.webhooks == nil {
+		return webhooks.ErrDisabled
+	}
+	return m.webhooks.Unregister(id)
+}
+
+func (m *mockNode) ParticipationKeyAutoRenewal() (node.KeyRenewalSettings, []node.KeyRenewal) {
+	args := m.Called()
+	return args.Get(0).(node.KeyRenewalSettings), args.Get(1).([]node.KeyRenewal)
+}
+
+func (m *mockNode) SetParticipationKeyAutoRenewal(settings node.KeyRenewalSettings) error {
+	args := m.Called(settings)
+	return args.Error(0)
+}
+
 func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	if !m.devmode {
 		return nil, fmt.Errorf("cannot get block timestamp when not in dev mode")
@@ -422,5 +655,8 @@ func testingenvWithBalances(t testing.TB, minMoneyAtStart, maxMoneyAtStart, numA
 		bal[raddr] = rbal
 	}
 
-	return ledger, roots, parts, tx, release
+	return ledger, roots, parts, tx, func() {
+		release()
+		ledger.Close()
+	}
 }
@@ -18,7 +18,9 @@ package v2
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"unicode"
@@ -30,6 +32,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -44,7 +47,7 @@ import (
 // returnError logs an internal message while returning the encoded response.
 func returnError(ctx echo.Context, code int, internal error, external string, logger logging.Logger) error {
 	logger.Info(internal)
-	return ctx.JSON(code, model.ErrorResponse{Message: external})
+	return writeErrorResponse(ctx, code, external, nil)
 }
 
 func badRequest(ctx echo.Context, internal error, external string, log logging.Logger) error {
@@ -67,10 +70,47 @@ func notFound(ctx echo.Context, internal error, external string, log logging.Log
 	return returnError(ctx, http.StatusNotFound, internal, external, log)
 }
 
+// missingLedgerEntry reports a block missing from the ledger, telling apart the rounds which are
+// behind the retention horizon of the node and will never be served by it.
+func missingLedgerEntry(ctx echo.Context, internal error, log logging.Logger) error {
+	var noEntry ledgercore.ErrNoEntry
+	if errors.As(internal, &noEntry) && noEntry.Pruned() {
+		return notFound(ctx, internal, fmt.Sprintf(errRoundPruned, noEntry.Round, noEntry.Earliest), log)
+	}
+	return notFound(ctx, internal, errFailedLookingUpLedger, log)
+}
+
+// rejectRequestBody reports a request body which could not be decoded, either because it exceeds
+// the body size limit of its route or because it is malformed.
+func rejectRequestBody(ctx echo.Context, err error, log logging.Logger) error {
+	if limit, exceeded := middlewares.RequestBodyLimitExceeded(ctx.Request()); exceeded {
+		return returnError(ctx, http.StatusRequestEntityTooLarge, err, fmt.Sprintf(middlewares.RequestTooLargeMessage, limit), log)
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return returnError(ctx, http.StatusRequestEntityTooLarge, err, fmt.Sprintf(middlewares.RequestTooLargeMessage, tooLarge.Limit), log)
+	}
+	return badRequest(ctx, err, err.Error(), log)
+}
+
 func notImplemented(ctx echo.Context, internal error, external string, log logging.Logger) error {
 	return returnError(ctx, http.StatusNotImplemented, internal, external, log)
 }
 
+// clientID identifies the client of a request, to scope the state the node keeps on its behalf such as
+// its simulation sessions. It is the ID of the API token of the request, or the address the request came
+// from when the API is served without tokens.
+func clientID(ctx echo.Context) string {
+	if id := middlewares.AuthTokenID(ctx); id != "" {
+		return id
+	}
+	host, _, err := net.SplitHostPort(ctx.Request().RemoteAddr)
+	if err != nil {
+		host = ctx.Request().RemoteAddr
+	}
+	return "@" + host
+}
+
 func convertSlice[X any, Y any](input []X, fn func(X) Y) []Y {
 	output := make([]Y, len(input))
 	for i := range input {
@@ -432,6 +472,18 @@ func convertOpcodeTraceUnit(opcodeTraceUnit simulation.OpcodeTraceUnit) model.Si
 		StackPopCount:  omitEmpty(opcodeTraceUnit.StackPopCount),
 		ScratchChanges: sliceOrNil(convertSlice(opcodeTraceUnit.ScratchSlotChanges, convertScratchChange)),
 		StateChanges:   sliceOrNil(convertSlice(opcodeTraceUnit.StateChanges, convertApplicationStateChange)),
+		SourceLocation: convertSourceLocation(opcodeTraceUnit.SourceLocation),
+	}
+}
+
+func convertSourceLocation(location *simulation.SourceLocation) *model.SimulationSourceLocation {
+	if location == nil {
+		return nil
+	}
+	return &model.SimulationSourceLocation{
+		Source: location.Source,
+		Line:   location.Line,
+		Column: location.Column,
 	}
 }
 
@@ -459,8 +511,28 @@ func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResul
 		Txn:                      ConvertInnerTxn(&txnResult.Txn),
 		AppBudgetConsumed:        omitEmpty(txnResult.AppBudgetConsumed),
 		LogicSigBudgetConsumed:   omitEmpty(txnResult.LogicSigBudgetConsumed),
+		AdditionalFeeRequired:    omitEmpty(txnResult.AdditionalFeeRequired),
 		TransactionTrace:         convertTxnTrace(txnResult.Trace),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnResult.UnnamedResourcesAccessed),
+		FixedSigner:              addrOrNil(txnResult.FixedSigner),
+		PopulatedResourceArrays:  convertResourceArrays(txnResult.PopulatedResourceArrays),
+	}
+}
+
+func convertResourceArrays(arrays *simulation.ResourceArrays) *model.SimulateResourceArrays {
+	if arrays == nil {
+		return nil
+	}
+	return &model.SimulateResourceArrays{
+		Accounts: sliceOrNil(stringSlice(arrays.Accounts)),
+		Assets:   sliceOrNil(uint64Slice(arrays.Assets)),
+		Apps:     sliceOrNil(uint64Slice(arrays.Apps)),
+		Boxes: sliceOrNil(convertSlice(arrays.Boxes, func(box logic.BoxRef) model.BoxReference {
+			return model.BoxReference{
+				App:  uint64(box.App),
+				Name: []byte(box.Name),
+			}
+		})),
 	}
 }
 
@@ -505,9 +577,17 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 		FailureMessage:           omitEmpty(txnGroupResult.FailureMessage),
 		AppBudgetAdded:           omitEmpty(txnGroupResult.AppBudgetAdded),
 		AppBudgetConsumed:        omitEmpty(txnGroupResult.AppBudgetConsumed),
+		AdditionalFeeRequired:    omitEmpty(txnGroupResult.AdditionalFeeRequired),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnGroupResult.UnnamedResourcesAccessed),
 	}
 
+	if len(txnGroupResult.ExtraResourceArrays) > 0 {
+		extraArrays := convertSlice(txnGroupResult.ExtraResourceArrays, func(arrays simulation.ResourceArrays) model.SimulateResourceArrays {
+			return *convertResourceArrays(&arrays)
+		})
+		encoded.ExtraResourceArrays = &extraArrays
+	}
+
 	if len(txnGroupResult.FailedAt) > 0 {
 		failedAt := slices.Clone[[]uint64, uint64](txnGroupResult.FailedAt)
 		encoded.FailedAt = &failedAt
@@ -522,9 +602,12 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 		evalOverrides = &model.SimulationEvalOverrides{
 			AllowEmptySignatures:  omitEmpty(result.EvalOverrides.AllowEmptySignatures),
 			AllowUnnamedResources: omitEmpty(result.EvalOverrides.AllowUnnamedResources),
+			AllowInsufficientFees: omitEmpty(result.EvalOverrides.AllowInsufficientFees),
 			MaxLogSize:            result.EvalOverrides.MaxLogSize,
 			MaxLogCalls:           result.EvalOverrides.MaxLogCalls,
 			ExtraOpcodeBudget:     omitEmpty(result.EvalOverrides.ExtraOpcodeBudget),
+			FixSigners:            omitEmpty(result.EvalOverrides.FixSigners),
+			PopulateResources:     omitEmpty(result.EvalOverrides.PopulateResources),
 		}
 	}
 
@@ -548,13 +631,27 @@ func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Requ
 	for i, txnGroup := range request.TxnGroups {
 		txnGroups[i] = txnGroup.Txns
 	}
+	var sourceMaps map[crypto.Digest]logic.SourceMap
+	if len(request.SourceMaps) > 0 {
+		sourceMaps = make(map[crypto.Digest]logic.SourceMap, len(request.SourceMaps))
+		for _, sourceMap := range request.SourceMaps {
+			var hash crypto.Digest
+			copy(hash[:], sourceMap.ProgramHash)
+			sourceMaps[hash] = sourceMap.SourceMap
+		}
+	}
 	return simulation.Request{
 		TxnGroups:             txnGroups,
 		AllowEmptySignatures:  request.AllowEmptySignatures,
 		AllowMoreLogging:      request.AllowMoreLogging,
 		AllowUnnamedResources: request.AllowUnnamedResources,
+		AllowInsufficientFees: request.AllowInsufficientFees,
+		FixSigners:            request.FixSigners,
+		PopulateResources:     request.PopulateResources,
 		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
 		TraceConfig:           request.ExecTraceConfig,
+		Session:               request.Session,
+		SourceMaps:            sourceMaps,
 	}
 }
 
@@ -32,10 +32,13 @@ import (
 	"time"
 
 	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/logging"
@@ -49,6 +52,13 @@ import (
 
 var server http.Server
 
+// adminServer serves the admin API when it is configured on a dedicated address. It runs in the
+// algod process, sharing the node with the public API server.
+var adminServer *http.Server
+
+// metricsServer serves the Prometheus metrics when they are configured on a dedicated address.
+var metricsServer *http.Server
+
 // maxHeaderBytes must have enough room to hold an api token
 const maxHeaderBytes = 4096
 
@@ -66,12 +76,16 @@ type Server struct {
 	Genesis              bookkeeping.Genesis
 	pidFile              string
 	netFile              string
+	adminNetFile         string
+	metricsNetFile       string
 	netListenFile        string
 	log                  logging.Logger
 	node                 ServerNode
 	metricCollector      *metrics.MetricService
+	metricLabels         map[string]string
 	metricServiceStarted bool
 	stopping             chan struct{}
+	shutdownRequested    chan struct{}
 }
 
 // Initialize creates a Node instance with applicable network services
@@ -211,6 +225,17 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	}
 	s.log.Infoln("++++++++++++++++++++++++++++++++++++++++")
 
+	cryptoBackend, err := crypto.SetBackend(crypto.Backend(cfg.CryptoBackend))
+	if err != nil {
+		return fmt.Errorf("Initialize() failed to select the crypto backend: %w", err)
+	}
+	s.log.Infof("Using the %s crypto backend", cryptoBackend)
+	falconBackend, err := crypto.SetFalconBackend(cfg.FalconBackend)
+	if err != nil {
+		return fmt.Errorf("Initialize() failed to select the falcon backend: %w", err)
+	}
+	s.log.Infof("Using the %s falcon backend", falconBackend)
+
 	metricLabels := map[string]string{}
 	if s.log.GetTelemetryEnabled() {
 		metricLabels["telemetry_session"] = s.log.GetTelemetrySession()
@@ -221,6 +246,7 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 			metricLabels["telemetry_instance"] = i
 		}
 	}
+	s.metricLabels = metricLabels
 	s.metricCollector = metrics.MakeMetricService(
 		&metrics.ServiceConfig{
 			NodeExporterListenAddress: cfg.NodeExporterListenAddress,
@@ -249,6 +275,9 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	// When a caller to logging uses Fatal, we want to stop the node before os.Exit is called.
 	logging.RegisterExitHandler(s.Stop)
 
+	// Created here rather than in Start so that a shutdown may be requested while the node is still starting.
+	s.shutdownRequested = make(chan struct{}, 1)
+
 	return nil
 }
 
@@ -268,7 +297,7 @@ func makeListener(addr string) (net.Listener, error) {
 	return net.Listen("tcp", addr)
 }
 
-// Start starts a Node instance and its network services
+// Start starts a Node instance and its network services, and returns once the node has been stopped
 func (s *Server) Start() {
 	s.log.Info("Trying to start an Algorand node")
 	fmt.Print("Initializing the Algorand node... ")
@@ -282,7 +311,7 @@ func (s *Server) Start() {
 		metrics.DefaultRegistry().Register(metrics.NewRuntimeMetrics())
 	}
 
-	if cfg.EnableMetricReporting {
+	if cfg.EnableMetricReporting && cfg.MetricsEndpointAddress == "" {
 		if err := s.metricCollector.Start(context.Background()); err != nil {
 			// log this error
 			s.log.Infof("Unable to start metric collection service : %v", err)
@@ -307,6 +336,12 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	apiTokens := apiServer.MakeAPITokens(s.log, s.RootPath, apiToken, adminAPIToken, cfg.APITokenRotationOverlap)
+	var submissions *v2.SubmissionCache
+	if cfg.TxSubmissionIdempotencyWindow > 0 {
+		submissions = v2.MakeSubmissionCache(s.log, filepath.Join(s.RootPath, v2.SubmissionCacheFilename), cfg.TxSubmissionIdempotencyWindow)
+	}
+
 	s.stopping = make(chan struct{})
 
 	addr := cfg.EndpointAddress
@@ -330,9 +365,63 @@ func (s *Server) Start() {
 		MaxHeaderBytes: maxHeaderBytes,
 	}
 
+	role := apiServer.RouterRoleCombined
+	if cfg.AdminEndpointAddress != "" {
+		role = apiServer.RouterRolePublic
+	}
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
-		cfg.RestConnectionsSoftLimit)
+		s.log, s.node, s.stopping, apiTokens, submissions, s, listener,
+		cfg.RestConnectionsSoftLimit, role)
+
+	var adminRouter *echo.Echo
+	var adminAddr string
+	if cfg.AdminEndpointAddress != "" {
+		adminListener, err := net.Listen("tcp", cfg.AdminEndpointAddress)
+		if err != nil {
+			fmt.Printf("Could not start admin API listener: %v\n", err)
+			os.Exit(1)
+		}
+		adminListener = limitlistener.RejectingLimitListener(
+			adminListener, cfg.RestConnectionsHardLimit, s.log)
+		adminAddr = adminListener.Addr().String()
+		adminServer = &http.Server{
+			Addr:           adminAddr,
+			ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
+			WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+			MaxHeaderBytes: maxHeaderBytes,
+		}
+		adminRouter = apiServer.NewRouter(
+			s.log, s.node, s.stopping, apiTokens, submissions, s, adminListener,
+			cfg.RestConnectionsSoftLimit, apiServer.RouterRoleAdmin)
+	}
+
+	var metricsAddr string
+	if cfg.MetricsEndpointAddress != "" {
+		metricsListener, err := net.Listen("tcp", cfg.MetricsEndpointAddress)
+		if err != nil {
+			fmt.Printf("Could not start metrics listener: %v\n", err)
+			os.Exit(1)
+		}
+		metricsListener = limitlistener.RejectingLimitListener(
+			metricsListener, cfg.RestConnectionsHardLimit, s.log)
+		metricsAddr = metricsListener.Addr().String()
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler(nil, s.metricLabels))
+		metricsServer = &http.Server{
+			Addr:           metricsAddr,
+			Handler:        mux,
+			ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
+			WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+			MaxHeaderBytes: maxHeaderBytes,
+		}
+		go func() {
+			err := metricsServer.Serve(metricsListener)
+			if err != nil && err != http.ErrServerClosed {
+				s.log.Warnf("metrics server stopped: %v", err)
+			}
+		}()
+		fmt.Printf("Metrics are served on %v\n", metricsAddr)
+	}
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -349,6 +438,23 @@ func (s *Server) Start() {
 		fmt.Printf("netfile error: %v\n", err)
 		os.Exit(1)
 	}
+	if adminRouter != nil {
+		s.adminNetFile = filepath.Join(s.RootPath, "algod.admin.net")
+		err = os.WriteFile(s.adminNetFile, []byte(fmt.Sprintf("%s\n", adminAddr)), 0644)
+		if err != nil {
+			fmt.Printf("admin netfile error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if metricsServer != nil {
+		s.metricsNetFile = filepath.Join(s.RootPath, "algod.metrics.net")
+		err = os.WriteFile(s.metricsNetFile, []byte(fmt.Sprintf("%s\n", metricsAddr)), 0644)
+		if err != nil {
+			fmt.Printf("metrics netfile error: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
 	listenAddr, listening := s.node.ListeningAddress()
 	if listening {
@@ -360,11 +466,18 @@ func (s *Server) Start() {
 		}
 	}
 
-	errChan := make(chan error, 1)
+	errChan := make(chan error, 2)
 	go func() {
 		err := e.StartServer(&server)
 		errChan <- err
 	}()
+	if adminRouter != nil {
+		go func() {
+			err := adminRouter.StartServer(adminServer)
+			errChan <- err
+		}()
+		fmt.Printf("Admin API is served separately on %v\n", adminAddr)
+	}
 
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
@@ -383,10 +496,22 @@ func (s *Server) Start() {
 	case sig := <-c:
 		fmt.Printf("Exiting on %v\n", sig)
 		s.Stop()
-		os.Exit(0)
+	case <-s.shutdownRequested:
+		fmt.Println("Exiting on shutdown request")
+		s.Stop()
 	}
 }
 
+// RequestShutdown shuts the node down once the given delay elapsed, as requested through the REST API.
+func (s *Server) RequestShutdown(delay time.Duration) {
+	time.AfterFunc(delay, func() {
+		select {
+		case s.shutdownRequested <- struct{}{}:
+		default:
+		}
+	})
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
 	// close the s.stopping, which would signal the rest api router that any pending commands
@@ -402,6 +527,18 @@ func (s *Server) Stop() {
 	if err != nil {
 		s.log.Error(err)
 	}
+	if adminServer != nil {
+		err = adminServer.Shutdown(context.Background())
+		if err != nil {
+			s.log.Error(err)
+		}
+	}
+	if metricsServer != nil {
+		err = metricsServer.Shutdown(context.Background())
+		if err != nil {
+			s.log.Error(err)
+		}
+	}
 
 	if s.metricServiceStarted {
 		if err := s.metricCollector.Shutdown(); err != nil {
@@ -415,5 +552,7 @@ func (s *Server) Stop() {
 
 	os.Remove(s.pidFile)
 	os.Remove(s.netFile)
+	os.Remove(s.adminNetFile)
+	os.Remove(s.metricsNetFile)
 	os.Remove(s.netListenFile)
 }
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/algorand/go-algorand/nodecontrol"
+)
+
+// launchdExitTimeout is how long launchd lets algod shut down cleanly before killing it.
+const launchdExitTimeout = 60 * time.Second
+
+// launchdThrottleInterval is the minimum delay between two restarts of a crashed node.
+const launchdThrottleInterval = 10 * time.Second
+
+var launchdTemplate = template.Must(template.New("plist").Funcs(template.FuncMap{"xml": xmlEscape}).Parse(
+	`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{xml .Label}}</string>
+	<key>ProgramArguments</key>
+	<array>
+{{- range .Arguments}}
+		<string>{{xml .}}</string>
+{{- end}}
+	</array>
+	<key>WorkingDirectory</key>
+	<string>{{xml .DataDir}}</string>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+	<key>ThrottleInterval</key>
+	<integer>{{.ThrottleInterval}}</integer>
+	<key>ExitTimeOut</key>
+	<integer>{{.ExitTimeout}}</integer>
+	<key>StandardOutPath</key>
+	<string>{{xml .StdoutPath}}</string>
+	<key>StandardErrorPath</key>
+	<string>{{xml .StderrPath}}</string>
+</dict>
+</plist>
+`))
+
+// launchdLabel returns the launchd label of the service.
+func launchdLabel(name string) string {
+	return "com.algorand." + name
+}
+
+// launchdPlist returns the property list describing the service to launchd.
+// The node is restarted whenever it exits unsuccessfully, and is otherwise left
+// stopped after a clean shutdown.
+func launchdPlist(cfg Config) ([]byte, error) {
+	data := struct {
+		Label            string
+		Arguments        []string
+		DataDir          string
+		ThrottleInterval int
+		ExitTimeout      int
+		StdoutPath       string
+		StderrPath       string
+	}{
+		Label:            launchdLabel(cfg.Name),
+		Arguments:        append([]string{cfg.Executable}, cfg.args()...),
+		DataDir:          cfg.DataDir,
+		ThrottleInterval: int(launchdThrottleInterval / time.Second),
+		ExitTimeout:      int(launchdExitTimeout / time.Second),
+		StdoutPath:       filepath.Join(cfg.DataDir, nodecontrol.StdOutFilename),
+		StderrPath:       filepath.Join(cfg.DataDir, nodecontrol.StdErrFilename),
+	}
+	var buf bytes.Buffer
+	if err := launchdTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s)) //nolint:errcheck // strings.Builder never fails
+	return b.String()
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLaunchdPlist(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := Config{
+		Name:       "algod-test",
+		DataDir:    "/var/algorand/R&D <node>",
+		Executable: "/usr/local/bin/algod",
+	}
+	plist, err := launchdPlist(cfg)
+	require.NoError(t, err)
+
+	// the property list must stay well-formed whatever the paths contain
+	var doc struct {
+		Dict struct {
+			Keys    []string `xml:"key"`
+			Strings []string `xml:"string"`
+			Array   struct {
+				Strings []string `xml:"string"`
+			} `xml:"array"`
+		} `xml:"dict"`
+	}
+	require.NoError(t, xml.Unmarshal(plist, &doc))
+	require.Contains(t, doc.Dict.Keys, "KeepAlive")
+	require.Contains(t, doc.Dict.Keys, "ExitTimeOut")
+	require.Equal(t, "com.algorand.algod-test", doc.Dict.Strings[0])
+	require.Equal(t, cfg.DataDir, doc.Dict.Strings[1])
+	require.Equal(t, append([]string{cfg.Executable}, cfg.args()...), doc.Dict.Array.Strings)
+}
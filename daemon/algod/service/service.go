@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package service integrates algod with the native service manager of the
+// operating system, so that a node can be supervised without third-party wrappers:
+// the Windows service control manager, launchd on macOS, and systemd elsewhere.
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultName is the service name used unless another one is configured.
+const DefaultName = "algod"
+
+// ErrUnsupported is returned when the operating system has no service manager algod integrates with.
+var ErrUnsupported = errors.New("native services are not supported on this platform, use the systemd units shipped in the installer directory instead")
+
+// Config describes algod as a native service.
+type Config struct {
+	// Name identifies the service to the service manager.
+	Name string
+	// DataDir is the absolute path of the data directory of the node.
+	DataDir string
+	// Executable is the absolute path of the algod binary run by the service.
+	Executable string
+}
+
+// args returns the command line the service manager runs algod with.
+func (c Config) args() []string {
+	return []string{"-d", c.DataDir, "-service", "run", "-service-name", c.Name}
+}
+
+// description returns the human readable description of the service.
+func (c Config) description() string {
+	return fmt.Sprintf("Algorand node running from the data directory %s", c.DataDir)
+}
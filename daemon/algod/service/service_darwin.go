@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build darwin
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// launchdPlistPath returns where the property list of the service is installed:
+// system-wide daemons when running as root, and per-user agents otherwise.
+func launchdPlistPath(name string) (string, error) {
+	dir := "/Library/LaunchDaemons"
+	if os.Geteuid() != 0 {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, "Library", "LaunchAgents")
+	}
+	return filepath.Join(dir, launchdLabel(name)+".plist"), nil
+}
+
+// Install registers algod with launchd and starts it.
+func Install(cfg Config) error {
+	path, err := launchdPlistPath(cfg.Name)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(path); err == nil {
+		return fmt.Errorf("service %s is already installed at %s", cfg.Name, path)
+	}
+	plist, err := launchdPlist(cfg)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, plist, 0644); err != nil {
+		return err
+	}
+	if err = launchctl("load", "-w", path); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// Uninstall stops algod and removes it from launchd.
+func Uninstall(cfg Config) error {
+	path, err := launchdPlistPath(cfg.Name)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(path); err != nil {
+		return fmt.Errorf("service %s is not installed: %w", cfg.Name, err)
+	}
+	if err = launchctl("unload", "-w", path); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Run runs the node in the foreground. launchd stops it by sending SIGTERM,
+// which start already handles by shutting the node down cleanly.
+func Run(cfg Config, log logging.Logger, start func(), stop func()) error {
+	log.Infof("Running as the launchd service %s", launchdLabel(cfg.Name))
+	start()
+	return nil
+}
+
+func launchctl(args ...string) error {
+	out, err := exec.Command("launchctl", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("launchctl %s failed: %w: %s", args[0], err, out)
+	}
+	return nil
+}
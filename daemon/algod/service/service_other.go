@@ -0,0 +1,40 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows && !darwin
+
+package service
+
+import (
+	"github.com/algorand/go-algorand/logging"
+)
+
+// Install is not supported on this platform.
+func Install(cfg Config) error {
+	return ErrUnsupported
+}
+
+// Uninstall is not supported on this platform.
+func Uninstall(cfg Config) error {
+	return ErrUnsupported
+}
+
+// Run runs the node in the foreground, leaving supervision to the init system
+// such as systemd, which stops it with SIGTERM.
+func Run(cfg Config, log logging.Logger, start func(), stop func()) error {
+	start()
+	return nil
+}
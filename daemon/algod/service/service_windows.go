@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build windows
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// restartDelay is how long the service control manager waits before restarting a failed node.
+const restartDelay = 10 * time.Second
+
+// failureResetPeriod is how long the node has to run without failing for the restart counter to reset.
+const failureResetPeriod = 24 * time.Hour
+
+// stopWaitHint tells the service control manager how long a clean shutdown may take.
+const stopWaitHint = 60 * time.Second
+
+// Event identifiers reported to the Windows event log.
+const (
+	eventStarted uint32 = iota + 1
+	eventStopped
+	eventFailed
+	eventLogged
+)
+
+// Install registers algod with the Windows service control manager, restarting it
+// whenever it fails, and registers it as a source of the Windows event log.
+func Install(cfg Config) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("cannot connect to the service control manager: %w", err)
+	}
+	defer m.Disconnect()
+
+	if s, openErr := m.OpenService(cfg.Name); openErr == nil {
+		s.Close()
+		return fmt.Errorf("service %s is already installed", cfg.Name)
+	}
+	s, err := m.CreateService(cfg.Name, cfg.Executable, mgr.Config{
+		DisplayName: "Algorand node (" + cfg.Name + ")",
+		Description: cfg.description(),
+		StartType:   mgr.StartAutomatic,
+	}, cfg.args()...)
+	if err != nil {
+		return fmt.Errorf("cannot create service %s: %w", cfg.Name, err)
+	}
+	defer s.Close()
+
+	restart := mgr.RecoveryAction{Type: mgr.ServiceRestart, Delay: restartDelay}
+	err = s.SetRecoveryActions([]mgr.RecoveryAction{restart, restart, restart}, uint32(failureResetPeriod/time.Second))
+	if err == nil {
+		// a node stopping with an error, rather than crashing, must be restarted as well
+		err = s.SetRecoveryActionsOnNonCrashFailures(true)
+	}
+	if err == nil {
+		err = eventlog.InstallAsEventCreate(cfg.Name, eventlog.Error|eventlog.Warning|eventlog.Info)
+	}
+	if err != nil {
+		s.Delete()
+		return fmt.Errorf("cannot configure service %s: %w", cfg.Name, err)
+	}
+	return s.Start()
+}
+
+// Uninstall stops algod and removes it from the Windows service control manager and event log.
+func Uninstall(cfg Config) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("cannot connect to the service control manager: %w", err)
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(cfg.Name)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed: %w", cfg.Name, err)
+	}
+	defer s.Close()
+
+	if status, queryErr := s.Query(); queryErr == nil && status.State != svc.Stopped {
+		if _, err = s.Control(svc.Stop); err != nil {
+			return fmt.Errorf("cannot stop service %s: %w", cfg.Name, err)
+		}
+	}
+	if err = s.Delete(); err != nil {
+		return fmt.Errorf("cannot delete service %s: %w", cfg.Name, err)
+	}
+	return eventlog.Remove(cfg.Name)
+}
+
+// Run runs the node under the Windows service control manager, forwarding warnings
+// and errors to the Windows event log. When algod was not started by the service
+// control manager, the node simply runs in the foreground.
+func Run(cfg Config, log logging.Logger, start func(), stop func()) error {
+	isService, err := svc.IsWindowsService()
+	if err != nil {
+		return err
+	}
+	if !isService {
+		start()
+		return nil
+	}
+
+	elog, err := eventlog.Open(cfg.Name)
+	if err != nil {
+		return fmt.Errorf("cannot open the event log of service %s: %w", cfg.Name, err)
+	}
+	defer elog.Close()
+	log.AddHook(eventLogHook{elog: elog})
+
+	return svc.Run(cfg.Name, &handler{elog: elog, start: start, stop: stop})
+}
+
+// handler translates the requests of the service control manager into node lifecycle calls.
+type handler struct {
+	elog  *eventlog.Log
+	start func()
+	stop  func()
+}
+
+// Execute implements svc.Handler
+func (h *handler) Execute(args []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	status <- svc.Status{State: svc.StartPending}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.start()
+	}()
+	status <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+	h.elog.Info(eventStarted, "Algorand node started")
+
+	for {
+		select {
+		case <-done:
+			// The node stopped without being asked to; report a failure so that the
+			// service control manager applies the recovery actions and restarts it.
+			h.elog.Error(eventFailed, "Algorand node stopped unexpectedly")
+			return true, 1
+		case request := <-requests:
+			switch request.Cmd {
+			case svc.Interrogate:
+				status <- request.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				status <- svc.Status{State: svc.StopPending, WaitHint: uint32(stopWaitHint / time.Millisecond)}
+				h.stop()
+				<-done
+				h.elog.Info(eventStopped, "Algorand node stopped")
+				return false, 0
+			}
+		}
+	}
+}
+
+// eventLogHook forwards warnings and errors logged by the node to the Windows event log.
+type eventLogHook struct {
+	elog *eventlog.Log
+}
+
+// Levels implements logrus.Hook
+func (h eventLogHook) Levels() []logrus.Level {
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel}
+}
+
+// Fire implements logrus.Hook
+func (h eventLogHook) Fire(entry *logrus.Entry) error {
+	if entry.Level == logrus.WarnLevel {
+		return h.elog.Warning(eventLogged, entry.Message)
+	}
+	return h.elog.Error(eventLogged, entry.Message)
+}
@@ -71,6 +71,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api/v1"
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
@@ -79,9 +80,10 @@ import (
 
 const (
 	apiV1Tag = "v1"
+	apiV2Tag = "v2"
 )
 
-var supportedAPIVersions = []string{apiV1Tag}
+var supportedAPIVersions = []string{apiV1Tag, apiV2Tag}
 
 // The /versions endpoint is one of two non-versioned API endpoints, since its
 // response tells us which API versions are supported (the other is /swagger.json)
@@ -156,5 +158,10 @@ func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, a
 	v1Router := rootRouter.PathPrefix(fmt.Sprintf("/%s", apiV1Tag)).Subrouter()
 	v1.RegisterHandlers(v1Router, sm, log, apiToken, reqCB)
 
+	// Handle API V2 routes at /v2/<...>. They are generated from kmd.oas3.yml
+	// with their full path, so their subrouter has no prefix of its own.
+	v2Router := rootRouter.NewRoute().Subrouter()
+	v2.RegisterHandlers(v2Router, sm, log, apiToken, reqCB)
+
 	return rootRouter
 }
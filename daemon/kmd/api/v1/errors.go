@@ -25,4 +25,5 @@ var errPasswordLong = fmt.Errorf("password too long")
 var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
+var errNoHDDerivation = fmt.Errorf("wallet does not support hierarchical deterministic key derivation")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
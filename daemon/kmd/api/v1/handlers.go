@@ -691,6 +691,69 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	successResponse(w, resp)
 }
 
+// postKeyDeriveHandler handles `POST /v1/key/derive`
+func postKeyDeriveHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/key/derive DeriveKey
+	//---
+	//    Summary: Derive a hierarchical deterministic key
+	//    Produces:
+	//    - application/json
+	//    Description: >
+	//      Derives the ARC-0052 address key m/44'/283'/account'/0/key_index from
+	//      the wallet's master derivation key and returns its address. Derived
+	//      keys are not added to the wallet.
+	//    Parameters:
+	//      - name: Derive Key Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeriveKeyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeriveKeyResponse"
+	var req kmdapi.APIV1POSTKeyDeriveRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	hdWallet, ok := wlt.(wallet.HDWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoHDDerivation)
+		return
+	}
+
+	// Derive the key
+	pk, err := hdWallet.DeriveKey(req.Account, req.KeyIndex)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// The path was validated by DeriveKey
+	path, _ := crypto.ARC52Path(crypto.ARC52ContextAddress, req.Account, req.KeyIndex)
+
+	// Build the response
+	resp := kmdapi.APIV1POSTKeyDeriveResponse{
+		Address:        encodeAddress(crypto.Digest(pk)),
+		DerivationPath: crypto.FormatBIP32Path(path),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // deleteKeyHandler handles `DELETE /v1/key`
 func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation DELETE /v1/key DeleteKey
@@ -858,6 +921,74 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postTransactionSignDerivedHandler handles `POST /v1/transaction/sign-derived`
+func postTransactionSignDerivedHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/transaction/sign-derived SignTransactionDerived
+	//---
+	//    Summary: Sign a transaction with a derived key
+	//    Description: >
+	//      Signs the passed transaction with the ARC-0052 address key
+	//      m/44'/283'/account'/0/key_index derived from the wallet's master
+	//      derivation key. If the derived address is not the sender, the
+	//      signed transaction's AuthAddr is set to it.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Transaction Derived Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignTransactionDerivedRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignTransactionDerivedResponse"
+	var req kmdapi.APIV1POSTTransactionSignDerivedRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	hdWallet, ok := wlt.(wallet.HDWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoHDDerivation)
+		return
+	}
+
+	// Decode the transaction
+	var tx transactions.Transaction
+	err = protocol.Decode(req.Transaction, &tx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
+	}
+
+	// Sign the transaction
+	stx, err := hdWallet.SignTransactionDerived(tx, req.Account, req.KeyIndex, []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTTransactionSignDerivedResponse{
+		SignedTransaction: stx,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postProgramSignHandler handles `POST /v1/program/sign`
 func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/program/sign SignProgram
@@ -1305,6 +1436,7 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
 	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
+	router.HandleFunc("/key/derive", wrapCtx(ctx, postKeyDeriveHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, deleteKeyHandler)).Methods("DELETE")
 
 	router.HandleFunc("/multisig/list", wrapCtx(ctx, postMultisigListHandler)).Methods("POST")
@@ -1315,5 +1447,6 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/multisig", wrapCtx(ctx, deleteMultisigHandler)).Methods("DELETE")
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
+	router.HandleFunc("/transaction/sign-derived", wrapCtx(ctx, postTransactionSignDerivedHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
 }
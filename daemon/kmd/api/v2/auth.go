@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/algorand/go-algorand/daemon/kmd/api/v1"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+func authMiddleware(log logging.Logger, apiToken string) func(http.Handler) http.Handler {
+	// Make sure no one is trying to call us with an invalid token
+	err := tokens.ValidateAPIToken(apiToken)
+	if err != nil {
+		log.Fatalf("cannot start server with invalid API token: %v", err)
+	}
+
+	apiTokenBytes := []byte(apiToken)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Grab the apiToken from the HTTP header, which is shared with v1
+			providedToken := []byte(r.Header.Get(v1.KMDTokenHeader))
+
+			// Check the token in constant time
+			if subtle.ConstantTimeCompare(providedToken, apiTokenBytes) == 1 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			status, resp := errorResponse(http.StatusUnauthorized, errCodeInvalidAPIToken, errInvalidAPIToken)
+			writeResponse(w, r, status, resp)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+)
+
+// Error codes reported in the code field of an ErrorResponse. Clients match on
+// them, so they must not change once released.
+const (
+	errCodeInvalidAPIToken      = "invalid-api-token"
+	errCodeMalformedRequest     = "malformed-request"
+	errCodeInvalidWalletHandle  = "invalid-wallet-handle"
+	errCodeWrongPassword        = "wrong-password"
+	errCodeNotFound             = "not-found"
+	errCodeAlreadyExists        = "already-exists"
+	errCodeWalletError          = "wallet-error"
+	errCodeIdempotencyKeyReused = "idempotency-key-reused"
+	errCodeInternal             = "internal-error"
+)
+
+var errCouldNotDecode = fmt.Errorf("could not decode request body")
+var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
+var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
+var errRequestTooLarge = fmt.Errorf("request body larger than %d bytes", maxRequestBodyBytes)
+var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errIdempotencyKeyReused = fmt.Errorf("idempotency key was already used with a different request")
+var errPrivateKeyLength = fmt.Errorf("private key must be 64 bytes")
+var errPublicKeyLength = fmt.Errorf("public key must be 32 bytes")
+var errMasterDerivationKeyLength = fmt.Errorf("master derivation key must be 32 bytes")
+var errIdempotencyKeyLength = fmt.Errorf("idempotency key longer than %d bytes", maxIdempotencyKeyLen)
@@ -0,0 +1,487 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package v2 implements the kmd v2 API from the handlers generated from
+// kmd.oas3.yml.
+package v2
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2/generated/routes"
+	"github.com/algorand/go-algorand/daemon/kmd/session"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// defaultWalletDriverName is the driver of the wallets created without
+	// naming one
+	defaultWalletDriverName = "sqlite"
+	// maxRequestBodyBytes bounds the size of the request bodies we read
+	maxRequestBodyBytes = 10 * 1024 * 1024
+)
+
+// Handlers implements routes.ServerInterface on top of kmd's session store
+type Handlers struct {
+	sm          *session.Manager
+	log         logging.Logger
+	idempotency *idempotencyCache
+}
+
+// operation performs a request given its body, returning the status and the
+// object to encode in the response
+type operation func(body []byte) (int, interface{})
+
+// serve reads the request body and runs op on it. When the client passed an
+// idempotency key, a retry of a successful request returns the original
+// response without running op again.
+func (h *Handlers) serve(w http.ResponseWriter, r *http.Request, idempotencyKey *model.IdempotencyKey, op operation) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodyBytes+1))
+	if err != nil {
+		status, resp := badRequest(errCouldNotDecode)
+		writeResponse(w, r, status, resp)
+		return
+	}
+	if len(body) > maxRequestBodyBytes {
+		status, resp := badRequest(errRequestTooLarge)
+		writeResponse(w, r, status, resp)
+		return
+	}
+
+	if idempotencyKey == nil || *idempotencyKey == "" {
+		status, resp := op(body)
+		writeResponse(w, r, status, resp)
+		return
+	}
+
+	key := *idempotencyKey
+	if len(key) > maxIdempotencyKeyLen {
+		status, resp := badRequest(errIdempotencyKeyLength)
+		writeResponse(w, r, status, resp)
+		return
+	}
+
+	result, owner, err := h.idempotency.begin(key, fingerprint(r, body))
+	if err != nil {
+		status, resp := errorResponse(http.StatusUnprocessableEntity, errCodeIdempotencyKeyReused, err)
+		writeResponse(w, r, status, resp)
+		return
+	}
+	if owner {
+		status, resp := op(body)
+		h.idempotency.finish(key, result, status, resp)
+	} else {
+		<-result.done
+	}
+	writeResponse(w, r, result.status, result.response)
+}
+
+// authenticated decodes a request naming a wallet handle token, and runs op
+// with the unlocked wallet
+func (h *Handlers) authenticated(r *http.Request, body []byte, req interface{}, token func() string, op func(wallet.Wallet, int64) (int, interface{})) (int, interface{}) {
+	err := decode(requestHandle(r), body, req)
+	if err != nil {
+		return badRequest(errCouldNotDecode)
+	}
+
+	wlt, expiresSeconds, err := h.sm.AuthWithWalletHandleToken([]byte(token()))
+	if err != nil {
+		return handleError(err)
+	}
+	return op(wlt, expiresSeconds)
+}
+
+// ListWallets lists all of the wallets that kmd is aware of.
+// (GET /v2/wallets)
+func (h *Handlers) ListWallets(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		walletMetadatas, err := driver.ListWalletMetadatas()
+		if err != nil {
+			return internalError(err)
+		}
+
+		wallets := make([]model.Wallet, 0, len(walletMetadatas))
+		for _, metadata := range walletMetadatas {
+			wallets = append(wallets, walletFromMetadata(metadata))
+		}
+		return http.StatusOK, model.ListWalletsResponse{Wallets: wallets}
+	})
+}
+
+// CreateWallet creates a new wallet with the given parameters.
+// (POST /v2/wallet)
+func (h *Handlers) CreateWallet(w http.ResponseWriter, r *http.Request, params model.CreateWalletParams) {
+	h.serve(w, r, params.IdempotencyKey, func(body []byte) (int, interface{}) {
+		var req model.CreateWalletRequest
+		err := decode(requestHandle(r), body, &req)
+		if err != nil {
+			return badRequest(errCouldNotDecode)
+		}
+
+		driverName := defaultWalletDriverName
+		if req.WalletDriverName != nil && *req.WalletDriverName != "" {
+			driverName = *req.WalletDriverName
+		}
+		walletDriver, err := driver.FetchWalletDriver(driverName)
+		if err != nil {
+			return walletError(err)
+		}
+
+		// A zero master derivation key makes the driver generate one
+		var mdk crypto.MasterDerivationKey
+		if req.MasterDerivationKey != nil {
+			if len(*req.MasterDerivationKey) != len(mdk) {
+				return badRequest(errMasterDerivationKeyLength)
+			}
+			copy(mdk[:], *req.MasterDerivationKey)
+		}
+
+		walletID, err := wallet.GenerateWalletID()
+		if err != nil {
+			return internalError(err)
+		}
+
+		// If the wallet name is blank, use the wallet ID
+		walletName := walletID
+		if req.WalletName != nil && *req.WalletName != "" {
+			walletName = []byte(*req.WalletName)
+		}
+
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), mdk)
+		if err != nil {
+			return walletError(err)
+		}
+
+		wlt, err := walletDriver.FetchWallet(walletID)
+		if err != nil {
+			return internalError(err)
+		}
+		metadata, err := wlt.Metadata()
+		if err != nil {
+			return internalError(err)
+		}
+		return http.StatusOK, model.WalletResponse{Wallet: walletFromMetadata(metadata)}
+	})
+}
+
+// InitWalletHandleToken unlocks a wallet and returns a wallet handle token.
+// (POST /v2/wallet/init)
+func (h *Handlers) InitWalletHandleToken(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.InitWalletHandleTokenRequest
+		err := decode(requestHandle(r), body, &req)
+		if err != nil {
+			return badRequest(errCouldNotDecode)
+		}
+
+		wlt, err := driver.FetchWalletByID([]byte(req.WalletId))
+		if err != nil {
+			return walletError(err)
+		}
+
+		handleToken, err := h.sm.InitWalletHandle(wlt, []byte(req.WalletPassword))
+		if err != nil {
+			return walletError(err)
+		}
+		return http.StatusOK, model.WalletHandleTokenResponse{WalletHandleToken: string(handleToken)}
+	})
+}
+
+// ReleaseWalletHandleToken invalidates a wallet handle token.
+// (POST /v2/wallet/release)
+func (h *Handlers) ReleaseWalletHandleToken(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.WalletHandleTokenRequest
+		err := decode(requestHandle(r), body, &req)
+		if err != nil {
+			return badRequest(errCouldNotDecode)
+		}
+
+		err = h.sm.ReleaseWalletHandle([]byte(req.WalletHandleToken))
+		if err != nil {
+			return handleError(err)
+		}
+		return http.StatusNoContent, nil
+	})
+}
+
+// RenewWalletHandleToken resets the expiration of a wallet handle token.
+// (POST /v2/wallet/renew)
+func (h *Handlers) RenewWalletHandleToken(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.WalletHandleTokenRequest
+		err := decode(requestHandle(r), body, &req)
+		if err != nil {
+			return badRequest(errCouldNotDecode)
+		}
+
+		wlt, expiresSeconds, err := h.sm.RenewWalletHandleToken([]byte(req.WalletHandleToken))
+		if err != nil {
+			return handleError(err)
+		}
+		return walletHandle(wlt, expiresSeconds)
+	})
+}
+
+// RenameWallet renames a wallet.
+// (POST /v2/wallet/rename)
+func (h *Handlers) RenameWallet(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.RenameWalletRequest
+		err := decode(requestHandle(r), body, &req)
+		if err != nil {
+			return badRequest(errCouldNotDecode)
+		}
+
+		wlt, err := driver.FetchWalletByID([]byte(req.WalletId))
+		if err != nil {
+			return walletError(err)
+		}
+		metadata, err := wlt.Metadata()
+		if err != nil {
+			return internalError(err)
+		}
+		walletDriver, err := driver.FetchWalletDriver(metadata.DriverName)
+		if err != nil {
+			return internalError(err)
+		}
+
+		err = walletDriver.RenameWallet([]byte(req.WalletName), metadata.ID, []byte(req.WalletPassword))
+		if err != nil {
+			return walletError(err)
+		}
+
+		metadata, err = wlt.Metadata()
+		if err != nil {
+			return internalError(err)
+		}
+		return http.StatusOK, model.WalletResponse{Wallet: walletFromMetadata(metadata)}
+	})
+}
+
+// GetWalletInfo returns the wallet of a wallet handle token, and the
+// expiration of the token.
+// (POST /v2/wallet/info)
+func (h *Handlers) GetWalletInfo(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.WalletHandleTokenRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, walletHandle)
+	})
+}
+
+// ExportMasterKey exports the master derivation key of a wallet.
+// (POST /v2/master-key/export)
+func (h *Handlers) ExportMasterKey(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.ExportMasterKeyRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			mdk, err := wlt.ExportMasterDerivationKey([]byte(req.WalletPassword))
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusOK, model.MasterKeyResponse{MasterDerivationKey: mdk[:]}
+		})
+	})
+}
+
+// ListKeysInWallet lists the addresses of the keys in a wallet.
+// (POST /v2/key/list)
+func (h *Handlers) ListKeysInWallet(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.WalletHandleTokenRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			addrs, err := wlt.ListKeys()
+			if err != nil {
+				return internalError(err)
+			}
+
+			addresses := make([]string, 0, len(addrs))
+			for _, addr := range addrs {
+				addresses = append(addresses, encodeAddress(addr))
+			}
+			return http.StatusOK, model.AddressListResponse{Addresses: addresses}
+		})
+	})
+}
+
+// ImportKey imports an externally generated key into a wallet.
+// (POST /v2/key/import)
+func (h *Handlers) ImportKey(w http.ResponseWriter, r *http.Request, params model.ImportKeyParams) {
+	h.serve(w, r, params.IdempotencyKey, func(body []byte) (int, interface{}) {
+		var req model.ImportKeyRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			var sk crypto.PrivateKey
+			if len(req.PrivateKey) != len(sk) {
+				return badRequest(errPrivateKeyLength)
+			}
+			copy(sk[:], req.PrivateKey)
+
+			addr, err := wlt.ImportKey(sk)
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusOK, model.AddressResponse{Address: encodeAddress(addr)}
+		})
+	})
+}
+
+// ExportKey exports the secret key of an address in a wallet.
+// (POST /v2/key/export)
+func (h *Handlers) ExportKey(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.ExportKeyRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			addr, err := decodeAddress(req.Address)
+			if err != nil {
+				return badRequest(err)
+			}
+
+			sk, err := wlt.ExportKey(addr, []byte(req.WalletPassword))
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusOK, model.PrivateKeyResponse{PrivateKey: sk[:]}
+		})
+	})
+}
+
+// GenerateKey generates the next key of a wallet.
+// (POST /v2/key)
+func (h *Handlers) GenerateKey(w http.ResponseWriter, r *http.Request, params model.GenerateKeyParams) {
+	h.serve(w, r, params.IdempotencyKey, func(body []byte) (int, interface{}) {
+		var req model.GenerateKeyRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			addr, err := wlt.GenerateKey(false)
+			if err != nil {
+				return internalError(err)
+			}
+			return http.StatusOK, model.AddressResponse{Address: encodeAddress(addr)}
+		})
+	})
+}
+
+// DeleteKey deletes the key of an address from a wallet.
+// (DELETE /v2/key)
+func (h *Handlers) DeleteKey(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.DeleteKeyRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			addr, err := decodeAddress(req.Address)
+			if err != nil {
+				return badRequest(err)
+			}
+
+			err = wlt.DeleteKey(addr, []byte(req.WalletPassword))
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusNoContent, nil
+		})
+	})
+}
+
+// SignTransaction signs a transaction with a key of a wallet.
+// (POST /v2/transaction/sign)
+func (h *Handlers) SignTransaction(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.SignTransactionRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			var tx transactions.Transaction
+			err := protocol.Decode(req.Transaction, &tx)
+			if err != nil {
+				return badRequest(errCouldNotDecodeTx)
+			}
+
+			// A zero public key signs with the key of the sender
+			var pk crypto.PublicKey
+			if req.PublicKey != nil {
+				if len(*req.PublicKey) != len(pk) {
+					return badRequest(errPublicKeyLength)
+				}
+				copy(pk[:], *req.PublicKey)
+			}
+
+			stx, err := wlt.SignTransaction(tx, pk, []byte(req.WalletPassword))
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusOK, model.SignedTransactionResponse{SignedTransaction: stx}
+		})
+	})
+}
+
+// SignProgram signs a program with the key of an address in a wallet.
+// (POST /v2/program/sign)
+func (h *Handlers) SignProgram(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, nil, func(body []byte) (int, interface{}) {
+		var req model.SignProgramRequest
+		return h.authenticated(r, body, &req, func() string { return req.WalletHandleToken }, func(wlt wallet.Wallet, _ int64) (int, interface{}) {
+			addr, err := decodeAddress(req.Address)
+			if err != nil {
+				return badRequest(err)
+			}
+
+			sig, err := wlt.SignProgram(req.Program, addr, []byte(req.WalletPassword))
+			if err != nil {
+				return walletError(err)
+			}
+			return http.StatusOK, model.SignatureResponse{Signature: sig}
+		})
+	})
+}
+
+// reqCallbackMiddleware calls the reqCB function once per request that passes
+// through, like the v1 API does to kick kmd's watchdog timer.
+func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reqCB()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RegisterHandlers sets up the v2 API handlers on the passed router. The
+// routes of the spec carry their /v2 prefix, so router should not add one.
+func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
+	// All /v2 requests require a valid auth token
+	router.Use(authMiddleware(log, apiToken))
+
+	// reqCB gets called each time a request matches a route
+	router.Use(reqCallbackMiddleware(reqCB))
+
+	h := &Handlers{
+		sm:          sm,
+		log:         log,
+		idempotency: makeIdempotencyCache(),
+	}
+	routes.HandlerWithOptions(h, routes.GorillaServerOptions{
+		BaseRouter: router,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			status, resp := badRequest(err)
+			writeResponse(w, r, status, resp)
+		},
+	})
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/kmd/api/v1"
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/session"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var testAPIToken = strings.Repeat("a", 64)
+
+// handlersTest serves the v2 API on top of sqlite wallets stored in a
+// temporary directory
+type handlersTest struct {
+	t        *testing.T
+	router   *mux.Router
+	requests int
+}
+
+func makeHandlersTest(t *testing.T) *handlersTest {
+	cfg := config.KMDConfig{
+		DataDir:             t.TempDir(),
+		SessionLifetimeSecs: 60,
+	}
+	cfg.DriverConfig.SQLiteWalletDriverConfig = config.SQLiteWalletDriverConfig{
+		WalletsDir:   t.TempDir(),
+		UnsafeScrypt: true,
+		ScryptParams: config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1},
+	}
+	cfg.DriverConfig.LedgerWalletDriverConfig.Disable = true
+	require.NoError(t, driver.InitWalletDrivers(cfg, logging.TestingLog(t)))
+
+	sm := session.MakeManager(cfg)
+	t.Cleanup(sm.Kill)
+
+	ht := &handlersTest{t: t, router: mux.NewRouter()}
+	RegisterHandlers(ht.router, sm, logging.TestingLog(t), testAPIToken, func() { ht.requests++ })
+	return ht
+}
+
+// call sends req to path, encoded as contentType, and decodes the response
+// into resp, which must be encoded as the Accept header asked for
+func (ht *handlersTest) call(method, path, token, contentType, accept string, req, resp interface{}) int {
+	var body []byte
+	if req != nil {
+		if contentType == msgpackContentType {
+			body = protocol.EncodeReflect(req)
+		} else {
+			body = protocol.EncodeJSON(req)
+		}
+	}
+	httpReq := httptest.NewRequest(method, path, bytes.NewReader(body))
+	httpReq.Header.Set(v1.KMDTokenHeader, token)
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		httpReq.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	ht.router.ServeHTTP(rec, httpReq)
+
+	if resp != nil {
+		if accept == msgpackContentType {
+			require.Equal(ht.t, msgpackContentType, rec.Header().Get("Content-Type"))
+			require.NoError(ht.t, protocol.DecodeReflect(rec.Body.Bytes(), resp))
+		} else {
+			require.Equal(ht.t, jsonContentType, rec.Header().Get("Content-Type"))
+			require.NoError(ht.t, protocol.DecodeJSON(rec.Body.Bytes(), resp))
+		}
+	}
+	return rec.Code
+}
+
+func (ht *handlersTest) post(path string, req, resp interface{}) int {
+	return ht.call(http.MethodPost, path, testAPIToken, jsonContentType, jsonContentType, req, resp)
+}
+
+func TestHandlersAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ht := makeHandlersTest(t)
+
+	// Requests without the API token are rejected before reaching the handlers
+	for _, token := range []string{"", strings.Repeat("b", 64), testAPIToken[1:]} {
+		var errResp model.ErrorResponse
+		status := ht.call(http.MethodGet, "/v2/wallets", token, "", jsonContentType, nil, &errResp)
+		require.Equal(t, http.StatusUnauthorized, status)
+		require.Equal(t, errCodeInvalidAPIToken, errResp.Code)
+		require.Equal(t, errInvalidAPIToken.Error(), errResp.Message)
+	}
+	require.Zero(t, ht.requests)
+
+	// Even in the format they ask for
+	var errResp model.ErrorResponse
+	status := ht.call(http.MethodGet, "/v2/wallets", "", "", msgpackContentType, nil, &errResp)
+	require.Equal(t, http.StatusUnauthorized, status)
+	require.Equal(t, errCodeInvalidAPIToken, errResp.Code)
+
+	var wallets model.ListWalletsResponse
+	status = ht.call(http.MethodGet, "/v2/wallets", testAPIToken, "", jsonContentType, nil, &wallets)
+	require.Equal(t, http.StatusOK, status)
+	require.Empty(t, wallets.Wallets)
+	require.Equal(t, 1, ht.requests)
+}
+
+func TestHandlersWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ht := makeHandlersTest(t)
+
+	name := "w"
+	var created model.WalletResponse
+	status := ht.post("/v2/wallet", model.CreateWalletRequest{WalletName: &name, WalletPassword: "pw"}, &created)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "w", created.Wallet.Name)
+	require.Equal(t, defaultWalletDriverName, created.Wallet.DriverName)
+
+	var errResp model.ErrorResponse
+	status = ht.post("/v2/wallet", model.CreateWalletRequest{WalletName: &name, WalletPassword: "pw"}, &errResp)
+	require.Equal(t, http.StatusConflict, status)
+	require.Equal(t, errCodeAlreadyExists, errResp.Code)
+
+	unknownDriver := "unknown"
+	status = ht.post("/v2/wallet", model.CreateWalletRequest{WalletDriverName: &unknownDriver, WalletPassword: "pw"}, &errResp)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errCodeWalletError, errResp.Code)
+
+	short := []byte{1, 2, 3}
+	status = ht.post("/v2/wallet", model.CreateWalletRequest{MasterDerivationKey: &short, WalletPassword: "pw"}, &errResp)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errCodeMalformedRequest, errResp.Code)
+	require.Equal(t, errMasterDerivationKeyLength.Error(), errResp.Message)
+
+	status = ht.post("/v2/wallet/init", model.InitWalletHandleTokenRequest{WalletId: created.Wallet.Id, WalletPassword: "wrong"}, &errResp)
+	require.Equal(t, http.StatusUnauthorized, status)
+	require.Equal(t, errCodeWrongPassword, errResp.Code)
+
+	status = ht.post("/v2/wallet/init", model.InitWalletHandleTokenRequest{WalletId: "missing", WalletPassword: "pw"}, &errResp)
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, errCodeNotFound, errResp.Code)
+
+	var handle model.WalletHandleTokenResponse
+	status = ht.post("/v2/wallet/init", model.InitWalletHandleTokenRequest{WalletId: created.Wallet.Id, WalletPassword: "pw"}, &handle)
+	require.Equal(t, http.StatusOK, status)
+	require.NotEmpty(t, handle.WalletHandleToken)
+
+	var info model.WalletHandleResponse
+	status = ht.post("/v2/wallet/info", model.WalletHandleTokenRequest{WalletHandleToken: handle.WalletHandleToken}, &info)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, created.Wallet, info.WalletHandle.Wallet)
+
+	status = ht.post("/v2/wallet/release", model.WalletHandleTokenRequest{WalletHandleToken: handle.WalletHandleToken}, nil)
+	require.Equal(t, http.StatusNoContent, status)
+
+	// The released handle no longer authenticates the wallet requests
+	status = ht.post("/v2/wallet/info", model.WalletHandleTokenRequest{WalletHandleToken: handle.WalletHandleToken}, &errResp)
+	require.Equal(t, http.StatusUnauthorized, status)
+	require.Equal(t, errCodeInvalidWalletHandle, errResp.Code)
+}
+
+func TestHandlersMalformedRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ht := makeHandlersTest(t)
+
+	send := func(path string, contentType string, body []byte, headers ...string) (int, model.ErrorResponse) {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set(v1.KMDTokenHeader, testAPIToken)
+		req.Header.Set("Content-Type", contentType)
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		rec := httptest.NewRecorder()
+		ht.router.ServeHTTP(rec, req)
+		var errResp model.ErrorResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &errResp))
+		return rec.Code, errResp
+	}
+
+	status, errResp := send("/v2/wallet/init", jsonContentType, []byte("{"))
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errCodeMalformedRequest, errResp.Code)
+	require.Equal(t, errCouldNotDecode.Error(), errResp.Message)
+
+	// A JSON body is not decoded as msgpack
+	status, errResp = send("/v2/wallet/init", msgpackContentType, protocol.EncodeJSON(model.InitWalletHandleTokenRequest{WalletId: "id"}))
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errCodeMalformedRequest, errResp.Code)
+
+	status, errResp = send("/v2/wallet/init", jsonContentType, bytes.Repeat([]byte(" "), maxRequestBodyBytes+1))
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errRequestTooLarge.Error(), errResp.Message)
+
+	status, errResp = send("/v2/key", jsonContentType, protocol.EncodeJSON(model.GenerateKeyRequest{}), "Idempotency-Key", strings.Repeat("k", maxIdempotencyKeyLen+1))
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errIdempotencyKeyLength.Error(), errResp.Message)
+}
+
+func TestHandlersMsgpack(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ht := makeHandlersTest(t)
+
+	// Requests and responses are encoded as msgpack or JSON independently
+	var created model.WalletResponse
+	status := ht.call(http.MethodPost, "/v2/wallet", testAPIToken, msgpackContentType, jsonContentType, model.CreateWalletRequest{WalletPassword: "pw"}, &created)
+	require.Equal(t, http.StatusOK, status)
+
+	var handle model.WalletHandleTokenResponse
+	status = ht.call(http.MethodPost, "/v2/wallet/init", testAPIToken, jsonContentType, msgpackContentType, model.InitWalletHandleTokenRequest{WalletId: created.Wallet.Id, WalletPassword: "pw"}, &handle)
+	require.Equal(t, http.StatusOK, status)
+
+	var generated model.AddressResponse
+	status = ht.call(http.MethodPost, "/v2/key", testAPIToken, msgpackContentType, msgpackContentType, model.GenerateKeyRequest{WalletHandleToken: handle.WalletHandleToken}, &generated)
+	require.Equal(t, http.StatusOK, status)
+
+	// The first acceptable type of the Accept header wins, JSON being the default
+	var keys model.AddressListResponse
+	status = ht.call(http.MethodPost, "/v2/key/list", testAPIToken, msgpackContentType, "text/html, application/msgpack;q=0.9, application/json", model.WalletHandleTokenRequest{WalletHandleToken: handle.WalletHandleToken}, nil)
+	require.Equal(t, http.StatusOK, status)
+	status = ht.call(http.MethodPost, "/v2/key/list", testAPIToken, msgpackContentType, "", model.WalletHandleTokenRequest{WalletHandleToken: handle.WalletHandleToken}, &keys)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []string{generated.Address}, keys.Addresses)
+
+	// Errors are encoded as asked for too
+	var errResp model.ErrorResponse
+	status = ht.call(http.MethodPost, "/v2/key/export", testAPIToken, msgpackContentType, msgpackContentType, model.ExportKeyRequest{WalletHandleToken: handle.WalletHandleToken, Address: "bad", WalletPassword: "pw"}, &errResp)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, errCodeMalformedRequest, errResp.Code)
+	require.Equal(t, errCouldNotDecodeAddress.Error(), errResp.Message)
+
+	var exported model.PrivateKeyResponse
+	status = ht.call(http.MethodPost, "/v2/key/export", testAPIToken, msgpackContentType, msgpackContentType, model.ExportKeyRequest{WalletHandleToken: handle.WalletHandleToken, Address: generated.Address, WalletPassword: "pw"}, &exported)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, exported.PrivateKey, 64)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const (
+	// idempotencyKeyTTL is how long the response of an operation performed
+	// with an idempotency key is kept to answer retries
+	idempotencyKeyTTL = time.Hour
+	// maxIdempotencyKeys bounds the number of responses kept, dropping the
+	// oldest ones first
+	maxIdempotencyKeys = 10000
+	// maxIdempotencyKeyLen matches the maxLength of the header in the spec
+	maxIdempotencyKeyLen = 256
+)
+
+// idempotentResult is the outcome of an operation performed with an
+// idempotency key. done is closed once status and response are set.
+type idempotentResult struct {
+	fingerprint crypto.Digest
+	expires     time.Time
+	done        chan struct{}
+	status      int
+	response    interface{}
+}
+
+type idempotencyEntry struct {
+	key    string
+	result *idempotentResult
+}
+
+// idempotencyCache remembers the successful responses of the operations
+// performed with an idempotency key, so that a retried request returns the
+// original response instead of performing the operation again. Failed
+// operations are forgotten so that they can be retried.
+type idempotencyCache struct {
+	mu      deadlock.Mutex
+	results map[string]*idempotentResult
+	// order lists the entries from the oldest to the newest, and may hold
+	// entries that were since removed from results
+	order []idempotencyEntry
+	now   func() time.Time
+}
+
+func makeIdempotencyCache() *idempotencyCache {
+	return &idempotencyCache{
+		results: make(map[string]*idempotentResult),
+		now:     time.Now,
+	}
+}
+
+// fingerprint identifies the request a key was used with
+func fingerprint(r *http.Request, body []byte) crypto.Digest {
+	return crypto.Hash(append([]byte(r.Method+" "+r.URL.Path+"\n"), body...))
+}
+
+// begin looks up the result stored for key. If there is none, it records a
+// pending result that the caller owns and must complete with finish.
+// Otherwise the stored result is returned, and callers wait on its done
+// channel before reading it. Reusing a key for a different request fails.
+func (c *idempotencyCache) begin(key string, fp crypto.Digest) (result *idempotentResult, owner bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.pruneLocked(now)
+
+	if result, ok := c.results[key]; ok {
+		if result.fingerprint != fp {
+			return nil, false, errIdempotencyKeyReused
+		}
+		return result, false, nil
+	}
+
+	result = &idempotentResult{
+		fingerprint: fp,
+		expires:     now.Add(idempotencyKeyTTL),
+		done:        make(chan struct{}),
+	}
+	c.results[key] = result
+	c.order = append(c.order, idempotencyEntry{key: key, result: result})
+	return result, true, nil
+}
+
+// finish completes a result returned by begin, forgetting it unless the
+// operation succeeded
+func (c *idempotencyCache) finish(key string, result *idempotentResult, status int, response interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result.status = status
+	result.response = response
+	if status >= http.StatusMultipleChoices && c.results[key] == result {
+		delete(c.results, key)
+	}
+	close(result.done)
+}
+
+// pruneLocked drops the expired results, and the oldest ones when the cache
+// is full
+func (c *idempotencyCache) pruneLocked(now time.Time) {
+	for len(c.order) > 0 {
+		entry := c.order[0]
+		current := c.results[entry.key] == entry.result
+		if current && now.Before(entry.result.expires) && len(c.results) < maxIdempotencyKeys {
+			break
+		}
+		if current {
+			delete(c.results, entry.key)
+		}
+		c.order[0] = idempotencyEntry{}
+		c.order = c.order[1:]
+	}
+}
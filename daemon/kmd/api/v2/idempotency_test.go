@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2/generated/model"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeIdempotencyCache()
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+
+	req := httptest.NewRequest(http.MethodPost, "/v2/key", nil)
+	fp := fingerprint(req, []byte("a"))
+
+	result, owner, err := c.begin("k", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+	c.finish("k", result, http.StatusOK, "first")
+
+	// A retry gets the stored result
+	replay, owner, err := c.begin("k", fp)
+	require.NoError(t, err)
+	require.False(t, owner)
+	<-replay.done
+	require.Equal(t, "first", replay.response)
+
+	// Reusing the key for another request fails
+	_, _, err = c.begin("k", fingerprint(req, []byte("b")))
+	require.ErrorIs(t, err, errIdempotencyKeyReused)
+
+	// Failures are forgotten
+	result, owner, err = c.begin("failed", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+	c.finish("failed", result, http.StatusUnauthorized, "denied")
+	_, owner, err = c.begin("failed", fp)
+	require.NoError(t, err)
+	require.True(t, owner)
+
+	// Results expire
+	now = now.Add(idempotencyKeyTTL)
+	_, owner, err = c.begin("k", fingerprint(req, []byte("b")))
+	require.NoError(t, err)
+	require.True(t, owner)
+}
+
+func TestIdempotencyCacheBounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeIdempotencyCache()
+	req := httptest.NewRequest(http.MethodPost, "/v2/key", nil)
+	fp := fingerprint(req, nil)
+	for i := 0; i < maxIdempotencyKeys+10; i++ {
+		key := string(protocol.EncodeJSON(i))
+		result, owner, err := c.begin(key, fp)
+		require.NoError(t, err)
+		require.True(t, owner)
+		c.finish(key, result, http.StatusOK, i)
+	}
+	require.Len(t, c.results, maxIdempotencyKeys)
+	require.NotContains(t, c.results, "0")
+	require.Contains(t, c.results, string(protocol.EncodeJSON(maxIdempotencyKeys+9)))
+}
+
+func TestServeIdempotencyKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	h := &Handlers{idempotency: makeIdempotencyCache()}
+	calls := 0
+	op := func(body []byte) (int, interface{}) {
+		calls++
+		return http.StatusOK, model.AddressResponse{Address: string(body)}
+	}
+	serve := func(key string, body string, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v2/key", bytes.NewBufferString(body))
+		req.Header.Set("Accept", accept)
+		rec := httptest.NewRecorder()
+		h.serve(rec, req, &key, op)
+		return rec
+	}
+
+	rec := serve("k", "addr", jsonContentType)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, jsonContentType, rec.Header().Get("Content-Type"))
+	var resp model.AddressResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &resp))
+	require.Equal(t, "addr", resp.Address)
+
+	// The retry replays the response in the format it asks for
+	rec = serve("k", "addr", msgpackContentType)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, msgpackContentType, rec.Header().Get("Content-Type"))
+	resp = model.AddressResponse{}
+	require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &resp))
+	require.Equal(t, "addr", resp.Address)
+	require.Equal(t, 1, calls)
+
+	rec = serve("k", "other", jsonContentType)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var errResp model.ErrorResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &errResp))
+	require.Equal(t, errCodeIdempotencyKeyReused, errResp.Code)
+	require.Equal(t, 1, calls)
+
+	// Without a key, the operation runs every time
+	rec = serve("", "addr", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/api/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	jsonContentType    = "application/json"
+	msgpackContentType = "application/msgpack"
+)
+
+// mediaType returns the media type of a Content-Type or Accept entry, without
+// its parameters
+func mediaType(value string) string {
+	mt, _, err := mime.ParseMediaType(strings.TrimSpace(value))
+	if err != nil {
+		return ""
+	}
+	return mt
+}
+
+// requestHandle returns the codec matching the Content-Type of the request.
+// Bodies are JSON unless the client says otherwise.
+func requestHandle(r *http.Request) codec.Handle {
+	if mediaType(r.Header.Get("Content-Type")) == msgpackContentType {
+		return protocol.CodecHandle
+	}
+	return protocol.JSONHandle
+}
+
+// responseHandle picks the encoding of the response from the Accept header of
+// the request, returning the codec and the Content-Type to report. The first
+// supported media type wins, and JSON is used when none is supported.
+func responseHandle(r *http.Request) (codec.Handle, string) {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		switch mediaType(accept) {
+		case msgpackContentType:
+			return protocol.CodecHandle, msgpackContentType
+		case jsonContentType:
+			return protocol.JSONHandle, jsonContentType
+		}
+	}
+	return protocol.JSONHandle, jsonContentType
+}
+
+func encode(handle codec.Handle, obj interface{}) ([]byte, error) {
+	var output []byte
+	enc := codec.NewEncoderBytes(&output, handle)
+
+	err := enc.Encode(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode object: %v", err)
+	}
+	return output, nil
+}
+
+func decode(handle codec.Handle, data []byte, v interface{}) error {
+	dec := codec.NewDecoderBytes(data, handle)
+
+	err := dec.Decode(v)
+	if err != nil {
+		return fmt.Errorf("failed to decode object: %v", err)
+	}
+	return nil
+}
+
+// writeResponse encodes obj in the format requested by the client. A nil obj
+// writes an empty body.
+func writeResponse(w http.ResponseWriter, r *http.Request, status int, obj interface{}) {
+	if obj == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	handle, contentType := responseHandle(r)
+	data, err := encode(handle, obj)
+	if err != nil {
+		status, obj = internalError(err)
+		contentType = jsonContentType
+		data = protocol.EncodeJSON(obj)
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// errorResponse builds the body reported for a failed request
+func errorResponse(status int, code string, err error) (int, interface{}) {
+	return status, model.ErrorResponse{
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+func badRequest(err error) (int, interface{}) {
+	return errorResponse(http.StatusBadRequest, errCodeMalformedRequest, err)
+}
+
+func internalError(err error) (int, interface{}) {
+	return errorResponse(http.StatusInternalServerError, errCodeInternal, err)
+}
+
+// handleError reports a wallet handle token that could not be used
+func handleError(err error) (int, interface{}) {
+	return errorResponse(http.StatusUnauthorized, errCodeInvalidWalletHandle, err)
+}
+
+// walletError classifies an error returned by a wallet or its driver
+func walletError(err error) (int, interface{}) {
+	switch {
+	case driver.IsNotFound(err):
+		return errorResponse(http.StatusNotFound, errCodeNotFound, err)
+	case driver.IsAlreadyExists(err):
+		return errorResponse(http.StatusConflict, errCodeAlreadyExists, err)
+	case driver.IsWrongPassword(err):
+		return errorResponse(http.StatusUnauthorized, errCodeWrongPassword, err)
+	default:
+		return errorResponse(http.StatusBadRequest, errCodeWalletError, err)
+	}
+}
+
+func encodeAddress(addr crypto.Digest) string {
+	return basics.Address(addr).GetUserAddress()
+}
+
+// decodeAddress parses a checksummed address from a request
+func decodeAddress(addr string) (crypto.Digest, error) {
+	reqAddr, err := basics.UnmarshalChecksumAddress(addr)
+	if err != nil {
+		return crypto.Digest{}, errCouldNotDecodeAddress
+	}
+	return crypto.Digest(reqAddr), nil
+}
+
+// walletFromMetadata converts our internal wallet metadata into its API
+// representation
+func walletFromMetadata(metadata wallet.Metadata) model.Wallet {
+	supportedTxs := make([]string, 0, len(metadata.SupportedTransactions))
+	for _, txType := range metadata.SupportedTransactions {
+		supportedTxs = append(supportedTxs, string(txType))
+	}
+	return model.Wallet{
+		Id:            string(metadata.ID),
+		Name:          string(metadata.Name),
+		DriverName:    metadata.DriverName,
+		DriverVersion: uint64(metadata.DriverVersion),
+		MnemonicUx:    metadata.SupportsMnemonicUX,
+		SupportedTxs:  supportedTxs,
+	}
+}
+
+// walletHandle describes an unlocked wallet and the lifetime of its token
+func walletHandle(w wallet.Wallet, expiresSeconds int64) (int, interface{}) {
+	metadata, err := w.Metadata()
+	if err != nil {
+		return internalError(err)
+	}
+	if expiresSeconds < 0 {
+		expiresSeconds = 0
+	}
+	return http.StatusOK, model.WalletHandleResponse{
+		WalletHandle: model.WalletHandle{
+			Wallet:         walletFromMetadata(metadata),
+			ExpiresSeconds: uint64(expiresSeconds),
+		},
+	}
+}
@@ -117,12 +117,18 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTKeyListRequest:
 		reqPath = "v1/key/list"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTKeyDeriveRequest:
+		reqPath = "v1/key/derive"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTProgramSignRequest:
 		reqPath = "v1/program/sign"
 		reqMethod = "POST"
 	case kmdapi.APIV1POSTTransactionSignRequest:
 		reqPath = "v1/transaction/sign"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTTransactionSignDerivedRequest:
+		reqPath = "v1/transaction/sign-derived"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMultisigListRequest:
 		reqPath = "v1/multisig/list"
 		reqMethod = "POST"
@@ -210,6 +210,31 @@ func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKe
 	return
 }
 
+// DeriveKey wraps kmdapi.APIV1POSTKeyDeriveRequest
+func (kcl KMDClient) DeriveKey(walletHandle []byte, account, keyIndex uint32) (resp kmdapi.APIV1POSTKeyDeriveResponse, err error) {
+	req := kmdapi.APIV1POSTKeyDeriveRequest{
+		WalletHandleToken: string(walletHandle),
+		Account:           account,
+		KeyIndex:          keyIndex,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SignTransactionDerived wraps kmdapi.APIV1POSTTransactionSignDerivedRequest
+func (kcl KMDClient) SignTransactionDerived(walletHandle, pw []byte, account, keyIndex uint32, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignDerivedResponse, err error) {
+	txBytes := protocol.Encode(&tx)
+	req := kmdapi.APIV1POSTTransactionSignDerivedRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		Account:           account,
+		KeyIndex:          keyIndex,
+		Transaction:       txBytes,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignProgram wraps kmdapi.APIV1POSTProgramSignRequest
 func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []byte) (resp kmdapi.APIV1POSTProgramSignResponse, err error) {
 	req := kmdapi.APIV1POSTProgramSignRequest{
@@ -18,6 +18,8 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -46,6 +48,7 @@ type KMDConfig struct {
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	HSMWalletDriverConfig    HSMWalletDriverConfig    `json:"hsm"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -60,6 +63,49 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// HSMWalletDriverConfig is configuration specific to the HSMWalletDriver.
+// Each entry exposes the Ed25519 keys of one signing backend as a wallet.
+type HSMWalletDriverConfig struct {
+	Wallets []HSMWalletConfig `json:"wallets"`
+}
+
+// HSMWalletConfig describes a single hardware-backed wallet
+type HSMWalletConfig struct {
+	Name    string          `json:"name"`
+	Backend string          `json:"backend"`
+	PKCS11  HSMPKCS11Config `json:"pkcs11"`
+	Remote  HSMRemoteConfig `json:"remote"`
+}
+
+// HSMPKCS11Config locates a token through a PKCS#11 module. The user PIN is
+// read from the environment variable named by PINEnv so that it never has to
+// be written to the kmd config file.
+type HSMPKCS11Config struct {
+	ModulePath string `json:"module_path"`
+	TokenLabel string `json:"token_label"`
+	PINEnv     string `json:"pin_env"`
+}
+
+// HSMRemoteConfig points at a remote signing service. CertFile and KeyFile
+// enable TLS client authentication; the optional bearer token is read from
+// the environment variable named by TokenEnv. The service is spoken to over
+// HTTPS with JSON bodies, not gRPC; see remoteSigner in the wallet driver
+// package for the protocol.
+type HSMRemoteConfig struct {
+	URL      string `json:"url"`
+	CAFile   string `json:"ca_file"`
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	TokenEnv string `json:"token_env"`
+}
+
+const (
+	// HSMBackendPKCS11 selects the PKCS#11 backend
+	HSMBackendPKCS11 = "pkcs11"
+	// HSMBackendRemote selects the remote signer backend
+	HSMBackendRemote = "remote"
+)
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -95,6 +141,37 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	return k.DriverConfig.HSMWalletDriverConfig.validate()
+}
+
+func (h HSMWalletDriverConfig) validate() error {
+	names := make(map[string]bool, len(h.Wallets))
+	for _, w := range h.Wallets {
+		if w.Name == "" {
+			return ErrHSMWalletName
+		}
+		if names[w.Name] {
+			return fmt.Errorf("%w: %s", ErrHSMWalletDuplicate, w.Name)
+		}
+		names[w.Name] = true
+
+		switch w.Backend {
+		case HSMBackendPKCS11:
+			if !filepath.IsAbs(w.PKCS11.ModulePath) {
+				return fmt.Errorf("%w: %s", ErrHSMModuleNotAbsolute, w.Name)
+			}
+		case HSMBackendRemote:
+			u, err := url.Parse(w.Remote.URL)
+			if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				return fmt.Errorf("%w: %s", ErrHSMRemoteURL, w.Name)
+			}
+			if (w.Remote.CertFile == "") != (w.Remote.KeyFile == "") {
+				return fmt.Errorf("%w: %s", ErrHSMClientCert, w.Name)
+			}
+		default:
+			return fmt.Errorf("%w: %s", ErrHSMBackend, w.Backend)
+		}
+	}
 	return nil
 }
 
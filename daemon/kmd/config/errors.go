@@ -22,3 +22,21 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrHSMWalletName is returned when an hsm wallet has no name
+var ErrHSMWalletName = fmt.Errorf("hsm wallets must have a name")
+
+// ErrHSMWalletDuplicate is returned when two hsm wallets share a name
+var ErrHSMWalletDuplicate = fmt.Errorf("duplicate hsm wallet name")
+
+// ErrHSMBackend is returned when an hsm wallet names an unknown backend
+var ErrHSMBackend = fmt.Errorf("unknown hsm backend")
+
+// ErrHSMModuleNotAbsolute is returned when the PKCS#11 module path is relative
+var ErrHSMModuleNotAbsolute = fmt.Errorf("pkcs11 module path must be absolute path")
+
+// ErrHSMRemoteURL is returned when the remote signer URL is not an http(s) URL
+var ErrHSMRemoteURL = fmt.Errorf("remote signer url must be an http or https url")
+
+// ErrHSMClientCert is returned when only one of the client certificate and key is set
+var ErrHSMClientCert = fmt.Errorf("remote signer cert_file and key_file must be set together")
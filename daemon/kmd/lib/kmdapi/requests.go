@@ -140,6 +140,17 @@ type APIV1POSTKeyRequest struct {
 	DisplayMnemonic   bool   `json:"display_mnemonic"`
 }
 
+// APIV1POSTKeyDeriveRequest is the request for `POST /v1/key/derive`
+//
+// swagger:model DeriveKeyRequest
+type APIV1POSTKeyDeriveRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Account           uint32 `json:"account"`
+	KeyIndex          uint32 `json:"key_index"`
+}
+
 // APIV1DELETEKeyRequest is the request for `DELETE /v1/key`
 //
 // swagger:model DeleteKeyRequest
@@ -178,6 +189,22 @@ type APIV1POSTTransactionSignRequest struct {
 	WalletPassword string           `json:"wallet_password"`
 }
 
+// APIV1POSTTransactionSignDerivedRequest is the request for `POST /v1/transaction/sign-derived`
+//
+// swagger:model SignTransactionDerivedRequest
+type APIV1POSTTransactionSignDerivedRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// Base64 encoding of msgpack encoding of a `Transaction` object
+	//
+	// swagger:strfmt byte
+	Transaction    []byte `json:"transaction"`
+	Account        uint32 `json:"account"`
+	KeyIndex       uint32 `json:"key_index"`
+	WalletPassword string `json:"wallet_password"`
+}
+
 // APIV1POSTProgramSignRequest is the request for `POST /v1/program/sign`
 //
 // swagger:model SignProgramRequest
@@ -215,6 +215,21 @@ type generateKeyResponse struct {
 	Body *APIV1POSTKeyResponse
 }
 
+// APIV1POSTKeyDeriveResponse is the response to `POST /v1/key/derive`
+// friendly:DeriveKeyResponse
+type APIV1POSTKeyDeriveResponse struct {
+	APIV1ResponseEnvelope
+	Address        string `json:"address"`
+	DerivationPath string `json:"derivation_path"`
+}
+
+// Response to `POST /v1/key/derive`
+// swagger:response DeriveKeyResponse
+type deriveKeyResponse struct {
+	//	in:body
+	Body *APIV1POSTKeyDeriveResponse
+}
+
 // APIV1DELETEKeyResponse is the response to `DELETE /v1/key`
 // friendly:DeleteKeyResponse
 type APIV1DELETEKeyResponse struct {
@@ -258,6 +273,22 @@ type signTransactionResponse struct {
 	Body *APIV1POSTTransactionSignResponse
 }
 
+// APIV1POSTTransactionSignDerivedResponse is the response to `POST /v1/transaction/sign-derived`
+// friendly:SignTransactionDerivedResponse
+type APIV1POSTTransactionSignDerivedResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	SignedTransaction []byte `json:"signed_transaction"`
+}
+
+// Response to `POST /v1/transaction/sign-derived`
+// swagger:response SignTransactionDerivedResponse
+type signTransactionDerivedResponse struct {
+	//	in:body
+	Body *APIV1POSTTransactionSignDerivedResponse
+}
+
 // APIV1POSTProgramSignResponse is the response to `POST /v1/data/sign`
 // friendly:SignProgramResponse
 type APIV1POSTProgramSignResponse struct {
@@ -28,6 +28,7 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	hsmWalletDriverName:    &HSMWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -116,3 +117,21 @@ func ListWalletDriverNames() []string {
 	}
 	return drivers
 }
+
+// IsNotFound returns true if err reports that the requested wallet, key or
+// multisig preimage does not exist
+func IsNotFound(err error) bool {
+	return err == errWalletNotFound || err == errKeyNotFound || err == errMsigDataNotFound
+}
+
+// IsAlreadyExists returns true if err reports that the wallet or key being
+// created conflicts with an existing one
+func IsAlreadyExists(err error) bool {
+	return err == errKeyExists || err == errSameName || err == errSameID
+}
+
+// IsWrongPassword returns true if err reports that the wallet password did
+// not match
+func IsWrongPassword(err error) bool {
+	return err == errDecrypt
+}
@@ -0,0 +1,490 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+const (
+	hsmWalletDriverName      = "hsm"
+	hsmWalletDriverVersion   = 1
+	hsmWalletsDirName        = "hsm_wallets"
+	hsmWalletsDirPermissions = 0700
+)
+
+var hsmWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// hsmSigner is implemented by each HSM backend. Spending keys stay inside
+// the backend; kmd only ever sees public keys and Ed25519 signatures over
+// the domain-separated message bytes it passes to Sign.
+type hsmSigner interface {
+	PublicKeys() ([]crypto.PublicKey, error)
+	Sign(pk crypto.PublicKey, msg []byte) (crypto.Signature, error)
+	Close() error
+}
+
+// openHSMSigner connects to the backend selected by cfg
+func openHSMSigner(cfg config.HSMWalletConfig) (hsmSigner, error) {
+	switch cfg.Backend {
+	case config.HSMBackendPKCS11:
+		return openPKCS11Signer(cfg.PKCS11)
+	case config.HSMBackendRemote:
+		return makeRemoteSigner(cfg.Remote)
+	default:
+		return nil, fmt.Errorf("%w: %s", config.ErrHSMBackend, cfg.Backend)
+	}
+}
+
+// HSMWalletDriver exposes keys held by hardware security modules, either
+// through a local PKCS#11 module or a remote signing service. Wallets are
+// declared in the kmd config rather than created through the API, and keys
+// are provisioned on the HSM itself.
+type HSMWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*HSMWallet
+	log     logging.Logger
+}
+
+// HSMWallet represents a particular wallet under the HSMWalletDriver.
+// Multisig preimages are public information, so they are kept in a JSON
+// file next to the other kmd state.
+type HSMWallet struct {
+	mu       deadlock.Mutex
+	id       string
+	name     string
+	signer   hsmSigner
+	msigPath string
+	msigs    map[crypto.Digest]hsmMultisigPreimage
+}
+
+// hsmMultisigPreimage is the on-disk form of an imported multisig address
+type hsmMultisigPreimage struct {
+	Version   uint8            `json:"version"`
+	Threshold uint8            `json:"threshold"`
+	PKs       []basics.Address `json:"pks"`
+}
+
+func makeHSMMultisigPreimage(version, threshold uint8, pks []crypto.PublicKey) hsmMultisigPreimage {
+	preimage := hsmMultisigPreimage{Version: version, Threshold: threshold}
+	for _, pk := range pks {
+		preimage.PKs = append(preimage.PKs, basics.Address(pk))
+	}
+	return preimage
+}
+
+func (p hsmMultisigPreimage) publicKeys() []crypto.PublicKey {
+	pks := make([]crypto.PublicKey, len(p.PKs))
+	for i, addr := range p.PKs {
+		pks[i] = crypto.PublicKey(addr)
+	}
+	return pks
+}
+
+// InitWithConfig opens every configured HSM wallet. A backend that cannot be
+// opened is logged and skipped so that it does not keep kmd from starting.
+func (hwd *HSMWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	hwd.log = log
+	for _, w := range hwd.wallets {
+		w.signer.Close()
+	}
+	hwd.wallets = make(map[string]*HSMWallet)
+
+	hsmCfg := cfg.DriverConfig.HSMWalletDriverConfig
+	if len(hsmCfg.Wallets) == 0 {
+		return nil
+	}
+
+	dir := filepath.Join(cfg.DataDir, hsmWalletsDirName)
+	err := os.Mkdir(dir, hsmWalletsDirPermissions)
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	for _, wcfg := range hsmCfg.Wallets {
+		signer, err := openHSMSigner(wcfg)
+		if err != nil {
+			hwd.log.Warnf("failed to open hsm wallet %s: %v", wcfg.Name, err)
+			continue
+		}
+
+		id := pathToID(hsmWalletDriverName + "/" + wcfg.Name)
+		hw := &HSMWallet{
+			id:       id,
+			name:     wcfg.Name,
+			signer:   signer,
+			msigPath: filepath.Join(dir, id+".json"),
+		}
+		err = hw.loadMultisigs()
+		if err != nil {
+			signer.Close()
+			return fmt.Errorf("hsm wallet %s: %w", wcfg.Name, err)
+		}
+		hwd.wallets[id] = hw
+	}
+	return nil
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (hwd *HSMWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	for _, w := range hwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. HSM wallets are declared in
+// the kmd config file.
+func (hwd *HSMWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (hwd *HSMWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (hwd *HSMWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	hw, ok := hwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return hw, nil
+}
+
+// Init implements the Wallet interface. Access to the keys is controlled by
+// the HSM credentials in the kmd config, not by a wallet password.
+func (hw *HSMWallet) Init(pw []byte) error {
+	return nil
+}
+
+// CheckPassword implements the Wallet interface.
+func (hw *HSMWallet) CheckPassword(pw []byte) error {
+	return nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (hw *HSMWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (hw *HSMWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(hw.id),
+		Name:                  []byte(hw.name),
+		DriverName:            hsmWalletDriverName,
+		DriverVersion:         hsmWalletDriverVersion,
+		SupportedTransactions: hsmWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface.
+func (hw *HSMWallet) ListKeys() ([]crypto.Digest, error) {
+	pks, err := hw.signer.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]crypto.Digest, len(pks))
+	for i, pk := range pks {
+		addrs[i] = publicKeyToAddress(pk)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (hw *HSMWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (hw *HSMWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (hw *HSMWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (hw *HSMWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (hw *HSMWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	addr, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	if _, ok := hw.msigs[addr]; ok {
+		return crypto.Digest{}, errKeyExists
+	}
+	hw.msigs[addr] = makeHSMMultisigPreimage(version, threshold, pks)
+	err = hw.saveMultisigsLocked()
+	if err != nil {
+		delete(hw.msigs, addr)
+		return crypto.Digest{}, err
+	}
+	return addr, nil
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (hw *HSMWallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	preimage, ok := hw.msigs[addr]
+	if !ok {
+		return 0, 0, nil, errMsigDataNotFound
+	}
+	return preimage.Version, preimage.Threshold, preimage.publicKeys(), nil
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (hw *HSMWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	for addr := range hw.msigs {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (hw *HSMWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	preimage, ok := hw.msigs[addr]
+	if !ok {
+		return errMsigDataNotFound
+	}
+	delete(hw.msigs, addr)
+	err := hw.saveMultisigsLocked()
+	if err != nil {
+		hw.msigs[addr] = preimage
+	}
+	return err
+}
+
+// SignTransaction implements the Wallet interface.
+func (hw *HSMWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+
+	sig, err := hw.sign(pk, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (hw *HSMWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	progb := logic.Program(data)
+	sig, err := hw.sign(crypto.PublicKey(src), &progb)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (hw *HSMWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	return hw.multisigSign(tx, crypto.Digest(tx.Src()), signer, pk, partial)
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (hw *HSMWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	progb := logic.Program(data)
+	return hw.multisigSign(&progb, src, crypto.Digest{}, pk, partial)
+}
+
+// multisigSign adds pk's signature over msg to partial. An empty partial
+// starts a new multisig from the imported preimage of src; otherwise the
+// partial must belong to src or, when rekeyed, to signer.
+func (hw *HSMWallet) multisigSign(msg crypto.Hashable, src, signer crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig) (crypto.MultisigSig, error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		version, threshold, pks, err := hw.LookupMultisigPreimage(src)
+		if err != nil {
+			return partial, err
+		}
+		partial.Version = version
+		partial.Threshold = threshold
+		partial.Subsigs = make([]crypto.MultisigSubsig, len(pks))
+		for i := range pks {
+			partial.Subsigs[i].Key = pks[i]
+		}
+	} else {
+		addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+		if err != nil {
+			return partial, err
+		}
+		if addr != src && addr != signer {
+			return partial, errMsigWrongAddr
+		}
+	}
+
+	isValidKey := false
+	for _, subsig := range partial.Subsigs {
+		if subsig.Key == pk {
+			isValidKey = true
+			break
+		}
+	}
+	if !isValidKey {
+		return partial, errMsigWrongKey
+	}
+
+	sig, err := hw.sign(pk, msg)
+	if err != nil {
+		return partial, err
+	}
+
+	// Copy the subsigs so that a failed merge never leaves the caller's
+	// partial half-updated
+	result := partial
+	result.Subsigs = append([]crypto.MultisigSubsig(nil), partial.Subsigs...)
+	for i := range result.Subsigs {
+		if result.Subsigs[i].Key == pk {
+			result.Subsigs[i].Sig = sig
+		}
+	}
+	return result, nil
+}
+
+// sign asks the backend to sign msg with pk and checks the result, so that a
+// misbehaving backend cannot hand back an unusable signature
+func (hw *HSMWallet) sign(pk crypto.PublicKey, msg crypto.Hashable) (crypto.Signature, error) {
+	pks, err := hw.signer.PublicKeys()
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+
+	found := false
+	for _, k := range pks {
+		if k == pk {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return crypto.Signature{}, errKeyNotFound
+	}
+
+	sig, err := hw.signer.Sign(pk, crypto.HashRep(msg))
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(msg, sig) {
+		return crypto.Signature{}, errHSMBadSignature
+	}
+	return sig, nil
+}
+
+// loadMultisigs reads the imported multisig preimages, if any
+func (hw *HSMWallet) loadMultisigs() error {
+	hw.msigs = make(map[crypto.Digest]hsmMultisigPreimage)
+
+	var preimages []hsmMultisigPreimage
+	err := codecs.LoadObjectFromFile(hw.msigPath, &preimages)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, preimage := range preimages {
+		addr, err := crypto.MultisigAddrGen(preimage.Version, preimage.Threshold, preimage.publicKeys())
+		if err != nil {
+			return err
+		}
+		hw.msigs[addr] = preimage
+	}
+	return nil
+}
+
+// saveMultisigsLocked writes the imported multisig preimages. hw.mu must be
+// held
+func (hw *HSMWallet) saveMultisigsLocked() error {
+	preimages := make([]hsmMultisigPreimage, 0, len(hw.msigs))
+	for _, preimage := range hw.msigs {
+		preimages = append(preimages, preimage)
+	}
+	return codecs.SaveObjectToFile(hw.msigPath, preimages, true)
+}
@@ -0,0 +1,467 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build cgo && !windows
+
+package driver
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+
+// The subset of the PKCS#11 v2.40 ABI used by kmd. Only the leading entries
+// of CK_FUNCTION_LIST are declared; their order is fixed by the standard.
+typedef unsigned long CK_ULONG;
+typedef CK_ULONG CK_RV;
+typedef CK_ULONG CK_SLOT_ID;
+typedef CK_ULONG CK_SESSION_HANDLE;
+typedef CK_ULONG CK_OBJECT_HANDLE;
+typedef unsigned char CK_BYTE;
+
+typedef struct { CK_BYTE major; CK_BYTE minor; } CK_VERSION;
+typedef struct { CK_ULONG type; void *pValue; CK_ULONG ulValueLen; } CK_ATTRIBUTE;
+typedef struct { CK_ULONG mechanism; void *pParameter; CK_ULONG ulParameterLen; } CK_MECHANISM;
+
+typedef struct {
+	void *CreateMutex, *DestroyMutex, *LockMutex, *UnlockMutex;
+	CK_ULONG flags;
+	void *pReserved;
+} CK_C_INITIALIZE_ARGS;
+
+typedef struct {
+	CK_BYTE label[32];
+	CK_BYTE manufacturerID[32];
+	CK_BYTE model[16];
+	CK_BYTE serialNumber[16];
+	CK_ULONG flags;
+	CK_ULONG ulMaxSessionCount, ulSessionCount, ulMaxRwSessionCount, ulRwSessionCount;
+	CK_ULONG ulMaxPinLen, ulMinPinLen;
+	CK_ULONG ulTotalPublicMemory, ulFreePublicMemory, ulTotalPrivateMemory, ulFreePrivateMemory;
+	CK_VERSION hardwareVersion, firmwareVersion;
+	CK_BYTE utcTime[16];
+} CK_TOKEN_INFO;
+
+typedef struct {
+	CK_VERSION version;
+	CK_RV (*C_Initialize)(void *);
+	CK_RV (*C_Finalize)(void *);
+	void *C_GetInfo, *C_GetFunctionList;
+	CK_RV (*C_GetSlotList)(CK_BYTE, CK_SLOT_ID *, CK_ULONG *);
+	void *C_GetSlotInfo;
+	CK_RV (*C_GetTokenInfo)(CK_SLOT_ID, CK_TOKEN_INFO *);
+	void *C_GetMechanismList, *C_GetMechanismInfo, *C_InitToken, *C_InitPIN, *C_SetPIN;
+	CK_RV (*C_OpenSession)(CK_SLOT_ID, CK_ULONG, void *, void *, CK_SESSION_HANDLE *);
+	CK_RV (*C_CloseSession)(CK_SESSION_HANDLE);
+	void *C_CloseAllSessions, *C_GetSessionInfo, *C_GetOperationState, *C_SetOperationState;
+	CK_RV (*C_Login)(CK_SESSION_HANDLE, CK_ULONG, CK_BYTE *, CK_ULONG);
+	void *C_Logout, *C_CreateObject, *C_CopyObject, *C_DestroyObject, *C_GetObjectSize;
+	CK_RV (*C_GetAttributeValue)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE, CK_ATTRIBUTE *, CK_ULONG);
+	void *C_SetAttributeValue;
+	CK_RV (*C_FindObjectsInit)(CK_SESSION_HANDLE, CK_ATTRIBUTE *, CK_ULONG);
+	CK_RV (*C_FindObjects)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE *, CK_ULONG, CK_ULONG *);
+	CK_RV (*C_FindObjectsFinal)(CK_SESSION_HANDLE);
+	void *C_EncryptInit, *C_Encrypt, *C_EncryptUpdate, *C_EncryptFinal;
+	void *C_DecryptInit, *C_Decrypt, *C_DecryptUpdate, *C_DecryptFinal;
+	void *C_DigestInit, *C_Digest, *C_DigestUpdate, *C_DigestKey, *C_DigestFinal;
+	CK_RV (*C_SignInit)(CK_SESSION_HANDLE, CK_MECHANISM *, CK_OBJECT_HANDLE);
+	CK_RV (*C_Sign)(CK_SESSION_HANDLE, CK_BYTE *, CK_ULONG, CK_BYTE *, CK_ULONG *);
+} CK_FUNCTION_LIST;
+
+#define CKR_OK                            0x000
+#define CKR_USER_ALREADY_LOGGED_IN        0x100
+#define CKR_CRYPTOKI_ALREADY_INITIALIZED  0x191
+#define CKF_OS_LOCKING_OK                 0x002
+#define CKF_SERIAL_SESSION                0x004
+#define CKU_USER                          1
+#define CKA_CLASS                         0x000
+#define CKA_KEY_TYPE                      0x100
+#define CKA_ID                            0x102
+#define CKA_EC_POINT                      0x181
+#define CKK_EC_EDWARDS                    0x040
+#define CKM_EDDSA                         0x1057
+
+static void *p11_open(const char *path) {
+	return dlopen(path, RTLD_NOW | RTLD_LOCAL);
+}
+
+static void p11_close(void *module) {
+	dlclose(module);
+}
+
+static CK_RV p11_function_list(void *module, CK_FUNCTION_LIST **list) {
+	CK_RV (*get)(CK_FUNCTION_LIST **) = (CK_RV (*)(CK_FUNCTION_LIST **))dlsym(module, "C_GetFunctionList");
+	if (get == NULL) {
+		return (CK_RV)-1;
+	}
+	return get(list);
+}
+
+static CK_RV p11_initialize(CK_FUNCTION_LIST *f) {
+	CK_C_INITIALIZE_ARGS args = {0};
+	args.flags = CKF_OS_LOCKING_OK;
+	CK_RV rv = f->C_Initialize(&args);
+	return rv == CKR_CRYPTOKI_ALREADY_INITIALIZED ? CKR_OK : rv;
+}
+
+static CK_RV p11_finalize(CK_FUNCTION_LIST *f) {
+	return f->C_Finalize(NULL);
+}
+
+static CK_RV p11_slots(CK_FUNCTION_LIST *f, CK_SLOT_ID *slots, CK_ULONG *n) {
+	return f->C_GetSlotList(1, slots, n);
+}
+
+static CK_RV p11_token_label(CK_FUNCTION_LIST *f, CK_SLOT_ID slot, CK_BYTE *label) {
+	CK_TOKEN_INFO info;
+	CK_RV rv = f->C_GetTokenInfo(slot, &info);
+	if (rv == CKR_OK) {
+		for (int i = 0; i < 32; i++) {
+			label[i] = info.label[i];
+		}
+	}
+	return rv;
+}
+
+static CK_RV p11_open_session(CK_FUNCTION_LIST *f, CK_SLOT_ID slot, CK_SESSION_HANDLE *s) {
+	return f->C_OpenSession(slot, CKF_SERIAL_SESSION, NULL, NULL, s);
+}
+
+static CK_RV p11_close_session(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE s) {
+	return f->C_CloseSession(s);
+}
+
+static CK_RV p11_login(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE s, CK_BYTE *pin, CK_ULONG pinLen) {
+	CK_RV rv = f->C_Login(s, CKU_USER, pin, pinLen);
+	return rv == CKR_USER_ALREADY_LOGGED_IN ? CKR_OK : rv;
+}
+
+// p11_find collects up to max Ed25519 key objects of the given class,
+// optionally restricted to a CKA_ID
+static CK_RV p11_find(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE s, CK_ULONG class, CK_BYTE *id, CK_ULONG idLen, CK_OBJECT_HANDLE *objs, CK_ULONG max, CK_ULONG *n) {
+	CK_ULONG keyType = CKK_EC_EDWARDS;
+	CK_ATTRIBUTE tmpl[3] = {
+		{CKA_CLASS, &class, sizeof(class)},
+		{CKA_KEY_TYPE, &keyType, sizeof(keyType)},
+		{CKA_ID, id, idLen},
+	};
+	CK_RV rv = f->C_FindObjectsInit(s, tmpl, id == NULL ? 2 : 3);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+	rv = f->C_FindObjects(s, objs, max, n);
+	CK_RV frv = f->C_FindObjectsFinal(s);
+	return rv != CKR_OK ? rv : frv;
+}
+
+static CK_RV p11_attribute(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE s, CK_OBJECT_HANDLE obj, CK_ULONG type, CK_BYTE *buf, CK_ULONG *len) {
+	CK_ATTRIBUTE attr = {type, buf, *len};
+	CK_RV rv = f->C_GetAttributeValue(s, obj, &attr, 1);
+	*len = attr.ulValueLen;
+	return rv;
+}
+
+static CK_RV p11_sign(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE s, CK_OBJECT_HANDLE key, CK_BYTE *msg, CK_ULONG msgLen, CK_BYTE *sig, CK_ULONG *sigLen) {
+	CK_MECHANISM mech = {CKM_EDDSA, NULL, 0};
+	CK_RV rv = f->C_SignInit(s, &mech, key);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+	return f->C_Sign(s, msg, msgLen, sig, sigLen);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+)
+
+const (
+	pkcs11MaxSlots = 64
+	pkcs11MaxKeys  = 256
+
+	pkcs11ClassPublicKey  = 2
+	pkcs11ClassPrivateKey = 3
+)
+
+// pkcs11Error reports a failed PKCS#11 call by its CK_RV code
+type pkcs11Error struct {
+	call string
+	rv   C.CK_RV
+}
+
+func (e pkcs11Error) Error() string {
+	return fmt.Sprintf("pkcs11 %s failed: CK_RV 0x%x", e.call, uint64(e.rv))
+}
+
+func pkcs11Check(call string, rv C.CK_RV) error {
+	if rv != 0 {
+		return pkcs11Error{call: call, rv: rv}
+	}
+	return nil
+}
+
+// pkcs11Signer signs with Ed25519 (CKM_EDDSA) keys on a PKCS#11 token. A
+// PKCS#11 session may only run one operation at a time, so every call is
+// serialized by mu.
+type pkcs11Signer struct {
+	mu      deadlock.Mutex
+	module  unsafe.Pointer
+	funcs   *C.CK_FUNCTION_LIST
+	session C.CK_SESSION_HANDLE
+	keys    map[crypto.PublicKey]C.CK_OBJECT_HANDLE
+}
+
+func openPKCS11Signer(cfg config.HSMPKCS11Config) (hsmSigner, error) {
+	path := C.CString(cfg.ModulePath)
+	defer C.free(unsafe.Pointer(path))
+
+	ps := &pkcs11Signer{module: C.p11_open(path)}
+	if ps.module == nil {
+		return nil, fmt.Errorf("cannot load pkcs11 module %s: %s", cfg.ModulePath, C.GoString(C.dlerror()))
+	}
+
+	err := ps.open(cfg)
+	if err != nil {
+		ps.Close()
+		return nil, err
+	}
+	return ps, nil
+}
+
+func (ps *pkcs11Signer) open(cfg config.HSMPKCS11Config) error {
+	err := pkcs11Check("C_GetFunctionList", C.p11_function_list(ps.module, &ps.funcs))
+	if err != nil {
+		return err
+	}
+	err = pkcs11Check("C_Initialize", C.p11_initialize(ps.funcs))
+	if err != nil {
+		ps.funcs = nil
+		return err
+	}
+
+	slot, err := ps.findSlot(cfg.TokenLabel)
+	if err != nil {
+		return err
+	}
+	err = pkcs11Check("C_OpenSession", C.p11_open_session(ps.funcs, slot, &ps.session))
+	if err != nil {
+		return err
+	}
+
+	if cfg.PINEnv != "" {
+		pin := []byte(os.Getenv(cfg.PINEnv))
+		var pinPtr *C.CK_BYTE
+		if len(pin) > 0 {
+			pinPtr = (*C.CK_BYTE)(unsafe.Pointer(&pin[0]))
+		}
+		err = pkcs11Check("C_Login", C.p11_login(ps.funcs, ps.session, pinPtr, C.CK_ULONG(len(pin))))
+		if err != nil {
+			return err
+		}
+	}
+
+	return ps.scanKeysLocked()
+}
+
+// findSlot returns the slot holding the token with the given label, or the
+// only token present when no label is configured
+func (ps *pkcs11Signer) findSlot(label string) (C.CK_SLOT_ID, error) {
+	var slots [pkcs11MaxSlots]C.CK_SLOT_ID
+	n := C.CK_ULONG(len(slots))
+	err := pkcs11Check("C_GetSlotList", C.p11_slots(ps.funcs, &slots[0], &n))
+	if err != nil {
+		return 0, err
+	}
+
+	if label == "" {
+		if n != 1 {
+			return 0, fmt.Errorf("%w: %d tokens present and no token_label configured", errHSMTokenNotFound, n)
+		}
+		return slots[0], nil
+	}
+
+	for _, slot := range slots[:n] {
+		var buf [32]C.CK_BYTE
+		err = pkcs11Check("C_GetTokenInfo", C.p11_token_label(ps.funcs, slot, &buf[0]))
+		if err != nil {
+			return 0, err
+		}
+		// Token labels are blank padded
+		tokenLabel := C.GoBytes(unsafe.Pointer(&buf[0]), C.int(len(buf)))
+		if string(bytes.TrimRight(tokenLabel, " ")) == label {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", errHSMTokenNotFound, label)
+}
+
+// scanKeysLocked pairs every Ed25519 public key object with the private key
+// sharing its CKA_ID. ps.mu must be held, or ps not yet shared.
+func (ps *pkcs11Signer) scanKeysLocked() error {
+	pubs, err := ps.find(pkcs11ClassPublicKey, nil)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[crypto.PublicKey]C.CK_OBJECT_HANDLE)
+	for _, pub := range pubs {
+		point, err := ps.attribute(pub, C.CKA_EC_POINT)
+		if err != nil {
+			return err
+		}
+		pk, ok := ed25519FromECPoint(point)
+		if !ok {
+			continue
+		}
+
+		id, err := ps.attribute(pub, C.CKA_ID)
+		if err != nil {
+			return err
+		}
+		privs, err := ps.find(pkcs11ClassPrivateKey, id)
+		if err != nil {
+			return err
+		}
+		if len(privs) == 1 {
+			keys[pk] = privs[0]
+		}
+	}
+
+	if len(keys) == 0 {
+		return errHSMKeyNotFound
+	}
+	ps.keys = keys
+	return nil
+}
+
+func (ps *pkcs11Signer) find(class C.CK_ULONG, id []byte) ([]C.CK_OBJECT_HANDLE, error) {
+	var idPtr *C.CK_BYTE
+	if len(id) > 0 {
+		idPtr = (*C.CK_BYTE)(unsafe.Pointer(&id[0]))
+	}
+
+	objs := make([]C.CK_OBJECT_HANDLE, pkcs11MaxKeys)
+	var n C.CK_ULONG
+	err := pkcs11Check("C_FindObjects", C.p11_find(ps.funcs, ps.session, class, idPtr, C.CK_ULONG(len(id)), &objs[0], C.CK_ULONG(len(objs)), &n))
+	if err != nil {
+		return nil, err
+	}
+	return objs[:n], nil
+}
+
+func (ps *pkcs11Signer) attribute(obj C.CK_OBJECT_HANDLE, attr C.CK_ULONG) ([]byte, error) {
+	var n C.CK_ULONG
+	err := pkcs11Check("C_GetAttributeValue", C.p11_attribute(ps.funcs, ps.session, obj, attr, nil, &n))
+	if err != nil || n == 0 {
+		return nil, err
+	}
+
+	buf := make([]byte, n)
+	err = pkcs11Check("C_GetAttributeValue", C.p11_attribute(ps.funcs, ps.session, obj, attr, (*C.CK_BYTE)(unsafe.Pointer(&buf[0])), &n))
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// ed25519FromECPoint decodes CKA_EC_POINT, which tokens return either as a
+// DER OCTET STRING or as the raw 32 byte point
+func ed25519FromECPoint(point []byte) (pk crypto.PublicKey, ok bool) {
+	if len(point) == len(pk)+2 && point[0] == 0x04 && int(point[1]) == len(pk) {
+		point = point[2:]
+	}
+	if len(point) != len(pk) {
+		return pk, false
+	}
+	copy(pk[:], point)
+	return pk, true
+}
+
+// PublicKeys implements hsmSigner. The token is rescanned so that keys
+// provisioned while kmd is running become visible.
+func (ps *pkcs11Signer) PublicKeys() ([]crypto.PublicKey, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	err := ps.scanKeysLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]crypto.PublicKey, 0, len(ps.keys))
+	for pk := range ps.keys {
+		pks = append(pks, pk)
+	}
+	return pks, nil
+}
+
+// Sign implements hsmSigner
+func (ps *pkcs11Signer) Sign(pk crypto.PublicKey, msg []byte) (sig crypto.Signature, err error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	key, ok := ps.keys[pk]
+	if !ok {
+		err = errKeyNotFound
+		return
+	}
+
+	var msgPtr *C.CK_BYTE
+	if len(msg) > 0 {
+		msgPtr = (*C.CK_BYTE)(unsafe.Pointer(&msg[0]))
+	}
+	var buf [len(sig)]byte
+	n := C.CK_ULONG(len(buf))
+	err = pkcs11Check("C_Sign", C.p11_sign(ps.funcs, ps.session, key, msgPtr, C.CK_ULONG(len(msg)), (*C.CK_BYTE)(unsafe.Pointer(&buf[0])), &n))
+	if err != nil {
+		return
+	}
+	if int(n) != len(sig) {
+		err = fmt.Errorf("pkcs11 returned a %d byte signature", n)
+		return
+	}
+	copy(sig[:], buf[:])
+	return
+}
+
+// Close implements hsmSigner
+func (ps *pkcs11Signer) Close() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.funcs != nil {
+		if ps.session != 0 {
+			C.p11_close_session(ps.funcs, ps.session)
+			ps.session = 0
+		}
+		C.p11_finalize(ps.funcs)
+		ps.funcs = nil
+	}
+	if ps.module != nil {
+		C.p11_close(ps.module)
+		ps.module = nil
+	}
+	return nil
+}
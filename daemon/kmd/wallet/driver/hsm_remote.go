@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+)
+
+const (
+	remoteSignerKeysPath = "/v1/keys"
+	remoteSignerSignPath = "/v1/sign"
+	remoteSignerTimeout  = 10 * time.Second
+	// remoteSignerMaxErrorBody bounds how much of an error reply is quoted
+	remoteSignerMaxErrorBody = 512
+)
+
+// remoteSignerKeysResponse is the reply to GET /v1/keys
+type remoteSignerKeysResponse struct {
+	Keys [][]byte `json:"keys"`
+}
+
+// remoteSignerSignRequest is the body of POST /v1/sign. Message is the
+// domain-separated byte string to sign with pure Ed25519.
+type remoteSignerSignRequest struct {
+	PublicKey []byte `json:"public_key"`
+	Message   []byte `json:"message"`
+}
+
+// remoteSignerSignResponse is the reply to POST /v1/sign
+type remoteSignerSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// remoteSigner talks to a signing service fronting an HSM that is not
+// attached to the kmd host. Keys and signatures travel as base64 in JSON
+// over (mutually authenticated) TLS.
+//
+// The protocol is plain HTTP/JSON rather than gRPC, which kmd does not depend
+// on, so that a signing service can be put together with any HTTP stack:
+//
+//	GET  /v1/keys  ->  {"keys": [<public key>, ...]}
+//	POST /v1/sign      {"public_key": <public key>, "message": <bytes>}
+//	               ->  {"signature": <64 byte Ed25519 signature>}
+//
+// Byte strings are base64 encoded. The bearer token, when configured, is sent
+// in the Authorization header, and any status other than 200 fails the call
+// with the beginning of the reply body as the error message.
+type remoteSigner struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func makeRemoteSigner(cfg config.HSMRemoteConfig) (*remoteSigner, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	rs := &remoteSigner{
+		url: strings.TrimSuffix(cfg.URL, "/"),
+		client: &http.Client{
+			Timeout:   remoteSignerTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+	if cfg.TokenEnv != "" {
+		rs.token = os.Getenv(cfg.TokenEnv)
+	}
+	return rs, nil
+}
+
+// PublicKeys implements hsmSigner
+func (rs *remoteSigner) PublicKeys() ([]crypto.PublicKey, error) {
+	var resp remoteSignerKeysResponse
+	err := rs.do(http.MethodGet, remoteSignerKeysPath, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]crypto.PublicKey, len(resp.Keys))
+	for i, key := range resp.Keys {
+		if len(key) != len(pks[i]) {
+			return nil, fmt.Errorf("remote signer returned a %d byte public key", len(key))
+		}
+		copy(pks[i][:], key)
+	}
+	return pks, nil
+}
+
+// Sign implements hsmSigner
+func (rs *remoteSigner) Sign(pk crypto.PublicKey, msg []byte) (sig crypto.Signature, err error) {
+	req := remoteSignerSignRequest{PublicKey: pk[:], Message: msg}
+	var resp remoteSignerSignResponse
+	err = rs.do(http.MethodPost, remoteSignerSignPath, &req, &resp)
+	if err != nil {
+		return
+	}
+	if len(resp.Signature) != len(sig) {
+		err = fmt.Errorf("remote signer returned a %d byte signature", len(resp.Signature))
+		return
+	}
+	copy(sig[:], resp.Signature)
+	return
+}
+
+// Close implements hsmSigner
+func (rs *remoteSigner) Close() error {
+	rs.client.CloseIdleConnections()
+	return nil
+}
+
+func (rs *remoteSigner) do(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		enc, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(enc)
+	}
+
+	req, err := http.NewRequest(method, rs.url+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if rs.token != "" {
+		req.Header.Set("Authorization", "Bearer "+rs.token)
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, remoteSignerMaxErrorBody))
+		return fmt.Errorf("remote signer %s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testRemoteSigner serves the remote signer protocol from in-memory keys
+type testRemoteSigner struct {
+	keys    map[crypto.PublicKey]*crypto.SignatureSecrets
+	corrupt bool
+}
+
+func makeTestRemoteSigner(n int) *testRemoteSigner {
+	trs := &testRemoteSigner{keys: make(map[crypto.PublicKey]*crypto.SignatureSecrets)}
+	for i := 0; i < n; i++ {
+		var seed crypto.Seed
+		crypto.RandBytes(seed[:])
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		trs.keys[crypto.PublicKey(secrets.SignatureVerifier)] = secrets
+	}
+	return trs
+}
+
+func (trs *testRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer secret" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case remoteSignerKeysPath:
+		var resp remoteSignerKeysResponse
+		for pk := range trs.keys {
+			resp.Keys = append(resp.Keys, append([]byte(nil), pk[:]...))
+		}
+		json.NewEncoder(w).Encode(&resp)
+	case remoteSignerSignPath:
+		var req remoteSignerSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var pk crypto.PublicKey
+		copy(pk[:], req.PublicKey)
+		secrets, ok := trs.keys[pk]
+		if !ok {
+			http.Error(w, "unknown key", http.StatusNotFound)
+			return
+		}
+		sig := secrets.SignBytes(req.Message)
+		if trs.corrupt {
+			sig[0] ^= 1
+		}
+		json.NewEncoder(w).Encode(&remoteSignerSignResponse{Signature: sig[:]})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (trs *testRemoteSigner) publicKeys() (pks []crypto.PublicKey) {
+	for pk := range trs.keys {
+		pks = append(pks, pk)
+	}
+	return
+}
+
+func makeTestHSMWallet(t *testing.T, dataDir string, trs *testRemoteSigner) *HSMWallet {
+	srv := httptest.NewServer(trs)
+	t.Cleanup(srv.Close)
+	os.Setenv("KMD_TEST_SIGNER_TOKEN", "secret")
+
+	var cfg config.KMDConfig
+	cfg.DataDir = dataDir
+	cfg.DriverConfig.HSMWalletDriverConfig.Wallets = []config.HSMWalletConfig{{
+		Name:    "treasury",
+		Backend: config.HSMBackendRemote,
+		Remote:  config.HSMRemoteConfig{URL: srv.URL, TokenEnv: "KMD_TEST_SIGNER_TOKEN"},
+	}}
+	require.NoError(t, cfg.Validate())
+
+	var hwd HSMWalletDriver
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "treasury", string(mds[0].Name))
+	require.Equal(t, hsmWalletDriverName, mds[0].DriverName)
+
+	w, err := hwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+	return w.(*HSMWallet)
+}
+
+func TestHSMWalletSignTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	trs := makeTestRemoteSigner(2)
+	hw := makeTestHSMWallet(t, t.TempDir(), trs)
+
+	addrs, err := hw.ListKeys()
+	require.NoError(t, err)
+	require.Len(t, addrs, 2)
+
+	pks := trs.publicKeys()
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(pks[0]), Fee: basics.MicroAlgos{Raw: 1000}},
+	}
+
+	// Sender's own key
+	enc, err := hw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, crypto.SignatureVerifier(pks[0]).Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	// Rekeyed to the other HSM key
+	enc, err = hw.SignTransaction(tx, pks[1], nil)
+	require.NoError(t, err)
+	stxn = transactions.SignedTxn{}
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, crypto.SignatureVerifier(pks[1]).Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address(pks[1]), stxn.AuthAddr)
+
+	// Keys that are not on the HSM
+	_, err = hw.SignTransaction(tx, crypto.PublicKey{1}, nil)
+	require.Equal(t, errKeyNotFound, err)
+
+	// Programs are signed under the program domain separator
+	program := []byte{0x06, 0x81, 0x01}
+	sig, err := hw.SignProgram(program, crypto.Digest(pks[0]), nil)
+	require.NoError(t, err)
+	var progSig crypto.Signature
+	copy(progSig[:], sig)
+	progb := logic.Program(program)
+	require.True(t, crypto.SignatureVerifier(pks[0]).Verify(&progb, progSig))
+
+	// A backend returning garbage is caught before it reaches the caller
+	trs.corrupt = true
+	_, err = hw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.Equal(t, errHSMBadSignature, err)
+
+	_, err = hw.ImportKey(crypto.PrivateKey{})
+	require.Equal(t, errNotSupported, err)
+	_, err = hw.ExportKey(addrs[0], nil)
+	require.Equal(t, errNotSupported, err)
+}
+
+func TestHSMWalletMultisig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	trs := makeTestRemoteSigner(2)
+	hw := makeTestHSMWallet(t, dataDir, trs)
+
+	pks := trs.publicKeys()
+	outside := makeTestRemoteSigner(1).publicKeys()[0]
+	msigPKs := []crypto.PublicKey{pks[0], outside, pks[1]}
+	addr, err := hw.ImportMultisigAddr(1, 2, msigPKs)
+	require.NoError(t, err)
+	_, err = hw.ImportMultisigAddr(1, 2, msigPKs)
+	require.Equal(t, errKeyExists, err)
+
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(addr), Fee: basics.MicroAlgos{Raw: 1000}},
+	}
+
+	// Start from the imported preimage, then add the second HSM key
+	msig, err := hw.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, nil, crypto.Digest{})
+	require.NoError(t, err)
+	require.Error(t, crypto.MultisigVerify(tx, addr, msig))
+	msig, err = hw.MultisigSignTransaction(tx, pks[1], msig, nil, crypto.Digest{})
+	require.NoError(t, err)
+	require.NoError(t, crypto.MultisigVerify(tx, addr, msig))
+
+	_, err = hw.MultisigSignTransaction(tx, outside, msig, nil, crypto.Digest{})
+	require.Equal(t, errKeyNotFound, err)
+	_, err = hw.MultisigSignTransaction(tx, crypto.PublicKey{1}, msig, nil, crypto.Digest{})
+	require.Equal(t, errMsigWrongKey, err)
+
+	// Preimages survive a restart
+	hw = makeTestHSMWallet(t, dataDir, trs)
+	addrs, err := hw.ListMultisigAddrs()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{addr}, addrs)
+	version, threshold, got, err := hw.LookupMultisigPreimage(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), version)
+	require.Equal(t, uint8(2), threshold)
+	require.Equal(t, msigPKs, got)
+
+	require.NoError(t, hw.DeleteMultisigAddr(addr, nil))
+	_, err = hw.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, nil, crypto.Digest{})
+	require.Equal(t, errMsigDataNotFound, err)
+}
+
+func TestHSMWalletDriverConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	remote := config.HSMWalletConfig{Name: "a", Backend: config.HSMBackendRemote, Remote: config.HSMRemoteConfig{URL: "https://signer:8443"}}
+	pkcs11 := config.HSMWalletConfig{Name: "b", Backend: config.HSMBackendPKCS11, PKCS11: config.HSMPKCS11Config{ModulePath: "/usr/lib/softhsm/libsofthsm2.so"}}
+
+	validate := func(wallets ...config.HSMWalletConfig) error {
+		var cfg config.KMDConfig
+		cfg.DriverConfig.HSMWalletDriverConfig.Wallets = wallets
+		return cfg.Validate()
+	}
+
+	require.NoError(t, validate(remote, pkcs11))
+	require.ErrorIs(t, validate(remote, remote), config.ErrHSMWalletDuplicate)
+
+	bad := remote
+	bad.Name = ""
+	require.ErrorIs(t, validate(bad), config.ErrHSMWalletName)
+	bad = remote
+	bad.Backend = "tpm"
+	require.ErrorIs(t, validate(bad), config.ErrHSMBackend)
+	bad = remote
+	bad.Remote.URL = "signer:8443"
+	require.ErrorIs(t, validate(bad), config.ErrHSMRemoteURL)
+	bad = remote
+	bad.Remote.CertFile = "/etc/kmd/client.pem"
+	require.ErrorIs(t, validate(bad), config.ErrHSMClientCert)
+	bad = pkcs11
+	bad.PKCS11.ModulePath = "libsofthsm2.so"
+	require.ErrorIs(t, validate(bad), config.ErrHSMModuleNotAbsolute)
+
+	// An unreachable module is skipped rather than failing kmd startup
+	var cfg config.KMDConfig
+	cfg.DataDir = t.TempDir()
+	bad.PKCS11.ModulePath = "/nonexistent/libpkcs11.so"
+	cfg.DriverConfig.HSMWalletDriverConfig.Wallets = []config.HSMWalletConfig{bad}
+	var hwd HSMWalletDriver
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Empty(t, mds)
+}
@@ -29,8 +29,10 @@ import (
 	"github.com/mattn/go-sqlite3"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
@@ -1141,6 +1143,60 @@ func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	return
 }
 
+// deriveHDKey derives the ARC-0052 address key for account and index. As in
+// the specification, the root key comes from the BIP-39 seed of a 24 word
+// mnemonic without passphrase, whose entropy is the wallet's master
+// derivation key.
+func (sw *SQLiteWallet) deriveHDKey(account, index uint32) (key crypto.BIP32Ed25519Key, err error) {
+	path, err := crypto.ARC52Path(crypto.ARC52ContextAddress, account, index)
+	if err != nil {
+		return
+	}
+	mnemonic, err := passphrase.KeyToBIP39Mnemonic(sw.masterDerivationKey)
+	if err != nil {
+		return
+	}
+	root := crypto.BIP32Ed25519RootKey(passphrase.BIP39Seed(mnemonic, ""))
+	return root.DerivePath(path)
+}
+
+// DeriveKey returns the public key of the ARC-0052 address key for account
+// and index
+func (sw *SQLiteWallet) DeriveKey(account, index uint32) (pk crypto.PublicKey, err error) {
+	key, err := sw.deriveHDKey(account, index)
+	if err != nil {
+		return
+	}
+	return key.PublicKey(), nil
+}
+
+// SignTransactionDerived signs the passed transaction with the ARC-0052
+// address key for account and index
+func (sw *SQLiteWallet) SignTransactionDerived(tx transactions.Transaction, account, index uint32, pw []byte) (stx []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	key, err := sw.deriveHDKey(account, index)
+	if err != nil {
+		return
+	}
+
+	// Sign the transaction, setting the AuthAddr if the derived key is not
+	// the sender, like transactions.Transaction.Sign does
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: key.Sign(tx),
+	}
+	if signer := basics.Address(key.PublicKey()); signer != tx.Sender {
+		stxn.AuthAddr = signer
+	}
+	stx = protocol.Encode(&stxn)
+	return
+}
+
 // SignProgram signs the passed data for the src address
 func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (stx []byte, err error) {
 	// Check the password
@@ -59,6 +59,15 @@ type Wallet interface {
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
 }
 
+// HDWallet is implemented by wallets that can derive ARC-0052 style
+// hierarchical deterministic address keys (m/44'/283'/account'/0/index)
+// from their master derivation key. Derived keys are never stored; they are
+// recomputed for every request.
+type HDWallet interface {
+	DeriveKey(account, index uint32) (crypto.PublicKey, error)
+	SignTransactionDerived(tx transactions.Transaction, account, index uint32, pw []byte) ([]byte, error)
+}
+
 // Metadata represents high-level information about a wallet, like its name, id
 // and what operations it supports
 type Metadata struct {
@@ -11,6 +11,15 @@ import (
 )
 
 // The following msgp objects are implemented in this file:
+// ParticipationExport
+//          |-----> (*) MarshalMsg
+//          |-----> (*) CanMarshalMsg
+//          |-----> (*) UnmarshalMsg
+//          |-----> (*) CanUnmarshalMsg
+//          |-----> (*) Msgsize
+//          |-----> (*) MsgIsZero
+//          |-----> ParticipationExportMaxSize()
+//
 // ParticipationKeyIdentity
 //             |-----> (*) MarshalMsg
 //             |-----> (*) CanMarshalMsg
@@ -30,6 +39,435 @@ import (
 //        |-----> StateProofKeysMaxSize()
 //
 
+// MarshalMsg implements msgp.Marshaler
+func (z *ParticipationExport) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(8)
+	var zb0002Mask uint16 /* 9 bits */
+	if (*z).Parent.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).FirstValid.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if (*z).KeyDilution == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	if (*z).LastValid.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x10
+	}
+	if (*z).StateProofContext == nil {
+		zb0002Len--
+		zb0002Mask |= 0x20
+	}
+	if len((*z).StateProofKeys) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x40
+	}
+	if (*z).Voting == nil {
+		zb0002Len--
+		zb0002Mask |= 0x80
+	}
+	if (*z).VRF == nil {
+		zb0002Len--
+		zb0002Mask |= 0x100
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "addr"
+			o = append(o, 0xa4, 0x61, 0x64, 0x64, 0x72)
+			o = (*z).Parent.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "fv"
+			o = append(o, 0xa2, 0x66, 0x76)
+			o = (*z).FirstValid.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "kd"
+			o = append(o, 0xa2, 0x6b, 0x64)
+			o = msgp.AppendUint64(o, (*z).KeyDilution)
+		}
+		if (zb0002Mask & 0x10) == 0 { // if not empty
+			// string "lv"
+			o = append(o, 0xa2, 0x6c, 0x76)
+			o = (*z).LastValid.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x20) == 0 { // if not empty
+			// string "spctx"
+			o = append(o, 0xa5, 0x73, 0x70, 0x63, 0x74, 0x78)
+			if (*z).StateProofContext == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = (*z).StateProofContext.MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x40) == 0 { // if not empty
+			// string "spkeys"
+			o = append(o, 0xa6, 0x73, 0x70, 0x6b, 0x65, 0x79, 0x73)
+			if (*z).StateProofKeys == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).StateProofKeys)))
+			}
+			for zb0001 := range (*z).StateProofKeys {
+				o = (*z).StateProofKeys[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x80) == 0 { // if not empty
+			// string "vote"
+			o = append(o, 0xa4, 0x76, 0x6f, 0x74, 0x65)
+			if (*z).Voting == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = (*z).Voting.MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x100) == 0 { // if not empty
+			// string "vrf"
+			o = append(o, 0xa3, 0x76, 0x72, 0x66)
+			if (*z).VRF == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = (*z).VRF.MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *ParticipationExport) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ParticipationExport)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ParticipationExport) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Parent.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Parent")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).FirstValid.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "FirstValid")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).LastValid.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "LastValid")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).KeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "KeyDilution")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				(*z).VRF = nil
+			} else {
+				if (*z).VRF == nil {
+					(*z).VRF = new(crypto.VRFSecrets)
+				}
+				bts, err = (*z).VRF.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "VRF")
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				(*z).Voting = nil
+			} else {
+				if (*z).Voting == nil {
+					(*z).Voting = new(crypto.OneTimeSignatureSecrets)
+				}
+				bts, err = (*z).Voting.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Voting")
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				(*z).StateProofContext = nil
+			} else {
+				if (*z).StateProofContext == nil {
+					(*z).StateProofContext = new(merklesignature.SignerContext)
+				}
+				bts, err = (*z).StateProofContext.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "StateProofContext")
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "StateProofKeys")
+				return
+			}
+			if zb0004 > maxExportedStateProofKeys {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(maxExportedStateProofKeys))
+				err = msgp.WrapError(err, "struct-from-array", "StateProofKeys")
+				return
+			}
+			if zb0005 {
+				(*z).StateProofKeys = nil
+			} else if (*z).StateProofKeys != nil && cap((*z).StateProofKeys) >= zb0004 {
+				(*z).StateProofKeys = ((*z).StateProofKeys)[:zb0004]
+			} else {
+				(*z).StateProofKeys = make([]merklesignature.KeyRoundPair, zb0004)
+			}
+			for zb0001 := range (*z).StateProofKeys {
+				bts, err = (*z).StateProofKeys[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "StateProofKeys", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = ParticipationExport{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "addr":
+				bts, err = (*z).Parent.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Parent")
+					return
+				}
+			case "fv":
+				bts, err = (*z).FirstValid.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "FirstValid")
+					return
+				}
+			case "lv":
+				bts, err = (*z).LastValid.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "LastValid")
+					return
+				}
+			case "kd":
+				(*z).KeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "KeyDilution")
+					return
+				}
+			case "vrf":
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					(*z).VRF = nil
+				} else {
+					if (*z).VRF == nil {
+						(*z).VRF = new(crypto.VRFSecrets)
+					}
+					bts, err = (*z).VRF.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "VRF")
+						return
+					}
+				}
+			case "vote":
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					(*z).Voting = nil
+				} else {
+					if (*z).Voting == nil {
+						(*z).Voting = new(crypto.OneTimeSignatureSecrets)
+					}
+					bts, err = (*z).Voting.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Voting")
+						return
+					}
+				}
+			case "spctx":
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					(*z).StateProofContext = nil
+				} else {
+					if (*z).StateProofContext == nil {
+						(*z).StateProofContext = new(merklesignature.SignerContext)
+					}
+					bts, err = (*z).StateProofContext.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "StateProofContext")
+						return
+					}
+				}
+			case "spkeys":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "StateProofKeys")
+					return
+				}
+				if zb0006 > maxExportedStateProofKeys {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(maxExportedStateProofKeys))
+					err = msgp.WrapError(err, "StateProofKeys")
+					return
+				}
+				if zb0007 {
+					(*z).StateProofKeys = nil
+				} else if (*z).StateProofKeys != nil && cap((*z).StateProofKeys) >= zb0006 {
+					(*z).StateProofKeys = ((*z).StateProofKeys)[:zb0006]
+				} else {
+					(*z).StateProofKeys = make([]merklesignature.KeyRoundPair, zb0006)
+				}
+				for zb0001 := range (*z).StateProofKeys {
+					bts, err = (*z).StateProofKeys[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "StateProofKeys", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *ParticipationExport) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ParticipationExport)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ParticipationExport) Msgsize() (s int) {
+	s = 1 + 5 + (*z).Parent.Msgsize() + 3 + (*z).FirstValid.Msgsize() + 3 + (*z).LastValid.Msgsize() + 3 + msgp.Uint64Size + 4
+	if (*z).VRF == nil {
+		s += msgp.NilSize
+	} else {
+		s += (*z).VRF.Msgsize()
+	}
+	s += 5
+	if (*z).Voting == nil {
+		s += msgp.NilSize
+	} else {
+		s += (*z).Voting.Msgsize()
+	}
+	s += 6
+	if (*z).StateProofContext == nil {
+		s += msgp.NilSize
+	} else {
+		s += (*z).StateProofContext.Msgsize()
+	}
+	s += 7 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).StateProofKeys {
+		s += (*z).StateProofKeys[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *ParticipationExport) MsgIsZero() bool {
+	return ((*z).Parent.MsgIsZero()) && ((*z).FirstValid.MsgIsZero()) && ((*z).LastValid.MsgIsZero()) && ((*z).KeyDilution == 0) && ((*z).VRF == nil) && ((*z).Voting == nil) && ((*z).StateProofContext == nil) && (len((*z).StateProofKeys) == 0)
+}
+
+// MaxSize returns a maximum valid message size for this message type
+func ParticipationExportMaxSize() (s int) {
+	s = 1 + 5 + basics.AddressMaxSize() + 3 + basics.RoundMaxSize() + 3 + basics.RoundMaxSize() + 3 + msgp.Uint64Size + 4
+	s += crypto.VRFSecretsMaxSize()
+	s += 5
+	s += crypto.OneTimeSignatureSecretsMaxSize()
+	s += 6
+	s += merklesignature.SignerContextMaxSize()
+	s += 7
+	// Calculating size of slice: z.StateProofKeys
+	s += msgp.ArrayHeaderSize + ((maxExportedStateProofKeys) * (merklesignature.KeyRoundPairMaxSize()))
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *ParticipationKeyIdentity) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// MaxParticipationChallengeSize is the size limit of a ParticipationChallenge.
+const MaxParticipationChallengeSize = 1024
+
+// ErrParticipationChallengeProve is returned when a challenge cannot be proven with a participation key.
+var ErrParticipationChallengeProve = errors.New("unable to prove the challenge with the participation key")
+
+// ParticipationChallenge is an arbitrary challenge chosen by an external party, such as a
+// payout program or a monitoring system. The node proves it with the selection (VRF) key of
+// one of its participation keys, which lets the party check that it is talking to the node
+// holding the participation key registered on chain for an account.
+type ParticipationChallenge []byte
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (c ParticipationChallenge) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.ParticipationChallenge, c
+}
+
+func (c ParticipationChallenge) check() error {
+	if len(c) == 0 || len(c) > MaxParticipationChallengeSize {
+		return fmt.Errorf("challenge must be between 1 and %d bytes, got %d", MaxParticipationChallengeSize, len(c))
+	}
+	return nil
+}
+
+// Prove proves the challenge with the VRF secrets of a participation key.
+func (c ParticipationChallenge) Prove(secrets *crypto.VRFSecrets) (crypto.VrfProof, error) {
+	if err := c.check(); err != nil {
+		return crypto.VrfProof{}, err
+	}
+	if secrets == nil {
+		return crypto.VrfProof{}, ErrParticipationChallengeProve
+	}
+	proof, ok := secrets.SK.Prove(c)
+	if !ok {
+		return crypto.VrfProof{}, ErrParticipationChallengeProve
+	}
+	return proof, nil
+}
+
+// Verify checks that the proof of the challenge was made with the secrets of the selection key.
+func (c ParticipationChallenge) Verify(selectionKey crypto.VrfPubkey, proof crypto.VrfProof) bool {
+	if c.check() != nil {
+		return false
+	}
+	ok, _ := selectionKey.Verify(proof, c)
+	return ok
+}
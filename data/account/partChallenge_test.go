@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParticipationChallenge(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	secrets := crypto.GenerateVRFSecrets()
+	other := crypto.GenerateVRFSecrets()
+	challenge := ParticipationChallenge("are you the node of this account?")
+
+	proof, err := challenge.Prove(secrets)
+	require.NoError(t, err)
+	require.True(t, challenge.Verify(secrets.PK, proof))
+	require.False(t, challenge.Verify(other.PK, proof))
+	require.False(t, ParticipationChallenge("another challenge").Verify(secrets.PK, proof))
+
+	_, err = ParticipationChallenge{}.Prove(secrets)
+	require.Error(t, err)
+	_, err = make(ParticipationChallenge, MaxParticipationChallengeSize+1).Prove(secrets)
+	require.Error(t, err)
+	_, err = challenge.Prove(nil)
+	require.ErrorIs(t, err, ErrParticipationChallengeProve)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxExportedStateProofKeys bounds the number of state proof keys in a ParticipationExport. A key is
+// generated for every leaf of the state proof merkle tree, so this is the largest tree we can encode.
+const maxExportedStateProofKeys = merklearray.MaxNumLeavesOnEncodedTree
+
+// TransportKeySize is the size of the curve25519 keys used to seal exported participation keys.
+const TransportKeySize = 32
+
+// ErrParticipationExportOpen is returned when a sealed participation export cannot be decrypted
+// with the given transport key pair.
+var ErrParticipationExportOpen = errors.New("unable to open sealed participation key, was it sealed to a different transport key?")
+
+// ParticipationExport is the portable form of an installed participation key. It holds
+// every secret the participation registry keeps, so that another node can install it
+// without access to the original partkey file.
+type ParticipationExport struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Parent      basics.Address `codec:"addr"`
+	FirstValid  basics.Round   `codec:"fv"`
+	LastValid   basics.Round   `codec:"lv"`
+	KeyDilution uint64         `codec:"kd"`
+
+	VRF               *crypto.VRFSecrets              `codec:"vrf"`
+	Voting            *crypto.OneTimeSignatureSecrets `codec:"vote"`
+	StateProofContext *merklesignature.SignerContext  `codec:"spctx"`
+	StateProofKeys    []merklesignature.KeyRoundPair  `codec:"spkeys,allocbound=maxExportedStateProofKeys"`
+}
+
+// Participation returns the Participation described by the export. The state proof keys
+// are not part of the result and need to be appended to the registry separately.
+func (e ParticipationExport) Participation() Participation {
+	part := Participation{
+		Parent:      e.Parent,
+		VRF:         e.VRF,
+		Voting:      e.Voting,
+		FirstValid:  e.FirstValid,
+		LastValid:   e.LastValid,
+		KeyDilution: e.KeyDilution,
+	}
+	if e.StateProofContext != nil {
+		part.StateProofSecrets = &merklesignature.Secrets{SignerContext: *e.StateProofContext}
+	}
+	return part
+}
+
+// SealParticipationExport encodes the export and encrypts it to the recipient's transport key,
+// so that only the holder of the matching private key is able to install it.
+func SealParticipationExport(export ParticipationExport, recipient *[TransportKeySize]byte) ([]byte, error) {
+	sealed, err := box.SealAnonymous(nil, protocol.Encode(&export), recipient, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to seal participation key: %w", err)
+	}
+	return sealed, nil
+}
+
+// OpenParticipationExport decrypts and decodes a blob created by SealParticipationExport.
+func OpenParticipationExport(sealed []byte, publicKey, privateKey *[TransportKeySize]byte) (ParticipationExport, error) {
+	encoded, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	if !ok {
+		return ParticipationExport{}, ErrParticipationExportOpen
+	}
+	var export ParticipationExport
+	err := protocol.Decode(encoded, &export)
+	if err != nil {
+		return ParticipationExport{}, fmt.Errorf("unable to decode participation key: %w", err)
+	}
+	if export.VRF == nil || export.Voting == nil {
+		return ParticipationExport{}, fmt.Errorf("participation key is missing its VRF or voting secrets")
+	}
+	return export, nil
+}
+
+// GenerateTransportKey creates a new curve25519 key pair used to receive sealed participation keys.
+func GenerateTransportKey() (publicKey, privateKey *[TransportKeySize]byte, err error) {
+	return box.GenerateKey(rand.Reader)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestParticipationExport_RoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	source, dbfile := getRegistry(t)
+	defer registryCloseTest(t, source, dbfile)
+
+	max := uint64(20)
+	p := makeTestParticipationWithLifetime(a, 1, 0, basics.Round(max), 3, 3)
+	id, err := source.Insert(p)
+	a.NoError(err)
+	a.NoError(source.AppendKeys(id, p.StateProofSecrets.GetAllKeys()))
+	a.NoError(source.Flush(10 * time.Second))
+
+	export, err := source.Export(id)
+	a.NoError(err)
+	a.Len(export.StateProofKeys, len(p.StateProofSecrets.GetAllKeys()))
+
+	publicKey, privateKey, err := GenerateTransportKey()
+	a.NoError(err)
+	sealed, err := SealParticipationExport(export, publicKey)
+	a.NoError(err)
+
+	// only the recipient is able to open the export
+	otherPublicKey, otherPrivateKey, err := GenerateTransportKey()
+	a.NoError(err)
+	_, err = OpenParticipationExport(sealed, otherPublicKey, otherPrivateKey)
+	a.ErrorIs(err, ErrParticipationExportOpen)
+
+	opened, err := OpenParticipationExport(sealed, publicKey, privateKey)
+	a.NoError(err)
+
+	targetDB, err := db.OpenPair(t.Name()+"_target", true)
+	a.NoError(err)
+	target, err := makeParticipationRegistry(targetDB, logging.TestingLog(t))
+	a.NoError(err)
+	defer registryCloseTest(t, target, "")
+
+	importedID, err := target.Insert(opened.Participation())
+	a.NoError(err)
+	a.Equal(id, importedID)
+	a.NoError(target.AppendKeys(importedID, opened.StateProofKeys))
+	a.NoError(target.Flush(10 * time.Second))
+
+	assertParticipation(t, p, target.Get(importedID))
+	for round := uint64(3); round < max; round += 3 {
+		expected, err := source.GetStateProofSecretsForRound(id, basics.Round(round))
+		a.NoError(err)
+		actual, err := target.GetStateProofSecretsForRound(importedID, basics.Round(round))
+		a.NoError(err)
+		a.Equal(*expected.StateProofSecrets.SigningKey, *actual.StateProofSecrets.SigningKey)
+	}
+}
+
+func TestParticipationExport_NotFound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	registry, dbfile := getRegistry(t)
+	defer registryCloseTest(t, registry, dbfile)
+
+	_, err := registry.Export(ParticipationID{})
+	a.ErrorIs(err, ErrParticipationIDNotFound)
+}
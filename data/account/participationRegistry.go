@@ -255,6 +255,9 @@ type ParticipationRegistry interface {
 	// GetStateProofSecretsForRound fetches a record with stateproof secrets for a particular round.
 	GetStateProofSecretsForRound(id ParticipationID, round basics.Round) (StateProofSecretsForRound, error)
 
+	// Export fetches a record with all of its secrets, including the remaining state proof keys.
+	Export(id ParticipationID) (ParticipationExport, error)
+
 	// HasLiveKeys quickly tests to see if there is a valid participation key over some range of rounds
 	HasLiveKeys(from, to basics.Round) bool
 
@@ -367,6 +370,10 @@ const (
 		FROM StateProofKeys s
 		WHERE round=?
 		   AND pk IN (SELECT pk FROM Keysets WHERE participationID=?)`
+	selectStateProofKeys = `SELECT s.round, s.key
+		FROM StateProofKeys s
+		WHERE pk IN (SELECT pk FROM Keysets WHERE participationID=?)
+		ORDER BY s.round`
 	deleteKeysets          = `DELETE FROM Keysets WHERE pk=?`
 	deleteRolling          = `DELETE FROM Rolling WHERE pk=?`
 	deleteStateProofByPK   = `DELETE FROM StateProofKeys WHERE pk=?`
@@ -832,6 +839,65 @@ func (db *participationDB) GetStateProofSecretsForRound(id ParticipationID, roun
 	return result, nil
 }
 
+// Export returns the participation record with all of its secrets, so that it can be installed on another node.
+func (db *participationDB) Export(id ParticipationID) (ParticipationExport, error) {
+	record := db.Get(id)
+	if record.IsZero() {
+		return ParticipationExport{}, ErrParticipationIDNotFound
+	}
+
+	export := ParticipationExport{
+		Parent:      record.Account,
+		FirstValid:  record.FirstValid,
+		LastValid:   record.LastValid,
+		KeyDilution: record.KeyDilution,
+		VRF:         record.VRF,
+		Voting:      record.Voting,
+	}
+	if record.StateProof == nil {
+		return export, nil
+	}
+
+	err := db.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var rawSignerContext []byte
+		row := tx.QueryRow(selectStateProofData, id[:])
+		err := row.Scan(&rawSignerContext)
+		if err != nil {
+			return fmt.Errorf("error while querying stateproof data: %w", err)
+		}
+		export.StateProofContext = &merklesignature.SignerContext{}
+		err = protocol.Decode(rawSignerContext, export.StateProofContext)
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(selectStateProofKeys, id[:])
+		if err != nil {
+			return fmt.Errorf("error while querying secrets: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var round uint64
+			var rawKey []byte
+			err = rows.Scan(&round, &rawKey)
+			if err != nil {
+				return fmt.Errorf("error while scanning secrets: %w", err)
+			}
+			key := merklesignature.KeyRoundPair{Round: round, Key: &crypto.FalconSigner{}}
+			err = protocol.Decode(rawKey, key.Key)
+			if err != nil {
+				return err
+			}
+			export.StateProofKeys = append(export.StateProofKeys, key)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return ParticipationExport{}, fmt.Errorf("failed to export participation %s: %w", id, err)
+	}
+	return export, nil
+}
+
 // GetForRound fetches a record with all secrets for a particular round.
 func (db *participationDB) GetForRound(id ParticipationID, round basics.Round) (ParticipationRecordForRound, error) {
 	var result ParticipationRecordForRound
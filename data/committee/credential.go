@@ -73,9 +73,33 @@ type (
 // If it is, the returned Credential constitutes a proof of this fact.
 // Otherwise, an error is returned.
 func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Membership) (res Credential, err error) {
-	selectionKey := m.Record.SelectionID
-	ok, vrfOut := selectionKey.Verify(cred.Proof, m.Selector)
+	ok, vrfOut := m.Record.SelectionID.Verify(cred.Proof, m.Selector)
+	return cred.authenticate(proto, m, ok, vrfOut)
+}
+
+// BatchVerify verifies a batch of unauthenticated credentials, checking all
+// their VRF proofs at once. The i-th returned Credential and error are those
+// creds[i].Verify(proto[i], m[i]) would return.
+func BatchVerify(proto []config.ConsensusParams, creds []UnauthenticatedCredential, m []Membership) ([]Credential, []error) {
+	bv := crypto.MakeVrfBatchVerifier(len(creds))
+	for i := range creds {
+		bv.EnqueueProof(m[i].Record.SelectionID, creds[i].Proof, m[i].Selector)
+	}
+	// the error only summarizes failed, which is checked proof by proof below
+	outputs, failed, _ := bv.VerifyWithFeedback()
 
+	res := make([]Credential, len(creds))
+	errs := make([]error, len(creds))
+	for i := range creds {
+		res[i], errs[i] = creds[i].authenticate(proto[i], m[i], !failed[i], outputs[i])
+	}
+	return res, errs
+}
+
+// authenticate turns the result of the verification of the credential's VRF
+// proof into a Credential, checking that it selects the member.
+func (cred UnauthenticatedCredential) authenticate(proto config.ConsensusParams, m Membership, ok bool, vrfOut crypto.VrfOutput) (res Credential, err error) {
+	selectionKey := m.Record.SelectionID
 	hashable := hashableCredential{
 		RawOut: vrfOut,
 		Member: m.Record.Addr,
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -354,6 +355,56 @@ func TestAccountNotSelected(t *testing.T) {
 	require.Zero(t, leaders)
 }
 
+func TestBatchVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	selParams, _, round, addresses, _, vrfSecrets, _, _ := testingenv(t, 100, 2000)
+	var protos []config.ConsensusParams
+	var creds []UnauthenticatedCredential
+	var ms []Membership
+	for i := range addresses {
+		_, record, selectionSeed, totalMoney := selParams(addresses[i])
+		sel := AgreementSelector{
+			Seed:   selectionSeed,
+			Round:  round,
+			Period: Period(0),
+			Step:   Soft,
+		}
+		cred := MakeCredential(vrfSecrets[i], sel)
+		switch i % 10 {
+		case 0:
+			// an invalid proof
+			cred.Proof[60] ^= 1
+		case 1:
+			// a proof for another account's key
+			record.SelectionID = vrfSecrets[(i+1)%len(vrfSecrets)].Pubkey()
+		case 2:
+			// an unselected account
+			record.MicroAlgosWithRewards.Raw = 0
+		}
+		protos = append(protos, proto)
+		creds = append(creds, cred)
+		ms = append(ms, Membership{Record: record, Selector: sel, TotalMoney: totalMoney})
+	}
+
+	res, errs := BatchVerify(protos, creds, ms)
+	require.Len(t, res, len(creds))
+	require.Len(t, errs, len(creds))
+	selected := 0
+	for i := range creds {
+		expected, expectedErr := creds[i].Verify(protos[i], ms[i])
+		require.Equal(t, expected, res[i])
+		require.Equal(t, expectedErr, errs[i])
+		if i%10 < 3 {
+			require.Error(t, errs[i])
+		}
+		if errs[i] == nil {
+			selected++
+		}
+	}
+	require.NotZero(t, selected)
+}
+
 // TODO update to remove VRF verification overhead
 func BenchmarkSortition(b *testing.B) {
 	selParams, _, round, addresses, _, vrfSecrets, _, _ := testingenv(b, 100, 2000)
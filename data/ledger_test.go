@@ -402,13 +402,15 @@ func TestConsensusVersion(t *testing.T) {
 		Latest:    basics.Round(consensusParams.MaxTxnLife + flushOffset - 1),
 		Committed: basics.Round(consensusParams.MaxTxnLife + flushOffset - 1)}, err)
 
-	// check round #1 which was already dropped.
+	// check round #1 which was already dropped, and is reported behind the retention horizon.
 	ver, err = l.ConsensusVersion(basics.Round(1))
 	require.Equal(t, protocol.ConsensusVersion(""), ver)
+	require.Greater(t, l.EarliestBlock(), basics.Round(1))
 	require.Equal(t, ledgercore.ErrNoEntry{
 		Round:     basics.Round(1),
 		Latest:    basics.Round(consensusParams.MaxTxnLife + flushOffset - 1),
-		Committed: basics.Round(consensusParams.MaxTxnLife + flushOffset - 1)}, err)
+		Committed: basics.Round(consensusParams.MaxTxnLife + flushOffset - 1),
+		Earliest:  l.EarliestBlock()}, err)
 
 	// add another round, with upgrade
 	rnd := basics.Round(consensusParams.MaxTxnLife + flushOffset)
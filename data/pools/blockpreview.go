@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+)
+
+// BlockPreview summarizes the block most recently assembled by the transaction
+// pool. It is refreshed on every assembly attempt, whether or not the node ends
+// up proposing the block.
+type BlockPreview struct {
+	Round       basics.Round
+	AssembledAt time.Time
+	// StopReason tells why the pool stopped adding transactions to the payset;
+	// it is one of the telemetryspec.AssembleBlock* reasons.
+	StopReason string
+
+	// TxIDs lists the top-level transactions of the payset, in block order.
+	TxIDs []transactions.Txid
+	// DeferredGroups is the number of pending transaction groups left out of the payset
+	// when it was closed early.
+	DeferredGroups int
+
+	TotalFees      basics.MicroAlgos
+	PaysetBytes    int
+	MaxPaysetBytes int
+
+	RewardsLevel   uint64
+	RewardsRate    uint64
+	RewardsResidue uint64
+}
+
+func makeBlockPreview(vb *ledgercore.ValidatedBlock, stats telemetryspec.AssembleBlockMetrics) (*BlockPreview, error) {
+	blk := vb.Block()
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &BlockPreview{
+		Round:          blk.Round(),
+		AssembledAt:    time.Now(),
+		StopReason:     stats.StopReason,
+		TxIDs:          make([]transactions.Txid, len(payset)),
+		DeferredGroups: stats.DeferredCount,
+		MaxPaysetBytes: config.Consensus[blk.CurrentProtocol].MaxTxnBytesPerBlock,
+		RewardsLevel:   blk.RewardsLevel,
+		RewardsRate:    blk.RewardsRate,
+		RewardsResidue: blk.RewardsResidue,
+	}
+	for i, stxn := range payset {
+		preview.TxIDs[i] = stxn.ID()
+		preview.TotalFees.Raw += stxn.Txn.Fee.Raw
+		preview.PaysetBytes += blk.Payset[i].GetEncodedLength()
+	}
+	return preview, nil
+}
@@ -30,6 +30,9 @@ var ErrStaleBlockAssemblyRequest = errors.New("AssembleBlock: requested block as
 // ErrPendingQueueReachedMaxCap indicates the current transaction pool has reached its max capacity
 var ErrPendingQueueReachedMaxCap = errors.New("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
 
+// ErrPoolNotAccepting indicates the transaction pool stopped accepting transactions, as the node is shutting down
+var ErrPoolNotAccepting = errors.New("TransactionPool.Remember: transaction pool stopped accepting transactions")
+
 // ErrNoPendingBlockEvaluator indicates there is no pending block evaluator to accept a new tx group
 var ErrNoPendingBlockEvaluator = errors.New("TransactionPool.ingest: no pending block evaluator")
 
@@ -45,3 +48,14 @@ func (e *ErrTxPoolFeeError) Error() string {
 	return fmt.Sprintf("fee %d below threshold %d (%d per byte * %d bytes)",
 		e.fee, e.feeThreshold, e.feePerByte, e.encodedLength)
 }
+
+// ErrTxPoolSenderLimit is returned when a transaction group would take one of its senders past the
+// number of pending transactions a sender may have in the pool
+type ErrTxPoolSenderLimit struct {
+	sender basics.Address
+	limit  int
+}
+
+func (e *ErrTxPoolSenderLimit) Error() string {
+	return fmt.Sprintf("sender %v reached the limit of %d pending transactions", e.sender, e.limit)
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// feeCongestedBlockFullness is the share of the block capacity above which a block is considered
+// congested, meaning that the transactions it left out had to compete on fees to get in.
+const feeCongestedBlockFullness = 0.8
+
+// blockFees is what the FeeTracker keeps of a block.
+type blockFees struct {
+	round basics.Round
+	// fullness is the share of the block capacity used by the payset.
+	fullness float64
+	// feesPerByte are the fees per byte paid by the transaction groups of the payset.
+	feesPerByte []float64
+}
+
+// FeeTracker is a block listener keeping the fees paid by the transaction groups of the recent
+// blocks, and how full these blocks were, to estimate the fee a new transaction needs to pay to be
+// committed promptly.
+type FeeTracker struct {
+	mu     deadlock.Mutex
+	window int
+	// blocks are the recent blocks, from the oldest to the newest.
+	blocks []blockFees
+}
+
+// FeeEstimate summarizes the fees paid in the recent blocks.
+type FeeEstimate struct {
+	// Blocks is the number of recent blocks observed, and CongestedBlocks how many of them were
+	// congested.
+	Blocks          int
+	CongestedBlocks int
+	// Fullness is the average share of the block capacity used by the recent blocks.
+	Fullness float64
+	// PoolFeePerByte is the minimum fee per byte a transaction needs to get into the pool.
+	PoolFeePerByte uint64
+	// FeePerByteP50, FeePerByteP90 and FeePerByteP99 are these percentiles of the fee per byte paid
+	// by the transaction groups of the congested blocks, and never less than PoolFeePerByte. When no
+	// recent block was congested, any transaction the pool accepts fits in the next blocks, and they
+	// are PoolFeePerByte.
+	FeePerByteP50 uint64
+	FeePerByteP90 uint64
+	FeePerByteP99 uint64
+}
+
+// MakeFeeTracker makes a FeeTracker keeping the last window blocks.
+func MakeFeeTracker(window int) *FeeTracker {
+	return &FeeTracker{window: window}
+}
+
+// paysetGroups splits a payset into its transaction groups, which are contiguous.
+func paysetGroups(payset transactions.Payset) [][]transactions.SignedTxnInBlock {
+	var groups [][]transactions.SignedTxnInBlock
+	start := 0
+	for i := 1; i <= len(payset); i++ {
+		if i == len(payset) || payset[i].Txn.Group.IsZero() || payset[i].Txn.Group != payset[start].Txn.Group {
+			groups = append(groups, payset[start:i])
+			start = i
+		}
+	}
+	return groups
+}
+
+// OnNewBlock implements ledgercore.BlockListener, recording the fees paid in the block.
+func (ft *FeeTracker) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	if ft.window <= 0 {
+		return
+	}
+
+	bf := blockFees{round: block.Round()}
+	paysetBytes := 0
+	for _, txgroup := range paysetGroups(block.Payset) {
+		var fee float64
+		size := 0
+		for i := range txgroup {
+			fee += float64(txgroup[i].Txn.Fee.Raw)
+			size += txgroup[i].GetEncodedLength()
+		}
+		paysetBytes += size
+		if size > 0 {
+			bf.feesPerByte = append(bf.feesPerByte, fee/float64(size))
+		}
+	}
+	if maxBytes := config.Consensus[block.CurrentProtocol].MaxTxnBytesPerBlock; maxBytes > 0 {
+		bf.fullness = math.Min(float64(paysetBytes)/float64(maxBytes), 1)
+	}
+
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	ft.blocks = append(ft.blocks, bf)
+	if len(ft.blocks) > ft.window {
+		ft.blocks = append(ft.blocks[:0], ft.blocks[len(ft.blocks)-ft.window:]...)
+	}
+}
+
+// percentile returns the p-th percentile of the sorted values, by nearest rank.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// Estimate returns the fees paid in the recent blocks, given the minimum fee per byte of the
+// transaction pool, which reflects how congested the pool is.
+func (ft *FeeTracker) Estimate(poolFeePerByte uint64) FeeEstimate {
+	estimate := FeeEstimate{PoolFeePerByte: poolFeePerByte}
+	var fees []float64
+
+	ft.mu.Lock()
+	estimate.Blocks = len(ft.blocks)
+	var fullness float64
+	for _, bf := range ft.blocks {
+		fullness += bf.fullness
+		if bf.fullness >= feeCongestedBlockFullness {
+			estimate.CongestedBlocks++
+			fees = append(fees, bf.feesPerByte...)
+		}
+	}
+	ft.mu.Unlock()
+
+	if estimate.Blocks > 0 {
+		estimate.Fullness = fullness / float64(estimate.Blocks)
+	}
+	sort.Float64s(fees)
+	feePerByte := func(p float64) uint64 {
+		fpb := uint64(math.Ceil(percentile(fees, p)))
+		if fpb < poolFeePerByte {
+			return poolFeePerByte
+		}
+		return fpb
+	}
+	estimate.FeePerByteP50 = feePerByte(0.5)
+	estimate.FeePerByteP90 = feePerByte(0.9)
+	estimate.FeePerByteP99 = feePerByte(0.99)
+	return estimate
+}
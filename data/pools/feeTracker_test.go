@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestFeeTrackerGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txn := func(fee uint64, group crypto.Digest) transactions.SignedTxnInBlock {
+		var stib transactions.SignedTxnInBlock
+		stib.Txn.Type = protocol.PaymentTx
+		stib.Txn.Fee = basics.MicroAlgos{Raw: fee}
+		stib.Txn.Group = group
+		return stib
+	}
+	g1 := crypto.Hash([]byte("g1"))
+	g2 := crypto.Hash([]byte("g2"))
+	payset := transactions.Payset{txn(1000, crypto.Digest{}), txn(2000, g1), txn(0, g1), txn(1000, crypto.Digest{}), txn(1000, g2), txn(3000, g2)}
+
+	groups := paysetGroups(payset)
+	require.Len(t, groups, 4)
+	require.Len(t, groups[0], 1)
+	require.Len(t, groups[1], 2)
+	require.Len(t, groups[2], 1)
+	require.Len(t, groups[3], 2)
+
+	var block bookkeeping.Block
+	block.BlockHeader.Round = 1
+	block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	block.Payset = payset
+
+	ft := MakeFeeTracker(2)
+	ft.OnNewBlock(block, ledgercore.StateDelta{})
+	require.Len(t, ft.blocks, 1)
+	require.Len(t, ft.blocks[0].feesPerByte, 4)
+	require.Equal(t, float64(2000)/float64(payset[1].GetEncodedLength()+payset[2].GetEncodedLength()), ft.blocks[0].feesPerByte[1])
+	require.Greater(t, ft.blocks[0].fullness, 0.0)
+	require.Less(t, ft.blocks[0].fullness, feeCongestedBlockFullness)
+
+	// only the last window blocks are kept
+	for rnd := basics.Round(2); rnd <= 4; rnd++ {
+		block.BlockHeader.Round = rnd
+		ft.OnNewBlock(block, ledgercore.StateDelta{})
+	}
+	require.Len(t, ft.blocks, 2)
+	require.Equal(t, basics.Round(3), ft.blocks[0].round)
+	require.Equal(t, basics.Round(4), ft.blocks[1].round)
+
+	// blocks far from full do not raise the estimate above the pool fee
+	estimate := ft.Estimate(3)
+	require.Equal(t, 2, estimate.Blocks)
+	require.Zero(t, estimate.CongestedBlocks)
+	require.Equal(t, uint64(3), estimate.FeePerByteP50)
+	require.Equal(t, uint64(3), estimate.FeePerByteP90)
+	require.Equal(t, uint64(3), estimate.FeePerByteP99)
+}
+
+func TestFeeTrackerEstimate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ft := MakeFeeTracker(10)
+	estimate := ft.Estimate(0)
+	require.Equal(t, FeeEstimate{}, estimate)
+
+	fees := make([]float64, 100)
+	for i := range fees {
+		fees[i] = float64(i) + 0.5
+	}
+	ft.blocks = []blockFees{
+		{round: 1, fullness: 0.2, feesPerByte: []float64{1000}},
+		{round: 2, fullness: 1, feesPerByte: fees},
+	}
+
+	estimate = ft.Estimate(0)
+	require.Equal(t, 2, estimate.Blocks)
+	require.Equal(t, 1, estimate.CongestedBlocks)
+	require.InDelta(t, 0.6, estimate.Fullness, 1e-9)
+	require.Equal(t, uint64(50), estimate.FeePerByteP50)
+	require.Equal(t, uint64(90), estimate.FeePerByteP90)
+	require.Equal(t, uint64(99), estimate.FeePerByteP99)
+
+	// the pool fee is a floor
+	estimate = ft.Estimate(95)
+	require.Equal(t, uint64(95), estimate.PoolFeePerByte)
+	require.Equal(t, uint64(95), estimate.FeePerByteP50)
+	require.Equal(t, uint64(95), estimate.FeePerByteP90)
+	require.Equal(t, uint64(99), estimate.FeePerByteP99)
+}
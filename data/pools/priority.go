@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The eviction policies of the transaction pool, which decide what happens to a transaction
+// group arriving when the pool is full.
+const (
+	// EvictionPolicyFIFO rejects the groups arriving when the pool is full.
+	EvictionPolicyFIFO = "fifo"
+	// EvictionPolicyLowestFee evicts the pending groups paying the lowest fee per byte to make room
+	// for a group paying more, and rejects the arriving group otherwise.
+	EvictionPolicyLowestFee = "lowest-fee"
+)
+
+// groupFeePerByte returns the fee per byte a transaction group pays, which is how the pool
+// prioritizes and evicts pending groups.
+func groupFeePerByte(txgroup []transactions.SignedTxn) float64 {
+	var fee float64
+	var size int
+	for i := range txgroup {
+		fee += float64(txgroup[i].Txn.Fee.Raw)
+		size += txgroup[i].GetEncodedLength()
+	}
+	if size == 0 {
+		return 0
+	}
+	return fee / float64(size)
+}
+
+// priorityKey is a resource shared by transaction groups, which keep their relative order when the
+// pool prioritizes them, since the later group may depend on the changes of the earlier one.
+type priorityKey struct {
+	addr basics.Address
+	app  basics.AppIndex
+	asa  basics.AssetIndex
+}
+
+// groupPriorityKeys returns the resources txgroup refers to, and whether it may create an
+// application or an asset. A creation changes the identifiers given to the later ones, and later
+// groups may refer to the new application, its account or the new asset without sharing any
+// resource with the creating group, so no group moves ahead of or past a group that may create.
+// An application call may create both from its inner transactions.
+func groupPriorityKeys(txgroup []transactions.SignedTxn) (keys []priorityKey, creates bool) {
+	addApp := func(app basics.AppIndex) {
+		keys = append(keys, priorityKey{app: app}, priorityKey{addr: app.Address()})
+	}
+	for i := range txgroup {
+		txn := &txgroup[i].Txn
+		for _, addr := range txn.RelevantAddrs(transactions.SpecialAddresses{}) {
+			if !addr.IsZero() {
+				keys = append(keys, priorityKey{addr: addr})
+			}
+		}
+		switch txn.Type {
+		case protocol.ApplicationCallTx:
+			creates = true
+			if txn.ApplicationID != 0 {
+				addApp(txn.ApplicationID)
+			}
+			for _, addr := range txn.Accounts {
+				keys = append(keys, priorityKey{addr: addr})
+			}
+			// the boxes belong to this application or to the foreign ones
+			for _, app := range txn.ForeignApps {
+				addApp(app)
+			}
+			for _, asa := range txn.ForeignAssets {
+				keys = append(keys, priorityKey{asa: asa})
+			}
+		case protocol.AssetTransferTx:
+			keys = append(keys, priorityKey{asa: txn.XferAsset})
+		case protocol.AssetConfigTx:
+			if txn.ConfigAsset != 0 {
+				keys = append(keys, priorityKey{asa: txn.ConfigAsset})
+			} else {
+				creates = true
+			}
+		case protocol.AssetFreezeTx:
+			keys = append(keys, priorityKey{asa: txn.FreezeAsset})
+		}
+	}
+	return keys, creates
+}
+
+// prioritize returns txgroups ordered by decreasing fee per byte, so that the groups paying the most
+// make it into the next block. A group never moves ahead of an earlier group sharing an account, an
+// application or an asset with it, nor of an earlier group that may create one: it is prioritized
+// at most as high as that group. A group that may create never moves ahead of an earlier one that
+// may create either. Groups of the same priority keep their order.
+func prioritize(txgroups [][]transactions.SignedTxn) [][]transactions.SignedTxn {
+	priorities := make([]float64, len(txgroups))
+	latest := make(map[priorityKey]float64)
+	creation := math.Inf(1)
+	for i, txgroup := range txgroups {
+		priority := math.Min(groupFeePerByte(txgroup), creation)
+		keys, creates := groupPriorityKeys(txgroup)
+		for _, key := range keys {
+			if p, ok := latest[key]; ok && p < priority {
+				priority = p
+			}
+		}
+		for _, key := range keys {
+			latest[key] = priority
+		}
+		if creates {
+			creation = priority
+		}
+		priorities[i] = priority
+	}
+
+	order := make([]int, len(txgroups))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return priorities[order[i]] > priorities[order[j]]
+	})
+
+	result := make([][]transactions.SignedTxn, len(txgroups))
+	for i, idx := range order {
+		result[i] = txgroups[idx]
+	}
+	return result
+}
+
+// evictionCandidates picks groups of txgroups paying less than feePerByte, holding at least count
+// transactions between them, to evict: the groups paying the lowest fee per byte first, and the
+// most recent ones first among the groups paying the same fee. A group is only picked once no
+// remaining later group depends on it, as prioritize defines it, since the later group would fail
+// once the pending block evaluator is recomputed without it. It returns false if there are not
+// enough such groups.
+func evictionCandidates(txgroups [][]transactions.SignedTxn, feePerByte float64, count int) (map[int]bool, bool) {
+	evicted := make(map[int]bool)
+	if count <= 0 {
+		return evicted, true
+	}
+
+	type candidate struct {
+		idx        int
+		feePerByte float64
+	}
+	var candidates []candidate
+	for i, txgroup := range txgroups {
+		if fpb := groupFeePerByte(txgroup); fpb < feePerByte {
+			candidates = append(candidates, candidate{idx: i, feePerByte: fpb})
+		}
+	}
+	if len(candidates) == 0 {
+		return evicted, false
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].feePerByte != candidates[j].feePerByte {
+			return candidates[i].feePerByte < candidates[j].feePerByte
+		}
+		return candidates[i].idx > candidates[j].idx
+	})
+
+	// users lists, for each resource, the groups referring to it in increasing order, so that a
+	// group may be evicted once it is the last one of each of its lists
+	groupKeys := make([][]priorityKey, len(txgroups))
+	creates := make([]bool, len(txgroups))
+	users := make(map[priorityKey][]int)
+	for i, txgroup := range txgroups {
+		groupKeys[i], creates[i] = groupPriorityKeys(txgroup)
+		for _, key := range groupKeys[i] {
+			if u := users[key]; len(u) == 0 || u[len(u)-1] != i {
+				users[key] = append(u, i)
+			}
+		}
+	}
+	last := len(txgroups) - 1
+
+	evictable := func(i int) bool {
+		// every later group may depend on a group that may create
+		if creates[i] && i != last {
+			return false
+		}
+		for _, key := range groupKeys[i] {
+			if u := users[key]; u[len(u)-1] != i {
+				return false
+			}
+		}
+		return true
+	}
+
+	for progress := true; progress; {
+		progress = false
+		for _, c := range candidates {
+			if evicted[c.idx] || !evictable(c.idx) {
+				continue
+			}
+			evicted[c.idx] = true
+			count -= len(txgroups[c.idx])
+			if count <= 0 {
+				return evicted, true
+			}
+			for _, key := range groupKeys[c.idx] {
+				if u := users[key]; u[len(u)-1] == c.idx {
+					users[key] = u[:len(u)-1]
+				}
+			}
+			for last >= 0 && evicted[last] {
+				last--
+			}
+			// the groups the evicted one depended on may be evictable now, and pay less
+			progress = true
+			break
+		}
+	}
+	return evicted, false
+}
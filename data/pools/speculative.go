@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"context"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var txPoolSpeculationAdopted = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_speculation_adopted_total", Description: "Number of pending blocks taken over from a speculative assembly"})
+var txPoolSpeculationDiscarded = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_speculation_discarded_total", Description: "Number of speculative assemblies discarded because another block was committed or they did not complete in time"})
+
+// speculativeAssembly is the evaluation of the pending transaction groups on
+// top of a validated block that was not committed yet. The fields following
+// done are written by the speculating goroutine, and may only be read once
+// done is closed.
+type speculativeAssembly struct {
+	base   bookkeeping.BlockHash // hash of the block the assembly builds upon
+	round  basics.Round          // round of the block being assembled
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	err         error
+	evaluator   BlockEvaluator
+	wholeBlocks basics.Round
+	blk         *ledgercore.ValidatedBlock
+	stats       telemetryspec.AssembleBlockMetrics
+	// seen holds the first transaction id of every group that was fed to the evaluator.
+	seen     map[transactions.Txid]struct{}
+	accepted [][]transactions.SignedTxn
+	rejected []rejectedTxGroup
+}
+
+type rejectedTxGroup struct {
+	txgroup []transactions.SignedTxn
+	err     error
+}
+
+// StartSpeculativeAssembly starts assembling the block following vb in the
+// background, using the transaction groups currently pending in the pool.
+// vb must be the next block of the ledger. If vb ends up being committed,
+// OnNewBlock takes over the speculative evaluator rather than evaluating the
+// pending transaction groups again, so that the proposal for the following
+// round is ready sooner.
+func (pool *TransactionPool) StartSpeculativeAssembly(vb *ledgercore.ValidatedBlock) {
+	if !pool.speculativeAssembly {
+		return
+	}
+	prev := vb.Block().BlockHeader
+	if prev.Round != pool.ledger.Latest()+1 {
+		return
+	}
+	// Ensure we know about the next protocol version, as MakeBlock would panic otherwise.
+	_, upgradeState, err := bookkeeping.ProcessUpgradeParams(prev)
+	if err != nil {
+		return
+	}
+	if _, ok := config.Consensus[upgradeState.CurrentProtocol]; !ok {
+		return
+	}
+
+	base := vb.Block().Hash()
+	pool.speculationMu.Lock()
+	defer pool.speculationMu.Unlock()
+	if pool.speculation != nil {
+		if pool.speculation.base == base {
+			return
+		}
+		pool.speculation.cancel()
+		txPoolSpeculationDiscarded.Inc(nil)
+	}
+
+	pool.pendingMu.RLock()
+	txgroups := pool.pendingTxGroups
+	pool.pendingMu.RUnlock()
+	if pool.feePriority {
+		txgroups = prioritize(txgroups)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	spec := &speculativeAssembly{
+		base:   base,
+		round:  prev.Round + 1,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	pool.speculation = spec
+	go pool.speculate(ctx, spec, vb, txgroups)
+}
+
+// speculate feeds txgroups to an evaluator of the block following vb, the same
+// way recomputeBlockEvaluator does for the pending block evaluator.
+func (pool *TransactionPool) speculate(ctx context.Context, spec *speculativeAssembly, vb *ledgercore.ValidatedBlock, txgroups [][]transactions.SignedTxn) {
+	defer close(spec.done)
+
+	next := bookkeeping.MakeBlock(vb.Block().BlockHeader)
+	evaluator, err := pool.ledger.StartSpeculativeEvaluator(vb, next.BlockHeader, len(txgroups), 0, nil)
+	if err != nil {
+		spec.err = err
+		return
+	}
+	spec.evaluator = evaluator
+	spec.seen = make(map[transactions.Txid]struct{}, len(txgroups))
+	spec.stats.StartCount = len(txgroups)
+	spec.stats.StopReason = telemetryspec.AssembleBlockEmpty
+
+	committed := vb.Delta().Txids
+	for _, txgroup := range txgroups {
+		if ctx.Err() != nil {
+			spec.err = ctx.Err()
+			return
+		}
+		if len(txgroup) == 0 {
+			spec.stats.InvalidCount++
+			continue
+		}
+		spec.seen[txgroup[0].ID()] = struct{}{}
+		if _, alreadyCommitted := committed[txgroup[0].ID()]; alreadyCommitted {
+			spec.stats.EarlyCommittedCount++
+			continue
+		}
+		err := spec.add(txgroup)
+		if spec.err != nil {
+			return
+		}
+		if err != nil {
+			spec.rejected = append(spec.rejected, rejectedTxGroup{txgroup: txgroup, err: err})
+			continue
+		}
+		spec.accepted = append(spec.accepted, txgroup)
+	}
+}
+
+// add mirrors addToPendingBlockEvaluator, generating the block as soon as it is full.
+func (spec *speculativeAssembly) add(txgroup []transactions.SignedTxn) error {
+	err := spec.addOnce(txgroup)
+	if err == ledgercore.ErrNoSpace {
+		if spec.blk == nil {
+			blockGenerationStarts := time.Now()
+			spec.blk, spec.err = spec.evaluator.GenerateBlock()
+			if spec.err != nil {
+				return spec.err
+			}
+			spec.stats.StopReason = telemetryspec.AssembleBlockFull
+			spec.stats.BlockGenerationDuration = uint64(time.Since(blockGenerationStarts))
+		}
+		spec.wholeBlocks++
+		spec.evaluator.ResetTxnBytes()
+		err = spec.addOnce(txgroup)
+	}
+	return err
+}
+
+// addOnce mirrors addToPendingBlockEvaluatorOnce.
+func (spec *speculativeAssembly) addOnce(txgroup []transactions.SignedTxn) error {
+	r := spec.round + spec.wholeBlocks
+	for _, tx := range txgroup {
+		if tx.Txn.LastValid < r {
+			return &transactions.TxnDeadError{
+				Round:      r,
+				FirstValid: tx.Txn.FirstValid,
+				LastValid:  tx.Txn.LastValid,
+				Early:      false,
+			}
+		}
+	}
+	return spec.evaluator.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup))
+}
+
+// takeSpeculation returns the completed speculative assembly built on top of
+// block, if any. Speculations that can no longer be used are discarded, while
+// those built on top of later rounds are left in place.
+func (pool *TransactionPool) takeSpeculation(block bookkeeping.Block) *speculativeAssembly {
+	pool.speculationMu.Lock()
+	defer pool.speculationMu.Unlock()
+	spec := pool.speculation
+	if spec == nil || spec.round-1 > block.Round() {
+		return nil
+	}
+	pool.speculation = nil
+
+	if spec.base != block.Hash() {
+		spec.cancel()
+		txPoolSpeculationDiscarded.Inc(nil)
+		return nil
+	}
+	select {
+	case <-spec.done:
+	default:
+		// Waiting would only delay the round further than evaluating the
+		// pending transaction groups from scratch does.
+		spec.cancel()
+		txPoolSpeculationDiscarded.Inc(nil)
+		return nil
+	}
+	if spec.err != nil {
+		pool.log.Infof("TransactionPool: discarding speculative assembly for round %d: %v", spec.round, spec.err)
+		txPoolSpeculationDiscarded.Inc(nil)
+		return nil
+	}
+	return spec
+}
+
+// discardSpeculation cancels the speculative assembly in progress, if any.
+func (pool *TransactionPool) discardSpeculation() {
+	pool.speculationMu.Lock()
+	defer pool.speculationMu.Unlock()
+	if pool.speculation != nil {
+		pool.speculation.cancel()
+		pool.speculation = nil
+	}
+}
+
+// adoptSpeculation makes the evaluator of a completed speculative assembly the
+// pending block evaluator. The speculation was evaluated against the state the
+// ledger reached by committing its base block, so the transaction groups it
+// accepted or rejected keep their outcome; only the groups that joined the pool
+// after it started are evaluated now. Expects that the pool.mu mutex would be
+// already taken.
+func (pool *TransactionPool) adoptSpeculation(spec *speculativeAssembly, committedTxIds map[transactions.Txid]ledgercore.IncludedTransactions) (stats telemetryspec.ProcessBlockMetrics) {
+	txPoolSpeculationAdopted.Inc(nil)
+	pool.startAssembly(spec.round, time.Now())
+
+	pool.pendingMu.RLock()
+	txgroups := pool.pendingTxGroups
+	pool.pendingMu.RUnlock()
+
+	pool.pendingBlockEvaluator = spec.evaluator
+	pool.numPendingWholeBlocks = spec.wholeBlocks
+	asmStats := spec.stats
+	for _, txgroup := range spec.accepted {
+		pool.rememberedTxGroups = append(pool.rememberedTxGroups, txgroup)
+		for _, t := range txgroup {
+			pool.rememberedTxids[t.ID()] = t
+		}
+	}
+	for _, rejected := range spec.rejected {
+		pool.dropPendingTxGroup(rejected.txgroup, rejected.err, &asmStats, &stats)
+	}
+
+	if spec.blk != nil {
+		pool.assemblyMu.Lock()
+		if pool.assemblyRound <= spec.round {
+			pool.assemblyResults.ok = true
+			pool.assemblyResults.assemblyCompletedOrAbandoned = true
+			pool.assemblyResults.blk = spec.blk
+			pool.assemblyResults.stats = asmStats
+			pool.updatePreview(spec.blk, asmStats)
+			pool.assemblyCond.Broadcast()
+		}
+		pool.assemblyMu.Unlock()
+	}
+
+	firstTxnGrpTime := time.Now()
+	for _, txgroup := range txgroups {
+		if len(txgroup) == 0 {
+			continue
+		}
+		if _, speculated := spec.seen[txgroup[0].ID()]; speculated {
+			continue
+		}
+		asmStats.StartCount++
+		if _, alreadyCommitted := committedTxIds[txgroup[0].ID()]; alreadyCommitted {
+			asmStats.EarlyCommittedCount++
+			continue
+		}
+		err := pool.add(txgroup, &asmStats)
+		if err != nil {
+			pool.dropPendingTxGroup(txgroup, err, &asmStats, &stats)
+		}
+	}
+
+	pool.finishAssembly(asmStats, firstTxnGrpTime)
+	pool.rememberCommit(true)
+	return
+}
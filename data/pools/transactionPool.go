@@ -19,6 +19,7 @@ package pools
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,8 +36,17 @@ import (
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/condvar"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
+var txPoolAssemblyOverBudget = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_assembly_over_budget_total", Description: "Number of proposal paysets closed early because block assembly exceeded its budget"})
+var txPoolAssemblyDeferredGroups = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_assembly_deferred_txgroups_total", Description: "Number of pending transaction groups left out of proposals closed early by the assembly budget"})
+var txPoolEvictedGroups = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_evicted_txgroups_total", Description: "Number of pending transaction groups evicted for transaction groups paying a higher fee"})
+var txPoolSenderLimitRejections = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_sender_limit_rejected_total", Description: "Number of transaction groups rejected because a sender reached its limit of pending transactions"})
+
+// txPoolEvictedStatus is the status of the transactions evicted from the pool.
+const txPoolEvictedStatus = "evicted from the transaction pool for a transaction group paying a higher fee"
+
 // A TransactionPool prepares valid blocks for proposal and caches
 // validated transaction groups.
 //
@@ -74,11 +84,15 @@ type TransactionPool struct {
 	// assemblyRound indicates which round number we're currently waiting for or waited for last.
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
+	// assemblyPreview summarizes the last assembled block. It's protected by the pool.assemblyMu lock.
+	assemblyPreview *BlockPreview
 
-	// pendingMu protects pendingTxGroups and pendingTxids
+	// pendingMu protects pendingTxGroups, pendingTxids and pendingSenders
 	pendingMu       deadlock.RWMutex
 	pendingTxGroups [][]transactions.SignedTxn
 	pendingTxids    map[transactions.Txid]transactions.SignedTxn
+	// pendingSenders counts the pending transactions of each sender, when their number is limited.
+	pendingSenders map[basics.Address]int
 
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
@@ -93,9 +107,37 @@ type TransactionPool struct {
 	// proposalAssemblyTime is the ProposalAssemblyTime configured for this node.
 	proposalAssemblyTime time.Duration
 
+	// proposalAssemblyBudget is the ProposalAssemblyBudget configured for this node.
+	proposalAssemblyBudget time.Duration
+	// assemblyBudgetDeadline is the time by which the pending payset is closed regardless of
+	// whether the AssembleBlock function was called yet. It's protected by the pool.assemblyMu lock.
+	assemblyBudgetDeadline time.Time
+
 	// stateproofOverflowed indicates that a stateproof transaction was allowed to
 	// exceed the txPoolMaxSize. This flag is reset to false OnNewBlock
 	stateproofOverflowed bool
+
+	// speculativeAssembly indicates whether the next block is assembled ahead of time
+	// on top of validated blocks, as configured by EnableSpeculativeAssembly.
+	speculativeAssembly bool
+
+	// speculationMu protects speculation
+	speculationMu deadlock.Mutex
+	speculation   *speculativeAssembly
+
+	// notAccepting is set once the pool stops accepting transactions, before the node shuts down.
+	notAccepting atomic.Bool
+
+	// feePriority orders the pending groups by fee per byte when they are fed to a new block
+	// evaluator, as configured by TxPoolFeePriority.
+	feePriority bool
+	// evictionPolicy is the TxPoolEvictionPolicy configured for this node.
+	evictionPolicy string
+	// maxTxnsPerSender is the TxPoolMaxTxnsPerSender configured for this node.
+	maxTxnsPerSender int
+
+	evictedGroups         atomic.Uint64
+	senderLimitRejections atomic.Uint64
 }
 
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
@@ -115,17 +157,31 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 		cfg.TxPoolExponentialIncreaseFactor = 1
 	}
 	pool := TransactionPool{
-		pendingTxids:         make(map[transactions.Txid]transactions.SignedTxn),
-		rememberedTxids:      make(map[transactions.Txid]transactions.SignedTxn),
-		expiredTxCount:       make(map[basics.Round]int),
-		ledger:               ledger,
-		statusCache:          makeStatusCache(cfg.TxPoolSize),
-		logProcessBlockStats: cfg.EnableProcessBlockStats,
-		logAssembleStats:     cfg.EnableAssembleStats,
-		expFeeFactor:         cfg.TxPoolExponentialIncreaseFactor,
-		txPoolMaxSize:        cfg.TxPoolSize,
-		proposalAssemblyTime: cfg.ProposalAssemblyTime,
-		log:                  log,
+		pendingTxids:           make(map[transactions.Txid]transactions.SignedTxn),
+		rememberedTxids:        make(map[transactions.Txid]transactions.SignedTxn),
+		expiredTxCount:         make(map[basics.Round]int),
+		ledger:                 ledger,
+		statusCache:            makeStatusCache(cfg.TxPoolSize),
+		logProcessBlockStats:   cfg.EnableProcessBlockStats,
+		logAssembleStats:       cfg.EnableAssembleStats,
+		expFeeFactor:           cfg.TxPoolExponentialIncreaseFactor,
+		txPoolMaxSize:          cfg.TxPoolSize,
+		proposalAssemblyTime:   cfg.ProposalAssemblyTime,
+		proposalAssemblyBudget: cfg.ProposalAssemblyBudget,
+		speculativeAssembly:    cfg.EnableSpeculativeAssembly,
+		feePriority:            cfg.TxPoolFeePriority,
+		evictionPolicy:         cfg.TxPoolEvictionPolicy,
+		maxTxnsPerSender:       cfg.TxPoolMaxTxnsPerSender,
+		log:                    log,
+	}
+	switch pool.evictionPolicy {
+	case EvictionPolicyFIFO, EvictionPolicyLowestFee:
+	default:
+		log.Warnf("MakeTransactionPool: unknown TxPoolEvictionPolicy %q, using %q", pool.evictionPolicy, EvictionPolicyFIFO)
+		pool.evictionPolicy = EvictionPolicyFIFO
+	}
+	if pool.maxTxnsPerSender > 0 {
+		pool.pendingSenders = make(map[basics.Address]int)
 	}
 	pool.cond.L = &pool.mu
 	pool.assemblyCond.L = &pool.assemblyMu
@@ -175,12 +231,16 @@ func (pool *TransactionPool) Reset() {
 	defer pool.cond.Broadcast()
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	if pool.pendingSenders != nil {
+		pool.pendingSenders = make(map[basics.Address]int)
+	}
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
 	pool.statusCache.reset()
+	pool.discardSpeculation()
 	pool.recomputeBlockEvaluator(nil, 0)
 }
 
@@ -241,6 +301,9 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 		pool.stateproofOverflowed = false
 		pool.pendingTxids = pool.rememberedTxids
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
+		if pool.pendingSenders != nil {
+			pool.pendingSenders = make(map[basics.Address]int)
+		}
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
 
@@ -248,6 +311,11 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 			pool.pendingTxids[txid] = txn
 		}
 	}
+	if pool.pendingSenders != nil {
+		for _, txn := range pool.rememberedTxids {
+			pool.pendingSenders[txn.Txn.Sender]++
+		}
+	}
 
 	pool.rememberedTxGroups = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
@@ -275,6 +343,11 @@ func (pool *TransactionPool) pendingCountNoLock() int {
 // and not from the total number of transaction groups.
 // As long as we haven't surpassed the size limit, we should be good to go.
 func (pool *TransactionPool) checkPendingQueueSize(txnGroup []transactions.SignedTxn) error {
+	if pool.maxTxnsPerSender > 0 {
+		if err := pool.checkSenderLimit(txnGroup); err != nil {
+			return err
+		}
+	}
 	pendingSize := pool.pendingTxIDsCount()
 	txCount := len(txnGroup)
 	if pendingSize+txCount > pool.txPoolMaxSize {
@@ -287,11 +360,131 @@ func (pool *TransactionPool) checkPendingQueueSize(txnGroup []transactions.Signe
 				return nil
 			}
 		}
+		if pool.evictionPolicy == EvictionPolicyLowestFee && pool.canEvict(txnGroup, groupFeePerByte(txnGroup), pendingSize+txCount-pool.txPoolMaxSize) {
+			return nil
+		}
 		return ErrPendingQueueReachedMaxCap
 	}
 	return nil
 }
 
+// checkSenderLimit tests whether adding txnGroup would take one of its senders past the number of
+// pending transactions a sender may have. State proof transactions are not limited.
+func (pool *TransactionPool) checkSenderLimit(txnGroup []transactions.SignedTxn) error {
+	counts := make(map[basics.Address]int, len(txnGroup))
+	for _, t := range txnGroup {
+		if t.Txn.Type != protocol.StateProofTx {
+			counts[t.Txn.Sender]++
+		}
+	}
+
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	for sender, count := range counts {
+		if pool.pendingSenders[sender]+count > pool.maxTxnsPerSender {
+			pool.senderLimitRejections.Add(1)
+			txPoolSenderLimitRejections.Inc(nil)
+			return &ErrTxPoolSenderLimit{sender: sender, limit: pool.maxTxnsPerSender}
+		}
+	}
+	return nil
+}
+
+// canEvict tells whether enough of the pending groups paying less than feePerByte could be evicted
+// to make room for count transactions of txgroup, which pays feePerByte.
+func (pool *TransactionPool) canEvict(txgroup []transactions.SignedTxn, feePerByte float64, count int) bool {
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	txgroups := append(pool.pendingTxGroups[:len(pool.pendingTxGroups):len(pool.pendingTxGroups)], txgroup)
+	_, ok := evictionCandidates(txgroups, feePerByte, count)
+	return ok
+}
+
+// evictOverflow evicts pending groups paying less than feePerByte, as picked by evictionCandidates,
+// until the pool is back within its size. The evicted groups stay in the pending block evaluator
+// until it is recomputed on the next block. Expects that the pool.mu mutex would be already taken.
+func (pool *TransactionPool) evictOverflow(feePerByte float64) {
+	pool.pendingMu.Lock()
+	defer pool.pendingMu.Unlock()
+
+	excess := len(pool.pendingTxids) - pool.txPoolMaxSize
+	if excess <= 0 {
+		return
+	}
+	evicted, _ := evictionCandidates(pool.pendingTxGroups, feePerByte, excess)
+
+	// pendingTxGroups is shared with the callers of PendingTxGroups, so it is replaced rather than modified
+	kept := make([][]transactions.SignedTxn, 0, len(pool.pendingTxGroups)-len(evicted))
+	for i, txgroup := range pool.pendingTxGroups {
+		if !evicted[i] {
+			kept = append(kept, txgroup)
+			continue
+		}
+		for _, t := range txgroup {
+			delete(pool.pendingTxids, t.ID())
+			pool.statusCache.put(t, txPoolEvictedStatus)
+			if pool.pendingSenders != nil {
+				pool.pendingSenders[t.Txn.Sender]--
+				if pool.pendingSenders[t.Txn.Sender] <= 0 {
+					delete(pool.pendingSenders, t.Txn.Sender)
+				}
+			}
+		}
+	}
+	pool.pendingTxGroups = kept
+	pool.evictedGroups.Add(uint64(len(evicted)))
+	txPoolEvictedGroups.AddUint64(uint64(len(evicted)), nil)
+}
+
+// PoolStats describes the pending transactions of the pool and how it orders and limits them.
+type PoolStats struct {
+	PendingTxns   int
+	PendingGroups int
+	MaxTxns       int
+	// FeePerByte is the minimum fee per byte a transaction needs to pay to get into the pool.
+	FeePerByte uint64
+	// MinFeePerByte, MedianFeePerByte and MaxFeePerByte are taken over the fee per byte of the pending groups.
+	MinFeePerByte    float64
+	MedianFeePerByte float64
+	MaxFeePerByte    float64
+
+	FeePriority      bool
+	EvictionPolicy   string
+	MaxTxnsPerSender int
+	// EvictedGroups and SenderLimitRejections count the groups evicted and rejected since the pool was created.
+	EvictedGroups         uint64
+	SenderLimitRejections uint64
+}
+
+// Stats returns the current statistics of the pool.
+func (pool *TransactionPool) Stats() PoolStats {
+	pool.pendingMu.RLock()
+	stats := PoolStats{
+		PendingTxns:   len(pool.pendingTxids),
+		PendingGroups: len(pool.pendingTxGroups),
+	}
+	fees := make([]float64, len(pool.pendingTxGroups))
+	for i, txgroup := range pool.pendingTxGroups {
+		fees[i] = groupFeePerByte(txgroup)
+	}
+	pool.pendingMu.RUnlock()
+
+	if len(fees) > 0 {
+		sort.Float64s(fees)
+		stats.MinFeePerByte = fees[0]
+		stats.MedianFeePerByte = fees[len(fees)/2]
+		stats.MaxFeePerByte = fees[len(fees)-1]
+	}
+	stats.MaxTxns = pool.txPoolMaxSize
+	stats.FeePerByte = pool.FeePerByte()
+	stats.FeePriority = pool.feePriority
+	stats.EvictionPolicy = pool.evictionPolicy
+	stats.MaxTxnsPerSender = pool.maxTxnsPerSender
+	stats.EvictedGroups = pool.evictedGroups.Load()
+	stats.SenderLimitRejections = pool.senderLimitRejections.Load()
+	return stats
+}
+
 // FeePerByte returns the current minimum microalgos per byte a transaction
 // needs to pay in order to get into the pool.
 func (pool *TransactionPool) FeePerByte() uint64 {
@@ -458,6 +651,9 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 // Remember stores the provided transaction group.
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
+	if pool.notAccepting.Load() {
+		return ErrPoolNotAccepting
+	}
 	if err := pool.checkPendingQueueSize(txgroup); err != nil {
 		return err
 	}
@@ -471,9 +667,18 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 	}
 
 	pool.rememberCommit(false)
+	if pool.evictionPolicy == EvictionPolicyLowestFee {
+		pool.evictOverflow(groupFeePerByte(txgroup))
+	}
 	return nil
 }
 
+// StopAccepting makes the pool reject any transaction group remembered from now on. The pending
+// transactions stay in the pool, and keep being included in the blocks it assembles.
+func (pool *TransactionPool) StopAccepting() {
+	pool.notAccepting.Store(true)
+}
+
 // Lookup returns the error associated with a transaction that used
 // to be in the pool.  If no status information is available (e.g., because
 // it was too long ago, or the transaction committed successfully), then
@@ -545,7 +750,13 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 		// Recompute the pool by starting from the new latest block.
 		// This has the side-effect of discarding transactions that
 		// have been committed (or that are otherwise no longer valid).
-		stats = pool.recomputeBlockEvaluator(committedTxids, knownCommitted)
+		// If the block is the one the next block was speculatively
+		// assembled upon, the speculative evaluator is used instead.
+		if spec := pool.takeSpeculation(block); spec != nil {
+			stats = pool.adoptSpeculation(spec, committedTxids)
+		} else {
+			stats = pool.recomputeBlockEvaluator(committedTxids, knownCommitted)
+		}
 	}
 
 	stats.KnownCommittedCount = knownCommitted
@@ -577,6 +788,19 @@ func (pool *TransactionPool) isAssemblyTimedOut() bool {
 	return time.Now().After(pool.assemblyDeadline.Add(-generateBlockDuration))
 }
 
+// isAssemblyOverBudget determines if the time spent selecting and validating transactions for the pending block has
+// exceeded the configured ProposalAssemblyBudget. Unlike isAssemblyTimedOut, the budget is counted from the moment the
+// pending evaluator was started, so that a slow evaluation closes the payset early rather than leaving the agreement
+// with an empty block once its deadline has passed.
+// The function expects that the pool.assemblyMu lock would be taken before being called.
+func (pool *TransactionPool) isAssemblyOverBudget() bool {
+	if pool.assemblyBudgetDeadline.IsZero() {
+		return false
+	}
+	generateBlockDuration := generateBlockBaseDuration + time.Duration(pool.pendingBlockEvaluator.PaySetSize())*generateBlockTransactionDuration
+	return time.Now().After(pool.assemblyBudgetDeadline.Add(-generateBlockDuration))
+}
+
 func (pool *TransactionPool) addToPendingBlockEvaluatorOnce(txgroup []transactions.SignedTxn, recomputing bool, stats *telemetryspec.AssembleBlockMetrics) error {
 	r := pool.pendingBlockEvaluator.Round() + pool.numPendingWholeBlocks
 	for _, tx := range txgroup {
@@ -613,13 +837,21 @@ func (pool *TransactionPool) addToPendingBlockEvaluatorOnce(txgroup []transactio
 				stats.StopReason = telemetryspec.AssembleBlockAbandon
 				pool.assemblyResults.stats = *stats
 				pool.assemblyCond.Broadcast()
-			} else if err == ledgercore.ErrNoSpace || pool.isAssemblyTimedOut() {
+			} else if err == ledgercore.ErrNoSpace || pool.isAssemblyTimedOut() || pool.isAssemblyOverBudget() {
 				pool.assemblyResults.ok = true
 				pool.assemblyResults.assemblyCompletedOrAbandoned = true
 				if err == ledgercore.ErrNoSpace {
 					stats.StopReason = telemetryspec.AssembleBlockFull
 				} else {
-					stats.StopReason = telemetryspec.AssembleBlockTimeout
+					if pool.isAssemblyTimedOut() {
+						stats.StopReason = telemetryspec.AssembleBlockTimeout
+					} else {
+						stats.StopReason = telemetryspec.AssembleBlockOverBudget
+						txPoolAssemblyOverBudget.Inc(nil)
+						txPoolAssemblyDeferredGroups.AddUint64(uint64(stats.DeferredCount), nil)
+						pool.log.Infof("TransactionPool: assembly budget of %v exceeded for round %d; closing payset with %d transactions and deferring %d pending transaction groups",
+							pool.proposalAssemblyBudget, pool.assemblyResults.roundStartedEvaluating, pool.pendingBlockEvaluator.PaySetSize(), stats.DeferredCount)
+					}
 					// if the block is not full, it means that the above transaction made it to the block, so we want to add it here.
 					stats.ProcessingTime.AddTransaction(transactionGroupDuration)
 				}
@@ -633,6 +865,7 @@ func (pool *TransactionPool) addToPendingBlockEvaluatorOnce(txgroup []transactio
 				}
 				stats.BlockGenerationDuration = uint64(time.Since(blockGenerationStarts))
 				pool.assemblyResults.stats = *stats
+				pool.updatePreview(lvb, *stats)
 				pool.assemblyCond.Broadcast()
 			} else {
 				// add the transaction time only if we didn't ended up finishing the block.
@@ -658,6 +891,7 @@ func (pool *TransactionPool) addToPendingBlockEvaluator(txgroup []transactions.S
 // by the BlockEvaluator). Expects that the pool.mu mutex would be already taken.
 func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transactions.Txid]ledgercore.IncludedTransactions, knownCommitted uint) (stats telemetryspec.ProcessBlockMetrics) {
 	pool.pendingBlockEvaluator = nil
+	recomputeStarts := time.Now()
 
 	latest := pool.ledger.Latest()
 	prev, err := pool.ledger.BlockHdr(latest)
@@ -687,12 +921,11 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	txgroups := pool.pendingTxGroups
 	pendingCount := pool.pendingCountNoLock()
 	pool.pendingMu.RUnlock()
-
-	pool.assemblyMu.Lock()
-	pool.assemblyResults = poolAsmResults{
-		roundStartedEvaluating: prev.Round + basics.Round(1),
+	if pool.feePriority {
+		txgroups = prioritize(txgroups)
 	}
-	pool.assemblyMu.Unlock()
+
+	pool.startAssembly(prev.Round+basics.Round(1), recomputeStarts)
 
 	next := bookkeeping.MakeBlock(prev)
 	pool.numPendingWholeBlocks = 0
@@ -724,7 +957,9 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	firstTxnGrpTime := time.Now()
 
 	// Feed the transactions in order
-	for _, txgroup := range txgroups {
+	for i, txgroup := range txgroups {
+		// the number of groups that would be left out of the block if the payset gets closed after this group.
+		asmStats.DeferredCount = len(txgroups) - i - 1
 		if len(txgroup) == 0 {
 			asmStats.InvalidCount++
 			continue
@@ -735,37 +970,65 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 		}
 		err := pool.add(txgroup, &asmStats)
 		if err != nil {
-			for _, tx := range txgroup {
-				pool.statusCache.put(tx, err.Error())
-			}
-			// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
-			switch terr := err.(type) {
-			case *ledgercore.TransactionInLedgerError:
-				asmStats.CommittedCount++
-				stats.RemovedInvalidCount++
-			case *transactions.TxnDeadError:
-				if int(terr.LastValid-terr.FirstValid) > 20 {
-					// cutoff value  here is picked as a somewhat arbitrary cutoff trying to separate longer lived transactions from very short lived ones
-					asmStats.ExpiredLongLivedCount++
-				}
-				asmStats.ExpiredCount++
-				stats.ExpiredCount++
-			case *ledgercore.LeaseInLedgerError:
-				asmStats.LeaseErrorCount++
-				stats.RemovedInvalidCount++
-				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
-			case *transactions.MinFeeError:
-				asmStats.MinFeeErrorCount++
-				stats.RemovedInvalidCount++
-				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
-			default:
-				asmStats.InvalidCount++
-				stats.RemovedInvalidCount++
-				pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
-			}
+			pool.dropPendingTxGroup(txgroup, err, &asmStats, &stats)
+		}
+	}
+
+	pool.finishAssembly(asmStats, firstTxnGrpTime)
+	pool.rememberCommit(true)
+	return
+}
+
+// startAssembly resets the assembly results ahead of feeding the pending
+// transaction groups to the evaluator of the given round.
+func (pool *TransactionPool) startAssembly(round basics.Round, starts time.Time) {
+	pool.assemblyMu.Lock()
+	defer pool.assemblyMu.Unlock()
+	pool.assemblyResults = poolAsmResults{
+		roundStartedEvaluating: round,
+	}
+	pool.assemblyBudgetDeadline = time.Time{}
+	if pool.proposalAssemblyBudget > 0 {
+		pool.assemblyBudgetDeadline = starts.Add(pool.proposalAssemblyBudget)
+	}
+}
+
+// dropPendingTxGroup records why a pending transaction group could not be
+// re-added to the pending block evaluator.
+func (pool *TransactionPool) dropPendingTxGroup(txgroup []transactions.SignedTxn, err error, asmStats *telemetryspec.AssembleBlockMetrics, stats *telemetryspec.ProcessBlockMetrics) {
+	for _, tx := range txgroup {
+		pool.statusCache.put(tx, err.Error())
+	}
+	// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
+	switch terr := err.(type) {
+	case *ledgercore.TransactionInLedgerError:
+		asmStats.CommittedCount++
+		stats.RemovedInvalidCount++
+	case *transactions.TxnDeadError:
+		if int(terr.LastValid-terr.FirstValid) > 20 {
+			// cutoff value  here is picked as a somewhat arbitrary cutoff trying to separate longer lived transactions from very short lived ones
+			asmStats.ExpiredLongLivedCount++
 		}
+		asmStats.ExpiredCount++
+		stats.ExpiredCount++
+	case *ledgercore.LeaseInLedgerError:
+		asmStats.LeaseErrorCount++
+		stats.RemovedInvalidCount++
+		pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+	case *transactions.MinFeeError:
+		asmStats.MinFeeErrorCount++
+		stats.RemovedInvalidCount++
+		pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+	default:
+		asmStats.InvalidCount++
+		stats.RemovedInvalidCount++
+		pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
 	}
+}
 
+// finishAssembly generates the pending block once all the pending transaction
+// groups were fed to the evaluator, unless the block was already generated.
+func (pool *TransactionPool) finishAssembly(asmStats telemetryspec.AssembleBlockMetrics, firstTxnGrpTime time.Time) {
 	pool.assemblyMu.Lock()
 	if !pool.assemblyDeadline.IsZero() {
 		// The deadline was generated by the agreement, allocating ProposalAssemblyTime milliseconds for completing proposal
@@ -787,12 +1050,35 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 		}
 		asmStats.BlockGenerationDuration = uint64(time.Since(blockGenerationStarts))
 		pool.assemblyResults.stats = asmStats
+		pool.updatePreview(lvb, asmStats)
 		pool.assemblyCond.Broadcast()
 	}
 	pool.assemblyMu.Unlock()
+}
 
-	pool.rememberCommit(true)
-	return
+// updatePreview replaces the preview of the pending block with the one of the
+// newly assembled block lvb. Expects that the pool.assemblyMu mutex would be already taken.
+func (pool *TransactionPool) updatePreview(lvb *ledgercore.ValidatedBlock, stats telemetryspec.AssembleBlockMetrics) {
+	if lvb == nil {
+		return
+	}
+	preview, err := makeBlockPreview(lvb, stats)
+	if err != nil {
+		pool.log.Warnf("TransactionPool: unable to preview the block assembled for round %d: %v", lvb.Block().Round(), err)
+		return
+	}
+	pool.assemblyPreview = preview
+}
+
+// PendingBlockPreview returns a summary of the block most recently assembled by
+// the pool, and false if no block was assembled yet.
+func (pool *TransactionPool) PendingBlockPreview() (BlockPreview, bool) {
+	pool.assemblyMu.Lock()
+	defer pool.assemblyMu.Unlock()
+	if pool.assemblyPreview == nil {
+		return BlockPreview{}, false
+	}
+	return *pool.assemblyPreview, true
 }
 
 func (pool *TransactionPool) getStateProofStats(txib *transactions.SignedTxnInBlock, encodedLen int) telemetryspec.StateProofStats {
@@ -44,6 +44,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/stateproof/verify"
@@ -190,6 +191,43 @@ func TestMinBalanceOK(t *testing.T) {
 	require.NoError(t, transactionPool.RememberOne(signedTx))
 }
 
+func TestStopAccepting(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secrets := []*crypto.SignatureSecrets{keypair(), keypair()}
+	addresses := []basics.Address{basics.Address(secrets[0].SignatureVerifier), basics.Address(secrets[1].SignatureVerifier)}
+	ledger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	payment := func(note byte) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[0],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{note},
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[1],
+				Amount:   basics.MicroAlgos{Raw: minBalance},
+			},
+		}
+		return tx.Sign(secrets[0])
+	}
+	require.NoError(t, transactionPool.RememberOne(payment(1)))
+
+	transactionPool.StopAccepting()
+	require.ErrorIs(t, transactionPool.RememberOne(payment(2)), ErrPoolNotAccepting)
+	// the transactions already in the pool stay in it
+	require.Equal(t, 1, transactionPool.PendingCount())
+}
+
 func TestSenderGoesBelowMinBalance(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -1612,3 +1650,485 @@ func generateProofForTesting(
 
 	return proof
 }
+
+func TestAssemblyBudgetDefersPendingGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 5
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	// any evaluation exceeds this budget, so the payset is closed right after the first group.
+	cfg.ProposalAssemblyBudget = time.Nanosecond
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	for i, sender := range addresses {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(i)},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[i])))
+	}
+
+	// commit an empty block so the pool recomputes its evaluator for the next round.
+	eval := newBlockEvaluator(t, mockLedger)
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+
+	overBudget := txPoolAssemblyOverBudget.GetUint64Value()
+	deferred := txPoolAssemblyDeferredGroups.GetUint64Value()
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+
+	transactionPool.assemblyMu.Lock()
+	stats := transactionPool.assemblyResults.stats
+	transactionPool.assemblyMu.Unlock()
+	require.Equal(t, telemetryspec.AssembleBlockOverBudget, stats.StopReason)
+	require.Equal(t, numOfAccounts-1, stats.DeferredCount)
+	require.Equal(t, overBudget+1, txPoolAssemblyOverBudget.GetUint64Value())
+	require.Equal(t, deferred+uint64(numOfAccounts-1), txPoolAssemblyDeferredGroups.GetUint64Value())
+
+	assembled, err := transactionPool.AssembleBlock(blk.Block().Round()+1, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, assembled.Block().Payset, 1)
+
+	// the deferred groups remain pending for a later block.
+	require.Len(t, transactionPool.PendingTxGroups(), numOfAccounts)
+}
+
+func TestPendingBlockPreview(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	// the pool assembles a block for the next round as soon as it's created.
+	preview, ok := transactionPool.PendingBlockPreview()
+	require.True(t, ok)
+	require.Equal(t, mockLedger.Latest()+1, preview.Round)
+	require.Empty(t, preview.TxIDs)
+
+	var txids []transactions.Txid
+	var fees uint64
+	for i, sender := range addresses {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + uint64(i)},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(i)},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[i])))
+		txids = append(txids, tx.ID())
+		fees += tx.Fee.Raw
+	}
+
+	// commit an empty block so the pool assembles the pending transactions for the next round.
+	eval := newBlockEvaluator(t, mockLedger)
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+
+	preview, ok = transactionPool.PendingBlockPreview()
+	require.True(t, ok)
+	require.Equal(t, blk.Block().Round()+1, preview.Round)
+	require.Equal(t, txids, preview.TxIDs)
+	require.Equal(t, telemetryspec.AssembleBlockEmpty, preview.StopReason)
+	require.Equal(t, fees, preview.TotalFees.Raw)
+	require.Equal(t, proto.MaxTxnBytesPerBlock, preview.MaxPaysetBytes)
+	require.Positive(t, preview.PaysetBytes)
+
+	assembled, err := transactionPool.AssembleBlock(preview.Round, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, assembled.Block().RewardsLevel, preview.RewardsLevel)
+	require.Equal(t, assembled.Block().RewardsRate, preview.RewardsRate)
+}
+
+func TestSpeculativeAssembly(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	cfg.EnableSpeculativeAssembly = true
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	var signed []transactions.SignedTxn
+	for i, sender := range addresses {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(i)},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		signed = append(signed, tx.Sign(secrets[i]))
+	}
+	require.NoError(t, transactionPool.RememberOne(signed[0]))
+	require.NoError(t, transactionPool.RememberOne(signed[1]))
+
+	// speculate on top of a block that commits the first transaction.
+	eval := newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(signed[0], transactions.ApplyData{}))
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	transactionPool.StartSpeculativeAssembly(vb)
+	spec := transactionPool.speculation
+	require.NotNil(t, spec)
+	<-spec.done
+	require.NoError(t, spec.err)
+	require.Len(t, spec.accepted, 1)
+	require.Equal(t, uint64(1), spec.stats.EarlyCommittedCount)
+
+	// a transaction remembered after the speculation started is evaluated once it's adopted.
+	require.NoError(t, transactionPool.RememberOne(signed[2]))
+
+	require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+	transactionPool.OnNewBlock(vb.Block(), vb.Delta())
+	require.Nil(t, transactionPool.speculation)
+	require.Equal(t, spec.evaluator, transactionPool.pendingBlockEvaluator)
+	require.ElementsMatch(t, []transactions.Txid{signed[1].ID(), signed[2].ID()}, transactionPool.PendingTxIDs())
+
+	assembled, err := transactionPool.AssembleBlock(vb.Block().Round()+1, time.Time{})
+	require.NoError(t, err)
+	payset, err := assembled.Block().DecodePaysetFlat()
+	require.NoError(t, err)
+	require.Len(t, payset, 2)
+	require.ElementsMatch(t, []transactions.Txid{signed[1].ID(), signed[2].ID()}, []transactions.Txid{payset[0].ID(), payset[1].ID()})
+
+	// a speculation on a block that is not the committed one is discarded.
+	eval = newBlockEvaluator(t, mockLedger)
+	vb, err = eval.GenerateBlock()
+	require.NoError(t, err)
+	transactionPool.StartSpeculativeAssembly(vb)
+	spec = transactionPool.speculation
+	require.NotNil(t, spec)
+	<-spec.done
+
+	eval = newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(signed[1], transactions.ApplyData{}))
+	committed, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*committed, agreement.Certificate{}))
+	transactionPool.OnNewBlock(committed.Block(), committed.Delta())
+	require.Nil(t, transactionPool.speculation)
+	require.NotEqual(t, spec.evaluator, transactionPool.pendingBlockEvaluator)
+	require.Equal(t, []transactions.Txid{signed[2].ID()}, transactionPool.PendingTxIDs())
+}
+
+func TestPrioritize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var senders [3]basics.Address
+	for i := range senders {
+		senders[i] = basics.Address{byte(i + 1)}
+	}
+	group := func(sender basics.Address, fee uint64) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender: sender,
+				Fee:    basics.MicroAlgos{Raw: fee},
+			},
+		}}}
+	}
+
+	low := group(senders[0], 1000)
+	high := group(senders[1], 5000)
+	// follows low on the same sender, so it may depend on it and cannot move ahead of it.
+	dependent := group(senders[0], 9000)
+	highest := group(senders[2], 8000)
+
+	txgroups := [][]transactions.SignedTxn{low, high, dependent, highest}
+	require.Equal(t, [][]transactions.SignedTxn{highest, high, low, dependent}, prioritize(txgroups))
+	// the pending groups are left as they are.
+	require.Equal(t, [][]transactions.SignedTxn{low, high, dependent, highest}, txgroups)
+}
+
+func TestPrioritizeApplications(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	funder := basics.Address{1}
+	caller := basics.Address{2}
+	other := basics.Address{3}
+	app := basics.AppIndex(7)
+	pay := func(sender, receiver basics.Address, fee uint64) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: fee}},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver},
+		}}}
+	}
+	call := func(sender basics.Address, app basics.AppIndex, fee uint64) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:                     protocol.ApplicationCallTx,
+			Header:                   transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: fee}},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: app},
+		}}}
+	}
+
+	// the call spends from the account of the application, which fund pays into.
+	fund := pay(funder, app.Address(), 1000)
+	appCall := call(caller, app, 9000)
+	require.Equal(t, [][]transactions.SignedTxn{fund, appCall}, prioritize([][]transactions.SignedTxn{fund, appCall}))
+
+	// the same goes for an application the call only refers to.
+	foreignCall := call(caller, app+1, 9000)
+	foreignCall[0].Txn.ForeignApps = []basics.AppIndex{app}
+	require.Equal(t, [][]transactions.SignedTxn{fund, foreignCall}, prioritize([][]transactions.SignedTxn{fund, foreignCall}))
+
+	// a call may create applications and assets, which the later groups may use, so they stay behind it.
+	unrelated := pay(other, other, 9000)
+	require.Equal(t, [][]transactions.SignedTxn{appCall, unrelated}, prioritize([][]transactions.SignedTxn{appCall, unrelated}))
+	require.Equal(t, [][]transactions.SignedTxn{unrelated, fund}, prioritize([][]transactions.SignedTxn{fund, unrelated}))
+}
+
+func TestTxPoolLowestFeeEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 5
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 3
+	cfg.TxPoolEvictionPolicy = EvictionPolicyLowestFee
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	makeTxn := func(i int, fee uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[i],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		return tx.Sign(secrets[i])
+	}
+
+	lowest := makeTxn(0, proto.MinTxnFee)
+	require.NoError(t, transactionPool.RememberOne(lowest))
+	require.NoError(t, transactionPool.RememberOne(makeTxn(1, 3*proto.MinTxnFee)))
+	require.NoError(t, transactionPool.RememberOne(makeTxn(2, 4*proto.MinTxnFee)))
+
+	// a group paying no more than the pending ones is rejected when the pool is full.
+	require.ErrorIs(t, transactionPool.RememberOne(makeTxn(3, proto.MinTxnFee)), ErrPendingQueueReachedMaxCap)
+
+	// a group paying more evicts the pending group paying the least.
+	higher := makeTxn(4, 2*proto.MinTxnFee)
+	require.NoError(t, transactionPool.RememberOne(higher))
+	require.Equal(t, 3, transactionPool.PendingCount())
+	_, txErr, found := transactionPool.Lookup(lowest.ID())
+	require.True(t, found)
+	require.Equal(t, txPoolEvictedStatus, txErr)
+	_, txErr, found = transactionPool.Lookup(higher.ID())
+	require.True(t, found)
+	require.Empty(t, txErr)
+
+	stats := transactionPool.Stats()
+	require.Equal(t, 3, stats.PendingTxns)
+	require.Equal(t, 3, stats.PendingGroups)
+	require.Equal(t, 3, stats.MaxTxns)
+	require.Equal(t, EvictionPolicyLowestFee, stats.EvictionPolicy)
+	require.Equal(t, uint64(1), stats.EvictedGroups)
+	require.Equal(t, groupFeePerByte([]transactions.SignedTxn{higher}), stats.MinFeePerByte)
+	require.Less(t, stats.MinFeePerByte, stats.MedianFeePerByte)
+	require.Less(t, stats.MedianFeePerByte, stats.MaxFeePerByte)
+}
+
+func TestTxPoolLowestFeeEvictionKeepsDependencies(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 4
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 2
+	cfg.TxPoolEvictionPolicy = EvictionPolicyLowestFee
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	makeTxn := func(i int, receiver int, fee uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[receiver],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		return tx.Sign(secrets[i])
+	}
+
+	// funded pays into the sender of dependent, which may need the funds.
+	funded := makeTxn(0, 1, proto.MinTxnFee)
+	dependent := makeTxn(1, 1, 3*proto.MinTxnFee)
+	require.NoError(t, transactionPool.RememberOne(funded))
+	require.NoError(t, transactionPool.RememberOne(dependent))
+
+	// the only group paying less than the arriving one has a pending group depending on it.
+	require.ErrorIs(t, transactionPool.RememberOne(makeTxn(2, 2, 2*proto.MinTxnFee)), ErrPendingQueueReachedMaxCap)
+
+	// a group paying more than both evicts the dependent group, which nothing depends on.
+	higher := makeTxn(3, 3, 4*proto.MinTxnFee)
+	require.NoError(t, transactionPool.RememberOne(higher))
+	require.Equal(t, 2, transactionPool.PendingCount())
+	_, txErr, found := transactionPool.Lookup(dependent.ID())
+	require.True(t, found)
+	require.Equal(t, txPoolEvictedStatus, txErr)
+	_, txErr, found = transactionPool.Lookup(funded.ID())
+	require.True(t, found)
+	require.Empty(t, txErr)
+}
+
+func TestTxPoolMaxTxnsPerSender(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 2
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.TxPoolMaxTxnsPerSender = 2
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	note := 0
+	makeTxn := func(i int) transactions.SignedTxn {
+		note++
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(note)},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		return tx.Sign(secrets[i])
+	}
+
+	require.NoError(t, transactionPool.RememberOne(makeTxn(0)))
+	require.NoError(t, transactionPool.RememberOne(makeTxn(0)))
+	var limitErr *ErrTxPoolSenderLimit
+	require.ErrorAs(t, transactionPool.RememberOne(makeTxn(0)), &limitErr)
+	// the other senders are not affected.
+	require.NoError(t, transactionPool.RememberOne(makeTxn(1)))
+	require.Equal(t, uint64(1), transactionPool.Stats().SenderLimitRejections)
+
+	// the pending transactions of the sender are released once they are committed.
+	eval := newBlockEvaluator(t, mockLedger)
+	for _, txgroup := range transactionPool.PendingTxGroups() {
+		require.NoError(t, eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup)))
+	}
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+	require.NoError(t, transactionPool.RememberOne(makeTxn(0)))
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// relayPolicyReloadInterval is how often the relay policy file is checked for changes.
+const relayPolicyReloadInterval = 10 * time.Second
+
+// RelayPolicyAction is what the relay policy does with the transaction groups one of its rules matches.
+type RelayPolicyAction string
+
+const (
+	// RelayPolicyAccept handles the transaction group as usual. No rule can have this action.
+	RelayPolicyAccept RelayPolicyAction = ""
+	// RelayPolicyDeprioritize remembers the transaction group in the pool, but only relays it while the
+	// transaction backlog is less than half full.
+	RelayPolicyDeprioritize RelayPolicyAction = "deprioritize"
+	// RelayPolicyDrop discards the transaction group before it is verified, so that it is neither
+	// remembered in the pool nor relayed.
+	RelayPolicyDrop RelayPolicyAction = "drop"
+)
+
+// precedence orders the actions so that the strongest action of a group wins.
+func (a RelayPolicyAction) precedence() int {
+	switch a {
+	case RelayPolicyDrop:
+		return 2
+	case RelayPolicyDeprioritize:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// RelayPolicyRule matches a class of transactions. A transaction matches the rule when it meets all
+// the conditions the rule sets, and a rule has to set at least one condition.
+type RelayPolicyRule struct {
+	// Name identifies the rule in the logs.
+	Name string `json:"name"`
+	// Action is applied to the transaction groups containing a matching transaction.
+	Action RelayPolicyAction `json:"action"`
+
+	// Type matches the transactions of that type.
+	Type protocol.TxType `json:"type,omitempty"`
+	// ZeroAmount matches the payments and asset transfers that move nothing: a zero amount with no
+	// close-out. Asset opt-ins are not matched.
+	ZeroAmount bool `json:"zero-amount,omitempty"`
+	// MinNoteSize matches the transactions with a note at least that many bytes long.
+	MinNoteSize int `json:"min-note-size,omitempty"`
+	// MaxFee matches the transactions paying at most that fee.
+	MaxFee uint64 `json:"max-fee,omitempty"`
+}
+
+// relayPolicyFile is the layout of the relay policy file.
+type relayPolicyFile struct {
+	Rules []RelayPolicyRule `json:"rules"`
+}
+
+func (r *RelayPolicyRule) validate() error {
+	switch r.Action {
+	case RelayPolicyDrop, RelayPolicyDeprioritize:
+	default:
+		return fmt.Errorf("rule %q has unknown action %q", r.Name, r.Action)
+	}
+	if r.MinNoteSize < 0 {
+		return fmt.Errorf("rule %q has a negative min-note-size", r.Name)
+	}
+	if r.Type == "" && !r.ZeroAmount && r.MinNoteSize == 0 && r.MaxFee == 0 {
+		return fmt.Errorf("rule %q has no condition", r.Name)
+	}
+	return nil
+}
+
+func (r *RelayPolicyRule) matches(txn *transactions.Transaction) bool {
+	if r.Type != "" && txn.Type != r.Type {
+		return false
+	}
+	if r.ZeroAmount && !isZeroAmount(txn) {
+		return false
+	}
+	if r.MinNoteSize > 0 && len(txn.Note) < r.MinNoteSize {
+		return false
+	}
+	if r.MaxFee > 0 && txn.Fee.Raw > r.MaxFee {
+		return false
+	}
+	return true
+}
+
+func isZeroAmount(txn *transactions.Transaction) bool {
+	switch txn.Type {
+	case protocol.PaymentTx:
+		return txn.Amount.IsZero() && txn.CloseRemainderTo.IsZero()
+	case protocol.AssetTransferTx:
+		optIn := txn.AssetReceiver == txn.Sender && txn.AssetSender.IsZero()
+		return txn.AssetAmount == 0 && txn.AssetCloseTo.IsZero() && !optIn
+	default:
+		return false
+	}
+}
+
+// parseRelayPolicy decodes and validates the content of a relay policy file.
+func parseRelayPolicy(data []byte) ([]RelayPolicyRule, error) {
+	var file relayPolicyFile
+	err := json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, err
+	}
+	for i := range file.Rules {
+		err = file.Rules[i].validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return file.Rules, nil
+}
+
+// RelayPolicy is a node-local set of rules deciding which of the transaction groups received from the
+// gossip network are dropped or deprioritized. It is read from a file the operator maintains and is
+// reloaded whenever that file changes.
+//
+// The relay policy has no bearing on the validity of transactions: it only affects what this node
+// pools and relays for its peers. Blocks with transactions the policy drops are still valid, and the
+// transactions submitted to this node directly are not subject to it.
+type RelayPolicy struct {
+	path string
+
+	mu      deadlock.RWMutex
+	rules   []RelayPolicyRule
+	modTime time.Time
+	size    int64
+}
+
+// MakeRelayPolicy loads the relay policy in the file at path.
+func MakeRelayPolicy(path string) (*RelayPolicy, error) {
+	p := &RelayPolicy{path: path}
+	_, err := p.reload()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load relay policy %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// reload reads the policy file again if it changed since it was last read. On errors the rules
+// currently in force are kept.
+func (p *RelayPolicy) reload() (changed bool, err error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.RLock()
+	unchanged := info.ModTime().Equal(p.modTime) && info.Size() == p.size
+	p.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return false, err
+	}
+	rules, err := parseRelayPolicy(data)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rules = rules
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	return true, nil
+}
+
+// Rules returns the rules currently in force.
+func (p *RelayPolicy) Rules() []RelayPolicyRule {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]RelayPolicyRule(nil), p.rules...)
+}
+
+// Classify returns the action the policy takes for a transaction group: the strongest action of the
+// rules matching any of its transactions, and the name of the rule it comes from.
+func (p *RelayPolicy) Classify(txgroup []transactions.SignedTxn) (action RelayPolicyAction, rule string) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for i := range txgroup {
+		for j := range p.rules {
+			r := &p.rules[j]
+			if r.Action.precedence() <= action.precedence() || !r.matches(&txgroup[i].Txn) {
+				continue
+			}
+			action, rule = r.Action, r.Name
+			if action == RelayPolicyDrop {
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testRelayPolicy = `{"rules": [
+	{"name": "zero-pay", "action": "drop", "type": "pay", "zero-amount": true},
+	{"name": "big-note", "action": "deprioritize", "min-note-size": 512}
+]}`
+
+func writeRelayPolicy(t *testing.T, path string, content string, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestRelayPolicyParse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rules, err := parseRelayPolicy([]byte(testRelayPolicy))
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, RelayPolicyRule{Name: "zero-pay", Action: RelayPolicyDrop, Type: protocol.PaymentTx, ZeroAmount: true}, rules[0])
+	require.Equal(t, RelayPolicyRule{Name: "big-note", Action: RelayPolicyDeprioritize, MinNoteSize: 512}, rules[1])
+
+	_, err = parseRelayPolicy([]byte(`{"rules": [{"name": "r", "action": "ban", "max-fee": 1000}]}`))
+	require.ErrorContains(t, err, `unknown action "ban"`)
+	_, err = parseRelayPolicy([]byte(`{"rules": [{"name": "r", "action": "drop"}]}`))
+	require.ErrorContains(t, err, "has no condition")
+	_, err = parseRelayPolicy([]byte(`{"rules": [`))
+	require.Error(t, err)
+}
+
+func TestRelayPolicyClassify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "relaypolicy.json")
+	writeRelayPolicy(t, path, testRelayPolicy, time.Now())
+	policy, err := MakeRelayPolicy(path)
+	require.NoError(t, err)
+
+	sender := basics.Address{1}
+	pay := func(amount uint64, note int) transactions.SignedTxn {
+		return transactions.SignedTxn{Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender, Note: make([]byte, note)},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: basics.Address{2}, Amount: basics.MicroAlgos{Raw: amount}},
+		}}
+	}
+	optIn := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:                   protocol.AssetTransferTx,
+		Header:                 transactions.Header{Sender: sender},
+		AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: 1, AssetReceiver: sender},
+	}}
+	closeOut := pay(0, 0)
+	closeOut.Txn.CloseRemainderTo = basics.Address{3}
+
+	tests := []struct {
+		name    string
+		txgroup []transactions.SignedTxn
+		action  RelayPolicyAction
+		rule    string
+	}{
+		{"payment", []transactions.SignedTxn{pay(1, 0)}, RelayPolicyAccept, ""},
+		{"zero payment", []transactions.SignedTxn{pay(0, 0)}, RelayPolicyDrop, "zero-pay"},
+		{"close out", []transactions.SignedTxn{closeOut}, RelayPolicyAccept, ""},
+		{"opt-in", []transactions.SignedTxn{optIn}, RelayPolicyAccept, ""},
+		{"big note", []transactions.SignedTxn{pay(1, 512)}, RelayPolicyDeprioritize, "big-note"},
+		{"small note", []transactions.SignedTxn{pay(1, 511)}, RelayPolicyAccept, ""},
+		{"strongest wins", []transactions.SignedTxn{pay(1, 1024), pay(0, 0)}, RelayPolicyDrop, "zero-pay"},
+	}
+	for _, test := range tests {
+		action, rule := policy.Classify(test.txgroup)
+		require.Equal(t, test.action, action, test.name)
+		require.Equal(t, test.rule, rule, test.name)
+	}
+}
+
+func TestRelayPolicyReload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "relaypolicy.json")
+	_, err := MakeRelayPolicy(path)
+	require.Error(t, err)
+
+	now := time.Now()
+	writeRelayPolicy(t, path, testRelayPolicy, now)
+	policy, err := MakeRelayPolicy(path)
+	require.NoError(t, err)
+	require.Len(t, policy.Rules(), 2)
+
+	changed, err := policy.reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	writeRelayPolicy(t, path, `{"rules": [{"name": "cheap", "action": "deprioritize", "max-fee": 1000}]}`, now.Add(time.Second))
+	changed, err = policy.reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, []RelayPolicyRule{{Name: "cheap", Action: RelayPolicyDeprioritize, MaxFee: 1000}}, policy.Rules())
+
+	// a broken policy file leaves the rules in force
+	writeRelayPolicy(t, path, `{"rules": [{"name": "all", "action": "drop"}]}`, now.Add(2*time.Second))
+	changed, err = policy.reload()
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Len(t, policy.Rules(), 1)
+}
+
+func TestTxHandlerProcessIncomingRelayPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "relaypolicy.json")
+	writeRelayPolicy(t, path, `{"rules": [{"name": "big-note", "action": "drop", "min-note-size": 64}]}`, time.Now())
+	policy, err := MakeRelayPolicy(path)
+	require.NoError(t, err)
+
+	handler := makeTestTxHandlerOrphaned(2)
+	handler.relayPolicy = policy
+
+	// makeRandomTransactions uses a signature-sized note
+	_, blob := makeRandomTransactions(1)
+	action := handler.processIncomingTxn(network.IncomingMessage{Data: blob, Sender: mockSender{}})
+	require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+	require.Equal(t, 0, len(handler.backlogQueue))
+
+	writeRelayPolicy(t, path, `{"rules": [{"name": "big-note", "action": "deprioritize", "min-note-size": 64}]}`, time.Now().Add(time.Second))
+	_, err = policy.reload()
+	require.NoError(t, err)
+	_, blob = makeRandomTransactions(1)
+	action = handler.processIncomingTxn(network.IncomingMessage{Data: blob, Sender: mockSender{}})
+	require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+	require.Equal(t, 1, len(handler.backlogQueue))
+	msg := <-handler.backlogQueue
+	require.Equal(t, RelayPolicyDeprioritize, msg.relayAction)
+}
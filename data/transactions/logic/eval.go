@@ -315,6 +315,12 @@ type EvalParams struct {
 	// transactions). nil is treated as 0 (used before fee pooling is enabled).
 	FeeCredit *uint64
 
+	// FeeDeficit, when non-nil, allows inner transactions to be submitted even
+	// if FeeCredit can not cover their fees. The missing amount is accumulated
+	// here instead. Only simulation sets this, in order to report the fees a
+	// group would need.
+	FeeDeficit *uint64
+
 	Specials *transactions.SpecialAddresses
 
 	// Total pool of app call budget in a group transaction (nil before budget pooling enabled)
@@ -463,14 +469,7 @@ func NewAppEvalParams(txgroup []transactions.SignedTxnWithAD, proto *config.Cons
 }
 
 func (ep *EvalParams) computeAvailability() *resources {
-	available := &resources{
-		sharedAccounts: make(map[basics.Address]struct{}),
-		sharedAsas:     make(map[basics.AssetIndex]struct{}),
-		sharedApps:     make(map[basics.AppIndex]struct{}),
-		sharedHoldings: make(map[ledgercore.AccountAsset]struct{}),
-		sharedLocals:   make(map[ledgercore.AccountApp]struct{}),
-		boxes:          make(map[BoxRef]bool),
-	}
+	available := makeResources()
 	for i := range ep.TxnGroup {
 		available.fill(&ep.TxnGroup[i].Txn, ep)
 	}
@@ -526,6 +525,7 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		Tracer:                  caller.Tracer,
 		minAvmVersion:           minAvmVersion,
 		FeeCredit:               caller.FeeCredit,
+		FeeDeficit:              caller.FeeDeficit,
 		Specials:                caller.Specials,
 		PooledApplicationBudget: caller.PooledApplicationBudget,
 		pooledAllowedInners:     caller.pooledAllowedInners,
@@ -5632,7 +5632,14 @@ func opItxnSubmit(cx *EvalContext) (err error) {
 		// See if the FeeCredit is enough to cover the shortfall
 		shortfall := groupFee - groupPaid
 		if cx.FeeCredit == nil || *cx.FeeCredit < shortfall {
-			return fmt.Errorf("fee too small %#v", cx.subtxns)
+			if cx.FeeDeficit == nil {
+				return fmt.Errorf("fee too small %#v", cx.subtxns)
+			}
+			if cx.FeeCredit == nil {
+				cx.FeeCredit = new(uint64)
+			}
+			*cx.FeeDeficit = basics.AddSaturate(*cx.FeeDeficit, shortfall-*cx.FeeCredit)
+			shortfall = *cx.FeeCredit
 		}
 		*cx.FeeCredit -= shortfall
 	} else {
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// GroupResources reports the resources the transactions of a group make available to the programs of the
+// group under the resource sharing rules, with the group indexes of the transactions which make each of them
+// available. It explains why a program can or can't access a resource it doesn't name itself.
+type GroupResources struct {
+	Accounts []SharedAccount
+	Assets   []SharedAsset
+	Apps     []SharedApp
+	// Holdings and Locals are only available together with the account and the asset or app when a single
+	// transaction names both: an account named by a transaction and an asset named by another don't make the
+	// holding available.
+	Holdings []SharedHolding
+	Locals   []SharedLocal
+	Boxes    []SharedBox
+
+	// AppCreations and AssetCreations are the group indexes of the transactions creating an app or an asset.
+	// Once created, the app or asset, with its holdings or locals and, for an app, its account, are available
+	// to the later transactions of the group.
+	AppCreations   []int
+	AssetCreations []int
+
+	// Programs are the app calls of the group. Only the ones with programs of version 9 and later access the
+	// resources of the whole group; the others only access the resources named by their own transaction.
+	Programs []ProgramResources
+}
+
+// SharedAccount is an account available to the group.
+type SharedAccount struct {
+	Address basics.Address
+	Sources []int
+}
+
+// SharedAsset is an asset available to the group.
+type SharedAsset struct {
+	Asset   basics.AssetIndex
+	Sources []int
+}
+
+// SharedApp is an app available to the group.
+type SharedApp struct {
+	App     basics.AppIndex
+	Sources []int
+}
+
+// SharedHolding is an asset holding available to the group.
+type SharedHolding struct {
+	Address basics.Address
+	Asset   basics.AssetIndex
+	Sources []int
+}
+
+// SharedLocal is the local state of an account in an app available to the group.
+type SharedLocal struct {
+	Address basics.Address
+	App     basics.AppIndex
+	Sources []int
+}
+
+// SharedBox is a box available to the group.
+type SharedBox struct {
+	App     basics.AppIndex
+	Name    string
+	Sources []int
+}
+
+// ProgramResources tells which resources the program called by a transaction of the group accesses.
+type ProgramResources struct {
+	GroupIndex int
+	// App is zero when the transaction creates the app.
+	App     basics.AppIndex
+	Version uint64
+	// Shared is set when the program accesses the resources of the whole group, rather than only the ones
+	// named by its transaction.
+	Shared bool
+}
+
+// AnalyzeGroupResources computes the GroupResources of a transaction group. appVersion returns the version of
+// the approval program of an existing app.
+func AnalyzeGroupResources(txgroup []transactions.SignedTxn, appVersion func(basics.AppIndex) (uint64, error)) (GroupResources, error) {
+	// fill only needs the EvalParams to compute the addresses of the apps
+	ep := &EvalParams{appAddrCache: make(map[basics.AppIndex]basics.Address)}
+
+	accounts := make(map[basics.Address][]int)
+	assets := make(map[basics.AssetIndex][]int)
+	apps := make(map[basics.AppIndex][]int)
+	holdings := make(map[ledgercore.AccountAsset][]int)
+	locals := make(map[ledgercore.AccountApp][]int)
+	boxes := make(map[BoxRef][]int)
+
+	var report GroupResources
+	for gi := range txgroup {
+		tx := &txgroup[gi].Txn
+		r := makeResources()
+		r.fill(tx, ep)
+		for addr := range r.sharedAccounts {
+			accounts[addr] = append(accounts[addr], gi)
+		}
+		for id := range r.sharedAsas {
+			assets[id] = append(assets[id], gi)
+		}
+		for id := range r.sharedApps {
+			apps[id] = append(apps[id], gi)
+		}
+		for h := range r.sharedHoldings {
+			holdings[h] = append(holdings[h], gi)
+		}
+		for l := range r.sharedLocals {
+			locals[l] = append(locals[l], gi)
+		}
+		for br := range r.boxes {
+			boxes[br] = append(boxes[br], gi)
+		}
+
+		switch {
+		case tx.Type == protocol.AssetConfigTx && tx.ConfigAsset == 0:
+			report.AssetCreations = append(report.AssetCreations, gi)
+		case tx.Type == protocol.ApplicationCallTx:
+			program := ProgramResources{GroupIndex: gi, App: tx.ApplicationID}
+			var err error
+			if tx.ApplicationID == 0 {
+				report.AppCreations = append(report.AppCreations, gi)
+				program.Version, _, err = transactions.ProgramVersion(tx.ApprovalProgram)
+			} else {
+				program.Version, err = appVersion(tx.ApplicationID)
+			}
+			if err != nil {
+				return GroupResources{}, fmt.Errorf("transaction %d: %w", gi, err)
+			}
+			program.Shared = program.Version >= sharedResourcesVersion
+			report.Programs = append(report.Programs, program)
+		}
+	}
+
+	for addr, sources := range accounts {
+		report.Accounts = append(report.Accounts, SharedAccount{Address: addr, Sources: sources})
+	}
+	sort.Slice(report.Accounts, func(i, j int) bool {
+		return bytes.Compare(report.Accounts[i].Address[:], report.Accounts[j].Address[:]) < 0
+	})
+	for id, sources := range assets {
+		report.Assets = append(report.Assets, SharedAsset{Asset: id, Sources: sources})
+	}
+	sort.Slice(report.Assets, func(i, j int) bool { return report.Assets[i].Asset < report.Assets[j].Asset })
+	for id, sources := range apps {
+		report.Apps = append(report.Apps, SharedApp{App: id, Sources: sources})
+	}
+	sort.Slice(report.Apps, func(i, j int) bool { return report.Apps[i].App < report.Apps[j].App })
+	for h, sources := range holdings {
+		report.Holdings = append(report.Holdings, SharedHolding{Address: h.Address, Asset: h.Asset, Sources: sources})
+	}
+	sort.Slice(report.Holdings, func(i, j int) bool {
+		if c := bytes.Compare(report.Holdings[i].Address[:], report.Holdings[j].Address[:]); c != 0 {
+			return c < 0
+		}
+		return report.Holdings[i].Asset < report.Holdings[j].Asset
+	})
+	for l, sources := range locals {
+		report.Locals = append(report.Locals, SharedLocal{Address: l.Address, App: l.App, Sources: sources})
+	}
+	sort.Slice(report.Locals, func(i, j int) bool {
+		if c := bytes.Compare(report.Locals[i].Address[:], report.Locals[j].Address[:]); c != 0 {
+			return c < 0
+		}
+		return report.Locals[i].App < report.Locals[j].App
+	})
+	for br, sources := range boxes {
+		report.Boxes = append(report.Boxes, SharedBox{App: br.App, Name: br.Name, Sources: sources})
+	}
+	sort.Slice(report.Boxes, func(i, j int) bool {
+		if report.Boxes[i].App != report.Boxes[j].App {
+			return report.Boxes[i].App < report.Boxes[j].App
+		}
+		return report.Boxes[i].Name < report.Boxes[j].Name
+	})
+	return report, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	. "github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAnalyzeGroupResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{1}
+	receiver := basics.Address{2}
+	other := basics.Address{3}
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+	axfer := txntest.Txn{
+		Type:          protocol.AssetTransferTx,
+		Sender:        sender,
+		AssetReceiver: receiver,
+		XferAsset:     10,
+	}
+	appl := txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender,
+		ApplicationID: 500,
+		Accounts:      []basics.Address{other},
+		ForeignAssets: []basics.AssetIndex{10},
+		Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("box")}},
+	}
+	create := txntest.Txn{
+		Type:            protocol.ApplicationCallTx,
+		Sender:          other,
+		ApprovalProgram: "#pragma version 8\nint 1",
+	}
+	txgroup := txntest.Group(&pay, &axfer, &appl, &create)
+
+	appVersion := func(app basics.AppIndex) (uint64, error) {
+		require.Equal(t, basics.AppIndex(500), app)
+		return 9, nil
+	}
+	report, err := AnalyzeGroupResources(txgroup, appVersion)
+	require.NoError(t, err)
+
+	appAddr := basics.AppIndex(500).Address()
+	require.Equal(t, []SharedAccount{
+		{Address: sender, Sources: []int{0, 1, 2}},
+		{Address: receiver, Sources: []int{0, 1}},
+		{Address: other, Sources: []int{2, 3}},
+	}, report.Accounts[:3])
+	require.Contains(t, report.Accounts, SharedAccount{Address: appAddr, Sources: []int{2}})
+	require.Equal(t, []SharedAsset{{Asset: 10, Sources: []int{1, 2}}}, report.Assets)
+	require.Equal(t, []SharedApp{{App: 500, Sources: []int{2}}}, report.Apps)
+	require.Contains(t, report.Holdings, SharedHolding{Address: sender, Asset: 10, Sources: []int{1, 2}})
+	require.Contains(t, report.Holdings, SharedHolding{Address: receiver, Asset: 10, Sources: []int{1}})
+	require.Contains(t, report.Holdings, SharedHolding{Address: other, Asset: 10, Sources: []int{2}})
+	require.Contains(t, report.Locals, SharedLocal{Address: other, App: 500, Sources: []int{2}})
+	// the receiver is only named by transactions which don't name the app
+	require.NotContains(t, report.Locals, SharedLocal{Address: receiver, App: 500, Sources: []int{1}})
+	require.Len(t, report.Locals, 3)
+	require.Equal(t, []SharedBox{{App: 500, Name: "box", Sources: []int{2}}}, report.Boxes)
+	require.Equal(t, []int{3}, report.AppCreations)
+	require.Empty(t, report.AssetCreations)
+	require.Equal(t, []ProgramResources{
+		{GroupIndex: 2, App: 500, Version: 9, Shared: true},
+		{GroupIndex: 3, Version: 8, Shared: false},
+	}, report.Programs)
+
+	_, err = AnalyzeGroupResources(txgroup, func(basics.AppIndex) (uint64, error) {
+		return 0, errors.New("application does not exist")
+	})
+	require.ErrorContains(t, err, "transaction 2: application does not exist")
+}
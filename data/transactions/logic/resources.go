@@ -59,6 +59,17 @@ type resources struct {
 	dirtyBytes uint64
 }
 
+func makeResources() *resources {
+	return &resources{
+		sharedAccounts: make(map[basics.Address]struct{}),
+		sharedAsas:     make(map[basics.AssetIndex]struct{}),
+		sharedApps:     make(map[basics.AppIndex]struct{}),
+		sharedHoldings: make(map[ledgercore.AccountAsset]struct{}),
+		sharedLocals:   make(map[ledgercore.AccountApp]struct{}),
+		boxes:          make(map[BoxRef]bool),
+	}
+}
+
 func (r *resources) shareHolding(addr basics.Address, id basics.AssetIndex) {
 	r.sharedHoldings[ledgercore.AccountAsset{Address: addr, Asset: id}] = struct{}{}
 }
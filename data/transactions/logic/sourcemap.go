@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -92,3 +93,77 @@ func MakeSourceMapLine(tcol, sindex, sline, scol int) string {
 	intToVLQ(scol, buf)
 	return buf.String()
 }
+
+// SourceLocation is the position in the source files of a program of the opcode at a PC.
+type SourceLocation struct {
+	// Source is the index of the source file in the Sources of the source map.
+	Source int
+	// Line and Column start at 0.
+	Line   int
+	Column int
+}
+
+// Locations decodes the mappings of the source map into the source location of the opcodes,
+// indexed by their PC. The PCs without a mapping are left out.
+func (s SourceMap) Locations() (map[int]SourceLocation, error) {
+	locations := make(map[int]SourceLocation)
+	// the source index, line and column fields are relative to the previous segment of the mappings
+	var fields [4]int
+	for pc, line := range strings.Split(s.Mappings, ";") {
+		if line == "" {
+			continue
+		}
+		fields[0] = 0 // the generated column is relative to the previous segment of the same line
+		for i, segment := range strings.Split(line, ",") {
+			values, err := vlqDecode(segment)
+			if err != nil {
+				return nil, fmt.Errorf("pc %d: %w", pc, err)
+			}
+			if len(values) != 1 && len(values) != 4 && len(values) != 5 {
+				return nil, fmt.Errorf("pc %d: segment %s has %d fields", pc, segment, len(values))
+			}
+			for j := 0; j < len(values) && j < len(fields); j++ {
+				fields[j] += values[j]
+			}
+			if i > 0 || len(values) == 1 {
+				// TEAL has one opcode per PC, the first segment of the line locates it
+				continue
+			}
+			if fields[1] < 0 || fields[1] >= len(s.Sources) {
+				return nil, fmt.Errorf("pc %d: source index %d out of range", pc, fields[1])
+			}
+			locations[pc] = SourceLocation{Source: fields[1], Line: fields[2], Column: fields[3]}
+		}
+	}
+	return locations, nil
+}
+
+// vlqDecode decodes the base64 VLQ values of a segment of source map mappings.
+func vlqDecode(segment string) ([]int, error) {
+	var values []int
+	value, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q in segment %s", segment[i], segment)
+		}
+		value |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			if shift > 30 {
+				return nil, fmt.Errorf("value too large in segment %s", segment)
+			}
+			continue
+		}
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated segment %s", segment)
+	}
+	return values, nil
+}
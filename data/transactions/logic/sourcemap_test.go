@@ -66,3 +66,35 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
 }
+
+func TestSourceMapLocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	offsetToLine := map[int]int{1: 1, 2: 2, 5: 3, 6: 600, 9: 2}
+	locations, err := GetSourceMap([]string{"test.teal"}, offsetToLine).Locations()
+	a.NoError(err)
+	a.Len(locations, len(offsetToLine))
+	for pc, line := range offsetToLine {
+		a.Equal(SourceLocation{Source: 0, Line: line}, locations[pc])
+	}
+
+	// several sources, columns and segments per line
+	sm := SourceMap{
+		Version:  sourceMapVersion,
+		Sources:  []string{"a.teal", "b.teal"},
+		Mappings: ";" + MakeSourceMapLine(0, 0, 4, 2) + "," + MakeSourceMapLine(3, 1, 7, 1) + ";" + MakeSourceMapLine(0, 0, -1, 3),
+	}
+	locations, err = sm.Locations()
+	a.NoError(err)
+	a.Equal(map[int]SourceLocation{
+		1: {Source: 0, Line: 4, Column: 2},
+		2: {Source: 1, Line: 10, Column: 6},
+	}, locations)
+
+	for _, mappings := range []string{"A!AA", "AAA", "AAAg", "AEAA"} {
+		_, err = SourceMap{Sources: []string{"a.teal", "b.teal"}, Mappings: mappings}.Locations()
+		a.Error(err, mappings)
+	}
+}
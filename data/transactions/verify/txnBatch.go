@@ -178,7 +178,7 @@ func (tbp *txnSigBatchProcessor) preProcessUnverifiedTxns(uTxns []execpool.Input
 
 	for i := range uTxns {
 		ut := uTxns[i].(*UnverifiedTxnSigJob)
-		groupCtx, err := txnGroupBatchPrep(ut.TxnGroup, blockHeader, tbp.ledger, batchVerifier, nil)
+		groupCtx, err := txnGroupBatchPrep(ut.TxnGroup, blockHeader, tbp.ledger, batchVerifier, nil, true)
 		if err != nil {
 			// verification failed, no need to add the sig to the batch, report the error
 			tbp.sendResult(ut.TxnGroup, ut.BacklogMessage, err)
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
@@ -41,6 +42,7 @@ import (
 )
 
 var transactionMessagesHandled = metrics.MakeCounter(metrics.TransactionMessagesHandled)
+var transactionMessagesRelayed = metrics.MakeCounter(metrics.TransactionMessagesRelayed)
 var transactionMessagesDroppedFromBacklog = metrics.MakeCounter(metrics.TransactionMessagesDroppedFromBacklog)
 var transactionMessagesDroppedFromPool = metrics.MakeCounter(metrics.TransactionMessagesDroppedFromPool)
 var transactionMessagesAlreadyCommitted = metrics.MakeCounter(metrics.TransactionMessagesAlreadyCommitted)
@@ -62,6 +64,9 @@ var transactionGroupTxSyncHandled = metrics.MakeCounter(metrics.TransactionGroup
 var transactionGroupTxSyncRemember = metrics.MakeCounter(metrics.TransactionGroupTxSyncRemember)
 var transactionGroupTxSyncAlreadyCommitted = metrics.MakeCounter(metrics.TransactionGroupTxSyncAlreadyCommitted)
 var txBacklogDroppedCongestionManagement = metrics.MakeCounter(metrics.TransactionMessagesTxnDroppedCongestionManagement)
+var transactionMessagesRelayPolicyDropped = metrics.MakeCounter(metrics.TransactionMessagesRelayPolicyDropped)
+var transactionMessagesRelayPolicyNotRelayed = metrics.MakeCounter(metrics.TransactionMessagesRelayPolicyNotRelayed)
+var transactionMessagesRelayPaused = metrics.MakeCounter(metrics.TransactionMessagesRelayPaused)
 
 // ErrInvalidTxPool is reported when nil is passed for the tx pool
 var ErrInvalidTxPool = errors.New("MakeTxHandler: txPool is nil on initialization")
@@ -107,6 +112,7 @@ type txBacklogMsg struct {
 	unverifiedTxGroupHash *crypto.Digest           // hash (if any) of the unverifiedTxGroup
 	verificationErr       error                    // The verification error generated by the verification function, if any.
 	capguard              *util.ErlCapacityGuard   // the structure returned from the elastic rate limiter, to be released when dequeued
+	relayAction           RelayPolicyAction        // the action of the relay policy for the unverifiedTxGroup
 }
 
 // TxHandler handles transaction messages
@@ -128,6 +134,10 @@ type TxHandler struct {
 	streamVerifierChan    chan execpool.InputJob
 	streamVerifierDropped chan *verify.UnverifiedTxnSigJob
 	erl                   *util.ElasticRateLimiter
+	relayPolicy           *RelayPolicy
+	relayPaused           atomic.Bool
+	relayFilter           *txRelayFilter
+	relayFilterInterval   time.Duration
 }
 
 // TxHandlerOpts is TxHandler configuration options
@@ -139,6 +149,8 @@ type TxHandlerOpts struct {
 	GenesisID     string
 	GenesisHash   crypto.Digest
 	Config        config.Local
+	// RelayPolicy, if set, drops or deprioritizes some of the transaction groups received from the network.
+	RelayPolicy *RelayPolicy
 }
 
 // MakeTxHandler makes a new handler for transaction messages
@@ -169,6 +181,7 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 		net:                   opts.Net,
 		streamVerifierChan:    make(chan execpool.InputJob),
 		streamVerifierDropped: make(chan *verify.UnverifiedTxnSigJob),
+		relayPolicy:           opts.RelayPolicy,
 	}
 
 	if opts.Config.TxFilterRawMsgEnabled() {
@@ -177,6 +190,10 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 	if opts.Config.TxFilterCanonicalEnabled() {
 		handler.txCanonicalCache = makeDigestCache(int(opts.Config.TxIncomingFilterMaxSize))
 	}
+	if opts.Config.TxRelayFilterExchangeInterval > 0 {
+		handler.relayFilter = &txRelayFilter{}
+		handler.relayFilterInterval = opts.Config.TxRelayFilterExchangeInterval
+	}
 
 	if opts.Config.EnableTxBacklogRateLimiting {
 		rateLimiter := util.NewElasticRateLimiter(
@@ -225,6 +242,14 @@ func (handler *TxHandler) Start() {
 	handler.backlogWg.Add(2)
 	go handler.backlogWorker()
 	go handler.backlogGaugeThread()
+	if handler.relayPolicy != nil {
+		handler.backlogWg.Add(1)
+		go handler.relayPolicyReloadThread()
+	}
+	if handler.relayFilter != nil {
+		handler.backlogWg.Add(1)
+		go handler.relayFilterThread()
+	}
 	handler.streamVerifier.Start(handler.ctx)
 	if handler.erl != nil {
 		handler.erl.Start()
@@ -266,6 +291,54 @@ func (handler *TxHandler) backlogGaugeThread() {
 	}
 }
 
+// relayPolicyReloadThread reloads the relay policy whenever its file changes.
+func (handler *TxHandler) relayPolicyReloadThread() {
+	defer handler.backlogWg.Done()
+	ticker := time.NewTicker(relayPolicyReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			changed, err := handler.relayPolicy.reload()
+			if err != nil {
+				logging.Base().Warnf("Unable to reload relay policy %s, keeping the current rules: %v", handler.relayPolicy.path, err)
+			} else if changed {
+				logging.Base().Infof("Reloaded relay policy %s with %d rules", handler.relayPolicy.path, len(handler.relayPolicy.Rules()))
+			}
+		case <-handler.ctx.Done():
+			return
+		}
+	}
+}
+
+// relayFilterThread periodically sends the peers a filter of the transaction groups received recently,
+// so that they do not relay these groups back.
+func (handler *TxHandler) relayFilterThread() {
+	defer handler.backlogWg.Done()
+	ticker := time.NewTicker(handler.relayFilterInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			keys := handler.relayFilter.rotate()
+			if len(keys) == 0 {
+				continue
+			}
+			data, err := network.EncodeTxnFilter(keys)
+			if err != nil {
+				logging.Base().Warnf("Unable to encode the transaction relay filter: %v", err)
+				continue
+			}
+			err = handler.net.Broadcast(handler.ctx, protocol.TxnFilterTag, data, false, nil)
+			if err != nil {
+				logging.Base().Debugf("Unable to broadcast the transaction relay filter: %v", err)
+			}
+		case <-handler.ctx.Done():
+			return
+		}
+	}
+}
+
 // backlogWorker is the worker go routine that process the incoming messages from the postVerificationQueue and backlogQueue channels
 // and dispatches them further.
 func (handler *TxHandler) backlogWorker() {
@@ -493,8 +566,20 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 		logging.Base().Infof("unable to pin transaction: %v", err)
 	}
 
+	// deprioritized groups are only relayed while the backlog has room to spare
+	if wi.relayAction == RelayPolicyDeprioritize && 2*len(handler.backlogQueue) >= cap(handler.backlogQueue) {
+		transactionMessagesRelayPolicyNotRelayed.Inc(nil)
+		return
+	}
+
+	if handler.relayPaused.Load() {
+		transactionMessagesRelayPaused.Inc(nil)
+		return
+	}
+
 	// We reencode here instead of using rawmsg.Data to avoid broadcasting non-canonical encodings
 	handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	transactionMessagesRelayed.Inc(nil)
 }
 
 func (handler *TxHandler) deleteFromCaches(msgKey *crypto.Digest, canonicalKey *crypto.Digest) {
@@ -640,6 +725,26 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		}
 	}
 
+	if handler.relayFilter != nil {
+		handler.relayFilter.add(reencode(unverifiedTxGroup))
+	}
+
+	var relayAction RelayPolicyAction
+	if handler.relayPolicy != nil {
+		var rule string
+		relayAction, rule = handler.relayPolicy.Classify(unverifiedTxGroup)
+		if relayAction == RelayPolicyDrop {
+			transactionMessagesRelayPolicyDropped.Inc(nil)
+			logging.Base().Debugf("relay policy rule %q dropped a tx group", rule)
+			if capguard != nil {
+				if err := capguard.Release(); err != nil {
+					logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
+				}
+			}
+			return network.OutgoingMessage{Action: network.Ignore}
+		}
+	}
+
 	select {
 	case handler.backlogQueue <- &txBacklogMsg{
 		rawmsg:                &rawmsg,
@@ -647,6 +752,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		rawmsgDataHash:        msgKey,
 		unverifiedTxGroupHash: canonicalKey,
 		capguard:              capguard,
+		relayAction:           relayAction,
 	}:
 	default:
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
@@ -686,6 +792,17 @@ func (handler *TxHandler) LocalTransaction(txgroup []transactions.SignedTxn) err
 	return nil
 }
 
+// PauseRelay stops the handler from relaying the transactions received from the network to its peers when paused
+// is set, and lets it relay them again otherwise. The transactions are still verified and added to the pool.
+func (handler *TxHandler) PauseRelay(paused bool) {
+	handler.relayPaused.Store(paused)
+}
+
+// RelayPaused tells whether the handler was stopped from relaying the transactions received from the network.
+func (handler *TxHandler) RelayPaused() bool {
+	return handler.relayPaused.Load()
+}
+
 // checkAlreadyCommitted test to see if the given transaction ( in the txBacklogMsg ) was already committed, and
 // whether it would qualify as a candidate for the transaction pool.
 //
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/network"
+)
+
+// txRelayFilter collects the transaction groups received from the network, so that they can be
+// advertised to peers in a filter of recently seen transactions, see network.EncodeTxnFilter.
+// It keeps the groups received over the current and the previous exchange intervals.
+type txRelayFilter struct {
+	mu       deadlock.Mutex
+	current  []crypto.Digest
+	previous []crypto.Digest
+}
+
+// add records a transaction group, given as its canonical encoding, which is also the payload
+// peers relay it with.
+func (f *txRelayFilter) add(encodedGroup []byte) {
+	key := network.TxnFilterKey(encodedGroup)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.current) < network.MaxTxnFilterEntries {
+		f.current = append(f.current, key)
+	}
+}
+
+// rotate starts a new exchange interval and returns the keys of the groups received over the
+// last two intervals, oldest first.
+func (f *txRelayFilter) rotate() []crypto.Digest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]crypto.Digest, 0, len(f.previous)+len(f.current))
+	keys = append(keys, f.previous...)
+	keys = append(keys, f.current...)
+	f.previous, f.current = f.current, make([]crypto.Digest, 0, len(f.current))
+	return keys
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxRelayFilterRotate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	group := func(fv basics.Round) []byte {
+		return reencode([]transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: transactions.Header{FirstValid: fv},
+		}}})
+	}
+
+	var f txRelayFilter
+	require.Empty(t, f.rotate())
+
+	f.add(group(1))
+	f.add(group(2))
+	keys := f.rotate()
+	// the keys are those the network checks the relayed payload against
+	require.Equal(t, []crypto.Digest{network.TxnFilterKey(group(1)), network.TxnFilterKey(group(2))}, keys)
+
+	// the groups of the previous interval are advertised once more
+	f.add(group(3))
+	keys = f.rotate()
+	require.Equal(t, []crypto.Digest{network.TxnFilterKey(group(1)), network.TxnFilterKey(group(2)), network.TxnFilterKey(group(3))}, keys)
+	keys = f.rotate()
+	require.Equal(t, []crypto.Digest{network.TxnFilterKey(group(3))}, keys)
+	require.Empty(t, f.rotate())
+}
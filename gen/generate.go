@@ -93,13 +93,26 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 		return
 	}
 
+	importedMoney, err := validateImportedAccounts(genesisData.ImportedAccounts, genesisData, consensusParams.MinBalance)
+	if err != nil {
+		return
+	}
+	// the wallets stakes are percentages of the money not held by the imported accounts
+	walletsMoney := TotalMoney - importedMoney
+	if len(genesisData.Wallets) == 0 {
+		if walletsMoney != 0 {
+			err = fmt.Errorf("imported accounts hold %d of the total money %d and there are no wallets to hold the rest", importedMoney, TotalMoney)
+		}
+		return
+	}
+
 	var sum uint64
 	allocation = make([]genesisAllocation, len(genesisData.Wallets))
 
 	for i, wallet := range genesisData.Wallets {
 		acct := genesisAllocation{
 			Name:   wallet.Name,
-			Stake:  uint64(float64(TotalMoney/100)*wallet.Stake + .5),
+			Stake:  uint64(float64(walletsMoney)/100*wallet.Stake + .5),
 			Online: basics.Online,
 		}
 		if !wallet.Online {
@@ -109,17 +122,17 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 		sum += acct.Stake
 	}
 
-	if sum != TotalMoney {
+	if sum != walletsMoney {
 		fsum := float64(sum)
-		ftot := float64(TotalMoney)
-		if (math.Abs((fsum-ftot)/ftot) < 0.01) && (u64absDiff(sum, TotalMoney) < 10000) {
+		ftot := float64(walletsMoney)
+		if (math.Abs((fsum-ftot)/ftot) < 0.01) && (u64absDiff(sum, walletsMoney) < 10000) {
 			if verboseOut != nil {
-				fmt.Fprintf(verboseOut, "doing roundoff fixup expected total money %d actual sum %d\n", TotalMoney, sum)
+				fmt.Fprintf(verboseOut, "doing roundoff fixup expected total money %d actual sum %d\n", walletsMoney, sum)
 			}
 			// wallet stake is a float and roundoff might happen but we might be close enough to do fixup
 			i := 0
-			for sum != TotalMoney {
-				if sum < TotalMoney {
+			for sum != walletsMoney {
+				if sum < walletsMoney {
 					allocation[i].Stake++
 					sum++
 				} else {
@@ -131,7 +144,7 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 				i = (i + 1) % len(allocation)
 			}
 		} else {
-			panic(fmt.Sprintf("Amounts don't add up to TotalMoney - off by %v", int64(TotalMoney)-int64(sum)))
+			panic(fmt.Sprintf("Amounts don't add up to TotalMoney - off by %v", int64(walletsMoney)-int64(sum)))
 		}
 	}
 	return
@@ -279,14 +292,7 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 			data.Status = wallet.Online
 			data.MicroAlgos.Raw = wallet.Stake
 			if wallet.Online == basics.Online {
-				data.VoteID = part.VotingSecrets().OneTimeSignatureVerifier
-				data.SelectionID = part.VRFSecrets().PK
-				data.VoteFirstValid = part.FirstValid
-				data.VoteLastValid = part.LastValid
-				data.VoteKeyDilution = part.KeyDilution
-				if protoParams.EnableStateProofKeyregCheck {
-					data.StateProofID = part.StateProofVerifier().Commitment
-				}
+				setParticipationKeys(&data, part, protoParams)
 			}
 
 			writeMu.Lock()
@@ -389,6 +395,15 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		})
 	}
 
+	for _, acct := range genData.ImportedAccounts {
+		var alloc bookkeeping.GenesisAllocation
+		alloc, err = importedGenesisAllocation(acct, protoParams)
+		if err != nil {
+			return err
+		}
+		g.Allocation = append(g.Allocation, alloc)
+	}
+
 	jsonData := protocol.EncodeJSON(g)
 	err = os.WriteFile(filepath.Join(outDir, config.GenesisJSONFile), append(jsonData, '\n'), 0666)
 
@@ -400,6 +415,44 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 	return
 }
 
+// setParticipationKeys registers the voting keys of a participation key in the genesis data of an online account.
+func setParticipationKeys(data *bookkeeping.GenesisAccountData, part account.PersistedParticipation, protoParams config.ConsensusParams) {
+	data.VoteID = part.VotingSecrets().OneTimeSignatureVerifier
+	data.SelectionID = part.VRFSecrets().PK
+	data.VoteFirstValid = part.FirstValid
+	data.VoteLastValid = part.LastValid
+	data.VoteKeyDilution = part.KeyDilution
+	if protoParams.EnableStateProofKeyregCheck {
+		data.StateProofID = part.StateProofVerifier().Commitment
+	}
+}
+
+// importedGenesisAllocation makes the genesis allocation of an imported account, reading the
+// voting keys of online accounts from their participation key file.
+func importedGenesisAllocation(acct ImportedAccount, protoParams config.ConsensusParams) (bookkeeping.GenesisAllocation, error) {
+	data := bookkeeping.GenesisAccountData{
+		Status:     basics.Offline,
+		MicroAlgos: basics.MicroAlgos{Raw: acct.MicroAlgos},
+	}
+	if acct.Online {
+		part, partDB, err := loadPartKeys(acct.PartKeyFile)
+		if err != nil {
+			return bookkeeping.GenesisAllocation{}, fmt.Errorf("imported account %s: participation key file %s: %w", acct.Address, acct.PartKeyFile, err)
+		}
+		defer partDB.Close()
+		if part.Parent.String() != acct.Address {
+			return bookkeeping.GenesisAllocation{}, fmt.Errorf("imported account %s: participation key file %s belongs to %s", acct.Address, acct.PartKeyFile, part.Parent)
+		}
+		data.Status = basics.Online
+		setParticipationKeys(&data, part, protoParams)
+	}
+	return bookkeeping.GenesisAllocation{
+		Address: acct.Address,
+		Comment: acct.Comment,
+		State:   data,
+	}, nil
+}
+
 // If err != nil, rootDB needs to be closed.
 func loadRootKey(filename string) (root account.Root, rootDB db.Accessor, err error) {
 	if !util.FileExists(filename) {
@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ImportedAccount represents an account with a known address and an explicit balance, imported
+// into a genesis.json file instead of being generated along with its keys.
+type ImportedAccount struct {
+	Address    string `json:"address"`
+	MicroAlgos uint64 `json:"microalgos"`
+	Online     bool   `json:"online,omitempty"`
+	// PartKeyFile is the participation key file of an online account. Its voting keys are
+	// registered in the genesis, and it has to belong to the account.
+	PartKeyFile string `json:"partkey,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// The columns of an accounts manifest in CSV format. The first row of the file names the columns,
+// in any order; the address and microalgos columns are required.
+const (
+	manifestAddressColumn    = "address"
+	manifestMicroAlgosColumn = "microalgos"
+	manifestOnlineColumn     = "online"
+	manifestPartKeyColumn    = "partkey"
+	manifestCommentColumn    = "comment"
+)
+
+// LoadAccountsManifest loads the accounts to import into a genesis from a manifest file, which is
+// a JSON array of accounts if the file has a .json extension, and a CSV file otherwise. Relative
+// participation key file paths are resolved against the directory of the manifest.
+func LoadAccountsManifest(file string) (accounts []ImportedAccount, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		err = json.NewDecoder(f).Decode(&accounts)
+	} else {
+		accounts, err = readAccountsCSV(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse accounts manifest %s: %w", file, err)
+	}
+
+	for i := range accounts {
+		if accounts[i].PartKeyFile != "" && !filepath.IsAbs(accounts[i].PartKeyFile) {
+			accounts[i].PartKeyFile = filepath.Join(filepath.Dir(file), accounts[i].PartKeyFile)
+		}
+	}
+	return accounts, nil
+}
+
+func readAccountsCSV(r io.Reader) ([]ImportedAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{manifestAddressColumn, manifestMicroAlgosColumn} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing %s column", required)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var accounts []ImportedAccount
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		acct := ImportedAccount{
+			Address:     field(record, manifestAddressColumn),
+			PartKeyFile: field(record, manifestPartKeyColumn),
+			Comment:     field(record, manifestCommentColumn),
+		}
+		acct.MicroAlgos, err = strconv.ParseUint(field(record, manifestMicroAlgosColumn), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid %s: %w", line, manifestMicroAlgosColumn, err)
+		}
+		if online := field(record, manifestOnlineColumn); online != "" {
+			acct.Online, err = strconv.ParseBool(online)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s: %w", line, manifestOnlineColumn, err)
+			}
+		}
+		accounts = append(accounts, acct)
+	}
+	return accounts, nil
+}
+
+// validateImportedAccounts checks the imported accounts are well formed and returns the money they
+// hold in total.
+func validateImportedAccounts(accounts []ImportedAccount, genesisData *GenesisData, minBalance uint64) (total uint64, err error) {
+	seen := make(map[basics.Address]bool, len(accounts))
+	for i, acct := range accounts {
+		addr, err := basics.UnmarshalChecksumAddress(acct.Address)
+		if err != nil {
+			return 0, fmt.Errorf("imported account %d: invalid address %s: %w", i, acct.Address, err)
+		}
+		if addr == genesisData.FeeSink || addr == genesisData.RewardsPool {
+			return 0, fmt.Errorf("imported account %s is a special account", acct.Address)
+		}
+		if seen[addr] {
+			return 0, fmt.Errorf("imported account %s appears more than once", acct.Address)
+		}
+		seen[addr] = true
+
+		if acct.MicroAlgos < minBalance {
+			return 0, fmt.Errorf("imported account %s balance %d is below the minimum balance %d", acct.Address, acct.MicroAlgos, minBalance)
+		}
+		if acct.Online && acct.PartKeyFile == "" {
+			return 0, fmt.Errorf("imported account %s is online but has no participation key file", acct.Address)
+		}
+		if !acct.Online && acct.PartKeyFile != "" {
+			return 0, fmt.Errorf("imported account %s is offline but has a participation key file", acct.Address)
+		}
+
+		if acct.MicroAlgos > TotalMoney-total {
+			return 0, fmt.Errorf("imported accounts hold more than the total money %d", TotalMoney)
+		}
+		total += acct.MicroAlgos
+	}
+	return total, nil
+}
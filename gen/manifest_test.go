@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestLoadAccountsManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	addr1 := basics.Address(crypto.Hash([]byte("first"))).String()
+	addr2 := basics.Address(crypto.Hash([]byte("second"))).String()
+
+	csvFile := filepath.Join(dir, "accounts.csv")
+	csvData := fmt.Sprintf("online, address, microalgos, partkey\ntrue, %s, 1000000, keys/first.partkey\n, %s, 2000000,\n", addr1, addr2)
+	require.NoError(t, os.WriteFile(csvFile, []byte(csvData), 0600))
+	expected := []ImportedAccount{
+		{Address: addr1, MicroAlgos: 1000000, Online: true, PartKeyFile: filepath.Join(dir, "keys", "first.partkey")},
+		{Address: addr2, MicroAlgos: 2000000},
+	}
+	accounts, err := LoadAccountsManifest(csvFile)
+	require.NoError(t, err)
+	require.Equal(t, expected, accounts)
+
+	jsonFile := filepath.Join(dir, "accounts.json")
+	jsonData := fmt.Sprintf(`[{"address": "%s", "microalgos": 1000000, "online": true, "partkey": "keys/first.partkey"}, {"address": "%s", "microalgos": 2000000}]`, addr1, addr2)
+	require.NoError(t, os.WriteFile(jsonFile, []byte(jsonData), 0600))
+	accounts, err = LoadAccountsManifest(jsonFile)
+	require.NoError(t, err)
+	require.Equal(t, expected, accounts)
+
+	require.NoError(t, os.WriteFile(csvFile, []byte("address,online\n"), 0600))
+	_, err = LoadAccountsManifest(csvFile)
+	require.ErrorContains(t, err, "missing microalgos column")
+
+	require.NoError(t, os.WriteFile(csvFile, []byte(fmt.Sprintf("address,microalgos\n%s,lots\n", addr1)), 0600))
+	_, err = LoadAccountsManifest(csvFile)
+	require.ErrorContains(t, err, "line 2: invalid microalgos")
+}
+
+func TestImportedAccountsValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address(crypto.Hash([]byte("imported"))).String()
+	wallets := []WalletData{{Name: "w1", Stake: 50}, {Name: "w2", Stake: 50}}
+	setup := func(wallets []WalletData, accounts ...ImportedAccount) ([]genesisAllocation, error) {
+		genesisData := DefaultGenesis
+		genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+		genesisData.Wallets = wallets
+		genesisData.ImportedAccounts = accounts
+		_, _, allocation, err := setupGenerateGenesisFiles(&genesisData, config.Consensus, nil)
+		return allocation, err
+	}
+
+	// the wallets share the money the imported accounts do not hold
+	allocation, err := setup(wallets, ImportedAccount{Address: addr, MicroAlgos: TotalMoney / 2})
+	require.NoError(t, err)
+	require.Equal(t, TotalMoney/4, allocation[0].Stake)
+	require.Equal(t, TotalMoney/4, allocation[1].Stake)
+
+	_, err = setup(nil, ImportedAccount{Address: addr, MicroAlgos: TotalMoney})
+	require.NoError(t, err)
+	_, err = setup(nil, ImportedAccount{Address: addr, MicroAlgos: TotalMoney / 2})
+	require.ErrorContains(t, err, "no wallets to hold the rest")
+
+	_, err = setup(wallets, ImportedAccount{Address: addr, MicroAlgos: TotalMoney}, ImportedAccount{Address: defaultSinkAddr.String(), MicroAlgos: 1e6})
+	require.ErrorContains(t, err, "special account")
+	_, err = setup(wallets, ImportedAccount{Address: addr, MicroAlgos: TotalMoney}, ImportedAccount{Address: addr, MicroAlgos: 1e6})
+	require.ErrorContains(t, err, "more than once")
+	_, err = setup(wallets, ImportedAccount{Address: addr, MicroAlgos: TotalMoney + 1})
+	require.ErrorContains(t, err, "more than the total money")
+	_, err = setup(wallets, ImportedAccount{Address: addr, MicroAlgos: 1})
+	require.ErrorContains(t, err, "below the minimum balance")
+	_, err = setup(wallets, ImportedAccount{Address: addr, MicroAlgos: 1e6, Online: true})
+	require.ErrorContains(t, err, "no participation key file")
+	_, err = setup(wallets, ImportedAccount{Address: "not an address", MicroAlgos: 1e6})
+	require.ErrorContains(t, err, "invalid address")
+}
+
+func TestGenesisWithImportedAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	outDir := t.TempDir()
+	onlineAddr := basics.Address(crypto.Hash([]byte("online")))
+	offlineAddr := basics.Address(crypto.Hash([]byte("offline")))
+
+	partKeyFile := filepath.Join(t.TempDir(), "online.partkey")
+	partDB, err := db.MakeErasableAccessor(partKeyFile)
+	require.NoError(t, err)
+	part, err := account.FillDBWithParticipationKeys(partDB, onlineAddr, 0, 100, 10)
+	require.NoError(t, err)
+	partDB.Close()
+
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "imported"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.ImportedAccounts = []ImportedAccount{
+		{Address: onlineAddr.String(), MicroAlgos: TotalMoney - 1e6, Online: true, PartKeyFile: partKeyFile, Comment: "online"},
+		{Address: offlineAddr.String(), MicroAlgos: 1e6},
+	}
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	// the special accounts are followed by the imported accounts
+	require.Len(t, genesis.Allocation, 4)
+	online := genesis.Allocation[2]
+	require.Equal(t, onlineAddr.String(), online.Address)
+	require.Equal(t, "online", online.Comment)
+	require.Equal(t, basics.Online, online.State.Status)
+	require.Equal(t, TotalMoney-1e6, online.State.MicroAlgos.Raw)
+	require.Equal(t, part.VotingSecrets().OneTimeSignatureVerifier, online.State.VoteID)
+	require.Equal(t, part.VRFSecrets().PK, online.State.SelectionID)
+	offline := genesis.Allocation[3]
+	require.Equal(t, offlineAddr.String(), offline.Address)
+	require.Equal(t, basics.Offline, offline.State.Status)
+
+	// the participation key has to belong to the account
+	genesisData.ImportedAccounts[0].Address, genesisData.ImportedAccounts[1].Address = offlineAddr.String(), onlineAddr.String()
+	err = GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil)
+	require.ErrorContains(t, err, "belongs to")
+}
@@ -53,6 +53,8 @@ type GenesisData struct {
 	RewardsPoolBalance uint64 // Values < `ConsensusParams.MinBalance` are adjusted to `ConsensusParams.MinBalance`
 	DevMode            bool
 	Comment            string
+	// ImportedAccounts are added to the genesis with their balance, and the wallets share the money left.
+	ImportedAccounts []ImportedAccount `json:",omitempty"`
 }
 
 // LoadGenesisData loads a GenesisData structure from a json file
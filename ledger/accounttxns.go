@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// ErrAccountTxnIndexDisabled is returned when querying the account transaction
+// index on a ledger that was not configured with EnableAccountTxnIndex.
+var ErrAccountTxnIndexDisabled = errors.New("account transaction index is not enabled")
+
+// accountTxnIndexTracker indexes the IDs of the transactions touching each
+// account. The entries of the rounds not committed yet are held in memory; they
+// are written to the tracker database along with the accounts of the same rounds,
+// and the entries older than the configured retention are pruned. The index covers
+// the rounds following the one it was enabled at; it restarts from scratch if the
+// ledger moves past the rounds it covers without it, e.g. after it was disabled or
+// a fast catchup.
+type accountTxnIndexTracker struct {
+	enabled   bool
+	retention basics.Round
+
+	dbs trackerdb.Store
+	log logging.Logger
+
+	mu      deadlock.RWMutex
+	since   basics.Round
+	dbRound basics.Round
+	// deltas holds the entries of the rounds following dbRound, one slice per round.
+	deltas [][]trackerdb.AccountTxnEntry
+}
+
+func (at *accountTxnIndexTracker) initialize(cfg config.Local) {
+	at.enabled = cfg.EnableAccountTxnIndex
+	at.retention = basics.Round(cfg.AccountTxnIndexRounds)
+}
+
+func (at *accountTxnIndexTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	at.mu.Lock()
+	defer at.mu.Unlock()
+
+	at.since = 0
+	at.dbRound = dbRound
+	at.deltas = nil
+	if !at.enabled {
+		return nil
+	}
+
+	at.dbs = l.trackerDB()
+	at.log = l.trackerLog()
+	err := at.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		since, through, err := tx.MakeAccountTxnIndexReader().AccountTxnIndexRange(ctx)
+		if err != nil && !errors.Is(err, trackerdb.ErrNotFound) {
+			return err
+		}
+		// the index is written along with the accounts, so it can only be behind them
+		// if it was disabled for a while.
+		if err != nil || through != dbRound {
+			since = dbRound + 1
+			err = tx.MakeAccountTxnIndexWriter().ResetAccountTxnIndex(ctx, since)
+			if err != nil {
+				return err
+			}
+		}
+		at.since = since
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("accountTxnIndexTracker: unable to load the account transaction index : %w", err)
+	}
+	return nil
+}
+
+func (at *accountTxnIndexTracker) close() {
+}
+
+func (at *accountTxnIndexTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !at.enabled {
+		return
+	}
+
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		at.log.Warnf("accountTxnIndexTracker: unable to decode the payset of round %d : %v", blk.Round(), err)
+	}
+
+	var entries []trackerdb.AccountTxnEntry
+	for intra, stxn := range payset {
+		txid := stxn.ID()
+		addrs := make(map[basics.Address]struct{})
+		txnAccounts(stxn, addrs)
+		for addr := range addrs {
+			entries = append(entries, trackerdb.AccountTxnEntry{Address: addr, Round: blk.Round(), Intra: uint64(intra), TxID: txid})
+		}
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.deltas = append(at.deltas, entries)
+}
+
+// txnAccounts adds to addrs the accounts touched by stxn and its inner transactions.
+// The fee sink is left out, since every transaction pays fees to it.
+func txnAccounts(stxn transactions.SignedTxnWithAD, addrs map[basics.Address]struct{}) {
+	for _, addr := range stxn.Txn.RelevantAddrs(transactions.SpecialAddresses{}) {
+		if !addr.IsZero() {
+			addrs[addr] = struct{}{}
+		}
+	}
+	for _, itxn := range stxn.ApplyData.EvalDelta.InnerTxns {
+		txnAccounts(itxn, addrs)
+	}
+}
+
+// lookup returns up to limit transactions of addr confirmed within [minRound, maxRound],
+// the most recent ones first. A limit of 0 returns all of them.
+func (at *accountTxnIndexTracker) lookup(addr basics.Address, minRound, maxRound basics.Round, limit uint64) (ledgercore.AccountTxns, error) {
+	if !at.enabled {
+		return ledgercore.AccountTxns{}, ErrAccountTxnIndexDisabled
+	}
+
+	var result ledgercore.AccountTxns
+	at.mu.RLock()
+	result.Since = at.since
+	dbRound := at.dbRound
+scan:
+	for i := len(at.deltas) - 1; i >= 0; i-- {
+		for j := len(at.deltas[i]) - 1; j >= 0; j-- {
+			entry := at.deltas[i][j]
+			if entry.Address != addr || entry.Round < minRound || entry.Round > maxRound {
+				continue
+			}
+			if limit > 0 && uint64(len(result.Txns)) == limit {
+				break scan
+			}
+			result.Txns = append(result.Txns, ledgercore.AccountTxn{Round: entry.Round, Intra: entry.Intra, TxID: entry.TxID})
+		}
+	}
+	at.mu.RUnlock()
+
+	// the rounds up to dbRound are in the database; the ones past it may be written
+	// there already, but are only read from memory until postCommit catches up.
+	if minRound > dbRound || (limit > 0 && uint64(len(result.Txns)) == limit) {
+		return result, nil
+	}
+	if maxRound > dbRound {
+		maxRound = dbRound
+	}
+	var remaining uint64
+	if limit > 0 {
+		remaining = limit - uint64(len(result.Txns))
+	}
+	var entries []trackerdb.AccountTxnEntry
+	err := at.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		entries, err = tx.MakeAccountTxnIndexReader().LookupAccountTxns(ctx, addr, minRound, maxRound, remaining)
+		return err
+	})
+	if err != nil {
+		return ledgercore.AccountTxns{}, err
+	}
+	for _, entry := range entries {
+		result.Txns = append(result.Txns, ledgercore.AccountTxn{Round: entry.Round, Intra: entry.Intra, TxID: entry.TxID})
+	}
+	return result, nil
+}
+
+func (at *accountTxnIndexTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+func (at *accountTxnIndexTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if !at.enabled {
+		return nil
+	}
+
+	at.mu.RLock()
+	defer at.mu.RUnlock()
+	if dcc.oldBase != at.dbRound || dcc.offset > uint64(len(at.deltas)) {
+		return fmt.Errorf("accountTxnIndexTracker: unable to commit rounds %d-%d, tracking rounds %d-%d", dcc.oldBase+1, dcc.newBase(), at.dbRound+1, at.dbRound+basics.Round(len(at.deltas)))
+	}
+
+	dcc.accountTxns.entries = nil
+	for _, entries := range at.deltas[:dcc.offset] {
+		dcc.accountTxns.entries = append(dcc.accountTxns.entries, entries...)
+	}
+	dcc.accountTxns.pruneBefore = 0
+	if at.retention > 0 && dcc.newBase() >= at.retention {
+		dcc.accountTxns.pruneBefore = dcc.newBase() - at.retention + 1
+	}
+	return nil
+}
+
+func (at *accountTxnIndexTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	if !at.enabled {
+		return nil
+	}
+
+	aw := tx.MakeAccountTxnIndexWriter()
+	err := aw.StoreAccountTxns(ctx, dcc.accountTxns.entries, dcc.newBase())
+	if err != nil {
+		return err
+	}
+	if dcc.accountTxns.pruneBefore > 0 {
+		err = aw.PruneAccountTxns(ctx, dcc.accountTxns.pruneBefore)
+	}
+	return err
+}
+
+func (at *accountTxnIndexTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	if !at.enabled {
+		return
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.deltas = at.deltas[dcc.offset:]
+	at.dbRound = dcc.newBase()
+	if at.since < dcc.accountTxns.pruneBefore {
+		at.since = dcc.accountTxns.pruneBefore
+	}
+}
+
+func (at *accountTxnIndexTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (at *accountTxnIndexTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (at *accountTxnIndexTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (at *accountTxnIndexTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (at *accountTxnIndexTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
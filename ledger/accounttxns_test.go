@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAccountTxnIndexTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	cfg.EnableAccountTxnIndex = true
+	cfg.AccountTxnIndexRounds = 3
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	addrs := make([]basics.Address, 5)
+	for i := range addrs {
+		addrs[i] = ledgertesting.RandomAddress()
+	}
+	pay := func(from, to basics.Address, note byte) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = protocol.PaymentTx
+		stxn.Txn.Sender = from
+		stxn.Txn.Receiver = to
+		stxn.Txn.Note = []byte{note}
+		stxn.Txn.GenesisHash = genesisInitState.GenesisHash
+		return stxn
+	}
+	newBlock := func(rnd basics.Round, txns ...transactions.SignedTxnWithAD) []transactions.Txid {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+		blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+		blk.BlockHeader.GenesisHash = genesisInitState.GenesisHash
+		var txids []transactions.Txid
+		for _, stxn := range txns {
+			stib, err := blk.EncodeSignedTxn(stxn.SignedTxn, stxn.ApplyData)
+			require.NoError(t, err)
+			blk.Payset = append(blk.Payset, stib)
+			txids = append(txids, stxn.ID())
+		}
+		l.accountTxns.newBlock(blk, ledgercore.StateDelta{})
+		return txids
+	}
+	commit := func(oldBase basics.Round, offset uint64) {
+		dcc := &deferredCommitContext{deferredCommitRange: deferredCommitRange{oldBase: oldBase, offset: offset}}
+		require.NoError(t, l.accountTxns.prepareCommit(dcc))
+		err := l.trackerDBs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return l.accountTxns.commitRound(ctx, tx, dcc)
+		})
+		require.NoError(t, err)
+		l.accountTxns.postCommit(context.Background(), dcc)
+	}
+	lookup := func(addr basics.Address, minRound, maxRound basics.Round, limit uint64) ledgercore.AccountTxns {
+		txns, err := l.accountTxns.lookup(addr, minRound, maxRound, limit)
+		require.NoError(t, err)
+		return txns
+	}
+
+	require.Equal(t, ledgercore.AccountTxns{Since: 1}, lookup(addrs[0], 0, 100, 0))
+
+	// an application call is indexed for the accounts of its inner transactions
+	appl := transactions.SignedTxnWithAD{}
+	appl.Txn.Type = protocol.ApplicationCallTx
+	appl.Txn.Sender = addrs[2]
+	appl.Txn.GenesisHash = genesisInitState.GenesisHash
+	appl.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{pay(basics.Address{}, addrs[3], 0)}
+	txids1 := newBlock(1, pay(addrs[0], addrs[1], 1), appl)
+	txids2 := newBlock(2, pay(addrs[1], addrs[0], 2))
+	txids3 := newBlock(3, pay(addrs[0], addrs[0], 3))
+
+	all := []ledgercore.AccountTxn{
+		{Round: 3, Intra: 0, TxID: txids3[0]},
+		{Round: 2, Intra: 0, TxID: txids2[0]},
+		{Round: 1, Intra: 0, TxID: txids1[0]},
+	}
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all}, lookup(addrs[0], 0, 100, 0))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: []ledgercore.AccountTxn{{Round: 1, Intra: 1, TxID: txids1[1]}}}, lookup(addrs[3], 0, 100, 0))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1}, lookup(addrs[4], 0, 100, 0))
+
+	// the results are the same whether the rounds are committed or not
+	commit(0, 2)
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all}, lookup(addrs[0], 0, 100, 0))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all[:2]}, lookup(addrs[0], 0, 100, 2))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all[1:2]}, lookup(addrs[0], 2, 2, 0))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all[1:]}, lookup(addrs[0], 0, 2, 0))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: []ledgercore.AccountTxn{{Round: 1, Intra: 1, TxID: txids1[1]}}}, lookup(addrs[3], 0, 100, 0))
+
+	// the index survives a reload, then drops the rounds past the retention
+	require.NoError(t, l.accountTxns.loadFromDisk(l, 2))
+	require.Equal(t, ledgercore.AccountTxns{Since: 1, Txns: all[1:]}, lookup(addrs[0], 0, 100, 0))
+	newBlock(3, pay(addrs[0], addrs[0], 3))
+	newBlock(4)
+	commit(2, 2)
+	require.Equal(t, ledgercore.AccountTxns{Since: 2, Txns: all[:2]}, lookup(addrs[0], 0, 100, 0))
+
+	// the index restarts when the ledger moved past the rounds it covers
+	require.NoError(t, l.accountTxns.loadFromDisk(l, 6))
+	require.Equal(t, ledgercore.AccountTxns{Since: 7}, lookup(addrs[0], 0, 100, 0))
+}
+
+func TestAccountTxnIndexConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.AccountTransactions(basics.Address{}, 0, 100, 0)
+	require.ErrorIs(t, err, ErrAccountTxnIndexDisabled)
+
+	cfg.EnableAccountTxnIndex = true
+	l2, err := OpenLedger(logging.TestingLog(t), t.Name()+"-enabled", true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	l2.WaitForCommit(l2.Latest())
+	require.NoError(t, l2.reloadLedger())
+
+	txns, err := l2.AccountTransactions(basics.Address{}, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.AccountTxns{Since: 1}, txns)
+}
@@ -0,0 +1,410 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// accountHistoryTracker retains the states of the accounts and of their
+// resources over the rounds preceding the ones the account updates tracker
+// keeps in memory, so that they can be looked up at any of the last
+// MaxAccountHistoryRounds rounds before its dbRound. Only the states of the
+// modified accounts and resources are kept: one missing from the history did
+// not change since the first round it covers, and is read at the dbRound of the
+// account updates tracker instead. The changes of the boxes are kept too, by
+// the application account holding them. The history is kept in memory and
+// covers the rounds following the one the ledger was loaded at.
+type accountHistoryTracker struct {
+	window uint64
+	au     *accountUpdates
+
+	mu deadlock.RWMutex
+	// since is the first round covered by the history.
+	since basics.Round
+	// rounds holds the rewards of the rounds following since, in round order.
+	rounds []accountHistoryRound
+	// accounts holds the states of the accounts modified since the history
+	// started, in round order.
+	accounts map[basics.Address][]accountHistoryEntry
+	// fresh lists the accounts modified for the first time in the rounds not
+	// committed yet, which states before the modification are not known yet.
+	fresh []accountHistoryEntryRef
+	// resources holds the states of the resources modified since the history
+	// started, by account, and freshResources the resources modified for the
+	// first time in the rounds not committed yet, like accounts and fresh.
+	resources      map[basics.Address]map[basics.CreatableIndex]*resourceHistory
+	freshResources []resourceHistoryRef
+	// boxes holds the changes of the boxes since the history started, by
+	// application account, in round order.
+	boxes map[basics.Address][]accountBoxChange
+}
+
+type accountHistoryRound struct {
+	rewardsVersion protocol.ConsensusVersion
+	rewardsLevel   uint64
+}
+
+type accountHistoryEntry struct {
+	round basics.Round
+	data  ledgercore.AccountData
+	// base marks the state an account had before it was first modified, which
+	// holds from the start of the history through round.
+	base bool
+}
+
+type accountHistoryEntryRef struct {
+	round basics.Round
+	addr  basics.Address
+}
+
+type resourceHistory struct {
+	ctype basics.CreatableType
+	// entries are in round order, like the accountHistoryEntry of an account.
+	entries []resourceHistoryEntry
+}
+
+type resourceHistoryEntry struct {
+	round basics.Round
+	data  ledgercore.AccountResource
+	base  bool
+}
+
+type resourceHistoryRef struct {
+	round basics.Round
+	key   accountCreatable
+	ctype basics.CreatableType
+}
+
+// accountBoxChange is a change of a box held by an application account.
+type accountBoxChange struct {
+	round basics.Round
+	app   basics.AppIndex
+	name  string
+	ledgercore.KvValueDelta
+}
+
+// accountHistoryResult is the state of an account at a round covered by the history.
+type accountHistoryResult struct {
+	data           ledgercore.AccountData
+	rewardsVersion protocol.ConsensusVersion
+	rewardsLevel   uint64
+	// found is false when the account did not change between the round and the
+	// dbRound of the account updates tracker, and data is not set.
+	found bool
+}
+
+func (aht *accountHistoryTracker) initialize(cfg config.Local, au *accountUpdates) {
+	aht.window = cfg.MaxAccountHistoryRounds
+	aht.au = au
+	au.history = nil
+	if aht.window > 0 {
+		au.history = aht
+	}
+}
+
+func (aht *accountHistoryTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+
+	aht.since = dbRound + 1
+	aht.rounds = nil
+	aht.accounts = make(map[basics.Address][]accountHistoryEntry)
+	aht.fresh = nil
+	aht.resources = make(map[basics.Address]map[basics.CreatableIndex]*resourceHistory)
+	aht.freshResources = nil
+	aht.boxes = make(map[basics.Address][]accountBoxChange)
+	return nil
+}
+
+func (aht *accountHistoryTracker) close() {
+}
+
+func (aht *accountHistoryTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if aht.window == 0 {
+		return
+	}
+
+	rnd := blk.Round()
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+	aht.rounds = append(aht.rounds, accountHistoryRound{rewardsVersion: blk.CurrentProtocol, rewardsLevel: blk.RewardsLevel})
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, data := delta.Accts.GetByIdx(i)
+		entries := aht.accounts[addr]
+		if len(entries) == 0 {
+			aht.fresh = append(aht.fresh, accountHistoryEntryRef{round: rnd, addr: addr})
+		}
+		aht.accounts[addr] = append(entries, accountHistoryEntry{round: rnd, data: data})
+	}
+	for _, res := range delta.Accts.GetAllAssetResources() {
+		data := ledgercore.AccountResource{AssetParams: res.Params.Params, AssetHolding: res.Holding.Holding}
+		aht.addResourceLocked(rnd, res.Addr, basics.CreatableIndex(res.Aidx), basics.AssetCreatable, data)
+	}
+	for _, res := range delta.Accts.GetAllAppResources() {
+		data := ledgercore.AccountResource{AppParams: res.Params.Params, AppLocalState: res.State.LocalState}
+		aht.addResourceLocked(rnd, res.Addr, basics.CreatableIndex(res.Aidx), basics.AppCreatable, data)
+	}
+	for key, kv := range delta.KvMods {
+		app, name, err := apps.SplitBoxKey(key)
+		if err != nil {
+			continue
+		}
+		addr := basics.AppIndex(app).Address()
+		aht.boxes[addr] = append(aht.boxes[addr], accountBoxChange{round: rnd, app: basics.AppIndex(app), name: name, KvValueDelta: kv})
+	}
+}
+
+func (aht *accountHistoryTracker) addResourceLocked(rnd basics.Round, addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType, data ledgercore.AccountResource) {
+	byIndex := aht.resources[addr]
+	if byIndex == nil {
+		byIndex = make(map[basics.CreatableIndex]*resourceHistory)
+		aht.resources[addr] = byIndex
+	}
+	h := byIndex[cidx]
+	if h == nil {
+		h = &resourceHistory{ctype: ctype}
+		byIndex[cidx] = h
+		aht.freshResources = append(aht.freshResources, resourceHistoryRef{round: rnd, key: accountCreatable{address: addr, index: cidx}, ctype: ctype})
+	}
+	h.entries = append(h.entries, resourceHistoryEntry{round: rnd, data: data})
+}
+
+// lookup returns the state of addr at rnd. It returns false if rnd is not
+// covered by the history.
+func (aht *accountHistoryTracker) lookup(rnd basics.Round, addr basics.Address) (accountHistoryResult, bool) {
+	aht.mu.RLock()
+	defer aht.mu.RUnlock()
+
+	if rnd < aht.since || uint64(rnd-aht.since) >= uint64(len(aht.rounds)) {
+		return accountHistoryResult{}, false
+	}
+	r := aht.rounds[rnd-aht.since]
+	res := accountHistoryResult{rewardsVersion: r.rewardsVersion, rewardsLevel: r.rewardsLevel}
+
+	entries := aht.accounts[addr]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].round > rnd })
+	switch {
+	case i > 0:
+		res.data, res.found = entries[i-1].data, true
+	case len(entries) > 0 && entries[0].base:
+		res.data, res.found = entries[0].data, true
+	}
+	return res, true
+}
+
+// lookupResource returns the state of the resource cidx of addr at rnd, like
+// lookup does for the accounts.
+func (aht *accountHistoryTracker) lookupResource(rnd basics.Round, addr basics.Address, cidx basics.CreatableIndex) (data ledgercore.AccountResource, found bool, covered bool) {
+	aht.mu.RLock()
+	defer aht.mu.RUnlock()
+
+	if rnd < aht.since || uint64(rnd-aht.since) >= uint64(len(aht.rounds)) {
+		return ledgercore.AccountResource{}, false, false
+	}
+	h := aht.resources[addr][cidx]
+	if h == nil {
+		return ledgercore.AccountResource{}, false, true
+	}
+	entries := h.entries
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].round > rnd })
+	switch {
+	case i > 0:
+		return entries[i-1].data, true, true
+	case entries[0].base:
+		return entries[0].data, true, true
+	}
+	return ledgercore.AccountResource{}, false, true
+}
+
+// modified returns the resources of addr modified in the rounds from+1 through
+// to, and the changes of the boxes addr holds in these rounds. It returns false
+// if from or to are not covered by the history.
+func (aht *accountHistoryTracker) modified(addr basics.Address, from basics.Round, to basics.Round) (map[basics.CreatableIndex]basics.CreatableType, []accountBoxChange, bool) {
+	aht.mu.RLock()
+	defer aht.mu.RUnlock()
+
+	if from < aht.since || uint64(to-aht.since) >= uint64(len(aht.rounds)) {
+		return nil, nil, false
+	}
+	resources := make(map[basics.CreatableIndex]basics.CreatableType)
+	for cidx, h := range aht.resources[addr] {
+		for _, entry := range h.entries {
+			if !entry.base && entry.round > from && entry.round <= to {
+				resources[cidx] = h.ctype
+				break
+			}
+		}
+	}
+	var boxes []accountBoxChange
+	for _, change := range aht.boxes[addr] {
+		if change.round > from && change.round <= to {
+			boxes = append(boxes, change)
+		}
+	}
+	return resources, boxes, true
+}
+
+// historyRange returns the first and last rounds covered by the history.
+func (aht *accountHistoryTracker) historyRange() (basics.Round, basics.Round) {
+	aht.mu.RLock()
+	defer aht.mu.RUnlock()
+	return aht.since, aht.since + basics.Round(len(aht.rounds)) - 1
+}
+
+func (aht *accountHistoryTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+// prepareCommit records the states the accounts first modified in the
+// committed rounds had before. They are read from the account updates tracker
+// at the old dbRound, which it still serves until its postCommit.
+func (aht *accountHistoryTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if aht.window == 0 {
+		return nil
+	}
+
+	newBase := dcc.newBase()
+	aht.mu.RLock()
+	n := 0
+	for n < len(aht.fresh) && aht.fresh[n].round <= newBase {
+		n++
+	}
+	fresh := aht.fresh[:n:n]
+	m := 0
+	for m < len(aht.freshResources) && aht.freshResources[m].round <= newBase {
+		m++
+	}
+	freshResources := aht.freshResources[:m:m]
+	aht.mu.RUnlock()
+
+	bases := make([]ledgercore.AccountData, len(fresh))
+	for i, ref := range fresh {
+		data, _, _, _, err := aht.au.lookupWithoutRewards(dcc.oldBase, ref.addr, true)
+		if err != nil {
+			return fmt.Errorf("accountHistoryTracker: unable to look up account %v at round %d : %w", ref.addr, dcc.oldBase, err)
+		}
+		bases[i] = data
+	}
+	resourceBases := make([]ledgercore.AccountResource, len(freshResources))
+	for i, ref := range freshResources {
+		data, _, err := aht.au.lookupResource(dcc.oldBase, ref.key.address, ref.key.index, ref.ctype, true)
+		if err != nil {
+			return fmt.Errorf("accountHistoryTracker: unable to look up resource %d of account %v at round %d : %w", ref.key.index, ref.key.address, dcc.oldBase, err)
+		}
+		resourceBases[i] = data
+	}
+
+	// newBlock only appends to fresh, and only postCommit removes accounts, so the
+	// accounts looked up are still at the head of fresh, with their first
+	// modification at the head of their entries.
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+	for i, ref := range fresh {
+		entries := aht.accounts[ref.addr]
+		base := accountHistoryEntry{round: dcc.oldBase, data: bases[i], base: true}
+		aht.accounts[ref.addr] = append([]accountHistoryEntry{base}, entries...)
+	}
+	aht.fresh = aht.fresh[n:]
+	for i, ref := range freshResources {
+		h := aht.resources[ref.key.address][ref.key.index]
+		base := resourceHistoryEntry{round: dcc.oldBase, data: resourceBases[i], base: true}
+		h.entries = append([]resourceHistoryEntry{base}, h.entries...)
+	}
+	aht.freshResources = aht.freshResources[m:]
+	return nil
+}
+
+func (aht *accountHistoryTracker) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+// postCommit drops the rounds which moved out of the history window.
+func (aht *accountHistoryTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	newBase := dcc.newBase()
+	if aht.window == 0 || uint64(newBase) <= aht.window {
+		return
+	}
+
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+	since := newBase - basics.Round(aht.window)
+	if since <= aht.since {
+		return
+	}
+	aht.rounds = aht.rounds[since-aht.since:]
+	aht.since = since
+	for addr, entries := range aht.accounts {
+		i := sort.Search(len(entries), func(i int) bool { return entries[i].round >= since })
+		switch {
+		case i == len(entries):
+			// the account did not change since, so its state is the one at dbRound.
+			delete(aht.accounts, addr)
+		case i > 1:
+			// the last state before since holds until the next modification.
+			aht.accounts[addr] = entries[i-1:]
+		}
+	}
+	for addr, byIndex := range aht.resources {
+		for cidx, h := range byIndex {
+			i := sort.Search(len(h.entries), func(i int) bool { return h.entries[i].round >= since })
+			switch {
+			case i == len(h.entries):
+				delete(byIndex, cidx)
+			case i > 1:
+				h.entries = h.entries[i-1:]
+			}
+		}
+		if len(byIndex) == 0 {
+			delete(aht.resources, addr)
+		}
+	}
+	for addr, changes := range aht.boxes {
+		i := sort.Search(len(changes), func(i int) bool { return changes[i].round >= since })
+		if i == len(changes) {
+			delete(aht.boxes, addr)
+		} else if i > 0 {
+			aht.boxes[addr] = changes[i:]
+		}
+	}
+}
+
+func (aht *accountHistoryTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (aht *accountHistoryTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (aht *accountHistoryTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (aht *accountHistoryTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (aht *accountHistoryTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAccountHistoryTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	protocolVersion := protocol.ConsensusCurrentVersion
+	accts := setupAccts(20)
+	rewardsLevels := []uint64{0}
+
+	ml := makeMockLedgerForTracker(t, true, 1, protocolVersion, accts)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.MaxAcctLookback = 4
+	conf.MaxAccountHistoryRounds = 10
+	au := &accountUpdates{}
+	au.initialize(conf)
+	ao := &onlineAccounts{}
+	ao.initialize(conf)
+	aht := &accountHistoryTracker{}
+	aht.initialize(conf, au)
+	_, err := trackerDBInitialize(ml, false, ".")
+	require.NoError(t, err)
+	require.NoError(t, ml.trackers.initialize(ml, []ledgerTracker{au, ao, &txTail{}, aht}, conf))
+	require.NoError(t, ml.trackers.loadFromDisk(ml))
+
+	const lastRound = 40
+	rewardLevel := uint64(0)
+	for i := basics.Round(1); i <= lastRound; i++ {
+		rewardLevel += 2
+		// only some of the accounts change on each round
+		updates, totals := ledgertesting.RandomDeltasBalanced(3, accts[i-1], rewardLevel)
+		prevRound, prevTotals, err := au.LatestTotals()
+		require.NoError(t, err)
+		require.Equal(t, i-1, prevRound)
+
+		newPool := totals[testPoolAddr]
+		newPool.MicroAlgos.Raw -= prevTotals.RewardUnits() * 2
+		updates.Upsert(testPoolAddr, newPool)
+		totals[testPoolAddr] = newPool
+
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: i}}
+		blk.RewardsLevel = rewardLevel
+		blk.CurrentProtocol = protocolVersion
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, updates.Len(), 0)
+		delta.Accts.MergeAccounts(updates)
+		delta.Totals = ledgercore.AccountTotals{RewardsLevel: rewardLevel}
+		ml.addBlock(blockEntry{block: blk}, delta)
+		accts = append(accts, applyPartialDeltas(accts[i-1], updates))
+		rewardsLevels = append(rewardsLevels, rewardLevel)
+
+		ml.trackers.lastFlushTime = time.Time{}
+		ml.trackers.committedUpTo(i)
+		ml.trackers.waitAccountsWriting()
+	}
+
+	dbRound := au.cachedDBRound
+	require.Equal(t, basics.Round(lastRound-conf.MaxAcctLookback), dbRound)
+	since, _ := aht.historyRange()
+	require.Equal(t, dbRound-basics.Round(conf.MaxAccountHistoryRounds), since)
+
+	for rnd := since; rnd <= lastRound; rnd++ {
+		for addr, data := range accts[rnd] {
+			d, _, rewardsVersion, level, err := au.lookupWithoutRewards(rnd, addr, true)
+			require.NoError(t, err)
+			require.Equal(t, ledgercore.ToAccountData(data), d, "round %d", rnd)
+			require.Equal(t, protocolVersion, rewardsVersion)
+			require.Equal(t, rewardsLevels[rnd], level)
+		}
+	}
+
+	// the rounds older than the history are not available
+	_, _, _, _, err = au.lookupWithoutRewards(since-1, testPoolAddr, true)
+	var roundErr *RoundOffsetError
+	require.ErrorAs(t, err, &roundErr)
+
+	// only the accounts modified within the history window are retained
+	for addr, entries := range aht.accounts {
+		require.NotEmpty(t, entries)
+		require.GreaterOrEqual(t, entries[len(entries)-1].round, since, addr)
+	}
+}
+
+func TestAccountHistoryResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	protocolVersion := protocol.ConsensusCurrentVersion
+	accts := setupAccts(5)
+
+	ml := makeMockLedgerForTracker(t, true, 1, protocolVersion, accts)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.MaxAcctLookback = 4
+	conf.MaxAccountHistoryRounds = 10
+	au := &accountUpdates{}
+	au.initialize(conf)
+	ao := &onlineAccounts{}
+	ao.initialize(conf)
+	aht := &accountHistoryTracker{}
+	aht.initialize(conf, au)
+	_, err := trackerDBInitialize(ml, false, ".")
+	require.NoError(t, err)
+	require.NoError(t, ml.trackers.initialize(ml, []ledgerTracker{au, ao, &txTail{}, aht}, conf))
+	require.NoError(t, ml.trackers.loadFromDisk(ml))
+
+	var addr basics.Address
+	for a := range accts[0] {
+		if a != testPoolAddr {
+			addr = a
+			break
+		}
+	}
+	const asset = basics.AssetIndex(1000)
+	const app = basics.AppIndex(7)
+	appAddr := app.Address()
+	boxKey := apps.MakeBoxKey(uint64(app), "box")
+
+	// the holding of the asset changes every 5 rounds, and the box of the app every 7 rounds
+	const lastRound = 40
+	var boxValue []byte
+	for i := basics.Round(1); i <= lastRound; i++ {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: i}}
+		blk.CurrentProtocol = protocolVersion
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 1, 0)
+		if i%5 == 0 {
+			data := ledgercore.ToAccountData(accts[0][addr])
+			data.TotalAssets = 1
+			delta.Accts.Upsert(addr, data)
+			delta.Accts.UpsertAssetResource(addr, asset, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: uint64(i)}})
+		}
+		if i%7 == 0 {
+			delta.KvMods = map[string]ledgercore.KvValueDelta{}
+			delta.KvMods[boxKey] = ledgercore.KvValueDelta{Data: []byte{byte(i)}, OldData: boxValue}
+			boxValue = []byte{byte(i)}
+		}
+		ml.addBlock(blockEntry{block: blk}, delta)
+
+		ml.trackers.lastFlushTime = time.Time{}
+		ml.trackers.committedUpTo(i)
+		ml.trackers.waitAccountsWriting()
+	}
+
+	dbRound := au.cachedDBRound
+	require.Equal(t, basics.Round(lastRound-conf.MaxAcctLookback), dbRound)
+	since, _ := aht.historyRange()
+	require.Equal(t, dbRound-basics.Round(conf.MaxAccountHistoryRounds), since)
+
+	for rnd := since; rnd <= lastRound; rnd++ {
+		res, _, err := au.lookupResource(rnd, addr, basics.CreatableIndex(asset), basics.AssetCreatable, true)
+		require.NoError(t, err)
+		require.NotNil(t, res.AssetHolding, "round %d", rnd)
+		require.Equal(t, uint64(rnd-rnd%5), res.AssetHolding.Amount, "round %d", rnd)
+	}
+	_, _, err = au.lookupResource(since-1, addr, basics.CreatableIndex(asset), basics.AssetCreatable, true)
+	var roundErr *RoundOffsetError
+	require.ErrorAs(t, err, &roundErr)
+
+	// from the history through the in-memory deltas
+	changes, err := au.lookupAccountChanges(addr, 27, 38)
+	require.NoError(t, err)
+	require.Len(t, changes.Resources, 1)
+	require.Equal(t, basics.CreatableIndex(asset), changes.Resources[0].Index)
+	require.Equal(t, uint64(25), changes.Resources[0].Before.AssetHolding.Amount)
+	require.Equal(t, uint64(35), changes.Resources[0].After.AssetHolding.Amount)
+	require.Empty(t, changes.Boxes)
+
+	changes, err = au.lookupAccountChanges(appAddr, 27, 38)
+	require.NoError(t, err)
+	require.Empty(t, changes.Resources)
+	require.Equal(t, []ledgercore.BoxChange{{App: app, Name: "box", Before: []byte{21}, After: []byte{35}}}, changes.Boxes)
+
+	// within the in-memory deltas
+	changes, err = au.lookupAccountChanges(appAddr, dbRound, lastRound)
+	require.NoError(t, err)
+	require.Empty(t, changes.Boxes)
+	changes, err = au.lookupAccountChanges(addr, dbRound, lastRound)
+	require.NoError(t, err)
+	require.Len(t, changes.Resources, 1)
+	require.Equal(t, uint64(35), changes.Resources[0].Before.AssetHolding.Amount)
+	require.Equal(t, uint64(40), changes.Resources[0].After.AssetHolding.Amount)
+
+	// no change
+	changes, err = au.lookupAccountChanges(addr, 31, 34)
+	require.NoError(t, err)
+	require.Empty(t, changes.Resources)
+
+	_, err = au.lookupAccountChanges(addr, since-1, lastRound)
+	require.ErrorAs(t, err, &roundErr)
+}
+
+func TestAccountHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	au := &accountUpdates{}
+	aht := &accountHistoryTracker{}
+	aht.initialize(config.GetDefaultLocal(), au)
+	require.Nil(t, au.history)
+
+	aht.newBlock(bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 1}}, ledgercore.StateDelta{})
+	require.Empty(t, aht.rounds)
+}
@@ -17,15 +17,18 @@
 package ledger
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/algorand/avm-abi/apps"
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
@@ -211,6 +214,15 @@ type accountUpdates struct {
 	// baseKVs stores the most recently used KV, at exactly dbRound
 	baseKVs lruKV
 
+	// hotAccounts stores the most frequently looked up accounts, at exactly dbRound
+	hotAccounts hotCache[basics.Address, trackerdb.PersistedAccountData]
+
+	// hotResources stores the most frequently looked up resources, at exactly dbRound
+	hotResources hotCache[accountCreatable, trackerdb.PersistedResourcesData]
+
+	// hotCacheSize is the maximal number of entries in hotAccounts, and in hotResources
+	hotCacheSize int
+
 	// logAccountUpdatesMetrics is a flag for enable/disable metrics logging
 	logAccountUpdatesMetrics bool
 
@@ -225,6 +237,9 @@ type accountUpdates struct {
 
 	// disableCache (de)activates the LRU cache use in accountUpdates
 	disableCache bool
+
+	// history serves the account lookups of the rounds before dbRound, when enabled
+	history *accountHistoryTracker
 }
 
 // RoundOffsetError is an error for when requested round is behind earliest stored db entry
@@ -296,11 +311,16 @@ func (au *accountUpdates) initialize(cfg config.Local) {
 	au.logAccountUpdatesInterval = cfg.AccountUpdatesStatsInterval
 
 	au.disableCache = cfg.DisableLedgerLRUCache
+	au.hotCacheSize = cfg.HotAccountsCacheSize
 }
 
 // loadFromDisk is the 2nd level initialization, and is required before the accountUpdates becomes functional
 // The close function is expected to be call in pair with loadFromDisk
 func (au *accountUpdates) loadFromDisk(l ledgerForTracker, lastBalancesRound basics.Round) error {
+	// the goroutines of the hot caches take accountsMu, so they are stopped before reinitializing the caches under it
+	au.hotAccounts.stop()
+	au.hotResources.stop()
+
 	au.accountsMu.Lock()
 	defer au.accountsMu.Unlock()
 
@@ -321,6 +341,10 @@ func (au *accountUpdates) close() {
 	au.baseAccounts.prune(0)
 	au.baseResources.prune(0)
 	au.baseKVs.prune(0)
+	au.hotAccounts.stop()
+	au.hotResources.stop()
+	au.hotAccounts.clear()
+	au.hotResources.clear()
 }
 
 // flushCaches flushes any pending data in caches so that it is fully available during future lookups.
@@ -581,6 +605,106 @@ func (au *accountUpdates) lookupKeysByPrefix(round basics.Round, keyPrefix strin
 	}
 }
 
+// LookupKeysByRange returns, in order, up to maxKeyNum keys starting with keyPrefix that are not lower than from.
+func (au *accountUpdates) LookupKeysByRange(round basics.Round, keyPrefix string, from string, maxKeyNum uint64) ([]string, error) {
+	return au.lookupKeysByRange(round, keyPrefix, from, maxKeyNum, true /* take lock */)
+}
+
+func (au *accountUpdates) lookupKeysByRange(round basics.Round, keyPrefix string, from string, maxKeyNum uint64, synchronized bool) (resultKeys []string, err error) {
+	// as in lookupKeysByPrefix, results tells whether each key seen is present (true) or deleted (false)
+	var results map[string]bool
+	var resultCount uint64
+
+	needUnlock := false
+	if synchronized {
+		au.accountsMu.RLock()
+		needUnlock = true
+	}
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+		if err == nil {
+			resultKeys = make([]string, 0, resultCount)
+			for resKey, present := range results {
+				if present {
+					resultKeys = append(resultKeys, resKey)
+				}
+			}
+			sort.Strings(resultKeys)
+			if uint64(len(resultKeys)) > maxKeyNum {
+				resultKeys = resultKeys[:maxKeyNum]
+			}
+		}
+	}()
+
+	for {
+		currentDBRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, rndErr := au.roundOffset(round)
+		if rndErr != nil {
+			return nil, rndErr
+		}
+
+		results = map[string]bool{}
+		resultCount = 0
+
+		// Unlike lookupKeysByPrefix, the keys of the deltas are not in order, so all the ones in range are
+		// collected before reading the first maxKeyNum keys of the database that the deltas did not modify.
+		// The first maxKeyNum keys of the union are then the ones looked up.
+		for offset > 0 {
+			offset--
+			for keyInRound, mv := range au.deltas[offset].KvMods {
+				if !strings.HasPrefix(keyInRound, keyPrefix) || keyInRound < from {
+					continue
+				}
+				if _, ok := results[keyInRound]; ok {
+					continue
+				}
+				results[keyInRound] = mv.Data != nil
+			}
+		}
+
+		round = currentDBRound + basics.Round(currentDeltaLen)
+
+		if synchronized {
+			au.accountsMu.RUnlock()
+			needUnlock = false
+		}
+
+		dbRound, dbErr := au.accountsq.LookupKeysByRange(keyPrefix, from, maxKeyNum, results, 0)
+		if dbErr != nil {
+			return nil, dbErr
+		}
+		for _, present := range results {
+			if present {
+				resultCount++
+			}
+		}
+		if dbRound == currentDBRound {
+			return
+		}
+
+		if synchronized {
+			if dbRound < currentDBRound {
+				au.log.Errorf("accountUpdates.lookupKeysByRange: database round %d is behind in-memory round %d", dbRound, currentDBRound)
+				err = &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDBRound}
+				return
+			}
+			// The DB round is higher than expected, so a write-into-DB must have happened. Start over again.
+			au.accountsMu.RLock()
+			needUnlock = true
+			for currentDBRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+				au.accountsReadCond.Wait()
+			}
+		} else {
+			au.log.Errorf("accountUpdates.lookupKeysByRange: database round %d mismatching in-memory round %d", dbRound, currentDBRound)
+			err = &MismatchingDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDBRound}
+			return
+		}
+	}
+}
+
 // LookupWithoutRewards returns the account data for a given address at a given round.
 func (au *accountUpdates) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, err error) {
 	data, validThrough, _, _, err = au.lookupWithoutRewards(rnd, addr, true /* take lock*/)
@@ -773,11 +897,18 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 		au.baseAccounts.init(au.log, baseAccountsPendingAccountsBufferSize, baseAccountsPendingAccountsWarnThreshold)
 		au.baseResources.init(au.log, baseResourcesPendingAccountsBufferSize, baseResourcesPendingAccountsWarnThreshold)
 		au.baseKVs.init(au.log, baseKVPendingBufferSize, baseKVPendingWarnThreshold)
+		au.hotAccounts.init(au.hotCacheSize, ledgerHotCacheAccounts, ledgerHotCacheAccountsHits)
+		au.hotResources.init(au.hotCacheSize, ledgerHotCacheResources, ledgerHotCacheResourcesHits)
 	} else {
 		au.baseAccounts.init(au.log, 0, 1)
 		au.baseResources.init(au.log, 0, 1)
 		au.baseKVs.init(au.log, 0, 1)
+		au.hotAccounts.init(0, ledgerHotCacheAccounts, ledgerHotCacheAccountsHits)
+		au.hotResources.init(0, ledgerHotCacheResources, ledgerHotCacheResourcesHits)
 	}
+	dbRound := func() basics.Round { return au.cachedDBRound }
+	au.hotAccounts.start(&au.accountsMu, dbRound)
+	au.hotResources.start(&au.accountsMu, dbRound)
 	return nil
 }
 
@@ -1106,7 +1237,20 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 		currentDeltaLen := len(au.deltas)
 		offset, err = au.roundOffset(rnd)
 		if err != nil {
-			return
+			var roundErr *RoundOffsetError
+			if au.history == nil || !errors.As(err, &roundErr) {
+				return
+			}
+			hist, found, covered := au.history.lookupResource(rnd, addr, aidx)
+			if !covered {
+				return
+			}
+			err = nil
+			if found {
+				return hist, rnd, nil
+			}
+			// the resource did not change between rnd and dbRound, so read it at dbRound
+			offset = 0
 		}
 
 		// check if we've had this address modified in the past rounds. ( i.e. if it's in the deltas )
@@ -1142,6 +1286,13 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 			// we don't technically need this, since it's already in the baseResources, however, writing this over
 			// would ensure that we promote this field.
 			au.baseResources.writePending(macct, addr)
+			au.hotResources.recordAccess(accountCreatable{addr, aidx}, macct, macct.Round)
+			return macct.AccountResource(), rnd, nil
+		}
+
+		// check the hotResources, which keep the frequently looked up resources once evicted from the baseResources
+		if macct, has := au.hotResources.read(accountCreatable{addr, aidx}); has {
+			au.hotResources.recordAccess(accountCreatable{addr, aidx}, macct, macct.Round)
 			return macct.AccountResource(), rnd, nil
 		}
 
@@ -1168,6 +1319,7 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 			if persistedData.AcctRef != nil {
 				// if we read actual data return it
 				au.baseResources.writePending(persistedData, addr)
+				au.hotResources.recordAccess(accountCreatable{addr, aidx}, persistedData, persistedData.Round)
 				return persistedData.AccountResource(), rnd, nil
 			}
 			au.baseResources.writeNotFoundPending(addr, aidx)
@@ -1192,6 +1344,123 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 	}
 }
 
+// lookupAccountChanges returns the net changes of the resources of addr, and of the boxes it holds as an
+// application account, between the rounds from and to. The rounds before dbRound are served by the
+// account history.
+func (au *accountUpdates) lookupAccountChanges(addr basics.Address, from basics.Round, to basics.Round) (ledgercore.AccountChanges, error) {
+	var changes ledgercore.AccountChanges
+	resources, boxes, err := au.modifiedBetween(addr, from, to)
+	if err != nil {
+		return changes, err
+	}
+
+	indexes := make([]basics.CreatableIndex, 0, len(resources))
+	for cidx := range resources {
+		indexes = append(indexes, cidx)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	for _, cidx := range indexes {
+		ctype := resources[cidx]
+		before, _, err := au.lookupResource(from, addr, cidx, ctype, true)
+		if err != nil {
+			return ledgercore.AccountChanges{}, err
+		}
+		after, _, err := au.lookupResource(to, addr, cidx, ctype, true)
+		if err != nil {
+			return ledgercore.AccountChanges{}, err
+		}
+		if reflect.DeepEqual(before, after) {
+			continue
+		}
+		changes.Resources = append(changes.Resources, ledgercore.ResourceChange{Index: cidx, Type: ctype, Before: before, After: after})
+	}
+
+	// the changes are in round order, so the value before a box first changed is the previous value of its
+	// first change, and its value after is the one of its last change.
+	byKey := make(map[string]int)
+	for _, change := range boxes {
+		key := apps.MakeBoxKey(uint64(change.app), change.name)
+		i, ok := byKey[key]
+		if !ok {
+			i = len(changes.Boxes)
+			byKey[key] = i
+			changes.Boxes = append(changes.Boxes, ledgercore.BoxChange{App: change.app, Name: change.name, Before: change.OldData})
+		}
+		changes.Boxes[i].After = change.Data
+	}
+	n := 0
+	for _, box := range changes.Boxes {
+		if (box.Before == nil) != (box.After == nil) || !bytes.Equal(box.Before, box.After) {
+			changes.Boxes[n] = box
+			n++
+		}
+	}
+	changes.Boxes = changes.Boxes[:n]
+	sort.Slice(changes.Boxes, func(i, j int) bool {
+		if changes.Boxes[i].App != changes.Boxes[j].App {
+			return changes.Boxes[i].App < changes.Boxes[j].App
+		}
+		return changes.Boxes[i].Name < changes.Boxes[j].Name
+	})
+	return changes, nil
+}
+
+// modifiedBetween returns the resources of addr modified in the rounds from+1 through to, and the changes
+// of the boxes addr holds in these rounds, in round order.
+func (au *accountUpdates) modifiedBetween(addr basics.Address, from basics.Round, to basics.Round) (map[basics.CreatableIndex]basics.CreatableType, []accountBoxChange, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+
+	if to < from {
+		return nil, nil, fmt.Errorf("round %d is before round %d", to, from)
+	}
+	if latest := au.cachedDBRound + basics.Round(len(au.deltas)); to > latest {
+		return nil, nil, fmt.Errorf("round %d too high: dbRound %d, deltas %d", to, au.cachedDBRound, len(au.deltas))
+	}
+	if from < au.cachedDBRound {
+		if au.history == nil {
+			return nil, nil, &RoundOffsetError{round: from, dbRound: au.cachedDBRound}
+		}
+		resources, boxes, ok := au.history.modified(addr, from, to)
+		if !ok {
+			return nil, nil, &RoundOffsetError{round: from, dbRound: au.cachedDBRound}
+		}
+		return resources, boxes, nil
+	}
+
+	resources := make(map[basics.CreatableIndex]basics.CreatableType)
+	var boxes []accountBoxChange
+	appAddrs := make(map[uint64]basics.Address)
+	for rnd := from + 1; rnd <= to; rnd++ {
+		delta := &au.deltas[rnd-au.cachedDBRound-1]
+		for _, res := range delta.Accts.GetAllAssetResources() {
+			if res.Addr == addr {
+				resources[basics.CreatableIndex(res.Aidx)] = basics.AssetCreatable
+			}
+		}
+		for _, res := range delta.Accts.GetAllAppResources() {
+			if res.Addr == addr {
+				resources[basics.CreatableIndex(res.Aidx)] = basics.AppCreatable
+			}
+		}
+		for key, kv := range delta.KvMods {
+			app, name, err := apps.SplitBoxKey(key)
+			if err != nil {
+				continue
+			}
+			appAddr, ok := appAddrs[app]
+			if !ok {
+				appAddr = basics.AppIndex(app).Address()
+				appAddrs[app] = appAddr
+			}
+			if appAddr == addr {
+				boxes = append(boxes, accountBoxChange{round: rnd, app: basics.AppIndex(app), name: name, KvValueDelta: kv})
+			}
+		}
+	}
+	return resources, boxes, nil
+}
+
 func (au *accountUpdates) lookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
 	au.accountsMu.RLock()
 	defer au.accountsMu.RUnlock()
@@ -1228,12 +1497,27 @@ func (au *accountUpdates) lookupWithoutRewards(rnd basics.Round, addr basics.Add
 		currentDeltaLen := len(au.deltas)
 		offset, err = au.roundOffset(rnd)
 		if err != nil {
-			return
+			var roundErr *RoundOffsetError
+			if au.history == nil || !errors.As(err, &roundErr) {
+				return
+			}
+			hist, ok := au.history.lookup(rnd, addr)
+			if !ok {
+				return
+			}
+			err = nil
+			if hist.found {
+				return hist.data, rnd, hist.rewardsVersion, hist.rewardsLevel, nil
+			}
+			// the account did not change between rnd and dbRound, so read it at dbRound
+			offset = 0
+			rewardsVersion = hist.rewardsVersion
+			rewardsLevel = hist.rewardsLevel
+		} else {
+			rewardsVersion = au.versions[offset]
+			rewardsLevel = au.roundTotals[offset].RewardsLevel
 		}
 
-		rewardsVersion = au.versions[offset]
-		rewardsLevel = au.roundTotals[offset].RewardsLevel
-
 		// check if we've had this address modified in the past rounds. ( i.e. if it's in the deltas )
 		macct, indeltas := au.accounts[addr]
 		if indeltas {
@@ -1267,6 +1551,13 @@ func (au *accountUpdates) lookupWithoutRewards(rnd basics.Round, addr basics.Add
 			// we don't technically need this, since it's already in the baseAccounts, however, writing this over
 			// would ensure that we promote this field.
 			au.baseAccounts.writePending(macct)
+			au.hotAccounts.recordAccess(addr, macct, macct.Round)
+			return macct.AccountData.GetLedgerCoreAccountData(), rnd, rewardsVersion, rewardsLevel, nil
+		}
+
+		// check the hotAccounts, which keep the frequently looked up accounts once evicted from the baseAccounts
+		if macct, has := au.hotAccounts.read(addr); has {
+			au.hotAccounts.recordAccess(addr, macct, macct.Round)
 			return macct.AccountData.GetLedgerCoreAccountData(), rnd, rewardsVersion, rewardsLevel, nil
 		}
 
@@ -1293,6 +1584,7 @@ func (au *accountUpdates) lookupWithoutRewards(rnd basics.Round, addr basics.Add
 			if persistedData.Ref != nil {
 				// if we read actual data return it
 				au.baseAccounts.writePending(persistedData)
+				au.hotAccounts.recordAccess(addr, persistedData, persistedData.Round)
 				return persistedData.AccountData.GetLedgerCoreAccountData(), rnd, rewardsVersion, rewardsLevel, nil
 			}
 			au.baseAccounts.writeNotFoundPending(addr)
@@ -1587,11 +1879,13 @@ func (au *accountUpdates) postCommit(ctx context.Context, dcc *deferredCommitCon
 
 	for _, persistedAcct := range dcc.updatedPersistedAccounts {
 		au.baseAccounts.write(persistedAcct)
+		au.hotAccounts.update(persistedAcct.Addr, persistedAcct, persistedAcct.Round)
 	}
 
 	for addr, deltas := range dcc.updatedPersistedResources {
 		for _, persistedRes := range deltas {
 			au.baseResources.write(persistedRes, addr)
+			au.hotResources.update(accountCreatable{addr, persistedRes.Aidx}, persistedRes, persistedRes.Round)
 		}
 	}
 
@@ -1750,6 +2044,8 @@ func (au *accountUpdates) vacuumDatabase(ctx context.Context) (err error) {
 	au.baseAccounts.prune(0)
 	au.baseResources.prune(0)
 	au.baseKVs.prune(0)
+	au.hotAccounts.clear()
+	au.hotResources.clear()
 
 	startTime := time.Now()
 	vacuumExitCh := make(chan struct{}, 1)
@@ -1802,6 +2098,7 @@ var ledgerAccountsinitCount = metrics.NewCounter("ledger_accountsinit_count", "c
 var ledgerAccountsinitMicros = metrics.NewCounter("ledger_accountsinit_micros", "µs spent")
 var ledgerCommitroundCount = metrics.NewCounter("ledger_commitround_count", "calls")
 var ledgerCommitroundMicros = metrics.NewCounter("ledger_commitround_micros", "µs spent")
+var ledgerCommitroundLatency = metrics.MakeHistogram(metrics.LedgerCommitRoundLatency, metrics.DefaultLatencyBuckets)
 var ledgerGeneratecatchpointCount = metrics.NewCounter("ledger_generatecatchpoint_count", "calls")
 var ledgerGeneratecatchpointMicros = metrics.NewCounter("ledger_generatecatchpoint_micros", "µs spent")
 var ledgerVacuumCount = metrics.NewCounter("ledger_vacuum_count", "calls")
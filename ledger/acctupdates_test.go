@@ -1033,6 +1033,80 @@ func TestBoxNamesByAppIDs(t *testing.T) {
 	}
 }
 
+func TestBoxNamesByRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	initialBlocksCount := 1
+	accts := make(map[basics.Address]basics.AccountData)
+
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+
+	ml := makeMockLedgerForTracker(t, true, initialBlocksCount, protocol.ConsensusCurrentVersion,
+		[]map[basics.Address]basics.AccountData{accts},
+	)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+
+	knownCreatables := make(map[basics.CreatableIndex]bool)
+	opts := auNewBlockOpts{ledgercore.AccountDeltas{}, protocol.ConsensusCurrentVersion, protoParams, knownCreatables}
+
+	const appID = 7
+	keyPrefix := apps.MakeBoxKey(appID, "")
+	var names []string
+	for i := 0; i < 20; i++ {
+		names = append(names, fmt.Sprintf("box%02d", i))
+	}
+
+	// add the boxes in reverse order, one per round, so that the oldest ones are in the database
+	// and the most recent ones only in the deltas.
+	var currentRound basics.Round
+	for i := len(names) - 1; i >= 0; i-- {
+		currentRound++
+		auNewBlock(t, currentRound, au, accts, opts, map[string]ledgercore.KvValueDelta{
+			apps.MakeBoxKey(appID, names[i]): {Data: []byte(names[i])},
+			// a box of another app, which is never returned
+			apps.MakeBoxKey(appID+1, names[i]): {Data: []byte(names[i])},
+		})
+		auCommitSync(t, currentRound, au, ml)
+	}
+	require.Positive(t, au.cachedDBRound)
+
+	// delete a box of the database in a round still in the deltas
+	currentRound++
+	auNewBlock(t, currentRound, au, accts, opts, map[string]ledgercore.KvValueDelta{
+		apps.MakeBoxKey(appID, names[15]): {},
+	})
+	auCommitSync(t, currentRound, au, ml)
+	expected := append(append([]string{}, names[:15]...), names[16:]...)
+
+	// page through the boxes
+	var found []string
+	from := keyPrefix
+	for {
+		keys, err := au.LookupKeysByRange(currentRound, keyPrefix, from, 4)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(keys), 4)
+		for _, key := range keys {
+			found = append(found, key[len(keyPrefix):])
+		}
+		if len(keys) < 4 {
+			break
+		}
+		from = keys[len(keys)-1] + "\x00"
+	}
+	require.Equal(t, expected, found)
+
+	keys, err := au.LookupKeysByRange(currentRound, apps.MakeBoxKey(appID, "box1"), apps.MakeBoxKey(appID, "box13"), 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		apps.MakeBoxKey(appID, "box13"), apps.MakeBoxKey(appID, "box14"), apps.MakeBoxKey(appID, "box16"),
+		apps.MakeBoxKey(appID, "box17"), apps.MakeBoxKey(appID, "box18"), apps.MakeBoxKey(appID, "box19"),
+	}, keys)
+}
+
 func TestKVCache(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+	"golang.org/x/exp/slices"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrAppEventIndexDisabled is returned when querying the application event
+// index on a ledger that was not configured with EnableAppEventIndex.
+var ErrAppEventIndexDisabled = errors.New("application event index is not enabled")
+
+// appEventPruneInterval is the number of rounds the events older than the
+// retention are allowed to linger before they are pruned.
+const appEventPruneInterval = 64
+
+// appEventTracker indexes the ARC-28 events emitted by application calls, i.e.
+// their logs, by application. Any log of at least 4 bytes is considered to be an
+// event, its first 4 bytes being the selector of the event. The index is held in
+// memory; on startup it is rebuilt from the blocks retained in the block database,
+// so its history extends as far back as the node keeps blocks, within the
+// configured retention.
+type appEventTracker struct {
+	enabled   bool
+	retention basics.Round
+
+	mu    deadlock.RWMutex
+	since basics.Round
+	// byApp holds the events of each application, ordered by round.
+	byApp map[basics.AppIndex][]ledgercore.AppEvent
+}
+
+func (aet *appEventTracker) initialize(cfg config.Local) {
+	aet.enabled = cfg.FeatureRequested(config.FeatureAppEventIndex)
+	aet.retention = basics.Round(cfg.AppEventIndexRounds)
+}
+
+func (aet *appEventTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	aet.mu.Lock()
+	defer aet.mu.Unlock()
+
+	aet.since = 0
+	aet.byApp = make(map[basics.AppIndex][]ledgercore.AppEvent)
+	if !aet.enabled {
+		return nil
+	}
+
+	// blocks past dbRound are delivered through newBlock by the tracker replay.
+	var earliest basics.Round
+	blockDBs := l.blockDB()
+	err := blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		earliest, err = blockdb.BlockEarliest(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if aet.retention > 0 && dbRound >= aet.retention && dbRound-aet.retention+1 > earliest {
+		earliest = dbRound - aet.retention + 1
+	}
+	aet.since = earliest
+
+	for rnd := earliest; rnd <= dbRound; rnd++ {
+		blk, err := l.Block(rnd)
+		if err != nil {
+			return fmt.Errorf("appEventTracker: unable to load block %d : %w", rnd, err)
+		}
+		err = aet.indexBlock(blk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (aet *appEventTracker) close() {
+}
+
+func (aet *appEventTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !aet.enabled {
+		return
+	}
+
+	aet.mu.Lock()
+	defer aet.mu.Unlock()
+	// the block was already validated, so its payset is known to decode.
+	_ = aet.indexBlock(blk)
+	aet.prune(blk.Round())
+}
+
+// indexBlock appends the events of blk to the index. The caller must hold aet.mu.
+func (aet *appEventTracker) indexBlock(blk bookkeeping.Block) error {
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		return fmt.Errorf("appEventTracker: unable to decode payset of block %d : %w", blk.Round(), err)
+	}
+	for _, stxn := range payset {
+		aet.indexTxn(blk.Round(), stxn.ID(), stxn, false)
+	}
+	return nil
+}
+
+func (aet *appEventTracker) indexTxn(rnd basics.Round, txid transactions.Txid, stxn transactions.SignedTxnWithAD, inner bool) {
+	if stxn.Txn.Type == protocol.ApplicationCallTx {
+		app := stxn.Txn.ApplicationID
+		if app == 0 {
+			app = stxn.ApplyData.ApplicationID
+		}
+		for i, log := range stxn.ApplyData.EvalDelta.Logs {
+			if len(log) < len(ledgercore.AppEventSelector{}) {
+				continue
+			}
+			ev := ledgercore.AppEvent{
+				Round:    rnd,
+				TxID:     txid,
+				Inner:    inner,
+				App:      app,
+				LogIndex: uint64(i),
+				Data:     []byte(log[len(ledgercore.AppEventSelector{}):]),
+			}
+			copy(ev.Selector[:], log)
+			aet.byApp[app] = append(aet.byApp[app], ev)
+		}
+	}
+
+	for _, itxn := range stxn.ApplyData.EvalDelta.InnerTxns {
+		aet.indexTxn(rnd, txid, itxn, true)
+	}
+}
+
+// prune drops the events older than the retention, once they lag by more than
+// appEventPruneInterval rounds. The caller must hold aet.mu.
+func (aet *appEventTracker) prune(latest basics.Round) {
+	if aet.retention == 0 || latest < aet.retention {
+		return
+	}
+	cutoff := latest - aet.retention + 1
+	if cutoff < aet.since+appEventPruneInterval {
+		return
+	}
+	for app, events := range aet.byApp {
+		i := sort.Search(len(events), func(i int) bool { return events[i].Round >= cutoff })
+		if i == len(events) {
+			delete(aet.byApp, app)
+		} else if i > 0 {
+			aet.byApp[app] = slices.Clone(events[i:])
+		}
+	}
+	aet.since = cutoff
+}
+
+// lookup returns up to limit events of app emitted within [minRound, maxRound], the
+// oldest ones first. A nil selector matches any event, a zero maxRound leaves the
+// range open-ended and a limit of 0 returns all of them.
+func (aet *appEventTracker) lookup(app basics.AppIndex, selector *ledgercore.AppEventSelector, minRound, maxRound basics.Round, limit uint64) (ledgercore.AppEvents, error) {
+	if !aet.enabled {
+		return ledgercore.AppEvents{}, ErrAppEventIndexDisabled
+	}
+
+	aet.mu.RLock()
+	defer aet.mu.RUnlock()
+
+	result := ledgercore.AppEvents{Since: aet.since}
+	events := aet.byApp[app]
+	start := sort.Search(len(events), func(i int) bool { return events[i].Round >= minRound })
+	for _, ev := range events[start:] {
+		if maxRound != 0 && ev.Round > maxRound {
+			break
+		}
+		if selector != nil && ev.Selector != *selector {
+			continue
+		}
+		if limit > 0 && uint64(len(result.Events)) == limit {
+			break
+		}
+		result.Events = append(result.Events, ev)
+	}
+	return result, nil
+}
+
+func (aet *appEventTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+func (aet *appEventTracker) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+func (aet *appEventTracker) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+func (aet *appEventTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (aet *appEventTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (aet *appEventTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (aet *appEventTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (aet *appEventTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (aet *appEventTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
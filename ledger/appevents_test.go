@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAppEventTrackerIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sender := ledgertesting.RandomAddress()
+	swap := ledgercore.MakeAppEventSelector("Swap(uint64,uint64)")
+	mint := ledgercore.MakeAppEventSelector("Mint(address,uint64)")
+	event := func(selector ledgercore.AppEventSelector, data string) string {
+		return string(selector[:]) + data
+	}
+	appCall := func(app basics.AppIndex, logs ...string) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = protocol.ApplicationCallTx
+		stxn.Txn.Sender = sender
+		stxn.Txn.ApplicationID = app
+		stxn.ApplyData.EvalDelta.Logs = logs
+		return stxn
+	}
+
+	creation := appCall(0, event(mint, "m1"))
+	creation.ApplyData.ApplicationID = 9
+	outer := appCall(5, "abc")
+	outer.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{appCall(7, event(swap, "s3"))}
+
+	aet := appEventTracker{enabled: true}
+	aet.byApp = make(map[basics.AppIndex][]ledgercore.AppEvent)
+	aet.newBlock(makeComplianceTestBlock(t, 1, appCall(7, event(swap, "s1"), event(mint, "m0"))), ledgercore.StateDelta{})
+	aet.newBlock(makeComplianceTestBlock(t, 2, creation, appCall(7, event(swap, "s2"))), ledgercore.StateDelta{})
+	aet.newBlock(makeComplianceTestBlock(t, 3, outer), ledgercore.StateDelta{})
+
+	events, err := aet.lookup(7, nil, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events.Events, 4)
+	require.Equal(t, ledgercore.AppEvent{Round: 1, TxID: events.Events[0].TxID, App: 7, Selector: swap, Data: []byte("s1")}, events.Events[0])
+	require.Equal(t, uint64(1), events.Events[1].LogIndex)
+	require.Equal(t, mint, events.Events[1].Selector)
+
+	// logs shorter than a selector are not events
+	events, err = aet.lookup(5, nil, 0, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, events.Events)
+
+	// inner events are recorded against their top-level transaction
+	events, err = aet.lookup(7, &swap, 2, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events.Events, 2)
+	require.Equal(t, []byte("s2"), events.Events[0].Data)
+	require.Equal(t, []byte("s3"), events.Events[1].Data)
+	require.True(t, events.Events[1].Inner)
+	outer.Txn.GenesisHash = crypto.Hash([]byte(t.Name()))
+	require.Equal(t, outer.ID(), events.Events[1].TxID)
+
+	events, err = aet.lookup(7, &swap, 0, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	require.Equal(t, []byte("s1"), events.Events[0].Data)
+
+	// events of created applications are recorded against the new application
+	events, err = aet.lookup(9, &mint, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	require.Equal(t, []byte("m1"), events.Events[0].Data)
+}
+
+func TestAppEventTrackerPrune(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	swap := ledgercore.MakeAppEventSelector("Swap(uint64,uint64)")
+	var stxn transactions.SignedTxnWithAD
+	stxn.Txn.Type = protocol.ApplicationCallTx
+	stxn.Txn.ApplicationID = 7
+	stxn.ApplyData.EvalDelta.Logs = []string{string(swap[:])}
+
+	aet := appEventTracker{enabled: true, retention: 10}
+	aet.byApp = make(map[basics.AppIndex][]ledgercore.AppEvent)
+	// the events older than the last 10 rounds are pruned once they lag by appEventPruneInterval rounds
+	last := basics.Round(appEventPruneInterval + 9)
+	for rnd := basics.Round(1); rnd <= last; rnd++ {
+		aet.newBlock(makeComplianceTestBlock(t, rnd, stxn), ledgercore.StateDelta{})
+		events, err := aet.lookup(7, nil, 0, 0, 0)
+		require.NoError(t, err)
+		if rnd < last {
+			require.Zero(t, events.Since)
+			require.Len(t, events.Events, int(rnd))
+		}
+	}
+
+	events, err := aet.lookup(7, nil, 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, last-9, events.Since)
+	require.Len(t, events.Events, 10)
+	require.Equal(t, last-9, events.Events[0].Round)
+}
+
+func TestAppEventsConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.AppEvents(1, nil, 0, 0, 0)
+	require.ErrorIs(t, err, ErrAppEventIndexDisabled)
+
+	cfg.EnableAppEventIndex = true
+	l2, err := OpenLedger(logging.TestingLog(t), t.Name()+"-enabled", true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	l2.WaitForCommit(l2.Latest())
+	require.NoError(t, l2.reloadLedger())
+
+	events, err := l2.AppEvents(1, nil, 0, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, events.Events)
+}
@@ -78,6 +78,13 @@ func createApplication(ac *transactions.ApplicationCallTxnFields, balances Balan
 	// Allocate the new app params (+ 1 to match Assets Idx namespace)
 	appIdx = basics.AppIndex(txnCounter + 1)
 
+	// The devnet oracle app is served from the consensus parameters, its ID is never allocated
+	oracleAppID := balances.ConsensusParams().DevnetOracleAppID
+	if oracleAppID != 0 && uint64(appIdx) == oracleAppID {
+		err = fmt.Errorf("cannot create app %d: the index is reserved for the devnet oracle", appIdx)
+		return
+	}
+
 	// Sanity check that there isn't an app with this counter value.
 	var present bool
 	_, present, err = balances.GetAppParams(creator, appIdx)
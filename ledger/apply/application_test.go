@@ -464,6 +464,14 @@ func TestAppCallCreate(t *testing.T) {
 	a.Equal(ac.LocalStateSchema, params.LocalStateSchema)
 	a.Equal(ac.GlobalStateSchema, params.GlobalStateSchema)
 	a.Equal(1, len(b.createdCreatables))
+
+	// the index of the devnet oracle app is never allocated
+	proto := b.ConsensusParams()
+	proto.DevnetOracleAppID = txnCounter + 2
+	b.SetParams(proto)
+	_, err = createApplication(&ac, b, creator, txnCounter+1)
+	a.ErrorContains(err, "reserved for the devnet oracle")
+	a.Equal(1, b.putAppParams)
 }
 
 // TestAppCallApplyCreate carefully tracks and validates balance record updates
@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -814,3 +815,145 @@ func checkTrackers(t *testing.T, wl *wrappedLedger, rnd basics.Round) (basics.Ro
 
 	return minMinSave, nil
 }
+
+func TestArchivalWindow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Start in non-archival mode with a partial archival range, add 2K blocks,
+	// ensure the blocks in the range are kept, restart, ensure the range is reported
+
+	const maxBlocks = 2000
+	tests := []struct {
+		name          string
+		sinceRound    uint64
+		windowRounds  uint64
+		expectedFirst basics.Round
+	}{
+		{"since", 100, 0, 100},
+		{"window", 0, 1500, maxBlocks - 1500 + 1},
+		{"union", 800, 1500, maxBlocks - 1500 + 1},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			dbName := fmt.Sprintf("%s.%d", test.name, crypto.RandUint64())
+			dbPrefix := filepath.Join(t.TempDir(), dbName)
+
+			genesisInitState := getInitState()
+			const inMem = false // use persistent storage
+			cfg := config.GetDefaultLocal()
+			cfg.Archival = false
+			cfg.ArchivalSinceRound = test.sinceRound
+			cfg.ArchivalWindowRounds = test.windowRounds
+
+			l, err := OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+			require.NoError(t, err)
+			blk := genesisInitState.Block
+
+			for i := 0; i < maxBlocks; i++ {
+				blk.BlockHeader.Round++
+				blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+				l.AddBlock(blk, agreement.Certificate{})
+			}
+			l.WaitForCommit(blk.Round())
+
+			// the in-memory earliest round is updated after the blocks are forgotten
+			reported := l.EarliestBlock()
+			var earliest basics.Round
+			err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+				earliest, err = blockdb.BlockEarliest(tx)
+				return err
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, earliest, test.expectedFirst)
+			require.LessOrEqual(t, reported, earliest)
+
+			l.Close()
+			l, err = OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+			require.NoError(t, err)
+			defer l.Close()
+			require.Equal(t, earliest, l.EarliestBlock())
+			require.Equal(t, basics.Round(maxBlocks), l.Latest())
+		})
+	}
+}
+
+func TestMaxBlockHistoryRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Start in archival mode with a retention window, add 2K blocks, ensure
+	// the blocks behind the retention horizon are pruned and reported as such
+
+	const maxBlocks = 2000
+	const retention = 500
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	dbPrefix := filepath.Join(t.TempDir(), dbName)
+
+	genesisInitState := getInitState()
+	const inMem = false // use persistent storage
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.MaxBlockHistoryRounds = retention
+
+	l, err := OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	blk := genesisInitState.Block
+
+	for i := 0; i < maxBlocks; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		l.AddBlock(blk, agreement.Certificate{})
+	}
+	l.WaitForCommit(blk.Round())
+
+	// the blocks are pruned in the background
+	horizon := basics.Round(maxBlocks - retention + 1)
+	require.Eventually(t, func() bool {
+		return l.EarliestBlock() > 1
+	}, 10*time.Second, 10*time.Millisecond)
+	earliest := l.EarliestBlock()
+	require.LessOrEqual(t, earliest, horizon)
+
+	_, err = l.Block(earliest)
+	require.NoError(t, err)
+	_, err = l.Block(earliest - 1)
+	var noEntry ledgercore.ErrNoEntry
+	require.ErrorAs(t, err, &noEntry)
+	require.True(t, noEntry.Pruned())
+	require.Equal(t, earliest, noEntry.Earliest)
+	require.Contains(t, err.Error(), "retention horizon")
+
+	// a missing future block is not reported as pruned
+	_, err = l.Block(blk.Round() + 1)
+	require.ErrorAs(t, err, &noEntry)
+	require.False(t, noEntry.Pruned())
+}
+
+func TestRetentionMinToSave(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		maxBlockHistory basics.Round
+		round           basics.Round
+		trackersMin     basics.Round
+		minToSave       basics.Round
+		expected        basics.Round
+	}{
+		{"disabled", 0, 2000, 1500, 0, 0},
+		{"below window", 500, 400, 300, 0, 0},
+		{"archival", 500, 2000, 1800, 0, 1501},
+		{"trackers need more", 500, 2000, 1000, 0, 1000},
+		{"partial archival", 500, 2000, 1800, 1200, 1501},
+		{"trackers keep less", 500, 2000, 1900, 1900, 1900},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			l := &Ledger{maxBlockHistory: test.maxBlockHistory}
+			require.Equal(t, test.expected, l.retentionMinToSave(test.round, test.trackersMin, test.minToSave))
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeComplianceTestBlock(t *testing.T, rnd basics.Round, txns ...transactions.SignedTxnWithAD) bookkeeping.Block {
+	blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+	blk.BlockHeader.GenesisHash = crypto.Hash([]byte(t.Name()))
+	for _, txn := range txns {
+		txn.Txn.GenesisHash = blk.GenesisHash()
+		txib, err := blk.EncodeSignedTxn(txn.SignedTxn, txn.ApplyData)
+		require.NoError(t, err)
+		blk.Payset = append(blk.Payset, txib)
+	}
+	return blk
+}
+
+func TestAssetComplianceTrackerIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	manager := ledgertesting.RandomAddress()
+	clawback := ledgertesting.RandomAddress()
+	holder := ledgertesting.RandomAddress()
+	other := ledgertesting.RandomAddress()
+	app := basics.AppIndex(50)
+
+	freeze := func(asset basics.AssetIndex, target basics.Address, frozen bool) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = protocol.AssetFreezeTx
+		stxn.Txn.Sender = manager
+		stxn.Txn.FreezeAsset = asset
+		stxn.Txn.FreezeAccount = target
+		stxn.Txn.AssetFrozen = frozen
+		return stxn
+	}
+	xfer := func(asset basics.AssetIndex, sender, revoked basics.Address, amount uint64) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = protocol.AssetTransferTx
+		stxn.Txn.Sender = sender
+		stxn.Txn.XferAsset = asset
+		stxn.Txn.AssetSender = revoked
+		stxn.Txn.AssetReceiver = sender
+		stxn.Txn.AssetAmount = amount
+		return stxn
+	}
+
+	appCall := transactions.SignedTxnWithAD{}
+	appCall.Txn.Type = protocol.ApplicationCallTx
+	appCall.Txn.Sender = manager
+	appCall.Txn.ApplicationID = app
+	appCall.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{xfer(7, app.Address(), other, 3)}
+
+	act := assetComplianceTracker{enabled: true}
+	act.reset()
+	act.newBlock(makeComplianceTestBlock(t, 1, freeze(7, holder, true), xfer(7, holder, basics.Address{}, 10)), ledgercore.StateDelta{})
+	act.newBlock(makeComplianceTestBlock(t, 2, xfer(7, clawback, holder, 5), freeze(8, holder, true)), ledgercore.StateDelta{})
+	act.newBlock(makeComplianceTestBlock(t, 3, freeze(7, holder, false), appCall), ledgercore.StateDelta{})
+
+	events, err := act.lookup(7, basics.Address{}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, ledgercore.AssetFreezeEvent, events[0].Kind)
+	require.Equal(t, basics.Round(1), events[0].Round)
+	require.Equal(t, holder, events[0].Target)
+	require.Equal(t, manager, events[0].Sender)
+
+	require.Equal(t, ledgercore.AssetClawbackEvent, events[1].Kind)
+	require.Equal(t, holder, events[1].Target)
+	require.Equal(t, clawback, events[1].Sender)
+	require.Equal(t, clawback, events[1].Receiver)
+	require.Equal(t, uint64(5), events[1].Amount)
+
+	require.Equal(t, ledgercore.AssetUnfreezeEvent, events[2].Kind)
+
+	require.Equal(t, ledgercore.AssetClawbackEvent, events[3].Kind)
+	require.True(t, events[3].Inner)
+	appCall.Txn.GenesisHash = crypto.Hash([]byte(t.Name()))
+	require.Equal(t, appCall.ID(), events[3].TxID)
+	require.Equal(t, other, events[3].Target)
+
+	events, err = act.lookup(7, holder, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ledgercore.AssetClawbackEvent, events[0].Kind)
+	require.Equal(t, ledgercore.AssetUnfreezeEvent, events[1].Kind)
+
+	events, err = act.lookup(0, holder, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, basics.AssetIndex(8), events[2].Asset)
+
+	_, err = act.lookup(0, basics.Address{}, 0, 0)
+	require.Error(t, err)
+}
+
+func TestAssetComplianceTrackerPrune(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	holder := ledgertesting.RandomAddress()
+	var stxn transactions.SignedTxnWithAD
+	stxn.Txn.Type = protocol.AssetFreezeTx
+	stxn.Txn.FreezeAsset = 7
+	stxn.Txn.FreezeAccount = holder
+
+	act := assetComplianceTracker{enabled: true, retention: 10}
+	act.reset()
+	// the events older than the last 10 rounds are pruned once they lag by assetCompliancePruneInterval rounds
+	last := basics.Round(assetCompliancePruneInterval + 9)
+	for rnd := basics.Round(1); rnd <= last; rnd++ {
+		act.newBlock(makeComplianceTestBlock(t, rnd, stxn), ledgercore.StateDelta{})
+		if rnd < last {
+			events, err := act.lookup(7, basics.Address{}, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, events, int(rnd))
+		}
+	}
+
+	// both indexes only reference the remaining events
+	require.Equal(t, last-9, act.since)
+	for _, target := range []basics.Address{{}, holder} {
+		events, err := act.lookup(7, target, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 10)
+		require.Equal(t, last-9, events[0].Round)
+		require.Equal(t, last, events[9].Round)
+	}
+	events, err := act.lookup(0, holder, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 10)
+}
+
+func TestAssetComplianceEventsConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.AssetComplianceEvents(1, basics.Address{}, 0, 0)
+	require.ErrorIs(t, err, ErrAssetComplianceIndexDisabled)
+
+	cfg.EnableAssetComplianceIndex = true
+	l2, err := OpenLedger(logging.TestingLog(t), t.Name()+"-enabled", true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	l2.WaitForCommit(l2.Latest())
+	require.NoError(t, l2.reloadLedger())
+
+	events, err := l2.AssetComplianceEvents(1, basics.Address{}, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
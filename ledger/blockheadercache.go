@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"container/list"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// blockHeaderCache keeps the most recently used block headers read from the block database, so that the
+// lookups of older headers than the ones the txTail keeps don't hit the database when they are repeated.
+// A cache of size 0 keeps nothing.
+type blockHeaderCache struct {
+	mu deadlock.Mutex
+
+	size int
+	// headers contain the cached headers, the most recently used ones at the front.
+	headers *list.List
+	rounds  map[basics.Round]*list.Element
+}
+
+func makeBlockHeaderCache(size int) *blockHeaderCache {
+	return &blockHeaderCache{
+		size:    size,
+		headers: list.New(),
+		rounds:  make(map[basics.Round]*list.Element, size),
+	}
+}
+
+// get returns the cached header of the given round.
+func (c *blockHeaderCache) get(rnd basics.Round) (bookkeeping.BlockHeader, bool) {
+	if c.size == 0 {
+		return bookkeeping.BlockHeader{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.rounds[rnd]
+	if !ok {
+		ledgerBlockhdrCacheMisses.Inc(nil)
+		return bookkeeping.BlockHeader{}, false
+	}
+	ledgerBlockhdrCacheHits.Inc(nil)
+	c.headers.MoveToFront(el)
+	return el.Value.(bookkeeping.BlockHeader), true
+}
+
+// put adds a header to the cache, evicting the least recently used one when the cache is full.
+func (c *blockHeaderCache) put(hdr bookkeeping.BlockHeader) {
+	if c.size == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.rounds[hdr.Round]; ok {
+		c.headers.MoveToFront(el)
+		return
+	}
+	c.rounds[hdr.Round] = c.headers.PushFront(hdr)
+	if c.headers.Len() > c.size {
+		oldest := c.headers.Back()
+		c.headers.Remove(oldest)
+		delete(c.rounds, oldest.Value.(bookkeeping.BlockHeader).Round)
+	}
+}
+
+// clear drops all the cached headers.
+func (c *blockHeaderCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headers.Init()
+	c.rounds = make(map[basics.Round]*list.Element, c.size)
+}
+
+var ledgerBlockhdrCacheHits = metrics.NewCounter("ledger_blockhdr_cache_hits", "block header lookups served by the block header cache")
+var ledgerBlockhdrCacheMisses = metrics.NewCounter("ledger_blockhdr_cache_misses", "block header lookups missing the block header cache")
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockHeaderCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeBlockHeaderCache(2)
+	for rnd := basics.Round(1); rnd <= 2; rnd++ {
+		c.put(bookkeeping.BlockHeader{Round: rnd, TimeStamp: int64(rnd)})
+	}
+
+	hdr, ok := c.get(1)
+	require.True(t, ok)
+	require.Equal(t, int64(1), hdr.TimeStamp)
+
+	// round 2 is the least recently used one.
+	c.put(bookkeeping.BlockHeader{Round: 3})
+	_, ok = c.get(2)
+	require.False(t, ok)
+	_, ok = c.get(1)
+	require.True(t, ok)
+	_, ok = c.get(3)
+	require.True(t, ok)
+
+	c.clear()
+	_, ok = c.get(1)
+	require.False(t, ok)
+
+	disabled := makeBlockHeaderCache(0)
+	disabled.put(bookkeeping.BlockHeader{Round: 1})
+	_, ok = disabled.get(1)
+	require.False(t, ok)
+}
@@ -40,22 +40,34 @@ type blockEntry struct {
 	cert  agreement.Certificate
 }
 
+// blockPruneBatchRounds is the maximal number of rounds whose blocks the
+// syncer deletes in a single database transaction.
+const blockPruneBatchRounds = 10000
+
 type blockQueue struct {
 	l *Ledger
 
 	lastCommitted basics.Round
-	q             []blockEntry
+	// earliest is the earliest round still kept in the block database.
+	earliest basics.Round
+	// pruneTarget is the round before which the syncer deletes the blocks.
+	pruneTarget basics.Round
+	q           []blockEntry
 
 	mu      deadlock.Mutex
 	cond    *sync.Cond
 	running bool
 	closed  chan struct{}
+
+	// hdrCache keeps the headers recently read from the block database.
+	hdrCache *blockHeaderCache
 }
 
 func newBlockQueue(l *Ledger) (*blockQueue, error) {
 	bq := &blockQueue{}
 	bq.cond = sync.NewCond(&bq.mu)
 	bq.l = l
+	bq.hdrCache = makeBlockHeaderCache(int(l.cfg.BlockHeaderCacheRounds))
 	return bq, nil
 }
 
@@ -77,11 +89,17 @@ func (bq *blockQueue) start() error {
 	}
 	bq.running = true
 	bq.closed = make(chan struct{})
+	// the blocks database may have been replaced, e.g. by a catchpoint catchup.
+	bq.hdrCache.clear()
 	ledgerBlockqInitCount.Inc(nil)
 	start := time.Now()
 	err := bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var err0 error
 		bq.lastCommitted, err0 = blockdb.BlockLatest(tx)
+		if err0 != nil {
+			return err0
+		}
+		bq.earliest, err0 = blockdb.BlockEarliest(tx)
 		return err0
 	})
 	ledgerBlockqInitMicros.AddMicrosecondsSince(start, nil)
@@ -89,6 +107,7 @@ func (bq *blockQueue) start() error {
 		return err
 	}
 
+	bq.pruneTarget = bq.earliest
 	go bq.syncer()
 	return nil
 }
@@ -164,21 +183,54 @@ func (bq *blockQueue) syncer() {
 			bq.mu.Unlock()
 
 			minToSave := bq.l.notifyCommit(committed)
-			bfstart := time.Now()
-			ledgerSyncBlockforgetCount.Inc(nil)
-			err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-				return blockdb.BlockForgetBefore(tx, minToSave)
-			})
-			ledgerSyncBlockforgetMicros.AddMicrosecondsSince(bfstart, nil)
-			if err != nil {
-				bq.l.log.Warnf("blockQueue.syncer: blockForgetBefore(%d): %v", minToSave, err)
+
+			bq.mu.Lock()
+			if minToSave > bq.pruneTarget {
+				bq.pruneTarget = minToSave
 			}
+			bq.mu.Unlock()
+
+			bq.pruneBatch()
 
 			bq.mu.Lock()
 		}
 	}
 }
 
+// pruneBatch deletes the blocks before pruneTarget from the block database, at
+// most blockPruneBatchRounds rounds of them so that a large backlog of blocks
+// does not hold the database for long: the rest is left to the next flushes.
+// It runs in the syncer, as the writes to the block database must not overlap.
+func (bq *blockQueue) pruneBatch() {
+	bq.mu.Lock()
+	target, earliest := bq.pruneTarget, bq.earliest
+	bq.mu.Unlock()
+	if target <= earliest {
+		return
+	}
+	next := target
+	if next-earliest > blockPruneBatchRounds {
+		next = earliest + blockPruneBatchRounds
+	}
+
+	bfstart := time.Now()
+	ledgerSyncBlockforgetCount.Inc(nil)
+	err := bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockdb.BlockForgetBefore(tx, next)
+	})
+	ledgerSyncBlockforgetMicros.AddMicrosecondsSince(bfstart, nil)
+	if err != nil {
+		bq.l.log.Warnf("blockQueue.syncer: blockForgetBefore(%d): %v", next, err)
+		return
+	}
+
+	bq.mu.Lock()
+	if next > bq.earliest {
+		bq.earliest = next
+	}
+	bq.mu.Unlock()
+}
+
 func (bq *blockQueue) waitCommit(r basics.Round) {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
@@ -194,6 +246,14 @@ func (bq *blockQueue) latest() basics.Round {
 	return bq.lastCommitted + basics.Round(len(bq.q))
 }
 
+// earliestCommitted returns the earliest round whose block is kept in the
+// block database.
+func (bq *blockQueue) earliestCommitted() basics.Round {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.earliest
+}
+
 func (bq *blockQueue) latestCommitted() (basics.Round, basics.Round) {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
@@ -255,12 +315,15 @@ func (bq *blockQueue) checkEntry(r basics.Round) (e *blockEntry, lastCommitted b
 	return &bq.q[r-bq.lastCommitted-1], lastCommitted, latest, nil
 }
 
-func updateErrNoEntry(err error, lastCommitted basics.Round, latest basics.Round) error {
+func (bq *blockQueue) updateErrNoEntry(err error, lastCommitted basics.Round, latest basics.Round) error {
 	if err != nil {
 		switch errt := err.(type) {
 		case ledgercore.ErrNoEntry:
 			errt.Committed = lastCommitted
 			errt.Latest = latest
+			if earliest := bq.earliestCommitted(); errt.Round < earliest {
+				errt.Earliest = earliest
+			}
 			return errt
 		}
 	}
@@ -286,7 +349,7 @@ func (bq *blockQueue) getBlock(r basics.Round) (blk bookkeeping.Block, err error
 		return err0
 	})
 	ledgerGetblockMicros.AddMicrosecondsSince(start, nil)
-	err = updateErrNoEntry(err, lastCommitted, latest)
+	err = bq.updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
 
@@ -300,6 +363,13 @@ func (bq *blockQueue) getBlockHdr(r basics.Round) (hdr bookkeeping.BlockHeader,
 		return
 	}
 
+	// the headers of the pruned rounds may still be cached.
+	if r >= bq.earliestCommitted() {
+		if cached, ok := bq.hdrCache.get(r); ok {
+			return cached, nil
+		}
+	}
+
 	start := time.Now()
 	ledgerGetblockhdrCount.Inc(nil)
 	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -308,7 +378,10 @@ func (bq *blockQueue) getBlockHdr(r basics.Round) (hdr bookkeeping.BlockHeader,
 		return err0
 	})
 	ledgerGetblockhdrMicros.AddMicrosecondsSince(start, nil)
-	err = updateErrNoEntry(err, lastCommitted, latest)
+	if err == nil {
+		bq.hdrCache.put(hdr)
+	}
+	err = bq.updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
 
@@ -334,7 +407,7 @@ func (bq *blockQueue) getEncodedBlockCert(r basics.Round) (blk []byte, cert []by
 		return err0
 	})
 	ledgerGeteblockcertMicros.AddMicrosecondsSince(start, nil)
-	err = updateErrNoEntry(err, lastCommitted, latest)
+	err = bq.updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
 
@@ -356,7 +429,7 @@ func (bq *blockQueue) getBlockCert(r basics.Round) (blk bookkeeping.Block, cert
 		return err0
 	})
 	ledgerGetblockcertMicros.AddMicrosecondsSince(start, nil)
-	err = updateErrNoEntry(err, lastCommitted, latest)
+	err = bq.updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
 
@@ -128,3 +128,31 @@ func TestGetEncodedBlockCert(t *testing.T) {
 	expectedErr := &ledgercore.ErrNoEntry{}
 	require.True(t, errors.As(err, expectedErr))
 }
+
+func TestGetBlockHdrCached(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _, _ := ledgertesting.Genesis(10)
+
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.BlockHeaderCacheRounds = 10
+	l, err := OpenLedger(logging.Base(), t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the genesis block is committed, its header is read from the database and then cached.
+	l.blockQ.hdrCache.clear()
+	hdr, err := l.blockQ.getBlockHdr(0)
+	require.NoError(t, err)
+	require.Equal(t, genesisInitState.Block.BlockHeader, hdr)
+	cached, ok := l.blockQ.hdrCache.get(0)
+	require.True(t, ok)
+	require.Equal(t, hdr, cached)
+
+	hits := ledgerBlockhdrCacheHits.GetUint64Value()
+	hdr, err = l.blockQ.getBlockHdr(0)
+	require.NoError(t, err)
+	require.Equal(t, genesisInitState.Block.BlockHeader, hdr)
+	require.Greater(t, ledgerBlockhdrCacheHits.GetUint64Value(), hits)
+}
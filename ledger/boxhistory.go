@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrBoxHistoryIndexDisabled is returned when querying the box history index on
+// a ledger that was not configured with EnableBoxHistoryIndex.
+var ErrBoxHistoryIndexDisabled = errors.New("box history index is not enabled")
+
+// boxHistoryTracker records the rounds in which boxes are created and deleted.
+// Since the blocks do not carry the box changes, the events are taken from the
+// state deltas and persisted in the block database, ahead of the tracker
+// database commit of the same rounds. The index covers the rounds following the
+// one it was enabled at; it restarts from scratch if the ledger moves past the
+// rounds it covers without it, e.g. after it was disabled or a fast catchup.
+// Only the events not persisted yet are kept in memory; lookups query the block
+// database for the others.
+type boxHistoryTracker struct {
+	enabled bool
+
+	blockDBs db.Pair
+
+	mu    deadlock.RWMutex
+	since basics.Round
+	// pending holds the events not persisted yet, in round order.
+	pending []blockdb.BoxHistoryEvent
+}
+
+func (bht *boxHistoryTracker) initialize(cfg config.Local) {
+	bht.enabled = cfg.EnableBoxHistoryIndex
+}
+
+func (bht *boxHistoryTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	bht.mu.Lock()
+	defer bht.mu.Unlock()
+
+	bht.since = 0
+	bht.pending = nil
+	if !bht.enabled {
+		return nil
+	}
+
+	bht.blockDBs = l.blockDB()
+	err := bht.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := blockdb.BoxHistoryInit(tx)
+		if err != nil {
+			return err
+		}
+		since, through, ok, err := blockdb.BoxHistoryRange(tx)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok || through < dbRound:
+			err = blockdb.BoxHistoryReset(tx, dbRound)
+			since = dbRound + 1
+		case through > dbRound:
+			// the events past dbRound are delivered again through newBlock by the tracker replay.
+			err = blockdb.BoxHistoryTruncate(tx, dbRound)
+		}
+		if err != nil {
+			return err
+		}
+		bht.since = since
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("boxHistoryTracker: unable to load the box history : %w", err)
+	}
+	return nil
+}
+
+func (bht *boxHistoryTracker) close() {
+}
+
+func (bht *boxHistoryTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !bht.enabled {
+		return
+	}
+
+	bht.mu.Lock()
+	defer bht.mu.Unlock()
+	for key, kv := range delta.KvMods {
+		if _, _, err := apps.SplitBoxKey(key); err != nil {
+			continue
+		}
+		// a box created and deleted within the same round never existed as far as
+		// the rounds are concerned, and a box deleted and created again was merely
+		// modified.
+		var ev blockdb.BoxHistoryEvent
+		switch {
+		case kv.OldData == nil && kv.Data != nil:
+			ev = blockdb.BoxHistoryEvent{Key: key, Round: blk.Round()}
+		case kv.OldData != nil && kv.Data == nil:
+			ev = blockdb.BoxHistoryEvent{Key: key, Round: blk.Round(), Deleted: true}
+		default:
+			continue
+		}
+		bht.pending = append(bht.pending, ev)
+	}
+}
+
+// lookup returns the creation and deletion rounds of the box of app named name.
+func (bht *boxHistoryTracker) lookup(app basics.AppIndex, name string) (ledgercore.BoxHistory, error) {
+	if !bht.enabled {
+		return ledgercore.BoxHistory{}, ErrBoxHistoryIndexDisabled
+	}
+
+	key := apps.MakeBoxKey(uint64(app), name)
+	var h ledgercore.BoxHistory
+	// the read lock is held across the query, so that the events prepareCommit
+	// persists meanwhile are still found in pending.
+	bht.mu.RLock()
+	defer bht.mu.RUnlock()
+	err := bht.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		h.Created, h.Deleted, err = blockdb.BoxHistoryLookup(tx, key)
+		return err
+	})
+	if err != nil {
+		return ledgercore.BoxHistory{}, fmt.Errorf("boxHistoryTracker: unable to look up the box history : %w", err)
+	}
+	if h.Deleted < h.Created {
+		h.Deleted = 0
+	}
+	for _, ev := range bht.pending {
+		if ev.Key != key {
+			continue
+		}
+		if ev.Deleted {
+			h.Deleted = ev.Round
+		} else {
+			h.Created = ev.Round
+			h.Deleted = 0
+		}
+	}
+	h.Since = bht.since
+	return h, nil
+}
+
+func (bht *boxHistoryTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+// prepareCommit persists the events of the rounds about to be committed. They
+// are written before the tracker database commit, so that the index never lags
+// behind it; events written for rounds that end up not being committed are
+// dropped by loadFromDisk.
+func (bht *boxHistoryTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if !bht.enabled {
+		return nil
+	}
+
+	newBase := dcc.newBase()
+	bht.mu.RLock()
+	n := 0
+	for n < len(bht.pending) && bht.pending[n].Round <= newBase {
+		n++
+	}
+	events := bht.pending[:n:n]
+	bht.mu.RUnlock()
+
+	err := bht.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockdb.BoxHistoryPut(tx, events, newBase)
+	})
+	if err != nil {
+		return fmt.Errorf("boxHistoryTracker: unable to persist the box history through round %d : %w", newBase, err)
+	}
+
+	// newBlock only appends to pending, so the persisted events are still at its head.
+	bht.mu.Lock()
+	bht.pending = bht.pending[n:]
+	bht.mu.Unlock()
+	return nil
+}
+
+func (bht *boxHistoryTracker) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+func (bht *boxHistoryTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (bht *boxHistoryTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (bht *boxHistoryTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (bht *boxHistoryTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (bht *boxHistoryTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (bht *boxHistoryTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
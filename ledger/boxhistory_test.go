@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBoxHistoryTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	cfg.EnableBoxHistoryIndex = true
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	app := basics.AppIndex(50)
+	keyA := apps.MakeBoxKey(uint64(app), "a")
+	keyB := apps.MakeBoxKey(uint64(app), "b")
+	value := []byte("value")
+	newBlock := func(rnd basics.Round, mods map[string]ledgercore.KvValueDelta) {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+		l.boxHistory.newBlock(blk, ledgercore.StateDelta{KvMods: mods})
+	}
+	lookup := func(name string) ledgercore.BoxHistory {
+		h, err := l.boxHistory.lookup(app, name)
+		require.NoError(t, err)
+		return h
+	}
+
+	require.Equal(t, ledgercore.BoxHistory{Since: 1}, lookup("a"))
+
+	newBlock(1, map[string]ledgercore.KvValueDelta{
+		keyA:               {Data: value},
+		"not a box key":    {Data: value},
+		keyB + "-modified": {Data: value, OldData: value},
+	})
+	newBlock(2, map[string]ledgercore.KvValueDelta{
+		keyA: {OldData: value},
+		keyB: {Data: value},
+	})
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 1, Deleted: 2}, lookup("a"))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 2}, lookup("b"))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1}, lookup("b-modified"))
+
+	// only the committed rounds are persisted
+	require.NoError(t, l.boxHistory.prepareCommit(&deferredCommitContext{deferredCommitRange: deferredCommitRange{oldBase: 0, offset: 1}}))
+	// the lookups combine the persisted events with the pending ones
+	require.Len(t, l.boxHistory.pending, 2)
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 1, Deleted: 2}, lookup("a"))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 2}, lookup("b"))
+	require.NoError(t, l.boxHistory.loadFromDisk(l, 1))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 1}, lookup("a"))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1}, lookup("b"))
+
+	// rounds persisted past the tracker database round are dropped
+	newBlock(2, map[string]ledgercore.KvValueDelta{keyA: {OldData: value}})
+	require.NoError(t, l.boxHistory.prepareCommit(&deferredCommitContext{deferredCommitRange: deferredCommitRange{oldBase: 1, offset: 1}}))
+	require.NoError(t, l.boxHistory.loadFromDisk(l, 2))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 1, Deleted: 2}, lookup("a"))
+	require.NoError(t, l.boxHistory.loadFromDisk(l, 1))
+	require.Equal(t, ledgercore.BoxHistory{Since: 1, Created: 1}, lookup("a"))
+
+	// the index restarts when the ledger moved past the rounds it covers
+	require.NoError(t, l.boxHistory.loadFromDisk(l, 5))
+	require.Equal(t, ledgercore.BoxHistory{Since: 6}, lookup("a"))
+}
+
+func TestBoxHistoryConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.BoxHistory(1, "a")
+	require.ErrorIs(t, err, ErrBoxHistoryIndexDisabled)
+
+	cfg.EnableBoxHistoryIndex = true
+	l2, err := OpenLedger(logging.TestingLog(t), t.Name()+"-enabled", true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	l2.WaitForCommit(l2.Latest())
+	require.NoError(t, l2.reloadLedger())
+
+	h, err := l2.BoxHistory(1, "a")
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.BoxHistory{Since: 1}, h)
+}
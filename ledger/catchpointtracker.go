@@ -75,6 +75,9 @@ const (
 	catchpointBalancesFileNameSuffix   = ".msgpack"
 )
 
+// ErrCatchpointsDisabled is returned when requesting a catchpoint file from a ledger not tracking catchpoints.
+var ErrCatchpointsDisabled = errors.New("catchpoints are not enabled")
+
 func catchpointStage1Encoder(w io.Writer) (io.WriteCloser, error) {
 	return snappy.NewBufferedWriter(w), nil
 }
@@ -124,6 +127,16 @@ type catchpointTracker struct {
 	// processing.
 	catchpointDataSlowWriting chan struct{}
 
+	// generationPaused stops new catchpoints from being started, see Ledger.PauseCatchpointGeneration.
+	generationPaused atomic.Bool
+
+	// requestedFileRound is the round of the catchpoint requested to get a file when the
+	// node isn't configured to generate them, see Ledger.RequestCatchpointFile. Zero when none was requested.
+	requestedFileRound atomic.Uint64
+
+	// fileListeners are notified of the catchpoint files written, see Ledger.RegisterCatchpointFileListener.
+	fileListeners []ledgercore.CatchpointFileListener
+
 	// catchpointDataWriting helps to synchronize the (first stage) catchpoint data file
 	// writing. When this atomic variable is 0, no writing is going on.
 	// Any non-zero value indicates a catchpoint being written, or scheduled to be written.
@@ -149,8 +162,8 @@ type catchpointTracker struct {
 	// catchpoint files even before the protocol upgrade took place.
 	forceCatchpointFileWriting bool
 
-	// catchpointsMu protects `roundDigest`, `reenableCatchpointsRound` and
-	// `lastCatchpointLabel`.
+	// catchpointsMu protects `roundDigest`, `reenableCatchpointsRound`,
+	// `lastCatchpointLabel` and `fileListeners`.
 	catchpointsMu deadlock.RWMutex
 }
 
@@ -218,7 +231,7 @@ func (ct *catchpointTracker) getSPVerificationData() (encodedData []byte, spVeri
 	return encodedData, spVerificationHash, nil
 }
 
-func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, updatingBalancesDuration time.Duration) error {
+func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, updatingBalancesDuration time.Duration, generateFile bool) error {
 	ct.log.Infof("finishing catchpoint's first stage dbRound: %d", dbRound)
 
 	var totalKVs uint64
@@ -236,7 +249,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		return err
 	}
 
-	if ct.enableGeneratingCatchpointFiles {
+	if generateFile {
 		// Generate the catchpoint file. This is done inline so that it will
 		// block any new accounts from being written. generateCatchpointData()
 		// expects that the accounts data would not be modified in the
@@ -289,7 +302,7 @@ func (ct *catchpointTracker) finishFirstStageAfterCrash(dbRound basics.Round) er
 		return err
 	}
 
-	return ct.finishFirstStage(context.Background(), dbRound, 0)
+	return ct.finishFirstStage(context.Background(), dbRound, 0, ct.enableGeneratingCatchpointFiles)
 }
 
 func (ct *catchpointTracker) finishCatchpointsAfterCrash(catchpointLookback uint64) error {
@@ -465,13 +478,16 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 	reenableCatchpointsRound := ct.reenableCatchpointsRound
 	ct.catchpointsMu.Unlock()
 
-	// Check if we need to do the first stage of catchpoint generation.
+	// Check if we need to do the first stage of catchpoint generation. No new catchpoint is
+	// started while the generation is paused, the catchpoints without a first stage are skipped.
 	var hasIntermediateFirstStageRound bool
 	var hasMultipleIntermediateFirstStageRounds bool
-	hasIntermediateFirstStageRound, hasMultipleIntermediateFirstStageRounds, dcr.offset =
-		calculateFirstStageRounds(
-			dcr.oldBase, dcr.offset, reenableCatchpointsRound,
-			ct.catchpointInterval, dcr.catchpointLookback)
+	if !ct.generationPaused.Load() {
+		hasIntermediateFirstStageRound, hasMultipleIntermediateFirstStageRounds, dcr.offset =
+			calculateFirstStageRounds(
+				dcr.oldBase, dcr.offset, reenableCatchpointsRound,
+				ct.catchpointInterval, dcr.catchpointLookback)
+	}
 
 	// if we're still writing the previous balances, we can't move forward yet.
 	if ct.isWritingCatchpointDataFile() {
@@ -490,10 +506,19 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 		return nil
 	}
 
+	dcr.enableGeneratingCatchpointFiles = false
 	if hasIntermediateFirstStageRound {
 		dcr.catchpointFirstStage = true
 
-		if ct.enableGeneratingCatchpointFiles {
+		dcr.enableGeneratingCatchpointFiles = ct.enableGeneratingCatchpointFiles
+		// a catchpoint at or after the requested round gets a file even if the node doesn't generate them.
+		requested := ct.requestedFileRound.Load()
+		if requested != 0 && uint64(dcr.oldBase)+dcr.offset+dcr.catchpointLookback >= requested {
+			dcr.enableGeneratingCatchpointFiles = true
+			ct.requestedFileRound.CompareAndSwap(requested, 0)
+		}
+
+		if dcr.enableGeneratingCatchpointFiles {
 			ct.catchpointDataSlowWriting = make(chan struct{}, 1)
 			if hasMultipleIntermediateFirstStageRounds {
 				close(ct.catchpointDataSlowWriting)
@@ -501,8 +526,6 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 		}
 	}
 
-	dcr.enableGeneratingCatchpointFiles = ct.enableGeneratingCatchpointFiles
-
 	rounds := ct.calculateCatchpointRounds(dcr)
 	dcr.catchpointSecondStage = (len(rounds) > 0)
 
@@ -515,7 +538,7 @@ func (ct *catchpointTracker) prepareCommit(dcc *deferredCommitContext) error {
 	ct.catchpointsMu.RLock()
 	defer ct.catchpointsMu.RUnlock()
 
-	if ct.enableGeneratingCatchpointFiles && dcc.catchpointFirstStage {
+	if dcc.enableGeneratingCatchpointFiles && dcc.catchpointFirstStage {
 		// store non-zero ( all ones ) into the catchpointWriting atomic variable to indicate that a catchpoint is being written
 		atomic.StoreInt32(&ct.catchpointDataWriting, int32(-1))
 	}
@@ -532,7 +555,7 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 	dbRound := dcc.oldBase
 
 	defer func() {
-		if err != nil && dcc.catchpointFirstStage && ct.enableGeneratingCatchpointFiles {
+		if err != nil && dcc.catchpointFirstStage && dcc.enableGeneratingCatchpointFiles {
 			atomic.StoreInt32(&ct.catchpointDataWriting, 0)
 		}
 	}()
@@ -772,10 +795,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 	ct.lastCatchpointLabel = label
 	ct.catchpointsMu.Unlock()
 
-	if !ct.enableGeneratingCatchpointFiles {
-		return nil
-	}
-
+	// The data file exists only when the first stage generated it.
 	catchpointDataFilePath := filepath.Join(ct.dbDirectory, trackerdb.CatchpointDirName)
 	catchpointDataFilePath =
 		filepath.Join(catchpointDataFilePath, makeCatchpointDataFilePath(accountsRound))
@@ -845,9 +865,33 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		With("catchpointLabel", label).
 		Infof("Catchpoint file was created")
 
+	if ct.catchpointFileHistoryLength != 0 {
+		ct.catchpointsMu.RLock()
+		listeners := ct.fileListeners
+		ct.catchpointsMu.RUnlock()
+		for _, listener := range listeners {
+			listener.OnCatchpointFile(round, label)
+		}
+	}
 	return nil
 }
 
+// requestCatchpointFile makes the next catchpoint whose first stage is yet to run, after dbRound, get a
+// file. It returns the round of that catchpoint.
+func (ct *catchpointTracker) requestCatchpointFile(dbRound basics.Round, catchpointLookback uint64) basics.Round {
+	// The smallest multiple of the interval whose accounts round is after dbRound.
+	round := (uint64(dbRound) + catchpointLookback + ct.catchpointInterval) / ct.catchpointInterval * ct.catchpointInterval
+	for {
+		requested := ct.requestedFileRound.Load()
+		if requested != 0 && requested <= round {
+			return basics.Round(requested)
+		}
+		if ct.requestedFileRound.CompareAndSwap(requested, round) {
+			return basics.Round(round)
+		}
+	}
+}
+
 // Try create a catchpoint (a label and possibly a file with db record) and remove
 // the unfinished catchpoint record.
 func (ct *catchpointTracker) finishCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest, catchpointLookback uint64) error {
@@ -924,7 +968,7 @@ func (ct *catchpointTracker) pruneFirstStageRecordsData(ctx context.Context, max
 
 func (ct *catchpointTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
 	if dcc.catchpointFirstStage {
-		err := ct.finishFirstStage(ctx, dcc.newBase(), dcc.updatingBalancesDuration)
+		err := ct.finishFirstStage(ctx, dcc.newBase(), dcc.updatingBalancesDuration, dcc.enableGeneratingCatchpointFiles)
 		if err != nil {
 			ct.log.Warnf(
 				"error finishing catchpoint's first stage dcc.newBase: %d err: %v",
@@ -974,8 +1018,8 @@ func (ct *catchpointTracker) handleCommitError(dcc *deferredCommitContext) {
 }
 
 func (ct *catchpointTracker) cancelWrite(dcc *deferredCommitContext) {
-	// if the node is configured to generate catchpoint files, we might need to update the catchpointWriting variable.
-	if ct.enableGeneratingCatchpointFiles {
+	// if the commit generates catchpoint files, we might need to update the catchpointWriting variable.
+	if dcc.enableGeneratingCatchpointFiles {
 		// determine if this was a catchpoint round
 		if dcc.catchpointFirstStage {
 			// it was a catchpoint round, so update the catchpointWriting to indicate that we're done.
@@ -1423,6 +1467,28 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 	return nil, ledgercore.ErrNoEntry{}
 }
 
+// GetCatchpointLabel returns the catchpoint label this node generated for the provided round.
+// It returns ledgercore.ErrNoEntry if no catchpoint is retained for that round.
+func (ct *catchpointTracker) GetCatchpointLabel(round basics.Round) (string, error) {
+	var label string
+	err := ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		cr, err := tx.MakeCatchpointReader()
+		if err != nil {
+			return err
+		}
+
+		_, label, _, err = cr.GetCatchpoint(ctx, round)
+		return
+	})
+	if err == sql.ErrNoRows || (err == nil && label == "") {
+		return "", ledgercore.ErrNoEntry{Round: round}
+	}
+	if err != nil {
+		return "", fmt.Errorf("catchpointTracker.GetCatchpointLabel() unable to lookup catchpoint %d: %v", round, err)
+	}
+	return label, nil
+}
+
 func (ct *catchpointTracker) catchpointEnabled() bool {
 	return ct.catchpointInterval != 0
 }
@@ -1452,10 +1518,11 @@ func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx trackerdb.
 		if err != nil {
 			return err
 		}
-		// if catchpoint is disabled on this node, we could complete the initialization right here.
-		if !ct.catchpointEnabled() {
-			return nil
-		}
+	}
+	// if catchpoint is disabled on this node, we could complete the initialization right here.
+	// the trie would not be kept up to date anyway, and would be reset on the next start.
+	if !ct.catchpointEnabled() {
+		return nil
 	}
 
 	// create the merkle trie for the balances
@@ -150,6 +150,34 @@ func TestCatchpointGetCatchpointStream(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCatchpointGetCatchpointLabel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	ct := newCatchpointTracker(t, ml, conf, ".")
+	defer ct.close()
+
+	blockHash := crypto.Hash([]byte{1})
+	balancesHash := crypto.Hash([]byte{2})
+	spVerificationHash := crypto.Hash([]byte{3})
+	label := ledgercore.MakeLabel(ledgercore.MakeCatchpointLabelMakerCurrent(basics.Round(1), &blockHash, &balancesHash, ledgercore.AccountTotals{}, &spVerificationHash))
+	err := ct.catchpointStore.StoreCatchpoint(context.Background(), basics.Round(1), "1.catchpoint", label, 3)
+	require.NoError(t, err)
+
+	stored, err := ct.GetCatchpointLabel(basics.Round(1))
+	require.NoError(t, err)
+	require.Equal(t, label, stored)
+
+	_, err = ct.GetCatchpointLabel(basics.Round(2))
+	require.Equal(t, ledgercore.ErrNoEntry{Round: 2}, err)
+}
+
 // TestCatchpointsDeleteStored - The goal of this test is to verify that the deleteStoredCatchpoints function works correctly.
 // It does so by filling up the storedcatchpoints with dummy catchpoint file entries, as well as creating these dummy files on disk.
 // ( the term dummy is only because these aren't real catchpoint files, but rather a zero-length file ). Then, the test calls the function
@@ -1030,6 +1058,57 @@ func TestCatchpointTrackerWaitNotBlocking(t *testing.T) {
 	}
 }
 
+// TestCatchpointGenerationPaused checks no catchpoint first stage is scheduled while the catchpoint generation is
+// paused, and that the commit range isn't shortened for it.
+func TestCatchpointGenerationPaused(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ct := &catchpointTracker{catchpointInterval: 10, reenableCatchpointsRound: 1}
+	ct.generationPaused.Store(true)
+
+	dcr := ct.produceCommittingTask(20, 0, &deferredCommitRange{offset: 20, catchpointLookback: 5})
+	require.NotNil(t, dcr)
+	require.False(t, dcr.catchpointFirstStage)
+	require.Equal(t, uint64(20), dcr.offset)
+
+	ct.generationPaused.Store(false)
+	dcr = ct.produceCommittingTask(20, 0, &deferredCommitRange{offset: 20, catchpointLookback: 5})
+	require.NotNil(t, dcr)
+	require.True(t, dcr.catchpointFirstStage)
+	require.Equal(t, uint64(15), dcr.offset)
+}
+
+// TestCatchpointFileRequested checks a node not generating catchpoint files writes the file of the
+// catchpoint requested, and only that one.
+func TestCatchpointFileRequested(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ct := &catchpointTracker{catchpointInterval: 10, reenableCatchpointsRound: 1}
+
+	dcr := ct.produceCommittingTask(20, 0, &deferredCommitRange{offset: 20, catchpointLookback: 5})
+	require.NotNil(t, dcr)
+	require.True(t, dcr.catchpointFirstStage)
+	require.False(t, dcr.enableGeneratingCatchpointFiles)
+
+	// the first stage of catchpoint 20 runs at round 15, after the db round 12.
+	require.Equal(t, basics.Round(20), ct.requestCatchpointFile(12, 5))
+	// a later request waits for the catchpoint already requested.
+	require.Equal(t, basics.Round(20), ct.requestCatchpointFile(17, 5))
+
+	dcr = ct.produceCommittingTask(20, 0, &deferredCommitRange{offset: 20, catchpointLookback: 5})
+	require.NotNil(t, dcr)
+	require.True(t, dcr.catchpointFirstStage)
+	require.True(t, dcr.enableGeneratingCatchpointFiles)
+	require.Zero(t, ct.requestedFileRound.Load())
+
+	dcr = ct.produceCommittingTask(30, 15, &deferredCommitRange{oldBase: 15, offset: 15, catchpointLookback: 5})
+	require.NotNil(t, dcr)
+	require.True(t, dcr.catchpointFirstStage)
+	require.False(t, dcr.enableGeneratingCatchpointFiles)
+}
+
 func TestCalculateFirstStageRounds(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -235,6 +235,24 @@ const (
 	catchpointCatchupStateLast = CatchpointCatchupStateSwitch
 )
 
+// String returns the name of the catchpoint catchup state, as reported by the REST API.
+func (s CatchpointCatchupState) String() string {
+	switch s {
+	case CatchpointCatchupStateInactive:
+		return "inactive"
+	case CatchpointCatchupStateLedgerDownload:
+		return "ledger-download"
+	case CatchpointCatchupStateLatestBlockDownload:
+		return "latest-block-download"
+	case CatchpointCatchupStateBlocksDownload:
+		return "blocks-download"
+	case CatchpointCatchupStateSwitch:
+		return "switch"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
 // CatchupAccessorClientLedger represents ledger interface needed for catchpoint accessor clients
 type CatchupAccessorClientLedger interface {
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
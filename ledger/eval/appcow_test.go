@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -1406,3 +1407,62 @@ func TestCowDelKey(t *testing.T) {
 	a.Panics(func() { c.delKey(ledgertesting.RandomAddress(), aidx, false, key, 0) })
 	a.Panics(func() { c.delKey(addr, aidx+1, false, key, 0) })
 }
+
+func TestCowGetGlobalDevnetOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+
+	addr := ledgertesting.RandomAddress()
+	aidx := basics.AppIndex(1)
+	c := getCow([]modsData{{addr, basics.CreatableIndex(aidx), basics.AppCreatable}})
+	c.lookupParent = &emptyLedger{}
+	c.mods.Hdr.GenesisHash = crypto.Digest{1}
+	c.proto.DevnetOracleAppID = 5000
+	c.proto.DevnetOracleValues = map[string]config.DevnetOracleValue{
+		"pair":  {Type: config.DevnetOracleBytes, Bytes: "ALGO/USD"},
+		"price": {Type: config.DevnetOracleUint, Uint: 123456},
+		"empty": {Type: config.DevnetOracleBytes},
+		"zero":  {Type: config.DevnetOracleUint},
+	}
+
+	tv, ok, err := c.GetGlobal(5000, "pair")
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(basics.TealValue{Type: basics.TealBytesType, Bytes: "ALGO/USD"}, tv)
+
+	tv, ok, err = c.GetGlobal(5000, "price")
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(basics.TealValue{Type: basics.TealUintType, Uint: 123456}, tv)
+
+	// the type tells empty byte slices from zeros
+	tv, ok, err = c.GetGlobal(5000, "empty")
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(basics.TealValue{Type: basics.TealBytesType}, tv)
+
+	tv, ok, err = c.GetGlobal(5000, "zero")
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(basics.TealValue{Type: basics.TealUintType}, tv)
+
+	_, ok, err = c.GetGlobal(5000, "missing")
+	a.NoError(err)
+	a.False(ok)
+
+	// other apps are still looked up in the ledger
+	_, _, err = c.GetGlobal(aidx+1, "price")
+	a.ErrorContains(err, "does not exist")
+
+	// an app created with the oracle ID before the oracle was set up is not shadowed
+	c.mods.AddCreatable(5000, ledgercore.ModifiedCreatable{Ctype: basics.AppCreatable, Creator: addr, Created: true})
+	_, _, err = c.GetGlobal(5000, "price")
+	a.ErrorContains(err, "is an existing app")
+	delete(c.mods.Creatables, 5000)
+
+	// and the oracle app is looked up in the ledger on testnet
+	c.mods.Hdr.GenesisHash, _ = crypto.DigestFromString("JBR3KGFEWPEE5SAQ6IWU6EEBZMHXD4CZU6WCBXWGF57XBZIJHIRA")
+	_, _, err = c.GetGlobal(5000, "price")
+	a.ErrorContains(err, "does not exist")
+}
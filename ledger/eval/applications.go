@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/apply"
@@ -146,6 +147,18 @@ func (cs *roundCowState) fetchAppCreator(appIdx basics.AppIndex) (basics.Address
 }
 
 func (cs *roundCowState) GetGlobal(appIdx basics.AppIndex, key string) (basics.TealValue, bool, error) {
+	if cs.proto.DevnetOracleAppID != 0 && uint64(appIdx) == cs.proto.DevnetOracleAppID && config.DevnetOracleAllowed(cs.mods.Hdr.GenesisHash) {
+		// the ID is reserved from then on, but an app may have been created with it before
+		_, exists, err := cs.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+		if err != nil {
+			return basics.TealValue{}, false, err
+		}
+		if exists {
+			return basics.TealValue{}, false, fmt.Errorf("devnet oracle app %d is an existing app", appIdx)
+		}
+		tv, ok := devnetOracleValue(cs.proto, key)
+		return tv, ok, nil
+	}
 	creator, err := cs.fetchAppCreator(appIdx)
 	if err != nil {
 		return basics.TealValue{}, false, err
@@ -153,6 +166,23 @@ func (cs *roundCowState) GetGlobal(appIdx basics.AppIndex, key string) (basics.T
 	return cs.getKey(creator, appIdx, true, key, 0)
 }
 
+// devnetOracleValue looks up key in the operator supplied global state of the devnet oracle app.
+func devnetOracleValue(proto config.ConsensusParams, key string) (basics.TealValue, bool) {
+	value, ok := proto.DevnetOracleValues[key]
+	if !ok {
+		return basics.TealValue{}, false
+	}
+	switch value.Type {
+	case config.DevnetOracleBytes:
+		return basics.TealValue{Type: basics.TealBytesType, Bytes: value.Bytes}, true
+	case config.DevnetOracleUint:
+		return basics.TealValue{Type: basics.TealUintType, Uint: value.Uint}, true
+	default:
+		// rejected by config.CheckDevnetOracle when the node starts
+		return basics.TealValue{}, false
+	}
+}
+
 func (cs *roundCowState) SetGlobal(appIdx basics.AppIndex, key string, value basics.TealValue) error {
 	creator, err := cs.fetchAppCreator(appIdx)
 	if err != nil {
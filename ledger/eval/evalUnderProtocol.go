@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package eval
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// EvalUnderProtocol validates blk as if the consensus parameters of version were in effect,
+// leaving its header untouched, and returns the resulting state delta. It evaluates the block
+// sequentially, without prefetching accounts nor using the verified transaction cache, as it is
+// only meant to check, ahead of a protocol upgrade, how the next consensus version would treat
+// the blocks of the current one.
+func EvalUnderProtocol(l LedgerForEvaluator, blk bookkeeping.Block, version protocol.ConsensusVersion) (ledgercore.StateDelta, error) {
+	proto, ok := config.Consensus[version]
+	if !ok {
+		return ledgercore.StateDelta{}, protocol.Error(version)
+	}
+	l.FlushCaches()
+
+	eval, err := StartEvaluator(l, blk.BlockHeader,
+		EvaluatorOptions{
+			PaysetHint:  len(blk.Payset),
+			Validate:    true,
+			ProtoParams: &proto,
+		})
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+
+	paysetgroups, err := blk.DecodePaysetGroups()
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+
+	// signatures and logic signatures are checked in the context of the other version too
+	hdr := blk.BlockHeader
+	hdr.CurrentProtocol = version
+	for _, txgroup := range paysetgroups {
+		signedTxns := make([]transactions.SignedTxn, len(txgroup))
+		for i := range txgroup {
+			signedTxns[i] = txgroup[i].SignedTxn
+		}
+		if _, err = verify.TxnGroup(signedTxns, &hdr, nil, l); err != nil {
+			return ledgercore.StateDelta{}, err
+		}
+		if err = eval.TransactionGroup(txgroup); err != nil {
+			return ledgercore.StateDelta{}, err
+		}
+	}
+
+	if err = eval.endOfBlock(); err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	return eval.state.deltas(), nil
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// hotCachePromotionScore is the number of recent lookups which makes an entry hot.
+const hotCachePromotionScore = 4
+
+// hotCacheDecayInterval is the interval after which the scores of the entries decay.
+const hotCacheDecayInterval = 5 * time.Minute
+
+// hotCachePendingAccessesBufferSize is the number of lookups waiting to be counted; the ones beyond are not counted.
+const hotCachePendingAccessesBufferSize = 100000
+
+// hotCacheBatchSize is the maximal number of lookups counted before promoting the entries which became hot.
+const hotCacheBatchSize = 4096
+
+// hotCacheEntry is an entry of a hotCache, with the round it was read from the database at.
+type hotCacheEntry[V interface{}] struct {
+	data  V
+	round basics.Round
+}
+
+// hotCacheLocker is the lock synchronizing the entries of a hotCache.
+type hotCacheLocker interface {
+	sync.Locker
+	RLock()
+	RUnlock()
+}
+
+// hotCacheAccess is a lookup of an entry reported to a hotCache.
+type hotCacheAccess[K comparable, V interface{}] struct {
+	key   K
+	entry hotCacheEntry[V]
+}
+
+// hotCache is a cache of the entries of the accounts database most frequently looked up, however long ago they were
+// last modified. Unlike the LRU caches, which keep the entries of the recent rounds and the most recently used ones,
+// it keeps the entries block evaluation keeps coming back to, such as the accounts and resources of popular apps
+// and assets, which would otherwise be read from the database again every time they leave the LRU caches.
+//
+// The lookups are reported through pendingAccesses, without locking, and counted by a background goroutine. An
+// entry whose score reaches hotCachePromotionScore is promoted as soon as it is counted, while there is room. The
+// goroutine only takes the write lock when a batch of lookups has entries to promote; the cached entries are kept
+// current by update instead. Every
+// hotCacheDecayInterval, the scores are halved and the entries whose score fell below hotCachePromotionScore are
+// demoted, making room for the entries looked up since.
+// Its entries are synchronized by the lock given to start, which the caller holds to read and update them; the
+// scores belong to the background goroutine.
+type hotCache[K comparable, V interface{}] struct {
+	// size is the maximal number of entries; a size of 0 disables the cache.
+	size int
+	// entries are the cached entries, at the round of their latest change written to the database.
+	entries map[K]hotCacheEntry[V]
+	// scores count the recent lookups of the entries, cached or not, up to 4 times size entries.
+	scores map[K]uint64
+	// pendingAccesses are the lookups not counted yet.
+	pendingAccesses chan hotCacheAccess[K, V]
+
+	entriesGauge *metrics.Gauge
+	hitsCounter  *metrics.Counter
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// init initializes the hotCache for use. The background goroutine must be stopped.
+// thread locking semantics : write lock
+func (h *hotCache[K, V]) init(size int, entriesGauge *metrics.Gauge, hitsCounter *metrics.Counter) {
+	h.size = size
+	h.entries = nil
+	h.scores = nil
+	h.pendingAccesses = nil
+	h.entriesGauge = entriesGauge
+	h.hitsCounter = hitsCounter
+	if size > 0 {
+		h.entries = make(map[K]hotCacheEntry[V], size)
+		h.scores = make(map[K]uint64, size)
+		h.pendingAccesses = make(chan hotCacheAccess[K, V], hotCachePendingAccessesBufferSize)
+	}
+	h.reportEntries()
+}
+
+// start launches the goroutine counting the lookups, which promotes and demotes the entries while holding lock.
+// dbRound is called with lock held, and returns the round of the database. The goroutine runs until stop is called.
+func (h *hotCache[K, V]) start(lock hotCacheLocker, dbRound func() basics.Round) {
+	if h.size == 0 || h.quit != nil {
+		return
+	}
+	h.quit = make(chan struct{})
+	h.wg.Add(1)
+	go h.run(lock, dbRound, h.quit)
+}
+
+// stop stops the goroutine counting the lookups, and waits for it to exit.
+// thread locking semantics : no lock is held
+func (h *hotCache[K, V]) stop() {
+	if h.quit == nil {
+		return
+	}
+	close(h.quit)
+	h.wg.Wait()
+	h.quit = nil
+}
+
+func (h *hotCache[K, V]) run(lock hotCacheLocker, dbRound func() basics.Round, quit chan struct{}) {
+	defer h.wg.Done()
+	decay := time.NewTicker(hotCacheDecayInterval)
+	defer decay.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case access := <-h.pendingAccesses:
+			candidates := h.count(access, nil)
+		batch:
+			for i := 1; i < hotCacheBatchSize; i++ {
+				select {
+				case access = <-h.pendingAccesses:
+					candidates = h.count(access, candidates)
+				default:
+					break batch
+				}
+			}
+			if len(candidates) > 0 {
+				lock.RLock()
+				candidates = h.promotable(candidates, dbRound())
+				lock.RUnlock()
+			}
+			if len(candidates) > 0 {
+				lock.Lock()
+				h.promote(candidates, dbRound())
+				lock.Unlock()
+			}
+		case <-decay.C:
+			lock.Lock()
+			h.decay()
+			lock.Unlock()
+		}
+	}
+}
+
+// read returns the cached entry of the given key.
+// thread locking semantics : read lock
+func (h *hotCache[K, V]) read(key K) (data V, has bool) {
+	entry, has := h.entries[key]
+	if has {
+		h.hitsCounter.Inc(nil)
+	}
+	return entry.data, has
+}
+
+// recordAccess reports a lookup of an entry, as read at the given round from the database or one of the caches.
+// the function doesn't block, and in case of a buffer overflow the lookup is not counted.
+// thread locking semantics : no lock is required.
+func (h *hotCache[K, V]) recordAccess(key K, data V, round basics.Round) {
+	select {
+	case h.pendingAccesses <- hotCacheAccess[K, V]{key: key, entry: hotCacheEntry[V]{data: data, round: round}}:
+	default:
+	}
+}
+
+// count counts a lookup in the score of its entry, and appends it to candidates when the entry is hot.
+// thread locking semantics : called by the background goroutine only
+func (h *hotCache[K, V]) count(access hotCacheAccess[K, V], candidates []hotCacheAccess[K, V]) []hotCacheAccess[K, V] {
+	score, tracked := h.scores[access.key]
+	if !tracked && len(h.scores) >= 4*h.size {
+		return candidates
+	}
+	score++
+	h.scores[access.key] = score
+	if score >= hotCachePromotionScore {
+		candidates = append(candidates, access)
+	}
+	return candidates
+}
+
+// promotable filters the hot entries looked up down to the ones promote would cache: the entries not cached yet,
+// read at dbRound, for which there is room.
+// thread locking semantics : read lock, called by the background goroutine only
+func (h *hotCache[K, V]) promotable(candidates []hotCacheAccess[K, V], dbRound basics.Round) []hotCacheAccess[K, V] {
+	room := h.size - len(h.entries)
+	promotable := candidates[:0]
+	for _, access := range candidates {
+		if room <= 0 {
+			break
+		}
+		if _, ok := h.entries[access.key]; ok || access.entry.round != dbRound {
+			continue
+		}
+		promotable = append(promotable, access)
+		room--
+	}
+	return promotable
+}
+
+// promote caches the hot entries looked up, while there is room. Only the entries read at dbRound are promoted,
+// since the ones read earlier might have changed since; the cached ones are left as they are, as update keeps
+// them current.
+// thread locking semantics : write lock, called by the background goroutine only
+func (h *hotCache[K, V]) promote(candidates []hotCacheAccess[K, V], dbRound basics.Round) {
+	for _, access := range candidates {
+		if _, ok := h.entries[access.key]; ok {
+			continue
+		}
+		if access.entry.round == dbRound && len(h.entries) < h.size {
+			h.entries[access.key] = access.entry
+		}
+	}
+	h.reportEntries()
+}
+
+// decay halves the scores, demoting the entries which cooled down.
+// thread locking semantics : write lock, called by the background goroutine only
+func (h *hotCache[K, V]) decay() {
+	for key, score := range h.scores {
+		score /= 2
+		if score == 0 {
+			delete(h.scores, key)
+			continue
+		}
+		h.scores[key] = score
+	}
+	for key := range h.entries {
+		if h.scores[key] < hotCachePromotionScore {
+			delete(h.entries, key)
+		}
+	}
+	h.reportEntries()
+}
+
+// update replaces a cached entry with the version written to the database by a commit.
+// thread locking semantics : write lock
+func (h *hotCache[K, V]) update(key K, data V, round basics.Round) {
+	if cached, ok := h.entries[key]; ok && cached.round < round {
+		h.entries[key] = hotCacheEntry[V]{data: data, round: round}
+	}
+}
+
+// clear drops all the cached entries, e.g. when the database row references they carry are no longer valid. The
+// entries which are still looked up are cached again.
+// thread locking semantics : write lock
+func (h *hotCache[K, V]) clear() {
+	if h.size > 0 {
+		h.entries = make(map[K]hotCacheEntry[V], h.size)
+	}
+	h.reportEntries()
+}
+
+// reportEntries sets the gauge of the number of cached entries, once the hotCache is initialized.
+func (h *hotCache[K, V]) reportEntries() {
+	if h.entriesGauge != nil {
+		h.entriesGauge.Set(uint64(len(h.entries)))
+	}
+}
+
+var ledgerHotCacheAccounts = metrics.MakeGauge(metrics.MetricName{Name: "algod_ledger_hot_cache_accounts", Description: "number of accounts in the cache of the frequently looked up accounts"})
+var ledgerHotCacheAccountsHits = metrics.NewCounter("ledger_hot_cache_accounts_hits", "account lookups served by the cache of the frequently looked up accounts")
+var ledgerHotCacheResources = metrics.MakeGauge(metrics.MetricName{Name: "algod_ledger_hot_cache_resources", Description: "number of resources in the cache of the frequently looked up resources"})
+var ledgerHotCacheResourcesHits = metrics.NewCounter("ledger_hot_cache_resources_hits", "resource lookups served by the cache of the frequently looked up resources")
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeHotCacheAccount(addr byte, rnd basics.Round, balance uint64) trackerdb.PersistedAccountData {
+	acct := trackerdb.PersistedAccountData{Addr: basics.Address{addr}, Round: rnd}
+	acct.AccountData.MicroAlgos = basics.MicroAlgos{Raw: balance}
+	return acct
+}
+
+func TestHotCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var hot hotCache[basics.Address, trackerdb.PersistedAccountData]
+	hot.init(2, ledgerHotCacheAccounts, ledgerHotCacheAccountsHits)
+
+	// count the lookups as the background goroutine would, promoting the hot accounts after every batch
+	lookup := func(acct trackerdb.PersistedAccountData, times int, dbRound basics.Round) {
+		var candidates []hotCacheAccess[basics.Address, trackerdb.PersistedAccountData]
+		for i := 0; i < times; i++ {
+			access := hotCacheAccess[basics.Address, trackerdb.PersistedAccountData]{key: acct.Addr, entry: hotCacheEntry[trackerdb.PersistedAccountData]{data: acct, round: acct.Round}}
+			candidates = hot.count(access, candidates)
+		}
+		hot.promote(hot.promotable(candidates, dbRound), dbRound)
+	}
+
+	// an account is promoted once looked up often enough, when read at dbRound
+	lookup(makeHotCacheAccount(1, 10, 100), hotCachePromotionScore-1, 10)
+	lookup(makeHotCacheAccount(2, 9, 200), hotCachePromotionScore, 10)
+	_, has := hot.read(basics.Address{1})
+	require.False(t, has)
+	_, has = hot.read(basics.Address{2})
+	require.False(t, has)
+
+	lookup(makeHotCacheAccount(1, 10, 100), 1, 10)
+	lookup(makeHotCacheAccount(2, 10, 200), 1, 10)
+	lookup(makeHotCacheAccount(3, 10, 300), hotCachePromotionScore, 10)
+	acct, has := hot.read(basics.Address{1})
+	require.True(t, has)
+	require.Equal(t, uint64(100), acct.AccountData.MicroAlgos.Raw)
+	_, has = hot.read(basics.Address{2})
+	require.True(t, has)
+	// there is no room left for the third one
+	_, has = hot.read(basics.Address{3})
+	require.False(t, has)
+
+	// the cached accounts follow the commits
+	hot.update(basics.Address{1}, makeHotCacheAccount(1, 11, 150), 11)
+	hot.update(basics.Address{3}, makeHotCacheAccount(3, 11, 350), 11)
+	acct, _ = hot.read(basics.Address{1})
+	require.Equal(t, uint64(150), acct.AccountData.MicroAlgos.Raw)
+	require.Equal(t, basics.Round(11), acct.Round)
+	_, has = hot.read(basics.Address{3})
+	require.False(t, has)
+	// and don't go back to older versions
+	lookup(makeHotCacheAccount(1, 10, 100), 1, 11)
+	acct, _ = hot.read(basics.Address{1})
+	require.Equal(t, uint64(150), acct.AccountData.MicroAlgos.Raw)
+
+	// the lookups of cached entries promote nothing, so that the write lock isn't taken for them
+	access := hotCacheAccess[basics.Address, trackerdb.PersistedAccountData]{key: basics.Address{1}, entry: hotCacheEntry[trackerdb.PersistedAccountData]{data: acct, round: 11}}
+	require.Empty(t, hot.promotable(hot.count(access, nil), 11))
+
+	// the accounts which are still looked up stay cached while the others cool down
+	lookup(makeHotCacheAccount(1, 11, 150), 2*hotCachePromotionScore, 11)
+	hot.decay()
+	_, has = hot.read(basics.Address{1})
+	require.True(t, has)
+	_, has = hot.read(basics.Address{2})
+	require.False(t, has)
+
+	// which makes room for other accounts
+	lookup(makeHotCacheAccount(3, 11, 350), hotCachePromotionScore, 11)
+	acct, has = hot.read(basics.Address{3})
+	require.True(t, has)
+	require.Equal(t, uint64(350), acct.AccountData.MicroAlgos.Raw)
+
+	// the accounts still looked up are cached again once cleared
+	hot.clear()
+	_, has = hot.read(basics.Address{1})
+	require.False(t, has)
+	lookup(makeHotCacheAccount(1, 11, 150), 1, 11)
+	_, has = hot.read(basics.Address{1})
+	require.True(t, has)
+}
+
+func TestHotCacheBackground(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var mu sync.RWMutex
+	var hot hotCache[accountCreatable, trackerdb.PersistedResourcesData]
+	hot.init(10, ledgerHotCacheResources, ledgerHotCacheResourcesHits)
+	hot.start(&mu, func() basics.Round { return 5 })
+	defer hot.stop()
+
+	// the resources are promoted in the background, without waiting for a new block
+	key := accountCreatable{basics.Address{1}, 1000}
+	res := trackerdb.PersistedResourcesData{Aidx: 1000, Round: 5}
+	for i := 0; i < hotCachePromotionScore; i++ {
+		hot.recordAccess(key, res, res.Round)
+	}
+	require.Eventually(t, func() bool {
+		mu.RLock()
+		defer mu.RUnlock()
+		_, has := hot.read(key)
+		return has
+	}, 5*time.Second, 10*time.Millisecond)
+
+	hot.stop()
+	hot.stop()
+}
+
+func TestHotCacheDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var hot hotCache[basics.Address, trackerdb.PersistedAccountData]
+	hot.init(0, ledgerHotCacheAccounts, ledgerHotCacheAccountsHits)
+	hot.start(&sync.RWMutex{}, func() basics.Round { return 1 })
+	acct := makeHotCacheAccount(1, 1, 100)
+	for i := 0; i < hotCachePromotionScore; i++ {
+		hot.recordAccess(acct.Addr, acct, acct.Round)
+	}
+	hot.update(acct.Addr, acct, acct.Round)
+	_, has := hot.read(acct.Addr)
+	require.False(t, has)
+	hot.stop()
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+// kvDeltaSubscriptionBuffer is the number of rounds of changes a subscriber may fall behind by
+// before its subscription is ended.
+const kvDeltaSubscriptionBuffer = 64
+
+// kvDeltaPublisher publishes the changes the new blocks make to the key-value pairs to the
+// subscribers interested in them. Nothing is persisted: subscribers get the changes of the blocks
+// added to the ledger after they subscribed, and lose their subscription when the ledger closes.
+type kvDeltaPublisher struct {
+	mu     deadlock.Mutex
+	latest basics.Round
+	subs   map[*KvDeltaSubscription]struct{}
+}
+
+// KvDeltaSubscription delivers the changes made to the key-value pairs whose key starts with a
+// prefix, one round at a time. Rounds which did not change any of these pairs are skipped.
+type KvDeltaSubscription struct {
+	prefix    string
+	since     basics.Round
+	updates   chan ledgercore.KvRoundDeltas
+	lagged    atomic.Bool
+	publisher *kvDeltaPublisher
+}
+
+// Since returns the first round whose changes are delivered by the subscription.
+func (s *KvDeltaSubscription) Since() basics.Round {
+	return s.since
+}
+
+// Updates returns the channel the changes are delivered on. It is closed once the subscription
+// ends, which happens when it is closed, when the ledger closes, or when the subscriber falls
+// behind.
+func (s *KvDeltaSubscription) Updates() <-chan ledgercore.KvRoundDeltas {
+	return s.updates
+}
+
+// Lagged tells whether the subscription ended because the subscriber fell behind.
+func (s *KvDeltaSubscription) Lagged() bool {
+	return s.lagged.Load()
+}
+
+// Close ends the subscription.
+func (s *KvDeltaSubscription) Close() {
+	s.publisher.mu.Lock()
+	defer s.publisher.mu.Unlock()
+	s.publisher.end(s)
+}
+
+func (kp *kvDeltaPublisher) subscribe(prefix string) *KvDeltaSubscription {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	sub := &KvDeltaSubscription{
+		prefix:    prefix,
+		since:     kp.latest + 1,
+		updates:   make(chan ledgercore.KvRoundDeltas, kvDeltaSubscriptionBuffer),
+		publisher: kp,
+	}
+	if kp.subs == nil {
+		kp.subs = make(map[*KvDeltaSubscription]struct{})
+	}
+	kp.subs[sub] = struct{}{}
+	return sub
+}
+
+// end removes sub from the subscribers and closes its channel. The caller must hold kp.mu.
+func (kp *kvDeltaPublisher) end(sub *KvDeltaSubscription) {
+	if _, ok := kp.subs[sub]; ok {
+		delete(kp.subs, sub)
+		close(sub.updates)
+	}
+}
+
+func (kp *kvDeltaPublisher) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.latest = dbRound
+	return nil
+}
+
+func (kp *kvDeltaPublisher) close() {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	for sub := range kp.subs {
+		kp.end(sub)
+	}
+}
+
+func (kp *kvDeltaPublisher) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.latest = blk.Round()
+	if len(kp.subs) == 0 || len(delta.KvMods) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(delta.KvMods))
+	for key, kv := range delta.KvMods {
+		// a pair created and deleted within the round was never visible.
+		if kv.OldData == nil && kv.Data == nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for sub := range kp.subs {
+		var deltas []ledgercore.KvDelta
+		for _, key := range keys {
+			if !strings.HasPrefix(key, sub.prefix) {
+				continue
+			}
+			kv := delta.KvMods[key]
+			deltas = append(deltas, ledgercore.KvDelta{Key: key, Data: kv.Data, Existed: kv.OldData != nil})
+		}
+		if len(deltas) == 0 {
+			continue
+		}
+		select {
+		case sub.updates <- ledgercore.KvRoundDeltas{Round: blk.Round(), Deltas: deltas}:
+		default:
+			// never block the ledger on a slow subscriber.
+			sub.lagged.Store(true)
+			kp.end(sub)
+		}
+	}
+}
+
+func (kp *kvDeltaPublisher) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+func (kp *kvDeltaPublisher) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+func (kp *kvDeltaPublisher) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+func (kp *kvDeltaPublisher) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (kp *kvDeltaPublisher) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (kp *kvDeltaPublisher) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (kp *kvDeltaPublisher) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (kp *kvDeltaPublisher) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (kp *kvDeltaPublisher) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func kvDeltaBlock(rnd basics.Round, mods map[string]ledgercore.KvValueDelta) (bookkeeping.Block, ledgercore.StateDelta) {
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = rnd
+	return blk, ledgercore.StateDelta{KvMods: mods}
+}
+
+func TestKvDeltaPublisher(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var kp kvDeltaPublisher
+	require.NoError(t, kp.loadFromDisk(nil, 10))
+
+	sub := kp.subscribe("watched/")
+	require.Equal(t, basics.Round(11), sub.Since())
+
+	kp.newBlock(kvDeltaBlock(11, map[string]ledgercore.KvValueDelta{
+		"watched/b":   {Data: []byte("new"), OldData: []byte("old")},
+		"watched/a":   {Data: []byte("created")},
+		"watched/c":   {OldData: []byte("deleted")},
+		"watched/tmp": {},
+		"ignored/a":   {Data: []byte("created")},
+	}))
+	// a round not changing any of the watched pairs is skipped
+	kp.newBlock(kvDeltaBlock(12, map[string]ledgercore.KvValueDelta{
+		"ignored/a": {Data: []byte("modified"), OldData: []byte("created")},
+	}))
+	kp.newBlock(kvDeltaBlock(13, map[string]ledgercore.KvValueDelta{
+		"watched/a": {OldData: []byte("created")},
+	}))
+
+	require.Equal(t, ledgercore.KvRoundDeltas{Round: 11, Deltas: []ledgercore.KvDelta{
+		{Key: "watched/a", Data: []byte("created")},
+		{Key: "watched/b", Data: []byte("new"), Existed: true},
+		{Key: "watched/c", Existed: true},
+	}}, <-sub.Updates())
+	require.Equal(t, ledgercore.KvRoundDeltas{Round: 13, Deltas: []ledgercore.KvDelta{
+		{Key: "watched/a", Existed: true},
+	}}, <-sub.Updates())
+
+	sub.Close()
+	_, ok := <-sub.Updates()
+	require.False(t, ok)
+	require.False(t, sub.Lagged())
+	// closing twice is harmless
+	sub.Close()
+}
+
+func TestKvDeltaPublisherLagging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var kp kvDeltaPublisher
+	require.NoError(t, kp.loadFromDisk(nil, 0))
+	slow := kp.subscribe("")
+	other := kp.subscribe("")
+
+	for rnd := basics.Round(1); rnd <= kvDeltaSubscriptionBuffer+1; rnd++ {
+		kp.newBlock(kvDeltaBlock(rnd, map[string]ledgercore.KvValueDelta{"key": {Data: []byte{byte(rnd)}}}))
+		if rnd <= kvDeltaSubscriptionBuffer {
+			<-other.Updates()
+		}
+	}
+	require.True(t, slow.Lagged())
+	for range slow.Updates() {
+	}
+	require.False(t, other.Lagged())
+	require.Equal(t, basics.Round(kvDeltaSubscriptionBuffer+1), (<-other.Updates()).Round)
+
+	// the subscriptions end when the ledger closes
+	kp.close()
+	_, ok := <-other.Updates()
+	require.False(t, ok)
+	require.False(t, other.Lagged())
+}
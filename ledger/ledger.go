@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -38,8 +39,6 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
-	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
-	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -65,6 +64,16 @@ type Ledger struct {
 	// (archival mode) or trims older blocks to save space (non-archival).
 	archival bool
 
+	// archivalSinceRound and archivalWindow widen the range of blocks kept
+	// by a non-archival ledger; see config.Local.ArchivalSinceRound and
+	// config.Local.ArchivalWindowRounds.
+	archivalSinceRound basics.Round
+	archivalWindow     basics.Round
+
+	// maxBlockHistory bounds the number of blocks kept, when non-zero;
+	// see config.Local.MaxBlockHistoryRounds.
+	maxBlockHistory basics.Round
+
 	// the synchronous mode that would be used for the ledger databases.
 	synchronousMode db.SynchronousMode
 
@@ -89,6 +98,13 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	compliance     assetComplianceTracker
+	boxHistory     boxHistoryTracker
+	accountTxns    accountTxnIndexTracker
+	recentTxns     recentTxnIndexTracker
+	appEvents      appEventTracker
+	kvDeltas       kvDeltaPublisher
+	acctHistory    accountHistoryTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -98,32 +114,39 @@ type Ledger struct {
 
 	cfg config.Local
 
-	dbPathPrefix string
+	paths ledgerPaths
 
 	tracer logic.EvalTracer
 }
 
 // OpenLedger creates a Ledger object, using SQLite database filenames
-// based on dbPathPrefix (in-memory if dbMem is true). genesisInitState.Blocks and
+// based on dbPathPrefix (in-memory if dbMem is true). The databases and the catchpoint
+// files are moved to the directories configured for them, if any. genesisInitState.Blocks and
 // genesisInitState.Accounts specify the initial blocks and accounts to use if the
 // database wasn't initialized before.
 func OpenLedger(
 	log logging.Logger, dbPathPrefix string, dbMem bool, genesisInitState ledgercore.InitState, cfg config.Local,
 ) (*Ledger, error) {
-	var err error
+	err := checkStorageEngine(cfg, dbPathPrefix)
+	if err != nil {
+		return nil, err
+	}
 	verifiedCacheSize := cfg.VerifiedTranscationsCacheSize
 	if verifiedCacheSize < cfg.TxPoolSize {
 		verifiedCacheSize = cfg.TxPoolSize
 		log.Warnf("The VerifiedTranscationsCacheSize in the config file was misconfigured to have smaller size then the TxPoolSize; The verified cache size was adjusted from %d to %d.", cfg.VerifiedTranscationsCacheSize, cfg.TxPoolSize)
 	}
 	var tracer logic.EvalTracer
-	if cfg.EnableTxnEvalTracer {
+	if cfg.FeatureRequested(config.FeatureTxnEvalTracer) {
 		tracer = eval.MakeTxnGroupDeltaTracer(cfg.MaxAcctLookback)
 	}
 
 	l := &Ledger{
 		log:                            log,
 		archival:                       cfg.Archival,
+		archivalSinceRound:             basics.Round(cfg.ArchivalSinceRound),
+		archivalWindow:                 basics.Round(cfg.ArchivalWindowRounds),
+		maxBlockHistory:                basics.Round(cfg.MaxBlockHistoryRounds),
 		genesisHash:                    genesisInitState.GenesisHash,
 		genesisAccounts:                genesisInitState.Accounts,
 		genesisProto:                   config.Consensus[genesisInitState.Block.CurrentProtocol],
@@ -132,7 +155,7 @@ func OpenLedger(
 		accountsRebuildSynchronousMode: db.SynchronousMode(cfg.AccountsRebuildSynchronousMode),
 		verifiedTxnCache:               verify.MakeVerifiedTransactionCache(verifiedCacheSize),
 		cfg:                            cfg,
-		dbPathPrefix:                   dbPathPrefix,
+		paths:                          resolveLedgerPaths(dbPathPrefix, genesisInitState.Block.GenesisID(), cfg),
 		tracer:                         tracer,
 	}
 
@@ -142,7 +165,7 @@ func OpenLedger(
 		}
 	}()
 
-	l.trackerDBs, l.blockDBs, err = openLedgerDB(dbPathPrefix, dbMem, cfg, log)
+	l.trackerDBs, l.blockDBs, err = openLedgerDB(l.paths, dbMem, cfg, log)
 	if err != nil {
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
 		return nil, err
@@ -218,11 +241,24 @@ func (l *Ledger) reloadLedger() error {
 		&l.notifier,       // send OnNewBlocks to subscribers
 		&l.metrics,        // provides metrics reporting support
 		&l.spVerification, // provides state proof verification support
+		&l.compliance,     // indexes asset freeze and clawback transactions, when enabled
+		&l.boxHistory,     // records box creation and deletion rounds, when enabled
+		&l.accountTxns,    // indexes the transactions touching each account, when enabled
+		&l.recentTxns,     // indexes the leases and notes of the recent transactions, when enabled
+		&l.appEvents,      // indexes the events emitted by application calls, when enabled
+		&l.kvDeltas,       // publishes the changes of the KV pairs to their subscribers
+		&l.acctHistory,    // retains the account states older than the in-memory deltas, when enabled
 	}
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
-	l.catchpoint.initialize(l.cfg, l.dbPathPrefix)
+	l.catchpoint.initialize(l.cfg, l.paths.catchpointPrefix)
+	l.compliance.initialize(l.cfg)
+	l.boxHistory.initialize(l.cfg)
+	l.accountTxns.initialize(l.cfg)
+	l.recentTxns.initialize(l.cfg)
+	l.appEvents.initialize(l.cfg)
+	l.acctHistory.initialize(l.cfg, &l.accts)
 
 	err = l.trackers.initialize(l, trackers, l.cfg)
 	if err != nil {
@@ -280,11 +316,38 @@ func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	return
 }
 
-func openLedgerDB(dbPathPrefix string, dbMem bool, cfg config.Local, log logging.Logger) (trackerDBs trackerdb.Store, blockDBs db.Pair, err error) {
+// ledgerPaths are the path prefixes of the files of a ledger. They all equal the prefix in the data
+// directory unless directories are configured for the databases or the catchpoint files.
+type ledgerPaths struct {
+	dbPathPrefix     string
+	trackerDBPrefix  string
+	blockDBPrefix    string
+	catchpointPrefix string
+}
+
+// resolveLedgerPaths returns the path prefixes of the files of the ledger of the network genesisID,
+// whose path prefix in the data directory is dbPathPrefix.
+func resolveLedgerPaths(dbPathPrefix string, genesisID string, cfg config.Local) ledgerPaths {
+	dirs := cfg.ResolveGenesisDirs(filepath.Dir(dbPathPrefix), genesisID)
+	prefixIn := func(dir string) string {
+		if dir == dirs.RootGenesisDir {
+			return dbPathPrefix
+		}
+		return filepath.Join(dir, filepath.Base(dbPathPrefix))
+	}
+	return ledgerPaths{
+		dbPathPrefix:     dbPathPrefix,
+		trackerDBPrefix:  prefixIn(dirs.TrackerGenesisDir),
+		blockDBPrefix:    prefixIn(dirs.BlockGenesisDir),
+		catchpointPrefix: prefixIn(dirs.CatchpointGenesisDir),
+	}
+}
+
+func openLedgerDB(paths ledgerPaths, dbMem bool, cfg config.Local, log logging.Logger) (trackerDBs trackerdb.Store, blockDBs db.Pair, err error) {
 	// Backwards compatibility: we used to store both blocks and tracker
 	// state in a single SQLite db file.
 	if !dbMem {
-		commonDBFilename := dbPathPrefix + ".sqlite"
+		commonDBFilename := paths.dbPathPrefix + ".sqlite"
 		_, err = os.Stat(commonDBFilename)
 		if !os.IsNotExist(err) {
 			// before launch, we used to have both blocks and tracker
@@ -298,24 +361,13 @@ func openLedgerDB(dbPathPrefix string, dbMem bool, cfg config.Local, log logging
 	outErr := make(chan error, 2)
 	go func() {
 		var lerr error
-		switch cfg.StorageEngine {
-		case "pebbledb":
-			dir := dbPathPrefix + "/tracker.pebble"
-			trackerDBs, lerr = pebbledbdriver.Open(dir, dbMem, config.Consensus[protocol.ConsensusCurrentVersion], log)
-		// anything else will initialize a sqlite engine.
-		case "sqlite":
-			fallthrough
-		default:
-			file := dbPathPrefix + ".tracker.sqlite"
-			trackerDBs, lerr = sqlitedriver.Open(file, dbMem, log)
-		}
-
+		trackerDBs, lerr = openTrackerDB(paths.trackerDBPrefix, dbMem, cfg.StorageEngine, log)
 		outErr <- lerr
 	}()
 
 	go func() {
 		var lerr error
-		blockDBFilename := dbPathPrefix + ".block.sqlite"
+		blockDBFilename := paths.blockDBPrefix + ".block.sqlite"
 		blockDBs, lerr = db.OpenPair(blockDBFilename, dbMem)
 		if lerr != nil {
 			outErr <- lerr
@@ -445,13 +497,51 @@ func (l *Ledger) notifyCommit(r basics.Round) basics.Round {
 		l.trackerMu.Unlock()
 		ledgerTrackerMuLockMicros.AddMicrosecondsSince(t0, nil)
 	}()
-	minToSave := l.trackers.committedUpTo(r)
+	trackersMinToSave := l.trackers.committedUpTo(r)
+	minToSave := trackersMinToSave
 
 	if l.archival {
 		// Do not forget any blocks.
 		minToSave = 0
+	} else {
+		minToSave = l.archivalMinToSave(r, minToSave)
+	}
+
+	return l.retentionMinToSave(r, trackersMinToSave, minToSave)
+}
+
+// retentionMinToSave raises minToSave so that at most maxBlockHistory blocks
+// are kept, without going past trackersMinToSave, the earliest block the
+// trackers still need (e.g. for catchpoint generation).
+func (l *Ledger) retentionMinToSave(r basics.Round, trackersMinToSave basics.Round, minToSave basics.Round) basics.Round {
+	if l.maxBlockHistory == 0 || r < l.maxBlockHistory {
+		return minToSave
+	}
+	horizon := r - l.maxBlockHistory + 1
+	if horizon > trackersMinToSave {
+		horizon = trackersMinToSave
+	}
+	if horizon > minToSave {
+		minToSave = horizon
 	}
+	return minToSave
+}
 
+// archivalMinToSave lowers minToSave so that the blocks covered by the
+// configured partial archival range are kept in the database.
+func (l *Ledger) archivalMinToSave(r basics.Round, minToSave basics.Round) basics.Round {
+	if l.archivalSinceRound != 0 && l.archivalSinceRound < minToSave {
+		minToSave = l.archivalSinceRound
+	}
+	if l.archivalWindow != 0 {
+		windowStart := basics.Round(0)
+		if r > l.archivalWindow {
+			windowStart = r - l.archivalWindow + 1
+		}
+		if windowStart < minToSave {
+			minToSave = windowStart
+		}
+	}
 	return minToSave
 }
 
@@ -463,6 +553,44 @@ func (l *Ledger) GetLastCatchpointLabel() string {
 	return l.catchpoint.GetLastCatchpointLabel()
 }
 
+// PauseCatchpointGeneration stops the ledger from starting new catchpoints when paused is set, and lets it
+// start them again otherwise. The catchpoints already started are completed, and the catchpoints of the rounds
+// passed while paused are skipped.
+func (l *Ledger) PauseCatchpointGeneration(paused bool) {
+	l.catchpoint.generationPaused.Store(paused)
+}
+
+// RequestCatchpointFile makes the ledger write the file of its next catchpoint even when it isn't configured
+// to generate catchpoint files, and returns the round of that catchpoint.
+func (l *Ledger) RequestCatchpointFile() (basics.Round, error) {
+	if !l.catchpoint.catchpointEnabled() {
+		return 0, ErrCatchpointsDisabled
+	}
+	hdr, err := l.BlockHdr(l.Latest())
+	if err != nil {
+		return 0, err
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	catchpointLookback := proto.CatchpointLookback
+	if catchpointLookback == 0 {
+		catchpointLookback = proto.MaxBalLookback
+	}
+	return l.catchpoint.requestCatchpointFile(l.trackers.getDbRound(), catchpointLookback), nil
+}
+
+// RegisterCatchpointFileListener registers a listener that will be called when a
+// catchpoint file is written.
+func (l *Ledger) RegisterCatchpointFileListener(listener ledgercore.CatchpointFileListener) {
+	l.catchpoint.catchpointsMu.Lock()
+	defer l.catchpoint.catchpointsMu.Unlock()
+	l.catchpoint.fileListeners = append(l.catchpoint.fileListeners, listener)
+}
+
+// CatchpointGenerationPaused tells whether the ledger was stopped from starting new catchpoints.
+func (l *Ledger) CatchpointGenerationPaused() bool {
+	return l.catchpoint.generationPaused.Load()
+}
+
 // GetCreatorForRound takes a CreatableIndex and a CreatableType and tries to
 // look up a creator address, setting ok to false if the query succeeded but no
 // creator was found.
@@ -487,6 +615,15 @@ func (l *Ledger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta,
 	return l.accts.lookupStateDelta(rnd)
 }
 
+// LookupAccountChanges returns the net changes of the assets and applications of addr, and of the boxes it
+// holds as an application account, between the rounds from and to. The rounds older than the in-memory
+// deltas are only available through the account history, see MaxAccountHistoryRounds.
+func (l *Ledger) LookupAccountChanges(addr basics.Address, from basics.Round, to basics.Round) (ledgercore.AccountChanges, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.lookupAccountChanges(addr, from, to)
+}
+
 // GetTracer returns the logic.EvalTracer attached to the ledger--can be nil.
 func (l *Ledger) GetTracer() logic.EvalTracer {
 	return l.tracer
@@ -588,6 +725,15 @@ func (l *Ledger) LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKey
 	return l.accts.LookupKeysByPrefix(round, keyPrefix, maxKeyNum)
 }
 
+// LookupKeysByRange returns, in order, up to `maxKeyNum` keys with a specific
+// prefix that are not lower than `from`
+func (l *Ledger) LookupKeysByRange(round basics.Round, keyPrefix string, from string, maxKeyNum uint64) ([]string, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	return l.accts.LookupKeysByRange(round, keyPrefix, from, maxKeyNum)
+}
+
 // LookupAgreement returns account data used by agreement.
 func (l *Ledger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
 	l.trackerMu.RLock()
@@ -643,6 +789,12 @@ func (l *Ledger) Latest() basics.Round {
 	return l.blockQ.latest()
 }
 
+// EarliestBlock returns the earliest block round number kept in persistent
+// storage. Blocks from this round up to Latest() can be served to peers.
+func (l *Ledger) EarliestBlock() basics.Round {
+	return l.blockQ.earliestCommitted()
+}
+
 // LatestCommitted returns the last block round number written to
 // persistent storage.  This block, and all previous blocks, are
 // guaranteed to be available after a crash. In addition, it returns
@@ -714,6 +866,8 @@ func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) err
 // the block has previously been validated.  Otherwise, AddValidatedBlock
 // behaves like AddBlock.
 func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
+	l.upgradeDryRun(vb)
+
 	// Grab the tracker lock first, to ensure newBlock() is notified before committedUpTo().
 	t0 := time.Now()
 	l.trackerMu.Lock()
@@ -795,6 +949,87 @@ func (l *Ledger) GetCatchpointStream(round basics.Round) (ReadCloseSizer, error)
 	return l.catchpoint.GetCatchpointStream(round)
 }
 
+// GetCatchpointLabel returns the catchpoint label generated by this node for the given round,
+// as long as the catchpoint is still retained.
+func (l *Ledger) GetCatchpointLabel(round basics.Round) (string, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointLabel(round)
+}
+
+// AssetComplianceEvents returns the freeze and clawback events recorded between minRound and
+// maxRound for the given asset and target address. A zero asset or target address matches any
+// value, but at least one of them must be set. It returns ErrAssetComplianceIndexDisabled unless
+// the ledger was configured with EnableAssetComplianceIndex.
+func (l *Ledger) AssetComplianceEvents(asset basics.AssetIndex, target basics.Address, minRound, maxRound basics.Round) ([]ledgercore.AssetComplianceEvent, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.compliance.lookup(asset, target, minRound, maxRound)
+}
+
+// BoxHistory returns the rounds in which the box of app named name was last created and
+// deleted. It returns ErrBoxHistoryIndexDisabled unless the ledger was configured with
+// EnableBoxHistoryIndex.
+func (l *Ledger) BoxHistory(app basics.AppIndex, name string) (ledgercore.BoxHistory, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.boxHistory.lookup(app, name)
+}
+
+// SubscribeKvDeltas subscribes to the changes made to the key-value pairs whose key starts with
+// prefix by the blocks added to the ledger from now on. The subscription must be closed once done.
+func (l *Ledger) SubscribeKvDeltas(prefix string) *KvDeltaSubscription {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.kvDeltas.subscribe(prefix)
+}
+
+// AccountTransactions returns up to limit transactions touching addr confirmed within
+// [minRound, maxRound], the most recent ones first; a limit of 0 returns all of them.
+// It returns ErrAccountTxnIndexDisabled unless the ledger was configured with
+// EnableAccountTxnIndex.
+func (l *Ledger) AccountTransactions(addr basics.Address, minRound, maxRound basics.Round, limit uint64) (ledgercore.AccountTxns, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accountTxns.lookup(addr, minRound, maxRound, limit)
+}
+
+// RecentTransactions returns up to limit top-level transactions of the last MaxTxnLife
+// rounds matching query, the most recent ones first; a limit of 0 returns all of them.
+// It returns ErrRecentTxnIndexDisabled unless the ledger was configured with
+// EnableRecentTxnIndex.
+func (l *Ledger) RecentTransactions(query ledgercore.RecentTxnQuery, limit uint64) (ledgercore.RecentTxns, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.recentTxns.lookup(query, limit)
+}
+
+// AppEvents returns up to limit ARC-28 events emitted by app within [minRound, maxRound],
+// the oldest ones first. A nil selector matches any event, a zero maxRound leaves the range
+// open-ended and a limit of 0 returns all of them. It returns ErrAppEventIndexDisabled unless
+// the ledger was configured with EnableAppEventIndex.
+func (l *Ledger) AppEvents(app basics.AppIndex, selector *ledgercore.AppEventSelector, minRound, maxRound basics.Round, limit uint64) (ledgercore.AppEvents, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.appEvents.lookup(app, selector, minRound, maxRound, limit)
+}
+
+// LockProbes returns, keyed by lock name, functions which acquire and release the
+// main ledger locks for reading, so that monitoring code can time how long the
+// locks are held by writers.
+func (l *Ledger) LockProbes() map[string]func() {
+	return map[string]func(){
+		"ledger.trackerMu": func() {
+			l.trackerMu.RLock()
+			l.trackerMu.RUnlock() //nolint:staticcheck // empty critical section by design
+		},
+		"ledger.accountsMu": func() {
+			l.accts.accountsMu.RLock()
+			l.accts.accountsMu.RUnlock() //nolint:staticcheck // empty critical section by design
+		},
+	}
+}
+
 // ledgerForTracker methods
 func (l *Ledger) trackerDB() trackerdb.Store {
 	return l.trackerDBs
@@ -869,11 +1104,28 @@ func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionP
 	if err != nil {
 		return nil, err
 	}
+	ledgerBlocksValidatedTotal.Inc(nil)
 
 	vb := ledgercore.MakeValidatedBlock(blk, delta)
 	return &vb, nil
 }
 
+// FlushTrackers writes the latest blocks to the blocks database and commits the trackers state
+// for them right away, rather than once the flush interval passed, and waits for the commit to
+// complete. The rounds the trackers keep in memory are left for the ledger to replay on startup.
+func (l *Ledger) FlushTrackers() {
+	latest := l.Latest()
+	l.WaitForCommit(latest)
+	// let any commit in progress complete before forcing a new one
+	l.trackers.waitAccountsWriting()
+
+	l.trackers.mu.Lock()
+	l.trackers.lastFlushTime = time.Time{}
+	l.trackers.mu.Unlock()
+	l.notifyCommit(latest)
+	l.trackers.waitAccountsWriting()
+}
+
 // LatestTrackerCommitted returns the trackers' dbRound which "is always exactly accountsRound()"
 func (l *Ledger) LatestTrackerCommitted() basics.Round {
 	return l.trackers.getDbRound()
@@ -893,3 +1145,4 @@ var ledgerVerifygenhashCount = metrics.NewCounter("ledger_verifygenhash_count",
 var ledgerVerifygenhashMicros = metrics.NewCounter("ledger_verifygenhash_micros", "µs spent")
 var ledgerTrackerMuLockCount = metrics.NewCounter("ledger_lock_trackermu_count", "calls")
 var ledgerTrackerMuLockMicros = metrics.NewCounter("ledger_lock_trackermu_micros", "µs spent")
+var ledgerBlocksValidatedTotal = metrics.MakeCounter(metrics.LedgerBlocksValidatedTotal)
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build dbfaults
+// +build dbfaults
+
+package ledger
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/faults"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// addEmptyBlocks adds count empty blocks on top of blk, and returns the last one added.
+func addEmptyBlocks(t *testing.T, l *Ledger, blk bookkeeping.Block, count int) bookkeeping.Block {
+	for i := 0; i < count; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	return blk
+}
+
+// testFaultsEngines runs test with the tracker database kept in each storage engine.
+func testFaultsEngines(t *testing.T, test func(t *testing.T, cfg config.Local)) {
+	for _, engine := range []string{StorageEngineSQLite, StorageEnginePebbleDB} {
+		t.Run(engine, func(t *testing.T) {
+			partitiontest.PartitionTest(t)
+			cfg := config.GetDefaultLocal()
+			cfg.StorageEngine = engine
+			// the key-value engines do not support catchpoints
+			cfg.CatchpointTracking = -1
+			defer faults.Reset()
+			test(t, cfg)
+		})
+	}
+}
+
+// crashed wraps injector to report when it simulates a crash.
+func crashed(injector faults.Injector) (faults.Injector, func() bool) {
+	var crash atomic.Bool
+	return func(db faults.Database, point faults.Point) *faults.Fault {
+		fault := injector(db, point)
+		if fault != nil && (fault.Crash || fault.PartialCommit) {
+			crash.Store(true)
+		}
+		return fault
+	}, crash.Load
+}
+
+// reopenAfterCrash closes l once it crashed, and reopens its databases without faults.
+func reopenAfterCrash(t *testing.T, l *Ledger, dbName string, genesisInitState ledgercore.InitState, cfg config.Local) *Ledger {
+	log := l.log
+	l.Close()
+	faults.Reset()
+	l, err := OpenLedger(log, dbName, false, genesisInitState, cfg)
+	require.NoError(t, err)
+
+	blocksCommitted, _ := l.LatestCommitted()
+	require.LessOrEqual(t, l.LatestTrackerCommitted(), blocksCommitted)
+	require.Equal(t, blocksCommitted, l.Latest())
+	_, _, err = l.LatestTotals()
+	require.NoError(t, err)
+	return l
+}
+
+// TestLedgerTransientDBFaults checks that the ledger makes progress and reopens in a consistent
+// state while its databases randomly fail and stall transactions.
+func TestLedgerTransientDBFaults(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testFaultsEngines(t, func(t *testing.T, cfg config.Local) {
+		dbName := filepath.Join(t.TempDir(), "ledger")
+		genesisInitState := getInitState()
+		log := logging.TestingLog(t)
+		log.SetLevel(logging.Error)
+
+		l, err := OpenLedger(log, dbName, false, genesisInitState, cfg)
+		require.NoError(t, err)
+
+		random := []faults.Injector{
+			faults.RandomFaults(1, faults.TrackerDB, 0.2, 0.2, 10*time.Millisecond),
+			faults.RandomFaults(2, faults.BlockDB, 0.2, 0.2, 10*time.Millisecond),
+		}
+		faults.SetInjector(func(db faults.Database, point faults.Point) *faults.Fault {
+			for _, injector := range random {
+				if fault := injector(db, point); fault != nil {
+					return fault
+				}
+			}
+			return nil
+		})
+
+		blk := addEmptyBlocks(t, l, genesisInitState.Block, 40)
+		l.WaitForCommit(blk.Round())
+		// the storage engines which do not retry the failed tracker commits leave them to the next ones
+		lookback := basics.Round(cfg.MaxAcctLookback)
+		require.Eventually(t, func() bool {
+			l.trackers.mu.Lock()
+			l.trackers.lastFlushTime = time.Time{}
+			l.trackers.mu.Unlock()
+			l.trackers.scheduleCommit(l.Latest(), lookback)
+			l.trackers.waitAccountsWriting()
+			return l.LatestTrackerCommitted()+lookback >= blk.Round()
+		}, 10*time.Second, 10*time.Millisecond)
+		_, expected, err := l.LatestTotals()
+		require.NoError(t, err)
+		l.Close()
+
+		faults.Reset()
+		l, err = OpenLedger(log, dbName, false, genesisInitState, cfg)
+		require.NoError(t, err)
+		defer l.Close()
+
+		require.Equal(t, blk.Round(), l.Latest())
+		_, totals, err := l.LatestTotals()
+		require.NoError(t, err)
+		require.Equal(t, expected, totals)
+	})
+}
+
+// TestLedgerRecoversFromCrashDuringTrackerCommit simulates a crash after blocks were written to the
+// block database, but before the trackers committed them, and checks the ledger recovers on reopen.
+func TestLedgerRecoversFromCrashDuringTrackerCommit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testFaultsEngines(t, func(t *testing.T, cfg config.Local) {
+		dbName := filepath.Join(t.TempDir(), "ledger")
+		genesisInitState := getInitState()
+		log := logging.TestingLog(t)
+		log.SetLevel(logging.Error)
+
+		l, err := OpenLedger(log, dbName, false, genesisInitState, cfg)
+		require.NoError(t, err)
+
+		blk := addEmptyBlocks(t, l, genesisInitState.Block, 20)
+		l.WaitForCommit(blk.Round())
+
+		injector, crash := crashed(faults.CrashAt(faults.TrackerDB, faults.BeforeCommit, 1))
+		faults.SetInjector(injector)
+
+		blk = addEmptyBlocks(t, l, blk, 20)
+		l.trackers.mu.Lock()
+		l.trackers.lastFlushTime = time.Time{}
+		l.trackers.mu.Unlock()
+		l.trackers.scheduleCommit(l.Latest(), basics.Round(cfg.MaxAcctLookback))
+		require.Eventually(t, crash, 5*time.Second, 10*time.Millisecond)
+
+		l = reopenAfterCrash(t, l, dbName, genesisInitState, cfg)
+		defer l.Close()
+
+		// the recovered ledger keeps accepting blocks from where its block database left off
+		blk.BlockHeader.Round = l.Latest()
+		blk = addEmptyBlocks(t, l, blk, 10)
+		l.WaitForCommit(blk.Round())
+		require.Equal(t, blk.Round(), l.Latest())
+	})
+}
+
+// TestLedgerRecoversFromPartialBlockFlush simulates a crash in the middle of a flush of the block
+// queue, once part of its blocks were written, and checks the ledger recovers them on reopen.
+func TestLedgerRecoversFromPartialBlockFlush(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testFaultsEngines(t, func(t *testing.T, cfg config.Local) {
+		// archival ledgers do not prune blocks, so that the writes to the block database are the added blocks
+		cfg.Archival = true
+		dbName := filepath.Join(t.TempDir(), "ledger")
+		genesisInitState := getInitState()
+		log := logging.TestingLog(t)
+		log.SetLevel(logging.Error)
+
+		l, err := OpenLedger(log, dbName, false, genesisInitState, cfg)
+		require.NoError(t, err)
+
+		injector, crash := crashed(faults.PartialCommitAt(faults.BlockDB, 10))
+		faults.SetInjector(injector)
+
+		blk := addEmptyBlocks(t, l, genesisInitState.Block, 20)
+		require.Eventually(t, crash, 5*time.Second, 10*time.Millisecond)
+
+		l = reopenAfterCrash(t, l, dbName, genesisInitState, cfg)
+		defer l.Close()
+
+		// the blocks written before the interrupted one are kept, whichever flush they were part of
+		require.Equal(t, basics.Round(9), l.Latest())
+		blk.BlockHeader.Round = l.Latest()
+		blk = addEmptyBlocks(t, l, blk, 10)
+		l.WaitForCommit(blk.Round())
+		require.Equal(t, blk.Round(), l.Latest())
+	})
+}
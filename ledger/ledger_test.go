@@ -1530,6 +1530,36 @@ func TestGetLastCatchpointLabel(t *testing.T) {
 	require.Equal(t, lastCatchpointLabel, ledger.GetLastCatchpointLabel())
 }
 
+// TestLedgerFlushTrackers checks FlushTrackers commits the trackers state without waiting for the flush interval.
+func TestLedgerFlushTrackers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 4
+	l, err := OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the first blocks are committed by the first flush, let the flush interval start over
+	l.trackers.mu.Lock()
+	l.trackers.lastFlushTime = time.Now()
+	l.trackers.mu.Unlock()
+
+	for i := 0; i < 20; i++ {
+		blk := makeNewEmptyBlock(t, l, t.Name(), genesisInitState.Accounts)
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	l.WaitForCommit(l.Latest())
+	l.trackers.waitAccountsWriting()
+	require.Less(t, l.LatestTrackerCommitted(), l.Latest()-basics.Round(cfg.MaxAcctLookback))
+
+	l.FlushTrackers()
+	require.Equal(t, l.Latest()-basics.Round(cfg.MaxAcctLookback), l.LatestTrackerCommitted())
+}
+
 // generate at least 3 asset and 3 app creatables, and return the ids
 // of the asset/app with at least 3 elements less or equal.
 func generateCreatables(numElementsPerSegement int) (
@@ -2334,7 +2364,7 @@ func TestLedgerMigrateV6ShrinkDeltas(t *testing.T) {
 	cfg.MaxAcctLookback = proto.MaxBalLookback
 	log := logging.TestingLog(t)
 	log.SetLevel(logging.Info) // prevent spamming with ledger.AddValidatedBlock debug message
-	trackerDB, blockDB, err := openLedgerDB(dbName, inMem, cfg, log)
+	trackerDB, blockDB, err := openLedgerDB(resolveLedgerPaths(dbName, "", cfg), inMem, cfg, log)
 	require.NoError(t, err)
 	defer func() {
 		trackerDB.Close()
@@ -3248,3 +3278,28 @@ func TestLedgerSPTrackerAfterReplay(t *testing.T) {
 	a.Equal(1, len(l.spVerification.pendingDeleteContexts))
 	verifyStateProofVerificationTracking(t, &l.spVerification, firstStateProofRound, 1, proto.StateProofInterval, true, any)
 }
+
+func TestLedgerConfiguredDirs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	genesisID := genesisInitState.Block.GenesisID()
+	rootDir := t.TempDir()
+	cfg := config.GetDefaultLocal()
+	cfg.TrackerDBDir = t.TempDir()
+	cfg.BlockDBDir = t.TempDir()
+	cfg.CatchpointDir = t.TempDir()
+	dirs, err := cfg.EnsureGenesisDirs(rootDir, genesisID)
+	require.NoError(t, err)
+
+	l, err := OpenLedger(logging.TestingLog(t), filepath.Join(rootDir, config.LedgerFilenamePrefix), false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.FileExists(t, filepath.Join(dirs.TrackerGenesisDir, config.LedgerFilenamePrefix+".tracker.sqlite"))
+	require.FileExists(t, filepath.Join(dirs.BlockGenesisDir, config.LedgerFilenamePrefix+".block.sqlite"))
+	require.NoFileExists(t, filepath.Join(rootDir, config.LedgerFilenamePrefix+".tracker.sqlite"))
+	require.NoFileExists(t, filepath.Join(rootDir, config.LedgerFilenamePrefix+".block.sqlite"))
+	require.Equal(t, dirs.CatchpointGenesisDir, l.catchpoint.dbDirectory)
+}
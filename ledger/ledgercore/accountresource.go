@@ -75,3 +75,26 @@ func AssignAccountResourceToAccountData(cindex basics.CreatableIndex, resource A
 	}
 	return
 }
+
+// AccountChanges are the net changes of the resources of an account, and of the boxes it holds as an
+// application account, between two rounds.
+type AccountChanges struct {
+	Resources []ResourceChange
+	Boxes     []BoxChange
+}
+
+// ResourceChange is the state of a resource of an account at the first and the last rounds of a range.
+type ResourceChange struct {
+	Index  basics.CreatableIndex
+	Type   basics.CreatableType
+	Before AccountResource
+	After  AccountResource
+}
+
+// BoxChange is the value of a box at the first and the last rounds of a range, nil when it did not exist.
+type BoxChange struct {
+	App    basics.AppIndex
+	Name   string
+	Before []byte
+	After  []byte
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// AccountTxn is a transaction that touched an account, as recorded by the
+// ledger's account transaction index. Inner transactions are recorded as the
+// top-level transaction they belong to.
+//
+//msgp:ignore AccountTxn
+type AccountTxn struct {
+	Round basics.Round
+	// Intra is the position of the transaction in the payset of its block.
+	Intra uint64
+	TxID  transactions.Txid
+}
+
+// AccountTxns lists the transactions that touched an account, the most recent
+// ones first. The index only knows about the rounds starting at Since.
+//
+//msgp:ignore AccountTxns
+type AccountTxns struct {
+	// Since is the first round covered by the index.
+	Since basics.Round
+	Txns  []AccountTxn
+}
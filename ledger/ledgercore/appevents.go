@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"crypto/sha512"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// AppEventSelector identifies the type of an ARC-28 event: it is made of the first
+// 4 bytes of the SHA-512/256 hash of the event signature, e.g. "Swap(uint64,uint64)".
+type AppEventSelector [4]byte
+
+// MakeAppEventSelector returns the selector of the events with the given signature.
+func MakeAppEventSelector(signature string) AppEventSelector {
+	var selector AppEventSelector
+	hash := sha512.Sum512_256([]byte(signature))
+	copy(selector[:], hash[:])
+	return selector
+}
+
+// AppEvent is an ARC-28 event, i.e. a log emitted by an application call starting
+// with the selector of the event, as recorded by the ledger's application event
+// index. Events emitted by inner application calls are recorded against the
+// top-level transaction they belong to.
+//
+//msgp:ignore AppEvent
+type AppEvent struct {
+	Round basics.Round
+	TxID  transactions.Txid
+	Inner bool
+	// App is the application which emitted the event.
+	App basics.AppIndex
+	// LogIndex is the position of the event among the logs of the application call.
+	LogIndex uint64
+
+	Selector AppEventSelector
+	// Data holds the ABI encoded arguments of the event, following the selector.
+	Data []byte
+}
+
+// AppEvents lists the events of an application, the oldest ones first. The index
+// only knows about the rounds starting at Since.
+//
+//msgp:ignore AppEvents
+type AppEvents struct {
+	// Since is the first round covered by the index.
+	Since  basics.Round
+	Events []AppEvent
+}
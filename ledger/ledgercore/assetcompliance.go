@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// AssetComplianceEventKind identifies the kind of an AssetComplianceEvent.
+type AssetComplianceEventKind string
+
+const (
+	// AssetFreezeEvent is recorded when an asset holding is frozen.
+	AssetFreezeEvent AssetComplianceEventKind = "freeze"
+	// AssetUnfreezeEvent is recorded when an asset holding is unfrozen.
+	AssetUnfreezeEvent AssetComplianceEventKind = "unfreeze"
+	// AssetClawbackEvent is recorded when the clawback address revokes an asset holding.
+	AssetClawbackEvent AssetComplianceEventKind = "clawback"
+)
+
+// AssetComplianceEvent describes a single freeze or clawback action applied to an
+// asset holding, as recorded by the ledger's asset compliance index.
+//
+//msgp:ignore AssetComplianceEvent
+type AssetComplianceEvent struct {
+	Kind  AssetComplianceEventKind
+	Round basics.Round
+	// TxID is the ID of the top-level transaction. Events emitted by inner
+	// transactions are reported against their outermost parent.
+	TxID  transactions.Txid
+	Inner bool
+
+	Asset  basics.AssetIndex
+	Target basics.Address
+	Sender basics.Address
+
+	// Receiver and Amount are only set for clawback events.
+	Receiver basics.Address
+	Amount   uint64
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// BoxHistory describes when a box was last created and deleted, as recorded by
+// the ledger's box history index. The index only knows about the rounds starting
+// at Since, so a box with neither a Created nor a Deleted round was not created
+// or deleted within that range.
+//
+//msgp:ignore BoxHistory
+type BoxHistory struct {
+	// Since is the first round covered by the index.
+	Since basics.Round
+	// Created is the round in which the box was last created, or zero if it
+	// was created before Since.
+	Created basics.Round
+	// Deleted is the round in which the box was last deleted, or zero if it
+	// was not deleted after it was last created.
+	Deleted basics.Round
+}
@@ -87,10 +87,20 @@ type ErrNoEntry struct {
 	Round     basics.Round
 	Latest    basics.Round
 	Committed basics.Round
+	// Earliest is the earliest round kept by the ledger, the blocks before it having been pruned.
+	Earliest basics.Round
+}
+
+// Pruned tells whether the entry is missing because it is behind the retention horizon of the ledger.
+func (err ErrNoEntry) Pruned() bool {
+	return err.Round < err.Earliest
 }
 
 // Error satisfies builtin interface `error`
 func (err ErrNoEntry) Error() string {
+	if err.Pruned() {
+		return fmt.Sprintf("ledger does not have entry %d: it is behind the retention horizon, the earliest round kept is %d", err.Round, err.Earliest)
+	}
 	return fmt.Sprintf("ledger does not have entry %d (latest %d, committed %d)", err.Round, err.Latest, err.Committed)
 }
 
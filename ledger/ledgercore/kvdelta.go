@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// KvDelta describes the change of a key-value pair made by a round.
+//
+//msgp:ignore KvDelta
+type KvDelta struct {
+	Key string
+	// Data is the value of the pair after the round, or nil if the round deleted it.
+	Data []byte
+	// Existed tells whether the pair existed before the round.
+	Existed bool
+}
+
+// KvRoundDeltas are the changes a round made to the key-value pairs a
+// subscriber is interested in, sorted by key.
+//
+//msgp:ignore KvRoundDeltas
+type KvRoundDeltas struct {
+	Round  basics.Round
+	Deltas []KvDelta
+}
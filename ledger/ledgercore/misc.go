@@ -33,3 +33,8 @@ type InitState struct {
 type BlockListener interface {
 	OnNewBlock(block bookkeeping.Block, delta StateDelta)
 }
+
+// CatchpointFileListener represents an object that needs to get notified on the catchpoint files written.
+type CatchpointFileListener interface {
+	OnCatchpointFile(round basics.Round, label string)
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ProposerStats compares the number of blocks an account proposed over a range of rounds
+// with the number of blocks it was expected to propose given its share of the online stake.
+//
+//msgp:ignore ProposerStats
+type ProposerStats struct {
+	Address basics.Address
+	// Stake is the online stake of the account, as of the balance round of the last round of the range.
+	Stake basics.MicroAlgos
+	// Proposals is the number of blocks of the range proposed by the account.
+	Proposals uint64
+	// ExpectedProposals is the number of blocks of the range the account was expected to propose.
+	ExpectedProposals float64
+}
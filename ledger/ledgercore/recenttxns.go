@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// RecentTxnQuery selects transactions from the ledger's recent transaction index.
+// A transaction matches the query when it matches all of its non-zero fields.
+//
+//msgp:ignore RecentTxnQuery
+type RecentTxnQuery struct {
+	Sender basics.Address
+	// Lease is only matched along with the Sender, since leases are scoped to the
+	// account that sets them.
+	Lease      [32]byte
+	NotePrefix []byte
+}
+
+// RecentTxn is a top-level transaction recorded by the ledger's recent transaction index.
+//
+//msgp:ignore RecentTxn
+type RecentTxn struct {
+	Round basics.Round
+	// Intra is the position of the transaction in the payset of its block.
+	Intra     uint64
+	TxID      transactions.Txid
+	Sender    basics.Address
+	LastValid basics.Round
+}
+
+// RecentTxns lists the transactions matching a query, the most recent ones first.
+// The index only knows about the rounds starting at Since.
+//
+//msgp:ignore RecentTxns
+type RecentTxns struct {
+	// Since is the first round covered by the index.
+	Since basics.Round
+	Txns  []RecentTxn
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ProposerReport compares, for the top n online accounts, the number of blocks they proposed between
+// minRound and maxRound with the number of blocks they were expected to propose given their share of
+// the online stake. Accounts outside of the top n which proposed blocks in the range are reported too.
+// The stake of the accounts is taken as of the balance round of maxRound, and the accounts are sorted
+// by decreasing stake.
+func (l *Ledger) ProposerReport(minRound, maxRound basics.Round, n uint64) ([]ledgercore.ProposerStats, error) {
+	if minRound == 0 || minRound > maxRound {
+		return nil, fmt.Errorf("invalid round range [%d, %d]", minRound, maxRound)
+	}
+
+	hdr, err := l.BlockHdr(maxRound)
+	if err != nil {
+		return nil, err
+	}
+	params, ok := config.Consensus[hdr.CurrentProtocol]
+	if !ok {
+		return nil, protocol.Error(hdr.CurrentProtocol)
+	}
+	balanceRound := agreement.BalanceRound(maxRound, params)
+	balanceHdr, err := l.BlockHdr(balanceRound)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stake is looked up first, so that a balance round outside of the lookback window of the online
+	// accounts fails before the certificates are read.
+	l.trackerMu.RLock()
+	top, _, err := l.acctsOnline.TopOnlineAccounts(balanceRound, maxRound, n, &params, balanceHdr.RewardsLevel)
+	if err != nil {
+		l.trackerMu.RUnlock()
+		return nil, err
+	}
+	totalStake, err := l.acctsOnline.onlineCirculation(balanceRound, maxRound)
+	l.trackerMu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The proposer of a block is recorded in its certificate. Blocks without a certificate, such as
+	// the ones of dev mode networks, are not taken into account.
+	proposals := make(map[basics.Address]uint64)
+	var certifiedRounds uint64
+	for rnd := minRound; rnd <= maxRound; rnd++ {
+		_, encodedCert, err := l.EncodedBlockCert(rnd)
+		if err != nil {
+			return nil, err
+		}
+		if len(encodedCert) == 0 {
+			continue
+		}
+		var cert agreement.Certificate
+		err = protocol.Decode(encodedCert, &cert)
+		if err != nil {
+			return nil, err
+		}
+		if cert.Proposal.OriginalProposer.IsZero() {
+			continue
+		}
+		proposals[cert.Proposal.OriginalProposer]++
+		certifiedRounds++
+	}
+
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	report := make([]ledgercore.ProposerStats, 0, len(top)+len(proposals))
+	reported := make(map[basics.Address]bool, len(top))
+	for _, acct := range top {
+		report = append(report, ledgercore.ProposerStats{Address: acct.Address, Stake: acct.MicroAlgos})
+		reported[acct.Address] = true
+	}
+	for addr := range proposals {
+		if reported[addr] {
+			continue
+		}
+		data, err := l.acctsOnline.LookupOnlineAccountData(balanceRound, addr)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, ledgercore.ProposerStats{Address: addr, Stake: data.MicroAlgosWithRewards})
+	}
+
+	for i := range report {
+		report[i].Proposals = proposals[report[i].Address]
+		if totalStake.Raw > 0 {
+			share := float64(report[i].Stake.Raw) / float64(totalStake.Raw)
+			report[i].ExpectedProposals = share * float64(certifiedRounds)
+		}
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Stake.Raw != report[j].Stake.Raw {
+			return report[i].Stake.Raw > report[j].Stake.Raw
+		}
+		return bytes.Compare(report[i].Address[:], report[j].Address[:]) < 0
+	})
+	return report, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/execpool"
+)
+
+func TestProposerReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	var online []basics.Address
+	for addr, data := range genesisInitState.Accounts {
+		if data.Status == basics.Online {
+			online = append(online, addr)
+		}
+	}
+	require.GreaterOrEqual(t, len(online), 2)
+
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+	l.verifiedTxnCache = verify.GetMockedCache(false)
+
+	// the first account proposes 6 blocks, the second one 3, and the last block has no certificate
+	proposers := []basics.Address{online[0], online[0], online[1], online[0], online[1], online[0], online[0], online[1], online[0], {}}
+	for _, proposer := range proposers {
+		blk := makeNewEmptyBlock(t, l, t.Name(), genesisInitState.Accounts)
+		vb, err := l.Validate(context.Background(), blk, backlogPool)
+		require.NoError(t, err)
+		var cert agreement.Certificate
+		if !proposer.IsZero() {
+			cert.Round = blk.Round()
+			cert.Proposal.OriginalProposer = proposer
+			cert.Proposal.BlockDigest = blk.Digest()
+		}
+		require.NoError(t, l.AddValidatedBlock(*vb, cert))
+	}
+
+	_, err = l.ProposerReport(0, 10, 10)
+	require.Error(t, err)
+	_, err = l.ProposerReport(5, 4, 10)
+	require.Error(t, err)
+	_, err = l.ProposerReport(1, l.Latest()+1, 10)
+	require.Error(t, err)
+
+	report, err := l.ProposerReport(1, basics.Round(len(proposers)), 1)
+	require.NoError(t, err)
+
+	totalStake, err := l.OnlineCirculation(0, basics.Round(len(proposers)))
+	require.NoError(t, err)
+	byAddr := make(map[basics.Address]int)
+	for i, stats := range report {
+		byAddr[stats.Address] = i
+		if i > 0 {
+			require.GreaterOrEqual(t, report[i-1].Stake.Raw, stats.Stake.Raw)
+		}
+		share := float64(stats.Stake.Raw) / float64(totalStake.Raw)
+		require.InDelta(t, share*9, stats.ExpectedProposals, 1e-9)
+	}
+
+	// the top account and the two proposers are reported, and nobody else
+	require.Contains(t, byAddr, online[0])
+	require.Contains(t, byAddr, online[1])
+	require.LessOrEqual(t, len(report), 3)
+	require.Equal(t, uint64(6), report[byAddr[online[0]]].Proposals)
+	require.Equal(t, uint64(3), report[byAddr[online[1]]].Proposals)
+	require.Equal(t, genesisInitState.Accounts[online[0]].MicroAlgos, report[byAddr[online[0]]].Stake)
+}
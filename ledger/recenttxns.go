@@ -0,0 +1,282 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// ErrRecentTxnIndexDisabled is returned when querying the recent transaction
+// index on a ledger that was not configured with EnableRecentTxnIndex.
+var ErrRecentTxnIndexDisabled = errors.New("recent transaction index is not enabled")
+
+// RecentTxnNotePrefixLength is the length of the note prefixes kept by the recent
+// transaction index, and so the longest prefix it can be queried with.
+const RecentTxnNotePrefixLength = 32
+
+// ErrRecentTxnNotePrefixTooLong is returned when querying the recent transaction index
+// with a note prefix longer than RecentTxnNotePrefixLength.
+var ErrRecentTxnNotePrefixTooLong = fmt.Errorf("note prefixes cannot be longer than %d bytes", RecentTxnNotePrefixLength)
+
+// recentTxnEntry is a top-level transaction of a round tracked by the recent transaction index.
+type recentTxnEntry struct {
+	sender     basics.Address
+	lease      [32]byte
+	notePrefix []byte
+	intra      uint64
+	txid       transactions.Txid
+	lastValid  basics.Round
+}
+
+type recentTxnRound struct {
+	round   basics.Round
+	entries []recentTxnEntry
+}
+
+// recentTxnRef locates an entry of the recent transaction index.
+type recentTxnRef struct {
+	round basics.Round
+	pos   int
+}
+
+// recentTxnIndexTracker indexes the leases and note prefixes of the top-level
+// transactions of the last MaxTxnLife rounds, so that clients can find out whether
+// a transaction they submitted with a given lease or note was confirmed without
+// resorting to an indexer. The index is only held in memory; it is rebuilt from the
+// blocks of the ledger when the ledger is loaded.
+type recentTxnIndexTracker struct {
+	enabled bool
+
+	log logging.Logger
+
+	mu    deadlock.RWMutex
+	since basics.Round
+	// rounds holds the entries of consecutive rounds, the oldest first.
+	rounds []recentTxnRound
+	leases map[ledgercore.Txlease][]recentTxnRef
+}
+
+func (rt *recentTxnIndexTracker) initialize(cfg config.Local) {
+	rt.enabled = cfg.EnableRecentTxnIndex
+}
+
+func (rt *recentTxnIndexTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.since = dbRound + 1
+	rt.rounds = nil
+	rt.leases = make(map[ledgercore.Txlease][]recentTxnRef)
+	if !rt.enabled {
+		return nil
+	}
+
+	rt.log = l.trackerLog()
+	hdr, err := l.BlockHdr(dbRound)
+	if err != nil {
+		return fmt.Errorf("recentTxnIndexTracker: unable to load block header %d : %w", dbRound, err)
+	}
+	maxTxnLife := basics.Round(config.Consensus[hdr.CurrentProtocol].MaxTxnLife)
+
+	// the blocks of the rounds past dbRound are replayed through newBlock. Going
+	// backwards, stop at the first block missing from the block database, e.g.
+	// after a fast catchup.
+	var blocks []bookkeeping.Block
+	for rnd := dbRound; rnd > 0 && rnd+maxTxnLife > dbRound; rnd-- {
+		blk, err := l.Block(rnd)
+		if err != nil {
+			break
+		}
+		blocks = append(blocks, blk)
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		rt.add(blocks[i])
+	}
+	return nil
+}
+
+func (rt *recentTxnIndexTracker) close() {
+}
+
+func (rt *recentTxnIndexTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !rt.enabled {
+		return
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.add(blk)
+}
+
+// add indexes the transactions of blk, and evicts the rounds which are now past
+// MaxTxnLife. It must be called with the lock held.
+func (rt *recentTxnIndexTracker) add(blk bookkeeping.Block) {
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		rt.log.Warnf("recentTxnIndexTracker: unable to decode the payset of round %d : %v", blk.Round(), err)
+	}
+
+	if len(rt.rounds) == 0 || rt.rounds[len(rt.rounds)-1].round+1 != blk.Round() {
+		// the rounds have to be consecutive; start over if one was skipped
+		rt.rounds = nil
+		rt.leases = make(map[ledgercore.Txlease][]recentTxnRef)
+		rt.since = blk.Round()
+	}
+
+	entries := make([]recentTxnEntry, len(payset))
+	for intra, stxn := range payset {
+		note := stxn.Txn.Note
+		if len(note) > RecentTxnNotePrefixLength {
+			note = note[:RecentTxnNotePrefixLength]
+		}
+		entries[intra] = recentTxnEntry{
+			sender:     stxn.Txn.Sender,
+			lease:      stxn.Txn.Lease,
+			notePrefix: append([]byte(nil), note...),
+			intra:      uint64(intra),
+			txid:       stxn.ID(),
+			lastValid:  stxn.Txn.LastValid,
+		}
+		if stxn.Txn.Lease != [32]byte{} {
+			key := ledgercore.Txlease{Sender: stxn.Txn.Sender, Lease: stxn.Txn.Lease}
+			rt.leases[key] = append(rt.leases[key], recentTxnRef{round: blk.Round(), pos: intra})
+		}
+	}
+	rt.rounds = append(rt.rounds, recentTxnRound{round: blk.Round(), entries: entries})
+
+	maxTxnLife := basics.Round(config.Consensus[blk.CurrentProtocol].MaxTxnLife)
+	evict := 0
+	for evict < len(rt.rounds) && rt.rounds[evict].round+maxTxnLife <= blk.Round() {
+		for _, entry := range rt.rounds[evict].entries {
+			if entry.lease == [32]byte{} {
+				continue
+			}
+			// the references are appended in round order, so the evicted ones come first
+			key := ledgercore.Txlease{Sender: entry.sender, Lease: entry.lease}
+			refs := rt.leases[key][1:]
+			if len(refs) == 0 {
+				delete(rt.leases, key)
+			} else {
+				rt.leases[key] = refs
+			}
+		}
+		evict++
+	}
+	rt.rounds = rt.rounds[evict:]
+	if rt.since < rt.rounds[0].round {
+		rt.since = rt.rounds[0].round
+	}
+}
+
+func (e *recentTxnEntry) matches(query *ledgercore.RecentTxnQuery) bool {
+	if !query.Sender.IsZero() && e.sender != query.Sender {
+		return false
+	}
+	if query.Lease != [32]byte{} && e.lease != query.Lease {
+		return false
+	}
+	return bytes.HasPrefix(e.notePrefix, query.NotePrefix)
+}
+
+func (e *recentTxnEntry) recentTxn(rnd basics.Round) ledgercore.RecentTxn {
+	return ledgercore.RecentTxn{Round: rnd, Intra: e.intra, TxID: e.txid, Sender: e.sender, LastValid: e.lastValid}
+}
+
+// lookup returns up to limit transactions matching query, the most recent ones
+// first. A limit of 0 returns all of them.
+func (rt *recentTxnIndexTracker) lookup(query ledgercore.RecentTxnQuery, limit uint64) (ledgercore.RecentTxns, error) {
+	if !rt.enabled {
+		return ledgercore.RecentTxns{}, ErrRecentTxnIndexDisabled
+	}
+	if len(query.NotePrefix) > RecentTxnNotePrefixLength {
+		return ledgercore.RecentTxns{}, ErrRecentTxnNotePrefixTooLong
+	}
+
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+	result := ledgercore.RecentTxns{Since: rt.since}
+	full := func() bool {
+		return limit > 0 && uint64(len(result.Txns)) == limit
+	}
+
+	if query.Lease != [32]byte{} {
+		// leases are looked up through their own index, which needs the sender
+		if query.Sender.IsZero() {
+			return result, nil
+		}
+		refs := rt.leases[ledgercore.Txlease{Sender: query.Sender, Lease: query.Lease}]
+		for i := len(refs) - 1; i >= 0 && !full(); i-- {
+			entry := &rt.rounds[refs[i].round-rt.rounds[0].round].entries[refs[i].pos]
+			if entry.matches(&query) {
+				result.Txns = append(result.Txns, entry.recentTxn(refs[i].round))
+			}
+		}
+		return result, nil
+	}
+
+	for i := len(rt.rounds) - 1; i >= 0 && !full(); i-- {
+		entries := rt.rounds[i].entries
+		for j := len(entries) - 1; j >= 0 && !full(); j-- {
+			if entries[j].matches(&query) {
+				result.Txns = append(result.Txns, entries[j].recentTxn(rt.rounds[i].round))
+			}
+		}
+	}
+	return result, nil
+}
+
+func (rt *recentTxnIndexTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+func (rt *recentTxnIndexTracker) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+func (rt *recentTxnIndexTracker) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+func (rt *recentTxnIndexTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (rt *recentTxnIndexTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (rt *recentTxnIndexTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (rt *recentTxnIndexTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (rt *recentTxnIndexTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (rt *recentTxnIndexTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRecentTxnIndexTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(1, true)}
+	ml := makeMockLedgerForTracker(t, true, 1, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableRecentTxnIndex = true
+	var rt recentTxnIndexTracker
+	rt.initialize(cfg)
+	require.NoError(t, rt.loadFromDisk(ml, 0))
+
+	genesisHash := crypto.Digest{1}
+	addrs := []basics.Address{{1}, {2}}
+	lease := [32]byte{7}
+	pay := func(from basics.Address, lease [32]byte, note string) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = protocol.PaymentTx
+		stxn.Txn.Sender = from
+		stxn.Txn.Lease = lease
+		stxn.Txn.Note = []byte(note)
+		stxn.Txn.LastValid = 100
+		stxn.Txn.GenesisHash = genesisHash
+		return stxn
+	}
+	newBlock := func(txns ...transactions.SignedTxnWithAD) []transactions.Txid {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: ml.Latest() + 1}}
+		blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+		blk.BlockHeader.GenesisHash = genesisHash
+		var txids []transactions.Txid
+		for _, stxn := range txns {
+			stib, err := blk.EncodeSignedTxn(stxn.SignedTxn, stxn.ApplyData)
+			require.NoError(t, err)
+			blk.Payset = append(blk.Payset, stib)
+			txids = append(txids, stxn.ID())
+		}
+		ml.addToBlockQueue(blockEntry{block: blk}, ledgercore.StateDelta{})
+		rt.newBlock(blk, ledgercore.StateDelta{})
+		return txids
+	}
+	lookup := func(query ledgercore.RecentTxnQuery, limit uint64) ledgercore.RecentTxns {
+		txns, err := rt.lookup(query, limit)
+		require.NoError(t, err)
+		return txns
+	}
+
+	txids1 := newBlock(pay(addrs[0], lease, "order:1"), pay(addrs[1], lease, "order:2"))
+	txids2 := newBlock(pay(addrs[0], [32]byte{}, "order:3"), pay(addrs[0], lease, "other"))
+
+	leased := []ledgercore.RecentTxn{
+		{Round: 2, Intra: 1, TxID: txids2[1], Sender: addrs[0], LastValid: 100},
+		{Round: 1, Intra: 0, TxID: txids1[0], Sender: addrs[0], LastValid: 100},
+	}
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: leased}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 0))
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: leased[:1]}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 1))
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: leased[1:]}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease, NotePrefix: []byte("order")}, 0))
+	// leases are scoped to their sender
+	require.Equal(t, ledgercore.RecentTxns{Since: 1}, lookup(ledgercore.RecentTxnQuery{Lease: lease}, 0))
+
+	orders := []ledgercore.RecentTxn{
+		{Round: 2, Intra: 0, TxID: txids2[0], Sender: addrs[0], LastValid: 100},
+		{Round: 1, Intra: 1, TxID: txids1[1], Sender: addrs[1], LastValid: 100},
+		{Round: 1, Intra: 0, TxID: txids1[0], Sender: addrs[0], LastValid: 100},
+	}
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: orders}, lookup(ledgercore.RecentTxnQuery{NotePrefix: []byte("order:")}, 0))
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: orders[1:2]}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[1], NotePrefix: []byte("order:")}, 0))
+
+	_, err := rt.lookup(ledgercore.RecentTxnQuery{NotePrefix: make([]byte, RecentTxnNotePrefixLength+1)}, 0)
+	require.ErrorIs(t, err, ErrRecentTxnNotePrefixTooLong)
+
+	// the index is rebuilt from the blocks when loaded
+	require.NoError(t, rt.loadFromDisk(ml, 2))
+	require.Equal(t, ledgercore.RecentTxns{Since: 1, Txns: leased}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 0))
+
+	// the rounds past MaxTxnLife are evicted
+	maxTxnLife := config.Consensus[protocol.ConsensusCurrentVersion].MaxTxnLife
+	for i := uint64(1); i < maxTxnLife; i++ {
+		newBlock()
+	}
+	require.Equal(t, ledgercore.RecentTxns{Since: 2, Txns: leased[:1]}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 0))
+	require.Equal(t, ledgercore.RecentTxns{Since: 2, Txns: orders[:1]}, lookup(ledgercore.RecentTxnQuery{NotePrefix: []byte("order:")}, 0))
+	newBlock()
+	require.Equal(t, ledgercore.RecentTxns{Since: 3}, lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 0))
+	require.Empty(t, rt.leases)
+
+	var disabled recentTxnIndexTracker
+	disabled.initialize(config.GetDefaultLocal())
+	require.NoError(t, disabled.loadFromDisk(ml, ml.Latest()))
+	_, err = disabled.lookup(ledgercore.RecentTxnQuery{Sender: addrs[0], Lease: lease}, 0)
+	require.ErrorIs(t, err, ErrRecentTxnIndexDisabled)
+}
+
+func TestRecentTxnIndexConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	query := ledgercore.RecentTxnQuery{NotePrefix: []byte("x")}
+	_, err = l.RecentTransactions(query, 0)
+	require.ErrorIs(t, err, ErrRecentTxnIndexDisabled)
+
+	cfg.EnableRecentTxnIndex = true
+	l2, err := OpenLedger(logging.TestingLog(t), t.Name()+"-enabled", true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	addEmptyValidatedBlock(t, l2, genesisInitState.Accounts)
+	l2.WaitForCommit(l2.Latest())
+	require.NoError(t, l2.reloadLedger())
+
+	txns, err := l2.RecentTransactions(query, 0)
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.RecentTxns{Since: 1}, txns)
+}
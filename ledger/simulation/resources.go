@@ -17,8 +17,10 @@
 package simulation
 
 import (
+	"bytes"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
@@ -605,3 +607,353 @@ func (p *resourcePolicy) AvailableBox(app basics.AppIndex, name string, operatio
 	}
 	return p.tracker.addBox(app, name, readSize, *p.initialBoxSurplusReadBudget, p.ep.Proto.BytesPerBoxReference)
 }
+
+// ResourceArrays are resources to add to the foreign arrays of an app call. Boxes name their app by
+// ID rather than by its position in the foreign apps of the app call, and an empty BoxRef stands for
+// an empty box reference adding to the box I/O budget.
+type ResourceArrays struct {
+	Accounts []basics.Address
+	Assets   []basics.AssetIndex
+	Apps     []basics.AppIndex
+	Boxes    []logic.BoxRef
+}
+
+// resourceSlot tracks the references of an app call, including the ones it was submitted with, as
+// resources are placed into it.
+type resourceSlot struct {
+	added ResourceArrays
+
+	sender   basics.Address
+	accounts map[basics.Address]struct{}
+	assets   map[basics.AssetIndex]struct{}
+	apps     map[basics.AppIndex]struct{}
+
+	numAccounts, numAssets, numApps, numBoxes int
+}
+
+func makeResourceSlot(txn *transactions.Transaction) *resourceSlot {
+	slot := &resourceSlot{
+		sender:      txn.Sender,
+		accounts:    make(map[basics.Address]struct{}),
+		assets:      make(map[basics.AssetIndex]struct{}),
+		apps:        make(map[basics.AppIndex]struct{}),
+		numAccounts: len(txn.Accounts),
+		numAssets:   len(txn.ForeignAssets),
+		numApps:     len(txn.ForeignApps),
+		numBoxes:    len(txn.Boxes),
+	}
+	for _, addr := range txn.Accounts {
+		slot.accounts[addr] = struct{}{}
+	}
+	for _, aid := range txn.ForeignAssets {
+		slot.assets[aid] = struct{}{}
+	}
+	if txn.ApplicationID != 0 {
+		slot.apps[txn.ApplicationID] = struct{}{}
+	}
+	for _, aid := range txn.ForeignApps {
+		slot.apps[aid] = struct{}{}
+	}
+	return slot
+}
+
+func (s *resourceSlot) hasAccount(addr basics.Address) bool {
+	_, ok := s.accounts[addr]
+	return ok || addr == s.sender
+}
+
+func (s *resourceSlot) hasAsset(aid basics.AssetIndex) bool {
+	_, ok := s.assets[aid]
+	return ok
+}
+
+func (s *resourceSlot) hasApp(aid basics.AppIndex) bool {
+	_, ok := s.apps[aid]
+	return ok
+}
+
+func (s *resourceSlot) fits(accounts, assets, apps, boxes int, proto *config.ConsensusParams) bool {
+	return s.numAccounts+accounts <= proto.MaxAppTxnAccounts &&
+		s.numAssets+assets <= proto.MaxAppTxnForeignAssets &&
+		s.numApps+apps <= proto.MaxAppTxnForeignApps &&
+		s.numBoxes+boxes <= proto.MaxAppBoxReferences &&
+		s.numAccounts+s.numAssets+s.numApps+s.numBoxes+accounts+assets+apps+boxes <= proto.MaxAppTotalTxnReferences
+}
+
+func (s *resourceSlot) addAccount(addr basics.Address) {
+	if s.hasAccount(addr) {
+		return
+	}
+	s.accounts[addr] = struct{}{}
+	s.added.Accounts = append(s.added.Accounts, addr)
+	s.numAccounts++
+}
+
+func (s *resourceSlot) addAsset(aid basics.AssetIndex) {
+	if s.hasAsset(aid) {
+		return
+	}
+	s.assets[aid] = struct{}{}
+	s.added.Assets = append(s.added.Assets, aid)
+	s.numAssets++
+}
+
+func (s *resourceSlot) addApp(aid basics.AppIndex) {
+	if s.hasApp(aid) {
+		return
+	}
+	s.apps[aid] = struct{}{}
+	s.added.Apps = append(s.added.Apps, aid)
+	s.numApps++
+}
+
+func (s *resourceSlot) addBox(box logic.BoxRef) {
+	s.added.Boxes = append(s.added.Boxes, box)
+	s.numBoxes++
+}
+
+// resourcePopulator places the unnamed resources accessed by a group into the foreign arrays of its
+// app calls, and into extra app calls once those are full.
+type resourcePopulator struct {
+	proto    *config.ConsensusParams
+	txnSlots map[int]*resourceSlot
+	order    []int
+	extra    []*resourceSlot
+	// maxExtra is the number of app calls which can be added to the group.
+	maxExtra int
+}
+
+func makeResourcePopulator(txgroup []transactions.SignedTxnWithAD, proto *config.ConsensusParams) *resourcePopulator {
+	p := &resourcePopulator{
+		proto:    proto,
+		txnSlots: make(map[int]*resourceSlot),
+		maxExtra: proto.MaxTxGroupSize - len(txgroup),
+	}
+	for i := range txgroup {
+		if txgroup[i].Txn.Type == protocol.ApplicationCallTx {
+			p.txnSlots[i] = makeResourceSlot(&txgroup[i].Txn)
+			p.order = append(p.order, i)
+		}
+	}
+	return p
+}
+
+// place adds a resource to the first app call with room for the references it needs there, adding
+// an extra app call if none has room. It returns false if the resource could not be placed.
+func (p *resourcePopulator) place(need func(*resourceSlot) (accounts, assets, apps, boxes int), add func(*resourceSlot)) bool {
+	tryPlace := func(slot *resourceSlot) bool {
+		accounts, assets, apps, boxes := need(slot)
+		if !slot.fits(accounts, assets, apps, boxes, p.proto) {
+			return false
+		}
+		add(slot)
+		return true
+	}
+	for _, gi := range p.order {
+		if tryPlace(p.txnSlots[gi]) {
+			return true
+		}
+	}
+	for _, slot := range p.extra {
+		if tryPlace(slot) {
+			return true
+		}
+	}
+	if len(p.extra) >= p.maxExtra {
+		return false
+	}
+	slot := makeResourceSlot(&transactions.Transaction{})
+	p.extra = append(p.extra, slot)
+	return tryPlace(slot)
+}
+
+func (p *resourcePopulator) available(has func(*resourceSlot) bool) bool {
+	for _, gi := range p.order {
+		if has(p.txnSlots[gi]) {
+			return true
+		}
+	}
+	for _, slot := range p.extra {
+		if has(slot) {
+			return true
+		}
+	}
+	return false
+}
+
+// missing counts a reference which still needs to be added.
+func missing(has bool) int {
+	if has {
+		return 0
+	}
+	return 1
+}
+
+// addTxnResources adds the resources a transaction which cannot use shared resources accessed to
+// its own foreign arrays. The tracker of the transaction already kept them within its limits.
+func (p *resourcePopulator) addTxnResources(gi int, resources *ResourceTracker) {
+	slot, ok := p.txnSlots[gi]
+	if !ok {
+		return
+	}
+	for _, app := range sortedApps(resources.Apps) {
+		slot.addApp(app)
+	}
+	for _, aid := range sortedAssets(resources.Assets) {
+		slot.addAsset(aid)
+	}
+	for _, addr := range sortedAddresses(resources.Accounts) {
+		slot.addAccount(addr)
+	}
+	for _, box := range sortedBoxes(resources.Boxes) {
+		slot.addBox(box)
+	}
+	for i := 0; i < resources.NumEmptyBoxRefs; i++ {
+		slot.addBox(logic.BoxRef{})
+	}
+}
+
+// addGroupResources spreads the resources the transactions which can use shared resources
+// accessed across the app calls of the group. Cross-product references go first, as both of
+// their resources must be in the same app call, then boxes along with their apps.
+func (p *resourcePopulator) addGroupResources(resources *ResourceTracker) {
+	for _, local := range sortedLocals(resources.AppLocals) {
+		p.place(func(s *resourceSlot) (int, int, int, int) {
+			return missing(s.hasAccount(local.Address)), 0, missing(s.hasApp(local.App)), 0
+		}, func(s *resourceSlot) {
+			s.addApp(local.App)
+			s.addAccount(local.Address)
+		})
+	}
+	for _, holding := range sortedHoldings(resources.AssetHoldings) {
+		p.place(func(s *resourceSlot) (int, int, int, int) {
+			return missing(s.hasAccount(holding.Address)), missing(s.hasAsset(holding.Asset)), 0, 0
+		}, func(s *resourceSlot) {
+			s.addAsset(holding.Asset)
+			s.addAccount(holding.Address)
+		})
+	}
+	for _, box := range sortedBoxes(resources.Boxes) {
+		p.place(func(s *resourceSlot) (int, int, int, int) {
+			return 0, 0, missing(s.hasApp(box.App)), 1
+		}, func(s *resourceSlot) {
+			s.addApp(box.App)
+			s.addBox(box)
+		})
+	}
+	for _, app := range sortedApps(resources.Apps) {
+		if p.available(func(s *resourceSlot) bool { return s.hasApp(app) }) {
+			continue
+		}
+		p.place(func(*resourceSlot) (int, int, int, int) { return 0, 0, 1, 0 }, func(s *resourceSlot) { s.addApp(app) })
+	}
+	for _, aid := range sortedAssets(resources.Assets) {
+		if p.available(func(s *resourceSlot) bool { return s.hasAsset(aid) }) {
+			continue
+		}
+		p.place(func(*resourceSlot) (int, int, int, int) { return 0, 1, 0, 0 }, func(s *resourceSlot) { s.addAsset(aid) })
+	}
+	for _, addr := range sortedAddresses(resources.Accounts) {
+		if p.available(func(s *resourceSlot) bool { return s.hasAccount(addr) }) {
+			continue
+		}
+		p.place(func(*resourceSlot) (int, int, int, int) { return 1, 0, 0, 0 }, func(s *resourceSlot) { s.addAccount(addr) })
+	}
+	for i := 0; i < resources.NumEmptyBoxRefs; i++ {
+		p.place(func(*resourceSlot) (int, int, int, int) { return 0, 0, 0, 1 }, func(s *resourceSlot) { s.addBox(logic.BoxRef{}) })
+	}
+}
+
+// populateResourceArrays fills in the PopulatedResourceArrays of the app calls of the group and its
+// ExtraResourceArrays from the unnamed resources it accessed.
+func (r *TxnGroupResult) populateResourceArrays(txgroup []transactions.SignedTxnWithAD, proto *config.ConsensusParams) {
+	p := makeResourcePopulator(txgroup, proto)
+	for i := range r.Txns {
+		if r.Txns[i].UnnamedResourcesAccessed != nil {
+			p.addTxnResources(i, r.Txns[i].UnnamedResourcesAccessed)
+		}
+	}
+	if r.UnnamedResourcesAccessed != nil {
+		p.addGroupResources(r.UnnamedResourcesAccessed)
+	}
+
+	for gi, slot := range p.txnSlots {
+		if len(slot.added.Accounts)+len(slot.added.Assets)+len(slot.added.Apps)+len(slot.added.Boxes) != 0 {
+			added := slot.added
+			r.Txns[gi].PopulatedResourceArrays = &added
+		}
+	}
+	r.ExtraResourceArrays = nil
+	for _, slot := range p.extra {
+		r.ExtraResourceArrays = append(r.ExtraResourceArrays, slot.added)
+	}
+}
+
+func sortedAddresses(set map[basics.Address]struct{}) []basics.Address {
+	addrs := make([]basics.Address, 0, len(set))
+	for addr := range set {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	return addrs
+}
+
+func sortedAssets(set map[basics.AssetIndex]struct{}) []basics.AssetIndex {
+	aids := make([]basics.AssetIndex, 0, len(set))
+	for aid := range set {
+		aids = append(aids, aid)
+	}
+	sort.Slice(aids, func(i, j int) bool { return aids[i] < aids[j] })
+	return aids
+}
+
+func sortedApps(set map[basics.AppIndex]struct{}) []basics.AppIndex {
+	aids := make([]basics.AppIndex, 0, len(set))
+	for aid := range set {
+		aids = append(aids, aid)
+	}
+	sort.Slice(aids, func(i, j int) bool { return aids[i] < aids[j] })
+	return aids
+}
+
+func sortedBoxes(set map[logic.BoxRef]uint64) []logic.BoxRef {
+	boxes := make([]logic.BoxRef, 0, len(set))
+	for box := range set {
+		boxes = append(boxes, box)
+	}
+	sort.Slice(boxes, func(i, j int) bool {
+		if boxes[i].App != boxes[j].App {
+			return boxes[i].App < boxes[j].App
+		}
+		return boxes[i].Name < boxes[j].Name
+	})
+	return boxes
+}
+
+func sortedLocals(set map[ledgercore.AccountApp]struct{}) []ledgercore.AccountApp {
+	locals := make([]ledgercore.AccountApp, 0, len(set))
+	for local := range set {
+		locals = append(locals, local)
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		if locals[i].App != locals[j].App {
+			return locals[i].App < locals[j].App
+		}
+		return bytes.Compare(locals[i].Address[:], locals[j].Address[:]) < 0
+	})
+	return locals
+}
+
+func sortedHoldings(set map[ledgercore.AccountAsset]struct{}) []ledgercore.AccountAsset {
+	holdings := make([]ledgercore.AccountAsset, 0, len(set))
+	for holding := range set {
+		holdings = append(holdings, holding)
+	}
+	sort.Slice(holdings, func(i, j int) bool {
+		if holdings[i].Asset != holdings[j].Asset {
+			return holdings[i].Asset < holdings[j].Asset
+		}
+		return bytes.Compare(holdings[i].Address[:], holdings[j].Address[:]) < 0
+	})
+	return holdings
+}
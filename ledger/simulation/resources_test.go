@@ -296,3 +296,67 @@ func TestGlobalVsLocalResources(t *testing.T) {
 		}, groupAssignment.localTxnResources[2].Apps)
 	})
 }
+
+func TestPopulateResourceArrays(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	txns := make([]transactions.SignedTxnWithAD, 3)
+	txns[0].Txn.Type = protocol.ApplicationCallTx
+	txns[0].Txn.ApplicationID = 10
+	txns[0].Txn.ForeignAssets = []basics.AssetIndex{1, 2, 3, 4, 5, 6, 7}
+	txns[1].Txn.Type = protocol.PaymentTx
+	txns[2].Txn.Type = protocol.ApplicationCallTx
+	txns[2].Txn.ApplicationID = 20
+
+	account1 := basics.Address{1, 1, 1}
+	account2 := basics.Address{2, 2, 2}
+
+	groupResult := makeTxnGroupResult(make([]transactions.SignedTxn, len(txns)))
+	groupResult.Txns[2].UnnamedResourcesAccessed = &ResourceTracker{
+		Accounts: map[basics.Address]struct{}{account2: {}},
+	}
+	groupResult.UnnamedResourcesAccessed = &ResourceTracker{
+		Accounts: map[basics.Address]struct{}{account1: {}},
+		Assets:   map[basics.AssetIndex]struct{}{8: {}, 9: {}},
+		Boxes: map[logic.BoxRef]uint64{
+			{App: 10, Name: "box"}: 0,
+			{App: 30, Name: "box"}: 0,
+		},
+		NumEmptyBoxRefs: 1,
+	}
+
+	groupResult.populateResourceArrays(txns, &proto)
+
+	// Txn 0 only has room for one more reference, and the payment cannot hold any.
+	require.Equal(t, &ResourceArrays{
+		Boxes: []logic.BoxRef{{App: 10, Name: "box"}},
+	}, groupResult.Txns[0].PopulatedResourceArrays)
+	require.Nil(t, groupResult.Txns[1].PopulatedResourceArrays)
+	require.Equal(t, &ResourceArrays{
+		Accounts: []basics.Address{account2, account1},
+		Assets:   []basics.AssetIndex{8, 9},
+		Apps:     []basics.AppIndex{30},
+		Boxes:    []logic.BoxRef{{App: 30, Name: "box"}, {}},
+	}, groupResult.Txns[2].PopulatedResourceArrays)
+	require.Empty(t, groupResult.ExtraResourceArrays)
+
+	// Once the app calls are full, the remaining resources go to extra app calls.
+	groupResult = makeTxnGroupResult(make([]transactions.SignedTxn, len(txns)))
+	groupResult.UnnamedResourcesAccessed = &ResourceTracker{
+		Assets: make(map[basics.AssetIndex]struct{}),
+	}
+	for aid := basics.AssetIndex(100); aid < 120; aid++ {
+		groupResult.UnnamedResourcesAccessed.Assets[aid] = struct{}{}
+	}
+
+	groupResult.populateResourceArrays(txns, &proto)
+
+	require.Equal(t, &ResourceArrays{Assets: []basics.AssetIndex{100}}, groupResult.Txns[0].PopulatedResourceArrays)
+	require.Equal(t, &ResourceArrays{Assets: []basics.AssetIndex{101, 102, 103, 104, 105, 106, 107, 108}}, groupResult.Txns[2].PopulatedResourceArrays)
+	require.Equal(t, []ResourceArrays{
+		{Assets: []basics.AssetIndex{109, 110, 111, 112, 113, 114, 115, 116}},
+		{Assets: []basics.AssetIndex{117, 118, 119}},
+	}, groupResult.ExtraResourceArrays)
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// MaxSessionGroups is the maximum number of transaction groups a simulation session can accumulate.
+const MaxSessionGroups = 64
+
+// ErrSessionNotFound is returned when deleting a simulation session which does not exist.
+var ErrSessionNotFound = errors.New("simulation session not found")
+
+// ErrSessionsDisabled is returned when simulating in a session while sessions are not enabled.
+var ErrSessionsDisabled = errors.New("simulation sessions are not enabled")
+
+// Sessions keeps the simulation sessions of a node. A session lets successive simulate requests
+// build on each other's hypothetical state: every transaction group simulated successfully in a
+// session is kept, and evaluated ahead of the groups simulated later in the same session. The
+// session state is applied to the latest round of the ledger each time, rather than to the round
+// the session was created at. Sessions which are not used for the configured TTL are discarded.
+type Sessions struct {
+	mu       deadlock.Mutex
+	sessions map[string]*session
+	ttl      time.Duration
+	max      int
+}
+
+type session struct {
+	// mu serializes the simulations of a session, so that each one builds on the previous ones.
+	mu       deadlock.Mutex
+	requests []Request
+
+	// expires is protected by Sessions.mu.
+	expires time.Time
+}
+
+// MakeSessions creates a store of at most max simulation sessions, each discarded once unused for ttl.
+func MakeSessions(ttl time.Duration, max int) *Sessions {
+	return &Sessions{
+		sessions: make(map[string]*session),
+		ttl:      ttl,
+		max:      max,
+	}
+}
+
+// acquire returns the session with the given name, creating it if needed, and extends its lifetime.
+func (ss *Sessions) acquire(name string, now time.Time) (*session, error) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	for n, sess := range ss.sessions {
+		if !sess.expires.After(now) {
+			delete(ss.sessions, n)
+		}
+	}
+
+	sess, ok := ss.sessions[name]
+	if !ok {
+		if ss.max <= 0 {
+			return nil, ErrSessionsDisabled
+		}
+		if len(ss.sessions) >= ss.max {
+			return nil, InvalidRequestError{SimulatorError{fmt.Errorf("too many simulation sessions, at most %d can be open", ss.max)}}
+		}
+		sess = &session{}
+		ss.sessions[name] = sess
+	}
+	sess.expires = now.Add(ss.ttl)
+	return sess, nil
+}
+
+// Delete discards the session with the given name.
+func (ss *Sessions) Delete(name string) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	sess, ok := ss.sessions[name]
+	if !ok || !sess.expires.After(time.Now()) {
+		return ErrSessionNotFound
+	}
+	delete(ss.sessions, name)
+	return nil
+}
+
+// Simulate simulates the request on top of the state of the session named by request.Session.
+// The simulated group is added to the session if it is evaluated successfully.
+func (ss *Sessions) Simulate(ledger *data.Ledger, developerAPI bool, request Request) (Result, error) {
+	sess, err := ss.acquire(request.Session, time.Now())
+	if err != nil {
+		return Result{}, err
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if len(sess.requests) >= MaxSessionGroups {
+		return Result{}, InvalidRequestError{SimulatorError{fmt.Errorf("simulation session already holds the maximum of %d transaction groups", MaxSessionGroups)}}
+	}
+
+	simulator := MakeSimulator(ledger, developerAPI)
+	simulator.replay = sess.requests
+	result, err := simulator.Simulate(request)
+	if err != nil {
+		return Result{}, err
+	}
+	if result.TxnGroups[0].FailureMessage == "" {
+		if request.FixSigners {
+			// Replay the group with the authorizers it was evaluated with.
+			request.TxnGroups = [][]transactions.SignedTxn{withFixedSigners(request.TxnGroups[0], result.TxnGroups[0])}
+		}
+		sess.requests = append(sess.requests, request)
+	}
+	return result, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSimulateSessions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	newAccount := basics.Address{0xee}
+
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: newAccount,
+		Amount:   1_000_000,
+	})
+	spend := func(note string) []transactions.SignedTxn {
+		txn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   newAccount,
+			Receiver: sender.Addr,
+			Amount:   100_000,
+			Note:     []byte(note),
+		})
+		return []transactions.SignedTxn{txn.SignedTxn()}
+	}
+	request := func(session string, group []transactions.SignedTxn) Request {
+		return Request{TxnGroups: [][]transactions.SignedTxn{group}, AllowEmptySignatures: true, Session: session}
+	}
+
+	// the new account cannot spend anything outside of a session which funded it
+	sessions := MakeSessions(time.Minute, 2)
+	result, err := MakeSimulator(env.Ledger, false).Simulate(request("", spend("1")))
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	result, err = sessions.Simulate(env.Ledger, false, request("a", []transactions.SignedTxn{fund.Txn().Sign(sender.Sk)}))
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	result, err = sessions.Simulate(env.Ledger, false, request("a", spend("1")))
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Len(t, result.Block.Block().Payset, 2)
+
+	result, err = sessions.Simulate(env.Ledger, false, request("b", spend("1")))
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	// the session state applies on top of the rounds added to the ledger since
+	env.TransferAlgos(sender.Addr, env.Accounts[1].Addr, 1000)
+	result, err = sessions.Simulate(env.Ledger, false, request("a", spend("2")))
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, env.Ledger.Latest(), result.LastRound)
+	require.Len(t, result.Block.Block().Payset, 3)
+
+	// a transaction the session already holds cannot be added again
+	result, err = sessions.Simulate(env.Ledger, false, request("a", spend("2")))
+	require.NoError(t, err)
+	require.NotEmpty(t, result.TxnGroups[0].FailureMessage)
+	require.Len(t, sessions.sessions["a"].requests, 3)
+
+	_, err = sessions.Simulate(env.Ledger, false, request("c", spend("1")))
+	require.ErrorAs(t, err, &InvalidRequestError{})
+
+	require.NoError(t, sessions.Delete("a"))
+	require.ErrorIs(t, sessions.Delete("a"), ErrSessionNotFound)
+	result, err = sessions.Simulate(env.Ledger, false, request("a", spend("1")))
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	_, err = MakeSessions(time.Minute, 0).Simulate(env.Ledger, false, request("a", spend("1")))
+	require.ErrorIs(t, err, ErrSessionsDisabled)
+}
+
+func TestSimulateSessionsExpire(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sessions := MakeSessions(time.Minute, 2)
+	now := time.Now()
+	a, err := sessions.acquire("a", now)
+	require.NoError(t, err)
+	_, err = sessions.acquire("b", now)
+	require.NoError(t, err)
+
+	// using a session extends its lifetime, unused ones are discarded
+	again, err := sessions.acquire("a", now.Add(50*time.Second))
+	require.NoError(t, err)
+	require.Same(t, a, again)
+	_, err = sessions.acquire("c", now.Add(90*time.Second))
+	require.NoError(t, err)
+	require.Len(t, sessions.sessions, 2)
+	require.Contains(t, sessions.sessions, "a")
+	require.Contains(t, sessions.sessions, "c")
+}
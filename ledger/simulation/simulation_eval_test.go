@@ -1111,6 +1111,65 @@ func TestAppCallWithExtraBudgetReturningPC(t *testing.T) {
 	})
 }
 
+func TestSourceMapExecTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	approvalSource := `#pragma version 8
+int 1
+int 2
++
+pop
+int 1`
+	ops, err := logic.AssembleString(approvalSource)
+	require.NoError(t, err)
+	approvalHash := crypto.Hash(ops.Program)
+	sourceMap := logic.GetSourceMap([]string{"approval.teal"}, ops.OffsetToLine)
+
+	createTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   approvalSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	}).Txn().Sign(sender.Sk)
+
+	simulator := simulation.MakeSimulator(env.Ledger, true)
+	request := simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{createTxn}},
+		TraceConfig: simulation.ExecTraceConfig{Enable: true},
+		SourceMaps:  map[crypto.Digest]logic.SourceMap{approvalHash: sourceMap},
+	}
+	result, err := simulator.Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	units := result.TxnGroups[0].Txns[0].Trace.ApprovalProgramTrace
+	require.NotEmpty(t, units)
+	for _, unit := range units {
+		line, ok := ops.OffsetToLine[int(unit.PC)]
+		if !ok {
+			// e.g. the constant blocks generated by the assembler
+			require.Nil(t, unit.SourceLocation, "pc %d", unit.PC)
+			continue
+		}
+		require.NotNil(t, unit.SourceLocation, "pc %d", unit.PC)
+		require.Equal(t, "approval.teal", unit.SourceLocation.Source)
+		require.Equal(t, uint64(line), unit.SourceLocation.Line)
+	}
+	require.Equal(t, uint64(5), units[len(units)-1].SourceLocation.Line)
+
+	request.SourceMaps[approvalHash] = logic.SourceMap{Sources: []string{"approval.teal"}, Mappings: "AAAg"}
+	_, err = simulator.Simulate(request)
+	require.ErrorContains(t, err, "invalid source map")
+
+	request.TraceConfig.Enable = false
+	_, err = simulator.Simulate(request)
+	require.ErrorContains(t, err, "basic trace must be enabled when supplying source maps")
+}
+
 func TestAppCallWithExtraBudgetOverBudget(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -4665,6 +4724,98 @@ func TestPooledFeesAcrossSignedAndUnsigned(t *testing.T) {
 	})
 }
 
+// TestInsufficientFees tests that AllowInsufficientFees lets an underpaid group be simulated, and
+// that the fees each transaction is missing are reported.
+func TestInsufficientFees(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	minFee := env.TxnInfo.CurrentProtocolParams().MinTxnFee
+
+	// The app submits two inner payments which leave their fees to the group
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 6
+txn ApplicationID
+bz end
+itxn_begin
+int pay
+itxn_field TypeEnum
+txn Sender
+itxn_field Receiver
+int 0
+itxn_field Fee
+itxn_next
+int pay
+itxn_field TypeEnum
+txn Sender
+itxn_field Receiver
+int 0
+itxn_field Fee
+itxn_submit
+end:
+int 1`,
+		ClearStateProgram: "#pragma version 6\nint 1",
+	})
+	env.TransferAlgos(sender.Addr, appID.Address(), 1_000_000)
+	s := simulation.MakeSimulator(env.Ledger, false)
+
+	makeGroup := func(payFee, appCallFee uint64) []transactions.SignedTxn {
+		pay := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: sender.Addr,
+			Fee:      payFee,
+		})
+		appCall := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:          protocol.ApplicationCallTx,
+			Sender:        sender.Addr,
+			ApplicationID: appID,
+			Fee:           appCallFee,
+		})
+		txntest.Group(&pay, &appCall)
+		return []transactions.SignedTxn{pay.Txn().Sign(sender.Sk), appCall.Txn().Sign(sender.Sk)}
+	}
+
+	// Without the option, the underpaid group is rejected outright
+	txgroup := makeGroup(minFee-300, minFee)
+	_, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{txgroup}})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "less than the minimum")
+
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{txgroup},
+		AllowInsufficientFees: true,
+	})
+	require.NoError(t, err)
+	require.True(t, result.EvalOverrides.AllowInsufficientFees)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, uint64(300), result.TxnGroups[0].Txns[0].AdditionalFeeRequired)
+	require.Equal(t, 2*minFee, result.TxnGroups[0].Txns[1].AdditionalFeeRequired)
+	require.Equal(t, 2*minFee+300, result.TxnGroups[0].AdditionalFeeRequired)
+
+	// Overpayment elsewhere in the group is credited against the inner transaction fees
+	txgroup = makeGroup(minFee-300, minFee+500)
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{txgroup},
+		AllowInsufficientFees: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Zero(t, result.TxnGroups[0].Txns[0].AdditionalFeeRequired)
+	require.Equal(t, 2*minFee-200, result.TxnGroups[0].Txns[1].AdditionalFeeRequired)
+	require.Equal(t, 2*minFee-200, result.TxnGroups[0].AdditionalFeeRequired)
+
+	// Paying the reported amounts makes the group succeed without the option
+	txgroup = makeGroup(minFee, minFee+500+2*minFee-200)
+	result, err = s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{txgroup}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Zero(t, result.TxnGroups[0].AdditionalFeeRequired)
+}
+
 const logAndFail = `#pragma version 6
 byte "message"
 log
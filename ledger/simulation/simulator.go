@@ -43,8 +43,18 @@ type Request struct {
 	AllowEmptySignatures  bool
 	AllowMoreLogging      bool
 	AllowUnnamedResources bool
+	AllowInsufficientFees bool
+	FixSigners            bool
+	PopulateResources     bool
 	ExtraOpcodeBudget     uint64
 	TraceConfig           ExecTraceConfig
+
+	// Session names the simulation session the request is evaluated in, if any. See Sessions.
+	Session string
+
+	// SourceMaps holds the source maps of programs by program hash. The opcodes of the programs
+	// in the execution trace are then located in their source.
+	SourceMaps map[crypto.Digest]logic.SourceMap
 }
 
 // Latest is part of the LedgerForSimulator interface.
@@ -89,6 +99,9 @@ type EvalFailureError struct {
 type Simulator struct {
 	ledger       simulatorLedger
 	developerAPI bool
+
+	// replay holds the requests of a simulation session, which are evaluated ahead of the simulated group.
+	replay []Request
 }
 
 // MakeSimulator creates a new simulator from a ledger.
@@ -149,21 +162,107 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 	}
 
 	// Verify the signed transactions are well-formed and have valid signatures
-	_, err = verify.TxnGroupWithTracer(txnsToVerify, &hdr, nil, s.ledger, tracer)
+	if overrides.AllowInsufficientFees {
+		_, err = verify.TxnGroupWithTracerIgnoringFees(txnsToVerify, &hdr, nil, s.ledger, tracer)
+	} else {
+		_, err = verify.TxnGroupWithTracer(txnsToVerify, &hdr, nil, s.ledger, tracer)
+	}
 	if err != nil {
 		err = InvalidRequestError{SimulatorError{err}}
 	}
 	return err
 }
 
+// fixSigners records in groupResult the authorizer each transaction of txgroup without a signature
+// needs, when it differs from the one it was submitted with. The authorizers follow the rekeys and
+// closes of the top level transactions of the session and of the group, but not the ones made by
+// inner transactions.
+func (s Simulator) fixSigners(txgroup []transactions.SignedTxn, groupResult *TxnGroupResult) error {
+	authorizers := make(map[basics.Address]basics.Address)
+	authorizer := func(addr basics.Address) (basics.Address, error) {
+		if auth, ok := authorizers[addr]; ok {
+			return auth, nil
+		}
+		data, _, err := s.ledger.LookupWithoutRewards(s.ledger.start, addr)
+		if err != nil {
+			return basics.Address{}, err
+		}
+		return data.AuthAddr, nil
+	}
+	track := func(txn transactions.Transaction) {
+		if !txn.RekeyTo.IsZero() {
+			if txn.RekeyTo == txn.Sender {
+				authorizers[txn.Sender] = basics.Address{}
+			} else {
+				authorizers[txn.Sender] = txn.RekeyTo
+			}
+		}
+		if txn.Type == protocol.PaymentTx && !txn.CloseRemainderTo.IsZero() {
+			authorizers[txn.Sender] = basics.Address{}
+		}
+	}
+
+	for _, request := range s.replay {
+		for _, stxn := range request.TxnGroups[0] {
+			track(stxn.Txn)
+		}
+	}
+	for i, stxn := range txgroup {
+		if txnHasNoSignature(stxn) {
+			auth, err := authorizer(stxn.Txn.Sender)
+			if err != nil {
+				return err
+			}
+			if auth != stxn.AuthAddr {
+				if auth.IsZero() {
+					auth = stxn.Txn.Sender
+				}
+				groupResult.Txns[i].FixedSigner = auth
+			}
+		}
+		track(stxn.Txn)
+	}
+	return nil
+}
+
+// withFixedSigners returns a copy of txgroup with the authorizers recorded by fixSigners.
+func withFixedSigners(txgroup []transactions.SignedTxn, groupResult TxnGroupResult) []transactions.SignedTxn {
+	fixed := make([]transactions.SignedTxn, len(txgroup))
+	copy(fixed, txgroup)
+	for i := range fixed {
+		signer := groupResult.Txns[i].FixedSigner
+		if signer == fixed[i].Txn.Sender {
+			fixed[i].AuthAddr = basics.Address{}
+		} else if !signer.IsZero() {
+			fixed[i].AuthAddr = signer
+		}
+	}
+	return fixed
+}
+
 func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn, tracer logic.EvalTracer) (*ledgercore.ValidatedBlock, error) {
 	// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
 	// and data.Ledger embeds *ledger.Ledger
-	eval, err := s.ledger.StartEvaluator(hdr, len(stxns), 0, tracer)
+	eval, err := s.ledger.StartEvaluator(hdr, len(stxns)+len(s.replay), 0, tracer)
 	if err != nil {
 		return nil, err
 	}
 
+	// Evaluate the groups of the session first, each one traced by its own tracer so that the
+	// overrides of its request apply, and its results do not show up in the simulated group's.
+	for i, request := range s.replay {
+		replayTracer, err := makeEvalTracer(s.ledger.start, request, s.developerAPI)
+		if err != nil {
+			return nil, err
+		}
+		eval.Tracer = replayTracer
+		err = eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(request.TxnGroups[0]))
+		if err != nil {
+			return nil, InvalidRequestError{SimulatorError{fmt.Errorf("transaction group %d of the session no longer applies: %w", i, err)}}
+		}
+	}
+	eval.Tracer = tracer
+
 	group := transactions.WrapSignedTxnsWithAD(stxns)
 
 	err = eval.TransactionGroup(group)
@@ -224,7 +323,21 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
-	block, err := s.simulateWithTracer(simulateRequest.TxnGroups[0], simulatorTracer, simulatorTracer.result.EvalOverrides)
+	locator, err := makeSourceLocator(simulateRequest.SourceMaps)
+	if err != nil {
+		return Result{}, InvalidRequestError{SimulatorError{err}}
+	}
+
+	txgroup := simulateRequest.TxnGroups[0]
+	if simulateRequest.FixSigners {
+		err = s.fixSigners(txgroup, &simulatorTracer.result.TxnGroups[0])
+		if err != nil {
+			return Result{}, err
+		}
+		txgroup = withFixedSigners(txgroup, simulatorTracer.result.TxnGroups[0])
+	}
+
+	block, err := s.simulateWithTracer(txgroup, simulatorTracer, simulatorTracer.result.EvalOverrides)
 	if err != nil {
 		var verifyError *verify.TxGroupError
 		switch {
@@ -269,5 +382,46 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 	}
 	simulatorTracer.result.TxnGroups[0].AppBudgetConsumed = totalCost
 
+	// Update the fee required by the group by aggregating individual txn shortfalls
+	totalFeeRequired := uint64(0)
+	for _, txn := range simulatorTracer.result.TxnGroups[0].Txns {
+		totalFeeRequired = basics.AddSaturate(totalFeeRequired, txn.AdditionalFeeRequired)
+	}
+	simulatorTracer.result.TxnGroups[0].AdditionalFeeRequired = totalFeeRequired
+
+	for i := range simulatorTracer.result.TxnGroups[0].Txns {
+		locator.locate(simulatorTracer.result.TxnGroups[0].Txns[i].Trace)
+	}
+
 	return *simulatorTracer.result, nil
 }
+
+// SimulateBatch simulates each transaction group of the request independently, against the same
+// round: unlike a block, the groups don't see the effects of each other. The options of the request
+// apply to every group. A group which can't be simulated is reported by the failure message of its
+// result, rather than failing the whole batch.
+func (s Simulator) SimulateBatch(batchRequest Request) (Result, error) {
+	if len(batchRequest.TxnGroups) == 0 {
+		return Result{}, InvalidRequestError{SimulatorError{err: errors.New("expected at least 1 transaction group")}}
+	}
+	result, err := makeSimulationResult(s.ledger.start, batchRequest, s.developerAPI)
+	if err != nil {
+		return Result{}, err
+	}
+
+	for i, txgroup := range batchRequest.TxnGroups {
+		request := batchRequest
+		request.TxnGroups = [][]transactions.SignedTxn{txgroup}
+		groupResult, err := s.Simulate(request)
+		if err != nil {
+			var invalidErr InvalidRequestError
+			if !errors.As(err, &invalidErr) {
+				return Result{}, err
+			}
+			result.TxnGroups[i].FailureMessage = err.Error()
+			continue
+		}
+		result.TxnGroups[i] = groupResult.TxnGroups[0]
+	}
+	return result, nil
+}
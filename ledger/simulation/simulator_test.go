@@ -239,3 +239,112 @@ int 1`,
 	}
 	mocktracer.AssertEventsEqual(t, expectedEvents, mockTracer.Events)
 }
+
+func TestSimulateFixSigners(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	authority := env.Accounts[1]
+	other := env.Accounts[2]
+
+	env.Rekey(sender.Addr, authority.Addr)
+	s := MakeSimulator(env.Ledger, false)
+
+	payTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: other.Addr,
+		Amount:   1_000,
+		RekeyTo:  other.Addr,
+	})
+	secondPayTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: other.Addr,
+		Amount:   1_000,
+		RekeyTo:  sender.Addr,
+	})
+	txntest.Group(&payTxn, &secondPayTxn)
+	txgroup := []transactions.SignedTxn{payTxn.SignedTxn(), secondPayTxn.SignedTxn()}
+
+	request := Request{
+		TxnGroups:            [][]transactions.SignedTxn{txgroup},
+		AllowEmptySignatures: true,
+	}
+	result, err := s.Simulate(request)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "should have been authorized by")
+
+	request.FixSigners = true
+	result, err = s.Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.True(t, result.EvalOverrides.FixSigners)
+	require.Equal(t, authority.Addr, result.TxnGroups[0].Txns[0].FixedSigner)
+	require.Equal(t, other.Addr, result.TxnGroups[0].Txns[1].FixedSigner)
+
+	// Signed transactions are left alone.
+	request.TxnGroups[0][0] = payTxn.Txn().Sign(sender.Sk)
+	result, err = s.Simulate(request)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "should have been authorized by")
+	require.True(t, result.TxnGroups[0].Txns[0].FixedSigner.IsZero())
+
+	// Fixing signers requires empty signatures to be allowed.
+	request.AllowEmptySignatures = false
+	_, err = s.Simulate(request)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+}
+
+func TestSimulateBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+	s := MakeSimulator(env.Ledger, false)
+
+	// Both payments spend more than half the balance of the sender, so the second one only succeeds
+	// because it is not evaluated after the first one.
+	amount := sender.AcctData.MicroAlgos.Raw/2 + 1
+	payTxn := func(note string) transactions.SignedTxn {
+		return env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: receiver.Addr,
+			Amount:   amount,
+			Note:     []byte(note),
+		}).SignedTxn()
+	}
+	first, second := payTxn("first"), payTxn("second")
+	badGroup := payTxn("bad")
+	badGroup.Txn.Group = crypto.Digest{1}
+
+	request := Request{
+		TxnGroups:            [][]transactions.SignedTxn{{first}, {badGroup}, {second}},
+		AllowEmptySignatures: true,
+	}
+	result, err := s.SimulateBatch(request)
+	require.NoError(t, err)
+	require.Equal(t, env.Ledger.Latest(), result.LastRound)
+	require.True(t, result.EvalOverrides.AllowEmptySignatures)
+	require.Len(t, result.TxnGroups, 3)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.NotEmpty(t, result.TxnGroups[1].FailureMessage)
+	require.Empty(t, result.TxnGroups[2].FailureMessage)
+	require.Equal(t, first.Txn, result.TxnGroups[0].Txns[0].Txn.Txn)
+	require.Equal(t, second.Txn, result.TxnGroups[2].Txns[0].Txn.Txn)
+
+	// Invalid options fail the whole batch.
+	request.FixSigners, request.AllowEmptySignatures = true, false
+	_, err = s.SimulateBatch(request)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+
+	_, err = s.SimulateBatch(Request{})
+	require.ErrorAs(t, err, &InvalidRequestError{})
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// SourceLocation is the position of an opcode in the source of its program.
+type SourceLocation struct {
+	// Source is the name of the source file, from the source map of the program.
+	Source string
+
+	// Line and Column start at 0, like in source maps.
+	Line   uint64
+	Column uint64
+}
+
+// programSource is the decoded source map of a program.
+type programSource struct {
+	sources   []string
+	locations map[int]logic.SourceLocation
+}
+
+// sourceLocator locates the opcodes of the programs whose source map was supplied in their source.
+type sourceLocator map[crypto.Digest]programSource
+
+func makeSourceLocator(sourceMaps map[crypto.Digest]logic.SourceMap) (sourceLocator, error) {
+	locator := make(sourceLocator, len(sourceMaps))
+	for hash, sourceMap := range sourceMaps {
+		locations, err := sourceMap.Locations()
+		if err != nil {
+			return nil, fmt.Errorf("invalid source map for program %s: %w", hash, err)
+		}
+		for pc, location := range locations {
+			if location.Line < 0 || location.Column < 0 {
+				return nil, fmt.Errorf("invalid source map for program %s: pc %d is located before the start of its source", hash, pc)
+			}
+		}
+		locator[hash] = programSource{sources: sourceMap.Sources, locations: locations}
+	}
+	return locator, nil
+}
+
+// locate sets the source location of the opcodes of the programs in trace, including the traces
+// of its inner transactions.
+func (l sourceLocator) locate(trace *TransactionTrace) {
+	if len(l) == 0 || trace == nil {
+		return
+	}
+	l.locateProgram(trace.ApprovalProgramHash, trace.ApprovalProgramTrace)
+	l.locateProgram(trace.ClearStateProgramHash, trace.ClearStateProgramTrace)
+	l.locateProgram(trace.LogicSigHash, trace.LogicSigTrace)
+	for i := range trace.InnerTraces {
+		l.locate(&trace.InnerTraces[i])
+	}
+}
+
+func (l sourceLocator) locateProgram(hash crypto.Digest, units []OpcodeTraceUnit) {
+	program, ok := l[hash]
+	if !ok {
+		return
+	}
+	for i := range units {
+		location, ok := program.locations[int(units[i].PC)]
+		if !ok {
+			continue
+		}
+		units[i].SourceLocation = &SourceLocation{
+			Source: program.sources[location.Source],
+			Line:   uint64(location.Line),
+			Column: uint64(location.Column),
+		}
+	}
+}
@@ -45,6 +45,21 @@ type TxnResult struct {
 	//
 	// In that case, it will be populated with the unnamed resources accessed by this transaction.
 	UnnamedResourcesAccessed *ResourceTracker
+
+	// AdditionalFeeRequired is only set if AllowInsufficientFees is true. It is the amount this
+	// transaction's fee must be raised by so that the group pays the minimum fee and covers the
+	// fees of any inner transactions this transaction issued.
+	AdditionalFeeRequired uint64
+
+	// FixedSigner is only set if FixSigners is true and this transaction was submitted without a
+	// signature and without the authorizer its sender requires. It is the authorizer the
+	// transaction was evaluated with, and so the address which must sign it.
+	FixedSigner basics.Address
+
+	// PopulatedResourceArrays is only set if PopulateResources is true and this is an app call.
+	// It holds the unnamed resources accessed by the group which should be added to the foreign
+	// arrays of this transaction so that the group no longer needs to access unnamed resources.
+	PopulatedResourceArrays *ResourceArrays
 }
 
 // TxnGroupResult contains the simulation result for a single transaction group
@@ -59,6 +74,8 @@ type TxnGroupResult struct {
 	AppBudgetAdded uint64
 	// AppBudgetConsumed is the total opcode cost used for this group
 	AppBudgetConsumed uint64
+	// AdditionalFeeRequired is the total extra fee this group must pay, if AllowInsufficientFees is true
+	AdditionalFeeRequired uint64
 
 	// UnnamedResourcesAccessed will be present if AllowUnnamedResources is true. In that case, it
 	// will be populated with the unnamed resources accessed by this transaction group from
@@ -68,6 +85,11 @@ type TxnGroupResult struct {
 	// will be placed in the corresponding `UnnamedResourcesAccessed` field in the appropriate
 	// TxnResult struct.
 	UnnamedResourcesAccessed *ResourceTracker
+
+	// ExtraResourceArrays will be present if PopulateResources is true and the unnamed
+	// resources accessed by the group do not fit in the foreign arrays of its app calls. Each
+	// entry holds the resources of an extra app call to add to the group.
+	ExtraResourceArrays []ResourceArrays
 }
 
 func makeTxnGroupResult(txgroup []transactions.SignedTxn) TxnGroupResult {
@@ -87,6 +109,9 @@ const ResultLatestVersion = uint64(2)
 type ResultEvalOverrides struct {
 	AllowEmptySignatures  bool
 	AllowUnnamedResources bool
+	AllowInsufficientFees bool
+	FixSigners            bool
+	PopulateResources     bool
 	MaxLogCalls           *uint64
 	MaxLogSize            *uint64
 	ExtraOpcodeBudget     uint64
@@ -168,6 +193,20 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 			},
 		}
 	}
+	if request.FixSigners && !request.AllowEmptySignatures {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("empty signatures must be allowed when fixing signers"),
+			},
+		}
+	}
+	if request.PopulateResources && !request.AllowUnnamedResources {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("unnamed resources must be allowed when populating resources"),
+			},
+		}
+	}
 	if !request.TraceConfig.Enable {
 		if request.TraceConfig.Stack {
 			return InvalidRequestError{
@@ -190,6 +229,13 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 				},
 			}
 		}
+		if len(request.SourceMaps) > 0 {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace must be enabled when supplying source maps"),
+				},
+			}
+		}
 	}
 	return nil
 }
@@ -205,6 +251,9 @@ func makeSimulationResult(lastRound basics.Round, request Request, developerAPI
 		AllowEmptySignatures:  request.AllowEmptySignatures,
 		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
 		AllowUnnamedResources: request.AllowUnnamedResources,
+		AllowInsufficientFees: request.AllowInsufficientFees,
+		FixSigners:            request.FixSigners,
+		PopulateResources:     request.PopulateResources,
 	}.AllowMoreLogging(request.AllowMoreLogging)
 
 	if err := validateSimulateRequest(request, developerAPI); err != nil {
@@ -273,6 +322,10 @@ type OpcodeTraceUnit struct {
 
 	// StateChanges stands for the creation/reading/writing/deletion operations to app's state
 	StateChanges []StateOperation
+
+	// SourceLocation is the position of the opcode in the source of the program, when the
+	// request supplied the source map of the program.
+	SourceLocation *SourceLocation
 }
 
 // TransactionTrace contains the trace effects of a single transaction evaluation (including its inners)
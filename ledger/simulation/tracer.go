@@ -173,6 +173,33 @@ func (tracer *evalTracer) BeforeTxnGroup(ep *logic.EvalParams) {
 			tracer.unnamedResourcePolicy = newResourcePolicy(ep, &tracer.result.TxnGroups[0])
 			ep.EvalConstants.UnnamedResources = tracer.unnamedResourcePolicy
 		}
+		if tracer.result.EvalOverrides.AllowInsufficientFees {
+			tracer.assignMinFeeShortfall(ep)
+			ep.FeeDeficit = new(uint64)
+		}
+	}
+}
+
+// assignMinFeeShortfall spreads the amount by which a top level group underpays the minimum fee
+// across its underpaying transactions, in group order.
+func (tracer *evalTracer) assignMinFeeShortfall(ep *logic.EvalParams) {
+	minFee := ep.Proto.MinTxnFee
+	feeNeeded := basics.MulSaturate(minFee, uint64(len(ep.TxnGroup)))
+	feesPaid := uint64(0)
+	for _, stxn := range ep.TxnGroup {
+		feesPaid = basics.AddSaturate(feesPaid, stxn.Txn.Fee.Raw)
+	}
+	shortfall := basics.SubSaturate(feeNeeded, feesPaid)
+	for i, stxn := range ep.TxnGroup {
+		if shortfall == 0 {
+			break
+		}
+		owed := basics.SubSaturate(minFee, stxn.Txn.Fee.Raw)
+		if owed > shortfall {
+			owed = shortfall
+		}
+		tracer.result.TxnGroups[0].Txns[i].AdditionalFeeRequired += owed
+		shortfall -= owed
 	}
 }
 
@@ -181,6 +208,9 @@ func (tracer *evalTracer) AfterTxnGroup(ep *logic.EvalParams, deltas *ledgercore
 	tracer.cursorEvalTracer.AfterTxnGroup(ep, deltas, evalError)
 
 	if ep.GetCaller() == nil && tracer.unnamedResourcePolicy != nil {
+		if tracer.result.EvalOverrides.PopulateResources {
+			tracer.result.TxnGroups[0].populateResourceArrays(ep.TxnGroup, ep.Proto)
+		}
 		tracer.unnamedResourcePolicy = nil
 	}
 }
@@ -246,6 +276,13 @@ func (tracer *evalTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
 func (tracer *evalTracer) AfterTxn(ep *logic.EvalParams, groupIndex int, ad transactions.ApplyData, evalError error) {
 	tracer.handleError(evalError)
 	tracer.saveApplyData(ad)
+	if ep.GetCaller() == nil && ep.FeeDeficit != nil {
+		// Blame any inner transaction fees that could not be covered on the top level
+		// transaction that issued them.
+		txnResult := &tracer.result.TxnGroups[0].Txns[groupIndex]
+		txnResult.AdditionalFeeRequired = basics.AddSaturate(txnResult.AdditionalFeeRequired, *ep.FeeDeficit)
+		*ep.FeeDeficit = 0
+	}
 	// if the current transaction + simulation condition would lead to exec trace making
 	// we should clean them up from tracer.execTraceStack.
 	if tracer.result.ReturnTrace() {
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+// CopyDatabases writes consistent copies of the tracker and block databases of the ledger into dir, named as they
+// are in the data directory, and returns the round the trackers are committed to in the copies. The trackers are
+// flushed first, so that the copies are as recent as the ledger. The tracker database is copied before the block
+// database, which therefore holds every block the copied trackers are committed to, as a node opening the copies
+// expects. Only the sqlite storage engine supports copying its databases.
+func (l *Ledger) CopyDatabases(ctx context.Context, dir string) (basics.Round, error) {
+	state, err := l.GetCatchpointCatchupState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if state != CatchpointCatchupStateInactive {
+		return 0, fmt.Errorf("cannot copy the ledger databases during a catchpoint catchup")
+	}
+
+	trackerPath, err := trackerDBPath(l.paths.trackerDBPrefix, l.cfg.StorageEngine)
+	if err != nil {
+		return 0, err
+	}
+	blockPath := l.paths.blockDBPrefix + ".block.sqlite"
+	for _, path := range []string{trackerPath, blockPath} {
+		if _, err = os.Stat(path); err != nil {
+			return 0, fmt.Errorf("cannot find the ledger database to copy: %w", err)
+		}
+	}
+
+	l.FlushTrackers()
+
+	trackerCopyPrefix := filepath.Join(dir, filepath.Base(l.paths.trackerDBPrefix))
+	trackerCopyPath, err := trackerDBPath(trackerCopyPrefix, l.cfg.StorageEngine)
+	if err != nil {
+		return 0, err
+	}
+	err = l.trackerDBs.CopyTo(ctx, trackerCopyPath)
+	if err != nil {
+		return 0, fmt.Errorf("copying the tracker database: %w", err)
+	}
+	err = l.blockDBs.Rdb.VacuumInto(ctx, filepath.Join(dir, filepath.Base(blockPath)))
+	if err != nil {
+		return 0, fmt.Errorf("copying the block database: %w", err)
+	}
+
+	// the trackers may have committed more rounds after they were flushed: the round of the copy is read from it
+	trackerCopy, err := openTrackerDB(trackerCopyPrefix, false, l.cfg.StorageEngine, l.log)
+	if err != nil {
+		return 0, err
+	}
+	defer trackerCopy.Close()
+	var round basics.Round
+	err = trackerCopy.SnapshotContext(ctx, func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		round, err = ar.AccountsRound()
+		return err
+	})
+	return round, err
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCopyDatabases(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+
+	l, err := OpenLedger(log, filepath.Join(t.TempDir(), "ledger"), false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	for i := 0; i < 10; i++ {
+		addEmptyValidatedBlock(t, l, genesisInitState.Accounts)
+	}
+
+	copyDir := t.TempDir()
+	round, err := l.CopyDatabases(context.Background(), copyDir)
+	require.NoError(t, err)
+	require.Equal(t, l.LatestTrackerCommitted(), round)
+	require.FileExists(t, filepath.Join(copyDir, "ledger.tracker.sqlite"))
+	require.FileExists(t, filepath.Join(copyDir, "ledger.block.sqlite"))
+	// the copies are new files
+	_, err = l.CopyDatabases(context.Background(), copyDir)
+	require.Error(t, err)
+
+	copied, err := OpenLedger(log, filepath.Join(copyDir, "ledger"), false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer copied.Close()
+	require.Equal(t, l.Latest(), copied.Latest())
+	require.Equal(t, round, copied.LatestTrackerCommitted())
+	for addr, data := range genesisInitState.Accounts {
+		ad, _, _, err := copied.LookupLatest(addr)
+		require.NoError(t, err)
+		require.Equal(t, data.MicroAlgos, ad.MicroAlgos)
+	}
+}
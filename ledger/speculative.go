@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// speculativeLedger exposes the state of the ledger as it would be after a
+// validated block that was not added to it yet, so that the following block
+// can be evaluated ahead of time. Lookups at the round of the block consult its
+// state delta first, and fall back to the ledger at the previous round.
+type speculativeLedger struct {
+	l  *Ledger
+	vb *ledgercore.ValidatedBlock
+}
+
+// StartSpeculativeEvaluator creates a BlockEvaluator for the block following prev,
+// which must be the next block of the ledger but does not need to be added to it.
+// The evaluator only remains usable for as long as the ledger keeps the state of
+// the round preceding prev.
+func (l *Ledger) StartSpeculativeEvaluator(prev *ledgercore.ValidatedBlock, hdr bookkeeping.BlockHeader, paysetHint, maxTxnBytesPerBlock int, tracer logic.EvalTracer) (*eval.BlockEvaluator, error) {
+	if latest := l.Latest(); prev.Block().Round() != latest+1 {
+		return nil, ledgercore.ErrNonSequentialBlockEval{EvaluatorRound: prev.Block().Round(), LatestRound: latest}
+	}
+	tracerForEval := tracer
+	if tracerForEval == nil {
+		tracerForEval = l.tracer
+	}
+	return eval.StartEvaluator(speculativeLedger{l: l, vb: prev}, hdr,
+		eval.EvaluatorOptions{
+			PaysetHint:          paysetHint,
+			Generate:            true,
+			Validate:            true,
+			MaxTxnBytesPerBlock: maxTxnBytesPerBlock,
+			Tracer:              tracerForEval,
+		})
+}
+
+func (sl speculativeLedger) round() basics.Round {
+	return sl.vb.Block().Round()
+}
+
+func (sl speculativeLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == sl.round() {
+		return sl.vb.Block().BlockHeader, nil
+	}
+	return sl.l.BlockHdr(rnd)
+}
+
+func (sl speculativeLedger) CheckDup(proto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	delta := sl.vb.Delta()
+	if proto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
+		if expires, ok := delta.Txleases[txl]; ok && current <= expires {
+			return ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+		}
+	}
+	if _, confirmed := delta.Txids[txid]; confirmed {
+		return &ledgercore.TransactionInLedgerError{Txid: txid, InBlockEvaluator: false}
+	}
+	return sl.l.CheckDup(proto, current, firstValid, lastValid, txid, txl)
+}
+
+func (sl speculativeLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	if rnd != sl.round() {
+		return sl.l.LookupWithoutRewards(rnd, addr)
+	}
+	if ad, ok := sl.vb.Delta().Accts.GetData(addr); ok {
+		return ad, rnd, nil
+	}
+	ad, _, err := sl.l.LookupWithoutRewards(rnd-1, addr)
+	return ad, rnd, err
+}
+
+func (sl speculativeLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	if rnd != sl.round() {
+		return sl.l.LookupAsset(rnd, addr, aidx)
+	}
+	res, err := sl.l.LookupAsset(rnd-1, addr, aidx)
+	if err != nil {
+		return ledgercore.AssetResource{}, err
+	}
+	accts := sl.vb.Delta().Accts
+	if params, ok := accts.GetAssetParams(addr, aidx); ok {
+		res.AssetParams = params.Params
+	}
+	if holding, ok := accts.GetAssetHolding(addr, aidx); ok {
+		res.AssetHolding = holding.Holding
+	}
+	return res, nil
+}
+
+func (sl speculativeLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	if rnd != sl.round() {
+		return sl.l.LookupApplication(rnd, addr, aidx)
+	}
+	res, err := sl.l.LookupApplication(rnd-1, addr, aidx)
+	if err != nil {
+		return ledgercore.AppResource{}, err
+	}
+	accts := sl.vb.Delta().Accts
+	if params, ok := accts.GetAppParams(addr, aidx); ok {
+		res.AppParams = params.Params
+	}
+	if state, ok := accts.GetAppLocalState(addr, aidx); ok {
+		res.AppLocalState = state.LocalState
+	}
+	return res, nil
+}
+
+func (sl speculativeLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if rnd != sl.round() {
+		return sl.l.LookupKv(rnd, key)
+	}
+	if kv, ok := sl.vb.Delta().KvMods[key]; ok {
+		return kv.Data, nil
+	}
+	return sl.l.LookupKv(rnd-1, key)
+}
+
+func (sl speculativeLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	if rnd != sl.round() {
+		return sl.l.GetCreatorForRound(rnd, cidx, ctype)
+	}
+	if mc, ok := sl.vb.Delta().Creatables[cidx]; ok && mc.Ctype == ctype {
+		if mc.Created {
+			return mc.Creator, true, nil
+		}
+		return basics.Address{}, false, nil
+	}
+	return sl.l.GetCreatorForRound(rnd-1, cidx, ctype)
+}
+
+func (sl speculativeLedger) GetStateProofVerificationContext(stateProofLastAttestedRound basics.Round) (*ledgercore.StateProofVerificationContext, error) {
+	return sl.l.GetStateProofVerificationContext(stateProofLastAttestedRound)
+}
+
+func (sl speculativeLedger) GenesisHash() crypto.Digest {
+	return sl.l.GenesisHash()
+}
+
+func (sl speculativeLedger) GenesisProto() config.ConsensusParams {
+	return sl.l.GenesisProto()
+}
+
+func (sl speculativeLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	return sl.round(), sl.vb.Delta().Totals, nil
+}
+
+func (sl speculativeLedger) VotersForStateProof(rnd basics.Round) (*ledgercore.VotersForRound, error) {
+	return sl.l.VotersForStateProof(rnd)
+}
+
+func (sl speculativeLedger) FlushCaches() {
+}
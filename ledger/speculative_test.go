@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestSpeculativeEvaluator checks that a block assembled on top of a validated
+// block that was not added to the ledger yet is identical to the block
+// assembled once it was.
+func TestSpeculativeEvaluator(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, keys := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	var sender basics.Address
+	for addr := range genesisInitState.Accounts {
+		if addr != testPoolAddr && addr != testSinkAddr {
+			sender = addr
+			break
+		}
+	}
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	freshKey := crypto.GenerateSignatureSecrets(seed)
+	fresh := basics.Address(freshKey.SignatureVerifier)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	pay := func(from, to basics.Address, amount uint64, key *crypto.SignatureSecrets) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      from,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  1,
+				LastValid:   100,
+				GenesisID:   genesisInitState.Block.GenesisID(),
+				GenesisHash: l.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: to,
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		return tx.Sign(key)
+	}
+
+	genesisHdr, err := l.BlockHdr(0)
+	require.NoError(t, err)
+	eval, err := l.StartEvaluator(bookkeeping.MakeBlock(genesisHdr).BlockHeader, 0, 0, nil)
+	require.NoError(t, err)
+	funding := pay(sender, fresh, 1_000_000, keys[sender])
+	require.NoError(t, eval.Transaction(funding, transactions.ApplyData{}))
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	// The account funded by vb only exists from the point of view of the
+	// speculative evaluator.
+	hdr := bookkeeping.MakeBlock(vb.Block().BlockHeader).BlockHeader
+	spend := pay(fresh, sender, 100_000, freshKey)
+
+	spec, err := l.StartSpeculativeEvaluator(vb, hdr, 0, 0, nil)
+	require.NoError(t, err)
+	err = spec.Transaction(funding, transactions.ApplyData{})
+	var tile *ledgercore.TransactionInLedgerError
+	require.ErrorAs(t, err, &tile)
+	require.False(t, tile.InBlockEvaluator)
+	require.NoError(t, spec.Transaction(spend, transactions.ApplyData{}))
+	specVb, err := spec.GenerateBlock()
+	require.NoError(t, err)
+
+	require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	_, err = l.StartSpeculativeEvaluator(vb, hdr, 0, 0, nil)
+	require.ErrorAs(t, err, &ledgercore.ErrNonSequentialBlockEval{})
+
+	eval, err = l.StartEvaluator(hdr, 0, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, eval.Transaction(spend, transactions.ApplyData{}))
+	expected, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	require.Equal(t, expected.Block().Hash(), specVb.Block().Hash())
+	require.Equal(t, expected.Delta().Totals, specVb.Delta().Totals)
+	require.Equal(t, expected.Delta().Accts, specVb.Delta().Accts)
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/store/faults"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/faultdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The storage engines the tracker database can be kept in, as named by config.Local.StorageEngine.
+const (
+	// StorageEngineSQLite keeps the tracker database in a SQLite file; it is the default.
+	StorageEngineSQLite = "sqlite"
+	// StorageEnginePebbleDB keeps the tracker database in a Pebble key-value store.
+	StorageEnginePebbleDB = "pebbledb"
+)
+
+// trackerDBPath returns the path of the files of the tracker database kept in the given storage engine.
+func trackerDBPath(dbPathPrefix string, engine string) (string, error) {
+	switch engine {
+	case StorageEnginePebbleDB:
+		// the pebble driver suffixes the directory it is given
+		return dbPathPrefix + "/tracker.pebble.pebbledb", nil
+	case StorageEngineSQLite, "":
+		return dbPathPrefix + ".tracker.sqlite", nil
+	default:
+		return "", errUnknownStorageEngine(engine)
+	}
+}
+
+func errUnknownStorageEngine(engine string) error {
+	return fmt.Errorf("unknown storage engine %q; supported engines are %q and %q", engine, StorageEngineSQLite, StorageEnginePebbleDB)
+}
+
+// openTrackerDB opens the tracker database kept in the given storage engine. In binaries built with the
+// `dbfaults` build tag, the database is wrapped to inject the faults set up by the tests.
+func openTrackerDB(dbPathPrefix string, dbMem bool, engine string, log logging.Logger) (store trackerdb.Store, err error) {
+	switch engine {
+	case StorageEnginePebbleDB:
+		store, err = pebbledbdriver.Open(dbPathPrefix+"/tracker.pebble", dbMem, config.Consensus[protocol.ConsensusCurrentVersion], log)
+	case StorageEngineSQLite, "":
+		store, err = sqlitedriver.Open(dbPathPrefix+".tracker.sqlite", dbMem, log)
+	default:
+		return nil, errUnknownStorageEngine(engine)
+	}
+	if err != nil || !faults.Enabled {
+		return store, err
+	}
+	return faultdriver.MakeStore(store), nil
+}
+
+// TrackerDBPath returns the path of the tracker database of the ledger of the network genesisID, stored at
+// dbPathPrefix or in the directories set in cfg, in the storage engine set in cfg.StorageEngine. It is the
+// SQLite file, or the directory of the key-value store.
+func TrackerDBPath(dbPathPrefix string, genesisID string, cfg config.Local) (string, error) {
+	paths := resolveLedgerPaths(dbPathPrefix, genesisID, cfg)
+	return trackerDBPath(paths.trackerDBPrefix, cfg.StorageEngine)
+}
+
+// OpenExistingTrackerDB opens the tracker database at path, as returned by TrackerDBPath. Directories are
+// opened as Pebble stores and files as SQLite databases. Unlike the ledger, it fails rather than creating
+// a database when there is none at path.
+func OpenExistingTrackerDB(path string, log logging.Logger) (trackerdb.Store, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		// the pebble driver suffixes the directory it is given
+		prefix, found := strings.CutSuffix(filepath.Clean(path), ".pebbledb")
+		if !found {
+			return nil, fmt.Errorf("%s is not a tracker database", path)
+		}
+		return pebbledbdriver.Open(prefix, false, config.Consensus[protocol.ConsensusCurrentVersion], log)
+	}
+	return sqlitedriver.Open(path, false, log)
+}
+
+// checkStorageEngine returns an error if the storage engine set in cfg cannot run a ledger with the rest
+// of cfg: the key-value engines do not support catchpoints yet.
+func checkStorageEngine(cfg config.Local, dbPathPrefix string) error {
+	switch cfg.StorageEngine {
+	case StorageEngineSQLite, "":
+		return nil
+	case StorageEnginePebbleDB:
+		var ct catchpointTracker
+		ct.initialize(cfg, dbPathPrefix)
+		if ct.catchpointEnabled() {
+			return fmt.Errorf("the %s storage engine does not support catchpoints; set CatchpointTracking to -1 or CatchpointInterval to 0", cfg.StorageEngine)
+		}
+		return nil
+	default:
+		return errUnknownStorageEngine(cfg.StorageEngine)
+	}
+}
+
+// MigrateTrackerDB copies the tracker database of the ledger of the network genesisID, stored at
+// dbPathPrefix or in the directories set in cfg, from the storage engine set in cfg.StorageEngine
+// into a new database kept in the toEngine storage engine. The ledger must not be open while migrating. The source database is left untouched, so that the node keeps
+// using it until cfg.StorageEngine is switched over to toEngine.
+//
+// Only migrating from the SQLite engine is supported, since the key-value engines cannot iterate over
+// their accounts yet.
+func MigrateTrackerDB(dbPathPrefix string, genesisID string, genesisProto protocol.ConsensusVersion, genesisHash crypto.Digest, cfg config.Local, toEngine string, log logging.Logger) (err error) {
+	fromEngine := cfg.StorageEngine
+	if fromEngine == "" {
+		fromEngine = StorageEngineSQLite
+	}
+	if fromEngine == toEngine {
+		return fmt.Errorf("the tracker database is already kept in the %s storage engine", toEngine)
+	}
+	if fromEngine != StorageEngineSQLite {
+		return fmt.Errorf("migrating the tracker database from the %s storage engine is not supported", fromEngine)
+	}
+	paths := resolveLedgerPaths(dbPathPrefix, genesisID, cfg)
+	srcPath, err := trackerDBPath(paths.trackerDBPrefix, fromEngine)
+	if err != nil {
+		return err
+	}
+	dstPath, err := trackerDBPath(paths.trackerDBPrefix, toEngine)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(srcPath); err != nil {
+		return fmt.Errorf("cannot find the tracker database to migrate: %w", err)
+	}
+	if _, err = os.Stat(dstPath); err == nil {
+		return fmt.Errorf("a tracker database already exists at %s; remove it before migrating", dstPath)
+	}
+
+	src, err := openTrackerDB(paths.trackerDBPrefix, false, fromEngine, log)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	blockDBs, err := db.OpenPair(paths.blockDBPrefix+".block.sqlite", false)
+	if err != nil {
+		return err
+	}
+	defer blockDBs.Close()
+
+	// bring the source up to the schema of this binary, as a node starting on it would,
+	// so that both sides of the copy agree on the layout of the state.
+	params := trackerdb.Params{
+		InitProto:    genesisProto,
+		GenesisHash:  genesisHash,
+		DbPathPrefix: filepath.Dir(paths.catchpointPrefix),
+		BlockDb:      blockDBs,
+	}
+	ctx := context.Background()
+	_, err = src.RunMigrations(ctx, params, log, trackerdb.AccountDBVersion)
+	if err != nil {
+		return fmt.Errorf("upgrading the tracker database before migrating: %w", err)
+	}
+
+	dst, err := openTrackerDB(paths.trackerDBPrefix, false, toEngine, log)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		dst.Close()
+		if err != nil {
+			os.RemoveAll(dstPath)
+		}
+	}()
+	_, err = dst.RunMigrations(ctx, trackerdb.Params{InitProto: genesisProto, GenesisHash: genesisHash}, log, trackerdb.AccountDBVersion)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("migrating the tracker database from %s to %s", srcPath, dstPath)
+	return trackerdb.CopyStore(ctx, src, dst, config.Consensus[protocol.ConsensusCurrentVersion])
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMigrateTrackerDB(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	log := logging.TestingLog(t)
+	dbPathPrefix := filepath.Join(t.TempDir(), "ledger")
+	cfg := config.GetDefaultLocal()
+	// the key-value engines do not support catchpoints
+	cfg.CatchpointTracking = -1
+
+	l, err := OpenLedger(log, dbPathPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		addEmptyValidatedBlock(t, l, genesisInitState.Accounts)
+	}
+	latest := l.Latest()
+	l.WaitForCommit(latest)
+	_, totals, err := l.LatestTotals()
+	require.NoError(t, err)
+	l.Close()
+
+	migrate := func(cfg config.Local, toEngine string) error {
+		return MigrateTrackerDB(dbPathPrefix, genesisInitState.Block.GenesisID(), protocol.ConsensusCurrentVersion, genesisInitState.GenesisHash, cfg, toEngine, log)
+	}
+	require.ErrorContains(t, migrate(cfg, StorageEngineSQLite), "already kept in the sqlite storage engine")
+	require.ErrorContains(t, migrate(cfg, "rocksdb"), `unknown storage engine "rocksdb"`)
+	require.NoError(t, migrate(cfg, StorageEnginePebbleDB))
+	require.ErrorContains(t, migrate(cfg, StorageEnginePebbleDB), "a tracker database already exists")
+
+	cfg.StorageEngine = StorageEnginePebbleDB
+	require.ErrorContains(t, migrate(cfg, StorageEngineSQLite), "not supported")
+	catchpointCfg := cfg
+	catchpointCfg.CatchpointTracking = 2
+	_, err = OpenLedger(log, dbPathPrefix, false, genesisInitState, catchpointCfg)
+	require.ErrorContains(t, err, "does not support catchpoints")
+	l, err = OpenLedger(log, dbPathPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.Equal(t, latest, l.Latest())
+	_, migratedTotals, err := l.LatestTotals()
+	require.NoError(t, err)
+	require.Equal(t, totals, migratedTotals)
+	for addr, data := range genesisInitState.Accounts {
+		ad, rnd, _, err := l.LookupLatest(addr)
+		require.NoError(t, err)
+		require.Equal(t, latest, rnd)
+		require.Equal(t, data.MicroAlgos, ad.MicroAlgos)
+		require.Equal(t, data.Status, ad.Status)
+	}
+}
+
+func TestOpenTrackerDBUnknownEngine(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := openTrackerDB(filepath.Join(t.TempDir(), "ledger"), true, "rocksdb", logging.TestingLog(t))
+	require.ErrorContains(t, err, `unknown storage engine "rocksdb"`)
+}
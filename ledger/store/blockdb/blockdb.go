@@ -18,6 +18,7 @@ package blockdb
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/faults"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -153,8 +155,13 @@ func BlockGetCert(tx *sql.Tx, rnd basics.Round) (blk bookkeeping.Block, cert agr
 
 // BlockPut stores block and certificate
 func BlockPut(tx *sql.Tx, blk bookkeeping.Block, cert agreement.Certificate) error {
+	err := injectWriteFault(tx)
+	if err != nil {
+		return err
+	}
+
 	var max sql.NullInt64
-	err := tx.QueryRow("SELECT MAX(rnd) FROM blocks").Scan(&max)
+	err = tx.QueryRow("SELECT MAX(rnd) FROM blocks").Scan(&max)
 	if err != nil {
 		return err
 	}
@@ -228,6 +235,11 @@ func BlockEarliest(tx *sql.Tx) (basics.Round, error) {
 
 // BlockForgetBefore removes block entries with round numbers less than the specified round
 func BlockForgetBefore(tx *sql.Tx, rnd basics.Round) error {
+	err := injectWriteFault(tx)
+	if err != nil {
+		return err
+	}
+
 	next, err := BlockNext(tx)
 	if err != nil {
 		return err
@@ -276,6 +288,10 @@ func BlockStartCatchupStaging(tx *sql.Tx, blk bookkeeping.Block) error {
 
 // BlockCompleteCatchup applies catchpoint caught up blocks
 func BlockCompleteCatchup(tx *sql.Tx) (err error) {
+	err = injectWriteFault(tx)
+	if err != nil {
+		return err
+	}
 	_, err = tx.Exec("ALTER TABLE blocks RENAME TO blocks_old")
 	if err != nil {
 		return err
@@ -306,6 +322,11 @@ func BlockAbortCatchup(tx *sql.Tx) error {
 
 // BlockPutStaging store a block into catchpoint staging table
 func BlockPutStaging(tx *sql.Tx, blk bookkeeping.Block) (err error) {
+	err = injectWriteFault(tx)
+	if err != nil {
+		return err
+	}
+
 	// insert the new entry
 	_, err = tx.Exec("INSERT INTO catchpointblocks (rnd, proto, hdrdata, blkdata) VALUES (?, ?, ?, ?)",
 		blk.Round(),
@@ -354,3 +375,16 @@ func BlockEnsureSingleBlock(tx *sql.Tx) (blk bookkeeping.Block, err error) {
 
 	return blk, err
 }
+
+// injectWriteFault reaches the Write fault point of the block database. On a partial commit, the
+// writes made so far in tx are committed before the write fails, and the rollback of the failed
+// transaction does nothing.
+func injectWriteFault(tx *sql.Tx) error {
+	err := faults.Inject(faults.BlockDB, faults.Write)
+	if errors.Is(err, faults.ErrPartialCommit) {
+		if commitErr := tx.Commit(); commitErr != nil {
+			return commitErr
+		}
+	}
+	return err
+}
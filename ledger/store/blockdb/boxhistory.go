@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// The box history index keeps the rounds in which boxes were created and deleted
+// next to the blocks, along with the range of rounds it covers.
+var boxHistorySchema = []string{
+	`CREATE TABLE IF NOT EXISTS boxhistory (
+		key blob,
+		rnd integer,
+		deleted integer)`,
+	`CREATE INDEX IF NOT EXISTS boxhistory_rnd_idx ON boxhistory (rnd)`,
+	`CREATE INDEX IF NOT EXISTS boxhistory_key_idx ON boxhistory (key, deleted, rnd)`,
+	`CREATE TABLE IF NOT EXISTS boxhistoryrange (
+		id integer primary key,
+		since integer,
+		through integer)`,
+}
+
+// BoxHistoryEvent records the creation or deletion of a box in a round.
+type BoxHistoryEvent struct {
+	Key     string
+	Round   basics.Round
+	Deleted bool
+}
+
+// BoxHistoryInit creates the box history tables, if they do not exist yet.
+func BoxHistoryInit(tx *sql.Tx) error {
+	for _, tableCreate := range boxHistorySchema {
+		_, err := tx.Exec(tableCreate)
+		if err != nil {
+			return fmt.Errorf("blockdb BoxHistoryInit could not create table %v", err)
+		}
+	}
+	return nil
+}
+
+// BoxHistoryRange returns the first and the last round covered by the box
+// history index. ok is false if the index was never populated.
+func BoxHistoryRange(tx *sql.Tx) (since, through basics.Round, ok bool, err error) {
+	err = tx.QueryRow("SELECT since, through FROM boxhistoryrange WHERE id=0").Scan(&since, &through)
+	if err == sql.ErrNoRows {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return since, through, true, nil
+}
+
+// BoxHistoryReset drops all the recorded events and restarts the index right
+// after the given round.
+func BoxHistoryReset(tx *sql.Tx, rnd basics.Round) error {
+	_, err := tx.Exec("DELETE FROM boxhistory")
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT OR REPLACE INTO boxhistoryrange (id, since, through) VALUES (0, ?, ?)", rnd+1, rnd)
+	return err
+}
+
+// BoxHistoryTruncate drops the events recorded after the given round.
+func BoxHistoryTruncate(tx *sql.Tx, rnd basics.Round) error {
+	_, err := tx.Exec("DELETE FROM boxhistory WHERE rnd>?", rnd)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE boxhistoryrange SET through=? WHERE id=0", rnd)
+	return err
+}
+
+// BoxHistoryLookup returns the last rounds in which the box of the given key
+// was created and deleted, or 0 for the events that were not recorded.
+func BoxHistoryLookup(tx *sql.Tx, key string) (created, deleted basics.Round, err error) {
+	var c, d sql.NullInt64
+	err = tx.QueryRow("SELECT (SELECT MAX(rnd) FROM boxhistory WHERE key=? AND deleted=0), (SELECT MAX(rnd) FROM boxhistory WHERE key=? AND deleted=1)", []byte(key), []byte(key)).Scan(&c, &d)
+	if err != nil {
+		return 0, 0, err
+	}
+	return basics.Round(c.Int64), basics.Round(d.Int64), nil
+}
+
+// BoxHistoryPut appends events to the box history index and extends the
+// range it covers through the given round.
+func BoxHistoryPut(tx *sql.Tx, events []BoxHistoryEvent, through basics.Round) error {
+	for _, ev := range events {
+		_, err := tx.Exec("INSERT INTO boxhistory (key, rnd, deleted) VALUES (?, ?, ?)", []byte(ev.Key), ev.Round, ev.Deleted)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := tx.Exec("UPDATE boxhistoryrange SET through=? WHERE id=0", through)
+	return err
+}
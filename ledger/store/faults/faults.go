@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package faults injects faults into the databases of the ledger, so that tests can exercise its commit
+// and recovery paths. The tracker database is wrapped by the faultdriver package whatever its storage
+// engine, and the block database functions report their writes.
+//
+// Faults are only injected in binaries built with the `dbfaults` build tag; see faults_enabled.go.
+package faults
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Database identifies a database of the ledger.
+type Database string
+
+const (
+	// TrackerDB is the database of the ledger trackers.
+	TrackerDB Database = "tracker"
+	// BlockDB is the database of the ledger blocks.
+	BlockDB Database = "block"
+)
+
+// Point identifies the stage of a database operation at which a fault may be injected.
+type Point int
+
+const (
+	// BeforeTx is reached once a transaction, batch or snapshot has begun, before its function runs.
+	BeforeTx Point = iota
+	// Write is reached before each group of writes of a transaction: every writer made by a tracker
+	// database transaction or batch, and every block database write.
+	Write
+	// BeforeCommit is reached after the transaction function succeeded, before the transaction is committed.
+	// An error injected here rolls the transaction back.
+	BeforeCommit
+	// AfterCommit is reached after the transaction was committed. An error injected here is returned
+	// to the caller although the changes were written, as if the process crashed right after the commit.
+	AfterCommit
+)
+
+func (p Point) String() string {
+	switch p {
+	case BeforeTx:
+		return "before-tx"
+	case Write:
+		return "write"
+	case BeforeCommit:
+		return "before-commit"
+	case AfterCommit:
+		return "after-commit"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrSimulatedCrash is returned by every database operation once an injected fault simulated a crash,
+// until the faults are reset.
+var ErrSimulatedCrash = errors.New("faults: simulated crash")
+
+// ErrPartialCommit is returned at a Write point by a partial commit fault. The transaction must commit
+// the writes it made before this one, and then fail: the caller sees the crash, but the database keeps
+// the first part of the transaction.
+var ErrPartialCommit = fmt.Errorf("%w after a partial commit", ErrSimulatedCrash)
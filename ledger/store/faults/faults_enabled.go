@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build dbfaults
+// +build dbfaults
+
+package faults
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Enabled is set in binaries built with the `dbfaults` build tag.
+const Enabled = true
+
+// ErrInjectedFault is the error returned by faults which specify neither a latency, an error nor a crash.
+var ErrInjectedFault = errors.New("faults: injected fault")
+
+// Fault describes what happens to a database operation at a fault point.
+type Fault struct {
+	// Latency delays the operation before it proceeds.
+	Latency time.Duration
+	// Err, when set, fails the operation with this error. Errors for which the sqlite
+	// transactions are retried, such as the one of TransientError, simulate transient failures.
+	Err error
+	// Crash fails this and every following operation, on every database, with ErrSimulatedCrash.
+	Crash bool
+	// PartialCommit is a crash at a Write point which commits the writes the transaction made before it.
+	PartialCommit bool
+}
+
+// Injector is consulted at every fault point of every database operation. It returns the fault
+// to inject, or nil to let the operation proceed. It may be called concurrently.
+type Injector func(db Database, point Point) *Fault
+
+var faults struct {
+	mu       sync.Mutex
+	injector Injector
+	crashed  bool
+}
+
+// SetInjector installs the injector consulted by the ledger databases.
+func SetInjector(injector Injector) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.injector = injector
+}
+
+// Reset removes the installed injector and clears a simulated crash, so the databases can be reopened.
+func Reset() {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.injector = nil
+	faults.crashed = false
+}
+
+// TransientError returns an error for which the sqlite transactions are retried.
+// The transactions of the other storage engines fail with it.
+func TransientError() error {
+	return sqlite3.Error{Code: sqlite3.ErrBusy}
+}
+
+// RandomFaults returns an injector which fails the operations of db with a transient error with
+// probability errorRate, and delays them by up to maxLatency with probability latencyRate.
+// The same seed yields the same sequence of decisions.
+func RandomFaults(seed int64, db Database, errorRate, latencyRate float64, maxLatency time.Duration) Injector {
+	var mu sync.Mutex
+	rng := rand.New(rand.NewSource(seed))
+	return func(d Database, point Point) *Fault {
+		if d != db || point == AfterCommit {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		var fault Fault
+		if maxLatency > 0 && rng.Float64() < latencyRate {
+			fault.Latency = time.Duration(rng.Int63n(int64(maxLatency)))
+		}
+		if rng.Float64() < errorRate {
+			fault.Err = TransientError()
+		}
+		if fault == (Fault{}) {
+			return nil
+		}
+		return &fault
+	}
+}
+
+// CrashAt returns an injector which simulates a crash the nth time the given fault point is reached by db.
+func CrashAt(db Database, point Point, n int) Injector {
+	return crashAt(db, point, n, Fault{Crash: true})
+}
+
+// PartialCommitAt returns an injector which simulates a crash the nth time db writes,
+// after committing the writes made before by the same transaction.
+func PartialCommitAt(db Database, n int) Injector {
+	return crashAt(db, Write, n, Fault{PartialCommit: true})
+}
+
+func crashAt(db Database, point Point, n int, fault Fault) Injector {
+	var mu sync.Mutex
+	count := 0
+	return func(d Database, p Point) *Fault {
+		if d != db || p != point {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+		if count != n {
+			return nil
+		}
+		return &fault
+	}
+}
+
+// Inject injects the fault returned by the installed injector, if any, and returns the error the
+// operation must fail with. It returns ErrPartialCommit when the writes made so far by the
+// transaction must be committed before it fails.
+func Inject(db Database, point Point) error {
+	faults.mu.Lock()
+	injector := faults.injector
+	crashed := faults.crashed
+	faults.mu.Unlock()
+
+	if crashed {
+		return ErrSimulatedCrash
+	}
+	if injector == nil {
+		return nil
+	}
+	fault := injector(db, point)
+	if fault == nil {
+		return nil
+	}
+
+	if fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+	if fault.Crash || fault.PartialCommit {
+		faults.mu.Lock()
+		faults.crashed = true
+		faults.mu.Unlock()
+		if fault.PartialCommit && point == Write {
+			return ErrPartialCommit
+		}
+		return ErrSimulatedCrash
+	}
+	if fault.Err == nil && fault.Latency == 0 {
+		return ErrInjectedFault
+	}
+	return fault.Err
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build dbfaults
+// +build dbfaults
+
+package faults
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestFaultsRandomIsDeterministic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	decisions := func() (out []bool) {
+		injector := RandomFaults(42, TrackerDB, 0.3, 0.3, time.Millisecond)
+		for i := 0; i < 100; i++ {
+			out = append(out, injector(TrackerDB, BeforeCommit) != nil)
+		}
+		return
+	}
+	first := decisions()
+	require.Equal(t, first, decisions())
+	require.Contains(t, first, true)
+	require.Contains(t, first, false)
+
+	injector := RandomFaults(42, TrackerDB, 1, 1, time.Millisecond)
+	require.Nil(t, injector(BlockDB, BeforeCommit))
+	require.Nil(t, injector(TrackerDB, AfterCommit))
+}
+
+func TestFaultsCrash(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer Reset()
+
+	SetInjector(CrashAt(BlockDB, AfterCommit, 2))
+	require.NoError(t, Inject(BlockDB, AfterCommit))
+	require.NoError(t, Inject(TrackerDB, AfterCommit))
+	require.ErrorIs(t, Inject(BlockDB, AfterCommit), ErrSimulatedCrash)
+
+	// every database fails once crashed
+	require.ErrorIs(t, Inject(TrackerDB, BeforeTx), ErrSimulatedCrash)
+	require.ErrorIs(t, Inject(BlockDB, Write), ErrSimulatedCrash)
+
+	Reset()
+	require.NoError(t, Inject(TrackerDB, BeforeTx))
+}
+
+func TestFaultsPartialCommit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer Reset()
+
+	SetInjector(PartialCommitAt(TrackerDB, 2))
+	require.NoError(t, Inject(TrackerDB, BeforeTx))
+	require.NoError(t, Inject(TrackerDB, Write))
+	require.NoError(t, Inject(BlockDB, Write))
+	err := Inject(TrackerDB, Write)
+	require.ErrorIs(t, err, ErrPartialCommit)
+	require.ErrorIs(t, err, ErrSimulatedCrash)
+
+	// only the interrupted write commits what came before it
+	err = Inject(TrackerDB, Write)
+	require.ErrorIs(t, err, ErrSimulatedCrash)
+	require.NotErrorIs(t, err, ErrPartialCommit)
+}
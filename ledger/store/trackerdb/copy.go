@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package trackerdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// the accounts and kvs are copied in batches of this many entries, so that the
+// destination store never has to hold the whole state in a single write.
+const (
+	copyAccountsBatchSize  = 1000
+	copyResourcesBatchSize = 10000
+	copyKVsBatchSize       = 10000
+)
+
+// CopyStore copies the tracker state held in src into dst, which is expected
+// to be freshly initialized at the same schema version. It copies the accounts,
+// resources, creatables, kvs, online accounts history, online round params,
+// txtail, state proof verification contexts, totals and rounds.
+//
+// The catchpoint state and the account transaction index are not copied: the
+// first is rebuilt by the catchpoint tracker, and the second restarts from the
+// round of the copy.
+func CopyStore(ctx context.Context, src Store, dst Store, proto config.ConsensusParams) error {
+	snap, err := src.BeginSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	err = copyAccounts(ctx, snap, dst, proto)
+	if err != nil {
+		return fmt.Errorf("copying accounts: %w", err)
+	}
+	err = copyKVs(ctx, snap, dst)
+	if err != nil {
+		return fmt.Errorf("copying kvs: %w", err)
+	}
+	err = copyOnlineAccounts(ctx, snap, dst, proto)
+	if err != nil {
+		return fmt.Errorf("copying online accounts: %w", err)
+	}
+	// the rounds go last, so that the destination does not claim a round
+	// before its state is complete.
+	err = copyRoundState(ctx, snap, dst)
+	if err != nil {
+		return fmt.Errorf("copying round state: %w", err)
+	}
+	return nil
+}
+
+// copyAccounts copies the accounts along with their resources, and the
+// creatables owned by them.
+func copyAccounts(ctx context.Context, snap SnapshotScope, dst Store, proto config.ConsensusParams) error {
+	iter := snap.MakeEncodedAccoutsBatchIter()
+	defer iter.Close()
+
+	// an account with many resources spans several batches; its ref is kept
+	// so that the remaining resources are attached to it.
+	var lastAddr basics.Address
+	var lastRef AccountRef
+	for {
+		bals, _, err := iter.Next(ctx, copyAccountsBatchSize, copyResourcesBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(bals) == 0 {
+			return nil
+		}
+
+		err = dst.BatchContext(ctx, func(ctx context.Context, tx BatchScope) error {
+			aw, err := tx.MakeAccountsOptimizedWriter(true, true, false, true)
+			if err != nil {
+				return err
+			}
+			defer aw.Close()
+
+			for _, bal := range bals {
+				if lastRef == nil || bal.Address != lastAddr {
+					var data BaseAccountData
+					err = protocol.Decode(bal.AccountData, &data)
+					if err != nil {
+						return err
+					}
+					lastRef, err = aw.InsertAccount(bal.Address, data.NormalizedOnlineBalance(proto), data)
+					if err != nil {
+						return err
+					}
+					lastAddr = bal.Address
+				}
+
+				for aidx, raw := range bal.Resources {
+					var data ResourcesData
+					err = protocol.Decode(raw, &data)
+					if err != nil {
+						return err
+					}
+					cidx := basics.CreatableIndex(aidx)
+					_, err = aw.InsertResource(lastRef, cidx, data)
+					if err != nil {
+						return err
+					}
+					if !data.IsOwning() {
+						continue
+					}
+					if data.IsAsset() {
+						_, err = aw.InsertCreatable(cidx, basics.AssetCreatable, bal.Address[:])
+						if err != nil {
+							return err
+						}
+					}
+					if data.IsApp() {
+						_, err = aw.InsertCreatable(cidx, basics.AppCreatable, bal.Address[:])
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// copyKVs copies the application boxes.
+func copyKVs(ctx context.Context, snap SnapshotScope, dst Store) error {
+	iter, err := snap.MakeKVsIter(ctx)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	keys := make([]string, 0, copyKVsBatchSize)
+	values := make([][]byte, 0, copyKVsBatchSize)
+	flush := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		err := dst.BatchContext(ctx, func(ctx context.Context, tx BatchScope) error {
+			aw, err := tx.MakeAccountsOptimizedWriter(false, false, true, false)
+			if err != nil {
+				return err
+			}
+			defer aw.Close()
+			for i := range keys {
+				err = aw.UpsertKvPair(keys[i], values[i])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		keys = keys[:0]
+		values = values[:0]
+		return err
+	}
+
+	for iter.Next() {
+		k, v, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		keys = append(keys, string(k))
+		values = append(values, v)
+		if len(keys) == copyKVsBatchSize {
+			err = flush()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// copyOnlineAccounts copies the history of the online accounts.
+func copyOnlineAccounts(ctx context.Context, snap SnapshotScope, dst Store, proto config.ConsensusParams) error {
+	ar, err := snap.MakeAccountsReader()
+	if err != nil {
+		return err
+	}
+	accts, err := ar.OnlineAccountsAll(0)
+	if err != nil {
+		return err
+	}
+
+	for len(accts) > 0 {
+		batch := accts
+		if len(batch) > copyAccountsBatchSize {
+			batch = batch[:copyAccountsBatchSize]
+		}
+		accts = accts[len(batch):]
+
+		err = dst.BatchContext(ctx, func(ctx context.Context, tx BatchScope) error {
+			ow, err := tx.MakeOnlineAccountsOptimizedWriter(true)
+			if err != nil {
+				return err
+			}
+			defer ow.Close()
+			for _, acct := range batch {
+				_, err = ow.InsertOnlineAccount(acct.Addr, acct.AccountData.NormalizedOnlineBalance(proto), acct.AccountData, uint64(acct.UpdRound), uint64(acct.AccountData.VoteLastValid))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyRoundState copies the state tied to the rounds: the online round params,
+// txtail, state proof verification contexts, totals, and the rounds themselves.
+func copyRoundState(ctx context.Context, snap SnapshotScope, dst Store) error {
+	ar, err := snap.MakeAccountsReader()
+	if err != nil {
+		return err
+	}
+	dbRound, err := ar.AccountsRound()
+	if err != nil {
+		return err
+	}
+	hashRound, err := ar.AccountsHashRound(ctx)
+	if err != nil {
+		return err
+	}
+	totals, err := ar.AccountsTotals(ctx, false)
+	if err != nil {
+		return err
+	}
+	roundParams, endRound, err := ar.AccountsOnlineRoundParams()
+	if err != nil {
+		return err
+	}
+	tail, _, tailBase, err := ar.LoadTxTail(ctx, dbRound)
+	if err != nil {
+		return err
+	}
+	tailData := make([][]byte, len(tail))
+	for i := range tail {
+		tailData[i] = protocol.Encode(tail[i])
+	}
+	spContexts, err := snap.MakeSpVerificationCtxReader().GetAllSPContexts(ctx)
+	if err != nil {
+		return err
+	}
+	spRefs := make([]*ledgercore.StateProofVerificationContext, len(spContexts))
+	for i := range spContexts {
+		spRefs[i] = &spContexts[i]
+	}
+
+	return dst.BatchContext(ctx, func(ctx context.Context, tx BatchScope) error {
+		aw, err := tx.MakeAccountsWriter()
+		if err != nil {
+			return err
+		}
+		if len(roundParams) > 0 {
+			err = aw.AccountsPutOnlineRoundParams(roundParams, endRound+1-basics.Round(len(roundParams)))
+			if err != nil {
+				return err
+			}
+		}
+		if len(tailData) > 0 {
+			err = aw.TxtailNewRound(ctx, tailBase, tailData, tailBase)
+			if err != nil {
+				return err
+			}
+		}
+		if len(spRefs) > 0 {
+			err = tx.MakeSpVerificationCtxWriter().StoreSPContexts(ctx, spRefs)
+			if err != nil {
+				return err
+			}
+		}
+		err = aw.AccountsPutTotals(totals, false)
+		if err != nil {
+			return err
+		}
+		err = aw.UpdateAccountsHashRound(ctx, hashRound)
+		if err != nil {
+			return err
+		}
+		return aw.UpdateAccountsRound(dbRound)
+	})
+}
@@ -159,6 +159,29 @@ func (ar *accountsReader) LookupKeysByPrefix(prefix string, maxKeyNum uint64, re
 	return roundP, nil
 }
 
+// LookupKeysByRange implements trackerdb.AccountsReader
+func (ar *accountsReader) LookupKeysByRange(prefix string, from string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error) {
+	// the secondary works on a copy of the results, so both see the same input
+	resultsS := make(map[string]bool, len(results))
+	for k, v := range results {
+		resultsS[k] = v
+	}
+	roundP, errP := ar.primary.LookupKeysByRange(prefix, from, maxKeyNum, results, resultCount)
+	roundS, errS := ar.secondary.LookupKeysByRange(prefix, from, maxKeyNum, resultsS, resultCount)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if roundP != roundS || !cmp.Equal(results, resultsS) {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return roundP, nil
+}
+
 // LookupResources implements trackerdb.AccountsReader
 func (ar *accountsReader) LookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data trackerdb.PersistedResourcesData, err error) {
 	dataP, errP := ar.primary.LookupResources(addr, aidx, ctype)
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dualdriver
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/google/go-cmp/cmp"
+)
+
+type accountTxnIndexReader struct {
+	primary   trackerdb.AccountTxnIndexReader
+	secondary trackerdb.AccountTxnIndexReader
+}
+
+// AccountTxnIndexRange implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) AccountTxnIndexRange(ctx context.Context) (since basics.Round, through basics.Round, err error) {
+	sinceP, throughP, errP := r.primary.AccountTxnIndexRange(ctx)
+	sinceS, throughS, errS := r.secondary.AccountTxnIndexRange(ctx)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if sinceP != sinceS || throughP != throughS {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return sinceP, throughP, nil
+}
+
+// LookupAccountTxns implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) LookupAccountTxns(ctx context.Context, addr basics.Address, minRound basics.Round, maxRound basics.Round, limit uint64) ([]trackerdb.AccountTxnEntry, error) {
+	entriesP, errP := r.primary.LookupAccountTxns(ctx, addr, minRound, maxRound, limit)
+	entriesS, errS := r.secondary.LookupAccountTxns(ctx, addr, minRound, maxRound, limit)
+	// coalesce errors
+	err := coalesceErrors(errP, errS)
+	if err != nil {
+		return nil, err
+	}
+	// check results match
+	if !cmp.Equal(entriesP, entriesS, allowAllUnexported) {
+		err = ErrInconsistentResult
+		return nil, err
+	}
+	// return primary results
+	return entriesP, nil
+}
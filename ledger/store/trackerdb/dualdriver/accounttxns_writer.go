@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dualdriver
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+type accountTxnIndexWriter struct {
+	primary   trackerdb.AccountTxnIndexWriter
+	secondary trackerdb.AccountTxnIndexWriter
+}
+
+// ResetAccountTxnIndex implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) ResetAccountTxnIndex(ctx context.Context, since basics.Round) error {
+	errP := w.primary.ResetAccountTxnIndex(ctx, since)
+	errS := w.secondary.ResetAccountTxnIndex(ctx, since)
+	// coalesce errors
+	return coalesceErrors(errP, errS)
+}
+
+// StoreAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) StoreAccountTxns(ctx context.Context, entries []trackerdb.AccountTxnEntry, through basics.Round) error {
+	errP := w.primary.StoreAccountTxns(ctx, entries, through)
+	errS := w.secondary.StoreAccountTxns(ctx, entries, through)
+	// coalesce errors
+	return coalesceErrors(errP, errS)
+}
+
+// PruneAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) PruneAccountTxns(ctx context.Context, before basics.Round) error {
+	errP := w.primary.PruneAccountTxns(ctx, before)
+	errS := w.secondary.PruneAccountTxns(ctx, before)
+	// coalesce errors
+	return coalesceErrors(errP, errS)
+}
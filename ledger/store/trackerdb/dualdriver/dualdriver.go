@@ -176,6 +176,11 @@ func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err er
 	return
 }
 
+func (s *trackerStore) CopyTo(ctx context.Context, path string) error {
+	// the primary database is the one the node reads from
+	return s.primary.CopyTo(ctx, path)
+}
+
 func (s *trackerStore) ResetToV6Test(ctx context.Context) error {
 	// TODO
 	return nil
@@ -231,6 +236,13 @@ func (r *reader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader
 	return &stateproofReader{primary, secondary}
 }
 
+// MakeAccountTxnIndexReader implements trackerdb.Reader
+func (r *reader) MakeAccountTxnIndexReader() trackerdb.AccountTxnIndexReader {
+	primary := r.primary.MakeAccountTxnIndexReader()
+	secondary := r.secondary.MakeAccountTxnIndexReader()
+	return &accountTxnIndexReader{primary, secondary}
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (*reader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	// TODO: catchpoint
@@ -255,6 +267,12 @@ func (*reader) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error) {
 	return nil, nil
 }
 
+// MakeOrderedTableIter implements trackerdb.Reader
+func (r *reader) MakeOrderedTableIter(ctx context.Context, table trackerdb.OrderedTable) (trackerdb.KVsIter, error) {
+	// the stores hold the same rows, only the primary one is read
+	return r.primary.MakeOrderedTableIter(ctx, table)
+}
+
 type writer struct {
 	primary   trackerdb.Writer
 	secondary trackerdb.Writer
@@ -300,6 +318,13 @@ func (w *writer) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter
 	return &stateproofWriter{primary, secondary}
 }
 
+// MakeAccountTxnIndexWriter implements trackerdb.Writer
+func (w *writer) MakeAccountTxnIndexWriter() trackerdb.AccountTxnIndexWriter {
+	primary := w.primary.MakeAccountTxnIndexWriter()
+	secondary := w.secondary.MakeAccountTxnIndexWriter()
+	return &accountTxnIndexWriter{primary, secondary}
+}
+
 // Testing implements trackerdb.Writer
 func (w *writer) Testing() trackerdb.WriterTestExt {
 	primary := w.primary.Testing()
@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package faultdriver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/algorand/go-algorand/ledger/store/faults"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+type trackerStore struct {
+	trackerdb.Store
+}
+
+// MakeStore wraps a tracker store of any storage engine so that its transactions, batches and snapshots
+// reach the fault points of faults.TrackerDB. Faults are only injected in binaries built with the
+// `dbfaults` build tag.
+func MakeStore(store trackerdb.Store) trackerdb.Store {
+	return &trackerStore{store}
+}
+
+// Batch implements trackerdb.Store
+func (s *trackerStore) Batch(fn trackerdb.BatchFn) (err error) {
+	return s.BatchContext(context.Background(), fn)
+}
+
+// BatchContext implements trackerdb.Store
+func (s *trackerStore) BatchContext(ctx context.Context, fn trackerdb.BatchFn) (err error) {
+	w := &writes{}
+	err = s.Store.BatchContext(ctx, func(ctx context.Context, tx trackerdb.BatchScope) error {
+		return w.run(func() error {
+			return fn(ctx, &batchScope{tx, w})
+		})
+	})
+	return w.committed(err)
+}
+
+// BeginBatch implements trackerdb.Store
+func (s *trackerStore) BeginBatch(ctx context.Context) (trackerdb.Batch, error) {
+	err := faults.Inject(faults.TrackerDB, faults.BeforeTx)
+	if err != nil {
+		return nil, err
+	}
+	handle, err := s.Store.BeginBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &batch{batchScope{handle, &writes{}}, handle}, nil
+}
+
+// Snapshot implements trackerdb.Store
+func (s *trackerStore) Snapshot(fn trackerdb.SnapshotFn) (err error) {
+	return s.SnapshotContext(context.Background(), fn)
+}
+
+// SnapshotContext implements trackerdb.Store
+func (s *trackerStore) SnapshotContext(ctx context.Context, fn trackerdb.SnapshotFn) (err error) {
+	return s.Store.SnapshotContext(ctx, func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		err := faults.Inject(faults.TrackerDB, faults.BeforeTx)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, tx)
+	})
+}
+
+// BeginSnapshot implements trackerdb.Store
+func (s *trackerStore) BeginSnapshot(ctx context.Context) (trackerdb.Snapshot, error) {
+	err := faults.Inject(faults.TrackerDB, faults.BeforeTx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Store.BeginSnapshot(ctx)
+}
+
+// Transaction implements trackerdb.Store
+func (s *trackerStore) Transaction(fn trackerdb.TransactionFn) (err error) {
+	return s.TransactionContext(context.Background(), fn)
+}
+
+// TransactionContext implements trackerdb.Store
+func (s *trackerStore) TransactionContext(ctx context.Context, fn trackerdb.TransactionFn) (err error) {
+	w := &writes{}
+	err = s.Store.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		return w.run(func() error {
+			return fn(ctx, &transactionScope{tx, w})
+		})
+	})
+	return w.committed(err)
+}
+
+// BeginTransaction implements trackerdb.Store
+func (s *trackerStore) BeginTransaction(ctx context.Context) (trackerdb.Transaction, error) {
+	err := faults.Inject(faults.TrackerDB, faults.BeforeTx)
+	if err != nil {
+		return nil, err
+	}
+	handle, err := s.Store.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{transactionScope{handle, &writes{}}, handle}, nil
+}
+
+// writes tracks the writes of a transaction or batch, through the writers it makes.
+type writes struct {
+	// partial is set once a write was interrupted by a partial commit: the writes made
+	// before it are committed, and the transaction then fails.
+	partial bool
+}
+
+// write reaches the Write fault point, before a writer is made.
+func (w *writes) write() error {
+	err := faults.Inject(faults.TrackerDB, faults.Write)
+	if errors.Is(err, faults.ErrPartialCommit) {
+		w.partial = true
+	}
+	return err
+}
+
+// run runs the function of a transaction or batch between its fault points. After a partial commit
+// it succeeds whatever the function returned, so that the writes made so far are committed.
+// It may be called again when the storage engine retries the transaction.
+func (w *writes) run(fn func() error) error {
+	w.partial = false
+	err := faults.Inject(faults.TrackerDB, faults.BeforeTx)
+	if err != nil {
+		return err
+	}
+	err = fn()
+	if w.partial {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return faults.Inject(faults.TrackerDB, faults.BeforeCommit)
+}
+
+// commit commits a transaction or batch handle between its fault points.
+func (w *writes) commit(commit func() error) error {
+	if !w.partial {
+		err := faults.Inject(faults.TrackerDB, faults.BeforeCommit)
+		if err != nil {
+			return err
+		}
+	}
+	return w.committed(commit())
+}
+
+// committed returns the outcome of a transaction or batch, once the storage engine committed it or failed to.
+func (w *writes) committed(err error) error {
+	if err != nil {
+		return err
+	}
+	if w.partial {
+		return faults.ErrSimulatedCrash
+	}
+	return faults.Inject(faults.TrackerDB, faults.AfterCommit)
+}
+
+type batchScope struct {
+	trackerdb.BatchScope
+	*writes
+}
+
+// MakeAccountsWriter implements trackerdb.Writer
+func (b *batchScope) MakeAccountsWriter() (trackerdb.AccountsWriterExt, error) {
+	if err := b.write(); err != nil {
+		return nil, err
+	}
+	return b.BatchScope.MakeAccountsWriter()
+}
+
+// MakeAccountsOptimizedWriter implements trackerdb.Writer
+func (b *batchScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	if err := b.write(); err != nil {
+		return nil, err
+	}
+	return b.BatchScope.MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables)
+}
+
+// MakeOnlineAccountsOptimizedWriter implements trackerdb.Writer
+func (b *batchScope) MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (trackerdb.OnlineAccountsWriter, error) {
+	if err := b.write(); err != nil {
+		return nil, err
+	}
+	return b.BatchScope.MakeOnlineAccountsOptimizedWriter(hasAccounts)
+}
+
+type batch struct {
+	batchScope
+	handle trackerdb.Batch
+}
+
+// Commit implements trackerdb.Batch
+func (b *batch) Commit() error {
+	return b.commit(b.handle.Commit)
+}
+
+// Close implements trackerdb.Batch
+func (b *batch) Close() error {
+	return b.handle.Close()
+}
+
+type transactionScope struct {
+	trackerdb.TransactionScope
+	*writes
+}
+
+// MakeAccountsWriter implements trackerdb.Writer
+func (tx *transactionScope) MakeAccountsWriter() (trackerdb.AccountsWriterExt, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeAccountsWriter()
+}
+
+// MakeAccountsOptimizedWriter implements trackerdb.Writer
+func (tx *transactionScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables)
+}
+
+// MakeOnlineAccountsOptimizedWriter implements trackerdb.Writer
+func (tx *transactionScope) MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (trackerdb.OnlineAccountsWriter, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeOnlineAccountsOptimizedWriter(hasAccounts)
+}
+
+// MakeCatchpointWriter implements trackerdb.Catchpoint
+func (tx *transactionScope) MakeCatchpointWriter() (trackerdb.CatchpointWriter, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeCatchpointWriter()
+}
+
+// MakeCatchpointReaderWriter implements trackerdb.Catchpoint
+func (tx *transactionScope) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeCatchpointReaderWriter()
+}
+
+// MakeMerkleCommitter implements trackerdb.Catchpoint
+func (tx *transactionScope) MakeMerkleCommitter(staging bool) (trackerdb.MerkleCommitter, error) {
+	if err := tx.write(); err != nil {
+		return nil, err
+	}
+	return tx.TransactionScope.MakeMerkleCommitter(staging)
+}
+
+type transaction struct {
+	transactionScope
+	handle trackerdb.Transaction
+}
+
+// Commit implements trackerdb.Transaction
+func (tx *transaction) Commit() error {
+	return tx.commit(tx.handle.Commit)
+}
+
+// Close implements trackerdb.Transaction
+func (tx *transaction) Close() error {
+	return tx.handle.Close()
+}
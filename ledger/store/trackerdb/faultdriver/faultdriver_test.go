@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build dbfaults
+// +build dbfaults
+
+package faultdriver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/faults"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testEngines runs test against a fault injecting store of each storage engine.
+func testEngines(t *testing.T, test func(t *testing.T, store trackerdb.Store)) {
+	engines := map[string]func(t *testing.T) trackerdb.Store{
+		"sqlite": func(t *testing.T) trackerdb.Store {
+			store, _ := sqlitedriver.OpenForTesting(t, false)
+			return store
+		},
+		"pebbledb": func(t *testing.T) trackerdb.Store {
+			return pebbledbdriver.OpenForTesting(t, false)
+		},
+	}
+	for name, open := range engines {
+		t.Run(name, func(t *testing.T) {
+			partitiontest.PartitionTest(t)
+			store := open(t)
+			defer store.Close()
+			params := trackerdb.Params{InitProto: protocol.ConsensusCurrentVersion}
+			_, err := store.RunMigrations(context.Background(), params, logging.TestingLog(t), trackerdb.AccountDBVersion)
+			require.NoError(t, err)
+
+			defer faults.Reset()
+			test(t, MakeStore(store))
+		})
+	}
+}
+
+// updateRound writes rnd as the round of the accounts in tx, with a writer of its own.
+func updateRound(tx trackerdb.Writer, rnd basics.Round) error {
+	aw, err := tx.MakeAccountsWriter()
+	if err != nil {
+		return err
+	}
+	return aw.UpdateAccountsRound(rnd)
+}
+
+// putTotals writes totals as the totals of the accounts in tx, with a writer of its own.
+func putTotals(tx trackerdb.Writer, totals ledgercore.AccountTotals) error {
+	aw, err := tx.MakeAccountsWriter()
+	if err != nil {
+		return err
+	}
+	return aw.AccountsPutTotals(totals, false)
+}
+
+func requireCommitted(t *testing.T, store trackerdb.Store, rnd basics.Round, rewardsLevel uint64) {
+	ar, err := store.MakeAccountsReader()
+	require.NoError(t, err)
+	committedRound, err := ar.AccountsRound()
+	require.NoError(t, err)
+	require.Equal(t, rnd, committedRound)
+	totals, err := ar.AccountsTotals(context.Background(), false)
+	require.NoError(t, err)
+	require.Equal(t, rewardsLevel, totals.RewardsLevel)
+}
+
+func TestFaultDriverRollback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testEngines(t, func(t *testing.T, store trackerdb.Store) {
+		faults.SetInjector(func(db faults.Database, point faults.Point) *faults.Fault {
+			if db == faults.TrackerDB && point == faults.BeforeCommit {
+				return &faults.Fault{}
+			}
+			return nil
+		})
+
+		err := store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return updateRound(tx, 1)
+		})
+		require.ErrorIs(t, err, faults.ErrInjectedFault)
+		err = store.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+			return updateRound(tx, 1)
+		})
+		require.ErrorIs(t, err, faults.ErrInjectedFault)
+		requireCommitted(t, store, 0, 0)
+	})
+}
+
+func TestFaultDriverCrashAfterCommit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testEngines(t, func(t *testing.T, store trackerdb.Store) {
+		faults.SetInjector(faults.CrashAt(faults.TrackerDB, faults.AfterCommit, 1))
+
+		// the transaction is written, but the process "crashes" before it learns about it
+		err := store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return updateRound(tx, 1)
+		})
+		require.ErrorIs(t, err, faults.ErrSimulatedCrash)
+		err = store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return updateRound(tx, 2)
+		})
+		require.ErrorIs(t, err, faults.ErrSimulatedCrash)
+		_, err = store.BeginSnapshot(context.Background())
+		require.ErrorIs(t, err, faults.ErrSimulatedCrash)
+		requireCommitted(t, store, 1, 0)
+
+		faults.Reset()
+		err = store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return updateRound(tx, 2)
+		})
+		require.NoError(t, err)
+		requireCommitted(t, store, 2, 0)
+	})
+}
+
+func TestFaultDriverPartialCommit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testEngines(t, func(t *testing.T, store trackerdb.Store) {
+		// the second writer of the transaction crashes, after the writes of the first one are committed
+		faults.SetInjector(faults.PartialCommitAt(faults.TrackerDB, 2))
+		err := store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			err := updateRound(tx, 1)
+			if err != nil {
+				return err
+			}
+			return putTotals(tx, ledgercore.AccountTotals{RewardsLevel: 1})
+		})
+		require.ErrorIs(t, err, faults.ErrSimulatedCrash)
+		requireCommitted(t, store, 1, 0)
+
+		// the same goes for the batches committed through their handle
+		faults.Reset()
+		faults.SetInjector(faults.PartialCommitAt(faults.TrackerDB, 2))
+		batch, err := store.BeginBatch(context.Background())
+		require.NoError(t, err)
+		defer batch.Close()
+		require.NoError(t, updateRound(batch, 2))
+		require.ErrorIs(t, putTotals(batch, ledgercore.AccountTotals{RewardsLevel: 2}), faults.ErrPartialCommit)
+		require.ErrorIs(t, batch.Commit(), faults.ErrSimulatedCrash)
+		requireCommitted(t, store, 2, 0)
+	})
+}
@@ -263,6 +263,51 @@ func (r *accountsReader) LookupKeysByPrefix(prefix string, maxKeyNum uint64, res
 	return
 }
 
+func (r *accountsReader) LookupKeysByRange(prefix string, from string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error) {
+	// read the current db round
+	round, err = r.AccountsRound()
+	if err != nil {
+		return
+	}
+
+	if from < prefix {
+		from = prefix
+	}
+	start := appKvKey(from)
+	_, end := keyPrefixIntervalPreprocessing(appKvKey(prefix))
+	namespaceLen := len(appKvKey(""))
+
+	// the iterator walks the keys in order
+	iter := r.kvr.NewIter(start, end, false)
+	defer iter.Close()
+
+	var value []byte
+
+	for iter.Next() {
+		// end iteration if we reached max results
+		if resultCount == maxKeyNum {
+			return
+		}
+
+		key := string(iter.Key()[namespaceLen:])
+		// skip the keys modified in the rounds not yet written to the db
+		if _, ok := results[key]; ok {
+			continue
+		}
+
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		if len(value) > 0 {
+			results[key] = true
+			resultCount++
+		}
+	}
+
+	return
+}
+
 func (r *accountsReader) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error) {
 	// The old SQL impl:
 	//
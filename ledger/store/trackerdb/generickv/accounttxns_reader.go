@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+type accountTxnIndexReader struct {
+	kvr KvRead
+}
+
+// MakeAccountTxnIndexReader returns a trackerdb.AccountTxnIndexReader for a KV
+func MakeAccountTxnIndexReader(kvr KvRead) trackerdb.AccountTxnIndexReader {
+	return &accountTxnIndexReader{kvr}
+}
+
+// AccountTxnIndexRange implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) AccountTxnIndexRange(ctx context.Context) (since basics.Round, through basics.Round, err error) {
+	// SQL at the time of writing:
+	//
+	// SELECT since, through
+	// FROM accounttxnsrange
+	// WHERE id=0
+
+	key := accountTxnRangeKey()
+	value, closer, err := r.kvr.Get(key[:])
+	if err != nil {
+		return 0, 0, err
+	}
+	defer closer.Close()
+
+	if len(value) != 16 {
+		return 0, 0, fmt.Errorf("account txn index range: invalid value length %d", len(value))
+	}
+	since = basics.Round(binary.BigEndian.Uint64(value[0:8]))
+	through = basics.Round(binary.BigEndian.Uint64(value[8:16]))
+	return since, through, nil
+}
+
+// LookupAccountTxns implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) LookupAccountTxns(ctx context.Context, addr basics.Address, minRound basics.Round, maxRound basics.Round, limit uint64) ([]trackerdb.AccountTxnEntry, error) {
+	// SQL at the time of writing:
+	//
+	// SELECT round, intra, txid
+	// FROM accounttxns
+	// WHERE address=? AND round>=? AND round<=?
+	// ORDER BY round DESC, intra DESC
+	// LIMIT ?
+
+	if minRound > maxRound {
+		return nil, nil
+	}
+
+	low, high := accountTxnRoundRange(addr, minRound, maxRound)
+	iter := r.kvr.NewIter(low[:], high[:], true)
+	defer iter.Close()
+
+	var entries []trackerdb.AccountTxnEntry
+	for iter.Next() {
+		value, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		entry := trackerdb.AccountTxnEntry{Address: addr}
+		entry.Round, entry.Intra = extractAccountTxnRoundIntra(iter.Key())
+		copy(entry.TxID[:], value)
+		entries = append(entries, entry)
+
+		if limit > 0 && uint64(len(entries)) == limit {
+			break
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+type accountTxnIndexWriter struct {
+	kvw KvWrite
+	kvr KvRead
+}
+
+// MakeAccountTxnIndexWriter returns a trackerdb.AccountTxnIndexWriter for a KV
+func MakeAccountTxnIndexWriter(kvw KvWrite, kvr KvRead) trackerdb.AccountTxnIndexWriter {
+	return &accountTxnIndexWriter{kvw, kvr}
+}
+
+func (w *accountTxnIndexWriter) setRange(since basics.Round, through basics.Round) error {
+	var raw [16]byte
+	binary.BigEndian.PutUint64(raw[0:8], uint64(since))
+	binary.BigEndian.PutUint64(raw[8:16], uint64(through))
+	key := accountTxnRangeKey()
+	return w.kvw.Set(key[:], raw[:])
+}
+
+// ResetAccountTxnIndex implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) ResetAccountTxnIndex(ctx context.Context, since basics.Round) error {
+	// SQL at the time of writing:
+	//
+	// DELETE FROM accounttxns
+	//
+	// INSERT OR REPLACE INTO accounttxnsrange
+	//		(id, since, through)
+	// VALUES
+	//		(0, ?, ?)
+
+	for _, prefix := range []string{kvPrefixAccountTxn, kvPrefixAccountTxnRound} {
+		start, end := accountTxnFullRangePrefix(prefix)
+		err := w.kvw.DeleteRange(start[:], end[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.setRange(since, since.SubSaturate(1))
+}
+
+// StoreAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) StoreAccountTxns(ctx context.Context, entries []trackerdb.AccountTxnEntry, through basics.Round) error {
+	// SQL at the time of writing:
+	//
+	// INSERT OR REPLACE INTO accounttxns
+	//		(address, round, intra, txid)
+	// VALUES
+	//		(?, ?, ?, ?)
+	//
+	// UPDATE accounttxnsrange
+	// SET through=?
+	// WHERE id=0
+
+	for i := range entries {
+		key := accountTxnKey(entries[i].Address, entries[i].Round, entries[i].Intra)
+		err := w.kvw.Set(key[:], entries[i].TxID[:])
+		if err != nil {
+			return err
+		}
+		// the entries are also keyed by round, for pruning
+		roundKey := accountTxnRoundKey(entries[i].Round, entries[i].Intra, entries[i].Address)
+		err = w.kvw.Set(roundKey[:], []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	since, _, err := MakeAccountTxnIndexReader(w.kvr).AccountTxnIndexRange(ctx)
+	if err != nil {
+		return err
+	}
+	return w.setRange(since, through)
+}
+
+// PruneAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) PruneAccountTxns(ctx context.Context, before basics.Round) error {
+	// SQL at the time of writing:
+	//
+	// DELETE FROM accounttxns
+	// WHERE round<?
+	//
+	// UPDATE accounttxnsrange
+	// SET since=?
+	// WHERE id=0 AND since<?
+
+	start, end := accountTxnRoundRangePrefix(before)
+	iter := w.kvr.NewIter(start[:], end[:], false)
+	var keys [][52]byte
+	for iter.Next() {
+		rnd, intra, addr := extractAccountTxnRoundKey(iter.Key())
+		keys = append(keys, accountTxnKey(addr, rnd, intra))
+	}
+	iter.Close()
+
+	for i := range keys {
+		err := w.kvw.Delete(keys[i][:])
+		if err != nil {
+			return err
+		}
+	}
+	err := w.kvw.DeleteRange(start[:], end[:])
+	if err != nil {
+		return err
+	}
+
+	since, through, err := MakeAccountTxnIndexReader(w.kvr).AccountTxnIndexRange(ctx)
+	if err != nil {
+		return err
+	}
+	if since < before {
+		return w.setRange(before, through)
+	}
+	return nil
+}
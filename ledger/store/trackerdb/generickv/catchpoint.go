@@ -20,21 +20,24 @@ import (
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 )
 
-type catchpoint struct{}
+type catchpoint struct {
+	kvr KvRead
+	kvw KvWrite
+}
 
 // MakeCatchpoint returns a trackerdb.Catchpoint for a KV
-func MakeCatchpoint() trackerdb.Catchpoint {
-	return &catchpoint{}
+func MakeCatchpoint(kvr KvRead, kvw KvWrite) trackerdb.Catchpoint {
+	return &catchpoint{kvr, kvw}
 }
 
 // MakeCatchpointReaderWriter implements trackerdb.Catchpoint
-func (*catchpoint) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
-	panic("unimplemented")
+func (c *catchpoint) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	return MakeCatchpointReaderWriter(c.kvr, c.kvw), nil
 }
 
 // MakeCatchpointWriter implements trackerdb.Catchpoint
-func (*catchpoint) MakeCatchpointWriter() (trackerdb.CatchpointWriter, error) {
-	panic("unimplemented")
+func (c *catchpoint) MakeCatchpointWriter() (trackerdb.CatchpointWriter, error) {
+	return MakeCatchpointWriter(c.kvw, c.kvr), nil
 }
 
 // MakeMerkleCommitter implements trackerdb.Catchpoint
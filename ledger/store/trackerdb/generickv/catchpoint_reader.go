@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// the catchpoint state values carry a tag telling the integer ones from the string ones,
+// the same way the sql table keeps them in separate columns of a single row.
+const (
+	catchpointStateUint64Tag = 'i'
+	catchpointStateStringTag = 's'
+)
+
+type catchpointReader struct {
+	kvr KvRead
+}
+
+// MakeCatchpointReader returns a trackerdb.CatchpointReader for a KV
+func MakeCatchpointReader(kvr KvRead) trackerdb.CatchpointReader {
+	return &catchpointReader{kvr}
+}
+
+// GetCatchpoint implements trackerdb.CatchpointReader
+func (r *catchpointReader) GetCatchpoint(ctx context.Context, round basics.Round) (fileName string, catchpoint string, fileSize int64, err error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT filename, catchpoint, filesize FROM storedcatchpoints WHERE round=?
+
+	key := catchpointRoundKey(kvPrefixStoredCatchpoint, round)
+	value, closer, err := r.kvr.Get(key[:])
+	if err == trackerdb.ErrNotFound {
+		// the callers treat an empty record as a missing one
+		return "", "", 0, nil
+	}
+	if err != nil {
+		return "", "", 0, err
+	}
+	defer closer.Close()
+
+	return decodeStoredCatchpoint(value)
+}
+
+// GetOldestCatchpointFiles implements trackerdb.CatchpointReader
+func (r *catchpointReader) GetOldestCatchpointFiles(ctx context.Context, fileCount int, filesToKeep int) (fileNames map[basics.Round]string, err error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT round, filename FROM storedcatchpoints WHERE pinned = 0 and
+	//   round <= COALESCE((SELECT round FROM storedcatchpoints WHERE pinned = 0 ORDER BY round DESC LIMIT ?, 1),0)
+	// ORDER BY round ASC LIMIT ?
+
+	low, high := catchpointFullRangePrefix(kvPrefixStoredCatchpoint)
+	iter := r.kvr.NewIter(low[:], high[:], false)
+	defer iter.Close()
+
+	var rounds []basics.Round
+	var names []string
+	for iter.Next() {
+		value, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		fileName, _, _, err := decodeStoredCatchpoint(value)
+		if err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, extractCatchpointRound(iter.Key()))
+		names = append(names, fileName)
+	}
+
+	// keep the newest filesToKeep files, and return at most fileCount of the older ones
+	count := len(rounds) - filesToKeep
+	if count > fileCount {
+		count = fileCount
+	}
+	fileNames = make(map[basics.Round]string)
+	for i := 0; i < count; i++ {
+		fileNames[rounds[i]] = names[i]
+	}
+	return fileNames, nil
+}
+
+// ReadCatchpointStateUint64 implements trackerdb.CatchpointReader
+func (r *catchpointReader) ReadCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState) (val uint64, err error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT intval FROM catchpointstate WHERE id=?
+
+	value, closer, err := r.kvr.Get(catchpointStateKey(string(stateName)))
+	if err == trackerdb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+
+	if len(value) != 9 || value[0] != catchpointStateUint64Tag {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(value[1:]), nil
+}
+
+// ReadCatchpointStateString implements trackerdb.CatchpointReader
+func (r *catchpointReader) ReadCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState) (val string, err error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT strval FROM catchpointstate WHERE id=?
+
+	value, closer, err := r.kvr.Get(catchpointStateKey(string(stateName)))
+	if err == trackerdb.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer closer.Close()
+
+	if len(value) == 0 || value[0] != catchpointStateStringTag {
+		return "", nil
+	}
+	return string(value[1:]), nil
+}
+
+// SelectUnfinishedCatchpoints implements trackerdb.CatchpointReader
+func (r *catchpointReader) SelectUnfinishedCatchpoints(ctx context.Context) ([]trackerdb.UnfinishedCatchpointRecord, error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT round, blockhash FROM unfinishedcatchpoints ORDER BY round
+
+	low, high := catchpointFullRangePrefix(kvPrefixUnfinishedCatchpoint)
+	iter := r.kvr.NewIter(low[:], high[:], false)
+	defer iter.Close()
+
+	var res []trackerdb.UnfinishedCatchpointRecord
+	for iter.Next() {
+		value, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		record := trackerdb.UnfinishedCatchpointRecord{Round: extractCatchpointRound(iter.Key())}
+		copy(record.BlockHash[:], value)
+		res = append(res, record)
+	}
+	return res, nil
+}
+
+// SelectCatchpointFirstStageInfo implements trackerdb.CatchpointReader
+func (r *catchpointReader) SelectCatchpointFirstStageInfo(ctx context.Context, round basics.Round) (trackerdb.CatchpointFirstStageInfo, bool /*exists*/, error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT info FROM catchpointfirststageinfo WHERE round=?
+
+	key := catchpointRoundKey(kvPrefixCatchpointFirstStage, round)
+	value, closer, err := r.kvr.Get(key[:])
+	if err == trackerdb.ErrNotFound {
+		return trackerdb.CatchpointFirstStageInfo{}, false, nil
+	}
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+	defer closer.Close()
+
+	var res trackerdb.CatchpointFirstStageInfo
+	err = protocol.Decode(value, &res)
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+	return res, true, nil
+}
+
+// SelectOldCatchpointFirstStageInfoRounds implements trackerdb.CatchpointReader
+func (r *catchpointReader) SelectOldCatchpointFirstStageInfoRounds(ctx context.Context, maxRound basics.Round) ([]basics.Round, error) {
+	// The SQL at the time of writing:
+	//
+	// SELECT round FROM catchpointfirststageinfo WHERE round <= ?
+
+	low, high := catchpointFullRangePrefix(kvPrefixCatchpointFirstStage)
+	iter := r.kvr.NewIter(low[:], high[:], false)
+	defer iter.Close()
+
+	var res []basics.Round
+	for iter.Next() {
+		rnd := extractCatchpointRound(iter.Key())
+		if rnd > maxRound {
+			break
+		}
+		res = append(res, rnd)
+	}
+	return res, nil
+}
+
+func encodeStoredCatchpoint(fileName string, catchpoint string, fileSize int64) []byte {
+	value := make([]byte, 0, 8+4+len(fileName)+len(catchpoint))
+	value = binary.BigEndian.AppendUint64(value, uint64(fileSize))
+	value = binary.BigEndian.AppendUint32(value, uint32(len(fileName)))
+	value = append(value, fileName...)
+	value = append(value, catchpoint...)
+	return value
+}
+
+func decodeStoredCatchpoint(value []byte) (fileName string, catchpoint string, fileSize int64, err error) {
+	if len(value) < 12 {
+		return "", "", 0, fmt.Errorf("stored catchpoint record too short: %d bytes", len(value))
+	}
+	fileSize = int64(binary.BigEndian.Uint64(value[0:8]))
+	nameLen := int(binary.BigEndian.Uint32(value[8:12]))
+	if len(value) < 12+nameLen {
+		return "", "", 0, fmt.Errorf("stored catchpoint record truncated: %d bytes for a %d bytes file name", len(value), nameLen)
+	}
+	return string(value[12 : 12+nameLen]), string(value[12+nameLen:]), fileSize, nil
+}
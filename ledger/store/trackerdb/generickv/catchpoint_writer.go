@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// errCatchpointCatchupUnsupported is returned by the operations staging a catchpoint being caught up to,
+// which the KV stores do not support yet.
+var errCatchpointCatchupUnsupported = errors.New("catchpoint catchup is not supported by the key-value storage engines")
+
+type catchpointWriter struct {
+	kvw KvWrite
+	kvr KvRead
+}
+
+type catchpointReaderWriter struct {
+	catchpointReader
+	catchpointWriter
+}
+
+// MakeCatchpointWriter returns a trackerdb.CatchpointWriter for a KV
+func MakeCatchpointWriter(kvw KvWrite, kvr KvRead) trackerdb.CatchpointWriter {
+	return &catchpointWriter{kvw, kvr}
+}
+
+// MakeCatchpointReaderWriter returns a trackerdb.CatchpointReaderWriter for a KV
+func MakeCatchpointReaderWriter(kvr KvRead, kvw KvWrite) trackerdb.CatchpointReaderWriter {
+	return &catchpointReaderWriter{catchpointReader{kvr}, catchpointWriter{kvw, kvr}}
+}
+
+// StoreCatchpoint implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) StoreCatchpoint(ctx context.Context, round basics.Round, fileName string, catchpoint string, fileSize int64) (err error) {
+	// The SQL at the time of writing:
+	//
+	// DELETE FROM storedcatchpoints WHERE round=?
+	// INSERT INTO storedcatchpoints(round, filename, catchpoint, filesize, pinned) VALUES(?, ?, ?, ?, 0)
+
+	key := catchpointRoundKey(kvPrefixStoredCatchpoint, round)
+	if fileName == "" && catchpoint == "" && fileSize == 0 {
+		return w.kvw.Delete(key[:])
+	}
+	return w.kvw.Set(key[:], encodeStoredCatchpoint(fileName, catchpoint, fileSize))
+}
+
+// WriteCatchpointStateUint64 implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState, setValue uint64) (err error) {
+	// The SQL at the time of writing:
+	//
+	// DELETE FROM catchpointstate WHERE id=?					(when setValue is 0)
+	// INSERT OR REPLACE INTO catchpointstate(id, intval) VALUES(?, ?)
+
+	key := catchpointStateKey(string(stateName))
+	if setValue == 0 {
+		return w.kvw.Delete(key)
+	}
+	value := binary.BigEndian.AppendUint64([]byte{catchpointStateUint64Tag}, setValue)
+	return w.kvw.Set(key, value)
+}
+
+// WriteCatchpointStateString implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState, setValue string) (err error) {
+	// The SQL at the time of writing:
+	//
+	// DELETE FROM catchpointstate WHERE id=?					(when setValue is "")
+	// INSERT OR REPLACE INTO catchpointstate(id, strval) VALUES(?, ?)
+
+	key := catchpointStateKey(string(stateName))
+	if setValue == "" {
+		return w.kvw.Delete(key)
+	}
+	value := append([]byte{catchpointStateStringTag}, setValue...)
+	return w.kvw.Set(key, value)
+}
+
+// InsertUnfinishedCatchpoint implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) InsertUnfinishedCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest) error {
+	// The SQL at the time of writing:
+	//
+	// INSERT INTO unfinishedcatchpoints(round, blockhash) VALUES(?, ?)
+
+	key := catchpointRoundKey(kvPrefixUnfinishedCatchpoint, round)
+	return w.kvw.Set(key[:], blockHash[:])
+}
+
+// DeleteUnfinishedCatchpoint implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) DeleteUnfinishedCatchpoint(ctx context.Context, round basics.Round) error {
+	// The SQL at the time of writing:
+	//
+	// DELETE FROM unfinishedcatchpoints WHERE round = ?
+
+	key := catchpointRoundKey(kvPrefixUnfinishedCatchpoint, round)
+	return w.kvw.Delete(key[:])
+}
+
+// InsertOrReplaceCatchpointFirstStageInfo implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) InsertOrReplaceCatchpointFirstStageInfo(ctx context.Context, round basics.Round, info *trackerdb.CatchpointFirstStageInfo) error {
+	// The SQL at the time of writing:
+	//
+	// INSERT OR REPLACE INTO catchpointfirststageinfo(round, info) VALUES(?, ?)
+
+	key := catchpointRoundKey(kvPrefixCatchpointFirstStage, round)
+	return w.kvw.Set(key[:], protocol.Encode(info))
+}
+
+// DeleteOldCatchpointFirstStageInfo implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) DeleteOldCatchpointFirstStageInfo(ctx context.Context, maxRoundToDelete basics.Round) error {
+	// The SQL at the time of writing:
+	//
+	// DELETE FROM catchpointfirststageinfo WHERE round <= ?
+
+	low, high := catchpointFullRangePrefix(kvPrefixCatchpointFirstStage)
+	if maxRoundToDelete < basics.Round(^uint64(0)) {
+		end := catchpointRoundKey(kvPrefixCatchpointFirstStage, maxRoundToDelete+1)
+		return w.kvw.DeleteRange(low[:], end[:])
+	}
+	return w.kvw.DeleteRange(low[:], high[:])
+}
+
+// DeleteStoredCatchpoints implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) DeleteStoredCatchpoints(ctx context.Context, dbDirectory string) (err error) {
+	cr := catchpointReader{w.kvr}
+	fileNames, err := cr.GetOldestCatchpointFiles(ctx, int(^uint(0)>>1), 0)
+	if err != nil {
+		return err
+	}
+	for round, fileName := range fileNames {
+		err = trackerdb.RemoveSingleCatchpointFileFromDisk(dbDirectory, fileName)
+		if err != nil {
+			return err
+		}
+		// clear the entry from the database
+		err = w.StoreCatchpoint(ctx, round, "", "", 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateCatchpointStagingHashesIndex implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) CreateCatchpointStagingHashesIndex(ctx context.Context) (err error) {
+	return errCatchpointCatchupUnsupported
+}
+
+// WriteCatchpointStagingBalances implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStagingBalances(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	return errCatchpointCatchupUnsupported
+}
+
+// WriteCatchpointStagingKVs implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error {
+	return errCatchpointCatchupUnsupported
+}
+
+// WriteCatchpointStagingCreatable implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStagingCreatable(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	return errCatchpointCatchupUnsupported
+}
+
+// WriteCatchpointStagingHashes implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) WriteCatchpointStagingHashes(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	return errCatchpointCatchupUnsupported
+}
+
+// ApplyCatchpointStagingBalances implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) ApplyCatchpointStagingBalances(ctx context.Context, balancesRound basics.Round, merkleRootRound basics.Round) (err error) {
+	return errCatchpointCatchupUnsupported
+}
+
+// ResetCatchpointStagingBalances implements trackerdb.CatchpointWriter
+func (w *catchpointWriter) ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error) {
+	return errCatchpointCatchupUnsupported
+}
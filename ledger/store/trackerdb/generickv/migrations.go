@@ -112,6 +112,12 @@ func (m *migrator) Migrate(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+		case 10:
+			// the account transaction index needs no setup on a KV store
+			err := m.setVersion(ctx, 11)
+			if err != nil {
+				return err
+			}
 		default:
 			// any other version we do nothing
 			return nil
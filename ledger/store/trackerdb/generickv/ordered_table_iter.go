@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+// orderedTableIter iterates over the keys of a prefix, whose order already is the one of the
+// trackerdb.OrderedTable keys: the address comes first, followed by the big-endian index.
+type orderedTableIter struct {
+	iter KvIter
+	// indexed tells that the key holds an address, a separator and an index, the separator
+	// being dropped from the keys returned.
+	indexed bool
+}
+
+// MakeOrderedTableIter implements trackerdb.Reader
+func (r *reader) MakeOrderedTableIter(ctx context.Context, table trackerdb.OrderedTable) (trackerdb.KVsIter, error) {
+	var prefix string
+	indexed := false
+	switch table {
+	case trackerdb.AccountsTable:
+		prefix = kvPrefixAccount
+	case trackerdb.ResourcesTable:
+		prefix, indexed = kvPrefixResource, true
+	case trackerdb.KVsTable:
+		prefix = kvPrefixAppKv
+	case trackerdb.OnlineAccountsTable:
+		prefix, indexed = kvPrefixOnlineAccount, true
+	case trackerdb.OnlineRoundParamsTable:
+		prefix = kvOnlineAccountRoundParams
+	default:
+		return nil, fmt.Errorf("unknown tracker table %d", table)
+	}
+	low, high := fullRangePrefix(prefix)
+	return &orderedTableIter{iter: r.NewIter(low[:], high[:], false), indexed: indexed}, nil
+}
+
+func (i *orderedTableIter) Next() bool {
+	return i.iter.Next()
+}
+
+func (i *orderedTableIter) KeyValue() (k []byte, v []byte, err error) {
+	k = i.iter.Key()[prefixLength+separatorLength:]
+	if i.indexed {
+		k = append(k[:addressLength], k[addressLength+separatorLength:]...)
+	}
+	v, err = i.iter.Value()
+	return k, v, err
+}
+
+func (i *orderedTableIter) Close() {
+	i.iter.Close()
+}
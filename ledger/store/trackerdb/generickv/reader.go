@@ -53,6 +53,11 @@ func (r *reader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader
 	return MakeStateproofReader(r)
 }
 
+// MakeAccountTxnIndexReader implements trackerdb.Reader
+func (r *reader) MakeAccountTxnIndexReader() trackerdb.AccountTxnIndexReader {
+	return MakeAccountTxnIndexReader(r)
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (r *reader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	// TODO: catchpoint
@@ -61,8 +66,7 @@ func (r *reader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.Ca
 
 // MakeCatchpointReader implements trackerdb.Reader
 func (r *reader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
-	// TODO: catchpoint
-	panic("unimplemented")
+	return MakeCatchpointReader(r), nil
 }
 
 // MakeEncodedAccoutsBatchIter implements trackerdb.Reader
@@ -18,6 +18,7 @@ package generickv
 
 import (
 	"encoding/binary"
+	"math"
 
 	"github.com/algorand/go-algorand/data/basics"
 )
@@ -42,6 +43,13 @@ const (
 	kvTxTail                     = "xj"
 	kvOnlineAccountRoundParams   = "xk"
 	kvPrefixStateproof           = "xl"
+	kvPrefixAccountTxn           = "xm"
+	kvPrefixAccountTxnRound      = "xn"
+	kvAccountTxnRange            = "xo"
+	kvPrefixCatchpointState      = "xp"
+	kvPrefixStoredCatchpoint     = "xq"
+	kvPrefixUnfinishedCatchpoint = "xr"
+	kvPrefixCatchpointFirstStage = "xs"
 )
 
 const (
@@ -398,3 +406,163 @@ func stateproofFullRangePrefix() ([3]byte, [3]byte) {
 
 	return low, high
 }
+
+func accountTxnKey(addr basics.Address, rnd basics.Round, intra uint64) [52]byte {
+	var key [prefixLength + separatorLength + addressLength + separatorLength + 8 + 8]byte
+
+	rnd8 := bigEndianUint64(uint64(rnd))
+	intra8 := bigEndianUint64(intra)
+
+	copy(key[0:], kvPrefixAccountTxn)
+	key[prefixLength] = separator
+	copy(key[prefixLength+separatorLength:], addr[:])
+	key[prefixLength+separatorLength+addressLength] = separator
+	copy(key[prefixLength+separatorLength+addressLength+separatorLength:], rnd8[:])
+	copy(key[prefixLength+separatorLength+addressLength+separatorLength+8:], intra8[:])
+
+	return key
+}
+
+func extractAccountTxnRoundIntra(key []byte) (basics.Round, uint64) {
+	const offset int = prefixLength + separatorLength + addressLength + separatorLength
+	rnd := basics.Round(binary.BigEndian.Uint64(key[offset : offset+8]))
+	intra := binary.BigEndian.Uint64(key[offset+8 : offset+16])
+	return rnd, intra
+}
+
+// accountTxnRoundRange returns the prefixes bounding the entries of addr within [minRound, maxRound].
+func accountTxnRoundRange(addr basics.Address, minRound, maxRound basics.Round) ([44]byte, [44]byte) {
+	var low, high [prefixLength + separatorLength + addressLength + separatorLength + 8]byte
+
+	copy(low[0:], kvPrefixAccountTxn)
+	low[prefixLength] = separator
+	copy(low[prefixLength+separatorLength:], addr[:])
+	low[prefixLength+separatorLength+addressLength] = separator
+	minRound8 := bigEndianUint64(uint64(minRound))
+	copy(low[prefixLength+separatorLength+addressLength+separatorLength:], minRound8[:])
+
+	copy(high[0:], kvPrefixAccountTxn)
+	high[prefixLength] = separator
+	copy(high[prefixLength+separatorLength:], addr[:])
+	if maxRound == math.MaxUint64 {
+		high[prefixLength+separatorLength+addressLength] = endRangeSeparator
+	} else {
+		high[prefixLength+separatorLength+addressLength] = separator
+		maxRound8 := bigEndianUint64(uint64(maxRound) + 1)
+		copy(high[prefixLength+separatorLength+addressLength+separatorLength:], maxRound8[:])
+	}
+
+	return low, high
+}
+
+func accountTxnRoundKey(rnd basics.Round, intra uint64, addr basics.Address) [51]byte {
+	var key [prefixLength + separatorLength + 8 + 8 + addressLength]byte
+
+	rnd8 := bigEndianUint64(uint64(rnd))
+	intra8 := bigEndianUint64(intra)
+
+	copy(key[0:], kvPrefixAccountTxnRound)
+	key[prefixLength] = separator
+	copy(key[prefixLength+separatorLength:], rnd8[:])
+	copy(key[prefixLength+separatorLength+8:], intra8[:])
+	copy(key[prefixLength+separatorLength+8+8:], addr[:])
+
+	return key
+}
+
+func extractAccountTxnRoundKey(key []byte) (basics.Round, uint64, basics.Address) {
+	const offset int = prefixLength + separatorLength
+	rnd := basics.Round(binary.BigEndian.Uint64(key[offset : offset+8]))
+	intra := binary.BigEndian.Uint64(key[offset+8 : offset+16])
+	var addr basics.Address
+	copy(addr[:], key[offset+16:offset+16+addressLength])
+	return rnd, intra, addr
+}
+
+func accountTxnRoundRangePrefix(rnd basics.Round) ([3]byte, [11]byte) {
+	var low [prefixLength + separatorLength]byte
+	var high [prefixLength + separatorLength + 8]byte
+
+	rnd8 := bigEndianUint64(uint64(rnd))
+
+	copy(low[0:], kvPrefixAccountTxnRound)
+	low[prefixLength] = separator
+
+	copy(high[0:], kvPrefixAccountTxnRound)
+	high[prefixLength] = separator
+	copy(high[prefixLength+separatorLength:], rnd8[:])
+
+	return low, high
+}
+
+func accountTxnFullRangePrefix(prefix string) ([3]byte, [3]byte) {
+	var low, high [prefixLength + separatorLength]byte
+
+	copy(low[0:], prefix)
+	low[prefixLength] = separator
+
+	copy(high[0:], prefix)
+	high[prefixLength] = endRangeSeparator
+
+	return low, high
+}
+
+func accountTxnRangeKey() [2]byte {
+	var key [prefixLength]byte
+	copy(key[0:], kvAccountTxnRange)
+	return key
+}
+
+func catchpointStateKey(stateName string) []byte {
+	key := make([]byte, 0, prefixLength+separatorLength+len(stateName))
+
+	key = append(key, kvPrefixCatchpointState...)
+	key = append(key, separator)
+	key = append(key, stateName...)
+
+	return key
+}
+
+// catchpointRoundKey returns the key of the catchpoint record of the given round, within
+// the stored, unfinished or first stage catchpoints, depending on the prefix.
+func catchpointRoundKey(prefix string, rnd basics.Round) [11]byte {
+	var key [prefixLength + separatorLength + 8]byte
+
+	rnd8 := bigEndianUint64(uint64(rnd))
+
+	copy(key[0:], prefix)
+	key[prefixLength] = separator
+	copy(key[prefixLength+separatorLength:], rnd8[:])
+
+	return key
+}
+
+func extractCatchpointRound(key []byte) basics.Round {
+	const offset int = prefixLength + separatorLength
+	u64Rnd := binary.BigEndian.Uint64(key[offset : offset+roundLength])
+	return basics.Round(u64Rnd)
+}
+
+func catchpointFullRangePrefix(prefix string) ([3]byte, [3]byte) {
+	var low, high [prefixLength + separatorLength]byte
+
+	copy(low[0:], prefix)
+	low[prefixLength] = separator
+
+	copy(high[0:], prefix)
+	high[prefixLength] = endRangeSeparator
+
+	return low, high
+}
+
+func fullRangePrefix(prefix string) ([3]byte, [3]byte) {
+	var low, high [prefixLength + separatorLength]byte
+
+	copy(low[0:], prefix)
+	low[prefixLength] = separator
+
+	copy(high[0:], prefix)
+	high[prefixLength] = endRangeSeparator
+
+	return low, high
+}
@@ -59,6 +59,11 @@ func (w *writer) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter
 	return MakeStateproofWriter(w)
 }
 
+// MakeAccountTxnIndexWriter implements trackerdb.Writer
+func (w *writer) MakeAccountTxnIndexWriter() trackerdb.AccountTxnIndexWriter {
+	return MakeAccountTxnIndexWriter(w, w)
+}
+
 // Testing implements trackerdb.Writer
 func (w *writer) Testing() trackerdb.WriterTestExt {
 	return &writerForTesting{w.store, w, w}
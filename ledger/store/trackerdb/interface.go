@@ -24,6 +24,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/encoded"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 )
@@ -108,6 +109,7 @@ type AccountsReader interface {
 
 	LookupKeyValue(key string) (pv PersistedKVData, err error)
 	LookupKeysByPrefix(prefix string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error)
+	LookupKeysByRange(prefix string, from string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error)
 
 	LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error)
 
@@ -234,6 +236,24 @@ type KVsIter interface {
 	Close()
 }
 
+// OrderedTable enumerates the tables of the tracker state MakeOrderedTableIter iterates over.
+type OrderedTable int
+
+const (
+	// AccountsTable holds the encoded BaseAccountData of each account, keyed by address.
+	AccountsTable OrderedTable = iota
+	// ResourcesTable holds the encoded ResourcesData of each account resource, keyed by the address
+	// followed by the big-endian creatable index.
+	ResourcesTable
+	// KVsTable holds the application boxes, keyed by their kv key.
+	KVsTable
+	// OnlineAccountsTable holds the encoded BaseOnlineAccountData history, keyed by the address
+	// followed by the big-endian update round.
+	OnlineAccountsTable
+	// OnlineRoundParamsTable holds the encoded OnlineRoundParamsData, keyed by the big-endian round.
+	OnlineRoundParamsTable
+)
+
 // EncodedAccountsBatchIter is an iterator for a accounts.
 type EncodedAccountsBatchIter interface {
 	Next(ctx context.Context, accountCount int, resourceCount int) (bals []encoded.BalanceRecordV6, numAccountsProcessed uint64, err error)
@@ -268,3 +288,34 @@ type SpVerificationCtxReaderWriter interface {
 	SpVerificationCtxReader
 	SpVerificationCtxWriter
 }
+
+// AccountTxnEntry is an entry of the account transaction index, recording that
+// the transaction TxID, at position Intra of the payset of Round, touched Address.
+type AccountTxnEntry struct {
+	Address basics.Address
+	Round   basics.Round
+	Intra   uint64
+	TxID    transactions.Txid
+}
+
+// AccountTxnIndexReader is a reader abstraction for the account transaction index tracker
+// Use with SnapshotScope
+type AccountTxnIndexReader interface {
+	// AccountTxnIndexRange returns the range of rounds covered by the index. It returns
+	// ErrNotFound if the index was never reset.
+	AccountTxnIndexRange(ctx context.Context) (since basics.Round, through basics.Round, err error)
+	// LookupAccountTxns returns up to limit entries of addr within [minRound, maxRound],
+	// the most recent ones first. A limit of 0 returns all of them.
+	LookupAccountTxns(ctx context.Context, addr basics.Address, minRound basics.Round, maxRound basics.Round, limit uint64) ([]AccountTxnEntry, error)
+}
+
+// AccountTxnIndexWriter is a writer abstraction for the account transaction index tracker
+// Use with BatchScope
+type AccountTxnIndexWriter interface {
+	// ResetAccountTxnIndex removes all the entries and makes the index start over, covering no round before since.
+	ResetAccountTxnIndex(ctx context.Context, since basics.Round) error
+	// StoreAccountTxns adds entries to the index and extends it through the given round.
+	StoreAccountTxns(ctx context.Context, entries []AccountTxnEntry, through basics.Round) error
+	// PruneAccountTxns removes the entries of the rounds before the given one, which the index no longer covers.
+	PruneAccountTxns(ctx context.Context, before basics.Round) error
+}
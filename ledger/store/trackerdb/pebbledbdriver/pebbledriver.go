@@ -20,6 +20,7 @@ package pebbledbdriver
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"runtime"
 	"time"
@@ -222,7 +223,7 @@ func Open(dbdir string, inMem bool, proto config.ConsensusParams, log logging.Lo
 		proto,
 		generickv.MakeReader(&kvs, proto),
 		generickv.MakeWriter(store, &kvs, &kvs),
-		generickv.MakeCatchpoint(),
+		generickv.MakeCatchpoint(&kvs, &kvs),
 	}
 	return store, nil
 }
@@ -360,7 +361,7 @@ func (s *trackerStore) BeginTransaction(ctx context.Context) (trackerdb.Transact
 		trackerdb.Reader
 		trackerdb.Writer
 		trackerdb.Catchpoint
-	}{scope, generickv.MakeReader(&scope, s.proto), generickv.MakeWriter(s, &scope, &scope), generickv.MakeCatchpoint()}, nil
+	}{scope, generickv.MakeReader(&scope, s.proto), generickv.MakeWriter(s, &scope, &scope), generickv.MakeCatchpoint(&scope, &scope)}, nil
 }
 
 // Vacuum implements trackerdb.Store
@@ -369,6 +370,11 @@ func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err er
 	return db.VacuumStats{}, nil
 }
 
+// CopyTo implements trackerdb.Store
+func (s *trackerStore) CopyTo(ctx context.Context, path string) error {
+	return fmt.Errorf("copying the tracker database is not supported by the pebbledb storage engine")
+}
+
 // ResetToV6Test implements trackerdb.Store
 func (s *trackerStore) ResetToV6Test(ctx context.Context) error {
 	// TODO
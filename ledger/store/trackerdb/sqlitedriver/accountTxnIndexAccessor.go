@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type accountTxnIndexReader struct {
+	q db.Queryable
+}
+
+type accountTxnIndexWriter struct {
+	e db.Executable
+}
+
+func makeAccountTxnIndexReader(q db.Queryable) *accountTxnIndexReader {
+	return &accountTxnIndexReader{q: q}
+}
+
+func makeAccountTxnIndexWriter(e db.Executable) *accountTxnIndexWriter {
+	return &accountTxnIndexWriter{e: e}
+}
+
+// AccountTxnIndexRange implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) AccountTxnIndexRange(ctx context.Context) (since basics.Round, through basics.Round, err error) {
+	err = db.Retry(func() error {
+		err := r.q.QueryRowContext(ctx, "SELECT since, through FROM accounttxnsrange WHERE id=0").Scan(&since, &through)
+		if err == sql.ErrNoRows {
+			return trackerdb.ErrNotFound
+		}
+		return err
+	})
+	return
+}
+
+// LookupAccountTxns implements trackerdb.AccountTxnIndexReader
+func (r *accountTxnIndexReader) LookupAccountTxns(ctx context.Context, addr basics.Address, minRound basics.Round, maxRound basics.Round, limit uint64) ([]trackerdb.AccountTxnEntry, error) {
+	query := "SELECT round, intra, txid FROM accounttxns WHERE address=? AND round>=? AND round<=? ORDER BY round DESC, intra DESC"
+	args := []interface{}{addr[:], minRound, maxRound}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	var entries []trackerdb.AccountTxnEntry
+	err := db.Retry(func() error {
+		rows, err := r.q.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		// Clear `entries` in case this function is repeated.
+		entries = entries[:0]
+		for rows.Next() {
+			entry := trackerdb.AccountTxnEntry{Address: addr}
+			var txid []byte
+			err = rows.Scan(&entry.Round, &entry.Intra, &txid)
+			if err != nil {
+				return err
+			}
+			copy(entry.TxID[:], txid)
+			entries = append(entries, entry)
+		}
+		return rows.Err()
+	})
+	return entries, err
+}
+
+// ResetAccountTxnIndex implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) ResetAccountTxnIndex(ctx context.Context, since basics.Round) error {
+	_, err := w.e.ExecContext(ctx, "DELETE FROM accounttxns")
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "INSERT OR REPLACE INTO accounttxnsrange(id, since, through) VALUES(0, ?, ?)", since, since.SubSaturate(1))
+	return err
+}
+
+// StoreAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) StoreAccountTxns(ctx context.Context, entries []trackerdb.AccountTxnEntry, through basics.Round) error {
+	if len(entries) > 0 {
+		stmt, err := w.e.PrepareContext(ctx, "INSERT OR REPLACE INTO accounttxns(address, round, intra, txid) VALUES(?, ?, ?, ?)")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for i := range entries {
+			_, err = stmt.ExecContext(ctx, entries[i].Address[:], entries[i].Round, entries[i].Intra, entries[i].TxID[:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err := w.e.ExecContext(ctx, "UPDATE accounttxnsrange SET through=? WHERE id=0", through)
+	return err
+}
+
+// PruneAccountTxns implements trackerdb.AccountTxnIndexWriter
+func (w *accountTxnIndexWriter) PruneAccountTxns(ctx context.Context, before basics.Round) error {
+	_, err := w.e.ExecContext(ctx, "DELETE FROM accounttxns WHERE round<?", before)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "UPDATE accounttxnsrange SET since=? WHERE id=0 AND since<?", before, before)
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// orderedTableQueries select the rows of each trackerdb.OrderedTable in key order, the index
+// of the indexed tables coming as the second column. The resources are selected by address
+// rather than by the local rowid of their account.
+var orderedTableQueries = map[trackerdb.OrderedTable]string{
+	trackerdb.AccountsTable:          "SELECT address, data FROM accountbase ORDER BY address",
+	trackerdb.ResourcesTable:         "SELECT a.address, r.aidx, r.data FROM resources r JOIN accountbase a ON a.rowid = r.addrid ORDER BY a.address, r.aidx",
+	trackerdb.KVsTable:               "SELECT key, value FROM kvstore ORDER BY key",
+	trackerdb.OnlineAccountsTable:    "SELECT address, updround, data FROM onlineaccounts ORDER BY address, updround",
+	trackerdb.OnlineRoundParamsTable: "SELECT rnd, data FROM onlineroundparamstail ORDER BY rnd",
+}
+
+type orderedTableIter struct {
+	rows  *sql.Rows
+	table trackerdb.OrderedTable
+}
+
+// MakeOrderedTableIter creates an iterator over the rows of table in key order.
+func MakeOrderedTableIter(ctx context.Context, q db.Queryable, table trackerdb.OrderedTable) (*orderedTableIter, error) {
+	query, ok := orderedTableQueries[table]
+	if !ok {
+		return nil, fmt.Errorf("unknown tracker table %d", table)
+	}
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &orderedTableIter{rows: rows, table: table}, nil
+}
+
+func (iter *orderedTableIter) Next() bool {
+	return iter.rows.Next()
+}
+
+func (iter *orderedTableIter) KeyValue() (k []byte, v []byte, err error) {
+	var index uint64
+	switch iter.table {
+	case trackerdb.ResourcesTable, trackerdb.OnlineAccountsTable:
+		err = iter.rows.Scan(&k, &index, &v)
+		k = binary.BigEndian.AppendUint64(k, index)
+	case trackerdb.OnlineRoundParamsTable:
+		err = iter.rows.Scan(&index, &v)
+		k = binary.BigEndian.AppendUint64(nil, index)
+	default:
+		err = iter.rows.Scan(&k, &v)
+	}
+	return k, v, err
+}
+
+func (iter *orderedTableIter) Close() {
+	iter.rows.Close()
+}
@@ -154,6 +154,24 @@ const createStateProofVerificationTableQuery = `
 	lastattestedround integer primary key NOT NULL,
 	verificationcontext blob NOT NULL)`
 
+const createAccountTxnsTableQuery = `
+	CREATE TABLE IF NOT EXISTS accounttxns (
+	address blob NOT NULL,
+	round integer NOT NULL,
+	intra integer NOT NULL,
+	txid blob NOT NULL,
+	PRIMARY KEY (address, round, intra) )`
+
+const createAccountTxnsRoundIndex = `
+	CREATE INDEX IF NOT EXISTS accounttxns_round_idx
+	ON accounttxns ( round )`
+
+const createAccountTxnsRangeTableQuery = `
+	CREATE TABLE IF NOT EXISTS accounttxnsrange (
+	id integer primary key NOT NULL,
+	since integer NOT NULL,
+	through integer NOT NULL)`
+
 const createVoteLastValidIndex = `
 	CREATE INDEX IF NOT EXISTS onlineaccounts_votelastvalid_idx
 	ON onlineaccounts ( votelastvalid )`
@@ -174,6 +192,8 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS catchpointfirststageinfo`,
 	`DROP TABLE IF EXISTS unfinishedcatchpoints`,
 	`DROP TABLE IF EXISTS stateproofverification`,
+	`DROP TABLE IF EXISTS accounttxns`,
+	`DROP TABLE IF EXISTS accounttxnsrange`,
 }
 
 // accountsInit fills the database using tx with initAccounts if the
@@ -395,6 +415,16 @@ func createStateProofVerificationTable(ctx context.Context, e db.Executable) err
 	return err
 }
 
+func createAccountTxnsTables(ctx context.Context, e db.Executable) error {
+	for _, stmt := range []string{createAccountTxnsTableQuery, createAccountTxnsRoundIndex, createAccountTxnsRangeTableQuery} {
+		_, err := e.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // performResourceTableMigration migrate the database to use the resources table.
 func performResourceTableMigration(ctx context.Context, e db.Executable, log func(processed, total uint64)) (err error) {
 	now := time.Now().UnixNano()
@@ -35,6 +35,7 @@ type accountsDbQueries struct {
 	lookupAllResourcesStmt *sql.Stmt
 	lookupKvPairStmt       *sql.Stmt
 	lookupKeysByRangeStmt  *sql.Stmt
+	lookupOrderedKeysStmt  *sql.Stmt
 	lookupCreatorStmt      *sql.Stmt
 }
 
@@ -97,6 +98,11 @@ func AccountsInitDbQueries(q db.Queryable) (*accountsDbQueries, error) {
 		return nil, err
 	}
 
+	qs.lookupOrderedKeysStmt, err = q.Prepare("SELECT acctrounds.rnd, kvstore.key FROM acctrounds LEFT JOIN kvstore ON kvstore.key >= ? AND kvstore.key < ? WHERE id='acctbase' ORDER BY kvstore.key")
+	if err != nil {
+		return nil, err
+	}
+
 	qs.lookupCreatorStmt, err = q.Prepare("SELECT acctrounds.rnd, assetcreators.creator FROM acctrounds LEFT JOIN assetcreators ON asset = ? AND ctype = ? WHERE id='acctbase'")
 	if err != nil {
 		return nil, err
@@ -286,6 +292,47 @@ func (qs *accountsDbQueries) LookupKeysByPrefix(prefix string, maxKeyNum uint64,
 	return
 }
 
+// LookupKeysByRange adds to results, in order, the keys matching the prefix that are not lower than from,
+// skipping the keys already in results, until results holds maxKeyNum keys.
+func (qs *accountsDbQueries) LookupKeysByRange(prefix string, from string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error) {
+	start, end := keyPrefixIntervalPreprocessing([]byte(prefix))
+	if end == nil {
+		return 0, fmt.Errorf("lookup by strange prefix %#v", prefix)
+	}
+	if from > string(start) {
+		start = []byte(from)
+	}
+	err = db.Retry(func() error {
+		var rows *sql.Rows
+		rows, err = qs.lookupOrderedKeysStmt.Query(start, end)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var v sql.NullString
+
+		for rows.Next() {
+			if resultCount == maxKeyNum {
+				return nil
+			}
+			err = rows.Scan(&round, &v)
+			if err != nil {
+				return err
+			}
+			if v.Valid {
+				if _, ok := results[v.String]; ok {
+					continue
+				}
+				results[v.String] = true
+				resultCount++
+			}
+		}
+		return nil
+	})
+	return
+}
+
 // keyPrefixIntervalPreprocessing is implemented to generate an interval for DB queries that look up keys by prefix.
 // Such DB query was designed this way, to trigger the binary search optimization in SQLITE3.
 // The DB comparison for blob typed primary key is lexicographic, i.e., byte by byte.
@@ -543,6 +590,7 @@ func (qs *accountsDbQueries) Close() {
 		&qs.lookupAllResourcesStmt,
 		&qs.lookupKvPairStmt,
 		&qs.lookupKeysByRangeStmt,
+		&qs.lookupOrderedKeysStmt,
 		&qs.lookupCreatorStmt,
 	}
 	for _, preparedQuery := range preparedQueries {
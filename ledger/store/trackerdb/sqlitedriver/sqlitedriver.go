@@ -132,6 +132,10 @@ func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err
 	return
 }
 
+func (s *trackerSQLStore) CopyTo(ctx context.Context, path string) error {
+	return s.pair.Rdb.VacuumInto(ctx, path)
+}
+
 func (s *trackerSQLStore) ResetToV6Test(ctx context.Context) error {
 	var resetExprs = []string{
 		`DROP TABLE IF EXISTS onlineaccounts`,
@@ -180,6 +184,11 @@ func (r *sqlReader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxRea
 	return makeStateProofVerificationReader(r.q)
 }
 
+// MakeAccountTxnIndexReader implements trackerdb.Reader
+func (r *sqlReader) MakeAccountTxnIndexReader() trackerdb.AccountTxnIndexReader {
+	return makeAccountTxnIndexReader(r.q)
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (r *sqlReader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	return MakeCatchpointPendingHashesIterator(hashCount, r.q)
@@ -200,6 +209,11 @@ func (r *sqlReader) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error)
 	return MakeKVsIter(ctx, r.q)
 }
 
+// MakeOrderedTableIter implements trackerdb.Reader
+func (r *sqlReader) MakeOrderedTableIter(ctx context.Context, table trackerdb.OrderedTable) (trackerdb.KVsIter, error) {
+	return MakeOrderedTableIter(ctx, r.q, table)
+}
+
 type sqlWriter struct {
 	e db.Executable
 }
@@ -224,6 +238,11 @@ func (w *sqlWriter) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWri
 	return makeStateProofVerificationWriter(w.e)
 }
 
+// MakeAccountTxnIndexWriter implements trackerdb.Writer
+func (w *sqlWriter) MakeAccountTxnIndexWriter() trackerdb.AccountTxnIndexWriter {
+	return makeAccountTxnIndexWriter(w.e)
+}
+
 // Testing implements trackerdb.Writer
 func (w *sqlWriter) Testing() trackerdb.WriterTestExt {
 	return w
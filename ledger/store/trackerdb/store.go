@@ -44,6 +44,8 @@ type Store interface {
 	BeginTransaction(ctx context.Context) (Transaction, error)
 	// maintenance
 	Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
+	// CopyTo writes a consistent copy of the database into the new file path.
+	CopyTo(ctx context.Context, path string) error
 	// testing
 	ResetToV6Test(ctx context.Context) error
 	// cleanup
@@ -56,6 +58,7 @@ type Reader interface {
 	MakeAccountsOptimizedReader() (AccountsReader, error)
 	MakeOnlineAccountsOptimizedReader() (OnlineAccountsReader, error)
 	MakeSpVerificationCtxReader() SpVerificationCtxReader
+	MakeAccountTxnIndexReader() AccountTxnIndexReader
 	// catchpoint
 	// Note: BuildMerkleTrie() needs this on the reader handle in sqlite to not get locked by write txns
 	MakeCatchpointPendingHashesIterator(hashCount int) CatchpointPendingHashesIter
@@ -63,6 +66,9 @@ type Reader interface {
 	MakeCatchpointReader() (CatchpointReader, error)
 	MakeEncodedAccoutsBatchIter() EncodedAccountsBatchIter
 	MakeKVsIter(ctx context.Context) (KVsIter, error)
+	// MakeOrderedTableIter iterates over the rows of table in key order. The keys do not depend on
+	// the local layout of the store, such as rowids, so the same row has the same key in every store.
+	MakeOrderedTableIter(ctx context.Context, table OrderedTable) (KVsIter, error)
 }
 
 // Writer is the interface for the trackerdb write operations.
@@ -72,6 +78,7 @@ type Writer interface {
 	MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (AccountsWriter, error)
 	MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (OnlineAccountsWriter, error)
 	MakeSpVerificationCtxWriter() SpVerificationCtxWriter
+	MakeAccountTxnIndexWriter() AccountTxnIndexWriter
 	// testing
 	Testing() WriterTestExt
 }
@@ -18,6 +18,7 @@ package testsuite
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
@@ -36,6 +37,7 @@ func init() {
 	registerTest("online_accounts-round_params-update", CustomTestOnlineAccountParams)
 	registerTest("accounts-lookup_by_rowid", CustomTestAccountLookupByRowID)
 	registerTest("resources-lookup_by_rowid", CustomTestResourceLookupByRowID)
+	registerTest("ordered-tables", CustomTestOrderedTables)
 }
 
 func CustomTestRoundUpdate(t *customT) {
@@ -311,3 +313,96 @@ func CustomTestResourceLookupByRowID(t *customT) {
 	require.Error(t, err)
 	require.Equal(t, err, trackerdb.ErrNotFound)
 }
+
+func CustomTestOrderedTables(t *customT) {
+	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, true, false)
+	require.NoError(t, err)
+	defer aow.Close()
+
+	oaw, err := t.db.MakeOnlineAccountsOptimizedWriter(true)
+	require.NoError(t, err)
+
+	aw, err := t.db.MakeAccountsWriter()
+	require.NoError(t, err)
+
+	// generate some test data, written out of key order
+	var addrA, addrB basics.Address
+	addrA[0], addrB[0] = 1, 2
+	dataA := trackerdb.BaseAccountData{RewardsBase: 1000}
+	dataB := trackerdb.BaseAccountData{RewardsBase: 2000}
+	refB, err := aow.InsertAccount(addrB, dataB.NormalizedOnlineBalance(t.proto), dataB)
+	require.NoError(t, err)
+	refA, err := aow.InsertAccount(addrA, dataA.NormalizedOnlineBalance(t.proto), dataA)
+	require.NoError(t, err)
+
+	resData := trackerdb.MakeResourcesData(0)
+	_, err = aow.InsertResource(refA, basics.CreatableIndex(300), resData)
+	require.NoError(t, err)
+	_, err = aow.InsertResource(refB, basics.CreatableIndex(1), resData)
+	require.NoError(t, err)
+	_, err = aow.InsertResource(refA, basics.CreatableIndex(2), resData)
+	require.NoError(t, err)
+
+	err = aow.UpsertKvPair("b", []byte{2})
+	require.NoError(t, err)
+	err = aow.UpsertKvPair("a", []byte{1})
+	require.NoError(t, err)
+
+	onlineData := trackerdb.BaseOnlineAccountData{MicroAlgos: basics.MicroAlgos{Raw: 100}}
+	_, err = oaw.InsertOnlineAccount(addrB, onlineData.NormalizedOnlineBalance(t.proto), onlineData, 1, 0)
+	require.NoError(t, err)
+	_, err = oaw.InsertOnlineAccount(addrA, onlineData.NormalizedOnlineBalance(t.proto), onlineData, 5, 0)
+	require.NoError(t, err)
+	_, err = oaw.InsertOnlineAccount(addrA, onlineData.NormalizedOnlineBalance(t.proto), onlineData, 3, 0)
+	require.NoError(t, err)
+
+	// Note: some engines might start with some data built-in data for round 0
+	err = aw.AccountsPruneOnlineRoundParams(basics.Round(42))
+	require.NoError(t, err)
+	err = aw.AccountsPutOnlineRoundParams([]ledgercore.OnlineRoundParamsData{{OnlineSupply: 7}, {OnlineSupply: 8}}, basics.Round(256))
+	require.NoError(t, err)
+
+	indexed := func(addr basics.Address, index uint64) string {
+		return string(binary.BigEndian.AppendUint64(addr[:], index))
+	}
+	round := func(rnd uint64) string {
+		return string(binary.BigEndian.AppendUint64(nil, rnd))
+	}
+	expected := map[trackerdb.OrderedTable][]string{
+		trackerdb.AccountsTable:          {string(addrA[:]), string(addrB[:])},
+		trackerdb.ResourcesTable:         {indexed(addrA, 2), indexed(addrA, 300), indexed(addrB, 1)},
+		trackerdb.KVsTable:               {"a", "b"},
+		trackerdb.OnlineAccountsTable:    {indexed(addrA, 3), indexed(addrA, 5), indexed(addrB, 1)},
+		trackerdb.OnlineRoundParamsTable: {round(256), round(257)},
+	}
+
+	//
+	// test
+	//
+
+	for table, keys := range expected {
+		iter, err := t.db.MakeOrderedTableIter(context.Background(), table)
+		require.NoError(t, err)
+
+		var read []string
+		for iter.Next() {
+			k, v, err := iter.KeyValue()
+			require.NoError(t, err)
+			require.NotEmpty(t, v)
+			read = append(read, string(k))
+		}
+		iter.Close()
+		require.Equal(t, keys, read, "table %d", table)
+	}
+
+	// the values are the encoded rows
+	iter, err := t.db.MakeOrderedTableIter(context.Background(), trackerdb.AccountsTable)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.True(t, iter.Next())
+	_, v, err := iter.KeyValue()
+	require.NoError(t, err)
+	var readData trackerdb.BaseAccountData
+	require.NoError(t, protocol.Decode(v, &readData))
+	require.Equal(t, dataA, readData)
+}
@@ -28,6 +28,7 @@ func init() {
 	registerTest("resources-crud", CustomTestResourcesCrud)
 	registerTest("resources-query-all", CustomTestResourcesQueryAll)
 	registerTest("kv-crud", CustomTestAppKVCrud)
+	registerTest("kv-range", CustomTestAppKVRange)
 	registerTest("creatables-crud", CustomTestCreatablesCrud)
 }
 
@@ -305,6 +306,46 @@ func CustomTestAppKVCrud(t *customT) {
 	require.Equal(t, expectedRound, pv1.Round) // db round (this is present even if record does not exist)
 }
 
+func CustomTestAppKVRange(t *customT) {
+	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, true, false)
+	require.NoError(t, err)
+
+	aor, err := t.db.MakeAccountsOptimizedReader()
+	require.NoError(t, err)
+
+	aw, err := t.db.MakeAccountsWriter()
+	require.NoError(t, err)
+
+	expectedRound := basics.Round(3)
+	err = aw.UpdateAccountsRound(expectedRound)
+	require.NoError(t, err)
+
+	// insert the kvs out of order, along with a key outside of the prefix
+	for _, key := range []string{"prefix-d", "prefix-b", "prefix-a", "prefix-c", "prefix-e", "prefiy-a"} {
+		err = aow.UpsertKvPair(key, []byte(key))
+		require.NoError(t, err)
+	}
+
+	// the keys come in order, starting at from
+	results := make(map[string]bool)
+	rnd, err := aor.LookupKeysByRange("prefix-", "prefix-b", 2, results, 0)
+	require.NoError(t, err)
+	require.Equal(t, expectedRound, rnd)
+	require.Equal(t, map[string]bool{"prefix-b": true, "prefix-c": true}, results)
+
+	// the keys already in the results are skipped, and do not count
+	results = map[string]bool{"prefix-b": false}
+	_, err = aor.LookupKeysByRange("prefix-", "", 3, results, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"prefix-a": true, "prefix-b": false, "prefix-c": true, "prefix-d": true}, results)
+
+	// a start past the prefix finds nothing
+	results = make(map[string]bool)
+	_, err = aor.LookupKeysByRange("prefix-", "prefiy-", 10, results, 0)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
 func CustomTestCreatablesCrud(t *customT) {
 	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, false, true)
 	require.NoError(t, err)
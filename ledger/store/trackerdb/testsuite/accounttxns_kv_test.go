@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// register tests that will run on each KV implementation
+	registerTest("accounttxns-crud", CustomTestAccountTxnsReadWrite)
+	registerTest("accounttxns-prune", CustomTestAccountTxnsPrune)
+}
+
+func CustomTestAccountTxnsReadWrite(t *customT) {
+	aw := t.db.MakeAccountTxnIndexWriter()
+	ar := t.db.MakeAccountTxnIndexReader()
+
+	addrA := RandomAddress()
+	addrB := RandomAddress()
+	txid := func(i int) transactions.Txid { return transactions.Txid(crypto.Hash([]byte{byte(i)})) }
+
+	//
+	// test
+	//
+
+	// the index is not set up yet
+	_, _, err := ar.AccountTxnIndexRange(context.Background())
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	err = aw.ResetAccountTxnIndex(context.Background(), basics.Round(5))
+	require.NoError(t, err)
+	since, through, err := ar.AccountTxnIndexRange(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), since)
+	require.Equal(t, basics.Round(4), through)
+
+	// store some entries
+	entries := []trackerdb.AccountTxnEntry{
+		{Address: addrA, Round: 5, Intra: 0, TxID: txid(0)},
+		{Address: addrB, Round: 5, Intra: 0, TxID: txid(0)},
+		{Address: addrA, Round: 5, Intra: 3, TxID: txid(1)},
+		{Address: addrA, Round: 7, Intra: 1, TxID: txid(2)},
+	}
+	err = aw.StoreAccountTxns(context.Background(), entries, basics.Round(7))
+	require.NoError(t, err)
+	since, through, err = ar.AccountTxnIndexRange(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), since)
+	require.Equal(t, basics.Round(7), through)
+
+	// read back the entries of an account, most recent first
+	result, err := ar.LookupAccountTxns(context.Background(), addrA, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.AccountTxnEntry{entries[3], entries[2], entries[0]}, result)
+
+	// bounded by round and limit
+	result, err = ar.LookupAccountTxns(context.Background(), addrA, 5, 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.AccountTxnEntry{entries[2], entries[0]}, result)
+	result, err = ar.LookupAccountTxns(context.Background(), addrA, 0, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.AccountTxnEntry{entries[3], entries[2]}, result)
+
+	// other accounts are not affected
+	result, err = ar.LookupAccountTxns(context.Background(), addrB, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.AccountTxnEntry{entries[1]}, result)
+	result, err = ar.LookupAccountTxns(context.Background(), RandomAddress(), 0, 100, 0)
+	require.NoError(t, err)
+	require.Empty(t, result)
+
+	// reset drops all the entries
+	err = aw.ResetAccountTxnIndex(context.Background(), basics.Round(10))
+	require.NoError(t, err)
+	result, err = ar.LookupAccountTxns(context.Background(), addrA, 0, 100, 0)
+	require.NoError(t, err)
+	require.Empty(t, result)
+	since, through, err = ar.AccountTxnIndexRange(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), since)
+	require.Equal(t, basics.Round(9), through)
+}
+
+func CustomTestAccountTxnsPrune(t *customT) {
+	aw := t.db.MakeAccountTxnIndexWriter()
+	ar := t.db.MakeAccountTxnIndexReader()
+
+	addr := RandomAddress()
+
+	// prepare the test with some data
+	err := aw.ResetAccountTxnIndex(context.Background(), basics.Round(1))
+	require.NoError(t, err)
+	var entries []trackerdb.AccountTxnEntry
+	for rnd := basics.Round(1); rnd <= 10; rnd++ {
+		entries = append(entries, trackerdb.AccountTxnEntry{Address: addr, Round: rnd, TxID: transactions.Txid(crypto.Hash([]byte{byte(rnd)}))})
+	}
+	err = aw.StoreAccountTxns(context.Background(), entries, basics.Round(10))
+	require.NoError(t, err)
+
+	//
+	// test
+	//
+
+	err = aw.PruneAccountTxns(context.Background(), basics.Round(8))
+	require.NoError(t, err)
+
+	result, err := ar.LookupAccountTxns(context.Background(), addr, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.AccountTxnEntry{entries[9], entries[8], entries[7]}, result)
+
+	since, through, err := ar.AccountTxnIndexRange(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(8), since)
+	require.Equal(t, basics.Round(10), through)
+
+	// pruning does not move the range backwards
+	err = aw.PruneAccountTxns(context.Background(), basics.Round(3))
+	require.NoError(t, err)
+	since, _, err = ar.AccountTxnIndexRange(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(8), since)
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// register tests that will run on each KV implementation
+	registerTest("catchpoint-state", CustomTestCatchpointState)
+	registerTest("catchpoint-records", CustomTestCatchpointRecords)
+}
+
+func CustomTestCatchpointState(t *customT) {
+	ctx := context.Background()
+	crw, err := t.db.MakeCatchpointReaderWriter()
+	require.NoError(t, err)
+	if crw == nil {
+		t.Skip("catchpoints are not supported by this engine")
+	}
+
+	// missing states read as zero values
+	v, err := crw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchpointLookback)
+	require.NoError(t, err)
+	require.Zero(t, v)
+	s, err := crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateLastCatchpoint)
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	// write and read back
+	require.NoError(t, crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchpointLookback, 320))
+	require.NoError(t, crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateLastCatchpoint, "1000#ABCD"))
+	v, err = crw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchpointLookback)
+	require.NoError(t, err)
+	require.Equal(t, uint64(320), v)
+	s, err = crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateLastCatchpoint)
+	require.NoError(t, err)
+	require.Equal(t, "1000#ABCD", s)
+
+	// zero values clear the state
+	require.NoError(t, crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchpointLookback, 0))
+	require.NoError(t, crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateLastCatchpoint, ""))
+	v, err = crw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchpointLookback)
+	require.NoError(t, err)
+	require.Zero(t, v)
+	s, err = crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateLastCatchpoint)
+	require.NoError(t, err)
+	require.Empty(t, s)
+}
+
+func CustomTestCatchpointRecords(t *customT) {
+	ctx := context.Background()
+	crw, err := t.db.MakeCatchpointReaderWriter()
+	require.NoError(t, err)
+	if crw == nil {
+		t.Skip("catchpoints are not supported by this engine")
+	}
+
+	// unfinished catchpoints
+	require.NoError(t, crw.InsertUnfinishedCatchpoint(ctx, 20, crypto.Digest{2}))
+	require.NoError(t, crw.InsertUnfinishedCatchpoint(ctx, 10, crypto.Digest{1}))
+	unfinished, err := crw.SelectUnfinishedCatchpoints(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.UnfinishedCatchpointRecord{{Round: 10, BlockHash: crypto.Digest{1}}, {Round: 20, BlockHash: crypto.Digest{2}}}, unfinished)
+	require.NoError(t, crw.DeleteUnfinishedCatchpoint(ctx, 10))
+	unfinished, err = crw.SelectUnfinishedCatchpoints(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []trackerdb.UnfinishedCatchpointRecord{{Round: 20, BlockHash: crypto.Digest{2}}}, unfinished)
+
+	// first stage info
+	for _, rnd := range []basics.Round{10, 20, 30} {
+		info := trackerdb.CatchpointFirstStageInfo{Totals: ledgercore.AccountTotals{RewardsLevel: uint64(rnd)}, TotalAccounts: uint64(rnd)}
+		require.NoError(t, crw.InsertOrReplaceCatchpointFirstStageInfo(ctx, rnd, &info))
+	}
+	info, exists, err := crw.SelectCatchpointFirstStageInfo(ctx, 20)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, uint64(20), info.TotalAccounts)
+	_, exists, err = crw.SelectCatchpointFirstStageInfo(ctx, 25)
+	require.NoError(t, err)
+	require.False(t, exists)
+	rounds, err := crw.SelectOldCatchpointFirstStageInfoRounds(ctx, 20)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []basics.Round{10, 20}, rounds)
+	require.NoError(t, crw.DeleteOldCatchpointFirstStageInfo(ctx, 20))
+	rounds, err = crw.SelectOldCatchpointFirstStageInfoRounds(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{30}, rounds)
+
+	// stored catchpoints
+	for _, rnd := range []basics.Round{10, 20, 30, 40} {
+		require.NoError(t, crw.StoreCatchpoint(ctx, rnd, trackerdb.MakeCatchpointFilePath(rnd), "label", int64(rnd)))
+	}
+	fileName, label, fileSize, err := crw.GetCatchpoint(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, trackerdb.MakeCatchpointFilePath(20), fileName)
+	require.Equal(t, "label", label)
+	require.Equal(t, int64(20), fileSize)
+
+	// the newest files are kept
+	files, err := crw.GetOldestCatchpointFiles(ctx, 10, 2)
+	require.NoError(t, err)
+	require.Equal(t, map[basics.Round]string{10: trackerdb.MakeCatchpointFilePath(10), 20: trackerdb.MakeCatchpointFilePath(20)}, files)
+	files, err = crw.GetOldestCatchpointFiles(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[basics.Round]string{10: trackerdb.MakeCatchpointFilePath(10)}, files)
+
+	// clearing a record removes it
+	require.NoError(t, crw.StoreCatchpoint(ctx, 10, "", "", 0))
+	files, err = crw.GetOldestCatchpointFiles(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	require.NoError(t, crw.DeleteStoredCatchpoints(ctx, t.TempDir()))
+	files, err = crw.GetOldestCatchpointFiles(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
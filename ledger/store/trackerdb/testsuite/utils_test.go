@@ -21,6 +21,7 @@ package testsuite
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"sort"
 	"testing"
@@ -108,7 +109,7 @@ func makeMockDB(proto config.ConsensusParams) trackerdb.Store {
 		proto,
 		generickv.MakeReader(&kvs, proto),
 		generickv.MakeWriter(db, &kvs, &kvs),
-		generickv.MakeCatchpoint(),
+		generickv.MakeCatchpoint(&kvs, &kvs),
 	}
 	return db
 }
@@ -237,7 +238,7 @@ func (db *mockDB) BeginTransaction(ctx context.Context) (trackerdb.Transaction,
 		trackerdb.Reader
 		trackerdb.Writer
 		trackerdb.Catchpoint
-	}{scope, generickv.MakeReader(&scope, db.proto), generickv.MakeWriter(db, &scope, &scope), generickv.MakeCatchpoint()}, nil
+	}{scope, generickv.MakeReader(&scope, db.proto), generickv.MakeWriter(db, &scope, &scope), generickv.MakeCatchpoint(&scope, &scope)}, nil
 }
 
 func (db *mockDB) Vacuum(ctx context.Context) (stats db.VacuumStats, err error) {
@@ -245,6 +246,11 @@ func (db *mockDB) Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
 	return stats, nil
 }
 
+// CopyTo implements trackerdb.Store
+func (db *mockDB) CopyTo(ctx context.Context, path string) error {
+	return fmt.Errorf("copying the tracker database is not supported by the in-memory mock store")
+}
+
 func (db *mockDB) ResetToV6Test(ctx context.Context) error {
 	// TODO
 	return nil
@@ -279,8 +285,9 @@ func (kvs *kvstore) NewIter(low, high []byte, reverse bool) generickv.KvIter {
 	slow := string(low)
 	shigh := string(high)
 
+	// the lower bound is inclusive, as in pebble
 	for k := range kvs.data {
-		if k > slow && k < shigh {
+		if k >= slow && k < shigh {
 			keys = append(keys, k)
 		}
 	}
@@ -279,6 +279,13 @@ type deferredCommitContext struct {
 		// state proof verification commit information
 		commitContext []verificationCommitContext
 	}
+
+	accountTxns struct {
+		// entries of the account transaction index for the committed rounds
+		entries []trackerdb.AccountTxnEntry
+		// the index entries of the rounds before pruneBefore are removed, if non zero
+		pruneBefore basics.Round
+	}
 }
 
 func (dcc deferredCommitContext) newBase() basics.Round {
@@ -577,6 +584,7 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 		return aw.UpdateAccountsRound(dbRound + basics.Round(offset))
 	})
 	ledgerCommitroundMicros.AddMicrosecondsSince(start, nil)
+	ledgerCommitroundLatency.ObserveSince(start)
 
 	if err != nil {
 
@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"reflect"
+
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var (
+	upgradeDryRunBlocks      = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_upgrade_dry_run_blocks_total", Description: "Number of blocks evaluated under the consensus version of a pending upgrade"})
+	upgradeDryRunDivergences = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_upgrade_dry_run_divergences_total", Description: "Number of blocks rejected or given different effects by the consensus version of a pending upgrade"})
+)
+
+// upgradeDryRunProtocol returns the consensus version blk should be evaluated under in the
+// upgrade dry run, or an empty version if it should not be.
+func (l *Ledger) upgradeDryRunProtocol(hdr bookkeeping.BlockHeader) protocol.ConsensusVersion {
+	if !l.cfg.EnableUpgradeDryRun {
+		return ""
+	}
+	version := protocol.ConsensusVersion(l.cfg.UpgradeDryRunProtocol)
+	if version == "" {
+		version = hdr.NextProtocol
+	}
+	if version == hdr.CurrentProtocol {
+		return ""
+	}
+	return version
+}
+
+// sameMap is reflect.DeepEqual, but for an empty map being the same as a nil one.
+func sameMap[K comparable, V interface{}](a, b map[K]V) bool {
+	return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+}
+
+// deltaDivergences returns the parts of two state deltas of the same block which differ.
+func deltaDivergences(current, next ledgercore.StateDelta) []string {
+	var divergences []string
+	if !reflect.DeepEqual(current.Accts.Accts, next.Accts.Accts) {
+		divergences = append(divergences, "accounts")
+	}
+	if !reflect.DeepEqual(current.Accts.AppResources, next.Accts.AppResources) {
+		divergences = append(divergences, "app-resources")
+	}
+	if !reflect.DeepEqual(current.Accts.AssetResources, next.Accts.AssetResources) {
+		divergences = append(divergences, "asset-resources")
+	}
+	if !sameMap(current.KvMods, next.KvMods) {
+		divergences = append(divergences, "kv")
+	}
+	if !sameMap(current.Txids, next.Txids) {
+		divergences = append(divergences, "txids")
+	}
+	if !sameMap(current.Txleases, next.Txleases) {
+		divergences = append(divergences, "txleases")
+	}
+	if !sameMap(current.Creatables, next.Creatables) {
+		divergences = append(divergences, "creatables")
+	}
+	if current.StateProofNext != next.StateProofNext {
+		divergences = append(divergences, "state-proof-next")
+	}
+	if current.Totals != next.Totals {
+		divergences = append(divergences, "totals")
+	}
+	return divergences
+}
+
+// upgradeDryRun evaluates a validated block under the consensus version of the pending upgrade,
+// and reports whether that version would reject the block or give it different effects. It must
+// be called while the latest round of the ledger is the one before the block.
+func (l *Ledger) upgradeDryRun(vb ledgercore.ValidatedBlock) {
+	blk := vb.Block()
+	version := l.upgradeDryRunProtocol(blk.BlockHeader)
+	if version == "" || blk.Round() != l.Latest()+1 {
+		return
+	}
+
+	upgradeDryRunBlocks.Inc(nil)
+	details := telemetryspec.UpgradeDryRunDivergenceEventDetails{
+		Round:           uint64(blk.Round()),
+		CurrentProtocol: string(blk.CurrentProtocol),
+		NextProtocol:    string(version),
+	}
+	delta, err := eval.EvalUnderProtocol(l, blk, version)
+	if err != nil {
+		details.Rejected = true
+		details.Error = err.Error()
+		l.log.Warnf("upgrade dry run: block %d is rejected under consensus version %s: %v", blk.Round(), version, err)
+	} else {
+		details.Divergences = deltaDivergences(vb.Delta(), delta)
+		if len(details.Divergences) == 0 {
+			return
+		}
+		l.log.Warnf("upgrade dry run: block %d has different effects under consensus version %s: %v", blk.Round(), version, details.Divergences)
+	}
+	upgradeDryRunDivergences.Inc(nil)
+	l.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.UpgradeDryRunDivergenceEvent, details)
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestUpgradeDryRunProtocol(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hdr := bookkeeping.BlockHeader{UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusV37}}
+	l := &Ledger{cfg: config.GetDefaultLocal()}
+	hdr.NextProtocol = protocol.ConsensusV38
+	require.Empty(t, l.upgradeDryRunProtocol(hdr))
+
+	l.cfg.EnableUpgradeDryRun = true
+	require.Equal(t, protocol.ConsensusV38, l.upgradeDryRunProtocol(hdr))
+
+	// without a pending upgrade there is nothing to evaluate under
+	hdr.NextProtocol = ""
+	require.Empty(t, l.upgradeDryRunProtocol(hdr))
+
+	// unless the version is configured
+	l.cfg.UpgradeDryRunProtocol = string(protocol.ConsensusFuture)
+	require.Equal(t, protocol.ConsensusFuture, l.upgradeDryRunProtocol(hdr))
+	l.cfg.UpgradeDryRunProtocol = string(protocol.ConsensusV37)
+	require.Empty(t, l.upgradeDryRunProtocol(hdr))
+}
+
+func TestDeltaDivergences(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hdr := bookkeeping.BlockHeader{}
+	current := ledgercore.MakeStateDelta(&hdr, 0, 1, 0)
+	next := ledgercore.MakeStateDelta(&hdr, 0, 1, 0)
+	require.Empty(t, deltaDivergences(current, next))
+
+	current.Accts.Upsert(basics.Address{0x01}, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1}}})
+	next.Accts.Upsert(basics.Address{0x01}, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 2}}})
+	next.Totals.Online.Money.Raw = 1
+	require.Equal(t, []string{"accounts", "totals"}, deltaDivergences(current, next))
+}
+
+// dryRunBlock returns a validated block paying from the first genesis account to the second one.
+func dryRunBlock(t *testing.T, l *Ledger, addrs []basics.Address, secrets []*crypto.SignatureSecrets) *ledgercore.ValidatedBlock {
+	blkEval := nextBlock(t, l)
+	pay := txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[0], Receiver: addrs[1], Amount: 1000}
+	fillDefaults(t, l, blkEval, &pay)
+	stxn := pay.Txn().Sign(secrets[0])
+	require.NoError(t, blkEval.Transaction(stxn, transactions.ApplyData{}))
+	vb, err := blkEval.GenerateBlock()
+	require.NoError(t, err)
+	return vb
+}
+
+func TestEvalUnderProtocol(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, secrets := ledgertesting.NewTestGenesis()
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, config.GetDefaultLocal())
+	defer l.Close()
+
+	vb := dryRunBlock(t, l, addrs, secrets)
+
+	// under the same version, the evaluation matches the one of the block
+	delta, err := eval.EvalUnderProtocol(l, vb.Block(), protocol.ConsensusCurrentVersion)
+	require.NoError(t, err)
+	require.Empty(t, deltaDivergences(vb.Delta(), delta))
+
+	// a version from before the genesis hash was required rejects the block
+	_, err = eval.EvalUnderProtocol(l, vb.Block(), protocol.ConsensusV17)
+	require.Error(t, err)
+
+	_, err = eval.EvalUnderProtocol(l, vb.Block(), "unknown-version")
+	require.Error(t, err)
+}
+
+func TestUpgradeDryRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, secrets := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableUpgradeDryRun = true
+	cfg.UpgradeDryRunProtocol = string(protocol.ConsensusV17)
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	blocks := upgradeDryRunBlocks.GetUint64Value()
+	divergences := upgradeDryRunDivergences.GetUint64Value()
+	vb := dryRunBlock(t, l, addrs, secrets)
+	require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	require.Equal(t, vb.Block().Round(), l.Latest())
+	require.GreaterOrEqual(t, upgradeDryRunBlocks.GetUint64Value(), blocks+1)
+	require.GreaterOrEqual(t, upgradeDryRunDivergences.GetUint64Value(), divergences+1)
+}
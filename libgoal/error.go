@@ -21,4 +21,5 @@ import "fmt"
 var (
 	errorNoDataDirectory = fmt.Errorf("Data directory not specified.  Please make sure to pass dir path or an empty string " +
 		" if $ALGORAND_DATA in your environment is set.")
+	errorRemoteAlgod = fmt.Errorf("kmd and node control are not available when talking to a remote algod")
 )
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -59,6 +60,9 @@ type Client struct {
 	cacheDir     string
 	consensus    config.ConsensusProtocols
 
+	// remoteAlgod is the client of the remote algod the client was made for, if any
+	remoteAlgod *algodclient.RestClient
+
 	suggestedParamsCache  model.TransactionParametersResponse
 	suggestedParamsExpire time.Time
 	suggestedParamsMaxAge time.Duration
@@ -77,6 +81,13 @@ type ClientConfig struct {
 
 	// BinDir may be "" and it will be guesed
 	BinDir string
+
+	// AlgodURL is the URL of a remote algod to talk to instead of the one of AlgodDataDir.
+	// When it is set, no data dir is needed, and kmd and node control are unavailable.
+	AlgodURL string
+
+	// AlgodAPIToken is the API token used to talk to the algod at AlgodURL
+	AlgodAPIToken string
 }
 
 // ClientType represents the type of client you need
@@ -137,6 +148,10 @@ func MakeClientFromConfig(config ClientConfig, clientType ClientType) (c Client,
 
 // Init takes data directory path or an empty string if $ALGORAND_DATA is defined and initializes Client
 func (c *Client) init(config ClientConfig, clientType ClientType) error {
+	if config.AlgodURL != "" {
+		return c.initRemote(config, clientType)
+	}
+
 	// check and assign dataDir
 	dataDir, err := getDataDir(config.AlgodDataDir)
 	if err != nil {
@@ -185,6 +200,25 @@ func (c *Client) init(config ClientConfig, clientType ClientType) error {
 	return nil
 }
 
+// initRemote initializes a Client talking to the remote algod at config.AlgodURL.
+func (c *Client) initRemote(cfg ClientConfig, clientType ClientType) error {
+	if clientType == KmdClient || clientType == FullClient {
+		return errorRemoteAlgod
+	}
+	algodURL, err := url.Parse(cfg.AlgodURL)
+	if err != nil {
+		return err
+	}
+	if algodURL.Scheme == "" || algodURL.Host == "" {
+		return fmt.Errorf("invalid algod URL '%s'", cfg.AlgodURL)
+	}
+	algod := algodclient.MakeRestClient(*algodURL, cfg.AlgodAPIToken)
+	c.remoteAlgod = &algod
+	c.cacheDir = cfg.CacheDir
+	c.consensus = config.Consensus
+	return nil
+}
+
 func (c *Client) ensureKmdClient() (*kmdclient.KMDClient, error) {
 	kmd, err := c.getKMDClient()
 	if err != nil {
@@ -239,6 +273,9 @@ func (c *Client) SetKMDStartArgs(args nodecontrol.KMDStartArgs) {
 }
 
 func (c *Client) getKMDClient() (kmdclient.KMDClient, error) {
+	if c.remoteAlgod != nil {
+		return kmdclient.KMDClient{}, errorRemoteAlgod
+	}
 	// Will return alreadyRunning = true if kmd already running
 	_, err := c.nc.StartKMD(c.kmdStartArgs)
 	if err != nil {
@@ -253,6 +290,9 @@ func (c *Client) getKMDClient() (kmdclient.KMDClient, error) {
 }
 
 func (c *Client) getAlgodClient() (algodclient.RestClient, error) {
+	if c.remoteAlgod != nil {
+		return *c.remoteAlgod, nil
+	}
 	algodClient, err := c.nc.AlgodClient()
 	if err != nil {
 		return algodclient.RestClient{}, err
@@ -261,6 +301,13 @@ func (c *Client) getAlgodClient() (algodclient.RestClient, error) {
 }
 
 func (c *Client) ensureGenesisID() (string, error) {
+	if c.remoteAlgod != nil {
+		versions, err := c.remoteAlgod.Versions()
+		if err != nil {
+			return "", err
+		}
+		return versions.GenesisID, nil
+	}
 	genesis, err := c.nc.GetGenesis()
 	if err != nil {
 		return "", err
@@ -280,6 +327,9 @@ func (c *Client) GenesisID() (string, error) {
 
 // FullStop stops the clients including graceful shutdown to algod and kmd
 func (c *Client) FullStop() error {
+	if c.remoteAlgod != nil {
+		return errorRemoteAlgod
+	}
 	return c.nc.FullStop()
 }
 
@@ -695,6 +745,16 @@ func (c *Client) AccountAssetInformation(accountAddress string, assetID uint64)
 	return
 }
 
+// BuildOptInGroups builds the transaction groups opting an account in to, or closing it out of,
+// the given assets and applications, and reports whether they would fail.
+func (c *Client) BuildOptInGroups(accountAddress string, assets, apps []uint64, closeOut bool) (resp model.OptInResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.BuildOptInGroups(accountAddress, model.OptInRequest{Assets: &assets, Applications: &apps, CloseOut: &closeOut})
+	}
+	return
+}
+
 // RawAccountAssetInformation gets account information about a given asset.
 func (c *Client) RawAccountAssetInformation(accountAddress string, assetID uint64) (accountResource modelV2.AccountAssetModel, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -897,6 +957,33 @@ func (c Client) LedgerSupply() (resp model.SupplyResponse, err error) {
 	return
 }
 
+// Metrics returns the metrics of the node, in the Prometheus text format
+func (c Client) Metrics() (metrics string, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		metrics, err = algod.Metrics()
+	}
+	return
+}
+
+// TransactionPoolStats returns statistics on the transaction pool of the node
+func (c Client) TransactionPoolStats() (resp model.TransactionPoolStatsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.TransactionPoolStats()
+	}
+	return
+}
+
+// CatchpointLabel returns the catchpoint label the node generated for the given round
+func (c Client) CatchpointLabel(round uint64) (resp model.CatchpointLabelResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.CatchpointLabel(round)
+	}
+	return
+}
+
 // CurrentRound returns the current known round
 func (c Client) CurrentRound() (lastRound uint64, err error) {
 	// Get current round
@@ -1143,6 +1230,15 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// CatchupStatus returns the progress of the catchup to the given catchpoint label.
+func (c *Client) CatchupStatus(catchpointLabel string) (resp model.CatchupStatusResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.CatchupStatus(catchpointLabel)
+	}
+	return
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1294,6 +1390,22 @@ func (c *Client) SimulateTransactionsRaw(encodedRequest []byte) (result v2.PreEn
 	return
 }
 
+// SimulateTransactionsBatchRaw simulates each transaction group of the raw request bytes independently, against the
+// same round, and returns their simulation results.
+func (c *Client) SimulateTransactionsBatchRaw(encodedRequest []byte) (result v2.PreEncodedSimulateResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	var resp []byte
+	resp, err = algod.RawSimulateBatchRawTransaction(encodedRequest)
+	if err != nil {
+		return
+	}
+	err = protocol.DecodeReflect(resp, &result)
+	return
+}
+
 // SimulateTransactions simulates transactions and returns relevant simulation results.
 func (c *Client) SimulateTransactions(request v2.PreEncodedSimulateRequest) (result v2.PreEncodedSimulateResponse, err error) {
 	return c.SimulateTransactionsRaw(protocol.EncodeReflect(&request))
@@ -17,8 +17,12 @@
 package libgoal
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -141,3 +145,37 @@ func TestValidRounds(t *testing.T) {
 	a.Equal(uint64(100), fv)
 	a.Equal(maxTxnLife, lv)
 }
+
+func TestRemoteAlgodClient(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/versions" || r.Header.Get("X-Algo-API-Token") != "remote-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(protocol.EncodeJSON(common.Version{GenesisID: "remotenet-v1"}))
+	}))
+	defer server.Close()
+
+	cfg := ClientConfig{AlgodURL: server.URL, AlgodAPIToken: "remote-token"}
+	client, err := MakeClientFromConfig(cfg, AlgodClient)
+	require.NoError(t, err)
+	require.Empty(t, client.DataDir())
+
+	genesisID, err := client.GenesisID()
+	require.NoError(t, err)
+	require.Equal(t, "remotenet-v1", genesisID)
+
+	_, err = client.ensureKmdClient()
+	require.ErrorIs(t, err, errorRemoteAlgod)
+	require.ErrorIs(t, client.FullStop(), errorRemoteAlgod)
+
+	_, err = MakeClientFromConfig(cfg, FullClient)
+	require.ErrorIs(t, err, errorRemoteAlgod)
+
+	_, err = MakeClientFromConfig(ClientConfig{AlgodURL: "localhost:8080"}, AlgodClient)
+	require.Error(t, err)
+}
@@ -38,6 +38,7 @@ import (
 	"io"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 
@@ -163,10 +164,29 @@ type Logger interface {
 	GetInstanceName() string
 	GetTelemetryURI() string
 	CloseTelemetry()
+
+	// RedirectOutput redirects the output at runtime to OutputSyslog or to a file, or back to the
+	// output set by SetOutput when target is empty.
+	RedirectOutput(target string) error
+	// OutputRedirection returns the target the output is redirected to, or "" when it isn't.
+	OutputRedirection() string
+	// SetTelemetryCategoryEnabled enables or disables sending the telemetry events of a category.
+	SetTelemetryCategoryEnabled(category telemetryspec.Category, enabled bool)
+	// DisabledTelemetryCategories returns the categories of the telemetry events which are not sent.
+	DisabledTelemetryCategories() []telemetryspec.Category
 }
 
 type loggerState struct {
 	telemetry *telemetryState
+
+	// outputMu protects output and redirection.
+	outputMu sync.Mutex
+	// output is the writer set by SetOutput, written to unless the output is redirected.
+	output      io.Writer
+	redirection *outputRedirection
+
+	categoriesMu       sync.RWMutex
+	disabledCategories map[telemetryspec.Category]bool
 }
 
 type logger struct {
@@ -288,18 +308,32 @@ func (l logger) WithFields(fields Fields) Logger {
 }
 
 func (l logger) GetLevel() (lvl Level) {
-	return Level(l.entry.Logger.Level)
+	return Level(l.entry.Logger.GetLevel())
 }
 
 func (l logger) SetLevel(lvl Level) {
-	l.entry.Logger.Level = logrus.Level(lvl)
+	// the level may be changed at runtime, while other goroutines log
+	l.entry.Logger.SetLevel(logrus.Level(lvl))
 }
 
 func (l logger) IsLevelEnabled(level Level) bool {
-	return l.entry.Logger.Level >= logrus.Level(level)
+	return l.entry.Logger.IsLevelEnabled(logrus.Level(level))
 }
 
 func (l logger) SetOutput(w io.Writer) {
+	state := l.loggerState
+	state.outputMu.Lock()
+	defer state.outputMu.Unlock()
+	state.output = w
+	if state.redirection != nil {
+		state.redirection.writer.Close()
+		state.redirection = nil
+	}
+	l.applyOutput(w)
+}
+
+// applyOutput writes the log to w, capturing its history for telemetry when enabled.
+func (l logger) applyOutput(w io.Writer) {
 	if l.GetTelemetryEnabled() {
 		l.setOutput(l.loggerState.telemetry.wrapOutput(w))
 	} else {
@@ -308,7 +342,7 @@ func (l logger) SetOutput(w io.Writer) {
 }
 
 func (l logger) setOutput(w io.Writer) {
-	l.entry.Logger.Out = w
+	l.entry.Logger.SetOutput(w)
 }
 
 func (l logger) getOutput() io.Writer {
@@ -366,7 +400,7 @@ func NewLogger() Logger {
 func NewWrappedLogger(l *logrus.Logger) Logger {
 	out := logger{
 		logrus.NewEntry(l),
-		&loggerState{},
+		&loggerState{output: l.Out},
 	}
 	formatter := out.entry.Logger.Formatter
 	tf, ok := formatter.(*logrus.TextFormatter)
@@ -448,7 +482,7 @@ func (l logger) GetTelemetryUploadingEnabled() bool {
 }
 
 func (l logger) Metrics(category telemetryspec.Category, metrics telemetryspec.MetricDetails, details interface{}) {
-	if l.loggerState.telemetry != nil {
+	if l.loggerState.telemetry != nil && l.telemetryCategoryEnabled(category) {
 		l.loggerState.telemetry.logMetrics(l, category, metrics, details)
 	}
 }
@@ -458,7 +492,7 @@ func (l logger) Event(category telemetryspec.Category, identifier telemetryspec.
 }
 
 func (l logger) EventWithDetails(category telemetryspec.Category, identifier telemetryspec.Event, details interface{}) {
-	if l.loggerState.telemetry != nil {
+	if l.loggerState.telemetry != nil && l.telemetryCategoryEnabled(category) {
 		l.loggerState.telemetry.logEvent(l, category, identifier, details)
 	}
 }
@@ -474,3 +508,36 @@ func (l logger) FlushTelemetry() {
 		l.loggerState.telemetry.Flush()
 	}
 }
+
+func (l logger) SetTelemetryCategoryEnabled(category telemetryspec.Category, enabled bool) {
+	state := l.loggerState
+	state.categoriesMu.Lock()
+	defer state.categoriesMu.Unlock()
+	if enabled {
+		delete(state.disabledCategories, category)
+		return
+	}
+	if state.disabledCategories == nil {
+		state.disabledCategories = make(map[telemetryspec.Category]bool)
+	}
+	state.disabledCategories[category] = true
+}
+
+func (l logger) DisabledTelemetryCategories() []telemetryspec.Category {
+	state := l.loggerState
+	state.categoriesMu.RLock()
+	defer state.categoriesMu.RUnlock()
+	categories := make([]telemetryspec.Category, 0, len(state.disabledCategories))
+	for category := range state.disabledCategories {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i] < categories[j] })
+	return categories
+}
+
+func (l logger) telemetryCategoryEnabled(category telemetryspec.Category) bool {
+	state := l.loggerState
+	state.categoriesMu.RLock()
+	defer state.categoriesMu.RUnlock()
+	return !state.disabledCategories[category]
+}
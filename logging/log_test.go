@@ -19,6 +19,8 @@ package logging
 import (
 	"bytes"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 
@@ -140,3 +142,32 @@ func TestFatalExitHandler(t *testing.T) {
 	require.True(t, flag.Load())
 
 }
+
+func TestRedirectOutput(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	nl := NewLogger()
+	nl.SetLevel(Info)
+	nl.SetOutput(&buf)
+	a.Empty(nl.OutputRedirection())
+
+	a.ErrorContains(nl.RedirectOutput("relative.log"), "absolute path")
+
+	path := filepath.Join(t.TempDir(), "redirected.log")
+	a.NoError(nl.RedirectOutput(path))
+	a.Equal(path, nl.OutputRedirection())
+	nl.Info("redirected")
+
+	a.NoError(nl.RedirectOutput(""))
+	a.Empty(nl.OutputRedirection())
+	nl.Info("restored")
+
+	a.NotContains(buf.String(), "redirected")
+	a.Contains(buf.String(), "restored")
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Contains(string(data), "redirected")
+	a.NotContains(string(data), "restored")
+}
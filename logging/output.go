@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// OutputSyslog is the target of RedirectOutput sending the log to the system logger.
+const OutputSyslog = "syslog"
+
+// outputRedirection is a target the output is redirected to at runtime.
+type outputRedirection struct {
+	target string
+	writer io.WriteCloser
+}
+
+// RedirectOutput redirects the output to target: OutputSyslog, or the absolute path of a file the log is
+// appended to. An empty target restores the output set by SetOutput. The writer of the previous
+// redirection, if any, is closed.
+func (l logger) RedirectOutput(target string) error {
+	var redirection *outputRedirection
+	if target != "" {
+		var w io.WriteCloser
+		var err error
+		switch {
+		case target == OutputSyslog:
+			w, err = newSyslogWriter()
+		case filepath.IsAbs(target):
+			w, err = os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		default:
+			return fmt.Errorf("the log can only be redirected to %s or to an absolute path, got %q", OutputSyslog, target)
+		}
+		if err != nil {
+			return err
+		}
+		redirection = &outputRedirection{target: target, writer: w}
+	}
+
+	state := l.loggerState
+	state.outputMu.Lock()
+	defer state.outputMu.Unlock()
+	previous := state.redirection
+	state.redirection = redirection
+	if redirection != nil {
+		l.applyOutput(redirection.writer)
+	} else {
+		l.applyOutput(state.output)
+	}
+	// the logger no longer writes to the previous writer once its output is set
+	if previous != nil {
+		previous.writer.Close()
+	}
+	return nil
+}
+
+func (l logger) OutputRedirection() string {
+	state := l.loggerState
+	state.outputMu.Lock()
+	defer state.outputMu.Unlock()
+	if state.redirection == nil {
+		return ""
+	}
+	return state.redirection.target
+}
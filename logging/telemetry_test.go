@@ -164,6 +164,24 @@ func TestTelemetryHook(t *testing.T) {
 	a.Equal(buildMessage(testString1, testString2), entries[1])
 }
 
+func TestDisabledTelemetryCategories(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	f := makeTelemetryTestFixture(logrus.InfoLevel)
+	defer f.l.SetTelemetryCategoryEnabled(telemetryspec.Network, true)
+
+	f.l.SetTelemetryCategoryEnabled(telemetryspec.Network, false)
+	a.Equal([]telemetryspec.Category{telemetryspec.Network}, f.l.DisabledTelemetryCategories())
+	f.l.Event(telemetryspec.Network, testString2)
+	f.l.Event(telemetryspec.Agreement, testString2)
+	a.Equal([]string{buildMessage(string(telemetryspec.Agreement), testString2)}, f.hookEntries())
+
+	f.l.SetTelemetryCategoryEnabled(telemetryspec.Network, true)
+	a.Empty(f.l.DisabledTelemetryCategories())
+	f.l.Event(telemetryspec.Network, testString2)
+	a.Len(f.hookEntries(), 2)
+}
+
 func TestNilMetrics(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -39,3 +39,6 @@ const Network Category = "Network"
 
 // Transaction category
 const Transaction Category = "Transaction"
+
+// Categories lists all the categories.
+var Categories = []Category{ApplicationState, HostApplicationState, Agreement, Accounts, Network, Transaction}
@@ -366,3 +366,19 @@ type BalancesAccountVacuumEventDetails struct {
 	// AfterVacuumSpaceBytes is the number of bytes used by the database after running the vacuuming process.
 	AfterVacuumSpaceBytes uint64
 }
+
+// UpgradeDryRunDivergenceEvent event
+const UpgradeDryRunDivergenceEvent Event = "UpgradeDryRunDivergence"
+
+// UpgradeDryRunDivergenceEventDetails is generated when a block, evaluated under the consensus version
+// the network is upgrading to, is rejected or has different effects than under the current version.
+type UpgradeDryRunDivergenceEventDetails struct {
+	Round           uint64
+	CurrentProtocol string
+	NextProtocol    string
+	// Rejected is set when the block is not valid under the next version, Error holding the reason.
+	Rejected bool
+	Error    string `json:",omitempty"`
+	// Divergences lists the parts of the state delta which differ between the two versions.
+	Divergences []string `json:",omitempty"`
+}
@@ -60,6 +60,7 @@ type AssembleBlockStats struct {
 	StopReason                string
 	TotalLength               uint64
 	EarlyCommittedCount       uint64 // number of transaction groups that were pending on the transaction pool but have been included in previous block
+	DeferredCount             int    // number of transaction groups that were left pending on the transaction pool when the payset was closed
 	Nanoseconds               int64
 	ProcessingTime            transactionProcessingTimeDistribution
 	BlockGenerationDuration   uint64
@@ -95,6 +96,9 @@ const AssembleBlockPoolBehind = "pool-behind"
 // AssembleBlockEvalOld represents the assembled block that was returned being a round too old
 const AssembleBlockEvalOld = "eval-old"
 
+// AssembleBlockOverBudget represents AssembleBlock closing the payset early since the assembly budget was exhausted
+const AssembleBlockOverBudget = "over-budget"
+
 // AssembleBlockAbandon represents the block generation being abandoned since it won't be needed.
 const AssembleBlockAbandon = "block-abandon"
 
@@ -129,6 +133,7 @@ func (m AssembleBlockStats) String() string {
 	b.WriteString(fmt.Sprintf("StopReason:%s, ", m.StopReason))
 	b.WriteString(fmt.Sprintf("TotalLength:%d, ", m.TotalLength))
 	b.WriteString(fmt.Sprintf("EarlyCommittedCount:%d, ", m.EarlyCommittedCount))
+	b.WriteString(fmt.Sprintf("DeferredCount:%d, ", m.DeferredCount))
 	b.WriteString(fmt.Sprintf("Nanoseconds:%d, ", m.Nanoseconds))
 	b.WriteString(fmt.Sprintf("ProcessingTime:%v, ", m.ProcessingTime))
 	b.WriteString(fmt.Sprintf("BlockGenerationDuration:%d, ", m.BlockGenerationDuration))
@@ -156,7 +156,7 @@ func (t NetworkTemplate) createNodeDirectories(targetFolder string, binDir strin
 			return
 		}
 
-		if mergedCfg.EnableP2P {
+		if mergedCfg.FeatureRequested(config.FeatureP2PNetwork) {
 			// generate peer ID file for this node
 			sk, pkErr := p2p.GetPrivKey(config.Local{P2PPersistPeerID: true}, genesisDir)
 			if pkErr != nil {
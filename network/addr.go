@@ -90,6 +90,10 @@ func (wn *WebsocketNetwork) addrToGossipAddr(addr string) (string, error) {
 	if parsedURL.Scheme == "" {
 		parsedURL.Scheme = "ws"
 	}
+	if wn.gossipTLS != nil {
+		// with gossip TLS, every peer of the network serves the gossip endpoint over TLS only
+		parsedURL.Scheme = "wss"
+	}
 	parsedURL.Path = strings.Replace(path.Join(parsedURL.Path, GossipNetworkPath), "{genesisID}", wn.GenesisID, -1)
 	return parsedURL.String(), nil
 }
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// duplicateLatencyWindow is how long the first arrival of a message is remembered. Messages arriving again
+// after that are considered as arriving for the first time.
+const duplicateLatencyWindow = 20 * time.Second
+
+// ErrPeerDuplicateLatencyDisabled is returned when the duplicate latency of the peers is requested
+// while the configuration does not enable tracking it.
+var ErrPeerDuplicateLatencyDisabled = errors.New("peer duplicate latency tracking is disabled")
+
+var peerDuplicateLatency = metrics.MakeLabeledHistogram(metrics.NetworkPeerDuplicateLatency, metrics.DefaultLatencyBuckets)
+
+// duplicateLatencyTags are the tags of the messages whose arrival times are tracked.
+var duplicateLatencyTags = []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag}
+
+// PeerDuplicateLatency returns, for each peer and tracked message tag, the histogram of the time between
+// the first arrival of the messages and their arrival from the peer.
+func PeerDuplicateLatency() []metrics.HistogramSnapshot {
+	return peerDuplicateLatency.Snapshot()
+}
+
+// duplicateLatencyTracker remembers when the votes and proposals first arrived from any peer, and records
+// how long after that they arrive from each peer. The peer delivering a message first records no delay.
+type duplicateLatencyTracker struct {
+	deadlock.Mutex
+	window int64
+	// current and previous hold the first arrival time of the messages seen in the current and in the
+	// previous window, in nanoseconds since epoch.
+	current  map[crypto.Digest]int64
+	previous map[crypto.Digest]int64
+	// rotated is the time at which current started.
+	rotated int64
+}
+
+func makeDuplicateLatencyTracker(window time.Duration) *duplicateLatencyTracker {
+	return &duplicateLatencyTracker{
+		window:   int64(window),
+		current:  make(map[crypto.Digest]int64),
+		previous: make(map[crypto.Digest]int64),
+	}
+}
+
+func duplicateLatencyTag(tag protocol.Tag) bool {
+	return tag == protocol.AgreementVoteTag || tag == protocol.ProposalPayloadTag
+}
+
+// observe records the arrival of a message from the given peer at received, in nanoseconds since epoch.
+func (t *duplicateLatencyTracker) observe(tag protocol.Tag, data []byte, peer string, received int64) {
+	if !duplicateLatencyTag(tag) || len(data) == 0 {
+		return
+	}
+	digest := generateMessageDigest(tag, data)
+
+	t.Lock()
+	if received-t.rotated >= t.window {
+		t.previous = t.current
+		t.current = make(map[crypto.Digest]int64, len(t.previous))
+		t.rotated = received
+	}
+	first, has := t.current[digest]
+	if !has {
+		first, has = t.previous[digest]
+	}
+	if !has {
+		t.current[digest] = received
+		first = received
+	}
+	t.Unlock()
+
+	delay := received - first
+	if delay < 0 {
+		delay = 0
+	}
+	peerDuplicateLatency.ObserveDuration(time.Duration(delay), map[string]string{"peer": peer, "tag": string(tag)})
+}
+
+// removePeer drops the histograms of a disconnected peer.
+func (t *duplicateLatencyTracker) removePeer(peer string) {
+	for _, tag := range duplicateLatencyTags {
+		peerDuplicateLatency.Remove(map[string]string{"peer": peer, "tag": string(tag)})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+func findDuplicateLatency(peer string, tag protocol.Tag) (metrics.HistogramSnapshot, bool) {
+	for _, snapshot := range PeerDuplicateLatency() {
+		if snapshot.Labels["peer"] == peer && snapshot.Labels["tag"] == string(tag) {
+			return snapshot, true
+		}
+	}
+	return metrics.HistogramSnapshot{}, false
+}
+
+func TestDuplicateLatencyTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tracker := makeDuplicateLatencyTracker(time.Second)
+	first, second := t.Name()+"-first", t.Name()+"-second"
+	vote := []byte("vote")
+	start := time.Now().UnixNano()
+
+	tracker.observe(protocol.AgreementVoteTag, vote, first, start)
+	tracker.observe(protocol.AgreementVoteTag, vote, second, start+int64(30*time.Millisecond))
+	// messages of other tags are not tracked
+	tracker.observe(protocol.TxnTag, vote, second, start)
+
+	snapshot, ok := findDuplicateLatency(first, protocol.AgreementVoteTag)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), snapshot.Count)
+	require.Zero(t, snapshot.Sum)
+
+	snapshot, ok = findDuplicateLatency(second, protocol.AgreementVoteTag)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), snapshot.Count)
+	require.InDelta(t, 0.03, snapshot.Sum, 1e-9)
+	_, ok = findDuplicateLatency(second, protocol.TxnTag)
+	require.False(t, ok)
+
+	// the first arrival is remembered for at least one window, and at most two
+	tracker.observe(protocol.ProposalPayloadTag, []byte("other"), first, start+int64(1500*time.Millisecond))
+	tracker.observe(protocol.AgreementVoteTag, vote, first, start+int64(1600*time.Millisecond))
+	snapshot, _ = findDuplicateLatency(first, protocol.AgreementVoteTag)
+	require.InDelta(t, 1.6, snapshot.Sum, 1e-9)
+
+	tracker.observe(protocol.ProposalPayloadTag, []byte("other"), first, start+int64(2600*time.Millisecond))
+	tracker.observe(protocol.AgreementVoteTag, vote, second, start+int64(3700*time.Millisecond))
+	snapshot, _ = findDuplicateLatency(second, protocol.AgreementVoteTag)
+	require.Equal(t, uint64(2), snapshot.Count)
+	require.InDelta(t, 0.03, snapshot.Sum, 1e-9)
+
+	tracker.removePeer(first)
+	_, ok = findDuplicateLatency(first, protocol.AgreementVoteTag)
+	require.False(t, ok)
+	_, ok = findDuplicateLatency(first, protocol.ProposalPayloadTag)
+	require.False(t, ok)
+}
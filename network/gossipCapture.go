@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkCapturedMessages = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_captured_messages_total", Description: "Number of inbound gossip messages written to the capture files"})
+
+// captureFileMagic starts every gossip capture file.
+const captureFileMagic = "algogcap1"
+
+// captureFileExt is the extension of the gossip capture files.
+const captureFileExt = ".gcap"
+
+// captureRecordHeaderSize is the size of the fixed part of a capture record: the reception time in
+// nanoseconds since the epoch, the two bytes of the tag and the length of the message data.
+const captureRecordHeaderSize = 8 + 2 + 4
+
+// CapturedMessage is an inbound gossip message read from a capture file.
+type CapturedMessage struct {
+	Tag      protocol.Tag
+	Data     []byte
+	Received int64
+}
+
+// gossipCapture records the inbound gossip messages of a node to disk, in one series of files per
+// tag. Each series is rotated once its current file reaches the maximum size, and only the most
+// recent files of each series are kept.
+type gossipCapture struct {
+	mu           deadlock.Mutex
+	dir          string
+	tags         map[protocol.Tag]bool
+	maxFileBytes uint64
+	maxFiles     int
+	log          logging.Logger
+
+	files map[protocol.Tag]*captureFile
+}
+
+type captureFile struct {
+	f    *os.File
+	w    *bufio.Writer
+	seq  int
+	size uint64
+}
+
+// makeGossipCapture returns the capture configured by cfg, or nil if capturing is not enabled.
+func makeGossipCapture(cfg config.Local, log logging.Logger) *gossipCapture {
+	if cfg.GossipCaptureDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(cfg.GossipCaptureDir, 0700); err != nil {
+		log.Warnf("unable to create the gossip capture directory, not capturing: %v", err)
+		return nil
+	}
+	gc := &gossipCapture{
+		dir:          cfg.GossipCaptureDir,
+		maxFileBytes: cfg.GossipCaptureMaxFileSize,
+		maxFiles:     int(cfg.GossipCaptureMaxFiles),
+		log:          log,
+		files:        make(map[protocol.Tag]*captureFile),
+	}
+	if gc.maxFiles < 1 {
+		gc.maxFiles = 1
+	}
+	if cfg.GossipCaptureTags != "" {
+		gc.tags = make(map[protocol.Tag]bool)
+		for _, tag := range strings.Split(cfg.GossipCaptureTags, ",") {
+			gc.tags[protocol.Tag(strings.TrimSpace(tag))] = true
+		}
+	}
+	log.Infof("capturing inbound gossip messages to %s", gc.dir)
+	return gc
+}
+
+// captureFileSeq returns the sequence number of a capture file of the given tag, or false if the
+// name is not the one of such a file.
+func captureFileSeq(name string, tag protocol.Tag) (int, bool) {
+	prefix := string(tag) + "-"
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, captureFileExt) {
+		return 0, false
+	}
+	seq, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, prefix), captureFileExt))
+	return seq, err == nil
+}
+
+// existingSeqs returns the sequence numbers of the capture files of tag in the capture directory, in order.
+func (gc *gossipCapture) existingSeqs(tag protocol.Tag) []int {
+	entries, err := os.ReadDir(gc.dir)
+	if err != nil {
+		return nil
+	}
+	var seqs []int
+	for _, entry := range entries {
+		if seq, ok := captureFileSeq(entry.Name(), tag); ok {
+			seqs = append(seqs, seq)
+		}
+	}
+	sort.Ints(seqs)
+	return seqs
+}
+
+func (gc *gossipCapture) fileName(tag protocol.Tag, seq int) string {
+	return filepath.Join(gc.dir, fmt.Sprintf("%s-%06d%s", tag, seq, captureFileExt))
+}
+
+// open starts a new capture file for tag, after the existing ones, and removes the oldest files
+// beyond the retention limit. The caller must hold gc.mu.
+func (gc *gossipCapture) open(tag protocol.Tag, seq int) (*captureFile, error) {
+	f, err := os.OpenFile(gc.fileName(tag, seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	cf := &captureFile{f: f, w: bufio.NewWriter(f), seq: seq}
+	n, err := cf.w.WriteString(captureFileMagic)
+	cf.size += uint64(n)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	seqs := gc.existingSeqs(tag)
+	for len(seqs) > gc.maxFiles {
+		if err := os.Remove(gc.fileName(tag, seqs[0])); err != nil {
+			gc.log.Warnf("unable to remove an old gossip capture file: %v", err)
+		}
+		seqs = seqs[1:]
+	}
+	return cf, nil
+}
+
+func (cf *captureFile) close() error {
+	err := cf.w.Flush()
+	if cerr := cf.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// record writes msg to the capture file of its tag, if its tag is captured.
+func (gc *gossipCapture) record(msg IncomingMessage) {
+	if gc.tags != nil && !gc.tags[msg.Tag] {
+		return
+	}
+	if len(msg.Tag) != 2 {
+		return
+	}
+	recordSize := uint64(captureRecordHeaderSize + len(msg.Data))
+
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	var err error
+	cf := gc.files[msg.Tag]
+	if cf != nil && cf.size+recordSize > gc.maxFileBytes && cf.size > uint64(len(captureFileMagic)) {
+		if err = cf.close(); err != nil {
+			gc.log.Warnf("unable to close a gossip capture file: %v", err)
+		}
+		cf, err = gc.open(msg.Tag, cf.seq+1)
+		gc.files[msg.Tag] = cf
+	} else if cf == nil {
+		seq := 0
+		if seqs := gc.existingSeqs(msg.Tag); len(seqs) > 0 {
+			seq = seqs[len(seqs)-1] + 1
+		}
+		cf, err = gc.open(msg.Tag, seq)
+		gc.files[msg.Tag] = cf
+	}
+	if err != nil {
+		delete(gc.files, msg.Tag)
+		gc.log.Warnf("unable to open a gossip capture file: %v", err)
+		return
+	}
+
+	var header [captureRecordHeaderSize]byte
+	binary.BigEndian.PutUint64(header[0:8], uint64(msg.Received))
+	copy(header[8:10], msg.Tag)
+	binary.BigEndian.PutUint32(header[10:14], uint32(len(msg.Data)))
+	if _, err = cf.w.Write(header[:]); err == nil {
+		_, err = cf.w.Write(msg.Data)
+	}
+	if err != nil {
+		gc.log.Warnf("unable to write to a gossip capture file: %v", err)
+		return
+	}
+	cf.size += recordSize
+	networkCapturedMessages.Inc(nil)
+}
+
+// close flushes and closes the capture files.
+func (gc *gossipCapture) close() {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	for tag, cf := range gc.files {
+		if err := cf.close(); err != nil {
+			gc.log.Warnf("unable to close a gossip capture file: %v", err)
+		}
+		delete(gc.files, tag)
+	}
+}
+
+// CaptureReader reads the messages of a gossip capture file.
+type CaptureReader struct {
+	r *bufio.Reader
+}
+
+// MakeCaptureReader checks that r holds a gossip capture, and returns a reader of its messages.
+func MakeCaptureReader(r io.Reader) (*CaptureReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(captureFileMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != captureFileMagic {
+		return nil, errors.New("not a gossip capture file")
+	}
+	return &CaptureReader{r: br}, nil
+}
+
+// Next returns the next message of the capture, or io.EOF once all of them have been read.
+// A capture file which was not closed properly may end with a truncated message, which is
+// reported as io.ErrUnexpectedEOF.
+func (cr *CaptureReader) Next() (CapturedMessage, error) {
+	var header [captureRecordHeaderSize]byte
+	if _, err := io.ReadFull(cr.r, header[:]); err != nil {
+		return CapturedMessage{}, err
+	}
+	dataLen := binary.BigEndian.Uint32(header[10:14])
+	if dataLen > MaxMessageLength {
+		return CapturedMessage{}, fmt.Errorf("captured message of %d bytes is larger than the %d bytes limit", dataLen, MaxMessageLength)
+	}
+	msg := CapturedMessage{
+		Received: int64(binary.BigEndian.Uint64(header[0:8])),
+		Tag:      protocol.Tag(header[8:10]),
+		Data:     make([]byte, dataLen),
+	}
+	if _, err := io.ReadFull(cr.r, msg.Data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return CapturedMessage{}, err
+	}
+	return msg, nil
+}
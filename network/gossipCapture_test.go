@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func readCaptureFile(t *testing.T, path string) []CapturedMessage {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	cr, err := MakeCaptureReader(f)
+	require.NoError(t, err)
+	var msgs []CapturedMessage
+	for {
+		msg, err := cr.Next()
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestGossipCapture(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	require.Nil(t, makeGossipCapture(cfg, logging.TestingLog(t)))
+
+	cfg.GossipCaptureDir = filepath.Join(t.TempDir(), "capture")
+	cfg.GossipCaptureTags = "TX, AV"
+	cfg.GossipCaptureMaxFileSize = uint64(len(captureFileMagic) + 2*(captureRecordHeaderSize+10))
+	cfg.GossipCaptureMaxFiles = 2
+	gc := makeGossipCapture(cfg, logging.TestingLog(t))
+	require.NotNil(t, gc)
+
+	for i := 0; i < 7; i++ {
+		gc.record(IncomingMessage{Tag: protocol.TxnTag, Data: []byte{byte(i), 1, 2, 3, 4, 5, 6, 7, 8, 9}, Received: int64(i)})
+	}
+	gc.record(IncomingMessage{Tag: protocol.AgreementVoteTag, Data: []byte("vote"), Received: 100})
+	gc.record(IncomingMessage{Tag: protocol.ProposalPayloadTag, Data: []byte("proposal"), Received: 101})
+	gc.close()
+
+	// the transactions were written two per file, and only the last two files are kept
+	entries, err := os.ReadDir(cfg.GossipCaptureDir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.Equal(t, []string{"AV-000000.gcap", "TX-000002.gcap", "TX-000003.gcap"}, names)
+
+	msgs := readCaptureFile(t, filepath.Join(cfg.GossipCaptureDir, "TX-000002.gcap"))
+	require.Len(t, msgs, 2)
+	require.Equal(t, CapturedMessage{Tag: protocol.TxnTag, Data: []byte{4, 1, 2, 3, 4, 5, 6, 7, 8, 9}, Received: 4}, msgs[0])
+	require.Len(t, readCaptureFile(t, filepath.Join(cfg.GossipCaptureDir, "TX-000003.gcap")), 1)
+	require.Equal(t, []CapturedMessage{{Tag: protocol.AgreementVoteTag, Data: []byte("vote"), Received: 100}},
+		readCaptureFile(t, filepath.Join(cfg.GossipCaptureDir, "AV-000000.gcap")))
+
+	// capturing again continues the existing series
+	gc = makeGossipCapture(cfg, logging.TestingLog(t))
+	gc.record(IncomingMessage{Tag: protocol.TxnTag, Data: []byte("again"), Received: 200})
+	gc.close()
+	_, err = os.Stat(filepath.Join(cfg.GossipCaptureDir, "TX-000004.gcap"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(cfg.GossipCaptureDir, "TX-000002.gcap"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCaptureReaderTruncated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.GossipCaptureDir = t.TempDir()
+	gc := makeGossipCapture(cfg, logging.TestingLog(t))
+	gc.record(IncomingMessage{Tag: protocol.TxnTag, Data: []byte("transaction"), Received: 1})
+	gc.close()
+
+	path := filepath.Join(cfg.GossipCaptureDir, "TX-000000.gcap")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	cr, err := MakeCaptureReader(f)
+	require.NoError(t, err)
+	_, err = cr.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = MakeCaptureReader(f)
+	require.Error(t, err)
+}
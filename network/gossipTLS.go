@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+)
+
+// gossipTLS.go implements the optional mutual TLS of the websocket gossip connections of private
+// networks. Every node identifies itself with the certificate of GossipTLSCertFile, both when
+// serving the gossip endpoint and when connecting to its peers, and accepts only the peers whose
+// certificate is issued by GossipTLSCAFile and, when GossipTLSPinnedPeers is set, is pinned there.
+
+var errGossipTLSCertOrKeyMissing = errors.New("both GossipTLSCertFile and GossipTLSKeyFile must be set to enable gossip TLS")
+var errGossipTLSNoPeerAuthentication = errors.New("gossip TLS requires GossipTLSCAFile or GossipTLSPinnedPeers to authenticate the peers")
+var errGossipTLSPeerNotPinned = errors.New("peer certificate is not pinned in GossipTLSPinnedPeers")
+
+// gossipTLSConfig holds the TLS configurations of the two ends of the gossip connections.
+type gossipTLSConfig struct {
+	server *tls.Config
+	client *tls.Config
+}
+
+// makeGossipTLSConfig loads the gossip TLS configuration out of the node configuration.
+// It returns nil if gossip TLS is not enabled.
+func makeGossipTLSConfig(cfg config.Local) (*gossipTLSConfig, error) {
+	if cfg.GossipTLSCertFile == "" && cfg.GossipTLSKeyFile == "" {
+		return nil, nil
+	}
+	if cfg.GossipTLSCertFile == "" || cfg.GossipTLSKeyFile == "" {
+		return nil, errGossipTLSCertOrKeyMissing
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.GossipTLSCertFile, cfg.GossipTLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the gossip TLS certificate: %w", err)
+	}
+
+	pins, err := parsePinnedPeers(cfg.GossipTLSPinnedPeers)
+	if err != nil {
+		return nil, err
+	}
+	var roots *x509.CertPool
+	if cfg.GossipTLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.GossipTLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the gossip TLS certificate authorities: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in GossipTLSCAFile %s", cfg.GossipTLSCAFile)
+		}
+	} else if len(pins) == 0 {
+		return nil, errGossipTLSNoPeerAuthentication
+	}
+
+	verifyPinned := func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(pins) == 0 {
+			return nil
+		}
+		if len(rawCerts) == 0 {
+			return errGossipTLSPeerNotPinned
+		}
+		if _, ok := pins[sha256.Sum256(rawCerts[0])]; !ok {
+			return errGossipTLSPeerNotPinned
+		}
+		return nil
+	}
+
+	server := &tls.Config{
+		MinVersion:            tls.VersionTLS12,
+		Certificates:          []tls.Certificate{cert},
+		ClientAuth:            tls.RequireAndVerifyClientCert,
+		ClientCAs:             roots,
+		VerifyPeerCertificate: verifyPinned,
+	}
+	client := &tls.Config{
+		MinVersion:            tls.VersionTLS12,
+		Certificates:          []tls.Certificate{cert},
+		RootCAs:               roots,
+		VerifyPeerCertificate: verifyPinned,
+	}
+	if roots == nil {
+		// Without certificate authorities, the pinned fingerprints are the only authentication of the
+		// peers, which makes self-signed node certificates usable.
+		server.ClientAuth = tls.RequireAnyClientCert
+		client.InsecureSkipVerify = true
+	}
+	return &gossipTLSConfig{server: server, client: client}, nil
+}
+
+// parsePinnedPeers parses the comma delimited list of certificate fingerprints of GossipTLSPinnedPeers.
+// The fingerprints may have their bytes separated by colons, as printed by openssl.
+func parsePinnedPeers(pinnedPeers string) (map[[sha256.Size]byte]struct{}, error) {
+	pins := make(map[[sha256.Size]byte]struct{})
+	for _, entry := range strings.Split(pinnedPeers, ",") {
+		entry = strings.ReplaceAll(strings.TrimSpace(entry), ":", "")
+		if entry == "" {
+			continue
+		}
+		var fingerprint [sha256.Size]byte
+		decoded, err := hex.DecodeString(entry)
+		if err != nil || len(decoded) != len(fingerprint) {
+			return nil, fmt.Errorf("invalid certificate fingerprint %#v in GossipTLSPinnedPeers", entry)
+		}
+		copy(fingerprint[:], decoded)
+		pins[fingerprint] = struct{}{}
+	}
+	return pins, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// option to enable gossip TLS on a test node
+type testGossipTLSOption struct{ gossipTLS *gossipTLSConfig }
+
+func (o testGossipTLSOption) applyOpt(wn *WebsocketNetwork) {
+	wn.gossipTLS = o.gossipTLS
+}
+
+// testCertificate creates a certificate for 127.0.0.1, signed by parent or self-signed if parent is nil,
+// and writes it and its key to PEM files in dir.
+func testCertificate(t *testing.T, dir, name string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (cert *x509.Certificate, key *ecdsa.PrivateKey, certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return cert, key, certFile, keyFile
+}
+
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestGossipTLSConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca, caKey, caFile, _ := testCertificate(t, dir, "ca", true, nil, nil)
+	node, _, certFile, keyFile := testCertificate(t, dir, "node", false, ca, caKey)
+
+	cfg := defaultConfig
+	gossipTLS, err := makeGossipTLSConfig(cfg)
+	require.NoError(t, err)
+	require.Nil(t, gossipTLS)
+
+	cfg.GossipTLSCertFile = certFile
+	_, err = makeGossipTLSConfig(cfg)
+	require.ErrorIs(t, err, errGossipTLSCertOrKeyMissing)
+
+	cfg.GossipTLSKeyFile = keyFile
+	_, err = makeGossipTLSConfig(cfg)
+	require.ErrorIs(t, err, errGossipTLSNoPeerAuthentication)
+
+	cfg.GossipTLSPinnedPeers = "00ff"
+	_, err = makeGossipTLSConfig(cfg)
+	require.ErrorContains(t, err, "invalid certificate fingerprint")
+
+	// fingerprints printed by openssl are accepted as well
+	printed := strings.ToUpper(fingerprint(node))
+	var colons []string
+	for i := 0; i < len(printed); i += 2 {
+		colons = append(colons, printed[i:i+2])
+	}
+	cfg.GossipTLSPinnedPeers = " " + strings.Join(colons, ":") + " ,"
+	gossipTLS, err = makeGossipTLSConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAnyClientCert, gossipTLS.server.ClientAuth)
+	require.True(t, gossipTLS.client.InsecureSkipVerify)
+	require.NoError(t, gossipTLS.client.VerifyPeerCertificate([][]byte{node.Raw}, nil))
+	require.ErrorIs(t, gossipTLS.client.VerifyPeerCertificate([][]byte{ca.Raw}, nil), errGossipTLSPeerNotPinned)
+
+	cfg.GossipTLSPinnedPeers = ""
+	cfg.GossipTLSCAFile = caFile
+	gossipTLS, err = makeGossipTLSConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, gossipTLS.server.ClientAuth)
+	require.False(t, gossipTLS.client.InsecureSkipVerify)
+	require.NotNil(t, gossipTLS.client.RootCAs)
+}
+
+// TestGossipTLSConnect checks that two nodes with certificates of the same
+// authority gossip over TLS, and that a peer which is not pinned is rejected.
+func TestGossipTLSConnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	ca, caKey, caFile, _ := testCertificate(t, dir, "ca", true, nil, nil)
+	nodeA, _, certFileA, keyFileA := testCertificate(t, dir, "nodeA", false, ca, caKey)
+	_, _, certFileB, keyFileB := testCertificate(t, dir, "nodeB", false, ca, caKey)
+
+	cfgA := defaultConfig
+	cfgA.GossipFanout = 1
+	cfgA.GossipTLSCAFile = caFile
+	cfgA.GossipTLSCertFile = certFileA
+	cfgA.GossipTLSKeyFile = keyFileA
+	gossipTLSA, err := makeGossipTLSConfig(cfgA)
+	require.NoError(t, err)
+	cfgB := cfgA
+	cfgB.GossipTLSCertFile = certFileB
+	cfgB.GossipTLSKeyFile = keyFileB
+	cfgB.GossipTLSPinnedPeers = fingerprint(nodeA)
+	gossipTLSB, err := makeGossipTLSConfig(cfgB)
+	require.NoError(t, err)
+
+	netA := makeTestWebsocketNodeWithConfig(t, cfgA, testGossipTLSOption{gossipTLSA})
+	netA.Start()
+	defer netStop(t, netA, "A")
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	require.True(t, strings.HasPrefix(addrA, "https://"))
+
+	// B is given the plain host:port of A, and still connects over TLS
+	parsedA, err := url.Parse(addrA)
+	require.NoError(t, err)
+	netB := makeTestWebsocketNodeWithConfig(t, cfgB, testGossipTLSOption{gossipTLSB})
+	netB.phonebook.ReplacePeerList([]string{parsedA.Host}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	counter := newMessageCounter(t, 1)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	select {
+	case <-counter.done:
+	case <-time.After(2 * time.Second):
+		t.Errorf("timeout, count=%d, wanted 1", counter.count)
+	}
+
+	// a client without a certificate is refused by A; with TLS 1.3 the refusal is only seen on the first read
+	conn, err := tls.Dial("tcp", parsedA.Host, &tls.Config{InsecureSkipVerify: true})
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	require.Error(t, err)
+
+	// A is refused by a client pinning another certificate
+	cfgB.GossipTLSPinnedPeers = fingerprint(ca)
+	gossipTLSB, err = makeGossipTLSConfig(cfgB)
+	require.NoError(t, err)
+	_, err = tls.Dial("tcp", parsedA.Host, gossipTLSB.client)
+	require.ErrorIs(t, err, errGossipTLSPeerNotPinned)
+}
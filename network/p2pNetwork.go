@@ -61,6 +61,9 @@ type P2PNetwork struct {
 	wsPeersLock                    deadlock.RWMutex
 	wsPeersChangeCounter           int32
 	wsPeersConnectivityCheckTicker *time.Ticker
+
+	// outboundTransport applies the outbound http policy to the http requests sent to the peers
+	outboundTransport http.RoundTripper
 }
 
 type p2pPeerStats struct {
@@ -90,12 +93,14 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		wsPeers:   make(map[peer.ID]*wsPeer),
 		peerStats: make(map[peer.ID]*p2pPeerStats),
 	}
+	net.outboundTransport = makeOutboundHTTPTransport(http.DefaultTransport, cfg)
 	net.ctx, net.ctxCancel = context.WithCancel(context.Background())
 	net.handler = msgHandler{
 		ctx:        net.ctx,
 		log:        log,
 		config:     cfg,
 		readBuffer: make(chan IncomingMessage, readBufferLen),
+		capture:    makeGossipCapture(cfg, log),
 	}
 	net.broadcaster = msgBroadcaster{
 		ctx:                    net.ctx,
@@ -158,6 +163,9 @@ func (n *P2PNetwork) Stop() {
 	n.ctxCancel()
 	n.service.Close()
 	n.wg.Wait()
+	if n.handler.capture != nil {
+		n.handler.capture.close()
+	}
 }
 
 func (n *P2PNetwork) meshThread() {
@@ -271,7 +279,7 @@ func (n *P2PNetwork) ClearHandlers() {
 
 // GetRoundTripper returns a Transport that would limit the number of outgoing connections.
 func (n *P2PNetwork) GetRoundTripper() http.RoundTripper {
-	return http.DefaultTransport
+	return n.outboundTransport
 }
 
 // OnNetworkAdvance notifies the network library that the agreement protocol was able to make a notable progress.
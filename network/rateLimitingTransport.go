@@ -21,7 +21,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/httpclient"
 )
 
 // rateLimitingTransport is the transport for execute a single HTTP transaction, obtaining the Response for a given Request.
@@ -29,6 +31,8 @@ type rateLimitingTransport struct {
 	phonebook       Phonebook
 	innerTransport  *http.Transport
 	queueingTimeout time.Duration
+	// forceTLS upgrades the http requests to https, for networks whose peers serve over TLS only
+	forceTLS bool
 }
 
 // ErrConnectionQueueingTimeout indicates that we've exceeded the time allocated for
@@ -72,7 +76,17 @@ func (r *rateLimitingTransport) RoundTrip(req *http.Request) (res *http.Response
 		}
 		return nil, ErrConnectionQueueingTimeout
 	}
+	if r.forceTLS && req.URL.Scheme == "http" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = "https"
+	}
 	res, err = r.innerTransport.RoundTrip(req)
 	r.phonebook.UpdateConnectionTime(req.Host, provisionalTime)
 	return
 }
+
+// makeOutboundHTTPTransport wraps the given transport with the retry and circuit breaking policy
+// configured for the outbound http requests of the node.
+func makeOutboundHTTPTransport(inner http.RoundTripper, cfg config.Local) http.RoundTripper {
+	return httpclient.MakeTransport(inner, httpclient.PolicyFromConfig(cfg))
+}
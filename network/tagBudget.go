@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkSentThrottledMicrosTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_sent_throttled_micros_total", Description: "Total microseconds outgoing messages were held back by the bandwidth budget of their tag"})
+var networkSentThrottledDroppedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_sent_throttled_dropped_total", Description: "Number of outgoing messages dropped after being held back by the bandwidth budget of their tag for too long"})
+
+// parseTagBudgets parses a comma-separated list of tag:bytes-per-second pairs, such as "TX:2000000,TS:8000000".
+// The votes and proposals are always sent first and cannot be given a budget.
+func parseTagBudgets(spec string) (map[protocol.Tag]uint64, error) {
+	budgets := make(map[protocol.Tag]uint64)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("bandwidth budget %q is not of the form tag:bytes-per-second", entry)
+		}
+		tag := protocol.Tag(strings.TrimSpace(name))
+		if len(tag) != 2 {
+			return nil, fmt.Errorf("bandwidth budget %q does not name a message tag", entry)
+		}
+		if highPriorityTag([]protocol.Tag{tag}) {
+			return nil, fmt.Errorf("the messages of tag %s are sent first and cannot have a bandwidth budget", tag)
+		}
+		rate, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil || rate == 0 {
+			return nil, fmt.Errorf("bandwidth budget of tag %s is not a positive number of bytes per second: %q", tag, value)
+		}
+		budgets[tag] = rate
+	}
+	return budgets, nil
+}
+
+// maxHeldMessagesPerTag is how many messages of a tag may be held back by its budget at once. The messages
+// queued beyond it are dropped.
+const maxHeldMessagesPerTag = 1024
+
+// tagBudget is a token bucket limiting the rate at which the messages of a tag are sent to a peer. It
+// holds up to one second worth of bytes, and is only used by the write loop of its peer.
+type tagBudget struct {
+	rate   float64 // bytes per second
+	tokens float64
+	last   time.Time
+
+	// held are the messages of the tag waiting for the budget, in the order they were queued. They are
+	// set aside so that the write loop keeps sending the messages of the other tags meanwhile.
+	held []heldMessage
+}
+
+// heldMessage is a message held back by the budget of its tag since the given time.
+type heldMessage struct {
+	msg  sendMessage
+	held time.Time
+}
+
+func makeTagBudgets(rates map[protocol.Tag]uint64, now time.Time) map[protocol.Tag]*tagBudget {
+	if len(rates) == 0 {
+		return nil
+	}
+	budgets := make(map[protocol.Tag]*tagBudget, len(rates))
+	for tag, rate := range rates {
+		budgets[tag] = &tagBudget{rate: float64(rate), tokens: float64(rate), last: now}
+	}
+	return budgets
+}
+
+// delay returns how long the sending of a message of the given size has to be held back. When it returns
+// zero, the message is sent and its size is taken out of the budget. A message larger than the bucket is
+// let through once the bucket is full, bringing the budget in debt.
+func (b *tagBudget) delay(size int, now time.Time) time.Duration {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += b.rate * elapsed.Seconds()
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+		b.last = now
+	}
+	needed := float64(size)
+	if needed > b.rate {
+		needed = b.rate
+	}
+	if b.tokens >= needed {
+		b.tokens -= float64(size)
+		return 0
+	}
+	return time.Duration((needed-b.tokens)/b.rate*float64(time.Second)) + 1
+}
+
+// admit tells whether a message of the given size may be sent right away, taking its size out of the
+// budget if so. It may not when messages of the tag are already held back, to keep them in order.
+func (b *tagBudget) admit(size int, now time.Time) bool {
+	return len(b.held) == 0 && b.delay(size, now) == 0
+}
+
+// hold sets a message aside until the budget allows it to be sent. It returns false, dropping the
+// message, when too many messages of the tag are held back already.
+func (b *tagBudget) hold(msg sendMessage, now time.Time) bool {
+	if len(b.held) >= maxHeldMessagesPerTag {
+		networkSentThrottledDroppedTotal.Inc(nil)
+		return false
+	}
+	b.held = append(b.held, heldMessage{msg: msg, held: now})
+	return true
+}
+
+// release returns the held messages which the budget allows to be sent by now, in order, dropping those
+// which were queued more than maxMessageQueueDuration ago. It also returns how long to wait before the
+// next held message may be sent, or zero if no message is held back.
+func (b *tagBudget) release(now time.Time) (msgs []sendMessage, wait time.Duration) {
+	for len(b.held) > 0 {
+		h := b.held[0]
+		if now.Sub(h.msg.enqueued) > maxMessageQueueDuration {
+			networkSentThrottledDroppedTotal.Inc(nil)
+		} else if wait = b.delay(len(h.msg.data), now); wait > 0 {
+			break
+		} else {
+			networkSentThrottledMicrosTotal.AddUint64(uint64(now.Sub(h.held).Microseconds()), nil)
+			msgs = append(msgs, h.msg)
+		}
+		b.held[0] = heldMessage{}
+		b.held = b.held[1:]
+	}
+	if len(b.held) == 0 {
+		b.held = nil
+	}
+	return msgs, wait
+}
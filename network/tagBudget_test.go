@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseTagBudgets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	budgets, err := parseTagBudgets(" TX:2000000, TS:8000000,")
+	require.NoError(t, err)
+	require.Equal(t, map[protocol.Tag]uint64{protocol.TxnTag: 2000000, protocol.TopicMsgRespTag: 8000000}, budgets)
+
+	budgets, err = parseTagBudgets("")
+	require.NoError(t, err)
+	require.Empty(t, budgets)
+
+	for _, spec := range []string{"TX", "TXN:1000", "TX:0", "TX:-1", "TX:fast", "AV:1000", "PP:1000"} {
+		_, err = parseTagBudgets(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestTagBudgetDelay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	budget := makeTagBudgets(map[protocol.Tag]uint64{protocol.TxnTag: 1000}, now)[protocol.TxnTag]
+
+	// the bucket starts full
+	require.Zero(t, budget.delay(600, now))
+	require.Equal(t, 200*time.Millisecond, budget.delay(600, now).Round(time.Millisecond))
+	now = now.Add(200 * time.Millisecond)
+	require.Zero(t, budget.delay(600, now))
+
+	// messages larger than the bucket wait for it to be full, and then bring the budget in debt
+	require.Equal(t, time.Second, budget.delay(5000, now).Round(time.Millisecond))
+	now = now.Add(time.Second)
+	require.Zero(t, budget.delay(5000, now))
+	require.Equal(t, 4600*time.Millisecond, budget.delay(600, now).Round(time.Millisecond))
+
+	// the bucket holds no more than one second worth of bytes
+	now = now.Add(time.Hour)
+	require.Zero(t, budget.delay(1000, now))
+	require.NotZero(t, budget.delay(1, now))
+}
+
+func makeTestWritingPeer(t *testing.T, conn *countingConn) *wsPeer {
+	return &wsPeer{
+		wsPeerCore:         wsPeerCore{log: logging.TestingLog(t)},
+		conn:               conn,
+		sendMessageTag:     defaultSendMessageTags,
+		closing:            make(chan struct{}),
+		sendBufferHighPrio: make(chan sendMessages, 10),
+	}
+}
+
+func makeTestSendMessages(tags ...protocol.Tag) sendMessages {
+	var msgs sendMessages
+	for _, tag := range tags {
+		data := append([]byte(tag), make([]byte, 1000)...)
+		msgs.msgs = append(msgs.msgs, sendMessage{data: data, enqueued: time.Now(), peerEnqueued: time.Now(), ctx: context.Background()})
+	}
+	return msgs
+}
+
+func TestWriteLoopPreemptsBulk(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	conn := &countingConn{}
+	wp := makeTestWritingPeer(t, conn)
+
+	// the votes queued while a bulk batch is being sent are sent before the rest of the batch
+	wp.sendBufferHighPrio <- makeTestSendMessages(protocol.AgreementVoteTag)
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSend(makeTestSendMessages(protocol.TxnTag, protocol.TxnTag, protocol.TxnTag), true))
+	require.Equal(t, []protocol.Tag{protocol.TxnTag, protocol.AgreementVoteTag, protocol.TxnTag, protocol.TxnTag}, conn.written)
+
+	// high priority batches are never preempted
+	conn.written = nil
+	wp.sendBufferHighPrio <- makeTestSendMessages(protocol.ProposalPayloadTag)
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSend(makeTestSendMessages(protocol.AgreementVoteTag, protocol.AgreementVoteTag), false))
+	require.Equal(t, []protocol.Tag{protocol.AgreementVoteTag, protocol.AgreementVoteTag}, conn.written)
+	require.Len(t, wp.sendBufferHighPrio, 1)
+}
+
+func TestWriteLoopTagBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	conn := &countingConn{}
+	wp := makeTestWritingPeer(t, conn)
+	wp.tagBudgets = makeTagBudgets(map[protocol.Tag]uint64{protocol.TxnTag: 100000}, time.Now())
+	budget := wp.tagBudgets[protocol.TxnTag]
+	budget.tokens = 0
+
+	// the transactions exceeding the budget are set aside while the messages of the other tags are sent
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSend(makeTestSendMessages(protocol.TxnTag, protocol.MsgOfInterestTag, protocol.TxnTag), true))
+	require.Equal(t, []protocol.Tag{protocol.MsgOfInterestTag}, conn.written)
+	require.Len(t, budget.held, 2)
+
+	// they are sent in order once the budget allows them
+	wait, err := wp.writeLoopSendHeld(time.Now())
+	require.Equal(t, disconnectReasonNone, err)
+	require.Greater(t, wait, time.Duration(0))
+	require.Equal(t, []protocol.Tag{protocol.MsgOfInterestTag}, conn.written)
+	wait, err = wp.writeLoopSendHeld(time.Now().Add(time.Second))
+	require.Equal(t, disconnectReasonNone, err)
+	require.Zero(t, wait)
+	require.Equal(t, []protocol.Tag{protocol.MsgOfInterestTag, protocol.TxnTag, protocol.TxnTag}, conn.written)
+	require.Empty(t, budget.held)
+
+	// held messages which waited for too long are dropped without disconnecting the peer
+	conn.written = nil
+	budget.tokens = 0
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(makeTestSendMessages(protocol.TxnTag).msgs[0]))
+	wait, err = wp.writeLoopSendHeld(time.Now().Add(maxMessageQueueDuration + time.Second))
+	require.Equal(t, disconnectReasonNone, err)
+	require.Zero(t, wait)
+	require.Empty(t, conn.written)
+	require.Empty(t, budget.held)
+
+	// stale messages still disconnect the peer, whatever their tag
+	msg := makeTestSendMessages(protocol.TxnTag).msgs[0]
+	msg.enqueued = time.Now().Add(-2 * maxMessageQueueDuration)
+	require.Equal(t, disconnectStaleWrite, wp.writeLoopSendMsg(msg))
+
+	// no more than maxHeldMessagesPerTag messages are held back
+	budget.tokens = -1e9
+	for i := 0; i < maxHeldMessagesPerTag+10; i++ {
+		require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(makeTestSendMessages(protocol.TxnTag).msgs[0]))
+	}
+	require.Len(t, budget.held, maxHeldMessagesPerTag)
+	require.Empty(t, conn.written)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/bloom"
+)
+
+// Peers supporting PeerFeatureTxnFilter periodically send each other a bloom filter of the
+// transaction messages they received recently (tag TxnFilterTag). A node does not relay a
+// transaction message to a peer whose latest filter contains it, which saves the egress of
+// sending back transactions the peer already has. False positives only delay a transaction
+// to that peer until another neighbor relays it, and every filter uses a new random prefix
+// so the same message is not repeatedly filtered out.
+
+// MaxTxnFilterEntries is the maximum number of transaction messages in a filter sent to peers.
+const MaxTxnFilterEntries = 100000
+
+// TxnFilterFalsePositiveRate is the false positive rate of the filters sent to peers.
+const TxnFilterFalsePositiveRate = 0.01
+
+// txnFilterMaxAge is how long a filter received from a peer is honored, in case the peer
+// stops sending newer ones.
+const txnFilterMaxAge = time.Minute
+
+var errTxnFilterSize = errors.New("transaction filter exceeds the maximum size")
+
+// TxnFilterMaxSize returns the maximum size of an encoded transaction filter.
+func TxnFilterMaxSize() int {
+	return int(bloom.BinaryMarshalLength(MaxTxnFilterEntries, TxnFilterFalsePositiveRate))
+}
+
+// TxnFilterKey returns the key identifying the transaction message of the given payload in
+// the transaction filters exchanged with peers.
+func TxnFilterKey(payload []byte) crypto.Digest {
+	return generateMessageDigest(protocol.TxnTag, payload)
+}
+
+// EncodeTxnFilter encodes a filter of the given transaction message keys to be broadcast with
+// TxnFilterTag. When there are more than MaxTxnFilterEntries keys, only the last ones are kept.
+func EncodeTxnFilter(keys []crypto.Digest) ([]byte, error) {
+	if len(keys) > MaxTxnFilterEntries {
+		keys = keys[len(keys)-MaxTxnFilterEntries:]
+	}
+	sizeBits, numHashes := bloom.Optimal(len(keys), TxnFilterFalsePositiveRate)
+	filter := bloom.New(sizeBits, numHashes, uint32(crypto.RandUint64()))
+	for i := range keys {
+		filter.Set(keys[i][:])
+	}
+	return filter.MarshalBinary()
+}
+
+// peerTxnFilter is the latest transaction filter received from a peer.
+type peerTxnFilter struct {
+	filter   *bloom.Filter
+	received time.Time
+}
+
+func decodeTxnFilter(data []byte) (*bloom.Filter, error) {
+	if len(data) > TxnFilterMaxSize() {
+		return nil, errTxnFilterSize
+	}
+	return bloom.UnmarshalBinary(data)
+}
+
+// has reports whether the filter, if still current, contains the transaction message of the given key.
+// It must only be called from the write loop of the peer, as bloom filters are not safe for concurrent use.
+func (f *peerTxnFilter) has(key crypto.Digest, now time.Time) bool {
+	if f == nil || now.Sub(f.received) > txnFilterMaxAge {
+		return false
+	}
+	return f.filter.Test(key[:])
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type countingConn struct {
+	nopConn
+	written []protocol.Tag
+}
+
+func (c *countingConn) WriteMessage(_ int, data []byte) error {
+	c.written = append(c.written, protocol.Tag(data[:2]))
+	return nil
+}
+
+func TestTxnFilterEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	keys := make([]crypto.Digest, MaxTxnFilterEntries+10)
+	for i := range keys {
+		crypto.RandBytes(keys[i][:])
+	}
+	data, err := EncodeTxnFilter(keys)
+	require.NoError(t, err)
+	require.Equal(t, TxnFilterMaxSize(), len(data))
+	require.LessOrEqual(t, uint64(len(data)), protocol.TxnFilterTag.MaxMessageSize())
+
+	filter, err := decodeTxnFilter(data)
+	require.NoError(t, err)
+	received := &peerTxnFilter{filter: filter, received: time.Now()}
+	// only the last MaxTxnFilterEntries keys are kept
+	for _, key := range keys[10:] {
+		require.True(t, received.has(key, time.Now()))
+	}
+	var missing crypto.Digest
+	crypto.RandBytes(missing[:])
+	require.False(t, received.has(missing, time.Now()))
+
+	// stale filters are ignored
+	require.False(t, received.has(keys[len(keys)-1], time.Now().Add(txnFilterMaxAge+time.Second)))
+	var none *peerTxnFilter
+	require.False(t, none.has(keys[len(keys)-1], time.Now()))
+
+	_, err = decodeTxnFilter(make([]byte, TxnFilterMaxSize()+1))
+	require.ErrorIs(t, err, errTxnFilterSize)
+}
+
+func TestTxnFilterSuppressesRelay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	conn := &countingConn{}
+	wp := &wsPeer{
+		wsPeerCore:     wsPeerCore{log: logging.TestingLog(t)},
+		conn:           conn,
+		sendMessageTag: defaultSendMessageTags,
+	}
+	send := func(tag protocol.Tag, payload []byte) {
+		data := append([]byte(tag), payload...)
+		msg := sendMessage{data: data, enqueued: time.Now(), peerEnqueued: time.Now(), hash: crypto.Hash(data), ctx: context.Background()}
+		require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(msg))
+	}
+
+	seen, unseen := []byte("seen transaction"), []byte("unseen transaction")
+	filterData, err := EncodeTxnFilter([]crypto.Digest{TxnFilterKey(seen)})
+	require.NoError(t, err)
+
+	// filters are only sent to peers supporting them
+	send(protocol.TxnFilterTag, filterData)
+	require.Empty(t, conn.written)
+	wp.features = pfTxnFilter
+	send(protocol.TxnFilterTag, filterData)
+	require.Equal(t, []protocol.Tag{protocol.TxnFilterTag}, conn.written)
+
+	conn.written = nil
+	send(protocol.TxnTag, seen)
+	send(protocol.TxnTag, unseen)
+	require.Len(t, conn.written, 2)
+
+	wp.handleTxnFilterMessage(IncomingMessage{Tag: protocol.TxnFilterTag, Data: filterData})
+	conn.written = nil
+	send(protocol.TxnTag, seen)
+	require.Empty(t, conn.written)
+	send(protocol.TxnTag, unseen)
+	require.Equal(t, []protocol.Tag{protocol.TxnTag}, conn.written)
+}
@@ -125,6 +125,7 @@ var networkPeerAlreadyClosed = metrics.MakeCounter(metrics.MetricName{Name: "alg
 
 var networkSlowPeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_slow_drops_total", Description: "number of peers dropped for being slow to send to"})
 var networkIdlePeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_idle_drops_total", Description: "number of peers dropped due to idle connection"})
+var networkGenesisMismatch = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_genesis_mismatch_total", Description: "number of peer handshakes rejected for advertising another genesis ID or genesis hash"})
 var networkBroadcastQueueFull = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_broadcast_queue_full_total", Description: "number of messages that were drops due to full broadcast queue"})
 
 var minPing = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_peer_min_ping_seconds", Description: "Network round trip time to fastest peer in seconds."})
@@ -171,6 +172,9 @@ type WebsocketNetwork struct {
 	router   *mux.Router
 	scheme   string // are we serving http or https ?
 
+	// gossipTLS is set when the gossip connections use mutual TLS
+	gossipTLS *gossipTLSConfig
+
 	upgrader websocket.Upgrader
 
 	config config.Local
@@ -195,6 +199,10 @@ type WebsocketNetwork struct {
 	NetworkID protocol.NetworkID
 	RandomID  string
 
+	// GenesisHash, when set, is advertised during the handshake, and peers advertising a different
+	// genesis hash are refused. It guards against custom networks sharing a genesis ID.
+	GenesisHash crypto.Digest
+
 	ready     int32
 	readyChan chan struct{}
 
@@ -209,6 +217,10 @@ type WebsocketNetwork struct {
 
 	incomingMsgFilter *messageFilter // message filter to remove duplicate incoming messages from different peers
 
+	duplicateLatency *duplicateLatencyTracker // records how late each peer delivers the votes and proposals, if enabled
+
+	tagBudgetRates map[protocol.Tag]uint64 // bandwidth budgets, in bytes per second, of the message tags sent to each peer
+
 	eventualReadyDelay time.Duration
 
 	relayMessages bool // True if we should relay messages from other nodes (nominally true for relays, false otherwise)
@@ -249,7 +261,9 @@ type WebsocketNetwork struct {
 	// transport and dialer are customized to limit the number of
 	// connection in compliance with connectionsRateLimitingCount.
 	transport rateLimitingTransport
-	dialer    Dialer
+	// outboundTransport applies the outbound http policy on top of transport
+	outboundTransport http.RoundTripper
+	dialer            Dialer
 
 	// messagesOfInterest specifies the message types that this node
 	// wants to receive.  nil means default.  non-nil causes this
@@ -328,6 +342,9 @@ type msgHandler struct {
 	config     config.Local
 	readBuffer chan IncomingMessage
 	Multiplexer
+
+	// capture is nil unless GossipCaptureDir is set
+	capture *gossipCapture
 }
 
 // networkPeerManager provides the network functionality needed by msgBroadcaster and msgHandler for managing
@@ -585,6 +602,13 @@ func (wn *WebsocketNetwork) setup() {
 	maxIdleConnsPerHost := int(wn.config.ConnectionsRateLimitingCount)
 	wn.dialer = makeRateLimitingDialer(wn.phonebook, preferredResolver)
 	wn.transport = makeRateLimitingTransport(wn.phonebook, 10*time.Second, &wn.dialer, maxIdleConnsPerHost)
+	if wn.gossipTLS != nil {
+		// peers serve their http endpoints on the same TLS-only listener as the gossip endpoint
+		wn.transport.innerTransport.TLSClientConfig = wn.gossipTLS.client
+		wn.transport.forceTLS = true
+		wn.server.TLSConfig = wn.gossipTLS.server
+	}
+	wn.outboundTransport = makeOutboundHTTPTransport(&wn.transport, wn.config)
 
 	wn.upgrader.ReadBufferSize = 4096
 	wn.upgrader.WriteBufferSize = 4096
@@ -644,6 +668,7 @@ func (wn *WebsocketNetwork) setup() {
 		log:        wn.log,
 		config:     wn.config,
 		readBuffer: make(chan IncomingMessage, readBufferLen),
+		capture:    makeGossipCapture(wn.config, wn.log),
 	}
 
 	var rbytes [10]byte
@@ -653,6 +678,17 @@ func (wn *WebsocketNetwork) setup() {
 	if wn.config.EnableIncomingMessageFilter {
 		wn.incomingMsgFilter = makeMessageFilter(wn.config.IncomingMessageFilterBucketCount, wn.config.IncomingMessageFilterBucketSize)
 	}
+	if wn.config.EnablePeerDuplicateLatency {
+		wn.duplicateLatency = makeDuplicateLatencyTracker(duplicateLatencyWindow)
+	}
+	if wn.config.OutgoingTagBandwidthBudgets != "" {
+		budgets, err := parseTagBudgets(wn.config.OutgoingTagBandwidthBudgets)
+		if err != nil {
+			wn.log.Warnf("ignoring OutgoingTagBandwidthBudgets: %v", err)
+		} else {
+			wn.tagBudgetRates = budgets
+		}
+	}
 	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag})
 	wn.lastNetworkAdvance = time.Now().UTC()
 
@@ -702,7 +738,7 @@ func (wn *WebsocketNetwork) Start() {
 	if wn.config.DisableOutgoingConnectionThrottling {
 		wn.throttledOutgoingConnections = 0
 	}
-	if wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "" {
+	if wn.gossipTLS != nil || (wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "") {
 		wn.scheme = "https"
 	} else {
 		wn.scheme = "http"
@@ -762,7 +798,10 @@ func (wn *WebsocketNetwork) Start() {
 func (wn *WebsocketNetwork) httpdThread() {
 	defer wn.wg.Done()
 	var err error
-	if wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "" {
+	if wn.gossipTLS != nil {
+		// the certificate is provided by wn.server.TLSConfig
+		err = wn.server.ServeTLS(wn.listener, "", "")
+	} else if wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "" {
 		err = wn.server.ServeTLS(wn.listener, wn.config.TLSCertFile, wn.config.TLSKeyFile)
 	} else {
 		err = wn.server.Serve(wn.listener)
@@ -815,6 +854,9 @@ func (wn *WebsocketNetwork) Stop() {
 	if wn.listener != nil {
 		wn.log.Debugf("closed %s", listenAddr)
 	}
+	if wn.handler.capture != nil {
+		wn.handler.capture.close()
+	}
 
 	// Wait for the requestsTracker to finish up to avoid potential race condition
 	<-wn.requestsTracker.getWaitUntilNoConnectionsChannel(5 * time.Millisecond)
@@ -844,6 +886,19 @@ func (wn *WebsocketNetwork) setHeaders(header http.Header) {
 	header.Set(InstanceNameHeader, localInstanceName)
 	header.Set(AddressHeader, wn.PublicAddress())
 	header.Set(NodeRandomHeader, wn.RandomID)
+	if !wn.GenesisHash.IsZero() {
+		header.Set(GenesisHashHeader, wn.GenesisHash.String())
+	}
+}
+
+// checkGenesisHash returns false if the peer advertised a genesis hash different from ours.
+// Peers which do not advertise one are accepted, as older versions do not send it.
+func (wn *WebsocketNetwork) checkGenesisHash(otherHeader http.Header) bool {
+	otherGenesisHash := otherHeader.Get(GenesisHashHeader)
+	if wn.GenesisHash.IsZero() || otherGenesisHash == "" {
+		return true
+	}
+	return otherGenesisHash == wn.GenesisHash.String()
 }
 
 // checkServerResponseVariables check that the version and random-id in the request headers matches the server ones.
@@ -872,6 +927,12 @@ func (wn *WebsocketNetwork) checkServerResponseVariables(otherHeader http.Header
 		} else {
 			wn.log.Warnf("new peer %#v did not include genesis header in response. mine=%#v headers %#v", addr, wn.GenesisID, otherHeader)
 		}
+		networkGenesisMismatch.Inc(map[string]string{"direction": "outgoing"})
+		return false, ""
+	}
+	if !wn.checkGenesisHash(otherHeader) {
+		wn.log.Warn(filterASCII(fmt.Sprintf("new peer %#v genesis hash mismatch, mine=%#v theirs=%#v", addr, wn.GenesisHash.String(), otherHeader.Get(GenesisHashHeader))))
+		networkGenesisMismatch.Inc(map[string]string{"direction": "outgoing"})
 		return false, ""
 	}
 	return true, matchingVersion
@@ -958,6 +1019,7 @@ func (wn *WebsocketNetwork) checkIncomingConnectionVariables(response http.Respo
 	if wn.GenesisID != otherGenesisID {
 		wn.log.Warn(filterASCII(fmt.Sprintf("new peer %#v genesis mismatch, mine=%#v theirs=%#v, headers %#v", request.RemoteAddr, wn.GenesisID, otherGenesisID, request.Header)))
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "mismatching genesis-id"})
+		networkGenesisMismatch.Inc(map[string]string{"direction": "incoming"})
 		response.WriteHeader(http.StatusPreconditionFailed)
 		n, err := response.Write([]byte("mismatching genesis ID"))
 		if err != nil {
@@ -966,6 +1028,19 @@ func (wn *WebsocketNetwork) checkIncomingConnectionVariables(response http.Respo
 		return http.StatusPreconditionFailed
 	}
 
+	if !wn.checkGenesisHash(request.Header) {
+		otherGenesisHash := request.Header.Get(GenesisHashHeader)
+		wn.log.Warn(filterASCII(fmt.Sprintf("new peer %#v genesis hash mismatch, mine=%#v theirs=%#v", request.RemoteAddr, wn.GenesisHash.String(), otherGenesisHash)))
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "mismatching genesis-hash"})
+		networkGenesisMismatch.Inc(map[string]string{"direction": "incoming"})
+		response.WriteHeader(http.StatusPreconditionFailed)
+		n, err := response.Write([]byte("mismatching genesis hash"))
+		if err != nil {
+			wn.log.Warnf("ws failed to write mismatching genesis hash response '%s' : n = %d err = %v", otherGenesisHash, n, err)
+		}
+		return http.StatusPreconditionFailed
+	}
+
 	otherRandom := request.Header.Get(NodeRandomHeader)
 	if otherRandom == "" {
 		// This is pretty harmless and some configurations of phonebooks or DNS records make this likely. Quietly filter it out.
@@ -1041,7 +1116,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	wn.setHeaders(responseHeader)
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	responseHeader.Set(PeerFeaturesHeader, localPeerFeatures)
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1079,6 +1154,8 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		outgoing:          false,
 		InstanceName:      trackedRequest.otherInstanceName,
 		incomingMsgFilter: wn.incomingMsgFilter,
+		duplicateLatency:  wn.duplicateLatency,
+		tagBudgetRates:    wn.tagBudgetRates,
 		prioChallenge:     challenge,
 		createTime:        trackedRequest.created,
 		version:           matchingVersion,
@@ -1141,6 +1218,9 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 			if wn.config.EnableOutgoingNetworkMessageFiltering && len(msg.Data) >= messageFilterSize {
 				wn.sendFilterMessage(msg, net)
 			}
+			if wn.capture != nil {
+				wn.capture.record(msg)
+			}
 			//wn.log.Debugf("msg handling %#v [%d]byte", msg.Tag, len(msg.Data))
 			start := time.Now()
 
@@ -1386,7 +1466,8 @@ func (wn *msgBroadcaster) preparePeerData(request broadcastRequest, prio bool, p
 		copy(mbytes, tbytes)
 		copy(mbytes[len(tbytes):], d)
 		data[i] = mbytes
-		if request.tags[i] != protocol.MsgDigestSkipTag && len(d) >= messageFilterSize {
+		if request.tags[i] == protocol.TxnTag || (request.tags[i] != protocol.MsgDigestSkipTag && len(d) >= messageFilterSize) {
+			// transaction messages are always hashed to be checked against the peers transaction filters
 			digests[i] = crypto.Hash(mbytes)
 		}
 
@@ -1927,6 +2008,9 @@ const TelemetryIDHeader = "X-Algorand-TelId"
 // GenesisHeader HTTP header for genesis id to make sure we're on the same chain
 const GenesisHeader = "X-Algorand-Genesis"
 
+// GenesisHashHeader HTTP header for genesis hash to make sure we're on the same chain, even when genesis ids collide
+const GenesisHashHeader = "X-Algorand-Genesis-Hash"
+
 // NodeRandomHeader HTTP header that a node uses to make sure it's not talking to itself
 const NodeRandomHeader = "X-Algorand-NodeRandom"
 
@@ -1955,6 +2039,13 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureTxnFilter is a value for PeerFeaturesHeader indicating peer
+// supports receiving filters of recently seen transactions
+const PeerFeatureTxnFilter = "txfilter"
+
+// localPeerFeatures lists the features announced to peers in PeerFeaturesHeader
+var localPeerFeatures = strings.Join([]string{PeerFeatureProposalCompression, PeerFeatureTxnFilter}, ",")
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2008,9 +2099,9 @@ func (wn *WebsocketNetwork) numOutgoingPending() int {
 }
 
 // GetRoundTripper returns an http.Transport that limits the number of connection
-// to comply with connectionsRateLimitingCount.
+// to comply with connectionsRateLimitingCount, and retries the failed requests.
 func (wn *WebsocketNetwork) GetRoundTripper() http.RoundTripper {
-	return &wn.transport
+	return wn.outboundTransport
 }
 
 // filterASCII filter out the non-ascii printable characters out of the given input string and
@@ -2054,7 +2145,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	requestHeader.Set(PeerFeaturesHeader, localPeerFeatures)
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2066,6 +2157,9 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		NetDial:           wn.dialer.Dial,
 		MaxHeaderSize:     wn.wsMaxHeaderBytes,
 	}
+	if wn.gossipTLS != nil {
+		websocketDialer.TLSClientConfig = wn.gossipTLS.client
+	}
 
 	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
 
@@ -2152,6 +2246,8 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		conn:                        wsPeerWebsocketConnImpl{conn},
 		outgoing:                    true,
 		incomingMsgFilter:           wn.incomingMsgFilter,
+		duplicateLatency:            wn.duplicateLatency,
+		tagBudgetRates:              wn.tagBudgetRates,
 		createTime:                  time.Now(),
 		connMonitor:                 wn.connPerfMonitor,
 		throttledOutgoingConnection: throttledConnection,
@@ -2250,6 +2346,10 @@ func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddre
 		nodeInfo:          nodeInfo,
 		resolveSRVRecords: tools_network.ReadFromSRV,
 	}
+	wn.gossipTLS, err = makeGossipTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
 	wn.setup()
 	return wn, nil
@@ -2327,6 +2427,9 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 		heap.Remove(peersHeap{wn}, peer.peerIndex)
 		wn.prioTracker.removePeer(peer)
 		wn.identityTracker.removeIdentity(peer)
+		if wn.duplicateLatency != nil {
+			wn.duplicateLatency.removePeer(peer.duplicateLatencyLabel())
+		}
 		if peer.throttledOutgoingConnection {
 			atomic.AddInt32(&wn.throttledOutgoingConnections, int32(1))
 		}
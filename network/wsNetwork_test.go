@@ -2443,6 +2443,25 @@ func TestWebsocketNetwork_checkServerResponseVariables(t *testing.T) {
 	differentGenesisIDHeader.Set(GenesisHeader, wn.GenesisID+"tag")
 	responseVariableOk, _ = wn.checkServerResponseVariables(differentGenesisIDHeader, "addressX")
 	require.Equal(t, false, responseVariableOk)
+
+	// the genesis hash is only compared when both sides advertise one.
+	wn.GenesisHash = crypto.Hash([]byte("genesis-hash1"))
+	responseVariableOk, _ = wn.checkServerResponseVariables(header, "addressX")
+	require.Equal(t, true, responseVariableOk)
+
+	sameGenesisHashHeader := header.Clone()
+	sameGenesisHashHeader.Set(GenesisHashHeader, wn.GenesisHash.String())
+	responseVariableOk, _ = wn.checkServerResponseVariables(sameGenesisHashHeader, "addressX")
+	require.Equal(t, true, responseVariableOk)
+
+	differentGenesisHashHeader := header.Clone()
+	differentGenesisHashHeader.Set(GenesisHashHeader, crypto.Hash([]byte("genesis-hash2")).String())
+	responseVariableOk, _ = wn.checkServerResponseVariables(differentGenesisHashHeader, "addressX")
+	require.Equal(t, false, responseVariableOk)
+
+	setHeader := http.Header{}
+	wn.setHeaders(setHeader)
+	require.Equal(t, wn.GenesisHash.String(), setHeader.Get(GenesisHashHeader))
 }
 
 func (wn *WebsocketNetwork) broadcastWithTimestamp(tag protocol.Tag, data []byte, when time.Time) error {
@@ -113,7 +113,9 @@ var defaultSendMessageTags = map[protocol.Tag]bool{
 	protocol.ProposalPayloadTag:   true,
 	protocol.TopicMsgRespTag:      true,
 	protocol.MsgOfInterestTag:     true,
+	protocol.TxnFilterTag:         true,
 	protocol.TxnTag:               true,
+	protocol.UniStateDeltaReqTag:  true,
 	protocol.UniEnsBlockReqTag:    true,
 	protocol.VoteBundleTag:        true,
 }
@@ -248,6 +250,14 @@ type wsPeer struct {
 	incomingMsgFilter *messageFilter
 	outgoingMsgFilter *messageFilter
 
+	// duplicateLatency, if set, records how late the peer delivers the votes and proposals.
+	duplicateLatency *duplicateLatencyTracker
+
+	// tagBudgetRates are the bandwidth budgets, in bytes per second, of the message tags sent to the peer,
+	// and tagBudgets the matching token buckets, which are only used by the write loop.
+	tagBudgetRates map[protocol.Tag]uint64
+	tagBudgets     map[protocol.Tag]*tagBudget
+
 	processed chan struct{}
 
 	pingLock              deadlock.Mutex
@@ -281,6 +291,9 @@ type wsPeer struct {
 	// peer features derived from the peer version
 	features peerFeatureFlag
 
+	// txnFilter is the latest filter of recently seen transactions received from the peer
+	txnFilter atomic.Pointer[peerTxnFilter]
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -382,7 +395,7 @@ func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) err
 	copy(mbytes, tbytes)
 	copy(mbytes[len(tbytes):], msg)
 	var digest crypto.Digest
-	if tag != protocol.MsgDigestSkipTag && len(msg) >= messageFilterSize {
+	if tag == protocol.TxnTag || (tag != protocol.MsgDigestSkipTag && len(msg) >= messageFilterSize) {
 		digest = crypto.Hash(mbytes)
 	}
 
@@ -474,6 +487,7 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	if config.EnableOutgoingNetworkMessageFiltering {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
+	wp.tagBudgets = makeTagBudgets(wp.tagBudgetRates, time.Now())
 
 	wp.wg.Add(2)
 	go wp.readLoop()
@@ -485,6 +499,15 @@ func (wp *wsPeer) OriginAddress() string {
 	return wp.originAddress
 }
 
+// duplicateLatencyLabel identifies the peer in the duplicate latency histograms: by the address it
+// is connected to, or announces, or else by the address it connects from.
+func (wp *wsPeer) duplicateLatencyLabel() string {
+	if wp.rootURL != "" {
+		return wp.rootURL
+	}
+	return wp.OriginAddress()
+}
+
 func (wp *wsPeer) reportReadErr(err error) {
 	// only report error if we haven't already closed the peer
 	if atomic.LoadInt32(&wp.didInnerClose) == 0 {
@@ -633,6 +656,10 @@ func (wp *wsPeer) readLoop() {
 			// network maintenance message handled immediately instead of handing off to general handlers
 			wp.handleFilterMessage(msg)
 			continue
+		case protocol.TxnFilterTag:
+			// network maintenance message handled immediately instead of handing off to general handlers
+			wp.handleTxnFilterMessage(msg)
+			continue
 		case protocol.TxnTag:
 			atomic.AddUint64(&wp.txMessageCount, 1)
 		case protocol.AgreementVoteTag:
@@ -641,13 +668,16 @@ func (wp *wsPeer) readLoop() {
 			atomic.AddUint64(&wp.ppMessageCount, 1)
 		// the remaining valid tags: no special handling here
 		case protocol.NetPrioResponseTag, protocol.PingTag, protocol.PingReplyTag,
-			protocol.StateProofSigTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag:
+			protocol.StateProofSigTag, protocol.UniStateDeltaReqTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag:
 		default: // unrecognized tag
 			unknownProtocolTagMessagesTotal.Inc(nil)
 			atomic.AddUint64(&wp.unkMessageCount, 1)
 			continue // drop message, skip adding it to queue
 			// TODO: should disconnect here?
 		}
+		if wp.duplicateLatency != nil {
+			wp.duplicateLatency.observe(msg.Tag, msg.Data, wp.duplicateLatencyLabel(), msg.Received)
+		}
 		if len(msg.Data) > 0 && wp.incomingMsgFilter != nil && dedupSafeTag(msg.Tag) {
 			if wp.incomingMsgFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, true) {
 				//wp.log.Debugf("dropped incoming duplicate %s(%d)", msg.Tag, len(msg.Data))
@@ -744,11 +774,22 @@ func (wp *wsPeer) handleFilterMessage(msg IncomingMessage) {
 	}
 }
 
-func (wp *wsPeer) writeLoopSend(msgs sendMessages) disconnectReason {
+func (wp *wsPeer) handleTxnFilterMessage(msg IncomingMessage) {
+	filter, err := decodeTxnFilter(msg.Data)
+	if err != nil {
+		wp.log.Warnf("bad transaction filter message from %s: %v", wp.conn.RemoteAddrString(), err)
+		return
+	}
+	wp.txnFilter.Store(&peerTxnFilter{filter: filter, received: time.Now()})
+}
+
+// writeLoopSend sends a batch of messages. When the batch is preemptible, the high priority messages
+// queued in the meantime are sent before each of its messages.
+func (wp *wsPeer) writeLoopSend(msgs sendMessages, preemptible bool) disconnectReason {
 	if msgs.onRelease != nil {
 		defer msgs.onRelease()
 	}
-	for _, msg := range msgs.msgs {
+	for i, msg := range msgs.msgs {
 		select {
 		case <-msg.ctx.Done():
 			//logging.Base().Infof("cancelled large send, msg %v out of %v", i, len(msgs.msgs))
@@ -756,6 +797,12 @@ func (wp *wsPeer) writeLoopSend(msgs sendMessages) disconnectReason {
 		default:
 		}
 
+		if preemptible && i > 0 {
+			if err := wp.writeLoopSendHighPrio(); err != disconnectReasonNone {
+				return err
+			}
+		}
+
 		if err := wp.writeLoopSendMsg(msg); err != disconnectReasonNone {
 			return err
 		}
@@ -782,6 +829,16 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		// the peer isn't interested in this message.
 		return disconnectReasonNone
 	}
+	if tag == protocol.TxnFilterTag && !wp.pfTxnFilterSupported() {
+		// the peer would not understand this message.
+		return disconnectReasonNone
+	}
+	if tag == protocol.TxnTag && wp.txnFilter.Load().has(msg.hash, time.Now()) {
+		// the peer has notified us it already has this message
+		outgoingNetworkMessageFilteredOutTotal.Inc(nil)
+		outgoingNetworkMessageFilteredOutBytesTotal.AddUint64(uint64(len(msg.data)), nil)
+		return disconnectReasonNone
+	}
 
 	// check if this message was waiting in the queue for too long. If this is the case, return "true" to indicate that we want to close the connection.
 	now := time.Now()
@@ -792,6 +849,16 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectStaleWrite
 	}
 
+	// a message held back by the budget of its tag is set aside, and sent by the write loop once the budget allows it.
+	if budget := wp.tagBudgets[tag]; budget != nil && !budget.admit(len(msg.data), now) {
+		budget.hold(msg, now)
+		return disconnectReasonNone
+	}
+	return wp.writeLoopWrite(msg, tag)
+}
+
+// writeLoopWrite writes a message to the connection.
+func (wp *wsPeer) writeLoopWrite(msg sendMessage, tag protocol.Tag) disconnectReason {
 	atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, msg.enqueued.UnixNano())
 	defer atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, 0)
 	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
@@ -811,6 +878,38 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	return disconnectReasonNone
 }
 
+// writeLoopSendHighPrio sends the high priority messages queued so far.
+func (wp *wsPeer) writeLoopSendHighPrio() disconnectReason {
+	for {
+		select {
+		case data := <-wp.sendBufferHighPrio:
+			if err := wp.writeLoopSend(data, false); err != disconnectReasonNone {
+				return err
+			}
+		default:
+			return disconnectReasonNone
+		}
+	}
+}
+
+// writeLoopSendHeld sends the messages held back by the budget of their tag which it allows by now. It
+// returns how long to wait before the next held message may be sent, or zero if no message is held back.
+func (wp *wsPeer) writeLoopSendHeld(now time.Time) (time.Duration, disconnectReason) {
+	var next time.Duration
+	for tag, budget := range wp.tagBudgets {
+		msgs, wait := budget.release(now)
+		for _, msg := range msgs {
+			if err := wp.writeLoopWrite(msg, tag); err != disconnectReasonNone {
+				return 0, err
+			}
+		}
+		if wait > 0 && (next == 0 || wait < next) {
+			next = wait
+		}
+	}
+	return next, disconnectReasonNone
+}
+
 func (wp *wsPeer) writeLoop() {
 	// the cleanupCloseError sets the default error to disconnectWriteError; depending on the exit reason, the error might get changed.
 	cleanupCloseError := disconnectWriteError
@@ -818,10 +917,23 @@ func (wp *wsPeer) writeLoop() {
 		wp.writeLoopCleanup(cleanupCloseError)
 	}()
 	for {
+		// send the messages held back by their budget as soon as it allows them
+		var heldReady <-chan time.Time
+		if len(wp.tagBudgets) > 0 {
+			wait, writeErr := wp.writeLoopSendHeld(time.Now())
+			if writeErr != disconnectReasonNone {
+				cleanupCloseError = writeErr
+				return
+			}
+			if wait > 0 {
+				heldReady = time.After(wait)
+			}
+		}
+
 		// send from high prio channel as long as we can
 		select {
 		case data := <-wp.sendBufferHighPrio:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
+			if writeErr := wp.writeLoopSend(data, false); writeErr != disconnectReasonNone {
 				cleanupCloseError = writeErr
 				return
 			}
@@ -833,15 +945,16 @@ func (wp *wsPeer) writeLoop() {
 		case <-wp.closing:
 			return
 		case data := <-wp.sendBufferHighPrio:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
+			if writeErr := wp.writeLoopSend(data, false); writeErr != disconnectReasonNone {
 				cleanupCloseError = writeErr
 				return
 			}
 		case data := <-wp.sendBufferBulk:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
+			if writeErr := wp.writeLoopSend(data, true); writeErr != disconnectReasonNone {
 				cleanupCloseError = writeErr
 				return
 			}
+		case <-heldReady:
 		}
 	}
 }
@@ -1110,6 +1223,10 @@ func (wp *wsPeer) pfProposalCompressionSupported() bool {
 	return wp.features&pfCompressedProposal != 0
 }
 
+func (wp *wsPeer) pfTxnFilterSupported() bool {
+	return wp.features&pfTxnFilter != 0
+}
+
 func (wp *wsPeer) OnClose(f func()) {
 	if wp.closers == nil {
 		wp.closers = []func(){}
@@ -1122,6 +1239,8 @@ type peerFeatureFlag int
 
 const pfCompressedProposal peerFeatureFlag = 1
 
+const pfTxnFilter peerFeatureFlag = 2
+
 // versionPeerFeatures defines protocol version when peer features were introduced
 const versionPeerFeatures = "2.2"
 
@@ -1164,6 +1283,9 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 		if part == PeerFeatureProposalCompression {
 			features |= pfCompressedProposal
 		}
+		if part == PeerFeatureTxnFilter {
+			features |= pfTxnFilter
+		}
 	}
 	return features
 }
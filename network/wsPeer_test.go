@@ -183,6 +183,8 @@ func TestVersionToFeature(t *testing.T) {
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ","), pfCompressedProposal},
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ", "), pfCompressedProposal},
 		{"2.3", PeerFeatureProposalCompression, pfCompressedProposal},
+		{"2.2", PeerFeatureTxnFilter, pfTxnFilter},
+		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, PeerFeatureTxnFilter}, ","), pfCompressedProposal | pfTxnFilter},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {